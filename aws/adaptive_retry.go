@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+const (
+	// RetryModeStandard is the default retry mode. Requests are retried with
+	// exponential backoff, without limiting the rate requests are sent at.
+	RetryModeStandard = "standard"
+
+	// RetryModeAdaptive retries requests the same as RetryModeStandard, and
+	// also limits the rate that requests are sent at by the client once the
+	// service starts throttling requests. The send rate is reduced each time
+	// a request is throttled, and slowly increased as requests succeed.
+	RetryModeAdaptive = "adaptive"
+)
+
+const (
+	// The minimum send rate, in requests per second, adaptive mode will reduce
+	// the rate to.
+	adaptiveMinFillRate = 0.5
+
+	// The factor the send rate is multiplied by each time a request is
+	// throttled.
+	adaptiveThrottleBeta = 0.7
+
+	// The amount, in requests per second, the send rate is increased by each
+	// time a request succeeds.
+	adaptiveSuccessIncrease = 1.0
+
+	// The window the measured send rate is sampled over.
+	adaptiveMeasureWindow = 500 * time.Millisecond
+)
+
+// throttleCodes is a collection of service response codes which signify the
+// request was throttled by the service.
+var throttleCodes = map[string]struct{}{
+	"ProvisionedThroughputExceededException": {},
+	"Throttling":                             {},
+	"ThrottlingException":                    {},
+	"RequestLimitExceeded":                   {},
+	"RequestThrottled":                       {},
+	"TooManyRequestsException":               {},
+}
+
+func isCodeThrottle(code string) bool {
+	_, ok := throttleCodes[code]
+	return ok
+}
+
+// An adaptiveRateLimiter is a client side token bucket limiting the rate that
+// requests are sent at. The bucket is only enabled once a request has been
+// throttled. While enabled the bucket's fill rate is adjusted with additive
+// increase on successful requests, and multiplicative decrease on throttled
+// requests.
+type adaptiveRateLimiter struct {
+	m sync.Mutex
+
+	enabled    bool
+	fillRate   float64 // tokens per second
+	capacity   float64
+	tokens     float64
+	lastRefill time.Time
+
+	measuredRate float64 // smoothed requests per second sent
+	windowStart  time.Time
+	windowCount  int
+
+	now   func() time.Time
+	sleep func(Context, time.Duration) error
+}
+
+// newAdaptiveRateLimiter returns an initialized adaptiveRateLimiter.
+func newAdaptiveRateLimiter() *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{
+		now:   time.Now,
+		sleep: SleepWithContext,
+	}
+}
+
+// acquire blocks until the request is allowed to be sent. Returns the
+// context's error if the context is canceled while waiting.
+func (l *adaptiveRateLimiter) acquire(ctx Context) error {
+	for {
+		l.m.Lock()
+		l.measure()
+		if !l.enabled {
+			l.m.Unlock()
+			return nil
+		}
+
+		l.refill()
+		if l.tokens >= 1 {
+			l.tokens--
+			l.m.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.fillRate * float64(time.Second))
+		l.m.Unlock()
+
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// throttled reduces the send rate after a request was throttled, enabling
+// the rate limiting if it was not already enabled.
+func (l *adaptiveRateLimiter) throttled() {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	rate := l.measuredRate
+	if l.enabled && l.fillRate < rate {
+		rate = l.fillRate
+	}
+	l.refill()
+	l.setFillRate(rate * adaptiveThrottleBeta)
+	l.enabled = true
+}
+
+// succeeded increases the send rate after a request succeeded.
+func (l *adaptiveRateLimiter) succeeded() {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	if !l.enabled {
+		return
+	}
+	l.refill()
+	l.setFillRate(l.fillRate + adaptiveSuccessIncrease)
+}
+
+// setFillRate sets the bucket's fill rate, and sizes the bucket to allow for
+// bursts of up to one second of requests.
+func (l *adaptiveRateLimiter) setFillRate(rate float64) {
+	l.fillRate = math.Max(rate, adaptiveMinFillRate)
+	l.capacity = math.Max(l.fillRate, 1)
+	l.tokens = math.Min(l.tokens, l.capacity)
+}
+
+// refill adds the tokens accumulated since the last refill to the bucket.
+func (l *adaptiveRateLimiter) refill() {
+	now := l.now()
+	if !l.lastRefill.IsZero() {
+		l.tokens += now.Sub(l.lastRefill).Seconds() * l.fillRate
+		l.tokens = math.Min(l.tokens, l.capacity)
+	}
+	l.lastRefill = now
+}
+
+// measure records a request being sent, updating the smoothed measured
+// send rate at the end of each measurement window.
+func (l *adaptiveRateLimiter) measure() {
+	now := l.now()
+	if l.windowStart.IsZero() {
+		l.windowStart = now
+	}
+	l.windowCount++
+
+	if elapsed := now.Sub(l.windowStart); elapsed >= adaptiveMeasureWindow {
+		rate := float64(l.windowCount) / elapsed.Seconds()
+		l.measuredRate = 0.8*l.measuredRate + 0.2*rate
+		l.windowStart = now
+		l.windowCount = 0
+	} else if l.measuredRate == 0 {
+		l.measuredRate = float64(l.windowCount) / adaptiveMeasureWindow.Seconds()
+	}
+}
+
+// acquireSendToken blocks until the service's send rate limiter, and its
+// adaptive rate limiter, allow the request to be sent. Returns false and sets
+// the request's error if the request's context was canceled while waiting.
+func acquireSendToken(r *Request) bool {
+	if l := r.Service.sendRateLimiter; l != nil {
+		if err := l.acquire(r.Context()); err != nil {
+			r.Error = newCanceledError(err)
+			r.Retryable.Set(false)
+			return false
+		}
+	}
+	if r.Service.rateLimiter == nil {
+		return true
+	}
+	if err := r.Service.rateLimiter.acquire(r.Context()); err != nil {
+		r.Error = newCanceledError(err)
+		r.Retryable.Set(false)
+		return false
+	}
+	return true
+}
+
+// AdaptiveRetryErrorHandler is a request handler which reduces the service's
+// adaptive send rate if the request failed because it was throttled.
+func AdaptiveRetryErrorHandler(r *Request) {
+	if r.Service.rateLimiter == nil {
+		return
+	}
+	if isRequestThrottled(r) {
+		r.Service.rateLimiter.throttled()
+	}
+}
+
+// AdaptiveRetrySuccessHandler is a request handler which increases the
+// service's adaptive send rate after a request succeeded. It runs with the
+// Complete handlers, so requests whose response failed to unmarshal are not
+// counted as succeeded.
+func AdaptiveRetrySuccessHandler(r *Request) {
+	if r.Service.rateLimiter == nil || r.Error != nil {
+		return
+	}
+	r.Service.rateLimiter.succeeded()
+}
+
+// isRequestThrottled returns if the request failed due to being throttled.
+func isRequestThrottled(r *Request) bool {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == 429 {
+		return true
+	}
+	if err, ok := r.Error.(awserr.Error); ok {
+		return isCodeThrottle(err.Code())
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClock struct {
+	now   time.Time
+	slept []time.Duration
+}
+
+func (c *mockClock) Now() time.Time { return c.now }
+
+func (c *mockClock) Sleep(ctx Context, d time.Duration) error {
+	c.slept = append(c.slept, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func newMockRateLimiter(c *mockClock) *adaptiveRateLimiter {
+	l := newAdaptiveRateLimiter()
+	l.now = c.Now
+	l.sleep = c.Sleep
+	return l
+}
+
+func TestAdaptiveRateLimiterDisabledUntilThrottled(t *testing.T) {
+	c := &mockClock{now: time.Unix(0, 0)}
+	l := newMockRateLimiter(c)
+
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, l.acquire(BackgroundContext()))
+	}
+	assert.False(t, l.enabled)
+	assert.Empty(t, c.slept)
+
+	l.succeeded()
+	assert.False(t, l.enabled)
+}
+
+func TestAdaptiveRateLimiterThrottleReducesRate(t *testing.T) {
+	c := &mockClock{now: time.Unix(0, 0)}
+	l := newMockRateLimiter(c)
+
+	for i := 0; i < 10; i++ {
+		l.acquire(BackgroundContext())
+		c.now = c.now.Add(100 * time.Millisecond)
+	}
+
+	l.throttled()
+	assert.True(t, l.enabled)
+	first := l.fillRate
+	assert.True(t, first > 0)
+
+	l.throttled()
+	assert.InDelta(t, first*adaptiveThrottleBeta, l.fillRate, 0.0001)
+
+	for i := 0; i < 100; i++ {
+		l.throttled()
+	}
+	assert.Equal(t, adaptiveMinFillRate, l.fillRate)
+
+	l.succeeded()
+	assert.Equal(t, adaptiveMinFillRate+adaptiveSuccessIncrease, l.fillRate)
+}
+
+func TestAdaptiveRateLimiterWaitsForTokens(t *testing.T) {
+	c := &mockClock{now: time.Unix(0, 0)}
+	l := newMockRateLimiter(c)
+	l.enabled = true
+	l.setFillRate(2)
+
+	assert.NoError(t, l.acquire(BackgroundContext()))
+	assert.NoError(t, l.acquire(BackgroundContext()))
+	assert.Len(t, c.slept, 2)
+	for _, d := range c.slept {
+		assert.Equal(t, 500*time.Millisecond, d)
+	}
+}
+
+func TestAdaptiveRateLimiterCanceled(t *testing.T) {
+	l := newAdaptiveRateLimiter()
+	l.enabled = true
+	l.setFillRate(adaptiveMinFillRate)
+
+	ctx, cancel := context.WithCancel(BackgroundContext())
+	cancel()
+	assert.Equal(t, context.Canceled, l.acquire(ctx))
+}
+
+func TestAdaptiveRetryMode(t *testing.T) {
+	s := NewService(&Config{RetryMode: RetryModeAdaptive, MaxRetries: 0})
+	assert.NotNil(t, s.rateLimiter)
+
+	s = NewService(&Config{})
+	assert.Nil(t, s.rateLimiter)
+}
+
+func TestAdaptiveRetryErrorHandler(t *testing.T) {
+	s := NewService(&Config{RetryMode: RetryModeAdaptive})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+
+	r.HTTPResponse = &http.Response{StatusCode: 400}
+	r.Error = awserr.New("ValidationError", "", nil)
+	AdaptiveRetryErrorHandler(r)
+	assert.False(t, s.rateLimiter.enabled)
+
+	r.Error = awserr.New("ThrottlingException", "", nil)
+	AdaptiveRetryErrorHandler(r)
+	assert.True(t, s.rateLimiter.enabled)
+}
+
+func TestAdaptiveRetrySuccessHandlerUnmarshalError(t *testing.T) {
+	s := NewService(&Config{RetryMode: RetryModeAdaptive, MaxRetries: 0})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":`)}
+	})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		defer r.HTTPResponse.Body.Close()
+		if err := json.NewDecoder(r.HTTPResponse.Body).Decode(r.Data); err != nil {
+			r.Error = awserr.New("SerializationError", "failed decoding response", err)
+		}
+	})
+	s.rateLimiter.enabled = true
+	s.rateLimiter.setFillRate(adaptiveMinFillRate)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.Error(t, r.Send())
+	assert.Equal(t, adaptiveMinFillRate, s.rateLimiter.fillRate)
+
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	})
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+	assert.Equal(t, adaptiveMinFillRate+adaptiveSuccessIncrease, s.rateLimiter.fillRate)
+}
@@ -58,6 +58,12 @@ func New(code, message string, origErr error) Error {
 // RequestFailures may not always have a requestID value if the request failed
 // prior to reaching the service such as a connection error.
 //
+// The errors of all responses the service returned an error status code for
+// are RequestFailures, so the code of the service's error can be checked
+// without matching the error's text. If the error response could not be
+// decoded the code is "SerializationError", and the original error is the
+// decoding error.
+//
 // Example:
 //
 //     output, err := s3manage.Upload(svc, input, opts)
@@ -103,3 +109,52 @@ type RequestFailure interface {
 func NewRequestFailure(err Error, statusCode int, reqID string) RequestFailure {
 	return newRequestError(err, statusCode, reqID)
 }
+
+// ErrCodeBatchPartialFailure is the code of the BatchErrors returned for
+// batch operations of which some of the items failed.
+const ErrCodeBatchPartialFailure = "BatchPartialFailure"
+
+// A BatchError is an Error of a batch operation, such as DynamoDB's
+// BatchWriteItem, SQS's SendMessageBatch, or Kinesis's PutRecords, of which
+// some of the items failed while the others succeeded. The service responds
+// successfully to such requests, the failed items are only reported in the
+// response, so the error is built from the response by the service package.
+//
+// Example:
+//
+//     out, err := svc.SendMessageBatch(in)
+//     if err == nil {
+//         err = sqs.SendMessageBatchError(in, out)
+//     }
+//     if batchErr, ok := err.(awserr.BatchError); ok {
+//         for _, itemErr := range batchErr.Errors() {
+//             log.Println("Entry", itemErr.Index(), "failed:", itemErr.Code(), itemErr.Message())
+//         }
+//     }
+//
+type BatchError interface {
+	Error
+
+	// Returns the errors of the failed items.
+	Errors() []BatchItemError
+}
+
+// A BatchItemError is the error of a single item of a batch operation.
+type BatchItemError interface {
+	Error
+
+	// The index of the item in the batch request.
+	Index() int
+}
+
+// NewBatchError returns a BatchError for the errors of the failed items of a
+// batch operation, described by the code and message.
+func NewBatchError(code, message string, errs []BatchItemError) BatchError {
+	return newBatchError(code, message, errs)
+}
+
+// NewBatchItemError returns a BatchItemError for the item at index of a batch
+// request, described by the code and message the service returned for it.
+func NewBatchItemError(index int, code, message string) BatchItemError {
+	return newBatchItemError(index, code, message)
+}
@@ -1,6 +1,9 @@
 package awserr
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // SprintError returns a string of the formatted error code.
 //
@@ -133,3 +136,76 @@ func (r requestError) StatusCode() int {
 func (r requestError) RequestID() string {
 	return r.requestID
 }
+
+// A batchError wraps the errors of the failed items of a batch operation.
+//
+// Composed of baseError for code and message.
+type batchError struct {
+	awsError
+	errs []BatchItemError
+}
+
+// newBatchError returns an error for the code and message wrapping the
+// errors of the failed items.
+func newBatchError(code, message string, errs []BatchItemError) *batchError {
+	return &batchError{
+		awsError: newBaseError(code, message, nil),
+		errs:     errs,
+	}
+}
+
+// Error returns the string representation of the error, with a line for the
+// error of each failed item.
+// Satisfies the error interface.
+func (b batchError) Error() string {
+	extra := make([]string, len(b.errs))
+	for i, err := range b.errs {
+		extra[i] = err.Error()
+	}
+	return SprintError(b.Code(), b.Message(), strings.Join(extra, "\n\t"), nil)
+}
+
+// String returns the string representation of the error.
+// Alias for Error to satisfy the stringer interface.
+func (b batchError) String() string {
+	return b.Error()
+}
+
+// Errors returns the errors of the failed items.
+func (b batchError) Errors() []BatchItemError {
+	return b.errs
+}
+
+// A batchItemError wraps the error of an item of a batch request.
+//
+// Composed of baseError for code and message.
+type batchItemError struct {
+	awsError
+	index int
+}
+
+// newBatchItemError returns an error for the code and message of the item at
+// index.
+func newBatchItemError(index int, code, message string) *batchItemError {
+	return &batchItemError{
+		awsError: newBaseError(code, message, nil),
+		index:    index,
+	}
+}
+
+// Error returns the string representation of the error.
+// Satisfies the error interface.
+func (b batchItemError) Error() string {
+	return fmt.Sprintf("[%d] %s", b.index, SprintError(b.Code(), b.Message(), "", nil))
+}
+
+// String returns the string representation of the error.
+// Alias for Error to satisfy the stringer interface.
+func (b batchItemError) String() string {
+	return b.Error()
+}
+
+// Index returns the index of the item in the batch request.
+func (b batchItemError) Index() int {
+	return b.index
+}
@@ -63,6 +63,9 @@ func rcopy(dst, src reflect.Value, root bool) {
 
 		t := dst.Type()
 		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue // unexported fields, e.g. shape metadata, cannot be set
+			}
 			name := t.Field(i).Name
 			srcval := src.FieldByName(name)
 			if srcval.IsValid() {
@@ -102,6 +102,22 @@ func TestCopyIgnoreNilMembers(t *testing.T) {
 	assert.Nil(t, f3.C)
 }
 
+func TestCopyUnexportedMembers(t *testing.T) {
+	type metadata struct {
+		SDKShapeTraits bool
+	}
+	type Foo struct {
+		A *string
+		metadata
+	}
+
+	str := "hello"
+	f := &Foo{A: &str}
+	f2 := awsutil.CopyOf(f).(*Foo)
+	assert.Equal(t, "hello", *f2.A)
+	assert.False(t, f2.SDKShapeTraits)
+}
+
 func TestCopyPrimitive(t *testing.T) {
 	str := "hello"
 	var s string
@@ -33,6 +33,9 @@ func TestValueAtPathSuccess(t *testing.T) {
 	assert.Equal(t, []interface{}{"terminal"}, awsutil.ValuesAtPath(data, "B . B . C"))
 	assert.Equal(t, []interface{}{"terminal", "terminal2"}, awsutil.ValuesAtPath(data, "B.*.C"))
 	assert.Equal(t, []interface{}{"initial"}, awsutil.ValuesAtPath(data, "A.D.X || C"))
+
+	m := struct{ M map[string]*Struct }{M: map[string]*Struct{"k": {C: "mapped"}}}
+	assert.Equal(t, []interface{}{"mapped"}, awsutil.ValuesAtPath(m, "M.*.C"))
 }
 
 func TestValueAtPathFailure(t *testing.T) {
@@ -52,7 +52,11 @@ func stringValue(v reflect.Value, indent int, buf *bytes.Buffer) {
 			val := v.FieldByName(n)
 			buf.WriteString(strings.Repeat(" ", indent+2))
 			buf.WriteString(n + ": ")
-			stringValue(val, indent+2, buf)
+			if field, _ := v.Type().FieldByName(n); field.Tag.Get("sensitive") == "true" {
+				buf.WriteString("<sensitive>")
+			} else {
+				stringValue(val, indent+2, buf)
+			}
 
 			if i < len(names)-1 {
 				buf.WriteString(",\n")
@@ -0,0 +1,19 @@
+package awsutil_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringValueRedactsSensitiveFields(t *testing.T) {
+	type Input struct {
+		KeyID     *string `type:"string"`
+		Plaintext []byte  `type:"blob" sensitive:"true"`
+	}
+
+	s := awsutil.StringValue(&Input{KeyID: aws.String("key"), Plaintext: []byte("secret")})
+	assert.Equal(t, "{\n  KeyID: \"key\",\n  Plaintext: <sensitive>\n}", s)
+}
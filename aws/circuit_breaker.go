@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeCircuitBreakerOpen is the awserr.Error code for requests which were
+// not sent because the circuit of their endpoint is open.
+const ErrCodeCircuitBreakerOpen = "CircuitBreakerOpen"
+
+// The FailureThreshold and CoolDown of circuit breakers which do not set
+// them.
+const (
+	DefaultCircuitBreakerFailureThreshold = 5
+	DefaultCircuitBreakerCoolDown         = 30 * time.Second
+)
+
+// A CircuitBreaker fails the requests to an endpoint fast, without sending
+// them, once the requests to the endpoint failed a number of times in a row,
+// so callers do not wait for the retries of requests to an endpoint which is
+// down or hung. Attempts which fail with network errors, time out, or receive
+// a 5XX response count as failures, other responses, e.g. throttling errors,
+// show the endpoint is up.
+//
+// The circuit of an endpoint opens after FailureThreshold consecutive
+// failures. Requests to the endpoint fail with ErrCodeCircuitBreakerOpen until
+// the CoolDown passed, after which a single request is sent as a trial. The
+// circuit closes if the trial succeeds, or opens for another CoolDown if it
+// fails.
+//
+// A CircuitBreaker is safe for concurrent use, and can be shared by service
+// clients, which share the circuits of their endpoints.
+//
+// Example:
+//     svc := dynamodb.New(&aws.Config{
+//         CircuitBreaker: aws.NewCircuitBreaker(5, 10*time.Second),
+//     })
+type CircuitBreaker struct {
+	// The number of consecutive failed attempts which open the circuit of
+	// an endpoint. Defaults to DefaultCircuitBreakerFailureThreshold.
+	FailureThreshold int
+
+	// How long the circuit of an endpoint stays open before a trial request
+	// is sent. Defaults to DefaultCircuitBreakerCoolDown.
+	CoolDown time.Duration
+
+	m        sync.Mutex
+	circuits map[string]*circuit
+
+	now func() time.Time
+}
+
+// A circuit is the state of the requests to an endpoint.
+type circuit struct {
+	failures int       // consecutive failed attempts
+	opened   time.Time // when the circuit opened, zero if it is closed
+	trial    bool      // if a trial request is being sent
+}
+
+// NewCircuitBreaker returns a CircuitBreaker opening the circuit of an
+// endpoint after threshold consecutive failures, for the coolDown.
+func NewCircuitBreaker(threshold int, coolDown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{FailureThreshold: threshold, CoolDown: coolDown}
+}
+
+// allow returns true if an attempt can be sent to the endpoint, or false if
+// the endpoint's circuit is open.
+func (b *CircuitBreaker) allow(endpoint string) bool {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	c := b.circuits[endpoint]
+	if c == nil || c.opened.IsZero() {
+		return true
+	}
+	if c.trial || b.timeNow().Sub(c.opened) < b.coolDown() {
+		return false
+	}
+	c.trial = true
+	return true
+}
+
+// record records the result of an attempt sent to the endpoint.
+func (b *CircuitBreaker) record(endpoint string, failed bool) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	c := b.circuits[endpoint]
+	if !failed {
+		if c != nil {
+			delete(b.circuits, endpoint)
+		}
+		return
+	}
+
+	if c == nil {
+		if b.circuits == nil {
+			b.circuits = map[string]*circuit{}
+		}
+		c = &circuit{}
+		b.circuits[endpoint] = c
+	}
+	c.failures++
+	if c.trial || c.failures >= b.failureThreshold() {
+		c.opened = b.timeNow()
+	}
+	c.trial = false
+}
+
+// release releases the endpoint's trial, if any, without a result, e.g. for
+// a canceled attempt.
+func (b *CircuitBreaker) release(endpoint string) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if c := b.circuits[endpoint]; c != nil {
+		c.trial = false
+	}
+}
+
+func (b *CircuitBreaker) failureThreshold() int {
+	if b.FailureThreshold > 0 {
+		return b.FailureThreshold
+	}
+	return DefaultCircuitBreakerFailureThreshold
+}
+
+func (b *CircuitBreaker) coolDown() time.Duration {
+	if b.CoolDown > 0 {
+		return b.CoolDown
+	}
+	return DefaultCircuitBreakerCoolDown
+}
+
+func (b *CircuitBreaker) timeNow() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+// allowCircuit returns true if the request's attempt can be sent, or false
+// and sets the request's error if the circuit of its endpoint is open, when
+// the Config has a CircuitBreaker. It is called by the SendHandler, so only
+// attempts which are sent take a trial and have their result recorded, not
+// requests which are presigned or fail to be signed.
+func allowCircuit(r *Request) bool {
+	b := r.Config.CircuitBreaker
+	if b == nil {
+		return true
+	}
+
+	endpoint := r.HTTPRequest.URL.Host
+	if !b.allow(endpoint) {
+		r.Error = awserr.New(ErrCodeCircuitBreakerOpen, "circuit breaker open for endpoint "+endpoint, nil)
+		r.Retryable.Set(false)
+		return false
+	}
+	r.circuitEndpoint = endpoint
+	return true
+}
+
+// CircuitBreakerResultHandler is a request handler recording the result of
+// the request's last attempt with the Config's CircuitBreaker. It runs with
+// the Retry handlers for failed attempts, and the Complete handlers for the
+// request's last attempt.
+func CircuitBreakerResultHandler(r *Request) {
+	b := r.Config.CircuitBreaker
+	if b == nil || r.circuitEndpoint == "" {
+		return
+	}
+	endpoint := r.circuitEndpoint
+	r.circuitEndpoint = ""
+
+	if err, ok := r.Error.(awserr.Error); ok && err.Code() == ErrCodeRequestCanceled {
+		b.release(endpoint)
+		return
+	}
+	b.record(endpoint, isEndpointFailure(r))
+}
+
+// isEndpointFailure returns true if the request's last attempt failed
+// because of its endpoint, which is down or failing, rather than because of
+// the request.
+func isEndpointFailure(r *Request) bool {
+	if r.Error == nil {
+		return false
+	}
+	if r.HTTPResponse == nil || r.HTTPResponse.StatusCode == 0 {
+		return true
+	}
+	return r.HTTPResponse.StatusCode >= 500
+}
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+// statusTransport responds to requests with the status code, counting the
+// requests sent.
+type statusTransport struct {
+	status *int
+	sent   *int
+}
+
+func (t statusTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	*t.sent++
+	return &http.Response{StatusCode: *t.status, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}, nil
+}
+
+// newCircuitBreakerService returns a service whose requests are sent through
+// the breaker, receiving responses with the status code, and recording the
+// number of attempts sent.
+func newCircuitBreakerService(b *CircuitBreaker, status *int, sent *int) *Service {
+	s := NewService(&Config{
+		Region:         "mock-region",
+		MaxRetries:     0,
+		CircuitBreaker: b,
+		HTTPClient:     &http.Client{Transport: statusTransport{status: status, sent: sent}},
+	})
+	s.ServiceName = "mock-service"
+	s.buildEndpoint()
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	return s
+}
+
+func TestCircuitBreakerOpens(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewCircuitBreaker(3, time.Minute)
+	b.now = func() time.Time { return now }
+	status, sent := 503, 0
+	s := newCircuitBreakerService(b, &status, &sent)
+
+	for i := 0; i < 3; i++ {
+		err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+		assert.Equal(t, "UnknownError", err.(awserr.Error).Code())
+	}
+	assert.Equal(t, 3, sent)
+
+	// Requests fail fast while the circuit is open.
+	err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+	assert.Equal(t, ErrCodeCircuitBreakerOpen, err.(awserr.Error).Code())
+	assert.Equal(t, 3, sent)
+
+	// A failed trial opens the circuit for another cool-down.
+	now = now.Add(time.Minute)
+	assert.Error(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	assert.Equal(t, 4, sent)
+	err = NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+	assert.Equal(t, ErrCodeCircuitBreakerOpen, err.(awserr.Error).Code())
+
+	// A successful trial closes the circuit.
+	now = now.Add(time.Minute)
+	status = 200
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	}
+	assert.Equal(t, 6, sent)
+}
+
+func TestCircuitBreakerSingleTrial(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewCircuitBreaker(1, time.Minute)
+	b.now = func() time.Time { return now }
+
+	b.record("example.com", true)
+	assert.False(t, b.allow("example.com"))
+	assert.True(t, b.allow("other.example.com"))
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow("example.com"))
+	assert.False(t, b.allow("example.com"), "expect a single trial while the circuit is open")
+
+	b.release("example.com")
+	assert.True(t, b.allow("example.com"))
+}
+
+func TestCircuitBreakerClientErrors(t *testing.T) {
+	b := NewCircuitBreaker(1, time.Minute)
+	status, sent := 400, 0
+	s := newCircuitBreakerService(b, &status, &sent)
+
+	// Client errors and throttling show the endpoint is up.
+	for _, status = range []int{400, 429, 400} {
+		assert.Error(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	}
+	assert.Equal(t, 3, sent)
+	assert.Empty(t, b.circuits)
+}
+
+func TestCircuitBreakerRetries(t *testing.T) {
+	b := NewCircuitBreaker(2, time.Minute)
+	status, sent := 500, 0
+	s := newCircuitBreakerService(b, &status, &sent)
+	s.Config.MaxRetries = 5
+	s.Config.RetryBaseDelay = time.Millisecond
+
+	// Each attempt counts, so a request stops retrying once the circuit opens.
+	err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+	assert.Equal(t, ErrCodeCircuitBreakerOpen, err.(awserr.Error).Code())
+	assert.Equal(t, 2, sent)
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	status, sent := 503, 0
+	s := newCircuitBreakerService(nil, &status, &sent)
+	for i := 0; i < 10; i++ {
+		assert.Error(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	}
+	assert.Equal(t, 10, sent)
+}
+
+func TestCircuitBreakerPresign(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewCircuitBreaker(1, time.Minute)
+	b.now = func() time.Time { return now }
+	status, sent := 503, 0
+	s := newCircuitBreakerService(b, &status, &sent)
+
+	assert.Error(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	now = now.Add(time.Minute)
+
+	// Presigned requests are not sent, so they do not take the trial.
+	_, err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Presign(time.Minute)
+	assert.NoError(t, err)
+
+	status = 200
+	assert.NoError(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+	assert.Equal(t, 2, sent)
+	assert.Empty(t, b.circuits, "expect the trial to close the circuit")
+}
+
+func TestCircuitBreakerSigningFailure(t *testing.T) {
+	b := NewCircuitBreaker(1, time.Minute)
+	status, sent := 200, 0
+	s := newCircuitBreakerService(b, &status, &sent)
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		r.Error = awserr.New("NoCredentialProviders", "no valid providers in chain", nil)
+	})
+
+	// Requests which are not sent do not count as failures of the endpoint.
+	for i := 0; i < 3; i++ {
+		err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+		assert.Equal(t, "NoCredentialProviders", err.(awserr.Error).Code())
+	}
+	assert.Equal(t, 0, sent)
+	assert.Empty(t, b.circuits)
+}
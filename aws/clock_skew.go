@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// skewTolerance is the minimum difference between the local clock and the
+// service's clock that is treated as clock skew.
+const skewTolerance = 4 * time.Minute
+
+// clockSkewCodes is a collection of service response codes which signify
+// the request was rejected because its signing time is too far from the
+// service's time.
+var clockSkewCodes = map[string]struct{}{
+	"RequestTimeTooSkewed": {},
+	"RequestExpired":       {},
+	"RequestInTheFuture":   {},
+}
+
+// possibleClockSkewCodes is a collection of service response codes which
+// may be caused by clock skew. These are only treated as clock skew if the
+// service's Date header shows the local clock is skewed.
+var possibleClockSkewCodes = map[string]struct{}{
+	"AuthFailure":               {},
+	"InvalidSignatureException": {},
+	"SignatureDoesNotMatch":     {},
+}
+
+// clockSkews holds the clock offset detected for each endpoint host. The
+// offset is added to the local time when signing requests to the endpoint.
+var clockSkews = struct {
+	sync.RWMutex
+	offsets map[string]time.Duration
+}{offsets: map[string]time.Duration{}}
+
+// endpointClockSkew returns the clock offset detected for the endpoint.
+func endpointClockSkew(endpoint string) time.Duration {
+	key := clockSkewKey(endpoint)
+	clockSkews.RLock()
+	defer clockSkews.RUnlock()
+	return clockSkews.offsets[key]
+}
+
+// setEndpointClockSkew stores the clock offset for the endpoint.
+func setEndpointClockSkew(endpoint string, offset time.Duration) {
+	key := clockSkewKey(endpoint)
+	clockSkews.Lock()
+	defer clockSkews.Unlock()
+	clockSkews.offsets[key] = offset
+}
+
+func clockSkewKey(endpoint string) string {
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return endpoint
+}
+
+// signingTime returns the current time corrected by the clock offset detected
+// for the service's endpoint.
+func (s *Service) signingTime() time.Time {
+	return time.Now().Add(endpointClockSkew(s.Endpoint))
+}
+
+// correctClockSkew returns true if the request failed because the local clock
+// is skewed from the service's clock. The offset from the service's Date
+// header is stored for the endpoint, and the request's signing time updated
+// and its signature removed so that it will be signed again before it is
+// retried.
+func correctClockSkew(r *Request) bool {
+	if r.Error == nil || r.HTTPResponse == nil {
+		return false
+	}
+	aerr, ok := r.Error.(awserr.Error)
+	if !ok {
+		return false
+	}
+	_, skewed := clockSkewCodes[aerr.Code()]
+	_, possible := possibleClockSkewCodes[aerr.Code()]
+	if !skewed && !possible {
+		return false
+	}
+
+	serverTime, err := http.ParseTime(r.HTTPResponse.Header.Get("Date"))
+	if err != nil {
+		return false
+	}
+	offset := serverTime.Sub(time.Now())
+	if offset > -skewTolerance && offset < skewTolerance {
+		// A Date header close to the local time means the error was not
+		// caused by clock skew, so don't adjust the stored offset.
+		return false
+	}
+
+	setEndpointClockSkew(r.Service.Endpoint, offset)
+	r.Time = r.Service.signingTime()
+	r.HTTPRequest.Header.Del("Authorization")
+	return true
+}
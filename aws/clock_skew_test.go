@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func resetClockSkews() {
+	clockSkews.Lock()
+	clockSkews.offsets = map[string]time.Duration{}
+	clockSkews.Unlock()
+}
+
+func TestRequestCorrectsClockSkew(t *testing.T) {
+	defer resetClockSkews()
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	serverTime := time.Now().Add(time.Hour)
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 403, Header: http.Header{"Date": []string{serverTime.UTC().Format(http.TimeFormat)}},
+			Body: body(`{"__type":"RequestTimeTooSkewed","message":"The difference between the request time and the current time is too large."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: 10, Endpoint: "https://skew.example.com"})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	signTimes := []time.Time{}
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		if r.HTTPRequest.Header.Get("Authorization") == "" {
+			signTimes = append(signTimes, r.Time)
+			r.HTTPRequest.Header.Set("Authorization", "signed")
+		}
+	})
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, int(r.RetryCount))
+	assert.Equal(t, "valid", out.Data)
+
+	// The request is signed again with the corrected time.
+	assert.Equal(t, 2, len(signTimes))
+	assert.True(t, signTimes[1].Sub(serverTime) < time.Minute && serverTime.Sub(signTimes[1]) < time.Minute,
+		"expect signing time %v near %v", signTimes[1], serverTime)
+
+	// Future requests to the endpoint use the detected offset.
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.True(t, r.Time.Sub(serverTime) < time.Minute && serverTime.Sub(r.Time) < time.Minute)
+
+	other := NewService(&Config{Endpoint: "https://other.example.com"})
+	r = NewRequest(other, &Operation{Name: "Operation"}, nil, nil)
+	assert.True(t, time.Now().Sub(r.Time) < time.Minute)
+}
+
+func TestCorrectClockSkewIgnoresUnskewedAuthFailure(t *testing.T) {
+	defer resetClockSkews()
+
+	s := NewService(&Config{Endpoint: "https://skew.example.com"})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{StatusCode: 401, Header: http.Header{
+		"Date": []string{time.Now().UTC().Format(http.TimeFormat)},
+	}}
+	r.Error = awserr.New("AuthFailure", "AWS was not able to validate the provided access credentials", nil)
+	r.HTTPRequest.Header.Set("Authorization", "signed")
+
+	assert.False(t, correctClockSkew(r))
+	assert.Equal(t, "signed", r.HTTPRequest.Header.Get("Authorization"))
+	assert.Equal(t, time.Duration(0), endpointClockSkew(s.Endpoint))
+
+	r.HTTPResponse.Header.Set("Date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, correctClockSkew(r))
+	assert.Empty(t, r.HTTPRequest.Header.Get("Authorization"))
+	assert.True(t, endpointClockSkew(s.Endpoint) < -59*time.Minute)
+}
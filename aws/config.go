@@ -1,12 +1,16 @@
 package aws
 
 import (
-	"io"
+	"net"
 	"net/http"
+	"net/http/httptrace"
+	"net/url"
 	"os"
+	"reflect"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
 )
 
 // DefaultChainCredentials is a Credentials which will find the first available
@@ -14,10 +18,15 @@ import (
 //
 // This should be used in the default case. Once the type of credentials are
 // known switching to the specific Credentials will be more efficient.
+//
+// Credentials which assume a role, e.g. with the web identity token file of
+// AWS_WEB_IDENTITY_TOKEN_FILE, are resolved by the session package instead,
+// since they require an STS client.
 var DefaultChainCredentials = credentials.NewChainCredentials(
 	[]credentials.Provider{
 		&credentials.EnvProvider{},
 		&credentials.SharedCredentialsProvider{Filename: "", Profile: ""},
+		&credentials.ContainerProvider{ExpiryWindow: 5 * time.Minute},
 		&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute},
 	})
 
@@ -25,6 +34,24 @@ var DefaultChainCredentials = credentials.NewChainCredentials(
 // the service specific retry default will be used.
 const DefaultRetries = -1
 
+// DefaultRetryMaxDelay is the maximum delay before a request is retried when
+// the Config's RetryMaxDelay is not set.
+const DefaultRetryMaxDelay = 20 * time.Second
+
+const (
+	// STSRegionalEndpointLegacy sends STS requests in the standard AWS
+	// partition to the global endpoint, `sts.amazonaws.com`.
+	STSRegionalEndpointLegacy = "legacy"
+
+	// STSRegionalEndpointRegional sends STS requests to the endpoint of the
+	// Config's Region, e.g. `sts.us-west-2.amazonaws.com`.
+	STSRegionalEndpointRegional = "regional"
+)
+
+// defaultHTTPClient is the HTTP client of the DefaultConfig, and of service
+// clients created without an HTTP client.
+var defaultHTTPClient = defaults.HTTPClient()
+
 // DefaultConfig is the default all service configuration will be based off of.
 // By default, all clients use this structure for initialization options unless
 // a custom configuration object is passed in.
@@ -32,19 +59,55 @@ const DefaultRetries = -1
 // You may modify this global structure to change all default configuration
 // in the SDK. Note that configuration options are copied by value, so any
 // modifications must happen before constructing a client.
+//
+// The following environment variables are read when the DefaultConfig is
+// created:
+//     AWS_REGION, AWS_DEFAULT_REGION - the Region, AWS_REGION takes precedence
+//     AWS_MAX_ATTEMPTS               - the MaxRetries, plus the initial attempt
+//     AWS_RETRY_MODE                 - the RetryMode, "standard" or "adaptive"
+//     AWS_STS_REGIONAL_ENDPOINTS     - the STSRegionalEndpoint, "legacy" or "regional"
+//     AWS_ENABLE_ENDPOINT_DISCOVERY  - the EnableEndpointDiscovery, "true" or "false"
+//     AWS_SDK_UA_APP_ID              - the AppID
+//     AWS_CA_BUNDLE                  - the file the CABundle is loaded from
+//     AWS_S3_USE_ARN_REGION          - the S3UseARNRegion, "true" or "false"
+//     AWS_CSM_ENABLED                - the CSMEnabled, "true" or "false"
+//     AWS_CSM_HOST, AWS_CSM_PORT     - the CSMHost and CSMPort
+//     AWS_CSM_CLIENT_ID              - the CSMClientID
+//
+// The DefaultChainCredentials also read AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN, AWS_PROFILE,
+// AWS_SHARED_CREDENTIALS_FILE, AWS_CONTAINER_CREDENTIALS_RELATIVE_URI,
+// AWS_CONTAINER_CREDENTIALS_FULL_URI, AWS_CONTAINER_AUTHORIZATION_TOKEN,
+// AWS_EC2_METADATA_SERVICE_ENDPOINT, and AWS_EC2_METADATA_DISABLED.
 var DefaultConfig = &Config{
 	Credentials:             DefaultChainCredentials,
 	Endpoint:                "",
-	Region:                  os.Getenv("AWS_REGION"),
+	Region:                  envRegion(),
 	DisableSSL:              false,
-	HTTPClient:              http.DefaultClient,
+	HTTPClient:              defaultHTTPClient,
+	CABundle:                envCABundle(),
 	LogHTTPBody:             false,
-	LogLevel:                0,
-	Logger:                  os.Stdout,
-	MaxRetries:              DefaultRetries,
+	LogLevel:                LogOff,
+	Logger:                  NewDefaultLogger(),
+	MaxRetries:              envMaxRetries(),
+	RetryMode:               envRetryMode(),
+	STSRegionalEndpoint:     envSTSRegionalEndpoint(),
+	EnableEndpointDiscovery: envEnableEndpointDiscovery(),
 	DisableParamValidation:  false,
 	DisableComputeChecksums: false,
 	S3ForcePathStyle:        false,
+	S3UseARNRegion:          envS3UseARNRegion(),
+	AppID:                   os.Getenv("AWS_SDK_UA_APP_ID"),
+	CSMEnabled:              envCSMEnabled(),
+	CSMHost:                 os.Getenv("AWS_CSM_HOST"),
+	CSMPort:                 envCSMPort(),
+	CSMClientID:             os.Getenv("AWS_CSM_CLIENT_ID"),
+}
+
+// A Dialer opens the network connections requests are sent over. A
+// net.Dialer is a Dialer.
+type Dialer interface {
+	DialContext(ctx Context, network, address string) (net.Conn, error)
 }
 
 // A Config provides service configuration for service clients. By default,
@@ -58,10 +121,23 @@ type Config struct {
 	// that overrides the default generated endpoint for a client. Set this
 	// to `""` to use the default generated endpoint.
 	//
+	// If neither Endpoint nor EndpointResolver provide the endpoint, the
+	// `AWS_ENDPOINT_URL_<SERVICE>` and `AWS_ENDPOINT_URL` environment
+	// variables are used. <SERVICE> is the client's service ID upper cased
+	// with spaces replaced by underscores, e.g. `AWS_ENDPOINT_URL_DYNAMODB`
+	// for DynamoDB, or `AWS_ENDPOINT_URL_CLOUDWATCH_LOGS` for CloudWatch Logs.
+	// Setting `AWS_IGNORE_CONFIGURED_ENDPOINT_URLS=true` ignores them.
+	//
 	// @note You must still provide a `Region` value when specifying an
 	//   endpoint for a client.
 	Endpoint string
 
+	// An optional resolver used to resolve the endpoint of each service
+	// client created with the Config. It is not used if Endpoint is set. If
+	// the resolver returns an empty endpoint the default endpoint of the
+	// service is used.
+	EndpointResolver EndpointResolver
+
 	// The region to send requests to. This parameter is required and must
 	// be configured globally or on a per-client basis unless otherwise
 	// noted. A full list of regions is found in the "Regions and Endpoints"
@@ -71,35 +147,207 @@ type Config struct {
 	//   AWS Regions and Endpoints
 	Region string
 
+	// The name of the service requests are signed for, overriding the name
+	// deduced from the service and its endpoint, e.g. "execute-api" for a
+	// service fronted by API Gateway. Defaults to "".
+	SigningName string
+
+	// The region requests are signed for, overriding the region deduced from
+	// the Region and the endpoint, e.g. for a custom Endpoint proxying a
+	// service in another region, such as a VPC endpoint. Defaults to "".
+	SigningRegion string
+
 	// Set this to `true` to disable SSL when sending requests. Defaults
 	// to `false`.
 	DisableSSL bool
 
-	// The HTTP client to use when sending requests. Defaults to
-	// `http.DefaultClient`.
+	// Set this to `true` to use the dual-stack (IPv4 and IPv6) endpoint of
+	// services which support dual-stack endpoints, e.g.
+	// `s3.dualstack.us-east-1.amazonaws.com`. Services without dual-stack
+	// endpoints will use their default endpoint. Has no effect if Endpoint
+	// is set. Defaults to `false`.
+	UseDualStack bool
+
+	// Set this to `true` to use the FIPS 140-2 validated endpoint of services
+	// which have FIPS endpoints, e.g. `sts-fips.us-east-1.amazonaws.com`.
+	// Services without FIPS endpoints will use their dual-stack or default
+	// endpoint. FIPS endpoints take precedence over dual-stack endpoints. Has
+	// no effect if Endpoint is set. Defaults to `false`.
+	UseFIPSEndpoint bool
+
+	// The endpoint STS clients send requests to in the standard AWS
+	// partition, either STSRegionalEndpointLegacy for the global endpoint or
+	// STSRegionalEndpointRegional for the endpoint of the Region. Clients
+	// without a Region use the global endpoint. Has no effect if Endpoint is
+	// set. Defaults to "", which is the same as STSRegionalEndpointLegacy.
+	STSRegionalEndpoint string
+
+	// Set this to `true` to send the requests of operations which support
+	// endpoint discovery, e.g. DynamoDB's, to the endpoints discovered with
+	// the service's endpoint operation, e.g. DescribeEndpoints. Discovered
+	// endpoints are cached for each identity and Region until they expire.
+	// Operations which require endpoint discovery always discover their
+	// endpoint. Has no effect if Endpoint is set. Defaults to `false`.
+	EnableEndpointDiscovery bool
+
+	// Set this to `true` to disable the host prefixes of operations whose
+	// requests are sent to a prefixed host of the service's endpoint, e.g.
+	// "data.", for example to send requests to a custom Endpoint which does
+	// not support the prefixed hosts. Defaults to `false`.
+	DisableEndpointHostPrefix bool
+
+	// The HTTP client to use when sending requests. Defaults to a client
+	// created with defaults.HTTPClient, which unlike `http.DefaultClient`
+	// times out connecting to and waiting for unresponsive hosts. The client
+	// created for a CABundle, Dialer, Proxy, or MaxIdleConnsPerHost is shared
+	// by the services of Configs with the same CABundle, Dialer, and
+	// MaxIdleConnsPerHost.
 	HTTPClient *http.Client
 
+	// A PEM encoded bundle of the CA certificates servers are verified with,
+	// instead of the system's certificate pool, e.g. for TLS intercepting
+	// proxies. Used to create a client with the default HTTP transport, has
+	// no effect if HTTPClient is set to another client. Requests fail with
+	// an ErrCodeInvalidCABundle error if the bundle has no valid certificates.
+	CABundle []byte
+
+	// The dialer connections are opened with, e.g. to connect through a
+	// SOCKS5 bastion or from another network namespace, or a
+	// dnscache.Resolver caching the addresses of endpoints. Used to create a
+	// client with the default HTTP transport, has no effect if HTTPClient is
+	// set to another client. Defaults to a net.Dialer with the default
+	// timeouts.
+	Dialer Dialer
+
+	// Proxy returns the proxy a request is sent through, or nil to send the
+	// request directly. "socks5://" proxy URLs are also supported. Used to
+	// create a client with the default HTTP transport, has no effect if
+	// HTTPClient is set to another client. Defaults to
+	// http.ProxyFromEnvironment.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// The maximum number of idle connections kept open to each host, for
+	// clients sending many concurrent requests. Used to create a client with
+	// the default HTTP transport, has no effect if HTTPClient is set to
+	// another client. Defaults to defaults.MaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int
+
+	// The timeouts of API calls by operation name, e.g.
+	// map[string]time.Duration{"GetItem": time.Second}, so a client can be
+	// used for both latency sensitive and long running calls. The timeout of
+	// a call includes its retries and the delays before them, and reading a
+	// response body streamed to the caller. Calls which time out fail with
+	// ErrCodeRequestCanceled. Defaults to nil, calls of operations without a
+	// timeout are limited by their context only.
+	OperationTimeouts map[string]time.Duration
+
+	// The maximum duration of each attempt of a request, including reading
+	// the response. An attempt which does not complete in time is abandoned
+	// and retried, unlike the HTTPClient's Timeout which spans the whole
+	// request. Streamed response bodies, e.g. of S3 GetObject, must be read
+	// and closed within the timeout of their attempt. Defaults to zero, which
+	// does not limit attempts.
+	AttemptTimeout time.Duration
+
+	// An optional httptrace.ClientTrace whose hooks are called as each
+	// attempt of a request is sent, e.g. to measure the DNS lookup, connect
+	// and TLS handshake times of requests, and the time to their first
+	// response byte. The hooks are called in addition to those of a trace of
+	// the request's Context. Set it for a single request with WithConfig.
+	ClientTrace *httptrace.ClientTrace
+
 	// Set this to `true` to also log the body of the HTTP requests made by the
-	// client.
+	// client. Equivalent to setting the LogDebugWithHTTPBody LogLevel flag.
 	//
 	// @note `LogLevel` must be set to a non-zero value in order to activate
 	//   body logging.
 	LogHTTPBody bool
 
-	// An integer value representing the logging level. The default log level
-	// is zero (0), which represents no logging. Set to a non-zero value to
-	// perform logging.
-	LogLevel uint
+	// The logging level, as a bitmask of LogLevelType flags. The default log
+	// level is LogOff, which represents no logging. Set to LogDebug, or a
+	// combination of the LogDebugWith... flags to perform logging.
+	LogLevel LogLevelType
 
-	// The logger writer interface to write logging messages to. Defaults to
-	// standard out.
-	Logger io.Writer
+	// The logger to write logging messages to. Defaults to standard out.
+	//
+	// Use NewWriterLogger to log to an io.Writer, or LoggerFunc to route
+	// messages to another logging package.
+	Logger Logger
+
+	// An optional collector of the metrics of each attempt of the requests
+	// sent, e.g. their latency, status code, and error code. Use
+	// MetricsCollectorFunc to collect the metrics with a function.
+	MetricsCollector MetricsCollector
+
+	// An optional tracer of the API calls of the requests sent, and of each
+	// of their attempts, as spans. See Tracer.
+	Tracer Tracer
+
+	// Set this to `true` to report each API call and attempt of the requests
+	// sent to the Client Side Monitoring (CSM) agent at CSMHost and CSMPort,
+	// as UDP datagrams. Datagrams are dropped if the agent is not listening.
+	// Defaults to `false`.
+	CSMEnabled bool
+
+	// The host and port of the CSM agent. Default to DefaultCSMHost and
+	// DefaultCSMPort if empty.
+	CSMHost string
+	CSMPort int
+
+	// An optional ID of the application included in CSM datagrams.
+	CSMClientID string
 
 	// The maximum number of times that a request will be retried for failures.
 	// Defaults to -1, which defers the max retry setting to the service specific
 	// configuration.
 	MaxRetries int
 
+	// The base delay used to compute the delay before a request is retried.
+	// The delay grows exponentially from the base with each retry, and a
+	// random delay between zero and the computed delay is used (full jitter).
+	// Defaults to zero, which defers to the service specific base delay.
+	RetryBaseDelay time.Duration
+
+	// The maximum delay before a request is retried. The exponentially
+	// growing retry delay is capped at this value before jitter is applied.
+	// Defaults to zero, which uses DefaultRetryMaxDelay.
+	RetryMaxDelay time.Duration
+
+	// The retry mode service clients will use, either RetryModeStandard or
+	// RetryModeAdaptive. In adaptive mode the client also limits the rate it
+	// sends requests at while the service is throttling its requests.
+	// Defaults to "", which is the same as RetryModeStandard.
+	RetryMode string
+
+	// The maximum rate, in requests per second, the client sends requests
+	// at, e.g. to keep a batch job under the API rate limits of an account.
+	// Each attempt of a request counts, including retries. Requests wait
+	// until they can be sent without exceeding the rate, or their context is
+	// canceled. Defaults to zero, which does not limit the rate.
+	MaxSendRate float64
+
+	// The number of requests which can be sent at once, in a burst, without
+	// waiting for the MaxSendRate. Defaults to zero, which sends requests one
+	// at a time at the MaxSendRate.
+	MaxSendBurst int
+
+	// Set this to `true` to disable retrying requests which failed due to
+	// transient network errors, e.g. connection resets, unexpected EOFs, or
+	// temporary DNS failures. Defaults to `false`.
+	DisableNetworkErrorRetries bool
+
+	// Set this to `true` to disable the retry quota of service clients. By
+	// default each client has a quota of retries, which are taken from the
+	// quota and returned to it by requests which succeed, so clients stop
+	// retrying their requests while most of them fail, e.g. during an outage,
+	// instead of adding to the load of the service. Defaults to `false`.
+	DisableRetryQuota bool
+
+	// The circuit breaker failing requests fast, without sending them, to
+	// endpoints which failed a number of requests in a row. Defaults to nil,
+	// which sends all requests.
+	CircuitBreaker *CircuitBreaker
+
 	// Disables semantic parameter validation, which validates input for missing
 	// required fields and/or other semantic request input errors.
 	DisableParamValidation bool
@@ -108,6 +356,23 @@ type Config struct {
 	// CRC32 checksums in Amazon DynamoDB.
 	DisableComputeChecksums bool
 
+	// Set this to `true` to disable the cleaning of the URI path of REST
+	// protocol requests, e.g. to send an S3 object key containing "//" or
+	// "./" byte-for-byte instead of resolving it to a different key.
+	// Defaults to `false`.
+	DisableRestProtocolURICleaning bool
+
+	// Set this to `true` to gzip the bodies of requests to operations which
+	// accept compressed requests, e.g. Amazon CloudWatch PutMetricData and
+	// Amazon CloudWatch Logs PutLogEvents, with the `gzip` Content-Encoding.
+	// Bodies smaller than RequestMinCompressSize are sent uncompressed.
+	// Defaults to `false`.
+	CompressRequestBody bool
+
+	// The minimum size in bytes of the request bodies gzipped when
+	// CompressRequestBody is set. Defaults to 10240 bytes if zero.
+	RequestMinCompressSize int64
+
 	// Set this to `true` to force the request to use path-style addressing,
 	// i.e., `http://s3.amazonaws.com/BUCKET/KEY`. By default, the S3 client will
 	// use virtual hosted bucket addressing when possible
@@ -117,6 +382,376 @@ type Config struct {
 	// @see http://docs.aws.amazon.com/AmazonS3/latest/dev/VirtualHosting.html
 	//   Amazon S3: Virtual Hosting of Buckets
 	S3ForcePathStyle bool
+
+	// Set this to `true` to send requests for an S3 access point ARN to the
+	// region of the ARN, instead of failing the request if the ARN's region
+	// differs from the client's Region. Defaults to `false`.
+	//
+	// @note This configuration option is specific to the Amazon S3 service.
+	S3UseARNRegion bool
+
+	// Set this to `true` to sign the bodies of S3 PutObject and UploadPart
+	// requests chunk by chunk as they are sent, with the
+	// `STREAMING-AWS4-HMAC-SHA256-PAYLOAD` aws-chunked content encoding,
+	// instead of reading the whole body to compute its hash before the
+	// request is sent. Defaults to `false`.
+	//
+	// @note This configuration option is specific to the Amazon S3 service.
+	S3UseStreamingSignature bool
+
+	// Set this to `true` to sign S3 requests sent over HTTPS with the
+	// `UNSIGNED-PAYLOAD` payload hash, instead of reading the whole body to
+	// compute its hash before the request is sent. The body is still protected
+	// by TLS. Requests sent over HTTP are signed with the hash of their body.
+	// Takes precedence over S3UseStreamingSignature. Defaults to `false`.
+	//
+	// @note This configuration option is specific to the Amazon S3 service.
+	S3UseUnsignedPayload bool
+
+	// An optional ID of the application sending requests, which is appended
+	// to the User-Agent header of requests as `app/<AppID>`, e.g. to identify
+	// the application in CloudTrail and server access logs.
+	AppID string
+
+	// The names of the fields Merge resets to their DefaultConfig values.
+	resets []string
+}
+
+// NewConfig returns a new Config pointer that can be chained with builder
+// methods to set multiple configuration values inline without using pointers.
+//
+// Unlike a Config literal, MaxRetries of the returned Config is DefaultRetries,
+// so merging the Config will not change the number of retries unless
+// WithMaxRetries is used.
+//
+// Example:
+//     svc := s3.New(aws.NewConfig().WithRegion("us-west-2").WithMaxRetries(10))
+func NewConfig() *Config {
+	return &Config{MaxRetries: DefaultRetries}
+}
+
+// WithCredentials sets the credentials used to sign requests, returning the
+// Config pointer for chaining.
+func (c *Config) WithCredentials(creds *credentials.Credentials) *Config {
+	c.Credentials = creds
+	return c
+}
+
+// WithEndpoint sets the endpoint URL requests are sent to, returning the Config
+// pointer for chaining.
+func (c *Config) WithEndpoint(endpoint string) *Config {
+	c.Endpoint = endpoint
+	return c
+}
+
+// WithEndpointResolver sets the resolver used to resolve service endpoints,
+// returning the Config pointer for chaining.
+func (c *Config) WithEndpointResolver(resolver EndpointResolver) *Config {
+	c.EndpointResolver = resolver
+	return c
+}
+
+// WithRegion sets the region requests are sent to, returning the Config pointer
+// for chaining.
+func (c *Config) WithRegion(region string) *Config {
+	c.Region = region
+	return c
+}
+
+// WithSigningName sets the name of the service requests are signed for,
+// returning the Config pointer for chaining.
+func (c *Config) WithSigningName(name string) *Config {
+	c.SigningName = name
+	return c
+}
+
+// WithSigningRegion sets the region requests are signed for, returning the
+// Config pointer for chaining.
+func (c *Config) WithSigningRegion(region string) *Config {
+	c.SigningRegion = region
+	return c
+}
+
+// WithDisableSSL sets if SSL is disabled when sending requests, returning the
+// Config pointer for chaining.
+func (c *Config) WithDisableSSL(disable bool) *Config {
+	c.DisableSSL = disable
+	return c
+}
+
+// WithUseDualStack sets if dual-stack endpoints are used, returning the
+// Config pointer for chaining.
+func (c *Config) WithUseDualStack(enable bool) *Config {
+	c.UseDualStack = enable
+	return c
+}
+
+// WithUseFIPSEndpoint sets if FIPS endpoints are used, returning the Config
+// pointer for chaining.
+func (c *Config) WithUseFIPSEndpoint(enable bool) *Config {
+	c.UseFIPSEndpoint = enable
+	return c
+}
+
+// WithEnableEndpointDiscovery sets if the endpoints of requests are
+// discovered, returning the Config pointer for chaining.
+func (c *Config) WithEnableEndpointDiscovery(enable bool) *Config {
+	c.EnableEndpointDiscovery = enable
+	return c
+}
+
+// WithDisableEndpointHostPrefix sets if the host prefixes of operations are
+// disabled, returning the Config pointer for chaining.
+func (c *Config) WithDisableEndpointHostPrefix(disable bool) *Config {
+	c.DisableEndpointHostPrefix = disable
+	return c
+}
+
+// WithSTSRegionalEndpoint sets the endpoint STS clients send requests to,
+// returning the Config pointer for chaining.
+func (c *Config) WithSTSRegionalEndpoint(mode string) *Config {
+	c.STSRegionalEndpoint = mode
+	return c
+}
+
+// WithHTTPClient sets the HTTP client used to send requests, returning the
+// Config pointer for chaining.
+func (c *Config) WithHTTPClient(client *http.Client) *Config {
+	c.HTTPClient = client
+	return c
+}
+
+// WithCABundle sets the PEM encoded bundle of CA certificates servers are
+// verified with, returning the Config pointer for chaining.
+func (c *Config) WithCABundle(bundle []byte) *Config {
+	c.CABundle = bundle
+	return c
+}
+
+// WithDialer sets the dialer connections are opened with, returning the
+// Config pointer for chaining.
+func (c *Config) WithDialer(dialer Dialer) *Config {
+	c.Dialer = dialer
+	return c
+}
+
+// WithProxy sets the function returning the proxy requests are sent
+// through, returning the Config pointer for chaining.
+func (c *Config) WithProxy(proxy func(*http.Request) (*url.URL, error)) *Config {
+	c.Proxy = proxy
+	return c
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections kept
+// open to each host, returning the Config pointer for chaining.
+func (c *Config) WithMaxIdleConnsPerHost(max int) *Config {
+	c.MaxIdleConnsPerHost = max
+	return c
+}
+
+// WithOperationTimeouts sets the timeouts of API calls by operation name,
+// returning the Config pointer for chaining.
+func (c *Config) WithOperationTimeouts(timeouts map[string]time.Duration) *Config {
+	c.OperationTimeouts = timeouts
+	return c
+}
+
+// WithAttemptTimeout sets the maximum duration of each attempt of a request,
+// returning the Config pointer for chaining.
+func (c *Config) WithAttemptTimeout(timeout time.Duration) *Config {
+	c.AttemptTimeout = timeout
+	return c
+}
+
+// WithClientTrace sets the httptrace.ClientTrace whose hooks are called as
+// requests are sent, returning the Config pointer for chaining.
+func (c *Config) WithClientTrace(trace *httptrace.ClientTrace) *Config {
+	c.ClientTrace = trace
+	return c
+}
+
+// WithLogHTTPBody sets if the bodies of HTTP requests are logged, returning the
+// Config pointer for chaining.
+func (c *Config) WithLogHTTPBody(logBody bool) *Config {
+	c.LogHTTPBody = logBody
+	return c
+}
+
+// WithLogLevel sets the logging level, returning the Config pointer for
+// chaining.
+func (c *Config) WithLogLevel(level LogLevelType) *Config {
+	c.LogLevel = level
+	return c
+}
+
+// WithLogger sets the logger log messages are written to, returning the Config
+// pointer for chaining.
+func (c *Config) WithLogger(logger Logger) *Config {
+	c.Logger = logger
+	return c
+}
+
+// WithMetricsCollector sets the collector of the metrics of each attempt of
+// the requests sent, returning the Config pointer for chaining.
+func (c *Config) WithMetricsCollector(collector MetricsCollector) *Config {
+	c.MetricsCollector = collector
+	return c
+}
+
+// WithTracer sets the tracer of the API calls and attempts of the requests
+// sent, returning the Config pointer for chaining.
+func (c *Config) WithTracer(tracer Tracer) *Config {
+	c.Tracer = tracer
+	return c
+}
+
+// WithCSMEnabled sets if API calls and attempts are reported to the CSM agent,
+// returning the Config pointer for chaining.
+func (c *Config) WithCSMEnabled(enabled bool) *Config {
+	c.CSMEnabled = enabled
+	return c
+}
+
+// WithCSMAgent sets the host and port of the CSM agent, returning the Config
+// pointer for chaining.
+func (c *Config) WithCSMAgent(host string, port int) *Config {
+	c.CSMHost = host
+	c.CSMPort = port
+	return c
+}
+
+// WithCSMClientID sets the ID of the application included in CSM datagrams,
+// returning the Config pointer for chaining.
+func (c *Config) WithCSMClientID(id string) *Config {
+	c.CSMClientID = id
+	return c
+}
+
+// WithMaxRetries sets the maximum number of times a request will be retried,
+// returning the Config pointer for chaining.
+func (c *Config) WithMaxRetries(max int) *Config {
+	c.MaxRetries = max
+	return c
+}
+
+// WithRetryBaseDelay sets the base delay used to compute retry delays,
+// returning the Config pointer for chaining.
+func (c *Config) WithRetryBaseDelay(delay time.Duration) *Config {
+	c.RetryBaseDelay = delay
+	return c
+}
+
+// WithRetryMaxDelay sets the maximum delay before a request is retried,
+// returning the Config pointer for chaining.
+func (c *Config) WithRetryMaxDelay(delay time.Duration) *Config {
+	c.RetryMaxDelay = delay
+	return c
+}
+
+// WithRetryMode sets the retry mode, returning the Config pointer for chaining.
+func (c *Config) WithRetryMode(mode string) *Config {
+	c.RetryMode = mode
+	return c
+}
+
+// WithMaxSendRate sets the maximum rate, in requests per second, and
+// burst requests are sent at, returning the Config pointer for chaining.
+func (c *Config) WithMaxSendRate(rate float64, burst int) *Config {
+	c.MaxSendRate = rate
+	c.MaxSendBurst = burst
+	return c
+}
+
+// WithDisableNetworkErrorRetries sets if requests failing with transient
+// network errors are not retried, returning the Config pointer for chaining.
+func (c *Config) WithDisableNetworkErrorRetries(disable bool) *Config {
+	c.DisableNetworkErrorRetries = disable
+	return c
+}
+
+// WithDisableRetryQuota sets if the retry quota of service clients is
+// disabled, returning the Config pointer for chaining.
+func (c *Config) WithDisableRetryQuota(disable bool) *Config {
+	c.DisableRetryQuota = disable
+	return c
+}
+
+// WithCircuitBreaker sets the circuit breaker of requests, returning the
+// Config pointer for chaining.
+func (c *Config) WithCircuitBreaker(breaker *CircuitBreaker) *Config {
+	c.CircuitBreaker = breaker
+	return c
+}
+
+// WithDisableParamValidation sets if semantic parameter validation is disabled,
+// returning the Config pointer for chaining.
+func (c *Config) WithDisableParamValidation(disable bool) *Config {
+	c.DisableParamValidation = disable
+	return c
+}
+
+// WithDisableComputeChecksums sets if the computation of request and response
+// checksums is disabled, returning the Config pointer for chaining.
+func (c *Config) WithDisableComputeChecksums(disable bool) *Config {
+	c.DisableComputeChecksums = disable
+	return c
+}
+
+// WithDisableRestProtocolURICleaning sets if the URI path of REST protocol
+// requests is sent without cleaning, returning the Config pointer for chaining.
+func (c *Config) WithDisableRestProtocolURICleaning(disable bool) *Config {
+	c.DisableRestProtocolURICleaning = disable
+	return c
+}
+
+// WithCompressRequestBody sets if the bodies of requests to operations which
+// accept compressed requests are gzipped, returning the Config pointer for
+// chaining.
+func (c *Config) WithCompressRequestBody(compress bool) *Config {
+	c.CompressRequestBody = compress
+	return c
+}
+
+// WithRequestMinCompressSize sets the minimum size in bytes of the request
+// bodies gzipped, returning the Config pointer for chaining.
+func (c *Config) WithRequestMinCompressSize(size int64) *Config {
+	c.RequestMinCompressSize = size
+	return c
+}
+
+// WithS3ForcePathStyle sets if S3 requests are forced to use path-style
+// addressing, returning the Config pointer for chaining.
+func (c *Config) WithS3ForcePathStyle(force bool) *Config {
+	c.S3ForcePathStyle = force
+	return c
+}
+
+// WithS3UseARNRegion sets if S3 requests for access point ARNs are sent to
+// the ARN's region, returning the Config pointer for chaining.
+func (c *Config) WithS3UseARNRegion(use bool) *Config {
+	c.S3UseARNRegion = use
+	return c
+}
+
+// WithS3UseStreamingSignature sets if the bodies of S3 uploads are signed
+// chunk by chunk as they are sent, returning the Config pointer for chaining.
+func (c *Config) WithS3UseStreamingSignature(use bool) *Config {
+	c.S3UseStreamingSignature = use
+	return c
+}
+
+// WithS3UseUnsignedPayload sets if S3 requests sent over HTTPS are signed
+// without the hash of their body, returning the Config pointer for chaining.
+func (c *Config) WithS3UseUnsignedPayload(use bool) *Config {
+	c.S3UseUnsignedPayload = use
+	return c
+}
+
+// WithAppID sets the ID of the application sending requests, returning the
+// Config pointer for chaining.
+func (c *Config) WithAppID(id string) *Config {
+	c.AppID = id
+	return c
 }
 
 // Copy will return a shallow copy of the Config object.
@@ -124,16 +759,53 @@ func (c Config) Copy() Config {
 	dst := Config{}
 	dst.Credentials = c.Credentials
 	dst.Endpoint = c.Endpoint
+	dst.EndpointResolver = c.EndpointResolver
 	dst.Region = c.Region
+	dst.SigningName = c.SigningName
+	dst.SigningRegion = c.SigningRegion
 	dst.DisableSSL = c.DisableSSL
+	dst.UseDualStack = c.UseDualStack
+	dst.UseFIPSEndpoint = c.UseFIPSEndpoint
+	dst.STSRegionalEndpoint = c.STSRegionalEndpoint
+	dst.EnableEndpointDiscovery = c.EnableEndpointDiscovery
+	dst.DisableEndpointHostPrefix = c.DisableEndpointHostPrefix
 	dst.HTTPClient = c.HTTPClient
+	dst.CABundle = c.CABundle
+	dst.Dialer = c.Dialer
+	dst.Proxy = c.Proxy
+	dst.MaxIdleConnsPerHost = c.MaxIdleConnsPerHost
+	dst.OperationTimeouts = c.OperationTimeouts
+	dst.AttemptTimeout = c.AttemptTimeout
+	dst.ClientTrace = c.ClientTrace
 	dst.LogHTTPBody = c.LogHTTPBody
 	dst.LogLevel = c.LogLevel
 	dst.Logger = c.Logger
+	dst.MetricsCollector = c.MetricsCollector
+	dst.Tracer = c.Tracer
+	dst.CSMEnabled = c.CSMEnabled
+	dst.CSMHost = c.CSMHost
+	dst.CSMPort = c.CSMPort
+	dst.CSMClientID = c.CSMClientID
 	dst.MaxRetries = c.MaxRetries
+	dst.RetryBaseDelay = c.RetryBaseDelay
+	dst.RetryMaxDelay = c.RetryMaxDelay
+	dst.RetryMode = c.RetryMode
+	dst.MaxSendRate = c.MaxSendRate
+	dst.MaxSendBurst = c.MaxSendBurst
+	dst.DisableNetworkErrorRetries = c.DisableNetworkErrorRetries
+	dst.DisableRetryQuota = c.DisableRetryQuota
+	dst.CircuitBreaker = c.CircuitBreaker
 	dst.DisableParamValidation = c.DisableParamValidation
 	dst.DisableComputeChecksums = c.DisableComputeChecksums
+	dst.DisableRestProtocolURICleaning = c.DisableRestProtocolURICleaning
+	dst.CompressRequestBody = c.CompressRequestBody
+	dst.RequestMinCompressSize = c.RequestMinCompressSize
 	dst.S3ForcePathStyle = c.S3ForcePathStyle
+	dst.S3UseARNRegion = c.S3UseARNRegion
+	dst.S3UseStreamingSignature = c.S3UseStreamingSignature
+	dst.S3UseUnsignedPayload = c.S3UseUnsignedPayload
+	dst.AppID = c.AppID
+	dst.resets = c.resets
 
 	return dst
 }
@@ -141,8 +813,8 @@ func (c Config) Copy() Config {
 // Merge merges the newcfg attribute values into this Config. Each attribute
 // will be merged into this config if the newcfg attribute's value is non-zero.
 // Due to this, newcfg attributes with zero values cannot be merged in. For
-// example bool attributes cannot be cleared using Merge, and must be explicitly
-// set on the Config structure.
+// example bool attributes cannot be cleared using Merge, unless newcfg resets
+// them to their defaults with WithReset.
 func (c Config) Merge(newcfg *Config) *Config {
 	if newcfg == nil {
 		return &c
@@ -162,24 +834,114 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.Endpoint = c.Endpoint
 	}
 
+	if newcfg.EndpointResolver != nil {
+		cfg.EndpointResolver = newcfg.EndpointResolver
+	} else {
+		cfg.EndpointResolver = c.EndpointResolver
+	}
+
 	if newcfg.Region != "" {
 		cfg.Region = newcfg.Region
 	} else {
 		cfg.Region = c.Region
 	}
 
+	if newcfg.SigningName != "" {
+		cfg.SigningName = newcfg.SigningName
+	} else {
+		cfg.SigningName = c.SigningName
+	}
+
+	if newcfg.SigningRegion != "" {
+		cfg.SigningRegion = newcfg.SigningRegion
+	} else {
+		cfg.SigningRegion = c.SigningRegion
+	}
+
 	if newcfg.DisableSSL {
 		cfg.DisableSSL = newcfg.DisableSSL
 	} else {
 		cfg.DisableSSL = c.DisableSSL
 	}
 
+	if newcfg.UseDualStack {
+		cfg.UseDualStack = newcfg.UseDualStack
+	} else {
+		cfg.UseDualStack = c.UseDualStack
+	}
+
+	if newcfg.UseFIPSEndpoint {
+		cfg.UseFIPSEndpoint = newcfg.UseFIPSEndpoint
+	} else {
+		cfg.UseFIPSEndpoint = c.UseFIPSEndpoint
+	}
+
+	if newcfg.STSRegionalEndpoint != "" {
+		cfg.STSRegionalEndpoint = newcfg.STSRegionalEndpoint
+	} else {
+		cfg.STSRegionalEndpoint = c.STSRegionalEndpoint
+	}
+
+	if newcfg.EnableEndpointDiscovery {
+		cfg.EnableEndpointDiscovery = newcfg.EnableEndpointDiscovery
+	} else {
+		cfg.EnableEndpointDiscovery = c.EnableEndpointDiscovery
+	}
+
+	if newcfg.DisableEndpointHostPrefix {
+		cfg.DisableEndpointHostPrefix = newcfg.DisableEndpointHostPrefix
+	} else {
+		cfg.DisableEndpointHostPrefix = c.DisableEndpointHostPrefix
+	}
+
 	if newcfg.HTTPClient != nil {
 		cfg.HTTPClient = newcfg.HTTPClient
 	} else {
 		cfg.HTTPClient = c.HTTPClient
 	}
 
+	if len(newcfg.CABundle) > 0 {
+		cfg.CABundle = newcfg.CABundle
+	} else {
+		cfg.CABundle = c.CABundle
+	}
+
+	if newcfg.Dialer != nil {
+		cfg.Dialer = newcfg.Dialer
+	} else {
+		cfg.Dialer = c.Dialer
+	}
+
+	if newcfg.Proxy != nil {
+		cfg.Proxy = newcfg.Proxy
+	} else {
+		cfg.Proxy = c.Proxy
+	}
+
+	if newcfg.MaxIdleConnsPerHost != 0 {
+		cfg.MaxIdleConnsPerHost = newcfg.MaxIdleConnsPerHost
+	} else {
+		cfg.MaxIdleConnsPerHost = c.MaxIdleConnsPerHost
+	}
+
+	if newcfg.OperationTimeouts != nil {
+		cfg.OperationTimeouts = newcfg.OperationTimeouts
+	} else {
+		cfg.OperationTimeouts = c.OperationTimeouts
+	}
+
+	if newcfg.AttemptTimeout != 0 {
+		cfg.AttemptTimeout = newcfg.AttemptTimeout
+	} else {
+		cfg.AttemptTimeout = c.AttemptTimeout
+	}
+
+	if newcfg.ClientTrace != nil {
+		cfg.ClientTrace = newcfg.ClientTrace
+	} else {
+		cfg.ClientTrace = c.ClientTrace
+	}
+
 	if newcfg.LogHTTPBody {
 		cfg.LogHTTPBody = newcfg.LogHTTPBody
 	} else {
@@ -198,12 +960,96 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.Logger = c.Logger
 	}
 
+	if newcfg.MetricsCollector != nil {
+		cfg.MetricsCollector = newcfg.MetricsCollector
+	} else {
+		cfg.MetricsCollector = c.MetricsCollector
+	}
+
+	if newcfg.Tracer != nil {
+		cfg.Tracer = newcfg.Tracer
+	} else {
+		cfg.Tracer = c.Tracer
+	}
+
+	if newcfg.CSMEnabled {
+		cfg.CSMEnabled = newcfg.CSMEnabled
+	} else {
+		cfg.CSMEnabled = c.CSMEnabled
+	}
+
+	if newcfg.CSMHost != "" {
+		cfg.CSMHost = newcfg.CSMHost
+	} else {
+		cfg.CSMHost = c.CSMHost
+	}
+
+	if newcfg.CSMPort != 0 {
+		cfg.CSMPort = newcfg.CSMPort
+	} else {
+		cfg.CSMPort = c.CSMPort
+	}
+
+	if newcfg.CSMClientID != "" {
+		cfg.CSMClientID = newcfg.CSMClientID
+	} else {
+		cfg.CSMClientID = c.CSMClientID
+	}
+
 	if newcfg.MaxRetries != DefaultRetries {
 		cfg.MaxRetries = newcfg.MaxRetries
 	} else {
 		cfg.MaxRetries = c.MaxRetries
 	}
 
+	if newcfg.RetryBaseDelay != 0 {
+		cfg.RetryBaseDelay = newcfg.RetryBaseDelay
+	} else {
+		cfg.RetryBaseDelay = c.RetryBaseDelay
+	}
+
+	if newcfg.RetryMaxDelay != 0 {
+		cfg.RetryMaxDelay = newcfg.RetryMaxDelay
+	} else {
+		cfg.RetryMaxDelay = c.RetryMaxDelay
+	}
+
+	if newcfg.RetryMode != "" {
+		cfg.RetryMode = newcfg.RetryMode
+	} else {
+		cfg.RetryMode = c.RetryMode
+	}
+
+	if newcfg.MaxSendRate != 0 {
+		cfg.MaxSendRate = newcfg.MaxSendRate
+	} else {
+		cfg.MaxSendRate = c.MaxSendRate
+	}
+
+	if newcfg.MaxSendBurst != 0 {
+		cfg.MaxSendBurst = newcfg.MaxSendBurst
+	} else {
+		cfg.MaxSendBurst = c.MaxSendBurst
+	}
+
+	if newcfg.DisableNetworkErrorRetries {
+		cfg.DisableNetworkErrorRetries = newcfg.DisableNetworkErrorRetries
+	} else {
+		cfg.DisableNetworkErrorRetries = c.DisableNetworkErrorRetries
+	}
+
+	if newcfg.DisableRetryQuota {
+		cfg.DisableRetryQuota = newcfg.DisableRetryQuota
+	} else {
+		cfg.DisableRetryQuota = c.DisableRetryQuota
+	}
+
+	if newcfg.CircuitBreaker != nil {
+		cfg.CircuitBreaker = newcfg.CircuitBreaker
+	} else {
+		cfg.CircuitBreaker = c.CircuitBreaker
+	}
+
 	if newcfg.DisableParamValidation {
 		cfg.DisableParamValidation = newcfg.DisableParamValidation
 	} else {
@@ -216,11 +1062,92 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.DisableComputeChecksums = c.DisableComputeChecksums
 	}
 
+	if newcfg.DisableRestProtocolURICleaning {
+		cfg.DisableRestProtocolURICleaning = newcfg.DisableRestProtocolURICleaning
+	} else {
+		cfg.DisableRestProtocolURICleaning = c.DisableRestProtocolURICleaning
+	}
+
+	if newcfg.CompressRequestBody {
+		cfg.CompressRequestBody = newcfg.CompressRequestBody
+	} else {
+		cfg.CompressRequestBody = c.CompressRequestBody
+	}
+
+	if newcfg.RequestMinCompressSize != 0 {
+		cfg.RequestMinCompressSize = newcfg.RequestMinCompressSize
+	} else {
+		cfg.RequestMinCompressSize = c.RequestMinCompressSize
+	}
+
 	if newcfg.S3ForcePathStyle {
 		cfg.S3ForcePathStyle = newcfg.S3ForcePathStyle
 	} else {
 		cfg.S3ForcePathStyle = c.S3ForcePathStyle
 	}
 
+	if newcfg.S3UseARNRegion {
+		cfg.S3UseARNRegion = newcfg.S3UseARNRegion
+	} else {
+		cfg.S3UseARNRegion = c.S3UseARNRegion
+	}
+
+	if newcfg.S3UseStreamingSignature {
+		cfg.S3UseStreamingSignature = newcfg.S3UseStreamingSignature
+	} else {
+		cfg.S3UseStreamingSignature = c.S3UseStreamingSignature
+	}
+
+	if newcfg.S3UseUnsignedPayload {
+		cfg.S3UseUnsignedPayload = newcfg.S3UseUnsignedPayload
+	} else {
+		cfg.S3UseUnsignedPayload = c.S3UseUnsignedPayload
+	}
+
+	if newcfg.AppID != "" {
+		cfg.AppID = newcfg.AppID
+	} else {
+		cfg.AppID = c.AppID
+	}
+
+	for _, name := range newcfg.resets {
+		f := reflect.ValueOf(&cfg).Elem().FieldByName(name)
+		f.Set(reflect.ValueOf(DefaultConfig).Elem().FieldByName(name))
+	}
+
 	return &cfg
 }
+
+// WithReset sets the fields Merge resets to their DefaultConfig values when
+// the Config is merged on top of another Config, returning the Config pointer
+// for chaining. The values of the fields in the Config itself are ignored.
+// Use WithReset to clear a field set by the Config merged into, since Merge
+// otherwise skips zero values.
+//
+// The fields are named as in the Config struct, e.g. "Endpoint". WithReset
+// panics if a name is not an exported field of Config.
+//
+// Example:
+//     // Use the default endpoint, credentials and retries of the service,
+//     // even if they are set by the session.
+//     svc := s3.NewWithSession(sess, aws.NewConfig().
+//         WithReset("Endpoint", "Credentials", "MaxRetries"))
+func (c *Config) WithReset(fields ...string) *Config {
+	for _, name := range fields {
+		if f, ok := reflect.TypeOf(Config{}).FieldByName(name); !ok || f.PkgPath != "" {
+			panic("aws: unknown Config field " + name)
+		}
+	}
+	c.resets = append(c.resets, fields...)
+	return c
+}
+
+// resetsField returns true if Merge resets the named field.
+func (c *Config) resetsField(name string) bool {
+	for _, n := range c.resets {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
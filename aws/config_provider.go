@@ -0,0 +1,13 @@
+package aws
+
+// A ConfigProvider provides the Config and Handlers service clients are
+// created with. A session.Session is a ConfigProvider.
+type ConfigProvider interface {
+	// ClientConfig returns the Config a service client should be created
+	// with, merging config on top of the provider's Config.
+	ClientConfig(config *Config) *Config
+
+	// ClientHandlers returns the Handlers which will be added to a service
+	// client's handlers, after the client's own handlers.
+	ClientHandlers() Handlers
+}
@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"net"
 	"net/http"
-	"os"
+	"net/http/httptrace"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
@@ -10,6 +12,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 )
 
+var testLogger = NewDefaultLogger()
+
 var testCredentials = credentials.NewChainCredentials([]credentials.Provider{
 	&credentials.EnvProvider{},
 	&credentials.SharedCredentialsProvider{
@@ -18,19 +22,68 @@ var testCredentials = credentials.NewChainCredentials([]credentials.Provider{
 	&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute},
 })
 
+type testEndpointResolver struct{ endpoint string }
+
+func (r testEndpointResolver) ResolveEndpoint(service, region string) (string, string, error) {
+	return r.endpoint, region, nil
+}
+
+var testResolver = testEndpointResolver{endpoint: "TestResolverEndpoint"}
+
+var testDialer = &net.Dialer{Timeout: time.Second}
+
+var testCircuitBreaker = NewCircuitBreaker(3, time.Second)
+
+var testClientTrace = &httptrace.ClientTrace{}
+
 var copyTestConfig = Config{
-	Credentials:             testCredentials,
-	Endpoint:                "CopyTestEndpoint",
-	Region:                  "COPY_TEST_AWS_REGION",
-	DisableSSL:              true,
-	HTTPClient:              http.DefaultClient,
-	LogHTTPBody:             true,
-	LogLevel:                2,
-	Logger:                  os.Stdout,
-	MaxRetries:              DefaultRetries,
-	DisableParamValidation:  true,
-	DisableComputeChecksums: true,
-	S3ForcePathStyle:        true,
+	Credentials:                    testCredentials,
+	Endpoint:                       "CopyTestEndpoint",
+	EndpointResolver:               testResolver,
+	Region:                         "COPY_TEST_AWS_REGION",
+	SigningName:                    "COPY_TEST_SIGNING_NAME",
+	SigningRegion:                  "COPY_TEST_SIGNING_REGION",
+	DisableSSL:                     true,
+	UseDualStack:                   true,
+	UseFIPSEndpoint:                true,
+	STSRegionalEndpoint:            STSRegionalEndpointRegional,
+	EnableEndpointDiscovery:        true,
+	DisableEndpointHostPrefix:      true,
+	HTTPClient:                     http.DefaultClient,
+	CABundle:                       []byte("TestCABundle"),
+	Dialer:                         testDialer,
+	MaxIdleConnsPerHost:            50,
+	OperationTimeouts:              map[string]time.Duration{"Operation": time.Second},
+	AttemptTimeout:                 time.Second,
+	ClientTrace:                    testClientTrace,
+	LogHTTPBody:                    true,
+	LogLevel:                       2,
+	Logger:                         testLogger,
+	MetricsCollector:               testMetricsCollector,
+	Tracer:                         testTracer,
+	CSMEnabled:                     true,
+	CSMHost:                        "TestCSMHost",
+	CSMPort:                        31001,
+	CSMClientID:                    "TestCSMClientID",
+	MaxRetries:                     DefaultRetries,
+	RetryBaseDelay:                 10 * time.Millisecond,
+	RetryMaxDelay:                  time.Second,
+	RetryMode:                      RetryModeAdaptive,
+	MaxSendRate:                    10,
+	MaxSendBurst:                   5,
+	DisableNetworkErrorRetries:     true,
+	DisableRetryQuota:              true,
+	CircuitBreaker:                 testCircuitBreaker,
+	DisableParamValidation:         true,
+	DisableComputeChecksums:        true,
+	DisableRestProtocolURICleaning: true,
+	CompressRequestBody:            true,
+	RequestMinCompressSize:         1024,
+	S3ForcePathStyle:               true,
+	S3UseARNRegion:                 true,
+	S3UseStreamingSignature:        true,
+	S3UseUnsignedPayload:           true,
+	AppID:                          "TestAppID",
 }
 
 func TestCopy(t *testing.T) {
@@ -53,18 +106,53 @@ func TestCopyReturnsNewInstance(t *testing.T) {
 var mergeTestZeroValueConfig = Config{MaxRetries: DefaultRetries}
 
 var mergeTestConfig = Config{
-	Credentials:             testCredentials,
-	Endpoint:                "MergeTestEndpoint",
-	Region:                  "MERGE_TEST_AWS_REGION",
-	DisableSSL:              true,
-	HTTPClient:              http.DefaultClient,
-	LogHTTPBody:             true,
-	LogLevel:                2,
-	Logger:                  os.Stdout,
-	MaxRetries:              10,
-	DisableParamValidation:  true,
-	DisableComputeChecksums: true,
-	S3ForcePathStyle:        true,
+	Credentials:                    testCredentials,
+	Endpoint:                       "MergeTestEndpoint",
+	EndpointResolver:               testResolver,
+	Region:                         "MERGE_TEST_AWS_REGION",
+	SigningName:                    "MERGE_TEST_SIGNING_NAME",
+	SigningRegion:                  "MERGE_TEST_SIGNING_REGION",
+	DisableSSL:                     true,
+	UseDualStack:                   true,
+	UseFIPSEndpoint:                true,
+	STSRegionalEndpoint:            STSRegionalEndpointRegional,
+	EnableEndpointDiscovery:        true,
+	DisableEndpointHostPrefix:      true,
+	HTTPClient:                     http.DefaultClient,
+	CABundle:                       []byte("TestCABundle"),
+	Dialer:                         testDialer,
+	MaxIdleConnsPerHost:            50,
+	OperationTimeouts:              map[string]time.Duration{"Operation": time.Second},
+	AttemptTimeout:                 time.Second,
+	ClientTrace:                    testClientTrace,
+	LogHTTPBody:                    true,
+	LogLevel:                       2,
+	Logger:                         testLogger,
+	MetricsCollector:               testMetricsCollector,
+	Tracer:                         testTracer,
+	CSMEnabled:                     true,
+	CSMHost:                        "TestCSMHost",
+	CSMPort:                        31001,
+	CSMClientID:                    "TestCSMClientID",
+	MaxRetries:                     10,
+	RetryBaseDelay:                 10 * time.Millisecond,
+	RetryMaxDelay:                  time.Second,
+	RetryMode:                      RetryModeAdaptive,
+	MaxSendRate:                    10,
+	MaxSendBurst:                   5,
+	DisableNetworkErrorRetries:     true,
+	DisableRetryQuota:              true,
+	CircuitBreaker:                 testCircuitBreaker,
+	DisableParamValidation:         true,
+	DisableComputeChecksums:        true,
+	DisableRestProtocolURICleaning: true,
+	CompressRequestBody:            true,
+	RequestMinCompressSize:         1024,
+	S3ForcePathStyle:               true,
+	S3UseARNRegion:                 true,
+	S3UseStreamingSignature:        true,
+	S3UseUnsignedPayload:           true,
+	AppID:                          "TestAppID",
 }
 
 var mergeTests = []struct {
@@ -88,3 +176,150 @@ func TestMerge(t *testing.T) {
 		}
 	}
 }
+
+func TestCopyMergeProxy(t *testing.T) {
+	proxyURL, _ := url.Parse("socks5://localhost:1080")
+	proxy := http.ProxyURL(proxyURL)
+
+	for _, cfg := range []Config{
+		Config{Proxy: proxy}.Copy(),
+		*Config{}.Merge(&Config{Proxy: proxy}),
+		*Config{Proxy: proxy}.Merge(&Config{}),
+		*NewConfig().WithProxy(proxy),
+	} {
+		if cfg.Proxy == nil {
+			t.Errorf("Proxy = nil, want the proxy function")
+			continue
+		}
+		if u, _ := cfg.Proxy(nil); u != proxyURL {
+			t.Errorf("Proxy() = %v, want %v", u, proxyURL)
+		}
+	}
+}
+
+func TestMergeReset(t *testing.T) {
+	cfg := &Config{
+		Credentials: testCredentials,
+		Endpoint:    "MergeTestEndpoint",
+		Region:      "MERGE_TEST_AWS_REGION",
+		MaxRetries:  5,
+		DisableSSL:  true,
+	}
+
+	got := cfg.Merge(NewConfig().
+		WithEndpoint("IgnoredEndpoint").
+		WithReset("Endpoint", "Credentials", "MaxRetries", "DisableSSL"))
+	want := &Config{
+		Credentials: DefaultConfig.Credentials,
+		Endpoint:    DefaultConfig.Endpoint,
+		Region:      "MERGE_TEST_AWS_REGION",
+		MaxRetries:  DefaultConfig.MaxRetries,
+		DisableSSL:  false,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("   got %+v", got)
+		t.Errorf("  want %+v", want)
+	}
+
+	// The resets are not merged, so merging the result again keeps its values.
+	got = got.Merge(&Config{MaxRetries: DefaultRetries}).Merge(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("   got %+v", got)
+		t.Errorf("  want %+v", want)
+	}
+}
+
+func TestWithResetUnknownField(t *testing.T) {
+	for _, name := range []string{"Unknown", "resets"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("WithReset(%q) did not panic", name)
+				}
+			}()
+			NewConfig().WithReset(name)
+		}()
+	}
+}
+
+func TestNewConfigBuilder(t *testing.T) {
+	got := NewConfig().
+		WithCredentials(testCredentials).
+		WithEndpoint("MergeTestEndpoint").
+		WithEndpointResolver(testResolver).
+		WithRegion("MERGE_TEST_AWS_REGION").
+		WithSigningName("MERGE_TEST_SIGNING_NAME").
+		WithSigningRegion("MERGE_TEST_SIGNING_REGION").
+		WithDisableSSL(true).
+		WithUseDualStack(true).
+		WithUseFIPSEndpoint(true).
+		WithSTSRegionalEndpoint(STSRegionalEndpointRegional).
+		WithEnableEndpointDiscovery(true).
+		WithDisableEndpointHostPrefix(true).
+		WithHTTPClient(http.DefaultClient).
+		WithCABundle([]byte("TestCABundle")).
+		WithDialer(testDialer).
+		WithMaxIdleConnsPerHost(50).
+		WithOperationTimeouts(map[string]time.Duration{"Operation": time.Second}).
+		WithAttemptTimeout(time.Second).
+		WithClientTrace(testClientTrace).
+		WithLogHTTPBody(true).
+		WithLogLevel(2).
+		WithLogger(testLogger).
+		WithMetricsCollector(testMetricsCollector).
+		WithTracer(testTracer).
+		WithCSMEnabled(true).
+		WithCSMAgent("TestCSMHost", 31001).
+		WithCSMClientID("TestCSMClientID").
+		WithMaxRetries(10).
+		WithRetryBaseDelay(10*time.Millisecond).
+		WithRetryMaxDelay(time.Second).
+		WithRetryMode(RetryModeAdaptive).
+		WithMaxSendRate(10, 5).
+		WithDisableNetworkErrorRetries(true).
+		WithDisableRetryQuota(true).
+		WithCircuitBreaker(testCircuitBreaker).
+		WithDisableParamValidation(true).
+		WithDisableComputeChecksums(true).
+		WithDisableRestProtocolURICleaning(true).
+		WithCompressRequestBody(true).
+		WithRequestMinCompressSize(1024).
+		WithS3ForcePathStyle(true).
+		WithS3UseARNRegion(true).
+		WithS3UseStreamingSignature(true).
+		WithS3UseUnsignedPayload(true).
+		WithAppID("TestAppID")
+
+	if !reflect.DeepEqual(got, &mergeTestConfig) {
+		t.Errorf("   got %+v", got)
+		t.Errorf("  want %+v", &mergeTestConfig)
+	}
+}
+
+func TestNewConfigMergeKeepsRetries(t *testing.T) {
+	cfg := &Config{MaxRetries: 5}
+
+	got := cfg.Merge(NewConfig().WithRegion("region"))
+	if e, a := 5, got.MaxRetries; e != a {
+		t.Errorf("expect %d max retries, got %d", e, a)
+	}
+
+	got = cfg.Merge(NewConfig().WithMaxRetries(7))
+	if e, a := 7, got.MaxRetries; e != a {
+		t.Errorf("expect %d max retries, got %d", e, a)
+	}
+}
+
+func TestDefaultConfigHTTPClient(t *testing.T) {
+	if DefaultConfig.HTTPClient == http.DefaultClient {
+		t.Errorf("DefaultConfig.HTTPClient = http.DefaultClient, want a client with timeouts")
+	}
+	if tr, ok := DefaultConfig.HTTPClient.Transport.(*http.Transport); !ok || tr.TLSHandshakeTimeout == 0 {
+		t.Errorf("DefaultConfig.HTTPClient.Transport = %#v, want a transport with timeouts", DefaultConfig.HTTPClient.Transport)
+	}
+
+	s := NewService(&Config{})
+	if s.Config.HTTPClient != DefaultConfig.HTTPClient {
+		t.Errorf("Service HTTPClient = %p, want the DefaultConfig's %p", s.Config.HTTPClient, DefaultConfig.HTTPClient)
+	}
+}
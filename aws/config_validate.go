@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+)
+
+// Validate reports problems with the Config which would cause requests made
+// with it to fail, such as a missing region or credentials, or options which
+// conflict with each other. Validate can be used to surface configuration
+// mistakes when a client is created, instead of as signing or connection
+// errors when the first request is sent.
+//
+// If the Config is not valid an awserr.Error with the code "InvalidConfig"
+// is returned, listing each problem found.
+//
+// Example:
+//     cfg := aws.DefaultConfig.Merge(&aws.Config{Region: region})
+//     if err := cfg.Validate(); err != nil {
+//         log.Fatal(err)
+//     }
+func (c *Config) Validate() error {
+	errs := []string{}
+
+	if c.Region == "" {
+		errs = append(errs, "missing region, set Config.Region or the AWS_REGION environment variable")
+	}
+	if c.Credentials == nil {
+		errs = append(errs, "missing credentials, set Config.Credentials, or use "+
+			"credentials.AnonymousCredentials for requests which should not be signed")
+	}
+
+	if c.Endpoint != "" {
+		if u, err := url.Parse(c.Endpoint); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid Endpoint %q, %v", c.Endpoint, err))
+		} else if c.DisableSSL && strings.EqualFold(u.Scheme, "https") {
+			errs = append(errs, fmt.Sprintf("DisableSSL cannot be used with the https Endpoint %q, "+
+				"use an http:// Endpoint or don't set DisableSSL", c.Endpoint))
+		}
+	}
+
+	if c.MaxRetries < DefaultRetries {
+		errs = append(errs, fmt.Sprintf("invalid MaxRetries %d, must be zero or more, "+
+			"or DefaultRetries to use the service's default", c.MaxRetries))
+	}
+	if len(c.CABundle) > 0 {
+		if _, err := defaults.CABundleTLSConfig(c.CABundle); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid CABundle, %v", err))
+		}
+	} else if envCABundleErr != nil {
+		errs = append(errs, fmt.Sprintf("failed to load the AWS_CA_BUNDLE file, %v", envCABundleErr))
+	}
+	if c.AttemptTimeout < 0 {
+		errs = append(errs, fmt.Sprintf("invalid AttemptTimeout %v, must not be negative", c.AttemptTimeout))
+	}
+	if c.RetryBaseDelay < 0 {
+		errs = append(errs, fmt.Sprintf("invalid RetryBaseDelay %v, must not be negative", c.RetryBaseDelay))
+	}
+	if c.RetryMaxDelay < 0 {
+		errs = append(errs, fmt.Sprintf("invalid RetryMaxDelay %v, must not be negative", c.RetryMaxDelay))
+	}
+	if c.RetryBaseDelay > 0 && c.RetryMaxDelay > 0 && c.RetryBaseDelay > c.RetryMaxDelay {
+		errs = append(errs, fmt.Sprintf("RetryBaseDelay %v is greater than RetryMaxDelay %v",
+			c.RetryBaseDelay, c.RetryMaxDelay))
+	}
+	switch c.RetryMode {
+	case "", RetryModeStandard, RetryModeAdaptive:
+	default:
+		errs = append(errs, fmt.Sprintf("unknown RetryMode %q, must be %q or %q",
+			c.RetryMode, RetryModeStandard, RetryModeAdaptive))
+	}
+
+	switch c.STSRegionalEndpoint {
+	case "", STSRegionalEndpointLegacy, STSRegionalEndpointRegional:
+	default:
+		errs = append(errs, fmt.Sprintf("unknown STSRegionalEndpoint %q, must be %q or %q",
+			c.STSRegionalEndpoint, STSRegionalEndpointLegacy, STSRegionalEndpointRegional))
+	}
+
+	if (c.LogLevel != LogOff || c.LogHTTPBody) && c.Logger == nil {
+		errs = append(errs, "logging is enabled but Logger is not set, set Config.Logger")
+	}
+
+	if count := len(errs); count > 0 {
+		format := "%d validation errors:\n- %s"
+		msg := fmt.Sprintf(format, count, strings.Join(errs, "\n- "))
+		return awserr.New("InvalidConfig", msg, nil)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	cfg := NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.WithEndpoint("http://localhost:8080").WithDisableSSL(true)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestConfigValidateErrors(t *testing.T) {
+	cases := []struct {
+		cfg  *Config
+		errs []string
+	}{
+		{
+			NewConfig(),
+			[]string{"missing region", "missing credentials"},
+		},
+		{
+			NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials).
+				WithEndpoint("https://localhost").WithDisableSSL(true),
+			[]string{`DisableSSL cannot be used with the https Endpoint "https://localhost"`},
+		},
+		{
+			NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials).
+				WithRetryMode("fast").WithMaxRetries(-2).
+				WithRetryBaseDelay(time.Minute).WithRetryMaxDelay(time.Second),
+			[]string{`unknown RetryMode "fast"`, "invalid MaxRetries -2", "RetryBaseDelay 1m0s is greater than RetryMaxDelay 1s"},
+		},
+		{
+			NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials).
+				WithLogLevel(LogDebug),
+			[]string{"Logger is not set"},
+		},
+		{
+			NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials).
+				WithSTSRegionalEndpoint("global"),
+			[]string{`unknown STSRegionalEndpoint "global"`},
+		},
+		{
+			NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials).
+				WithAttemptTimeout(-time.Second),
+			[]string{"invalid AttemptTimeout -1s"},
+		},
+		{
+			NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials).
+				WithCABundle([]byte("not a certificate")),
+			[]string{"invalid CABundle, no certificates found"},
+		},
+	}
+
+	for i, c := range cases {
+		err := c.cfg.Validate()
+		if !assert.Error(t, err, "case %d", i) {
+			continue
+		}
+		aerr := err.(awserr.Error)
+		assert.Equal(t, "InvalidConfig", aerr.Code(), "case %d", i)
+		assert.Contains(t, aerr.Message(), fmt.Sprintf("%d validation errors", len(c.errs)), "case %d", i)
+		for _, e := range c.errs {
+			assert.Contains(t, aerr.Message(), e, "case %d", i)
+		}
+	}
+}
+
+func TestDefaultConfigValidate(t *testing.T) {
+	cfg := DefaultConfig.Merge(&Config{Region: "us-west-2"})
+	assert.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// Context is an alias of the standard library's context.Context. It is used
+// by the SDK to carry deadlines, cancellation signals, and request scoped
+// values across API operation calls.
+//
+// Any value satisfying context.Context can be passed to the SDK's
+// WithContext operation methods and Request.SetContext.
+type Context = context.Context
+
+// BackgroundContext returns a context that will never be canceled, has no
+// values, and no deadline. This context is used by the SDK when a request
+// was not given a context.
+func BackgroundContext() Context {
+	return context.Background()
+}
+
+// ErrCodeRequestCanceled is the awserr.Error code for requests which were
+// canceled because their context was done before a response was received.
+const ErrCodeRequestCanceled = "RequestCanceled"
+
+// ErrCodeRequestAttemptTimeout is the awserr.Error code for request attempts
+// which did not complete within the Config's AttemptTimeout. The attempt is
+// retried if the request has retries remaining.
+const ErrCodeRequestAttemptTimeout = "RequestAttemptTimeout"
+
+// SleepWithContext will wait for the timer duration to expire, or the context
+// is canceled. Which ever happens first. If the context is canceled the
+// Context's error will be returned.
+func SleepWithContext(ctx Context, dur time.Duration) error {
+	t := time.NewTimer(dur)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		break
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// newCanceledError returns the error a request is set with when its context
+// has been canceled.
+func newCanceledError(err error) error {
+	return awserr.New(ErrCodeRequestCanceled, "request context canceled", err)
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSleepWithContext(t *testing.T) {
+	err := SleepWithContext(BackgroundContext(), 1*time.Millisecond)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(BackgroundContext())
+	cancel()
+	err = SleepWithContext(ctx, 1*time.Minute)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestRequestContextDefault(t *testing.T) {
+	s := NewService(&Config{})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.Equal(t, BackgroundContext(), r.Context())
+
+	ctx, cancel := context.WithCancel(BackgroundContext())
+	defer cancel()
+	r.SetContext(ctx)
+	assert.Equal(t, ctx, r.Context())
+	assert.Equal(t, ctx, r.HTTPRequest.Context())
+}
+
+func TestRequestSendCanceledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	s := NewService(&Config{Endpoint: server.URL, MaxRetries: 10})
+	s.Handlers.Validate.Clear()
+
+	ctx, cancel := context.WithCancel(BackgroundContext())
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+
+	cancel()
+
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func TestRequestRetryDelayCanceledContext(t *testing.T) {
+	origSleepDelay := sleepDelay
+	defer func() { sleepDelay = origSleepDelay }()
+	sleepDelay = func(ctx Context, delay time.Duration) error {
+		return SleepWithContext(ctx, delay)
+	}
+
+	ctx, cancel := context.WithCancel(BackgroundContext())
+
+	s := NewService(&Config{MaxRetries: 10})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		cancel()
+		r.HTTPResponse = &http.Response{
+			StatusCode: 500,
+			Body:       body(`{"__type":"UnknownError","message":"An error occurred."}`),
+		}
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func TestCredentialsGetWithCanceledContext(t *testing.T) {
+	credProvider := &mockCredsProvider{expired: true}
+	creds := credentials.NewCredentials(credProvider)
+
+	ctx, cancel := context.WithCancel(BackgroundContext())
+	cancel()
+
+	_, err := creds.GetWithContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.False(t, credProvider.retreiveCalled)
+}
@@ -0,0 +1,287 @@
+package aws
+
+import "time"
+
+// The conversion helpers of this file convert between the pointer typed
+// fields of the API input and output types and Go values. A nil pointer is
+// converted to the zero value of its type.
+
+// StringValue returns the value of the string pointer passed in, or the
+// zero value if the pointer is nil.
+func StringValue(v *string) string {
+	if v != nil {
+		return *v
+	}
+	return ""
+}
+
+// StringSlice converts a slice of string values into a slice of string
+// pointers.
+func StringSlice(src []string) []*string {
+	dst := make([]*string, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = &(src[i])
+	}
+	return dst
+}
+
+// StringValueSlice converts a slice of string pointers into a slice of
+// string values. Nil pointers are converted to the zero value.
+func StringValueSlice(src []*string) []string {
+	dst := make([]string, len(src))
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil {
+			dst[i] = *(src[i])
+		}
+	}
+	return dst
+}
+
+// StringMap converts a string map of string values into a string map of
+// string pointers.
+func StringMap(src map[string]string) map[string]*string {
+	dst := make(map[string]*string, len(src))
+	for k, val := range src {
+		v := val
+		dst[k] = &v
+	}
+	return dst
+}
+
+// StringValueMap converts a string map of string pointers into a string map
+// of string values. Nil pointers are converted to the zero value.
+func StringValueMap(src map[string]*string) map[string]string {
+	dst := make(map[string]string, len(src))
+	for k, val := range src {
+		if val != nil {
+			dst[k] = *val
+		} else {
+			dst[k] = ""
+		}
+	}
+	return dst
+}
+
+// BooleanValue returns the value of the bool pointer passed in, or the
+// zero value if the pointer is nil.
+func BooleanValue(v *bool) bool {
+	if v != nil {
+		return *v
+	}
+	return false
+}
+
+// BooleanSlice converts a slice of bool values into a slice of bool
+// pointers.
+func BooleanSlice(src []bool) []*bool {
+	dst := make([]*bool, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = &(src[i])
+	}
+	return dst
+}
+
+// BooleanValueSlice converts a slice of bool pointers into a slice of
+// bool values. Nil pointers are converted to the zero value.
+func BooleanValueSlice(src []*bool) []bool {
+	dst := make([]bool, len(src))
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil {
+			dst[i] = *(src[i])
+		}
+	}
+	return dst
+}
+
+// BooleanMap converts a string map of bool values into a string map of
+// bool pointers.
+func BooleanMap(src map[string]bool) map[string]*bool {
+	dst := make(map[string]*bool, len(src))
+	for k, val := range src {
+		v := val
+		dst[k] = &v
+	}
+	return dst
+}
+
+// BooleanValueMap converts a string map of bool pointers into a string map
+// of bool values. Nil pointers are converted to the zero value.
+func BooleanValueMap(src map[string]*bool) map[string]bool {
+	dst := make(map[string]bool, len(src))
+	for k, val := range src {
+		if val != nil {
+			dst[k] = *val
+		} else {
+			dst[k] = false
+		}
+	}
+	return dst
+}
+
+// LongValue returns the value of the int64 pointer passed in, or the
+// zero value if the pointer is nil.
+func LongValue(v *int64) int64 {
+	if v != nil {
+		return *v
+	}
+	return 0
+}
+
+// LongSlice converts a slice of int64 values into a slice of int64
+// pointers.
+func LongSlice(src []int64) []*int64 {
+	dst := make([]*int64, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = &(src[i])
+	}
+	return dst
+}
+
+// LongValueSlice converts a slice of int64 pointers into a slice of
+// int64 values. Nil pointers are converted to the zero value.
+func LongValueSlice(src []*int64) []int64 {
+	dst := make([]int64, len(src))
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil {
+			dst[i] = *(src[i])
+		}
+	}
+	return dst
+}
+
+// LongMap converts a string map of int64 values into a string map of
+// int64 pointers.
+func LongMap(src map[string]int64) map[string]*int64 {
+	dst := make(map[string]*int64, len(src))
+	for k, val := range src {
+		v := val
+		dst[k] = &v
+	}
+	return dst
+}
+
+// LongValueMap converts a string map of int64 pointers into a string map
+// of int64 values. Nil pointers are converted to the zero value.
+func LongValueMap(src map[string]*int64) map[string]int64 {
+	dst := make(map[string]int64, len(src))
+	for k, val := range src {
+		if val != nil {
+			dst[k] = *val
+		} else {
+			dst[k] = 0
+		}
+	}
+	return dst
+}
+
+// DoubleValue returns the value of the float64 pointer passed in, or the
+// zero value if the pointer is nil.
+func DoubleValue(v *float64) float64 {
+	if v != nil {
+		return *v
+	}
+	return 0
+}
+
+// DoubleSlice converts a slice of float64 values into a slice of float64
+// pointers.
+func DoubleSlice(src []float64) []*float64 {
+	dst := make([]*float64, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = &(src[i])
+	}
+	return dst
+}
+
+// DoubleValueSlice converts a slice of float64 pointers into a slice of
+// float64 values. Nil pointers are converted to the zero value.
+func DoubleValueSlice(src []*float64) []float64 {
+	dst := make([]float64, len(src))
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil {
+			dst[i] = *(src[i])
+		}
+	}
+	return dst
+}
+
+// DoubleMap converts a string map of float64 values into a string map of
+// float64 pointers.
+func DoubleMap(src map[string]float64) map[string]*float64 {
+	dst := make(map[string]*float64, len(src))
+	for k, val := range src {
+		v := val
+		dst[k] = &v
+	}
+	return dst
+}
+
+// DoubleValueMap converts a string map of float64 pointers into a string map
+// of float64 values. Nil pointers are converted to the zero value.
+func DoubleValueMap(src map[string]*float64) map[string]float64 {
+	dst := make(map[string]float64, len(src))
+	for k, val := range src {
+		if val != nil {
+			dst[k] = *val
+		} else {
+			dst[k] = 0
+		}
+	}
+	return dst
+}
+
+// TimeValue returns the value of the Time pointer passed in, or the
+// zero value if the pointer is nil.
+func TimeValue(v *time.Time) time.Time {
+	if v != nil {
+		return *v
+	}
+	return time.Time{}
+}
+
+// TimeSlice converts a slice of Time values into a slice of Time
+// pointers.
+func TimeSlice(src []time.Time) []*time.Time {
+	dst := make([]*time.Time, len(src))
+	for i := 0; i < len(src); i++ {
+		dst[i] = &(src[i])
+	}
+	return dst
+}
+
+// TimeValueSlice converts a slice of Time pointers into a slice of
+// Time values. Nil pointers are converted to the zero value.
+func TimeValueSlice(src []*time.Time) []time.Time {
+	dst := make([]time.Time, len(src))
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil {
+			dst[i] = *(src[i])
+		}
+	}
+	return dst
+}
+
+// TimeMap converts a string map of Time values into a string map of
+// Time pointers.
+func TimeMap(src map[string]time.Time) map[string]*time.Time {
+	dst := make(map[string]*time.Time, len(src))
+	for k, val := range src {
+		v := val
+		dst[k] = &v
+	}
+	return dst
+}
+
+// TimeValueMap converts a string map of Time pointers into a string map
+// of Time values. Nil pointers are converted to the zero value.
+func TimeValueMap(src map[string]*time.Time) map[string]time.Time {
+	dst := make(map[string]time.Time, len(src))
+	for k, val := range src {
+		if val != nil {
+			dst[k] = *val
+		} else {
+			dst[k] = time.Time{}
+		}
+	}
+	return dst
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueConversions(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, "abc", StringValue(String("abc")))
+	assert.Equal(t, "", StringValue(nil))
+	assert.Equal(t, true, BooleanValue(Boolean(true)))
+	assert.Equal(t, false, BooleanValue(nil))
+	assert.Equal(t, int64(10), LongValue(Long(10)))
+	assert.Equal(t, int64(0), LongValue(nil))
+	assert.Equal(t, 1.5, DoubleValue(Double(1.5)))
+	assert.Equal(t, float64(0), DoubleValue(nil))
+	assert.Equal(t, now, TimeValue(Time(now)))
+	assert.Equal(t, time.Time{}, TimeValue(nil))
+}
+
+func TestSliceConversions(t *testing.T) {
+	in := []string{"a", "", "c"}
+	out := StringSlice(in)
+	assert.Len(t, out, 3)
+	for i := range in {
+		assert.Equal(t, in[i], *out[i])
+	}
+	assert.Equal(t, in, StringValueSlice(out))
+	assert.Equal(t, []string{"a", "", "c"}, StringValueSlice([]*string{String("a"), nil, String("c")}))
+
+	assert.Equal(t, []bool{true, false}, BooleanValueSlice(BooleanSlice([]bool{true, false})))
+	assert.Equal(t, []int64{1, 0, 3}, LongValueSlice([]*int64{Long(1), nil, Long(3)}))
+	assert.Equal(t, []float64{1.5}, DoubleValueSlice(DoubleSlice([]float64{1.5})))
+
+	now := time.Now()
+	assert.Equal(t, []time.Time{now, {}}, TimeValueSlice([]*time.Time{Time(now), nil}))
+	assert.Empty(t, StringValueSlice(nil))
+}
+
+func TestMapConversions(t *testing.T) {
+	in := map[string]string{"a": "1", "b": ""}
+	out := StringMap(in)
+	assert.Len(t, out, 2)
+	for k, v := range in {
+		assert.Equal(t, v, *out[k])
+	}
+	assert.Equal(t, in, StringValueMap(out))
+	assert.Equal(t, map[string]string{"a": "1", "b": ""}, StringValueMap(map[string]*string{"a": String("1"), "b": nil}))
+
+	assert.Equal(t, map[string]bool{"a": true}, BooleanValueMap(BooleanMap(map[string]bool{"a": true})))
+	assert.Equal(t, map[string]int64{"a": 1, "b": 0}, LongValueMap(map[string]*int64{"a": Long(1), "b": nil}))
+	assert.Equal(t, map[string]float64{"a": 1.5}, DoubleValueMap(DoubleMap(map[string]float64{"a": 1.5})))
+
+	now := time.Now()
+	assert.Equal(t, map[string]time.Time{"a": now}, TimeValueMap(TimeMap(map[string]time.Time{"a": now})))
+}
@@ -1,12 +1,19 @@
 package credentials
 
 import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
 var (
 	// ErrNoValidProvidersFoundInChain Is returned when there are no valid
-	// providers in the ChainProvider.
+	// providers in the ChainProvider. If the ChainProvider has providers, the
+	// error returned has the same code, and the ProviderErrors of each
+	// provider as its original error.
 	//
 	// @readonly
 	ErrNoValidProvidersFoundInChain = awserr.New("NoCredentialProviders", "no valid providers in chain", nil)
@@ -20,7 +27,8 @@ var (
 // in the list.
 //
 // If none of the Providers retrieve valid credentials Value, ChainProvider's
-// Retrieve() will return the error ErrNoValidProvidersFoundInChain.
+// Retrieve() will return an error with the code of
+// ErrNoValidProvidersFoundInChain, listing why each of the Providers failed.
 //
 // If a Provider is found which returns valid credentials Value ChainProvider
 // will cache that Provider for all calls to IsExpired(), until Retrieve is
@@ -61,17 +69,43 @@ func NewChainCredentials(providers []Provider) *Credentials {
 // If a provider is found it will be cached and any calls to IsExpired()
 // will return the expired state of the cached provider.
 func (c *ChainProvider) Retrieve() (Value, error) {
+	return c.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext returns the credentials value or error if no provider
+// returned without error. The context is passed to each provider in the
+// chain which satisfies ProviderWithContext.
+//
+// Stops searching the chain if the context is canceled, returning the
+// context's error.
+func (c *ChainProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	var errs ProviderErrors
 	for _, p := range c.Providers {
-		if creds, err := p.Retrieve(); err == nil {
+		if err := ctx.Err(); err != nil {
+			c.curr = nil
+			return Value{}, err
+		}
+
+		var creds Value
+		var err error
+		if pc, ok := p.(ProviderWithContext); ok {
+			creds, err = pc.RetrieveWithContext(ctx)
+		} else {
+			creds, err = p.Retrieve()
+		}
+		if err == nil {
 			c.curr = p
 			return creds, nil
 		}
+		errs = append(errs, ProviderError{Provider: p, Err: err})
 	}
 	c.curr = nil
 
-	// TODO better error reporting. maybe report error for each failed retrieve?
-
-	return Value{}, ErrNoValidProvidersFoundInChain
+	if len(errs) == 0 {
+		return Value{}, ErrNoValidProvidersFoundInChain
+	}
+	return Value{}, awserr.New(ErrNoValidProvidersFoundInChain.Code(),
+		ErrNoValidProvidersFoundInChain.Message(), errs)
 }
 
 // IsExpired will returned the expired state of the currently cached provider
@@ -83,3 +117,48 @@ func (c *ChainProvider) IsExpired() bool {
 
 	return true
 }
+
+// ExpiresAt returns the time the credentials of the currently cached
+// provider expire at, or the zero time if the provider is not an Expirer.
+func (c *ChainProvider) ExpiresAt() time.Time {
+	if e, ok := c.curr.(Expirer); ok {
+		return e.ExpiresAt()
+	}
+	return time.Time{}
+}
+
+// A ProviderError is the error a provider of a ChainProvider failed with.
+type ProviderError struct {
+	Provider Provider
+	Err      error
+}
+
+// Error returns the name of the provider's type and its error.
+func (e ProviderError) Error() string {
+	return fmt.Sprintf("%s: %s", providerName(e.Provider), e.Err.Error())
+}
+
+// ProviderErrors are the errors of each provider of a ChainProvider, in the
+// order of the providers.
+type ProviderErrors []ProviderError
+
+// Error returns the error of each provider on a separate line.
+func (e ProviderErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = strings.Replace(err.Error(), "\n", "\n\t\t", -1)
+	}
+	return fmt.Sprintf("%d providers failed:\n\t%s", len(e), strings.Join(msgs, "\n\t"))
+}
+
+// providerName returns the name of the provider's type, e.g. "EnvProvider".
+func providerName(p Provider) string {
+	t := reflect.TypeOf(p)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() == "" {
+		return fmt.Sprintf("%T", p)
+	}
+	return t.Name()
+}
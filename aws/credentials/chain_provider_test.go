@@ -69,5 +69,16 @@ func TestChainProviderWithNoValidProvider(t *testing.T) {
 
 	assert.True(t, p.IsExpired(), "Expect expired with no providers")
 	_, err := p.Retrieve()
-	assert.Equal(t, ErrNoValidProvidersFoundInChain, err, "Expect no providers error returned")
+	assert.Equal(t, ErrNoValidProvidersFoundInChain.Code(), err.(awserr.Error).Code(), "Expect no providers error returned")
+
+	errs, ok := err.(awserr.Error).OrigErr().(ProviderErrors)
+	if assert.True(t, ok, "Expect provider errors") && assert.Len(t, errs, 2) {
+		assert.Equal(t, p.Providers[0], errs[0].Provider)
+		assert.Equal(t, "FirstError", errs[0].Err.(awserr.Error).Code())
+		assert.Equal(t, "SecondError", errs[1].Err.(awserr.Error).Code())
+	}
+	assert.Equal(t, `NoCredentialProviders: no valid providers in chain
+caused by: 2 providers failed:
+	stubProvider: FirstError: first provider error
+	stubProvider: SecondError: second provider error`, err.Error())
 }
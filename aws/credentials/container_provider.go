@@ -0,0 +1,140 @@
+package credentials
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// containerCredentialsHost is the host of the ECS agent's credentials
+// endpoint AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is relative to.
+const containerCredentialsHost = "http://169.254.170.2"
+
+// ContainerProviderName is the ProviderName of the credentials retrieved by
+// the ContainerProvider.
+const ContainerProviderName = "ContainerProvider"
+
+// ErrContainerCredentialsNotSet is returned by the ContainerProvider if
+// neither its Endpoint, nor the AWS_CONTAINER_CREDENTIALS_RELATIVE_URI and
+// AWS_CONTAINER_CREDENTIALS_FULL_URI environment variables are set.
+//
+// @readonly
+var ErrContainerCredentialsNotSet = awserr.New("ContainerCredentialsNotSet",
+	"container credentials endpoint is not set", nil)
+
+// A ContainerProvider retrieves the credentials of the task role from the
+// credentials endpoint of the ECS agent, or another container orchestrator,
+// with an EndpointProvider, and keeps track if those credentials are expired.
+//
+// The endpoint is the Endpoint if set. Otherwise it is read from the
+// AWS_CONTAINER_CREDENTIALS_RELATIVE_URI environment variable, which is
+// relative to the ECS agent's address 169.254.170.2, or the full URL in
+// AWS_CONTAINER_CREDENTIALS_FULL_URI. A full URL must use HTTPS, or be the
+// loopback address or the ECS agent's address, so a credentials vending
+// sidecar can plug into the default credentials chain by setting
+// AWS_CONTAINER_CREDENTIALS_FULL_URI to its loopback endpoint. The value of the
+// AWS_CONTAINER_AUTHORIZATION_TOKEN environment variable is sent as the
+// Authorization header if the AuthorizationToken is not set.
+type ContainerProvider struct {
+	Expiry
+
+	// Endpoint must be fully quantified URL
+	Endpoint string
+
+	// The Authorization header sent to the Endpoint
+	AuthorizationToken string
+
+	// HTTP client to use when connecting to the credentials endpoint
+	Client *http.Client
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring, see EC2RoleProvider.ExpiryWindow.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewContainerCredentials returns a pointer to a new Credentials object
+// wrapping the ContainerProvider, retrieving credentials from the endpoint.
+func NewContainerCredentials(client *http.Client, endpoint string, window time.Duration) *Credentials {
+	return NewCredentials(&ContainerProvider{
+		Endpoint:     endpoint,
+		Client:       client,
+		ExpiryWindow: window,
+	})
+}
+
+// Retrieve retrieves credentials from the container credentials endpoint.
+// Error will be returned if the request fails, or unable to extract
+// the desired credentials.
+func (p *ContainerProvider) Retrieve() (Value, error) {
+	return p.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials from the container credentials
+// endpoint. The request is canceled if the context is canceled.
+func (p *ContainerProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	endpoint, err := p.endpoint()
+	if err != nil {
+		return Value{}, err
+	}
+	token := p.AuthorizationToken
+	if token == "" {
+		token = os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN")
+	}
+
+	ep := &EndpointProvider{
+		Endpoint:           endpoint,
+		AuthorizationToken: token,
+		Client:             p.Client,
+		ExpiryWindow:       p.ExpiryWindow,
+	}
+	creds, err := ep.RetrieveWithContext(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	p.SetExpiration(ep.expiration, 0)
+	creds.ProviderName = ContainerProviderName
+
+	return creds, nil
+}
+
+// endpoint returns the credentials endpoint of the provider, or from the
+// environment if not set.
+func (p *ContainerProvider) endpoint() (string, error) {
+	if p.Endpoint != "" {
+		return p.Endpoint, nil
+	}
+	if rel := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); rel != "" {
+		return containerCredentialsHost + rel, nil
+	}
+
+	full := os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+	if full == "" {
+		return "", ErrContainerCredentialsNotSet
+	}
+	u, err := url.Parse(full)
+	if err != nil {
+		return "", awserr.New("ContainerCredentialsEndpointError",
+			"invalid AWS_CONTAINER_CREDENTIALS_FULL_URI "+full, err)
+	}
+	if u.Scheme != "https" && !isContainerCredentialsHost(u.Hostname()) {
+		return "", awserr.New("ContainerCredentialsEndpointError",
+			"AWS_CONTAINER_CREDENTIALS_FULL_URI must use HTTPS, or a loopback or the ECS agent's host, "+full, nil)
+	}
+	return full, nil
+}
+
+// isContainerCredentialsHost returns true if the host may serve credentials
+// over plain HTTP.
+func isContainerCredentialsHost(host string) bool {
+	if host == "localhost" || host == "169.254.170.2" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,99 @@
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func initContainerTestServer(expireOn string, auth *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*auth = r.Header.Get("Authorization")
+		if r.URL.Path != "/creds" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code": "NotFound", "message": "unknown path"}`)
+			return
+		}
+		fmt.Fprintf(w, `{
+  "AccessKeyId" : "accessKey",
+  "SecretAccessKey" : "secret",
+  "Token" : "token",
+  "Expiration" : "%s"
+}`, expireOn)
+	}))
+}
+
+func TestContainerProvider(t *testing.T) {
+	var auth string
+	server := initContainerTestServer("2014-12-16T01:51:37Z", &auth)
+	defer server.Close()
+
+	p := &ContainerProvider{Endpoint: server.URL + "/creds", AuthorizationToken: "authToken"}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+	assert.Equal(t, "authToken", auth)
+	assert.True(t, p.IsExpired(), "Expect creds to be expired")
+}
+
+func TestContainerProviderEnv(t *testing.T) {
+	var auth string
+	server := initContainerTestServer(time.Now().Add(time.Hour).UTC().Format(time.RFC3339), &auth)
+	defer server.Close()
+
+	os.Clearenv()
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/creds")
+	os.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN", "envToken")
+	defer os.Clearenv()
+
+	p := &ContainerProvider{}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", creds.AccessKeyID)
+	assert.Equal(t, "envToken", auth)
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired")
+}
+
+func TestContainerProviderEndpointErrors(t *testing.T) {
+	os.Clearenv()
+	defer os.Clearenv()
+
+	_, err := (&ContainerProvider{}).Retrieve()
+	assert.Equal(t, ErrContainerCredentialsNotSet, err)
+
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", "http://example.com/creds")
+	_, err = (&ContainerProvider{}).Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, "ContainerCredentialsEndpointError", err.(awserr.Error).Code())
+	}
+
+	os.Clearenv()
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "/v2/credentials/id")
+	p := &ContainerProvider{}
+	endpoint, err := p.endpoint()
+	assert.NoError(t, err)
+	assert.Equal(t, "http://169.254.170.2/v2/credentials/id", endpoint)
+}
+
+func TestContainerProviderErrorResponse(t *testing.T) {
+	var auth string
+	server := initContainerTestServer("2014-12-16T01:51:37Z", &auth)
+	defer server.Close()
+
+	p := &ContainerProvider{Endpoint: server.URL + "/unknown"}
+	_, err := p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeCredentialsEndpoint, err.(awserr.Error).Code())
+		assert.Contains(t, err.Error(), "NotFound: unknown path")
+	}
+}
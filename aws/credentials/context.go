@@ -0,0 +1,37 @@
+package credentials
+
+import (
+	"context"
+	"time"
+)
+
+// Context is an alias of the standard library's context.Context, used when
+// retrieving credentials so the retrieval can be canceled.
+type Context = context.Context
+
+// backgroundContext returns a context that will never be canceled.
+func backgroundContext() Context {
+	return context.Background()
+}
+
+// A suppressedContext has the values of its Context, but is never canceled
+// and has no deadline, so a retrieval shared by concurrent calls is not
+// canceled with the call which started it.
+type suppressedContext struct {
+	Context
+}
+
+// Deadline returns that the context has no deadline.
+func (suppressedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done returns nil, the context is never canceled.
+func (suppressedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err returns nil, the context is never canceled.
+func (suppressedContext) Err() error {
+	return nil
+}
@@ -49,6 +49,7 @@
 package credentials
 
 import (
+	"math/rand"
 	"sync"
 	"time"
 )
@@ -76,6 +77,16 @@ type Value struct {
 
 	// AWS Session Token
 	SessionToken string
+
+	// The name of the Provider which retrieved the credentials, e.g.
+	// EnvProviderName, so it can be logged which credentials are used.
+	ProviderName string
+
+	// Set by Credentials if the credentials expire at Expires. Credentials
+	// which cannot expire, e.g. of the environment or the shared credentials
+	// file, are only retrieved once.
+	CanExpire bool
+	Expires   time.Time
 }
 
 // A Provider is the interface for any component which will provide credentials
@@ -94,6 +105,19 @@ type Provider interface {
 	IsExpired() bool
 }
 
+// A ProviderWithContext is a Provider that can retrieve credentials with a
+// Context. Credentials will use RetrieveWithContext instead of Retrieve for
+// providers which satisfy this interface, so that the retrieval is canceled
+// along with the context.
+type ProviderWithContext interface {
+	Provider
+
+	// RetrieveWithContext returns nil if it successfully retrieved the value.
+	// Error is returned if the value were not obtainable, empty, or the
+	// context was canceled.
+	RetrieveWithContext(Context) (Value, error)
+}
+
 // A Expiry provides shared expiration logic to be used by credentials
 // providers to implement expiry functionality.
 //
@@ -113,8 +137,28 @@ type Expiry struct {
 	// Defaults to time.Now if CurrentTime is not set.  Available for testing
 	// to be able to mock out the current time.
 	CurrentTime func() time.Time
+
+	// If greater than 0 the window the expiration is set with is at least
+	// this fraction of the credentials' remaining lifetime. For example a
+	// WindowFraction of 0.25 refreshes credentials valid for an hour 15
+	// minutes before they expire, and credentials valid for 6 hours 90
+	// minutes before they expire.
+	WindowFraction float64
+
+	// If greater than 0 the window the expiration is set with is reduced by
+	// a random fraction of the window of up to WindowJitterFraction, so a
+	// fleet of hosts which retrieved their credentials at the same time
+	// does not refresh them at the same time, and overload STS or the EC2
+	// metadata service. For example a WindowJitterFraction of 0.5 with a
+	// window of 10 minutes refreshes the credentials between 5 and 10
+	// minutes before they expire.
+	WindowJitterFraction float64
 }
 
+// randFloat64 returns the random fraction the window is reduced by.
+// Replaceable for testing.
+var randFloat64 = rand.Float64
+
 // SetExpiration sets the expiration IsExpired will check when called.
 //
 // If window is greater than 0 the expiration time will be reduced by the
@@ -123,13 +167,41 @@ type Expiry struct {
 // Using a window is helpful to trigger credentials to expire sooner than
 // the expiration time given to ensure no requests are made with expired
 // tokens.
+//
+// The window is adjusted by the Expiry's WindowFraction and
+// WindowJitterFraction if set.
 func (e *Expiry) SetExpiration(expiration time.Time, window time.Duration) {
+	if e.CurrentTime == nil {
+		e.CurrentTime = time.Now
+	}
+	if e.WindowFraction > 0 {
+		if w := time.Duration(e.WindowFraction * float64(expiration.Sub(e.CurrentTime()))); w > window {
+			window = w
+		}
+	}
+	if e.WindowJitterFraction > 0 {
+		window -= time.Duration(randFloat64() * e.WindowJitterFraction * float64(window))
+	}
+
 	e.expiration = expiration
 	if window > 0 {
 		e.expiration = e.expiration.Add(-window)
 	}
 }
 
+// An Expirer is a Provider which can tell when its credentials expire.
+// Providers which embed Expiry are Expirers.
+type Expirer interface {
+	// ExpiresAt returns the time the last retrieved credentials expire at.
+	ExpiresAt() time.Time
+}
+
+// ExpiresAt returns the time the credentials expire at, with the window the
+// expiration was set with subtracted.
+func (e *Expiry) ExpiresAt() time.Time {
+	return e.expiration
+}
+
 // IsExpired returns if the credentials are expired.
 func (e *Expiry) IsExpired() bool {
 	if e.CurrentTime == nil {
@@ -149,14 +221,41 @@ func (e *Expiry) IsExpired() bool {
 // The first Credentials.Get() will always call Provider.Retrieve() to get the
 // first instance of the credentials Value. All calls to Get() after that
 // will return the cached credentials Value until IsExpired() returns true.
+//
+// Only one call to Get() at a time retrieves expired credentials from the
+// Provider. Concurrent calls wait for, and share the result of, that
+// retrieval, so a burst of requests made when the credentials expire causes
+// a single request to STS or the EC2 metadata service.
 type Credentials struct {
 	creds        Value
 	forceRefresh bool
 	m            sync.Mutex
 
+	// The in-flight retrieval of the credentials, or nil.
+	refresh *refreshCall
+
+	// Called with the credentials each time they are retrieved.
+	onRefresh []func(Value)
+
 	provider Provider
 }
 
+// A refreshCall is a retrieval of the credentials shared by concurrent calls
+// to Get.
+type refreshCall struct {
+	// Closed when the retrieval is done.
+	done chan struct{}
+
+	// Closed once the OnRefresh functions were called with the credentials.
+	notified chan struct{}
+
+	creds Value
+	err   error
+
+	// Set if Expire was called while the credentials were retrieved.
+	expired bool
+}
+
 // NewCredentials returns a pointer to a new Credentials with the provider set.
 func NewCredentials(provider Provider) *Credentials {
 	return &Credentials{
@@ -175,19 +274,120 @@ func NewCredentials(provider Provider) *Credentials {
 // If Credentials.Expire() was called the credentials Value will be force
 // expired, and the next call to Get() will cause them to be refreshed.
 func (c *Credentials) Get() (Value, error) {
+	return c.GetWithContext(backgroundContext())
+}
+
+// GetWithContext returns the credentials value, or error if the credentials
+// Value failed to be retrieved, or the context was canceled.
+//
+// Behaves the same as Get, but if the Provider satisfies ProviderWithContext
+// the context will be passed to the Provider's RetrieveWithContext. The
+// retrieval is shared by concurrent calls, so the Provider is passed the
+// values of the context of the call which started it, but not its
+// cancellation or deadline: a canceled call does not fail the other calls
+// waiting for the retrieval. Each call stops waiting, returning the context's
+// error, once its own context is canceled.
+func (c *Credentials) GetWithContext(ctx Context) (Value, error) {
 	c.m.Lock()
-	defer c.m.Unlock()
+	if c.refresh == nil && !c.isExpired() {
+		creds := c.creds
+		c.m.Unlock()
+		return creds, nil
+	}
+	if err := ctx.Err(); err != nil {
+		c.m.Unlock()
+		return Value{}, err
+	}
+
+	call := c.refresh
+	started := call == nil
+	if started {
+		call = &refreshCall{done: make(chan struct{}), notified: make(chan struct{})}
+		c.refresh = call
+		go c.refreshCredentials(suppressedContext{ctx}, call)
+	}
+	c.m.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return Value{}, ctx.Err()
+	}
 
-	if c.isExpired() {
-		creds, err := c.provider.Retrieve()
-		if err != nil {
-			return Value{}, err
+	// The call which started the retrieval returns once the OnRefresh
+	// functions were called.
+	if started {
+		select {
+		case <-call.notified:
+		case <-ctx.Done():
 		}
-		c.creds = creds
-		c.forceRefresh = false
 	}
+	return call.creds, call.err
+}
+
+// refreshCredentials retrieves the credentials of the refresh call, and
+// caches them if they were retrieved.
+func (c *Credentials) refreshCredentials(ctx Context, call *refreshCall) {
+	call.creds, call.err = c.retrieve(ctx)
 
-	return c.creds, nil
+	c.m.Lock()
+	if call.err == nil {
+		c.creds = call.creds
+		c.forceRefresh = call.expired
+	}
+	c.refresh = nil
+	onRefresh := c.onRefresh
+	c.m.Unlock()
+	close(call.done)
+
+	if call.err == nil {
+		for _, fn := range onRefresh {
+			fn(call.creds)
+		}
+	}
+	close(call.notified)
+}
+
+// retrieve retrieves the credentials from the provider, passing ctx along
+// if the provider supports it.
+func (c *Credentials) retrieve(ctx Context) (Value, error) {
+	var v Value
+	var err error
+	if p, ok := c.provider.(ProviderWithContext); ok {
+		v, err = p.RetrieveWithContext(ctx)
+	} else {
+		v, err = c.provider.Retrieve()
+	}
+	if err != nil {
+		return v, err
+	}
+
+	if e, ok := c.provider.(Expirer); ok && !e.ExpiresAt().IsZero() {
+		v.CanExpire = true
+		v.Expires = e.ExpiresAt()
+	}
+	return v, nil
+}
+
+// OnRefresh adds fn to the functions called with the credentials each time
+// they are retrieved from the Provider, e.g. to log the rotation of the
+// credentials, or update systems authenticated with them.
+//
+// The functions are called once the credentials are retrieved, after the
+// credentials are returned to concurrent calls waiting for them, and before
+// the Get which started the retrieval returns, unless its context is
+// canceled. The
+// functions may call Get, which returns the new credentials unless they have
+// expired again.
+//
+//     creds.OnRefresh(func(v credentials.Value) {
+//         log.Printf("rotated credentials, access key ID %s", v.AccessKeyID)
+//     })
+func (c *Credentials) OnRefresh(fn func(Value)) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.onRefresh = append(c.onRefresh, fn)
 }
 
 // Expire expires the credentials and forces them to be retrieved on the
@@ -200,6 +400,9 @@ func (c *Credentials) Expire() {
 	defer c.m.Unlock()
 
 	c.forceRefresh = true
+	if c.refresh != nil {
+		c.refresh.expired = true
+	}
 }
 
 // IsExpired returns if the credentials are no longer valid, and need
@@ -215,6 +418,8 @@ func (c *Credentials) IsExpired() bool {
 }
 
 // isExpired helper method wrapping the definition of expired credentials.
+// The credentials are expired while they are retrieved, and the Provider is
+// not asked, since it is only safe to use from one goroutine at a time.
 func (c *Credentials) isExpired() bool {
-	return c.forceRefresh || c.provider.IsExpired()
+	return c.forceRefresh || c.refresh != nil || c.provider.IsExpired()
 }
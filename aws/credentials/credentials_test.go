@@ -1,7 +1,14 @@
 package credentials
 
 import (
+	"context"
+	"errors"
+	"math/rand"
+	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
@@ -60,3 +67,220 @@ func TestCredentialsExpire(t *testing.T) {
 	stub.expired = true
 	assert.True(t, c.IsExpired(), "Expected to be expired")
 }
+
+type blockingProvider struct {
+	retrieves int32
+	release   chan struct{}
+	expired   bool
+}
+
+func (p *blockingProvider) Retrieve() (Value, error) {
+	atomic.AddInt32(&p.retrieves, 1)
+	<-p.release
+	p.expired = false
+	return Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+}
+func (p *blockingProvider) IsExpired() bool {
+	return p.expired
+}
+
+func TestCredentialsGetSingleRetrieve(t *testing.T) {
+	p := &blockingProvider{release: make(chan struct{}), expired: true}
+	c := NewCredentials(p)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			creds, err := c.Get()
+			if err == nil && creds.AccessKeyID != "AKID" {
+				err = errors.New("unexpected access key ID " + creds.AccessKeyID)
+			}
+			errs <- err
+		}()
+	}
+
+	// Wait for the retrieval to start, and let the other calls queue up.
+	for atomic.LoadInt32(&p.retrieves) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.True(t, c.IsExpired(), "Expected to be expired while retrieving")
+	time.Sleep(10 * time.Millisecond)
+	close(p.release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&p.retrieves), "Expected only one retrieve")
+	assert.False(t, c.IsExpired(), "Expected not to be expired")
+}
+
+func TestCredentialsGetWaitCanceled(t *testing.T) {
+	p := &blockingProvider{release: make(chan struct{}), expired: true}
+	c := NewCredentials(p)
+
+	go c.Get()
+	for atomic.LoadInt32(&p.retrieves) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := c.GetWithContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	close(p.release)
+	creds, err := c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID", creds.AccessKeyID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&p.retrieves), "Expected only one retrieve")
+}
+
+type contextKey string
+
+// contextBlockingProvider is a blockingProvider retrieving with a context,
+// recording the context's error and test value once released.
+type contextBlockingProvider struct {
+	blockingProvider
+	ctxErr   error
+	ctxValue interface{}
+}
+
+func (p *contextBlockingProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	v, err := p.blockingProvider.Retrieve()
+	p.ctxErr, p.ctxValue = ctx.Err(), ctx.Value(contextKey("test"))
+	return v, err
+}
+
+func TestCredentialsGetLeaderCanceled(t *testing.T) {
+	p := &contextBlockingProvider{blockingProvider: blockingProvider{release: make(chan struct{}), expired: true}}
+	c := NewCredentials(p)
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), contextKey("test"), "value"))
+	leaderErr := make(chan error)
+	go func() {
+		_, err := c.GetWithContext(ctx)
+		leaderErr <- err
+	}()
+	for atomic.LoadInt32(&p.retrieves) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	type result struct {
+		creds Value
+		err   error
+	}
+	waiter := make(chan result)
+	go func() {
+		creds, err := c.GetWithContext(context.Background())
+		waiter <- result{creds, err}
+	}()
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-leaderErr)
+
+	close(p.release)
+	res := <-waiter
+	assert.NoError(t, res.err, "Expect the waiter not to fail with the leader's context")
+	assert.Equal(t, "AKID", res.creds.AccessKeyID)
+	assert.NoError(t, p.ctxErr, "Expect the retrieval not to be canceled with the leader")
+	assert.Equal(t, "value", p.ctxValue, "Expect the retrieval to have the leader's context values")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&p.retrieves), "Expected only one retrieve")
+}
+
+func TestCredentialsExpireWhileRetrieving(t *testing.T) {
+	p := &blockingProvider{release: make(chan struct{}), expired: true}
+	c := NewCredentials(p)
+
+	done := make(chan struct{})
+	go func() {
+		c.Get()
+		close(done)
+	}()
+	for atomic.LoadInt32(&p.retrieves) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	c.Expire()
+	close(p.release)
+	<-done
+	assert.True(t, c.IsExpired(), "Expected Expire to not be lost")
+}
+
+func TestExpiryWindowFraction(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := Expiry{CurrentTime: func() time.Time { return now }, WindowFraction: 0.25}
+
+	e.SetExpiration(now.Add(time.Hour), 5*time.Minute)
+	assert.Equal(t, now.Add(45*time.Minute), e.ExpiresAt(), "Expect the fraction of the lifetime to be the window")
+
+	e.SetExpiration(now.Add(10*time.Minute), 5*time.Minute)
+	assert.Equal(t, now.Add(5*time.Minute), e.ExpiresAt(), "Expect the larger window to be used")
+}
+
+func TestExpiryWindowJitterFraction(t *testing.T) {
+	defer func() { randFloat64 = rand.Float64 }()
+	randFloat64 = func() float64 { return 0.5 }
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := Expiry{CurrentTime: func() time.Time { return now }, WindowFraction: 0.25, WindowJitterFraction: 0.2}
+
+	e.SetExpiration(now.Add(time.Hour), 0)
+	assert.Equal(t, now.Add(45*time.Minute+90*time.Second), e.ExpiresAt(), "Expect the window to be reduced by the jitter")
+
+	e.WindowFraction = 0
+	e.SetExpiration(now.Add(time.Hour), 0)
+	assert.Equal(t, now.Add(time.Hour), e.ExpiresAt(), "Expect no jitter without a window")
+}
+
+func TestCredentialsOnRefresh(t *testing.T) {
+	stub := &stubProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, expired: true}
+	c := NewCredentials(stub)
+
+	var refreshed []Value
+	c.OnRefresh(func(v Value) {
+		creds, err := c.Get()
+		assert.Nil(t, err, "Expect no error")
+		assert.Equal(t, v, creds, "Expect Get to return the new credentials")
+		refreshed = append(refreshed, v)
+	})
+
+	c.Get()
+	c.Get()
+	assert.Equal(t, []Value{stub.creds}, refreshed, "Expect one refresh")
+
+	stub.creds.AccessKeyID = "AKID2"
+	c.Expire()
+	c.Get()
+	assert.Equal(t, []Value{{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, stub.creds}, refreshed, "Expect a refresh after expiring")
+
+	stub.err = errors.New("failed")
+	c.Expire()
+	c.Get()
+	assert.Len(t, refreshed, 2, "Expect no refresh when the retrieval fails")
+}
+
+func TestCredentialsGetMetadata(t *testing.T) {
+	c := NewStaticCredentials("AKID", "SECRET", "")
+	creds, err := c.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, StaticProviderName, creds.ProviderName, "Expect provider name to match")
+	assert.False(t, creds.CanExpire, "Expect static credentials to not expire")
+	assert.True(t, creds.Expires.IsZero(), "Expect no expiration")
+
+	p := &expiringProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}}
+	creds, err = NewCredentials(p).Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.True(t, creds.CanExpire, "Expect credentials to expire")
+	assert.Equal(t, time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), creds.Expires, "Expect expiration to match")
+
+	os.Clearenv()
+	creds, err = NewChainCredentials([]Provider{&EnvProvider{}, p}).Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.True(t, creds.CanExpire, "Expect credentials of the chain's provider to expire")
+	assert.Equal(t, time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), creds.Expires, "Expect expiration to match")
+}
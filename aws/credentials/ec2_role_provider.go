@@ -2,15 +2,44 @@ package credentials
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
-const metadataCredentialsEndpoint = "http://169.254.169.254/latest/meta-data/iam/security-credentials/"
+// EC2RoleProviderName is the ProviderName of the credentials retrieved by the
+// EC2RoleProvider.
+const EC2RoleProviderName = "EC2RoleProvider"
+
+const (
+	metadataServiceEndpoint     = "http://169.254.169.254"
+	metadataCredentialsEndpoint = "/latest/meta-data/iam/security-credentials/"
+)
+
+// DefaultEC2RoleProviderTimeout is the time each request of an
+// EC2RoleProvider may take when the EC2RoleProvider's Timeout is not set.
+// The metadata service responds quickly on EC2 instances, so a short timeout
+// limits the delay on hosts which are not EC2 instances.
+const DefaultEC2RoleProviderTimeout = time.Second
+
+// DefaultEC2RoleProviderMaxAttempts is the number of times an EC2RoleProvider
+// attempts each request when the EC2RoleProvider's MaxAttempts is not set.
+const DefaultEC2RoleProviderMaxAttempts = 2
+
+// ErrEC2MetadataDisabled is returned by the EC2RoleProvider if the EC2
+// metadata service has been disabled by setting the environment variable
+// "AWS_EC2_METADATA_DISABLED" to "true".
+//
+// @readonly
+var ErrEC2MetadataDisabled = awserr.New("EC2MetadataDisabled",
+	"EC2 metadata service is disabled by the AWS_EC2_METADATA_DISABLED environment variable", nil)
 
 // A EC2RoleProvider retrieves credentials from the EC2 service, and keeps track if
 // those credentials are expired.
@@ -27,6 +56,9 @@ const metadataCredentialsEndpoint = "http://169.254.169.254/latest/meta-data/iam
 //         // Use default EC2 Role metadata endpoint, Alternate endpoints can be
 //         // specified setting Endpoint to something else.
 //         Endpoint: "",
+//         // Give up on each request after 500ms, and attempt it once.
+//         Timeout: 500 * time.Millisecond,
+//         MaxAttempts: 1,
 //         // Do not use early expiry of credentials. If a non zero value is
 //         // specified the credentials will be expired early
 //         ExpiryWindow: 0,
@@ -34,12 +66,24 @@ const metadataCredentialsEndpoint = "http://169.254.169.254/latest/meta-data/iam
 type EC2RoleProvider struct {
 	Expiry
 
-	// Endpoint must be fully quantified URL
+	// Endpoint must be fully quantified URL. Defaults to the credentials path
+	// of the metadata service at the AWS_EC2_METADATA_SERVICE_ENDPOINT
+	// environment variable's URL, or http://169.254.169.254 if not set.
 	Endpoint string
 
 	// HTTP client to use when connecting to EC2 service
 	Client *http.Client
 
+	// The time each request to the EC2 service may take. Defaults to
+	// DefaultEC2RoleProviderTimeout if not set.
+	Timeout time.Duration
+
+	// The number of times each request to the EC2 service is attempted
+	// before giving up. Requests are retried when they fail to connect, time
+	// out, or fail with a 5xx status code. Defaults to
+	// DefaultEC2RoleProviderMaxAttempts if not set.
+	MaxAttempts int
+
 	// ExpiryWindow will allow the credentials to trigger refreshing prior to
 	// the credentials actually expiring. This is beneficial so race conditions
 	// with expiring credentials do not cause request to fail unexpectedly
@@ -47,6 +91,9 @@ type EC2RoleProvider struct {
 	//
 	// So a ExpiryWindow of 10s would cause calls to IsExpired() to return true
 	// 10 seconds before the credentials are actually expired.
+	// The window can also be a fraction of the credentials' lifetime, with
+	// random jitter, set by the Expiry's WindowFraction and
+	// WindowJitterFraction.
 	//
 	// If ExpiryWindow is 0 or less it will be ignored.
 	ExpiryWindow time.Duration
@@ -76,14 +123,28 @@ func NewEC2RoleCredentials(client *http.Client, endpoint string, window time.Dur
 // Error will be returned if the request fails, or unable to extract
 // the desired credentials.
 func (m *EC2RoleProvider) Retrieve() (Value, error) {
+	return m.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials from the EC2 service. The
+// requests made to the EC2 service will be canceled if the context is
+// canceled.
+func (m *EC2RoleProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	if strings.EqualFold(os.Getenv("AWS_EC2_METADATA_DISABLED"), "true") {
+		return Value{}, ErrEC2MetadataDisabled
+	}
 	if m.Client == nil {
 		m.Client = http.DefaultClient
 	}
 	if m.Endpoint == "" {
-		m.Endpoint = metadataCredentialsEndpoint
+		endpoint := os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT")
+		if endpoint == "" {
+			endpoint = metadataServiceEndpoint
+		}
+		m.Endpoint = strings.TrimRight(endpoint, "/") + metadataCredentialsEndpoint
 	}
 
-	credsList, err := requestCredList(m.Client, m.Endpoint)
+	credsList, err := m.requestCredList(ctx)
 	if err != nil {
 		return Value{}, err
 	}
@@ -93,7 +154,7 @@ func (m *EC2RoleProvider) Retrieve() (Value, error) {
 	}
 	credsName := credsList[0]
 
-	roleCreds, err := requestCred(m.Client, m.Endpoint, credsName)
+	roleCreds, err := m.requestCred(ctx, credsName)
 	if err != nil {
 		return Value{}, err
 	}
@@ -104,6 +165,7 @@ func (m *EC2RoleProvider) Retrieve() (Value, error) {
 		AccessKeyID:     roleCreds.AccessKeyID,
 		SecretAccessKey: roleCreds.SecretAccessKey,
 		SessionToken:    roleCreds.Token,
+		ProviderName:    EC2RoleProviderName,
 	}, nil
 }
 
@@ -118,8 +180,8 @@ type ec2RoleCredRespBody struct {
 
 // requestCredList requests a list of credentials from the EC2 service.
 // If there are no credentials, or there is an error making or receiving the request
-func requestCredList(client *http.Client, endpoint string) ([]string, error) {
-	resp, err := client.Get(endpoint)
+func (m *EC2RoleProvider) requestCredList(ctx Context) ([]string, error) {
+	resp, err := m.get(ctx, m.Endpoint)
 	if err != nil {
 		return nil, awserr.New("ListEC2Role", "failed to list EC2 Roles", err)
 	}
@@ -142,8 +204,8 @@ func requestCredList(client *http.Client, endpoint string) ([]string, error) {
 //
 // If the credentials cannot be found, or there is an error reading the response
 // and error will be returned.
-func requestCred(client *http.Client, endpoint, credsName string) (*ec2RoleCredRespBody, error) {
-	resp, err := client.Get(endpoint + credsName)
+func (m *EC2RoleProvider) requestCred(ctx Context, credsName string) (*ec2RoleCredRespBody, error) {
+	resp, err := m.get(ctx, m.Endpoint+credsName)
 	if err != nil {
 		return nil, awserr.New("GetEC2RoleCredentials",
 			fmt.Sprintf("failed to get %s EC2 Role credentials", credsName),
@@ -160,3 +222,65 @@ func requestCred(client *http.Client, endpoint, credsName string) (*ec2RoleCredR
 
 	return respCreds, nil
 }
+
+// get issues a GET request to the url, attempting it up to MaxAttempts times
+// with a timeout of Timeout each. The request is canceled if the context is
+// canceled.
+func (m *EC2RoleProvider) get(ctx Context, url string) (*http.Response, error) {
+	timeout, attempts := m.Timeout, m.MaxAttempts
+	if timeout <= 0 {
+		timeout = DefaultEC2RoleProviderTimeout
+	}
+	if attempts <= 0 {
+		attempts = DefaultEC2RoleProviderMaxAttempts
+	}
+
+	var err error
+	for i := 0; i < attempts && ctx.Err() == nil; i++ {
+		var resp *http.Response
+		var retry bool
+		if resp, retry, err = getWithTimeout(ctx, m.Client, url, timeout); err == nil || !retry {
+			return resp, err
+		}
+	}
+	return nil, err
+}
+
+// getWithTimeout issues a GET request to the url using the client, which
+// fails if it takes longer than timeout, or responds with a status other than
+// 200. The request is canceled if the context is canceled. Returns if a
+// failed request should be retried.
+func getWithTimeout(ctx Context, client *http.Client, url string, timeout time.Duration) (*http.Response, bool, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, true, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		cancel()
+		return nil, resp.StatusCode >= 500, fmt.Errorf("status code %d", resp.StatusCode)
+	}
+
+	// Cancel the timeout once the body has been read.
+	resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+	return resp, false, nil
+}
+
+// A cancelReadCloser cancels its context when closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
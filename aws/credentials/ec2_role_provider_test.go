@@ -5,6 +5,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -40,6 +42,19 @@ func TestEC2RoleProvider(t *testing.T) {
 	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
 }
 
+func TestEC2RoleProviderMetadataDisabled(t *testing.T) {
+	server := initTestServer("2014-12-16T01:51:37Z")
+	defer server.Close()
+
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	defer os.Unsetenv("AWS_EC2_METADATA_DISABLED")
+
+	p := &EC2RoleProvider{Client: http.DefaultClient, Endpoint: server.URL}
+
+	_, err := p.Retrieve()
+	assert.Equal(t, ErrEC2MetadataDisabled, err, "Expect metadata disabled error")
+}
+
 func TestEC2RoleProviderIsExpired(t *testing.T) {
 	server := initTestServer("2014-12-16T01:51:37Z")
 	defer server.Close()
@@ -106,3 +121,79 @@ func BenchmarkEC2RoleProvider(b *testing.B) {
 		}
 	})
 }
+
+func TestEC2RoleProviderServiceEndpointEnv(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest/meta-data/iam/security-credentials/":
+			fmt.Fprintln(w, "role")
+		case "/latest/meta-data/iam/security-credentials/role":
+			fmt.Fprint(w, `{"AccessKeyId": "accessKey", "SecretAccessKey": "secret", "Token": "token", "Expiration": "2014-12-16T01:51:37Z"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	os.Clearenv()
+	os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL)
+	defer os.Clearenv()
+
+	p := &EC2RoleProvider{}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, server.URL+"/latest/meta-data/iam/security-credentials/", p.Endpoint)
+}
+
+func TestEC2RoleProviderTimeout(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	p := &EC2RoleProvider{Client: http.DefaultClient, Endpoint: server.URL, Timeout: 50 * time.Millisecond, MaxAttempts: 3}
+
+	start := time.Now()
+	_, err := p.Retrieve()
+	assert.Error(t, err, "Expect error")
+	assert.True(t, time.Since(start) < 2*time.Second, "Expect requests to time out")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts), "Expect each attempt to be made")
+}
+
+func TestEC2RoleProviderRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if n := atomic.AddInt32(&attempts, 1); r.URL.Path == "/" && n == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Path == "/" {
+			fmt.Fprintln(w, "creds")
+			return
+		}
+		fmt.Fprint(w, `{"AccessKeyId": "accessKey", "SecretAccessKey": "secret", "Token": "token", "Expiration": "2014-12-16T01:51:37Z"}`)
+	}))
+	defer server.Close()
+
+	p := &EC2RoleProvider{Client: http.DefaultClient, Endpoint: server.URL + "/"}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect 5xx to be retried")
+	assert.Equal(t, "accessKey", creds.AccessKeyID)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+
+	atomic.StoreInt32(&attempts, 0)
+	p = &EC2RoleProvider{Client: http.DefaultClient, Endpoint: server.URL + "/missing"}
+	_, err = p.Retrieve()
+	assert.Error(t, err, "Expect error")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts), "Expect 4xx to not be retried")
+}
@@ -0,0 +1,146 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// EndpointProviderName is the ProviderName of the credentials retrieved by the
+// EndpointProvider.
+const EndpointProviderName = "EndpointProvider"
+
+// ErrCodeCredentialsEndpoint is the error code returned when the credentials
+// cannot be retrieved from the endpoint of an EndpointProvider.
+const ErrCodeCredentialsEndpoint = "CredentialsEndpointError"
+
+// An EndpointProvider retrieves credentials from an HTTP endpoint, e.g. a
+// credentials vending sidecar, and keeps track if those credentials are
+// expired.
+//
+// The endpoint is sent a GET request, with the AuthorizationToken as the
+// Authorization header if set, and must respond with a JSON document in the
+// format:
+//
+//     {
+//         "AccessKeyId": "AKID",
+//         "SecretAccessKey": "SECRET",
+//         "Token": "TOKEN",
+//         "Expiration": "2020-01-01T00:00:00Z"
+//     }
+//
+// An error response may describe the error in the format:
+//
+//     {
+//         "code": "ErrorCode",
+//         "message": "Helpful error message."
+//     }
+type EndpointProvider struct {
+	Expiry
+
+	// Endpoint must be fully quantified URL
+	Endpoint string
+
+	// The Authorization header sent to the Endpoint, not sent if empty
+	AuthorizationToken string
+
+	// HTTP client to use when connecting to the Endpoint
+	Client *http.Client
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring, see EC2RoleProvider.ExpiryWindow.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewEndpointCredentials returns a pointer to a new Credentials object
+// wrapping the EndpointProvider, retrieving credentials from the endpoint
+// with the authorization token.
+func NewEndpointCredentials(client *http.Client, endpoint, token string, window time.Duration) *Credentials {
+	return NewCredentials(&EndpointProvider{
+		Endpoint:           endpoint,
+		AuthorizationToken: token,
+		Client:             client,
+		ExpiryWindow:       window,
+	})
+}
+
+// Retrieve retrieves credentials from the endpoint.
+// Error will be returned if the request fails, or unable to extract
+// the desired credentials.
+func (p *EndpointProvider) Retrieve() (Value, error) {
+	return p.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials from the endpoint. The request is
+// canceled if the context is canceled.
+func (p *EndpointProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequest("GET", p.Endpoint, nil)
+	if err != nil {
+		return Value{}, awserr.New(ErrCodeCredentialsEndpoint,
+			"invalid credentials endpoint "+p.Endpoint, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.AuthorizationToken != "" {
+		req.Header.Set("Authorization", p.AuthorizationToken)
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return Value{}, awserr.New(ErrCodeCredentialsEndpoint,
+			"failed to get credentials from endpoint", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respErr := &endpointErrRespBody{}
+		json.NewDecoder(resp.Body).Decode(respErr)
+		return Value{}, awserr.New(ErrCodeCredentialsEndpoint,
+			fmt.Sprintf("failed to get credentials from endpoint, status %d, %s: %s",
+				resp.StatusCode, respErr.Code, respErr.Message), nil)
+	}
+
+	respCreds := &endpointCredRespBody{}
+	if err := json.NewDecoder(resp.Body).Decode(respCreds); err != nil {
+		return Value{}, awserr.New(ErrCodeCredentialsEndpoint,
+			"failed to decode credentials from endpoint", err)
+	}
+	if respCreds.AccessKeyID == "" || respCreds.SecretAccessKey == "" {
+		return Value{}, awserr.New(ErrCodeCredentialsEndpoint,
+			"credentials from endpoint are missing AccessKeyId or SecretAccessKey", nil)
+	}
+
+	p.SetExpiration(respCreds.Expiration, p.ExpiryWindow)
+
+	return Value{
+		AccessKeyID:     respCreds.AccessKeyID,
+		SecretAccessKey: respCreds.SecretAccessKey,
+		SessionToken:    respCreds.Token,
+		ProviderName:    EndpointProviderName,
+	}, nil
+}
+
+// A endpointCredRespBody provides the shape for deserializing the credentials
+// response of the endpoint.
+type endpointCredRespBody struct {
+	Expiration      time.Time
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	Token           string
+}
+
+// A endpointErrRespBody provides the shape for deserializing the error
+// response of the endpoint.
+type endpointErrRespBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,73 @@
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointProvider(t *testing.T) {
+	var auth string
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	server := initContainerTestServer(expiry, &auth)
+	defer server.Close()
+
+	creds := NewEndpointCredentials(nil, server.URL+"/creds", "authToken", 0)
+
+	v, err := creds.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", v.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "secret", v.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "token", v.SessionToken, "Expect session token to match")
+	assert.Equal(t, "authToken", auth)
+	assert.False(t, creds.IsExpired(), "Expect creds to not be expired")
+}
+
+func TestEndpointProviderNoAuthorizationToken(t *testing.T) {
+	auth := "unset"
+	server := initContainerTestServer("2014-12-16T01:51:37Z", &auth)
+	defer server.Close()
+
+	p := &EndpointProvider{Endpoint: server.URL + "/creds"}
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "", auth)
+	assert.True(t, p.IsExpired(), "Expect creds to be expired")
+}
+
+func TestEndpointProviderErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/invalid":
+			fmt.Fprint(w, `not json`)
+		case "/missing":
+			fmt.Fprint(w, `{"AccessKeyId": "accessKey"}`)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"code": "AccessDenied", "message": "invalid token"}`)
+		}
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		endpoint, msg string
+	}{
+		{server.URL + "/invalid", "failed to decode"},
+		{server.URL + "/missing", "missing AccessKeyId or SecretAccessKey"},
+		{server.URL + "/denied", "status 403, AccessDenied: invalid token"},
+		{"://invalid", "invalid credentials endpoint"},
+	}
+
+	for _, c := range cases {
+		_, err := (&EndpointProvider{Endpoint: c.endpoint}).Retrieve()
+		if assert.Error(t, err, c.endpoint) {
+			assert.Equal(t, ErrCodeCredentialsEndpoint, err.(awserr.Error).Code(), c.endpoint)
+			assert.Contains(t, err.Error(), c.msg, c.endpoint)
+		}
+	}
+}
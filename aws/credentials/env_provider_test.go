@@ -19,6 +19,7 @@ func TestEnvProviderRetrieve(t *testing.T) {
 	assert.Equal(t, "access", creds.AccessKeyID, "Expect access key ID to match")
 	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
 	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+	assert.Equal(t, EnvProviderName, creds.ProviderName, "Expect provider name to match")
 }
 
 func TestEnvProviderIsExpired(t *testing.T) {
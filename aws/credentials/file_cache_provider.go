@@ -0,0 +1,198 @@
+package credentials
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCacheProviderName is the ProviderName of the credentials the
+// FileCacheProvider read from its file. Credentials retrieved from its
+// Provider have the Provider's name.
+const FileCacheProviderName = "FileCacheProvider"
+
+// DefaultFileCacheDir returns the directory the AWS CLI caches the credentials
+// of assumed roles in, $HOME/.aws/cli/cache. Returns an empty string if the
+// home directory is unknown.
+func DefaultFileCacheDir() string {
+	homeDir := os.Getenv("HOME") // *nix
+	if homeDir == "" {
+		homeDir = os.Getenv("USERPROFILE") // Windows
+	}
+	if homeDir == "" {
+		return ""
+	}
+	return filepath.Join(homeDir, ".aws", "cli", "cache")
+}
+
+// FileCacheKey returns the name of the file in a cache directory the
+// credentials identified by key are cached in. The name is the SHA1 hash of
+// the JSON encoding of key, the same as the AWS CLI uses for the arguments of
+// the AssumeRole call, e.g.
+//
+//     map[string]interface{}{"RoleArn": "arn:aws:iam::123456789012:role/admin"}
+func FileCacheKey(key interface{}) string {
+	b, _ := json.Marshal(key)
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:]) + ".json"
+}
+
+// A FileCacheProvider caches the credentials of its Provider in a file, so
+// that the credentials are shared between process runs until they expire.
+// For example short lived command line tools assuming a role which requires
+// MFA do not need to ask the user for a token code each time they are run.
+//
+// The file has the format of the AWS CLI's cache in $HOME/.aws/cli/cache, so
+// credentials cached by the CLI can be used, and the other way round:
+//
+//     {
+//         "Credentials": {
+//             "AccessKeyId": "AKID",
+//             "SecretAccessKey": "SECRET",
+//             "SessionToken": "TOKEN",
+//             "Expiration": "2020-01-01T00:00:00Z"
+//         }
+//     }
+//
+// Only the credentials of Providers which are Expirers are cached. The file
+// is created readable by the user only. Failures to read or write the file
+// are ignored, and the credentials are retrieved from the Provider instead.
+//
+//     creds := credentials.NewFileCacheCredentials(provider,
+//         filepath.Join(credentials.DefaultFileCacheDir(),
+//             credentials.FileCacheKey(map[string]interface{}{"RoleArn": roleARN})))
+type FileCacheProvider struct {
+	Expiry
+
+	// The Provider the credentials are retrieved from when the cached
+	// credentials are missing or expired.
+	Provider Provider
+
+	// The file the credentials are cached in.
+	Filename string
+
+	// ExpiryWindow will allow the cached credentials to trigger refreshing
+	// prior to the credentials actually expiring, see
+	// EC2RoleProvider.ExpiryWindow. The window of credentials retrieved from
+	// the Provider is set by the Provider.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	// Set if the last retrieved credentials were not cached, because the
+	// Provider is not an Expirer.
+	uncached bool
+}
+
+// NewFileCacheCredentials returns a pointer to a new Credentials object
+// wrapping a FileCacheProvider which caches the credentials of provider in
+// filename.
+func NewFileCacheCredentials(provider Provider, filename string) *Credentials {
+	return NewCredentials(&FileCacheProvider{
+		Provider: provider,
+		Filename: filename,
+	})
+}
+
+// A fileCacheEntry is the content of a credentials cache file.
+type fileCacheEntry struct {
+	Credentials struct {
+		AccessKeyID     string `json:"AccessKeyId"`
+		SecretAccessKey string
+		SessionToken    string
+		Expiration      time.Time
+	}
+}
+
+// Retrieve returns the cached credentials, or retrieves the credentials
+// from the Provider and caches them if the cached credentials are missing or
+// expired.
+func (p *FileCacheProvider) Retrieve() (Value, error) {
+	return p.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext behaves the same as Retrieve, passing ctx to the
+// Provider if it is a ProviderWithContext.
+func (p *FileCacheProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	if v, ok := p.load(); ok {
+		p.uncached = false
+		return v, nil
+	}
+
+	var v Value
+	var err error
+	if pc, ok := p.Provider.(ProviderWithContext); ok {
+		v, err = pc.RetrieveWithContext(ctx)
+	} else {
+		v, err = p.Provider.Retrieve()
+	}
+	if err != nil {
+		return Value{}, err
+	}
+
+	e, ok := p.Provider.(Expirer)
+	p.uncached = !ok
+	if ok {
+		p.SetExpiration(e.ExpiresAt(), 0)
+		p.store(v, e.ExpiresAt())
+	} else {
+		p.SetExpiration(time.Time{}, 0)
+	}
+	return v, nil
+}
+
+// load returns the cached credentials if the file exists and the credentials
+// have not expired.
+func (p *FileCacheProvider) load() (Value, bool) {
+	b, err := ioutil.ReadFile(p.Filename)
+	if err != nil {
+		return Value{}, false
+	}
+	var entry fileCacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil || entry.Credentials.AccessKeyID == "" {
+		return Value{}, false
+	}
+
+	p.SetExpiration(entry.Credentials.Expiration, p.ExpiryWindow)
+	if p.Expiry.IsExpired() {
+		return Value{}, false
+	}
+	return Value{
+		AccessKeyID:     entry.Credentials.AccessKeyID,
+		SecretAccessKey: entry.Credentials.SecretAccessKey,
+		SessionToken:    entry.Credentials.SessionToken,
+		ProviderName:    FileCacheProviderName,
+	}, true
+}
+
+// store writes the credentials to the file, creating its directory if
+// needed.
+func (p *FileCacheProvider) store(v Value, expiration time.Time) {
+	var entry fileCacheEntry
+	entry.Credentials.AccessKeyID = v.AccessKeyID
+	entry.Credentials.SecretAccessKey = v.SecretAccessKey
+	entry.Credentials.SessionToken = v.SessionToken
+	entry.Credentials.Expiration = expiration.UTC()
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(p.Filename), 0700); err != nil {
+		return
+	}
+	ioutil.WriteFile(p.Filename, b, 0600)
+}
+
+// IsExpired returns if the credentials have expired. If the credentials were
+// not cached the Provider determines if they have expired.
+func (p *FileCacheProvider) IsExpired() bool {
+	if p.uncached {
+		return p.Provider.IsExpired()
+	}
+	return p.Expiry.IsExpired()
+}
@@ -0,0 +1,114 @@
+package credentials
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// expiringProvider is a provider whose credentials expire in 2100.
+type expiringProvider struct {
+	Expiry
+	creds     Value
+	retrieved int
+}
+
+func (p *expiringProvider) Retrieve() (Value, error) {
+	p.retrieved++
+	p.SetExpiration(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), 0)
+	return p.creds, nil
+}
+
+func TestFileCacheProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "cache", FileCacheKey(map[string]interface{}{"RoleArn": "arn"}))
+	inner := &expiringProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN"}}
+
+	p := &FileCacheProvider{Provider: inner, Filename: filename}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, inner.creds, creds, "Expect credentials of the provider")
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired")
+
+	info, err := os.Stat(filename)
+	assert.Nil(t, err, "Expect cache file to be written")
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "Expect cache file to be readable by the user only")
+
+	// A new provider, e.g. of the next process run, reads the cache file.
+	p = &FileCacheProvider{Provider: inner, Filename: filename}
+	creds, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "AKID", creds.AccessKeyID, "Expect cached credentials")
+	assert.Equal(t, FileCacheProviderName, creds.ProviderName, "Expect cached credentials")
+	assert.Equal(t, 1, inner.retrieved, "Expect the provider to be called once")
+	assert.Equal(t, time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), p.ExpiresAt().UTC())
+}
+
+func TestFileCacheProviderCLICache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// Written by the AWS CLI.
+	filename := filepath.Join(dir, "cli.json")
+	ioutil.WriteFile(filename, []byte(`{"Credentials": {"AccessKeyId": "cliAccessKey", "SecretAccessKey": "cliSecret", "SessionToken": "cliToken", "Expiration": "2100-01-01T00:00:00+00:00"}, "AssumedRoleUser": {"AssumedRoleId": "id", "Arn": "arn"}}`), 0600)
+
+	inner := &expiringProvider{}
+	p := &FileCacheProvider{Provider: inner, Filename: filename}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "cliAccessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "cliSecret", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "cliToken", creds.SessionToken, "Expect session token to match")
+	assert.Equal(t, 0, inner.retrieved, "Expect the provider to not be called")
+}
+
+func TestFileCacheProviderExpired(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "expired.json")
+	ioutil.WriteFile(filename, []byte(`{"Credentials": {"AccessKeyId": "oldAccessKey", "SecretAccessKey": "oldSecret", "Expiration": "2014-12-16T01:51:37Z"}}`), 0600)
+
+	inner := &expiringProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}}
+	p := &FileCacheProvider{Provider: inner, Filename: filename}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "AKID", creds.AccessKeyID, "Expect credentials of the provider")
+	assert.Equal(t, 1, inner.retrieved, "Expect the provider to be called")
+
+	b, _ := ioutil.ReadFile(filename)
+	assert.Contains(t, string(b), `"AccessKeyId":"AKID"`, "Expect cache file to be replaced")
+}
+
+func TestFileCacheProviderNotExpirer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "static.json")
+	inner := &stubProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}}
+	p := &FileCacheProvider{Provider: inner, Filename: filename}
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+
+	_, err = os.Stat(filename)
+	assert.True(t, os.IsNotExist(err), "Expect credentials to not be cached")
+
+	inner.expired = true
+	assert.True(t, p.IsExpired(), "Expect the provider to determine expiry")
+}
+
+func TestFileCacheKey(t *testing.T) {
+	// The AWS CLI key, sha1(`{"RoleArn":"arn:aws:iam::123456789012:role/admin"}`)
+	assert.Equal(t, "9d3c7ef2b3bc813444063eb85da8fe5de0738a04.json",
+		FileCacheKey(map[string]interface{}{"RoleArn": "arn:aws:iam::123456789012:role/admin"}))
+}
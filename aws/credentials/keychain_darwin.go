@@ -0,0 +1,16 @@
+package credentials
+
+import (
+	"bytes"
+	"os/exec"
+)
+
+// lookupKeychain reads the generic password of the service and account from
+// the user's keychains.
+func lookupKeychain(service, account string) ([]byte, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(out), nil
+}
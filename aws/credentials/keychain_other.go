@@ -0,0 +1,23 @@
+// +build !darwin,!windows
+
+package credentials
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+)
+
+// lookupKeychain reads the secret with the service and account attributes
+// from the freedesktop Secret Service.
+func lookupKeychain(service, account string) ([]byte, error) {
+	out, err := exec.Command("secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		return nil, err
+	}
+	out = bytes.TrimSpace(out)
+	if len(out) == 0 {
+		return nil, errors.New("secret not found")
+	}
+	return out, nil
+}
@@ -0,0 +1,115 @@
+package credentials
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// KeychainProviderName is the ProviderName of the credentials retrieved by the
+// KeychainProvider.
+const KeychainProviderName = "KeychainProvider"
+
+// DefaultKeychainService is the service a KeychainProvider's credentials are
+// stored under when the KeychainProvider's Service is not set.
+const DefaultKeychainService = "aws-sdk-go"
+
+// ErrCodeKeychainProvider is the error code returned when the credentials of
+// a KeychainProvider cannot be read from the OS credential store, or cannot
+// be parsed.
+const ErrCodeKeychainProvider = "KeychainProviderError"
+
+// A KeychainProvider retrieves credentials from the credential store of the
+// OS, so they do not need to be stored in plaintext in the shared
+// credentials file. Keychain credentials never expire.
+//
+// The credentials are stored as a password identified by a service and an
+// account, in the same JSON format the command of a ProcessProvider writes,
+// without the Version:
+//
+//     {"AccessKeyId": "AKID", "SecretAccessKey": "SECRET"}
+//
+// The credential stores used:
+//
+// * macOS:   the login keychain, read with the "security" command
+// * Windows: Credential Manager, the generic credential with the target name
+//            "<service>:<account>"
+// * Linux:   the freedesktop Secret Service, e.g. GNOME Keyring or KWallet,
+//            read with the "secret-tool" command, attributes "service" and
+//            "account"
+//
+// For example the credentials of the default profile are stored on macOS
+// with:
+//
+//     security add-generic-password -s aws-sdk-go -a default \
+//         -w '{"AccessKeyId": "AKID", "SecretAccessKey": "SECRET"}'
+//
+// on Windows by adding a generic credential in Credential Manager with the
+// address "aws-sdk-go:default" and the JSON as password, and on Linux with
+// the following command, entering the JSON when prompted for the password:
+//
+//     secret-tool store --label aws-sdk-go service aws-sdk-go account default
+type KeychainProvider struct {
+	// The service the credentials are stored under. Defaults to
+	// DefaultKeychainService if not set.
+	Service string
+
+	// The account the credentials are stored under, e.g. the name of the
+	// profile. Defaults to "default" if not set.
+	Account string
+
+	retrieved bool
+}
+
+// NewKeychainCredentials returns a pointer to a new Credentials object
+// wrapping the KeychainProvider, retrieving the credentials stored under the
+// account of DefaultKeychainService.
+func NewKeychainCredentials(account string) *Credentials {
+	return NewCredentials(&KeychainProvider{Account: account})
+}
+
+// keychainLookup returns the password stored under the service and account
+// in the OS credential store. Replaceable for testing.
+var keychainLookup = lookupKeychain
+
+// Retrieve reads the credentials from the OS credential store.
+func (p *KeychainProvider) Retrieve() (Value, error) {
+	p.retrieved = false
+
+	service := p.Service
+	if service == "" {
+		service = DefaultKeychainService
+	}
+	account := p.Account
+	if account == "" {
+		account = "default"
+	}
+
+	secret, err := keychainLookup(service, account)
+	if err != nil {
+		return Value{}, awserr.New(ErrCodeKeychainProvider,
+			"failed to read credentials of "+service+" account "+account+" from the OS credential store", err)
+	}
+
+	out := &processCredRespBody{}
+	if err := json.Unmarshal(secret, out); err != nil {
+		return Value{}, awserr.New(ErrCodeKeychainProvider, "failed to decode keychain credentials", err)
+	}
+	if out.AccessKeyID == "" || out.SecretAccessKey == "" {
+		return Value{}, awserr.New(ErrCodeKeychainProvider,
+			"keychain credentials are missing AccessKeyId or SecretAccessKey", nil)
+	}
+
+	p.retrieved = true
+	return Value{
+		AccessKeyID:     out.AccessKeyID,
+		SecretAccessKey: out.SecretAccessKey,
+		SessionToken:    out.SessionToken,
+		ProviderName:    KeychainProviderName,
+	}, nil
+}
+
+// IsExpired returns if the credentials have been retrieved.
+func (p *KeychainProvider) IsExpired() bool {
+	return !p.retrieved
+}
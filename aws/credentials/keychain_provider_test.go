@@ -0,0 +1,65 @@
+package credentials
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func stubKeychain(secrets map[string]string) func() {
+	orig := keychainLookup
+	keychainLookup = func(service, account string) ([]byte, error) {
+		secret, ok := secrets[service+":"+account]
+		if !ok {
+			return nil, errors.New("secret not found")
+		}
+		return []byte(secret), nil
+	}
+	return func() { keychainLookup = orig }
+}
+
+func TestKeychainProvider(t *testing.T) {
+	defer stubKeychain(map[string]string{
+		"aws-sdk-go:default": `{"AccessKeyId": "accessKey", "SecretAccessKey": "secret", "SessionToken": "token"}`,
+	})()
+
+	p := &KeychainProvider{}
+	assert.True(t, p.IsExpired(), "Expect creds to be expired before retrieve")
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve")
+}
+
+func TestKeychainProviderServiceAccount(t *testing.T) {
+	defer stubKeychain(map[string]string{
+		"work:prod": `{"AccessKeyId": "prodAccessKey", "SecretAccessKey": "prodSecret"}`,
+	})()
+
+	creds, err := (&KeychainProvider{Service: "work", Account: "prod"}).Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "prodAccessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "prodSecret", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Empty(t, creds.SessionToken, "Expect no session token")
+}
+
+func TestKeychainProviderErrors(t *testing.T) {
+	defer stubKeychain(map[string]string{
+		"aws-sdk-go:invalid": `not json`,
+		"aws-sdk-go:missing": `{"AccessKeyId": "accessKey"}`,
+	})()
+
+	for _, account := range []string{"notfound", "invalid", "missing"} {
+		p := &KeychainProvider{Account: account}
+		_, err := p.Retrieve()
+		if assert.Error(t, err, account) {
+			assert.Equal(t, ErrCodeKeychainProvider, err.(awserr.Error).Code(), account)
+		}
+		assert.True(t, p.IsExpired(), "Expect creds to be expired")
+	}
+}
@@ -0,0 +1,60 @@
+package credentials
+
+import (
+	"syscall"
+	"unicode/utf16"
+	"unsafe"
+)
+
+var (
+	advapi32      = syscall.NewLazyDLL("advapi32.dll")
+	procCredReadW = advapi32.NewProc("CredReadW")
+	procCredFree  = advapi32.NewProc("CredFree")
+)
+
+// credTypeGeneric is the CRED_TYPE_GENERIC type of credentials.
+const credTypeGeneric = 1
+
+// A winCredential is the CREDENTIALW structure returned by CredReadW.
+type winCredential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// lookupKeychain reads the generic credential with the target name
+// "<service>:<account>" from Credential Manager.
+func lookupKeychain(service, account string) ([]byte, error) {
+	target, err := syscall.UTF16PtrFromString(service + ":" + account)
+	if err != nil {
+		return nil, err
+	}
+
+	var cred *winCredential
+	r, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if r == 0 {
+		return nil, err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	blob := unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)
+
+	// Passwords stored with cmdkey or the Control Panel are UTF-16 encoded.
+	if len(blob) >= 2 && len(blob)%2 == 0 && blob[1] == 0 {
+		u := make([]uint16, len(blob)/2)
+		for i := range u {
+			u[i] = uint16(blob[2*i]) | uint16(blob[2*i+1])<<8
+		}
+		return []byte(string(utf16.Decode(u))), nil
+	}
+	return append([]byte(nil), blob...), nil
+}
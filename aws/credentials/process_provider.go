@@ -0,0 +1,161 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ProcessProviderName is the ProviderName of the credentials retrieved by the
+// ProcessProvider.
+const ProcessProviderName = "ProcessProvider"
+
+// DefaultProcessTimeout is the time the command of a ProcessProvider may run
+// for when the ProcessProvider's Timeout is not set.
+const DefaultProcessTimeout = time.Minute
+
+// ErrCodeProcessProvider is the error code returned when the command of a
+// ProcessProvider fails, or its output cannot be parsed.
+const ErrCodeProcessProvider = "ProcessProviderError"
+
+// A ProcessProvider retrieves credentials from the output of an external
+// command, as configured by the credential_process setting of a shared config
+// profile, and keeps track if those credentials are expired.
+//
+// The command is run by the shell, "sh -c" or "cmd.exe /C" on Windows, and
+// must write a JSON document to stdout in the format:
+//
+//     {
+//         "Version": 1,
+//         "AccessKeyId": "AKID",
+//         "SecretAccessKey": "SECRET",
+//         "SessionToken": "TOKEN",
+//         "Expiration": "2020-01-01T00:00:00Z"
+//     }
+//
+// The SessionToken and Expiration are optional. Credentials without an
+// Expiration never expire. The command's stderr is passed through to the
+// stderr of the process, so the command may prompt the user.
+type ProcessProvider struct {
+	Expiry
+
+	// The command to run.
+	Command string
+
+	// The time the command may run for before it is killed. Defaults to
+	// DefaultProcessTimeout if not set.
+	Timeout time.Duration
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring, see EC2RoleProvider.ExpiryWindow.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	// Set if the last retrieved credentials do not have an expiration.
+	neverExpires bool
+}
+
+// NewProcessCredentials returns a pointer to a new Credentials object
+// wrapping the ProcessProvider, retrieving credentials from the command.
+func NewProcessCredentials(command string) *Credentials {
+	return NewCredentials(&ProcessProvider{Command: command})
+}
+
+// Retrieve runs the command and returns the credentials it writes to stdout.
+func (p *ProcessProvider) Retrieve() (Value, error) {
+	return p.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext runs the command and returns the credentials it writes
+// to stdout. The command is killed if the context is canceled.
+func (p *ProcessProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	if p.Command == "" {
+		return Value{}, awserr.New(ErrCodeProcessProvider, "credential process command is empty", nil)
+	}
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = DefaultProcessTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.Command)
+	}
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+
+	if err := cmd.Start(); err != nil {
+		return Value{}, awserr.New(ErrCodeProcessProvider, "failed to start credential process", err)
+	}
+
+	// Wait in the background, since children of the killed shell may keep
+	// its stdout open after the context is done.
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err := <-done:
+		if err != nil {
+			return Value{}, awserr.New(ErrCodeProcessProvider, "credential process failed", err)
+		}
+	case <-ctx.Done():
+		return Value{}, awserr.New(ErrCodeProcessProvider, "credential process failed", ctx.Err())
+	}
+
+	out := &processCredRespBody{}
+	if err := json.Unmarshal(stdout.Bytes(), out); err != nil {
+		return Value{}, awserr.New(ErrCodeProcessProvider, "failed to decode credential process output", err)
+	}
+	switch {
+	case out.Version != 1:
+		return Value{}, awserr.New(ErrCodeProcessProvider,
+			fmt.Sprintf("unsupported credential process output version %d", out.Version), nil)
+	case out.AccessKeyID == "" || out.SecretAccessKey == "":
+		return Value{}, awserr.New(ErrCodeProcessProvider,
+			"credential process output is missing AccessKeyId or SecretAccessKey", nil)
+	}
+
+	p.neverExpires = out.Expiration == nil
+	if out.Expiration != nil {
+		p.SetExpiration(*out.Expiration, p.ExpiryWindow)
+	}
+
+	return Value{
+		AccessKeyID:     out.AccessKeyID,
+		SecretAccessKey: out.SecretAccessKey,
+		SessionToken:    out.SessionToken,
+		ProviderName:    ProcessProviderName,
+	}, nil
+}
+
+// IsExpired returns if the credentials are expired. Credentials without an
+// expiration never expire.
+func (p *ProcessProvider) IsExpired() bool {
+	if p.neverExpires {
+		return false
+	}
+	return p.Expiry.IsExpired()
+}
+
+// A processCredRespBody provides the shape for deserializing the output of
+// the credential process.
+type processCredRespBody struct {
+	Version         int
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
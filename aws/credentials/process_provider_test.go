@@ -0,0 +1,86 @@
+package credentials
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPath is the PATH the command's shell is looked up in, captured before
+// other tests clear the environment.
+var testPath = os.Getenv("PATH")
+
+func TestProcessProvider(t *testing.T) {
+	os.Setenv("PATH", testPath)
+	p := &ProcessProvider{Command: `echo '{"Version": 1, "AccessKeyId": "accessKey", "SecretAccessKey": "secret", "SessionToken": "token", "Expiration": "2014-12-16T01:51:37Z"}'`}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+	assert.True(t, p.IsExpired(), "Expect creds to be expired")
+}
+
+func TestProcessProviderExpiry(t *testing.T) {
+	os.Setenv("PATH", testPath)
+
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	p := &ProcessProvider{
+		Command:      `echo '{"Version": 1, "AccessKeyId": "accessKey", "SecretAccessKey": "secret", "Expiration": "` + expiry + `"}'`,
+		ExpiryWindow: 10 * time.Minute,
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired")
+
+	p.CurrentTime = func() time.Time { return time.Now().Add(55 * time.Minute) }
+	assert.True(t, p.IsExpired(), "Expect creds to be expired within the expiry window")
+}
+
+func TestProcessProviderNoExpiration(t *testing.T) {
+	os.Setenv("PATH", testPath)
+
+	p := &ProcessProvider{Command: `echo '{"Version": 1, "AccessKeyId": "accessKey", "SecretAccessKey": "secret"}'`}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "", creds.SessionToken)
+	assert.False(t, p.IsExpired(), "Expect creds to never expire")
+}
+
+func TestProcessProviderErrors(t *testing.T) {
+	os.Setenv("PATH", testPath)
+
+	commands := []string{
+		``,
+		`exit 1`,
+		`echo 'not json'`,
+		`echo '{"Version": 2, "AccessKeyId": "accessKey", "SecretAccessKey": "secret"}'`,
+		`echo '{"Version": 1, "AccessKeyId": "accessKey"}'`,
+	}
+
+	for _, c := range commands {
+		p := &ProcessProvider{Command: c}
+		_, err := p.Retrieve()
+		if assert.Error(t, err, c) {
+			assert.Equal(t, ErrCodeProcessProvider, err.(awserr.Error).Code(), c)
+		}
+	}
+}
+
+func TestProcessProviderTimeout(t *testing.T) {
+	os.Setenv("PATH", testPath)
+
+	p := &ProcessProvider{Command: `sleep 5`, Timeout: 50 * time.Millisecond}
+
+	start := time.Now()
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < 5*time.Second, "Expect command to be killed on timeout")
+}
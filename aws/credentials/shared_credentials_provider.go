@@ -10,6 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
+// SharedCredsProviderName is the ProviderName of the credentials retrieved
+// by the SharedCredentialsProvider.
+const SharedCredsProviderName = "SharedCredentialsProvider"
+
 var (
 	// ErrSharedCredentialsHomeNotFound is emitted when the user directory cannot be found.
 	//
@@ -22,8 +26,9 @@ var (
 //
 // Profile ini file example: $HOME/.aws/credentials
 type SharedCredentialsProvider struct {
-	// Path to the shared credentials file. If empty will default to current user's
-	// home directory.
+	// Path to the shared credentials file. If empty will default to the
+	// environment variable "AWS_SHARED_CREDENTIALS_FILE", or the current user's
+	// home directory if the environment variable is also not set.
 	Filename string
 
 	// AWS Profile to extract credentials from the shared credentials file. If empty
@@ -98,6 +103,7 @@ func loadProfile(filename, profile string) (Value, error) {
 		AccessKeyID:     id,
 		SecretAccessKey: secret,
 		SessionToken:    token,
+		ProviderName:    SharedCredsProviderName,
 	}, nil
 }
 
@@ -105,6 +111,9 @@ func loadProfile(filename, profile string) (Value, error) {
 //
 // Will return an error if the user's home directory path cannot be found.
 func (p *SharedCredentialsProvider) filename() (string, error) {
+	if p.Filename == "" {
+		p.Filename = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
 	if p.Filename == "" {
 		homeDir := os.Getenv("HOME") // *nix
 		if homeDir == "" {           // Windows
@@ -0,0 +1,175 @@
+// Package ssocreds is a credential Provider to retrieve the credentials of a
+// role assigned to an AWS SSO user.
+//
+// The provider uses the access token cached by the AWS CLI when the user
+// signed in with "aws sso login", so the SDK does not need to sign the user
+// in itself.
+package ssocreds
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sso"
+)
+
+// ProviderName is the ProviderName of the credentials retrieved by the
+// Provider.
+const ProviderName = "SSOProvider"
+
+const (
+	// ErrCodeSSOProviderInvalidToken is the error code returned when the
+	// cached access token cannot be loaded, or has expired. The user has to
+	// sign in again, e.g. with "aws sso login".
+	ErrCodeSSOProviderInvalidToken = "SSOProviderInvalidToken"
+
+	// ErrCodeSSOProviderGetRoleCredentials is the error code returned when
+	// the role credentials cannot be retrieved.
+	ErrCodeSSOProviderGetRoleCredentials = "SSOProviderGetRoleCredentials"
+)
+
+// RoleCredentialsGetter represents the minimal subset of the SSO client API
+// used by this provider.
+type RoleCredentialsGetter interface {
+	GetRoleCredentials(input *sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error)
+}
+
+// Provider retrieves the credentials of the RoleName role in the AccountID
+// account from the AWS SSO portal, and keeps track of their expiration time.
+// The credentials are retrieved with the access token of StartURL cached in
+// $HOME/.aws/sso/cache.
+//
+//     creds := credentials.NewCredentials(&ssocreds.Provider{
+//         Config:    &aws.Config{Region: "us-east-1"},
+//         AccountID: "123456789012",
+//         RoleName:  "ReadOnly",
+//         StartURL:  "https://example.awsapps.com/start",
+//     })
+type Provider struct {
+	credentials.Expiry
+
+	// Custom SSO client. If not set the default SSO client will be used,
+	// which is created from Config.
+	Client RoleCredentialsGetter
+
+	// Config the default SSO client is created with, merged on top of
+	// aws.DefaultConfig. The Config's Region must be the region of the SSO
+	// portal. Ignored if Client is set.
+	Config *aws.Config
+
+	// The ID of the account the role is assumed in.
+	AccountID string
+
+	// The name of the role assigned to the user.
+	RoleName string
+
+	// The start URL of the SSO portal the user signed in to.
+	StartURL string
+
+	// The file the access token is loaded from. Defaults to the file of the
+	// StartURL in $HOME/.aws/sso/cache if not set.
+	CachedTokenFilepath string
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring, see
+	// stscreds.AssumeRoleProvider.ExpiryWindow.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// Retrieve retrieves the role credentials from the SSO portal.
+func (p *Provider) Retrieve() (credentials.Value, error) {
+	token, err := p.loadToken()
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	if p.Client == nil {
+		p.Client = sso.New(p.Config)
+	}
+
+	out, err := p.Client.GetRoleCredentials(&sso.GetRoleCredentialsInput{
+		AccessToken: aws.String(token),
+		AccountID:   aws.String(p.AccountID),
+		RoleName:    aws.String(p.RoleName),
+	})
+	if err != nil {
+		return credentials.Value{}, awserr.New(ErrCodeSSOProviderGetRoleCredentials,
+			"failed to get SSO role credentials", err)
+	}
+	creds := out.RoleCredentials
+	if creds == nil || creds.AccessKeyID == nil || creds.SecretAccessKey == nil {
+		return credentials.Value{}, awserr.New(ErrCodeSSOProviderGetRoleCredentials,
+			"SSO role credentials are missing from the response", nil)
+	}
+
+	// We will proactively generate new credentials before they expire.
+	p.SetExpiration(time.Unix(0, aws.LongValue(creds.Expiration)*int64(time.Millisecond)), p.ExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyID),
+		SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    ProviderName,
+	}, nil
+}
+
+// A cachedToken provides the shape for deserializing the cached access token.
+type cachedToken struct {
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// loadToken returns the cached access token, or an error if it cannot be
+// loaded or has expired.
+func (p *Provider) loadToken() (string, error) {
+	filename := p.CachedTokenFilepath
+	if filename == "" {
+		var err error
+		if filename, err = cachedTokenFilename(p.StartURL); err != nil {
+			return "", err
+		}
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", awserr.New(ErrCodeSSOProviderInvalidToken,
+			"failed to read cached SSO token file "+filename, err)
+	}
+	t := cachedToken{}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return "", awserr.New(ErrCodeSSOProviderInvalidToken,
+			"failed to decode cached SSO token file "+filename, err)
+	}
+	if t.AccessToken == "" || !t.ExpiresAt.After(time.Now()) {
+		return "", awserr.New(ErrCodeSSOProviderInvalidToken,
+			"the cached SSO token is expired, or is missing the access token", nil)
+	}
+
+	return t.AccessToken, nil
+}
+
+// cachedTokenFilename returns the file the access token of the start URL is
+// cached in, which is named after the SHA-1 hash of the start URL.
+func cachedTokenFilename(startURL string) (string, error) {
+	homeDir := os.Getenv("HOME") // *nix
+	if homeDir == "" {           // Windows
+		homeDir = os.Getenv("USERPROFILE")
+	}
+	if homeDir == "" {
+		return "", awserr.New(ErrCodeSSOProviderInvalidToken,
+			"user home directory not found, unable to locate the cached SSO token", nil)
+	}
+
+	hash := sha1.Sum([]byte(startURL))
+	return filepath.Join(homeDir, ".aws", "sso", "cache", hex.EncodeToString(hash[:])+".json"), nil
+}
@@ -0,0 +1,115 @@
+package ssocreds
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubSSO struct {
+	input  *sso.GetRoleCredentialsInput
+	expiry time.Time
+	err    error
+}
+
+func (s *stubSSO) GetRoleCredentials(input *sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error) {
+	s.input = input
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &sso.GetRoleCredentialsOutput{
+		RoleCredentials: &sso.RoleCredentials{
+			AccessKeyID:     aws.String("ssoAccessKeyID"),
+			SecretAccessKey: aws.String("ssoSecretAccessKey"),
+			SessionToken:    aws.String("ssoSessionToken"),
+			Expiration:      aws.Long(s.expiry.UnixNano() / int64(time.Millisecond)),
+		},
+	}, nil
+}
+
+func writeToken(t *testing.T, dir, name string, expiresAt time.Time) string {
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	token := `{"accessToken": "accessToken", "expiresAt": "` + expiresAt.UTC().Format(time.RFC3339) + `"}`
+	if err := ioutil.WriteFile(path, []byte(token), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestProvider(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "ssocreds")
+	defer os.RemoveAll(dir)
+
+	stub := &stubSSO{expiry: time.Now().Add(time.Hour)}
+	p := &Provider{
+		Client:              stub,
+		AccountID:           "123456789012",
+		RoleName:            "ReadOnly",
+		CachedTokenFilepath: writeToken(t, dir, "token.json", time.Now().Add(time.Hour)),
+		ExpiryWindow:        5 * time.Minute,
+	}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "ssoAccessKeyID", creds.AccessKeyID)
+	assert.Equal(t, "ssoSecretAccessKey", creds.SecretAccessKey)
+	assert.Equal(t, "ssoSessionToken", creds.SessionToken)
+	assert.False(t, p.IsExpired())
+
+	assert.Equal(t, "accessToken", *stub.input.AccessToken)
+	assert.Equal(t, "123456789012", *stub.input.AccountID)
+	assert.Equal(t, "ReadOnly", *stub.input.RoleName)
+
+	stub.expiry = time.Now().Add(time.Minute)
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.True(t, p.IsExpired(), "Expect credentials within the expiry window to be expired")
+}
+
+func TestProviderCachedTokenFromStartURL(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "ssocreds")
+	defer os.RemoveAll(dir)
+
+	os.Clearenv()
+	os.Setenv("HOME", dir)
+	// The token is cached in a file named after the SHA-1 hash of the start URL.
+	writeToken(t, dir, ".aws/sso/cache/e8be5486177c5b5392bd9aa76563515b29358e6e.json", time.Now().Add(time.Hour))
+
+	stub := &stubSSO{expiry: time.Now().Add(time.Hour)}
+	p := &Provider{Client: stub, StartURL: "https://example.awsapps.com/start"}
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessToken", *stub.input.AccessToken)
+}
+
+func TestProviderErrors(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "ssocreds")
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		provider *Provider
+		code     string
+	}{
+		{&Provider{Client: &stubSSO{}, CachedTokenFilepath: filepath.Join(dir, "missing.json")}, ErrCodeSSOProviderInvalidToken},
+		{&Provider{Client: &stubSSO{}, CachedTokenFilepath: writeToken(t, dir, "expired.json", time.Now().Add(-time.Minute))}, ErrCodeSSOProviderInvalidToken},
+		{&Provider{Client: &stubSSO{err: errors.New("UnauthorizedException")}, CachedTokenFilepath: writeToken(t, dir, "valid.json", time.Now().Add(time.Hour))}, ErrCodeSSOProviderGetRoleCredentials},
+	}
+
+	for i, c := range cases {
+		_, err := c.provider.Retrieve()
+		if assert.Error(t, err, "case %d", i) {
+			assert.Equal(t, c.code, err.(awserr.Error).Code(), "case %d", i)
+		}
+	}
+}
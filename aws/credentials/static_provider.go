@@ -4,6 +4,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
+// StaticProviderName is the ProviderName of the credentials retrieved by the
+// StaticProvider.
+const StaticProviderName = "StaticProvider"
+
 var (
 	// ErrStaticCredentialsEmpty is emitted when static credentials are empty.
 	//
@@ -33,7 +37,9 @@ func (s *StaticProvider) Retrieve() (Value, error) {
 		return Value{}, ErrStaticCredentialsEmpty
 	}
 
-	return s.Value, nil
+	v := s.Value
+	v.ProviderName = StaticProviderName
+	return v, nil
 }
 
 // IsExpired returns if the credentials are expired.
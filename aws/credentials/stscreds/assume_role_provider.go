@@ -7,11 +7,23 @@ package stscreds
 import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"time"
 )
 
+// ProviderName is the ProviderName of the credentials retrieved by the
+// AssumeRoleProvider.
+const ProviderName = "AssumeRoleProvider"
+
+// ErrTokenProviderNotSet is returned when the role requires MFA, but the
+// AssumeRoleProvider's TokenProvider is not set.
+//
+// @readonly
+var ErrTokenProviderNotSet = awserr.New("AssumeRoleTokenProviderNotSetError",
+	"assume role with MFA enabled, but TokenProvider is not set", nil)
+
 // AssumeRoler represents the minimal subset of the STS client API used by this provider.
 type AssumeRoler interface {
 	AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
@@ -25,25 +37,36 @@ type AssumeRoler interface {
 //
 //		config := &aws.Config{
 //			Credentials: stscreds.NewCredentials(nil, "arn-of-the-role-to-assume", 10*time.Second),
-//		})
+//		}
 //		// Use config for creating your AWS service.
 //
 // Example how to obtain customised credentials:
 //
-//		provider := &stscreds.Provider{
+//		provider := &stscreds.AssumeRoleProvider{
+//			RoleARN: "arn-of-the-role-to-assume",
 //			// Extend the duration to 1 hour.
 //			Duration: time.Hour,
 //			// Custom role name.
 //			RoleSessionName: "custom-session-name",
+//			// Scope down the permissions of the role.
+//			Policy: `{"Version":"2012-10-17","Statement":[...]}`,
 //		}
 //		creds := credentials.NewCredentials(provider)
 //
 type AssumeRoleProvider struct {
 	credentials.Expiry
 
-	// Custom STS client. If not set the default STS client will be used.
+	// Custom STS client. If not set the default STS client will be used,
+	// which is created from Config.
 	Client AssumeRoler
 
+	// Config the default STS client is created with, merged on top of
+	// aws.DefaultConfig. For example setting the Config's Region and an
+	// STSRegionalEndpoint of aws.STSRegionalEndpointRegional sends the
+	// default client's requests to the regional STS endpoint. Ignored if
+	// Client is set.
+	Config *aws.Config
+
 	// Role to be assumed.
 	RoleARN string
 
@@ -53,6 +76,18 @@ type AssumeRoleProvider struct {
 	// Expiry duration of the STS credentials. Defaults to 15 minutes if not set.
 	Duration time.Duration
 
+	// An optional IAM policy in JSON format, which further restricts the
+	// permissions of the assumed role's credentials.
+	Policy string
+
+	// The serial number of the MFA device required to assume the role, if
+	// the role requires MFA.
+	SerialNumber string
+
+	// TokenProvider returns the current code of the MFA device. Required if
+	// SerialNumber is set.
+	TokenProvider func() (string, error)
+
 	// ExpiryWindow will allow the credentials to trigger refreshing prior to
 	// the credentials actually expiring. This is beneficial so race conditions
 	// with expiring credentials do not cause request to fail unexpectedly
@@ -60,6 +95,9 @@ type AssumeRoleProvider struct {
 	//
 	// So a ExpiryWindow of 10s would cause calls to IsExpired() to return true
 	// 10 seconds before the credentials are actually expired.
+	// The window can also be a fraction of the credentials' lifetime, with
+	// random jitter, set by the Expiry's WindowFraction and
+	// WindowJitterFraction.
 	//
 	// If ExpiryWindow is 0 or less it will be ignored.
 	ExpiryWindow time.Duration
@@ -88,7 +126,7 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 
 	// Apply defaults where parameters are not set.
 	if p.Client == nil {
-		p.Client = sts.New(nil)
+		p.Client = sts.New(p.Config)
 	}
 	if p.RoleSessionName == "" {
 		// Try to work out a role name that will hopefully end up unique.
@@ -99,12 +137,27 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 		p.Duration = 15 * time.Minute
 	}
 
-	roleOutput, err := p.Client.AssumeRole(&sts.AssumeRoleInput{
+	input := &sts.AssumeRoleInput{
 		DurationSeconds: aws.Long(int64(p.Duration / time.Second)),
 		RoleARN:         aws.String(p.RoleARN),
 		RoleSessionName: aws.String(p.RoleSessionName),
-	})
+	}
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+	if p.SerialNumber != "" {
+		if p.TokenProvider == nil {
+			return credentials.Value{}, ErrTokenProviderNotSet
+		}
+		code, err := p.TokenProvider()
+		if err != nil {
+			return credentials.Value{}, err
+		}
+		input.SerialNumber = aws.String(p.SerialNumber)
+		input.TokenCode = aws.String(code)
+	}
 
+	roleOutput, err := p.Client.AssumeRole(input)
 	if err != nil {
 		return credentials.Value{}, err
 	}
@@ -116,5 +169,6 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 		AccessKeyID:     *roleOutput.Credentials.AccessKeyID,
 		SecretAccessKey: *roleOutput.Credentials.SecretAccessKey,
 		SessionToken:    *roleOutput.Credentials.SessionToken,
+		ProviderName:    ProviderName,
 	}, nil
 }
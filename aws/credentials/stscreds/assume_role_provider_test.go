@@ -1,9 +1,15 @@
 package stscreds
 
 import (
+	"bytes"
+	"errors"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -39,6 +45,100 @@ func TestAssumeRoleProvider(t *testing.T) {
 	assert.Equal(t, "assumedSessionToken", creds.SessionToken, "Expect session token to match")
 }
 
+type recordingSTS struct {
+	input  *sts.AssumeRoleInput
+	expiry time.Time
+}
+
+func (s *recordingSTS) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	s.input = input
+	return &sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyID:     aws.String("assumedAccessKeyID"),
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      &s.expiry,
+		},
+	}, nil
+}
+
+func TestAssumeRoleProviderInput(t *testing.T) {
+	stub := &recordingSTS{expiry: time.Now().Add(time.Hour)}
+	p := &AssumeRoleProvider{
+		Client:          stub,
+		RoleARN:         "roleARN",
+		RoleSessionName: "sessionName",
+		Duration:        30 * time.Minute,
+		Policy:          "policy",
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+
+	assert.Equal(t, "roleARN", *stub.input.RoleARN)
+	assert.Equal(t, "sessionName", *stub.input.RoleSessionName)
+	assert.Equal(t, int64(1800), *stub.input.DurationSeconds)
+	assert.Equal(t, "policy", *stub.input.Policy)
+	assert.Nil(t, stub.input.SerialNumber)
+}
+
+func TestAssumeRoleProviderDefaults(t *testing.T) {
+	stub := &recordingSTS{expiry: time.Now().Add(time.Hour)}
+	p := &AssumeRoleProvider{
+		Client:  stub,
+		RoleARN: "roleARN",
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+
+	assert.NotEmpty(t, *stub.input.RoleSessionName)
+	assert.Equal(t, int64(900), *stub.input.DurationSeconds)
+	assert.Nil(t, stub.input.Policy)
+}
+
+func TestAssumeRoleProviderExpiryWindow(t *testing.T) {
+	stub := &recordingSTS{expiry: time.Now().Add(time.Minute)}
+	creds := NewCredentials(stub, "roleARN", 30*time.Second)
+
+	_, err := creds.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.False(t, creds.IsExpired(), "Expect credentials to be valid")
+
+	stub.expiry = time.Now().Add(10 * time.Second)
+	creds.Expire()
+	_, err = creds.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.True(t, creds.IsExpired(), "Expect credentials within the expiry window to be expired")
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestAssumeRoleProviderRegionalEndpoint(t *testing.T) {
+	host := ""
+	p := &AssumeRoleProvider{
+		RoleARN: "arn:aws:iam::123456789012:role/role",
+		Config: &aws.Config{
+			Region:              "us-west-2",
+			STSRegionalEndpoint: aws.STSRegionalEndpointRegional,
+			Credentials:         credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			MaxRetries:          0,
+			HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				host = r.URL.Host
+				return nil, errors.New("mock error")
+			})},
+		},
+	}
+
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.Equal(t, "sts.us-west-2.amazonaws.com", host)
+}
+
 func BenchmarkAssumeRoleProvider(b *testing.B) {
 	stub := &stubSTS{}
 	p := &AssumeRoleProvider{
@@ -56,3 +156,41 @@ func BenchmarkAssumeRoleProvider(b *testing.B) {
 		}
 	})
 }
+
+func TestReadTokenCode(t *testing.T) {
+	var prompt bytes.Buffer
+	code, err := readTokenCode(strings.NewReader("123456\n"), &prompt)
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", code)
+	assert.Equal(t, "Assume Role MFA token code: ", prompt.String())
+
+	code, err = readTokenCode(strings.NewReader(" 654321"), &prompt)
+	assert.NoError(t, err)
+	assert.Equal(t, "654321", code)
+
+	_, err = readTokenCode(strings.NewReader(""), &prompt)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestAssumeRoleProviderTokenProvider(t *testing.T) {
+	stub := &recordingSTS{expiry: time.Now().Add(time.Hour)}
+	p := &AssumeRoleProvider{
+		Client:        stub,
+		RoleARN:       "roleARN",
+		SerialNumber:  "serialNumber",
+		TokenProvider: func() (string, error) { return "123456", nil },
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "serialNumber", *stub.input.SerialNumber)
+	assert.Equal(t, "123456", *stub.input.TokenCode)
+
+	p.TokenProvider = func() (string, error) { return "", errors.New("no token") }
+	_, err = p.Retrieve()
+	assert.EqualError(t, err, "no token")
+
+	p.TokenProvider = nil
+	_, err = p.Retrieve()
+	assert.Equal(t, ErrTokenProviderNotSet, err)
+}
@@ -0,0 +1,138 @@
+package stscreds
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// SAMLProviderName is the ProviderName of the credentials retrieved by the
+// SAMLRoleProvider.
+const SAMLProviderName = "SAMLRoleProvider"
+
+// ErrCodeSAML is the error code returned when the SAML assertion cannot be
+// obtained, or is not accepted by STS.
+const ErrCodeSAML = "SAMLErr"
+
+// ErrSAMLAssertionProviderNotSet is returned when the SAMLRoleProvider's
+// AssertionProvider is not set.
+//
+// @readonly
+var ErrSAMLAssertionProviderNotSet = awserr.New(ErrCodeSAML,
+	"assume role with SAML, but AssertionProvider is not set", nil)
+
+// AssumeRoleWithSAMLer represents the minimal subset of the STS client API
+// used by the SAMLRoleProvider.
+type AssumeRoleWithSAMLer interface {
+	AssumeRoleWithSAML(input *sts.AssumeRoleWithSAMLInput) (*sts.AssumeRoleWithSAMLOutput, error)
+}
+
+// SAMLRoleProvider retrieves temporary credentials from the STS service by
+// exchanging a SAML assertion of an identity provider federated with IAM,
+// e.g. ADFS, and keeps track of their expiration time. The AssertionProvider
+// is called each time the credentials are refreshed, since assertions are
+// only valid for a few minutes.
+//
+//     creds := stscreds.NewSAMLCredentials(nil, "arn-of-the-role-to-assume",
+//         "arn-of-the-saml-provider", func() (string, error) {
+//             // Sign in to the identity provider, and return the base64
+//             // encoded SAMLResponse it posts to the AWS sign-in page.
+//         })
+type SAMLRoleProvider struct {
+	credentials.Expiry
+
+	// Custom STS client. If not set the default STS client will be used,
+	// which is created from Config.
+	Client AssumeRoleWithSAMLer
+
+	// Config the default STS client is created with, merged on top of
+	// aws.DefaultConfig. Ignored if Client is set. AssumeRoleWithSAML
+	// requests are not signed, so the Config's Credentials are not used.
+	Config *aws.Config
+
+	// Role to be assumed.
+	RoleARN string
+
+	// The ARN of the SAML provider in IAM which describes the identity
+	// provider.
+	PrincipalARN string
+
+	// AssertionProvider returns the base64 encoded SAML authentication
+	// response of the identity provider.
+	AssertionProvider func() (string, error)
+
+	// Expiry duration of the STS credentials. Defaults to 15 minutes if not
+	// set. The credentials expire earlier if the assertion's
+	// SessionNotOnOrAfter is earlier.
+	Duration time.Duration
+
+	// An optional IAM policy in JSON format, which further restricts the
+	// permissions of the assumed role's credentials.
+	Policy string
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring, see AssumeRoleProvider.ExpiryWindow.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewSAMLCredentials returns a pointer to a new Credentials object wrapping
+// the SAMLRoleProvider. Pass nil as client to use the default STS client.
+func NewSAMLCredentials(client AssumeRoleWithSAMLer, roleARN, principalARN string, assertionProvider func() (string, error)) *credentials.Credentials {
+	return credentials.NewCredentials(&SAMLRoleProvider{
+		Client:            client,
+		RoleARN:           roleARN,
+		PrincipalARN:      principalARN,
+		AssertionProvider: assertionProvider,
+	})
+}
+
+// Retrieve obtains a SAML assertion from the AssertionProvider, and generates
+// a new set of temporary credentials with it using STS.
+func (p *SAMLRoleProvider) Retrieve() (credentials.Value, error) {
+	if p.AssertionProvider == nil {
+		return credentials.Value{}, ErrSAMLAssertionProviderNotSet
+	}
+	assertion, err := p.AssertionProvider()
+	if err != nil {
+		return credentials.Value{}, awserr.New(ErrCodeSAML, "unable to obtain SAML assertion", err)
+	}
+
+	// Apply defaults where parameters are not set.
+	if p.Client == nil {
+		p.Client = sts.New(p.Config)
+	}
+	if p.Duration == 0 {
+		// Expire as often as AWS permits.
+		p.Duration = 15 * time.Minute
+	}
+
+	input := &sts.AssumeRoleWithSAMLInput{
+		DurationSeconds: aws.Long(int64(p.Duration / time.Second)),
+		PrincipalARN:    aws.String(p.PrincipalARN),
+		RoleARN:         aws.String(p.RoleARN),
+		SAMLAssertion:   aws.String(assertion),
+	}
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+
+	roleOutput, err := p.Client.AssumeRoleWithSAML(input)
+	if err != nil {
+		return credentials.Value{}, awserr.New(ErrCodeSAML, "failed to assume role with SAML", err)
+	}
+
+	// We will proactively generate new credentials before they expire.
+	p.SetExpiration(*roleOutput.Credentials.Expiration, p.ExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     *roleOutput.Credentials.AccessKeyID,
+		SecretAccessKey: *roleOutput.Credentials.SecretAccessKey,
+		SessionToken:    *roleOutput.Credentials.SessionToken,
+		ProviderName:    SAMLProviderName,
+	}, nil
+}
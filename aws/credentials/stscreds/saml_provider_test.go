@@ -0,0 +1,93 @@
+package stscreds
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubSAMLSTS struct {
+	input *sts.AssumeRoleWithSAMLInput
+	err   error
+}
+
+func (s *stubSAMLSTS) AssumeRoleWithSAML(input *sts.AssumeRoleWithSAMLInput) (*sts.AssumeRoleWithSAMLOutput, error) {
+	s.input = input
+	if s.err != nil {
+		return nil, s.err
+	}
+	expiry := time.Now().Add(60 * time.Minute)
+	return &sts.AssumeRoleWithSAMLOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyID:     aws.String("assumedAccessKeyID"),
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      &expiry,
+		},
+	}, nil
+}
+
+func TestSAMLRoleProvider(t *testing.T) {
+	assertions := []string{"assertion1", "assertion2"}
+	stub := &stubSAMLSTS{}
+	p := &SAMLRoleProvider{
+		Client:       stub,
+		RoleARN:      "roleARN",
+		PrincipalARN: "principalARN",
+		AssertionProvider: func() (string, error) {
+			a := assertions[0]
+			assertions = assertions[1:]
+			return a, nil
+		},
+		Policy: "policy",
+	}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "assumedAccessKeyID", creds.AccessKeyID)
+	assert.Equal(t, "assumedSecretAccessKey", creds.SecretAccessKey)
+	assert.Equal(t, "assumedSessionToken", creds.SessionToken)
+	assert.False(t, p.IsExpired())
+
+	assert.Equal(t, "roleARN", *stub.input.RoleARN)
+	assert.Equal(t, "principalARN", *stub.input.PrincipalARN)
+	assert.Equal(t, "assertion1", *stub.input.SAMLAssertion)
+	assert.Equal(t, "policy", *stub.input.Policy)
+	assert.Equal(t, int64(900), *stub.input.DurationSeconds)
+
+	// A new assertion is obtained on refresh.
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "assertion2", *stub.input.SAMLAssertion)
+}
+
+func TestSAMLRoleProviderErrors(t *testing.T) {
+	p := &SAMLRoleProvider{Client: &stubSAMLSTS{}, RoleARN: "roleARN"}
+	_, err := p.Retrieve()
+	assert.Equal(t, ErrSAMLAssertionProviderNotSet, err)
+
+	assertionErr := errors.New("sign in failed")
+	p.AssertionProvider = func() (string, error) { return "", assertionErr }
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeSAML, err.(awserr.Error).Code())
+		assert.Equal(t, assertionErr, err.(awserr.Error).OrigErr())
+	}
+
+	stubErr := errors.New("InvalidIdentityToken")
+	p = &SAMLRoleProvider{
+		Client:            &stubSAMLSTS{err: stubErr},
+		RoleARN:           "roleARN",
+		AssertionProvider: func() (string, error) { return "assertion", nil },
+	}
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeSAML, err.(awserr.Error).Code())
+		assert.Equal(t, stubErr, err.(awserr.Error).OrigErr())
+	}
+}
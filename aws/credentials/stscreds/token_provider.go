@@ -0,0 +1,34 @@
+package stscreds
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// StdinTokenProvider is an AssumeRoleProvider TokenProvider which prompts on
+// stderr for the MFA token code and reads it from stdin. The prompt blocks
+// until a line is read, so it is only suitable for interactive command line
+// tools.
+//
+//     provider := &stscreds.AssumeRoleProvider{
+//         RoleARN:       "arn-of-the-role-to-assume",
+//         SerialNumber:  "arn-of-the-mfa-device",
+//         TokenProvider: stscreds.StdinTokenProvider,
+//     }
+func StdinTokenProvider() (string, error) {
+	return readTokenCode(os.Stdin, os.Stderr)
+}
+
+// readTokenCode prompts on w for the MFA token code, and returns the next
+// line read from r.
+func readTokenCode(r io.Reader, w io.Writer) (string, error) {
+	fmt.Fprint(w, "Assume Role MFA token code: ")
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
@@ -0,0 +1,134 @@
+package stscreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// WebIdentityProviderName is the ProviderName of the credentials retrieved by the
+// WebIdentityRoleProvider.
+const WebIdentityProviderName = "WebIdentityRoleProvider"
+
+// ErrCodeWebIdentity is the error code returned when the web identity token
+// file cannot be read, or the token is not accepted by STS.
+const ErrCodeWebIdentity = "WebIdentityErr"
+
+// AssumeRoleWithWebIdentityer represents the minimal subset of the STS client
+// API used by the WebIdentityRoleProvider.
+type AssumeRoleWithWebIdentityer interface {
+	AssumeRoleWithWebIdentity(input *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error)
+}
+
+// WebIdentityRoleProvider retrieves temporary credentials from the STS
+// service by exchanging the OpenID Connect token read from a file, e.g. the
+// token Kubernetes projects into a pod's service account volume, and keeps
+// track of their expiration time. The token file is read again each time the
+// credentials are refreshed, so the token may be rotated.
+//
+// The session package uses this provider when the AWS_WEB_IDENTITY_TOKEN_FILE
+// and AWS_ROLE_ARN environment variables are set.
+//
+//     creds := stscreds.NewWebIdentityCredentials(nil,
+//         "arn-of-the-role-to-assume", "session-name", "/path/to/token")
+type WebIdentityRoleProvider struct {
+	credentials.Expiry
+
+	// Custom STS client. If not set the default STS client will be used,
+	// which is created from Config.
+	Client AssumeRoleWithWebIdentityer
+
+	// Config the default STS client is created with, merged on top of
+	// aws.DefaultConfig. Ignored if Client is set. AssumeRoleWithWebIdentity
+	// requests are not signed, so the Config's Credentials are not used.
+	Config *aws.Config
+
+	// Role to be assumed.
+	RoleARN string
+
+	// Session name, if you wish to reuse the credentials elsewhere.
+	RoleSessionName string
+
+	// The path of the file the web identity token is read from.
+	TokenFilePath string
+
+	// Expiry duration of the STS credentials. Defaults to 15 minutes if not set.
+	Duration time.Duration
+
+	// An optional IAM policy in JSON format, which further restricts the
+	// permissions of the assumed role's credentials.
+	Policy string
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring, see AssumeRoleProvider.ExpiryWindow.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewWebIdentityCredentials returns a pointer to a new Credentials object
+// wrapping the WebIdentityRoleProvider. Pass nil as client to use the default
+// STS client.
+func NewWebIdentityCredentials(client AssumeRoleWithWebIdentityer, roleARN, roleSessionName, path string) *credentials.Credentials {
+	return credentials.NewCredentials(&WebIdentityRoleProvider{
+		Client:          client,
+		RoleARN:         roleARN,
+		RoleSessionName: roleSessionName,
+		TokenFilePath:   path,
+	})
+}
+
+// Retrieve reads the web identity token from the token file, and generates a
+// new set of temporary credentials with it using STS.
+func (p *WebIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	b, err := ioutil.ReadFile(p.TokenFilePath)
+	if err != nil {
+		return credentials.Value{}, awserr.New(ErrCodeWebIdentity,
+			"unable to read web identity token file "+p.TokenFilePath, err)
+	}
+
+	// Apply defaults where parameters are not set.
+	if p.Client == nil {
+		p.Client = sts.New(p.Config)
+	}
+	if p.RoleSessionName == "" {
+		// Try to work out a role name that will hopefully end up unique.
+		p.RoleSessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+	if p.Duration == 0 {
+		// Expire as often as AWS permits.
+		p.Duration = 15 * time.Minute
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		DurationSeconds:  aws.Long(int64(p.Duration / time.Second)),
+		RoleARN:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(p.RoleSessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(b))),
+	}
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+
+	roleOutput, err := p.Client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return credentials.Value{}, awserr.New(ErrCodeWebIdentity,
+			"failed to assume role with web identity", err)
+	}
+
+	// We will proactively generate new credentials before they expire.
+	p.SetExpiration(*roleOutput.Credentials.Expiration, p.ExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     *roleOutput.Credentials.AccessKeyID,
+		SecretAccessKey: *roleOutput.Credentials.SecretAccessKey,
+		SessionToken:    *roleOutput.Credentials.SessionToken,
+		ProviderName:    WebIdentityProviderName,
+	}, nil
+}
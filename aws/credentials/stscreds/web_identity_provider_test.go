@@ -0,0 +1,106 @@
+package stscreds
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubWebIdentitySTS struct {
+	input *sts.AssumeRoleWithWebIdentityInput
+	err   error
+}
+
+func (s *stubWebIdentitySTS) AssumeRoleWithWebIdentity(input *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	s.input = input
+	if s.err != nil {
+		return nil, s.err
+	}
+	expiry := time.Now().Add(60 * time.Minute)
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyID:     aws.String("assumedAccessKeyID"),
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      &expiry,
+		},
+	}, nil
+}
+
+func writeTokenFile(t *testing.T, token string) (string, func()) {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(path, []byte(token), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestWebIdentityRoleProvider(t *testing.T) {
+	path, cleanup := writeTokenFile(t, "token1\n")
+	defer cleanup()
+
+	stub := &stubWebIdentitySTS{}
+	p := &WebIdentityRoleProvider{
+		Client:          stub,
+		RoleARN:         "roleARN",
+		RoleSessionName: "sessionName",
+		TokenFilePath:   path,
+	}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "assumedAccessKeyID", creds.AccessKeyID)
+	assert.Equal(t, "assumedSecretAccessKey", creds.SecretAccessKey)
+	assert.Equal(t, "assumedSessionToken", creds.SessionToken)
+	assert.False(t, p.IsExpired())
+
+	assert.Equal(t, "roleARN", *stub.input.RoleARN)
+	assert.Equal(t, "sessionName", *stub.input.RoleSessionName)
+	assert.Equal(t, "token1", *stub.input.WebIdentityToken)
+	assert.Equal(t, int64(900), *stub.input.DurationSeconds)
+
+	// The token file is read again on refresh.
+	ioutil.WriteFile(path, []byte("token2"), 0600)
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "token2", *stub.input.WebIdentityToken)
+}
+
+func TestWebIdentityRoleProviderErrors(t *testing.T) {
+	p := &WebIdentityRoleProvider{
+		Client:        &stubWebIdentitySTS{},
+		RoleARN:       "roleARN",
+		TokenFilePath: "/path/does/not/exist",
+	}
+	_, err := p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeWebIdentity, err.(awserr.Error).Code())
+	}
+
+	path, cleanup := writeTokenFile(t, "token")
+	defer cleanup()
+
+	stubErr := errors.New("InvalidIdentityToken")
+	p = &WebIdentityRoleProvider{
+		Client:        &stubWebIdentitySTS{err: stubErr},
+		RoleARN:       "roleARN",
+		TokenFilePath: path,
+	}
+	_, err = p.Retrieve()
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeWebIdentity, err.(awserr.Error).Code())
+		assert.Equal(t, stubErr, err.(awserr.Error).OrigErr())
+	}
+}
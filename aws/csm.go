@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// The defaults of the Config's CSMHost and CSMPort, the address the Client
+// Side Monitoring agent listens on.
+const (
+	DefaultCSMHost = "127.0.0.1"
+	DefaultCSMPort = 31000
+)
+
+const (
+	// The version of the CSM datagrams sent.
+	csmVersion = 1
+
+	// The number of datagrams buffered for the agent, datagrams sent while
+	// the buffer is full are dropped instead of blocking requests.
+	csmBufferSize = 100
+
+	// The maximum lengths of the user agent, exception codes and messages in
+	// datagrams. Longer values are truncated.
+	csmMaxUserAgentLength = 256
+	csmMaxExceptionLength = 128
+	csmMaxMessageLength   = 512
+)
+
+// A csmReporter sends the Client Side Monitoring datagrams of a client's API
+// calls and attempts to the CSM agent, as JSON over UDP.
+type csmReporter struct {
+	clientID string
+	ch       chan []byte
+}
+
+// csmAgents are the connections to the CSM agents datagrams are sent to, by
+// address. All clients reporting to an agent share its connection.
+var csmAgents struct {
+	sync.Mutex
+	conns map[string]chan []byte
+}
+
+// newCSMReporter returns a reporter sending the datagrams of cfg's clients
+// to the CSM agent at cfg's CSMHost and CSMPort, or nil if the agent's
+// address cannot be dialed.
+func newCSMReporter(cfg *Config) *csmReporter {
+	host, port := cfg.CSMHost, cfg.CSMPort
+	if host == "" {
+		host = DefaultCSMHost
+	}
+	if port == 0 {
+		port = DefaultCSMPort
+	}
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+
+	csmAgents.Lock()
+	defer csmAgents.Unlock()
+
+	ch, ok := csmAgents.conns[addr]
+	if !ok {
+		conn, err := net.Dial("udp", addr)
+		if err != nil {
+			return nil
+		}
+
+		ch = make(chan []byte, csmBufferSize)
+		go func() {
+			for b := range ch {
+				conn.Write(b)
+			}
+		}()
+
+		if csmAgents.conns == nil {
+			csmAgents.conns = map[string]chan []byte{}
+		}
+		csmAgents.conns[addr] = ch
+	}
+
+	return &csmReporter{clientID: cfg.CSMClientID, ch: ch}
+}
+
+// csmAttempt is the ApiCallAttempt datagram of an attempt of a request.
+type csmAttempt struct {
+	Version        int    `json:"Version"`
+	Type           string `json:"Type"`
+	ClientID       string `json:"ClientId"`
+	Service        string `json:"Service"`
+	API            string `json:"Api"`
+	Timestamp      int64  `json:"Timestamp"`
+	AttemptLatency int64  `json:"AttemptLatency"`
+	Fqdn           string `json:"Fqdn"`
+	UserAgent      string `json:"UserAgent"`
+	AccessKey      string `json:"AccessKey,omitempty"`
+	Region         string `json:"Region"`
+
+	HTTPStatusCode      int    `json:"HttpStatusCode,omitempty"`
+	AwsException        string `json:"AwsException,omitempty"`
+	AwsExceptionMessage string `json:"AwsExceptionMessage,omitempty"`
+	SdkException        string `json:"SdkException,omitempty"`
+	SdkExceptionMessage string `json:"SdkExceptionMessage,omitempty"`
+	XAmznRequestID      string `json:"XAmznRequestId,omitempty"`
+	XAmzRequestID       string `json:"XAmzRequestId,omitempty"`
+	XAmzID2             string `json:"XAmzId2,omitempty"`
+}
+
+// csmCall is the ApiCall datagram of a request, sent when it completes.
+type csmCall struct {
+	Version            int    `json:"Version"`
+	Type               string `json:"Type"`
+	ClientID           string `json:"ClientId"`
+	Service            string `json:"Service"`
+	API                string `json:"Api"`
+	Timestamp          int64  `json:"Timestamp"`
+	Latency            int64  `json:"Latency"`
+	AttemptCount       uint   `json:"AttemptCount"`
+	Region             string `json:"Region"`
+	MaxRetriesExceeded int    `json:"MaxRetriesExceeded"`
+
+	FinalHTTPStatusCode      int    `json:"FinalHttpStatusCode,omitempty"`
+	FinalAwsException        string `json:"FinalAwsException,omitempty"`
+	FinalAwsExceptionMessage string `json:"FinalAwsExceptionMessage,omitempty"`
+	FinalSdkException        string `json:"FinalSdkException,omitempty"`
+	FinalSdkExceptionMessage string `json:"FinalSdkExceptionMessage,omitempty"`
+}
+
+// reportAttempt sends the ApiCallAttempt datagram of the request's attempt
+// sent at start.
+func (c *csmReporter) reportAttempt(r *Request, start time.Time) {
+	if c == nil {
+		return
+	}
+
+	m := csmAttempt{
+		Version:        csmVersion,
+		Type:           "ApiCallAttempt",
+		ClientID:       c.clientID,
+		Service:        r.ServiceID,
+		API:            r.Operation.Name,
+		Timestamp:      start.UnixNano() / int64(time.Millisecond),
+		AttemptLatency: csmMilliseconds(time.Since(start)),
+		Fqdn:           r.HTTPRequest.URL.Host,
+		UserAgent:      truncate(r.HTTPRequest.Header.Get("User-Agent"), csmMaxUserAgentLength),
+		Region:         r.Config.Region,
+	}
+	if r.Config.Credentials != nil {
+		if creds, err := r.Config.Credentials.Get(); err == nil {
+			m.AccessKey = creds.AccessKeyID
+		}
+	}
+	if resp := r.HTTPResponse; resp != nil && resp.StatusCode != 0 {
+		m.HTTPStatusCode = resp.StatusCode
+		m.XAmznRequestID = resp.Header.Get("X-Amzn-Requestid")
+		m.XAmzRequestID = resp.Header.Get("X-Amz-Request-Id")
+		m.XAmzID2 = resp.Header.Get("X-Amz-Id-2")
+	}
+	m.AwsException, m.AwsExceptionMessage, m.SdkException, m.SdkExceptionMessage = csmException(r.Error)
+
+	c.send(m)
+}
+
+// reportCall sends the ApiCall datagram of the request sent at start.
+func (c *csmReporter) reportCall(r *Request, start time.Time) {
+	if c == nil {
+		return
+	}
+
+	m := csmCall{
+		Version:      csmVersion,
+		Type:         "ApiCall",
+		ClientID:     c.clientID,
+		Service:      r.ServiceID,
+		API:          r.Operation.Name,
+		Timestamp:    start.UnixNano() / int64(time.Millisecond),
+		Latency:      csmMilliseconds(time.Since(start)),
+		AttemptCount: r.RetryCount + 1,
+		Region:       r.Config.Region,
+	}
+	if r.Error != nil && r.Retryable.Get() && !r.WillRetry() {
+		m.MaxRetriesExceeded = 1
+	}
+	if resp := r.HTTPResponse; resp != nil && resp.StatusCode != 0 {
+		m.FinalHTTPStatusCode = resp.StatusCode
+	}
+	m.FinalAwsException, m.FinalAwsExceptionMessage, m.FinalSdkException, m.FinalSdkExceptionMessage = csmException(r.Error)
+
+	c.send(m)
+}
+
+// send queues the datagram to be sent to the agent, or drops it if the
+// agent's buffer is full.
+func (c *csmReporter) send(m interface{}) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return
+	}
+
+	select {
+	case c.ch <- b:
+	default:
+	}
+}
+
+// csmException returns the code and message of err as the exception of a
+// service error response, or as the exception of an SDK error, e.g. a
+// connection failure.
+func csmException(err error) (awsCode, awsMessage, sdkCode, sdkMessage string) {
+	if err == nil {
+		return
+	}
+
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() != 0 {
+		return truncate(reqErr.Code(), csmMaxExceptionLength), truncate(reqErr.Message(), csmMaxMessageLength), "", ""
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return "", "", truncate(aerr.Code(), csmMaxExceptionLength), truncate(aerr.Message(), csmMaxMessageLength)
+	}
+	return "", "", "UnknownError", truncate(err.Error(), csmMaxMessageLength)
+}
+
+// csmMilliseconds returns d in whole milliseconds.
+func csmMilliseconds(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
+
+// truncate returns the first n bytes of s.
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
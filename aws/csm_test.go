@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSMDatagrams(t *testing.T) {
+	agent, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer agent.Close()
+
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 200, Header: http.Header{"X-Amzn-Requestid": []string{"request-id"}}, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{
+		Region:         "mock-region",
+		Credentials:    credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		MaxRetries:     10,
+		RetryBaseDelay: time.Millisecond,
+		CSMEnabled:     true,
+		CSMPort:        agent.LocalAddr().(*net.UDPAddr).Port,
+		CSMClientID:    "client-id",
+	})
+	s.ServiceID = "Mock Service"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+
+	var datagrams []map[string]interface{}
+	buf := make([]byte, 4096)
+	agent.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for i := 0; i < 3; i++ {
+		n, _, err := agent.ReadFrom(buf)
+		if !assert.NoError(t, err) {
+			return
+		}
+		var m map[string]interface{}
+		assert.NoError(t, json.Unmarshal(buf[:n], &m))
+		datagrams = append(datagrams, m)
+	}
+
+	for _, m := range datagrams {
+		assert.Equal(t, float64(1), m["Version"])
+		assert.Equal(t, "client-id", m["ClientId"])
+		assert.Equal(t, "Mock Service", m["Service"])
+		assert.Equal(t, "Operation", m["Api"])
+		assert.Equal(t, "mock-region", m["Region"])
+	}
+
+	assert.Equal(t, "ApiCallAttempt", datagrams[0]["Type"])
+	assert.Equal(t, float64(500), datagrams[0]["HttpStatusCode"])
+	assert.Equal(t, "UnknownError", datagrams[0]["AwsException"])
+	assert.Equal(t, "AKID", datagrams[0]["AccessKey"])
+
+	assert.Equal(t, "ApiCallAttempt", datagrams[1]["Type"])
+	assert.Equal(t, float64(200), datagrams[1]["HttpStatusCode"])
+	assert.Equal(t, "request-id", datagrams[1]["XAmznRequestId"])
+	assert.Nil(t, datagrams[1]["AwsException"])
+
+	assert.Equal(t, "ApiCall", datagrams[2]["Type"])
+	assert.Equal(t, float64(2), datagrams[2]["AttemptCount"])
+	assert.Equal(t, float64(200), datagrams[2]["FinalHttpStatusCode"])
+	assert.Equal(t, float64(0), datagrams[2]["MaxRetriesExceeded"])
+}
+
+func TestCSMDisabled(t *testing.T) {
+	s := NewService(&Config{})
+	assert.Nil(t, s.csm)
+}
+
+func TestCSMException(t *testing.T) {
+	awsCode, awsMsg, sdkCode, sdkMsg := csmException(awserr.New("RequestError", "send request failed", nil))
+	assert.Equal(t, "", awsCode)
+	assert.Equal(t, "", awsMsg)
+	assert.Equal(t, "RequestError", sdkCode)
+	assert.Equal(t, "send request failed", sdkMsg)
+
+	awsCode, _, sdkCode, _ = csmException(awserr.NewRequestFailure(awserr.New("Throttling", "Rate exceeded", nil), 400, ""))
+	assert.Equal(t, "Throttling", awsCode)
+	assert.Equal(t, "", sdkCode)
+}
@@ -0,0 +1,80 @@
+// Package defaults provides the default HTTP client and transport service
+// clients send requests with.
+//
+// Unlike http.DefaultClient, the default client limits the time spent
+// connecting, negotiating TLS, and waiting for the response headers of a
+// request, so a request to an unresponsive host fails instead of hanging.
+//
+// The client can be used as the base of a customized client:
+//
+//     client := defaults.HTTPClient()
+//     client.Transport.(*http.Transport).MaxIdleConns = 500
+//     svc := s3.New(&aws.Config{HTTPClient: client})
+//
+// To verify servers with a custom TLS configuration, set the transport's
+// TLSClientConfig, e.g. to the configuration returned by CABundleTLSConfig.
+package defaults
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// The timeouts and connection pool limits of the default HTTP transport.
+const (
+	DialTimeout           = 30 * time.Second
+	DialKeepAlive         = 30 * time.Second
+	TLSHandshakeTimeout   = 10 * time.Second
+	ResponseHeaderTimeout = time.Minute
+	ExpectContinueTimeout = time.Second
+	IdleConnTimeout       = 90 * time.Second
+	MaxIdleConns          = 100
+	MaxIdleConnsPerHost   = MaxIdleConns
+)
+
+// HTTPClient returns a new HTTP client using a new HTTPTransport. The client
+// itself has no Timeout, the timeout of a whole request including its
+// retries is left to the request's context.
+func HTTPClient() *http.Client {
+	return &http.Client{Transport: HTTPTransport()}
+}
+
+// HTTPTransport returns a new HTTP transport with the default timeouts and
+// connection pool limits. Proxies are configured by the HTTP_PROXY,
+// HTTPS_PROXY, and NO_PROXY environment variables.
+//
+// Unlike http.DefaultTransport, which keeps 2 idle connections per host, the
+// transport keeps up to MaxIdleConnsPerHost idle connections to each host, as
+// clients usually send their requests to a single host, e.g. the endpoint of
+// their service. HTTP/2 is used with servers which support it.
+func HTTPTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   DialTimeout,
+			KeepAlive: DialKeepAlive,
+		}).DialContext,
+		TLSHandshakeTimeout:   TLSHandshakeTimeout,
+		ResponseHeaderTimeout: ResponseHeaderTimeout,
+		ExpectContinueTimeout: ExpectContinueTimeout,
+		IdleConnTimeout:       IdleConnTimeout,
+		MaxIdleConns:          MaxIdleConns,
+		MaxIdleConnsPerHost:   MaxIdleConnsPerHost,
+		ForceAttemptHTTP2:     true,
+	}
+}
+
+// CABundleTLSConfig returns a TLS configuration which verifies servers with
+// the CA certificates of the PEM encoded bundle, instead of the system's
+// certificate pool. An error is returned if the bundle has no certificates.
+func CABundleTLSConfig(bundle []byte) (*tls.Config, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, errors.New("no certificates found in the CA bundle")
+	}
+	return &tls.Config{RootCAs: pool}, nil
+}
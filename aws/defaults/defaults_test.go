@@ -0,0 +1,46 @@
+package defaults
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPClient(t *testing.T) {
+	c := HTTPClient()
+	assert.NotEqual(t, http.DefaultClient, c)
+	assert.Zero(t, c.Timeout)
+
+	tr := c.Transport.(*http.Transport)
+	assert.NotNil(t, tr.DialContext)
+	assert.NotNil(t, tr.Proxy)
+	assert.Equal(t, TLSHandshakeTimeout, tr.TLSHandshakeTimeout)
+	assert.Equal(t, ResponseHeaderTimeout, tr.ResponseHeaderTimeout)
+	assert.Equal(t, IdleConnTimeout, tr.IdleConnTimeout)
+	assert.Equal(t, MaxIdleConns, tr.MaxIdleConns)
+	assert.Equal(t, MaxIdleConnsPerHost, tr.MaxIdleConnsPerHost)
+	assert.True(t, tr.ForceAttemptHTTP2)
+
+	// Each client has its own transport.
+	assert.True(t, c.Transport != HTTPClient().Transport)
+}
+
+func TestCABundleTLSConfig(t *testing.T) {
+	_, err := CABundleTLSConfig([]byte("not a certificate"))
+	assert.Error(t, err)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	cfg, err := CABundleTLSConfig(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	assert.NoError(t, err)
+
+	tr := HTTPTransport()
+	tr.TLSClientConfig = cfg
+	resp, err := (&http.Client{Transport: tr}).Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+}
@@ -0,0 +1,191 @@
+// Package dnscache provides a caching DNS resolver for service clients, so a
+// client sending many requests to the same endpoint, e.g.
+// dynamodb.us-east-1.amazonaws.com, does not resolve the endpoint's host for
+// each new connection.
+//
+// A Resolver is a Dialer, which resolves the host of the addresses it dials
+// with its cache:
+//
+//     svc := dynamodb.New(&aws.Config{Dialer: dnscache.New(time.Minute)})
+//
+// The addresses of a host are cached for the resolver's TTL. Expired
+// addresses of a host which is still dialed are refreshed in the background,
+// and used until they are refreshed, so dialing does not wait for the host to
+// be resolved again. The addresses are dialed in turn, spreading the
+// connections over them.
+package dnscache
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+)
+
+// DefaultTTL is the TTL of the addresses cached by resolvers with no TTL.
+const DefaultTTL = time.Minute
+
+// now returns the current time, replaced by tests.
+var now = time.Now
+
+// A Resolver caches the addresses of the hosts it resolves for its TTL. A
+// Resolver is safe for concurrent use, and can be shared by service clients.
+type Resolver struct {
+	// How long the addresses of a host are cached for. Defaults to
+	// DefaultTTL.
+	TTL time.Duration
+
+	// LookupHost resolves a host to its addresses. Defaults to
+	// net.DefaultResolver's LookupHost.
+	LookupHost func(ctx aws.Context, host string) ([]string, error)
+
+	// The dialer connections to the resolved addresses are opened with.
+	// Defaults to a net.Dialer with the default timeouts.
+	Dialer aws.Dialer
+
+	mu    sync.Mutex
+	hosts map[string]*entry
+}
+
+// An entry is the cached addresses of a host.
+type entry struct {
+	addrs      []string
+	expires    time.Time
+	next       int  // the index of the address dialed next
+	refreshing bool // if the addresses are being refreshed
+	used       bool // if the addresses were used since they were resolved
+}
+
+// New returns a Resolver caching the addresses of hosts for the TTL.
+func New(ttl time.Duration) *Resolver {
+	return &Resolver{TTL: ttl}
+}
+
+// DialContext connects to the address on the named network, like
+// net.Dialer's DialContext. The host of the address is resolved with the
+// resolver's cache, and its addresses are tried in turn until one connects.
+func (r *Resolver) DialContext(ctx aws.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || net.ParseIP(host) != nil {
+		return r.dialer().DialContext(ctx, network, address)
+	}
+
+	addrs, err := r.Lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var conn net.Conn
+	for _, addr := range addrs {
+		conn, err = r.dialer().DialContext(ctx, network, net.JoinHostPort(addr, port))
+		if err == nil {
+			return conn, nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, err
+}
+
+// Lookup returns the addresses of the host, starting with the address to be
+// dialed next. Cached addresses are returned until they expire. Expired
+// addresses of hosts which were looked up since they were resolved are
+// returned while they are refreshed in the background, other hosts are
+// resolved again.
+func (r *Resolver) Lookup(ctx aws.Context, host string) ([]string, error) {
+	r.mu.Lock()
+	e, ok := r.hosts[host]
+	if ok && (now().Before(e.expires) || e.used) {
+		if !now().Before(e.expires) && !e.refreshing {
+			e.refreshing = true
+			go r.refresh(host)
+		}
+		e.used = true
+		addrs := e.rotate()
+		r.mu.Unlock()
+		return addrs, nil
+	}
+	r.mu.Unlock()
+
+	addrs, err := r.lookupHost()(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	r.add(host, addrs, true)
+	return addrs, nil
+}
+
+// refresh resolves the host again in the background, removing the host's
+// addresses if it cannot be resolved, so it is resolved again when it is
+// next looked up.
+func (r *Resolver) refresh(host string) {
+	addrs, err := r.lookupHost()(context.Background(), host)
+	if err != nil {
+		r.mu.Lock()
+		delete(r.hosts, host)
+		r.mu.Unlock()
+		return
+	}
+	r.add(host, addrs, false)
+}
+
+// add caches the addresses of the host, removing the hosts whose addresses
+// expired without being used.
+func (r *Resolver) add(host string, addrs []string, used bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t := now()
+	for h, e := range r.hosts {
+		if !t.Before(e.expires) && !e.used && !e.refreshing {
+			delete(r.hosts, h)
+		}
+	}
+	if r.hosts == nil {
+		r.hosts = map[string]*entry{}
+	}
+
+	e := &entry{addrs: addrs, expires: t.Add(r.ttl()), used: used}
+	if used {
+		e.next = 1
+	}
+	r.hosts[host] = e
+}
+
+// rotate returns the addresses of the entry starting with the address dialed
+// next, and advances the address dialed next.
+func (e *entry) rotate() []string {
+	i := e.next % len(e.addrs)
+	e.next = i + 1
+	return append(append([]string{}, e.addrs[i:]...), e.addrs[:i]...)
+}
+
+func (r *Resolver) ttl() time.Duration {
+	if r.TTL > 0 {
+		return r.TTL
+	}
+	return DefaultTTL
+}
+
+func (r *Resolver) lookupHost() func(aws.Context, string) ([]string, error) {
+	if r.LookupHost != nil {
+		return r.LookupHost
+	}
+	return net.DefaultResolver.LookupHost
+}
+
+var defaultDialer = &net.Dialer{
+	Timeout:   defaults.DialTimeout,
+	KeepAlive: defaults.DialKeepAlive,
+}
+
+func (r *Resolver) dialer() aws.Dialer {
+	if r.Dialer != nil {
+		return r.Dialer
+	}
+	return defaultDialer
+}
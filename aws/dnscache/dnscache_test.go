@@ -0,0 +1,175 @@
+package dnscache
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockLookup resolves hosts to its addrs, recording the hosts it resolves.
+type mockLookup struct {
+	sync.Mutex
+	addrs   []string
+	err     error
+	lookups []string
+	done    chan struct{}
+}
+
+func (m *mockLookup) LookupHost(ctx aws.Context, host string) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	if m.done != nil {
+		defer close(m.done)
+	}
+	m.lookups = append(m.lookups, host)
+	return m.addrs, m.err
+}
+
+func (m *mockLookup) count() int {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.lookups)
+}
+
+// setNow sets the current time of the resolvers to tm, returning the
+// function restoring it.
+func setNow(tm *time.Time) func() {
+	now = func() time.Time { return *tm }
+	return func() { now = time.Now }
+}
+
+func TestLookupCached(t *testing.T) {
+	tm := time.Unix(0, 0)
+	defer setNow(&tm)()
+	m := &mockLookup{addrs: []string{"10.0.0.1"}}
+	r := &Resolver{TTL: time.Minute, LookupHost: m.LookupHost}
+
+	for i := 0; i < 3; i++ {
+		addrs, err := r.Lookup(aws.BackgroundContext(), "example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.1"}, addrs)
+	}
+	assert.Equal(t, []string{"example.com"}, m.lookups)
+
+	_, err := r.Lookup(aws.BackgroundContext(), "other.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, m.count())
+}
+
+func TestLookupRefreshedInBackground(t *testing.T) {
+	tm := time.Unix(0, 0)
+	defer setNow(&tm)()
+	m := &mockLookup{addrs: []string{"10.0.0.1"}}
+	r := &Resolver{TTL: time.Minute, LookupHost: m.LookupHost}
+
+	_, err := r.Lookup(aws.BackgroundContext(), "example.com")
+	assert.NoError(t, err)
+
+	// Expired addresses are used while they are refreshed.
+	tm = tm.Add(time.Minute)
+	m.Lock()
+	m.addrs, m.done = []string{"10.0.0.2"}, make(chan struct{})
+	done := m.done
+	m.Unlock()
+	addrs, err := r.Lookup(aws.BackgroundContext(), "example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1"}, addrs)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the addresses to be refreshed")
+	}
+	assert.Eventually(t, func() bool {
+		addrs, _ := r.Lookup(aws.BackgroundContext(), "example.com")
+		return len(addrs) == 1 && addrs[0] == "10.0.0.2"
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 2, m.count())
+}
+
+func TestLookupUnusedExpired(t *testing.T) {
+	tm := time.Unix(0, 0)
+	defer setNow(&tm)()
+	m := &mockLookup{addrs: []string{"10.0.0.1"}}
+	r := &Resolver{TTL: time.Minute, LookupHost: m.LookupHost}
+
+	_, err := r.Lookup(aws.BackgroundContext(), "example.com")
+	assert.NoError(t, err)
+	r.mu.Lock()
+	r.hosts["example.com"].used = false
+	r.mu.Unlock()
+
+	// Hosts which were not looked up since they were resolved are resolved
+	// again once they expire.
+	tm = tm.Add(time.Minute)
+	m.Lock()
+	m.addrs = []string{"10.0.0.2"}
+	m.Unlock()
+	addrs, err := r.Lookup(aws.BackgroundContext(), "example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.2"}, addrs)
+	assert.Equal(t, 2, m.count())
+}
+
+func TestLookupFailed(t *testing.T) {
+	m := &mockLookup{err: errors.New("no such host")}
+	r := &Resolver{LookupHost: m.LookupHost}
+
+	for i := 0; i < 2; i++ {
+		_, err := r.Lookup(aws.BackgroundContext(), "example.com")
+		assert.Equal(t, m.err, err)
+	}
+	assert.Equal(t, 2, m.count(), "expect failed lookups not to be cached")
+}
+
+// recordingDialer records the addresses it dials, failing to connect to the
+// addresses in fail.
+type recordingDialer struct {
+	dialed []string
+	fail   map[string]bool
+}
+
+func (d *recordingDialer) DialContext(ctx aws.Context, network, address string) (net.Conn, error) {
+	d.dialed = append(d.dialed, address)
+	if d.fail[address] {
+		return nil, errors.New("connection refused")
+	}
+	client, server := net.Pipe()
+	server.Close()
+	return client, nil
+}
+
+func TestDialContext(t *testing.T) {
+	m := &mockLookup{addrs: []string{"10.0.0.1", "10.0.0.2"}}
+	d := &recordingDialer{fail: map[string]bool{"10.0.0.2:443": true}}
+	r := &Resolver{LookupHost: m.LookupHost, Dialer: d}
+
+	for i := 0; i < 2; i++ {
+		conn, err := r.DialContext(aws.BackgroundContext(), "tcp", "example.com:443")
+		if assert.NoError(t, err) {
+			conn.Close()
+		}
+	}
+	_, err := r.DialContext(aws.BackgroundContext(), "tcp", "10.0.0.3:443")
+	assert.NoError(t, err)
+
+	// The addresses are dialed in turn, and the next address is dialed if an
+	// address fails to connect.
+	assert.Equal(t, []string{"10.0.0.1:443", "10.0.0.2:443", "10.0.0.1:443", "10.0.0.3:443"}, d.dialed)
+	assert.Equal(t, 1, m.count())
+}
+
+func TestDialContextLookupFailed(t *testing.T) {
+	m := &mockLookup{err: errors.New("no such host")}
+	d := &recordingDialer{}
+	r := &Resolver{LookupHost: m.LookupHost, Dialer: d}
+
+	_, err := r.DialContext(aws.BackgroundContext(), "tcp", "example.com:443")
+	assert.Equal(t, m.err, err)
+	assert.Empty(t, d.dialed)
+}
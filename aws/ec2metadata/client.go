@@ -0,0 +1,134 @@
+// Package ec2metadata provides a client for the EC2 instance metadata
+// service, which EC2 instances use to retrieve information about themselves,
+// e.g. their region or the credentials of their IAM role.
+//
+// The metadata service can be disabled by setting the environment variable
+// "AWS_EC2_METADATA_DISABLED" to "true", e.g. to avoid delays waiting for the
+// service on hosts which are not EC2 instances. The environment variable
+// "AWS_EC2_METADATA_SERVICE_ENDPOINT" overrides the URL of the service, e.g.
+// "http://[fd00:ec2::254]".
+package ec2metadata
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// DefaultEndpoint is the endpoint of the EC2 instance metadata service.
+const DefaultEndpoint = "http://169.254.169.254/latest"
+
+// DefaultTimeout is the timeout of the HTTP client of a Client created with
+// New. The metadata service responds quickly on EC2 instances, so a short
+// timeout limits the delay on hosts which are not EC2 instances.
+const DefaultTimeout = 5 * time.Second
+
+// ErrEC2MetadataDisabled is returned by the Client if the EC2 metadata
+// service has been disabled by setting the environment variable
+// "AWS_EC2_METADATA_DISABLED" to "true".
+//
+// @readonly
+var ErrEC2MetadataDisabled = awserr.New("EC2MetadataDisabled",
+	"EC2 metadata service is disabled by the AWS_EC2_METADATA_DISABLED environment variable", nil)
+
+// A Client retrieves values from the EC2 instance metadata service.
+type Client struct {
+	// The endpoint of the metadata service. Defaults to the "/latest" path of
+	// the AWS_EC2_METADATA_SERVICE_ENDPOINT environment variable's URL, or
+	// DefaultEndpoint if not set.
+	Endpoint string
+
+	// The HTTP client requests to the metadata service are sent with.
+	// Defaults to a client with a timeout of DefaultTimeout.
+	HTTPClient *http.Client
+}
+
+// New returns a new Client for the default endpoint.
+func New() *Client {
+	return &Client{
+		Endpoint:   defaultEndpoint(),
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// defaultEndpoint returns the endpoint of the metadata service set by the
+// environment, or DefaultEndpoint.
+func defaultEndpoint() string {
+	if endpoint := os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"); endpoint != "" {
+		return strings.TrimRight(endpoint, "/") + "/latest"
+	}
+	return DefaultEndpoint
+}
+
+// GetMetadata returns the value of the instance metadata at the path under
+// "meta-data/", e.g. "instance-id".
+func (c *Client) GetMetadata(path string) (string, error) {
+	return c.GetMetadataWithContext(context.Background(), path)
+}
+
+// GetMetadataWithContext returns the value of the instance metadata at the
+// path under "meta-data/". The request is canceled if the context is
+// canceled.
+func (c *Client) GetMetadataWithContext(ctx context.Context, path string) (string, error) {
+	if strings.EqualFold(os.Getenv("AWS_EC2_METADATA_DISABLED"), "true") {
+		return "", ErrEC2MetadataDisabled
+	}
+
+	endpoint, client := c.Endpoint, c.HTTPClient
+	if endpoint == "" {
+		endpoint = defaultEndpoint()
+	}
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	req, err := http.NewRequest("GET", strings.TrimRight(endpoint, "/")+"/meta-data/"+path, nil)
+	if err != nil {
+		return "", awserr.New("EC2MetadataRequestError", "failed to create EC2 metadata request", err)
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", awserr.New("EC2MetadataRequestError", "failed to get EC2 metadata "+path, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", awserr.New("EC2MetadataRequestError", "failed to read EC2 metadata "+path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", awserr.New("EC2MetadataError",
+			fmt.Sprintf("failed to get EC2 metadata %s, status code %d", path, resp.StatusCode), nil)
+	}
+
+	return string(b), nil
+}
+
+// Region returns the region of the EC2 instance, derived from the
+// availability zone it was placed in.
+func (c *Client) Region() (string, error) {
+	return c.RegionWithContext(context.Background())
+}
+
+// RegionWithContext returns the region of the EC2 instance. The request is
+// canceled if the context is canceled.
+func (c *Client) RegionWithContext(ctx context.Context) (string, error) {
+	az, err := c.GetMetadataWithContext(ctx, "placement/availability-zone")
+	if err != nil {
+		return "", err
+	}
+
+	// The availability zone is the region followed by a letter, e.g.
+	// "us-west-2a".
+	az = strings.TrimSpace(az)
+	if len(az) < 2 {
+		return "", awserr.New("EC2MetadataError", fmt.Sprintf("invalid availability zone %q", az), nil)
+	}
+	return az[:len(az)-1], nil
+}
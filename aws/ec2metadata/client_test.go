@@ -0,0 +1,79 @@
+package ec2metadata
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func initTestServer(path, resp string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(resp))
+	}))
+}
+
+func TestGetMetadata(t *testing.T) {
+	os.Clearenv()
+	server := initTestServer("/latest/meta-data/instance-id", "i-1234567890abcdef0")
+	defer server.Close()
+
+	c := &Client{Endpoint: server.URL + "/latest"}
+	v, err := c.GetMetadata("instance-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1234567890abcdef0", v)
+
+	_, err = c.GetMetadata("missing")
+	assert.Error(t, err)
+	assert.Equal(t, "EC2MetadataError", err.(awserr.Error).Code())
+}
+
+func TestRegion(t *testing.T) {
+	os.Clearenv()
+	server := initTestServer("/latest/meta-data/placement/availability-zone", "us-west-2a")
+	defer server.Close()
+
+	c := New()
+	c.Endpoint = server.URL + "/latest/"
+	region, err := c.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", region)
+}
+
+func TestMetadataDisabled(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	defer os.Unsetenv("AWS_EC2_METADATA_DISABLED")
+
+	server := initTestServer("/latest/meta-data/placement/availability-zone", "us-west-2a")
+	defer server.Close()
+
+	_, err := (&Client{Endpoint: server.URL + "/latest"}).Region()
+	assert.Equal(t, ErrEC2MetadataDisabled, err)
+}
+
+func TestMetadataServiceEndpointEnv(t *testing.T) {
+	os.Clearenv()
+	server := initTestServer("/latest/meta-data/placement/availability-zone", "us-west-2a")
+	defer server.Close()
+
+	os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL+"/")
+	defer os.Unsetenv("AWS_EC2_METADATA_SERVICE_ENDPOINT")
+
+	c := New()
+	assert.Equal(t, server.URL+"/latest", c.Endpoint)
+	region, err := c.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", region)
+
+	region, err = (&Client{}).Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", region)
+}
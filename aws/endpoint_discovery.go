@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeEndpointDiscovery is the awserr.Error code for requests of
+// operations which require endpoint discovery when no endpoint was
+// discovered. Requests whose endpoint operation failed fail with its error.
+const ErrCodeEndpointDiscovery = "EndpointDiscoveryError"
+
+// A DiscoveredEndpoint is an endpoint of a service discovered with the
+// service's endpoint operation, e.g. DescribeEndpoints.
+type DiscoveredEndpoint struct {
+	// The address of the endpoint, a host, e.g.
+	// "dynamodb.us-west-2.amazonaws.com", or a URL.
+	Address string
+
+	// How long the endpoint can be cached for. Endpoints with no cache
+	// period are discovered again for each request.
+	CachePeriod time.Duration
+}
+
+// An endpointCache caches the endpoints discovered for a service client's
+// requests, by identity and Region.
+type endpointCache struct {
+	sync.Mutex
+	endpoints map[string]cachedEndpoint
+}
+
+type cachedEndpoint struct {
+	address string
+	expires time.Time
+}
+
+// get returns the cached address for key, if it has not expired.
+func (c *endpointCache) get(key string) (string, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	ep, ok := c.endpoints[key]
+	if !ok || !time.Now().Before(ep.expires) {
+		return "", false
+	}
+	return ep.address, true
+}
+
+// add caches the endpoint for key until its cache period expires, removing
+// the endpoints which have already expired.
+func (c *endpointCache) add(key string, ep DiscoveredEndpoint) {
+	if ep.CachePeriod <= 0 {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	for k, cached := range c.endpoints {
+		if !now.Before(cached.expires) {
+			delete(c.endpoints, k)
+		}
+	}
+	if c.endpoints == nil {
+		c.endpoints = map[string]cachedEndpoint{}
+	}
+	c.endpoints[key] = cachedEndpoint{address: ep.Address, expires: now.Add(ep.CachePeriod)}
+}
+
+// remove removes the endpoint cached for key.
+func (c *endpointCache) remove(key string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.endpoints, key)
+}
+
+// endpointDiscoveryEnabled returns true if the endpoint of the request is
+// discovered. Requests to configured endpoints are never discovered.
+func (r *Request) endpointDiscoveryEnabled() bool {
+	if r.Service.DiscoverEndpoints == nil || !r.Operation.EndpointDiscovery || r.Service.customEndpoint {
+		return false
+	}
+	return r.Config.EnableEndpointDiscovery || r.Operation.EndpointDiscoveryRequired
+}
+
+// endpointCacheKey returns the key of the endpoint discovered for the
+// request, its Region and the access key ID of its credentials.
+func (r *Request) endpointCacheKey() string {
+	var accessKeyID string
+	if r.Config.Credentials != nil {
+		if creds, err := r.Config.Credentials.Get(); err == nil {
+			accessKeyID = creds.AccessKeyID
+		}
+	}
+	return r.Config.Region + "/" + accessKeyID
+}
+
+// DiscoverEndpointHandler is a request handler sending requests of operations
+// which support endpoint discovery to their discovered endpoint, if endpoint
+// discovery is enabled. Endpoints are discovered with the service's
+// DiscoverEndpoints and cached until they expire. Requests whose endpoint
+// cannot be discovered are sent to the service's endpoint, unless their
+// operation requires endpoint discovery.
+func DiscoverEndpointHandler(r *Request) {
+	if !r.endpointDiscoveryEnabled() {
+		return
+	}
+
+	key := r.endpointCacheKey()
+	address, ok := r.Service.discoveredEndpoints.get(key)
+	if !ok {
+		endpoints, err := r.Service.DiscoverEndpoints(r)
+		if err != nil || len(endpoints) == 0 {
+			if r.Operation.EndpointDiscoveryRequired {
+				r.Error = awserr.New(ErrCodeEndpointDiscovery, "failed to discover endpoint", err)
+				return
+			}
+			// A retry may have been sent to an endpoint discovered before.
+			address = r.Service.Endpoint
+		} else {
+			r.Service.discoveredEndpoints.add(key, endpoints[0])
+			address = endpoints[0].Address
+		}
+	}
+
+	if !strings.Contains(address, "://") {
+		r.HTTPRequest.URL.Host = address
+		return
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		r.Error = awserr.New(ErrCodeEndpointDiscovery, "invalid discovered endpoint "+address, err)
+		return
+	}
+	r.HTTPRequest.URL.Scheme, r.HTTPRequest.URL.Host = u.Scheme, u.Host
+}
+
+// InvalidateEndpointHandler is a request handler removing the
+// cached endpoint of requests rejected by their discovered endpoint, e.g.
+// because it was moved, and retrying the requests with a newly discovered
+// endpoint.
+func InvalidateEndpointHandler(r *Request) {
+	if !r.endpointDiscoveryEnabled() {
+		return
+	}
+
+	invalid := r.HTTPResponse != nil && r.HTTPResponse.StatusCode == 421
+	if err, ok := r.Error.(awserr.Error); ok && err.Code() == "InvalidEndpointException" {
+		invalid = true
+	}
+	if invalid {
+		r.Service.discoveredEndpoints.remove(r.endpointCacheKey())
+		r.Retryable.Set(true)
+	}
+}
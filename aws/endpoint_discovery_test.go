@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDiscoveryService returns a service discovering the endpoints of its
+// requests with discover, which records the hosts its requests are sent to.
+func newDiscoveryService(cfg *Config, hosts *[]string, discover func(*Request) ([]DiscoveredEndpoint, error)) *Service {
+	cfg.Region = "mock-region"
+	cfg.Credentials = credentials.NewStaticCredentials("AKID", "SECRET", "SESSION")
+	cfg.RetryBaseDelay = time.Millisecond
+
+	s := NewService(cfg)
+	s.ServiceName = "mock-service"
+	s.buildEndpoint()
+	s.DiscoverEndpoints = discover
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		*hosts = append(*hosts, r.HTTPRequest.URL.Host)
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(``)}
+	})
+	return s
+}
+
+func TestDiscoverEndpoint(t *testing.T) {
+	var hosts []string
+	discovered := 0
+	s := newDiscoveryService(&Config{EnableEndpointDiscovery: true}, &hosts, func(r *Request) ([]DiscoveredEndpoint, error) {
+		discovered++
+		return []DiscoveredEndpoint{{Address: "discovered.example.com", CachePeriod: time.Minute}}, nil
+	})
+
+	op := &Operation{Name: "Operation", EndpointDiscovery: true}
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, NewRequest(s, op, nil, nil).Send())
+	}
+	assert.NoError(t, NewRequest(s, &Operation{Name: "Other"}, nil, nil).Send())
+
+	assert.Equal(t, 1, discovered)
+	assert.Equal(t, []string{"discovered.example.com", "discovered.example.com", "mock-service.mock-region.amazonaws.com"}, hosts)
+}
+
+func TestDiscoverEndpointCachePeriod(t *testing.T) {
+	var hosts []string
+	discovered := 0
+	s := newDiscoveryService(&Config{EnableEndpointDiscovery: true}, &hosts, func(r *Request) ([]DiscoveredEndpoint, error) {
+		discovered++
+		return []DiscoveredEndpoint{{Address: "https://discovered.example.com"}}, nil
+	})
+
+	op := &Operation{Name: "Operation", EndpointDiscovery: true}
+	for i := 0; i < 2; i++ {
+		r := NewRequest(s, op, nil, nil)
+		assert.NoError(t, r.Send())
+		assert.Equal(t, "https", r.HTTPRequest.URL.Scheme)
+	}
+	assert.Equal(t, 2, discovered)
+	assert.Equal(t, []string{"discovered.example.com", "discovered.example.com"}, hosts)
+}
+
+func TestDiscoverEndpointDisabled(t *testing.T) {
+	for _, cfg := range []*Config{{}, {EnableEndpointDiscovery: true, Endpoint: "https://custom.example.com"}} {
+		var hosts []string
+		s := newDiscoveryService(cfg, &hosts, func(r *Request) ([]DiscoveredEndpoint, error) {
+			t.Error("expected endpoint not to be discovered")
+			return nil, nil
+		})
+
+		assert.NoError(t, NewRequest(s, &Operation{Name: "Operation", EndpointDiscovery: true}, nil, nil).Send())
+		assert.Len(t, hosts, 1)
+		assert.NotEqual(t, "discovered.example.com", hosts[0])
+	}
+}
+
+func TestDiscoverEndpointFailed(t *testing.T) {
+	var hosts []string
+	var discoverErr error
+	s := newDiscoveryService(&Config{}, &hosts, func(r *Request) ([]DiscoveredEndpoint, error) {
+		return nil, discoverErr
+	})
+
+	// Operations which do not require discovery use the service's endpoint.
+	s.Config.EnableEndpointDiscovery = true
+	assert.NoError(t, NewRequest(s, &Operation{Name: "Operation", EndpointDiscovery: true}, nil, nil).Send())
+	assert.Equal(t, []string{"mock-service.mock-region.amazonaws.com"}, hosts)
+
+	// Operations which require discovery fail, even if it is not enabled.
+	s.Config.EnableEndpointDiscovery = false
+	required := &Operation{Name: "Operation", EndpointDiscovery: true, EndpointDiscoveryRequired: true}
+	err := NewRequest(s, required, nil, nil).Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeEndpointDiscovery, err.(awserr.Error).Code())
+
+	discoverErr = awserr.New("AccessDenied", "access denied", nil)
+	err = NewRequest(s, required, nil, nil).Send()
+	assert.Error(t, err)
+	assert.Equal(t, "AccessDenied", err.(awserr.Error).Code())
+	assert.Len(t, hosts, 1)
+}
+
+func TestDiscoveredEndpointInvalidated(t *testing.T) {
+	var hosts []string
+	discovered := 0
+	s := newDiscoveryService(&Config{EnableEndpointDiscovery: true, MaxRetries: 2}, &hosts, func(r *Request) ([]DiscoveredEndpoint, error) {
+		discovered++
+		return []DiscoveredEndpoint{{Address: "discovered" + string('0'+rune(discovered)) + ".example.com", CachePeriod: time.Hour}}, nil
+	})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.PushBack(func(r *Request) {
+		if len(hosts) == 1 {
+			r.HTTPResponse = &http.Response{StatusCode: 421, Body: body(`{"__type":"InvalidEndpointException","message":"Moved."}`)}
+		}
+	})
+
+	assert.NoError(t, NewRequest(s, &Operation{Name: "Operation", EndpointDiscovery: true}, nil, nil).Send())
+	assert.Equal(t, 2, discovered)
+	assert.Equal(t, []string{"discovered1.example.com", "discovered2.example.com"}, hosts)
+}
+
+func TestDiscoveredEndpointsByIdentity(t *testing.T) {
+	var hosts []string
+	s := newDiscoveryService(&Config{EnableEndpointDiscovery: true}, &hosts, func(r *Request) ([]DiscoveredEndpoint, error) {
+		creds, _ := r.Config.Credentials.Get()
+		return []DiscoveredEndpoint{{Address: creds.AccessKeyID + ".example.com", CachePeriod: time.Hour}}, nil
+	})
+
+	op := &Operation{Name: "Operation", EndpointDiscovery: true}
+	assert.NoError(t, NewRequest(s, op, nil, nil).Send())
+	r := NewRequest(s, op, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{Credentials: credentials.NewStaticCredentials("OTHER", "SECRET", "")}))
+	assert.NoError(t, r.Send())
+
+	assert.Equal(t, []string{"AKID.example.com", "OTHER.example.com"}, hosts)
+}
@@ -0,0 +1,31 @@
+package aws
+
+// An EndpointResolver resolves the endpoint a service client sends its
+// requests to. Setting a Config's EndpointResolver allows all clients created
+// with the Config to route their requests, e.g. through a proxy or VPC
+// endpoints, without setting the Endpoint of each client.
+//
+// ResolveEndpoint returns the endpoint, and the region requests to the
+// endpoint are signed for, of the service in the region. The service is the
+// endpoint prefix of the service, e.g. "s3" or "dynamodb". If an empty
+// endpoint is returned with no error the SDK's default endpoint for the
+// service will be used. If signingRegion is empty the Config's Region is
+// used to sign requests.
+type EndpointResolver interface {
+	ResolveEndpoint(service, region string) (endpoint, signingRegion string, err error)
+}
+
+// An EndpointResolverFunc is a convenience type to wrap a function so that
+// the EndpointResolver interface can be satisfied.
+//
+// Example:
+//     cfg := aws.NewConfig().WithEndpointResolver(aws.EndpointResolverFunc(
+//         func(service, region string) (string, string, error) {
+//             return "https://" + service + ".proxy.example.com", region, nil
+//         }))
+type EndpointResolverFunc func(service, region string) (endpoint, signingRegion string, err error)
+
+// ResolveEndpoint calls the wrapped function with the service and region.
+func (fn EndpointResolverFunc) ResolveEndpoint(service, region string) (endpoint, signingRegion string, err error) {
+	return fn(service, region)
+}
@@ -0,0 +1,230 @@
+// Package endpoints provides the AWS endpoints model, resolving the endpoint
+// of a service in a region and enumerating the partitions, regions, and
+// services of the model.
+//
+// A partition is a group of regions which share a DNS suffix, e.g. the
+// standard AWS partition "aws" of the "amazonaws.com" regions, or the China
+// partition "aws-cn" of the "amazonaws.com.cn" regions. A region is resolved
+// by the partition it is a region of, or whose region naming convention it
+// matches. Regions unknown to every partition are resolved by the standard AWS
+// partition.
+//
+// The package's model is a subset of the AWS endpoints model. It contains the
+// services with global endpoints, credential scopes, or endpoint variants,
+// and a few commonly used regional services. Services which are not in the
+// model are resolved with their partition's default hostname, e.g.
+// "{service}.{region}.amazonaws.com", unless the StrictMatching option is set,
+// in which case an UnknownServiceError is returned. Partition.Services and
+// Region.Services only enumerate the services in the model.
+//
+// Example:
+//     ep, err := endpoints.Resolve("s3", "us-west-2")
+//     if err != nil {
+//         return err
+//     }
+//     fmt.Println(ep.URL, ep.SigningRegion)
+package endpoints
+
+//go:generate go run ../../internal/model/cli/gen-partitions/main.go endpoints.json defaults.go
+//go:generate gofmt -s -w defaults.go
+
+// Options provide the configuration of endpoint resolution.
+type Options struct {
+	// Resolves endpoints with the "http" scheme instead of "https".
+	DisableSSL bool
+
+	// Resolves the dual-stack (IPv4 and IPv6) endpoint of services which
+	// have one. Services without dual-stack endpoints resolve their
+	// standard endpoint.
+	UseDualStack bool
+
+	// Resolves the FIPS 140-2 validated endpoint of services which have one.
+	// Takes precedence over UseDualStack.
+	UseFIPSEndpoint bool
+
+	// Resolves the regional STS endpoint, e.g. "sts.us-west-2.amazonaws.com",
+	// instead of the global endpoint of partitions with one.
+	UseSTSRegionalEndpoint bool
+
+	// Returns an error if the service, or its endpoint in the region, is
+	// not in the model instead of deriving the endpoint from the
+	// partition's defaults.
+	StrictMatching bool
+}
+
+// DisableSSLOption sets the DisableSSL option.
+func DisableSSLOption(o *Options) {
+	o.DisableSSL = true
+}
+
+// UseDualStackOption sets the UseDualStack option.
+func UseDualStackOption(o *Options) {
+	o.UseDualStack = true
+}
+
+// UseFIPSEndpointOption sets the UseFIPSEndpoint option.
+func UseFIPSEndpointOption(o *Options) {
+	o.UseFIPSEndpoint = true
+}
+
+// UseSTSRegionalEndpointOption sets the UseSTSRegionalEndpoint option.
+func UseSTSRegionalEndpointOption(o *Options) {
+	o.UseSTSRegionalEndpoint = true
+}
+
+// StrictMatchingOption sets the StrictMatching option.
+func StrictMatchingOption(o *Options) {
+	o.StrictMatching = true
+}
+
+// A ResolvedEndpoint is the endpoint of a service in a region.
+type ResolvedEndpoint struct {
+	// The URL of the endpoint.
+	URL string
+
+	// The ID of the partition the endpoint was resolved from.
+	PartitionID string
+
+	// The region requests to the endpoint are signed for.
+	SigningRegion string
+
+	// The service name requests to the endpoint are signed for.
+	SigningName string
+
+	// Set if the model does not specify the SigningName of the endpoint, and
+	// SigningName is the service's endpoint prefix.
+	SigningNameDerived bool
+}
+
+// Resolve returns the endpoint of the service in the region from the default
+// partitions. The service is the endpoint prefix of the service, e.g. "s3".
+//
+// An error is only returned if the StrictMatching option is set and the
+// endpoint is not in the model.
+func Resolve(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	return defaultPartitions.resolve(service, region, newOptions(opts))
+}
+
+// DefaultPartitions returns the partitions of the SDK's endpoints model.
+func DefaultPartitions() []Partition {
+	ps := make([]Partition, len(defaultPartitions))
+	for i := range defaultPartitions {
+		ps[i] = Partition{p: &defaultPartitions[i]}
+	}
+	return ps
+}
+
+// PartitionForRegion returns the partition of the region, and whether the
+// region is a region of any of the partitions.
+func PartitionForRegion(ps []Partition, region string) (Partition, bool) {
+	for _, p := range ps {
+		if p.p.hasRegion(region) {
+			return p, true
+		}
+	}
+	return Partition{}, false
+}
+
+// A Partition is a group of regions which share a DNS suffix.
+type Partition struct {
+	p *partition
+}
+
+// ID returns the ID of the partition, e.g. "aws".
+func (p Partition) ID() string { return p.p.ID }
+
+// Name returns the name of the partition, e.g. "AWS Standard".
+func (p Partition) Name() string { return p.p.Name }
+
+// DNSSuffix returns the DNS suffix of the partition, e.g. "amazonaws.com".
+func (p Partition) DNSSuffix() string { return p.p.DNSSuffix }
+
+// Resolve returns the endpoint of the service in the region of the
+// partition.
+func (p Partition) Resolve(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	return p.p.resolve(service, region, newOptions(opts))
+}
+
+// Regions returns the regions of the partition keyed by region ID.
+func (p Partition) Regions() map[string]Region {
+	rs := map[string]Region{}
+	for id, r := range p.p.Regions {
+		rs[id] = Region{id: id, desc: r.Description, p: p.p}
+	}
+	return rs
+}
+
+// Services returns the services in the model of the partition keyed by
+// service ID.
+func (p Partition) Services() map[string]Service {
+	ss := map[string]Service{}
+	for id := range p.p.Services {
+		ss[id] = Service{id: id, p: p.p}
+	}
+	return ss
+}
+
+// A Region is a region of a partition.
+type Region struct {
+	id, desc string
+	p        *partition
+}
+
+// ID returns the ID of the region, e.g. "us-east-1".
+func (r Region) ID() string { return r.id }
+
+// Description returns the description of the region, e.g.
+// "US East (N. Virginia)".
+func (r Region) Description() string { return r.desc }
+
+// ResolveEndpoint returns the endpoint of the service in the region.
+func (r Region) ResolveEndpoint(service string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	return r.p.resolve(service, r.id, newOptions(opts))
+}
+
+// Services returns the services which have an endpoint in the region keyed
+// by service ID.
+func (r Region) Services() map[string]Service {
+	ss := map[string]Service{}
+	for id, s := range r.p.Services {
+		if _, ok := s.Endpoints[s.endpointKey(r.id)]; ok {
+			ss[id] = Service{id: id, p: r.p}
+		}
+	}
+	return ss
+}
+
+// A Service is a service in the model of a partition.
+type Service struct {
+	id string
+	p  *partition
+}
+
+// ID returns the ID of the service, e.g. "s3".
+func (s Service) ID() string { return s.id }
+
+// ResolveEndpoint returns the endpoint of the service in the region.
+func (s Service) ResolveEndpoint(region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	return s.p.resolve(s.id, region, newOptions(opts))
+}
+
+// Regions returns the regions the service has an endpoint in keyed by region
+// ID.
+func (s Service) Regions() map[string]Region {
+	rs := map[string]Region{}
+	svc := s.p.Services[s.id]
+	for id, r := range s.p.Regions {
+		if _, ok := svc.Endpoints[svc.endpointKey(id)]; ok {
+			rs[id] = Region{id: id, desc: r.Description, p: s.p}
+		}
+	}
+	return rs
+}
+
+func newOptions(opts []func(*Options)) Options {
+	var o Options
+	for _, fn := range opts {
+		fn(&o)
+	}
+	return o
+}
@@ -0,0 +1,227 @@
+package endpoints
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolve(t *testing.T) {
+	cases := []struct {
+		service, region    string
+		opts               []func(*Options)
+		url, signingRegion string
+	}{
+		{"sqs", "us-west-2", nil, "https://sqs.us-west-2.amazonaws.com", "us-west-2"},
+		{"s3", "us-east-1", nil, "https://s3.amazonaws.com", "us-east-1"},
+		{"s3", "us-west-2", nil, "https://s3-us-west-2.amazonaws.com", "us-west-2"},
+		{"s3", "eu-central-1", nil, "https://s3.eu-central-1.amazonaws.com", "eu-central-1"},
+		{"iam", "us-west-2", nil, "https://iam.amazonaws.com", "us-east-1"},
+		{"sts", "eu-west-1", nil, "https://sts.amazonaws.com", "us-east-1"},
+		{"mock", "mock-region-1", nil, "https://mock.mock-region-1.amazonaws.com", "mock-region-1"},
+		{"sqs", "us-west-2", []func(*Options){DisableSSLOption}, "http://sqs.us-west-2.amazonaws.com", "us-west-2"},
+		{"s3", "us-east-1", []func(*Options){UseDualStackOption}, "https://s3.dualstack.us-east-1.amazonaws.com", "us-east-1"},
+		{"sqs", "us-east-1", []func(*Options){UseDualStackOption}, "https://sqs.us-east-1.amazonaws.com", "us-east-1"},
+		{"sts", "us-west-2", []func(*Options){UseFIPSEndpointOption}, "https://sts-fips.us-west-2.amazonaws.com", "us-west-2"},
+		{"s3", "us-west-2", []func(*Options){UseFIPSEndpointOption, UseDualStackOption}, "https://s3-fips.us-west-2.amazonaws.com", "us-west-2"},
+		{"iam", "us-west-2", []func(*Options){UseFIPSEndpointOption}, "https://iam.amazonaws.com", "us-east-1"},
+	}
+
+	for _, c := range cases {
+		ep, err := Resolve(c.service, c.region, c.opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, c.url, ep.URL, c.service+" "+c.region)
+		assert.Equal(t, c.signingRegion, ep.SigningRegion, c.service+" "+c.region)
+		assert.Equal(t, c.service, ep.SigningName)
+		assert.True(t, ep.SigningNameDerived)
+		assert.Equal(t, "aws", ep.PartitionID)
+	}
+}
+
+func TestResolveSigningName(t *testing.T) {
+	for _, region := range []string{"us-west-2", "cn-north-1", "us-gov-west-1"} {
+		ep, err := Resolve("streams.dynamodb", region, StrictMatchingOption)
+		assert.NoError(t, err)
+		assert.Equal(t, "dynamodb", ep.SigningName, region)
+		assert.False(t, ep.SigningNameDerived, region)
+		assert.Equal(t, region, ep.SigningRegion)
+	}
+}
+
+func TestResolveStrictMatching(t *testing.T) {
+	ep, err := Resolve("s3", "us-west-2", StrictMatchingOption)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://s3-us-west-2.amazonaws.com", ep.URL)
+
+	ep, err = Resolve("iam", "us-west-2", StrictMatchingOption)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://iam.amazonaws.com", ep.URL)
+
+	_, err = Resolve("s3", "mock-region-1", StrictMatchingOption)
+	assert.Error(t, err)
+	assert.Equal(t, "UnknownEndpointError", err.(awserr.Error).Code())
+
+	_, err = DefaultPartitions()[0].Resolve("mock", "us-west-2", StrictMatchingOption)
+	assert.Error(t, err)
+	assert.Equal(t, "UnknownServiceError", err.(awserr.Error).Code())
+}
+
+func TestDefaultPartitions(t *testing.T) {
+	ps := DefaultPartitions()
+	assert.Len(t, ps, 3)
+
+	p := ps[0]
+	assert.Equal(t, "aws", p.ID())
+	assert.Equal(t, "AWS Standard", p.Name())
+	assert.Equal(t, "amazonaws.com", p.DNSSuffix())
+
+	r, ok := p.Regions()["us-east-1"]
+	assert.True(t, ok)
+	assert.Equal(t, "us-east-1", r.ID())
+	assert.Equal(t, "US East (N. Virginia)", r.Description())
+
+	ep, err := r.ResolveEndpoint("s3")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://s3.amazonaws.com", ep.URL)
+
+	assert.Contains(t, r.Services(), "iam")
+	assert.NotContains(t, r.Services(), "lambda")
+
+	s, ok := p.Services()["sdb"]
+	assert.True(t, ok)
+	assert.Equal(t, "sdb", s.ID())
+	assert.Len(t, s.Regions(), 1)
+	assert.Contains(t, s.Regions(), "us-east-1")
+
+	ep, err = s.ResolveEndpoint("us-east-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sdb.amazonaws.com", ep.URL)
+
+	assert.Len(t, p.Services()["iam"].Regions(), len(p.Regions()))
+}
+
+func TestPartitionForRegion(t *testing.T) {
+	ps := DefaultPartitions()
+
+	p, ok := PartitionForRegion(ps, "us-west-2")
+	assert.True(t, ok)
+	assert.Equal(t, "aws", p.ID())
+
+	// Regions matching the partition's naming convention.
+	p, ok = PartitionForRegion(ps, "ap-northeast-2")
+	assert.True(t, ok)
+	assert.Equal(t, "aws", p.ID())
+
+	p, ok = PartitionForRegion(ps, "cn-north-1")
+	assert.True(t, ok)
+	assert.Equal(t, "aws-cn", p.ID())
+	assert.Equal(t, "amazonaws.com.cn", p.DNSSuffix())
+
+	p, ok = PartitionForRegion(ps, "us-gov-west-1")
+	assert.True(t, ok)
+	assert.Equal(t, "aws-us-gov", p.ID())
+
+	_, ok = PartitionForRegion(ps, "mock-region-1")
+	assert.False(t, ok)
+}
+
+func TestGlobalEndpoints(t *testing.T) {
+	region := "mock-region-1"
+	svcs := []string{"cloudfront", "iam", "importexport", "route53", "sts"}
+
+	for _, name := range svcs {
+		ep, err := Resolve(name, region)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://"+name+".amazonaws.com", ep.URL)
+		assert.Equal(t, "us-east-1", ep.SigningRegion)
+	}
+}
+
+func TestServicesInCN(t *testing.T) {
+	region := "cn-north-1"
+	svcs := []string{"cloudfront", "iam", "importexport", "route53", "sts", "s3"}
+
+	for _, name := range svcs {
+		ep, err := Resolve(name, region)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://"+name+"."+region+".amazonaws.com.cn", ep.URL)
+		assert.Equal(t, region, ep.SigningRegion)
+		assert.Equal(t, "aws-cn", ep.PartitionID)
+	}
+}
+
+func TestPartitionEndpoints(t *testing.T) {
+	cases := []struct {
+		service, region                 string
+		opts                            []func(*Options)
+		url, signingRegion, partitionID string
+	}{
+		{"s3", "cn-north-1", []func(*Options){UseDualStackOption}, "https://s3.dualstack.cn-north-1.amazonaws.com.cn", "cn-north-1", "aws-cn"},
+		{"sts", "cn-north-1", []func(*Options){UseFIPSEndpointOption}, "https://sts.cn-north-1.amazonaws.com.cn", "cn-north-1", "aws-cn"},
+		{"sqs", "cn-northwest-1", nil, "https://sqs.cn-northwest-1.amazonaws.com.cn", "cn-northwest-1", "aws-cn"},
+		{"iam", "us-gov-west-1", nil, "https://iam.us-gov.amazonaws.com", "us-gov-west-1", "aws-us-gov"},
+		{"sts", "us-gov-west-1", nil, "https://sts.us-gov-west-1.amazonaws.com", "us-gov-west-1", "aws-us-gov"},
+		{"s3", "us-gov-west-1", nil, "https://s3-us-gov-west-1.amazonaws.com", "us-gov-west-1", "aws-us-gov"},
+		{"s3", "us-gov-west-1", []func(*Options){UseFIPSEndpointOption}, "https://s3-fips-us-gov-west-1.amazonaws.com", "us-gov-west-1", "aws-us-gov"},
+		{"cloudfront", "us-gov-west-1", nil, "https://cloudfront.us-gov-west-1.amazonaws.com", "us-gov-west-1", "aws-us-gov"},
+	}
+
+	for _, c := range cases {
+		ep, err := Resolve(c.service, c.region, c.opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, c.url, ep.URL, c.service+" "+c.region)
+		assert.Equal(t, c.signingRegion, ep.SigningRegion, c.service+" "+c.region)
+		assert.Equal(t, c.partitionID, ep.PartitionID, c.service+" "+c.region)
+	}
+
+	// Global endpoints of a partition do not resolve regions of other
+	// partitions.
+	_, err := Resolve("iam", "cn-north-1", StrictMatchingOption)
+	assert.NoError(t, err)
+	_, err = DefaultPartitions()[0].Resolve("iam", "aws-cn-global", StrictMatchingOption)
+	assert.Error(t, err)
+}
+
+func TestResolveSTSRegionalEndpoint(t *testing.T) {
+	cases := []struct {
+		region, url, signingRegion string
+	}{
+		{"us-west-2", "https://sts.us-west-2.amazonaws.com", "us-west-2"},
+		{"ap-northeast-2", "https://sts.ap-northeast-2.amazonaws.com", "ap-northeast-2"},
+		{"cn-north-1", "https://sts.cn-north-1.amazonaws.com.cn", "cn-north-1"},
+		{"aws-global", "https://sts.amazonaws.com", "us-east-1"},
+		{"", "https://sts.amazonaws.com", "us-east-1"},
+	}
+
+	for _, c := range cases {
+		ep, err := Resolve("sts", c.region, UseSTSRegionalEndpointOption)
+		assert.NoError(t, err)
+		assert.Equal(t, c.url, ep.URL, "sts in "+c.region)
+		assert.Equal(t, c.signingRegion, ep.SigningRegion, "sts in "+c.region)
+	}
+
+	ep, err := Resolve("sts", "us-west-2", UseSTSRegionalEndpointOption, StrictMatchingOption)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sts.us-west-2.amazonaws.com", ep.URL)
+
+	// Only STS resolves regional endpoints.
+	ep, err = Resolve("iam", "us-west-2", UseSTSRegionalEndpointOption)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://iam.amazonaws.com", ep.URL)
+}
+
+func TestS3EndpointsUnchanged(t *testing.T) {
+	cases := map[string]string{
+		"us-east-1":    "https://s3.amazonaws.com",
+		"us-west-2":    "https://s3-us-west-2.amazonaws.com",
+		"eu-central-1": "https://s3.eu-central-1.amazonaws.com",
+		"cn-north-1":   "https://s3.cn-north-1.amazonaws.com.cn",
+		"mock-region":  "https://s3.mock-region.amazonaws.com",
+	}
+
+	for region, url := range cases {
+		ep, _ := Resolve("s3", region)
+		assert.Equal(t, url, ep.URL, "s3 in "+region)
+	}
+}
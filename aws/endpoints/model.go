@@ -0,0 +1,201 @@
+package endpoints
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+type partitions []partition
+
+func (ps partitions) resolve(service, region string, opts Options) (ResolvedEndpoint, error) {
+	for i := range ps {
+		if ps[i].canResolveEndpoint(service, region, opts.StrictMatching) {
+			return ps[i].resolve(service, region, opts)
+		}
+	}
+
+	if opts.StrictMatching || len(ps) == 0 {
+		return ResolvedEndpoint{}, awserr.New("UnknownEndpointError",
+			"could not resolve endpoint of service "+service+" in region "+region, nil)
+	}
+
+	// Regions which are not known to any partition are resolved with the
+	// first partition, i.e. the standard AWS partition.
+	return ps[0].resolve(service, region, opts)
+}
+
+type partition struct {
+	ID          string
+	Name        string
+	DNSSuffix   string
+	RegionRegex regionRegex
+	Defaults    endpoint
+	Regions     regions
+	Services    services
+}
+
+// hasRegion returns true if the region is a region of the partition, or
+// matches the partition's region naming convention.
+func (p *partition) hasRegion(region string) bool {
+	if _, ok := p.Regions[region]; ok {
+		return true
+	}
+	return p.RegionRegex.MatchString(region)
+}
+
+// canResolveEndpoint returns true if the partition resolves the endpoint of
+// the service in the region. Regions of other partitions are not resolved
+// by a partition's global endpoints.
+func (p *partition) canResolveEndpoint(service, region string, strict bool) bool {
+	s, hasService := p.Services[service]
+	if _, ok := s.Endpoints[region]; hasService && ok {
+		return true
+	}
+	if !p.hasRegion(region) {
+		return false
+	}
+	if strict {
+		_, ok := s.Endpoints[s.endpointKey(region)]
+		return hasService && ok
+	}
+	return true
+}
+
+func (p *partition) resolve(service, region string, opts Options) (ResolvedEndpoint, error) {
+	s, hasService := p.Services[service]
+	if opts.StrictMatching && !hasService {
+		return ResolvedEndpoint{}, awserr.New("UnknownServiceError",
+			"service "+service+" is not in partition "+p.ID, nil)
+	}
+	if opts.StrictMatching && !p.canResolveEndpoint(service, region, true) {
+		return ResolvedEndpoint{}, awserr.New("UnknownEndpointError",
+			"service "+service+" has no endpoint in region "+region, nil)
+	}
+
+	// Regional variants of the endpoint are resolved for the region, even
+	// for services which are not regionalized.
+	e := p.Defaults
+	e.mergeIn(s.Defaults)
+	regional := e
+	regional.mergeIn(s.Endpoints[region])
+
+	key := s.endpointKey(region)
+	if service == "sts" && opts.UseSTSRegionalEndpoint && p.hasRegion(region) {
+		key = region
+	}
+	e.mergeIn(s.Endpoints[key])
+
+	signingRegion := e.CredentialScope.Region
+	hostname := e.Hostname
+	if opts.UseFIPSEndpoint && regional.FIPSHostname != "" {
+		hostname, signingRegion = regional.FIPSHostname, region
+	} else if opts.UseDualStack && regional.DualStackHostname != "" {
+		hostname, signingRegion = regional.DualStackHostname, region
+	}
+	if signingRegion == "" {
+		signingRegion = region
+	}
+
+	signingName, derived := e.CredentialScope.Service, false
+	if signingName == "" {
+		signingName, derived = service, true
+	}
+
+	scheme := "https"
+	if opts.DisableSSL {
+		scheme = "http"
+	}
+
+	return ResolvedEndpoint{
+		URL:           scheme + "://" + p.expandHostname(hostname, service, region),
+		PartitionID:   p.ID,
+		SigningRegion: signingRegion,
+		SigningName:   signingName,
+
+		SigningNameDerived: derived,
+	}, nil
+}
+
+// expandHostname replaces the service, region, and DNS suffix placeholders
+// of the hostname.
+func (p *partition) expandHostname(hostname, service, region string) string {
+	hostname = strings.Replace(hostname, "{service}", service, -1)
+	hostname = strings.Replace(hostname, "{region}", region, -1)
+	return strings.Replace(hostname, "{dnsSuffix}", p.DNSSuffix, -1)
+}
+
+type regionRegex struct {
+	*regexp.Regexp
+}
+
+type regions map[string]region
+
+type region struct {
+	Description string
+}
+
+type services map[string]service
+
+type service struct {
+	PartitionEndpoint string
+	IsRegionalized    boxedBool
+	Defaults          endpoint
+	Endpoints         endpoints
+}
+
+// endpointKey returns the key of the service's endpoint for the region.
+// Services which are not regionalized use their partition endpoint for
+// regions without an endpoint of their own.
+func (s *service) endpointKey(region string) string {
+	if _, ok := s.Endpoints[region]; ok {
+		return region
+	}
+	if s.IsRegionalized == boxedFalse && s.PartitionEndpoint != "" {
+		return s.PartitionEndpoint
+	}
+	return region
+}
+
+type endpoints map[string]endpoint
+
+type endpoint struct {
+	Hostname          string
+	DualStackHostname string
+	FIPSHostname      string
+	CredentialScope   credentialScope
+}
+
+// mergeIn overrides the values of e with the non-zero values of other.
+func (e *endpoint) mergeIn(other endpoint) {
+	if other.Hostname != "" {
+		e.Hostname = other.Hostname
+	}
+	if other.DualStackHostname != "" {
+		e.DualStackHostname = other.DualStackHostname
+	}
+	if other.FIPSHostname != "" {
+		e.FIPSHostname = other.FIPSHostname
+	}
+	if other.CredentialScope.Region != "" {
+		e.CredentialScope.Region = other.CredentialScope.Region
+	}
+	if other.CredentialScope.Service != "" {
+		e.CredentialScope.Service = other.CredentialScope.Service
+	}
+}
+
+type credentialScope struct {
+	Region  string
+	Service string
+}
+
+// A boxedBool is a bool which may be unset in the model.
+type boxedBool int
+
+const (
+	boxedBoolUnset boxedBool = iota
+	boxedFalse
+	boxedTrue
+)
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// envRegion returns the region set by the "AWS_REGION" environment variable,
+// or "AWS_DEFAULT_REGION" if AWS_REGION is not set.
+func envRegion() string {
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region
+	}
+	return os.Getenv("AWS_DEFAULT_REGION")
+}
+
+// envMaxRetries returns the maximum number of retries derived from the
+// "AWS_MAX_ATTEMPTS" environment variable. The number of attempts includes
+// the initial attempt, so the maximum retries is one less. DefaultRetries is
+// returned if the variable is not set, or is not a positive integer.
+func envMaxRetries() int {
+	attempts, err := strconv.Atoi(os.Getenv("AWS_MAX_ATTEMPTS"))
+	if err != nil || attempts < 1 {
+		return DefaultRetries
+	}
+	return attempts - 1
+}
+
+// envRetryMode returns the retry mode set by the "AWS_RETRY_MODE" environment
+// variable. The "legacy" mode is treated as RetryModeStandard.
+func envRetryMode() string {
+	mode := strings.ToLower(os.Getenv("AWS_RETRY_MODE"))
+	if mode == "legacy" {
+		return RetryModeStandard
+	}
+	return mode
+}
+
+// envSTSRegionalEndpoint returns the STS endpoint set by the
+// "AWS_STS_REGIONAL_ENDPOINTS" environment variable.
+func envSTSRegionalEndpoint() string {
+	return strings.ToLower(os.Getenv("AWS_STS_REGIONAL_ENDPOINTS"))
+}
+
+// envEnableEndpointDiscovery returns true if the
+// "AWS_ENABLE_ENDPOINT_DISCOVERY" environment variable is "true".
+func envEnableEndpointDiscovery() bool {
+	return strings.EqualFold(os.Getenv("AWS_ENABLE_ENDPOINT_DISCOVERY"), "true")
+}
+
+// envS3UseARNRegion returns true if the "AWS_S3_USE_ARN_REGION" environment
+// variable is "true".
+func envS3UseARNRegion() bool {
+	return strings.EqualFold(os.Getenv("AWS_S3_USE_ARN_REGION"), "true")
+}
+
+// envCSMEnabled returns true if the "AWS_CSM_ENABLED" environment variable is
+// "true".
+func envCSMEnabled() bool {
+	return strings.EqualFold(os.Getenv("AWS_CSM_ENABLED"), "true")
+}
+
+// envCSMPort returns the CSM agent port set by the "AWS_CSM_PORT" environment
+// variable, or zero if it is not set or is not a valid port.
+func envCSMPort() int {
+	port, err := strconv.Atoi(os.Getenv("AWS_CSM_PORT"))
+	if err != nil || port < 0 || port > 65535 {
+		return 0
+	}
+	return port
+}
+
+// envCABundleErr is the error loading the "AWS_CA_BUNDLE" file, if any,
+// reported by Config.Validate.
+var envCABundleErr error
+
+// envCABundle returns the contents of the file the "AWS_CA_BUNDLE"
+// environment variable names.
+func envCABundle() []byte {
+	filename := os.Getenv("AWS_CA_BUNDLE")
+	if filename == "" {
+		return nil
+	}
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		envCABundleErr = err
+		return nil
+	}
+	return b
+}
+
+// envEndpointURL returns the endpoint of the service set by the
+// "AWS_ENDPOINT_URL_<SERVICE>" environment variable, or "AWS_ENDPOINT_URL" for
+// all services. <SERVICE> is the service ID upper cased with spaces and dashes
+// replaced by underscores, e.g. "AWS_ENDPOINT_URL_CLOUDWATCH_LOGS" for the
+// "CloudWatch Logs" service. No endpoint is returned if
+// "AWS_IGNORE_CONFIGURED_ENDPOINT_URLS" is "true".
+func envEndpointURL(serviceID string) string {
+	if strings.ToLower(os.Getenv("AWS_IGNORE_CONFIGURED_ENDPOINT_URLS")) == "true" {
+		return ""
+	}
+
+	if serviceID != "" {
+		name := strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(serviceID))
+		if endpoint := os.Getenv("AWS_ENDPOINT_URL_" + name); endpoint != "" {
+			return endpoint
+		}
+	}
+	return os.Getenv("AWS_ENDPOINT_URL")
+}
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvRegion(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	assert.Equal(t, "", envRegion())
+
+	os.Setenv("AWS_DEFAULT_REGION", "us-west-1")
+	assert.Equal(t, "us-west-1", envRegion())
+
+	os.Setenv("AWS_REGION", "us-west-2")
+	assert.Equal(t, "us-west-2", envRegion())
+}
+
+func TestEnvEnableEndpointDiscovery(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	assert.False(t, envEnableEndpointDiscovery())
+
+	os.Setenv("AWS_ENABLE_ENDPOINT_DISCOVERY", "true")
+	assert.True(t, envEnableEndpointDiscovery())
+
+	os.Setenv("AWS_ENABLE_ENDPOINT_DISCOVERY", "false")
+	assert.False(t, envEnableEndpointDiscovery())
+}
+
+func TestEnvS3UseARNRegion(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	assert.False(t, envS3UseARNRegion())
+
+	os.Setenv("AWS_S3_USE_ARN_REGION", "TRUE")
+	assert.True(t, envS3UseARNRegion())
+
+	os.Setenv("AWS_S3_USE_ARN_REGION", "false")
+	assert.False(t, envS3UseARNRegion())
+}
+
+func TestEnvCABundle(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+	defer func() { envCABundleErr = nil }()
+
+	os.Clearenv()
+	assert.Nil(t, envCABundle())
+	assert.NoError(t, envCABundleErr)
+
+	f, err := ioutil.TempFile("", "aws-ca-bundle")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString("TestCABundle")
+	f.Close()
+
+	os.Setenv("AWS_CA_BUNDLE", f.Name())
+	assert.Equal(t, []byte("TestCABundle"), envCABundle())
+	assert.NoError(t, envCABundleErr)
+
+	os.Setenv("AWS_CA_BUNDLE", f.Name()+".missing")
+	assert.Nil(t, envCABundle())
+	assert.Error(t, envCABundleErr)
+
+	err = NewConfig().WithRegion("us-west-2").WithCredentials(credentials.AnonymousCredentials).Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load the AWS_CA_BUNDLE file")
+}
+
+func TestEnvMaxRetries(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	cases := map[string]int{
+		"":    DefaultRetries,
+		"abc": DefaultRetries,
+		"0":   DefaultRetries,
+		"1":   0,
+		"5":   4,
+	}
+	for v, expect := range cases {
+		os.Setenv("AWS_MAX_ATTEMPTS", v)
+		assert.Equal(t, expect, envMaxRetries(), "AWS_MAX_ATTEMPTS=%q", v)
+	}
+}
+
+func TestEnvRetryMode(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	cases := map[string]string{
+		"":         "",
+		"legacy":   RetryModeStandard,
+		"standard": RetryModeStandard,
+		"Adaptive": RetryModeAdaptive,
+	}
+	for v, expect := range cases {
+		os.Setenv("AWS_RETRY_MODE", v)
+		assert.Equal(t, expect, envRetryMode(), "AWS_RETRY_MODE=%q", v)
+	}
+}
+
+func TestEnvSTSRegionalEndpoint(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	assert.Equal(t, "", envSTSRegionalEndpoint())
+
+	os.Setenv("AWS_STS_REGIONAL_ENDPOINTS", "Regional")
+	assert.Equal(t, STSRegionalEndpointRegional, envSTSRegionalEndpoint())
+}
+
+func TestEnvEndpointURL(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	assert.Equal(t, "", envEndpointURL("DynamoDB"))
+
+	os.Setenv("AWS_ENDPOINT_URL", "http://localhost:4566")
+	assert.Equal(t, "http://localhost:4566", envEndpointURL("DynamoDB"))
+	assert.Equal(t, "http://localhost:4566", envEndpointURL(""))
+
+	os.Setenv("AWS_ENDPOINT_URL_DYNAMODB", "http://localhost:8000")
+	os.Setenv("AWS_ENDPOINT_URL_CLOUDWATCH_LOGS", "http://localhost:8001")
+	os.Setenv("AWS_ENDPOINT_URL_SES", "http://localhost:8002")
+	os.Setenv("AWS_ENDPOINT_URL_ELASTIC_LOAD_BALANCING", "http://localhost:8003")
+	os.Setenv("AWS_ENDPOINT_URL_LOGS", "http://localhost:9000")
+	assert.Equal(t, "http://localhost:8000", envEndpointURL("DynamoDB"))
+	assert.Equal(t, "http://localhost:8001", envEndpointURL("CloudWatch Logs"))
+	assert.Equal(t, "http://localhost:8002", envEndpointURL("SES"))
+	assert.Equal(t, "http://localhost:8003", envEndpointURL("Elastic Load Balancing"))
+	assert.Equal(t, "http://localhost:4566", envEndpointURL("S3"))
+
+	os.Setenv("AWS_IGNORE_CONFIGURED_ENDPOINT_URLS", "true")
+	assert.Equal(t, "", envEndpointURL("DynamoDB"))
+}
+
+func restoreEnv(env []string) {
+	os.Clearenv()
+	for _, kv := range env {
+		if i := strings.Index(kv, "="); i > 0 {
+			os.Setenv(kv[:i], kv[i+1:])
+		}
+	}
+}
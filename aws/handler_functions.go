@@ -2,20 +2,55 @@ package aws
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
+	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
-var sleepDelay = func(delay time.Duration) {
-	time.Sleep(delay)
+// Names of the handlers added to service clients by the SDK. The names can be
+// used to remove or replace one of the SDK's handlers.
+//
+// All of the SDK's handlers are named "awssdk.<component>.<handler>", e.g.
+// "awssdk.core.SendHandler", "awssdk.v4.Sign", or "awssdk.jsonrpc.Build".
+//
+// Example:
+//     svc.Handlers.Validate.RemoveByName(aws.ValidateParametersHandlerName)
+const (
+	ValidateEndpointHandlerName      = "awssdk.core.ValidateEndpointHandler"
+	FillIdempotencyTokensHandlerName = "awssdk.core.FillIdempotencyTokens"
+	ValidateParametersHandlerName    = "awssdk.core.ValidateParameters"
+	UserAgentHandlerName             = "awssdk.core.UserAgentHandler"
+	HostPrefixHandlerName            = "awssdk.core.HostPrefix"
+	DiscoverEndpointHandlerName      = "awssdk.core.DiscoverEndpoint"
+	BuildContentLengthHandlerName    = "awssdk.core.BuildContentLength"
+	CompressRequestBodyHandlerName   = "awssdk.core.CompressRequestBody"
+	SendHandlerName                  = "awssdk.core.SendHandler"
+	ValidateResponseHandlerName      = "awssdk.core.ValidateResponseHandler"
+	DrainResponseBodyHandlerName     = "awssdk.core.DrainResponseBody"
+	InvalidateEndpointHandlerName    = "awssdk.core.InvalidateEndpoint"
+	CircuitBreakerResultHandlerName  = "awssdk.core.CircuitBreakerResult"
+	AfterRetryHandlerName            = "awssdk.core.AfterRetryHandler"
+	AdaptiveRetryErrorHandlerName    = "awssdk.core.AdaptiveRetryErrorHandler"
+	AdaptiveRetrySuccessHandlerName  = "awssdk.core.AdaptiveRetrySuccessHandler"
+	RetryQuotaSuccessHandlerName     = "awssdk.core.RetryQuotaSuccess"
+	LogRequestHandlerName            = "awssdk.core.LogRequest"
+	LogResponseHandlerName           = "awssdk.core.LogResponse"
+)
+
+var sleepDelay = func(ctx Context, delay time.Duration) error {
+	return SleepWithContext(ctx, delay)
 }
 
 // Interface for matching types which also have a Len method.
@@ -40,9 +75,17 @@ func BuildContentLength(r *Request) {
 	case lener:
 		length = int64(body.Len())
 	case io.Seeker:
-		r.bodyStart, _ = body.Seek(0, 1)
-		end, _ := body.Seek(0, 2)
-		body.Seek(r.bodyStart, 0) // make sure to seek back to original location
+		var end int64
+		var err error
+		if r.bodyStart, err = body.Seek(0, 1); err == nil {
+			if end, err = body.Seek(0, 2); err == nil {
+				_, err = body.Seek(r.bodyStart, 0) // make sure to seek back to original location
+			}
+		}
+		if err != nil {
+			r.Error = awserr.New("SerializationError", "failed to get length of request body", err)
+			return
+		}
 		length = end - r.bodyStart
 	default:
 		panic("Cannot get length of body, must provide `ContentLength`")
@@ -52,18 +95,234 @@ func BuildContentLength(r *Request) {
 	r.HTTPRequest.Header.Set("Content-Length", fmt.Sprintf("%d", length))
 }
 
+// defaultRequestMinCompressSize is the minimum size of the request bodies
+// gzipped if the Config's RequestMinCompressSize is zero.
+const defaultRequestMinCompressSize = 10240
+
+// CompressRequestBody gzips the body of the request, and adds gzip to its
+// Content-Encoding, if the Config's CompressRequestBody is set. Bodies smaller
+// than the Config's RequestMinCompressSize are not compressed. Service clients
+// add the handler to the Build handlers of operations which accept
+// compressed requests, after the body is built.
+func CompressRequestBody(r *Request) {
+	if !r.Config.CompressRequestBody || r.Body == nil || r.Error != nil {
+		return
+	}
+
+	minSize := r.Config.RequestMinCompressSize
+	if minSize == 0 {
+		minSize = defaultRequestMinCompressSize
+	}
+
+	start, err := r.Body.Seek(0, 1)
+	var body []byte
+	if err == nil {
+		body, err = ioutil.ReadAll(r.Body)
+	}
+	if err != nil {
+		r.Error = awserr.New("SerializationError", "failed to read request body to compress", err)
+		return
+	}
+	if int64(len(body)) < minSize {
+		r.Body.Seek(start, 0)
+		return
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(body)
+	w.Close()
+	r.SetBufferBody(buf.Bytes())
+
+	// The length of the uncompressed body is no longer correct.
+	r.HTTPRequest.Header.Del("Content-Length")
+	if encoding := r.HTTPRequest.Header.Get("Content-Encoding"); encoding != "" {
+		r.HTTPRequest.Header.Set("Content-Encoding", encoding+",gzip")
+	} else {
+		r.HTTPRequest.Header.Set("Content-Encoding", "gzip")
+	}
+}
+
+// AcceptGzipEncoding requests a gzip compressed response by setting the
+// request's Accept-Encoding to gzip, unless the Accept-Encoding is already
+// set, e.g. to identity by Amazon DynamoDB. Protocols add it to the requests
+// of operations whose responses are unmarshaled by the SDK, and decompress
+// the responses with DecompressResponseBody. Presigned requests are not
+// modified, the header would have to be sent with the presigned URL.
+//
+// The Accept-Encoding is set explicitly, instead of by the HTTP client's
+// transport, so responses are compressed even if the transport's
+// DisableCompression is set, or it is not an http.Transport.
+func AcceptGzipEncoding(r *Request) {
+	if r.ExpireTime == 0 && r.HTTPRequest.Header.Get("Accept-Encoding") == "" {
+		r.HTTPRequest.Header.Set("Accept-Encoding", "gzip")
+	}
+}
+
+// DecompressResponseBody replaces the body of a gzip compressed response to a
+// request with the gzip Accept-Encoding with its decompressed body. The
+// Content-Encoding and Content-Length of the response are removed, like in
+// the responses the HTTP client's transport decompresses.
+func DecompressResponseBody(r *Request) {
+	if !strings.EqualFold(r.HTTPRequest.Header.Get("Accept-Encoding"), "gzip") ||
+		!strings.EqualFold(r.HTTPResponse.Header.Get("Content-Encoding"), "gzip") {
+		return
+	}
+
+	r.HTTPResponse.Body = &gzipBody{body: r.HTTPResponse.Body}
+	r.HTTPResponse.Header.Del("Content-Encoding")
+	r.HTTPResponse.Header.Del("Content-Length")
+	r.HTTPResponse.ContentLength = -1
+	r.HTTPResponse.Uncompressed = true
+}
+
+// A gzipBody decompresses a gzip compressed response body as it is read.
+// The gzip header is read on the first Read, so errors are returned by Read
+// to the unmarshaler of the body.
+type gzipBody struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.zr == nil {
+		zr, err := gzip.NewReader(b.body)
+		if err != nil {
+			return 0, err
+		}
+		b.zr = zr
+	}
+	return b.zr.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
+
 // UserAgentHandler is a request handler for injecting User agent into requests.
+// The Config's AppID is appended as the "app/<AppID>" product.
 func UserAgentHandler(r *Request) {
 	r.HTTPRequest.Header.Set("User-Agent", SDKName+"/"+SDKVersion)
+	if r.Config.AppID != "" {
+		AddToUserAgent(r, "app/"+r.Config.AppID)
+	}
+}
+
+// AddToUserAgent appends the products to the User-Agent header of the
+// request, separated by spaces.
+func AddToUserAgent(r *Request, products ...string) {
+	ua := r.HTTPRequest.Header.Get("User-Agent")
+	for _, p := range products {
+		if p == "" {
+			continue
+		}
+		if ua != "" {
+			ua += " "
+		}
+		ua += p
+	}
+	r.HTTPRequest.Header.Set("User-Agent", ua)
+}
+
+// MakeAddToUserAgentHandler returns a request handler which appends the
+// product name and version, and any extra comments, to the User-Agent header
+// of requests, e.g. "name/version (extra1; extra2)". The handler must run after
+// the UserAgentHandler, e.g. be pushed back to the Build handlers.
+//
+// Example:
+//     svc.Handlers.Build.PushBack(aws.MakeAddToUserAgentHandler("my-app", "1.0"))
+func MakeAddToUserAgentHandler(name, version string, extra ...string) func(*Request) {
+	product := name + "/" + version
+	if len(extra) > 0 {
+		product += " (" + strings.Join(extra, "; ") + ")"
+	}
+	return func(r *Request) {
+		AddToUserAgent(r, product)
+	}
+}
+
+// MakeAddToUserAgentFreeFormHandler returns a request handler which appends
+// the free form string to the User-Agent header of requests.
+func MakeAddToUserAgentFreeFormHandler(s string) func(*Request) {
+	return func(r *Request) {
+		AddToUserAgent(r, s)
+	}
 }
 
 var reStatusCode = regexp.MustCompile(`^(\d+)`)
 
 // SendHandler is a request handler to send service request using HTTP client.
+//
+// If the service's Config has a MaxSendRate, or its RetryMode is
+// RetryModeAdaptive, SendHandler will wait to send the request until the
+// service's client side send rate allows it.
 func SendHandler(r *Request) {
-	var err error
-	r.HTTPResponse, err = r.Service.Config.HTTPClient.Do(r.HTTPRequest)
+	if !acquireSendToken(r) || !allowCircuit(r) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: int(0),
+			Status:     http.StatusText(int(0)),
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+		return
+	}
+
+	// Each attempt is sent with its own context, which is canceled by the
+	// request's Cancel, and released when the next attempt is sent or the
+	// request completes, so an AttemptTimeout also covers reading the
+	// response.
+	ctx := r.HTTPRequest.Context()
+	if r.attemptSpan != nil {
+		ctx = r.attemptSpan
+	}
+	if proxy := r.Service.Config.Proxy; proxy != nil {
+		ctx = context.WithValue(ctx, proxyContextKey{}, proxy)
+	}
+	ctx, cancel := r.beginAttempt(ctx, r.Service.Config.AttemptTimeout)
+	httpReq := r.HTTPRequest.WithContext(ctx)
+	if trace := r.Service.Config.ClientTrace; trace != nil {
+		httpReq = httpReq.WithContext(httptrace.WithClientTrace(httpReq.Context(), trace))
+	}
+
+	// Requests canceled before the attempt are not sent.
+	err := ctx.Err()
+	if err == nil {
+		r.HTTPResponse, err = r.sendHTTPRequest(httpReq)
+	}
+	if err == nil {
+		// Streamed response bodies are read after Send returns, so the
+		// attempt is also released when its response body is closed.
+		r.HTTPResponse.Body = &attemptBody{ReadCloser: r.HTTPResponse.Body, cancel: cancel}
+	}
 	if err != nil {
+		// Requests whose context was canceled must not be retried, and are
+		// reported as canceled instead of a generic request error.
+		if ctxErr := r.contextErr(); ctxErr != nil {
+			if r.HTTPResponse == nil {
+				r.HTTPResponse = &http.Response{
+					StatusCode: int(0),
+					Status:     http.StatusText(int(0)),
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+				}
+			}
+			r.Error = newCanceledError(ctxErr)
+			r.Retryable.Set(false)
+			return
+		}
+
+		// Attempts which timed out are abandoned and retried.
+		if httpReq.Context().Err() != nil {
+			if r.HTTPResponse == nil {
+				r.HTTPResponse = &http.Response{
+					StatusCode: int(0),
+					Status:     http.StatusText(int(0)),
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+				}
+			}
+			r.Error = awserr.New(ErrCodeRequestAttemptTimeout, "request attempt timed out", err)
+			r.Retryable.Set(true)
+			return
+		}
+
 		// Capture the case where url.Error is returned for error processing
 		// response. e.g. 301 without location header comes back as string
 		// error and r.HTTPResponse is nil. Other url redirect errors will
@@ -88,12 +347,78 @@ func SendHandler(r *Request) {
 				Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
 			}
 		}
-		// Catch all other request errors.
+		// Catch all other request errors. Only transient network errors,
+		// e.g. connection resets, are retryable.
 		r.Error = awserr.New("RequestError", "send request failed", err)
-		r.Retryable.Set(true) // network errors are retryable
+		r.Retryable.Set(!r.Service.Config.DisableNetworkErrorRetries && isErrTransient(err))
 	}
 }
 
+// An attemptBody is the response body of an attempt, which releases the
+// attempt's context when closed. The unread bytes of the body are drained
+// before it is closed, up to maxDrainResponseBodySize, so the connection can
+// be reused by the HTTP client.
+type attemptBody struct {
+	io.ReadCloser
+	cancel func()
+}
+
+func (b *attemptBody) Close() error {
+	drainBody(b.ReadCloser)
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// maxDrainResponseBodySize is the maximum number of unread bytes of response
+// bodies drained before they are closed. The connections of bodies with more
+// unread bytes are closed instead of reused.
+const maxDrainResponseBodySize = 4 << 10
+
+// drainBody reads and discards the unread bytes of the body, up to
+// maxDrainResponseBodySize.
+func drainBody(body io.Reader) {
+	io.CopyN(ioutil.Discard, body, maxDrainResponseBodySize)
+}
+
+// DrainResponseBody is a request handler draining and closing the response
+// body of the request's last attempt, so the connection can be reused even if
+// the body was not read to its end, e.g. by error unmarshalers or for
+// responses without output. The bodies of successful responses whose payload
+// is streamed to the caller, e.g. S3 GetObject's Body, are left open for the
+// caller to read and close.
+func DrainResponseBody(r *Request) {
+	if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+	if r.Error == nil && streamsResponseBody(r.Data) {
+		return
+	}
+
+	drainBody(r.HTTPResponse.Body)
+	r.HTTPResponse.Body.Close()
+}
+
+// streamsResponseBody returns true if data is an output whose payload is a
+// stream of the response body.
+func streamsResponseBody(data interface{}) bool {
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	field, ok := v.Type().FieldByName("SDKShapeTraits")
+	if !ok {
+		return false
+	}
+	payloadName := field.Tag.Get("payload")
+	if payloadName == "" {
+		return false
+	}
+
+	payload := v.FieldByName(payloadName)
+	return payload.IsValid() && payload.Kind() == reflect.Interface && !payload.IsNil()
+}
+
 // ValidateResponseHandler is a request handler to validate service response.
 func ValidateResponseHandler(r *Request) {
 	if r.HTTPResponse.StatusCode == 0 || r.HTTPResponse.StatusCode >= 300 {
@@ -105,15 +430,36 @@ func ValidateResponseHandler(r *Request) {
 // AfterRetryHandler performs final checks to determine if the request should
 // be retried and how long to delay.
 func AfterRetryHandler(r *Request) {
+	// Requests rejected because of local clock skew are always retried
+	// after being signed again with the corrected time.
+	if correctClockSkew(r) {
+		r.Retryable.Set(true)
+	}
+
 	// If one of the other handlers already set the retry state
 	// we don't want to override it based on the service's state
 	if !r.Retryable.IsSet() {
 		r.Retryable.Set(r.Service.ShouldRetry(r))
 	}
 
-	if r.WillRetry() {
+	// Requests are not retried once the client's retry quota is exhausted.
+	if r.WillRetry() && acquireRetryToken(r) {
+		// Wait at least as long as the service asked for, up to the
+		// maximum retry delay.
 		r.RetryDelay = r.Service.RetryRules(r)
-		sleepDelay(r.RetryDelay)
+		if delay, ok := retryAfterDelay(r); ok && delay > r.RetryDelay {
+			r.RetryDelay = delay
+		}
+
+		if r.Config.LogLevel.Matches(LogDebugWithRequestRetries) {
+			r.Config.Logger.Log(fmt.Sprintf("DEBUG: Retrying Request %s/%s, attempt %d, delay %v, error %v",
+				r.ServiceName, r.Operation.Name, r.RetryCount+1, r.RetryDelay, r.Error))
+		}
+		ctx, _ := r.beginAttempt(r.Context(), 0)
+		if err := sleepDelay(ctx, r.RetryDelay); err != nil {
+			r.Error = newCanceledError(err)
+			return
+		}
 
 		// when the expired token exception occurs the credentials
 		// need to be expired locally so that the next request to
@@ -147,9 +493,16 @@ var (
 
 // ValidateEndpointHandler is a request handler to validate a request had the
 // appropriate Region and Endpoint set. Will set r.Error if the endpoint or
-// region is not valid.
+// region is not valid, the Config's EndpointResolver failed, or the Config's
+// CABundle has no valid certificates.
 func ValidateEndpointHandler(r *Request) {
-	if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
+	if r.Service.endpointErr != nil {
+		r.Error = awserr.New("EndpointResolverError",
+			"failed to resolve endpoint for service "+r.Service.ServiceName, r.Service.endpointErr)
+	} else if r.Service.caBundleErr != nil {
+		r.Error = awserr.New(ErrCodeInvalidCABundle,
+			"failed to load the CA bundle of service "+r.Service.ServiceName, r.Service.caBundleErr)
+	} else if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
 		r.Error = ErrMissingRegion
 	} else if r.Service.Endpoint == "" {
 		r.Error = ErrMissingEndpoint
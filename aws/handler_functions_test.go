@@ -1,9 +1,23 @@
 package aws
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -79,3 +93,540 @@ func TestAfterRetryRefreshCreds(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, credProvider.retreiveCalled)
 }
+
+func TestServiceDualStackEndpoint(t *testing.T) {
+	svc := &Service{ServiceName: "s3", Config: &Config{Region: "us-west-2", UseDualStack: true}}
+	svc.Initialize()
+	assert.Equal(t, "https://s3.dualstack.us-west-2.amazonaws.com", svc.Endpoint)
+
+	// Services without dual-stack endpoints use their default endpoint.
+	svc = &Service{ServiceName: "sqs", Config: &Config{Region: "us-west-2", UseDualStack: true}}
+	svc.Initialize()
+	assert.Equal(t, "https://sqs.us-west-2.amazonaws.com", svc.Endpoint)
+
+	svc = &Service{ServiceName: "s3", Config: &Config{Region: "us-west-2", UseDualStack: true, Endpoint: "localhost"}}
+	svc.Initialize()
+	assert.Equal(t, "https://localhost", svc.Endpoint)
+}
+
+func TestServiceFIPSEndpoint(t *testing.T) {
+	svc := &Service{ServiceName: "sts", Config: &Config{Region: "us-west-2", UseFIPSEndpoint: true}}
+	svc.Initialize()
+	assert.Equal(t, "https://sts-fips.us-west-2.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-west-2", svc.SigningRegion)
+
+	// FIPS endpoints take precedence over dual-stack endpoints.
+	svc = &Service{ServiceName: "s3", Config: &Config{Region: "us-west-2", UseFIPSEndpoint: true, UseDualStack: true}}
+	svc.Initialize()
+	assert.Equal(t, "https://s3-fips.us-west-2.amazonaws.com", svc.Endpoint)
+
+	svc = &Service{ServiceName: "iam", Config: &Config{Region: "us-west-2", UseFIPSEndpoint: true}}
+	svc.Initialize()
+	assert.Equal(t, "https://iam.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-east-1", svc.SigningRegion)
+}
+
+func TestServiceSTSRegionalEndpoint(t *testing.T) {
+	svc := &Service{ServiceName: "sts", Config: &Config{Region: "us-west-2"}}
+	svc.Initialize()
+	assert.Equal(t, "https://sts.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-east-1", svc.SigningRegion)
+
+	svc = &Service{ServiceName: "sts", Config: &Config{Region: "us-west-2", STSRegionalEndpoint: STSRegionalEndpointRegional}}
+	svc.Initialize()
+	assert.Equal(t, "https://sts.us-west-2.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-west-2", svc.SigningRegion)
+
+	// Clients without a region use the global endpoint.
+	svc = &Service{ServiceName: "sts", Config: &Config{STSRegionalEndpoint: STSRegionalEndpointRegional}}
+	svc.Initialize()
+	assert.Equal(t, "https://sts.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-east-1", svc.SigningRegion)
+}
+
+func TestServiceSigningScopeOverride(t *testing.T) {
+	svc := &Service{ServiceName: "mock", Config: &Config{
+		Region:        "us-west-2",
+		Endpoint:      "https://abc123.execute-api.us-east-1.amazonaws.com/prod",
+		SigningName:   "execute-api",
+		SigningRegion: "us-east-1",
+	}}
+	svc.Initialize()
+	assert.Equal(t, "execute-api", svc.SigningName)
+	assert.Equal(t, "us-east-1", svc.SigningRegion)
+
+	// The signing region is used if the client has no region.
+	svc = &Service{ServiceName: "mock", Config: &Config{Endpoint: "https://localhost", SigningRegion: "us-east-1"}}
+	svc.Initialize()
+	r := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	ValidateEndpointHandler(r)
+	assert.NoError(t, r.Error)
+}
+
+func TestServicePartitionEndpoints(t *testing.T) {
+	svc := &Service{ServiceName: "iam", Config: &Config{Region: "cn-north-1"}}
+	svc.Initialize()
+	assert.Equal(t, "https://iam.cn-north-1.amazonaws.com.cn", svc.Endpoint)
+	assert.Equal(t, "cn-north-1", svc.SigningRegion)
+
+	svc = &Service{ServiceName: "iam", Config: &Config{Region: "us-gov-west-1"}}
+	svc.Initialize()
+	assert.Equal(t, "https://iam.us-gov.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-gov-west-1", svc.SigningRegion)
+
+	svc = &Service{ServiceName: "sqs", Config: &Config{Region: "cn-north-1", DisableSSL: true}}
+	svc.Initialize()
+	assert.Equal(t, "http://sqs.cn-north-1.amazonaws.com.cn", svc.Endpoint)
+}
+
+func TestServiceEndpointResolver(t *testing.T) {
+	resolver := EndpointResolverFunc(func(service, region string) (string, string, error) {
+		if service == "sqs" {
+			return "", "", nil
+		}
+		return service + ".proxy.example.com", "us-east-1", nil
+	})
+
+	svc := &Service{ServiceName: "s3", Config: &Config{Region: "us-west-2", EndpointResolver: resolver}}
+	svc.Initialize()
+	assert.Equal(t, "https://s3.proxy.example.com", svc.Endpoint)
+	assert.Equal(t, "us-east-1", svc.SigningRegion)
+
+	// An empty endpoint falls back to the default endpoint of the service.
+	svc = &Service{ServiceName: "sqs", Config: &Config{Region: "us-west-2", EndpointResolver: resolver}}
+	svc.Initialize()
+	assert.Equal(t, "https://sqs.us-west-2.amazonaws.com", svc.Endpoint)
+
+	// An explicit endpoint takes precedence over the resolver.
+	svc = &Service{ServiceName: "s3", Config: &Config{Region: "us-west-2", EndpointResolver: resolver, Endpoint: "localhost"}}
+	svc.Initialize()
+	assert.Equal(t, "https://localhost", svc.Endpoint)
+}
+
+func TestServiceEnvEndpointURL(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+
+	os.Clearenv()
+	os.Setenv("AWS_ENDPOINT_URL_S3", "localhost:4566")
+	os.Setenv("AWS_ENDPOINT_URL_CLOUDWATCH_LOGS", "localhost:4567")
+
+	svc := &Service{ServiceName: "s3", ServiceID: "S3", Config: &Config{Region: "us-west-2", DisableSSL: true}}
+	svc.Initialize()
+	assert.Equal(t, "http://localhost:4566", svc.Endpoint)
+	assert.Equal(t, "", svc.SigningRegion)
+
+	// The variable is named after the service ID, not the endpoint prefix.
+	svc = &Service{ServiceName: "logs", ServiceID: "CloudWatch Logs", Config: &Config{Region: "us-west-2"}}
+	svc.Initialize()
+	assert.Equal(t, "https://localhost:4567", svc.Endpoint)
+
+	// Endpoints configured in code take precedence.
+	svc = &Service{ServiceName: "s3", ServiceID: "S3", Config: &Config{Region: "us-west-2", Endpoint: "https://endpoint"}}
+	svc.Initialize()
+	assert.Equal(t, "https://endpoint", svc.Endpoint)
+
+	svc = &Service{ServiceName: "sqs", ServiceID: "SQS", Config: &Config{Region: "us-west-2"}}
+	svc.Initialize()
+	assert.Equal(t, "https://sqs.us-west-2.amazonaws.com", svc.Endpoint)
+}
+
+func TestValidateEndpointHandlerErrorResolver(t *testing.T) {
+	resolveErr := errors.New("no route")
+	svc := NewService(&Config{Region: "us-west-2",
+		EndpointResolver: EndpointResolverFunc(func(service, region string) (string, string, error) {
+			return "", "", resolveErr
+		}),
+	})
+	svc.Handlers.Clear()
+	svc.Handlers.Validate.PushBack(ValidateEndpointHandler)
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	err := req.Build()
+
+	assert.Error(t, err)
+	assert.Equal(t, "EndpointResolverError", err.(awserr.Error).Code())
+	assert.Equal(t, resolveErr, err.(awserr.Error).OrigErr())
+}
+
+func TestUserAgentHandler(t *testing.T) {
+	svc := NewService(&Config{Region: "us-west-2"})
+	r := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	UserAgentHandler(r)
+	assert.Equal(t, SDKName+"/"+SDKVersion, r.HTTPRequest.Header.Get("User-Agent"))
+
+	svc = NewService(&Config{Region: "us-west-2", AppID: "my-app"})
+	r = NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	UserAgentHandler(r)
+	assert.Equal(t, SDKName+"/"+SDKVersion+" app/my-app", r.HTTPRequest.Header.Get("User-Agent"))
+}
+
+func TestAddToUserAgentHandlers(t *testing.T) {
+	svc := NewService(&Config{Region: "us-west-2"})
+	svc.Handlers.Clear()
+	svc.Handlers.Build.PushBack(UserAgentHandler)
+	svc.Handlers.Build.PushBack(MakeAddToUserAgentHandler("name", "1.0", "extra1", "extra2"))
+	svc.Handlers.Build.PushBack(MakeAddToUserAgentHandler("other", "2.0"))
+	svc.Handlers.Build.PushBack(MakeAddToUserAgentFreeFormHandler("free form"))
+
+	r := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Build())
+	assert.Equal(t, SDKName+"/"+SDKVersion+" name/1.0 (extra1; extra2) other/2.0 free form",
+		r.HTTPRequest.Header.Get("User-Agent"))
+}
+
+func TestServiceHasCustomEndpoint(t *testing.T) {
+	oldEnv := os.Environ()
+	defer restoreEnv(oldEnv)
+	os.Clearenv()
+
+	svc := &Service{ServiceName: "sqs", ServiceID: "SQS", Config: &Config{Region: "us-west-2"}}
+	svc.Initialize()
+	assert.False(t, svc.HasCustomEndpoint())
+
+	// Endpoints equal to the resolved endpoint are still custom.
+	svc = &Service{ServiceName: "sqs", ServiceID: "SQS", Config: &Config{Region: "us-west-2",
+		Endpoint: "https://sqs.us-west-2.amazonaws.com"}}
+	svc.Initialize()
+	assert.True(t, svc.HasCustomEndpoint())
+
+	os.Setenv("AWS_ENDPOINT_URL_SQS", "http://localhost:4566")
+	svc = &Service{ServiceName: "sqs", ServiceID: "SQS", Config: &Config{Region: "us-west-2"}}
+	svc.Initialize()
+	assert.True(t, svc.HasCustomEndpoint())
+}
+
+func TestServiceCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	for _, c := range []struct {
+		bundle []byte
+		ok     bool
+		code   string
+	}{
+		{bundle, true, ""},
+		{nil, false, "RequestError"},
+		{[]byte("not a certificate"), false, ErrCodeInvalidCABundle},
+	} {
+		s := NewService(&Config{Region: "mock-region", Endpoint: server.URL, CABundle: c.bundle, MaxRetries: 0})
+		r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+		err := r.Send()
+		if c.ok {
+			assert.NoError(t, err)
+			assert.True(t, s.Config.HTTPClient != DefaultConfig.HTTPClient)
+		} else if assert.Error(t, err) {
+			assert.Equal(t, c.code, err.(awserr.Error).Code())
+		}
+	}
+
+	// The CA bundle is not used with a custom HTTP client.
+	client := &http.Client{}
+	s := NewService(&Config{HTTPClient: client, CABundle: bundle})
+	assert.True(t, s.Config.HTTPClient == client)
+}
+
+type recordingDialer struct {
+	addrs []string
+}
+
+func (d *recordingDialer) DialContext(ctx Context, network, address string) (net.Conn, error) {
+	d.addrs = append(d.addrs, address)
+	return (&net.Dialer{}).DialContext(ctx, network, address)
+}
+
+func TestServiceDialer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	d := &recordingDialer{}
+	s := NewService(&Config{Region: "mock-region", Endpoint: server.URL, Dialer: d})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, []string{server.Listener.Addr().String()}, d.addrs)
+
+	tr := s.Config.HTTPClient.Transport.(*http.Transport)
+	assert.NotZero(t, tr.TLSHandshakeTimeout)
+}
+
+func TestSendClientTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	var events []string
+	trace := &httptrace.ClientTrace{
+		ConnectDone: func(network, addr string, err error) {
+			events = append(events, "ConnectDone")
+		},
+		GotFirstResponseByte: func() {
+			events = append(events, "GotFirstResponseByte")
+		},
+	}
+
+	s := NewService(&Config{Region: "mock-region", Endpoint: server.URL, ClientTrace: trace})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, []string{"ConnectDone", "GotFirstResponseByte"}, events)
+}
+
+func TestSendClientTraceWithConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	var clientTraced, requestTraced bool
+	ctx := httptrace.WithClientTrace(BackgroundContext(), &httptrace.ClientTrace{
+		GotFirstResponseByte: func() { clientTraced = true },
+	})
+
+	s := NewService(&Config{Region: "mock-region", Endpoint: server.URL})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	r.ApplyOptions(WithConfig(NewConfig().WithClientTrace(&httptrace.ClientTrace{
+		GotFirstResponseByte: func() { requestTraced = true },
+	})))
+	assert.NoError(t, r.Send())
+	assert.True(t, requestTraced, "Expect the request's trace to be called")
+	assert.True(t, clientTraced, "Expect the trace of the request's context to also be called")
+	assert.Nil(t, s.Config.ClientTrace, "Expect the client's Config to be unmodified")
+}
+
+func TestServiceMaxIdleConnsPerHost(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region", MaxIdleConnsPerHost: 500})
+	tr := s.Config.HTTPClient.Transport.(*http.Transport)
+	assert.Equal(t, 500, tr.MaxIdleConnsPerHost)
+	assert.Equal(t, 500, tr.MaxIdleConns)
+	assert.NotZero(t, tr.TLSHandshakeTimeout)
+
+	client := &http.Client{}
+	s = NewService(&Config{Region: "mock-region", MaxIdleConnsPerHost: 500, HTTPClient: client})
+	assert.Equal(t, client, s.Config.HTTPClient)
+}
+
+func TestServiceProxy(t *testing.T) {
+	host := ""
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.URL.Host
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	s := NewService(&Config{Region: "mock-region", Endpoint: "http://mock.example.com", Proxy: http.ProxyURL(proxyURL)})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "mock.example.com", host)
+}
+
+func TestServiceSharedHTTPClient(t *testing.T) {
+	hosts := map[string]string{}
+	newProxy := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hosts[name] = r.URL.Host
+		}))
+	}
+	proxy1, proxy2 := newProxy("proxy1"), newProxy("proxy2")
+	defer proxy1.Close()
+	defer proxy2.Close()
+	proxyURL1, _ := url.Parse(proxy1.URL)
+	proxyURL2, _ := url.Parse(proxy2.URL)
+
+	// Services with the same settings share their HTTP client, and their
+	// requests are still sent through their own proxy.
+	s1 := NewService(&Config{Region: "mock-region", Endpoint: "http://one.example.com",
+		MaxIdleConnsPerHost: 321, Proxy: http.ProxyURL(proxyURL1)})
+	s2 := NewService(&Config{Region: "mock-region", Endpoint: "http://two.example.com",
+		MaxIdleConnsPerHost: 321, Proxy: http.ProxyURL(proxyURL2)})
+	assert.True(t, s1.Config.HTTPClient == s2.Config.HTTPClient)
+
+	assert.NoError(t, NewRequest(s1, &Operation{Name: "Operation"}, nil, nil).Send())
+	assert.NoError(t, NewRequest(s2, &Operation{Name: "Operation"}, nil, nil).Send())
+	assert.Equal(t, map[string]string{"proxy1": "one.example.com", "proxy2": "two.example.com"}, hosts)
+
+	s3 := NewService(&Config{Region: "mock-region", MaxIdleConnsPerHost: 321, Dialer: &recordingDialer{}})
+	assert.True(t, s1.Config.HTTPClient != s3.Config.HTTPClient)
+}
+
+func compressRequest(cfg *Config, body string) *Request {
+	svc := NewService(cfg)
+	svc.Handlers.Clear()
+	svc.Handlers.Build.PushBack(func(r *Request) {
+		r.SetStringBody(body)
+	})
+	svc.Handlers.Build.PushBack(CompressRequestBody)
+
+	r := NewRequest(svc, &Operation{Name: "Operation", HTTPMethod: "POST"}, nil, nil)
+	r.Build()
+	return r
+}
+
+func TestCompressRequestBody(t *testing.T) {
+	body := strings.Repeat("MetricData.member.1.Value=1&", 500)
+	r := compressRequest(&Config{CompressRequestBody: true}, body)
+	assert.NoError(t, r.Error)
+	assert.Equal(t, "gzip", r.HTTPRequest.Header.Get("Content-Encoding"))
+
+	gr, err := gzip.NewReader(r.Body)
+	assert.NoError(t, err)
+	b, _ := ioutil.ReadAll(gr)
+	assert.Equal(t, body, string(b))
+}
+
+func TestCompressRequestBodyMinSize(t *testing.T) {
+	r := compressRequest(&Config{CompressRequestBody: true}, "Action=PutMetricData")
+	assert.NoError(t, r.Error)
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Content-Encoding"))
+	b, _ := ioutil.ReadAll(r.Body)
+	assert.Equal(t, "Action=PutMetricData", string(b))
+
+	r = compressRequest(&Config{CompressRequestBody: true, RequestMinCompressSize: 1}, "Action=PutMetricData")
+	assert.Equal(t, "gzip", r.HTTPRequest.Header.Get("Content-Encoding"))
+}
+
+func TestCompressRequestBodyDisabled(t *testing.T) {
+	body := strings.Repeat("a", 2*defaultRequestMinCompressSize)
+	r := compressRequest(&Config{}, body)
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Content-Encoding"))
+	b, _ := ioutil.ReadAll(r.Body)
+	assert.Equal(t, body, string(b))
+}
+
+func TestAcceptGzipEncoding(t *testing.T) {
+	svc := NewService(&Config{})
+	r := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	AcceptGzipEncoding(r)
+	assert.Equal(t, "gzip", r.HTTPRequest.Header.Get("Accept-Encoding"))
+
+	r = NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	r.HTTPRequest.Header.Set("Accept-Encoding", "identity")
+	AcceptGzipEncoding(r)
+	assert.Equal(t, "identity", r.HTTPRequest.Header.Get("Accept-Encoding"))
+
+	r = NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	r.ExpireTime = time.Minute
+	AcceptGzipEncoding(r)
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Accept-Encoding"), "Expect presigned requests to not be modified")
+}
+
+func gzipResponse(body string) *http.Response {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte(body))
+	w.Close()
+
+	return &http.Response{
+		StatusCode:    200,
+		Header:        http.Header{"Content-Encoding": []string{"gzip"}, "Content-Length": []string{strconv.Itoa(buf.Len())}},
+		ContentLength: int64(buf.Len()),
+		Body:          ioutil.NopCloser(&buf),
+	}
+}
+
+func TestDecompressResponseBody(t *testing.T) {
+	svc := NewService(&Config{})
+	r := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	AcceptGzipEncoding(r)
+	r.HTTPResponse = gzipResponse(`{"data":"valid"}`)
+	DecompressResponseBody(r)
+
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"data":"valid"}`, string(b))
+	assert.Equal(t, "", r.HTTPResponse.Header.Get("Content-Encoding"))
+	assert.Equal(t, "", r.HTTPResponse.Header.Get("Content-Length"))
+	assert.Equal(t, int64(-1), r.HTTPResponse.ContentLength)
+	assert.True(t, r.HTTPResponse.Uncompressed)
+}
+
+func TestDecompressResponseBodyNotAccepted(t *testing.T) {
+	svc := NewService(&Config{})
+	r := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = gzipResponse(`{"data":"valid"}`)
+	body := r.HTTPResponse.Body
+	DecompressResponseBody(r)
+
+	assert.Equal(t, body, r.HTTPResponse.Body, "Expect the body of requests not accepting gzip to be unmodified")
+	assert.Equal(t, "gzip", r.HTTPResponse.Header.Get("Content-Encoding"))
+}
+
+func TestDecompressResponseBodyInvalid(t *testing.T) {
+	svc := NewService(&Config{})
+	r := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	AcceptGzipEncoding(r)
+	r.HTTPResponse = &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:       ioutil.NopCloser(strings.NewReader("not gzip")),
+	}
+	DecompressResponseBody(r)
+
+	_, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.Error(t, err)
+}
+
+func TestDrainResponseBodyReusesConnections(t *testing.T) {
+	var conns, reqs int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		if reqs < 3 {
+			w.WriteHeader(500)
+		}
+		// The unmarshalers stop reading at the end of the JSON document.
+		w.Write([]byte(`{"__type":"UnknownError","message":"An error occurred."}` + strings.Repeat(" ", 3000)))
+	}))
+	server.Config.ConnState = func(c net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns++
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	s := NewService(&Config{Region: "mock-region", Endpoint: server.URL, MaxRetries: 2,
+		RetryBaseDelay: time.Millisecond, HTTPClient: &http.Client{Transport: &http.Transport{}}})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(func(r *Request) {
+		json.NewDecoder(r.HTTPResponse.Body).Decode(&struct{}{})
+		r.Error = awserr.New("UnknownError", "An error occurred.", nil)
+	})
+	for i := 0; i < 2; i++ {
+		reqs = 0
+		assert.NoError(t, NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{}).Send())
+	}
+
+	assert.Equal(t, 1, conns)
+}
+
+type trackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+type streamingOutput struct {
+	Body io.ReadCloser `type:"blob"`
+
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+func TestDrainResponseBody(t *testing.T) {
+	b := &trackingBody{Reader: strings.NewReader(strings.Repeat("a", maxDrainResponseBodySize+1))}
+	r := &Request{HTTPResponse: &http.Response{Body: b}, Data: &testData{}}
+	DrainResponseBody(r)
+	assert.True(t, b.closed)
+	assert.Equal(t, 1, b.Reader.(*strings.Reader).Len())
+
+	// Streamed payloads are closed by the caller, unless the request failed.
+	b = &trackingBody{Reader: strings.NewReader("data")}
+	r = &Request{HTTPResponse: &http.Response{Body: b}, Data: &streamingOutput{Body: b}}
+	DrainResponseBody(r)
+	assert.False(t, b.closed)
+
+	r.Error = errors.New("failed")
+	DrainResponseBody(r)
+	assert.True(t, b.closed)
+}
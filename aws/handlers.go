@@ -1,7 +1,29 @@
 package aws
 
+import "reflect"
+
 // A Handlers provides a collection of request handlers for various
 // stages of handling requests.
+//
+// The handlers of a service client are copied into each request the client
+// creates, so handlers added to a client's lists are run for all of the
+// client's requests, and handlers added to a request's lists are only run for
+// that request. Handlers can be added to inject custom logic into the request
+// lifecycle, such as setting custom headers, auditing requests, or injecting
+// faults for testing.
+//
+// The lists are run in the following order while sending a request:
+//
+//     Validate, Build     - once, before the request is first signed
+//     Sign, Send          - for each attempt of the request
+//     UnmarshalMeta, ValidateResponse
+//                         - for each response received
+//     Unmarshal           - if the response was successful
+//     UnmarshalError      - if the response was an error
+//     Retry, AfterRetry   - if the attempt failed
+//     Complete            - once, after the request has completed
+//
+// A handler stops the request by setting the request's Error field.
 type Handlers struct {
 	Validate         HandlerList
 	Build            HandlerList
@@ -13,6 +35,16 @@ type Handlers struct {
 	UnmarshalError   HandlerList
 	Retry            HandlerList
 	AfterRetry       HandlerList
+
+	// Complete handlers are run once the request has finished sending,
+	// whether it succeeded or failed. The request's Error field will be set
+	// if it failed.
+	Complete HandlerList
+}
+
+// Copy returns a copy of this handler's lists.
+func (h *Handlers) Copy() Handlers {
+	return h.copy()
 }
 
 // copy returns of this handler's lists.
@@ -28,9 +60,26 @@ func (h *Handlers) copy() Handlers {
 		UnmarshalMeta:    h.UnmarshalMeta.copy(),
 		Retry:            h.Retry.copy(),
 		AfterRetry:       h.AfterRetry.copy(),
+		Complete:         h.Complete.copy(),
 	}
 }
 
+// Merge pushes the handlers of each of other's lists to the back of the
+// matching list of these handlers.
+func (h *Handlers) Merge(other Handlers) {
+	h.Validate.PushBackNamed(other.Validate.list...)
+	h.Build.PushBackNamed(other.Build.list...)
+	h.Sign.PushBackNamed(other.Sign.list...)
+	h.Send.PushBackNamed(other.Send.list...)
+	h.ValidateResponse.PushBackNamed(other.ValidateResponse.list...)
+	h.Unmarshal.PushBackNamed(other.Unmarshal.list...)
+	h.UnmarshalMeta.PushBackNamed(other.UnmarshalMeta.list...)
+	h.UnmarshalError.PushBackNamed(other.UnmarshalError.list...)
+	h.Retry.PushBackNamed(other.Retry.list...)
+	h.AfterRetry.PushBackNamed(other.AfterRetry.list...)
+	h.Complete.PushBackNamed(other.Complete.list...)
+}
+
 // Clear removes callback functions for all handlers
 func (h *Handlers) Clear() {
 	h.Validate.Clear()
@@ -43,23 +92,32 @@ func (h *Handlers) Clear() {
 	h.ValidateResponse.Clear()
 	h.Retry.Clear()
 	h.AfterRetry.Clear()
+	h.Complete.Clear()
+}
+
+// A NamedHandler is a request handler with a name. The name allows the
+// handler to be removed from, or replaced in, a HandlerList without holding
+// a reference to the handler function.
+type NamedHandler struct {
+	Name string
+	Fn   func(*Request)
 }
 
 // A HandlerList manages zero or more handlers in a list.
 type HandlerList struct {
-	list []func(*Request)
+	list []NamedHandler
 }
 
 // copy creates a copy of the handler list.
 func (l *HandlerList) copy() HandlerList {
 	var n HandlerList
-	n.list = append([]func(*Request){}, l.list...)
+	n.list = append([]NamedHandler{}, l.list...)
 	return n
 }
 
 // Clear clears the handler list.
 func (l *HandlerList) Clear() {
-	l.list = []func(*Request){}
+	l.list = []NamedHandler{}
 }
 
 // Len returns the number of handlers in the list.
@@ -69,17 +127,89 @@ func (l *HandlerList) Len() int {
 
 // PushBack pushes handlers f to the back of the handler list.
 func (l *HandlerList) PushBack(f ...func(*Request)) {
-	l.list = append(l.list, f...)
+	l.PushBackNamed(unnamedHandlers(f)...)
 }
 
 // PushFront pushes handlers f to the front of the handler list.
 func (l *HandlerList) PushFront(f ...func(*Request)) {
-	l.list = append(f, l.list...)
+	l.PushFrontNamed(unnamedHandlers(f)...)
+}
+
+// PushBackNamed pushes named handlers n to the back of the handler list.
+func (l *HandlerList) PushBackNamed(n ...NamedHandler) {
+	l.list = append(l.list, n...)
+}
+
+// PushFrontNamed pushes named handlers n to the front of the handler list.
+func (l *HandlerList) PushFrontNamed(n ...NamedHandler) {
+	l.list = append(append([]NamedHandler{}, n...), l.list...)
+}
+
+// Remove removes all handlers with the same name as n from the handler list.
+// If n is not named, the handlers with the same function as n are removed.
+func (l *HandlerList) Remove(n NamedHandler) {
+	if n.Name != "" {
+		l.RemoveByName(n.Name)
+		return
+	}
+
+	fn := reflect.ValueOf(n.Fn).Pointer()
+	list := make([]NamedHandler, 0, len(l.list))
+	for _, h := range l.list {
+		if h.Name != "" || reflect.ValueOf(h.Fn).Pointer() != fn {
+			list = append(list, h)
+		}
+	}
+	l.list = list
+}
+
+// RemoveByName removes all handlers named name from the handler list. An empty
+// name does not match any handler.
+func (l *HandlerList) RemoveByName(name string) {
+	if name == "" {
+		return
+	}
+
+	list := make([]NamedHandler, 0, len(l.list))
+	for _, h := range l.list {
+		if h.Name != name {
+			list = append(list, h)
+		}
+	}
+	l.list = list
+}
+
+// SwapNamed replaces the function of each handler in the list with the same
+// name as n with n's function. Returns true if any handler was replaced. An
+// empty name does not match any handler.
+func (l *HandlerList) SwapNamed(n NamedHandler) bool {
+	if n.Name == "" {
+		return false
+	}
+
+	swapped := false
+	for i := range l.list {
+		if l.list[i].Name == n.Name {
+			l.list[i].Fn = n.Fn
+			swapped = true
+		}
+	}
+	return swapped
 }
 
 // Run executes all handlers in the list with a given request object.
 func (l *HandlerList) Run(r *Request) {
-	for _, f := range l.list {
-		f(r)
+	for _, h := range l.list {
+		h.Fn(r)
 	}
 }
+
+// unnamedHandlers wraps the handler functions fns as NamedHandlers without
+// names.
+func unnamedHandlers(fns []func(*Request)) []NamedHandler {
+	n := make([]NamedHandler, len(fns))
+	for i, fn := range fns {
+		n[i] = NamedHandler{Fn: fn}
+	}
+	return n
+}
@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,3 +31,112 @@ func TestMultipleHandlers(t *testing.T) {
 		t.Error("Expected handler to execute")
 	}
 }
+
+func TestHandlersMerge(t *testing.T) {
+	s := ""
+	h := Handlers{}
+	h.Send.PushBack(func(r *Request) { s += "a" })
+
+	other := Handlers{}
+	other.Send.PushBack(func(r *Request) { s += "b" })
+	other.Build.PushBack(func(r *Request) { s += "c" })
+
+	h.Merge(other)
+	assert.Equal(t, 2, h.Send.Len())
+	assert.Equal(t, 1, h.Build.Len())
+	assert.Equal(t, 1, other.Send.Len())
+
+	h.Send.Run(&Request{})
+	assert.Equal(t, "ab", s)
+}
+
+func TestRequestCompleteHandlers(t *testing.T) {
+	s := NewService(&Config{MaxRetries: 1})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body("")}
+	})
+
+	completed := []error{}
+	s.Handlers.Complete.PushBack(func(r *Request) {
+		completed = append(completed, r.Error)
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, []error{nil}, completed)
+
+	// Handlers added to a request are only run for that request.
+	failErr := awserr.New("InjectedFault", "fault injected by test", nil)
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.Handlers.Send.PushBack(func(r *Request) { r.Error = failErr })
+	assert.Equal(t, failErr, r.Send())
+	assert.Equal(t, []error{nil, failErr}, completed)
+	assert.Equal(t, 1, s.Handlers.Send.Len())
+}
+
+func TestNamedHandlers(t *testing.T) {
+	s := ""
+	l := HandlerList{}
+	named := NamedHandler{Name: "Named", Fn: func(r *Request) { s += "n" }}
+	l.PushBack(func(r *Request) { s += "a" })
+	l.PushBackNamed(named)
+	l.PushFrontNamed(NamedHandler{Name: "Front", Fn: func(r *Request) { s += "f" }})
+	l.PushBackNamed(named)
+	assert.Equal(t, 4, l.Len())
+
+	l.Run(&Request{})
+	assert.Equal(t, "fann", s)
+
+	s = ""
+	assert.True(t, l.SwapNamed(NamedHandler{Name: "Named", Fn: func(r *Request) { s += "s" }}))
+	assert.False(t, l.SwapNamed(NamedHandler{Name: "Missing", Fn: func(r *Request) {}}))
+	l.Run(&Request{})
+	assert.Equal(t, "fass", s)
+
+	s = ""
+	l.Remove(named)
+	l.RemoveByName("Front")
+	assert.Equal(t, 1, l.Len())
+	l.Run(&Request{})
+	assert.Equal(t, "a", s)
+}
+
+func handlerA(r *Request) {}
+func handlerB(r *Request) {}
+
+func TestUnnamedHandlers(t *testing.T) {
+	l := HandlerList{}
+	l.PushBack(handlerA, handlerB, handlerA)
+	l.PushBackNamed(NamedHandler{Name: "Named", Fn: handlerA})
+
+	// Empty names do not match unnamed handlers.
+	l.RemoveByName("")
+	assert.Equal(t, 4, l.Len())
+	assert.False(t, l.SwapNamed(NamedHandler{Fn: handlerB}))
+
+	// Unnamed handlers are removed by their function.
+	l.Remove(NamedHandler{Fn: handlerA})
+	assert.Equal(t, 2, l.Len())
+	assert.Equal(t, "", l.list[0].Name)
+	assert.Equal(t, "Named", l.list[1].Name)
+}
+
+func TestServiceNamedCoreHandlers(t *testing.T) {
+	s := NewService(&Config{})
+	l := s.Handlers.Validate.Len()
+	s.Handlers.Validate.RemoveByName(ValidateParametersHandlerName)
+	assert.Equal(t, l-1, s.Handlers.Validate.Len())
+
+	sent := false
+	assert.True(t, s.Handlers.Send.SwapNamed(NamedHandler{Name: SendHandlerName, Fn: func(r *Request) {
+		sent = true
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body("")}
+	}}))
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.Handlers.Validate.Clear()
+	assert.NoError(t, r.Send())
+	assert.True(t, sent)
+}
@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// WithHedging returns an Option which hedges the attempts of the request: if
+// an attempt has not received a response after the delay, a second copy of
+// the attempt is sent, and the response of whichever copy is received first
+// is used. The other copy is canceled.
+//
+// Hedging reduces the tail latency of requests, at the cost of sending some of
+// them twice, so only the requests of idempotent operations, whose requests
+// can safely be processed twice by the service, are hedged: the operations
+// sent with GET or HEAD, the operations whose names start with a prefix of
+// HedgedOperationPrefixes, and the operations whose input has an idempotency
+// token. Requests whose body cannot be sent twice, e.g. aws-chunked streaming
+// uploads, are not hedged either. The two copies of an attempt count as a
+// single attempt, e.g. for retries and metrics.
+//
+// Example:
+//     out, err := svc.GetItemWithContext(ctx, params,
+//         aws.WithHedging(20*time.Millisecond))
+func WithHedging(delay time.Duration) Option {
+	return func(r *Request) {
+		r.hedgeDelay = delay
+	}
+}
+
+// HedgedOperationPrefixes are the prefixes of the names of the operations
+// which only read, and which WithHedging hedges the requests of even though
+// some protocols send them with POST, e.g. DynamoDB's GetItem.
+var HedgedOperationPrefixes = []string{"BatchGet", "Describe", "Get", "Head", "List", "Query", "Scan"}
+
+// A hedgeResult is the result of sending a copy of a hedged attempt.
+type hedgeResult struct {
+	index int
+	resp  *http.Response
+	err   error
+}
+
+// sendHTTPRequest sends the HTTP request of the request's attempt with the
+// Config's HTTPClient, hedging the attempt if the request is hedged.
+func (r *Request) sendHTTPRequest(req *http.Request) (*http.Response, error) {
+	if r.hedgeDelay <= 0 || !r.idempotent() {
+		return r.Service.Config.HTTPClient.Do(req)
+	}
+
+	// Each copy of the attempt is sent with its own copy of the body.
+	var getBody func() (io.ReadCloser, error)
+	switch {
+	case req.Body == nil || req.Body == http.NoBody:
+	case isPayloadBody(req.Body):
+		if _, err := r.Body.Seek(r.bodyStart, 0); err != nil {
+			return nil, err
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(b)), nil
+		}
+	case req.GetBody != nil:
+		getBody = req.GetBody
+	default:
+		// The body was encoded, e.g. with aws-chunked, and cannot be copied.
+		return r.Service.Config.HTTPClient.Do(req)
+	}
+
+	results := make(chan hedgeResult, 2)
+	var cancels []func()
+	send := func() {
+		ctx, cancel := context.WithCancel(req.Context())
+		cancels = append(cancels, cancel)
+		hedged := req.Clone(ctx)
+		i := len(cancels) - 1
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				results <- hedgeResult{index: i, err: err}
+				return
+			}
+			hedged.Body = body
+		}
+		go func() {
+			resp, err := r.Service.Config.HTTPClient.Do(hedged)
+			results <- hedgeResult{index: i, resp: resp, err: err}
+		}()
+	}
+
+	send()
+	timer := time.NewTimer(r.hedgeDelay)
+	defer timer.Stop()
+
+	pending := 1
+	for {
+		var res hedgeResult
+		select {
+		case <-timer.C:
+			send()
+			pending++
+			continue
+		case res = <-results:
+			pending--
+		}
+		if res.err != nil && pending > 0 {
+			// The other copy may still succeed.
+			continue
+		}
+
+		// The other copy is canceled, and its response discarded.
+		for i, cancel := range cancels {
+			if i != res.index {
+				cancel()
+			}
+		}
+		if pending > 0 {
+			go func() {
+				if other := <-results; other.resp != nil {
+					other.resp.Body.Close()
+				}
+			}()
+		}
+		if res.err != nil {
+			cancels[res.index]()
+			return nil, res.err
+		}
+		res.resp.Body = &attemptBody{ReadCloser: res.resp.Body, cancel: cancels[res.index]}
+		return res.resp, nil
+	}
+}
+
+// isPayloadBody returns true if the HTTP body is the request's Body as set by
+// SetReaderBody, and not an encoding of it.
+func isPayloadBody(body io.ReadCloser) bool {
+	_, ok := body.(*payloadBody)
+	return ok
+}
+
+// idempotent returns true if the request's operation can safely be processed
+// twice by the service.
+func (r *Request) idempotent() bool {
+	switch r.Operation.HTTPMethod {
+	case "GET", "HEAD":
+		return true
+	}
+	for _, prefix := range HedgedOperationPrefixes {
+		if strings.HasPrefix(r.Operation.Name, prefix) {
+			return true
+		}
+	}
+	return hasIdempotencyToken(r.Params)
+}
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hedgeTransport responds to the requests it receives after the delay of the
+// request, recording the bodies and contexts of the requests.
+type hedgeTransport struct {
+	sync.Mutex
+	delays []time.Duration
+	bodies []string
+	ctxs   []Context
+}
+
+func (t *hedgeTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	b, _ := ioutil.ReadAll(r.Body)
+	t.Lock()
+	i := len(t.bodies)
+	t.bodies = append(t.bodies, string(b))
+	t.ctxs = append(t.ctxs, r.Context())
+	delay := t.delays[i]
+	t.Unlock()
+
+	select {
+	case <-time.After(delay):
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+	return &http.Response{StatusCode: 200, Body: body(`{"data":"copy` + string('0'+rune(i)) + `"}`)}, nil
+}
+
+func sendHedged(tr *hedgeTransport, delay time.Duration, name string, opts ...Option) (*Request, *testData, error) {
+	s := NewService(&Config{Region: "mock-region", Endpoint: "https://localhost", MaxRetries: 0,
+		HTTPClient: &http.Client{Transport: tr}})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: name, HTTPMethod: "POST"}, nil, out)
+	r.SetStringBody(`{"key":"value"}`)
+	r.ApplyOptions(append(opts, WithHedging(delay))...)
+	err := r.Send()
+	return r, out, err
+}
+
+// encodeBody returns an Option which replaces the HTTP body of the request
+// when it is signed, like the aws-chunked signer, with a body which can be
+// copied with GetBody if copyable is true.
+func encodeBody(copyable bool) Option {
+	return func(r *Request) {
+		r.Handlers.Sign.PushBack(func(r *Request) {
+			r.HTTPRequest.Body = ioutil.NopCloser(strings.NewReader("encoded"))
+			r.HTTPRequest.ContentLength = int64(len("encoded"))
+			r.HTTPRequest.GetBody = nil
+			if copyable {
+				r.HTTPRequest.GetBody = func() (io.ReadCloser, error) {
+					return ioutil.NopCloser(strings.NewReader("encoded")), nil
+				}
+			}
+		})
+	}
+}
+
+func TestHedgedAttempt(t *testing.T) {
+	tr := &hedgeTransport{delays: []time.Duration{time.Minute, 0}}
+	_, out, err := sendHedged(tr, 10*time.Millisecond, "GetItem")
+	assert.NoError(t, err)
+	assert.Equal(t, "copy1", out.Data)
+
+	// The copies are sent with the same body, and the slow copy is canceled.
+	assert.Equal(t, []string{`{"key":"value"}`, `{"key":"value"}`}, tr.bodies)
+	assert.Eventually(t, func() bool { return tr.ctxs[0].Err() != nil }, time.Second, time.Millisecond)
+}
+
+func TestHedgedAttemptNotNeeded(t *testing.T) {
+	tr := &hedgeTransport{delays: []time.Duration{0}}
+	_, out, err := sendHedged(tr, time.Minute, "GetItem")
+	assert.NoError(t, err)
+	assert.Equal(t, "copy0", out.Data)
+	assert.Len(t, tr.bodies, 1)
+}
+
+func TestHedgedAttemptSlowHedge(t *testing.T) {
+	tr := &hedgeTransport{delays: []time.Duration{50 * time.Millisecond, time.Minute}}
+	r, out, err := sendHedged(tr, 10*time.Millisecond, "GetItem")
+	assert.NoError(t, err)
+	assert.Equal(t, "copy0", out.Data)
+	assert.Equal(t, uint(0), r.RetryCount)
+
+	tr.Lock()
+	defer tr.Unlock()
+	assert.Len(t, tr.bodies, 2)
+	assert.Eventually(t, func() bool { return tr.ctxs[1].Err() != nil }, time.Second, time.Millisecond)
+}
+
+// failingTransport fails the first request it receives.
+type failingTransport struct {
+	sync.Mutex
+	sent int
+}
+
+func (t *failingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.Lock()
+	t.sent++
+	sent := t.sent
+	t.Unlock()
+	if sent == 1 {
+		time.Sleep(20 * time.Millisecond)
+		return nil, &tempError{}
+	}
+	return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"data":"hedged"}`))}, nil
+}
+
+type tempError struct{}
+
+func (e *tempError) Error() string { return "connection reset" }
+
+func TestHedgedAttemptFailedCopy(t *testing.T) {
+	tr := &failingTransport{}
+	s := NewService(&Config{Region: "mock-region", Endpoint: "https://localhost", MaxRetries: 0,
+		HTTPClient: &http.Client{Transport: tr}})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+
+	// The response of the other copy is used if a copy fails.
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "GetItem"}, nil, out)
+	r.ApplyOptions(WithHedging(time.Millisecond))
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "hedged", out.Data)
+}
+
+func TestHedgedAttemptNotIdempotent(t *testing.T) {
+	tr := &hedgeTransport{delays: []time.Duration{50 * time.Millisecond}}
+	_, out, err := sendHedged(tr, 10*time.Millisecond, "PutItem")
+	assert.NoError(t, err)
+	assert.Equal(t, "copy0", out.Data)
+	assert.Len(t, tr.bodies, 1, "expect requests of non-idempotent operations not to be hedged")
+}
+
+type idempotencyTokenInput struct {
+	ClientToken *string `idempotencyToken:"true"`
+}
+
+func TestHedgedAttemptIdempotencyToken(t *testing.T) {
+	tr := &hedgeTransport{delays: []time.Duration{time.Minute, 0}}
+	_, out, err := sendHedged(tr, 10*time.Millisecond, "PutItem", func(r *Request) {
+		r.Params = &idempotencyTokenInput{}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "copy1", out.Data)
+	assert.Len(t, tr.bodies, 2)
+}
+
+func TestHedgedAttemptEncodedBody(t *testing.T) {
+	tr := &hedgeTransport{delays: []time.Duration{50 * time.Millisecond}}
+	_, out, err := sendHedged(tr, 10*time.Millisecond, "GetItem", encodeBody(false))
+	assert.NoError(t, err)
+	assert.Equal(t, "copy0", out.Data)
+	assert.Equal(t, []string{"encoded"}, tr.bodies, "expect requests with encoded bodies not to be hedged")
+}
+
+func TestHedgedAttemptGetBody(t *testing.T) {
+	tr := &hedgeTransport{delays: []time.Duration{time.Minute, 0}}
+	_, out, err := sendHedged(tr, 10*time.Millisecond, "GetItem", encodeBody(true))
+	assert.NoError(t, err)
+	assert.Equal(t, "copy1", out.Data)
+	assert.Equal(t, []string{"encoded", "encoded"}, tr.bodies, "expect the copies to be sent with the bodies of GetBody")
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"reflect"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// hostLabelRE matches the labels of host prefixes, e.g. "{AccountId}".
+var hostLabelRE = regexp.MustCompile(`\{(\w+)\}`)
+
+// validHostLabelRE matches the values host labels can be set to, a single
+// label of a host name.
+var validHostLabelRE = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// MakeHostPrefixHandler returns a request handler prefixing the host of the
+// request's URL with prefix, for operations whose API model sends requests to
+// a host prefixed endpoint, e.g. "data.". Labels of the prefix, e.g.
+// "{AccountId}.", are replaced with the value of the input parameter of the
+// same name. The host is not prefixed if the Config's
+// DisableEndpointHostPrefix is set.
+//
+// The handler must run after the protocol's Build handler, e.g. be pushed
+// back to the Build handlers of the operation's requests.
+func MakeHostPrefixHandler(prefix string) func(*Request) {
+	return func(r *Request) {
+		if r.Config.DisableEndpointHostPrefix {
+			return
+		}
+
+		var err error
+		host := hostLabelRE.ReplaceAllStringFunc(prefix, func(label string) string {
+			name := label[1 : len(label)-1]
+			value, ok := hostLabel(r.Params, name)
+			if !ok && err == nil {
+				err = awserr.New("HostLabelError", "host label "+name+" must be set to a valid host label, "+
+					"1 to 63 letters, digits, and hyphens, not starting or ending with a hyphen", nil)
+			}
+			return value
+		})
+		if err != nil {
+			r.Error = err
+			return
+		}
+
+		r.HTTPRequest.URL.Host = host + r.HTTPRequest.URL.Host
+	}
+}
+
+// hostLabel returns the value of the string member name of the params, and
+// true if it is a valid host label.
+func hostLabel(params interface{}, name string) (string, bool) {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	f := v.FieldByName(name)
+	if !f.IsValid() || f.Type() != reflect.TypeOf((*string)(nil)) || f.IsNil() {
+		return "", false
+	}
+	value := f.Elem().String()
+	return value, validHostLabelRE.MatchString(value)
+}
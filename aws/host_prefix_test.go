@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+type hostPrefixInput struct {
+	AccountID *string
+	Count     *int64
+}
+
+// sendHostPrefix sends a request with the input to a service with the Config,
+// prefixing its host with prefix, and returns the host it was sent to.
+func sendHostPrefix(cfg *Config, prefix string, params interface{}) (string, error) {
+	cfg.Region = "mock-region"
+	s := NewService(cfg)
+	s.ServiceName = "mock-service"
+	s.buildEndpoint()
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(``)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, params, nil)
+	r.Handlers.Build.PushBackNamed(NamedHandler{Name: HostPrefixHandlerName, Fn: MakeHostPrefixHandler(prefix)})
+	err := r.Send()
+	return r.HTTPRequest.URL.Host, err
+}
+
+func TestHostPrefix(t *testing.T) {
+	host, err := sendHostPrefix(&Config{}, "data.", &hostPrefixInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, "data.mock-service.mock-region.amazonaws.com", host)
+
+	host, err = sendHostPrefix(&Config{}, "{AccountID}.data.", &hostPrefixInput{AccountID: String("123456789012")})
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012.data.mock-service.mock-region.amazonaws.com", host)
+}
+
+func TestHostPrefixInvalidLabel(t *testing.T) {
+	for _, params := range []interface{}{
+		&hostPrefixInput{},
+		&hostPrefixInput{AccountID: String("")},
+		&hostPrefixInput{AccountID: String("-account")},
+		&hostPrefixInput{AccountID: String("account.example.com")},
+		&hostPrefixInput{AccountID: String("account/path")},
+		nil,
+	} {
+		_, err := sendHostPrefix(&Config{}, "{AccountID}.", params)
+		if assert.Error(t, err) {
+			assert.Equal(t, "HostLabelError", err.(awserr.Error).Code())
+		}
+	}
+
+	_, err := sendHostPrefix(&Config{}, "{Count}.", &hostPrefixInput{Count: Long(1)})
+	assert.Error(t, err)
+}
+
+func TestHostPrefixDisabled(t *testing.T) {
+	host, err := sendHostPrefix(&Config{DisableEndpointHostPrefix: true}, "{AccountID}.data.", &hostPrefixInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-service.mock-region.amazonaws.com", host)
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// newIdempotencyToken returns a random version 4 UUID, see RFC 4122.
+var newIdempotencyToken = func() (string, error) {
+	b := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// FillIdempotencyTokens is a request handler to set the members of the input
+// parameters the API marks as idempotency tokens, such as EC2's ClientToken,
+// to a random UUID if the caller left them nil. The service uses the token to
+// recognize retries of the request, so retries send the same token.
+//
+// The tokens are set on a copy of the input parameters, so that the caller's
+// input can be used for another request without it being taken as a retry.
+func FillIdempotencyTokens(r *Request) {
+	if !r.ParamsFilled() {
+		return
+	}
+	in := reflect.ValueOf(r.Params)
+	if in.Kind() != reflect.Ptr || in.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	var params reflect.Value
+	t := in.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("idempotencyToken") == "" || f.Type != reflect.TypeOf((*string)(nil)) {
+			continue
+		}
+		if !in.Elem().Field(i).IsNil() {
+			continue
+		}
+
+		token, err := newIdempotencyToken()
+		if err != nil {
+			r.Error = awserr.New("IdempotencyTokenError",
+				"failed to generate idempotency token for "+f.Name, err)
+			return
+		}
+		if !params.IsValid() {
+			params = reflect.New(t)
+			params.Elem().Set(in.Elem())
+		}
+		params.Elem().Field(i).Set(reflect.ValueOf(&token))
+	}
+
+	if params.IsValid() {
+		r.Params = params.Interface()
+	}
+}
+
+// hasIdempotencyToken returns true if the input parameters have a member the
+// API marks as an idempotency token.
+func hasIdempotencyToken(params interface{}) bool {
+	t := reflect.TypeOf(params)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.Elem().NumField(); i++ {
+		if t.Elem().Field(i).Tag.Get("idempotencyToken") != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package aws_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+type idempotentInput struct {
+	Name  *string `type:"string"`
+	Token *string `type:"string" idempotencyToken:"true"`
+
+	SDKShapeTraits bool
+}
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestFillIdempotencyTokens(t *testing.T) {
+	input := &idempotentInput{Name: aws.String("name")}
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.FillIdempotencyTokens(req)
+	assert.NoError(t, req.Error)
+
+	params := req.Params.(*idempotentInput)
+	assert.Regexp(t, uuidRegexp, aws.StringValue(params.Token))
+	assert.Equal(t, "name", aws.StringValue(params.Name))
+	assert.Nil(t, input.Token, "Expect the caller's input to not be modified")
+
+	req2 := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.FillIdempotencyTokens(req2)
+	assert.NotEqual(t, aws.StringValue(params.Token),
+		aws.StringValue(req2.Params.(*idempotentInput).Token), "Expect a new token for each request")
+}
+
+func TestFillIdempotencyTokensSet(t *testing.T) {
+	input := &idempotentInput{Token: aws.String("token")}
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.FillIdempotencyTokens(req)
+
+	assert.True(t, req.Params == input, "Expect the input to be used as is")
+	assert.Equal(t, "token", aws.StringValue(input.Token))
+}
+
+func TestFillIdempotencyTokensBeforeValidation(t *testing.T) {
+	type requiredTokenInput struct {
+		Token *string `type:"string" idempotencyToken:"true" required:"true"`
+
+		SDKShapeTraits bool
+	}
+
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+	req := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, &requiredTokenInput{}, nil)
+	req.Handlers.Validate.Run(req)
+	assert.NoError(t, req.Error)
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+)
+
+// An Iterator iterates over the results of a paginated request with a
+// channel, requesting the request's pages as their results are received from
+// the channel. The results are the elements of the lists at the Paginator's
+// ResultKeys in each page, e.g. the *s3.Object Contents of ListObjects' pages,
+// or the pages themselves if the operation has no result keys.
+//
+// The channel is not buffered, so a page is not requested before all the
+// results of the previous page were received. The channel is closed once the
+// results of the last page were received, or a page's request failed, after
+// which Err returns the error the iteration stopped with, if any.
+//
+// Example:
+//     req, _ := svc.ListObjectsRequest(params)
+//     it := aws.NewIterator(req)
+//     defer it.Close()
+//     for v := range it.C {
+//         obj := v.(*s3.Object)
+//         // process the object
+//     }
+//     if err := it.Err(); err != nil {
+//         // handle the error
+//     }
+type Iterator struct {
+	// The channel the results are received from.
+	C <-chan interface{}
+
+	err       error
+	cancel    func()
+	closeOnce sync.Once
+	closed    chan struct{} // closed by Close
+	done      chan struct{} // closed once the iteration stopped
+}
+
+// NewIterator returns an Iterator iterating over the results of the request
+// and of the requests of its next pages, which are sent with the request's
+// context. The request must not be sent before, or by another caller.
+//
+// Iterating stops, with an ErrCodeRequestCanceled Err, if the request's
+// context is canceled. Callers which stop receiving from the channel before
+// it is closed must call Close, or cancel the context, so the iteration does
+// not block forever.
+func NewIterator(r *Request) *Iterator {
+	ctx, cancel := context.WithCancel(r.Context())
+	r.SetContext(ctx)
+
+	c := make(chan interface{})
+	it := &Iterator{
+		C:      c,
+		cancel: cancel,
+		closed: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		err := r.EachPage(func(page interface{}, lastPage bool) bool {
+			for _, v := range pageResults(r.Operation.Paginator, page) {
+				select {
+				case c <- v:
+				case <-ctx.Done():
+					return false
+				}
+			}
+			return true
+		})
+		if err == nil && ctx.Err() != nil {
+			err = newCanceledError(ctx.Err())
+		}
+
+		select {
+		case <-it.closed:
+			err = nil // the caller stopped iterating
+		default:
+		}
+		it.err = err
+		cancel()
+		close(c)
+		close(it.done)
+	}()
+
+	return it
+}
+
+// Err returns the error the iteration stopped with, nil if it iterated over
+// all the pages, or was stopped by Close. Err must only be called once the
+// channel is closed.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration, canceling the request in flight, if any, and
+// waits for it to stop. Close can be called once the iteration stopped, and
+// more than once.
+func (it *Iterator) Close() {
+	it.closeOnce.Do(func() { close(it.closed) })
+	it.cancel()
+	<-it.done
+}
+
+// pageResults returns the elements of the lists at the paginator's result
+// keys in the page, or the page if the paginator has no result keys.
+func pageResults(p *Paginator, page interface{}) []interface{} {
+	if p == nil || len(p.ResultKeys) == 0 {
+		return []interface{}{page}
+	}
+
+	results := []interface{}{}
+	for _, key := range p.ResultKeys {
+		for _, v := range awsutil.ValuesAtAnyPath(page, key) {
+			rv := reflect.ValueOf(v)
+			if rv.Kind() != reflect.Slice {
+				results = append(results, v)
+				continue
+			}
+			for i := 0; i < rv.Len(); i++ {
+				results = append(results, rv.Index(i).Interface())
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// sensitiveHeaders is a collection of HTTP headers whose values are never
+// written to the log.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+}
+
+const redactedValue = "<sensitive>"
+
+var sensitiveHeadersRE = regexp.MustCompile(
+	`(?im)^(` + strings.Join(sensitiveHeaders, "|") + `):[^\r\n]*`)
+
+// redactLogDump replaces the values of signing headers, and the members of
+// the request's input and output shapes marked as sensitive in the API
+// models, in the dumped HTTP request or response.
+func redactLogDump(r *Request, dump []byte) []byte {
+	dump = sensitiveHeadersRE.ReplaceAll(dump, []byte("$1: "+redactedValue))
+
+	names := map[string]struct{}{}
+	sensitiveMemberNames(reflect.TypeOf(r.Params), names, map[reflect.Type]bool{})
+	sensitiveMemberNames(reflect.TypeOf(r.Data), names, map[reflect.Type]bool{})
+	for name := range names {
+		for _, re := range sensitiveMemberREs(name) {
+			dump = re.ReplaceAll(dump, []byte("${1}"+redactedValue+"${2}"))
+		}
+	}
+	return dump
+}
+
+// sensitiveMemberNames walks the shape type t adding the wire names of the
+// members tagged as sensitive to names.
+func sensitiveMemberNames(t reflect.Type, names map[string]struct{}, seen map[reflect.Type]bool) {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Map) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || seen[t] {
+		return
+	}
+	seen[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // ignore unexported fields
+		}
+		if field.Tag.Get("sensitive") == "true" {
+			name := field.Tag.Get("locationName")
+			if name == "" {
+				name = field.Name
+			}
+			names[name] = struct{}{}
+			continue
+		}
+		sensitiveMemberNames(field.Type, names, seen)
+	}
+}
+
+// sensitiveMemberREs returns the expressions matching the value of the member
+// name in a header, JSON, XML, or query string encoded dump. The first and
+// second groups capture the text surrounding the value.
+func sensitiveMemberREs(name string) []*regexp.Regexp {
+	n := regexp.QuoteMeta(name)
+	return []*regexp.Regexp{
+		regexp.MustCompile(`(?im)(^` + n + `: )[^\r\n]*()`),
+		regexp.MustCompile(`("` + n + `"\s*:\s*)"(?:[^"\\]|\\.)*"()`),
+		regexp.MustCompile(`(<` + n + `(?:\s[^>]*)?>)[^<]*(</` + n + `>)`),
+		regexp.MustCompile(`(?m)((?:^|&|\.)` + n + `=)[^&\s]*()`),
+	}
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type redactNested struct {
+	Secret *string `locationName:"secretValue" type:"string" sensitive:"true"`
+	Public *string `type:"string"`
+}
+
+type redactInput struct {
+	Key    *string        `location:"header" locationName:"x-amz-key" type:"string" sensitive:"true"`
+	Nested *redactNested  `type:"structure"`
+	List   []redactNested `type:"list"`
+	Data   []byte         `type:"blob" sensitive:"true"`
+}
+
+func TestRedactLogDump(t *testing.T) {
+	r := &Request{Params: &redactInput{}}
+
+	dump := strings.Join([]string{
+		"POST / HTTP/1.1",
+		"Authorization: AWS4-HMAC-SHA256 Credential=AKID/20150101/us-east-1/mock/aws4_request, Signature=abc",
+		"X-Amz-Security-Token: token",
+		"X-Amz-Key: header-secret",
+		"",
+		`{"Data":"cGxhaW50ZXh0","Nested":{"secretValue":"a \"quoted\" secret","Public":"visible"}}`,
+		`<Nested><secretValue>xml-secret</secretValue><Public>visible</Public></Nested>`,
+		`Action=Op&Nested.secretValue=query-secret&Nested.Public=visible`,
+	}, "\r\n")
+
+	out := string(redactLogDump(r, []byte(dump)))
+	for _, secret := range []string{"Signature=abc", "token", "header-secret", "cGxhaW50ZXh0", "quoted", "xml-secret", "query-secret"} {
+		assert.NotContains(t, out, secret)
+	}
+	assert.Contains(t, out, "Authorization: <sensitive>")
+	assert.Contains(t, out, "X-Amz-Security-Token: <sensitive>")
+	assert.Contains(t, out, "X-Amz-Key: <sensitive>")
+	assert.Contains(t, out, `"Data":<sensitive>`)
+	assert.Contains(t, out, "<secretValue><sensitive></secretValue>")
+	assert.Contains(t, out, "Nested.secretValue=<sensitive>&")
+	assert.Equal(t, 3, strings.Count(out, "visible"))
+}
+
+func TestDebugHandlersRedactSensitiveValues(t *testing.T) {
+	msgs := []string{}
+	logger := LoggerFunc(func(args ...interface{}) {
+		for _, a := range args {
+			msgs = append(msgs, a.(string))
+		}
+	})
+
+	s := NewService(&Config{Logger: logger, Endpoint: "https://localhost"})
+	s.Config.LogLevel = LogDebugWithHTTPBody
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{},
+			Body: body(`{"Nested":{"secretValue":"response-secret"}}`)}
+	})
+	s.AddDebugHandlers()
+	r := NewRequest(s, &Operation{Name: "Operation"}, &redactInput{}, &redactInput{})
+	r.HTTPRequest.Header.Set("Authorization", "signature")
+	r.SetStringBody(`{"Data":"request-secret"}`)
+	r.Handlers.Send.Run(r)
+
+	assert.Equal(t, 2, len(msgs))
+	assert.NotContains(t, msgs[0], "signature")
+	assert.NotContains(t, msgs[0], "request-secret")
+	assert.NotContains(t, msgs[1], "response-secret")
+}
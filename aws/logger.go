@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"io"
+	"log"
+	"os"
+)
+
+// A LogLevelType defines the level logging should be performed at. Used to
+// instruct the SDK which statements should be logged. The levels are a bitmask,
+// so multiple debug options can be combined, e.g.
+// LogDebugWithSigning | LogDebugWithRequestRetries.
+type LogLevelType uint
+
+// Matches returns true if the v LogLevel is enabled by this LogLevel. Should be
+// used with logging sub levels.
+//
+// Example, will return true if the LogDebug and LogDebugWithHTTPBody flags are
+// both set:
+//     LogLevel.Matches(LogDebugWithHTTPBody)
+func (l LogLevelType) Matches(v LogLevelType) bool {
+	return l&v == v
+}
+
+// AtLeast returns true if this LogLevel is at least high enough to satisfy v.
+func (l LogLevelType) AtLeast(v LogLevelType) bool {
+	return l >= v
+}
+
+const (
+	// LogOff states that no logging should be performed by the SDK. This is the
+	// default state of the SDK, and should be use to disable all logging.
+	LogOff LogLevelType = 0
+
+	// LogDebug state that debug output should be logged by the SDK. The HTTP
+	// request and response headers will be logged for each request sent.
+	LogDebug LogLevelType = 1
+)
+
+// Debug Logging Sub Levels
+const (
+	// LogDebugWithSigning states that the SDK should log request signing and
+	// presigning events. This should be used to log the signing details of
+	// requests for debugging. Will also enable LogDebug.
+	LogDebugWithSigning LogLevelType = LogDebug | (1 << (iota + 1))
+
+	// LogDebugWithHTTPBody states the SDK should log HTTP request and response
+	// bodies in addition to the headers and path. This should be used to see
+	// the body content of requests and responses made while using the SDK.
+	// Will also enable LogDebug.
+	LogDebugWithHTTPBody
+
+	// LogDebugWithRequestRetries states the SDK should log when service
+	// requests will be retried. This should be used to log when you want to
+	// log when service requests are being retried. Will also enable LogDebug.
+	LogDebugWithRequestRetries
+
+	// LogDebugWithRequestErrors states the SDK should log when service requests
+	// fail to build, send, validate, or unmarshal. Will also enable LogDebug.
+	LogDebugWithRequestErrors
+)
+
+// A Logger is a minimalistic interface for the SDK to log messages to. Should
+// be used to provide custom logging writers for the SDK to use, such as
+// routing log messages into a leveled or structured logging package.
+type Logger interface {
+	Log(...interface{})
+}
+
+// A LoggerFunc is a convenience type to convert a function taking a variadic
+// list of arguments and wrap it so the Logger interface can be used.
+//
+// Example:
+//     svc := s3.New(&aws.Config{Logger: aws.LoggerFunc(func(args ...interface{}) {
+//         fmt.Fprintln(os.Stdout, args...)
+//     })})
+type LoggerFunc func(...interface{})
+
+// Log calls the wrapped function with the arguments provided.
+func (f LoggerFunc) Log(args ...interface{}) {
+	f(args...)
+}
+
+// NewDefaultLogger returns a Logger which will write log messages to stdout,
+// and use the same formatting runes as the stdlib log.Logger.
+func NewDefaultLogger() Logger {
+	return NewWriterLogger(os.Stdout)
+}
+
+// NewWriterLogger returns a Logger which will write log messages to the
+// io.Writer provided, using the same formatting runes as the stdlib
+// log.Logger.
+func NewWriterLogger(w io.Writer) Logger {
+	return &defaultLogger{
+		logger: log.New(w, "", log.LstdFlags),
+	}
+}
+
+// A defaultLogger provides a minimalistic logger satisfying the Logger
+// interface.
+type defaultLogger struct {
+	logger *log.Logger
+}
+
+// Log logs the parameters to the stdlib logger. See log.Println.
+func (l defaultLogger) Log(args ...interface{}) {
+	l.logger.Println(args...)
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriterLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := NewWriterLogger(buf)
+	l.Log("hello", "world")
+
+	assert.True(t, strings.HasSuffix(buf.String(), "hello world\n"), "unexpected log %q", buf.String())
+}
+
+func TestServiceDebugHandlersLogToLogger(t *testing.T) {
+	msgs := []string{}
+	logger := LoggerFunc(func(args ...interface{}) {
+		for _, a := range args {
+			msgs = append(msgs, a.(string))
+		}
+	})
+
+	s := NewService(&Config{Logger: logger, Endpoint: "https://localhost"})
+	s.ServiceName = "mock"
+	s.Config.LogLevel = 1
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{}, Body: body("")}
+	})
+	s.AddDebugHandlers()
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.Handlers.Send.Run(r)
+
+	assert.Equal(t, 2, len(msgs))
+	assert.Contains(t, msgs[0], "DEBUG: Request mock/Operation Details:")
+	assert.Contains(t, msgs[0], "REQUEST POST-SIGN")
+	assert.Contains(t, msgs[1], "DEBUG: Response mock/Operation Details:")
+	assert.Contains(t, msgs[1], "200 OK")
+}
+
+func TestLogLevelMatches(t *testing.T) {
+	assert.True(t, LogDebug.Matches(LogDebug))
+	assert.False(t, LogOff.Matches(LogDebug))
+	assert.False(t, LogDebug.Matches(LogDebugWithSigning))
+	assert.True(t, LogDebugWithSigning.Matches(LogDebug))
+
+	l := LogDebugWithSigning | LogDebugWithRequestRetries
+	assert.True(t, l.Matches(LogDebug))
+	assert.True(t, l.Matches(LogDebugWithSigning))
+	assert.True(t, l.Matches(LogDebugWithRequestRetries))
+	assert.False(t, l.Matches(LogDebugWithHTTPBody))
+	assert.False(t, l.Matches(LogDebugWithRequestErrors))
+
+	assert.True(t, LogDebugWithHTTPBody.AtLeast(LogDebug))
+	assert.False(t, LogOff.AtLeast(LogDebug))
+}
+
+func TestRequestRetryAndErrorLogging(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	msgs := []string{}
+	logger := LoggerFunc(func(args ...interface{}) {
+		for _, a := range args {
+			msgs = append(msgs, a.(string))
+		}
+	})
+
+	s := NewService(&Config{MaxRetries: 1, Logger: logger, LogLevel: LogDebugWithRequestRetries | LogDebugWithRequestErrors})
+	s.ServiceName = "mock"
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+
+	assert.Equal(t, 2, len(msgs))
+	assert.Contains(t, msgs[0], "DEBUG: Retrying Request mock/Operation, attempt 1")
+	assert.Contains(t, msgs[1], "DEBUG: Request mock/Operation failed, attempt 2/2")
+	assert.Contains(t, msgs[1], "UnknownError")
+}
+
+func TestRequestNoBodyLoggingWithoutFlag(t *testing.T) {
+	msgs := []string{}
+	logger := LoggerFunc(func(args ...interface{}) {
+		for _, a := range args {
+			msgs = append(msgs, a.(string))
+		}
+	})
+
+	for _, c := range []struct {
+		level   LogLevelType
+		hasBody bool
+	}{
+		{LogDebugWithRequestRetries, false},
+		{LogDebugWithHTTPBody, true},
+	} {
+		msgs = msgs[:0]
+		s := NewService(&Config{Logger: logger, Endpoint: "https://localhost"})
+		s.Config.LogLevel = c.level
+		s.Handlers.Send.Clear() // mock sending
+		s.Handlers.Send.PushBack(func(r *Request) {
+			r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{}, Body: body("response body")}
+		})
+		s.AddDebugHandlers()
+		r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+		r.Handlers.Send.Run(r)
+
+		assert.Equal(t, 2, len(msgs))
+		assert.Equal(t, c.hasBody, strings.Contains(msgs[1], "response body"), "level %v", c.level)
+	}
+}
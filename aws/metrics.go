@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// A MetricsCollector collects the metrics of each attempt of the requests
+// sent by service clients, e.g. to export them to a monitoring system. The
+// collector is called by every request of the clients created with its
+// Config, so it must be safe for concurrent use.
+type MetricsCollector interface {
+	CollectAttempt(AttemptMetrics)
+}
+
+// A MetricsCollectorFunc is a convenience type to wrap a function so the
+// MetricsCollector interface can be used.
+//
+// Example:
+//     svc := s3.New(&aws.Config{MetricsCollector: aws.MetricsCollectorFunc(func(m aws.AttemptMetrics) {
+//         latency.WithLabelValues(m.ServiceName, m.Operation).Observe(m.Latency.Seconds())
+//     })})
+type MetricsCollectorFunc func(AttemptMetrics)
+
+// CollectAttempt calls the wrapped function with the attempt's metrics.
+func (f MetricsCollectorFunc) CollectAttempt(m AttemptMetrics) {
+	f(m)
+}
+
+// AttemptMetrics are the metrics of an attempt of a request.
+type AttemptMetrics struct {
+	// The name of the service and of the operation of the request, e.g. "s3"
+	// and "GetObject".
+	ServiceName string
+	Operation   string
+
+	// The number of times the request was retried before the attempt, zero
+	// for the first attempt.
+	RetryCount uint
+
+	// The time from when the attempt was sent until its response was
+	// unmarshaled, or the attempt failed.
+	Latency time.Duration
+
+	// The HTTP status code of the attempt's response, zero if no response was
+	// received.
+	StatusCode int
+
+	// The code of the error the attempt failed with, e.g. "Throttling", or
+	// empty if the attempt succeeded.
+	ErrorCode string
+}
+
+// collectAttempt passes the metrics of the attempt sent at start to the
+// Config's MetricsCollector, if it is set, reports the attempt to the CSM
+// agent if CSM is enabled, and ends the attempt's span if it is traced.
+func (r *Request) collectAttempt(start time.Time) {
+	r.endAttemptSpan()
+	r.Service.csm.reportAttempt(r, start)
+
+	collector := r.Service.Config.MetricsCollector
+	if collector == nil {
+		return
+	}
+
+	m := AttemptMetrics{
+		ServiceName: r.ServiceName,
+		Operation:   r.Operation.Name,
+		RetryCount:  r.RetryCount,
+		Latency:     time.Since(start),
+	}
+	if r.HTTPResponse != nil {
+		m.StatusCode = r.HTTPResponse.StatusCode
+	}
+	if r.Error != nil {
+		m.ErrorCode = "UnknownError"
+		if err, ok := r.Error.(awserr.Error); ok {
+			m.ErrorCode = err.Code()
+		}
+	}
+	collector.CollectAttempt(m)
+}
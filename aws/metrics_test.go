@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingCollector struct {
+	attempts []AttemptMetrics
+}
+
+func (c *recordingCollector) CollectAttempt(m AttemptMetrics) {
+	c.attempts = append(c.attempts, m)
+}
+
+var testMetricsCollector = &recordingCollector{}
+
+func TestCollectAttemptMetrics(t *testing.T) {
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 400, Body: body(`{"__type":"Throttling","message":"Rate exceeded."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	c := &recordingCollector{}
+	s := NewService(&Config{MaxRetries: 10, RetryBaseDelay: time.Millisecond, MetricsCollector: c})
+	s.ServiceName = "mock-service"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+
+	assert.Len(t, c.attempts, 3)
+	for i, m := range c.attempts {
+		assert.Equal(t, "mock-service", m.ServiceName)
+		assert.Equal(t, "Operation", m.Operation)
+		assert.Equal(t, uint(i), m.RetryCount)
+		assert.True(t, m.Latency >= 0)
+	}
+	assert.Equal(t, 500, c.attempts[0].StatusCode)
+	assert.Equal(t, "UnknownError", c.attempts[0].ErrorCode)
+	assert.Equal(t, 400, c.attempts[1].StatusCode)
+	assert.Equal(t, "Throttling", c.attempts[1].ErrorCode)
+	assert.Equal(t, 200, c.attempts[2].StatusCode)
+	assert.Equal(t, "", c.attempts[2].ErrorCode)
+}
+
+func TestCollectAttemptMetricsFunc(t *testing.T) {
+	var collected []AttemptMetrics
+	s := NewService(&Config{MetricsCollector: MetricsCollectorFunc(func(m AttemptMetrics) {
+		collected = append(collected, m)
+	})})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(``)}
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Len(t, collected, 1)
+	assert.Equal(t, 200, collected[0].StatusCode)
+}
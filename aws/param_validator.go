@@ -3,29 +3,96 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
+const (
+	// ErrCodeInvalidParameter is the code of the InvalidParamsError
+	// ValidateParameters sets as the request's error.
+	ErrCodeInvalidParameter = "InvalidParameter"
+
+	// ErrCodeParamRequired is the code of the InvalidParamError of a required
+	// parameter which is not set.
+	ErrCodeParamRequired = "ParamRequiredError"
+
+	// ErrCodeParamMinLen is the code of the InvalidParamError of a string,
+	// blob, list, or map parameter shorter than the minimum length.
+	ErrCodeParamMinLen = "ParamMinLenError"
+
+	// ErrCodeParamMaxLen is the code of the InvalidParamError of a string,
+	// blob, list, or map parameter longer than the maximum length.
+	ErrCodeParamMaxLen = "ParamMaxLenError"
+
+	// ErrCodeParamMinValue is the code of the InvalidParamError of a numeric
+	// parameter less than the minimum value.
+	ErrCodeParamMinValue = "ParamMinValueError"
+
+	// ErrCodeParamMaxValue is the code of the InvalidParamError of a numeric
+	// parameter greater than the maximum value.
+	ErrCodeParamMaxValue = "ParamMaxValueError"
+)
+
+// An InvalidParamsError is the error of the input parameters of a request
+// which failed validation. It collects the errors of all invalid parameters,
+// including those nested in structures, lists, and maps.
+//
+// Example:
+//
+//     _, err := svc.BatchWriteItem(input)
+//     if paramsErr, ok := err.(aws.InvalidParamsError); ok {
+//         for _, paramErr := range paramsErr.Errs() {
+//             // e.g. RequestItems["users"][2].PutRequest.Item
+//             log.Println(paramErr.Field(), paramErr.Code())
+//         }
+//     }
+//
+type InvalidParamsError interface {
+	awserr.Error
+
+	// Returns the errors of the invalid parameters, in the order the
+	// parameters were validated.
+	Errs() []InvalidParamError
+}
+
+// An InvalidParamError is the error of a single invalid input parameter.
+type InvalidParamError interface {
+	awserr.Error
+
+	// The path of the parameter from the input structure, e.g.
+	// Requests[2].PutRequest.Item. List elements are indexed by position and
+	// map values by their quoted key.
+	Field() string
+}
+
 // ValidateParameters is a request handler to validate the input parameters.
 // Validating parameters only has meaning if done prior to the request being sent.
+//
+// Besides required parameters being set, the values of set parameters are
+// checked against the constraints of the API model in their field's tags:
+// the min and max lengths of strings, blobs, lists, and maps, the min and max
+// values of numbers. The length of a string is its number of characters.
+//
+// If any parameters are invalid the request's error is set to an
+// InvalidParamsError.
 func ValidateParameters(r *Request) {
 	if r.ParamsFilled() {
-		v := validator{errors: []string{}}
+		v := validator{}
 		v.validateAny(reflect.ValueOf(r.Params), "")
 
-		if count := len(v.errors); count > 0 {
-			format := "%d validation errors:\n- %s"
-			msg := fmt.Sprintf(format, count, strings.Join(v.errors, "\n- "))
-			r.Error = awserr.New("InvalidParameter", msg, nil)
+		if len(v.errs) > 0 {
+			r.Error = newInvalidParamsError(v.errs)
 		}
 	}
 }
 
 // A validator validates values. Collects validations errors which occurs.
 type validator struct {
-	errors []string
+	errs []InvalidParamError
 }
 
 // validateAny will validate any struct, slice or map type. All validations
@@ -44,7 +111,10 @@ func (v *validator) validateAny(value reflect.Value, path string) {
 			v.validateAny(value.Index(i), path+fmt.Sprintf("[%d]", i))
 		}
 	case reflect.Map:
-		for _, n := range value.MapKeys() {
+		// Sort the keys so the errors are reported in a stable order.
+		keys := value.MapKeys()
+		sort.Sort(mapKeys(keys))
+		for _, n := range keys {
 			v.validateAny(value.MapIndex(n), path+fmt.Sprintf("[%q]", n.String()))
 		}
 	}
@@ -80,10 +150,131 @@ func (v *validator) validateStruct(value reflect.Value, path string) {
 		}
 
 		if notset {
-			msg := "missing required parameter: " + path + prefix + f.Name
-			v.errors = append(v.errors, msg)
+			field := path + prefix + f.Name
+			v.errs = append(v.errs, newInvalidParamError(ErrCodeParamRequired,
+				"missing required parameter: "+field, field))
 		} else {
+			v.validateConstraints(f.Tag, fvalue, path+prefix+f.Name)
 			v.validateAny(fvalue, path+prefix+f.Name)
 		}
 	}
 }
+
+// validateConstraints will validate the value of the field at path against
+// the constraints of the field's tags. Values which are not set are not
+// validated.
+func (v *validator) validateConstraints(tag reflect.StructTag, value reflect.Value, path string) {
+	value = reflect.Indirect(value)
+	if !value.IsValid() {
+		return
+	}
+
+	min, hasMin := floatTag(tag, "min")
+	max, hasMax := floatTag(tag, "max")
+
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		if value.Kind() != reflect.String && value.IsNil() {
+			return
+		}
+		n := value.Len()
+		if value.Kind() == reflect.String {
+			n = utf8.RuneCountInString(value.String())
+		}
+		if hasMin && float64(n) < min {
+			v.addError(ErrCodeParamMinLen, fmt.Sprintf("minimum length of %v", min), path)
+		}
+		if hasMax && float64(n) > max {
+			v.addError(ErrCodeParamMaxLen, fmt.Sprintf("maximum length of %v", max), path)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := float64(value.Int())
+		if hasMin && n < min {
+			v.addError(ErrCodeParamMinValue, fmt.Sprintf("minimum value of %v", min), path)
+		}
+		if hasMax && n > max {
+			v.addError(ErrCodeParamMaxValue, fmt.Sprintf("maximum value of %v", max), path)
+		}
+	case reflect.Float32, reflect.Float64:
+		n := value.Float()
+		if hasMin && n < min {
+			v.addError(ErrCodeParamMinValue, fmt.Sprintf("minimum value of %v", min), path)
+		}
+		if hasMax && n > max {
+			v.addError(ErrCodeParamMaxValue, fmt.Sprintf("maximum value of %v", max), path)
+		}
+	}
+}
+
+// addError adds the error of the invalid parameter at path.
+func (v *validator) addError(code, constraint, path string) {
+	v.errs = append(v.errs, newInvalidParamError(code,
+		"invalid parameter, "+constraint+": "+path, path))
+}
+
+// floatTag returns the number value of the tag key, and if the tag is set.
+func floatTag(tag reflect.StructTag, key string) (float64, bool) {
+	s := tag.Get(key)
+	if s == "" {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
+
+// mapKeys sorts the keys of a map by their string value.
+type mapKeys []reflect.Value
+
+func (k mapKeys) Len() int           { return len(k) }
+func (k mapKeys) Less(i, j int) bool { return k[i].String() < k[j].String() }
+func (k mapKeys) Swap(i, j int)      { k[i], k[j] = k[j], k[i] }
+
+// So that the Error interface type can be included as an anonymous field
+// in the error structs and not conflict with the error.Error() method.
+type awsError awserr.Error
+
+// An invalidParamsError wraps the errors of the invalid parameters.
+type invalidParamsError struct {
+	awsError
+	errs []InvalidParamError
+}
+
+// newInvalidParamsError returns an error for the errors of the invalid
+// parameters. The message lists the message of each error.
+func newInvalidParamsError(errs []InvalidParamError) *invalidParamsError {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Message()
+	}
+	msg := fmt.Sprintf("%d validation errors:\n- %s", len(errs), strings.Join(msgs, "\n- "))
+
+	return &invalidParamsError{
+		awsError: awserr.New(ErrCodeInvalidParameter, msg, nil),
+		errs:     errs,
+	}
+}
+
+// Errs returns the errors of the invalid parameters.
+func (e invalidParamsError) Errs() []InvalidParamError {
+	return e.errs
+}
+
+// An invalidParamError wraps the error of an invalid parameter.
+type invalidParamError struct {
+	awsError
+	field string
+}
+
+// newInvalidParamError returns an error for the code and message of the
+// parameter at the path field.
+func newInvalidParamError(code, message, field string) *invalidParamError {
+	return &invalidParamError{
+		awsError: awserr.New(code, message, nil),
+		field:    field,
+	}
+}
+
+// Field returns the path of the parameter.
+func (e invalidParamError) Field() string {
+	return e.field
+}
@@ -82,3 +82,98 @@ func TestNestedMissingRequiredParameters(t *testing.T) {
 	assert.Equal(t, "3 validation errors:\n- missing required parameter: RequiredList[0].Name\n- missing required parameter: RequiredMap[\"key2\"].Name\n- missing required parameter: OptionalStruct.Name", req.Error.(awserr.Error).Message())
 
 }
+
+func TestInvalidParamsErrorFields(t *testing.T) {
+	input := &StructShape{
+		RequiredList: []*ConditionalStructShape{{Name: aws.String("Name")}, {}, {}},
+		RequiredMap: map[string]*ConditionalStructShape{
+			"key2": {},
+			"key1": {},
+		},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+
+	paramsErr, ok := req.Error.(aws.InvalidParamsError)
+	assert.True(t, ok, "Expect an InvalidParamsError")
+	assert.Equal(t, aws.ErrCodeInvalidParameter, paramsErr.Code())
+
+	fields := []string{}
+	for _, err := range paramsErr.Errs() {
+		assert.Equal(t, aws.ErrCodeParamRequired, err.Code())
+		fields = append(fields, err.Field())
+	}
+	assert.Equal(t, []string{
+		"RequiredList[1].Name",
+		"RequiredList[2].Name",
+		`RequiredMap["key1"].Name`,
+		`RequiredMap["key2"].Name`,
+		"RequiredBool",
+	}, fields)
+	assert.Contains(t, paramsErr.Error(), "5 validation errors:")
+}
+
+type ConstrainedShape struct {
+	Name   *string            `type:"string" min:"3" max:"5"`
+	Limit  *int64             `type:"integer" min:"1" max:"100"`
+	Ratio  *float64           `type:"double" min:"0.5"`
+	IDs    []*string          `type:"list" min:"1"`
+	Tags   map[string]*string `type:"map" max:"1"`
+	Nested []*ConstrainedShape
+
+	SDKShapeTraits bool
+}
+
+func TestConstraintsValid(t *testing.T) {
+	input := &ConstrainedShape{
+		Name:  aws.String("héllo"),
+		Limit: aws.Long(100),
+		Ratio: aws.Double(0.5),
+		IDs:   []*string{aws.String("id")},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestConstraintsInvalid(t *testing.T) {
+	input := &ConstrainedShape{
+		Nested: []*ConstrainedShape{
+			{
+				Name:  aws.String("ab"),
+				Limit: aws.Long(0),
+				Ratio: aws.Double(0.1),
+				IDs:   []*string{},
+				Tags:  map[string]*string{"a": aws.String("1"), "b": aws.String("2")},
+			},
+			{
+				Name:  aws.String("abcdef"),
+				Limit: aws.Long(101),
+			},
+		},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+
+	paramsErr, ok := req.Error.(aws.InvalidParamsError)
+	assert.True(t, ok, "Expect an InvalidParamsError")
+
+	type fieldErr struct{ Field, Code string }
+	errs := []fieldErr{}
+	for _, err := range paramsErr.Errs() {
+		errs = append(errs, fieldErr{err.Field(), err.Code()})
+	}
+	assert.Equal(t, []fieldErr{
+		{"Nested[0].Name", aws.ErrCodeParamMinLen},
+		{"Nested[0].Limit", aws.ErrCodeParamMinValue},
+		{"Nested[0].Ratio", aws.ErrCodeParamMinValue},
+		{"Nested[0].IDs", aws.ErrCodeParamMinLen},
+		{"Nested[0].Tags", aws.ErrCodeParamMaxLen},
+		{"Nested[1].Name", aws.ErrCodeParamMaxLen},
+		{"Nested[1].Limit", aws.ErrCodeParamMaxValue},
+	}, errs)
+	assert.Equal(t, "invalid parameter, minimum length of 3: Nested[0].Name", paramsErr.Errs()[0].Message())
+}
@@ -2,14 +2,17 @@ package aws
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
@@ -19,6 +22,7 @@ type Request struct {
 	Handlers     Handlers
 	Time         time.Time
 	ExpireTime   time.Duration
+	Presigning   PresignOptions
 	Operation    *Operation
 	HTTPRequest  *http.Request
 	HTTPResponse *http.Response
@@ -32,7 +36,33 @@ type Request struct {
 	Retryable    SettableBool
 	RetryDelay   time.Duration
 
-	built bool
+	context Context
+	options []Option // applied by ApplyOptions, and to the request's next pages
+	built   bool
+
+	// Releases the context of the last attempt, or retry delay, and if the
+	// request was canceled by Cancel. Cancel may be called while the request
+	// is sent, so both are guarded by cancelMu.
+	cancelMu      sync.Mutex
+	cancelAttempt func()
+	canceled      bool
+
+	// The contexts of the spans of the API call and its current attempt
+	// started by the Config's Tracer, nil if they are not traced.
+	callSpan    Context
+	attemptSpan Context
+
+	// The number of tokens the last retry of the request took from the
+	// service's retry quota.
+	retryQuotaCost int
+
+	// The endpoint of the request's current attempt, whose result is
+	// recorded with the Config's CircuitBreaker.
+	circuitEndpoint string
+
+	// How long an attempt waits for a response before a hedged copy of it
+	// is sent, zero if the request is not hedged.
+	hedgeDelay time.Duration
 }
 
 // An Operation is the service API operation to be made.
@@ -41,6 +71,11 @@ type Operation struct {
 	HTTPMethod string
 	HTTPPath   string
 	*Paginator
+
+	// If the endpoint of the operation's requests can be discovered with the
+	// service's DiscoverEndpoints, and if it must be.
+	EndpointDiscovery         bool
+	EndpointDiscoveryRequired bool
 }
 
 // Paginator keeps track of pagination configuration for an API operation.
@@ -49,6 +84,10 @@ type Paginator struct {
 	OutputTokens    []string
 	LimitToken      string
 	TruncationToken string
+
+	// The paths of the lists of results in the operation's output, which an
+	// Iterator iterates over.
+	ResultKeys []string
 }
 
 // NewRequest returns a new Request pointer for the service API
@@ -73,7 +112,7 @@ func NewRequest(service *Service, operation *Operation, params interface{}, data
 	r := &Request{
 		Service:     service,
 		Handlers:    service.Handlers.copy(),
-		Time:        time.Now(),
+		Time:        service.signingTime(),
 		ExpireTime:  0,
 		Operation:   operation,
 		HTTPRequest: httpReq,
@@ -87,6 +126,137 @@ func NewRequest(service *Service, operation *Operation, params interface{}, data
 	return r
 }
 
+// SetContext adds a Context to the current request that can be used to cancel
+// an in-flight request. The Context value must not be nil, or SetContext will
+// panic.
+//
+// The context will be used for all attempts of the request, including retries,
+// the delay between retries, and retrieving the credentials used to sign the
+// request. If the context is canceled the request will fail with an
+// awserr.Error with the code ErrCodeRequestCanceled. Requests can also be
+// canceled without a context with Cancel.
+//
+// Example:
+//
+//     ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//     defer cancel()
+//
+//     req, out := svc.GetObjectRequest(params)
+//     req.SetContext(ctx)
+//     err := req.Send()
+func (r *Request) SetContext(ctx Context) {
+	if ctx == nil {
+		panic("context cannot be nil")
+	}
+	r.context = ctx
+	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+}
+
+// Context returns the Context of the request. If no context was set with
+// SetContext, BackgroundContext will be returned.
+func (r *Request) Context() Context {
+	if r.context != nil {
+		return r.context
+	}
+	return BackgroundContext()
+}
+
+// Cancel cancels the request, aborting the attempt in flight or the delay
+// before the next retry, e.g. to abort a long poll when an application shuts
+// down. Cancel can be called from another goroutine while the request is
+// sent. The request fails with an awserr.Error with the code
+// ErrCodeRequestCanceled, and is not retried. A request canceled before it is
+// sent fails without being sent.
+//
+// Example:
+//     req, out := svc.ReceiveMessageRequest(params)
+//     go func() {
+//         <-shutdown
+//         req.Cancel()
+//     }()
+//     err := req.Send()
+func (r *Request) Cancel() {
+	r.cancelMu.Lock()
+	defer r.cancelMu.Unlock()
+
+	r.canceled = true
+	if r.cancelAttempt != nil {
+		r.cancelAttempt()
+	}
+}
+
+// contextErr returns the error of the request's context if it is done, or
+// context.Canceled if the request was canceled with Cancel.
+func (r *Request) contextErr() error {
+	if err := r.Context().Err(); err != nil {
+		return err
+	}
+
+	r.cancelMu.Lock()
+	defer r.cancelMu.Unlock()
+	if r.canceled {
+		return context.Canceled
+	}
+	return nil
+}
+
+// beginAttempt returns the context of the request's next attempt, or retry
+// delay, derived from ctx, and the function releasing it. The context is
+// canceled by Cancel, and after the timeout if it is not zero. The context of
+// the previous attempt is released.
+func (r *Request) beginAttempt(ctx Context, timeout time.Duration) (Context, func()) {
+	r.cancelMu.Lock()
+	defer r.cancelMu.Unlock()
+
+	if r.cancelAttempt != nil {
+		r.cancelAttempt()
+	}
+
+	var cancel func()
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	if r.canceled {
+		cancel()
+	}
+	r.cancelAttempt = cancel
+	return ctx, cancel
+}
+
+// endAttempt releases the context of the request's last attempt.
+func (r *Request) endAttempt() {
+	r.cancelMu.Lock()
+	defer r.cancelMu.Unlock()
+
+	if r.cancelAttempt != nil {
+		r.cancelAttempt()
+		r.cancelAttempt = nil
+	}
+}
+
+// beginOperationTimeout sets the context of the request to time out after the
+// Config's OperationTimeouts timeout of the request's operation, if any,
+// returning the function releasing the context. The contexts of requests
+// whose response body is streamed to the caller are not released, so the
+// timeout also applies to reading the body.
+func (r *Request) beginOperationTimeout() func() {
+	timeout, ok := r.Config.OperationTimeouts[r.Operation.Name]
+	if !ok || timeout <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	r.SetContext(ctx)
+	return func() {
+		if r.Error == nil && streamsResponseBody(r.Data) {
+			return
+		}
+		cancel()
+	}
+}
+
 // WillRetry returns if the request's can be retried.
 func (r *Request) WillRetry() bool {
 	return r.Error != nil && r.Retryable.Get() && r.RetryCount < r.Service.MaxRetries()
@@ -119,19 +289,104 @@ func (r *Request) SetStringBody(s string) {
 
 // SetReaderBody will set the request's body reader.
 func (r *Request) SetReaderBody(reader io.ReadSeeker) {
-	r.HTTPRequest.Body = ioutil.NopCloser(reader)
+	r.HTTPRequest.Body = &payloadBody{Reader: reader}
 	r.Body = reader
 }
 
+// A payloadBody is the HTTP body of a request which sends its Body as is. The
+// signer replaces it when it encodes the Body, e.g. with aws-chunked.
+type payloadBody struct {
+	io.Reader
+}
+
+// Close does nothing, the Body is not closed by sending the request.
+func (b *payloadBody) Close() error {
+	return nil
+}
+
+// MaxPresignExpireTime is the longest time a presigned request can be valid
+// for, 7 days.
+const MaxPresignExpireTime = 7 * 24 * time.Hour
+
+// PresignOptions customize how a request is presigned, see
+// PresignWithOptions.
+type PresignOptions struct {
+	// The names of the headers to sign as headers, instead of moving them
+	// into the URL's query string. The presigned request is only valid when
+	// sent with these headers, e.g. signing the Content-Type of a presigned
+	// S3 PutObject makes uploads with another content type fail.
+	//
+	// Headers whose names start with X-Amz- are always signed as headers.
+	SignedHeaders []string
+
+	// Set to add the X-Amz-Security-Token of temporary credentials to the
+	// query string after signing, instead of signing it. Some services, such
+	// as AWS IoT, require the session token to not be signed.
+	UnsignedSessionToken bool
+}
+
 // Presign returns the request's signed URL. Error will be returned
-// if the signing fails.
+// if the signing fails, or expireTime is not greater than 0 and at most
+// MaxPresignExpireTime.
+//
+// The request of any operation can be presigned. Query protocol requests are
+// presigned as GET requests with the parameters in the URL, so the URL alone
+// makes the request, as it does for REST operations which have no body, such
+// as S3's GetObject. Other requests must be sent with their method, body, and
+// signed headers, see PresignRequest.
 func (r *Request) Presign(expireTime time.Duration) (string, error) {
+	u, _, err := r.PresignWithOptions(expireTime, PresignOptions{})
+	return u, err
+}
+
+// PresignRequest behaves the same as Presign, but also returns the headers
+// which were signed, other than the Host, e.g. the X-Amz-Target of JSON
+// protocol requests or the X-Amz-Acl of an S3 PutObject. The presigned
+// request is only valid when sent with these headers.
+//
+// Example:
+//     req, _ := svc.PutObjectRequest(params)
+//     url, header, err := req.PresignRequest(15 * time.Minute)
+//     // Send a PUT to url with the headers of header.
+func (r *Request) PresignRequest(expireTime time.Duration) (string, http.Header, error) {
+	return r.PresignWithOptions(expireTime, PresignOptions{})
+}
+
+// PresignWithOptions behaves the same as PresignRequest, presigning the
+// request as customized by opts.
+//
+// Example:
+//     // A URL to upload a PNG image, valid for a week.
+//     req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+//         Bucket:      aws.String("bucket"),
+//         Key:         aws.String("image.png"),
+//         ContentType: aws.String("image/png"),
+//     })
+//     url, header, err := req.PresignWithOptions(aws.MaxPresignExpireTime,
+//         aws.PresignOptions{SignedHeaders: []string{"Content-Type"}})
+func (r *Request) PresignWithOptions(expireTime time.Duration, opts PresignOptions) (string, http.Header, error) {
+	if expireTime <= 0 || expireTime > MaxPresignExpireTime {
+		return "", nil, awserr.New("InvalidPresignExpireTime",
+			fmt.Sprintf("presign expire time must be greater than 0 and at most %s, got %s",
+				MaxPresignExpireTime, expireTime), nil)
+	}
+
 	r.ExpireTime = expireTime
+	r.Presigning = opts
 	r.Sign()
 	if r.Error != nil {
-		return "", r.Error
+		return "", nil, r.Error
+	}
+
+	header := http.Header{}
+	for _, k := range strings.Split(r.HTTPRequest.URL.Query().Get("X-Amz-SignedHeaders"), ";") {
+		if k == "" || k == "host" {
+			continue
+		}
+		k = http.CanonicalHeaderKey(k)
+		header[k] = append([]string{}, r.HTTPRequest.Header[k]...)
 	}
-	return r.HTTPRequest.URL.String(), nil
+	return r.HTTPRequest.URL.String(), header, nil
 }
 
 // Build will build the request's object so it can be signed and sent
@@ -175,9 +430,33 @@ func (r *Request) Sign() error {
 // Send will send the request returning error if errors are encountered.
 //
 // Send will sign the request prior to sending. All Send Handlers will
-// be executed in the order they were set.
+// be executed in the order they were set. The Complete Handlers are executed
+// once the request has finished, including when it fails.
 func (r *Request) Send() error {
-	for {
+	// The timeout is released after the Complete handlers, which drain the
+	// response body with the request's context.
+	defer r.beginOperationTimeout()()
+	defer r.Handlers.Complete.Run(r)
+	defer r.Service.csm.reportCall(r, time.Now())
+	defer r.endCallSpan()
+	defer r.logRequestError()
+	defer func() {
+		// The response body of a successful request may be streamed to the
+		// caller, its attempt is released when the body is closed instead.
+		if r.Error != nil {
+			r.endAttempt()
+		}
+	}()
+	r.startCallSpan()
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			// Sign retries with the current time, the original signature
+			// may be outside of the service's clock skew window by now. The
+			// signer uses the current credentials, e.g. if they were rotated.
+			r.Time = r.Service.signingTime()
+		}
+
 		r.Sign()
 		if r.Error != nil {
 			return r.Error
@@ -186,12 +465,18 @@ func (r *Request) Send() error {
 		if r.Retryable.Get() {
 			// Re-seek the body back to the original point in for a retry so that
 			// send will send the body's contents again in the upcoming request.
-			r.Body.Seek(r.bodyStart, 0)
+			if _, err := r.Body.Seek(r.bodyStart, 0); err != nil {
+				r.Error = awserr.New("SerializationError", "failed to seek request body for retry", err)
+				return r.Error
+			}
 		}
 		r.Retryable.Reset()
 
+		r.startAttemptSpan()
+		start := time.Now()
 		r.Handlers.Send.Run(r)
 		if r.Error != nil {
+			r.collectAttempt(start)
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -204,6 +489,7 @@ func (r *Request) Send() error {
 		r.Handlers.ValidateResponse.Run(r)
 		if r.Error != nil {
 			r.Handlers.UnmarshalError.Run(r)
+			r.collectAttempt(start)
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -214,6 +500,7 @@ func (r *Request) Send() error {
 
 		r.Handlers.Unmarshal.Run(r)
 		if r.Error != nil {
+			r.collectAttempt(start)
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -222,12 +509,23 @@ func (r *Request) Send() error {
 			continue
 		}
 
+		r.collectAttempt(start)
 		break
 	}
 
 	return nil
 }
 
+// logRequestError logs the error the request failed with if the
+// LogDebugWithRequestErrors log level is enabled.
+func (r *Request) logRequestError() {
+	if r.Error == nil || !r.Config.LogLevel.Matches(LogDebugWithRequestErrors) {
+		return
+	}
+	r.Config.Logger.Log(fmt.Sprintf("DEBUG: Request %s/%s failed, attempt %d/%d, error %v",
+		r.ServiceName, r.Operation.Name, r.RetryCount+1, r.MaxRetries()+1, r.Error))
+}
+
 // HasNextPage returns true if this request has more pages of data available.
 func (r *Request) HasNextPage() bool {
 	return r.nextPageTokens() != nil
@@ -271,7 +569,8 @@ func (r *Request) nextPageTokens() []interface{} {
 }
 
 // NextPage returns a new Request that can be executed to return the next
-// page of result data. Call .Send() on this request to execute it.
+// page of result data. Call .Send() on this request to execute it. The new
+// request has the context of r, and the options applied to r.
 func (r *Request) NextPage() *Request {
 	tokens := r.nextPageTokens()
 	if tokens == nil {
@@ -283,6 +582,10 @@ func (r *Request) NextPage() *Request {
 	for i, intok := range nr.Operation.InputTokens {
 		awsutil.SetValueAtAnyPath(nr.Params, intok, tokens[i])
 	}
+	if r.context != nil {
+		nr.SetContext(r.context)
+	}
+	nr.ApplyOptions(r.options...)
 	return nr
 }
 
@@ -299,14 +602,101 @@ func (r *Request) NextPage() *Request {
 // as the structure "T". The lastPage value represents whether the page is
 // the last page of data or not. The return value of this function should
 // return true to keep iterating or false to stop.
+//
+// Iterating stops without an error when fn returns false. If the request of a
+// page fails, or the request's context is done before a page is requested,
+// iterating stops and a PaginationError is returned, which has the number of
+// pages fn was called with, and the input to resume iterating from. fn is not
+// called with the pages whose request failed.
 func (r *Request) EachPage(fn func(data interface{}, isLastPage bool) (shouldContinue bool)) error {
+	pages := 0
 	for page := r; page != nil; page = page.NextPage() {
-		page.Send()
-		shouldContinue := fn(page.Data, !page.HasNextPage())
-		if page.Error != nil || !shouldContinue {
-			return page.Error
+		if err := page.Context().Err(); err != nil {
+			return newPaginationError(newCanceledError(err), pages, page.Params)
+		}
+		if err := page.Send(); err != nil {
+			return newPaginationError(err, pages, page.Params)
+		}
+
+		pages++
+		if !fn(page.Data, !page.HasNextPage()) {
+			return nil
 		}
 	}
 
 	return nil
 }
+
+// A PaginationError is the error of a paginated request whose iteration over
+// its pages stopped because the request of a page failed, or its context was
+// done. Its code and message are those of the page's error, and it is an
+// awserr.RequestFailure if the page's error is.
+//
+// Example:
+//     err := svc.ListTablesPagesWithContext(ctx, params, fn)
+//     if pageErr, ok := err.(aws.PaginationError); ok {
+//         log.Println("stopped after", pageErr.Pages(), "pages:", pageErr.Code())
+//         // Resume iterating from the page which failed.
+//         err = svc.ListTablesPages(pageErr.Params().(*dynamodb.ListTablesInput), fn)
+//     }
+type PaginationError interface {
+	awserr.Error
+
+	// Returns the number of pages which were iterated over before the
+	// iteration stopped.
+	Pages() int
+
+	// Returns the input of the page whose request failed, which has the
+	// pagination tokens of the page. Iterating over the pages of a request
+	// with the input resumes from the page.
+	Params() interface{}
+}
+
+// A paginationError wraps the error of the page a pagination stopped at.
+type paginationError struct {
+	awsError
+	pages  int
+	params interface{}
+}
+
+// A paginationRequestFailure is a paginationError whose page's error is an
+// awserr.RequestFailure.
+type paginationRequestFailure struct {
+	paginationError
+	failure awserr.RequestFailure
+}
+
+// newPaginationError returns the error of a pagination which stopped at the
+// page with the input params because of err, after iterating over pages
+// pages.
+func newPaginationError(err error, pages int, params interface{}) error {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		aerr = awserr.New("PaginationError", "failed to request page", err)
+	}
+	e := paginationError{awsError: aerr, pages: pages, params: params}
+	if failure, ok := err.(awserr.RequestFailure); ok {
+		return paginationRequestFailure{paginationError: e, failure: failure}
+	}
+	return e
+}
+
+// Pages returns the number of pages iterated over.
+func (e paginationError) Pages() int {
+	return e.pages
+}
+
+// Params returns the input of the page whose request failed.
+func (e paginationError) Params() interface{} {
+	return e.params
+}
+
+// StatusCode returns the status code of the page's response.
+func (e paginationRequestFailure) StatusCode() int {
+	return e.failure.StatusCode()
+}
+
+// RequestID returns the request ID of the page's response.
+func (e paginationRequestFailure) RequestID() string {
+	return e.failure.RequestID()
+}
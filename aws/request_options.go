@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// An Option is a functional option that modifies a Request before it is sent.
+// Options can be passed to the WithContext variants of service client
+// operations to customize an individual API call.
+type Option func(*Request)
+
+// ApplyOptions will apply each option to the request, in the order provided.
+// The options are also applied to the requests of the request's next pages.
+func (r *Request) ApplyOptions(opts ...Option) {
+	r.options = append(r.options, opts...)
+	for _, opt := range opts {
+		opt(r)
+	}
+}
+
+// WithConfig returns an Option which merges cfg on top of the service
+// client's Config for a single request. The client's Config is not modified,
+// so other requests made by the client are not affected.
+//
+// The region, endpoint, signing name and region, credentials, HTTP client and
+// trace, logging, parameter validation, and retry delays can all be overridden
+// for the request. A MaxRetries of zero is treated as unset, so a Config
+// literal does not disable retries for the request. Fields can be reset to
+// their defaults for the request with Config.WithReset.
+//
+// The RetryMode and MaxSendRate cannot be overridden for a single request,
+// the client's retry mode and rate limiters are always used. Service specific
+// customizations made when the client was created are also not re-run for the
+// request's Config.
+//
+// Example:
+//     out, err := svc.ListBucketsWithContext(aws.BackgroundContext(), params,
+//         aws.WithConfig(aws.NewConfig().WithRegion("eu-west-1")))
+func WithConfig(cfg *Config) Option {
+	return func(r *Request) {
+		if cfg == nil {
+			return
+		}
+
+		svc := *r.Service
+		svc.Config = r.Service.Config.Merge(cfg)
+		if cfg.MaxRetries == 0 && !cfg.resetsField("MaxRetries") {
+			svc.Config.MaxRetries = r.Service.Config.MaxRetries
+		}
+		svc.Config.RetryMode = r.Service.Config.RetryMode
+
+		if cfg.Region != "" || cfg.Endpoint != "" || cfg.EndpointResolver != nil || cfg.DisableSSL ||
+			cfg.UseDualStack || cfg.UseFIPSEndpoint || cfg.STSRegionalEndpoint != "" || len(cfg.resets) > 0 ||
+			cfg.SigningName != "" || cfg.SigningRegion != "" {
+			svc.SigningRegion = ""
+			svc.buildEndpoint()
+
+			p := r.Operation.HTTPPath
+			if p == "" {
+				p = "/"
+			}
+			r.HTTPRequest.URL, _ = url.Parse(svc.Endpoint + p)
+		}
+
+		if cfg.LogLevel != 0 || cfg.Logger != nil {
+			r.Handlers.Send.RemoveByName(LogRequestHandlerName)
+			r.Handlers.Send.RemoveByName(LogResponseHandlerName)
+			svc.addDebugHandlers(&r.Handlers)
+		}
+		if cfg.DisableParamValidation {
+			r.Handlers.Validate.RemoveByName(ValidateParametersHandlerName)
+		}
+		r.Service = &svc
+	}
+}
+
+// WithGetResponseHeader returns an Option which sets val to the value of the
+// header of the request's last HTTP response, e.g. the request ID or, for S3,
+// the "X-Amz-Id-2" host ID, when the request completes. val is not modified if
+// no response was received.
+//
+// Example:
+//     var hostID string
+//     out, err := svc.GetObjectWithContext(ctx, params,
+//         aws.WithGetResponseHeader("X-Amz-Id-2", &hostID))
+func WithGetResponseHeader(key string, val *string) Option {
+	return func(r *Request) {
+		r.Handlers.Complete.PushBack(func(r *Request) {
+			if r.HTTPResponse != nil {
+				*val = r.HTTPResponse.Header.Get(key)
+			}
+		})
+	}
+}
+
+// WithGetResponseHeaders returns an Option which sets headers to the headers
+// of the request's last HTTP response when the request completes. headers is
+// not modified if no response was received.
+func WithGetResponseHeaders(headers *http.Header) Option {
+	return func(r *Request) {
+		r.Handlers.Complete.PushBack(func(r *Request) {
+			if r.HTTPResponse != nil {
+				*headers = r.HTTPResponse.Header
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestApplyOptions(t *testing.T) {
+	s := NewService(&Config{})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+
+	order := ""
+	r.ApplyOptions(
+		func(r *Request) { order += "a" },
+		func(r *Request) { order += "b" },
+	)
+	assert.Equal(t, "ab", order)
+}
+
+func TestWithConfigOverridesRequestConfig(t *testing.T) {
+	s := &Service{ServiceName: "mock", Config: &Config{Region: "us-west-2", MaxRetries: 1}}
+	s.Initialize()
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPPath: "/path"}, nil, nil)
+	assert.Equal(t, "https://mock.us-west-2.amazonaws.com/path", r.HTTPRequest.URL.String())
+
+	r.ApplyOptions(WithConfig(&Config{Region: "eu-west-1", MaxRetries: 5}))
+	assert.Equal(t, "https://mock.eu-west-1.amazonaws.com/path", r.HTTPRequest.URL.String())
+	assert.Equal(t, "eu-west-1", r.Config.Region)
+	assert.Equal(t, uint(5), r.MaxRetries())
+
+	// The client's service is not modified.
+	assert.Equal(t, "us-west-2", s.Config.Region)
+	assert.Equal(t, "https://mock.us-west-2.amazonaws.com", s.Endpoint)
+	assert.Equal(t, uint(1), s.MaxRetries())
+
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{Endpoint: "localhost:8080", DisableSSL: true}))
+	assert.Equal(t, "http://localhost:8080/", r.HTTPRequest.URL.String())
+
+	r.ApplyOptions(WithConfig(nil))
+	assert.Equal(t, "http://localhost:8080/", r.HTTPRequest.URL.String())
+}
+
+func TestWithConfigRetries(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	s := NewService(&Config{MaxRetries: 0})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{MaxRetries: 2}))
+	assert.Error(t, r.Send())
+	assert.Equal(t, 2, int(r.RetryCount))
+}
+
+func TestWithConfigEndpointResolver(t *testing.T) {
+	s := &Service{ServiceName: "mock", Config: &Config{Region: "us-west-2"}}
+	s.Initialize()
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPPath: "/path"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{
+		EndpointResolver: EndpointResolverFunc(func(service, region string) (string, string, error) {
+			return "vpce." + service + ".example.com", region, nil
+		}),
+	}))
+	assert.Equal(t, "https://vpce.mock.example.com/path", r.HTTPRequest.URL.String())
+	assert.Equal(t, "https://mock.us-west-2.amazonaws.com", s.Endpoint)
+}
+
+func TestWithConfigSigningScope(t *testing.T) {
+	s := &Service{ServiceName: "mock", Config: &Config{Region: "us-west-2"}}
+	s.Initialize()
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPPath: "/path"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{SigningName: "execute-api", SigningRegion: "us-east-1"}))
+	assert.Equal(t, "execute-api", r.Service.SigningName)
+	assert.Equal(t, "us-east-1", r.Service.SigningRegion)
+	assert.Equal(t, "us-west-2", s.SigningRegion)
+	assert.Equal(t, "", s.SigningName)
+}
+
+func TestWithConfigLiteralKeepsRetries(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	s := NewService(&Config{MaxRetries: 2})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{RetryBaseDelay: time.Millisecond}))
+	assert.Error(t, r.Send())
+	assert.Equal(t, 2, int(r.RetryCount))
+	assert.Equal(t, time.Millisecond, r.RetryBaseDelay())
+}
+
+func TestWithConfigRetryModeNotOverridden(t *testing.T) {
+	s := NewService(&Config{})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{RetryMode: RetryModeAdaptive}))
+	assert.Equal(t, "", r.Config.RetryMode)
+	assert.Nil(t, r.Service.rateLimiter)
+
+	s = NewService(&Config{RetryMode: RetryModeAdaptive})
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{RetryMode: RetryModeStandard}))
+	assert.Equal(t, RetryModeAdaptive, r.Config.RetryMode)
+	assert.True(t, r.Service.rateLimiter == s.rateLimiter)
+}
+
+func TestWithConfigLogLevel(t *testing.T) {
+	msgs := []string{}
+	logger := LoggerFunc(func(args ...interface{}) {
+		for _, a := range args {
+			msgs = append(msgs, a.(string))
+		}
+	})
+
+	s := NewService(&Config{Endpoint: "https://localhost"})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body("")}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ApplyOptions(WithConfig(&Config{Logger: logger, LogLevel: LogDebug}))
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 2, len(msgs))
+	assert.Contains(t, msgs[0], "DEBUG: Request")
+	assert.Contains(t, msgs[1], "DEBUG: Response")
+
+	// The client's handlers do not log.
+	msgs = msgs[:0]
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 0, len(msgs))
+}
+
+func TestWithConfigDisableParamValidation(t *testing.T) {
+	s := NewService(&Config{})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	n := r.Handlers.Validate.Len()
+
+	r.ApplyOptions(WithConfig(&Config{DisableParamValidation: true}))
+	assert.Equal(t, n-1, r.Handlers.Validate.Len())
+	assert.Equal(t, n, s.Handlers.Validate.Len())
+}
+
+func TestWithConfigReset(t *testing.T) {
+	s := &Service{ServiceName: "mock", Config: &Config{Region: "us-west-2", Endpoint: "localhost:8080", MaxRetries: 1}}
+	s.Initialize()
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPPath: "/path"}, nil, nil)
+	r.ApplyOptions(WithConfig(NewConfig().WithReset("Endpoint", "MaxRetries")))
+	assert.Equal(t, "https://mock.us-west-2.amazonaws.com/path", r.HTTPRequest.URL.String())
+	assert.Equal(t, uint(3), r.MaxRetries())
+	assert.Equal(t, "https://localhost:8080", s.Endpoint)
+}
+
+func TestWithGetResponseHeader(t *testing.T) {
+	s := NewService(&Config{Region: "us-west-2"})
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{"X-Amzn-Requestid": []string{"request-id"}}}
+	})
+
+	var reqID string
+	var headers http.Header
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ApplyOptions(WithGetResponseHeader("X-Amzn-Requestid", &reqID), WithGetResponseHeaders(&headers))
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "request-id", reqID)
+	assert.Equal(t, "request-id", headers.Get("X-Amzn-Requestid"))
+}
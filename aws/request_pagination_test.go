@@ -1,9 +1,11 @@
 package aws_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/internal/test/unit"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -158,6 +160,144 @@ func TestPaginationEarlyExit(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+// Use DynamoDB methods for simplicity
+func TestPaginationWithContext(t *testing.T) {
+	db := dynamodb.New(nil)
+
+	reqNum := 0
+	resps := []*dynamodb.ListTablesOutput{
+		{TableNames: []*string{aws.String("Table1"), aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+		{TableNames: []*string{aws.String("Table3")}},
+	}
+
+	db.Handlers.Send.Clear() // mock sending
+	db.Handlers.Unmarshal.Clear()
+	db.Handlers.UnmarshalMeta.Clear()
+	db.Handlers.ValidateResponse.Clear()
+	db.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+	ctxValues, optioned := []interface{}{}, 0
+	opt := func(r *aws.Request) {
+		optioned++
+		r.Handlers.Build.PushBack(func(r *aws.Request) {
+			ctxValues = append(ctxValues, r.Context().Value(ctxKey{}))
+		})
+	}
+
+	numPages := 0
+	err := db.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{Limit: aws.Long(2)},
+		func(p *dynamodb.ListTablesOutput, last bool) bool {
+			numPages++
+			return true
+		}, opt)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numPages)
+	assert.Equal(t, 2, optioned, "expect the options to be applied to each page")
+	assert.Equal(t, []interface{}{"value", "value"}, ctxValues, "expect each page to be sent with the context")
+}
+
+// Use DynamoDB methods for simplicity
+func TestPaginationContextCanceled(t *testing.T) {
+	db := dynamodb.New(nil)
+
+	reqNum := 0
+	resps := []*dynamodb.ListTablesOutput{
+		{TableNames: []*string{aws.String("Table1"), aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+		{TableNames: []*string{aws.String("Table3"), aws.String("Table4")}, LastEvaluatedTableName: aws.String("Table4")},
+		{TableNames: []*string{aws.String("Table5")}},
+	}
+
+	db.Handlers.Send.Clear() // mock sending
+	db.Handlers.Unmarshal.Clear()
+	db.Handlers.UnmarshalMeta.Clear()
+	db.Handlers.ValidateResponse.Clear()
+	db.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	numPages := 0
+	err := db.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{Limit: aws.Long(2)},
+		func(p *dynamodb.ListTablesOutput, last bool) bool {
+			numPages++
+			cancel()
+			return true
+		})
+
+	assert.Equal(t, 1, numPages)
+	assert.Equal(t, 1, reqNum, "expect the next page not to be requested")
+	pageErr, ok := err.(aws.PaginationError)
+	if assert.True(t, ok, "expect a PaginationError, got %T", err) {
+		assert.Equal(t, aws.ErrCodeRequestCanceled, pageErr.Code())
+		assert.Equal(t, context.Canceled, pageErr.OrigErr())
+		assert.Equal(t, 1, pageErr.Pages())
+		assert.Equal(t, "Table2", *pageErr.Params().(*dynamodb.ListTablesInput).ExclusiveStartTableName)
+	}
+}
+
+// Use DynamoDB methods for simplicity
+func TestPaginationPageFailed(t *testing.T) {
+	db := dynamodb.New(nil)
+
+	reqNum, failed := 0, false
+	resps := []*dynamodb.ListTablesOutput{
+		{TableNames: []*string{aws.String("Table1"), aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+		{TableNames: []*string{aws.String("Table3")}},
+	}
+
+	db.Handlers.Send.Clear() // mock sending
+	db.Handlers.Send.PushBack(func(r *aws.Request) {
+		if in := r.Params.(*dynamodb.ListTablesInput); in.ExclusiveStartTableName != nil && !failed {
+			failed = true
+			r.Error = awserr.NewRequestFailure(awserr.New("ServiceUnavailable", "unavailable", nil), 503, "request-id")
+		}
+	})
+	db.Handlers.Retry.Clear()
+	db.Handlers.AfterRetry.Clear()
+	db.Handlers.Unmarshal.Clear()
+	db.Handlers.UnmarshalMeta.Clear()
+	db.Handlers.ValidateResponse.Clear()
+	db.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	pages := []string{}
+	fn := func(p *dynamodb.ListTablesOutput, last bool) bool {
+		for _, t := range p.TableNames {
+			pages = append(pages, *t)
+		}
+		return true
+	}
+	err := db.ListTablesPages(&dynamodb.ListTablesInput{Limit: aws.Long(2)}, fn)
+	assert.Equal(t, []string{"Table1", "Table2"}, pages, "expect fn not to be called with the failed page")
+
+	pageErr, ok := err.(aws.PaginationError)
+	if assert.True(t, ok, "expect a PaginationError, got %T", err) {
+		assert.Equal(t, "ServiceUnavailable", pageErr.Code())
+		assert.Equal(t, 1, pageErr.Pages())
+	}
+	reqErr, ok := err.(awserr.RequestFailure)
+	if assert.True(t, ok, "expect a RequestFailure") {
+		assert.Equal(t, 503, reqErr.StatusCode())
+		assert.Equal(t, "request-id", reqErr.RequestID())
+	}
+
+	// Resume from the failed page.
+	err = db.ListTablesPages(pageErr.Params().(*dynamodb.ListTablesInput), fn)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Table1", "Table2", "Table3"}, pages)
+}
+
 func TestSkipPagination(t *testing.T) {
 	client := s3.New(nil)
 	client.Handlers.Send.Clear() // mock sending
@@ -303,3 +443,201 @@ func BenchmarkEachPageIterator(b *testing.B) {
 		})
 	}
 }
+
+// newIteratorTestClient returns a DynamoDB client whose ListTables requests
+// receive the pages, and whose request of the failPage page fails.
+func newIteratorTestClient(resps []*dynamodb.ListTablesOutput, failPage int) (*dynamodb.DynamoDB, *int) {
+	db := dynamodb.New(nil)
+	reqNum := 0
+
+	db.Handlers.Send.Clear() // mock sending
+	db.Handlers.Send.PushBack(func(r *aws.Request) {
+		if reqNum == failPage {
+			r.Error = awserr.New("ServiceUnavailable", "unavailable", nil)
+		}
+	})
+	db.Handlers.Retry.Clear()
+	db.Handlers.AfterRetry.Clear()
+	db.Handlers.Unmarshal.Clear()
+	db.Handlers.UnmarshalMeta.Clear()
+	db.Handlers.ValidateResponse.Clear()
+	db.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+	return db, &reqNum
+}
+
+var iteratorTestPages = []*dynamodb.ListTablesOutput{
+	{TableNames: []*string{aws.String("Table1"), aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+	{TableNames: []*string{aws.String("Table3"), aws.String("Table4")}, LastEvaluatedTableName: aws.String("Table4")},
+	{TableNames: []*string{aws.String("Table5")}},
+}
+
+func TestIterator(t *testing.T) {
+	db, _ := newIteratorTestClient(iteratorTestPages, -1)
+
+	req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{Limit: aws.Long(2)})
+	it := aws.NewIterator(req)
+	defer it.Close()
+
+	tables := []string{}
+	for v := range it.C {
+		tables = append(tables, *v.(*string))
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"Table1", "Table2", "Table3", "Table4", "Table5"}, tables)
+}
+
+func TestIteratorPageFailed(t *testing.T) {
+	db, _ := newIteratorTestClient(iteratorTestPages, 1)
+
+	req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{Limit: aws.Long(2)})
+	it := aws.NewIterator(req)
+	defer it.Close()
+
+	tables := []string{}
+	for v := range it.C {
+		tables = append(tables, *v.(*string))
+	}
+	assert.Equal(t, []string{"Table1", "Table2"}, tables)
+	pageErr, ok := it.Err().(aws.PaginationError)
+	if assert.True(t, ok, "expect a PaginationError, got %T", it.Err()) {
+		assert.Equal(t, "ServiceUnavailable", pageErr.Code())
+		assert.Equal(t, 1, pageErr.Pages())
+	}
+}
+
+func TestIteratorClose(t *testing.T) {
+	db, reqNum := newIteratorTestClient(iteratorTestPages, -1)
+
+	req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{Limit: aws.Long(2)})
+	it := aws.NewIterator(req)
+
+	v := <-it.C
+	assert.Equal(t, "Table1", *v.(*string))
+	it.Close()
+	it.Close()
+
+	for range it.C {
+		assert.Fail(t, "expect no results after Close")
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, 1, *reqNum, "expect the next page not to be requested")
+}
+
+func TestIteratorContextCanceled(t *testing.T) {
+	db, _ := newIteratorTestClient(iteratorTestPages, -1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{Limit: aws.Long(2)})
+	req.SetContext(ctx)
+	it := aws.NewIterator(req)
+	defer it.Close()
+
+	<-it.C
+	cancel()
+	for range it.C {
+	}
+	if assert.Error(t, it.Err()) {
+		assert.Equal(t, aws.ErrCodeRequestCanceled, it.Err().(awserr.Error).Code())
+	}
+}
+
+func TestIteratorWithoutResultKeys(t *testing.T) {
+	client := s3.New(nil)
+	client.Handlers.Send.Clear() // mock sending
+	client.Handlers.Unmarshal.Clear()
+	client.Handlers.UnmarshalMeta.Clear()
+	client.Handlers.ValidateResponse.Clear()
+	client.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		r.Data = &s3.HeadBucketOutput{}
+	})
+
+	req, _ := client.HeadBucketRequest(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	it := aws.NewIterator(req)
+	defer it.Close()
+
+	pages := []interface{}{}
+	for v := range it.C {
+		pages = append(pages, v)
+	}
+	assert.Nil(t, it.Err())
+	if assert.Len(t, pages, 1) {
+		assert.IsType(t, &s3.HeadBucketOutput{}, pages[0])
+	}
+}
+
+func TestResumeToken(t *testing.T) {
+	db, _ := newIteratorTestClient(iteratorTestPages, -1)
+
+	req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{Limit: aws.Long(2)})
+	assert.NoError(t, req.Send())
+	token, err := req.ResumeToken()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+
+	// Resume with a new client, e.g. in another process.
+	db, _ = newIteratorTestClient(iteratorTestPages[1:], -1)
+	params := &dynamodb.ListTablesInput{Limit: aws.Long(2)}
+	req, _ = db.ListTablesRequest(params)
+	assert.NoError(t, req.ResumeFrom(token))
+	assert.Equal(t, "Table2", aws.StringValue(params.ExclusiveStartTableName))
+
+	tables := []string{}
+	err = req.EachPage(func(p interface{}, last bool) bool {
+		for _, t := range p.(*dynamodb.ListTablesOutput).TableNames {
+			tables = append(tables, *t)
+		}
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Table3", "Table4", "Table5"}, tables)
+
+	// The last page has no next page to resume from.
+	req, _ = db.ListTablesRequest(&dynamodb.ListTablesInput{})
+	req.Data = iteratorTestPages[2]
+	token, err = req.ResumeToken()
+	assert.NoError(t, err)
+	assert.Empty(t, token)
+	assert.NoError(t, req.ResumeFrom(""))
+}
+
+func TestResumeTokenStructuredTokens(t *testing.T) {
+	db := dynamodb.New(nil)
+	key := map[string]*dynamodb.AttributeValue{
+		"id":   {S: aws.String("123")},
+		"data": {B: []byte("bin")},
+	}
+
+	req, _ := db.ScanRequest(&dynamodb.ScanInput{TableName: aws.String("table")})
+	req.Data = &dynamodb.ScanOutput{LastEvaluatedKey: key}
+	token, err := req.ResumeToken()
+	assert.NoError(t, err)
+
+	params := &dynamodb.ScanInput{TableName: aws.String("table")}
+	req, _ = db.ScanRequest(params)
+	assert.NoError(t, req.ResumeFrom(token))
+	assert.Equal(t, key, params.ExclusiveStartKey)
+}
+
+func TestResumeTokenInvalid(t *testing.T) {
+	db := dynamodb.New(nil)
+	req, _ := db.ScanRequest(&dynamodb.ScanInput{})
+	req.Data = &dynamodb.ScanOutput{LastEvaluatedKey: map[string]*dynamodb.AttributeValue{"id": {S: aws.String("123")}}}
+	scanToken, _ := req.ResumeToken()
+
+	for _, token := range []string{"not a token", "bm90IGpzb24", scanToken} {
+		req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{})
+		err := req.ResumeFrom(token)
+		if assert.Error(t, err, token) {
+			assert.Equal(t, aws.ErrCodeInvalidResumeToken, err.(awserr.Error).Code())
+		}
+	}
+
+	req, _ = db.DescribeTableRequest(&dynamodb.DescribeTableInput{})
+	err := req.ResumeFrom(scanToken)
+	if assert.Error(t, err) {
+		assert.Equal(t, aws.ErrCodeInvalidResumeToken, err.(awserr.Error).Code())
+	}
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -89,6 +90,76 @@ func TestRequestRecoverRetry5xx(t *testing.T) {
 	assert.Equal(t, "valid", out.Data)
 }
 
+// test that retries are signed again with the current time
+func TestRequestRetrySignedWithCurrentTime(t *testing.T) {
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: 10, RetryBaseDelay: time.Millisecond})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	var signTimes []time.Time
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		signTimes = append(signTimes, r.Time)
+	})
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Len(t, signTimes, 2)
+	assert.True(t, signTimes[1].After(signTimes[0]), "Expect the retry to be signed with a later time")
+}
+
+// test that retries send the body of a reader which is not an io.Seeker again
+func TestRequestRetryReaderBody(t *testing.T) {
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: 10, RetryBaseDelay: time.Millisecond})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	var bodies []string
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		b, _ := ioutil.ReadAll(r.HTTPRequest.Body)
+		bodies = append(bodies, string(b))
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "PUT"}, nil, &testData{})
+	r.SetReaderBody(ReadSeekCloser(readerOnly{bytes.NewReader([]byte("request body"))}))
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), r.HTTPRequest.ContentLength)
+	assert.Equal(t, []string{"request body", "request body"}, bodies)
+}
+
+// test that a reader body too large to buffer fails the request
+func TestRequestReaderBodyBufferExceeded(t *testing.T) {
+	s := NewService(&Config{})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "PUT"}, nil, &testData{})
+	data := bytes.Repeat([]byte{'a'}, MaxReadSeekCloserBuffer+1)
+	r.SetReaderBody(ReadSeekCloser(readerOnly{bytes.NewReader(data)}))
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "SerializationError", err.(awserr.Error).Code())
+	assert.Equal(t, errBodyBufferExceeded, err.(awserr.Error).OrigErr())
+}
+
 // test that retries occur for 4xx status codes with a response type that can be retried - see `shouldRetry`
 func TestRequestRecoverRetry4xxRetryable(t *testing.T) {
 	reqNum := 0
@@ -141,9 +212,13 @@ func TestRequest4xxUnretryable(t *testing.T) {
 
 func TestRequestExhaustRetries(t *testing.T) {
 	delays := []time.Duration{}
-	sleepDelay = func(delay time.Duration) {
+	sleepDelay = func(ctx Context, delay time.Duration) error {
 		delays = append(delays, delay)
+		return nil
 	}
+	origRandomDelay := randomDelay
+	defer func() { randomDelay = origRandomDelay }()
+	randomDelay = func(ceil time.Duration) time.Duration { return ceil }
 
 	reqNum := 0
 	reqs := []http.Response{
@@ -217,3 +292,348 @@ func TestRequestRecoverExpiredCreds(t *testing.T) {
 	assert.Equal(t, 1, int(r.RetryCount))
 	assert.Equal(t, "valid", out.Data)
 }
+
+func TestRetryRulesFullJitter(t *testing.T) {
+	s := NewService(&Config{RetryBaseDelay: 100 * time.Millisecond, RetryMaxDelay: time.Second})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+
+	for i, ceil := range []time.Duration{
+		100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second,
+	} {
+		r.RetryCount = uint(i)
+		for j := 0; j < 50; j++ {
+			d := retryRules(r)
+			assert.True(t, d >= 0 && d <= ceil, "expect %v in [0, %v]", d, ceil)
+		}
+	}
+
+	r.RetryCount = 100
+	assert.True(t, retryRules(r) <= time.Second)
+}
+
+func TestRetryRulesDefaults(t *testing.T) {
+	s := NewService(&Config{})
+	assert.Equal(t, 30*time.Millisecond, s.RetryBaseDelay())
+	assert.Equal(t, DefaultRetryMaxDelay, s.RetryMaxDelay())
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	cases := []struct {
+		status int
+		header string
+		delay  time.Duration
+		ok     bool
+	}{
+		{429, "3", 3 * time.Second, true},
+		{503, "0", 0, true},
+		{503, "", 0, false},
+		{503, "-1", 0, false},
+		{503, "soon", 0, false},
+		{500, "3", 0, false},
+		{429, "3600", 20 * time.Second, true},
+		{429, "99999999999999999", 20 * time.Second, true},
+		{429, time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, false},
+	}
+
+	s := NewService(&Config{RetryMaxDelay: 20 * time.Second})
+	for i, c := range cases {
+		r := &Request{Service: s, HTTPResponse: &http.Response{StatusCode: c.status, Header: http.Header{}}}
+		if c.header != "" {
+			r.HTTPResponse.Header.Set("Retry-After", c.header)
+		}
+		delay, ok := retryAfterDelay(r)
+		assert.Equal(t, c.ok, ok, "case %d", i)
+		assert.Equal(t, c.delay, delay, "case %d", i)
+	}
+
+	// Dates are capped at the maximum retry delay.
+	r := &Request{Service: s, HTTPResponse: &http.Response{StatusCode: 503, Header: http.Header{}}}
+	r.HTTPResponse.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	delay, ok := retryAfterDelay(r)
+	assert.True(t, ok)
+	assert.Equal(t, 20*time.Second, delay)
+
+	r.HTTPResponse.Header.Set("Retry-After", time.Now().Add(10*time.Second).UTC().Format(http.TimeFormat))
+	delay, ok = retryAfterDelay(r)
+	assert.True(t, ok)
+	assert.True(t, delay > 8*time.Second && delay <= 10*time.Second, "unexpected delay %v", delay)
+}
+
+func TestRequestRetryAfterHeader(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(ctx Context, delay time.Duration) error {
+		delays = append(delays, delay)
+		return nil
+	}
+
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 503, Header: http.Header{"Retry-After": []string{"2"}}, Body: body(`{"__type":"ServiceUnavailable","message":"Slow down."}`)},
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: 10, RetryBaseDelay: time.Millisecond, RetryMaxDelay: 5 * time.Second})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, int(r.RetryCount))
+	assert.Equal(t, 2, len(delays))
+	assert.Equal(t, 2*time.Second, delays[0])
+	assert.True(t, delays[1] <= 2*time.Millisecond)
+}
+
+func TestRequestRetryAfterHeaderCapped(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(ctx Context, delay time.Duration) error {
+		delays = append(delays, delay)
+		return nil
+	}
+
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 429, Header: http.Header{"Retry-After": []string{"3600"}}, Body: body(`{"__type":"Throttling","message":"Slow down."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: 10, RetryBaseDelay: time.Millisecond, RetryMaxDelay: 100 * time.Millisecond})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+	assert.Equal(t, []time.Duration{100 * time.Millisecond}, delays)
+}
+
+type hangTransport struct {
+	hangs    int
+	attempts int
+}
+
+// RoundTrip blocks the first hangs attempts until their context is done.
+func (t *hangTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.attempts++
+	if t.attempts <= t.hangs {
+		<-r.Context().Done()
+		return nil, r.Context().Err()
+	}
+	return &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}, nil
+}
+
+func TestRequestAttemptTimeoutRetried(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	tr := &hangTransport{hangs: 1}
+	s := NewService(&Config{
+		Region:         "mock-region",
+		Endpoint:       "https://localhost",
+		MaxRetries:     2,
+		AttemptTimeout: 10 * time.Millisecond,
+		HTTPClient:     &http.Client{Transport: tr},
+	})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 2, tr.attempts)
+	assert.Equal(t, 1, int(r.RetryCount))
+	assert.Equal(t, "valid", out.Data)
+}
+
+func TestRequestAttemptTimeoutExhaustsRetries(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	tr := &hangTransport{hangs: 10}
+	s := NewService(&Config{
+		Region:         "mock-region",
+		Endpoint:       "https://localhost",
+		MaxRetries:     2,
+		AttemptTimeout: 10 * time.Millisecond,
+		HTTPClient:     &http.Client{Transport: tr},
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestAttemptTimeout, err.(awserr.Error).Code())
+	assert.Equal(t, 3, tr.attempts)
+	assert.Equal(t, 2, int(r.RetryCount))
+}
+
+type contextBody struct {
+	io.Reader
+	ctx Context
+}
+
+// Read fails once the context of the body's attempt is done, like the body
+// of a response read from the connection.
+func (b *contextBody) Read(p []byte) (int, error) {
+	if err := b.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return b.Reader.Read(p)
+}
+
+func (b *contextBody) Close() error { return nil }
+
+type streamTransport struct {
+	ctx Context
+}
+
+func (t *streamTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.ctx = r.Context()
+	return &http.Response{
+		StatusCode: 200,
+		Body:       &contextBody{Reader: bytes.NewReader([]byte("streamed")), ctx: r.Context()},
+	}, nil
+}
+
+func TestRequestAttemptTimeoutStreamedBody(t *testing.T) {
+	tr := &streamTransport{}
+	s := NewService(&Config{
+		Region:         "mock-region",
+		Endpoint:       "https://localhost",
+		AttemptTimeout: time.Minute,
+		HTTPClient:     &http.Client{Transport: tr},
+	})
+
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		r.Data.(*streamingOutput).Body = r.HTTPResponse.Body
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &streamingOutput{})
+	assert.NoError(t, r.Send())
+
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.NoError(t, err, "Expect the body to be readable after Send returns")
+	assert.Equal(t, "streamed", string(b))
+
+	assert.NoError(t, tr.ctx.Err())
+	r.HTTPResponse.Body.Close()
+	assert.Error(t, tr.ctx.Err(), "Expect closing the body to release the attempt")
+}
+
+func TestRequestOperationTimeout(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	tr := &hangTransport{hangs: 10}
+	s := NewService(&Config{
+		Region:            "mock-region",
+		Endpoint:          "https://localhost",
+		MaxRetries:        2,
+		OperationTimeouts: map[string]time.Duration{"Slow": 20 * time.Millisecond},
+		HTTPClient:        &http.Client{Transport: tr},
+	})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+
+	r := NewRequest(s, &Operation{Name: "Slow"}, nil, nil)
+	err := r.Send()
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+		assert.Equal(t, context.DeadlineExceeded, err.(awserr.Error).OrigErr())
+	}
+	assert.Equal(t, 0, int(r.RetryCount), "Expect the timed out call not to be retried")
+
+	// Other operations have no timeout.
+	tr.hangs = 0
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+	_, ok := r.Context().Deadline()
+	assert.False(t, ok)
+}
+
+func TestRequestOperationTimeoutStreamedBody(t *testing.T) {
+	tr := &streamTransport{}
+	s := NewService(&Config{
+		Region:            "mock-region",
+		Endpoint:          "https://localhost",
+		OperationTimeouts: map[string]time.Duration{"Operation": time.Minute},
+		HTTPClient:        &http.Client{Transport: tr},
+	})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		r.Data.(*streamingOutput).Body = r.HTTPResponse.Body
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &streamingOutput{})
+	assert.NoError(t, r.Send())
+
+	_, ok := tr.ctx.Deadline()
+	assert.True(t, ok, "Expect the attempt to have the operation's deadline")
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.NoError(t, err, "Expect the body to be readable after Send returns")
+	assert.Equal(t, "streamed", string(b))
+}
+
+func TestRequestCancel(t *testing.T) {
+	tr := &hangTransport{hangs: 1}
+	s := NewService(&Config{
+		Region:     "mock-region",
+		Endpoint:   "https://localhost",
+		MaxRetries: 2,
+		HTTPClient: &http.Client{Transport: tr},
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	time.AfterFunc(10*time.Millisecond, r.Cancel)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, 1, tr.attempts)
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func TestRequestCancelBeforeSend(t *testing.T) {
+	tr := &hangTransport{}
+	s := NewService(&Config{
+		Region:     "mock-region",
+		Endpoint:   "https://localhost",
+		HTTPClient: &http.Client{Transport: tr},
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.Cancel()
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, 0, tr.attempts)
+}
+
+func TestRequestCancelRetryDelay(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return SleepWithContext(ctx, delay) }
+	defer func() {
+		sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+	}()
+
+	s := NewService(&Config{MaxRetries: 2, RetryBaseDelay: time.Minute})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	time.AfterFunc(10*time.Millisecond, r.Cancel)
+	start := time.Now()
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.True(t, time.Since(start) < time.Minute)
+	assert.Equal(t, 0, int(r.RetryCount))
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+)
+
+// ErrCodeInvalidResumeToken is the awserr.Error code for resume tokens which
+// are malformed, or are not tokens of the request's operation.
+const ErrCodeInvalidResumeToken = "InvalidResumeToken"
+
+// A resumeToken is the decoded form of a resume token, the values of the
+// input tokens of the operation's next page.
+type resumeToken struct {
+	Operation string
+	Tokens    []json.RawMessage
+}
+
+// ResumeToken returns an opaque token of the page after the request's page,
+// which can be saved, e.g. to checkpoint a long listing, and passed to
+// ResumeFrom to continue iterating from the page later, in another process.
+// ResumeToken must be called once the request was sent. Returns an empty
+// token if the request's page is the last page.
+//
+// The token holds the pagination tokens of the page, so it does not outlive
+// them, e.g. a token of a service whose pagination tokens expire expires too.
+//
+// Example:
+//     req, _ := svc.ListObjectsRequest(params)
+//     if err := req.ResumeFrom(checkpoint); err != nil {
+//         return err
+//     }
+//     for page := req; page != nil; page = page.NextPage() {
+//         if err := page.Send(); err != nil {
+//             return err
+//         }
+//         // process the page's objects
+//         if checkpoint, err = page.ResumeToken(); err != nil {
+//             return err
+//         }
+//     }
+func (r *Request) ResumeToken() (string, error) {
+	tokens := r.nextPageTokens()
+	if tokens == nil {
+		return "", nil
+	}
+
+	t := resumeToken{Operation: r.Operation.Name, Tokens: make([]json.RawMessage, len(tokens))}
+	for i, tok := range tokens {
+		b, err := json.Marshal(tok)
+		if err != nil {
+			return "", awserr.New("SerializationError", "failed to encode resume token", err)
+		}
+		t.Tokens[i] = b
+	}
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", awserr.New("SerializationError", "failed to encode resume token", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ResumeFrom sets the pagination tokens of the request's input from a token
+// returned by ResumeToken, so the request is the request of the token's page.
+// ResumeFrom must be called before the request is sent. An empty token leaves
+// the request unchanged, so the first token of an iteration does not need to
+// be handled separately.
+//
+// Returns an ErrCodeInvalidResumeToken awserr.Error if the token is malformed,
+// or is not a token of the request's operation.
+func (r *Request) ResumeFrom(token string) error {
+	if token == "" {
+		return nil
+	}
+	if r.Operation.Paginator == nil {
+		return awserr.New(ErrCodeInvalidResumeToken, "operation "+r.Operation.Name+" is not paginated", nil)
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return awserr.New(ErrCodeInvalidResumeToken, "failed to decode resume token", err)
+	}
+	var t resumeToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return awserr.New(ErrCodeInvalidResumeToken, "failed to decode resume token", err)
+	}
+	if t.Operation != r.Operation.Name || len(t.Tokens) != len(r.Operation.InputTokens) {
+		return awserr.New(ErrCodeInvalidResumeToken, "resume token is not a token of operation "+r.Operation.Name, nil)
+	}
+
+	params := reflect.Indirect(reflect.ValueOf(r.Params))
+	for i, intok := range r.Operation.InputTokens {
+		if string(t.Tokens[i]) == "null" {
+			continue
+		}
+		field, ok := params.Type().FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(name, intok)
+		})
+		if !ok {
+			return awserr.New(ErrCodeInvalidResumeToken, "unknown input token "+intok, nil)
+		}
+
+		typ := field.Type
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		v := reflect.New(typ)
+		if err := json.Unmarshal(t.Tokens[i], v.Interface()); err != nil {
+			return awserr.New(ErrCodeInvalidResumeToken, "failed to decode resume token", err)
+		}
+		awsutil.SetValueAtAnyPath(r.Params, intok, v.Elem().Interface())
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+const (
+	// The number of tokens in the retry quota of a client when it is
+	// created, which is also the maximum number of tokens of the quota.
+	retryQuotaCapacity = 500
+
+	// The number of tokens a retry costs.
+	retryQuotaRetryCost = 5
+
+	// The number of tokens the retry of an attempt which timed out costs.
+	retryQuotaTimeoutCost = 10
+
+	// The number of tokens added to the quota by each request which succeeded
+	// without being retried.
+	retryQuotaSuccessIncrement = 1
+)
+
+// A retryQuota is a token bucket shared by the requests of a client, which
+// limits the number of requests the client retries. Each retry costs tokens,
+// and the cost of the last retry of a request is returned to the quota if the
+// request succeeds, so clients stop retrying while most of their requests
+// fail, e.g. during an outage of the service, instead of multiplying the load
+// on the service. Requests which succeed without being retried slowly refill
+// the quota.
+type retryQuota struct {
+	m      sync.Mutex
+	tokens int
+}
+
+// newRetryQuota returns a full retryQuota.
+func newRetryQuota() *retryQuota {
+	return &retryQuota{tokens: retryQuotaCapacity}
+}
+
+// acquire takes cost tokens from the quota, returning false if the quota does
+// not have enough tokens.
+func (q *retryQuota) acquire(cost int) bool {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if q.tokens < cost {
+		return false
+	}
+	q.tokens -= cost
+	return true
+}
+
+// release returns tokens to the quota, up to its capacity.
+func (q *retryQuota) release(tokens int) {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	q.tokens += tokens
+	if q.tokens > retryQuotaCapacity {
+		q.tokens = retryQuotaCapacity
+	}
+}
+
+// acquireRetryToken takes the tokens the request's retry costs from the
+// service's retry quota. Returns false if the quota is exhausted, in which
+// case the request must not be retried.
+func acquireRetryToken(r *Request) bool {
+	if r.Service.retryQuota == nil {
+		return true
+	}
+
+	cost := retryQuotaRetryCost
+	if err, ok := r.Error.(awserr.Error); ok && err.Code() == ErrCodeRequestAttemptTimeout {
+		cost = retryQuotaTimeoutCost
+	}
+	if !r.Service.retryQuota.acquire(cost) {
+		return false
+	}
+	r.retryQuotaCost = cost
+	return true
+}
+
+// RetryQuotaSuccessHandler is a request handler which returns the tokens the
+// last retry of a successful request cost to the service's retry quota, or
+// adds a token to the quota if the request succeeded without being retried.
+// It runs with the Complete handlers, so requests whose response failed to
+// unmarshal are not counted as succeeded.
+func RetryQuotaSuccessHandler(r *Request) {
+	if r.Service.retryQuota == nil || r.Error != nil {
+		return
+	}
+
+	if r.retryQuotaCost > 0 {
+		r.Service.retryQuota.release(r.retryQuotaCost)
+		r.retryQuotaCost = 0
+	} else {
+		r.Service.retryQuota.release(retryQuotaSuccessIncrement)
+	}
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRetryQuotaService returns a service whose requests fail with status
+// until the attempt succeeds.
+func newRetryQuotaService(cfg *Config, status func(attempt int) int) *Service {
+	cfg.MaxRetries = 3
+	cfg.RetryBaseDelay = time.Millisecond
+	s := NewService(cfg)
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		if code := status(int(r.RetryCount)); code != 200 {
+			r.HTTPResponse = &http.Response{StatusCode: code, Body: body(`{"__type":"ServiceUnavailable","message":"Unavailable."}`)}
+			return
+		}
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	})
+	return s
+}
+
+func TestRetryQuota(t *testing.T) {
+	q := newRetryQuota()
+	for i := 0; i < retryQuotaCapacity/retryQuotaRetryCost; i++ {
+		assert.True(t, q.acquire(retryQuotaRetryCost))
+	}
+	assert.False(t, q.acquire(retryQuotaRetryCost))
+
+	q.release(retryQuotaRetryCost)
+	assert.True(t, q.acquire(retryQuotaRetryCost))
+
+	q.release(2 * retryQuotaCapacity)
+	assert.Equal(t, retryQuotaCapacity, q.tokens)
+}
+
+func TestRetryQuotaExhausted(t *testing.T) {
+	s := newRetryQuotaService(&Config{}, func(int) int { return 503 })
+	s.retryQuota.tokens = 2 * retryQuotaRetryCost
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "ServiceUnavailable", err.(awserr.Error).Code())
+	assert.Equal(t, uint(2), r.RetryCount, "expect the request to stop retrying once the quota is exhausted")
+	assert.Equal(t, 0, s.retryQuota.tokens)
+
+	// Requests which fail do not refill the quota.
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.Error(t, r.Send())
+	assert.Equal(t, uint(0), r.RetryCount)
+	assert.Equal(t, 0, s.retryQuota.tokens)
+}
+
+func TestRetryQuotaRefilledBySuccess(t *testing.T) {
+	s := newRetryQuotaService(&Config{}, func(attempt int) int {
+		if attempt < 2 {
+			return 503
+		}
+		return 200
+	})
+	s.retryQuota.tokens = 100
+
+	// The cost of the last retry is returned to the quota.
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+	assert.Equal(t, uint(2), r.RetryCount)
+	assert.Equal(t, 100-retryQuotaRetryCost, s.retryQuota.tokens)
+
+	s = newRetryQuotaService(&Config{}, func(int) int { return 200 })
+	s.retryQuota.tokens = 100
+	assert.NoError(t, NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{}).Send())
+	assert.Equal(t, 100+retryQuotaSuccessIncrement, s.retryQuota.tokens)
+}
+
+func TestRetryQuotaTimeoutCost(t *testing.T) {
+	s := NewService(&Config{MaxRetries: 3})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+
+	r.Error = awserr.New(ErrCodeRequestAttemptTimeout, "request attempt timed out", nil)
+	assert.True(t, acquireRetryToken(r))
+	assert.Equal(t, retryQuotaCapacity-retryQuotaTimeoutCost, s.retryQuota.tokens)
+	assert.Equal(t, retryQuotaTimeoutCost, r.retryQuotaCost)
+}
+
+func TestRetryQuotaDisabled(t *testing.T) {
+	s := newRetryQuotaService(&Config{DisableRetryQuota: true}, func(int) int { return 503 })
+	assert.Nil(t, s.retryQuota)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.Error(t, r.Send())
+	assert.Equal(t, uint(3), r.RetryCount)
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// A sendRateLimiter is a token bucket limiting the rate that the requests of
+// a client are sent at to the Config's MaxSendRate, allowing bursts of up to
+// MaxSendBurst requests. Each attempt of a request takes a token.
+type sendRateLimiter struct {
+	m sync.Mutex
+
+	rate       float64 // tokens per second
+	capacity   float64
+	tokens     float64
+	lastRefill time.Time
+
+	now   func() time.Time
+	sleep func(Context, time.Duration) error
+}
+
+// newSendRateLimiter returns a full sendRateLimiter sending up to rate
+// requests per second, with bursts of up to burst requests. A burst of less
+// than 1 allows bursts of a single request.
+func newSendRateLimiter(rate float64, burst int) *sendRateLimiter {
+	capacity := math.Max(float64(burst), 1)
+	return &sendRateLimiter{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		now:      time.Now,
+		sleep:    SleepWithContext,
+	}
+}
+
+// acquire blocks until a request can be sent. Returns the context's error if
+// the context is canceled while waiting.
+func (l *sendRateLimiter) acquire(ctx Context) error {
+	for {
+		l.m.Lock()
+		now := l.now()
+		if !l.lastRefill.IsZero() {
+			l.tokens = math.Min(l.tokens+now.Sub(l.lastRefill).Seconds()*l.rate, l.capacity)
+		}
+		l.lastRefill = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.m.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.m.Unlock()
+
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockSendRateLimiter(c *mockClock, rate float64, burst int) *sendRateLimiter {
+	l := newSendRateLimiter(rate, burst)
+	l.now = c.Now
+	l.sleep = c.Sleep
+	return l
+}
+
+func TestSendRateLimiterBurst(t *testing.T) {
+	c := &mockClock{now: time.Unix(0, 0)}
+	l := newMockSendRateLimiter(c, 10, 5)
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, l.acquire(BackgroundContext()))
+	}
+	assert.Empty(t, c.slept)
+
+	// Once the burst is used requests are sent at the rate.
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, l.acquire(BackgroundContext()))
+	}
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond}, c.slept)
+
+	// The bucket refills while no requests are sent, up to the burst.
+	c.slept = nil
+	c.now = c.now.Add(time.Hour)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, l.acquire(BackgroundContext()))
+	}
+	assert.Empty(t, c.slept)
+}
+
+func TestSendRateLimiterNoBurst(t *testing.T) {
+	c := &mockClock{now: time.Unix(0, 0)}
+	l := newMockSendRateLimiter(c, 2, 0)
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, l.acquire(BackgroundContext()))
+	}
+	assert.Equal(t, []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}, c.slept)
+}
+
+func TestMaxSendRate(t *testing.T) {
+	s := NewService(&Config{MaxSendRate: 1})
+	assert.NotNil(t, s.sendRateLimiter)
+
+	s = NewService(&Config{})
+	assert.Nil(t, s.sendRateLimiter)
+}
+
+func TestMaxSendRateCanceled(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region", MaxSendRate: 0.001, MaxRetries: 0})
+	s.Handlers.Validate.Clear()
+	s.Config.HTTPClient = &http.Client{Transport: &contextTransport{}}
+
+	assert.NoError(t, NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send())
+
+	// The next request waits for the rate limit, until its context is done.
+	ctx, cancel := context.WithTimeout(BackgroundContext(), 10*time.Millisecond)
+	defer cancel()
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+}
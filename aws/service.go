@@ -3,21 +3,32 @@ package aws
 import (
 	"fmt"
 	"math"
+	"math/rand"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"reflect"
 	"regexp"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/internal/endpoints"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 )
 
+// ErrCodeInvalidCABundle is the code of the error of requests whose service's
+// Config has a CABundle without valid certificates.
+const ErrCodeInvalidCABundle = "InvalidCABundle"
+
 // A Service implements the base service request and response handling
 // used by all services.
 type Service struct {
 	Config            *Config
 	Handlers          Handlers
 	ServiceName       string
+	ServiceID         string
 	APIVersion        string
 	Endpoint          string
 	SigningName       string
@@ -27,6 +38,40 @@ type Service struct {
 	RetryRules        func(*Request) time.Duration
 	ShouldRetry       func(*Request) bool
 	DefaultMaxRetries uint
+
+	// Discovers the endpoints of the requests of operations which support
+	// endpoint discovery, set by services which have an endpoint operation.
+	DiscoverEndpoints func(*Request) ([]DiscoveredEndpoint, error)
+
+	// The base delay used to compute retry delays if the Config's
+	// RetryBaseDelay is not set.
+	DefaultRetryBaseDelay time.Duration
+
+	rateLimiter *adaptiveRateLimiter
+
+	// Limits the rate the client's requests are sent at, if the Config has a
+	// MaxSendRate.
+	sendRateLimiter *sendRateLimiter
+
+	// Limits the retries of the client's requests, unless the retry quota
+	// is disabled.
+	retryQuota *retryQuota
+
+	// Reports API calls and attempts to the CSM agent, if CSM is enabled.
+	csm *csmReporter
+
+	// The endpoints discovered with DiscoverEndpoints.
+	discoveredEndpoints *endpointCache
+
+	// The error returned by the Config's EndpointResolver, if any.
+	endpointErr error
+
+	// The error loading the Config's CABundle, if any.
+	caBundleErr error
+
+	// Set if the endpoint was configured instead of resolved from the SDK's
+	// endpoints model.
+	customEndpoint bool
 }
 
 var schemeRE = regexp.MustCompile("^([^:]+)://")
@@ -44,7 +89,16 @@ func (s *Service) Initialize() {
 		s.Config = &Config{}
 	}
 	if s.Config.HTTPClient == nil {
-		s.Config.HTTPClient = http.DefaultClient
+		s.Config.HTTPClient = defaultHTTPClient
+	}
+	if s.Config.HTTPClient == defaultHTTPClient &&
+		(len(s.Config.CABundle) > 0 || s.Config.Dialer != nil || s.Config.Proxy != nil ||
+			s.Config.MaxIdleConnsPerHost > 0) {
+		if client, err := sharedHTTPClient(s.Config); err != nil {
+			s.caBundleErr = err
+		} else {
+			s.Config.HTTPClient = client
+		}
 	}
 
 	if s.RetryRules == nil {
@@ -56,27 +110,166 @@ func (s *Service) Initialize() {
 	}
 
 	s.DefaultMaxRetries = 3
-	s.Handlers.Validate.PushBack(ValidateEndpointHandler)
-	s.Handlers.Build.PushBack(UserAgentHandler)
-	s.Handlers.Sign.PushBack(BuildContentLength)
-	s.Handlers.Send.PushBack(SendHandler)
-	s.Handlers.AfterRetry.PushBack(AfterRetryHandler)
-	s.Handlers.ValidateResponse.PushBack(ValidateResponseHandler)
+	s.DefaultRetryBaseDelay = 30 * time.Millisecond
+	s.Handlers.Validate.PushBackNamed(NamedHandler{Name: ValidateEndpointHandlerName, Fn: ValidateEndpointHandler})
+	s.Handlers.Validate.PushBackNamed(NamedHandler{Name: FillIdempotencyTokensHandlerName, Fn: FillIdempotencyTokens})
+	s.Handlers.Build.PushBackNamed(NamedHandler{Name: UserAgentHandlerName, Fn: UserAgentHandler})
+	s.Handlers.Sign.PushBackNamed(NamedHandler{Name: DiscoverEndpointHandlerName, Fn: DiscoverEndpointHandler})
+	s.Handlers.Sign.PushBackNamed(NamedHandler{Name: BuildContentLengthHandlerName, Fn: BuildContentLength})
+	s.Handlers.Send.PushBackNamed(NamedHandler{Name: SendHandlerName, Fn: SendHandler})
+	s.Handlers.Retry.PushBackNamed(NamedHandler{Name: DrainResponseBodyHandlerName, Fn: DrainResponseBody})
+	s.Handlers.Retry.PushBackNamed(NamedHandler{Name: InvalidateEndpointHandlerName, Fn: InvalidateEndpointHandler})
+	s.Handlers.Retry.PushBackNamed(NamedHandler{Name: CircuitBreakerResultHandlerName, Fn: CircuitBreakerResultHandler})
+	s.Handlers.AfterRetry.PushBackNamed(NamedHandler{Name: AfterRetryHandlerName, Fn: AfterRetryHandler})
+	s.Handlers.ValidateResponse.PushBackNamed(NamedHandler{Name: ValidateResponseHandlerName, Fn: ValidateResponseHandler})
+	s.Handlers.Complete.PushBackNamed(NamedHandler{Name: DrainResponseBodyHandlerName, Fn: DrainResponseBody})
+	s.Handlers.Complete.PushBackNamed(NamedHandler{Name: CircuitBreakerResultHandlerName, Fn: CircuitBreakerResultHandler})
 	s.AddDebugHandlers()
 	s.buildEndpoint()
+	s.discoveredEndpoints = &endpointCache{}
+
+	if s.Config.RetryMode == RetryModeAdaptive {
+		s.rateLimiter = newAdaptiveRateLimiter()
+		s.Handlers.Retry.PushBackNamed(NamedHandler{Name: AdaptiveRetryErrorHandlerName, Fn: AdaptiveRetryErrorHandler})
+		s.Handlers.Complete.PushBackNamed(NamedHandler{Name: AdaptiveRetrySuccessHandlerName, Fn: AdaptiveRetrySuccessHandler})
+	}
+
+	if s.Config.MaxSendRate > 0 {
+		s.sendRateLimiter = newSendRateLimiter(s.Config.MaxSendRate, s.Config.MaxSendBurst)
+	}
+
+	if !s.Config.DisableRetryQuota {
+		s.retryQuota = newRetryQuota()
+		s.Handlers.Complete.PushBackNamed(NamedHandler{Name: RetryQuotaSuccessHandlerName, Fn: RetryQuotaSuccessHandler})
+	}
+
+	if s.Config.CSMEnabled {
+		s.csm = newCSMReporter(s.Config)
+	}
 
 	if !s.Config.DisableParamValidation {
-		s.Handlers.Validate.PushBack(ValidateParameters)
+		s.Handlers.Validate.PushBackNamed(NamedHandler{Name: ValidateParametersHandlerName, Fn: ValidateParameters})
+	}
+}
+
+// An httpClientKey is the settings of a Config which a customized HTTP client
+// is created for. The Proxy is not one of them, because requests are proxied
+// by the Proxy which SendHandler puts in their context.
+type httpClientKey struct {
+	caBundle            string
+	dialer              Dialer
+	maxIdleConnsPerHost int
+}
+
+// httpClients are the customized HTTP clients by the settings they were
+// created for, shared by the services of Configs with the same settings so
+// that each service, e.g. of a Session, does not have its own transport and
+// connection pool.
+var httpClients = struct {
+	sync.Mutex
+	m map[httpClientKey]*http.Client
+}{m: map[httpClientKey]*http.Client{}}
+
+// sharedHTTPClient returns the customized HTTP client of the Config's
+// settings, creating it for the first service with the settings. Services
+// whose Dialer cannot be compared have their own client.
+func sharedHTTPClient(cfg *Config) (*http.Client, error) {
+	if cfg.Dialer != nil && !reflect.TypeOf(cfg.Dialer).Comparable() {
+		return newHTTPClient(cfg)
+	}
+	key := httpClientKey{
+		caBundle:            string(cfg.CABundle),
+		dialer:              cfg.Dialer,
+		maxIdleConnsPerHost: cfg.MaxIdleConnsPerHost,
+	}
+
+	httpClients.Lock()
+	defer httpClients.Unlock()
+	if client, ok := httpClients.m[key]; ok {
+		return client, nil
+	}
+	client, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	httpClients.m[key] = client
+	return client, nil
+}
+
+// proxyContextKey is the context key of the Proxy of the Config a request is
+// sent with.
+type proxyContextKey struct{}
+
+// contextProxy returns a transport Proxy which proxies requests with the Proxy
+// of their context, or with the fallback if their context has none.
+func contextProxy(fallback func(*http.Request) (*url.URL, error)) func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		if proxy, ok := req.Context().Value(proxyContextKey{}).(func(*http.Request) (*url.URL, error)); ok {
+			return proxy(req)
+		}
+		return fallback(req)
+	}
+}
+
+// newHTTPClient returns a client with the default HTTP transport customized
+// by the Config's CABundle, Dialer, and MaxIdleConnsPerHost, which proxies
+// requests with the Proxy of their context. Returns an error if the CA bundle
+// has no certificates, which ValidateEndpointHandler fails the service's
+// requests with, instead of sending them with the system's certificate pool.
+func newHTTPClient(cfg *Config) (*http.Client, error) {
+	tr := defaults.HTTPTransport()
+	if len(cfg.CABundle) > 0 {
+		tlsCfg, err := defaults.CABundleTLSConfig(cfg.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		tr.TLSClientConfig = tlsCfg
+	}
+	if cfg.Dialer != nil {
+		tr.DialContext = cfg.Dialer.DialContext
+	}
+	tr.Proxy = contextProxy(tr.Proxy)
+	if cfg.MaxIdleConnsPerHost > 0 {
+		tr.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		if tr.MaxIdleConns < cfg.MaxIdleConnsPerHost {
+			tr.MaxIdleConns = cfg.MaxIdleConnsPerHost
+		}
 	}
+	return &http.Client{Transport: tr}, nil
 }
 
 // buildEndpoint builds the endpoint values the service will use to make requests with.
 func (s *Service) buildEndpoint() {
+	s.endpointErr = nil
+	s.customEndpoint = true
 	if s.Config.Endpoint != "" {
 		s.Endpoint = s.Config.Endpoint
 	} else {
-		s.Endpoint, s.SigningRegion =
-			endpoints.EndpointForRegion(s.ServiceName, s.Config.Region)
+		var endpoint, signingRegion string
+		if s.Config.EndpointResolver != nil {
+			endpoint, signingRegion, s.endpointErr =
+				s.Config.EndpointResolver.ResolveEndpoint(s.ServiceName, s.Config.Region)
+		}
+		if endpoint == "" {
+			endpoint = envEndpointURL(s.ServiceID)
+		}
+		if endpoint == "" {
+			ep, _ := endpoints.Resolve(s.ServiceName, s.Config.Region, s.endpointOptions)
+			endpoint, signingRegion = ep.URL, ep.SigningRegion
+			if !ep.SigningNameDerived {
+				s.SigningName = ep.SigningName
+			}
+			s.customEndpoint = false
+		}
+		s.Endpoint, s.SigningRegion = endpoint, signingRegion
+	}
+
+	// The Config's signing scope overrides the scope of the endpoint.
+	if s.Config.SigningName != "" {
+		s.SigningName = s.Config.SigningName
+	}
+	if s.Config.SigningRegion != "" {
+		s.SigningRegion = s.Config.SigningRegion
 	}
 
 	if s.Endpoint != "" && !schemeRE.MatchString(s.Endpoint) {
@@ -88,35 +281,70 @@ func (s *Service) buildEndpoint() {
 	}
 }
 
+// HasCustomEndpoint returns true if the Service's endpoint was configured,
+// through the Config's Endpoint or EndpointResolver or the environment,
+// instead of resolved from the SDK's endpoints model.
+func (s *Service) HasCustomEndpoint() bool {
+	return s.customEndpoint
+}
+
+// endpointOptions sets the endpoint resolution options of the Service's
+// Config.
+func (s *Service) endpointOptions(o *endpoints.Options) {
+	o.DisableSSL = s.Config.DisableSSL
+	o.UseDualStack = s.Config.UseDualStack
+	o.UseFIPSEndpoint = s.Config.UseFIPSEndpoint
+	o.UseSTSRegionalEndpoint = s.Config.STSRegionalEndpoint == STSRegionalEndpointRegional
+}
+
 // AddDebugHandlers injects debug logging handlers into the service to log request
 // debug information.
 func (s *Service) AddDebugHandlers() {
+	s.addDebugHandlers(&s.Handlers)
+}
+
+// addDebugHandlers adds the debug logging handlers to handlers if the
+// Service's Config enables debug logging.
+func (s *Service) addDebugHandlers(handlers *Handlers) {
 	out := s.Config.Logger
-	if s.Config.LogLevel == 0 {
+	if !s.Config.LogLevel.Matches(LogDebug) {
 		return
 	}
 
-	s.Handlers.Send.PushFront(func(r *Request) {
-		logBody := r.Config.LogHTTPBody
+	handlers.Send.PushFrontNamed(NamedHandler{Name: LogRequestHandlerName, Fn: func(r *Request) {
+		logBody := r.Config.LogHTTPBody || r.Config.LogLevel.Matches(LogDebugWithHTTPBody)
 		dumpedBody, _ := httputil.DumpRequestOut(r.HTTPRequest, logBody)
+		dumpedBody = redactLogDump(r, dumpedBody)
 
-		fmt.Fprintf(out, "---[ REQUEST POST-SIGN ]-----------------------------\n")
-		fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
-	s.Handlers.Send.PushBack(func(r *Request) {
-		fmt.Fprintf(out, "---[ RESPONSE ]--------------------------------------\n")
+		out.Log(fmt.Sprintf(logReqMsg, r.ServiceName, r.Operation.Name, string(dumpedBody)))
+	}})
+	handlers.Send.PushBackNamed(NamedHandler{Name: LogResponseHandlerName, Fn: func(r *Request) {
 		if r.HTTPResponse != nil {
-			logBody := r.Config.LogHTTPBody
+			logBody := r.Config.LogHTTPBody || r.Config.LogLevel.Matches(LogDebugWithHTTPBody)
 			dumpedBody, _ := httputil.DumpResponse(r.HTTPResponse, logBody)
-			fmt.Fprintf(out, "%s\n", string(dumpedBody))
+			dumpedBody = redactLogDump(r, dumpedBody)
+			out.Log(fmt.Sprintf(logRespMsg, r.ServiceName, r.Operation.Name, string(dumpedBody)))
 		} else if r.Error != nil {
-			fmt.Fprintf(out, "%s\n", r.Error)
+			out.Log(fmt.Sprintf(logRespErrMsg, r.ServiceName, r.Operation.Name, r.Error))
 		}
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
+	}})
 }
 
+const logReqMsg = `DEBUG: Request %s/%s Details:
+---[ REQUEST POST-SIGN ]-----------------------------
+%s
+-----------------------------------------------------`
+
+const logRespMsg = `DEBUG: Response %s/%s Details:
+---[ RESPONSE ]--------------------------------------
+%s
+-----------------------------------------------------`
+
+const logRespErrMsg = `DEBUG: Send Request %s/%s failed, error:
+---[ RESPONSE ]--------------------------------------
+%s
+-----------------------------------------------------`
+
 // MaxRetries returns the number of maximum returns the service will use to make
 // an individual API request.
 func (s *Service) MaxRetries() uint {
@@ -126,16 +354,96 @@ func (s *Service) MaxRetries() uint {
 	return uint(s.Config.MaxRetries)
 }
 
-// retryRules returns the delay duration before retrying this request again
+// RetryBaseDelay returns the base delay the service will use to compute the
+// delay before retrying an individual API request.
+func (s *Service) RetryBaseDelay() time.Duration {
+	if s.Config.RetryBaseDelay > 0 {
+		return s.Config.RetryBaseDelay
+	}
+	return s.DefaultRetryBaseDelay
+}
+
+// RetryMaxDelay returns the maximum delay the service will wait before
+// retrying an individual API request.
+func (s *Service) RetryMaxDelay() time.Duration {
+	if s.Config.RetryMaxDelay > 0 {
+		return s.Config.RetryMaxDelay
+	}
+	return DefaultRetryMaxDelay
+}
+
+// randomDelay returns a random delay in the range [0, ceil]. Replaceable for
+// testing.
+var randomDelay = func(ceil time.Duration) time.Duration {
+	return time.Duration(rand.Int63n(int64(ceil) + 1))
+}
+
+// retryRules returns the delay duration before retrying this request again.
+//
+// Uses capped exponential backoff with full jitter. The delay is a random
+// duration between zero and the base delay multiplied by 2^RetryCount,
+// capped at the service's maximum retry delay.
 func retryRules(r *Request) time.Duration {
-	delay := time.Duration(math.Pow(2, float64(r.RetryCount))) * 30
-	return delay * time.Millisecond
+	base, max := r.Service.RetryBaseDelay(), r.Service.RetryMaxDelay()
+	if base <= 0 {
+		return 0
+	}
+
+	ceil := max
+	if exp := math.Pow(2, float64(r.RetryCount)); float64(base)*exp < float64(max) {
+		ceil = time.Duration(float64(base) * exp)
+	}
+
+	return randomDelay(ceil)
+}
+
+// retryAfterDelay returns the delay requested by the service through the
+// Retry-After header of a throttled (429) or unavailable (503) response. The
+// header may either be a number of seconds or an HTTP date. The delay is
+// capped at the service's RetryMaxDelay. False is returned if the response
+// does not contain a usable Retry-After value, including dates in the past.
+func retryAfterDelay(r *Request) (time.Duration, bool) {
+	if r.HTTPResponse == nil {
+		return 0, false
+	}
+	if code := r.HTTPResponse.StatusCode; code != 429 && code != 503 {
+		return 0, false
+	}
+
+	v := r.HTTPResponse.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	var delay time.Duration
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		delay = time.Duration(secs) * time.Second
+		if secs > int64(math.MaxInt64/time.Second) {
+			delay = math.MaxInt64
+		}
+	} else if t, err := http.ParseTime(v); err == nil {
+		if delay = t.Sub(time.Now()); delay <= 0 {
+			return 0, false
+		}
+	} else {
+		return 0, false
+	}
+
+	if max := r.Service.RetryMaxDelay(); delay > max {
+		delay = max
+	}
+	return delay, true
 }
 
 // retryableCodes is a collection of service response codes which are retry-able
 // without any further action.
+//
+// Failures to send requests, "RequestError", are not included. Whether they are
+// retried depends on the network error and the DisableNetworkErrorRetries
+// option.
 var retryableCodes = map[string]struct{}{
-	"RequestError":                           {},
 	"ProvisionedThroughputExceededException": {},
 	"Throttling":                             {},
 	"ThrottlingException":                    {},
@@ -167,12 +475,20 @@ func isCodeExpiredCreds(code string) bool {
 
 // shouldRetry returns if the request should be retried.
 func shouldRetry(r *Request) bool {
-	if r.HTTPResponse.StatusCode >= 500 {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode >= 500 {
 		return true
 	}
 	if r.Error != nil {
 		if err, ok := r.Error.(awserr.Error); ok {
-			return isCodeRetryable(err.Code())
+			if isCodeRetryable(err.Code()) {
+				return true
+			}
+		}
+
+		// Transient network errors may also occur while the response body
+		// is being read, after the request was sent.
+		if !r.Service.Config.DisableNetworkErrorRetries && isErrTransient(r.Error) {
+			return true
 		}
 	}
 	return false
@@ -0,0 +1,383 @@
+// Package session provides a way to share configuration and request handlers
+// across multiple service clients.
+//
+// A Session is created once with the configuration that should be used by all
+// of the service clients created from it. The configuration is merged with
+// aws.DefaultConfig a single time when the Session is created, so the service
+// clients share the same credentials, and the credentials chain is only
+// resolved once for all of them.
+//
+//     sess := session.New(&aws.Config{Region: "us-west-2"})
+//
+//     // Log every request made by clients created from this session.
+//     sess.Handlers.Send.PushFront(func(r *aws.Request) {
+//         log.Println(r.Service.ServiceName, r.Operation.Name)
+//     })
+//
+//     s3svc := s3.NewWithSession(sess, nil)
+//     dbsvc := dynamodb.NewWithSession(sess, &aws.Config{Region: "us-east-1"})
+//
+// Shared Config
+//
+// New also loads the profile from the shared config file, $HOME/.aws/config
+// by default. Values from the shared config are only used when the Config
+// passed to New, and aws.DefaultConfig do not already provide them. The file
+// and profile can be selected with the "AWS_CONFIG_FILE" and "AWS_PROFILE"
+// environment variables.
+//
+// The region is resolved from the Config passed to New, the "AWS_REGION" and
+// "AWS_DEFAULT_REGION" environment variables, the shared config profile, and
+// finally the EC2 instance metadata service, so applications running on EC2
+// instances do not need to configure their region. The metadata service is
+// queried once per process, waiting for at most a second. Set the environment
+// variable "AWS_EC2_METADATA_DISABLED" to "true" to skip the metadata service.
+//
+// If the "AWS_WEB_IDENTITY_TOKEN_FILE" and "AWS_ROLE_ARN" environment
+// variables are set, and no credentials are set by the Config passed to New or
+// the environment, the Session's credentials assume the role with the web
+// identity token read from the file, using stscreds.WebIdentityRoleProvider.
+// The optional "AWS_ROLE_SESSION_NAME" environment variable sets the role's
+// session name. This is how workloads running in Kubernetes with service
+// account roles are provided credentials.
+//
+// Otherwise, if the profile has a role_arn, and no credentials are set by the
+// Config passed to New or the environment, the Session's credentials assume
+// the role with stscreds.AssumeRoleProvider. The role is assumed with the
+// credentials of the profile's source_profile, or of its credential_source,
+// one of "Environment", "Ec2InstanceMetadata", or "EcsContainer", or with the
+// Config's credentials if neither is set. A source_profile which itself has a
+// role_arn, sso_start_url, or credential_process provides those credentials,
+// so roles can be chained. Otherwise the source_profile's credentials are
+// read from the shared credentials file. Profiles with an mfa_serial require
+// a token provider for the MFA token codes, which is set with NewWithOptions,
+// e.g. stscreds.StdinTokenProvider for command line tools. Without it
+// retrieving the credentials fails with stscreds.ErrTokenProviderNotSet.
+//
+//     sess := session.NewWithOptions(session.Options{
+//         AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+//     })
+//
+// Set the CredentialsCacheDir option to cache the assumed role's credentials
+// on disk, so the user is only asked for a token code once until they expire,
+// e.g. credentials.DefaultFileCacheDir(), the cache of the AWS CLI.
+//
+// Otherwise, if the profile has an sso_start_url, the Session's credentials
+// are the credentials of the profile's sso_role_name role in its
+// sso_account_id account, retrieved from the AWS SSO portal in sso_region
+// with ssocreds.Provider. The user must have signed in to the portal with
+// "aws sso login", which caches the access token the credentials are
+// retrieved with.
+//
+// Otherwise, if the profile has a credential_process, the Session's
+// credentials are retrieved from the output of the command with
+// credentials.ProcessProvider, and refreshed by running the command again
+// when they expire.
+package session
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+)
+
+// A Session provides the configuration and request handlers shared by the
+// service clients created from it.
+//
+// Changes made to a Session only affect service clients created after the
+// change was made. Service clients copy the Session's Config and Handlers
+// when they are created.
+type Session struct {
+	// The configuration service clients will be created with.
+	Config *aws.Config
+
+	// Handlers which will be added to each service client's handlers. These
+	// handlers are pushed to the back of the client's handler lists, after
+	// the client's own handlers.
+	Handlers aws.Handlers
+
+	// The values loaded from the shared config file's profile. Empty if
+	// the shared config file or profile could not be loaded.
+	SharedConfig SharedConfig
+}
+
+// New returns a new Session with config merged on top of aws.DefaultConfig.
+// If config is nil aws.DefaultConfig will be used.
+//
+// The shared config file's profile is used to fill in values not set by
+// config or aws.DefaultConfig. A missing or invalid shared config file is
+// ignored.
+func New(config *aws.Config) *Session {
+	return NewWithOptions(Options{Config: config})
+}
+
+// Options are the options a Session is created with by NewWithOptions.
+type Options struct {
+	// The configuration merged on top of aws.DefaultConfig. If nil
+	// aws.DefaultConfig will be used.
+	Config *aws.Config
+
+	// Provides the MFA token code when the shared config profile's role
+	// requires MFA, e.g. stscreds.StdinTokenProvider.
+	AssumeRoleTokenProvider func() (string, error)
+
+	// The directory the credentials of the shared config profile's role or
+	// SSO role are cached in, so they are shared between process runs until
+	// they expire. Set to credentials.DefaultFileCacheDir() to share the
+	// cache of the AWS CLI. The credentials are not cached if not set.
+	CredentialsCacheDir string
+}
+
+// NewWithOptions returns a new Session created with the options, see New.
+func NewWithOptions(opts Options) *Session {
+	config := opts.Config
+	s := &Session{
+		Config: aws.DefaultConfig.Merge(config),
+	}
+
+	if sharedCfg, err := LoadSharedConfig("", ""); err == nil {
+		s.SharedConfig = sharedCfg
+	}
+	if s.Config.Region == "" {
+		s.Config.Region = s.SharedConfig.Region
+	}
+	if s.Config.Region == "" {
+		s.Config.Region = ec2Region.get()
+	}
+	if (config == nil || config.Credentials == nil) && !hasEnvCredentials() {
+		if tokenFile, roleARN := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"), os.Getenv("AWS_ROLE_ARN"); tokenFile != "" && roleARN != "" {
+			s.Config.Credentials = webIdentityCredentials(s.Config, roleARN, tokenFile)
+		} else if creds, err := profileCredentials(s.Config, s.SharedConfig, opts, nil); err != nil {
+			s.Config.Credentials = credentials.NewCredentials(errorProvider{err})
+		} else if creds != nil {
+			s.Config.Credentials = creds
+		}
+	}
+
+	return s
+}
+
+// ec2MetadataClient is the client the region of EC2 instances is retrieved
+// with. Replaceable for testing.
+var ec2MetadataClient = ec2metadata.New()
+
+// ec2MetadataRegionTimeout is how long sessions wait for the EC2 metadata
+// service's region. The service responds quickly on EC2 instances, so hosts
+// which are not EC2 instances do not delay creating sessions for long.
+var ec2MetadataRegionTimeout = time.Second
+
+// ec2Region is the region of the EC2 instance the process runs on, retrieved
+// once for all the sessions of the process.
+var ec2Region = &regionCache{}
+
+// A regionCache retrieves the region of the EC2 instance from the metadata
+// service once, caching the region, or that the host is not an EC2 instance.
+type regionCache struct {
+	once   sync.Once
+	region string
+}
+
+// get returns the region of the EC2 instance, or an empty string if the host
+// is not an EC2 instance or the metadata service is disabled.
+func (c *regionCache) get() string {
+	if strings.EqualFold(os.Getenv("AWS_EC2_METADATA_DISABLED"), "true") {
+		return ""
+	}
+	c.once.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), ec2MetadataRegionTimeout)
+		defer cancel()
+		c.region, _ = ec2MetadataClient.RegionWithContext(ctx)
+	})
+	return c.region
+}
+
+// hasEnvCredentials returns true if credentials are set by the environment.
+func hasEnvCredentials() bool {
+	_, err := credentials.NewEnvCredentials().Get()
+	return err == nil
+}
+
+// profileCredentials returns the credentials the shared config profile
+// provides, or nil if the profile does not provide credentials. The
+// profiles already visited while resolving a chain of source profiles are
+// tracked in visited.
+func profileCredentials(cfg *aws.Config, sharedCfg SharedConfig, opts Options, visited map[string]bool) (*credentials.Credentials, error) {
+	switch {
+	case sharedCfg.RoleARN != "":
+		return assumeRoleCredentials(cfg, sharedCfg, opts, visited)
+	case sharedCfg.SSOStartURL != "":
+		return ssoCredentials(cfg, sharedCfg, opts), nil
+	case sharedCfg.CredentialProcess != "":
+		return credentials.NewProcessCredentials(sharedCfg.CredentialProcess), nil
+	}
+	return nil, nil
+}
+
+// assumeRoleCredentials returns credentials which assume the shared config
+// profile's role, using the credentials of the source profile or credential
+// source if set, otherwise the credentials of cfg.
+func assumeRoleCredentials(cfg *aws.Config, sharedCfg SharedConfig, opts Options, visited map[string]bool) (*credentials.Credentials, error) {
+	stsCfg := cfg.Copy()
+	switch {
+	case sharedCfg.SourceProfile != "" && sharedCfg.CredentialSource != "":
+		return nil, awserr.New("SharedConfigSourceCollision",
+			"profile "+sharedCfg.Profile+" sets both source_profile and credential_source", nil)
+	case sharedCfg.SourceProfile != "":
+		creds, err := sourceProfileCredentials(cfg, sharedCfg, opts, visited)
+		if err != nil {
+			return nil, err
+		}
+		stsCfg.Credentials = creds
+	case sharedCfg.CredentialSource != "":
+		creds, err := credentialSourceCredentials(sharedCfg.CredentialSource)
+		if err != nil {
+			return nil, err
+		}
+		stsCfg.Credentials = creds
+	}
+
+	provider := &stscreds.AssumeRoleProvider{
+		Config:        &stsCfg,
+		RoleARN:       sharedCfg.RoleARN,
+		SerialNumber:  sharedCfg.MFASerial,
+		TokenProvider: opts.AssumeRoleTokenProvider,
+	}
+	key := map[string]interface{}{"RoleArn": sharedCfg.RoleARN}
+	if sharedCfg.MFASerial != "" {
+		key["SerialNumber"] = sharedCfg.MFASerial
+	}
+	return cachedCredentials(provider, opts, key), nil
+}
+
+// sourceProfileCredentials returns the credentials of the profile's source
+// profile. If the source profile provides credentials itself, e.g. by
+// assuming another role, those are used. Otherwise the credentials of the
+// source profile in the shared credentials file are used, which is also the
+// case if the profile is its own source profile.
+func sourceProfileCredentials(cfg *aws.Config, sharedCfg SharedConfig, opts Options, visited map[string]bool) (*credentials.Credentials, error) {
+	source := sharedCfg.SourceProfile
+	if source == sharedCfg.Profile {
+		return credentials.NewSharedCredentials("", source), nil
+	}
+
+	if visited == nil {
+		visited = map[string]bool{}
+	}
+	visited[sharedCfg.Profile] = true
+	if visited[source] {
+		return nil, awserr.New("SharedConfigSourceProfileCycle",
+			"source_profile "+source+" of profile "+sharedCfg.Profile+" forms a cycle", nil)
+	}
+
+	sourceCfg, err := LoadSharedConfig("", source)
+	if err != nil {
+		// The source profile may only be in the shared credentials file.
+		return credentials.NewSharedCredentials("", source), nil
+	}
+	creds, err := profileCredentials(cfg, sourceCfg, opts, visited)
+	if err != nil || creds != nil {
+		return creds, err
+	}
+	return credentials.NewSharedCredentials("", source), nil
+}
+
+// credentialSourceCredentials returns the credentials of the credential
+// source, one of "Environment", "Ec2InstanceMetadata", or "EcsContainer".
+func credentialSourceCredentials(source string) (*credentials.Credentials, error) {
+	switch source {
+	case "Environment":
+		return credentials.NewEnvCredentials(), nil
+	case "Ec2InstanceMetadata":
+		return credentials.NewCredentials(&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute}), nil
+	case "EcsContainer":
+		return credentials.NewCredentials(&credentials.ContainerProvider{ExpiryWindow: 5 * time.Minute}), nil
+	}
+	return nil, awserr.New("SharedConfigInvalidCredentialSource",
+		"invalid credential_source "+source+", expected one of Environment, Ec2InstanceMetadata, or EcsContainer", nil)
+}
+
+// An errorProvider is a credentials Provider which fails with the error
+// the Session's credentials could not be resolved with.
+type errorProvider struct {
+	err error
+}
+
+func (p errorProvider) Retrieve() (credentials.Value, error) { return credentials.Value{}, p.err }
+func (p errorProvider) IsExpired() bool                      { return true }
+
+// webIdentityCredentials returns credentials which assume the role with the
+// web identity token read from tokenFile.
+func webIdentityCredentials(cfg *aws.Config, roleARN, tokenFile string) *credentials.Credentials {
+	stsCfg := cfg.Copy()
+	stsCfg.Credentials = credentials.AnonymousCredentials
+
+	return credentials.NewCredentials(&stscreds.WebIdentityRoleProvider{
+		Config:          &stsCfg,
+		RoleARN:         roleARN,
+		RoleSessionName: os.Getenv("AWS_ROLE_SESSION_NAME"),
+		TokenFilePath:   tokenFile,
+	})
+}
+
+// ssoCredentials returns credentials of the shared config profile's SSO
+// role.
+func ssoCredentials(cfg *aws.Config, sharedCfg SharedConfig, opts Options) *credentials.Credentials {
+	ssoCfg := cfg.Copy()
+	ssoCfg.Region = sharedCfg.SSORegion
+	ssoCfg.Credentials = credentials.AnonymousCredentials
+
+	provider := &ssocreds.Provider{
+		Config:    &ssoCfg,
+		AccountID: sharedCfg.SSOAccountID,
+		RoleName:  sharedCfg.SSORoleName,
+		StartURL:  sharedCfg.SSOStartURL,
+	}
+	return cachedCredentials(provider, opts, map[string]interface{}{
+		"accountId": sharedCfg.SSOAccountID,
+		"roleName":  sharedCfg.SSORoleName,
+		"startUrl":  sharedCfg.SSOStartURL,
+	})
+}
+
+// cachedCredentials returns credentials of the provider which are cached in
+// the file of key in the options' CredentialsCacheDir, if set. The keys are
+// the ones the AWS CLI caches the credentials with.
+func cachedCredentials(provider credentials.Provider, opts Options, key map[string]interface{}) *credentials.Credentials {
+	if opts.CredentialsCacheDir == "" {
+		return credentials.NewCredentials(provider)
+	}
+	return credentials.NewFileCacheCredentials(provider,
+		filepath.Join(opts.CredentialsCacheDir, credentials.FileCacheKey(key)))
+}
+
+// Copy returns a new Session with a copy of the Session's Handlers, and its
+// Config merged with config. Use Copy to create a Session with configuration
+// that differs slightly from an existing Session.
+func (s *Session) Copy(config *aws.Config) *Session {
+	return &Session{
+		Config:       s.Config.Merge(config),
+		Handlers:     s.Handlers.Copy(),
+		SharedConfig: s.SharedConfig,
+	}
+}
+
+// ClientConfig returns the configuration a service client should be created
+// with, merging config on top of the Session's Config.
+func (s *Session) ClientConfig(config *aws.Config) *aws.Config {
+	return s.Config.Merge(config)
+}
+
+// ClientHandlers returns the Session's Handlers, which will be added to the
+// handlers of service clients created from the Session.
+func (s *Session) ClientHandlers() aws.Handlers {
+	return s.Handlers
+}
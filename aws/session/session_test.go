@@ -0,0 +1,479 @@
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	// Stub the EC2 metadata service, so sessions without a region do not
+	// query the metadata service of the host.
+	server := httptest.NewServer(http.NotFoundHandler())
+	ec2MetadataClient.Endpoint = server.URL + "/latest"
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+func TestNew(t *testing.T) {
+	s := New(&aws.Config{Region: "us-west-2"})
+	assert.Equal(t, "us-west-2", s.Config.Region)
+	assert.Equal(t, aws.DefaultConfig.Credentials, s.Config.Credentials)
+	assert.Equal(t, aws.DefaultConfig.HTTPClient, s.Config.HTTPClient)
+}
+
+func TestNewNilConfig(t *testing.T) {
+	s := New(nil)
+	assert.Equal(t, aws.DefaultConfig.Region, s.Config.Region)
+	assert.Equal(t, aws.DefaultConfig.MaxRetries, s.Config.MaxRetries)
+}
+
+func TestCopy(t *testing.T) {
+	s := New(&aws.Config{Region: "us-west-2"})
+	s.Handlers.Send.PushBack(func(r *aws.Request) {})
+
+	c := s.Copy(&aws.Config{Region: "us-east-1", MaxRetries: 5})
+	assert.Equal(t, "us-east-1", c.Config.Region)
+	assert.Equal(t, 5, c.Config.MaxRetries)
+	assert.Equal(t, "us-west-2", s.Config.Region)
+	assert.Equal(t, 1, c.Handlers.Send.Len())
+
+	c.Handlers.Send.PushBack(func(r *aws.Request) {})
+	assert.Equal(t, 2, c.Handlers.Send.Len())
+	assert.Equal(t, 1, s.Handlers.Send.Len())
+}
+
+func TestClientConfig(t *testing.T) {
+	s := New(&aws.Config{Region: "us-west-2"})
+
+	cfg := s.ClientConfig(&aws.Config{Endpoint: "localhost"})
+	assert.Equal(t, "us-west-2", cfg.Region)
+	assert.Equal(t, "localhost", cfg.Endpoint)
+	assert.Equal(t, "", s.Config.Endpoint)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+const assumeRoleRespMsg = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>assumedAccessKey</AccessKeyId>
+      <SecretAccessKey>assumedSecret</SecretAccessKey>
+      <SessionToken>assumedToken</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`
+
+func TestNewAssumeRoleProfile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "../credentials/example.ini")
+	os.Setenv("AWS_PROFILE", "assume_role_no_mfa")
+
+	var reqBody, auth string
+	s := New(&aws.Config{
+		Region:     "us-west-2",
+		MaxRetries: 0,
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(r.Body)
+			reqBody, auth = string(b), r.Header.Get("Authorization")
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(assumeRoleRespMsg))),
+			}, nil
+		})},
+	})
+
+	creds, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "assumedAccessKey", creds.AccessKeyID)
+	assert.Equal(t, "assumedSecret", creds.SecretAccessKey)
+	assert.Equal(t, "assumedToken", creds.SessionToken)
+
+	assert.Contains(t, reqBody, "Action=AssumeRole")
+	assert.Contains(t, reqBody, "RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Fadmin")
+	assert.True(t, strings.Contains(auth, "Credential=accessKey/"), auth)
+}
+
+func TestNewAssumeRoleProfileMFA(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "../credentials/example.ini")
+	os.Setenv("AWS_PROFILE", "assume_role")
+
+	s := New(nil)
+	_, err := s.Config.Credentials.Get()
+	assert.Equal(t, stscreds.ErrTokenProviderNotSet, err)
+}
+
+func TestNewWithOptionsAssumeRoleTokenProvider(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "../credentials/example.ini")
+	os.Setenv("AWS_PROFILE", "assume_role")
+
+	var reqBody string
+	s := NewWithOptions(Options{
+		Config: &aws.Config{
+			Region:     "us-west-2",
+			MaxRetries: 0,
+			HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				b, _ := ioutil.ReadAll(r.Body)
+				reqBody = string(b)
+				return &http.Response{
+					StatusCode: 200,
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(assumeRoleRespMsg))),
+				}, nil
+			})},
+		},
+		AssumeRoleTokenProvider: func() (string, error) { return "123456", nil },
+	})
+
+	creds, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "assumedAccessKey", creds.AccessKeyID)
+
+	assert.Contains(t, reqBody, "SerialNumber=arn%3Aaws%3Aiam%3A%3A123456789012%3Amfa%2Fuser")
+	assert.Contains(t, reqBody, "TokenCode=123456")
+}
+
+const assumeRoleWithWebIdentityRespMsg = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>webAccessKey</AccessKeyId>
+      <SecretAccessKey>webSecret</SecretAccessKey>
+      <SessionToken>webToken</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`
+
+func TestNewWebIdentityCredentials(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "assume_role_no_mfa")
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "example_web_identity_token")
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/web")
+	os.Setenv("AWS_ROLE_SESSION_NAME", "web-session")
+
+	var reqBody, auth string
+	s := New(&aws.Config{
+		Region:     "us-west-2",
+		MaxRetries: 0,
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(r.Body)
+			reqBody, auth = string(b), r.Header.Get("Authorization")
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(assumeRoleWithWebIdentityRespMsg))),
+			}, nil
+		})},
+	})
+
+	creds, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "webAccessKey", creds.AccessKeyID)
+	assert.Equal(t, "webSecret", creds.SecretAccessKey)
+	assert.Equal(t, "webToken", creds.SessionToken)
+
+	assert.Contains(t, reqBody, "Action=AssumeRoleWithWebIdentity")
+	assert.Contains(t, reqBody, "RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Fweb")
+	assert.Contains(t, reqBody, "RoleSessionName=web-session")
+	assert.Contains(t, reqBody, "WebIdentityToken=example-token")
+	assert.Empty(t, auth)
+}
+
+// testPath is the PATH the credential process' shell is looked up in,
+// captured before the tests clear the environment.
+var testPath = os.Getenv("PATH")
+
+func TestNewCredentialProcessProfile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PATH", testPath)
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "credential_process")
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	s := New(nil)
+	creds, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "processAccessKey", creds.AccessKeyID)
+	assert.Equal(t, "processSecret", creds.SecretAccessKey)
+	assert.False(t, s.Config.Credentials.IsExpired())
+}
+
+const getRoleCredentialsRespMsg = `{"roleCredentials": {
+  "accessKeyId": "ssoAccessKey",
+  "secretAccessKey": "ssoSecret",
+  "sessionToken": "ssoToken",
+  "expiration": 4102444800000
+}}`
+
+func TestNewSSOProfile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HOME", "testdata")
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "sso")
+
+	var reqURL, reqToken string
+	s := New(&aws.Config{
+		Region:     "us-west-2",
+		MaxRetries: 0,
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			reqURL, reqToken = r.URL.String(), r.Header.Get("x-amz-sso_bearer_token")
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(getRoleCredentialsRespMsg))),
+			}, nil
+		})},
+	})
+
+	creds, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "ssoAccessKey", creds.AccessKeyID)
+	assert.Equal(t, "ssoSecret", creds.SecretAccessKey)
+	assert.Equal(t, "ssoToken", creds.SessionToken)
+	assert.False(t, s.Config.Credentials.IsExpired())
+
+	assert.Equal(t, "https://portal.sso.us-east-1.amazonaws.com/federation/credentials?account_id=123456789012&role_name=ReadOnly", reqURL)
+	assert.Equal(t, "ssoAccessToken", reqToken)
+	assert.Equal(t, "us-west-2", s.Config.Region)
+}
+
+// assumeRoleTransport responds to each AssumeRole request with credentials
+// whose access key is the name of the role, recording the role ARN and
+// access key of each request.
+type assumeRoleTransport struct {
+	roles, auths []string
+}
+
+func (tr *assumeRoleTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	b, _ := ioutil.ReadAll(r.Body)
+	values, _ := url.ParseQuery(string(b))
+	role := values.Get("RoleArn")
+	tr.roles = append(tr.roles, role)
+	auth := r.Header.Get("Authorization")
+	tr.auths = append(tr.auths, auth[strings.Index(auth, "Credential=")+len("Credential="):strings.Index(auth, "/")])
+
+	resp := strings.Replace(assumeRoleRespMsg, "assumedAccessKey", role[strings.LastIndex(role, "/")+1:], 1)
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(resp)),
+	}, nil
+}
+
+func TestNewAssumeRoleProfileChained(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "../credentials/example.ini")
+	os.Setenv("AWS_PROFILE", "chained")
+
+	tr := &assumeRoleTransport{}
+	s := New(&aws.Config{Region: "us-west-2", MaxRetries: 0, HTTPClient: &http.Client{Transport: tr}})
+
+	creds, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "chained", creds.AccessKeyID)
+
+	assert.Equal(t, []string{"arn:aws:iam::123456789012:role/admin", "arn:aws:iam::123456789012:role/chained"}, tr.roles)
+	assert.Equal(t, []string{"accessKey", "admin"}, tr.auths)
+}
+
+func TestNewAssumeRoleProfileCredentialSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest/meta-data/iam/security-credentials/":
+			fmt.Fprintln(w, "role")
+		case "/latest/meta-data/iam/security-credentials/role":
+			fmt.Fprint(w, `{"AccessKeyId": "ec2AccessKey", "SecretAccessKey": "ec2Secret", "Token": "ec2Token", "Expiration": "2100-01-01T00:00:00Z"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "credential_source")
+	os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL)
+
+	tr := &assumeRoleTransport{}
+	s := New(&aws.Config{Region: "us-west-2", MaxRetries: 0, HTTPClient: &http.Client{Transport: tr}})
+
+	creds, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "ec2", creds.AccessKeyID)
+	assert.Equal(t, []string{"ec2AccessKey"}, tr.auths)
+}
+
+func TestNewAssumeRoleProfileCredentialsCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "session")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "../credentials/example.ini")
+	os.Setenv("AWS_PROFILE", "assume_role_no_mfa")
+
+	tr := &assumeRoleTransport{}
+	for i := 0; i < 2; i++ {
+		s := NewWithOptions(Options{
+			Config:              &aws.Config{Region: "us-west-2", MaxRetries: 0, HTTPClient: &http.Client{Transport: tr}},
+			CredentialsCacheDir: dir,
+		})
+
+		creds, err := s.Config.Credentials.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "admin", creds.AccessKeyID)
+	}
+	assert.Len(t, tr.roles, 1, "Expect the role to be assumed once")
+
+	_, err = os.Stat(filepath.Join(dir, credentials.FileCacheKey(map[string]interface{}{"RoleArn": "arn:aws:iam::123456789012:role/admin"})))
+	assert.NoError(t, err, "Expect the credentials to be cached with the AWS CLI's key")
+}
+
+func TestNewAssumeRoleProfileInvalidSource(t *testing.T) {
+	cases := map[string]string{
+		"credential_source_invalid": "SharedConfigInvalidCredentialSource",
+		"source_collision":          "SharedConfigSourceCollision",
+		"cycle_a":                   "SharedConfigSourceProfileCycle",
+	}
+
+	for profile, code := range cases {
+		os.Clearenv()
+		os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+		os.Setenv("AWS_PROFILE", profile)
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+		s := New(nil)
+		_, err := s.Config.Credentials.Get()
+		if assert.Error(t, err, profile) {
+			assert.Equal(t, code, err.(awserr.Error).Code(), profile)
+		}
+	}
+}
+
+func TestNewAssumeRoleProfileConfigCredentials(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "assume_role_no_mfa")
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	creds := credentials.NewStaticCredentials("AKID", "SECRET", "")
+	s := New(&aws.Config{Credentials: creds})
+	assert.Equal(t, creds, s.Config.Credentials)
+
+	os.Setenv("AWS_ACCESS_KEY_ID", "envAKID")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "envSECRET")
+	s = New(nil)
+	v, err := s.Config.Credentials.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "envAKID", v.AccessKeyID)
+}
+
+func TestNewRegionFromEC2Metadata(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "no_region")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/meta-data/placement/availability-zone" {
+			w.Write([]byte("eu-central-1b"))
+		}
+	}))
+	defer server.Close()
+
+	oldEndpoint := ec2MetadataClient.Endpoint
+	defer func() { ec2MetadataClient.Endpoint = oldEndpoint }()
+	ec2MetadataClient.Endpoint = server.URL + "/latest"
+	ec2Region = &regionCache{}
+	defer func() { ec2Region = &regionCache{} }()
+
+	s := New(nil)
+	assert.Equal(t, "eu-central-1", s.Config.Region)
+
+	// The shared config region takes precedence.
+	os.Setenv("AWS_PROFILE", "assume_role")
+	s = New(nil)
+	assert.Equal(t, "eu-west-1", s.Config.Region)
+
+	os.Setenv("AWS_PROFILE", "no_region")
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	s = New(nil)
+	assert.Equal(t, "", s.Config.Region)
+}
+
+func TestNewRegionFromEC2MetadataCached(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "no_region")
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte("eu-central-1b"))
+	}))
+	defer server.Close()
+
+	oldEndpoint := ec2MetadataClient.Endpoint
+	defer func() { ec2MetadataClient.Endpoint = oldEndpoint }()
+	ec2MetadataClient.Endpoint = server.URL + "/latest"
+	ec2Region = &regionCache{}
+	defer func() { ec2Region = &regionCache{} }()
+
+	assert.Equal(t, "eu-central-1", New(nil).Config.Region)
+	assert.Equal(t, "eu-central-1", New(nil).Config.Region)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "expect the region to be retrieved once")
+}
+
+func TestNewRegionFromEC2MetadataTimeout(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_PROFILE", "no_region")
+
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	oldEndpoint, oldTimeout := ec2MetadataClient.Endpoint, ec2MetadataRegionTimeout
+	defer func() { ec2MetadataClient.Endpoint, ec2MetadataRegionTimeout = oldEndpoint, oldTimeout }()
+	ec2MetadataClient.Endpoint = server.URL + "/latest"
+	ec2MetadataRegionTimeout = 10 * time.Millisecond
+	ec2Region = &regionCache{}
+	defer func() { ec2Region = &regionCache{} }()
+
+	start := time.Now()
+	s := New(nil)
+	assert.Equal(t, "", s.Config.Region)
+	assert.True(t, time.Since(start) < time.Second, "expect the lookup to time out")
+}
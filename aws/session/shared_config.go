@@ -0,0 +1,169 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/vaughan0/go-ini"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+var (
+	// ErrSharedConfigHomeNotFound is returned when the user's home directory
+	// cannot be found to locate the shared config file.
+	//
+	// @readonly
+	ErrSharedConfigHomeNotFound = awserr.New("UserHomeNotFound", "user home directory not found.", nil)
+)
+
+// A SharedConfig represents the configuration values of a single profile in
+// the shared config file, e.g. $HOME/.aws/config.
+//
+// Profile ini file example: $HOME/.aws/config
+//
+//     [default]
+//     region = us-west-2
+//     output = json
+//
+//     [profile admin]
+//     role_arn = arn:aws:iam::123456789012:role/admin
+//     source_profile = default
+//     mfa_serial = arn:aws:iam::123456789012:mfa/user
+//
+//     [profile external]
+//     credential_process = /usr/local/bin/credentials-helper --account dev
+//
+//     [profile sso]
+//     sso_start_url = https://example.awsapps.com/start
+//     sso_region = us-east-1
+//     sso_account_id = 123456789012
+//     sso_role_name = ReadOnly
+type SharedConfig struct {
+	// The name of the profile the values were loaded from.
+	Profile string
+
+	// The region the service clients should send requests to.
+	Region string
+
+	// The ARN of the role to assume.
+	RoleARN string
+
+	// The profile which provides the credentials used to assume RoleARN.
+	SourceProfile string
+
+	// The source of the credentials used to assume RoleARN, if not the
+	// SourceProfile, one of "Environment", "Ec2InstanceMetadata", or
+	// "EcsContainer".
+	CredentialSource string
+
+	// The serial number of the MFA device required to assume RoleARN.
+	MFASerial string
+
+	// The command the profile's credentials are retrieved from, see
+	// credentials.ProcessProvider.
+	CredentialProcess string
+
+	// The start URL of the AWS SSO portal the user signs in to.
+	SSOStartURL string
+
+	// The region of the AWS SSO portal.
+	SSORegion string
+
+	// The ID of the account the SSO role is assumed in.
+	SSOAccountID string
+
+	// The name of the role assigned to the SSO user.
+	SSORoleName string
+
+	// The output format of the AWS CLI. Loaded for completeness, it is not
+	// used by the SDK.
+	Output string
+}
+
+// LoadSharedConfig loads the profile's values from the shared config file.
+//
+// If filename is empty the environment variable "AWS_CONFIG_FILE" will be
+// used, or $HOME/.aws/config if the environment variable is not set. If
+// profile is empty the environment variable "AWS_PROFILE" will be used, or
+// "default" if the environment variable is not set.
+//
+// An error is returned if the file cannot be loaded, or the profile is not
+// present in the file.
+func LoadSharedConfig(filename, profile string) (SharedConfig, error) {
+	filename, err := sharedConfigFilename(filename)
+	if err != nil {
+		return SharedConfig{}, err
+	}
+	profile = sharedConfigProfile(profile)
+
+	f, err := ini.LoadFile(filename)
+	if err != nil {
+		return SharedConfig{}, awserr.New("SharedConfigLoad", "failed to load shared config file", err)
+	}
+
+	// Named profiles in the config file are prefixed with "profile ", except
+	// for the default profile.
+	section, ok := f[profile]
+	if !ok && profile != "default" {
+		section, ok = f["profile "+profile]
+	}
+	if !ok {
+		return SharedConfig{}, awserr.New("SharedConfigProfileNotExists",
+			fmt.Sprintf("shared config profile %s does not exist in %s", profile, filename),
+			nil)
+	}
+
+	return SharedConfig{
+		Profile:           profile,
+		Region:            section["region"],
+		RoleARN:           section["role_arn"],
+		SourceProfile:     section["source_profile"],
+		CredentialSource:  section["credential_source"],
+		MFASerial:         section["mfa_serial"],
+		CredentialProcess: section["credential_process"],
+		SSOStartURL:       section["sso_start_url"],
+		SSORegion:         section["sso_region"],
+		SSOAccountID:      section["sso_account_id"],
+		SSORoleName:       section["sso_role_name"],
+		Output:            section["output"],
+	}, nil
+}
+
+// sharedConfigFilename returns the filename to use to read the AWS shared
+// config.
+//
+// Will return an error if the user's home directory path cannot be found.
+func sharedConfigFilename(filename string) (string, error) {
+	if filename != "" {
+		return filename, nil
+	}
+	if filename = os.Getenv("AWS_CONFIG_FILE"); filename != "" {
+		return filename, nil
+	}
+
+	homeDir := os.Getenv("HOME") // *nix
+	if homeDir == "" {           // Windows
+		homeDir = os.Getenv("USERPROFILE")
+	}
+	if homeDir == "" {
+		return "", ErrSharedConfigHomeNotFound
+	}
+
+	return filepath.Join(homeDir, ".aws", "config"), nil
+}
+
+// sharedConfigProfile returns the shared config profile. If empty will read
+// environment variable "AWS_PROFILE". If that is not set profile will return
+// "default".
+func sharedConfigProfile(profile string) string {
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	return profile
+}
@@ -0,0 +1,94 @@
+package session
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSharedConfigDefaultProfile(t *testing.T) {
+	os.Clearenv()
+
+	cfg, err := LoadSharedConfig("example_config.ini", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "default", cfg.Profile)
+	assert.Equal(t, "us-west-2", cfg.Region)
+	assert.Equal(t, "json", cfg.Output)
+	assert.Empty(t, cfg.RoleARN)
+}
+
+func TestLoadSharedConfigNamedProfile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_PROFILE", "assume_role")
+
+	cfg, err := LoadSharedConfig("example_config.ini", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "assume_role", cfg.Profile)
+	assert.Equal(t, "eu-west-1", cfg.Region)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/admin", cfg.RoleARN)
+	assert.Equal(t, "default", cfg.SourceProfile)
+	assert.Equal(t, "arn:aws:iam::123456789012:mfa/user", cfg.MFASerial)
+}
+
+func TestLoadSharedConfigUnprefixedProfile(t *testing.T) {
+	os.Clearenv()
+
+	cfg, err := LoadSharedConfig("example_config.ini", "no_region")
+	assert.NoError(t, err)
+	assert.Empty(t, cfg.Region)
+	assert.Equal(t, "text", cfg.Output)
+}
+
+func TestLoadSharedConfigEnvFilename(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+
+	cfg, err := LoadSharedConfig("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", cfg.Region)
+}
+
+func TestLoadSharedConfigMissingProfile(t *testing.T) {
+	os.Clearenv()
+
+	_, err := LoadSharedConfig("example_config.ini", "missing")
+	assert.Error(t, err)
+	assert.Equal(t, "SharedConfigProfileNotExists", err.(awserr.Error).Code())
+}
+
+func TestNewSharedConfigRegion(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	origRegion := aws.DefaultConfig.Region
+	defer func() { aws.DefaultConfig.Region = origRegion }()
+	aws.DefaultConfig.Region = ""
+
+	s := New(nil)
+	assert.Equal(t, "us-west-2", s.Config.Region)
+	assert.Equal(t, "us-west-2", s.SharedConfig.Region)
+
+	s = New(&aws.Config{Region: "us-east-1"})
+	assert.Equal(t, "us-east-1", s.Config.Region)
+}
+
+func TestLoadSharedConfigCredentialProcess(t *testing.T) {
+	os.Clearenv()
+
+	cfg, err := LoadSharedConfig("example_config.ini", "credential_process")
+	assert.NoError(t, err)
+	assert.Equal(t, `echo '{"Version": 1, "AccessKeyId": "processAccessKey", "SecretAccessKey": "processSecret"}'`, cfg.CredentialProcess)
+}
+
+func TestLoadSharedConfigSSO(t *testing.T) {
+	os.Clearenv()
+
+	cfg, err := LoadSharedConfig("example_config.ini", "sso")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.awsapps.com/start", cfg.SSOStartURL)
+	assert.Equal(t, "us-east-1", cfg.SSORegion)
+	assert.Equal(t, "123456789012", cfg.SSOAccountID)
+	assert.Equal(t, "ReadOnly", cfg.SSORoleName)
+}
@@ -0,0 +1,134 @@
+// Package v4 signs HTTP requests with AWS Signature Version 4, for clients of
+// AWS services, or of APIs authorized with IAM such as Amazon Elasticsearch
+// Service domains and API Gateway APIs, which have no service client in the
+// SDK.
+//
+// Example:
+//     signer := v4.NewSigner(credentials.NewEnvCredentials())
+//
+//     body := strings.NewReader(`{"query": {"match_all": {}}}`)
+//     req, _ := http.NewRequest("POST", "https://domain.us-west-2.es.amazonaws.com/index/_search", body)
+//     req.Header.Set("Content-Type", "application/json")
+//
+//     if _, err := signer.Sign(req, body, "es", "us-west-2", time.Now()); err != nil {
+//         return err
+//     }
+//     resp, err := http.DefaultClient.Do(req)
+//
+// Service clients can be switched to SigV4a signatures, which are valid in all
+// regions, with UseSigV4a.
+package v4
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/signer/v4"
+)
+
+var errInvalidExpireTime = awserr.New("InvalidPresignExpireTime",
+	"presign expire time must be greater than 0 and at most 7 days", nil)
+
+// A Signer signs HTTP requests with the credentials of its Credentials.
+//
+// Requests are not signed if the Credentials are
+// credentials.AnonymousCredentials.
+type Signer struct {
+	// The credentials requests are signed with.
+	Credentials *credentials.Credentials
+
+	// Customizes how requests are presigned.
+	PresignOptions aws.PresignOptions
+
+	// The context credentials are retrieved with. Defaults to
+	// aws.BackgroundContext().
+	Context aws.Context
+}
+
+// NewSigner returns a Signer signing requests with creds.
+func NewSigner(creds *credentials.Credentials) *Signer {
+	return &Signer{Credentials: creds}
+}
+
+// UseSigV4a switches the service client of svc to sign its requests with
+// SigV4a instead of signature version 4, as requests to multi-region
+// resources must be. SigV4a signatures are asymmetric ECDSA P-256 signatures
+// with the region set "*", valid in all regions. Other clients are not
+// affected.
+//
+// Example:
+//     svc := s3.New(nil)
+//     v4.UseSigV4a(svc.Service)
+func UseSigV4a(svc *aws.Service) {
+	svc.Handlers.Sign.RemoveByName(v4.SignRequestHandler.Name)
+	svc.Handlers.Sign.PushBackNamed(v4.SignV4aRequestHandler)
+}
+
+// Sign signs req for the service and region at signTime, adding the
+// Authorization header. body is the body of req, it is read to compute the
+// payload hash and seeked back to its position. If req has no body it is set
+// to body.
+//
+// The S3 payload hash can be left unsigned by setting the request's
+// X-Amz-Content-Sha256 header to "UNSIGNED-PAYLOAD".
+//
+// Returns the headers which were signed, which the request must be sent with.
+func (s *Signer) Sign(req *http.Request, body io.ReadSeeker, service, region string, signTime time.Time) (http.Header, error) {
+	return s.sign(req, body, service, region, signTime, 0)
+}
+
+// Presign presigns req for the service and region at signTime, adding the
+// signature to the URL's query string. The URL is valid for expireTime, at
+// most aws.MaxPresignExpireTime. See Sign for body.
+//
+// Returns the headers which were signed, which the presigned request must be
+// sent with, other than the Host.
+func (s *Signer) Presign(req *http.Request, body io.ReadSeeker, service, region string, expireTime time.Duration, signTime time.Time) (http.Header, error) {
+	if expireTime <= 0 || expireTime > aws.MaxPresignExpireTime {
+		return nil, errInvalidExpireTime
+	}
+	return s.sign(req, body, service, region, signTime, expireTime)
+}
+
+func (s *Signer) sign(req *http.Request, body io.ReadSeeker, service, region string, signTime time.Time, expireTime time.Duration) (http.Header, error) {
+	if s.Credentials == credentials.AnonymousCredentials {
+		return http.Header{}, nil
+	}
+	if req.Body == nil && body != nil {
+		req.Body = ioutil.NopCloser(body)
+	}
+
+	ctx := s.Context
+	if ctx == nil {
+		ctx = aws.BackgroundContext()
+	}
+	err := v4.SignHTTPRequest(ctx, req, body, s.Credentials, service, region,
+		signTime, expireTime, s.PresignOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := req.URL.Query().Get("X-Amz-SignedHeaders")
+	if expireTime == 0 {
+		auth := req.Header.Get("Authorization")
+		if i := strings.Index(auth, "SignedHeaders="); i >= 0 {
+			signed = strings.SplitN(auth[i+len("SignedHeaders="):], ",", 2)[0]
+		}
+	}
+
+	header := http.Header{}
+	for _, k := range strings.Split(signed, ";") {
+		if k == "" || k == "host" {
+			continue
+		}
+		k = http.CanonicalHeaderKey(k)
+		header[k] = append([]string{}, req.Header[k]...)
+	}
+	return header, nil
+}
@@ -0,0 +1,105 @@
+package v4_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/internal/test/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+func newRequest() *http.Request {
+	req, _ := http.NewRequest("POST", "https://dynamodb.us-east-1.amazonaws.com", nil)
+	req.URL.Opaque = "//example.org/bucket/key-._~,!@#$%^&*()"
+	req.Header.Add("X-Amz-Target", "prefix.Operation")
+	req.Header.Add("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Add("X-Amz-Meta-Other-Header", "some-value=!@#$%^&* (+)")
+	return req
+}
+
+func TestSign(t *testing.T) {
+	signer := v4.NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"))
+	req := newRequest()
+	body := strings.NewReader("{}")
+
+	header, err := signer.Sign(req, body, "dynamodb", "us-east-1", time.Unix(0, 0))
+	assert.NoError(t, err)
+
+	// The same signature as the SDK's service clients sign requests with.
+	expectedSig := "AWS4-HMAC-SHA256 Credential=AKID/19700101/us-east-1/dynamodb/aws4_request, SignedHeaders=host;x-amz-date;x-amz-meta-other-header;x-amz-security-token;x-amz-target, Signature=69ada33fec48180dab153576e4dd80c4e04124f80dda3eccfed8a67c2b91ed5e"
+	assert.Equal(t, expectedSig, req.Header.Get("Authorization"))
+	assert.Equal(t, "19700101T000000Z", header.Get("X-Amz-Date"))
+	assert.Equal(t, "prefix.Operation", header.Get("X-Amz-Target"))
+	assert.Len(t, header, 4)
+
+	b, _ := ioutil.ReadAll(req.Body)
+	assert.Equal(t, "{}", string(b), "Expect the body to be set")
+
+	// Signing again replaces the signature.
+	body.Seek(0, 0)
+	_, err = signer.Sign(req, body, "dynamodb", "us-east-1", time.Unix(60, 0))
+	assert.NoError(t, err)
+	assert.Len(t, req.Header["Authorization"], 1)
+	assert.Equal(t, "19700101T000100Z", req.Header.Get("X-Amz-Date"))
+	assert.NotEqual(t, expectedSig, req.Header.Get("Authorization"))
+}
+
+func TestPresign(t *testing.T) {
+	signer := v4.NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", ""))
+	req, _ := http.NewRequest("GET", "https://execute-api.us-west-2.amazonaws.com/stage/resource?a=b", nil)
+
+	header, err := signer.Presign(req, nil, "execute-api", "us-west-2", 5*time.Minute, time.Unix(0, 0))
+	assert.NoError(t, err)
+	assert.Len(t, header, 0)
+
+	q := req.URL.Query()
+	assert.Equal(t, "b", q.Get("a"))
+	assert.Equal(t, "AKID/19700101/us-west-2/execute-api/aws4_request", q.Get("X-Amz-Credential"))
+	assert.Equal(t, "300", q.Get("X-Amz-Expires"))
+	assert.Equal(t, "host", q.Get("X-Amz-SignedHeaders"))
+	assert.NotEmpty(t, q.Get("X-Amz-Signature"))
+	assert.Empty(t, req.Header.Get("Authorization"))
+
+	_, err = signer.Presign(req, nil, "execute-api", "us-west-2", 8*24*time.Hour, time.Unix(0, 0))
+	assert.Error(t, err)
+}
+
+func TestSignAnonymous(t *testing.T) {
+	signer := v4.NewSigner(credentials.AnonymousCredentials)
+	req := newRequest()
+	_, err := signer.Sign(req, nil, "dynamodb", "us-east-1", time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, req.Header.Get("Authorization"))
+}
+
+func TestUseSigV4a(t *testing.T) {
+	svc := s3.New(nil)
+	v4.UseSigV4a(svc.Service)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		auth := r.HTTPRequest.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(auth, "AWS4-ECDSA-P256-SHA256 Credential=AKID/"), auth)
+		assert.Contains(t, auth, "/s3/aws4_request")
+		assert.Equal(t, "*", r.HTTPRequest.Header.Get("X-Amz-Region-Set"))
+
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(""))}
+	})
+
+	_, err := svc.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+
+	// Other clients are not affected.
+	other := s3.New(nil)
+	req, _ := other.HeadBucketRequest(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, req.Sign())
+	assert.True(t, strings.HasPrefix(req.HTTPRequest.Header.Get("Authorization"), "AWS4-HMAC-SHA256 "))
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// A Tracer traces the API calls of the requests sent by service clients, and
+// each attempt of the calls, as spans, e.g. to adapt an OpenTelemetry or
+// OpenTracing tracer to the SDK. The tracer is called by every request of
+// the clients created with its Config, so it must be safe for concurrent use.
+//
+// A span is started for each API call with the request's context, and a span
+// for each attempt of the call is started with the context returned for the
+// call's span. The HTTP request of the attempt is sent with the context
+// returned for the attempt's span, so the spans of instrumented HTTP clients
+// are children of the attempt's span.
+//
+// Example:
+//     type otelTracer struct{ tracer trace.Tracer }
+//
+//     func (t otelTracer) StartSpan(ctx aws.Context, name string, attrs map[string]interface{}) aws.Context {
+//         ctx, _ = t.tracer.Start(ctx, name, trace.WithAttributes(toKeyValues(attrs)...))
+//         return ctx
+//     }
+//
+//     func (t otelTracer) EndSpan(ctx aws.Context, attrs map[string]interface{}, err error) {
+//         span := trace.SpanFromContext(ctx)
+//         span.SetAttributes(toKeyValues(attrs)...)
+//         if err != nil {
+//             span.RecordError(err)
+//         }
+//         span.End()
+//     }
+type Tracer interface {
+	// StartSpan starts a span named name with the attributes, returning a
+	// copy of ctx with the span.
+	StartSpan(ctx Context, name string, attrs map[string]interface{}) Context
+
+	// EndSpan ends the span of ctx, a context returned by StartSpan, adding
+	// the attributes to the span. err is the error the span failed with, or
+	// nil if it succeeded.
+	EndSpan(ctx Context, attrs map[string]interface{}, err error)
+}
+
+// The names and attributes of the spans started for API calls and their
+// attempts.
+const (
+	// The name of the span of each attempt of an API call.
+	AttemptSpanName = "Attempt"
+
+	// The attributes of API call spans, and of attempt spans. The name of an
+	// API call's span is its service and operation, e.g. "S3.GetObject".
+	SpanAttributeService    = "rpc.service"
+	SpanAttributeOperation  = "rpc.method"
+	SpanAttributeRegion     = "aws.region"
+	SpanAttributeRequestID  = "aws.request_id"
+	SpanAttributeStatusCode = "http.status_code"
+	SpanAttributeErrorCode  = "aws.error_code"
+
+	// The number of times the call was retried, an attribute of API call
+	// spans when they end.
+	SpanAttributeRetryCount = "aws.retry_count"
+
+	// The number of the attempt, starting with 1 for the first attempt, an
+	// attribute of attempt spans.
+	SpanAttributeAttempt = "aws.attempt"
+)
+
+// startCallSpan starts the span of the request's API call, if the Config
+// has a Tracer.
+func (r *Request) startCallSpan() {
+	tracer := r.Service.Config.Tracer
+	if tracer == nil {
+		return
+	}
+
+	r.callSpan = tracer.StartSpan(r.Context(), r.ServiceID+"."+r.Operation.Name, map[string]interface{}{
+		SpanAttributeService:   r.ServiceID,
+		SpanAttributeOperation: r.Operation.Name,
+		SpanAttributeRegion:    r.Config.Region,
+	})
+}
+
+// endCallSpan ends the span of the request's API call, if it was started.
+func (r *Request) endCallSpan() {
+	if r.callSpan == nil {
+		return
+	}
+
+	attrs := r.spanResultAttributes()
+	attrs[SpanAttributeRetryCount] = r.RetryCount
+	r.Service.Config.Tracer.EndSpan(r.callSpan, attrs, r.Error)
+	r.callSpan = nil
+}
+
+// startAttemptSpan starts the span of the request's next attempt in the span
+// of its API call, if the call's span was started.
+func (r *Request) startAttemptSpan() {
+	if r.callSpan == nil {
+		return
+	}
+
+	r.attemptSpan = r.Service.Config.Tracer.StartSpan(r.callSpan, AttemptSpanName, map[string]interface{}{
+		SpanAttributeService:   r.ServiceID,
+		SpanAttributeOperation: r.Operation.Name,
+		SpanAttributeAttempt:   r.RetryCount + 1,
+	})
+}
+
+// endAttemptSpan ends the span of the request's last attempt, if it was
+// started.
+func (r *Request) endAttemptSpan() {
+	if r.attemptSpan == nil {
+		return
+	}
+
+	r.Service.Config.Tracer.EndSpan(r.attemptSpan, r.spanResultAttributes(), r.Error)
+	r.attemptSpan = nil
+}
+
+// spanResultAttributes returns the attributes of the request's last response
+// and error, added to its spans when they end.
+func (r *Request) spanResultAttributes() map[string]interface{} {
+	attrs := map[string]interface{}{}
+	if r.RequestID != "" {
+		attrs[SpanAttributeRequestID] = r.RequestID
+	}
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 0 {
+		attrs[SpanAttributeStatusCode] = r.HTTPResponse.StatusCode
+	}
+	if r.Error != nil {
+		attrs[SpanAttributeErrorCode] = "UnknownError"
+		if err, ok := r.Error.(awserr.Error); ok {
+			attrs[SpanAttributeErrorCode] = err.Code()
+		}
+	}
+	return attrs
+}
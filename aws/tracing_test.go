@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type span struct {
+	name   string
+	parent *span
+	start  map[string]interface{}
+	end    map[string]interface{}
+	err    error
+	ended  bool
+}
+
+type spanKey struct{}
+
+type recordingTracer struct {
+	sync.Mutex
+	spans []*span
+}
+
+func (t *recordingTracer) StartSpan(ctx Context, name string, attrs map[string]interface{}) Context {
+	t.Lock()
+	defer t.Unlock()
+
+	s := &span{name: name, start: attrs}
+	s.parent, _ = ctx.Value(spanKey{}).(*span)
+	t.spans = append(t.spans, s)
+	return context.WithValue(ctx, spanKey{}, s)
+}
+
+func (t *recordingTracer) EndSpan(ctx Context, attrs map[string]interface{}, err error) {
+	s := ctx.Value(spanKey{}).(*span)
+	s.end, s.err, s.ended = attrs, err, true
+}
+
+var testTracer = &recordingTracer{}
+
+func TestTracerSpans(t *testing.T) {
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	tracer := &recordingTracer{}
+	var sentSpans []*span
+	s := NewService(&Config{Region: "mock-region", MaxRetries: 10, RetryBaseDelay: time.Millisecond, Tracer: tracer})
+	s.ServiceID = "Mock"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		sentSpans = append(sentSpans, r.attemptSpan.Value(spanKey{}).(*span))
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+
+	if !assert.Len(t, tracer.spans, 3) {
+		return
+	}
+	call, attempts := tracer.spans[0], tracer.spans[1:]
+
+	assert.Equal(t, "Mock.Operation", call.name)
+	assert.Nil(t, call.parent)
+	assert.Equal(t, map[string]interface{}{
+		SpanAttributeService:   "Mock",
+		SpanAttributeOperation: "Operation",
+		SpanAttributeRegion:    "mock-region",
+	}, call.start)
+	assert.True(t, call.ended)
+	assert.Equal(t, uint(1), call.end[SpanAttributeRetryCount])
+	assert.Equal(t, 200, call.end[SpanAttributeStatusCode])
+	assert.Nil(t, call.err)
+
+	assert.Equal(t, sentSpans, attempts)
+	for i, a := range attempts {
+		assert.Equal(t, AttemptSpanName, a.name)
+		assert.Equal(t, call, a.parent)
+		assert.Equal(t, uint(i+1), a.start[SpanAttributeAttempt])
+		assert.True(t, a.ended)
+	}
+	assert.Equal(t, 500, attempts[0].end[SpanAttributeStatusCode])
+	assert.Equal(t, "UnknownError", attempts[0].end[SpanAttributeErrorCode])
+	assert.Error(t, attempts[0].err)
+	assert.Equal(t, 200, attempts[1].end[SpanAttributeStatusCode])
+	assert.Nil(t, attempts[1].end[SpanAttributeErrorCode])
+	assert.Nil(t, attempts[1].err)
+}
+
+func TestTracerSpanFailed(t *testing.T) {
+	tracer := &recordingTracer{}
+	s := NewService(&Config{MaxRetries: 0, Tracer: tracer})
+	s.Handlers.Validate.Clear()
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 400, Body: body(`{"__type":"ValidationError","message":"Invalid."}`)}
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.Error(t, r.Send())
+
+	if !assert.Len(t, tracer.spans, 2) {
+		return
+	}
+	for _, sp := range tracer.spans {
+		assert.True(t, sp.ended)
+		assert.Equal(t, r.Error, sp.err)
+		assert.Equal(t, "ValidationError", sp.end[SpanAttributeErrorCode])
+		assert.Equal(t, 400, sp.end[SpanAttributeStatusCode])
+	}
+}
+
+// contextTransport records the context of the requests it sends.
+type contextTransport struct {
+	ctx Context
+}
+
+func (t *contextTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.ctx = r.Context()
+	return &http.Response{StatusCode: 200, Body: body(``)}, nil
+}
+
+func TestSendWithAttemptSpan(t *testing.T) {
+	tracer := &recordingTracer{}
+	transport := &contextTransport{}
+	s := NewService(&Config{Tracer: tracer, AttemptTimeout: time.Minute, HTTPClient: &http.Client{Transport: transport}})
+	s.Handlers.Validate.Clear()
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+
+	if assert.Len(t, tracer.spans, 2) {
+		assert.Equal(t, tracer.spans[1], transport.ctx.Value(spanKey{}))
+	}
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// transientErrorMessages are substrings of error messages returned by the
+// network stack for connection failures which are safe to retry, but are not
+// exposed as a typed error on every platform.
+var transientErrorMessages = []string{
+	"connection reset",
+	"broken pipe",
+	"use of closed network connection",
+	"unexpected EOF",
+}
+
+// isErrTransient returns if the error is a transient network error, such as a
+// connection reset, unexpected EOF, or a temporary DNS failure. The request
+// failing with a transient error is expected to succeed if retried.
+//
+// url.Error and awserr.Error values are unwrapped to their original errors.
+func isErrTransient(err error) bool {
+	switch e := err.(type) {
+	case nil:
+		return false
+	case *url.Error:
+		return isErrTransient(e.Err)
+	case awserr.Error:
+		return isErrTransient(e.OrigErr())
+	case *net.DNSError:
+		return e.IsTemporary || e.IsTimeout
+	case *net.OpError:
+		if dnsErr, ok := e.Err.(*net.DNSError); ok {
+			// Hosts which do not exist will not exist when retried either.
+			return isErrTransient(dnsErr)
+		}
+		if e.Op == "dial" {
+			// connection refused, and unreachable hosts are transient for
+			// dials, the service may be in the middle of being replaced.
+			return true
+		}
+		return isErrTransient(e.Err)
+	case syscall.Errno:
+		return e == syscall.ECONNRESET || e == syscall.ECONNABORTED ||
+			e == syscall.ECONNREFUSED || e == syscall.EPIPE
+	}
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if e, ok := err.(net.Error); ok && e.Timeout() {
+		return true
+	}
+
+	msg := err.Error()
+	for _, m := range transientErrorMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsErrTransient(t *testing.T) {
+	cases := []struct {
+		err       error
+		transient bool
+	}{
+		{nil, false},
+		{io.EOF, true},
+		{io.ErrUnexpectedEOF, true},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: io.EOF}, true},
+		{&net.OpError{Op: "read", Err: syscall.ECONNRESET}, true},
+		{&net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, true},
+		{&net.DNSError{Err: "server misbehaving", IsTemporary: true}, true},
+		{&net.DNSError{Err: "no such host"}, false},
+		{&net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "bucket.example.com"}}, false},
+		{&net.OpError{Op: "dial", Err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}}, true},
+		{awserr.New("RequestError", "send request failed", io.ErrUnexpectedEOF), true},
+		{errors.New("read tcp 10.0.0.1:443: connection reset by peer"), true},
+		{errors.New("x509: certificate signed by unknown authority"), false},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: errors.New("unsupported protocol scheme")}, false},
+	}
+
+	for i, c := range cases {
+		assert.Equal(t, c.transient, isErrTransient(c.err), "case %d, %v", i, c.err)
+	}
+}
+
+type errTransport struct {
+	err error
+}
+
+func (t errTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+func TestSendHandlerTransientErrorRetryable(t *testing.T) {
+	client := &http.Client{Transport: errTransport{err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}
+	s := NewService(&Config{Endpoint: "https://localhost", HTTPClient: client})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+
+	SendHandler(r)
+	assert.Error(t, r.Error)
+	assert.True(t, r.Retryable.Get())
+
+	s = NewService(&Config{Endpoint: "https://localhost", HTTPClient: client, DisableNetworkErrorRetries: true})
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+
+	SendHandler(r)
+	assert.Error(t, r.Error)
+	assert.True(t, r.Retryable.IsSet())
+	assert.False(t, r.Retryable.Get())
+}
+
+func TestSendHandlerNonTransientErrorNotRetryable(t *testing.T) {
+	client := &http.Client{Transport: errTransport{err: errors.New("x509: certificate signed by unknown authority")}}
+	s := NewService(&Config{Endpoint: "https://localhost", HTTPClient: client})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+
+	SendHandler(r)
+	assert.Error(t, r.Error)
+	assert.False(t, r.Retryable.Get())
+}
+
+func TestShouldRetryUnmarshalTransientError(t *testing.T) {
+	s := NewService(&Config{})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{StatusCode: 200}
+	r.Error = awserr.New("SerializationError", "failed decoding response", io.ErrUnexpectedEOF)
+
+	assert.True(t, shouldRetry(r))
+
+	s.Config.DisableNetworkErrorRetries = true
+	assert.False(t, shouldRetry(r))
+}
+
+func TestShouldRetryRequestErrorNetworkRetriesDisabled(t *testing.T) {
+	s := NewService(&Config{})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.Error = awserr.New("RequestError", "send request failed", &net.OpError{Op: "read", Err: syscall.ECONNRESET})
+
+	assert.True(t, shouldRetry(r))
+
+	s.Config.DisableNetworkErrorRetries = true
+	assert.False(t, shouldRetry(r))
+
+	// Non-transient send failures are not retried.
+	s.Config.DisableNetworkErrorRetries = false
+	r.Error = awserr.New("RequestError", "send request failed", errors.New("x509: certificate signed by unknown authority"))
+	assert.False(t, shouldRetry(r))
+}
+
+func TestSendNetworkRetriesDisabled(t *testing.T) {
+	sleepDelay = func(ctx Context, delay time.Duration) error { return nil }
+
+	attempts := 0
+	client := &http.Client{Transport: errTransport{err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}
+	s := NewService(&Config{Endpoint: "https://localhost", HTTPClient: client, MaxRetries: 3,
+		DisableNetworkErrorRetries: true})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.PushFront(func(r *Request) { attempts++ })
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "RequestError", err.(awserr.Error).Code())
+	assert.Equal(t, 0, int(r.RetryCount))
+	assert.Equal(t, 1, attempts)
+
+	s.Config.DisableNetworkErrorRetries = false
+	attempts = 0
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.Error(t, r.Send())
+	assert.Equal(t, 3, int(r.RetryCount))
+	assert.Equal(t, 4, attempts)
+}
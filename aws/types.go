@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"time"
 )
 
@@ -31,15 +33,46 @@ func Time(t time.Time) *time.Time {
 	return &t
 }
 
+// MaxReadSeekCloserBuffer is the most bytes a ReaderSeekerCloser of a reader
+// which is not an io.Seeker will buffer to be able to seek back over them.
+const MaxReadSeekCloserBuffer = 5 * 1024 * 1024
+
+var (
+	errBodyBufferExceeded = fmt.Errorf("cannot seek reader body larger than %d bytes, use an io.ReadSeeker",
+		MaxReadSeekCloserBuffer)
+	errSeekBeforeBuffer = errors.New("cannot seek reader body before the first seek's offset")
+)
+
 // ReadSeekCloser wraps a io.Reader returning a ReaderSeakerCloser
 func ReadSeekCloser(r io.Reader) ReaderSeekerCloser {
-	return ReaderSeekerCloser{r}
+	rsc := ReaderSeekerCloser{r: r}
+	if _, ok := r.(io.Seeker); !ok && r != nil {
+		rsc.buf = &seekBuffer{}
+	}
+	return rsc
 }
 
 // ReaderSeekerCloser represents a reader that can also delegate io.Seeker and
 // io.Closer interfaces to the underlying object if they are available.
+//
+// If the reader is not an io.Seeker, e.g. a pipe or a network stream, the
+// bytes read after the first Seek are buffered in memory, so that the reader
+// can be seeked back over them. Passing such a reader as the body of a request
+// allows the body to be sent again when the request is retried. Bodies larger
+// than MaxReadSeekCloserBuffer cannot be seeked, and fail the request.
 type ReaderSeekerCloser struct {
-	r io.Reader
+	r   io.Reader
+	buf *seekBuffer
+}
+
+// A seekBuffer is the bytes of a reader which is not an io.Seeker, read from
+// the offset of the first Seek.
+type seekBuffer struct {
+	data     []byte
+	start    int64 // offset of the reader the data starts at
+	offset   int64 // offset of the reader the next Read reads at
+	seeked   bool
+	exceeded bool
 }
 
 // Read reads from the reader up to size of p. The number of bytes read, and
@@ -49,6 +82,21 @@ type ReaderSeekerCloser struct {
 //
 // Performs the same functionality as io.Reader Read
 func (r ReaderSeekerCloser) Read(p []byte) (int, error) {
+	if b := r.buf; b != nil {
+		if i := b.offset - b.start; b.seeked && i < int64(len(b.data)) {
+			n := copy(p, b.data[i:])
+			b.offset += int64(n)
+			return n, nil
+		}
+
+		n, err := r.r.Read(p)
+		if b.seeked {
+			b.write(p[:n])
+		}
+		b.offset += int64(n)
+		return n, err
+	}
+
 	switch t := r.r.(type) {
 	case io.Reader:
 		return t.Read(p)
@@ -61,8 +109,14 @@ func (r ReaderSeekerCloser) Read(p []byte) (int, error) {
 // current offset, and 2 means relative to the end. Seek returns the new offset
 // and an error, if any.
 //
-// If the ReaderSeekerCloser is not an io.Seeker nothing will be done.
+// If the ReaderSeekerCloser is not an io.Seeker the reader is buffered from the
+// offset of the first Seek, and can only be seeked within the buffered bytes.
+// Seeking relative to the end reads the rest of the reader into the buffer.
 func (r ReaderSeekerCloser) Seek(offset int64, whence int) (int64, error) {
+	if b := r.buf; b != nil {
+		return b.seek(r.r, offset, whence)
+	}
+
 	switch t := r.r.(type) {
 	case io.Seeker:
 		return t.Seek(offset, whence)
@@ -81,6 +135,80 @@ func (r ReaderSeekerCloser) Close() error {
 	return nil
 }
 
+func (b *seekBuffer) seek(r io.Reader, offset int64, whence int) (int64, error) {
+	if b.exceeded {
+		if whence == 1 && offset == 0 {
+			return b.offset, nil
+		}
+		return 0, errBodyBufferExceeded
+	}
+	if !b.seeked {
+		b.seeked = true
+		b.start = b.offset
+	}
+
+	end := func() int64 { return b.start + int64(len(b.data)) }
+
+	var pos int64
+	switch whence {
+	case 0:
+		pos = offset
+	case 1:
+		pos = b.offset + offset
+	case 2:
+		if err := b.fill(r, -1); err != nil {
+			return 0, err
+		}
+		pos = end() + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if pos < b.start {
+		return 0, errSeekBeforeBuffer
+	}
+	if pos > end() {
+		if err := b.fill(r, pos-end()); err != nil {
+			return 0, err
+		}
+	}
+
+	b.offset = pos
+	return pos, nil
+}
+
+// fill reads up to n bytes, or the rest of the reader if n is negative, into
+// the buffer.
+func (b *seekBuffer) fill(r io.Reader, n int64) error {
+	limit := MaxReadSeekCloserBuffer - int64(len(b.data)) + 1
+	if n >= 0 && n < limit {
+		limit = n
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(r, limit))
+	b.write(data)
+	if err != nil {
+		return err
+	}
+	if b.exceeded {
+		return errBodyBufferExceeded
+	}
+	return nil
+}
+
+// write appends p to the buffer, unless the buffer would exceed
+// MaxReadSeekCloserBuffer, in which case the buffer is discarded.
+func (b *seekBuffer) write(p []byte) {
+	if b.exceeded {
+		return
+	}
+	if int64(len(b.data)+len(p)) > MaxReadSeekCloserBuffer {
+		b.data, b.exceeded = nil, true
+		return
+	}
+	b.data = append(b.data, p...)
+}
+
 // A SettableBool provides a boolean value which includes the state if
 // the value was set or unset.  The set state is in addition to the value's
 // value(true|false)
@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A readerOnly hides the io.Seeker of a reader, like a pipe or network stream.
+type readerOnly struct {
+	io.Reader
+}
+
+func TestReadSeekCloserSeeker(t *testing.T) {
+	r := ReadSeekCloser(bytes.NewReader([]byte("abcdef")))
+	assert.Nil(t, r.buf)
+
+	n, err := r.Seek(2, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	b, _ := ioutil.ReadAll(r)
+	assert.Equal(t, "cdef", string(b))
+}
+
+func TestReadSeekCloserBuffersReader(t *testing.T) {
+	r := ReadSeekCloser(readerOnly{bytes.NewReader([]byte("abcdef"))})
+
+	start, err := r.Seek(0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), start)
+
+	end, err := r.Seek(0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), end)
+
+	_, err = r.Seek(start, 0)
+	assert.NoError(t, err)
+	b, _ := ioutil.ReadAll(r)
+	assert.Equal(t, "abcdef", string(b))
+
+	_, err = r.Seek(3, 0)
+	assert.NoError(t, err)
+	b, _ = ioutil.ReadAll(r)
+	assert.Equal(t, "def", string(b))
+}
+
+func TestReadSeekCloserBuffersFromFirstSeek(t *testing.T) {
+	r := ReadSeekCloser(readerOnly{bytes.NewReader([]byte("abcdef"))})
+
+	p := make([]byte, 2)
+	r.Read(p)
+	assert.Equal(t, "ab", string(p))
+
+	start, err := r.Seek(0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), start)
+
+	b, _ := ioutil.ReadAll(r)
+	assert.Equal(t, "cdef", string(b))
+
+	_, err = r.Seek(0, 0)
+	assert.Equal(t, errSeekBeforeBuffer, err)
+
+	_, err = r.Seek(start, 0)
+	assert.NoError(t, err)
+	b, _ = ioutil.ReadAll(r)
+	assert.Equal(t, "cdef", string(b))
+}
+
+func TestReadSeekCloserBufferExceeded(t *testing.T) {
+	data := bytes.Repeat([]byte{'a'}, MaxReadSeekCloserBuffer+1)
+	r := ReadSeekCloser(readerOnly{bytes.NewReader(data)})
+
+	_, err := r.Seek(0, 2)
+	assert.Equal(t, errBodyBufferExceeded, err)
+
+	_, err = r.Seek(0, 0)
+	assert.Equal(t, errBodyBufferExceeded, err)
+}
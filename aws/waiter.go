@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+)
+
+// ErrCodeResourceNotReady is the awserr.Error code for waiters which stopped
+// waiting because their resource reached a failure state, or did not reach
+// the success state within the waiter's attempts.
+const ErrCodeResourceNotReady = "ResourceNotReady"
+
+// A WaiterState is the state of a waiter's resource an acceptor matches.
+type WaiterState int
+
+// The states of a waiter's resource. The waiter stops waiting once its
+// resource reaches the success or failure state, and polls the resource
+// again if it is in the retry state.
+const (
+	SuccessWaiterState WaiterState = iota
+	FailureWaiterState
+	RetryWaiterState
+)
+
+// A WaiterMatchMode is how an acceptor matches the result of a waiter's
+// request.
+type WaiterMatchMode string
+
+// The modes acceptors match the result of a waiter's request with.
+const (
+	// The value at the acceptor's Argument path of the output is Expected.
+	PathWaiterMatch WaiterMatchMode = "path"
+
+	// All the values at the Argument path of the output are Expected, and
+	// there is at least one value.
+	PathAllWaiterMatch WaiterMatchMode = "pathAll"
+
+	// Any of the values at the Argument path of the output is Expected.
+	PathAnyWaiterMatch WaiterMatchMode = "pathAny"
+
+	// The status code of the response is Expected.
+	StatusWaiterMatch WaiterMatchMode = "status"
+
+	// The request failed with an awserr.Error whose code is Expected.
+	ErrorWaiterMatch WaiterMatchMode = "error"
+)
+
+// A WaiterAcceptor matches the result of a waiter's request to a state of the
+// waiter's resource.
+type WaiterAcceptor struct {
+	State    WaiterState
+	Matcher  WaiterMatchMode
+	Argument string
+	Expected interface{}
+}
+
+// A Waiter polls a resource with a request until it reaches a success or
+// failure state, which are matched from the results of the requests by the
+// waiter's Acceptors. Waiters are generated from the waiter models of the
+// services, e.g. dynamodb's WaitUntilTableExists, and can be customized with
+// WaiterOptions.
+type Waiter struct {
+	Name        string
+	Acceptors   []WaiterAcceptor
+	MaxAttempts int
+	Delay       WaiterDelay
+
+	// The longest the waiter waits for its resource, zero if it waits for up
+	// to MaxAttempts requests however long they take. The waiter stops
+	// waiting instead of delaying a request past MaxDuration.
+	MaxDuration time.Duration
+
+	// Called with each of the waiter's requests, once it was sent, e.g. to
+	// log the waiter's progress, or stop waiting by canceling the waiter's
+	// context.
+	AttemptCallback func(attempt int, r *Request)
+
+	// The options applied to each of the waiter's requests.
+	RequestOptions []Option
+
+	// Returns the request of an attempt of the waiter, with the options
+	// applied.
+	NewRequest func([]Option) *Request
+}
+
+// A WaiterDelay returns how long a waiter waits after the attempt, counted
+// from 1, before polling its resource again.
+type WaiterDelay func(attempt int) time.Duration
+
+// ConstantWaiterDelay returns a WaiterDelay waiting for the delay after each
+// attempt.
+func ConstantWaiterDelay(delay time.Duration) WaiterDelay {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialWaiterDelay returns a WaiterDelay waiting for the base delay
+// after the first attempt, doubling the delay after each following attempt up
+// to the max delay.
+func ExponentialWaiterDelay(base, max time.Duration) WaiterDelay {
+	return func(attempt int) time.Duration {
+		delay := base
+		for i := 1; i < attempt && delay < max; i++ {
+			delay *= 2
+		}
+		if delay > max {
+			delay = max
+		}
+		return delay
+	}
+}
+
+// A WaiterOption is a functional option that modifies a Waiter before it
+// waits. WaiterOptions can be passed to the WaitUntilWithContext variants of
+// service client waiters.
+type WaiterOption func(*Waiter)
+
+// WithWaiterMaxAttempts returns a WaiterOption which sets the number of times
+// the waiter polls its resource before it stops waiting.
+func WithWaiterMaxAttempts(attempts int) WaiterOption {
+	return func(w *Waiter) {
+		w.MaxAttempts = attempts
+	}
+}
+
+// WithWaiterDelay returns a WaiterOption which sets how long the waiter waits
+// between polling its resource, e.g. a ConstantWaiterDelay or an
+// ExponentialWaiterDelay.
+//
+// Example:
+//     err := svc.WaitUntilTableExistsWithContext(ctx, params,
+//         aws.WithWaiterDelay(aws.ExponentialWaiterDelay(time.Second, 30*time.Second)))
+func WithWaiterDelay(delay WaiterDelay) WaiterOption {
+	return func(w *Waiter) {
+		w.Delay = delay
+	}
+}
+
+// WithWaiterMaxDuration returns a WaiterOption which sets the longest the
+// waiter waits for its resource, whatever its number of attempts.
+func WithWaiterMaxDuration(d time.Duration) WaiterOption {
+	return func(w *Waiter) {
+		w.MaxDuration = d
+	}
+}
+
+// WithWaiterAttemptCallback returns a WaiterOption which sets the function
+// called with each of the waiter's requests once it was sent.
+func WithWaiterAttemptCallback(fn func(attempt int, r *Request)) WaiterOption {
+	return func(w *Waiter) {
+		w.AttemptCallback = fn
+	}
+}
+
+// WithWaiterRequestOptions returns a WaiterOption which applies the options to
+// each of the waiter's requests.
+//
+// Example:
+//     err := svc.WaitUntilTableExistsWithContext(ctx, params,
+//         aws.WithWaiterRequestOptions(aws.WithConfig(&aws.Config{MaxRetries: 1})))
+func WithWaiterRequestOptions(opts ...Option) WaiterOption {
+	return func(w *Waiter) {
+		w.RequestOptions = append(w.RequestOptions, opts...)
+	}
+}
+
+// ApplyOptions will apply each option to the waiter, in the order provided.
+func (w *Waiter) ApplyOptions(opts ...WaiterOption) {
+	for _, opt := range opts {
+		opt(w)
+	}
+}
+
+// WaitWithContext polls the waiter's resource until it reaches the success
+// state, returning nil. An awserr.Error with the code ErrCodeResourceNotReady
+// is returned if the resource reaches the failure state, or has not reached
+// the success state after MaxAttempts requests, or within MaxDuration. The
+// error of a request which no acceptor matches is returned, e.g. if the
+// context is canceled while a request is sent.
+//
+// The context must not be nil. Waiting stops if the context is canceled
+// between requests, returning an ErrCodeRequestCanceled awserr.Error.
+func (w Waiter) WaitWithContext(ctx Context) error {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		req := w.NewRequest(w.RequestOptions)
+		err := req.Send()
+		if w.AttemptCallback != nil {
+			w.AttemptCallback(attempt, req)
+		}
+
+		state, ok := w.match(req, err)
+		switch {
+		case ok && state == SuccessWaiterState:
+			return nil
+		case ok && state == FailureWaiterState:
+			return awserr.New(ErrCodeResourceNotReady, "failed waiting for successful resource state", err)
+		case !ok && err != nil:
+			return err
+		}
+
+		if attempt >= w.MaxAttempts {
+			return awserr.New(ErrCodeResourceNotReady, "exceeded "+w.Name+" wait attempts", err)
+		}
+		var delay time.Duration
+		if w.Delay != nil {
+			delay = w.Delay(attempt)
+		}
+		if w.MaxDuration > 0 && time.Since(start)+delay > w.MaxDuration {
+			return awserr.New(ErrCodeResourceNotReady, "exceeded "+w.Name+" max wait duration", err)
+		}
+		if ctx.Err() != nil {
+			return newCanceledError(ctx.Err())
+		}
+		if err := sleepDelay(ctx, delay); err != nil {
+			return newCanceledError(err)
+		}
+	}
+}
+
+// match returns the state of the first acceptor which matches the result of
+// the request, and false if no acceptor matches.
+func (w Waiter) match(req *Request, err error) (WaiterState, bool) {
+	for _, a := range w.Acceptors {
+		if a.matches(req, err) {
+			return a.State, true
+		}
+	}
+	return RetryWaiterState, false
+}
+
+// matches returns true if the request's result matches the acceptor.
+func (a WaiterAcceptor) matches(req *Request, err error) bool {
+	switch a.Matcher {
+	case StatusWaiterMatch:
+		return req.HTTPResponse != nil && waiterValueEqual(req.HTTPResponse.StatusCode, a.Expected)
+	case ErrorWaiterMatch:
+		aerr, ok := err.(awserr.Error)
+		return ok && aerr.Code() == a.Expected
+	}
+
+	if err != nil {
+		return false
+	}
+	vals := awsutil.ValuesAtAnyPath(req.Data, a.Argument)
+	switch a.Matcher {
+	case PathWaiterMatch:
+		return len(vals) == 1 && waiterValueEqual(vals[0], a.Expected)
+	case PathAllWaiterMatch:
+		for _, v := range vals {
+			if !waiterValueEqual(v, a.Expected) {
+				return false
+			}
+		}
+		return len(vals) > 0
+	case PathAnyWaiterMatch:
+		for _, v := range vals {
+			if waiterValueEqual(v, a.Expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// waiterValueEqual returns true if the value is the acceptor's expected
+// value. Numbers are equal if their values are, whatever their types.
+func waiterValueEqual(v, expected interface{}) bool {
+	if n, ok := expected.(int); ok {
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return rv.Int() == int64(n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return n >= 0 && rv.Uint() == uint64(n)
+		case reflect.Float32, reflect.Float64:
+			return rv.Float() == float64(n)
+		}
+		return false
+	}
+	return reflect.DeepEqual(v, expected)
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestWaiter returns a waiter polling with requests which receive the
+// responses, in order, and the delays the waiter waited for.
+func newTestWaiter(resps []*http.Response, acceptors ...WaiterAcceptor) (*Waiter, *[]time.Duration) {
+	s := NewService(&Config{})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	reqNum := 0
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = resps[reqNum]
+		reqNum++
+	})
+
+	delays := []time.Duration{}
+	sleepDelay = func(ctx Context, delay time.Duration) error {
+		delays = append(delays, delay)
+		return ctx.Err()
+	}
+
+	w := &Waiter{
+		Name:        "WaitUntilTestReady",
+		Acceptors:   acceptors,
+		MaxAttempts: len(resps),
+		Delay:       ConstantWaiterDelay(5 * time.Second),
+		NewRequest: func(opts []Option) *Request {
+			r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+			r.ApplyOptions(opts...)
+			return r
+		},
+	}
+	return w, &delays
+}
+
+func TestWaiterSuccess(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, delays := newTestWaiter([]*http.Response{
+		{StatusCode: 404, Body: body(`{"__type":"NotFound","message":"Not found."}`)},
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"ready"}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "Data", Expected: "ready"},
+		WaiterAcceptor{State: RetryWaiterState, Matcher: ErrorWaiterMatch, Expected: "NotFound"},
+	)
+
+	assert.NoError(t, w.WaitWithContext(context.Background()))
+	assert.Equal(t, []time.Duration{5 * time.Second, 5 * time.Second}, *delays)
+}
+
+func TestWaiterFailureState(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, _ := newTestWaiter([]*http.Response{
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"failed"}`)},
+		{StatusCode: 200, Body: body(`{"data":"ready"}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "Data", Expected: "ready"},
+		WaiterAcceptor{State: FailureWaiterState, Matcher: PathAnyWaiterMatch, Argument: "Data", Expected: "failed"},
+	)
+
+	err := w.WaitWithContext(context.Background())
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeResourceNotReady, err.(awserr.Error).Code())
+	}
+}
+
+func TestWaiterMaxAttempts(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, delays := newTestWaiter([]*http.Response{
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "Data", Expected: "ready"},
+	)
+	w.ApplyOptions(WithWaiterMaxAttempts(2), WithWaiterDelay(ConstantWaiterDelay(time.Second)))
+
+	err := w.WaitWithContext(context.Background())
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeResourceNotReady, err.(awserr.Error).Code())
+		assert.Contains(t, err.Error(), "exceeded WaitUntilTestReady wait attempts")
+	}
+	assert.Equal(t, []time.Duration{time.Second}, *delays)
+}
+
+func TestWaiterMaxDuration(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, delays := newTestWaiter([]*http.Response{
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"ready"}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "Data", Expected: "ready"},
+	)
+	w.ApplyOptions(WithWaiterMaxDuration(time.Minute),
+		WithWaiterDelay(ExponentialWaiterDelay(40*time.Second, time.Hour)))
+
+	err := w.WaitWithContext(context.Background())
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeResourceNotReady, err.(awserr.Error).Code())
+		assert.Contains(t, err.Error(), "exceeded WaitUntilTestReady max wait duration")
+	}
+	assert.Equal(t, []time.Duration{40 * time.Second}, *delays, "expect the waiter not to delay past its max duration")
+}
+
+func TestWaiterAttemptCallback(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, delays := newTestWaiter([]*http.Response{
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"ready"}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "Data", Expected: "ready"},
+	)
+	attempts, states := []int{}, []string{}
+	w.ApplyOptions(WithWaiterAttemptCallback(func(attempt int, r *Request) {
+		attempts = append(attempts, attempt)
+		states = append(states, r.Data.(*testData).Data)
+	}))
+
+	assert.NoError(t, w.WaitWithContext(context.Background()))
+	assert.Equal(t, []int{1, 2, 3}, attempts)
+	assert.Equal(t, []string{"pending", "pending", "ready"}, states)
+	assert.Len(t, *delays, 2)
+}
+
+func TestWaiterDelays(t *testing.T) {
+	d := ConstantWaiterDelay(5 * time.Second)
+	assert.Equal(t, 5*time.Second, d(1))
+	assert.Equal(t, 5*time.Second, d(10))
+
+	d = ExponentialWaiterDelay(time.Second, 10*time.Second)
+	assert.Equal(t, time.Second, d(1))
+	assert.Equal(t, 2*time.Second, d(2))
+	assert.Equal(t, 8*time.Second, d(4))
+	assert.Equal(t, 10*time.Second, d(5))
+	assert.Equal(t, 10*time.Second, d(100))
+}
+
+func TestWaiterStatusMatcher(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, _ := newTestWaiter([]*http.Response{
+		{StatusCode: 404, Body: body(`{"__type":"NotFound","message":"Not found."}`)},
+		{StatusCode: 200, Body: body(`{}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: StatusWaiterMatch, Expected: 200},
+		WaiterAcceptor{State: RetryWaiterState, Matcher: StatusWaiterMatch, Expected: 404},
+	)
+	assert.NoError(t, w.WaitWithContext(context.Background()))
+}
+
+func TestWaiterUnmatchedError(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, delays := newTestWaiter([]*http.Response{
+		{StatusCode: 400, Body: body(`{"__type":"AccessDenied","message":"Denied."}`)},
+		{StatusCode: 200, Body: body(`{"data":"ready"}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "Data", Expected: "ready"},
+	)
+
+	err := w.WaitWithContext(context.Background())
+	if assert.Error(t, err) {
+		assert.Equal(t, "AccessDenied", err.(awserr.Error).Code())
+	}
+	assert.Empty(t, *delays)
+}
+
+func TestWaiterContextCanceled(t *testing.T) {
+	defer func(orig func(Context, time.Duration) error) { sleepDelay = orig }(sleepDelay)
+
+	w, _ := newTestWaiter([]*http.Response{
+		{StatusCode: 200, Body: body(`{"data":"pending"}`)},
+		{StatusCode: 200, Body: body(`{"data":"ready"}`)},
+	},
+		WaiterAcceptor{State: SuccessWaiterState, Matcher: PathWaiterMatch, Argument: "Data", Expected: "ready"},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	w.ApplyOptions(WithWaiterRequestOptions(func(r *Request) {
+		r.Handlers.Complete.PushBack(func(*Request) { cancel() })
+	}))
+
+	err := w.WaitWithContext(ctx)
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	}
+}
+
+func TestWaiterValueEqual(t *testing.T) {
+	assert.True(t, waiterValueEqual(int64(200), 200))
+	assert.True(t, waiterValueEqual(200, 200))
+	assert.False(t, waiterValueEqual("200", 200))
+	assert.True(t, waiterValueEqual("ACTIVE", "ACTIVE"))
+	assert.True(t, waiterValueEqual(true, true))
+	assert.False(t, waiterValueEqual(false, true))
+}
@@ -0,0 +1,294 @@
+// Package xray traces the requests of service clients with AWS X-Ray. Each API
+// call is recorded as a subsegment of the caller's segment, with the service,
+// operation, region, request ID, and number of retries of the call, and the
+// call's trace header is sent to the service so its segments join the trace.
+//
+// Example:
+//     svc := s3.New(nil)
+//     xray.Instrument(svc.Service)
+//
+//     ctx := xray.WithSegment(aws.BackgroundContext(), xray.Segment{
+//         TraceID: traceID,
+//         ID:      segmentID,
+//         Sampled: true,
+//     })
+//     out, err := svc.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+//
+// Requests in a Lambda function, whose trace header is set in the
+// _X_AMZN_TRACE_ID environment variable, are traced in the function's segment
+// if their context has no segment. Subsegments are sent to the X-Ray daemon at
+// the AWS_XRAY_DAEMON_ADDRESS, or 127.0.0.1:2000 by default.
+package xray
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// Names of the handlers added by Instrument.
+const (
+	BeginSubsegmentHandlerName = "awssdk.xray.BeginSubsegment"
+	EndSubsegmentHandlerName   = "awssdk.xray.EndSubsegment"
+)
+
+// TraceHeader is the header the trace of a request is sent to services in.
+const TraceHeader = "X-Amzn-Trace-Id"
+
+// The address subsegments are sent to if AWS_XRAY_DAEMON_ADDRESS is not set.
+const defaultDaemonAddress = "127.0.0.1:2000"
+
+// The header of the documents sent to the X-Ray daemon.
+const daemonHeader = `{"format": "json", "version": 1}` + "\n"
+
+// A Segment is the X-Ray segment, or subsegment, the API calls of a request
+// are traced in.
+type Segment struct {
+	// The ID of the trace of the segment, e.g.
+	// "1-5759e988-bd862e3fe1be46a994272793".
+	TraceID string
+
+	// The ID of the segment, 16 hexadecimal digits.
+	ID string
+
+	// If the trace is sampled. The subsegments of API calls in traces which
+	// are not sampled are not recorded, but their trace header is still sent.
+	Sampled bool
+}
+
+type segmentKey struct{}
+type subsegmentKey struct{}
+
+// WithSegment returns a copy of ctx with the segment the API calls of the
+// requests sent with the context are traced in.
+func WithSegment(ctx aws.Context, seg Segment) aws.Context {
+	return context.WithValue(ctx, segmentKey{}, seg)
+}
+
+// SegmentFromContext returns the segment of ctx set with WithSegment, or the
+// segment of the _X_AMZN_TRACE_ID environment variable's trace header if ctx
+// has no segment.
+func SegmentFromContext(ctx aws.Context) (Segment, bool) {
+	if seg, ok := ctx.Value(segmentKey{}).(Segment); ok {
+		return seg, true
+	}
+	return parseTraceHeader(os.Getenv("_X_AMZN_TRACE_ID"))
+}
+
+// parseTraceHeader returns the segment of a trace header, e.g.
+// "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1".
+func parseTraceHeader(header string) (Segment, bool) {
+	var seg Segment
+	for _, part := range strings.Split(header, ";") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "Root":
+			seg.TraceID = kv[1]
+		case "Parent":
+			seg.ID = kv[1]
+		case "Sampled":
+			seg.Sampled = kv[1] == "1"
+		}
+	}
+	return seg, seg.TraceID != "" && seg.ID != ""
+}
+
+// Instrument adds the handlers tracing the API calls of svc's requests with
+// X-Ray to the service client. Requests whose context has no segment are not
+// traced.
+func Instrument(svc *aws.Service) {
+	svc.Handlers.Validate.RemoveByName(BeginSubsegmentHandlerName)
+	svc.Handlers.Complete.RemoveByName(EndSubsegmentHandlerName)
+
+	svc.Handlers.Validate.PushFrontNamed(aws.NamedHandler{Name: BeginSubsegmentHandlerName, Fn: beginSubsegment})
+	svc.Handlers.Complete.PushBackNamed(aws.NamedHandler{Name: EndSubsegmentHandlerName, Fn: endSubsegment})
+}
+
+// A subsegment is the X-Ray subsegment document of an API call.
+type subsegment struct {
+	Name      string    `json:"name"`
+	ID        string    `json:"id"`
+	TraceID   string    `json:"trace_id"`
+	ParentID  string    `json:"parent_id"`
+	Type      string    `json:"type"`
+	Namespace string    `json:"namespace"`
+	StartTime float64   `json:"start_time"`
+	EndTime   float64   `json:"end_time"`
+	Error     bool      `json:"error,omitempty"`
+	Throttle  bool      `json:"throttle,omitempty"`
+	Fault     bool      `json:"fault,omitempty"`
+	Cause     *cause    `json:"cause,omitempty"`
+	HTTP      *httpInfo `json:"http,omitempty"`
+	AWS       awsInfo   `json:"aws"`
+	sampled   bool
+}
+
+type cause struct {
+	Exceptions []exception `json:"exceptions"`
+}
+
+type exception struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type httpInfo struct {
+	Response struct {
+		Status int `json:"status"`
+	} `json:"response"`
+}
+
+type awsInfo struct {
+	Operation string `json:"operation"`
+	Region    string `json:"region"`
+	RequestID string `json:"request_id,omitempty"`
+	Retries   uint   `json:"retries"`
+}
+
+// beginSubsegment starts the subsegment of the request's API call in the
+// segment of its context, and sets the request's trace header.
+func beginSubsegment(r *aws.Request) {
+	if r.ExpireTime != 0 {
+		return // presigned requests are not sent by the SDK
+	}
+	seg, ok := SegmentFromContext(r.Context())
+	if !ok {
+		return
+	}
+
+	sub := &subsegment{
+		Name:      r.ServiceName,
+		ID:        newID(),
+		TraceID:   seg.TraceID,
+		ParentID:  seg.ID,
+		Type:      "subsegment",
+		Namespace: "aws",
+		StartTime: epochSeconds(time.Now()),
+		sampled:   seg.Sampled,
+	}
+	r.SetContext(context.WithValue(r.Context(), subsegmentKey{}, sub))
+
+	sampled, parent := "0", seg.ID
+	if seg.Sampled {
+		sampled, parent = "1", sub.ID
+	}
+	r.HTTPRequest.Header.Set(TraceHeader, "Root="+seg.TraceID+";Parent="+parent+";Sampled="+sampled)
+}
+
+// endSubsegment records the outcome of the request's API call in its
+// subsegment, and sends the subsegment to the X-Ray daemon.
+func endSubsegment(r *aws.Request) {
+	sub, ok := r.Context().Value(subsegmentKey{}).(*subsegment)
+	if !ok || !sub.sampled {
+		return
+	}
+
+	sub.EndTime = epochSeconds(time.Now())
+	sub.AWS = awsInfo{
+		Operation: r.Operation.Name,
+		Region:    r.Config.Region,
+		RequestID: r.RequestID,
+		Retries:   r.RetryCount,
+	}
+
+	status := 0
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 0 {
+		status = r.HTTPResponse.StatusCode
+		sub.HTTP = &httpInfo{}
+		sub.HTTP.Response.Status = status
+	}
+	if r.Error != nil {
+		switch {
+		case status == 429:
+			sub.Error, sub.Throttle = true, true
+		case status >= 400 && status < 500:
+			sub.Error = true
+		default:
+			sub.Fault = true
+		}
+
+		e := exception{ID: newID(), Type: "UnknownError", Message: r.Error.Error()}
+		if err, ok := r.Error.(awserr.Error); ok {
+			e.Type, e.Message = err.Code(), err.Message()
+		}
+		sub.Cause = &cause{Exceptions: []exception{e}}
+	}
+
+	b, err := json.Marshal(sub)
+	if err != nil {
+		return
+	}
+	daemon.send(append([]byte(daemonHeader), b...))
+}
+
+// The connection to the X-Ray daemon, dialed when the first subsegment is
+// sent.
+var daemon = &daemonConn{addr: daemonAddress()}
+
+type daemonConn struct {
+	sync.Mutex
+	addr string
+	conn net.Conn
+}
+
+// daemonAddress returns the UDP address of the X-Ray daemon set by the
+// "AWS_XRAY_DAEMON_ADDRESS" environment variable, which may also set the
+// daemon's TCP address, e.g. "tcp:127.0.0.1:2000 udp:127.0.0.2:2001".
+func daemonAddress() string {
+	env := os.Getenv("AWS_XRAY_DAEMON_ADDRESS")
+	if env == "" {
+		return defaultDaemonAddress
+	}
+	for _, addr := range strings.Fields(env) {
+		if strings.HasPrefix(addr, "udp:") {
+			return strings.TrimPrefix(addr, "udp:")
+		}
+		if !strings.HasPrefix(addr, "tcp:") {
+			return addr
+		}
+	}
+	return defaultDaemonAddress
+}
+
+// send sends the document to the daemon. Documents which cannot be sent are
+// dropped, tracing never fails a request.
+func (d *daemonConn) send(b []byte) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.conn == nil {
+		conn, err := net.Dial("udp", d.addr)
+		if err != nil {
+			return
+		}
+		d.conn = conn
+	}
+	d.conn.Write(b)
+}
+
+// newID returns a random 64 bit X-Ray ID, as 16 hexadecimal digits.
+func newID() string {
+	b := make([]byte, 8)
+	io.ReadFull(rand.Reader, b)
+	return hex.EncodeToString(b)
+}
+
+// epochSeconds returns t in seconds since the epoch, the time format of X-Ray
+// documents.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
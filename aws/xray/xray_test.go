@@ -0,0 +1,187 @@
+package xray
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSegment = Segment{TraceID: "1-5759e988-bd862e3fe1be46a994272793", ID: "53995c3f42cd8ad8", Sampled: true}
+
+// listen returns a UDP listener for the X-Ray daemon, which subsegments are
+// sent to until it is closed.
+func listen(t *testing.T) net.PacketConn {
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	daemon.Lock()
+	daemon.addr, daemon.conn = l.LocalAddr().String(), nil
+	daemon.Unlock()
+	return l
+}
+
+// readSubsegment returns the next subsegment document received by the daemon.
+func readSubsegment(t *testing.T, l net.PacketConn) map[string]interface{} {
+	buf := make([]byte, 4096)
+	l.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := l.ReadFrom(buf)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+
+	parts := bytes.SplitN(buf[:n], []byte("\n"), 2)
+	assert.Equal(t, `{"format": "json", "version": 1}`, string(parts[0]))
+	var m map[string]interface{}
+	assert.NoError(t, json.Unmarshal(parts[1], &m))
+	return m
+}
+
+// newService returns an instrumented service whose requests receive resp.
+func newService(resp *http.Response, err error) *aws.Service {
+	s := aws.NewService(&aws.Config{Region: "mock-region", MaxRetries: 0})
+	s.ServiceName = "mock"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Sign.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = resp
+		r.Error = err
+		r.RequestID = "request-id"
+	})
+	Instrument(s)
+	return s
+}
+
+func TestSubsegment(t *testing.T) {
+	l := listen(t)
+	defer l.Close()
+
+	s := newService(&http.Response{StatusCode: 200, Body: ioutil.NopCloser(&bytes.Buffer{})}, nil)
+	r := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(WithSegment(aws.BackgroundContext(), testSegment))
+	assert.NoError(t, r.Send())
+
+	m := readSubsegment(t, l)
+	assert.Equal(t, "mock", m["name"])
+	assert.Equal(t, "subsegment", m["type"])
+	assert.Equal(t, "aws", m["namespace"])
+	assert.Equal(t, testSegment.TraceID, m["trace_id"])
+	assert.Equal(t, testSegment.ID, m["parent_id"])
+	assert.Len(t, m["id"], 16)
+	assert.True(t, m["end_time"].(float64) >= m["start_time"].(float64))
+	assert.Nil(t, m["error"])
+	assert.Nil(t, m["fault"])
+	assert.Equal(t, map[string]interface{}{"response": map[string]interface{}{"status": float64(200)}}, m["http"])
+	assert.Equal(t, map[string]interface{}{
+		"operation":  "Operation",
+		"region":     "mock-region",
+		"request_id": "request-id",
+		"retries":    float64(0),
+	}, m["aws"])
+
+	assert.Equal(t, "Root="+testSegment.TraceID+";Parent="+m["id"].(string)+";Sampled=1",
+		r.HTTPRequest.Header.Get(TraceHeader))
+}
+
+func TestSubsegmentError(t *testing.T) {
+	l := listen(t)
+	defer l.Close()
+
+	for _, c := range []struct {
+		status          int
+		err             error
+		flag, exception string
+	}{
+		{429, awserr.NewRequestFailure(awserr.New("ThrottlingException", "Rate exceeded", nil), 429, "request-id"), "throttle", "ThrottlingException"},
+		{400, awserr.NewRequestFailure(awserr.New("ValidationException", "Invalid", nil), 400, "request-id"), "error", "ValidationException"},
+		{500, awserr.NewRequestFailure(awserr.New("InternalFailure", "Internal", nil), 500, "request-id"), "fault", "InternalFailure"},
+		{0, awserr.New("RequestError", "send request failed", nil), "fault", "RequestError"},
+	} {
+		var resp *http.Response
+		if c.status != 0 {
+			resp = &http.Response{StatusCode: c.status, Body: ioutil.NopCloser(&bytes.Buffer{})}
+		}
+		s := newService(resp, c.err)
+		s.Handlers.UnmarshalError.PushBack(func(r *aws.Request) { r.Error = c.err })
+		r := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, nil, nil)
+		r.SetContext(WithSegment(aws.BackgroundContext(), testSegment))
+		assert.Error(t, r.Send())
+
+		m := readSubsegment(t, l)
+		assert.Equal(t, true, m[c.flag], c.flag)
+		if c.flag == "throttle" {
+			assert.Equal(t, true, m["error"])
+		}
+		if c.status == 0 {
+			assert.Nil(t, m["http"])
+		}
+		exceptions := m["cause"].(map[string]interface{})["exceptions"].([]interface{})
+		assert.Equal(t, c.exception, exceptions[0].(map[string]interface{})["type"])
+	}
+}
+
+func TestNotSampled(t *testing.T) {
+	l := listen(t)
+	defer l.Close()
+
+	seg := testSegment
+	seg.Sampled = false
+	s := newService(&http.Response{StatusCode: 200, Body: ioutil.NopCloser(&bytes.Buffer{})}, nil)
+	r := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(WithSegment(aws.BackgroundContext(), seg))
+	assert.NoError(t, r.Send())
+
+	assert.Equal(t, "Root="+seg.TraceID+";Parent="+seg.ID+";Sampled=0", r.HTTPRequest.Header.Get(TraceHeader))
+
+	l.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	_, _, err := l.ReadFrom(make([]byte, 4096))
+	assert.Error(t, err, "expected no subsegment to be sent")
+}
+
+func TestNoSegment(t *testing.T) {
+	s := newService(&http.Response{StatusCode: 200, Body: ioutil.NopCloser(&bytes.Buffer{})}, nil)
+	r := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "", r.HTTPRequest.Header.Get(TraceHeader))
+}
+
+func TestSegmentFromEnv(t *testing.T) {
+	defer os.Setenv("_X_AMZN_TRACE_ID", os.Getenv("_X_AMZN_TRACE_ID"))
+	os.Setenv("_X_AMZN_TRACE_ID", "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1")
+
+	seg, ok := SegmentFromContext(aws.BackgroundContext())
+	assert.True(t, ok)
+	assert.Equal(t, testSegment, seg)
+
+	other := Segment{TraceID: "1-5759e988-00000000000000000000000", ID: "0000000000000000"}
+	seg, ok = SegmentFromContext(WithSegment(aws.BackgroundContext(), other))
+	assert.True(t, ok)
+	assert.Equal(t, other, seg)
+
+	os.Setenv("_X_AMZN_TRACE_ID", "")
+	_, ok = SegmentFromContext(aws.BackgroundContext())
+	assert.False(t, ok)
+}
+
+func TestDaemonAddress(t *testing.T) {
+	defer os.Setenv("AWS_XRAY_DAEMON_ADDRESS", os.Getenv("AWS_XRAY_DAEMON_ADDRESS"))
+
+	for env, addr := range map[string]string{
+		"":                                      "127.0.0.1:2000",
+		"127.0.0.2:2001":                        "127.0.0.2:2001",
+		"tcp:127.0.0.1:2000 udp:127.0.0.2:2001": "127.0.0.2:2001",
+		"udp:127.0.0.2:2001 tcp:127.0.0.1:2000": "127.0.0.2:2001",
+	} {
+		os.Setenv("AWS_XRAY_DAEMON_ADDRESS", env)
+		assert.Equal(t, addr, daemonAddress(), env)
+	}
+}
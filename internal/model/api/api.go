@@ -17,6 +17,7 @@ type API struct {
 	Metadata      Metadata
 	Operations    map[string]*Operation
 	Shapes        map[string]*Shape
+	Waiters       []*Waiter `json:"-"`
 	Documentation string
 
 	// Disables inflection checks. Only use this when generating tests
@@ -36,6 +37,7 @@ type API struct {
 	name              string
 	unrecognizedNames map[string]string
 	path              string
+	errorShapes       []*Shape
 }
 
 // A Metadata is the metadata about an API's definition.
@@ -45,6 +47,7 @@ type Metadata struct {
 	SigningName         string
 	ServiceAbbreviation string
 	ServiceFullName     string
+	ServiceID           string
 	SignatureVersion    string
 	JSONVersion         string
 	TargetPrefix        string
@@ -109,6 +112,15 @@ func (a *API) ProtocolPackage() string {
 	}
 }
 
+// SignerPackage returns the package name of the signer the service's
+// requests are signed with.
+func (a *API) SignerPackage() string {
+	if a.Metadata.SignatureVersion == "v2" {
+		return "v2"
+	}
+	return "v4"
+}
+
 // OperationNames returns a slice of API operations supported.
 func (a *API) OperationNames() []string {
 	i, names := 0, make([]string, len(a.Operations))
@@ -129,6 +141,17 @@ func (a *API) OperationList() []*Operation {
 	return list
 }
 
+// EndpointOperation returns the operation which discovers the endpoints of
+// the API's other operations, or nil if the API has none.
+func (a *API) EndpointOperation() *Operation {
+	for _, o := range a.OperationList() {
+		if o.EndpointOperation {
+			return o
+		}
+	}
+	return nil
+}
+
 // ShapeNames returns a slice of names for each shape used by the API.
 func (a *API) ShapeNames() []string {
 	i, names := 0, make([]string, len(a.Shapes))
@@ -149,6 +172,23 @@ func (a *API) ShapeList() []*Shape {
 	return list
 }
 
+// ErrorShapes returns a slice of the API's exception shapes, sorted by
+// name. Includes the exception shapes removed as unused.
+func (a *API) ErrorShapes() []*Shape {
+	return a.errorShapes
+}
+
+// collectErrorShapes collects the API's exception shapes, before unused
+// shapes are removed.
+func (a *API) collectErrorShapes() {
+	a.errorShapes = nil
+	for _, s := range a.ShapeList() {
+		if s.Exception {
+			a.errorShapes = append(a.errorShapes, s)
+		}
+	}
+}
+
 // resetImports resets the import map to default values.
 func (a *API) resetImports() {
 	a.imports = map[string]bool{
@@ -227,9 +267,24 @@ var initRequest func(*aws.Request)
 
 // New returns a new {{ .StructName }} client.
 func New(config *aws.Config) *{{ .StructName }} {
+	return newClient(aws.DefaultConfig.Merge(config), nil)
+}
+
+// NewWithSession returns a new {{ .StructName }} client created from the
+// Config and Handlers of p, e.g. a session.Session. If config is not nil it
+// will be merged on top of the Config of p.
+func NewWithSession(p aws.ConfigProvider, config *aws.Config) *{{ .StructName }} {
+	handlers := p.ClientHandlers()
+	return newClient(p.ClientConfig(config), &handlers)
+}
+
+// newClient creates, initializes and returns a new {{ .StructName }} client.
+// If handlers is not nil they will be added after the client's own handlers.
+func newClient(config *aws.Config, handlers *aws.Handlers) *{{ .StructName }} {
 	service := &aws.Service{
-		Config:       aws.DefaultConfig.Merge(config),
-		ServiceName:  "{{ .Metadata.EndpointPrefix }}",{{ if ne .Metadata.SigningName "" }}
+		Config:       config,
+		ServiceName:  "{{ .Metadata.EndpointPrefix }}",{{ if ne .ServiceID "" }}
+		ServiceID:    "{{ .ServiceID }}",{{ end }}{{ if ne .Metadata.SigningName "" }}
 		SigningName:  "{{ .Metadata.SigningName }}",{{ end }}
 		APIVersion:   "{{ .Metadata.APIVersion }}",
 {{ if eq .Metadata.Protocol "json" }}JSONVersion:  "{{ .Metadata.JSONVersion }}",
@@ -239,18 +294,23 @@ func New(config *aws.Config) *{{ .StructName }} {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack({{ .ProtocolPackage }}.Build)
-	service.Handlers.Unmarshal.PushBack({{ .ProtocolPackage }}.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack({{ .ProtocolPackage }}.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack({{ .ProtocolPackage }}.UnmarshalError)
-
+	service.Handlers.Sign.PushBackNamed({{ .SignerPackage }}.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed({{ .ProtocolPackage }}.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed({{ .ProtocolPackage }}.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed({{ .ProtocolPackage }}.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed({{ .ProtocolPackage }}.UnmarshalErrorHandler)
+	{{ if .EndpointOperation }}service.DiscoverEndpoints = discoverEndpoints
+	{{ end }}
 	{{ if .UseInitMethods }}// Run custom service initialization if present
 	if initService != nil {
 		initService(service)
 	}
 	{{ end  }}
 
+	if handlers != nil {
+		service.Handlers.Merge(*handlers)
+	}
+
 	return &{{ .StructName }}{service}
 }
 
@@ -267,13 +327,57 @@ func (c *{{ .StructName }}) newRequest(op *aws.Operation, params, data interface
 
 	return req
 }
+{{ with .EndpointOperation }}
+// discoverEndpoints discovers the endpoints of requests with the
+// {{ .ExportedName }} operation.
+func discoverEndpoints(r *aws.Request) ([]aws.DiscoveredEndpoint, error) {
+	req, out := (&{{ .API.StructName }}{r.Service}).{{ .ExportedName }}Request(nil)
+	req.SetContext(r.Context())
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]aws.DiscoveredEndpoint, 0, len(out.Endpoints))
+	for _, ep := range out.Endpoints {
+		endpoints = append(endpoints, aws.DiscoveredEndpoint{
+			Address:     aws.StringValue(ep.Address),
+			CachePeriod: time.Duration(aws.LongValue(ep.CachePeriodInMinutes)) * time.Minute,
+		})
+	}
+	return endpoints, nil
+}
+{{ end }}
 `))
 
+// ErrorsGoCode renders the constants of the service's error codes, which can
+// be compared with the Code of the awserr.Errors the service returns.
+func (a *API) ErrorsGoCode() string {
+	var buf bytes.Buffer
+	buf.WriteString("const (\n")
+	for i, s := range a.ErrorShapes() {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "// %s for service response error code\n// %q.\n",
+			s.ErrorCodeName(), s.ErrorCode())
+		if doc := s.Docstring(); strings.TrimSpace(doc) != "" {
+			buf.WriteString("//\n" + doc)
+		}
+		fmt.Fprintf(&buf, "%s = %q\n", s.ErrorCodeName(), s.ErrorCode())
+	}
+	buf.WriteString(")\n")
+
+	return util.GoFmt(buf.String())
+}
+
 // ServiceGoCode renders service go code. Returning it as a string.
 func (a *API) ServiceGoCode() string {
 	a.resetImports()
-	a.imports["github.com/aws/aws-sdk-go/internal/signer/v4"] = true
+	a.imports["github.com/aws/aws-sdk-go/internal/signer/"+a.SignerPackage()] = true
 	a.imports["github.com/aws/aws-sdk-go/internal/protocol/"+a.ProtocolPackage()] = true
+	if a.EndpointOperation() != nil {
+		a.imports["time"] = true
+	}
 
 	var buf bytes.Buffer
 	err := tplService.Execute(&buf, a)
@@ -313,6 +417,9 @@ type {{ .StructName }}API interface {
     {{ range $_, $o := .OperationList }}
         {{ $o.InterfaceSignature }}
     {{ end }}
+    {{ range $_, $w := .Waiters }}
+        {{ $w.InterfaceSignature }}
+    {{ end }}
 }
 `))
 
@@ -322,6 +429,7 @@ type {{ .StructName }}API interface {
 func (a *API) InterfaceGoCode() string {
 	a.resetImports()
 	a.imports = map[string]bool{
+		"github.com/aws/aws-sdk-go/aws":                        true,
 		"github.com/aws/aws-sdk-go/service/" + a.PackageName(): true,
 	}
 
@@ -40,3 +40,11 @@ func TestStructNameForExceptions(t *testing.T) {
 	}
 	assert.Equal(t, a.StructName(), "ConfigService")
 }
+
+func TestAPIServiceID(t *testing.T) {
+	a := API{Metadata: Metadata{EndpointPrefix: "logs"}}
+	assert.Equal(t, "CloudWatch Logs", a.ServiceID())
+
+	a = API{Metadata: Metadata{EndpointPrefix: "logs", ServiceID: "Model Service ID"}}
+	assert.Equal(t, "Model Service ID", a.ServiceID())
+}
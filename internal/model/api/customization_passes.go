@@ -11,6 +11,9 @@ func (a *API) customizationPasses() {
 		"s3":              s3Customizations,
 		"cloudfront":      cloudfrontCustomizations,
 		"dynamodbstreams": dynamodbstreamsCustomizations,
+		"cloudhsm":        clientTokenCustomizations,
+		"ec2":             clientTokenCustomizations,
+		"storagegateway":  clientTokenCustomizations,
 	}
 
 	if fn := svcCustomizations[a.PackageName()]; fn != nil {
@@ -60,3 +63,16 @@ func dynamodbstreamsCustomizations(a *API) {
 		}
 	}
 }
+
+// clientTokenCustomizations marks the ClientToken members of operation inputs
+// as idempotency tokens, for the API definitions which do not mark them.
+func clientTokenCustomizations(a *API) {
+	for _, op := range a.Operations {
+		if op.InputRef.Shape == nil {
+			continue
+		}
+		if ref, ok := op.InputRef.Shape.MemberRefs["ClientToken"]; ok && ref.Shape.Type == "string" {
+			ref.IdempotencyToken = true
+		}
+	}
+}
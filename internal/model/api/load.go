@@ -48,6 +48,7 @@ func (a *API) AttachString(str string) {
 func (a *API) Setup() {
 	a.unrecognizedNames = map[string]string{}
 	a.writeShapeNames()
+	a.setErrorCodes()
 	a.resolveReferences()
 	a.fixStutterNames()
 	a.renameExportable()
@@ -56,6 +57,7 @@ func (a *API) Setup() {
 	a.createInputOutputShapes()
 	a.customizationPasses()
 
+	a.collectErrorShapes()
 	if !a.NoRemoveUnusedShapes {
 		a.removeUnusedShapes()
 	}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -69,3 +70,127 @@ func TestRenamedShapes(t *testing.T) {
 	assert.Nil(t, a.Shapes["TestVpnIcmp"])
 	assert.NotNil(t, a.Shapes["TestVPNICMP"])
 }
+
+func TestErrorShapes(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Amazon Simple Queue Service" },
+		"operations": {
+			"OperationName": {
+				"input": { "shape": "TestName" }
+			}
+		},
+		"shapes": {
+			"TestName": { "type": "structure", "members": {} },
+			"NotFound": { "type": "structure", "members": {}, "exception": true },
+			"QueueDoesNotExist": {
+				"type": "structure",
+				"members": {},
+				"error": { "code": "AWS.SimpleQueueService.NonExistentQueue", "httpStatusCode": 400 },
+				"exception": true
+			}
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+
+	if assert.Len(t, a.ErrorShapes(), 2) {
+		assert.Equal(t, "ErrCodeNotFound", a.ErrorShapes()[0].ErrorCodeName())
+		assert.Equal(t, "NotFound", a.ErrorShapes()[0].ErrorCode())
+		assert.Equal(t, "ErrCodeQueueDoesNotExist", a.ErrorShapes()[1].ErrorCodeName())
+		assert.Equal(t, "AWS.SimpleQueueService.NonExistentQueue", a.ErrorShapes()[1].ErrorCode())
+	}
+	assert.Contains(t, a.ErrorsGoCode(), `ErrCodeQueueDoesNotExist = "AWS.SimpleQueueService.NonExistentQueue"`)
+}
+
+func TestHostPrefixLabelsRenamed(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Amazon Simple Queue Service" },
+		"operations": {
+			"OperationName": {
+				"input": { "shape": "TestName" },
+				"endpoint": { "hostPrefix": "{accountId}.data." }
+			}
+		},
+		"shapes": {
+			"TestName": {
+				"type": "structure",
+				"members": {
+					"accountId": { "shape": "OtherTest", "hostLabel": true }
+				}
+			},
+			"OtherTest": { "type": "string" }
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+
+	op := a.Operations["OperationName"]
+	if assert.NotNil(t, op.Endpoint) {
+		assert.Equal(t, "{AccountID}.data.", op.Endpoint.HostPrefix)
+	}
+	assert.NotNil(t, op.InputRef.Shape.MemberRefs["AccountID"])
+	assert.Contains(t, op.GoCode(), `aws.MakeHostPrefixHandler("{AccountID}.data.")`)
+}
+
+func TestWaiters(t *testing.T) {
+	apiJSON := `{
+		"metadata": { "serviceFullName": "Amazon DynamoDB" },
+		"operations": {
+			"DescribeTable": {
+				"input": { "shape": "DescribeTableInput" },
+				"output": { "shape": "DescribeTableOutput" }
+			}
+		},
+		"shapes": {
+			"DescribeTableInput": { "type": "structure", "members": {} },
+			"DescribeTableOutput": {
+				"type": "structure",
+				"members": {
+					"TableStatus": { "shape": "TableStatus" }
+				}
+			},
+			"TableStatus": { "type": "string" }
+		}
+	}`
+	waitersJSON := `{
+		"version": 2,
+		"waiters": {
+			"TableExists": {
+				"delay": 20,
+				"operation": "DescribeTable",
+				"maxAttempts": 25,
+				"acceptors": [
+					{ "expected": "ACTIVE", "matcher": "path", "state": "success", "argument": "TableStatus" },
+					{ "expected": 404, "matcher": "status", "state": "retry" }
+				]
+			},
+			"TableActive": {
+				"delay": 20,
+				"operation": "DescribeTable",
+				"maxAttempts": 25,
+				"acceptors": [
+					{ "expected": true, "matcher": "path", "state": "success", "argument": "length(TableStatus) > ` + "`0`" + `" }
+				]
+			}
+		}
+	}`
+	a := API{}
+	json.Unmarshal([]byte(apiJSON), &a)
+	p := waiterDefinitions{API: &a}
+	json.Unmarshal([]byte(waitersJSON), &p)
+	p.setup()
+	a.Setup()
+
+	if assert.Len(t, a.Waiters, 1, "expect the waiter with a JMESPath expression to be skipped") {
+		w := a.Waiters[0]
+		assert.Equal(t, "TableExists", w.ExportedName)
+		assert.Equal(t, a.Operations["DescribeTable"], w.Operation)
+	}
+	code := a.WaitersGoCode()
+	assert.Contains(t, code, "func (c *DynamoDB) WaitUntilTableExistsWithContext(")
+	assert.Contains(t, code, `Argument: "TableStatus",`)
+	assert.Contains(t, code, "Expected: 404,")
+	assert.Contains(t, code, "Delay:       aws.ConstantWaiterDelay(20 * time.Second),")
+	assert.Contains(t, code, "Matcher:  aws.StatusWaiterMatch,")
+	assert.Contains(t, a.InterfaceGoCode(), "WaitUntilTableExists(*dynamodb.DescribeTableInput) error")
+}
@@ -21,6 +21,32 @@ type Operation struct {
 	InputRef      ShapeRef `json:"input"`
 	OutputRef     ShapeRef `json:"output"`
 	Paginator     *Paginator
+
+	// Set if the endpoint of the operation's requests can be discovered with
+	// the service's endpoint operation.
+	EndpointDiscovery *EndpointDiscovery `json:"endpointdiscovery"`
+
+	// If the operation is the service's endpoint operation, which discovers
+	// the endpoints of the service's other operations.
+	EndpointOperation bool `json:"endpointoperation"`
+
+	// Set if the operation's requests are sent to a prefixed host.
+	Endpoint *EndpointTrait `json:"endpoint"`
+}
+
+// An EndpointTrait defines the host prefix of an Operation's requests, e.g.
+// "data." or "{AccountId}.", whose labels are replaced with the value of the
+// input member of the same name.
+type EndpointTrait struct {
+	HostPrefix string `json:"hostPrefix"`
+}
+
+// An EndpointDiscovery defines how the endpoint of an Operation's requests is
+// discovered.
+type EndpointDiscovery struct {
+	// If requests fail when their endpoint cannot be discovered, instead of
+	// being sent to the service's endpoint.
+	Required bool
 }
 
 // A HTTPInfo defines the method of HTTP request for the Operation.
@@ -56,8 +82,12 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 				OutputTokens: {{ .Paginator.OutputTokensString }},
 				LimitToken: "{{ .Paginator.LimitKey }}",
 				TruncationToken: "{{ .Paginator.MoreResults }}",
+				{{ if .Paginator.HasResultKeys }}ResultKeys: {{ .Paginator.ResultKeysString }},
+				{{ end }}
 		},
-		{{ end }}
+		{{ end }}{{ if .EndpointDiscovery }}EndpointDiscovery: true,
+		{{ if .EndpointDiscovery.Required }}EndpointDiscoveryRequired: true,
+		{{ end }}{{ end }}
 	}
 
 	if input == nil {
@@ -65,7 +95,11 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 	}
 
 	req = c.newRequest(op, input, output)
-	output = &{{ .OutputRef.GoTypeElem }}{}
+	{{ if .Endpoint }}req.Handlers.Build.PushBackNamed(aws.NamedHandler{
+		Name: aws.HostPrefixHandlerName,
+		Fn:   aws.MakeHostPrefixHandler("{{ .Endpoint.HostPrefix }}"),
+	})
+	{{ end }}output = &{{ .OutputRef.GoTypeElem }}{}
 	req.Data = output
 	return
 }
@@ -77,7 +111,24 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 	return out, err
 }
 
+// {{ .ExportedName }}WithContext is the same as {{ .ExportedName }} with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *{{ .API.StructName }}) {{ .ExportedName }}WithContext(` +
+	`ctx aws.Context, input {{ .InputRef.GoType }}, opts ...aws.Option) ({{ .OutputRef.GoType }}, error) {
+	req, out := c.{{ .ExportedName }}Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 {{ if .Paginator }}
+// {{ .ExportedName }}Pages iterates over the pages of a {{ .ExportedName }} operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *{{ .API.StructName }}) {{ .ExportedName }}Pages(` +
 	`input {{ .InputRef.GoType }}, fn func(p {{ .OutputRef.GoType }}, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.{{ .ExportedName }}Request(input)
@@ -85,6 +136,20 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Pages(` +
 		return fn(p.({{ .OutputRef.GoType }}), lastPage)
 	})
 }
+
+// {{ .ExportedName }}PagesWithContext is the same as {{ .ExportedName }}Pages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *{{ .API.StructName }}) {{ .ExportedName }}PagesWithContext(` +
+	`ctx aws.Context, input {{ .InputRef.GoType }}, fn func(p {{ .OutputRef.GoType }}, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.{{ .ExportedName }}Request(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.({{ .OutputRef.GoType }}), lastPage)
+	})
+}
 {{ end }}
 `))
 
@@ -102,7 +167,13 @@ func (o *Operation) GoCode() string {
 // tplInfSig defines the template for rendering an Operation's signature within an Interface definition.
 var tplInfSig = template.Must(template.New("opsig").Parse(`
 {{ .ExportedName }}({{ .InputRef.GoTypeWithPkgName }}) ({{ .OutputRef.GoTypeWithPkgName }}, error)
-`))
+
+{{ .ExportedName }}WithContext(aws.Context, {{ .InputRef.GoTypeWithPkgName }}, ...aws.Option) ({{ .OutputRef.GoTypeWithPkgName }}, error)
+{{ if .Paginator }}
+{{ .ExportedName }}Pages({{ .InputRef.GoTypeWithPkgName }}, func({{ .OutputRef.GoTypeWithPkgName }}, bool) bool) error
+
+{{ .ExportedName }}PagesWithContext(aws.Context, {{ .InputRef.GoTypeWithPkgName }}, func({{ .OutputRef.GoTypeWithPkgName }}, bool) bool, ...aws.Option) error
+{{ end }}`))
 
 // InterfaceSignature returns a string representing the Operation's interface{}
 // functional signature.
@@ -113,7 +184,9 @@ func (o *Operation) InterfaceSignature() string {
 		panic(err)
 	}
 
-	return strings.TrimSpace(util.GoFmt(buf.String()))
+	// The signatures are formatted along with the rest of the interface's
+	// code, since variadic parameters cannot be formatted on their own.
+	return strings.TrimSpace(buf.String())
 }
 
 // tplExample defines the template for rendering an Operation example
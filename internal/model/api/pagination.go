@@ -12,6 +12,7 @@ type Paginator struct {
 	OutputTokens interface{} `json:"output_token"`
 	LimitKey     string      `json:"limit_key"`
 	MoreResults  string      `json:"more_results"`
+	ResultKeys   interface{} `json:"result_key"`
 }
 
 // InputTokensString returns output tokens formatted as a list
@@ -26,6 +27,17 @@ func (p *Paginator) OutputTokensString() string {
 	return fmt.Sprintf("%#v", str)
 }
 
+// ResultKeysString returns result keys formatted as a list
+func (p *Paginator) ResultKeysString() string {
+	str := p.ResultKeys.([]string)
+	return fmt.Sprintf("%#v", str)
+}
+
+// HasResultKeys returns true if the paginator has result keys.
+func (p *Paginator) HasResultKeys() bool {
+	return len(p.ResultKeys.([]string)) > 0
+}
+
 // used for unmarshaling from the paginators JSON file
 type paginationDefinitions struct {
 	*API
@@ -57,28 +69,9 @@ func (p *paginationDefinitions) setup() {
 		}
 		paginator := e
 
-		switch t := paginator.InputTokens.(type) {
-		case string:
-			paginator.InputTokens = []string{t}
-		case []interface{}:
-			toks := []string{}
-			for _, e := range t {
-				s := e.(string)
-				toks = append(toks, s)
-			}
-			paginator.InputTokens = toks
-		}
-		switch t := paginator.OutputTokens.(type) {
-		case string:
-			paginator.OutputTokens = []string{t}
-		case []interface{}:
-			toks := []string{}
-			for _, e := range t {
-				s := e.(string)
-				toks = append(toks, s)
-			}
-			paginator.OutputTokens = toks
-		}
+		paginator.InputTokens = stringList(paginator.InputTokens)
+		paginator.OutputTokens = stringList(paginator.OutputTokens)
+		paginator.ResultKeys = stringList(paginator.ResultKeys)
 
 		if o, ok := p.Operations[n]; ok {
 			o.Paginator = &paginator
@@ -87,3 +80,20 @@ func (p *paginationDefinitions) setup() {
 		}
 	}
 }
+
+// stringList returns the string, or list of strings, of a paginator's
+// definition as a list.
+func stringList(v interface{}) []string {
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		toks := []string{}
+		for _, e := range t {
+			s := e.(string)
+			toks = append(toks, s)
+		}
+		return toks
+	}
+	return []string{}
+}
@@ -39,6 +39,20 @@ func (a *API) writeShapeNames() {
 	}
 }
 
+// setErrorCodes sets the error codes of the exception shapes from their
+// names in the API definition, before the shapes are renamed.
+func (a *API) setErrorCodes() {
+	for n, s := range a.Shapes {
+		if !s.Exception || s.errorCode != "" {
+			continue
+		}
+		s.errorCode = n
+		if s.ErrorInfo.Code != "" {
+			s.errorCode = s.ErrorInfo.Code
+		}
+	}
+}
+
 func (a *API) resolveReferences() {
 	resolver := referenceResolver{API: a, visited: map[*ShapeRef]bool{}}
 
@@ -142,6 +156,9 @@ func (a *API) fixStutterNames() {
 	}
 }
 
+// hostLabelRegex matches the labels of operations' host prefixes.
+var hostLabelRegex = regexp.MustCompile(`\{(\w+)\}`)
+
 // renameExportable renames all operation names to be exportable names.
 // All nested Shape names are also updated to the exportable variant.
 func (a *API) renameExportable() {
@@ -152,6 +169,18 @@ func (a *API) renameExportable() {
 			a.Operations[newName] = op
 		}
 		op.ExportedName = newName
+
+		// the labels of host prefixes name input members, which are renamed
+		// below
+		if op.Endpoint != nil {
+			op.Endpoint.HostPrefix = hostLabelRegex.ReplaceAllStringFunc(op.Endpoint.HostPrefix, func(label string) string {
+				return "{" + a.ExportableName(label[1:len(label)-1]) + "}"
+			})
+		}
+	}
+
+	for _, w := range a.Waiters {
+		w.ExportedName = a.ExportableName(w.Name)
 	}
 
 	for k, s := range a.Shapes {
@@ -0,0 +1,67 @@
+package api
+
+// serviceIDs are the service IDs of APIs whose models do not define one,
+// keyed by the API's endpoint prefix. The service ID identifies the service
+// independently of its endpoint prefix, e.g. "CloudWatch Logs" for "logs".
+var serviceIDs = map[string]string{
+	"autoscaling":          "Auto Scaling",
+	"cloudformation":       "CloudFormation",
+	"cloudfront":           "CloudFront",
+	"cloudhsm":             "CloudHSM",
+	"cloudsearch":          "CloudSearch",
+	"cloudsearchdomain":    "CloudSearch Domain",
+	"cloudtrail":           "CloudTrail",
+	"codecommit":           "CodeCommit",
+	"codedeploy":           "CodeDeploy",
+	"codepipeline":         "CodePipeline",
+	"cognito-identity":     "Cognito Identity",
+	"cognito-sync":         "Cognito Sync",
+	"config":               "Config Service",
+	"datapipeline":         "Data Pipeline",
+	"directconnect":        "Direct Connect",
+	"ds":                   "Directory Service",
+	"dynamodb":             "DynamoDB",
+	"ec2":                  "EC2",
+	"ecs":                  "ECS",
+	"elasticache":          "ElastiCache",
+	"elasticbeanstalk":     "Elastic Beanstalk",
+	"elasticfilesystem":    "EFS",
+	"elasticloadbalancing": "Elastic Load Balancing",
+	"elasticmapreduce":     "EMR",
+	"elastictranscoder":    "Elastic Transcoder",
+	"email":                "SES",
+	"glacier":              "Glacier",
+	"iam":                  "IAM",
+	"importexport":         "ImportExport",
+	"kinesis":              "Kinesis",
+	"kms":                  "KMS",
+	"lambda":               "Lambda",
+	"logs":                 "CloudWatch Logs",
+	"machinelearning":      "Machine Learning",
+	"mobileanalytics":      "Mobile Analytics",
+	"monitoring":           "CloudWatch",
+	"opsworks":             "OpsWorks",
+	"rds":                  "RDS",
+	"redshift":             "Redshift",
+	"route53":              "Route 53",
+	"route53domains":       "Route 53 Domains",
+	"s3":                   "S3",
+	"sdb":                  "SimpleDB",
+	"sns":                  "SNS",
+	"sqs":                  "SQS",
+	"ssm":                  "SSM",
+	"storagegateway":       "Storage Gateway",
+	"streams.dynamodb":     "DynamoDB Streams",
+	"sts":                  "STS",
+	"support":              "Support",
+	"swf":                  "SWF",
+	"workspaces":           "WorkSpaces",
+}
+
+// ServiceID returns the service ID of the API, e.g. "CloudWatch Logs".
+func (a *API) ServiceID() string {
+	if a.Metadata.ServiceID != "" {
+		return a.Metadata.ServiceID
+	}
+	return serviceIDs[a.Metadata.EndpointPrefix]
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -25,6 +26,8 @@ type ShapeRef struct {
 	XMLAttribute  bool
 	XMLNamespace  XMLInfo
 	Payload       string
+
+	IdempotencyToken bool `json:"idempotencyToken"`
 }
 
 // A XMLInfo defines URL and prefix for Shapes when rendered as XML
@@ -46,15 +49,39 @@ type Shape struct {
 	Payload       string
 	Type          string
 	Exception     bool
+	ErrorInfo     ErrorInfo `json:"error"`
 	Enum          []string
+	Min           *float64
+	Max           *float64
 	Flattened     bool
 	Streaming     bool
 	Location      string
 	LocationName  string
 	XMLNamespace  XMLInfo
+	Sensitive     bool
 
 	refs       []*ShapeRef // References to this shape
 	resolvePkg string      // use this package in the goType() if present
+	errorCode  string      // the error code of an exception shape
+}
+
+// An ErrorInfo defines the error code of an exception shape, if it differs
+// from the shape's name.
+type ErrorInfo struct {
+	Code           string
+	HTTPStatusCode int
+}
+
+// ErrorCodeName returns the name of the constant of the exception shape's
+// error code.
+func (s *Shape) ErrorCodeName() string {
+	return "ErrCode" + strings.ToUpper(s.ShapeName[0:1]) + s.ShapeName[1:]
+}
+
+// ErrorCode returns the error code services respond with for the exception
+// shape. Defaults to the shape's name in the API definition.
+func (s *Shape) ErrorCode() string {
+	return s.errorCode
 }
 
 // Rename changes the name of the Shape to newName. Also updates
@@ -219,6 +246,16 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 		code += `xmlAttribute:"true" `
 	}
 
+	if ref.Shape.Sensitive {
+		code += `sensitive:"true" `
+	}
+
+	if ref.IdempotencyToken {
+		code += `idempotencyToken:"true" `
+	}
+
+	code += ref.Shape.constraintTags()
+
 	if isRequired {
 		code += `required:"true"`
 	}
@@ -243,6 +280,23 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 	return strings.TrimSpace(code) + "`"
 }
 
+// constraintTags returns the tags of the shape's constraints the parameter
+// validator checks input values against. Patterns are left out, they are in
+// the syntax of Java's regular expressions, and some reject values the
+// services accept. Enums are left out too, services add values to them, which
+// the bundled models would reject, e.g. the S3 location constraints of new
+// regions.
+func (s *Shape) constraintTags() string {
+	code := ""
+	if s.Min != nil {
+		code += `min:"` + strconv.FormatFloat(*s.Min, 'f', -1, 64) + `" `
+	}
+	if s.Max != nil {
+		code += `max:"` + strconv.FormatFloat(*s.Max, 'f', -1, 64) + `" `
+	}
+	return code
+}
+
 // Docstring returns the godocs formated documentation
 func (ref *ShapeRef) Docstring() string {
 	if ref.Documentation != "" {
@@ -277,6 +331,17 @@ func (s *Shape) goCodeStringers() string {
 	return w.String()
 }
 
+// UsedAsOutput returns if the shape is the output of any of the API's
+// operations.
+func (s *Shape) UsedAsOutput() bool {
+	for _, o := range s.API.Operations {
+		if o.OutputRef.Shape == s {
+			return true
+		}
+	}
+	return false
+}
+
 // GoCode returns the rendered Go code for the Shape.
 func (s *Shape) GoCode() string {
 	code := s.Docstring() + "type " + s.ShapeName + " "
@@ -287,10 +352,9 @@ func (s *Shape) GoCode() string {
 			m := s.MemberRefs[n]
 			code += m.Docstring()
 			if (m.Streaming || m.Shape.Streaming) && s.Payload == n {
+				// Output payloads are streamed from the response body.
 				rtype := "io.ReadSeeker"
-				if len(s.refs) > 1 {
-					rtype = "aws.ReaderSeekCloser"
-				} else if strings.HasSuffix(s.ShapeName, "Output") {
+				if s.UsedAsOutput() {
 					rtype = "io.ReadCloser"
 				}
 
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/aws/aws-sdk-go/internal/util"
+)
+
+// A Waiter is the definition of a waiter, polling an operation until the
+// resource it describes reaches a state.
+type Waiter struct {
+	Name          string
+	ExportedName  string
+	Delay         int
+	MaxAttempts   int
+	OperationName string `json:"operation"`
+	Operation     *Operation
+	Acceptors     []WaitAcceptor
+}
+
+// A WaitAcceptor is the definition of an acceptor of a waiter, matching a
+// result of the waiter's operation to a state.
+type WaitAcceptor struct {
+	Expected interface{}
+	Matcher  string
+	State    string
+	Argument string
+}
+
+// used for unmarshaling from the waiters JSON file
+type waiterDefinitions struct {
+	*API
+	Waiters map[string]Waiter
+}
+
+// AttachWaiters attaches the waiter definitions from filename to the API.
+func (a *API) AttachWaiters(filename string) {
+	p := waiterDefinitions{API: a}
+
+	f, err := os.Open(filename)
+	defer f.Close()
+	if err != nil {
+		panic(err)
+	}
+	err = json.NewDecoder(f).Decode(&p)
+	if err != nil {
+		panic(err)
+	}
+
+	p.setup()
+}
+
+// setup attaches the waiters to the API, skipping the waiters which cannot be
+// generated. The waiters are sorted by name.
+func (p *waiterDefinitions) setup() {
+	p.API.Waiters = []*Waiter{}
+	for n, e := range p.Waiters {
+		if !e.supported() {
+			continue
+		}
+		w := e
+		w.Name = n
+
+		if o, ok := p.Operations[w.OperationName]; ok {
+			w.Operation = o
+		} else {
+			panic("unknown operation for waiter " + n)
+		}
+		p.API.Waiters = append(p.API.Waiters, &w)
+	}
+
+	sort.Sort(waitersByName(p.API.Waiters))
+}
+
+type waitersByName []*Waiter
+
+func (w waitersByName) Len() int           { return len(w) }
+func (w waitersByName) Less(i, j int) bool { return w[i].Name < w[j].Name }
+func (w waitersByName) Swap(i, j int)      { w[i], w[j] = w[j], w[i] }
+
+// supported returns false if any of the waiter's acceptors uses a matcher the
+// SDK does not support, or an argument which is a JMESPath expression rather
+// than a path, which the SDK cannot evaluate.
+func (w *Waiter) supported() bool {
+	for _, a := range w.Acceptors {
+		switch a.Matcher {
+		case "path", "pathAll", "pathAny":
+			if strings.ContainsAny(a.Argument, "()|`?@ ") {
+				return false
+			}
+		case "status", "error":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// MatcherName returns the name of the aws.WaiterMatchMode of the acceptor's
+// matcher.
+func (a WaitAcceptor) MatcherName() string {
+	return "aws." + strings.ToUpper(a.Matcher[0:1]) + a.Matcher[1:] + "WaiterMatch"
+}
+
+// StateName returns the name of the aws.WaiterState of the acceptor's state.
+func (a WaitAcceptor) StateName() string {
+	return "aws." + strings.ToUpper(a.State[0:1]) + a.State[1:] + "WaiterState"
+}
+
+// ExpectedString returns the acceptor's expected value as a Go literal.
+func (a WaitAcceptor) ExpectedString() string {
+	switch v := a.Expected.(type) {
+	case float64:
+		return fmt.Sprintf("%d", int(v))
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
+
+// tplWaiter defines the template for rendering a waiter.
+var tplWaiter = template.Must(template.New("waiter").Parse(`
+// WaitUntil{{ .ExportedName }} uses the {{ .Operation.API.NiceName }} API operation
+// {{ .Operation.ExportedName }} to wait until the resource is in the state the
+// waiter waits for. An awserr.Error with the code aws.ErrCodeResourceNotReady
+// is returned if the resource reaches a failure state, or is not in the state
+// after the waiter's attempts.
+func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .ExportedName }}(` +
+	`input {{ .Operation.InputRef.GoType }}) error {
+	return c.WaitUntil{{ .ExportedName }}WithContext(aws.BackgroundContext(), input)
+}
+
+// WaitUntil{{ .ExportedName }}WithContext is the same as WaitUntil{{ .ExportedName }}
+// with the addition of the ability to pass a context and options customizing
+// the waiter. The context must not be nil. If the context is canceled waiting
+// stops, and the in-flight request is canceled.
+func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .ExportedName }}WithContext(` +
+	`ctx aws.Context, input {{ .Operation.InputRef.GoType }}, opts ...aws.WaiterOption) error {
+	w := aws.Waiter{
+		Name:        "WaitUntil{{ .ExportedName }}",
+		MaxAttempts: {{ .MaxAttempts }},
+		Delay:       aws.ConstantWaiterDelay({{ .Delay }} * time.Second),
+		Acceptors: []aws.WaiterAcceptor{
+			{{ range $_, $a := .Acceptors }}{
+				State:    {{ $a.StateName }},
+				Matcher:  {{ $a.MatcherName }},
+				{{ if $a.Argument }}Argument: "{{ $a.Argument }}",
+				{{ end }}Expected: {{ $a.ExpectedString }},
+			},
+			{{ end }}
+		},
+		NewRequest: func(opts []aws.Option) *aws.Request {
+			req, _ := c.{{ .Operation.ExportedName }}Request(input)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req
+		},
+	}
+	w.ApplyOptions(opts...)
+
+	return w.WaitWithContext(ctx)
+}
+`))
+
+// GoCode returns the rendered Go code of the waiter.
+func (w *Waiter) GoCode() string {
+	var buf bytes.Buffer
+	if err := tplWaiter.Execute(&buf, w); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+// tplWaiterInfSig defines the template for rendering a waiter's signatures
+// within an Interface definition.
+var tplWaiterInfSig = template.Must(template.New("waitersig").Parse(`
+WaitUntil{{ .ExportedName }}({{ .Operation.InputRef.GoTypeWithPkgName }}) error
+
+WaitUntil{{ .ExportedName }}WithContext(aws.Context, {{ .Operation.InputRef.GoTypeWithPkgName }}, ...aws.WaiterOption) error
+`))
+
+// InterfaceSignature returns a string representing the waiter's interface{}
+// functional signature.
+func (w *Waiter) InterfaceSignature() string {
+	var buf bytes.Buffer
+	if err := tplWaiterInfSig.Execute(&buf, w); err != nil {
+		panic(err)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// WaitersGoCode renders the service's waiters. Returning it as a string.
+func (a *API) WaitersGoCode() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "import (\n%q\n\n%q\n)\n", "time", "github.com/aws/aws-sdk-go/aws")
+	for _, w := range a.Waiters {
+		buf.WriteString(w.GoCode())
+	}
+	return util.GoFmt(buf.String())
+}
@@ -24,10 +24,7 @@ type generateInfo struct {
 	PackageDir string
 }
 
-var excludeServices = map[string]struct{}{
-	"simpledb":     struct{}{},
-	"importexport": struct{}{},
-}
+var excludeServices = map[string]struct{}{}
 
 // newGenerateInfo initializes the service API's folder structure for a specific service.
 // If the SERVICES environment variable is set, and this service is not apart of the list
@@ -45,6 +42,11 @@ func newGenerateInfo(modelFile, svcPath string) *generateInfo {
 		g.API.AttachPaginators(paginatorsFile)
 	}
 
+	waitersFile := strings.Replace(modelFile, "api-2.json", "waiters-2.json", -1)
+	if _, err := os.Stat(waitersFile); err == nil {
+		g.API.AttachWaiters(waitersFile)
+	}
+
 	docsFile := strings.Replace(modelFile, "api-2.json", "docs-2.json", -1)
 	if _, err := os.Stat(docsFile); err == nil {
 		g.API.AttachDocs(docsFile)
@@ -152,6 +154,8 @@ func main() {
 					g.writeExamplesFile()
 					g.writeServiceFile()
 					g.writeInterfaceFile()
+					g.writeErrorsFile()
+					g.writeWaitersFile()
 				}
 			}
 		}()
@@ -208,6 +212,34 @@ func (g *generateInfo) writeInterfaceFile() {
 	)
 }
 
+// writeErrorsFile writes out the service error code file, if the service
+// has exception shapes.
+func (g *generateInfo) writeErrorsFile() {
+	if len(g.API.ErrorShapes()) == 0 {
+		return
+	}
+	writeGoFile(filepath.Join(g.PackageDir, "errors.go"),
+		codeLayout,
+		"",
+		g.API.PackageName(),
+		g.API.ErrorsGoCode(),
+	)
+}
+
+// writeWaitersFile writes out the service waiters file, if the service has
+// waiters.
+func (g *generateInfo) writeWaitersFile() {
+	if len(g.API.Waiters) == 0 {
+		return
+	}
+	writeGoFile(filepath.Join(g.PackageDir, "waiters.go"),
+		codeLayout,
+		"",
+		g.API.PackageName(),
+		g.API.WaitersGoCode(),
+	)
+}
+
 // writeAPIFile writes out the service api file.
 func (g *generateInfo) writeAPIFile() {
 	writeGoFile(filepath.Join(g.PackageDir, "api.go"),
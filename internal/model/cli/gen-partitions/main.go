@@ -0,0 +1,69 @@
+// Command aws-gen-gopartitions parses a JSON description of the AWS endpoint
+// partitions and generates a Go file of the partitions model.
+//
+//     aws-gen-gopartitions aws/endpoints/endpoints.json aws/endpoints/defaults.go
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/aws/aws-sdk-go/internal/model"
+)
+
+type endpoint struct {
+	Hostname          string `json:"hostname"`
+	DualStackHostname string `json:"dualStackHostname"`
+	FIPSHostname      string `json:"fipsHostname"`
+	CredentialScope   struct {
+		Region  string `json:"region"`
+		Service string `json:"service"`
+	} `json:"credentialScope"`
+}
+
+// Generates the partitions model from json description
+//
+// CLI Args:
+//  [0] This file's execution path
+//  [1] The definition file to use
+//  [2] The output file to generate
+func main() {
+	in, err := os.Open(os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	defer in.Close()
+
+	var partitions struct {
+		Version    int `json:"version"`
+		Partitions []struct {
+			ID          string   `json:"partition"`
+			Name        string   `json:"partitionName"`
+			DNSSuffix   string   `json:"dnsSuffix"`
+			RegionRegex string   `json:"regionRegex"`
+			Defaults    endpoint `json:"defaults"`
+			Regions     map[string]struct {
+				Description string `json:"description"`
+			} `json:"regions"`
+			Services map[string]struct {
+				PartitionEndpoint string              `json:"partitionEndpoint"`
+				IsRegionalized    *bool               `json:"isRegionalized"`
+				Defaults          endpoint            `json:"defaults"`
+				Endpoints         map[string]endpoint `json:"endpoints"`
+			} `json:"services"`
+		} `json:"partitions"`
+	}
+	if err := json.NewDecoder(in).Decode(&partitions); err != nil {
+		panic(err)
+	}
+
+	out, err := os.Create(os.Args[2])
+	if err != nil {
+		panic(err)
+	}
+	defer out.Close()
+
+	if err := model.GeneratePartitions(partitions, out); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// GeneratePartitions writes a Go file of the endpoint partitions to the given
+// writer.
+func GeneratePartitions(partitions interface{}, w io.Writer) error {
+	tmpl, err := template.New("partitions").Funcs(template.FuncMap{
+		"partitionVar": partitionVarName,
+		"boxedBool":    boxedBoolName,
+	}).Parse(partitionsTmpl)
+	if err != nil {
+		return err
+	}
+
+	out := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(out, partitions); err != nil {
+		return err
+	}
+
+	b, err := format.Source(bytes.TrimSpace(out.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, bytes.NewReader(b))
+	return err
+}
+
+// partitionVarName returns the name of the variable a partition is generated
+// as, e.g. "awscnPartition" for the "aws-cn" partition.
+func partitionVarName(id string) string {
+	return strings.Replace(id, "-", "", -1) + "Partition"
+}
+
+// boxedBoolName returns the name of the boxed value of an optional bool.
+func boxedBoolName(b *bool) string {
+	if *b {
+		return "boxedTrue"
+	}
+	return "boxedFalse"
+}
+
+const partitionsTmpl = `
+{{ define "endpoint" }}endpoint{
+	{{ if ne .Hostname "" }}Hostname: {{ printf "%q" .Hostname }},
+	{{ end }}{{ if ne .DualStackHostname "" }}DualStackHostname: {{ printf "%q" .DualStackHostname }},
+	{{ end }}{{ if ne .FIPSHostname "" }}FIPSHostname: {{ printf "%q" .FIPSHostname }},
+	{{ end }}{{ if or (ne .CredentialScope.Region "") (ne .CredentialScope.Service "") }}CredentialScope: credentialScope{
+		{{ if ne .CredentialScope.Region "" }}Region: {{ printf "%q" .CredentialScope.Region }},
+		{{ end }}{{ if ne .CredentialScope.Service "" }}Service: {{ printf "%q" .CredentialScope.Service }},
+		{{ end }}
+	},
+	{{ end }}
+}{{ end }}
+
+package endpoints
+
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+import "regexp"
+
+var defaultPartitions = partitions{
+	{{ range .Partitions }}{{ partitionVar .ID }},
+	{{ end }}
+}
+
+{{ range .Partitions }}
+var {{ partitionVar .ID }} = partition{
+	ID:          {{ printf "%q" .ID }},
+	Name:        {{ printf "%q" .Name }},
+	DNSSuffix:   {{ printf "%q" .DNSSuffix }},
+	RegionRegex: regionRegex{regexp.MustCompile({{ printf "%q" .RegionRegex }})},
+	Defaults:    {{ template "endpoint" .Defaults }},
+	Regions: regions{
+		{{ range $id, $region := .Regions }}{{ printf "%q" $id }}: region{
+			Description: {{ printf "%q" $region.Description }},
+		},
+		{{ end }}
+	},
+	Services: services{
+		{{ range $id, $service := .Services }}{{ printf "%q" $id }}: service{
+			{{ if ne $service.PartitionEndpoint "" }}PartitionEndpoint: {{ printf "%q" $service.PartitionEndpoint }},
+			{{ end }}{{ with $service.IsRegionalized }}IsRegionalized: {{ boxedBool . }},
+			{{ end }}Defaults: {{ template "endpoint" $service.Defaults }},
+			{{ if $service.Endpoints }}Endpoints: endpoints{
+				{{ range $key, $endpoint := $service.Endpoints }}{{ printf "%q" $key }}: {{ template "endpoint" $endpoint }},
+				{{ end }}
+			},
+			{{ end }}
+		},
+		{{ end }}
+	},
+}
+{{ end }}
+`
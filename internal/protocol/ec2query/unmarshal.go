@@ -11,6 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 )
 
+// UnmarshalHandler is a named request handler for unmarshaling ec2query protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "awssdk.ec2query.Unmarshal", Fn: Unmarshal}
+
 // Unmarshal unmarshals a response body for the EC2 protocol.
 func Unmarshal(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
@@ -24,9 +27,13 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling ec2query protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "awssdk.ec2query.UnmarshalMeta", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals response headers for the EC2 protocol.
 func UnmarshalMeta(r *aws.Request) {
-	// TODO implement unmarshaling of request IDs
+	aws.DecompressResponseBody(r)
+	r.RequestID = r.HTTPResponse.Header.Get("X-Amzn-Requestid")
 }
 
 type xmlErrorResponse struct {
@@ -36,6 +43,9 @@ type xmlErrorResponse struct {
 	RequestID string   `xml:"RequestId"`
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling ec2query protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "awssdk.ec2query.UnmarshalError", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals a response error for the EC2 protocol.
 func UnmarshalError(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
@@ -43,12 +53,19 @@ func UnmarshalError(r *aws.Request) {
 	resp := &xmlErrorResponse{}
 	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
 	if err != nil && err != io.EOF {
-		r.Error = awserr.New("SerializationError", "failed decoding EC2 Query error response", err)
+		r.Error = awserr.NewRequestFailure(
+			awserr.New("SerializationError", "failed decoding EC2 Query error response", err),
+			r.HTTPResponse.StatusCode,
+			r.RequestID,
+		)
 	} else {
+		if resp.RequestID != "" {
+			r.RequestID = resp.RequestID
+		}
 		r.Error = awserr.NewRequestFailure(
 			awserr.New(resp.Code, resp.Message, nil),
 			r.HTTPResponse.StatusCode,
-			resp.RequestID,
+			r.RequestID,
 		)
 	}
 }
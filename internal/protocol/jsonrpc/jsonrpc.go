@@ -17,6 +17,9 @@ import (
 
 var emptyJSON = []byte("{}")
 
+// BuildHandler is a named request handler for building jsonrpc protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "awssdk.jsonrpc.Build", Fn: Build}
+
 // Build builds a JSON payload for a JSON RPC request.
 func Build(req *aws.Request) {
 	var buf []byte
@@ -38,6 +41,10 @@ func Build(req *aws.Request) {
 	if req.Service.TargetPrefix != "" {
 		target := req.Service.TargetPrefix + "." + req.Operation.Name
 		req.HTTPRequest.Header.Add("X-Amz-Target", target)
+
+		// The responses of JSON RPC services are always unmarshaled, REST
+		// JSON requests accept gzip depending on their response payload.
+		aws.AcceptGzipEncoding(req)
 	}
 	if req.Service.JSONVersion != "" {
 		jsonVersion := req.Service.JSONVersion
@@ -45,6 +52,9 @@ func Build(req *aws.Request) {
 	}
 }
 
+// UnmarshalHandler is a named request handler for unmarshaling jsonrpc protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "awssdk.jsonrpc.Unmarshal", Fn: Unmarshal}
+
 // Unmarshal unmarshals a response for a JSON RPC service.
 func Unmarshal(req *aws.Request) {
 	defer req.HTTPResponse.Body.Close()
@@ -57,30 +67,45 @@ func Unmarshal(req *aws.Request) {
 	return
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling jsonrpc protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "awssdk.jsonrpc.UnmarshalMeta", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals headers from a response for a JSON RPC service.
 func UnmarshalMeta(req *aws.Request) {
+	aws.DecompressResponseBody(req)
 	req.RequestID = req.HTTPResponse.Header.Get("x-amzn-requestid")
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling jsonrpc protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "awssdk.jsonrpc.UnmarshalError", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals an error response for a JSON RPC service.
 func UnmarshalError(req *aws.Request) {
 	defer req.HTTPResponse.Body.Close()
 	bodyBytes, err := ioutil.ReadAll(req.HTTPResponse.Body)
 	if err != nil {
-		req.Error = awserr.New("SerializationError", "failed reading JSON RPC error response", err)
+		req.Error = awserr.NewRequestFailure(
+			awserr.New("SerializationError", "failed reading JSON RPC error response", err),
+			req.HTTPResponse.StatusCode,
+			req.RequestID,
+		)
 		return
 	}
 	if len(bodyBytes) == 0 {
 		req.Error = awserr.NewRequestFailure(
 			awserr.New("SerializationError", req.HTTPResponse.Status, nil),
 			req.HTTPResponse.StatusCode,
-			"",
+			req.RequestID,
 		)
 		return
 	}
 	var jsonErr jsonErrorResponse
 	if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
-		req.Error = awserr.New("SerializationError", "failed decoding JSON RPC error response", err)
+		req.Error = awserr.NewRequestFailure(
+			awserr.New("SerializationError", "failed decoding JSON RPC error response", err),
+			req.HTTPResponse.StatusCode,
+			req.RequestID,
+		)
 		return
 	}
 
@@ -88,7 +113,7 @@ func UnmarshalError(req *aws.Request) {
 	req.Error = awserr.NewRequestFailure(
 		awserr.New(codes[len(codes)-1], jsonErr.Message, nil),
 		req.HTTPResponse.StatusCode,
-		"",
+		req.RequestID,
 	)
 }
 
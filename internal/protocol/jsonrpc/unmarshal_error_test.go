@@ -0,0 +1,51 @@
+package jsonrpc_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/protocol/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func unmarshalErrorRequest(status int, body string) *aws.Request {
+	r := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{"X-Amzn-Requestid": []string{"request-id"}},
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+	jsonrpc.UnmarshalMeta(r)
+	jsonrpc.UnmarshalError(r)
+	return r
+}
+
+func TestUnmarshalError(t *testing.T) {
+	r := unmarshalErrorRequest(400, `{"__type": "com.amazonaws#ResourceNotFoundException", "message": "not found"}`)
+
+	err, ok := r.Error.(awserr.RequestFailure)
+	if assert.True(t, ok, "Expect a RequestFailure") {
+		assert.Equal(t, "ResourceNotFoundException", err.Code())
+		assert.Equal(t, "not found", err.Message())
+		assert.Equal(t, 400, err.StatusCode())
+		assert.Equal(t, "request-id", err.RequestID())
+	}
+}
+
+func TestUnmarshalErrorInvalidBody(t *testing.T) {
+	for _, body := range []string{"", "<html>"} {
+		r := unmarshalErrorRequest(503, body)
+
+		err, ok := r.Error.(awserr.RequestFailure)
+		if assert.True(t, ok, "Expect a RequestFailure for %q", body) {
+			assert.Equal(t, "SerializationError", err.Code())
+			assert.Equal(t, 503, err.StatusCode())
+			assert.Equal(t, "request-id", err.RequestID())
+		}
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/query/queryutil"
 )
 
+// BuildHandler is a named request handler for building query protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "awssdk.query.Build", Fn: Build}
+
 // Build builds a request for an AWS Query service.
 func Build(r *aws.Request) {
 	body := url.Values{
@@ -26,6 +29,7 @@ func Build(r *aws.Request) {
 		r.HTTPRequest.Method = "POST"
 		r.HTTPRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
 		r.SetBufferBody([]byte(body.Encode()))
+		aws.AcceptGzipEncoding(r)
 	} else { // This is a pre-signed request
 		r.HTTPRequest.Method = "GET"
 		r.HTTPRequest.URL.RawQuery = body.Encode()
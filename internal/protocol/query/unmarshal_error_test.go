@@ -0,0 +1,59 @@
+package query_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/protocol/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func unmarshalErrorRequest(status int, body string) *aws.Request {
+	r := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+	query.UnmarshalMeta(r)
+	query.UnmarshalError(r)
+	return r
+}
+
+func TestUnmarshalError(t *testing.T) {
+	r := unmarshalErrorRequest(400, `<ErrorResponse><Error><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>request-id</RequestId></ErrorResponse>`)
+
+	err, ok := r.Error.(awserr.RequestFailure)
+	if assert.True(t, ok, "Expect a RequestFailure") {
+		assert.Equal(t, "Throttling", err.Code())
+		assert.Equal(t, "Rate exceeded", err.Message())
+		assert.Equal(t, 400, err.StatusCode())
+		assert.Equal(t, "request-id", err.RequestID())
+	}
+}
+
+func TestUnmarshalErrorInvalidBody(t *testing.T) {
+	r := unmarshalErrorRequest(503, `<html><body>Service Unavailable`)
+
+	err, ok := r.Error.(awserr.RequestFailure)
+	if assert.True(t, ok, "Expect a RequestFailure") {
+		assert.Equal(t, "SerializationError", err.Code())
+		assert.Equal(t, 503, err.StatusCode())
+		assert.NotNil(t, err.OrigErr(), "Expect the decoding error")
+	}
+}
+
+func TestUnmarshalMetaRequestID(t *testing.T) {
+	r := unmarshalErrorRequest(500, `<html>`)
+	assert.Empty(t, r.RequestID)
+
+	r = aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{"X-Amzn-Requestid": []string{"header-request-id"}}}
+	query.UnmarshalMeta(r)
+	assert.Equal(t, "header-request-id", r.RequestID)
+}
@@ -36,13 +36,19 @@ func init() {
 	}
 }
 
-// Build builds the REST component of a service request.
+// Build builds the REST component of a service request. Requests accept gzip
+// compressed responses, unless the response payload is a blob, which is
+// returned to the caller as sent by the service.
 func Build(r *aws.Request) {
 	if r.ParamsFilled() {
 		v := reflect.ValueOf(r.Params).Elem()
 		buildLocationElements(r, v)
 		buildBody(r, v)
 	}
+
+	if t := PayloadType(r.Data); t == "structure" || t == "" {
+		aws.AcceptGzipEncoding(r)
+	}
 }
 
 func buildLocationElements(r *aws.Request, v reflect.Value) {
@@ -84,7 +90,7 @@ func buildLocationElements(r *aws.Request, v reflect.Value) {
 	}
 
 	r.HTTPRequest.URL.RawQuery = query.Encode()
-	updatePath(r.HTTPRequest.URL, r.HTTPRequest.URL.Path)
+	updatePath(r.HTTPRequest.URL, r.HTTPRequest.URL.Path, r.Config.DisableRestProtocolURICleaning)
 }
 
 func buildBody(r *aws.Request, v reflect.Value) {
@@ -153,11 +159,13 @@ func buildQueryString(r *aws.Request, v reflect.Value, name string, query url.Va
 	}
 }
 
-func updatePath(url *url.URL, urlPath string) {
+func updatePath(url *url.URL, urlPath string, disableURICleaning bool) {
 	scheme, query := url.Scheme, url.RawQuery
 
-	// clean up path
-	urlPath = path.Clean(urlPath)
+	// clean up path, unless the path must be sent as is
+	if !disableURICleaning {
+		urlPath = path.Clean(urlPath)
+	}
 
 	// get formatted URL minus scheme so we can build this into Opaque
 	url.Scheme, url.Path, url.RawQuery = "", "", ""
@@ -23,6 +23,19 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// UnmarshalMeta unmarshals the request ID of a response in a REST service,
+// and the REST component of the response. Gzip compressed responses are
+// decompressed first.
+func UnmarshalMeta(r *aws.Request) {
+	aws.DecompressResponseBody(r)
+	r.RequestID = r.HTTPResponse.Header.Get("X-Amzn-Requestid")
+	if r.RequestID == "" {
+		// S3 and some older services use a different header.
+		r.RequestID = r.HTTPResponse.Header.Get("X-Amz-Request-Id")
+	}
+	Unmarshal(r)
+}
+
 func unmarshalBody(r *aws.Request, v reflect.Value) {
 	if field, ok := v.Type().FieldByName("SDKShapeTraits"); ok {
 		if payloadName := field.Tag.Get("payload"); payloadName != "" {
@@ -50,7 +63,7 @@ func unmarshalBody(r *aws.Request, v reflect.Value) {
 						switch payload.Type().String() {
 						case "io.ReadSeeker":
 							payload.Set(reflect.ValueOf(aws.ReadSeekCloser(r.HTTPResponse.Body)))
-						case "aws.ReadSeekCloser", "io.ReadCloser":
+						case "io.ReadCloser":
 							payload.Set(reflect.ValueOf(r.HTTPResponse.Body))
 						default:
 							r.Error = awserr.New("SerializationError",
@@ -16,6 +16,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/rest"
 )
 
+// BuildHandler is a named request handler for building restjson protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "awssdk.restjson.Build", Fn: Build}
+
 // Build builds a request for the REST JSON protocol.
 func Build(r *aws.Request) {
 	rest.Build(r)
@@ -25,6 +28,9 @@ func Build(r *aws.Request) {
 	}
 }
 
+// UnmarshalHandler is a named request handler for unmarshaling restjson protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "awssdk.restjson.Unmarshal", Fn: Unmarshal}
+
 // Unmarshal unmarshals a response body for the REST JSON protocol.
 func Unmarshal(r *aws.Request) {
 	if t := rest.PayloadType(r.Data); t == "structure" || t == "" {
@@ -32,30 +38,44 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling restjson protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "awssdk.restjson.UnmarshalMeta", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals response headers for the REST JSON protocol.
 func UnmarshalMeta(r *aws.Request) {
-	rest.Unmarshal(r)
+	rest.UnmarshalMeta(r)
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling restjson protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "awssdk.restjson.UnmarshalError", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals a response error for the REST JSON protocol.
 func UnmarshalError(r *aws.Request) {
 	code := r.HTTPResponse.Header.Get("X-Amzn-Errortype")
 	bodyBytes, err := ioutil.ReadAll(r.HTTPResponse.Body)
 	if err != nil {
-		r.Error = awserr.New("SerializationError", "failed reading REST JSON error response", err)
+		r.Error = awserr.NewRequestFailure(
+			awserr.New("SerializationError", "failed reading REST JSON error response", err),
+			r.HTTPResponse.StatusCode,
+			r.RequestID,
+		)
 		return
 	}
 	if len(bodyBytes) == 0 {
 		r.Error = awserr.NewRequestFailure(
 			awserr.New("SerializationError", r.HTTPResponse.Status, nil),
 			r.HTTPResponse.StatusCode,
-			"",
+			r.RequestID,
 		)
 		return
 	}
 	var jsonErr jsonErrorResponse
 	if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
-		r.Error = awserr.New("SerializationError", "failed decoding REST JSON error response", err)
+		r.Error = awserr.NewRequestFailure(
+			awserr.New("SerializationError", "failed decoding REST JSON error response", err),
+			r.HTTPResponse.StatusCode,
+			r.RequestID,
+		)
 		return
 	}
 
@@ -67,7 +87,7 @@ func UnmarshalError(r *aws.Request) {
 	r.Error = awserr.NewRequestFailure(
 		awserr.New(codes[0], jsonErr.Message, nil),
 		r.HTTPResponse.StatusCode,
-		"",
+		r.RequestID,
 	)
 }
 
@@ -16,6 +16,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 )
 
+// BuildHandler is a named request handler for building restxml protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "awssdk.restxml.Build", Fn: Build}
+
 // Build builds a request payload for the REST XML protocol.
 func Build(r *aws.Request) {
 	rest.Build(r)
@@ -31,6 +34,9 @@ func Build(r *aws.Request) {
 	}
 }
 
+// UnmarshalHandler is a named request handler for unmarshaling restxml protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "awssdk.restxml.Unmarshal", Fn: Unmarshal}
+
 // Unmarshal unmarshals a payload response for the REST XML protocol.
 func Unmarshal(r *aws.Request) {
 	if t := rest.PayloadType(r.Data); t == "structure" || t == "" {
@@ -44,11 +50,17 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling restxml protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "awssdk.restxml.UnmarshalMeta", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals response headers for the REST XML protocol.
 func UnmarshalMeta(r *aws.Request) {
-	rest.Unmarshal(r)
+	rest.UnmarshalMeta(r)
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling restxml protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "awssdk.restxml.UnmarshalError", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals a response error for the REST XML protocol.
 func UnmarshalError(r *aws.Request) {
 	query.UnmarshalError(r)
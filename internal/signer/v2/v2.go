@@ -0,0 +1,175 @@
+// Package v2 implements signing for AWS Signature Version 2, which legacy
+// query protocol services such as Amazon SimpleDB require.
+package v2
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+const (
+	signatureVersion = "2"
+	signatureMethod  = "HmacSHA256"
+	timeFormat       = "2006-01-02T15:04:05Z"
+)
+
+var errInvalidMethod = awserr.New("InvalidMethod",
+	"v2 signer only handles HTTP POST and GET", nil)
+
+type signer struct {
+	Request     *http.Request
+	Time        time.Time
+	Credentials *credentials.Credentials
+	Context     aws.Context
+	Query       url.Values
+
+	stringToSign string
+	signature    string
+}
+
+// SignRequestHandler is a named request handler the SDK will use to sign
+// service client requests with the V2 signature.
+var SignRequestHandler = aws.NamedHandler{Name: "awssdk.v2.Sign", Fn: Sign}
+
+// Sign requests with signature version 2.
+//
+// The authentication parameters are added to the form encoded body of POST
+// requests, or the query string of GET requests. Signing is skipped if the
+// credentials is the credentials.AnonymousCredentials object.
+func Sign(req *aws.Request) {
+	// If the request does not need to be signed ignore the signing of the
+	// request if the AnonymousCredentials object is used.
+	if req.Service.Config.Credentials == credentials.AnonymousCredentials {
+		return
+	}
+
+	method := req.HTTPRequest.Method
+	if method != "POST" && method != "GET" {
+		req.Error = errInvalidMethod
+		return
+	}
+
+	s := signer{
+		Request:     req.HTTPRequest,
+		Time:        req.Time,
+		Credentials: req.Service.Config.Credentials,
+		Context:     req.Context(),
+	}
+
+	if method == "POST" {
+		var body []byte
+		if req.Body != nil {
+			req.Body.Seek(0, 0)
+			body, _ = ioutil.ReadAll(req.Body)
+		}
+		query, err := url.ParseQuery(string(body))
+		if err != nil {
+			req.Error = awserr.New("SerializationError", "failed to parse request body", err)
+			return
+		}
+		s.Query = query
+	} else {
+		s.Query = req.HTTPRequest.URL.Query()
+	}
+
+	if req.Error = s.sign(); req.Error != nil {
+		return
+	}
+
+	if method == "POST" {
+		// The signature is part of the body, so the body's length computed
+		// before signing is no longer correct.
+		body := s.Query.Encode()
+		req.SetStringBody(body)
+		req.HTTPRequest.Header.Del("Content-Length")
+		req.HTTPRequest.ContentLength = int64(len(body))
+	} else {
+		req.HTTPRequest.URL.RawQuery = s.Query.Encode()
+	}
+}
+
+func (v2 *signer) sign() error {
+	ctx := v2.Context
+	if ctx == nil {
+		ctx = aws.BackgroundContext()
+	}
+
+	creds, err := v2.Credentials.GetWithContext(ctx)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return awserr.New(aws.ErrCodeRequestCanceled,
+				"request context canceled", ctxErr)
+		}
+		return err
+	}
+
+	// Remove the signature of a previous attempt.
+	v2.Query.Del("Signature")
+	v2.Query.Set("AWSAccessKeyId", creds.AccessKeyID)
+	v2.Query.Set("SignatureVersion", signatureVersion)
+	v2.Query.Set("SignatureMethod", signatureMethod)
+	v2.Query.Set("Timestamp", v2.Time.UTC().Format(timeFormat))
+	if creds.SessionToken != "" {
+		v2.Query.Set("SecurityToken", creds.SessionToken)
+	} else {
+		v2.Query.Del("SecurityToken")
+	}
+
+	v2.buildStringToSign()
+
+	hash := hmac.New(sha256.New, []byte(creds.SecretAccessKey))
+	hash.Write([]byte(v2.stringToSign))
+	v2.signature = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	v2.Query.Set("Signature", v2.signature)
+
+	return nil
+}
+
+// buildStringToSign builds the string to sign of the request's method, host,
+// path, and its parameters sorted by name.
+func (v2 *signer) buildStringToSign() {
+	keys := make([]string, 0, len(v2.Query))
+	for k := range v2.Query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range v2.Query[k] {
+			params = append(params, escape(k)+"="+escape(v))
+		}
+	}
+
+	path := v2.Request.URL.Path
+	if path == "" {
+		path = "/"
+	}
+
+	v2.stringToSign = strings.Join([]string{
+		v2.Request.Method,
+		strings.ToLower(v2.Request.URL.Host),
+		path,
+		strings.Join(params, "&"),
+	}, "\n")
+}
+
+// escape percent encodes s as RFC 3986 requires, which Signature Version 2
+// canonicalizes parameters with.
+func escape(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.Replace(s, "+", "%20", -1)
+	s = strings.Replace(s, "%7E", "~", -1)
+	return s
+}
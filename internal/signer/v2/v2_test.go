@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io/ioutil"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildRequest(method, body string) *aws.Request {
+	r := aws.NewRequest(
+		aws.NewService(&aws.Config{
+			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+			Endpoint:    "https://SDB.amazonaws.com",
+		}),
+		&aws.Operation{
+			Name:       "Select",
+			HTTPMethod: method,
+			HTTPPath:   "/",
+		},
+		nil,
+		nil,
+	)
+	r.Time = time.Unix(0, 0)
+	if method == "POST" {
+		r.SetStringBody(body)
+	} else {
+		r.HTTPRequest.URL.RawQuery = body
+	}
+	return r
+}
+
+func expectedSignature(stringToSign string) string {
+	hash := hmac.New(sha256.New, []byte("SECRET"))
+	hash.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil))
+}
+
+func TestSignPost(t *testing.T) {
+	r := buildRequest("POST", "Action=Select&SelectExpression=select+*+from+%60my-domain%60&Version=2009-04-15")
+	Sign(r)
+	assert.Nil(t, r.Error)
+
+	body, _ := ioutil.ReadAll(r.Body)
+	q, err := url.ParseQuery(string(body))
+	assert.Nil(t, err)
+	assert.Equal(t, "AKID", q.Get("AWSAccessKeyId"))
+	assert.Equal(t, "2", q.Get("SignatureVersion"))
+	assert.Equal(t, "HmacSHA256", q.Get("SignatureMethod"))
+	assert.Equal(t, "1970-01-01T00:00:00Z", q.Get("Timestamp"))
+	assert.Equal(t, "SESSION", q.Get("SecurityToken"))
+	assert.Equal(t, int64(len(body)), r.HTTPRequest.ContentLength)
+
+	expected := expectedSignature("POST\nsdb.amazonaws.com\n/\n" +
+		"AWSAccessKeyId=AKID&Action=Select&SecurityToken=SESSION" +
+		"&SelectExpression=select%20%2A%20from%20%60my-domain%60" +
+		"&SignatureMethod=HmacSHA256&SignatureVersion=2" +
+		"&Timestamp=1970-01-01T00%3A00%3A00Z&Version=2009-04-15")
+	assert.Equal(t, expected, q.Get("Signature"))
+}
+
+func TestSignGet(t *testing.T) {
+	r := buildRequest("GET", "Action=ListDomains&Version=2009-04-15&Name=a~b")
+	Sign(r)
+	assert.Nil(t, r.Error)
+
+	q := r.HTTPRequest.URL.Query()
+	expected := expectedSignature("GET\nsdb.amazonaws.com\n/\n" +
+		"AWSAccessKeyId=AKID&Action=ListDomains&Name=a~b&SecurityToken=SESSION" +
+		"&SignatureMethod=HmacSHA256&SignatureVersion=2" +
+		"&Timestamp=1970-01-01T00%3A00%3A00Z&Version=2009-04-15")
+	assert.Equal(t, expected, q.Get("Signature"))
+}
+
+func TestResign(t *testing.T) {
+	r := buildRequest("POST", "Action=ListDomains&Version=2009-04-15")
+	Sign(r)
+	body, _ := ioutil.ReadAll(r.Body)
+	first, _ := url.ParseQuery(string(body))
+
+	r.Time = time.Unix(60, 0)
+	Sign(r)
+	body, _ = ioutil.ReadAll(r.Body)
+	second, _ := url.ParseQuery(string(body))
+
+	assert.Len(t, second["Signature"], 1, "Expect the previous signature to be replaced")
+	assert.NotEqual(t, first.Get("Signature"), second.Get("Signature"))
+	assert.Equal(t, "1970-01-01T00:01:00Z", second.Get("Timestamp"))
+}
+
+func TestSignInvalidMethod(t *testing.T) {
+	r := buildRequest("PUT", "")
+	Sign(r)
+	assert.Equal(t, errInvalidMethod, r.Error)
+}
+
+func TestAnonymousCredentials(t *testing.T) {
+	r := buildRequest("POST", "Action=ListDomains&Version=2009-04-15")
+	r.Service.Config.Credentials = credentials.AnonymousCredentials
+	Sign(r)
+
+	body, _ := ioutil.ReadAll(r.Body)
+	assert.Equal(t, "Action=ListDomains&Version=2009-04-15", string(body))
+}
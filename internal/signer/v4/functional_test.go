@@ -1,12 +1,14 @@
 package v4_test
 
 import (
+	"net/http"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/internal/test/unit"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/stretchr/testify/assert"
 )
@@ -41,3 +43,47 @@ func TestPresignHandler(t *testing.T) {
 
 	assert.NotContains(t, urlstr, "+") // + encoded as %20
 }
+
+func TestPresignRequest(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		ACL:    aws.String("public-read"),
+	})
+	urlstr, header, err := req.PresignRequest(5 * time.Minute)
+
+	assert.NoError(t, err)
+	assert.Contains(t, urlstr, "X-Amz-Signature=")
+	assert.Equal(t, "PUT", req.HTTPRequest.Method)
+	assert.Equal(t, http.Header{"X-Amz-Acl": {"public-read"}}, header)
+}
+
+func TestPresignRequestJSON(t *testing.T) {
+	svc := dynamodb.New(nil)
+	req, _ := svc.ListTablesRequest(&dynamodb.ListTablesInput{})
+	urlstr, header, err := req.PresignRequest(5 * time.Minute)
+
+	assert.NoError(t, err)
+	u, _ := url.Parse(urlstr)
+	assert.Equal(t, "host;x-amz-target", u.Query().Get("X-Amz-SignedHeaders"))
+	assert.Equal(t, "POST", req.HTTPRequest.Method)
+	assert.Equal(t, "DynamoDB_20120810.ListTables", header.Get("X-Amz-Target"))
+	assert.Len(t, header, 1)
+}
+
+func TestPresignExpireTimeLimit(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+
+	_, err := req.Presign(aws.MaxPresignExpireTime + time.Second)
+	assert.Error(t, err)
+
+	urlstr, err := req.Presign(aws.MaxPresignExpireTime)
+	assert.NoError(t, err)
+	u, _ := url.Parse(urlstr)
+	assert.Equal(t, "604800", u.Query().Get("X-Amz-Expires"))
+}
@@ -0,0 +1,138 @@
+package v4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+const (
+	// StreamingPayload is the X-Amz-Content-Sha256 of requests whose body is
+	// signed chunk by chunk as it is sent, in the aws-chunked content
+	// encoding, instead of the hash of the whole body being signed upfront.
+	StreamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+	streamingChunkPrefix   = "AWS4-HMAC-SHA256-PAYLOAD"
+	streamingChunkSize     = 64 * 1024
+	decodedLengthHeader    = "X-Amz-Decoded-Content-Length"
+	chunkedContentEncoding = "aws-chunked"
+	chunkSignatureField    = ";chunk-signature="
+)
+
+// isStreaming returns if the request's body is signed chunk by chunk.
+func (v4 *signer) isStreaming() bool {
+	return !v4.isPresign && v4.Request.Header.Get("X-Amz-Content-Sha256") == StreamingPayload
+}
+
+// buildStreamingHeaders sets the headers of the aws-chunked encoding of the
+// body, which are signed. The decoded length of requests signed again, e.g.
+// for retries, is read from the X-Amz-Decoded-Content-Length header, since
+// the Content-Length is already set to the encoded length.
+func (v4 *signer) buildStreamingHeaders() {
+	length := v4.Request.ContentLength
+	if l, err := strconv.ParseInt(v4.Request.Header.Get(decodedLengthHeader), 10, 64); err == nil {
+		length = l
+	}
+	v4.Request.Header.Set(decodedLengthHeader, strconv.FormatInt(length, 10))
+
+	encoding := v4.Request.Header.Get("Content-Encoding")
+	if encoding == "" {
+		encoding = chunkedContentEncoding
+	} else if !strings.HasPrefix(encoding, chunkedContentEncoding) {
+		encoding = chunkedContentEncoding + "," + encoding
+	}
+	v4.Request.Header.Set("Content-Encoding", encoding)
+}
+
+// buildStreamingBody replaces the body of the request with its aws-chunked
+// encoding, whose chunks are signed starting with the request's signature.
+func (v4 *signer) buildStreamingBody() {
+	length, _ := strconv.ParseInt(v4.Request.Header.Get(decodedLengthHeader), 10, 64)
+
+	var body io.Reader = bytes.NewReader(nil)
+	if v4.Body != nil {
+		body = io.LimitReader(v4.Body, length)
+	}
+
+	v4.Request.Body = ioutil.NopCloser(&chunkedReader{
+		body:      body,
+		key:       SigningKey(v4.CredValues.SecretAccessKey, v4.Time, v4.Region, v4.ServiceName),
+		time:      v4.formattedTime,
+		scope:     v4.credentialString,
+		signature: v4.signature,
+		chunk:     make([]byte, streamingChunkSize),
+	})
+	v4.Request.ContentLength = chunkedLength(length, streamingChunkSize)
+	v4.Request.Header.Set("Content-Length", strconv.FormatInt(v4.Request.ContentLength, 10))
+}
+
+// chunkedLength returns the length of the aws-chunked encoding of a body of
+// length bytes in chunks of size bytes, including the final empty chunk.
+func chunkedLength(length, size int64) int64 {
+	chunkLength := func(n int64) int64 {
+		return int64(len(strconv.FormatInt(n, 16))+len(chunkSignatureField)+64+2) + n + 2
+	}
+
+	encoded := (length/size)*chunkLength(size) + chunkLength(0)
+	if rem := length % size; rem > 0 {
+		encoded += chunkLength(rem)
+	}
+	return encoded
+}
+
+// A chunkedReader reads the aws-chunked encoding of body. Each chunk is
+// prefixed by its size and its signature, which signs the chunk's data and
+// the signature of the previous chunk:
+//
+//     hex(size);chunk-signature=signature\r\n
+//     data\r\n
+//
+// The body ends with an empty, signed, chunk.
+type chunkedReader struct {
+	body      io.Reader
+	key       []byte
+	time      string
+	scope     string
+	signature string
+
+	chunk []byte
+	buf   bytes.Buffer
+	done  bool
+}
+
+func (r *chunkedReader) Read(p []byte) (int, error) {
+	if r.buf.Len() == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(r.body, r.chunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		r.writeChunk(r.chunk[:n])
+		r.done = n == 0
+	}
+	return r.buf.Read(p)
+}
+
+// writeChunk signs the chunk and writes it to the buffer.
+func (r *chunkedReader) writeChunk(chunk []byte) {
+	stringToSign := strings.Join([]string{
+		streamingChunkPrefix,
+		r.time,
+		r.scope,
+		r.signature,
+		hex.EncodeToString(makeSha256([]byte{})),
+		hex.EncodeToString(makeSha256(chunk)),
+	}, "\n")
+	r.signature = hex.EncodeToString(makeHmac(r.key, []byte(stringToSign)))
+
+	fmt.Fprintf(&r.buf, "%x%s%s\r\n", len(chunk), chunkSignatureField, r.signature)
+	r.buf.Write(chunk)
+	r.buf.WriteString("\r\n")
+}
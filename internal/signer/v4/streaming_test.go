@@ -0,0 +1,93 @@
+package v4
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+// The example of the Amazon S3 documentation, "Signature Calculations for the
+// Authorization Header: Transferring Payload in Multiple Chunks".
+func TestChunkedReader(t *testing.T) {
+	signTime := time.Date(2013, 5, 24, 0, 0, 0, 0, time.UTC)
+	body := bytes.Repeat([]byte("a"), 66560)
+
+	r := &chunkedReader{
+		body:      bytes.NewReader(body),
+		key:       SigningKey("wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", signTime, "us-east-1", "s3"),
+		time:      "20130524T000000Z",
+		scope:     "20130524/us-east-1/s3/aws4_request",
+		signature: "4f232c4386841ef735655705268965c44a0e4690baa4adea153f7db9fa80a0a9",
+		chunk:     make([]byte, streamingChunkSize),
+	}
+	b, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+
+	expected := "10000;chunk-signature=ad80c730a21e5b8d04586a2213dd63b9a0e99e0e2307b0ade35a65485a288648\r\n" +
+		string(body[:65536]) + "\r\n" +
+		"400;chunk-signature=0055627c9e194cb4542bae2aa5492e3c1575bbb81b612b7d234b86a503ef5497\r\n" +
+		string(body[65536:]) + "\r\n" +
+		"0;chunk-signature=b6c6ea8a5354eaf15b3cb7646744f4275b71ea724fed81ceb9323e279d449df9\r\n\r\n"
+	assert.Equal(t, expected, string(b))
+	assert.Equal(t, int64(66824), chunkedLength(66560, streamingChunkSize))
+	assert.Equal(t, int64(len(b)), chunkedLength(66560, streamingChunkSize))
+}
+
+func TestChunkedLength(t *testing.T) {
+	for _, n := range []int64{0, 1, streamingChunkSize - 1, streamingChunkSize, streamingChunkSize + 1, 3 * streamingChunkSize} {
+		r := &chunkedReader{
+			body:  bytes.NewReader(make([]byte, n)),
+			chunk: make([]byte, streamingChunkSize),
+		}
+		b, _ := ioutil.ReadAll(r)
+		assert.Equal(t, int64(len(b)), chunkedLength(n, streamingChunkSize), "length %d", n)
+	}
+}
+
+func TestSignStreaming(t *testing.T) {
+	body := strings.NewReader("hello world")
+	req, _ := http.NewRequest("PUT", "https://bucket.s3.amazonaws.com/key", body)
+	req.ContentLength = 11
+	req.Header.Set("X-Amz-Content-Sha256", StreamingPayload)
+	req.Header.Set("Content-Encoding", "gzip")
+
+	s := signer{
+		Request:     req,
+		Time:        time.Unix(0, 0),
+		Query:       req.URL.Query(),
+		Body:        body,
+		ServiceName: "s3",
+		Region:      "us-east-1",
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	}
+	assert.NoError(t, s.sign())
+
+	assert.Contains(t, req.Header.Get("Authorization"),
+		"SignedHeaders=content-encoding;host;x-amz-content-sha256;x-amz-date;x-amz-decoded-content-length,")
+	assert.Equal(t, "aws-chunked,gzip", req.Header.Get("Content-Encoding"))
+	assert.Equal(t, "11", req.Header.Get("X-Amz-Decoded-Content-Length"))
+
+	b, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, req.ContentLength, int64(len(b)))
+	assert.True(t, strings.HasPrefix(string(b), "b;chunk-signature="), string(b))
+	assert.Contains(t, string(b), "\r\nhello world\r\n0;chunk-signature=")
+
+	// Signing again, e.g. for a retry, signs the decoded body again.
+	body.Seek(0, 0)
+	s.Time = time.Unix(60, 0)
+	assert.NoError(t, s.sign())
+	assert.Equal(t, "aws-chunked,gzip", req.Header.Get("Content-Encoding"))
+	assert.Equal(t, "11", req.Header.Get("X-Amz-Decoded-Content-Length"))
+
+	retried, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, len(b), len(retried))
+	assert.NotEqual(t, string(b), string(retried), "Expect chunks to be signed again")
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/internal/protocol/rest"
 
@@ -21,30 +22,42 @@ import (
 )
 
 const (
-	authHeaderPrefix = "AWS4-HMAC-SHA256"
-	timeFormat       = "20060102T150405Z"
-	shortTimeFormat  = "20060102"
+	authHeaderPrefix    = "AWS4-HMAC-SHA256"
+	authHeaderPrefixV4a = "AWS4-ECDSA-P256-SHA256"
+	regionSetHeader     = "X-Amz-Region-Set"
+	regionSetAllRegions = "*"
+	timeFormat          = "20060102T150405Z"
+	shortTimeFormat     = "20060102"
 )
 
+// UnsignedPayload is the X-Amz-Content-Sha256 of S3 requests whose body is
+// not signed, e.g. presigned requests or requests sent over HTTPS whose body
+// is too large to be hashed upfront.
+const UnsignedPayload = "UNSIGNED-PAYLOAD"
+
 var ignoredHeaders = map[string]bool{
-	"Authorization":  true,
-	"Content-Type":   true,
-	"Content-Length": true,
-	"User-Agent":     true,
+	"Authorization":   true,
+	"Content-Type":    true,
+	"Content-Length":  true,
+	"User-Agent":      true,
+	"X-Amzn-Trace-Id": true,
 }
 
 type signer struct {
 	Request     *http.Request
 	Time        time.Time
 	ExpireTime  time.Duration
+	Presigning  aws.PresignOptions
 	ServiceName string
 	Region      string
+	RegionSet   string
 	CredValues  credentials.Value
 	Credentials *credentials.Credentials
+	Context     aws.Context
 	Query       url.Values
 	Body        io.ReadSeeker
-	Debug       uint
-	Logger      io.Writer
+	Debug       aws.LogLevelType
+	Logger      aws.Logger
 
 	isPresign          bool
 	formattedTime      string
@@ -59,12 +72,37 @@ type signer struct {
 	authorization    string
 }
 
+// SignRequestHandler is a named request handler the SDK will use to sign
+// service client requests with the V4 signature.
+var SignRequestHandler = aws.NamedHandler{Name: "awssdk.v4.Sign", Fn: Sign}
+
+// SignV4aRequestHandler is a named request handler which signs service
+// client requests with the asymmetric SigV4a signature instead, see SignV4a.
+var SignV4aRequestHandler = aws.NamedHandler{Name: "awssdk.v4a.Sign", Fn: SignV4a}
+
 // Sign requests with signature version 4.
 //
 // Will sign the requests with the service config's Credentials object
 // Signing is skipped if the credentials is the credentials.AnonymousCredentials
 // object.
 func Sign(req *aws.Request) {
+	signRequest(req, "")
+}
+
+// SignV4a signs requests with SigV4a, the asymmetric variant of signature
+// version 4. The signature is an ECDSA P-256 signature with a key derived from
+// the credentials, which is valid in all regions instead of the request's
+// region, e.g. for requests to multi-region resources.
+//
+// Signing is skipped if the credentials is the
+// credentials.AnonymousCredentials object.
+func SignV4a(req *aws.Request) {
+	signRequest(req, regionSetAllRegions)
+}
+
+// signRequest signs req with signature version 4, or SigV4a valid in the
+// regions of regionSet if it is not empty.
+func signRequest(req *aws.Request, regionSet string) {
 	// If the request does not need to be signed ignore the signing of the
 	// request if the AnonymousCredentials object is used.
 	if req.Service.Config.Credentials == credentials.AnonymousCredentials {
@@ -85,11 +123,14 @@ func Sign(req *aws.Request) {
 		Request:     req.HTTPRequest,
 		Time:        req.Time,
 		ExpireTime:  req.ExpireTime,
+		Presigning:  req.Presigning,
 		Query:       req.HTTPRequest.URL.Query(),
 		Body:        req.Body,
 		ServiceName: name,
 		Region:      region,
+		RegionSet:   regionSet,
 		Credentials: req.Service.Config.Credentials,
+		Context:     req.Context(),
 		Debug:       req.Service.Config.LogLevel,
 		Logger:      req.Service.Config.Logger,
 	}
@@ -97,48 +138,92 @@ func Sign(req *aws.Request) {
 	req.Error = s.sign()
 }
 
+// SignHTTPRequest signs req with creds for the service and region with
+// signature version 4 at signTime, computing the payload hash from body. If
+// expireTime is not 0 req is presigned as customized by opts. Existing
+// signatures of req are replaced. It implements the aws/signer/v4 package.
+func SignHTTPRequest(ctx aws.Context, req *http.Request, body io.ReadSeeker, creds *credentials.Credentials,
+	service, region string, signTime time.Time, expireTime time.Duration, opts aws.PresignOptions) error {
+	s := signer{
+		Request:     req,
+		Time:        signTime,
+		ExpireTime:  expireTime,
+		Presigning:  opts,
+		Query:       req.URL.Query(),
+		Body:        body,
+		ServiceName: service,
+		Region:      region,
+		Credentials: creds,
+		Context:     ctx,
+	}
+
+	s.removePresign()
+	req.Header.Del("Authorization")
+	req.Header.Del("X-Amz-Date")
+	req.Header.Del("X-Amz-Security-Token")
+	req.Header.Del(regionSetHeader)
+	req.URL.RawQuery = s.Query.Encode()
+
+	return s.sign()
+}
+
 func (v4 *signer) sign() error {
 	if v4.ExpireTime != 0 {
 		v4.isPresign = true
 	}
 
-	if v4.isRequestSigned() {
+	// Requests are signed again each time they are sent, e.g. when they are
+	// retried, with the request's current time and credentials. Only
+	// presigned requests are kept until their credentials expire.
+	if v4.isPresign && v4.isRequestSigned() {
 		if !v4.Credentials.IsExpired() {
-			// If the request is already signed, and the credentials have not
-			// expired yet ignore the signing request.
+			// If the request is already presigned, and the credentials have
+			// not expired yet ignore the signing request.
 			return nil
 		}
 
 		// The credentials have expired for this request. The current signing
 		// is invalid, and needs to be request because the request will fail.
-		if v4.isPresign {
-			v4.removePresign()
-			// Update the request's query string to ensure the values stays in
-			// sync in the case retrieving the new credentials fails.
-			v4.Request.URL.RawQuery = v4.Query.Encode()
-		}
+		v4.removePresign()
+		// Update the request's query string to ensure the values stays in
+		// sync in the case retrieving the new credentials fails.
+		v4.Request.URL.RawQuery = v4.Query.Encode()
+	}
+
+	ctx := v4.Context
+	if ctx == nil {
+		ctx = aws.BackgroundContext()
 	}
 
 	var err error
-	v4.CredValues, err = v4.Credentials.Get()
+	v4.CredValues, err = v4.Credentials.GetWithContext(ctx)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return awserr.New(aws.ErrCodeRequestCanceled,
+				"request context canceled", ctxErr)
+		}
 		return err
 	}
 
 	if v4.isPresign {
-		v4.Query.Set("X-Amz-Algorithm", authHeaderPrefix)
-		if v4.CredValues.SessionToken != "" {
+		v4.Query.Set("X-Amz-Algorithm", v4.algorithm())
+		if v4.CredValues.SessionToken != "" && !v4.Presigning.UnsignedSessionToken {
 			v4.Query.Set("X-Amz-Security-Token", v4.CredValues.SessionToken)
 		} else {
 			v4.Query.Del("X-Amz-Security-Token")
 		}
 	} else if v4.CredValues.SessionToken != "" {
 		v4.Request.Header.Set("X-Amz-Security-Token", v4.CredValues.SessionToken)
+	} else {
+		// Remove the token of the credentials the request was last signed with.
+		v4.Request.Header.Del("X-Amz-Security-Token")
 	}
 
-	v4.build()
+	if err := v4.build(); err != nil {
+		return err
+	}
 
-	if v4.Debug > 0 {
+	if v4.Debug.Matches(aws.LogDebugWithSigning) {
 		v4.logSigningInfo()
 	}
 
@@ -146,40 +231,71 @@ func (v4 *signer) sign() error {
 }
 
 func (v4 *signer) logSigningInfo() {
-	out := v4.Logger
-	fmt.Fprintf(out, "---[ CANONICAL STRING  ]-----------------------------\n")
-	fmt.Fprintln(out, v4.canonicalString)
-	fmt.Fprintf(out, "---[ STRING TO SIGN ]--------------------------------\n")
-	fmt.Fprintln(out, v4.stringToSign)
+	signedURLMsg := ""
 	if v4.isPresign {
-		fmt.Fprintf(out, "---[ SIGNED URL ]--------------------------------\n")
-		fmt.Fprintln(out, v4.Request.URL)
+		signedURLMsg = fmt.Sprintf(logSignedURLMsg, v4.Request.URL.String())
+	}
+	msg := fmt.Sprintf(logSignInfoMsg, v4.canonicalString, v4.stringToSign,
+		strings.Replace(v4.signedHeaders, ";", "\n", -1), signedURLMsg)
+	if token := v4.CredValues.SessionToken; token != "" {
+		// Never log the session token the request was signed with.
+		msg = strings.Replace(msg, token, "<sensitive>", -1)
+		msg = strings.Replace(msg, url.QueryEscape(token), "<sensitive>", -1)
 	}
-	fmt.Fprintf(out, "-----------------------------------------------------\n")
+	v4.Logger.Log(msg)
 }
 
-func (v4 *signer) build() {
+const logSignInfoMsg = `DEBUG: Request Signature:
+---[ CANONICAL STRING  ]-----------------------------
+%s
+---[ STRING TO SIGN ]--------------------------------
+%s
+---[ SIGNED HEADERS ]--------------------------------
+%s%s
+-----------------------------------------------------`
+const logSignedURLMsg = `
+---[ SIGNED URL ]------------------------------------
+%s`
+
+func (v4 *signer) build() error {
 
 	v4.buildTime()             // no depends
 	v4.buildCredentialString() // no depends
 	if v4.isPresign {
 		v4.buildQuery() // no depends
 	}
+	if v4.isStreaming() {
+		v4.buildStreamingHeaders() // no depends
+	}
 	v4.buildCanonicalHeaders() // depends on cred string
 	v4.buildCanonicalString()  // depends on canon headers / signed headers
 	v4.buildStringToSign()     // depends on canon string
-	v4.buildSignature()        // depends on string to sign
+	if v4.RegionSet != "" {
+		if err := v4.buildSignatureV4a(); err != nil { // depends on string to sign
+			return awserr.New("SigningError", "failed to sign request with SigV4a", err)
+		}
+	} else {
+		v4.buildSignature() // depends on string to sign
+	}
 
 	if v4.isPresign {
 		v4.Request.URL.RawQuery += "&X-Amz-Signature=" + v4.signature
+		if v4.CredValues.SessionToken != "" && v4.Presigning.UnsignedSessionToken {
+			v4.Request.URL.RawQuery += "&X-Amz-Security-Token=" + url.QueryEscape(v4.CredValues.SessionToken)
+		}
 	} else {
 		parts := []string{
-			authHeaderPrefix + " Credential=" + v4.CredValues.AccessKeyID + "/" + v4.credentialString,
+			v4.algorithm() + " Credential=" + v4.CredValues.AccessKeyID + "/" + v4.credentialString,
 			"SignedHeaders=" + v4.signedHeaders,
 			"Signature=" + v4.signature,
 		}
 		v4.Request.Header.Set("Authorization", strings.Join(parts, ", "))
+
+		if v4.isStreaming() {
+			v4.buildStreamingBody() // depends on signature
+		}
 	}
+	return nil
 }
 
 func (v4 *signer) buildTime() {
@@ -195,16 +311,30 @@ func (v4 *signer) buildTime() {
 	}
 }
 
+// algorithm returns the signing algorithm of the request's signature.
+func (v4 *signer) algorithm() string {
+	if v4.RegionSet != "" {
+		return authHeaderPrefixV4a
+	}
+	return authHeaderPrefix
+}
+
 func (v4 *signer) buildCredentialString() {
-	v4.credentialString = strings.Join([]string{
-		v4.formattedShortTime,
-		v4.Region,
-		v4.ServiceName,
-		"aws4_request",
-	}, "/")
+	scope := []string{v4.formattedShortTime, v4.Region, v4.ServiceName, "aws4_request"}
+	if v4.RegionSet != "" {
+		// SigV4a signatures are not scoped to a region, the regions they are
+		// valid in are signed as the region set instead.
+		scope = append(scope[:1], scope[2:]...)
+	}
+	v4.credentialString = strings.Join(scope, "/")
 
 	if v4.isPresign {
 		v4.Query.Set("X-Amz-Credential", v4.CredValues.AccessKeyID+"/"+v4.credentialString)
+		if v4.RegionSet != "" {
+			v4.Query.Set(regionSetHeader, v4.RegionSet)
+		}
+	} else if v4.RegionSet != "" {
+		v4.Request.Header.Set(regionSetHeader, v4.RegionSet)
 	}
 }
 
@@ -216,6 +346,9 @@ func (v4 *signer) buildQuery() {
 		if _, ok := ignoredHeaders[http.CanonicalHeaderKey(k)]; ok {
 			continue // never hoist ignored headers
 		}
+		if v4.isSignedHeader(k) {
+			continue // sign as header
+		}
 
 		v4.Request.Header.Del(k)
 		v4.Query.Del(k)
@@ -225,11 +358,25 @@ func (v4 *signer) buildQuery() {
 	}
 }
 
+// isSignedHeader returns if the header is one of the headers the request is
+// presigned with, see aws.PresignOptions.
+func (v4 *signer) isSignedHeader(k string) bool {
+	if !v4.isPresign || http.CanonicalHeaderKey(k) == "Authorization" {
+		return false
+	}
+	for _, h := range v4.Presigning.SignedHeaders {
+		if http.CanonicalHeaderKey(h) == http.CanonicalHeaderKey(k) {
+			return true
+		}
+	}
+	return false
+}
+
 func (v4 *signer) buildCanonicalHeaders() {
 	var headers []string
 	headers = append(headers, "host")
 	for k := range v4.Request.Header {
-		if _, ok := ignoredHeaders[http.CanonicalHeaderKey(k)]; ok {
+		if _, ok := ignoredHeaders[http.CanonicalHeaderKey(k)]; ok && !v4.isSignedHeader(k) {
 			continue // ignored header
 		}
 		headers = append(headers, strings.ToLower(k))
@@ -283,7 +430,7 @@ func (v4 *signer) buildCanonicalString() {
 
 func (v4 *signer) buildStringToSign() {
 	v4.stringToSign = strings.Join([]string{
-		authHeaderPrefix,
+		v4.algorithm(),
 		v4.formattedTime,
 		v4.credentialString,
 		hex.EncodeToString(makeSha256([]byte(v4.canonicalString))),
@@ -291,20 +438,26 @@ func (v4 *signer) buildStringToSign() {
 }
 
 func (v4 *signer) buildSignature() {
-	secret := v4.CredValues.SecretAccessKey
-	date := makeHmac([]byte("AWS4"+secret), []byte(v4.formattedShortTime))
-	region := makeHmac(date, []byte(v4.Region))
-	service := makeHmac(region, []byte(v4.ServiceName))
-	credentials := makeHmac(service, []byte("aws4_request"))
-	signature := makeHmac(credentials, []byte(v4.stringToSign))
-	v4.signature = hex.EncodeToString(signature)
+	key := SigningKey(v4.CredValues.SecretAccessKey, v4.Time, v4.Region, v4.ServiceName)
+	v4.signature = hex.EncodeToString(makeHmac(key, []byte(v4.stringToSign)))
+}
+
+// SigningKey returns the key Signature Version 4 signatures of the day of t
+// are computed with, for the secret access key, region, and service. The
+// signature of a string to sign is the hex encoded HMAC-SHA256 of the string
+// with the key, e.g. for the policy of an S3 POST upload.
+func SigningKey(secret string, t time.Time, region, service string) []byte {
+	date := makeHmac([]byte("AWS4"+secret), []byte(t.UTC().Format(shortTimeFormat)))
+	regionKey := makeHmac(date, []byte(region))
+	serviceKey := makeHmac(regionKey, []byte(service))
+	return makeHmac(serviceKey, []byte("aws4_request"))
 }
 
 func (v4 *signer) bodyDigest() string {
 	hash := v4.Request.Header.Get("X-Amz-Content-Sha256")
 	if hash == "" {
 		if v4.isPresign && v4.ServiceName == "s3" {
-			hash = "UNSIGNED-PAYLOAD"
+			hash = UnsignedPayload
 		} else if v4.Body == nil {
 			hash = hex.EncodeToString(makeSha256([]byte{}))
 		} else {
@@ -336,6 +489,7 @@ func (v4 *signer) removePresign() {
 	v4.Query.Del("X-Amz-Expires")
 	v4.Query.Del("X-Amz-Credential")
 	v4.Query.Del("X-Amz-SignedHeaders")
+	v4.Query.Del(regionSetHeader)
 }
 
 func makeHmac(key []byte, data []byte) []byte {
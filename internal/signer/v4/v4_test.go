@@ -62,6 +62,31 @@ func TestPresignRequest(t *testing.T) {
 	assert.Equal(t, expectedDate, q.Get("X-Amz-Date"))
 }
 
+func TestPresignRequestSignedHeaders(t *testing.T) {
+	signer := buildSigner("s3", "us-east-1", time.Unix(0, 0), 300*time.Second, "")
+	signer.Request.Header.Add("Content-Disposition", "attachment")
+	signer.Presigning.SignedHeaders = []string{"content-type", "Content-Disposition"}
+	signer.sign()
+
+	q := signer.Request.URL.Query()
+	assert.Equal(t, "content-disposition;content-type;host;x-amz-meta-other-header;x-amz-target", q.Get("X-Amz-SignedHeaders"))
+	assert.Equal(t, "", q.Get("Content-Disposition"), "Expect signed header to not be moved to the query")
+	assert.Equal(t, "attachment", signer.Request.Header.Get("Content-Disposition"))
+}
+
+func TestPresignRequestUnsignedSessionToken(t *testing.T) {
+	signer := buildSigner("iotdata", "us-east-1", time.Unix(0, 0), 300*time.Second, "{}")
+	signer.Presigning.UnsignedSessionToken = true
+	signer.sign()
+
+	assert.True(t, strings.HasSuffix(signer.Request.URL.RawQuery, "&X-Amz-Security-Token=SESSION"),
+		"Expect the session token to be added after the signature")
+
+	signed := buildSigner("iotdata", "us-east-1", time.Unix(0, 0), 300*time.Second, "{}")
+	signed.sign()
+	assert.NotEqual(t, signed.signature, signer.signature, "Expect the session token to not be signed")
+}
+
 func TestSignRequest(t *testing.T) {
 	signer := buildSigner("dynamodb", "us-east-1", time.Unix(0, 0), 0, "{}")
 	signer.sign()
@@ -74,6 +99,15 @@ func TestSignRequest(t *testing.T) {
 	assert.Equal(t, expectedDate, q.Get("X-Amz-Date"))
 }
 
+func TestSignRequestIgnoresTraceHeader(t *testing.T) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Unix(0, 0), 0, "{}")
+	signer.Request.Header.Set("X-Amzn-Trace-Id", "Root=1-5759e988-bd862e3fe1be46a994272793")
+	signer.sign()
+
+	expectedSig := "AWS4-HMAC-SHA256 Credential=AKID/19700101/us-east-1/dynamodb/aws4_request, SignedHeaders=host;x-amz-date;x-amz-meta-other-header;x-amz-security-token;x-amz-target, Signature=69ada33fec48180dab153576e4dd80c4e04124f80dda3eccfed8a67c2b91ed5e"
+	assert.Equal(t, expectedSig, signer.Request.Header.Get("Authorization"))
+}
+
 func TestSignEmptyBody(t *testing.T) {
 	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 0, "")
 	signer.Body = nil
@@ -139,7 +173,7 @@ func TestAnonymousCredentials(t *testing.T) {
 	assert.Empty(t, hQ.Get("X-Amz-Date"))
 }
 
-func TestIgnoreResignRequestWithValidCreds(t *testing.T) {
+func TestResignRequestWithValidCreds(t *testing.T) {
 	r := aws.NewRequest(
 		aws.NewService(&aws.Config{
 			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
@@ -157,8 +191,18 @@ func TestIgnoreResignRequestWithValidCreds(t *testing.T) {
 	Sign(r)
 	sig := r.HTTPRequest.Header.Get("Authorization")
 
+	// Requests are signed again, e.g. when they are retried.
+	r.Time = r.Time.Add(time.Minute)
+	Sign(r)
+	assert.NotEqual(t, sig, r.HTTPRequest.Header.Get("Authorization"))
+	assert.Equal(t, r.Time.UTC().Format("20060102T150405Z"), r.HTTPRequest.Header.Get("X-Amz-Date"))
+
+	// Credentials without a session token remove the previous token.
+	r.Service.Config.Credentials = credentials.NewStaticCredentials("AKID2", "SECRET2", "")
 	Sign(r)
-	assert.Equal(t, sig, r.HTTPRequest.Header.Get("Authorization"))
+	assert.Contains(t, r.HTTPRequest.Header.Get("Authorization"), "Credential=AKID2/")
+	assert.Empty(t, r.HTTPRequest.Header.Get("X-Amz-Security-Token"))
+	assert.NotContains(t, r.HTTPRequest.Header.Get("Authorization"), "x-amz-security-token")
 }
 
 func TestIgnorePreResignRequestWithValidCreds(t *testing.T) {
@@ -206,7 +250,7 @@ func TestResignRequestExpiredCreds(t *testing.T) {
 }
 
 func TestPreResignRequestExpiredCreds(t *testing.T) {
-	provider := &credentials.StaticProvider{credentials.Value{"AKID", "SECRET", "SESSION"}}
+	provider := &credentials.StaticProvider{credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "SESSION"}}
 	creds := credentials.NewCredentials(provider)
 	r := aws.NewRequest(
 		aws.NewService(&aws.Config{Credentials: creds}),
@@ -243,3 +287,58 @@ func BenchmarkSignRequest(b *testing.B) {
 		signer.sign()
 	}
 }
+
+func TestSignWithModeledSigningName(t *testing.T) {
+	svc := &aws.Service{
+		ServiceName: "streams.dynamodb",
+		Config: &aws.Config{
+			Region:      "us-west-2",
+			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		},
+	}
+	svc.Initialize()
+	assert.Equal(t, "dynamodb", svc.SigningName)
+
+	r := aws.NewRequest(svc, &aws.Operation{Name: "GetRecords", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+	Sign(r)
+	assert.NoError(t, r.Error)
+	assert.Contains(t, r.HTTPRequest.Header.Get("Authorization"), "/us-west-2/dynamodb/aws4_request")
+}
+
+func TestSignWithConfigSigningScope(t *testing.T) {
+	svc := &aws.Service{
+		ServiceName: "mock",
+		Config: &aws.Config{
+			Region:        "us-west-2",
+			Endpoint:      "https://vpce-0123.mock.us-east-1.vpce.amazonaws.com",
+			SigningName:   "execute-api",
+			SigningRegion: "us-east-1",
+			Credentials:   credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		},
+	}
+	svc.Initialize()
+
+	r := aws.NewRequest(svc, &aws.Operation{Name: "Operation", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+	Sign(r)
+	assert.NoError(t, r.Error)
+	assert.Contains(t, r.HTTPRequest.Header.Get("Authorization"), "/us-east-1/execute-api/aws4_request")
+}
+
+func TestSignLogging(t *testing.T) {
+	var logged []string
+	signer := buildSigner("dynamodb", "us-east-1", time.Unix(0, 0), 0, "{}")
+	signer.Debug = aws.LogDebugWithSigning
+	signer.Logger = aws.LoggerFunc(func(args ...interface{}) {
+		logged = append(logged, args[0].(string))
+	})
+	signer.sign()
+
+	assert.Len(t, logged, 1)
+	msg := logged[0]
+	assert.Contains(t, msg, "---[ CANONICAL STRING  ]-----------------------------\n"+
+		strings.Replace(signer.canonicalString, "SESSION", "<sensitive>", -1))
+	assert.Contains(t, msg, "---[ STRING TO SIGN ]--------------------------------\n"+signer.stringToSign)
+	assert.Contains(t, msg, "---[ SIGNED HEADERS ]--------------------------------\n"+
+		"host\nx-amz-date\nx-amz-meta-other-header\nx-amz-security-token\nx-amz-target\n")
+	assert.NotContains(t, msg, "SESSION", "Expect the session token to not be logged")
+}
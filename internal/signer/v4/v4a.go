@@ -0,0 +1,97 @@
+package v4
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+)
+
+// The last key derived by SigV4aSigningKey, deriving a key takes a few HMACs
+// and a scalar multiplication, and the credentials rarely change.
+var v4aKeyCache struct {
+	sync.Mutex
+	accessKey, secret string
+	key               *ecdsa.PrivateKey
+}
+
+// SigV4aSigningKey returns the ECDSA P-256 key SigV4a signatures of the access
+// key ID and secret access key are computed with. The key is derived as
+// specified by NIST SP 800-108 in counter mode with HMAC-SHA256, and is the
+// same on every day and in every region.
+func SigV4aSigningKey(accessKey, secret string) (*ecdsa.PrivateKey, error) {
+	v4aKeyCache.Lock()
+	defer v4aKeyCache.Unlock()
+
+	if v4aKeyCache.key != nil && v4aKeyCache.accessKey == accessKey && v4aKeyCache.secret == secret {
+		return v4aKeyCache.key, nil
+	}
+
+	curve := elliptic.P256()
+	nMinusTwo := new(big.Int).Sub(curve.Params().N, big.NewInt(2))
+
+	// Candidate keys not less than n-2 are rejected and derived again with
+	// the next counter, so that the key plus 1 is a valid scalar.
+	for counter := 1; counter <= 0xFF; counter++ {
+		context := append([]byte(accessKey), byte(counter))
+		c := new(big.Int).SetBytes(deriveKey([]byte("AWS4A"+secret), []byte(authHeaderPrefixV4a), context))
+		if c.Cmp(nMinusTwo) >= 0 {
+			continue
+		}
+
+		key := &ecdsa.PrivateKey{D: c.Add(c, big.NewInt(1))}
+		key.PublicKey.Curve = curve
+		key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(key.D.Bytes())
+
+		v4aKeyCache.accessKey, v4aKeyCache.secret, v4aKeyCache.key = accessKey, secret, key
+		return key, nil
+	}
+	return nil, errors.New("failed to derive SigV4a signing key, counter exhausted")
+}
+
+// deriveKey returns the 256 bit key derived from key with the label and
+// context, the first block of the NIST SP 800-108 KDF in counter mode:
+// HMAC(key, i || label || 0x00 || context || L).
+func deriveKey(key, label, context []byte) []byte {
+	var buf [4]byte
+	hash := hmac.New(sha256.New, key)
+
+	binary.BigEndian.PutUint32(buf[:], 1)
+	hash.Write(buf[:])
+	hash.Write(label)
+	hash.Write([]byte{0x00})
+	hash.Write(context)
+	binary.BigEndian.PutUint32(buf[:], 256)
+	hash.Write(buf[:])
+
+	return hash.Sum(nil)
+}
+
+// buildSignatureV4a signs the string to sign with the SigV4a key of the
+// credentials. The signature is the hex encoded ASN.1 DER ECDSA signature of
+// the SHA256 hash of the string to sign.
+func (v4 *signer) buildSignatureV4a() error {
+	key, err := SigV4aSigningKey(v4.CredValues.AccessKeyID, v4.CredValues.SecretAccessKey)
+	if err != nil {
+		return err
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, makeSha256([]byte(v4.stringToSign)))
+	if err != nil {
+		return err
+	}
+	sig, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	if err != nil {
+		return err
+	}
+
+	v4.signature = hex.EncodeToString(sig)
+	return nil
+}
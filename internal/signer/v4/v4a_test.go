@@ -0,0 +1,64 @@
+package v4
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigV4aSigningKey(t *testing.T) {
+	key, err := SigV4aSigningKey("AKISORANDOMAASORANDOM", "q+jcrXGc+0zWN6uzclKVhvMmUsIfRPa4rlRandom")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "15d242ceebf8d8169fd6a8b5a746c41140414c3b07579038da06af89190fffcb", hex.EncodeToString(key.X.Bytes()))
+	assert.Equal(t, "0515242cedd82e94799482e4c0514b505afccf2c0c98d6a553bf539f424c5ec0", hex.EncodeToString(key.Y.Bytes()))
+
+	other, err := SigV4aSigningKey("AKID", "SECRET")
+	assert.NoError(t, err)
+	assert.NotEqual(t, key.D, other.D)
+}
+
+func verifySigV4a(t *testing.T, signer signer) {
+	key, err := SigV4aSigningKey("AKID", "SECRET")
+	assert.NoError(t, err)
+
+	b, err := hex.DecodeString(signer.signature)
+	assert.NoError(t, err)
+	var sig struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(b, &sig)
+	assert.NoError(t, err)
+	assert.True(t, ecdsa.Verify(&key.PublicKey, makeSha256([]byte(signer.stringToSign)), sig.R, sig.S), "Expect signature to verify")
+}
+
+func TestSignRequestV4a(t *testing.T) {
+	signer := buildSigner("s3", "us-east-1", time.Unix(0, 0), 0, "{}")
+	signer.RegionSet = "*"
+	assert.NoError(t, signer.sign())
+
+	auth := signer.Request.Header.Get("Authorization")
+	assert.True(t, strings.HasPrefix(auth, "AWS4-ECDSA-P256-SHA256 Credential=AKID/19700101/s3/aws4_request, "+
+		"SignedHeaders=host;x-amz-date;x-amz-meta-other-header;x-amz-region-set;x-amz-security-token;x-amz-target, Signature="), auth)
+	assert.Equal(t, "*", signer.Request.Header.Get("X-Amz-Region-Set"))
+	assert.True(t, strings.HasPrefix(signer.stringToSign, "AWS4-ECDSA-P256-SHA256\n19700101T000000Z\n19700101/s3/aws4_request\n"))
+	verifySigV4a(t, signer)
+}
+
+func TestPresignRequestV4a(t *testing.T) {
+	signer := buildSigner("s3", "us-east-1", time.Unix(0, 0), 300*time.Second, "{}")
+	signer.RegionSet = "*"
+	assert.NoError(t, signer.sign())
+
+	q := signer.Request.URL.Query()
+	assert.Equal(t, "AWS4-ECDSA-P256-SHA256", q.Get("X-Amz-Algorithm"))
+	assert.Equal(t, "AKID/19700101/s3/aws4_request", q.Get("X-Amz-Credential"))
+	assert.Equal(t, "*", q.Get("X-Amz-Region-Set"))
+	assert.Equal(t, signer.signature, q.Get("X-Amz-Signature"))
+	assert.Empty(t, signer.Request.Header.Get("X-Amz-Region-Set"))
+	verifySigV4a(t, signer)
+}
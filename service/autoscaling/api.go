@@ -41,6 +41,18 @@ func (c *AutoScaling) AttachInstances(input *AttachInstancesInput) (*AttachInsta
 	return out, err
 }
 
+// AttachInstancesWithContext is the same as AttachInstances with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) AttachInstancesWithContext(ctx aws.Context, input *AttachInstancesInput, opts ...aws.Option) (*AttachInstancesOutput, error) {
+	req, out := c.AttachInstancesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachLoadBalancers = "AttachLoadBalancers"
 
 // AttachLoadBalancersRequest generates a request for the AttachLoadBalancers operation.
@@ -75,6 +87,18 @@ func (c *AutoScaling) AttachLoadBalancers(input *AttachLoadBalancersInput) (*Att
 	return out, err
 }
 
+// AttachLoadBalancersWithContext is the same as AttachLoadBalancers with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) AttachLoadBalancersWithContext(ctx aws.Context, input *AttachLoadBalancersInput, opts ...aws.Option) (*AttachLoadBalancersOutput, error) {
+	req, out := c.AttachLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCompleteLifecycleAction = "CompleteLifecycleAction"
 
 // CompleteLifecycleActionRequest generates a request for the CompleteLifecycleAction operation.
@@ -116,6 +140,18 @@ func (c *AutoScaling) CompleteLifecycleAction(input *CompleteLifecycleActionInpu
 	return out, err
 }
 
+// CompleteLifecycleActionWithContext is the same as CompleteLifecycleAction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) CompleteLifecycleActionWithContext(ctx aws.Context, input *CompleteLifecycleActionInput, opts ...aws.Option) (*CompleteLifecycleActionOutput, error) {
+	req, out := c.CompleteLifecycleActionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAutoScalingGroup = "CreateAutoScalingGroup"
 
 // CreateAutoScalingGroupRequest generates a request for the CreateAutoScalingGroup operation.
@@ -150,6 +186,18 @@ func (c *AutoScaling) CreateAutoScalingGroup(input *CreateAutoScalingGroupInput)
 	return out, err
 }
 
+// CreateAutoScalingGroupWithContext is the same as CreateAutoScalingGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) CreateAutoScalingGroupWithContext(ctx aws.Context, input *CreateAutoScalingGroupInput, opts ...aws.Option) (*CreateAutoScalingGroupOutput, error) {
+	req, out := c.CreateAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLaunchConfiguration = "CreateLaunchConfiguration"
 
 // CreateLaunchConfigurationRequest generates a request for the CreateLaunchConfiguration operation.
@@ -184,6 +232,18 @@ func (c *AutoScaling) CreateLaunchConfiguration(input *CreateLaunchConfiguration
 	return out, err
 }
 
+// CreateLaunchConfigurationWithContext is the same as CreateLaunchConfiguration with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) CreateLaunchConfigurationWithContext(ctx aws.Context, input *CreateLaunchConfigurationInput, opts ...aws.Option) (*CreateLaunchConfigurationOutput, error) {
+	req, out := c.CreateLaunchConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateOrUpdateTags = "CreateOrUpdateTags"
 
 // CreateOrUpdateTagsRequest generates a request for the CreateOrUpdateTags operation.
@@ -224,6 +284,18 @@ func (c *AutoScaling) CreateOrUpdateTags(input *CreateOrUpdateTagsInput) (*Creat
 	return out, err
 }
 
+// CreateOrUpdateTagsWithContext is the same as CreateOrUpdateTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) CreateOrUpdateTagsWithContext(ctx aws.Context, input *CreateOrUpdateTagsInput, opts ...aws.Option) (*CreateOrUpdateTagsOutput, error) {
+	req, out := c.CreateOrUpdateTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAutoScalingGroup = "DeleteAutoScalingGroup"
 
 // DeleteAutoScalingGroupRequest generates a request for the DeleteAutoScalingGroup operation.
@@ -256,6 +328,18 @@ func (c *AutoScaling) DeleteAutoScalingGroup(input *DeleteAutoScalingGroupInput)
 	return out, err
 }
 
+// DeleteAutoScalingGroupWithContext is the same as DeleteAutoScalingGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DeleteAutoScalingGroupWithContext(ctx aws.Context, input *DeleteAutoScalingGroupInput, opts ...aws.Option) (*DeleteAutoScalingGroupOutput, error) {
+	req, out := c.DeleteAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLaunchConfiguration = "DeleteLaunchConfiguration"
 
 // DeleteLaunchConfigurationRequest generates a request for the DeleteLaunchConfiguration operation.
@@ -287,6 +371,18 @@ func (c *AutoScaling) DeleteLaunchConfiguration(input *DeleteLaunchConfiguration
 	return out, err
 }
 
+// DeleteLaunchConfigurationWithContext is the same as DeleteLaunchConfiguration with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DeleteLaunchConfigurationWithContext(ctx aws.Context, input *DeleteLaunchConfigurationInput, opts ...aws.Option) (*DeleteLaunchConfigurationOutput, error) {
+	req, out := c.DeleteLaunchConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLifecycleHook = "DeleteLifecycleHook"
 
 // DeleteLifecycleHookRequest generates a request for the DeleteLifecycleHook operation.
@@ -317,6 +413,18 @@ func (c *AutoScaling) DeleteLifecycleHook(input *DeleteLifecycleHookInput) (*Del
 	return out, err
 }
 
+// DeleteLifecycleHookWithContext is the same as DeleteLifecycleHook with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DeleteLifecycleHookWithContext(ctx aws.Context, input *DeleteLifecycleHookInput, opts ...aws.Option) (*DeleteLifecycleHookOutput, error) {
+	req, out := c.DeleteLifecycleHookRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteNotificationConfiguration = "DeleteNotificationConfiguration"
 
 // DeleteNotificationConfigurationRequest generates a request for the DeleteNotificationConfiguration operation.
@@ -344,6 +452,18 @@ func (c *AutoScaling) DeleteNotificationConfiguration(input *DeleteNotificationC
 	return out, err
 }
 
+// DeleteNotificationConfigurationWithContext is the same as DeleteNotificationConfiguration with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DeleteNotificationConfigurationWithContext(ctx aws.Context, input *DeleteNotificationConfigurationInput, opts ...aws.Option) (*DeleteNotificationConfigurationOutput, error) {
+	req, out := c.DeleteNotificationConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePolicy = "DeletePolicy"
 
 // DeletePolicyRequest generates a request for the DeletePolicy operation.
@@ -371,6 +491,18 @@ func (c *AutoScaling) DeletePolicy(input *DeletePolicyInput) (*DeletePolicyOutpu
 	return out, err
 }
 
+// DeletePolicyWithContext is the same as DeletePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DeletePolicyWithContext(ctx aws.Context, input *DeletePolicyInput, opts ...aws.Option) (*DeletePolicyOutput, error) {
+	req, out := c.DeletePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteScheduledAction = "DeleteScheduledAction"
 
 // DeleteScheduledActionRequest generates a request for the DeleteScheduledAction operation.
@@ -398,6 +530,18 @@ func (c *AutoScaling) DeleteScheduledAction(input *DeleteScheduledActionInput) (
 	return out, err
 }
 
+// DeleteScheduledActionWithContext is the same as DeleteScheduledAction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DeleteScheduledActionWithContext(ctx aws.Context, input *DeleteScheduledActionInput, opts ...aws.Option) (*DeleteScheduledActionOutput, error) {
+	req, out := c.DeleteScheduledActionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTags = "DeleteTags"
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -425,6 +569,18 @@ func (c *AutoScaling) DeleteTags(input *DeleteTagsInput) (*DeleteTagsOutput, err
 	return out, err
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DeleteTagsWithContext(ctx aws.Context, input *DeleteTagsInput, opts ...aws.Option) (*DeleteTagsOutput, error) {
+	req, out := c.DeleteTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAccountLimits = "DescribeAccountLimits"
 
 // DescribeAccountLimitsRequest generates a request for the DescribeAccountLimits operation.
@@ -456,6 +612,18 @@ func (c *AutoScaling) DescribeAccountLimits(input *DescribeAccountLimitsInput) (
 	return out, err
 }
 
+// DescribeAccountLimitsWithContext is the same as DescribeAccountLimits with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeAccountLimitsWithContext(ctx aws.Context, input *DescribeAccountLimitsInput, opts ...aws.Option) (*DescribeAccountLimitsOutput, error) {
+	req, out := c.DescribeAccountLimitsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAdjustmentTypes = "DescribeAdjustmentTypes"
 
 // DescribeAdjustmentTypesRequest generates a request for the DescribeAdjustmentTypes operation.
@@ -483,6 +651,18 @@ func (c *AutoScaling) DescribeAdjustmentTypes(input *DescribeAdjustmentTypesInpu
 	return out, err
 }
 
+// DescribeAdjustmentTypesWithContext is the same as DescribeAdjustmentTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeAdjustmentTypesWithContext(ctx aws.Context, input *DescribeAdjustmentTypesInput, opts ...aws.Option) (*DescribeAdjustmentTypesOutput, error) {
+	req, out := c.DescribeAdjustmentTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAutoScalingGroups = "DescribeAutoScalingGroups"
 
 // DescribeAutoScalingGroupsRequest generates a request for the DescribeAutoScalingGroups operation.
@@ -496,6 +676,7 @@ func (c *AutoScaling) DescribeAutoScalingGroupsRequest(input *DescribeAutoScalin
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"AutoScalingGroups"},
 		},
 	}
 
@@ -517,6 +698,22 @@ func (c *AutoScaling) DescribeAutoScalingGroups(input *DescribeAutoScalingGroups
 	return out, err
 }
 
+// DescribeAutoScalingGroupsWithContext is the same as DescribeAutoScalingGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeAutoScalingGroupsWithContext(ctx aws.Context, input *DescribeAutoScalingGroupsInput, opts ...aws.Option) (*DescribeAutoScalingGroupsOutput, error) {
+	req, out := c.DescribeAutoScalingGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeAutoScalingGroupsPages iterates over the pages of a DescribeAutoScalingGroups operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribeAutoScalingGroupsPages(input *DescribeAutoScalingGroupsInput, fn func(p *DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAutoScalingGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -524,6 +721,19 @@ func (c *AutoScaling) DescribeAutoScalingGroupsPages(input *DescribeAutoScalingG
 	})
 }
 
+// DescribeAutoScalingGroupsPagesWithContext is the same as DescribeAutoScalingGroupsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribeAutoScalingGroupsPagesWithContext(ctx aws.Context, input *DescribeAutoScalingGroupsInput, fn func(p *DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeAutoScalingGroupsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAutoScalingGroupsOutput), lastPage)
+	})
+}
+
 const opDescribeAutoScalingInstances = "DescribeAutoScalingInstances"
 
 // DescribeAutoScalingInstancesRequest generates a request for the DescribeAutoScalingInstances operation.
@@ -537,6 +747,7 @@ func (c *AutoScaling) DescribeAutoScalingInstancesRequest(input *DescribeAutoSca
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"AutoScalingInstances"},
 		},
 	}
 
@@ -558,6 +769,22 @@ func (c *AutoScaling) DescribeAutoScalingInstances(input *DescribeAutoScalingIns
 	return out, err
 }
 
+// DescribeAutoScalingInstancesWithContext is the same as DescribeAutoScalingInstances with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeAutoScalingInstancesWithContext(ctx aws.Context, input *DescribeAutoScalingInstancesInput, opts ...aws.Option) (*DescribeAutoScalingInstancesOutput, error) {
+	req, out := c.DescribeAutoScalingInstancesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeAutoScalingInstancesPages iterates over the pages of a DescribeAutoScalingInstances operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribeAutoScalingInstancesPages(input *DescribeAutoScalingInstancesInput, fn func(p *DescribeAutoScalingInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAutoScalingInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -565,6 +792,19 @@ func (c *AutoScaling) DescribeAutoScalingInstancesPages(input *DescribeAutoScali
 	})
 }
 
+// DescribeAutoScalingInstancesPagesWithContext is the same as DescribeAutoScalingInstancesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribeAutoScalingInstancesPagesWithContext(ctx aws.Context, input *DescribeAutoScalingInstancesInput, fn func(p *DescribeAutoScalingInstancesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeAutoScalingInstancesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAutoScalingInstancesOutput), lastPage)
+	})
+}
+
 const opDescribeAutoScalingNotificationTypes = "DescribeAutoScalingNotificationTypes"
 
 // DescribeAutoScalingNotificationTypesRequest generates a request for the DescribeAutoScalingNotificationTypes operation.
@@ -592,6 +832,18 @@ func (c *AutoScaling) DescribeAutoScalingNotificationTypes(input *DescribeAutoSc
 	return out, err
 }
 
+// DescribeAutoScalingNotificationTypesWithContext is the same as DescribeAutoScalingNotificationTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeAutoScalingNotificationTypesWithContext(ctx aws.Context, input *DescribeAutoScalingNotificationTypesInput, opts ...aws.Option) (*DescribeAutoScalingNotificationTypesOutput, error) {
+	req, out := c.DescribeAutoScalingNotificationTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLaunchConfigurations = "DescribeLaunchConfigurations"
 
 // DescribeLaunchConfigurationsRequest generates a request for the DescribeLaunchConfigurations operation.
@@ -605,6 +857,7 @@ func (c *AutoScaling) DescribeLaunchConfigurationsRequest(input *DescribeLaunchC
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"LaunchConfigurations"},
 		},
 	}
 
@@ -626,6 +879,22 @@ func (c *AutoScaling) DescribeLaunchConfigurations(input *DescribeLaunchConfigur
 	return out, err
 }
 
+// DescribeLaunchConfigurationsWithContext is the same as DescribeLaunchConfigurations with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeLaunchConfigurationsWithContext(ctx aws.Context, input *DescribeLaunchConfigurationsInput, opts ...aws.Option) (*DescribeLaunchConfigurationsOutput, error) {
+	req, out := c.DescribeLaunchConfigurationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeLaunchConfigurationsPages iterates over the pages of a DescribeLaunchConfigurations operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribeLaunchConfigurationsPages(input *DescribeLaunchConfigurationsInput, fn func(p *DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeLaunchConfigurationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -633,6 +902,19 @@ func (c *AutoScaling) DescribeLaunchConfigurationsPages(input *DescribeLaunchCon
 	})
 }
 
+// DescribeLaunchConfigurationsPagesWithContext is the same as DescribeLaunchConfigurationsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribeLaunchConfigurationsPagesWithContext(ctx aws.Context, input *DescribeLaunchConfigurationsInput, fn func(p *DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeLaunchConfigurationsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeLaunchConfigurationsOutput), lastPage)
+	})
+}
+
 const opDescribeLifecycleHookTypes = "DescribeLifecycleHookTypes"
 
 // DescribeLifecycleHookTypesRequest generates a request for the DescribeLifecycleHookTypes operation.
@@ -660,6 +942,18 @@ func (c *AutoScaling) DescribeLifecycleHookTypes(input *DescribeLifecycleHookTyp
 	return out, err
 }
 
+// DescribeLifecycleHookTypesWithContext is the same as DescribeLifecycleHookTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeLifecycleHookTypesWithContext(ctx aws.Context, input *DescribeLifecycleHookTypesInput, opts ...aws.Option) (*DescribeLifecycleHookTypesOutput, error) {
+	req, out := c.DescribeLifecycleHookTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLifecycleHooks = "DescribeLifecycleHooks"
 
 // DescribeLifecycleHooksRequest generates a request for the DescribeLifecycleHooks operation.
@@ -687,6 +981,18 @@ func (c *AutoScaling) DescribeLifecycleHooks(input *DescribeLifecycleHooksInput)
 	return out, err
 }
 
+// DescribeLifecycleHooksWithContext is the same as DescribeLifecycleHooks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeLifecycleHooksWithContext(ctx aws.Context, input *DescribeLifecycleHooksInput, opts ...aws.Option) (*DescribeLifecycleHooksOutput, error) {
+	req, out := c.DescribeLifecycleHooksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLoadBalancers = "DescribeLoadBalancers"
 
 // DescribeLoadBalancersRequest generates a request for the DescribeLoadBalancers operation.
@@ -714,6 +1020,18 @@ func (c *AutoScaling) DescribeLoadBalancers(input *DescribeLoadBalancersInput) (
 	return out, err
 }
 
+// DescribeLoadBalancersWithContext is the same as DescribeLoadBalancers with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeLoadBalancersWithContext(ctx aws.Context, input *DescribeLoadBalancersInput, opts ...aws.Option) (*DescribeLoadBalancersOutput, error) {
+	req, out := c.DescribeLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMetricCollectionTypes = "DescribeMetricCollectionTypes"
 
 // DescribeMetricCollectionTypesRequest generates a request for the DescribeMetricCollectionTypes operation.
@@ -744,6 +1062,18 @@ func (c *AutoScaling) DescribeMetricCollectionTypes(input *DescribeMetricCollect
 	return out, err
 }
 
+// DescribeMetricCollectionTypesWithContext is the same as DescribeMetricCollectionTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeMetricCollectionTypesWithContext(ctx aws.Context, input *DescribeMetricCollectionTypesInput, opts ...aws.Option) (*DescribeMetricCollectionTypesOutput, error) {
+	req, out := c.DescribeMetricCollectionTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeNotificationConfigurations = "DescribeNotificationConfigurations"
 
 // DescribeNotificationConfigurationsRequest generates a request for the DescribeNotificationConfigurations operation.
@@ -757,6 +1087,7 @@ func (c *AutoScaling) DescribeNotificationConfigurationsRequest(input *DescribeN
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"NotificationConfigurations"},
 		},
 	}
 
@@ -778,6 +1109,22 @@ func (c *AutoScaling) DescribeNotificationConfigurations(input *DescribeNotifica
 	return out, err
 }
 
+// DescribeNotificationConfigurationsWithContext is the same as DescribeNotificationConfigurations with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeNotificationConfigurationsWithContext(ctx aws.Context, input *DescribeNotificationConfigurationsInput, opts ...aws.Option) (*DescribeNotificationConfigurationsOutput, error) {
+	req, out := c.DescribeNotificationConfigurationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeNotificationConfigurationsPages iterates over the pages of a DescribeNotificationConfigurations operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribeNotificationConfigurationsPages(input *DescribeNotificationConfigurationsInput, fn func(p *DescribeNotificationConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeNotificationConfigurationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -785,6 +1132,19 @@ func (c *AutoScaling) DescribeNotificationConfigurationsPages(input *DescribeNot
 	})
 }
 
+// DescribeNotificationConfigurationsPagesWithContext is the same as DescribeNotificationConfigurationsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribeNotificationConfigurationsPagesWithContext(ctx aws.Context, input *DescribeNotificationConfigurationsInput, fn func(p *DescribeNotificationConfigurationsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeNotificationConfigurationsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeNotificationConfigurationsOutput), lastPage)
+	})
+}
+
 const opDescribePolicies = "DescribePolicies"
 
 // DescribePoliciesRequest generates a request for the DescribePolicies operation.
@@ -798,6 +1158,7 @@ func (c *AutoScaling) DescribePoliciesRequest(input *DescribePoliciesInput) (req
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"ScalingPolicies"},
 		},
 	}
 
@@ -818,6 +1179,22 @@ func (c *AutoScaling) DescribePolicies(input *DescribePoliciesInput) (*DescribeP
 	return out, err
 }
 
+// DescribePoliciesWithContext is the same as DescribePolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribePoliciesWithContext(ctx aws.Context, input *DescribePoliciesInput, opts ...aws.Option) (*DescribePoliciesOutput, error) {
+	req, out := c.DescribePoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribePoliciesPages iterates over the pages of a DescribePolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribePoliciesPages(input *DescribePoliciesInput, fn func(p *DescribePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribePoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -825,6 +1202,19 @@ func (c *AutoScaling) DescribePoliciesPages(input *DescribePoliciesInput, fn fun
 	})
 }
 
+// DescribePoliciesPagesWithContext is the same as DescribePoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribePoliciesPagesWithContext(ctx aws.Context, input *DescribePoliciesInput, fn func(p *DescribePoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribePoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribePoliciesOutput), lastPage)
+	})
+}
+
 const opDescribeScalingActivities = "DescribeScalingActivities"
 
 // DescribeScalingActivitiesRequest generates a request for the DescribeScalingActivities operation.
@@ -838,6 +1228,7 @@ func (c *AutoScaling) DescribeScalingActivitiesRequest(input *DescribeScalingAct
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"Activities"},
 		},
 	}
 
@@ -861,6 +1252,22 @@ func (c *AutoScaling) DescribeScalingActivities(input *DescribeScalingActivities
 	return out, err
 }
 
+// DescribeScalingActivitiesWithContext is the same as DescribeScalingActivities with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeScalingActivitiesWithContext(ctx aws.Context, input *DescribeScalingActivitiesInput, opts ...aws.Option) (*DescribeScalingActivitiesOutput, error) {
+	req, out := c.DescribeScalingActivitiesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeScalingActivitiesPages iterates over the pages of a DescribeScalingActivities operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribeScalingActivitiesPages(input *DescribeScalingActivitiesInput, fn func(p *DescribeScalingActivitiesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeScalingActivitiesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -868,6 +1275,19 @@ func (c *AutoScaling) DescribeScalingActivitiesPages(input *DescribeScalingActiv
 	})
 }
 
+// DescribeScalingActivitiesPagesWithContext is the same as DescribeScalingActivitiesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribeScalingActivitiesPagesWithContext(ctx aws.Context, input *DescribeScalingActivitiesInput, fn func(p *DescribeScalingActivitiesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeScalingActivitiesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeScalingActivitiesOutput), lastPage)
+	})
+}
+
 const opDescribeScalingProcessTypes = "DescribeScalingProcessTypes"
 
 // DescribeScalingProcessTypesRequest generates a request for the DescribeScalingProcessTypes operation.
@@ -895,6 +1315,18 @@ func (c *AutoScaling) DescribeScalingProcessTypes(input *DescribeScalingProcessT
 	return out, err
 }
 
+// DescribeScalingProcessTypesWithContext is the same as DescribeScalingProcessTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeScalingProcessTypesWithContext(ctx aws.Context, input *DescribeScalingProcessTypesInput, opts ...aws.Option) (*DescribeScalingProcessTypesOutput, error) {
+	req, out := c.DescribeScalingProcessTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeScheduledActions = "DescribeScheduledActions"
 
 // DescribeScheduledActionsRequest generates a request for the DescribeScheduledActions operation.
@@ -908,6 +1340,7 @@ func (c *AutoScaling) DescribeScheduledActionsRequest(input *DescribeScheduledAc
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"ScheduledUpdateGroupActions"},
 		},
 	}
 
@@ -929,6 +1362,22 @@ func (c *AutoScaling) DescribeScheduledActions(input *DescribeScheduledActionsIn
 	return out, err
 }
 
+// DescribeScheduledActionsWithContext is the same as DescribeScheduledActions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeScheduledActionsWithContext(ctx aws.Context, input *DescribeScheduledActionsInput, opts ...aws.Option) (*DescribeScheduledActionsOutput, error) {
+	req, out := c.DescribeScheduledActionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeScheduledActionsPages iterates over the pages of a DescribeScheduledActions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribeScheduledActionsPages(input *DescribeScheduledActionsInput, fn func(p *DescribeScheduledActionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeScheduledActionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -936,6 +1385,19 @@ func (c *AutoScaling) DescribeScheduledActionsPages(input *DescribeScheduledActi
 	})
 }
 
+// DescribeScheduledActionsPagesWithContext is the same as DescribeScheduledActionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribeScheduledActionsPagesWithContext(ctx aws.Context, input *DescribeScheduledActionsInput, fn func(p *DescribeScheduledActionsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeScheduledActionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeScheduledActionsOutput), lastPage)
+	})
+}
+
 const opDescribeTags = "DescribeTags"
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -949,6 +1411,7 @@ func (c *AutoScaling) DescribeTagsRequest(input *DescribeTagsInput) (req *aws.Re
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"Tags"},
 		},
 	}
 
@@ -978,6 +1441,22 @@ func (c *AutoScaling) DescribeTags(input *DescribeTagsInput) (*DescribeTagsOutpu
 	return out, err
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeTagsWithContext(ctx aws.Context, input *DescribeTagsInput, opts ...aws.Option) (*DescribeTagsOutput, error) {
+	req, out := c.DescribeTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeTagsPages iterates over the pages of a DescribeTags operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *AutoScaling) DescribeTagsPages(input *DescribeTagsInput, fn func(p *DescribeTagsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTagsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -985,6 +1464,19 @@ func (c *AutoScaling) DescribeTagsPages(input *DescribeTagsInput, fn func(p *Des
 	})
 }
 
+// DescribeTagsPagesWithContext is the same as DescribeTagsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *AutoScaling) DescribeTagsPagesWithContext(ctx aws.Context, input *DescribeTagsInput, fn func(p *DescribeTagsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeTagsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTagsOutput), lastPage)
+	})
+}
+
 const opDescribeTerminationPolicyTypes = "DescribeTerminationPolicyTypes"
 
 // DescribeTerminationPolicyTypesRequest generates a request for the DescribeTerminationPolicyTypes operation.
@@ -1012,6 +1504,18 @@ func (c *AutoScaling) DescribeTerminationPolicyTypes(input *DescribeTerminationP
 	return out, err
 }
 
+// DescribeTerminationPolicyTypesWithContext is the same as DescribeTerminationPolicyTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DescribeTerminationPolicyTypesWithContext(ctx aws.Context, input *DescribeTerminationPolicyTypesInput, opts ...aws.Option) (*DescribeTerminationPolicyTypesOutput, error) {
+	req, out := c.DescribeTerminationPolicyTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachInstances = "DetachInstances"
 
 // DetachInstancesRequest generates a request for the DetachInstances operation.
@@ -1045,6 +1549,18 @@ func (c *AutoScaling) DetachInstances(input *DetachInstancesInput) (*DetachInsta
 	return out, err
 }
 
+// DetachInstancesWithContext is the same as DetachInstances with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DetachInstancesWithContext(ctx aws.Context, input *DetachInstancesInput, opts ...aws.Option) (*DetachInstancesOutput, error) {
+	req, out := c.DetachInstancesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachLoadBalancers = "DetachLoadBalancers"
 
 // DetachLoadBalancersRequest generates a request for the DetachLoadBalancers operation.
@@ -1077,6 +1593,18 @@ func (c *AutoScaling) DetachLoadBalancers(input *DetachLoadBalancersInput) (*Det
 	return out, err
 }
 
+// DetachLoadBalancersWithContext is the same as DetachLoadBalancers with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DetachLoadBalancersWithContext(ctx aws.Context, input *DetachLoadBalancersInput, opts ...aws.Option) (*DetachLoadBalancersOutput, error) {
+	req, out := c.DetachLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableMetricsCollection = "DisableMetricsCollection"
 
 // DisableMetricsCollectionRequest generates a request for the DisableMetricsCollection operation.
@@ -1105,6 +1633,18 @@ func (c *AutoScaling) DisableMetricsCollection(input *DisableMetricsCollectionIn
 	return out, err
 }
 
+// DisableMetricsCollectionWithContext is the same as DisableMetricsCollection with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) DisableMetricsCollectionWithContext(ctx aws.Context, input *DisableMetricsCollectionInput, opts ...aws.Option) (*DisableMetricsCollectionOutput, error) {
+	req, out := c.DisableMetricsCollectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableMetricsCollection = "EnableMetricsCollection"
 
 // EnableMetricsCollectionRequest generates a request for the EnableMetricsCollection operation.
@@ -1136,6 +1676,18 @@ func (c *AutoScaling) EnableMetricsCollection(input *EnableMetricsCollectionInpu
 	return out, err
 }
 
+// EnableMetricsCollectionWithContext is the same as EnableMetricsCollection with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) EnableMetricsCollectionWithContext(ctx aws.Context, input *EnableMetricsCollectionInput, opts ...aws.Option) (*EnableMetricsCollectionOutput, error) {
+	req, out := c.EnableMetricsCollectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnterStandby = "EnterStandby"
 
 // EnterStandbyRequest generates a request for the EnterStandby operation.
@@ -1166,6 +1718,18 @@ func (c *AutoScaling) EnterStandby(input *EnterStandbyInput) (*EnterStandbyOutpu
 	return out, err
 }
 
+// EnterStandbyWithContext is the same as EnterStandby with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) EnterStandbyWithContext(ctx aws.Context, input *EnterStandbyInput, opts ...aws.Option) (*EnterStandbyOutput, error) {
+	req, out := c.EnterStandbyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opExecutePolicy = "ExecutePolicy"
 
 // ExecutePolicyRequest generates a request for the ExecutePolicy operation.
@@ -1193,6 +1757,18 @@ func (c *AutoScaling) ExecutePolicy(input *ExecutePolicyInput) (*ExecutePolicyOu
 	return out, err
 }
 
+// ExecutePolicyWithContext is the same as ExecutePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) ExecutePolicyWithContext(ctx aws.Context, input *ExecutePolicyInput, opts ...aws.Option) (*ExecutePolicyOutput, error) {
+	req, out := c.ExecutePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opExitStandby = "ExitStandby"
 
 // ExitStandbyRequest generates a request for the ExitStandby operation.
@@ -1223,6 +1799,18 @@ func (c *AutoScaling) ExitStandby(input *ExitStandbyInput) (*ExitStandbyOutput,
 	return out, err
 }
 
+// ExitStandbyWithContext is the same as ExitStandby with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) ExitStandbyWithContext(ctx aws.Context, input *ExitStandbyInput, opts ...aws.Option) (*ExitStandbyOutput, error) {
+	req, out := c.ExitStandbyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutLifecycleHook = "PutLifecycleHook"
 
 // PutLifecycleHookRequest generates a request for the PutLifecycleHook operation.
@@ -1272,6 +1860,18 @@ func (c *AutoScaling) PutLifecycleHook(input *PutLifecycleHookInput) (*PutLifecy
 	return out, err
 }
 
+// PutLifecycleHookWithContext is the same as PutLifecycleHook with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) PutLifecycleHookWithContext(ctx aws.Context, input *PutLifecycleHookInput, opts ...aws.Option) (*PutLifecycleHookOutput, error) {
+	req, out := c.PutLifecycleHookRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutNotificationConfiguration = "PutNotificationConfiguration"
 
 // PutNotificationConfigurationRequest generates a request for the PutNotificationConfiguration operation.
@@ -1307,6 +1907,18 @@ func (c *AutoScaling) PutNotificationConfiguration(input *PutNotificationConfigu
 	return out, err
 }
 
+// PutNotificationConfigurationWithContext is the same as PutNotificationConfiguration with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) PutNotificationConfigurationWithContext(ctx aws.Context, input *PutNotificationConfigurationInput, opts ...aws.Option) (*PutNotificationConfigurationOutput, error) {
+	req, out := c.PutNotificationConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutScalingPolicy = "PutScalingPolicy"
 
 // PutScalingPolicyRequest generates a request for the PutScalingPolicy operation.
@@ -1342,6 +1954,18 @@ func (c *AutoScaling) PutScalingPolicy(input *PutScalingPolicyInput) (*PutScalin
 	return out, err
 }
 
+// PutScalingPolicyWithContext is the same as PutScalingPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) PutScalingPolicyWithContext(ctx aws.Context, input *PutScalingPolicyInput, opts ...aws.Option) (*PutScalingPolicyOutput, error) {
+	req, out := c.PutScalingPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutScheduledUpdateGroupAction = "PutScheduledUpdateGroupAction"
 
 // PutScheduledUpdateGroupActionRequest generates a request for the PutScheduledUpdateGroupAction operation.
@@ -1374,6 +1998,18 @@ func (c *AutoScaling) PutScheduledUpdateGroupAction(input *PutScheduledUpdateGro
 	return out, err
 }
 
+// PutScheduledUpdateGroupActionWithContext is the same as PutScheduledUpdateGroupAction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) PutScheduledUpdateGroupActionWithContext(ctx aws.Context, input *PutScheduledUpdateGroupActionInput, opts ...aws.Option) (*PutScheduledUpdateGroupActionOutput, error) {
+	req, out := c.PutScheduledUpdateGroupActionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRecordLifecycleActionHeartbeat = "RecordLifecycleActionHeartbeat"
 
 // RecordLifecycleActionHeartbeatRequest generates a request for the RecordLifecycleActionHeartbeat operation.
@@ -1416,6 +2052,18 @@ func (c *AutoScaling) RecordLifecycleActionHeartbeat(input *RecordLifecycleActio
 	return out, err
 }
 
+// RecordLifecycleActionHeartbeatWithContext is the same as RecordLifecycleActionHeartbeat with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) RecordLifecycleActionHeartbeatWithContext(ctx aws.Context, input *RecordLifecycleActionHeartbeatInput, opts ...aws.Option) (*RecordLifecycleActionHeartbeatOutput, error) {
+	req, out := c.RecordLifecycleActionHeartbeatRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opResumeProcesses = "ResumeProcesses"
 
 // ResumeProcessesRequest generates a request for the ResumeProcesses operation.
@@ -1447,6 +2095,18 @@ func (c *AutoScaling) ResumeProcesses(input *ScalingProcessQuery) (*ResumeProces
 	return out, err
 }
 
+// ResumeProcessesWithContext is the same as ResumeProcesses with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) ResumeProcessesWithContext(ctx aws.Context, input *ScalingProcessQuery, opts ...aws.Option) (*ResumeProcessesOutput, error) {
+	req, out := c.ResumeProcessesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetDesiredCapacity = "SetDesiredCapacity"
 
 // SetDesiredCapacityRequest generates a request for the SetDesiredCapacity operation.
@@ -1477,6 +2137,18 @@ func (c *AutoScaling) SetDesiredCapacity(input *SetDesiredCapacityInput) (*SetDe
 	return out, err
 }
 
+// SetDesiredCapacityWithContext is the same as SetDesiredCapacity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) SetDesiredCapacityWithContext(ctx aws.Context, input *SetDesiredCapacityInput, opts ...aws.Option) (*SetDesiredCapacityOutput, error) {
+	req, out := c.SetDesiredCapacityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetInstanceHealth = "SetInstanceHealth"
 
 // SetInstanceHealthRequest generates a request for the SetInstanceHealth operation.
@@ -1507,6 +2179,18 @@ func (c *AutoScaling) SetInstanceHealth(input *SetInstanceHealthInput) (*SetInst
 	return out, err
 }
 
+// SetInstanceHealthWithContext is the same as SetInstanceHealth with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) SetInstanceHealthWithContext(ctx aws.Context, input *SetInstanceHealthInput, opts ...aws.Option) (*SetInstanceHealthOutput, error) {
+	req, out := c.SetInstanceHealthRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSuspendProcesses = "SuspendProcesses"
 
 // SuspendProcessesRequest generates a request for the SuspendProcesses operation.
@@ -1544,6 +2228,18 @@ func (c *AutoScaling) SuspendProcesses(input *ScalingProcessQuery) (*SuspendProc
 	return out, err
 }
 
+// SuspendProcessesWithContext is the same as SuspendProcesses with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) SuspendProcessesWithContext(ctx aws.Context, input *ScalingProcessQuery, opts ...aws.Option) (*SuspendProcessesOutput, error) {
+	req, out := c.SuspendProcessesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateInstanceInAutoScalingGroup = "TerminateInstanceInAutoScalingGroup"
 
 // TerminateInstanceInAutoScalingGroupRequest generates a request for the TerminateInstanceInAutoScalingGroup operation.
@@ -1575,6 +2271,18 @@ func (c *AutoScaling) TerminateInstanceInAutoScalingGroup(input *TerminateInstan
 	return out, err
 }
 
+// TerminateInstanceInAutoScalingGroupWithContext is the same as TerminateInstanceInAutoScalingGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) TerminateInstanceInAutoScalingGroupWithContext(ctx aws.Context, input *TerminateInstanceInAutoScalingGroupInput, opts ...aws.Option) (*TerminateInstanceInAutoScalingGroupOutput, error) {
+	req, out := c.TerminateInstanceInAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAutoScalingGroup = "UpdateAutoScalingGroup"
 
 // UpdateAutoScalingGroupRequest generates a request for the UpdateAutoScalingGroup operation.
@@ -1625,6 +2333,18 @@ func (c *AutoScaling) UpdateAutoScalingGroup(input *UpdateAutoScalingGroupInput)
 	return out, err
 }
 
+// UpdateAutoScalingGroupWithContext is the same as UpdateAutoScalingGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *AutoScaling) UpdateAutoScalingGroupWithContext(ctx aws.Context, input *UpdateAutoScalingGroupInput, opts ...aws.Option) (*UpdateAutoScalingGroupOutput, error) {
+	req, out := c.UpdateAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Describes scaling activity, which is a long-running process that represents
 // a change to your Auto Scaling group, such as changing its size or replacing
 // an instance.
@@ -1633,10 +2353,10 @@ type Activity struct {
 	ActivityID *string `locationName:"ActivityId" type:"string" required:"true"`
 
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The reason the activity began.
-	Cause *string `type:"string" required:"true"`
+	Cause *string `type:"string" min:"1" max:"1023" required:"true"`
 
 	// A friendly, more verbose description of the activity.
 	Description *string `type:"string"`
@@ -1657,7 +2377,7 @@ type Activity struct {
 	StatusCode *string `type:"string" required:"true"`
 
 	// A friendly, more verbose description of the activity status.
-	StatusMessage *string `type:"string"`
+	StatusMessage *string `type:"string" min:"1" max:"255"`
 
 	metadataActivity `json:"-" xml:"-"`
 }
@@ -1683,7 +2403,7 @@ func (s Activity) GoString() string {
 type AdjustmentType struct {
 	// The policy adjustment type. The valid values are ChangeInCapacity, ExactCapacity,
 	// and PercentChangeInCapacity.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255"`
 
 	metadataAdjustmentType `json:"-" xml:"-"`
 }
@@ -1705,10 +2425,10 @@ func (s AdjustmentType) GoString() string {
 // Describes an alarm.
 type Alarm struct {
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `type:"string"`
+	AlarmARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	metadataAlarm `json:"-" xml:"-"`
 }
@@ -1729,7 +2449,7 @@ func (s Alarm) GoString() string {
 
 type AttachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more EC2 instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -1771,7 +2491,7 @@ func (s AttachInstancesOutput) GoString() string {
 
 type AttachLoadBalancersInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// One or more load balancer names.
 	LoadBalancerNames []*string `type:"list"`
@@ -1814,7 +2534,7 @@ func (s AttachLoadBalancersOutput) GoString() string {
 // Describes a block device mapping.
 type BlockDeviceMapping struct {
 	// The device name exposed to the EC2 instance (for example, /dev/sdh or xvdh).
-	DeviceName *string `type:"string" required:"true"`
+	DeviceName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The information about the Amazon EBS volume.
 	EBS *EBS `locationName:"Ebs" type:"structure"`
@@ -1827,7 +2547,7 @@ type BlockDeviceMapping struct {
 	NoDevice *bool `type:"boolean"`
 
 	// The name of the virtual device, ephemeral0 to ephemeral3.
-	VirtualName *string `type:"string"`
+	VirtualName *string `type:"string" min:"1" max:"255"`
 
 	metadataBlockDeviceMapping `json:"-" xml:"-"`
 }
@@ -1848,7 +2568,7 @@ func (s BlockDeviceMapping) GoString() string {
 
 type CompleteLifecycleActionInput struct {
 	// The name of the group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The action for the group to take. This parameter can be either CONTINUE or
 	// ABANDON.
@@ -1857,10 +2577,10 @@ type CompleteLifecycleActionInput struct {
 	// A universally unique identifier (UUID) that identifies a specific lifecycle
 	// action associated with an instance. Auto Scaling sends this token to the
 	// notification target you specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataCompleteLifecycleActionInput `json:"-" xml:"-"`
 }
@@ -1900,11 +2620,11 @@ func (s CompleteLifecycleActionOutput) GoString() string {
 type CreateAutoScalingGroupInput struct {
 	// The name of the group. This name must be unique within the scope of your
 	// AWS account.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more Availability Zones for the group. This parameter is optional
 	// if you specify subnets using the VPCZoneIdentifier parameter.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start.
@@ -1937,7 +2657,7 @@ type CreateAutoScalingGroupInput struct {
 	//
 	// By default, health checks use Amazon EC2 instance status checks to determine
 	// the health of an instance. For more information, see Health Checks (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/healthcheck.html).
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32"`
 
 	// The ID of the EC2 instance used to create a launch configuration for the
 	// group. Alternatively, use the LaunchConfigurationName parameter to specify
@@ -1951,11 +2671,11 @@ type CreateAutoScalingGroupInput struct {
 	// For more information, see Create an Auto Scaling Group from an EC2 Instance
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-asg-from-instance.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16"`
 
 	// The name of the launch configuration. Alternatively, use the InstanceId parameter
 	// to specify an EC2 instance instead of a launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600"`
 
 	// One or more load balancers.
 	//
@@ -1972,7 +2692,7 @@ type CreateAutoScalingGroupInput struct {
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// The tag to be created or updated. Each tag should be defined by its resource
 	// type, resource ID, key, value, and a propagate flag. Valid values: key=value,
@@ -1999,7 +2719,7 @@ type CreateAutoScalingGroupInput struct {
 	// For more information, see Auto Scaling and Amazon Virtual Private Cloud
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataCreateAutoScalingGroupInput `json:"-" xml:"-"`
 }
@@ -2060,7 +2780,7 @@ type CreateLaunchConfigurationInput struct {
 	// This parameter is supported only if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and is not supported
@@ -2086,12 +2806,12 @@ type CreateLaunchConfigurationInput struct {
 	// AWS resources. For more information, see Launch Auto Scaling Instances with
 	// an IAM Role (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/us-iam-role.html)
 	// in the Auto Scaling Developer Guide.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600"`
 
 	// The ID of the Amazon Machine Image (AMI) to use to launch your EC2 instances.
 	// For more information, see Finding an AMI (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/finding-an-ami.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ImageID *string `locationName:"ImageId" type:"string"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255"`
 
 	// The ID of the EC2 instance to use to create the launch configuration.
 	//
@@ -2104,7 +2824,7 @@ type CreateLaunchConfigurationInput struct {
 	// For more information, see Create a Launch Configuration Using an EC2 Instance
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-lc-with-instanceID.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16"`
 
 	// Enables detailed monitoring if it is disabled. Detailed monitoring is enabled
 	// by default.
@@ -2119,19 +2839,19 @@ type CreateLaunchConfigurationInput struct {
 	// The instance type of the EC2 instance. For information about available instance
 	// types, see  Available Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html#AvailableInstanceTypes)
 	// in the Amazon Elastic Cloud Compute User Guide.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"255"`
 
 	// The ID of the kernel associated with the AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255"`
 
 	// The name of the key pair. For more information, see Amazon EC2 Key Pairs
 	// (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html) in
 	// the Amazon Elastic Compute Cloud User Guide.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255"`
 
 	// The name of the launch configuration. This name must be unique within the
 	// scope of your AWS account.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The tenancy of the instance. An instance with a tenancy of dedicated runs
 	// on single-tenant hardware and can only be launched into a VPC.
@@ -2148,10 +2868,10 @@ type CreateLaunchConfigurationInput struct {
 	// in the Auto Scaling Developer Guide.
 	//
 	// Valid values: default | dedicated
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64"`
 
 	// The ID of the RAM disk associated with the AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255"`
 
 	// One or more security groups with which to associate the instances.
 	//
@@ -2170,7 +2890,7 @@ type CreateLaunchConfigurationInput struct {
 	// the current Spot market price. For more information, see Launch Spot Instances
 	// in Your Auto Scaling Group (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/US-SpotInstances.html)
 	// in the Auto Scaling Developer Guide.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data to make available to the launched EC2 instances. For more information,
 	// see Instance Metadata and User Data (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-metadata.html)
@@ -2178,7 +2898,7 @@ type CreateLaunchConfigurationInput struct {
 	//
 	// At this time, launch configurations don't support compressed (zipped) user
 	// data files.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847"`
 
 	metadataCreateLaunchConfigurationInput `json:"-" xml:"-"`
 }
@@ -2256,7 +2976,7 @@ func (s CreateOrUpdateTagsOutput) GoString() string {
 
 type DeleteAutoScalingGroupInput struct {
 	// The name of the group to delete.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// Specifies that the group will be deleted along with all instances associated
 	// with the group, without waiting for all instances to be terminated. This
@@ -2300,7 +3020,7 @@ func (s DeleteAutoScalingGroupOutput) GoString() string {
 
 type DeleteLaunchConfigurationInput struct {
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteLaunchConfigurationInput `json:"-" xml:"-"`
 }
@@ -2339,10 +3059,10 @@ func (s DeleteLaunchConfigurationOutput) GoString() string {
 
 type DeleteLifecycleHookInput struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataDeleteLifecycleHookInput `json:"-" xml:"-"`
 }
@@ -2381,11 +3101,11 @@ func (s DeleteLifecycleHookOutput) GoString() string {
 
 type DeleteNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteNotificationConfigurationInput `json:"-" xml:"-"`
 }
@@ -2424,10 +3144,10 @@ func (s DeleteNotificationConfigurationOutput) GoString() string {
 
 type DeletePolicyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The name or Amazon Resource Name (ARN) of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeletePolicyInput `json:"-" xml:"-"`
 }
@@ -2466,10 +3186,10 @@ func (s DeletePolicyOutput) GoString() string {
 
 type DeleteScheduledActionInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the action to delete.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteScheduledActionInput `json:"-" xml:"-"`
 }
@@ -2886,7 +3606,7 @@ func (s DescribeLifecycleHookTypesOutput) GoString() string {
 
 type DescribeLifecycleHooksInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The names of one or more lifecycle hooks.
 	LifecycleHookNames []*string `type:"list"`
@@ -2931,7 +3651,7 @@ func (s DescribeLifecycleHooksOutput) GoString() string {
 
 type DescribeLoadBalancersInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The maximum number of items to return with this call.
 	MaxRecords *int64 `type:"integer"`
@@ -3079,7 +3799,7 @@ func (s DescribeNotificationConfigurationsOutput) GoString() string {
 
 type DescribePoliciesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum number of items to be returned with each call.
 	MaxRecords *int64 `type:"integer"`
@@ -3148,7 +3868,7 @@ type DescribeScalingActivitiesInput struct {
 	ActivityIDs []*string `locationName:"ActivityIds" type:"list"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum number of items to return with this call.
 	MaxRecords *int64 `type:"integer"`
@@ -3240,7 +3960,7 @@ func (s DescribeScalingProcessTypesOutput) GoString() string {
 
 type DescribeScheduledActionsInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The latest scheduled start time to return. If scheduled action names are
 	// provided, this parameter is ignored.
@@ -3403,7 +4123,7 @@ func (s DescribeTerminationPolicyTypesOutput) GoString() string {
 
 type DetachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -3452,7 +4172,7 @@ func (s DetachInstancesOutput) GoString() string {
 
 type DetachLoadBalancersInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// One or more load balancer names.
 	LoadBalancerNames []*string `type:"list"`
@@ -3494,7 +4214,7 @@ func (s DetachLoadBalancersOutput) GoString() string {
 
 type DisableMetricsCollectionInput struct {
 	// The name or Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more metrics. If you omit this parameter, all metrics are disabled.
 	//
@@ -3563,10 +4283,10 @@ type EBS struct {
 	// Valid values: Range is 100 to 4000.
 	//
 	// Default: None
-	IOPS *int64 `locationName:"Iops" type:"integer"`
+	IOPS *int64 `locationName:"Iops" type:"integer" min:"100" max:"20000"`
 
 	// The ID of the snapshot.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" min:"1" max:"255"`
 
 	// The volume size, in gigabytes.
 	//
@@ -3578,14 +4298,14 @@ type EBS struct {
 	// volume size, the default is the size of the snapshot.
 	//
 	// Required: Required when the volume type is io1.
-	VolumeSize *int64 `type:"integer"`
+	VolumeSize *int64 `type:"integer" min:"1" max:"16384"`
 
 	// The volume type.
 	//
 	// Valid values: standard | io1 | gp2
 	//
 	// Default: standard
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"1" max:"255"`
 
 	metadataEBS `json:"-" xml:"-"`
 }
@@ -3606,11 +4326,11 @@ func (s EBS) GoString() string {
 
 type EnableMetricsCollectionInput struct {
 	// The name or ARN of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The granularity to associate with the metrics to collect. The only valid
 	// value is 1Minute.
-	Granularity *string `type:"string" required:"true"`
+	Granularity *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more metrics. If you omit this parameter, all metrics are enabled.
 	//
@@ -3672,7 +4392,7 @@ func (s EnableMetricsCollectionOutput) GoString() string {
 // Describes an enabled metric.
 type EnabledMetric struct {
 	// The granularity of the metric. The only valid value is 1Minute.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255"`
 
 	// The name of the metric.
 	//
@@ -3691,7 +4411,7 @@ type EnabledMetric struct {
 	// GroupTerminatingInstances
 	//
 	// GroupTotalInstances
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255"`
 
 	metadataEnabledMetric `json:"-" xml:"-"`
 }
@@ -3712,7 +4432,7 @@ func (s EnabledMetric) GoString() string {
 
 type EnterStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instances to move into Standby mode. You must specify at least
 	// one instance ID.
@@ -3764,7 +4484,7 @@ func (s EnterStandbyOutput) GoString() string {
 
 type ExecutePolicyInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The breach threshold for the alarm.
 	//
@@ -3796,7 +4516,7 @@ type ExecutePolicyInput struct {
 	MetricValue *float64 `type:"double"`
 
 	// The name or ARN of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataExecutePolicyInput `json:"-" xml:"-"`
 }
@@ -3835,7 +4555,7 @@ func (s ExecutePolicyOutput) GoString() string {
 
 type ExitStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instance IDs. You must specify at least one instance ID.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -3907,13 +4627,13 @@ func (s Filter) GoString() string {
 // Describes an Auto Scaling group.
 type Group struct {
 	// The Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupARN *string `type:"string"`
+	AutoScalingGroupARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list" required:"true"`
+	AvailabilityZones []*string `type:"list" min:"1" required:"true"`
 
 	// The date and time the group was created.
 	CreatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -3934,13 +4654,13 @@ type Group struct {
 
 	// The service of interest for the health status check, which can be either
 	// EC2 for Amazon EC2 or ELB for Elastic Load Balancing.
-	HealthCheckType *string `type:"string" required:"true"`
+	HealthCheckType *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The EC2 instances associated with the group.
 	Instances []*Instance `type:"list"`
 
 	// The name of the associated launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more load balancers associated with the group.
 	LoadBalancerNames []*string `type:"list"`
@@ -3953,10 +4673,10 @@ type Group struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// The current state of the group when DeleteAutoScalingGroup is in progress.
-	Status *string `type:"string"`
+	Status *string `type:"string" min:"1" max:"255"`
 
 	// The suspended processes associated with the group.
 	SuspendedProcesses []*SuspendedProcess `type:"list"`
@@ -3971,7 +4691,7 @@ type Group struct {
 	//
 	// If you specify VPCZoneIdentifier and AvailabilityZones, ensure that the
 	// Availability Zones of the subnets match the values for AvailabilityZones.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataGroup `json:"-" xml:"-"`
 }
@@ -3993,16 +4713,16 @@ func (s Group) GoString() string {
 // Describes an EC2 instance.
 type Instance struct {
 	// The Availability Zone in which the instance is running.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The health status of the instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// A description of the current lifecycle state. Note that the Quarantined state
 	// is not used.
@@ -4028,26 +4748,26 @@ func (s Instance) GoString() string {
 // Describes an EC2 instance associated with an Auto Scaling group.
 type InstanceDetails struct {
 	// The name of the Auto Scaling group associated with the instance.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Availability Zone for the instance.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The health status of this instance. "Healthy" means that the instance is
 	// healthy and should remain in service. "Unhealthy" means that the instance
 	// is unhealthy and Auto Scaling should terminate and replace it.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The lifecycle state for the instance. For more information, see Auto Scaling
 	// Instance States (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/AutoScalingGroupLifecycle.html#AutoScalingStates)
 	// in the Auto Scaling Developer Guide.
-	LifecycleState *string `type:"string" required:"true"`
+	LifecycleState *string `type:"string" min:"1" max:"32" required:"true"`
 
 	metadataInstanceDetails `json:"-" xml:"-"`
 }
@@ -4101,7 +4821,7 @@ type LaunchConfiguration struct {
 	// This parameter can only be used if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and cannot be
@@ -4117,45 +4837,45 @@ type LaunchConfiguration struct {
 
 	// The name or Amazon Resource Name (ARN) of the instance profile associated
 	// with the IAM role for the instance.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600"`
 
 	// The ID of the Amazon Machine Image (AMI).
-	ImageID *string `locationName:"ImageId" type:"string" required:"true"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255" required:"true"`
 
 	// Controls whether instances in this group are launched with detailed monitoring.
 	InstanceMonitoring *InstanceMonitoring `type:"structure"`
 
 	// The instance type for the instances.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The ID of the kernel associated with the AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255"`
 
 	// The name of the key pair.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the launch configuration.
-	LaunchConfigurationARN *string `type:"string"`
+	LaunchConfigurationARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The tenancy of the instance, either default or dedicated. An instance with
 	// dedicated tenancy runs in an isolated, single-tenant hardware and can only
 	// be launched into a VPC.
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64"`
 
 	// The ID of the RAM disk associated with the AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255"`
 
 	// The security groups to associate with the instances.
 	SecurityGroups []*string `type:"list"`
 
 	// The price to bid when launching Spot Instances.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data available to the instances.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847"`
 
 	metadataLaunchConfiguration `json:"-" xml:"-"`
 }
@@ -4185,7 +4905,7 @@ func (s LaunchConfiguration) GoString() string {
 // in the Auto Scaling Developer Guide.
 type LifecycleHook struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The valid values
@@ -4203,7 +4923,7 @@ type LifecycleHook struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255"`
 
 	// The state of the EC2 instance to which you want to attach the lifecycle hook.
 	// For a list of lifecycle hook types, see DescribeLifecycleHookTypes.
@@ -4211,7 +4931,7 @@ type LifecycleHook struct {
 
 	// Additional information that you want to include any time Auto Scaling sends
 	// a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023"`
 
 	// The ARN of the notification target that Auto Scaling uses to notify you when
 	// an instance is in the transition state for the lifecycle hook. This ARN target
@@ -4220,11 +4940,11 @@ type LifecycleHook struct {
 	//
 	//  Lifecycle action token User account ID Name of the Auto Scaling group Lifecycle
 	// hook name EC2 instance ID Lifecycle transition Notification metadata
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataLifecycleHook `json:"-" xml:"-"`
 }
@@ -4246,7 +4966,7 @@ func (s LifecycleHook) GoString() string {
 // Describes the state of a load balancer.
 type LoadBalancerState struct {
 	// The name of the load balancer.
-	LoadBalancerName *string `type:"string"`
+	LoadBalancerName *string `type:"string" min:"1" max:"255"`
 
 	// The state of the load balancer.
 	//
@@ -4260,7 +4980,7 @@ type LoadBalancerState struct {
 	// Removing - The instances are being deregistered from the load balancer.
 	// If connection draining is enabled, Elastic Load Balancing waits for in-flight
 	// requests to complete before deregistering the instances.
-	State *string `type:"string"`
+	State *string `type:"string" min:"1" max:"255"`
 
 	metadataLoadBalancerState `json:"-" xml:"-"`
 }
@@ -4298,7 +5018,7 @@ type MetricCollectionType struct {
 	// GroupTerminatingInstances
 	//
 	// GroupTotalInstances
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255"`
 
 	metadataMetricCollectionType `json:"-" xml:"-"`
 }
@@ -4320,7 +5040,7 @@ func (s MetricCollectionType) GoString() string {
 // Describes a granularity of a metric.
 type MetricGranularityType struct {
 	// The granularity. The only valid value is 1Minute.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255"`
 
 	metadataMetricGranularityType `json:"-" xml:"-"`
 }
@@ -4342,7 +5062,7 @@ func (s MetricGranularityType) GoString() string {
 // Describes a notification.
 type NotificationConfiguration struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The types of events for an action to start.
 	//
@@ -4355,11 +5075,11 @@ type NotificationConfiguration struct {
 	// autoscaling:EC2_INSTANCE_TERMINATE_ERROR
 	//
 	// autoscaling:TEST_NOTIFICATION
-	NotificationType *string `type:"string"`
+	NotificationType *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string"`
+	TopicARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataNotificationConfiguration `json:"-" xml:"-"`
 }
@@ -4400,7 +5120,7 @@ type ProcessType struct {
 	// ReplaceUnhealthy
 	//
 	// ScheduledActions
-	ProcessName *string `type:"string" required:"true"`
+	ProcessName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataProcessType `json:"-" xml:"-"`
 }
@@ -4422,7 +5142,7 @@ func (s ProcessType) GoString() string {
 type PutLifecycleHookInput struct {
 	// The name of the Auto Scaling group to which you want to assign the lifecycle
 	// hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The value for this
@@ -4438,7 +5158,7 @@ type PutLifecycleHookInput struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The instance state to which you want to attach the lifecycle hook. For a
 	// list of lifecycle hook types, see DescribeLifecycleHookTypes.
@@ -4449,7 +5169,7 @@ type PutLifecycleHookInput struct {
 
 	// Contains additional information that you want to include any time Auto Scaling
 	// sends a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023"`
 
 	// The ARN of the notification target that Auto Scaling will use to notify you
 	// when an instance is in the transition state for the lifecycle hook. This
@@ -4470,14 +5190,14 @@ type PutLifecycleHookInput struct {
 	//
 	// When you call this operation, a test message is sent to the notification
 	// target. This test message contains an additional key/value pair: Event:autoscaling:TEST_NOTIFICATION.
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
 	//
 	// This parameter is required for new lifecycle hooks, but optional when updating
 	// existing hooks.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataPutLifecycleHookInput `json:"-" xml:"-"`
 }
@@ -4516,7 +5236,7 @@ func (s PutLifecycleHookOutput) GoString() string {
 
 type PutNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The type of event that will cause the notification to be sent. For details
 	// about notification types supported by Auto Scaling, see DescribeAutoScalingNotificationTypes.
@@ -4524,7 +5244,7 @@ type PutNotificationConfigurationInput struct {
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataPutNotificationConfigurationInput `json:"-" xml:"-"`
 }
@@ -4567,10 +5287,10 @@ type PutScalingPolicyInput struct {
 	//
 	// For more information, see Dynamic Scaling (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/as-scale-based-on-demand.html)
 	// in the Auto Scaling Developer Guide.
-	AdjustmentType *string `type:"string" required:"true"`
+	AdjustmentType *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The name or ARN of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The amount of time, in seconds, after a scaling activity completes and before
 	// the next scaling activity can start. If this parameter is not specified,
@@ -4594,7 +5314,7 @@ type PutScalingPolicyInput struct {
 	// as Average.
 	//
 	// This parameter is not supported if the policy type is SimpleScaling.
-	MetricAggregationType *string `type:"string"`
+	MetricAggregationType *string `type:"string" min:"1" max:"32"`
 
 	// The minimum number of instances to scale. If the value of AdjustmentType
 	// is PercentChangeInCapacity, the scaling policy changes the DesiredCapacity
@@ -4606,11 +5326,11 @@ type PutScalingPolicyInput struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The policy type. Valid values are SimpleScaling and StepScaling. If the policy
 	// type is null, the value is treated as SimpleScaling.
-	PolicyType *string `type:"string"`
+	PolicyType *string `type:"string" min:"1" max:"64"`
 
 	// The amount by which to scale, based on the specified adjustment type. A positive
 	// value adds to the current capacity while a negative number removes from the
@@ -4646,7 +5366,7 @@ func (s PutScalingPolicyInput) GoString() string {
 
 type PutScalingPolicyOutput struct {
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataPutScalingPolicyOutput `json:"-" xml:"-"`
 }
@@ -4667,7 +5387,7 @@ func (s PutScalingPolicyOutput) GoString() string {
 
 type PutScheduledUpdateGroupActionInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The number of EC2 instances that should be running in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -4687,10 +5407,10 @@ type PutScheduledUpdateGroupActionInput struct {
 	//
 	// When StartTime and EndTime are specified with Recurrence, they form the
 	// boundaries of when the recurring action will start and stop.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255"`
 
 	// The name of this scaling action.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The time for this action to start, in "YYYY-MM-DDThh:mm:ssZ" format in UTC/GMT
 	// only (for example, 2014-06-01T00:00:00Z).
@@ -4745,15 +5465,15 @@ func (s PutScheduledUpdateGroupActionOutput) GoString() string {
 
 type RecordLifecycleActionHeartbeatInput struct {
 	// The name of the Auto Scaling group for the hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// A token that uniquely identifies a specific lifecycle action associated with
 	// an instance. Auto Scaling sends this token to the notification target you
 	// specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataRecordLifecycleActionHeartbeatInput `json:"-" xml:"-"`
 }
@@ -4812,13 +5532,13 @@ func (s ResumeProcessesOutput) GoString() string {
 type ScalingPolicy struct {
 	// The adjustment type, which specifies how ScalingAdjustment is interpreted.
 	// Valid values are ChangeInCapacity, ExactCapacity, and PercentChangeInCapacity.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255"`
 
 	// The CloudWatch alarms related to the policy.
 	Alarms []*Alarm `type:"list"`
 
 	// The name of the Auto Scaling group associated with this scaling policy.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// any further trigger-related scaling activities can start.
@@ -4830,7 +5550,7 @@ type ScalingPolicy struct {
 
 	// The aggregation type for the CloudWatch metrics. Valid values are Minimum,
 	// Maximum, and Average.
-	MetricAggregationType *string `type:"string"`
+	MetricAggregationType *string `type:"string" min:"1" max:"32"`
 
 	// The minimum number of instances to scale. If the value of AdjustmentType
 	// is PercentChangeInCapacity, the scaling policy changes the DesiredCapacity
@@ -4842,13 +5562,13 @@ type ScalingPolicy struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the scaling policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"255"`
 
 	// The policy type. Valid values are SimpleScaling and StepScaling.
-	PolicyType *string `type:"string"`
+	PolicyType *string `type:"string" min:"1" max:"64"`
 
 	// The amount by which to scale, based on the specified adjustment type. A positive
 	// value adds to the current capacity while a negative number removes from the
@@ -4878,7 +5598,7 @@ func (s ScalingPolicy) GoString() string {
 
 type ScalingProcessQuery struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more of the following processes:
 	//
@@ -4919,7 +5639,7 @@ func (s ScalingProcessQuery) GoString() string {
 // Describes a scheduled update to an Auto Scaling group.
 type ScheduledUpdateGroupAction struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255"`
 
 	// The number of instances you prefer to maintain in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -4935,13 +5655,13 @@ type ScheduledUpdateGroupAction struct {
 	MinSize *int64 `type:"integer"`
 
 	// The recurring schedule for the action.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the scheduled action.
-	ScheduledActionARN *string `type:"string"`
+	ScheduledActionARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the scheduled action.
-	ScheduledActionName *string `type:"string"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255"`
 
 	// The date and time that the action is scheduled to begin. This date and time
 	// can be up to one month in the future.
@@ -4972,7 +5692,7 @@ func (s ScheduledUpdateGroupAction) GoString() string {
 
 type SetDesiredCapacityInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The number of EC2 instances that should be running in the Auto Scaling group.
 	DesiredCapacity *int64 `type:"integer" required:"true"`
@@ -5022,10 +5742,10 @@ type SetInstanceHealthInput struct {
 	// The health status of the instance. Set to Healthy if you want the instance
 	// to remain in service. Set to Unhealthy if you want the instance to be out
 	// of service. Auto Scaling will terminate and replace the unhealthy instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// If the Auto Scaling group of the specified instance has a HealthCheckGracePeriod
 	// specified for the group, by default, this call will respect the grace period.
@@ -5161,10 +5881,10 @@ func (s SuspendProcessesOutput) GoString() string {
 // see ProcessType.
 type SuspendedProcess struct {
 	// The name of the suspended process.
-	ProcessName *string `type:"string"`
+	ProcessName *string `type:"string" min:"1" max:"255"`
 
 	// The reason that the process was suspended.
-	SuspensionReason *string `type:"string"`
+	SuspensionReason *string `type:"string" min:"1" max:"255"`
 
 	metadataSuspendedProcess `json:"-" xml:"-"`
 }
@@ -5186,7 +5906,7 @@ func (s SuspendedProcess) GoString() string {
 // Describes a tag for an Auto Scaling group.
 type Tag struct {
 	// The tag key.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// Determines whether the tag is added to new instances as they are launched
 	// in the group.
@@ -5199,7 +5919,7 @@ type Tag struct {
 	ResourceType *string `type:"string"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -5221,7 +5941,7 @@ func (s Tag) GoString() string {
 // Describes a tag for an Auto Scaling group.
 type TagDescription struct {
 	// The tag key.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128"`
 
 	// Determines whether the tag is added to new instances as they are launched
 	// in the group.
@@ -5234,7 +5954,7 @@ type TagDescription struct {
 	ResourceType *string `type:"string"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTagDescription `json:"-" xml:"-"`
 }
@@ -5255,7 +5975,7 @@ func (s TagDescription) GoString() string {
 
 type TerminateInstanceInAutoScalingGroupInput struct {
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// If true, terminating this instance also decrements the size of the Auto Scaling
 	// group.
@@ -5301,10 +6021,10 @@ func (s TerminateInstanceInAutoScalingGroupOutput) GoString() string {
 
 type UpdateAutoScalingGroupInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start. For more information, see Understanding
@@ -5325,10 +6045,10 @@ type UpdateAutoScalingGroupInput struct {
 	// The type of health check for the instances in the Auto Scaling group. The
 	// health check type can either be EC2 for Amazon EC2 or ELB for Elastic Load
 	// Balancing.
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum size of the Auto Scaling group.
 	MaxSize *int64 `type:"integer"`
@@ -5338,7 +6058,7 @@ type UpdateAutoScalingGroupInput struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// A standalone termination policy or a list of termination policies used to
 	// select the instance to terminate. The policies are executed in the order
@@ -5358,7 +6078,7 @@ type UpdateAutoScalingGroupInput struct {
 	// For more information, see Auto Scaling and Amazon Virtual Private Cloud
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataUpdateAutoScalingGroupInput `json:"-" xml:"-"`
 }
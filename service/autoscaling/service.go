@@ -23,25 +23,44 @@ var initRequest func(*aws.Request)
 
 // New returns a new AutoScaling client.
 func New(config *aws.Config) *AutoScaling {
+	return newClient(aws.DefaultConfig.Merge(config), nil)
+}
+
+// NewWithSession returns a new AutoScaling client created from the
+// Config and Handlers of p, e.g. a session.Session. If config is not nil it
+// will be merged on top of the Config of p.
+func NewWithSession(p aws.ConfigProvider, config *aws.Config) *AutoScaling {
+	handlers := p.ClientHandlers()
+	return newClient(p.ClientConfig(config), &handlers)
+}
+
+// newClient creates, initializes and returns a new AutoScaling client.
+// If handlers is not nil they will be added after the client's own handlers.
+func newClient(config *aws.Config, handlers *aws.Handlers) *AutoScaling {
 	service := &aws.Service{
-		Config:      aws.DefaultConfig.Merge(config),
+		Config:      config,
 		ServiceName: "autoscaling",
+		ServiceID:   "Auto Scaling",
 		APIVersion:  "2011-01-01",
 	}
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
 		initService(service)
 	}
 
+	if handlers != nil {
+		service.Handlers.Merge(*handlers)
+	}
+
 	return &AutoScaling{service}
 }
 
@@ -40,6 +40,18 @@ func (c *CloudFormation) CancelUpdateStack(input *CancelUpdateStackInput) (*Canc
 	return out, err
 }
 
+// CancelUpdateStackWithContext is the same as CancelUpdateStack with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) CancelUpdateStackWithContext(ctx aws.Context, input *CancelUpdateStackInput, opts ...aws.Option) (*CancelUpdateStackOutput, error) {
+	req, out := c.CancelUpdateStackRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStack = "CreateStack"
 
 // CreateStackRequest generates a request for the CreateStack operation.
@@ -69,6 +81,18 @@ func (c *CloudFormation) CreateStack(input *CreateStackInput) (*CreateStackOutpu
 	return out, err
 }
 
+// CreateStackWithContext is the same as CreateStack with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) CreateStackWithContext(ctx aws.Context, input *CreateStackInput, opts ...aws.Option) (*CreateStackOutput, error) {
+	req, out := c.CreateStackRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteStack = "DeleteStack"
 
 // DeleteStackRequest generates a request for the DeleteStack operation.
@@ -98,6 +122,18 @@ func (c *CloudFormation) DeleteStack(input *DeleteStackInput) (*DeleteStackOutpu
 	return out, err
 }
 
+// DeleteStackWithContext is the same as DeleteStack with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) DeleteStackWithContext(ctx aws.Context, input *DeleteStackInput, opts ...aws.Option) (*DeleteStackOutput, error) {
+	req, out := c.DeleteStackRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStackEvents = "DescribeStackEvents"
 
 // DescribeStackEventsRequest generates a request for the DescribeStackEvents operation.
@@ -111,6 +147,7 @@ func (c *CloudFormation) DescribeStackEventsRequest(input *DescribeStackEventsIn
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"StackEvents"},
 		},
 	}
 
@@ -136,6 +173,22 @@ func (c *CloudFormation) DescribeStackEvents(input *DescribeStackEventsInput) (*
 	return out, err
 }
 
+// DescribeStackEventsWithContext is the same as DescribeStackEvents with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) DescribeStackEventsWithContext(ctx aws.Context, input *DescribeStackEventsInput, opts ...aws.Option) (*DescribeStackEventsOutput, error) {
+	req, out := c.DescribeStackEventsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeStackEventsPages iterates over the pages of a DescribeStackEvents operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFormation) DescribeStackEventsPages(input *DescribeStackEventsInput, fn func(p *DescribeStackEventsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeStackEventsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -143,6 +196,19 @@ func (c *CloudFormation) DescribeStackEventsPages(input *DescribeStackEventsInpu
 	})
 }
 
+// DescribeStackEventsPagesWithContext is the same as DescribeStackEventsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFormation) DescribeStackEventsPagesWithContext(ctx aws.Context, input *DescribeStackEventsInput, fn func(p *DescribeStackEventsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeStackEventsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStackEventsOutput), lastPage)
+	})
+}
+
 const opDescribeStackResource = "DescribeStackResource"
 
 // DescribeStackResourceRequest generates a request for the DescribeStackResource operation.
@@ -173,6 +239,18 @@ func (c *CloudFormation) DescribeStackResource(input *DescribeStackResourceInput
 	return out, err
 }
 
+// DescribeStackResourceWithContext is the same as DescribeStackResource with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) DescribeStackResourceWithContext(ctx aws.Context, input *DescribeStackResourceInput, opts ...aws.Option) (*DescribeStackResourceOutput, error) {
+	req, out := c.DescribeStackResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStackResources = "DescribeStackResources"
 
 // DescribeStackResourcesRequest generates a request for the DescribeStackResources operation.
@@ -216,6 +294,18 @@ func (c *CloudFormation) DescribeStackResources(input *DescribeStackResourcesInp
 	return out, err
 }
 
+// DescribeStackResourcesWithContext is the same as DescribeStackResources with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) DescribeStackResourcesWithContext(ctx aws.Context, input *DescribeStackResourcesInput, opts ...aws.Option) (*DescribeStackResourcesOutput, error) {
+	req, out := c.DescribeStackResourcesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStacks = "DescribeStacks"
 
 // DescribeStacksRequest generates a request for the DescribeStacks operation.
@@ -229,6 +319,7 @@ func (c *CloudFormation) DescribeStacksRequest(input *DescribeStacksInput) (req
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Stacks"},
 		},
 	}
 
@@ -250,6 +341,22 @@ func (c *CloudFormation) DescribeStacks(input *DescribeStacksInput) (*DescribeSt
 	return out, err
 }
 
+// DescribeStacksWithContext is the same as DescribeStacks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) DescribeStacksWithContext(ctx aws.Context, input *DescribeStacksInput, opts ...aws.Option) (*DescribeStacksOutput, error) {
+	req, out := c.DescribeStacksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeStacksPages iterates over the pages of a DescribeStacks operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFormation) DescribeStacksPages(input *DescribeStacksInput, fn func(p *DescribeStacksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeStacksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -257,6 +364,19 @@ func (c *CloudFormation) DescribeStacksPages(input *DescribeStacksInput, fn func
 	})
 }
 
+// DescribeStacksPagesWithContext is the same as DescribeStacksPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFormation) DescribeStacksPagesWithContext(ctx aws.Context, input *DescribeStacksInput, fn func(p *DescribeStacksOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeStacksRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStacksOutput), lastPage)
+	})
+}
+
 const opEstimateTemplateCost = "EstimateTemplateCost"
 
 // EstimateTemplateCostRequest generates a request for the EstimateTemplateCost operation.
@@ -286,6 +406,18 @@ func (c *CloudFormation) EstimateTemplateCost(input *EstimateTemplateCostInput)
 	return out, err
 }
 
+// EstimateTemplateCostWithContext is the same as EstimateTemplateCost with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) EstimateTemplateCostWithContext(ctx aws.Context, input *EstimateTemplateCostInput, opts ...aws.Option) (*EstimateTemplateCostOutput, error) {
+	req, out := c.EstimateTemplateCostRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetStackPolicy = "GetStackPolicy"
 
 // GetStackPolicyRequest generates a request for the GetStackPolicy operation.
@@ -314,6 +446,18 @@ func (c *CloudFormation) GetStackPolicy(input *GetStackPolicyInput) (*GetStackPo
 	return out, err
 }
 
+// GetStackPolicyWithContext is the same as GetStackPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) GetStackPolicyWithContext(ctx aws.Context, input *GetStackPolicyInput, opts ...aws.Option) (*GetStackPolicyOutput, error) {
+	req, out := c.GetStackPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetTemplate = "GetTemplate"
 
 // GetTemplateRequest generates a request for the GetTemplate operation.
@@ -347,6 +491,18 @@ func (c *CloudFormation) GetTemplate(input *GetTemplateInput) (*GetTemplateOutpu
 	return out, err
 }
 
+// GetTemplateWithContext is the same as GetTemplate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) GetTemplateWithContext(ctx aws.Context, input *GetTemplateInput, opts ...aws.Option) (*GetTemplateOutput, error) {
+	req, out := c.GetTemplateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetTemplateSummary = "GetTemplateSummary"
 
 // GetTemplateSummaryRequest generates a request for the GetTemplateSummary operation.
@@ -383,6 +539,18 @@ func (c *CloudFormation) GetTemplateSummary(input *GetTemplateSummaryInput) (*Ge
 	return out, err
 }
 
+// GetTemplateSummaryWithContext is the same as GetTemplateSummary with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) GetTemplateSummaryWithContext(ctx aws.Context, input *GetTemplateSummaryInput, opts ...aws.Option) (*GetTemplateSummaryOutput, error) {
+	req, out := c.GetTemplateSummaryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListStackResources = "ListStackResources"
 
 // ListStackResourcesRequest generates a request for the ListStackResources operation.
@@ -396,6 +564,7 @@ func (c *CloudFormation) ListStackResourcesRequest(input *ListStackResourcesInpu
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"StackResourceSummaries"},
 		},
 	}
 
@@ -419,6 +588,22 @@ func (c *CloudFormation) ListStackResources(input *ListStackResourcesInput) (*Li
 	return out, err
 }
 
+// ListStackResourcesWithContext is the same as ListStackResources with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) ListStackResourcesWithContext(ctx aws.Context, input *ListStackResourcesInput, opts ...aws.Option) (*ListStackResourcesOutput, error) {
+	req, out := c.ListStackResourcesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListStackResourcesPages iterates over the pages of a ListStackResources operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFormation) ListStackResourcesPages(input *ListStackResourcesInput, fn func(p *ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStackResourcesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -426,6 +611,19 @@ func (c *CloudFormation) ListStackResourcesPages(input *ListStackResourcesInput,
 	})
 }
 
+// ListStackResourcesPagesWithContext is the same as ListStackResourcesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFormation) ListStackResourcesPagesWithContext(ctx aws.Context, input *ListStackResourcesInput, fn func(p *ListStackResourcesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListStackResourcesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStackResourcesOutput), lastPage)
+	})
+}
+
 const opListStacks = "ListStacks"
 
 // ListStacksRequest generates a request for the ListStacks operation.
@@ -439,6 +637,7 @@ func (c *CloudFormation) ListStacksRequest(input *ListStacksInput) (req *aws.Req
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"StackSummaries"},
 		},
 	}
 
@@ -463,6 +662,22 @@ func (c *CloudFormation) ListStacks(input *ListStacksInput) (*ListStacksOutput,
 	return out, err
 }
 
+// ListStacksWithContext is the same as ListStacks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) ListStacksWithContext(ctx aws.Context, input *ListStacksInput, opts ...aws.Option) (*ListStacksOutput, error) {
+	req, out := c.ListStacksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListStacksPages iterates over the pages of a ListStacks operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFormation) ListStacksPages(input *ListStacksInput, fn func(p *ListStacksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStacksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -470,6 +685,19 @@ func (c *CloudFormation) ListStacksPages(input *ListStacksInput, fn func(p *List
 	})
 }
 
+// ListStacksPagesWithContext is the same as ListStacksPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFormation) ListStacksPagesWithContext(ctx aws.Context, input *ListStacksInput, fn func(p *ListStacksOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListStacksRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStacksOutput), lastPage)
+	})
+}
+
 const opSetStackPolicy = "SetStackPolicy"
 
 // SetStackPolicyRequest generates a request for the SetStackPolicy operation.
@@ -497,6 +725,18 @@ func (c *CloudFormation) SetStackPolicy(input *SetStackPolicyInput) (*SetStackPo
 	return out, err
 }
 
+// SetStackPolicyWithContext is the same as SetStackPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) SetStackPolicyWithContext(ctx aws.Context, input *SetStackPolicyInput, opts ...aws.Option) (*SetStackPolicyOutput, error) {
+	req, out := c.SetStackPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSignalResource = "SignalResource"
 
 // SignalResourceRequest generates a request for the SignalResource operation.
@@ -529,6 +769,18 @@ func (c *CloudFormation) SignalResource(input *SignalResourceInput) (*SignalReso
 	return out, err
 }
 
+// SignalResourceWithContext is the same as SignalResource with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) SignalResourceWithContext(ctx aws.Context, input *SignalResourceInput, opts ...aws.Option) (*SignalResourceOutput, error) {
+	req, out := c.SignalResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateStack = "UpdateStack"
 
 // UpdateStackRequest generates a request for the UpdateStack operation.
@@ -567,6 +819,18 @@ func (c *CloudFormation) UpdateStack(input *UpdateStackInput) (*UpdateStackOutpu
 	return out, err
 }
 
+// UpdateStackWithContext is the same as UpdateStack with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) UpdateStackWithContext(ctx aws.Context, input *UpdateStackInput, opts ...aws.Option) (*UpdateStackOutput, error) {
+	req, out := c.UpdateStackRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opValidateTemplate = "ValidateTemplate"
 
 // ValidateTemplateRequest generates a request for the ValidateTemplate operation.
@@ -594,6 +858,18 @@ func (c *CloudFormation) ValidateTemplate(input *ValidateTemplateInput) (*Valida
 	return out, err
 }
 
+// ValidateTemplateWithContext is the same as ValidateTemplate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFormation) ValidateTemplateWithContext(ctx aws.Context, input *ValidateTemplateInput, opts ...aws.Option) (*ValidateTemplateOutput, error) {
+	req, out := c.ValidateTemplateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // The input for CancelUpdateStack action.
 type CancelUpdateStackInput struct {
 	// The name or the unique stack ID that is associated with the stack.
@@ -663,7 +939,7 @@ type CreateStackInput struct {
 	// The Simple Notification Service (SNS) topic ARNs to publish stack related
 	// events. You can find your SNS topic ARNs using the SNS console (http://console.aws.amazon.com/sns)
 	// or your Command Line Interface (CLI).
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// Determines what action will be taken if stack creation fails. This must be
 	// one of: DO_NOTHING, ROLLBACK, or DELETE. You can specify either OnFailure
@@ -687,13 +963,13 @@ type CreateStackInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// A set of user-defined Tags to associate with this stack, represented by key/value
 	// pairs. Tags defined for the stack are propagated to EC2 resources that are
@@ -707,7 +983,7 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 460,800 bytes) located in an S3 bucket in the same region as the
@@ -716,12 +992,12 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// The amount of time that can pass before the stack status becomes CREATE_FAILED;
 	// if DisableRollback is not set or is set to false, the stack will be rolled
 	// back.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataCreateStackInput `json:"-" xml:"-"`
 }
@@ -808,7 +1084,7 @@ type DescribeStackEventsInput struct {
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique stack ID that is associated with the stack, which
 	// are not always interchangeable:
@@ -839,7 +1115,7 @@ func (s DescribeStackEventsInput) GoString() string {
 type DescribeStackEventsOutput struct {
 	// String that identifies the start of the next list of events, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackEvents structures.
 	StackEvents []*StackEvent `type:"list"`
@@ -991,7 +1267,7 @@ func (s DescribeStackResourcesOutput) GoString() string {
 type DescribeStacksInput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique stack ID that is associated with the stack, which
 	// are not always interchangeable:
@@ -1022,7 +1298,7 @@ func (s DescribeStacksInput) GoString() string {
 type DescribeStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of stack structures.
 	Stacks []*Stack `type:"list"`
@@ -1055,7 +1331,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateBody or TemplateURL. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -1064,7 +1340,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataEstimateTemplateCostInput `json:"-" xml:"-"`
 }
@@ -1134,7 +1410,7 @@ type GetStackPolicyOutput struct {
 	// Structure containing the stack policy body. (For more information, go to
 	//  Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide.)
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	metadataGetStackPolicyOutput `json:"-" xml:"-"`
 }
@@ -1185,7 +1461,7 @@ type GetTemplateOutput struct {
 	// Structure containing the template body. (For more information, go to Template
 	// Anatomy (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/template-anatomy.html)
 	// in the AWS CloudFormation User Guide.)
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	metadataGetTemplateOutput `json:"-" xml:"-"`
 }
@@ -1213,7 +1489,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	StackName *string `type:"string"`
+	StackName *string `type:"string" min:"1"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. For more information about templates, see
@@ -1222,7 +1498,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 460,800 bytes) located in an Amazon S3 bucket. For more information
@@ -1231,7 +1507,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataGetTemplateSummaryInput `json:"-" xml:"-"`
 }
@@ -1300,7 +1576,7 @@ type ListStackResourcesInput struct {
 	// if there is one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique stack ID that is associated with the stack, which
 	// are not always interchangeable:
@@ -1331,7 +1607,7 @@ func (s ListStackResourcesInput) GoString() string {
 type ListStackResourcesOutput struct {
 	// String that identifies the start of the next list of stack resources, if
 	// there is one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackResourceSummary structures.
 	StackResourceSummaries []*StackResourceSummary `type:"list"`
@@ -1359,7 +1635,7 @@ type ListStacksInput struct {
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// Stack status to use as a filter. Specify one or more stack status codes to
 	// list only stacks with the specified status codes. For a complete list of
@@ -1387,7 +1663,7 @@ func (s ListStacksInput) GoString() string {
 type ListStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackSummary structures containing information about the specified
 	// stacks.
@@ -1541,13 +1817,13 @@ type SetStackPolicyInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	metadataSetStackPolicyInput `json:"-" xml:"-"`
 }
@@ -1592,7 +1868,7 @@ type SignalResourceInput struct {
 
 	// The stack name or unique stack ID that includes the resource that you want
 	// to signal.
-	StackName *string `type:"string" required:"true"`
+	StackName *string `type:"string" min:"1" required:"true"`
 
 	// The status of the signal, which is either success or failure. A failure signal
 	// causes AWS CloudFormation to immediately fail the stack creation or update.
@@ -1602,7 +1878,7 @@ type SignalResourceInput struct {
 	// groups, specify the instance ID that you are signaling as the unique ID.
 	// If you send multiple signals to a single resource (such as signaling a wait
 	// condition), each signal requires a different unique ID.
-	UniqueID *string `locationName:"UniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"UniqueId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSignalResourceInput `json:"-" xml:"-"`
 }
@@ -1660,7 +1936,7 @@ type Stack struct {
 	LastUpdatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// SNS topic ARNs to which stack related events are published.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of output structures.
 	Outputs []*Output `type:"list"`
@@ -1684,7 +1960,7 @@ type Stack struct {
 	Tags []*Tag `type:"list"`
 
 	// The amount of time within which stack creation should complete.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataStack `json:"-" xml:"-"`
 }
@@ -2024,7 +2300,7 @@ type UpdateStackInput struct {
 	Capabilities []*string `type:"list"`
 
 	// Update the ARNs for the Amazon SNS topics that are associated with the stack.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of Parameter structures that specify input parameters for the stack.
 	// For more information, see the Parameter (http://docs.aws.amazon.com/AWSCloudFormation/latest/APIReference/API_Parameter.html)
@@ -2040,7 +2316,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Structure containing the temporary overriding stack policy body. You can
 	// specify either the StackPolicyDuringUpdateBody or the StackPolicyDuringUpdateURL
@@ -2049,7 +2325,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateBody *string `type:"string"`
+	StackPolicyDuringUpdateBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the temporary overriding stack policy. The
 	// URL must point to a policy (max size: 16KB) located in an S3 bucket in the
@@ -2059,7 +2335,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateURL *string `type:"string"`
+	StackPolicyDuringUpdateURL *string `type:"string" min:"1" max:"1350"`
 
 	// Location of a file containing the updated stack policy. The URL must point
 	// to a policy (max size: 16KB) located in an S3 bucket in the same region as
@@ -2069,7 +2345,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. (For more information, go to Template Anatomy
@@ -2078,7 +2354,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -2087,7 +2363,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// Reuse the existing template that is associated with the stack that you are
 	// updating.
@@ -2141,7 +2417,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 460,800 bytes) located in an S3 bucket in the same region as the
@@ -2150,7 +2426,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataValidateTemplateInput `json:"-" xml:"-"`
 }
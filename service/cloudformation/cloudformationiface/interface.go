@@ -4,6 +4,7 @@
 package cloudformationiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
@@ -11,35 +12,97 @@ import (
 type CloudFormationAPI interface {
 	CancelUpdateStack(*cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error)
 
+	CancelUpdateStackWithContext(aws.Context, *cloudformation.CancelUpdateStackInput, ...aws.Option) (*cloudformation.CancelUpdateStackOutput, error)
+
 	CreateStack(*cloudformation.CreateStackInput) (*cloudformation.CreateStackOutput, error)
 
+	CreateStackWithContext(aws.Context, *cloudformation.CreateStackInput, ...aws.Option) (*cloudformation.CreateStackOutput, error)
+
 	DeleteStack(*cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error)
 
+	DeleteStackWithContext(aws.Context, *cloudformation.DeleteStackInput, ...aws.Option) (*cloudformation.DeleteStackOutput, error)
+
 	DescribeStackEvents(*cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
 
+	DescribeStackEventsWithContext(aws.Context, *cloudformation.DescribeStackEventsInput, ...aws.Option) (*cloudformation.DescribeStackEventsOutput, error)
+
+	DescribeStackEventsPages(*cloudformation.DescribeStackEventsInput, func(*cloudformation.DescribeStackEventsOutput, bool) bool) error
+
+	DescribeStackEventsPagesWithContext(aws.Context, *cloudformation.DescribeStackEventsInput, func(*cloudformation.DescribeStackEventsOutput, bool) bool, ...aws.Option) error
+
 	DescribeStackResource(*cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error)
 
+	DescribeStackResourceWithContext(aws.Context, *cloudformation.DescribeStackResourceInput, ...aws.Option) (*cloudformation.DescribeStackResourceOutput, error)
+
 	DescribeStackResources(*cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error)
 
+	DescribeStackResourcesWithContext(aws.Context, *cloudformation.DescribeStackResourcesInput, ...aws.Option) (*cloudformation.DescribeStackResourcesOutput, error)
+
 	DescribeStacks(*cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
 
+	DescribeStacksWithContext(aws.Context, *cloudformation.DescribeStacksInput, ...aws.Option) (*cloudformation.DescribeStacksOutput, error)
+
+	DescribeStacksPages(*cloudformation.DescribeStacksInput, func(*cloudformation.DescribeStacksOutput, bool) bool) error
+
+	DescribeStacksPagesWithContext(aws.Context, *cloudformation.DescribeStacksInput, func(*cloudformation.DescribeStacksOutput, bool) bool, ...aws.Option) error
+
 	EstimateTemplateCost(*cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error)
 
+	EstimateTemplateCostWithContext(aws.Context, *cloudformation.EstimateTemplateCostInput, ...aws.Option) (*cloudformation.EstimateTemplateCostOutput, error)
+
 	GetStackPolicy(*cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error)
 
+	GetStackPolicyWithContext(aws.Context, *cloudformation.GetStackPolicyInput, ...aws.Option) (*cloudformation.GetStackPolicyOutput, error)
+
 	GetTemplate(*cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error)
 
+	GetTemplateWithContext(aws.Context, *cloudformation.GetTemplateInput, ...aws.Option) (*cloudformation.GetTemplateOutput, error)
+
 	GetTemplateSummary(*cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error)
 
+	GetTemplateSummaryWithContext(aws.Context, *cloudformation.GetTemplateSummaryInput, ...aws.Option) (*cloudformation.GetTemplateSummaryOutput, error)
+
 	ListStackResources(*cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error)
 
+	ListStackResourcesWithContext(aws.Context, *cloudformation.ListStackResourcesInput, ...aws.Option) (*cloudformation.ListStackResourcesOutput, error)
+
+	ListStackResourcesPages(*cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool) error
+
+	ListStackResourcesPagesWithContext(aws.Context, *cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool, ...aws.Option) error
+
 	ListStacks(*cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error)
 
+	ListStacksWithContext(aws.Context, *cloudformation.ListStacksInput, ...aws.Option) (*cloudformation.ListStacksOutput, error)
+
+	ListStacksPages(*cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool) error
+
+	ListStacksPagesWithContext(aws.Context, *cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool, ...aws.Option) error
+
 	SetStackPolicy(*cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error)
 
+	SetStackPolicyWithContext(aws.Context, *cloudformation.SetStackPolicyInput, ...aws.Option) (*cloudformation.SetStackPolicyOutput, error)
+
 	SignalResource(*cloudformation.SignalResourceInput) (*cloudformation.SignalResourceOutput, error)
 
+	SignalResourceWithContext(aws.Context, *cloudformation.SignalResourceInput, ...aws.Option) (*cloudformation.SignalResourceOutput, error)
+
 	UpdateStack(*cloudformation.UpdateStackInput) (*cloudformation.UpdateStackOutput, error)
 
+	UpdateStackWithContext(aws.Context, *cloudformation.UpdateStackInput, ...aws.Option) (*cloudformation.UpdateStackOutput, error)
+
 	ValidateTemplate(*cloudformation.ValidateTemplateInput) (*cloudformation.ValidateTemplateOutput, error)
+
+	ValidateTemplateWithContext(aws.Context, *cloudformation.ValidateTemplateInput, ...aws.Option) (*cloudformation.ValidateTemplateOutput, error)
+
+	WaitUntilStackCreateComplete(*cloudformation.DescribeStacksInput) error
+
+	WaitUntilStackCreateCompleteWithContext(aws.Context, *cloudformation.DescribeStacksInput, ...aws.WaiterOption) error
+
+	WaitUntilStackDeleteComplete(*cloudformation.DescribeStacksInput) error
+
+	WaitUntilStackDeleteCompleteWithContext(aws.Context, *cloudformation.DescribeStacksInput, ...aws.WaiterOption) error
+
+	WaitUntilStackUpdateComplete(*cloudformation.DescribeStacksInput) error
+
+	WaitUntilStackUpdateCompleteWithContext(aws.Context, *cloudformation.DescribeStacksInput, ...aws.WaiterOption) error
 }
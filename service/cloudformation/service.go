@@ -38,25 +38,44 @@ var initRequest func(*aws.Request)
 
 // New returns a new CloudFormation client.
 func New(config *aws.Config) *CloudFormation {
+	return newClient(aws.DefaultConfig.Merge(config), nil)
+}
+
+// NewWithSession returns a new CloudFormation client created from the
+// Config and Handlers of p, e.g. a session.Session. If config is not nil it
+// will be merged on top of the Config of p.
+func NewWithSession(p aws.ConfigProvider, config *aws.Config) *CloudFormation {
+	handlers := p.ClientHandlers()
+	return newClient(p.ClientConfig(config), &handlers)
+}
+
+// newClient creates, initializes and returns a new CloudFormation client.
+// If handlers is not nil they will be added after the client's own handlers.
+func newClient(config *aws.Config, handlers *aws.Handlers) *CloudFormation {
 	service := &aws.Service{
-		Config:      aws.DefaultConfig.Merge(config),
+		Config:      config,
 		ServiceName: "cloudformation",
+		ServiceID:   "CloudFormation",
 		APIVersion:  "2010-05-15",
 	}
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
 		initService(service)
 	}
 
+	if handlers != nil {
+		service.Handlers.Merge(*handlers)
+	}
+
 	return &CloudFormation{service}
 }
 
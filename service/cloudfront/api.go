@@ -37,6 +37,18 @@ func (c *CloudFront) CreateCloudFrontOriginAccessIdentity(input *CreateCloudFron
 	return out, err
 }
 
+// CreateCloudFrontOriginAccessIdentityWithContext is the same as CreateCloudFrontOriginAccessIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) CreateCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *CreateCloudFrontOriginAccessIdentityInput, opts ...aws.Option) (*CreateCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.CreateCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDistribution = "CreateDistribution2015_04_17"
 
 // CreateDistributionRequest generates a request for the CreateDistribution operation.
@@ -64,6 +76,18 @@ func (c *CloudFront) CreateDistribution(input *CreateDistributionInput) (*Create
 	return out, err
 }
 
+// CreateDistributionWithContext is the same as CreateDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) CreateDistributionWithContext(ctx aws.Context, input *CreateDistributionInput, opts ...aws.Option) (*CreateDistributionOutput, error) {
+	req, out := c.CreateDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInvalidation = "CreateInvalidation2015_04_17"
 
 // CreateInvalidationRequest generates a request for the CreateInvalidation operation.
@@ -91,6 +115,18 @@ func (c *CloudFront) CreateInvalidation(input *CreateInvalidationInput) (*Create
 	return out, err
 }
 
+// CreateInvalidationWithContext is the same as CreateInvalidation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) CreateInvalidationWithContext(ctx aws.Context, input *CreateInvalidationInput, opts ...aws.Option) (*CreateInvalidationOutput, error) {
+	req, out := c.CreateInvalidationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStreamingDistribution = "CreateStreamingDistribution2015_04_17"
 
 // CreateStreamingDistributionRequest generates a request for the CreateStreamingDistribution operation.
@@ -118,6 +154,18 @@ func (c *CloudFront) CreateStreamingDistribution(input *CreateStreamingDistribut
 	return out, err
 }
 
+// CreateStreamingDistributionWithContext is the same as CreateStreamingDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) CreateStreamingDistributionWithContext(ctx aws.Context, input *CreateStreamingDistributionInput, opts ...aws.Option) (*CreateStreamingDistributionOutput, error) {
+	req, out := c.CreateStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCloudFrontOriginAccessIdentity = "DeleteCloudFrontOriginAccessIdentity2015_04_17"
 
 // DeleteCloudFrontOriginAccessIdentityRequest generates a request for the DeleteCloudFrontOriginAccessIdentity operation.
@@ -145,6 +193,18 @@ func (c *CloudFront) DeleteCloudFrontOriginAccessIdentity(input *DeleteCloudFron
 	return out, err
 }
 
+// DeleteCloudFrontOriginAccessIdentityWithContext is the same as DeleteCloudFrontOriginAccessIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) DeleteCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *DeleteCloudFrontOriginAccessIdentityInput, opts ...aws.Option) (*DeleteCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.DeleteCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDistribution = "DeleteDistribution2015_04_17"
 
 // DeleteDistributionRequest generates a request for the DeleteDistribution operation.
@@ -172,6 +232,18 @@ func (c *CloudFront) DeleteDistribution(input *DeleteDistributionInput) (*Delete
 	return out, err
 }
 
+// DeleteDistributionWithContext is the same as DeleteDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) DeleteDistributionWithContext(ctx aws.Context, input *DeleteDistributionInput, opts ...aws.Option) (*DeleteDistributionOutput, error) {
+	req, out := c.DeleteDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteStreamingDistribution = "DeleteStreamingDistribution2015_04_17"
 
 // DeleteStreamingDistributionRequest generates a request for the DeleteStreamingDistribution operation.
@@ -199,6 +271,18 @@ func (c *CloudFront) DeleteStreamingDistribution(input *DeleteStreamingDistribut
 	return out, err
 }
 
+// DeleteStreamingDistributionWithContext is the same as DeleteStreamingDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) DeleteStreamingDistributionWithContext(ctx aws.Context, input *DeleteStreamingDistributionInput, opts ...aws.Option) (*DeleteStreamingDistributionOutput, error) {
+	req, out := c.DeleteStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCloudFrontOriginAccessIdentity = "GetCloudFrontOriginAccessIdentity2015_04_17"
 
 // GetCloudFrontOriginAccessIdentityRequest generates a request for the GetCloudFrontOriginAccessIdentity operation.
@@ -226,6 +310,18 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentity(input *GetCloudFrontOrigi
 	return out, err
 }
 
+// GetCloudFrontOriginAccessIdentityWithContext is the same as GetCloudFrontOriginAccessIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *GetCloudFrontOriginAccessIdentityInput, opts ...aws.Option) (*GetCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.GetCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCloudFrontOriginAccessIdentityConfig = "GetCloudFrontOriginAccessIdentityConfig2015_04_17"
 
 // GetCloudFrontOriginAccessIdentityConfigRequest generates a request for the GetCloudFrontOriginAccessIdentityConfig operation.
@@ -253,6 +349,18 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfig(input *GetCloudFron
 	return out, err
 }
 
+// GetCloudFrontOriginAccessIdentityConfigWithContext is the same as GetCloudFrontOriginAccessIdentityConfig with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfigWithContext(ctx aws.Context, input *GetCloudFrontOriginAccessIdentityConfigInput, opts ...aws.Option) (*GetCloudFrontOriginAccessIdentityConfigOutput, error) {
+	req, out := c.GetCloudFrontOriginAccessIdentityConfigRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDistribution = "GetDistribution2015_04_17"
 
 // GetDistributionRequest generates a request for the GetDistribution operation.
@@ -280,6 +388,18 @@ func (c *CloudFront) GetDistribution(input *GetDistributionInput) (*GetDistribut
 	return out, err
 }
 
+// GetDistributionWithContext is the same as GetDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) GetDistributionWithContext(ctx aws.Context, input *GetDistributionInput, opts ...aws.Option) (*GetDistributionOutput, error) {
+	req, out := c.GetDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDistributionConfig = "GetDistributionConfig2015_04_17"
 
 // GetDistributionConfigRequest generates a request for the GetDistributionConfig operation.
@@ -307,6 +427,18 @@ func (c *CloudFront) GetDistributionConfig(input *GetDistributionConfigInput) (*
 	return out, err
 }
 
+// GetDistributionConfigWithContext is the same as GetDistributionConfig with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) GetDistributionConfigWithContext(ctx aws.Context, input *GetDistributionConfigInput, opts ...aws.Option) (*GetDistributionConfigOutput, error) {
+	req, out := c.GetDistributionConfigRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetInvalidation = "GetInvalidation2015_04_17"
 
 // GetInvalidationRequest generates a request for the GetInvalidation operation.
@@ -334,6 +466,18 @@ func (c *CloudFront) GetInvalidation(input *GetInvalidationInput) (*GetInvalidat
 	return out, err
 }
 
+// GetInvalidationWithContext is the same as GetInvalidation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) GetInvalidationWithContext(ctx aws.Context, input *GetInvalidationInput, opts ...aws.Option) (*GetInvalidationOutput, error) {
+	req, out := c.GetInvalidationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetStreamingDistribution = "GetStreamingDistribution2015_04_17"
 
 // GetStreamingDistributionRequest generates a request for the GetStreamingDistribution operation.
@@ -361,6 +505,18 @@ func (c *CloudFront) GetStreamingDistribution(input *GetStreamingDistributionInp
 	return out, err
 }
 
+// GetStreamingDistributionWithContext is the same as GetStreamingDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) GetStreamingDistributionWithContext(ctx aws.Context, input *GetStreamingDistributionInput, opts ...aws.Option) (*GetStreamingDistributionOutput, error) {
+	req, out := c.GetStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetStreamingDistributionConfig = "GetStreamingDistributionConfig2015_04_17"
 
 // GetStreamingDistributionConfigRequest generates a request for the GetStreamingDistributionConfig operation.
@@ -388,6 +544,18 @@ func (c *CloudFront) GetStreamingDistributionConfig(input *GetStreamingDistribut
 	return out, err
 }
 
+// GetStreamingDistributionConfigWithContext is the same as GetStreamingDistributionConfig with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) GetStreamingDistributionConfigWithContext(ctx aws.Context, input *GetStreamingDistributionConfigInput, opts ...aws.Option) (*GetStreamingDistributionConfigOutput, error) {
+	req, out := c.GetStreamingDistributionConfigRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListCloudFrontOriginAccessIdentities = "ListCloudFrontOriginAccessIdentities2015_04_17"
 
 // ListCloudFrontOriginAccessIdentitiesRequest generates a request for the ListCloudFrontOriginAccessIdentities operation.
@@ -401,6 +569,7 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesRequest(input *ListClou
 			OutputTokens:    []string{"CloudFrontOriginAccessIdentityList.NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "CloudFrontOriginAccessIdentityList.IsTruncated",
+			ResultKeys:      []string{"CloudFrontOriginAccessIdentityList.Items"},
 		},
 	}
 
@@ -421,6 +590,22 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentities(input *ListCloudFrontO
 	return out, err
 }
 
+// ListCloudFrontOriginAccessIdentitiesWithContext is the same as ListCloudFrontOriginAccessIdentities with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesWithContext(ctx aws.Context, input *ListCloudFrontOriginAccessIdentitiesInput, opts ...aws.Option) (*ListCloudFrontOriginAccessIdentitiesOutput, error) {
+	req, out := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListCloudFrontOriginAccessIdentitiesPages iterates over the pages of a ListCloudFrontOriginAccessIdentities operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesPages(input *ListCloudFrontOriginAccessIdentitiesInput, fn func(p *ListCloudFrontOriginAccessIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -428,6 +613,19 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesPages(input *ListCloudF
 	})
 }
 
+// ListCloudFrontOriginAccessIdentitiesPagesWithContext is the same as ListCloudFrontOriginAccessIdentitiesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesPagesWithContext(ctx aws.Context, input *ListCloudFrontOriginAccessIdentitiesInput, fn func(p *ListCloudFrontOriginAccessIdentitiesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListCloudFrontOriginAccessIdentitiesOutput), lastPage)
+	})
+}
+
 const opListDistributions = "ListDistributions2015_04_17"
 
 // ListDistributionsRequest generates a request for the ListDistributions operation.
@@ -441,6 +639,7 @@ func (c *CloudFront) ListDistributionsRequest(input *ListDistributionsInput) (re
 			OutputTokens:    []string{"DistributionList.NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "DistributionList.IsTruncated",
+			ResultKeys:      []string{"DistributionList.Items"},
 		},
 	}
 
@@ -461,6 +660,22 @@ func (c *CloudFront) ListDistributions(input *ListDistributionsInput) (*ListDist
 	return out, err
 }
 
+// ListDistributionsWithContext is the same as ListDistributions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) ListDistributionsWithContext(ctx aws.Context, input *ListDistributionsInput, opts ...aws.Option) (*ListDistributionsOutput, error) {
+	req, out := c.ListDistributionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListDistributionsPages iterates over the pages of a ListDistributions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFront) ListDistributionsPages(input *ListDistributionsInput, fn func(p *ListDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDistributionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -468,6 +683,19 @@ func (c *CloudFront) ListDistributionsPages(input *ListDistributionsInput, fn fu
 	})
 }
 
+// ListDistributionsPagesWithContext is the same as ListDistributionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFront) ListDistributionsPagesWithContext(ctx aws.Context, input *ListDistributionsInput, fn func(p *ListDistributionsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListDistributionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDistributionsOutput), lastPage)
+	})
+}
+
 const opListInvalidations = "ListInvalidations2015_04_17"
 
 // ListInvalidationsRequest generates a request for the ListInvalidations operation.
@@ -481,6 +709,7 @@ func (c *CloudFront) ListInvalidationsRequest(input *ListInvalidationsInput) (re
 			OutputTokens:    []string{"InvalidationList.NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "InvalidationList.IsTruncated",
+			ResultKeys:      []string{"InvalidationList.Items"},
 		},
 	}
 
@@ -501,6 +730,22 @@ func (c *CloudFront) ListInvalidations(input *ListInvalidationsInput) (*ListInva
 	return out, err
 }
 
+// ListInvalidationsWithContext is the same as ListInvalidations with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) ListInvalidationsWithContext(ctx aws.Context, input *ListInvalidationsInput, opts ...aws.Option) (*ListInvalidationsOutput, error) {
+	req, out := c.ListInvalidationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListInvalidationsPages iterates over the pages of a ListInvalidations operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFront) ListInvalidationsPages(input *ListInvalidationsInput, fn func(p *ListInvalidationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInvalidationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -508,6 +753,19 @@ func (c *CloudFront) ListInvalidationsPages(input *ListInvalidationsInput, fn fu
 	})
 }
 
+// ListInvalidationsPagesWithContext is the same as ListInvalidationsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFront) ListInvalidationsPagesWithContext(ctx aws.Context, input *ListInvalidationsInput, fn func(p *ListInvalidationsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListInvalidationsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInvalidationsOutput), lastPage)
+	})
+}
+
 const opListStreamingDistributions = "ListStreamingDistributions2015_04_17"
 
 // ListStreamingDistributionsRequest generates a request for the ListStreamingDistributions operation.
@@ -521,6 +779,7 @@ func (c *CloudFront) ListStreamingDistributionsRequest(input *ListStreamingDistr
 			OutputTokens:    []string{"StreamingDistributionList.NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "StreamingDistributionList.IsTruncated",
+			ResultKeys:      []string{"StreamingDistributionList.Items"},
 		},
 	}
 
@@ -541,6 +800,22 @@ func (c *CloudFront) ListStreamingDistributions(input *ListStreamingDistribution
 	return out, err
 }
 
+// ListStreamingDistributionsWithContext is the same as ListStreamingDistributions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) ListStreamingDistributionsWithContext(ctx aws.Context, input *ListStreamingDistributionsInput, opts ...aws.Option) (*ListStreamingDistributionsOutput, error) {
+	req, out := c.ListStreamingDistributionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListStreamingDistributionsPages iterates over the pages of a ListStreamingDistributions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudFront) ListStreamingDistributionsPages(input *ListStreamingDistributionsInput, fn func(p *ListStreamingDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStreamingDistributionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -548,6 +823,19 @@ func (c *CloudFront) ListStreamingDistributionsPages(input *ListStreamingDistrib
 	})
 }
 
+// ListStreamingDistributionsPagesWithContext is the same as ListStreamingDistributionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudFront) ListStreamingDistributionsPagesWithContext(ctx aws.Context, input *ListStreamingDistributionsInput, fn func(p *ListStreamingDistributionsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListStreamingDistributionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStreamingDistributionsOutput), lastPage)
+	})
+}
+
 const opUpdateCloudFrontOriginAccessIdentity = "UpdateCloudFrontOriginAccessIdentity2015_04_17"
 
 // UpdateCloudFrontOriginAccessIdentityRequest generates a request for the UpdateCloudFrontOriginAccessIdentity operation.
@@ -575,6 +863,18 @@ func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(input *UpdateCloudFron
 	return out, err
 }
 
+// UpdateCloudFrontOriginAccessIdentityWithContext is the same as UpdateCloudFrontOriginAccessIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) UpdateCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *UpdateCloudFrontOriginAccessIdentityInput, opts ...aws.Option) (*UpdateCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.UpdateCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDistribution = "UpdateDistribution2015_04_17"
 
 // UpdateDistributionRequest generates a request for the UpdateDistribution operation.
@@ -602,6 +902,18 @@ func (c *CloudFront) UpdateDistribution(input *UpdateDistributionInput) (*Update
 	return out, err
 }
 
+// UpdateDistributionWithContext is the same as UpdateDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) UpdateDistributionWithContext(ctx aws.Context, input *UpdateDistributionInput, opts ...aws.Option) (*UpdateDistributionOutput, error) {
+	req, out := c.UpdateDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateStreamingDistribution = "UpdateStreamingDistribution2015_04_17"
 
 // UpdateStreamingDistributionRequest generates a request for the UpdateStreamingDistribution operation.
@@ -629,6 +941,18 @@ func (c *CloudFront) UpdateStreamingDistribution(input *UpdateStreamingDistribut
 	return out, err
 }
 
+// UpdateStreamingDistributionWithContext is the same as UpdateStreamingDistribution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudFront) UpdateStreamingDistributionWithContext(ctx aws.Context, input *UpdateStreamingDistributionInput, opts ...aws.Option) (*UpdateStreamingDistributionOutput, error) {
+	req, out := c.UpdateStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // A complex type that lists the AWS accounts, if any, that you included in
 // the TrustedSigners complex type for the default cache behavior or for any
 // of the other cache behaviors for this distribution. These are accounts that
@@ -2792,7 +3116,7 @@ func (s OriginAccessIdentitySummary) GoString() string {
 // A complex type that contains information about origins for this distribution.
 type Origins struct {
 	// A complex type that contains origins for this distribution.
-	Items []*Origin `locationNameList:"Origin" type:"list"`
+	Items []*Origin `locationNameList:"Origin" type:"list" min:"1"`
 
 	// The number of origins for this distribution.
 	Quantity *int64 `type:"integer" required:"true"`
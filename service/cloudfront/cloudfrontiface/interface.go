@@ -4,6 +4,7 @@
 package cloudfrontiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 )
 
@@ -11,43 +12,113 @@ import (
 type CloudFrontAPI interface {
 	CreateCloudFrontOriginAccessIdentity(*cloudfront.CreateCloudFrontOriginAccessIdentityInput) (*cloudfront.CreateCloudFrontOriginAccessIdentityOutput, error)
 
+	CreateCloudFrontOriginAccessIdentityWithContext(aws.Context, *cloudfront.CreateCloudFrontOriginAccessIdentityInput, ...aws.Option) (*cloudfront.CreateCloudFrontOriginAccessIdentityOutput, error)
+
 	CreateDistribution(*cloudfront.CreateDistributionInput) (*cloudfront.CreateDistributionOutput, error)
 
+	CreateDistributionWithContext(aws.Context, *cloudfront.CreateDistributionInput, ...aws.Option) (*cloudfront.CreateDistributionOutput, error)
+
 	CreateInvalidation(*cloudfront.CreateInvalidationInput) (*cloudfront.CreateInvalidationOutput, error)
 
+	CreateInvalidationWithContext(aws.Context, *cloudfront.CreateInvalidationInput, ...aws.Option) (*cloudfront.CreateInvalidationOutput, error)
+
 	CreateStreamingDistribution(*cloudfront.CreateStreamingDistributionInput) (*cloudfront.CreateStreamingDistributionOutput, error)
 
+	CreateStreamingDistributionWithContext(aws.Context, *cloudfront.CreateStreamingDistributionInput, ...aws.Option) (*cloudfront.CreateStreamingDistributionOutput, error)
+
 	DeleteCloudFrontOriginAccessIdentity(*cloudfront.DeleteCloudFrontOriginAccessIdentityInput) (*cloudfront.DeleteCloudFrontOriginAccessIdentityOutput, error)
 
+	DeleteCloudFrontOriginAccessIdentityWithContext(aws.Context, *cloudfront.DeleteCloudFrontOriginAccessIdentityInput, ...aws.Option) (*cloudfront.DeleteCloudFrontOriginAccessIdentityOutput, error)
+
 	DeleteDistribution(*cloudfront.DeleteDistributionInput) (*cloudfront.DeleteDistributionOutput, error)
 
+	DeleteDistributionWithContext(aws.Context, *cloudfront.DeleteDistributionInput, ...aws.Option) (*cloudfront.DeleteDistributionOutput, error)
+
 	DeleteStreamingDistribution(*cloudfront.DeleteStreamingDistributionInput) (*cloudfront.DeleteStreamingDistributionOutput, error)
 
+	DeleteStreamingDistributionWithContext(aws.Context, *cloudfront.DeleteStreamingDistributionInput, ...aws.Option) (*cloudfront.DeleteStreamingDistributionOutput, error)
+
 	GetCloudFrontOriginAccessIdentity(*cloudfront.GetCloudFrontOriginAccessIdentityInput) (*cloudfront.GetCloudFrontOriginAccessIdentityOutput, error)
 
+	GetCloudFrontOriginAccessIdentityWithContext(aws.Context, *cloudfront.GetCloudFrontOriginAccessIdentityInput, ...aws.Option) (*cloudfront.GetCloudFrontOriginAccessIdentityOutput, error)
+
 	GetCloudFrontOriginAccessIdentityConfig(*cloudfront.GetCloudFrontOriginAccessIdentityConfigInput) (*cloudfront.GetCloudFrontOriginAccessIdentityConfigOutput, error)
 
+	GetCloudFrontOriginAccessIdentityConfigWithContext(aws.Context, *cloudfront.GetCloudFrontOriginAccessIdentityConfigInput, ...aws.Option) (*cloudfront.GetCloudFrontOriginAccessIdentityConfigOutput, error)
+
 	GetDistribution(*cloudfront.GetDistributionInput) (*cloudfront.GetDistributionOutput, error)
 
+	GetDistributionWithContext(aws.Context, *cloudfront.GetDistributionInput, ...aws.Option) (*cloudfront.GetDistributionOutput, error)
+
 	GetDistributionConfig(*cloudfront.GetDistributionConfigInput) (*cloudfront.GetDistributionConfigOutput, error)
 
+	GetDistributionConfigWithContext(aws.Context, *cloudfront.GetDistributionConfigInput, ...aws.Option) (*cloudfront.GetDistributionConfigOutput, error)
+
 	GetInvalidation(*cloudfront.GetInvalidationInput) (*cloudfront.GetInvalidationOutput, error)
 
+	GetInvalidationWithContext(aws.Context, *cloudfront.GetInvalidationInput, ...aws.Option) (*cloudfront.GetInvalidationOutput, error)
+
 	GetStreamingDistribution(*cloudfront.GetStreamingDistributionInput) (*cloudfront.GetStreamingDistributionOutput, error)
 
+	GetStreamingDistributionWithContext(aws.Context, *cloudfront.GetStreamingDistributionInput, ...aws.Option) (*cloudfront.GetStreamingDistributionOutput, error)
+
 	GetStreamingDistributionConfig(*cloudfront.GetStreamingDistributionConfigInput) (*cloudfront.GetStreamingDistributionConfigOutput, error)
 
+	GetStreamingDistributionConfigWithContext(aws.Context, *cloudfront.GetStreamingDistributionConfigInput, ...aws.Option) (*cloudfront.GetStreamingDistributionConfigOutput, error)
+
 	ListCloudFrontOriginAccessIdentities(*cloudfront.ListCloudFrontOriginAccessIdentitiesInput) (*cloudfront.ListCloudFrontOriginAccessIdentitiesOutput, error)
 
+	ListCloudFrontOriginAccessIdentitiesWithContext(aws.Context, *cloudfront.ListCloudFrontOriginAccessIdentitiesInput, ...aws.Option) (*cloudfront.ListCloudFrontOriginAccessIdentitiesOutput, error)
+
+	ListCloudFrontOriginAccessIdentitiesPages(*cloudfront.ListCloudFrontOriginAccessIdentitiesInput, func(*cloudfront.ListCloudFrontOriginAccessIdentitiesOutput, bool) bool) error
+
+	ListCloudFrontOriginAccessIdentitiesPagesWithContext(aws.Context, *cloudfront.ListCloudFrontOriginAccessIdentitiesInput, func(*cloudfront.ListCloudFrontOriginAccessIdentitiesOutput, bool) bool, ...aws.Option) error
+
 	ListDistributions(*cloudfront.ListDistributionsInput) (*cloudfront.ListDistributionsOutput, error)
 
+	ListDistributionsWithContext(aws.Context, *cloudfront.ListDistributionsInput, ...aws.Option) (*cloudfront.ListDistributionsOutput, error)
+
+	ListDistributionsPages(*cloudfront.ListDistributionsInput, func(*cloudfront.ListDistributionsOutput, bool) bool) error
+
+	ListDistributionsPagesWithContext(aws.Context, *cloudfront.ListDistributionsInput, func(*cloudfront.ListDistributionsOutput, bool) bool, ...aws.Option) error
+
 	ListInvalidations(*cloudfront.ListInvalidationsInput) (*cloudfront.ListInvalidationsOutput, error)
 
+	ListInvalidationsWithContext(aws.Context, *cloudfront.ListInvalidationsInput, ...aws.Option) (*cloudfront.ListInvalidationsOutput, error)
+
+	ListInvalidationsPages(*cloudfront.ListInvalidationsInput, func(*cloudfront.ListInvalidationsOutput, bool) bool) error
+
+	ListInvalidationsPagesWithContext(aws.Context, *cloudfront.ListInvalidationsInput, func(*cloudfront.ListInvalidationsOutput, bool) bool, ...aws.Option) error
+
 	ListStreamingDistributions(*cloudfront.ListStreamingDistributionsInput) (*cloudfront.ListStreamingDistributionsOutput, error)
 
+	ListStreamingDistributionsWithContext(aws.Context, *cloudfront.ListStreamingDistributionsInput, ...aws.Option) (*cloudfront.ListStreamingDistributionsOutput, error)
+
+	ListStreamingDistributionsPages(*cloudfront.ListStreamingDistributionsInput, func(*cloudfront.ListStreamingDistributionsOutput, bool) bool) error
+
+	ListStreamingDistributionsPagesWithContext(aws.Context, *cloudfront.ListStreamingDistributionsInput, func(*cloudfront.ListStreamingDistributionsOutput, bool) bool, ...aws.Option) error
+
 	UpdateCloudFrontOriginAccessIdentity(*cloudfront.UpdateCloudFrontOriginAccessIdentityInput) (*cloudfront.UpdateCloudFrontOriginAccessIdentityOutput, error)
 
+	UpdateCloudFrontOriginAccessIdentityWithContext(aws.Context, *cloudfront.UpdateCloudFrontOriginAccessIdentityInput, ...aws.Option) (*cloudfront.UpdateCloudFrontOriginAccessIdentityOutput, error)
+
 	UpdateDistribution(*cloudfront.UpdateDistributionInput) (*cloudfront.UpdateDistributionOutput, error)
 
+	UpdateDistributionWithContext(aws.Context, *cloudfront.UpdateDistributionInput, ...aws.Option) (*cloudfront.UpdateDistributionOutput, error)
+
 	UpdateStreamingDistribution(*cloudfront.UpdateStreamingDistributionInput) (*cloudfront.UpdateStreamingDistributionOutput, error)
+
+	UpdateStreamingDistributionWithContext(aws.Context, *cloudfront.UpdateStreamingDistributionInput, ...aws.Option) (*cloudfront.UpdateStreamingDistributionOutput, error)
+
+	WaitUntilDistributionDeployed(*cloudfront.GetDistributionInput) error
+
+	WaitUntilDistributionDeployedWithContext(aws.Context, *cloudfront.GetDistributionInput, ...aws.WaiterOption) error
+
+	WaitUntilInvalidationCompleted(*cloudfront.GetInvalidationInput) error
+
+	WaitUntilInvalidationCompletedWithContext(aws.Context, *cloudfront.GetInvalidationInput, ...aws.WaiterOption) error
+
+	WaitUntilStreamingDistributionDeployed(*cloudfront.GetStreamingDistributionInput) error
+
+	WaitUntilStreamingDistributionDeployedWithContext(aws.Context, *cloudfront.GetStreamingDistributionInput, ...aws.WaiterOption) error
 }
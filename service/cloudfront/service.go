@@ -21,25 +21,44 @@ var initRequest func(*aws.Request)
 
 // New returns a new CloudFront client.
 func New(config *aws.Config) *CloudFront {
+	return newClient(aws.DefaultConfig.Merge(config), nil)
+}
+
+// NewWithSession returns a new CloudFront client created from the
+// Config and Handlers of p, e.g. a session.Session. If config is not nil it
+// will be merged on top of the Config of p.
+func NewWithSession(p aws.ConfigProvider, config *aws.Config) *CloudFront {
+	handlers := p.ClientHandlers()
+	return newClient(p.ClientConfig(config), &handlers)
+}
+
+// newClient creates, initializes and returns a new CloudFront client.
+// If handlers is not nil they will be added after the client's own handlers.
+func newClient(config *aws.Config, handlers *aws.Handlers) *CloudFront {
 	service := &aws.Service{
-		Config:      aws.DefaultConfig.Merge(config),
+		Config:      config,
 		ServiceName: "cloudfront",
+		ServiceID:   "CloudFront",
 		APIVersion:  "2015-04-17",
 	}
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
 		initService(service)
 	}
 
+	if handlers != nil {
+		service.Handlers.Merge(*handlers)
+	}
+
 	return &CloudFront{service}
 }
 
@@ -36,6 +36,18 @@ func (c *CloudHSM) CreateHAPG(input *CreateHAPGInput) (*CreateHAPGOutput, error)
 	return out, err
 }
 
+// CreateHAPGWithContext is the same as CreateHAPG with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) CreateHAPGWithContext(ctx aws.Context, input *CreateHAPGInput, opts ...aws.Option) (*CreateHAPGOutput, error) {
+	req, out := c.CreateHAPGRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateHSM = "CreateHsm"
 
 // CreateHSMRequest generates a request for the CreateHSM operation.
@@ -64,6 +76,18 @@ func (c *CloudHSM) CreateHSM(input *CreateHSMInput) (*CreateHSMOutput, error) {
 	return out, err
 }
 
+// CreateHSMWithContext is the same as CreateHSM with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) CreateHSMWithContext(ctx aws.Context, input *CreateHSMInput, opts ...aws.Option) (*CreateHSMOutput, error) {
+	req, out := c.CreateHSMRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLunaClient = "CreateLunaClient"
 
 // CreateLunaClientRequest generates a request for the CreateLunaClient operation.
@@ -91,6 +115,18 @@ func (c *CloudHSM) CreateLunaClient(input *CreateLunaClientInput) (*CreateLunaCl
 	return out, err
 }
 
+// CreateLunaClientWithContext is the same as CreateLunaClient with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) CreateLunaClientWithContext(ctx aws.Context, input *CreateLunaClientInput, opts ...aws.Option) (*CreateLunaClientOutput, error) {
+	req, out := c.CreateLunaClientRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHAPG = "DeleteHapg"
 
 // DeleteHAPGRequest generates a request for the DeleteHAPG operation.
@@ -118,6 +154,18 @@ func (c *CloudHSM) DeleteHAPG(input *DeleteHAPGInput) (*DeleteHAPGOutput, error)
 	return out, err
 }
 
+// DeleteHAPGWithContext is the same as DeleteHAPG with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) DeleteHAPGWithContext(ctx aws.Context, input *DeleteHAPGInput, opts ...aws.Option) (*DeleteHAPGOutput, error) {
+	req, out := c.DeleteHAPGRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHSM = "DeleteHsm"
 
 // DeleteHSMRequest generates a request for the DeleteHSM operation.
@@ -146,6 +194,18 @@ func (c *CloudHSM) DeleteHSM(input *DeleteHSMInput) (*DeleteHSMOutput, error) {
 	return out, err
 }
 
+// DeleteHSMWithContext is the same as DeleteHSM with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) DeleteHSMWithContext(ctx aws.Context, input *DeleteHSMInput, opts ...aws.Option) (*DeleteHSMOutput, error) {
+	req, out := c.DeleteHSMRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLunaClient = "DeleteLunaClient"
 
 // DeleteLunaClientRequest generates a request for the DeleteLunaClient operation.
@@ -173,6 +233,18 @@ func (c *CloudHSM) DeleteLunaClient(input *DeleteLunaClientInput) (*DeleteLunaCl
 	return out, err
 }
 
+// DeleteLunaClientWithContext is the same as DeleteLunaClient with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) DeleteLunaClientWithContext(ctx aws.Context, input *DeleteLunaClientInput, opts ...aws.Option) (*DeleteLunaClientOutput, error) {
+	req, out := c.DeleteLunaClientRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeHAPG = "DescribeHapg"
 
 // DescribeHAPGRequest generates a request for the DescribeHAPG operation.
@@ -200,6 +272,18 @@ func (c *CloudHSM) DescribeHAPG(input *DescribeHAPGInput) (*DescribeHAPGOutput,
 	return out, err
 }
 
+// DescribeHAPGWithContext is the same as DescribeHAPG with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) DescribeHAPGWithContext(ctx aws.Context, input *DescribeHAPGInput, opts ...aws.Option) (*DescribeHAPGOutput, error) {
+	req, out := c.DescribeHAPGRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeHSM = "DescribeHsm"
 
 // DescribeHSMRequest generates a request for the DescribeHSM operation.
@@ -228,6 +312,18 @@ func (c *CloudHSM) DescribeHSM(input *DescribeHSMInput) (*DescribeHSMOutput, err
 	return out, err
 }
 
+// DescribeHSMWithContext is the same as DescribeHSM with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) DescribeHSMWithContext(ctx aws.Context, input *DescribeHSMInput, opts ...aws.Option) (*DescribeHSMOutput, error) {
+	req, out := c.DescribeHSMRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLunaClient = "DescribeLunaClient"
 
 // DescribeLunaClientRequest generates a request for the DescribeLunaClient operation.
@@ -255,6 +351,18 @@ func (c *CloudHSM) DescribeLunaClient(input *DescribeLunaClientInput) (*Describe
 	return out, err
 }
 
+// DescribeLunaClientWithContext is the same as DescribeLunaClient with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) DescribeLunaClientWithContext(ctx aws.Context, input *DescribeLunaClientInput, opts ...aws.Option) (*DescribeLunaClientOutput, error) {
+	req, out := c.DescribeLunaClientRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetConfig = "GetConfig"
 
 // GetConfigRequest generates a request for the GetConfig operation.
@@ -283,6 +391,18 @@ func (c *CloudHSM) GetConfig(input *GetConfigInput) (*GetConfigOutput, error) {
 	return out, err
 }
 
+// GetConfigWithContext is the same as GetConfig with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) GetConfigWithContext(ctx aws.Context, input *GetConfigInput, opts ...aws.Option) (*GetConfigOutput, error) {
+	req, out := c.GetConfigRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListAvailableZones = "ListAvailableZones"
 
 // ListAvailableZonesRequest generates a request for the ListAvailableZones operation.
@@ -310,6 +430,18 @@ func (c *CloudHSM) ListAvailableZones(input *ListAvailableZonesInput) (*ListAvai
 	return out, err
 }
 
+// ListAvailableZonesWithContext is the same as ListAvailableZones with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) ListAvailableZonesWithContext(ctx aws.Context, input *ListAvailableZonesInput, opts ...aws.Option) (*ListAvailableZonesOutput, error) {
+	req, out := c.ListAvailableZonesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListHSMs = "ListHsms"
 
 // ListHSMsRequest generates a request for the ListHSMs operation.
@@ -343,6 +475,18 @@ func (c *CloudHSM) ListHSMs(input *ListHSMsInput) (*ListHSMsOutput, error) {
 	return out, err
 }
 
+// ListHSMsWithContext is the same as ListHSMs with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) ListHSMsWithContext(ctx aws.Context, input *ListHSMsInput, opts ...aws.Option) (*ListHSMsOutput, error) {
+	req, out := c.ListHSMsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListHapgs = "ListHapgs"
 
 // ListHapgsRequest generates a request for the ListHapgs operation.
@@ -375,6 +519,18 @@ func (c *CloudHSM) ListHapgs(input *ListHapgsInput) (*ListHapgsOutput, error) {
 	return out, err
 }
 
+// ListHapgsWithContext is the same as ListHapgs with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) ListHapgsWithContext(ctx aws.Context, input *ListHapgsInput, opts ...aws.Option) (*ListHapgsOutput, error) {
+	req, out := c.ListHapgsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListLunaClients = "ListLunaClients"
 
 // ListLunaClientsRequest generates a request for the ListLunaClients operation.
@@ -407,6 +563,18 @@ func (c *CloudHSM) ListLunaClients(input *ListLunaClientsInput) (*ListLunaClient
 	return out, err
 }
 
+// ListLunaClientsWithContext is the same as ListLunaClients with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) ListLunaClientsWithContext(ctx aws.Context, input *ListLunaClientsInput, opts ...aws.Option) (*ListLunaClientsOutput, error) {
+	req, out := c.ListLunaClientsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyHAPG = "ModifyHapg"
 
 // ModifyHAPGRequest generates a request for the ModifyHAPG operation.
@@ -434,6 +602,18 @@ func (c *CloudHSM) ModifyHAPG(input *ModifyHAPGInput) (*ModifyHAPGOutput, error)
 	return out, err
 }
 
+// ModifyHAPGWithContext is the same as ModifyHAPG with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) ModifyHAPGWithContext(ctx aws.Context, input *ModifyHAPGInput, opts ...aws.Option) (*ModifyHAPGOutput, error) {
+	req, out := c.ModifyHAPGRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyHSM = "ModifyHsm"
 
 // ModifyHSMRequest generates a request for the ModifyHSM operation.
@@ -461,6 +641,18 @@ func (c *CloudHSM) ModifyHSM(input *ModifyHSMInput) (*ModifyHSMOutput, error) {
 	return out, err
 }
 
+// ModifyHSMWithContext is the same as ModifyHSM with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) ModifyHSMWithContext(ctx aws.Context, input *ModifyHSMInput, opts ...aws.Option) (*ModifyHSMOutput, error) {
+	req, out := c.ModifyHSMRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyLunaClient = "ModifyLunaClient"
 
 // ModifyLunaClientRequest generates a request for the ModifyLunaClient operation.
@@ -491,6 +683,18 @@ func (c *CloudHSM) ModifyLunaClient(input *ModifyLunaClientInput) (*ModifyLunaCl
 	return out, err
 }
 
+// ModifyLunaClientWithContext is the same as ModifyLunaClient with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudHSM) ModifyLunaClientWithContext(ctx aws.Context, input *ModifyLunaClientInput, opts ...aws.Option) (*ModifyLunaClientOutput, error) {
+	req, out := c.ModifyLunaClientRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Contains the inputs for the CreateHapgRequest action.
 type CreateHAPGInput struct {
 	// The label of the new high-availability partition group.
@@ -539,7 +743,7 @@ func (s CreateHAPGOutput) GoString() string {
 type CreateHSMInput struct {
 	// A user-defined token to ensure idempotence. Subsequent calls to this action
 	// with the same token will be ignored.
-	ClientToken *string `locationName:"ClientToken" type:"string"`
+	ClientToken *string `locationName:"ClientToken" type:"string" idempotencyToken:"true"`
 
 	// The IP address to assign to the HSM's ENI.
 	ENIIP *string `locationName:"EniIp" type:"string"`
@@ -606,7 +810,7 @@ func (s CreateHSMOutput) GoString() string {
 type CreateLunaClientInput struct {
 	// The contents of a Base64-Encoded X.509 v3 certificate to be installed on
 	// the HSMs used by this client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" required:"true"`
 
 	// The label for the client.
 	Label *string `type:"string"`
@@ -984,7 +1188,7 @@ func (s DescribeLunaClientInput) GoString() string {
 
 type DescribeLunaClientOutput struct {
 	// The certificate installed on the HSMs used by this client.
-	Certificate *string `type:"string"`
+	Certificate *string `type:"string" min:"600" max:"2400"`
 
 	// The certificate fingerprint.
 	CertificateFingerprint *string `type:"string"`
@@ -1362,7 +1566,7 @@ func (s ModifyHSMOutput) GoString() string {
 
 type ModifyLunaClientInput struct {
 	// The new certificate for the client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" required:"true"`
 
 	// The ARN of the client.
 	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
@@ -0,0 +1,23 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cloudhsm
+
+const (
+	// ErrCodeInternalException for service response error code
+	// "CloudHsmInternalException".
+	//
+	// Indicates that an internal error occurred.
+	ErrCodeInternalException = "CloudHsmInternalException"
+
+	// ErrCodeInvalidRequestException for service response error code
+	// "InvalidRequestException".
+	//
+	// Indicates that one or more of the request parameters are not valid.
+	ErrCodeInvalidRequestException = "InvalidRequestException"
+
+	// ErrCodeServiceException for service response error code
+	// "CloudHsmServiceException".
+	//
+	// Indicates that an exception occurred in the AWS CloudHSM service.
+	ErrCodeServiceException = "CloudHsmServiceException"
+)
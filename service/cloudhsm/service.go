@@ -20,9 +20,24 @@ var initRequest func(*aws.Request)
 
 // New returns a new CloudHSM client.
 func New(config *aws.Config) *CloudHSM {
+	return newClient(aws.DefaultConfig.Merge(config), nil)
+}
+
+// NewWithSession returns a new CloudHSM client created from the
+// Config and Handlers of p, e.g. a session.Session. If config is not nil it
+// will be merged on top of the Config of p.
+func NewWithSession(p aws.ConfigProvider, config *aws.Config) *CloudHSM {
+	handlers := p.ClientHandlers()
+	return newClient(p.ClientConfig(config), &handlers)
+}
+
+// newClient creates, initializes and returns a new CloudHSM client.
+// If handlers is not nil they will be added after the client's own handlers.
+func newClient(config *aws.Config, handlers *aws.Handlers) *CloudHSM {
 	service := &aws.Service{
-		Config:       aws.DefaultConfig.Merge(config),
+		Config:       config,
 		ServiceName:  "cloudhsm",
+		ServiceID:    "CloudHSM",
 		APIVersion:   "2014-05-30",
 		JSONVersion:  "1.1",
 		TargetPrefix: "CloudHsmFrontendService",
@@ -30,17 +45,21 @@ func New(config *aws.Config) *CloudHSM {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
 		initService(service)
 	}
 
+	if handlers != nil {
+		service.Handlers.Merge(*handlers)
+	}
+
 	return &CloudHSM{service}
 }
 
@@ -39,6 +39,18 @@ func (c *CloudSearch) BuildSuggesters(input *BuildSuggestersInput) (*BuildSugges
 	return out, err
 }
 
+// BuildSuggestersWithContext is the same as BuildSuggesters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) BuildSuggestersWithContext(ctx aws.Context, input *BuildSuggestersInput, opts ...aws.Option) (*BuildSuggestersOutput, error) {
+	req, out := c.BuildSuggestersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDomain = "CreateDomain"
 
 // CreateDomainRequest generates a request for the CreateDomain operation.
@@ -68,6 +80,18 @@ func (c *CloudSearch) CreateDomain(input *CreateDomainInput) (*CreateDomainOutpu
 	return out, err
 }
 
+// CreateDomainWithContext is the same as CreateDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) CreateDomainWithContext(ctx aws.Context, input *CreateDomainInput, opts ...aws.Option) (*CreateDomainOutput, error) {
+	req, out := c.CreateDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineAnalysisScheme = "DefineAnalysisScheme"
 
 // DefineAnalysisSchemeRequest generates a request for the DefineAnalysisScheme operation.
@@ -98,6 +122,18 @@ func (c *CloudSearch) DefineAnalysisScheme(input *DefineAnalysisSchemeInput) (*D
 	return out, err
 }
 
+// DefineAnalysisSchemeWithContext is the same as DefineAnalysisScheme with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DefineAnalysisSchemeWithContext(ctx aws.Context, input *DefineAnalysisSchemeInput, opts ...aws.Option) (*DefineAnalysisSchemeOutput, error) {
+	req, out := c.DefineAnalysisSchemeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineExpression = "DefineExpression"
 
 // DefineExpressionRequest generates a request for the DefineExpression operation.
@@ -128,6 +164,18 @@ func (c *CloudSearch) DefineExpression(input *DefineExpressionInput) (*DefineExp
 	return out, err
 }
 
+// DefineExpressionWithContext is the same as DefineExpression with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DefineExpressionWithContext(ctx aws.Context, input *DefineExpressionInput, opts ...aws.Option) (*DefineExpressionOutput, error) {
+	req, out := c.DefineExpressionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineIndexField = "DefineIndexField"
 
 // DefineIndexFieldRequest generates a request for the DefineIndexField operation.
@@ -162,6 +210,18 @@ func (c *CloudSearch) DefineIndexField(input *DefineIndexFieldInput) (*DefineInd
 	return out, err
 }
 
+// DefineIndexFieldWithContext is the same as DefineIndexField with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DefineIndexFieldWithContext(ctx aws.Context, input *DefineIndexFieldInput, opts ...aws.Option) (*DefineIndexFieldOutput, error) {
+	req, out := c.DefineIndexFieldRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineSuggester = "DefineSuggester"
 
 // DefineSuggesterRequest generates a request for the DefineSuggester operation.
@@ -194,6 +254,18 @@ func (c *CloudSearch) DefineSuggester(input *DefineSuggesterInput) (*DefineSugge
 	return out, err
 }
 
+// DefineSuggesterWithContext is the same as DefineSuggester with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DefineSuggesterWithContext(ctx aws.Context, input *DefineSuggesterInput, opts ...aws.Option) (*DefineSuggesterOutput, error) {
+	req, out := c.DefineSuggesterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAnalysisScheme = "DeleteAnalysisScheme"
 
 // DeleteAnalysisSchemeRequest generates a request for the DeleteAnalysisScheme operation.
@@ -223,6 +295,18 @@ func (c *CloudSearch) DeleteAnalysisScheme(input *DeleteAnalysisSchemeInput) (*D
 	return out, err
 }
 
+// DeleteAnalysisSchemeWithContext is the same as DeleteAnalysisScheme with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DeleteAnalysisSchemeWithContext(ctx aws.Context, input *DeleteAnalysisSchemeInput, opts ...aws.Option) (*DeleteAnalysisSchemeOutput, error) {
+	req, out := c.DeleteAnalysisSchemeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDomain = "DeleteDomain"
 
 // DeleteDomainRequest generates a request for the DeleteDomain operation.
@@ -253,6 +337,18 @@ func (c *CloudSearch) DeleteDomain(input *DeleteDomainInput) (*DeleteDomainOutpu
 	return out, err
 }
 
+// DeleteDomainWithContext is the same as DeleteDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DeleteDomainWithContext(ctx aws.Context, input *DeleteDomainInput, opts ...aws.Option) (*DeleteDomainOutput, error) {
+	req, out := c.DeleteDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteExpression = "DeleteExpression"
 
 // DeleteExpressionRequest generates a request for the DeleteExpression operation.
@@ -282,6 +378,18 @@ func (c *CloudSearch) DeleteExpression(input *DeleteExpressionInput) (*DeleteExp
 	return out, err
 }
 
+// DeleteExpressionWithContext is the same as DeleteExpression with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DeleteExpressionWithContext(ctx aws.Context, input *DeleteExpressionInput, opts ...aws.Option) (*DeleteExpressionOutput, error) {
+	req, out := c.DeleteExpressionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteIndexField = "DeleteIndexField"
 
 // DeleteIndexFieldRequest generates a request for the DeleteIndexField operation.
@@ -311,6 +419,18 @@ func (c *CloudSearch) DeleteIndexField(input *DeleteIndexFieldInput) (*DeleteInd
 	return out, err
 }
 
+// DeleteIndexFieldWithContext is the same as DeleteIndexField with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DeleteIndexFieldWithContext(ctx aws.Context, input *DeleteIndexFieldInput, opts ...aws.Option) (*DeleteIndexFieldOutput, error) {
+	req, out := c.DeleteIndexFieldRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSuggester = "DeleteSuggester"
 
 // DeleteSuggesterRequest generates a request for the DeleteSuggester operation.
@@ -340,6 +460,18 @@ func (c *CloudSearch) DeleteSuggester(input *DeleteSuggesterInput) (*DeleteSugge
 	return out, err
 }
 
+// DeleteSuggesterWithContext is the same as DeleteSuggester with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DeleteSuggesterWithContext(ctx aws.Context, input *DeleteSuggesterInput, opts ...aws.Option) (*DeleteSuggesterOutput, error) {
+	req, out := c.DeleteSuggesterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAnalysisSchemes = "DescribeAnalysisSchemes"
 
 // DescribeAnalysisSchemesRequest generates a request for the DescribeAnalysisSchemes operation.
@@ -373,6 +505,18 @@ func (c *CloudSearch) DescribeAnalysisSchemes(input *DescribeAnalysisSchemesInpu
 	return out, err
 }
 
+// DescribeAnalysisSchemesWithContext is the same as DescribeAnalysisSchemes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeAnalysisSchemesWithContext(ctx aws.Context, input *DescribeAnalysisSchemesInput, opts ...aws.Option) (*DescribeAnalysisSchemesOutput, error) {
+	req, out := c.DescribeAnalysisSchemesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAvailabilityOptions = "DescribeAvailabilityOptions"
 
 // DescribeAvailabilityOptionsRequest generates a request for the DescribeAvailabilityOptions operation.
@@ -404,6 +548,18 @@ func (c *CloudSearch) DescribeAvailabilityOptions(input *DescribeAvailabilityOpt
 	return out, err
 }
 
+// DescribeAvailabilityOptionsWithContext is the same as DescribeAvailabilityOptions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeAvailabilityOptionsWithContext(ctx aws.Context, input *DescribeAvailabilityOptionsInput, opts ...aws.Option) (*DescribeAvailabilityOptionsOutput, error) {
+	req, out := c.DescribeAvailabilityOptionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDomains = "DescribeDomains"
 
 // DescribeDomainsRequest generates a request for the DescribeDomains operation.
@@ -436,6 +592,18 @@ func (c *CloudSearch) DescribeDomains(input *DescribeDomainsInput) (*DescribeDom
 	return out, err
 }
 
+// DescribeDomainsWithContext is the same as DescribeDomains with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeDomainsWithContext(ctx aws.Context, input *DescribeDomainsInput, opts ...aws.Option) (*DescribeDomainsOutput, error) {
+	req, out := c.DescribeDomainsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeExpressions = "DescribeExpressions"
 
 // DescribeExpressionsRequest generates a request for the DescribeExpressions operation.
@@ -468,6 +636,18 @@ func (c *CloudSearch) DescribeExpressions(input *DescribeExpressionsInput) (*Des
 	return out, err
 }
 
+// DescribeExpressionsWithContext is the same as DescribeExpressions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeExpressionsWithContext(ctx aws.Context, input *DescribeExpressionsInput, opts ...aws.Option) (*DescribeExpressionsOutput, error) {
+	req, out := c.DescribeExpressionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIndexFields = "DescribeIndexFields"
 
 // DescribeIndexFieldsRequest generates a request for the DescribeIndexFields operation.
@@ -500,6 +680,18 @@ func (c *CloudSearch) DescribeIndexFields(input *DescribeIndexFieldsInput) (*Des
 	return out, err
 }
 
+// DescribeIndexFieldsWithContext is the same as DescribeIndexFields with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeIndexFieldsWithContext(ctx aws.Context, input *DescribeIndexFieldsInput, opts ...aws.Option) (*DescribeIndexFieldsOutput, error) {
+	req, out := c.DescribeIndexFieldsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeScalingParameters = "DescribeScalingParameters"
 
 // DescribeScalingParametersRequest generates a request for the DescribeScalingParameters operation.
@@ -530,6 +722,18 @@ func (c *CloudSearch) DescribeScalingParameters(input *DescribeScalingParameters
 	return out, err
 }
 
+// DescribeScalingParametersWithContext is the same as DescribeScalingParameters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeScalingParametersWithContext(ctx aws.Context, input *DescribeScalingParametersInput, opts ...aws.Option) (*DescribeScalingParametersOutput, error) {
+	req, out := c.DescribeScalingParametersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeServiceAccessPolicies = "DescribeServiceAccessPolicies"
 
 // DescribeServiceAccessPoliciesRequest generates a request for the DescribeServiceAccessPolicies operation.
@@ -562,6 +766,18 @@ func (c *CloudSearch) DescribeServiceAccessPolicies(input *DescribeServiceAccess
 	return out, err
 }
 
+// DescribeServiceAccessPoliciesWithContext is the same as DescribeServiceAccessPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeServiceAccessPoliciesWithContext(ctx aws.Context, input *DescribeServiceAccessPoliciesInput, opts ...aws.Option) (*DescribeServiceAccessPoliciesOutput, error) {
+	req, out := c.DescribeServiceAccessPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSuggesters = "DescribeSuggesters"
 
 // DescribeSuggestersRequest generates a request for the DescribeSuggesters operation.
@@ -595,6 +811,18 @@ func (c *CloudSearch) DescribeSuggesters(input *DescribeSuggestersInput) (*Descr
 	return out, err
 }
 
+// DescribeSuggestersWithContext is the same as DescribeSuggesters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) DescribeSuggestersWithContext(ctx aws.Context, input *DescribeSuggestersInput, opts ...aws.Option) (*DescribeSuggestersOutput, error) {
+	req, out := c.DescribeSuggestersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opIndexDocuments = "IndexDocuments"
 
 // IndexDocumentsRequest generates a request for the IndexDocuments operation.
@@ -624,6 +852,18 @@ func (c *CloudSearch) IndexDocuments(input *IndexDocumentsInput) (*IndexDocument
 	return out, err
 }
 
+// IndexDocumentsWithContext is the same as IndexDocuments with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) IndexDocumentsWithContext(ctx aws.Context, input *IndexDocumentsInput, opts ...aws.Option) (*IndexDocumentsOutput, error) {
+	req, out := c.IndexDocumentsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListDomainNames = "ListDomainNames"
 
 // ListDomainNamesRequest generates a request for the ListDomainNames operation.
@@ -651,6 +891,18 @@ func (c *CloudSearch) ListDomainNames(input *ListDomainNamesInput) (*ListDomainN
 	return out, err
 }
 
+// ListDomainNamesWithContext is the same as ListDomainNames with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) ListDomainNamesWithContext(ctx aws.Context, input *ListDomainNamesInput, opts ...aws.Option) (*ListDomainNamesOutput, error) {
+	req, out := c.ListDomainNamesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAvailabilityOptions = "UpdateAvailabilityOptions"
 
 // UpdateAvailabilityOptionsRequest generates a request for the UpdateAvailabilityOptions operation.
@@ -683,6 +935,18 @@ func (c *CloudSearch) UpdateAvailabilityOptions(input *UpdateAvailabilityOptions
 	return out, err
 }
 
+// UpdateAvailabilityOptionsWithContext is the same as UpdateAvailabilityOptions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) UpdateAvailabilityOptionsWithContext(ctx aws.Context, input *UpdateAvailabilityOptionsInput, opts ...aws.Option) (*UpdateAvailabilityOptionsOutput, error) {
+	req, out := c.UpdateAvailabilityOptionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateScalingParameters = "UpdateScalingParameters"
 
 // UpdateScalingParametersRequest generates a request for the UpdateScalingParameters operation.
@@ -717,6 +981,18 @@ func (c *CloudSearch) UpdateScalingParameters(input *UpdateScalingParametersInpu
 	return out, err
 }
 
+// UpdateScalingParametersWithContext is the same as UpdateScalingParameters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) UpdateScalingParametersWithContext(ctx aws.Context, input *UpdateScalingParametersInput, opts ...aws.Option) (*UpdateScalingParametersOutput, error) {
+	req, out := c.UpdateScalingParametersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateServiceAccessPolicies = "UpdateServiceAccessPolicies"
 
 // UpdateServiceAccessPoliciesRequest generates a request for the UpdateServiceAccessPolicies operation.
@@ -747,6 +1023,18 @@ func (c *CloudSearch) UpdateServiceAccessPolicies(input *UpdateServiceAccessPoli
 	return out, err
 }
 
+// UpdateServiceAccessPoliciesWithContext is the same as UpdateServiceAccessPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearch) UpdateServiceAccessPoliciesWithContext(ctx aws.Context, input *UpdateServiceAccessPoliciesInput, opts ...aws.Option) (*UpdateServiceAccessPoliciesOutput, error) {
+	req, out := c.UpdateServiceAccessPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // The configured access rules for the domain's document and search endpoints,
 // and the current status of those rules.
 type AccessPoliciesStatus struct {
@@ -847,7 +1135,7 @@ type AnalysisScheme struct {
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataAnalysisScheme `json:"-" xml:"-"`
 }
@@ -926,7 +1214,7 @@ type BuildSuggestersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataBuildSuggestersInput `json:"-" xml:"-"`
 }
@@ -974,7 +1262,7 @@ type CreateDomainInput struct {
 	// A name for the domain you are creating. Allowed characters are a-z (lower-case
 	// letters), 0-9, and hyphen (-). Domain names must start with a letter or number
 	// and be at least 3 and no more than 28 characters long.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataCreateDomainInput `json:"-" xml:"-"`
 }
@@ -1020,7 +1308,7 @@ func (s CreateDomainOutput) GoString() string {
 // specifies the field is of type date-array. All options are enabled by default.
 type DateArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -1057,7 +1345,7 @@ func (s DateArrayOptions) GoString() string {
 // by default.
 type DateOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -1085,7 +1373,7 @@ type DateOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64"`
 
 	metadataDateOptions `json:"-" xml:"-"`
 }
@@ -1117,7 +1405,7 @@ type DefineAnalysisSchemeInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataDefineAnalysisSchemeInput `json:"-" xml:"-"`
 }
@@ -1167,7 +1455,7 @@ type DefineExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// A named expression that can be evaluated at search time. Can be used to sort
 	// the search results, define other expressions, or return computed information
@@ -1221,7 +1509,7 @@ type DefineIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// The index field and field options you want to configure.
 	IndexField *IndexField `type:"structure" required:"true"`
@@ -1273,7 +1561,7 @@ type DefineSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// Configuration information for a search suggester. Each suggester has a unique
 	// name and specifies the text field you want to use for suggestions. The following
@@ -1325,13 +1613,13 @@ func (s DefineSuggesterOutput) GoString() string {
 // to delete.
 type DeleteAnalysisSchemeInput struct {
 	// The name of the analysis scheme you want to delete.
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataDeleteAnalysisSchemeInput `json:"-" xml:"-"`
 }
@@ -1377,7 +1665,7 @@ func (s DeleteAnalysisSchemeOutput) GoString() string {
 // name of the domain you want to delete.
 type DeleteDomainInput struct {
 	// The name of the domain you want to permanently delete.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataDeleteDomainInput `json:"-" xml:"-"`
 }
@@ -1427,10 +1715,10 @@ type DeleteExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// The name of the Expression to delete.
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteExpressionInput `json:"-" xml:"-"`
 }
@@ -1480,11 +1768,11 @@ type DeleteIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// The name of the index field your want to remove from the domain's indexing
 	// options.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteIndexFieldInput `json:"-" xml:"-"`
 }
@@ -1533,10 +1821,10 @@ type DeleteSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// Specifies the name of the suggester you want to delete.
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteSuggesterInput `json:"-" xml:"-"`
 }
@@ -1592,7 +1880,7 @@ type DescribeAnalysisSchemesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataDescribeAnalysisSchemesInput `json:"-" xml:"-"`
 }
@@ -1644,7 +1932,7 @@ type DescribeAvailabilityOptionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataDescribeAvailabilityOptionsInput `json:"-" xml:"-"`
 }
@@ -1745,7 +2033,7 @@ type DescribeExpressionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// Limits the DescribeExpressions response to the specified expressions. If
 	// not specified, all expressions are shown.
@@ -1802,7 +2090,7 @@ type DescribeIndexFieldsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// A list of the index fields you want to describe. If not specified, information
 	// is returned for all configured index fields.
@@ -1855,7 +2143,7 @@ type DescribeScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataDescribeScalingParametersInput `json:"-" xml:"-"`
 }
@@ -1907,7 +2195,7 @@ type DescribeServiceAccessPoliciesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataDescribeServiceAccessPoliciesInput `json:"-" xml:"-"`
 }
@@ -1959,7 +2247,7 @@ type DescribeSuggestersInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// The suggesters you want to describe.
 	SuggesterNames []*string `type:"list"`
@@ -2022,7 +2310,7 @@ type DocumentSuggesterOptions struct {
 	SortExpression *string `type:"string"`
 
 	// The name of the index field you want to use for suggestions.
-	SourceField *string `type:"string" required:"true"`
+	SourceField *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDocumentSuggesterOptions `json:"-" xml:"-"`
 }
@@ -2064,13 +2352,13 @@ type DomainStatus struct {
 	DocService *ServiceEndpoint `type:"structure"`
 
 	// An internally generated unique identifier for a domain.
-	DomainID *string `locationName:"DomainId" type:"string" required:"true"`
+	DomainID *string `locationName:"DomainId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	Limits *Limits `type:"structure"`
 
@@ -2082,13 +2370,13 @@ type DomainStatus struct {
 	RequiresIndexDocuments *bool `type:"boolean" required:"true"`
 
 	// The number of search instances that are available to process search requests.
-	SearchInstanceCount *int64 `type:"integer"`
+	SearchInstanceCount *int64 `type:"integer" min:"1"`
 
 	// The instance type that is being used to process search requests.
 	SearchInstanceType *string `type:"string"`
 
 	// The number of partitions across which the search index is spread.
-	SearchPartitionCount *int64 `type:"integer"`
+	SearchPartitionCount *int64 `type:"integer" min:"1"`
 
 	// The service endpoint for requesting search results from a search domain.
 	SearchService *ServiceEndpoint `type:"structure"`
@@ -2167,7 +2455,7 @@ type DoubleOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64"`
 
 	metadataDoubleOptions `json:"-" xml:"-"`
 }
@@ -2192,13 +2480,13 @@ func (s DoubleOptions) GoString() string {
 type Expression struct {
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The expression to evaluate for sorting while processing a search request.
 	// The Expression syntax is based on JavaScript expressions. For more information,
 	// see Configuring Expressions (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/configuring-expressions.html"
 	// target="_blank) in the Amazon CloudSearch Developer Guide.
-	ExpressionValue *string `type:"string" required:"true"`
+	ExpressionValue *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataExpression `json:"-" xml:"-"`
 }
@@ -2249,7 +2537,7 @@ type IndexDocumentsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataIndexDocumentsInput `json:"-" xml:"-"`
 }
@@ -2327,7 +2615,7 @@ type IndexField struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The type of field. The valid options for a field depend on the field type.
 	// For more information about the supported field types, see Configuring Index
@@ -2468,7 +2756,7 @@ type IntOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64"`
 
 	metadataIntOptions `json:"-" xml:"-"`
 }
@@ -2492,7 +2780,7 @@ func (s IntOptions) GoString() string {
 // the field is of type latlon. All options are enabled by default.
 type LatLonOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2520,7 +2808,7 @@ type LatLonOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64"`
 
 	metadataLatLonOptions `json:"-" xml:"-"`
 }
@@ -2540,9 +2828,9 @@ func (s LatLonOptions) GoString() string {
 }
 
 type Limits struct {
-	MaximumPartitionCount *int64 `type:"integer" required:"true"`
+	MaximumPartitionCount *int64 `type:"integer" min:"1" required:"true"`
 
-	MaximumReplicationCount *int64 `type:"integer" required:"true"`
+	MaximumReplicationCount *int64 `type:"integer" min:"1" required:"true"`
 
 	metadataLimits `json:"-" xml:"-"`
 }
@@ -2607,7 +2895,7 @@ func (s ListDomainNamesOutput) GoString() string {
 // are enabled by default.
 type LiteralArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2642,7 +2930,7 @@ func (s LiteralArrayOptions) GoString() string {
 // is of type literal. All options are enabled by default.
 type LiteralOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2670,7 +2958,7 @@ type LiteralOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64"`
 
 	metadataLiteralOptions `json:"-" xml:"-"`
 }
@@ -2712,7 +3000,7 @@ type OptionStatus struct {
 	UpdateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// A unique integer that indicates when this option was last updated.
-	UpdateVersion *int64 `type:"integer"`
+	UpdateVersion *int64 `type:"integer" min:"0"`
 
 	metadataOptionStatus `json:"-" xml:"-"`
 }
@@ -2739,10 +3027,10 @@ type ScalingParameters struct {
 
 	// The number of partitions you want to preconfigure for your domain. Only valid
 	// when you select m2.2xlarge as the desired instance type.
-	DesiredPartitionCount *int64 `type:"integer"`
+	DesiredPartitionCount *int64 `type:"integer" min:"0"`
 
 	// The number of replicas you want to preconfigure for each index partition.
-	DesiredReplicationCount *int64 `type:"integer"`
+	DesiredReplicationCount *int64 `type:"integer" min:"0"`
 
 	metadataScalingParameters `json:"-" xml:"-"`
 }
@@ -2818,7 +3106,7 @@ type Suggester struct {
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSuggester `json:"-" xml:"-"`
 }
@@ -2872,7 +3160,7 @@ type TextArrayOptions struct {
 	AnalysisScheme *string `type:"string"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2908,7 +3196,7 @@ type TextOptions struct {
 	AnalysisScheme *string `type:"string"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2933,7 +3221,7 @@ type TextOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64"`
 
 	metadataTextOptions `json:"-" xml:"-"`
 }
@@ -2960,7 +3248,7 @@ type UpdateAvailabilityOptionsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// You expand an existing search domain to a second Availability Zone by setting
 	// the Multi-AZ option to true. Similarly, you can turn off the Multi-AZ option
@@ -3017,7 +3305,7 @@ type UpdateScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	// The desired instance type and desired number of replicas of each index partition.
 	ScalingParameters *ScalingParameters `type:"structure" required:"true"`
@@ -3074,7 +3362,7 @@ type UpdateServiceAccessPoliciesInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" required:"true"`
 
 	metadataUpdateServiceAccessPoliciesInput `json:"-" xml:"-"`
 }
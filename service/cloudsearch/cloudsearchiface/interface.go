@@ -4,6 +4,7 @@
 package cloudsearchiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudsearch"
 )
 
@@ -11,49 +12,97 @@ import (
 type CloudSearchAPI interface {
 	BuildSuggesters(*cloudsearch.BuildSuggestersInput) (*cloudsearch.BuildSuggestersOutput, error)
 
+	BuildSuggestersWithContext(aws.Context, *cloudsearch.BuildSuggestersInput, ...aws.Option) (*cloudsearch.BuildSuggestersOutput, error)
+
 	CreateDomain(*cloudsearch.CreateDomainInput) (*cloudsearch.CreateDomainOutput, error)
 
+	CreateDomainWithContext(aws.Context, *cloudsearch.CreateDomainInput, ...aws.Option) (*cloudsearch.CreateDomainOutput, error)
+
 	DefineAnalysisScheme(*cloudsearch.DefineAnalysisSchemeInput) (*cloudsearch.DefineAnalysisSchemeOutput, error)
 
+	DefineAnalysisSchemeWithContext(aws.Context, *cloudsearch.DefineAnalysisSchemeInput, ...aws.Option) (*cloudsearch.DefineAnalysisSchemeOutput, error)
+
 	DefineExpression(*cloudsearch.DefineExpressionInput) (*cloudsearch.DefineExpressionOutput, error)
 
+	DefineExpressionWithContext(aws.Context, *cloudsearch.DefineExpressionInput, ...aws.Option) (*cloudsearch.DefineExpressionOutput, error)
+
 	DefineIndexField(*cloudsearch.DefineIndexFieldInput) (*cloudsearch.DefineIndexFieldOutput, error)
 
+	DefineIndexFieldWithContext(aws.Context, *cloudsearch.DefineIndexFieldInput, ...aws.Option) (*cloudsearch.DefineIndexFieldOutput, error)
+
 	DefineSuggester(*cloudsearch.DefineSuggesterInput) (*cloudsearch.DefineSuggesterOutput, error)
 
+	DefineSuggesterWithContext(aws.Context, *cloudsearch.DefineSuggesterInput, ...aws.Option) (*cloudsearch.DefineSuggesterOutput, error)
+
 	DeleteAnalysisScheme(*cloudsearch.DeleteAnalysisSchemeInput) (*cloudsearch.DeleteAnalysisSchemeOutput, error)
 
+	DeleteAnalysisSchemeWithContext(aws.Context, *cloudsearch.DeleteAnalysisSchemeInput, ...aws.Option) (*cloudsearch.DeleteAnalysisSchemeOutput, error)
+
 	DeleteDomain(*cloudsearch.DeleteDomainInput) (*cloudsearch.DeleteDomainOutput, error)
 
+	DeleteDomainWithContext(aws.Context, *cloudsearch.DeleteDomainInput, ...aws.Option) (*cloudsearch.DeleteDomainOutput, error)
+
 	DeleteExpression(*cloudsearch.DeleteExpressionInput) (*cloudsearch.DeleteExpressionOutput, error)
 
+	DeleteExpressionWithContext(aws.Context, *cloudsearch.DeleteExpressionInput, ...aws.Option) (*cloudsearch.DeleteExpressionOutput, error)
+
 	DeleteIndexField(*cloudsearch.DeleteIndexFieldInput) (*cloudsearch.DeleteIndexFieldOutput, error)
 
+	DeleteIndexFieldWithContext(aws.Context, *cloudsearch.DeleteIndexFieldInput, ...aws.Option) (*cloudsearch.DeleteIndexFieldOutput, error)
+
 	DeleteSuggester(*cloudsearch.DeleteSuggesterInput) (*cloudsearch.DeleteSuggesterOutput, error)
 
+	DeleteSuggesterWithContext(aws.Context, *cloudsearch.DeleteSuggesterInput, ...aws.Option) (*cloudsearch.DeleteSuggesterOutput, error)
+
 	DescribeAnalysisSchemes(*cloudsearch.DescribeAnalysisSchemesInput) (*cloudsearch.DescribeAnalysisSchemesOutput, error)
 
+	DescribeAnalysisSchemesWithContext(aws.Context, *cloudsearch.DescribeAnalysisSchemesInput, ...aws.Option) (*cloudsearch.DescribeAnalysisSchemesOutput, error)
+
 	DescribeAvailabilityOptions(*cloudsearch.DescribeAvailabilityOptionsInput) (*cloudsearch.DescribeAvailabilityOptionsOutput, error)
 
+	DescribeAvailabilityOptionsWithContext(aws.Context, *cloudsearch.DescribeAvailabilityOptionsInput, ...aws.Option) (*cloudsearch.DescribeAvailabilityOptionsOutput, error)
+
 	DescribeDomains(*cloudsearch.DescribeDomainsInput) (*cloudsearch.DescribeDomainsOutput, error)
 
+	DescribeDomainsWithContext(aws.Context, *cloudsearch.DescribeDomainsInput, ...aws.Option) (*cloudsearch.DescribeDomainsOutput, error)
+
 	DescribeExpressions(*cloudsearch.DescribeExpressionsInput) (*cloudsearch.DescribeExpressionsOutput, error)
 
+	DescribeExpressionsWithContext(aws.Context, *cloudsearch.DescribeExpressionsInput, ...aws.Option) (*cloudsearch.DescribeExpressionsOutput, error)
+
 	DescribeIndexFields(*cloudsearch.DescribeIndexFieldsInput) (*cloudsearch.DescribeIndexFieldsOutput, error)
 
+	DescribeIndexFieldsWithContext(aws.Context, *cloudsearch.DescribeIndexFieldsInput, ...aws.Option) (*cloudsearch.DescribeIndexFieldsOutput, error)
+
 	DescribeScalingParameters(*cloudsearch.DescribeScalingParametersInput) (*cloudsearch.DescribeScalingParametersOutput, error)
 
+	DescribeScalingParametersWithContext(aws.Context, *cloudsearch.DescribeScalingParametersInput, ...aws.Option) (*cloudsearch.DescribeScalingParametersOutput, error)
+
 	DescribeServiceAccessPolicies(*cloudsearch.DescribeServiceAccessPoliciesInput) (*cloudsearch.DescribeServiceAccessPoliciesOutput, error)
 
+	DescribeServiceAccessPoliciesWithContext(aws.Context, *cloudsearch.DescribeServiceAccessPoliciesInput, ...aws.Option) (*cloudsearch.DescribeServiceAccessPoliciesOutput, error)
+
 	DescribeSuggesters(*cloudsearch.DescribeSuggestersInput) (*cloudsearch.DescribeSuggestersOutput, error)
 
+	DescribeSuggestersWithContext(aws.Context, *cloudsearch.DescribeSuggestersInput, ...aws.Option) (*cloudsearch.DescribeSuggestersOutput, error)
+
 	IndexDocuments(*cloudsearch.IndexDocumentsInput) (*cloudsearch.IndexDocumentsOutput, error)
 
+	IndexDocumentsWithContext(aws.Context, *cloudsearch.IndexDocumentsInput, ...aws.Option) (*cloudsearch.IndexDocumentsOutput, error)
+
 	ListDomainNames(*cloudsearch.ListDomainNamesInput) (*cloudsearch.ListDomainNamesOutput, error)
 
+	ListDomainNamesWithContext(aws.Context, *cloudsearch.ListDomainNamesInput, ...aws.Option) (*cloudsearch.ListDomainNamesOutput, error)
+
 	UpdateAvailabilityOptions(*cloudsearch.UpdateAvailabilityOptionsInput) (*cloudsearch.UpdateAvailabilityOptionsOutput, error)
 
+	UpdateAvailabilityOptionsWithContext(aws.Context, *cloudsearch.UpdateAvailabilityOptionsInput, ...aws.Option) (*cloudsearch.UpdateAvailabilityOptionsOutput, error)
+
 	UpdateScalingParameters(*cloudsearch.UpdateScalingParametersInput) (*cloudsearch.UpdateScalingParametersOutput, error)
 
+	UpdateScalingParametersWithContext(aws.Context, *cloudsearch.UpdateScalingParametersInput, ...aws.Option) (*cloudsearch.UpdateScalingParametersOutput, error)
+
 	UpdateServiceAccessPolicies(*cloudsearch.UpdateServiceAccessPoliciesInput) (*cloudsearch.UpdateServiceAccessPoliciesOutput, error)
+
+	UpdateServiceAccessPoliciesWithContext(aws.Context, *cloudsearch.UpdateServiceAccessPoliciesInput, ...aws.Option) (*cloudsearch.UpdateServiceAccessPoliciesOutput, error)
 }
@@ -0,0 +1,44 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cloudsearch
+
+const (
+	// ErrCodeBaseException for service response error code
+	// "BaseException".
+	//
+	// An error occurred while processing the request.
+	ErrCodeBaseException = "BaseException"
+
+	// ErrCodeDisabledOperationException for service response error code
+	// "DisabledAction".
+	//
+	// The request was rejected because it attempted an operation which is not enabled.
+	ErrCodeDisabledOperationException = "DisabledAction"
+
+	// ErrCodeInternalException for service response error code
+	// "InternalException".
+	//
+	// An internal error occurred while processing the request. If this problem
+	// persists, report an issue from the Service Health Dashboard (http://status.aws.amazon.com/"
+	// target="_blank).
+	ErrCodeInternalException = "InternalException"
+
+	// ErrCodeInvalidTypeException for service response error code
+	// "InvalidType".
+	//
+	// The request was rejected because it specified an invalid type definition.
+	ErrCodeInvalidTypeException = "InvalidType"
+
+	// ErrCodeLimitExceededException for service response error code
+	// "LimitExceeded".
+	//
+	// The request was rejected because a resource limit has already been met.
+	ErrCodeLimitExceededException = "LimitExceeded"
+
+	// ErrCodeResourceNotFoundException for service response error code
+	// "ResourceNotFound".
+	//
+	// The request was rejected because it attempted to reference a resource that
+	// does not exist.
+	ErrCodeResourceNotFoundException = "ResourceNotFound"
+)
@@ -55,6 +55,18 @@ func (c *CloudSearchDomain) Search(input *SearchInput) (*SearchOutput, error) {
 	return out, err
 }
 
+// SearchWithContext is the same as Search with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearchDomain) SearchWithContext(ctx aws.Context, input *SearchInput, opts ...aws.Option) (*SearchOutput, error) {
+	req, out := c.SearchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSuggest = "Suggest"
 
 // SuggestRequest generates a request for the Suggest operation.
@@ -98,6 +110,18 @@ func (c *CloudSearchDomain) Suggest(input *SuggestInput) (*SuggestOutput, error)
 	return out, err
 }
 
+// SuggestWithContext is the same as Suggest with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearchDomain) SuggestWithContext(ctx aws.Context, input *SuggestInput, opts ...aws.Option) (*SuggestOutput, error) {
+	req, out := c.SuggestRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadDocuments = "UploadDocuments"
 
 // UploadDocumentsRequest generates a request for the UploadDocuments operation.
@@ -146,6 +170,18 @@ func (c *CloudSearchDomain) UploadDocuments(input *UploadDocumentsInput) (*Uploa
 	return out, err
 }
 
+// UploadDocumentsWithContext is the same as UploadDocuments with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudSearchDomain) UploadDocumentsWithContext(ctx aws.Context, input *UploadDocumentsInput, opts ...aws.Option) (*UploadDocumentsOutput, error) {
+	req, out := c.UploadDocumentsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // A container for facet information.
 type Bucket struct {
 	// The number of hits that contain the facet value in the specified facet field.
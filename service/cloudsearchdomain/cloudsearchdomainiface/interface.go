@@ -4,6 +4,7 @@
 package cloudsearchdomainiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudsearchdomain"
 )
 
@@ -11,7 +12,13 @@ import (
 type CloudSearchDomainAPI interface {
 	Search(*cloudsearchdomain.SearchInput) (*cloudsearchdomain.SearchOutput, error)
 
+	SearchWithContext(aws.Context, *cloudsearchdomain.SearchInput, ...aws.Option) (*cloudsearchdomain.SearchOutput, error)
+
 	Suggest(*cloudsearchdomain.SuggestInput) (*cloudsearchdomain.SuggestOutput, error)
 
+	SuggestWithContext(aws.Context, *cloudsearchdomain.SuggestInput, ...aws.Option) (*cloudsearchdomain.SuggestOutput, error)
+
 	UploadDocuments(*cloudsearchdomain.UploadDocumentsInput) (*cloudsearchdomain.UploadDocumentsOutput, error)
+
+	UploadDocumentsWithContext(aws.Context, *cloudsearchdomain.UploadDocumentsInput, ...aws.Option) (*cloudsearchdomain.UploadDocumentsOutput, error)
 }
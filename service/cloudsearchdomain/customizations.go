@@ -0,0 +1,22 @@
+package cloudsearchdomain
+
+import "github.com/aws/aws-sdk-go/aws"
+
+func init() {
+	initService = func(s *aws.Service) {
+		// Each search domain has its own endpoint, so the client requires the
+		// domain's endpoint instead of the endpoint derived for the region.
+		if !s.HasCustomEndpoint() {
+			s.Endpoint = ""
+		}
+		s.Handlers.Validate.PushBack(validateEndpoint)
+	}
+}
+
+// validateEndpoint requires the domain's endpoint even if the region is also
+// missing, including when a request's Config overrides the region.
+func validateEndpoint(r *aws.Request) {
+	if !r.Service.HasCustomEndpoint() {
+		r.Error = aws.ErrMissingEndpoint
+	}
+}
@@ -1,6 +1,7 @@
 package cloudsearchdomain_test
 
 import (
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -34,6 +35,22 @@ func TestRequireEndpointIfNoRegionProvided(t *testing.T) {
 	assert.Equal(t, aws.ErrMissingEndpoint, err)
 }
 
+func TestRequireEndpointFromEnv(t *testing.T) {
+	oldEnv := os.Getenv("AWS_ENDPOINT_URL_CLOUDSEARCH_DOMAIN")
+	defer os.Setenv("AWS_ENDPOINT_URL_CLOUDSEARCH_DOMAIN", oldEnv)
+
+	os.Setenv("AWS_ENDPOINT_URL_CLOUDSEARCH_DOMAIN", "https://endpoint")
+	svc := cloudsearchdomain.New(&aws.Config{
+		Region:                 "mock-region",
+		DisableParamValidation: true,
+	})
+	req, _ := svc.SearchRequest(nil)
+	err := req.Build()
+
+	assert.Equal(t, "https://endpoint", svc.Endpoint)
+	assert.NoError(t, err)
+}
+
 func TestRequireEndpointUsed(t *testing.T) {
 	svc := cloudsearchdomain.New(&aws.Config{
 		Region:                 "mock-region",
@@ -46,3 +63,44 @@ func TestRequireEndpointUsed(t *testing.T) {
 	assert.Equal(t, "https://endpoint", svc.Endpoint)
 	assert.NoError(t, err)
 }
+
+func TestRequireEndpointMatchingDefaultUsed(t *testing.T) {
+	svc := cloudsearchdomain.New(&aws.Config{
+		Region:                 "mock-region",
+		DisableParamValidation: true,
+		Endpoint:               "https://cloudsearchdomain.mock-region.amazonaws.com",
+	})
+	req, _ := svc.SearchRequest(nil)
+	err := req.Build()
+
+	assert.Equal(t, "https://cloudsearchdomain.mock-region.amazonaws.com", svc.Endpoint)
+	assert.NoError(t, err)
+}
+
+func TestRequireEndpointFromResolver(t *testing.T) {
+	svc := cloudsearchdomain.New(&aws.Config{
+		Region:                 "mock-region",
+		DisableParamValidation: true,
+		UseDualStack:           true,
+		EndpointResolver: aws.EndpointResolverFunc(func(service, region string) (string, string, error) {
+			return "https://search-domain.mock-region.cloudsearch.amazonaws.com", "", nil
+		}),
+	})
+	req, _ := svc.SearchRequest(nil)
+	err := req.Build()
+
+	assert.Equal(t, "https://search-domain.mock-region.cloudsearch.amazonaws.com", svc.Endpoint)
+	assert.NoError(t, err)
+}
+
+func TestRequireEndpointIfRequestRegionProvided(t *testing.T) {
+	svc := cloudsearchdomain.New(&aws.Config{
+		DisableParamValidation: true,
+	})
+	req, _ := svc.SearchRequest(nil)
+	req.ApplyOptions(aws.WithConfig(&aws.Config{Region: "mock-region"}))
+	err := req.Build()
+
+	assert.Error(t, err)
+	assert.Equal(t, aws.ErrMissingEndpoint, err)
+}
@@ -0,0 +1,109 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cloudtrail
+
+const (
+	// ErrCodeCloudWatchLogsDeliveryUnavailableException for service response error code
+	// "CloudWatchLogsDeliveryUnavailable".
+	//
+	// Cannot set a CloudWatch Logs delivery for this region.
+	ErrCodeCloudWatchLogsDeliveryUnavailableException = "CloudWatchLogsDeliveryUnavailable"
+
+	// ErrCodeInsufficientS3BucketPolicyException for service response error code
+	// "InsufficientS3BucketPolicy".
+	//
+	// This exception is thrown when the policy on the S3 bucket is not sufficient.
+	ErrCodeInsufficientS3BucketPolicyException = "InsufficientS3BucketPolicy"
+
+	// ErrCodeInsufficientSNSTopicPolicyException for service response error code
+	// "InsufficientSnsTopicPolicy".
+	//
+	// This exception is thrown when the policy on the SNS topic is not sufficient.
+	ErrCodeInsufficientSNSTopicPolicyException = "InsufficientSnsTopicPolicy"
+
+	// ErrCodeInvalidCloudWatchLogsLogGroupARNException for service response error code
+	// "InvalidCloudWatchLogsLogGroupArn".
+	//
+	// This exception is thrown when the provided CloudWatch log group is not valid.
+	ErrCodeInvalidCloudWatchLogsLogGroupARNException = "InvalidCloudWatchLogsLogGroupArn"
+
+	// ErrCodeInvalidCloudWatchLogsRoleARNException for service response error code
+	// "InvalidCloudWatchLogsRoleArn".
+	//
+	// This exception is thrown when the provided role is not valid.
+	ErrCodeInvalidCloudWatchLogsRoleARNException = "InvalidCloudWatchLogsRoleArn"
+
+	// ErrCodeInvalidLookupAttributesException for service response error code
+	// "InvalidLookupAttributes".
+	//
+	// Occurs when an invalid lookup attribute is specified.
+	ErrCodeInvalidLookupAttributesException = "InvalidLookupAttributes"
+
+	// ErrCodeInvalidMaxResultsException for service response error code
+	// "InvalidMaxResults".
+	//
+	// This exception is thrown if the limit specified is invalid.
+	ErrCodeInvalidMaxResultsException = "InvalidMaxResults"
+
+	// ErrCodeInvalidNextTokenException for service response error code
+	// "InvalidNextToken".
+	//
+	// Invalid token or token that was previously used in a request with different
+	// parameters. This exception is thrown if the token is invalid.
+	ErrCodeInvalidNextTokenException = "InvalidNextToken"
+
+	// ErrCodeInvalidS3BucketNameException for service response error code
+	// "InvalidS3BucketName".
+	//
+	// This exception is thrown when the provided S3 bucket name is not valid.
+	ErrCodeInvalidS3BucketNameException = "InvalidS3BucketName"
+
+	// ErrCodeInvalidS3PrefixException for service response error code
+	// "InvalidS3Prefix".
+	//
+	// This exception is thrown when the provided S3 prefix is not valid.
+	ErrCodeInvalidS3PrefixException = "InvalidS3Prefix"
+
+	// ErrCodeInvalidSNSTopicNameException for service response error code
+	// "InvalidSnsTopicName".
+	//
+	// This exception is thrown when the provided SNS topic name is not valid.
+	ErrCodeInvalidSNSTopicNameException = "InvalidSnsTopicName"
+
+	// ErrCodeInvalidTimeRangeException for service response error code
+	// "InvalidTimeRange".
+	//
+	// Occurs if the timestamp values are invalid. Either the start time occurs
+	// after the end time or the time range is outside the range of possible values.
+	ErrCodeInvalidTimeRangeException = "InvalidTimeRange"
+
+	// ErrCodeInvalidTrailNameException for service response error code
+	// "InvalidTrailName".
+	//
+	// This exception is thrown when the provided trail name is not valid.
+	ErrCodeInvalidTrailNameException = "InvalidTrailName"
+
+	// ErrCodeMaximumNumberOfTrailsExceededException for service response error code
+	// "MaximumNumberOfTrailsExceeded".
+	//
+	// This exception is thrown when the maximum number of trails is reached.
+	ErrCodeMaximumNumberOfTrailsExceededException = "MaximumNumberOfTrailsExceeded"
+
+	// ErrCodeS3BucketDoesNotExistException for service response error code
+	// "S3BucketDoesNotExist".
+	//
+	// This exception is thrown when the specified S3 bucket does not exist.
+	ErrCodeS3BucketDoesNotExistException = "S3BucketDoesNotExist"
+
+	// ErrCodeTrailAlreadyExistsException for service response error code
+	// "TrailAlreadyExists".
+	//
+	// This exception is thrown when the specified trail already exists.
+	ErrCodeTrailAlreadyExistsException = "TrailAlreadyExists"
+
+	// ErrCodeTrailNotFoundException for service response error code
+	// "TrailNotFound".
+	//
+	// This exception is thrown when the trail with the given name is not found.
+	ErrCodeTrailNotFoundException = "TrailNotFound"
+)
@@ -37,6 +37,18 @@ func (c *CloudWatch) DeleteAlarms(input *DeleteAlarmsInput) (*DeleteAlarmsOutput
 	return out, err
 }
 
+// DeleteAlarmsWithContext is the same as DeleteAlarms with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) DeleteAlarmsWithContext(ctx aws.Context, input *DeleteAlarmsInput, opts ...aws.Option) (*DeleteAlarmsOutput, error) {
+	req, out := c.DeleteAlarmsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAlarmHistory = "DescribeAlarmHistory"
 
 // DescribeAlarmHistoryRequest generates a request for the DescribeAlarmHistory operation.
@@ -50,6 +62,7 @@ func (c *CloudWatch) DescribeAlarmHistoryRequest(input *DescribeAlarmHistoryInpu
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"AlarmHistoryItems"},
 		},
 	}
 
@@ -72,6 +85,22 @@ func (c *CloudWatch) DescribeAlarmHistory(input *DescribeAlarmHistoryInput) (*De
 	return out, err
 }
 
+// DescribeAlarmHistoryWithContext is the same as DescribeAlarmHistory with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) DescribeAlarmHistoryWithContext(ctx aws.Context, input *DescribeAlarmHistoryInput, opts ...aws.Option) (*DescribeAlarmHistoryOutput, error) {
+	req, out := c.DescribeAlarmHistoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeAlarmHistoryPages iterates over the pages of a DescribeAlarmHistory operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudWatch) DescribeAlarmHistoryPages(input *DescribeAlarmHistoryInput, fn func(p *DescribeAlarmHistoryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAlarmHistoryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -79,6 +108,19 @@ func (c *CloudWatch) DescribeAlarmHistoryPages(input *DescribeAlarmHistoryInput,
 	})
 }
 
+// DescribeAlarmHistoryPagesWithContext is the same as DescribeAlarmHistoryPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudWatch) DescribeAlarmHistoryPagesWithContext(ctx aws.Context, input *DescribeAlarmHistoryInput, fn func(p *DescribeAlarmHistoryOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeAlarmHistoryRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAlarmHistoryOutput), lastPage)
+	})
+}
+
 const opDescribeAlarms = "DescribeAlarms"
 
 // DescribeAlarmsRequest generates a request for the DescribeAlarms operation.
@@ -92,6 +134,7 @@ func (c *CloudWatch) DescribeAlarmsRequest(input *DescribeAlarmsInput) (req *aws
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"MetricAlarms"},
 		},
 	}
 
@@ -114,6 +157,22 @@ func (c *CloudWatch) DescribeAlarms(input *DescribeAlarmsInput) (*DescribeAlarms
 	return out, err
 }
 
+// DescribeAlarmsWithContext is the same as DescribeAlarms with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) DescribeAlarmsWithContext(ctx aws.Context, input *DescribeAlarmsInput, opts ...aws.Option) (*DescribeAlarmsOutput, error) {
+	req, out := c.DescribeAlarmsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeAlarmsPages iterates over the pages of a DescribeAlarms operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudWatch) DescribeAlarmsPages(input *DescribeAlarmsInput, fn func(p *DescribeAlarmsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAlarmsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -121,6 +180,19 @@ func (c *CloudWatch) DescribeAlarmsPages(input *DescribeAlarmsInput, fn func(p *
 	})
 }
 
+// DescribeAlarmsPagesWithContext is the same as DescribeAlarmsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudWatch) DescribeAlarmsPagesWithContext(ctx aws.Context, input *DescribeAlarmsInput, fn func(p *DescribeAlarmsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeAlarmsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAlarmsOutput), lastPage)
+	})
+}
+
 const opDescribeAlarmsForMetric = "DescribeAlarmsForMetric"
 
 // DescribeAlarmsForMetricRequest generates a request for the DescribeAlarmsForMetric operation.
@@ -149,6 +221,18 @@ func (c *CloudWatch) DescribeAlarmsForMetric(input *DescribeAlarmsForMetricInput
 	return out, err
 }
 
+// DescribeAlarmsForMetricWithContext is the same as DescribeAlarmsForMetric with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) DescribeAlarmsForMetricWithContext(ctx aws.Context, input *DescribeAlarmsForMetricInput, opts ...aws.Option) (*DescribeAlarmsForMetricOutput, error) {
+	req, out := c.DescribeAlarmsForMetricRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableAlarmActions = "DisableAlarmActions"
 
 // DisableAlarmActionsRequest generates a request for the DisableAlarmActions operation.
@@ -177,6 +261,18 @@ func (c *CloudWatch) DisableAlarmActions(input *DisableAlarmActionsInput) (*Disa
 	return out, err
 }
 
+// DisableAlarmActionsWithContext is the same as DisableAlarmActions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) DisableAlarmActionsWithContext(ctx aws.Context, input *DisableAlarmActionsInput, opts ...aws.Option) (*DisableAlarmActionsOutput, error) {
+	req, out := c.DisableAlarmActionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableAlarmActions = "EnableAlarmActions"
 
 // EnableAlarmActionsRequest generates a request for the EnableAlarmActions operation.
@@ -204,6 +300,18 @@ func (c *CloudWatch) EnableAlarmActions(input *EnableAlarmActionsInput) (*Enable
 	return out, err
 }
 
+// EnableAlarmActionsWithContext is the same as EnableAlarmActions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) EnableAlarmActionsWithContext(ctx aws.Context, input *EnableAlarmActionsInput, opts ...aws.Option) (*EnableAlarmActionsOutput, error) {
+	req, out := c.EnableAlarmActionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetMetricStatistics = "GetMetricStatistics"
 
 // GetMetricStatisticsRequest generates a request for the GetMetricStatistics operation.
@@ -256,6 +364,18 @@ func (c *CloudWatch) GetMetricStatistics(input *GetMetricStatisticsInput) (*GetM
 	return out, err
 }
 
+// GetMetricStatisticsWithContext is the same as GetMetricStatistics with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) GetMetricStatisticsWithContext(ctx aws.Context, input *GetMetricStatisticsInput, opts ...aws.Option) (*GetMetricStatisticsOutput, error) {
+	req, out := c.GetMetricStatisticsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListMetrics = "ListMetrics"
 
 // ListMetricsRequest generates a request for the ListMetrics operation.
@@ -269,6 +389,7 @@ func (c *CloudWatch) ListMetricsRequest(input *ListMetricsInput) (req *aws.Reque
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Metrics"},
 		},
 	}
 
@@ -291,6 +412,22 @@ func (c *CloudWatch) ListMetrics(input *ListMetricsInput) (*ListMetricsOutput, e
 	return out, err
 }
 
+// ListMetricsWithContext is the same as ListMetrics with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) ListMetricsWithContext(ctx aws.Context, input *ListMetricsInput, opts ...aws.Option) (*ListMetricsOutput, error) {
+	req, out := c.ListMetricsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListMetricsPages iterates over the pages of a ListMetrics operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudWatch) ListMetricsPages(input *ListMetricsInput, fn func(p *ListMetricsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListMetricsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -298,6 +435,19 @@ func (c *CloudWatch) ListMetricsPages(input *ListMetricsInput, fn func(p *ListMe
 	})
 }
 
+// ListMetricsPagesWithContext is the same as ListMetricsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudWatch) ListMetricsPagesWithContext(ctx aws.Context, input *ListMetricsInput, fn func(p *ListMetricsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListMetricsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMetricsOutput), lastPage)
+	})
+}
+
 const opPutMetricAlarm = "PutMetricAlarm"
 
 // PutMetricAlarmRequest generates a request for the PutMetricAlarm operation.
@@ -331,6 +481,18 @@ func (c *CloudWatch) PutMetricAlarm(input *PutMetricAlarmInput) (*PutMetricAlarm
 	return out, err
 }
 
+// PutMetricAlarmWithContext is the same as PutMetricAlarm with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) PutMetricAlarmWithContext(ctx aws.Context, input *PutMetricAlarmInput, opts ...aws.Option) (*PutMetricAlarmOutput, error) {
+	req, out := c.PutMetricAlarmRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutMetricData = "PutMetricData"
 
 // PutMetricDataRequest generates a request for the PutMetricData operation.
@@ -371,6 +533,18 @@ func (c *CloudWatch) PutMetricData(input *PutMetricDataInput) (*PutMetricDataOut
 	return out, err
 }
 
+// PutMetricDataWithContext is the same as PutMetricData with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) PutMetricDataWithContext(ctx aws.Context, input *PutMetricDataInput, opts ...aws.Option) (*PutMetricDataOutput, error) {
+	req, out := c.PutMetricDataRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetAlarmState = "SetAlarmState"
 
 // SetAlarmStateRequest generates a request for the SetAlarmState operation.
@@ -401,21 +575,33 @@ func (c *CloudWatch) SetAlarmState(input *SetAlarmStateInput) (*SetAlarmStateOut
 	return out, err
 }
 
+// SetAlarmStateWithContext is the same as SetAlarmState with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatch) SetAlarmStateWithContext(ctx aws.Context, input *SetAlarmStateInput, opts ...aws.Option) (*SetAlarmStateOutput, error) {
+	req, out := c.SetAlarmStateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // The AlarmHistoryItem data type contains descriptive information about the
 // history of a specific alarm. If you call DescribeAlarmHistory, Amazon CloudWatch
 // returns this data type as part of the DescribeAlarmHistoryResult data type.
 type AlarmHistoryItem struct {
 	// The descriptive name for the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// Machine-readable data about the alarm in JSON format.
-	HistoryData *string `type:"string"`
+	HistoryData *string `type:"string" min:"1" max:"4095"`
 
 	// The type of alarm history item.
 	HistoryItemType *string `type:"string"`
 
 	// A human-readable summary of the alarm history.
-	HistorySummary *string `type:"string"`
+	HistorySummary *string `type:"string" min:"1" max:"255"`
 
 	// The time stamp for the alarm history item. Amazon CloudWatch uses Coordinated
 	// Universal Time (UTC) when returning time stamps, which do not accommodate
@@ -489,7 +675,7 @@ func (s Datapoint) GoString() string {
 
 type DeleteAlarmsInput struct {
 	// A list of alarms to be deleted.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDeleteAlarmsInput `json:"-" xml:"-"`
 }
@@ -528,7 +714,7 @@ func (s DeleteAlarmsOutput) GoString() string {
 
 type DescribeAlarmHistoryInput struct {
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The ending date to retrieve alarm history.
 	EndDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -537,7 +723,7 @@ type DescribeAlarmHistoryInput struct {
 	HistoryItemType *string `type:"string"`
 
 	// The maximum number of alarm history records to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -590,16 +776,16 @@ func (s DescribeAlarmHistoryOutput) GoString() string {
 
 type DescribeAlarmsForMetricInput struct {
 	// The list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// The statistic for the metric.
 	Statistic *string `type:"string"`
@@ -648,17 +834,17 @@ func (s DescribeAlarmsForMetricOutput) GoString() string {
 
 type DescribeAlarmsInput struct {
 	// The action name prefix.
-	ActionPrefix *string `type:"string"`
+	ActionPrefix *string `type:"string" min:"1" max:"1024"`
 
 	// The alarm name prefix. AlarmNames cannot be specified if this parameter is
 	// specified.
-	AlarmNamePrefix *string `type:"string"`
+	AlarmNamePrefix *string `type:"string" min:"1" max:"255"`
 
 	// A list of alarm names to retrieve information for.
-	AlarmNames []*string `type:"list"`
+	AlarmNames []*string `type:"list" max:"100"`
 
 	// The maximum number of alarm descriptions to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -715,10 +901,10 @@ func (s DescribeAlarmsOutput) GoString() string {
 // For examples that use one or more dimensions, see PutMetricData.
 type Dimension struct {
 	// The name of the dimension.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value representing the dimension measurement
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataDimension `json:"-" xml:"-"`
 }
@@ -740,10 +926,10 @@ func (s Dimension) GoString() string {
 // The DimensionFilter data type is used to filter ListMetrics results.
 type DimensionFilter struct {
 	// The dimension name to be matched.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value of the dimension to be matched.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"255"`
 
 	metadataDimensionFilter `json:"-" xml:"-"`
 }
@@ -764,7 +950,7 @@ func (s DimensionFilter) GoString() string {
 
 type DisableAlarmActionsInput struct {
 	// The names of the alarms to disable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDisableAlarmActionsInput `json:"-" xml:"-"`
 }
@@ -803,7 +989,7 @@ func (s DisableAlarmActionsOutput) GoString() string {
 
 type EnableAlarmActionsInput struct {
 	// The names of the alarms to enable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataEnableAlarmActionsInput `json:"-" xml:"-"`
 }
@@ -842,7 +1028,7 @@ func (s EnableAlarmActionsOutput) GoString() string {
 
 type GetMetricStatisticsInput struct {
 	// A list of dimensions describing qualities of the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The time stamp to use for determining the last datapoint to return. The value
 	// specified is exclusive; results will include datapoints up to the time stamp
@@ -850,14 +1036,14 @@ type GetMetricStatisticsInput struct {
 	EndTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The name of the metric, with or without spaces.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric, with or without spaces.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The granularity, in seconds, of the returned datapoints. Period must be at
 	// least 60 seconds and must be a multiple of 60. The default value is 60.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The time stamp to use for determining the first datapoint to return. The
 	// value specified is inclusive; results include datapoints with the time stamp
@@ -869,7 +1055,7 @@ type GetMetricStatisticsInput struct {
 	// in the Amazon CloudWatch Developer Guide.
 	//
 	//  Valid Values: Average | Sum | SampleCount | Maximum | Minimum
-	Statistics []*string `type:"list" required:"true"`
+	Statistics []*string `type:"list" min:"1" max:"5" required:"true"`
 
 	// The unit for the metric.
 	Unit *string `type:"string"`
@@ -918,13 +1104,13 @@ func (s GetMetricStatisticsOutput) GoString() string {
 
 type ListMetricsInput struct {
 	// A list of dimensions to filter against.
-	Dimensions []*DimensionFilter `type:"list"`
+	Dimensions []*DimensionFilter `type:"list" max:"10"`
 
 	// The name of the metric to filter against.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace to filter against.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -981,13 +1167,13 @@ func (s ListMetricsOutput) GoString() string {
 // two dimensions, InstanceID and InstanceType.
 type Metric struct {
 	// A list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255"`
 
 	metadataMetric `json:"-" xml:"-"`
 }
@@ -1014,13 +1200,13 @@ type MetricAlarm struct {
 	ActionsEnabled *bool `type:"boolean"`
 
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `locationName:"AlarmArn" type:"string"`
+	AlarmARN *string `locationName:"AlarmArn" type:"string" min:"1" max:"1600"`
 
 	// The list of actions to execute when this alarm transitions into an ALARM
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only actions supported are publishing to an Amazon
 	// SNS topic and triggering an Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The time stamp of the last update to the alarm configuration. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -1030,20 +1216,20 @@ type MetricAlarm struct {
 	AlarmConfigurationUpdatedTimestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" min:"0" max:"255"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
 	ComparisonOperator *string `type:"string"`
 
 	// The list of dimensions associated with the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer"`
+	EvaluationPeriods *int64 `type:"integer" min:"1"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
@@ -1051,28 +1237,28 @@ type MetricAlarm struct {
 	// SNS topic or triggering an Auto Scaling policy.
 	//
 	// The current WSDL lists this attribute as UnknownActions.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name of the alarm's metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of alarm's associated metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only actions supported are publishing to an Amazon SNS
 	// topic and triggering an Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// A human-readable explanation for the alarm's state.
-	StateReason *string `type:"string"`
+	StateReason *string `type:"string" min:"0" max:"1023"`
 
 	// An explanation for the alarm's state in machine-readable JSON format
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" min:"0" max:"4000"`
 
 	// The time stamp of the last update to the alarm's state. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -1116,10 +1302,10 @@ func (s MetricAlarm) GoString() string {
 type MetricDatum struct {
 	// A list of dimensions associated with the metric. Note, when using the Dimensions
 	// value in a query, you need to append .member.N to it (e.g., Dimensions.member.N).
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// A set of statistical values describing the metric.
 	StatisticValues *StatisticSet `type:"structure"`
@@ -1169,45 +1355,45 @@ type PutMetricAlarmInput struct {
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" min:"0" max:"255"`
 
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
 	ComparisonOperator *string `type:"string" required:"true"`
 
 	// The dimensions for the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer" required:"true"`
+	EvaluationPeriods *int64 `type:"integer" min:"1" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name for the alarm's associated metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace for the alarm's associated metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only action supported is publishing to an Amazon SNS
 	// topic or an Amazon Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the specified statistic is applied.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The statistic to apply to the alarm's associated metric.
 	Statistic *string `type:"string" required:"true"`
@@ -1258,7 +1444,7 @@ type PutMetricDataInput struct {
 	MetricData []*MetricDatum `type:"list" required:"true"`
 
 	// The namespace for the metric data.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataPutMetricDataInput `json:"-" xml:"-"`
 }
@@ -1298,15 +1484,15 @@ func (s PutMetricDataOutput) GoString() string {
 type SetAlarmStateInput struct {
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account. The maximum length is 255 characters.
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in human-readable
 	// text format)
-	StateReason *string `type:"string" required:"true"`
+	StateReason *string `type:"string" min:"0" max:"1023" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in machine-readable
 	// JSON format)
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" min:"0" max:"4000"`
 
 	// The value of the state.
 	StateValue *string `type:"string" required:"true"`
@@ -4,6 +4,7 @@
 package cloudwatchiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 )
 
@@ -11,23 +12,57 @@ import (
 type CloudWatchAPI interface {
 	DeleteAlarms(*cloudwatch.DeleteAlarmsInput) (*cloudwatch.DeleteAlarmsOutput, error)
 
+	DeleteAlarmsWithContext(aws.Context, *cloudwatch.DeleteAlarmsInput, ...aws.Option) (*cloudwatch.DeleteAlarmsOutput, error)
+
 	DescribeAlarmHistory(*cloudwatch.DescribeAlarmHistoryInput) (*cloudwatch.DescribeAlarmHistoryOutput, error)
 
+	DescribeAlarmHistoryWithContext(aws.Context, *cloudwatch.DescribeAlarmHistoryInput, ...aws.Option) (*cloudwatch.DescribeAlarmHistoryOutput, error)
+
+	DescribeAlarmHistoryPages(*cloudwatch.DescribeAlarmHistoryInput, func(*cloudwatch.DescribeAlarmHistoryOutput, bool) bool) error
+
+	DescribeAlarmHistoryPagesWithContext(aws.Context, *cloudwatch.DescribeAlarmHistoryInput, func(*cloudwatch.DescribeAlarmHistoryOutput, bool) bool, ...aws.Option) error
+
 	DescribeAlarms(*cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
 
+	DescribeAlarmsWithContext(aws.Context, *cloudwatch.DescribeAlarmsInput, ...aws.Option) (*cloudwatch.DescribeAlarmsOutput, error)
+
+	DescribeAlarmsPages(*cloudwatch.DescribeAlarmsInput, func(*cloudwatch.DescribeAlarmsOutput, bool) bool) error
+
+	DescribeAlarmsPagesWithContext(aws.Context, *cloudwatch.DescribeAlarmsInput, func(*cloudwatch.DescribeAlarmsOutput, bool) bool, ...aws.Option) error
+
 	DescribeAlarmsForMetric(*cloudwatch.DescribeAlarmsForMetricInput) (*cloudwatch.DescribeAlarmsForMetricOutput, error)
 
+	DescribeAlarmsForMetricWithContext(aws.Context, *cloudwatch.DescribeAlarmsForMetricInput, ...aws.Option) (*cloudwatch.DescribeAlarmsForMetricOutput, error)
+
 	DisableAlarmActions(*cloudwatch.DisableAlarmActionsInput) (*cloudwatch.DisableAlarmActionsOutput, error)
 
+	DisableAlarmActionsWithContext(aws.Context, *cloudwatch.DisableAlarmActionsInput, ...aws.Option) (*cloudwatch.DisableAlarmActionsOutput, error)
+
 	EnableAlarmActions(*cloudwatch.EnableAlarmActionsInput) (*cloudwatch.EnableAlarmActionsOutput, error)
 
+	EnableAlarmActionsWithContext(aws.Context, *cloudwatch.EnableAlarmActionsInput, ...aws.Option) (*cloudwatch.EnableAlarmActionsOutput, error)
+
 	GetMetricStatistics(*cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error)
 
+	GetMetricStatisticsWithContext(aws.Context, *cloudwatch.GetMetricStatisticsInput, ...aws.Option) (*cloudwatch.GetMetricStatisticsOutput, error)
+
 	ListMetrics(*cloudwatch.ListMetricsInput) (*cloudwatch.ListMetricsOutput, error)
 
+	ListMetricsWithContext(aws.Context, *cloudwatch.ListMetricsInput, ...aws.Option) (*cloudwatch.ListMetricsOutput, error)
+
+	ListMetricsPages(*cloudwatch.ListMetricsInput, func(*cloudwatch.ListMetricsOutput, bool) bool) error
+
+	ListMetricsPagesWithContext(aws.Context, *cloudwatch.ListMetricsInput, func(*cloudwatch.ListMetricsOutput, bool) bool, ...aws.Option) error
+
 	PutMetricAlarm(*cloudwatch.PutMetricAlarmInput) (*cloudwatch.PutMetricAlarmOutput, error)
 
+	PutMetricAlarmWithContext(aws.Context, *cloudwatch.PutMetricAlarmInput, ...aws.Option) (*cloudwatch.PutMetricAlarmOutput, error)
+
 	PutMetricData(*cloudwatch.PutMetricDataInput) (*cloudwatch.PutMetricDataOutput, error)
 
+	PutMetricDataWithContext(aws.Context, *cloudwatch.PutMetricDataInput, ...aws.Option) (*cloudwatch.PutMetricDataOutput, error)
+
 	SetAlarmState(*cloudwatch.SetAlarmStateInput) (*cloudwatch.SetAlarmStateOutput, error)
+
+	SetAlarmStateWithContext(aws.Context, *cloudwatch.SetAlarmStateInput, ...aws.Option) (*cloudwatch.SetAlarmStateOutput, error)
 }
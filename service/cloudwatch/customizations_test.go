@@ -0,0 +1,44 @@
+package cloudwatch_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/test/unit"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+func TestPutMetricDataCompressed(t *testing.T) {
+	svc := cloudwatch.New(&aws.Config{CompressRequestBody: true, RequestMinCompressSize: 1})
+	req, _ := svc.PutMetricDataRequest(&cloudwatch.PutMetricDataInput{
+		Namespace:  aws.String("namespace"),
+		MetricData: []*cloudwatch.MetricDatum{{MetricName: aws.String("metric"), Value: aws.Double(1)}},
+	})
+	err := req.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "gzip", req.HTTPRequest.Header.Get("Content-Encoding"))
+
+	gr, err := gzip.NewReader(req.Body)
+	assert.NoError(t, err)
+	b, _ := ioutil.ReadAll(gr)
+	q, _ := url.ParseQuery(string(b))
+	assert.Equal(t, "PutMetricData", q.Get("Action"))
+	assert.Equal(t, "namespace", q.Get("Namespace"))
+}
+
+func TestListMetricsNotCompressed(t *testing.T) {
+	svc := cloudwatch.New(&aws.Config{CompressRequestBody: true, RequestMinCompressSize: 1})
+	req, _ := svc.ListMetricsRequest(&cloudwatch.ListMetricsInput{
+		Namespace: aws.String(strings.Repeat("namespace", 10)),
+	})
+	err := req.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Content-Encoding"))
+}
@@ -0,0 +1,54 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cloudwatch
+
+const (
+	// ErrCodeInternalServiceFault for service response error code
+	// "InternalServiceError".
+	//
+	// Indicates that the request processing has failed due to some unknown error,
+	// exception, or failure.
+	ErrCodeInternalServiceFault = "InternalServiceError"
+
+	// ErrCodeInvalidFormatFault for service response error code
+	// "InvalidFormat".
+	//
+	// Data was not syntactically valid JSON.
+	ErrCodeInvalidFormatFault = "InvalidFormat"
+
+	// ErrCodeInvalidNextToken for service response error code
+	// "InvalidNextToken".
+	//
+	// The next token specified is invalid.
+	ErrCodeInvalidNextToken = "InvalidNextToken"
+
+	// ErrCodeInvalidParameterCombinationException for service response error code
+	// "InvalidParameterCombination".
+	//
+	// Parameters that must not be used together were used together.
+	ErrCodeInvalidParameterCombinationException = "InvalidParameterCombination"
+
+	// ErrCodeInvalidParameterValueException for service response error code
+	// "InvalidParameterValue".
+	//
+	// Bad or out-of-range value was supplied for the input parameter.
+	ErrCodeInvalidParameterValueException = "InvalidParameterValue"
+
+	// ErrCodeLimitExceededFault for service response error code
+	// "LimitExceeded".
+	//
+	// The quota for alarms for this customer has already been reached.
+	ErrCodeLimitExceededFault = "LimitExceeded"
+
+	// ErrCodeMissingRequiredParameterException for service response error code
+	// "MissingParameter".
+	//
+	// An input parameter that is mandatory for processing the request is not supplied.
+	ErrCodeMissingRequiredParameterException = "MissingParameter"
+
+	// ErrCodeResourceNotFound for service response error code
+	// "ResourceNotFound".
+	//
+	// The named resource does not exist.
+	ErrCodeResourceNotFound = "ResourceNotFound"
+)
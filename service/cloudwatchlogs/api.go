@@ -41,6 +41,18 @@ func (c *CloudWatchLogs) CreateLogGroup(input *CreateLogGroupInput) (*CreateLogG
 	return out, err
 }
 
+// CreateLogGroupWithContext is the same as CreateLogGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) CreateLogGroupWithContext(ctx aws.Context, input *CreateLogGroupInput, opts ...aws.Option) (*CreateLogGroupOutput, error) {
+	req, out := c.CreateLogGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLogStream = "CreateLogStream"
 
 // CreateLogStreamRequest generates a request for the CreateLogStream operation.
@@ -74,6 +86,18 @@ func (c *CloudWatchLogs) CreateLogStream(input *CreateLogStreamInput) (*CreateLo
 	return out, err
 }
 
+// CreateLogStreamWithContext is the same as CreateLogStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) CreateLogStreamWithContext(ctx aws.Context, input *CreateLogStreamInput, opts ...aws.Option) (*CreateLogStreamOutput, error) {
+	req, out := c.CreateLogStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLogGroup = "DeleteLogGroup"
 
 // DeleteLogGroupRequest generates a request for the DeleteLogGroup operation.
@@ -102,6 +126,18 @@ func (c *CloudWatchLogs) DeleteLogGroup(input *DeleteLogGroupInput) (*DeleteLogG
 	return out, err
 }
 
+// DeleteLogGroupWithContext is the same as DeleteLogGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DeleteLogGroupWithContext(ctx aws.Context, input *DeleteLogGroupInput, opts ...aws.Option) (*DeleteLogGroupOutput, error) {
+	req, out := c.DeleteLogGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLogStream = "DeleteLogStream"
 
 // DeleteLogStreamRequest generates a request for the DeleteLogStream operation.
@@ -130,6 +166,18 @@ func (c *CloudWatchLogs) DeleteLogStream(input *DeleteLogStreamInput) (*DeleteLo
 	return out, err
 }
 
+// DeleteLogStreamWithContext is the same as DeleteLogStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DeleteLogStreamWithContext(ctx aws.Context, input *DeleteLogStreamInput, opts ...aws.Option) (*DeleteLogStreamOutput, error) {
+	req, out := c.DeleteLogStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMetricFilter = "DeleteMetricFilter"
 
 // DeleteMetricFilterRequest generates a request for the DeleteMetricFilter operation.
@@ -157,6 +205,18 @@ func (c *CloudWatchLogs) DeleteMetricFilter(input *DeleteMetricFilterInput) (*De
 	return out, err
 }
 
+// DeleteMetricFilterWithContext is the same as DeleteMetricFilter with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DeleteMetricFilterWithContext(ctx aws.Context, input *DeleteMetricFilterInput, opts ...aws.Option) (*DeleteMetricFilterOutput, error) {
+	req, out := c.DeleteMetricFilterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRetentionPolicy = "DeleteRetentionPolicy"
 
 // DeleteRetentionPolicyRequest generates a request for the DeleteRetentionPolicy operation.
@@ -185,6 +245,18 @@ func (c *CloudWatchLogs) DeleteRetentionPolicy(input *DeleteRetentionPolicyInput
 	return out, err
 }
 
+// DeleteRetentionPolicyWithContext is the same as DeleteRetentionPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DeleteRetentionPolicyWithContext(ctx aws.Context, input *DeleteRetentionPolicyInput, opts ...aws.Option) (*DeleteRetentionPolicyOutput, error) {
+	req, out := c.DeleteRetentionPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSubscriptionFilter = "DeleteSubscriptionFilter"
 
 // DeleteSubscriptionFilterRequest generates a request for the DeleteSubscriptionFilter operation.
@@ -212,6 +284,18 @@ func (c *CloudWatchLogs) DeleteSubscriptionFilter(input *DeleteSubscriptionFilte
 	return out, err
 }
 
+// DeleteSubscriptionFilterWithContext is the same as DeleteSubscriptionFilter with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DeleteSubscriptionFilterWithContext(ctx aws.Context, input *DeleteSubscriptionFilterInput, opts ...aws.Option) (*DeleteSubscriptionFilterOutput, error) {
+	req, out := c.DeleteSubscriptionFilterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLogGroups = "DescribeLogGroups"
 
 // DescribeLogGroupsRequest generates a request for the DescribeLogGroups operation.
@@ -225,6 +309,7 @@ func (c *CloudWatchLogs) DescribeLogGroupsRequest(input *DescribeLogGroupsInput)
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"logGroups"},
 		},
 	}
 
@@ -252,6 +337,22 @@ func (c *CloudWatchLogs) DescribeLogGroups(input *DescribeLogGroupsInput) (*Desc
 	return out, err
 }
 
+// DescribeLogGroupsWithContext is the same as DescribeLogGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DescribeLogGroupsWithContext(ctx aws.Context, input *DescribeLogGroupsInput, opts ...aws.Option) (*DescribeLogGroupsOutput, error) {
+	req, out := c.DescribeLogGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeLogGroupsPages iterates over the pages of a DescribeLogGroups operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudWatchLogs) DescribeLogGroupsPages(input *DescribeLogGroupsInput, fn func(p *DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeLogGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -259,6 +360,19 @@ func (c *CloudWatchLogs) DescribeLogGroupsPages(input *DescribeLogGroupsInput, f
 	})
 }
 
+// DescribeLogGroupsPagesWithContext is the same as DescribeLogGroupsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudWatchLogs) DescribeLogGroupsPagesWithContext(ctx aws.Context, input *DescribeLogGroupsInput, fn func(p *DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeLogGroupsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeLogGroupsOutput), lastPage)
+	})
+}
+
 const opDescribeLogStreams = "DescribeLogStreams"
 
 // DescribeLogStreamsRequest generates a request for the DescribeLogStreams operation.
@@ -272,6 +386,7 @@ func (c *CloudWatchLogs) DescribeLogStreamsRequest(input *DescribeLogStreamsInpu
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"logStreams"},
 		},
 	}
 
@@ -300,6 +415,22 @@ func (c *CloudWatchLogs) DescribeLogStreams(input *DescribeLogStreamsInput) (*De
 	return out, err
 }
 
+// DescribeLogStreamsWithContext is the same as DescribeLogStreams with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DescribeLogStreamsWithContext(ctx aws.Context, input *DescribeLogStreamsInput, opts ...aws.Option) (*DescribeLogStreamsOutput, error) {
+	req, out := c.DescribeLogStreamsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeLogStreamsPages iterates over the pages of a DescribeLogStreams operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudWatchLogs) DescribeLogStreamsPages(input *DescribeLogStreamsInput, fn func(p *DescribeLogStreamsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeLogStreamsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -307,6 +438,19 @@ func (c *CloudWatchLogs) DescribeLogStreamsPages(input *DescribeLogStreamsInput,
 	})
 }
 
+// DescribeLogStreamsPagesWithContext is the same as DescribeLogStreamsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudWatchLogs) DescribeLogStreamsPagesWithContext(ctx aws.Context, input *DescribeLogStreamsInput, fn func(p *DescribeLogStreamsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeLogStreamsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeLogStreamsOutput), lastPage)
+	})
+}
+
 const opDescribeMetricFilters = "DescribeMetricFilters"
 
 // DescribeMetricFiltersRequest generates a request for the DescribeMetricFilters operation.
@@ -320,6 +464,7 @@ func (c *CloudWatchLogs) DescribeMetricFiltersRequest(input *DescribeMetricFilte
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"metricFilters"},
 		},
 	}
 
@@ -346,6 +491,22 @@ func (c *CloudWatchLogs) DescribeMetricFilters(input *DescribeMetricFiltersInput
 	return out, err
 }
 
+// DescribeMetricFiltersWithContext is the same as DescribeMetricFilters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DescribeMetricFiltersWithContext(ctx aws.Context, input *DescribeMetricFiltersInput, opts ...aws.Option) (*DescribeMetricFiltersOutput, error) {
+	req, out := c.DescribeMetricFiltersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeMetricFiltersPages iterates over the pages of a DescribeMetricFilters operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudWatchLogs) DescribeMetricFiltersPages(input *DescribeMetricFiltersInput, fn func(p *DescribeMetricFiltersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeMetricFiltersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -353,6 +514,19 @@ func (c *CloudWatchLogs) DescribeMetricFiltersPages(input *DescribeMetricFilters
 	})
 }
 
+// DescribeMetricFiltersPagesWithContext is the same as DescribeMetricFiltersPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudWatchLogs) DescribeMetricFiltersPagesWithContext(ctx aws.Context, input *DescribeMetricFiltersInput, fn func(p *DescribeMetricFiltersOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeMetricFiltersRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeMetricFiltersOutput), lastPage)
+	})
+}
+
 const opDescribeSubscriptionFilters = "DescribeSubscriptionFilters"
 
 // DescribeSubscriptionFiltersRequest generates a request for the DescribeSubscriptionFilters operation.
@@ -387,6 +561,18 @@ func (c *CloudWatchLogs) DescribeSubscriptionFilters(input *DescribeSubscription
 	return out, err
 }
 
+// DescribeSubscriptionFiltersWithContext is the same as DescribeSubscriptionFilters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) DescribeSubscriptionFiltersWithContext(ctx aws.Context, input *DescribeSubscriptionFiltersInput, opts ...aws.Option) (*DescribeSubscriptionFiltersOutput, error) {
+	req, out := c.DescribeSubscriptionFiltersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opFilterLogEvents = "FilterLogEvents"
 
 // FilterLogEventsRequest generates a request for the FilterLogEvents operation.
@@ -427,6 +613,18 @@ func (c *CloudWatchLogs) FilterLogEvents(input *FilterLogEventsInput) (*FilterLo
 	return out, err
 }
 
+// FilterLogEventsWithContext is the same as FilterLogEvents with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) FilterLogEventsWithContext(ctx aws.Context, input *FilterLogEventsInput, opts ...aws.Option) (*FilterLogEventsOutput, error) {
+	req, out := c.FilterLogEventsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetLogEvents = "GetLogEvents"
 
 // GetLogEventsRequest generates a request for the GetLogEvents operation.
@@ -440,6 +638,7 @@ func (c *CloudWatchLogs) GetLogEventsRequest(input *GetLogEventsInput) (req *aws
 			OutputTokens:    []string{"nextForwardToken"},
 			LimitToken:      "limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"events"},
 		},
 	}
 
@@ -469,6 +668,22 @@ func (c *CloudWatchLogs) GetLogEvents(input *GetLogEventsInput) (*GetLogEventsOu
 	return out, err
 }
 
+// GetLogEventsWithContext is the same as GetLogEvents with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) GetLogEventsWithContext(ctx aws.Context, input *GetLogEventsInput, opts ...aws.Option) (*GetLogEventsOutput, error) {
+	req, out := c.GetLogEventsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// GetLogEventsPages iterates over the pages of a GetLogEvents operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *CloudWatchLogs) GetLogEventsPages(input *GetLogEventsInput, fn func(p *GetLogEventsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetLogEventsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -476,6 +691,19 @@ func (c *CloudWatchLogs) GetLogEventsPages(input *GetLogEventsInput, fn func(p *
 	})
 }
 
+// GetLogEventsPagesWithContext is the same as GetLogEventsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *CloudWatchLogs) GetLogEventsPagesWithContext(ctx aws.Context, input *GetLogEventsInput, fn func(p *GetLogEventsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.GetLogEventsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetLogEventsOutput), lastPage)
+	})
+}
+
 const opPutLogEvents = "PutLogEvents"
 
 // PutLogEventsRequest generates a request for the PutLogEvents operation.
@@ -515,6 +743,18 @@ func (c *CloudWatchLogs) PutLogEvents(input *PutLogEventsInput) (*PutLogEventsOu
 	return out, err
 }
 
+// PutLogEventsWithContext is the same as PutLogEvents with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) PutLogEventsWithContext(ctx aws.Context, input *PutLogEventsInput, opts ...aws.Option) (*PutLogEventsOutput, error) {
+	req, out := c.PutLogEventsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutMetricFilter = "PutMetricFilter"
 
 // PutMetricFilterRequest generates a request for the PutMetricFilter operation.
@@ -547,6 +787,18 @@ func (c *CloudWatchLogs) PutMetricFilter(input *PutMetricFilterInput) (*PutMetri
 	return out, err
 }
 
+// PutMetricFilterWithContext is the same as PutMetricFilter with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) PutMetricFilterWithContext(ctx aws.Context, input *PutMetricFilterInput, opts ...aws.Option) (*PutMetricFilterOutput, error) {
+	req, out := c.PutMetricFilterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRetentionPolicy = "PutRetentionPolicy"
 
 // PutRetentionPolicyRequest generates a request for the PutRetentionPolicy operation.
@@ -576,6 +828,18 @@ func (c *CloudWatchLogs) PutRetentionPolicy(input *PutRetentionPolicyInput) (*Pu
 	return out, err
 }
 
+// PutRetentionPolicyWithContext is the same as PutRetentionPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) PutRetentionPolicyWithContext(ctx aws.Context, input *PutRetentionPolicyInput, opts ...aws.Option) (*PutRetentionPolicyOutput, error) {
+	req, out := c.PutRetentionPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutSubscriptionFilter = "PutSubscriptionFilter"
 
 // PutSubscriptionFilterRequest generates a request for the PutSubscriptionFilter operation.
@@ -610,6 +874,18 @@ func (c *CloudWatchLogs) PutSubscriptionFilter(input *PutSubscriptionFilterInput
 	return out, err
 }
 
+// PutSubscriptionFilterWithContext is the same as PutSubscriptionFilter with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) PutSubscriptionFilterWithContext(ctx aws.Context, input *PutSubscriptionFilterInput, opts ...aws.Option) (*PutSubscriptionFilterOutput, error) {
+	req, out := c.PutSubscriptionFilterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTestMetricFilter = "TestMetricFilter"
 
 // TestMetricFilterRequest generates a request for the TestMetricFilter operation.
@@ -639,9 +915,21 @@ func (c *CloudWatchLogs) TestMetricFilter(input *TestMetricFilterInput) (*TestMe
 	return out, err
 }
 
+// TestMetricFilterWithContext is the same as TestMetricFilter with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CloudWatchLogs) TestMetricFilterWithContext(ctx aws.Context, input *TestMetricFilterInput, opts ...aws.Option) (*TestMetricFilterOutput, error) {
+	req, out := c.TestMetricFilterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 type CreateLogGroupInput struct {
 	// The name of the log group to create.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataCreateLogGroupInput `json:"-" xml:"-"`
 }
@@ -680,10 +968,10 @@ func (s CreateLogGroupOutput) GoString() string {
 
 type CreateLogStreamInput struct {
 	// The name of the log group under which the log stream is to be created.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// The name of the log stream to create.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataCreateLogStreamInput `json:"-" xml:"-"`
 }
@@ -722,7 +1010,7 @@ func (s CreateLogStreamOutput) GoString() string {
 
 type DeleteLogGroupInput struct {
 	// The name of the log group to delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataDeleteLogGroupInput `json:"-" xml:"-"`
 }
@@ -761,10 +1049,10 @@ func (s DeleteLogGroupOutput) GoString() string {
 
 type DeleteLogStreamInput struct {
 	// The name of the log group under which the log stream to delete belongs.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// The name of the log stream to delete.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataDeleteLogStreamInput `json:"-" xml:"-"`
 }
@@ -803,10 +1091,10 @@ func (s DeleteLogStreamOutput) GoString() string {
 
 type DeleteMetricFilterInput struct {
 	// The name of the metric filter to delete.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" required:"true"`
 
 	// The name of the log group that is associated with the metric filter to delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataDeleteMetricFilterInput `json:"-" xml:"-"`
 }
@@ -846,7 +1134,7 @@ func (s DeleteMetricFilterOutput) GoString() string {
 type DeleteRetentionPolicyInput struct {
 	// The name of the log group that is associated with the retention policy to
 	// delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataDeleteRetentionPolicyInput `json:"-" xml:"-"`
 }
@@ -885,11 +1173,11 @@ func (s DeleteRetentionPolicyOutput) GoString() string {
 
 type DeleteSubscriptionFilterInput struct {
 	// The name of the subscription filter to delete.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" required:"true"`
 
 	// The name of the log group that is associated with the subscription filter
 	// to delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataDeleteSubscriptionFilterInput `json:"-" xml:"-"`
 }
@@ -929,16 +1217,16 @@ func (s DeleteSubscriptionFilterOutput) GoString() string {
 type DescribeLogGroupsInput struct {
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// Will only return log groups that match the provided logGroupNamePrefix. If
 	// you don't specify a value, no prefix filter is applied.
-	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string"`
+	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string" min:"1" max:"512"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogGroups
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsInput `json:"-" xml:"-"`
 }
@@ -964,7 +1252,7 @@ type DescribeLogGroupsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsOutput `json:"-" xml:"-"`
 }
@@ -990,19 +1278,19 @@ type DescribeLogStreamsInput struct {
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// The log group name for which log streams are to be listed.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// Will only return log streams that match the provided logStreamNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string"`
+	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string" min:"1" max:"512"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogStreams
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// Specifies what to order the returned log streams by. Valid arguments are
 	// 'LogStreamName' or 'LastEventTime'. If you don't specify a value, results
@@ -1034,7 +1322,7 @@ type DescribeLogStreamsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogStreamsOutput `json:"-" xml:"-"`
 }
@@ -1056,19 +1344,19 @@ func (s DescribeLogStreamsOutput) GoString() string {
 type DescribeMetricFiltersInput struct {
 	// Will only return metric filters that match the provided filterNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string"`
+	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string" min:"1" max:"512"`
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// The log group name for which metric filters are to be listed.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeMetricFilters
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersInput `json:"-" xml:"-"`
 }
@@ -1093,7 +1381,7 @@ type DescribeMetricFiltersOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersOutput `json:"-" xml:"-"`
 }
@@ -1115,18 +1403,18 @@ func (s DescribeMetricFiltersOutput) GoString() string {
 type DescribeSubscriptionFiltersInput struct {
 	// Will only return subscription filters that match the provided filterNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string"`
+	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string" min:"1" max:"512"`
 
 	// The maximum number of results to return.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// The log group name for which subscription filters are to be listed.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeSubscriptionFiltersInput `json:"-" xml:"-"`
 }
@@ -1149,7 +1437,7 @@ type DescribeSubscriptionFiltersOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	SubscriptionFilters []*SubscriptionFilter `locationName:"subscriptionFilters" type:"list"`
 
@@ -1173,11 +1461,11 @@ func (s DescribeSubscriptionFiltersOutput) GoString() string {
 type FilterLogEventsInput struct {
 	// A unix timestamp indicating the end time of the range for the request. If
 	// provided, events with a timestamp later than this time will not be returned.
-	EndTime *int64 `locationName:"endTime" type:"long"`
+	EndTime *int64 `locationName:"endTime" type:"long" min:"0"`
 
 	// A valid CloudWatch Logs filter pattern to use for filtering the response.
 	// If not provided, all the events are matched.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512"`
 
 	// If provided, the API will make a best effort to provide responses that contain
 	// events from multiple log streams within the log group interleaved in a single
@@ -1187,22 +1475,22 @@ type FilterLogEventsInput struct {
 
 	// The maximum number of events to return in a page of results. Default is 10,000
 	// events.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"10000"`
 
 	// The name of the log group to query.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// Optional list of log stream names within the specified log group to search.
 	// Defaults to all the log streams in the log group.
-	LogStreamNames []*string `locationName:"logStreamNames" type:"list"`
+	LogStreamNames []*string `locationName:"logStreamNames" type:"list" min:"1" max:"100"`
 
 	// A pagination token obtained from a FilterLogEvents response to continue paginating
 	// the FilterLogEvents results.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// A unix timestamp indicating the start time of the range for the request.
 	// If provided, events with a timestamp prior to this time will not be returned.
-	StartTime *int64 `locationName:"startTime" type:"long"`
+	StartTime *int64 `locationName:"startTime" type:"long" min:"0"`
 
 	metadataFilterLogEventsInput `json:"-" xml:"-"`
 }
@@ -1228,7 +1516,7 @@ type FilterLogEventsOutput struct {
 
 	// A pagination token obtained from a FilterLogEvents response to continue paginating
 	// the FilterLogEvents results.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// A list of SearchedLogStream objects indicating which log streams have been
 	// searched in this request and whether each has been searched completely or
@@ -1259,17 +1547,17 @@ type FilteredLogEvent struct {
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	IngestionTime *int64 `locationName:"ingestionTime" type:"long"`
+	IngestionTime *int64 `locationName:"ingestionTime" type:"long" min:"0"`
 
 	// The name of the log stream this event belongs to.
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512"`
 
 	// The data contained in the log event.
-	Message *string `locationName:"message" type:"string"`
+	Message *string `locationName:"message" type:"string" min:"1"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0"`
 
 	metadataFilteredLogEvent `json:"-" xml:"-"`
 }
@@ -1291,23 +1579,23 @@ func (s FilteredLogEvent) GoString() string {
 type GetLogEventsInput struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	EndTime *int64 `locationName:"endTime" type:"long"`
+	EndTime *int64 `locationName:"endTime" type:"long" min:"0"`
 
 	// The maximum number of log events returned in the response. If you don't specify
 	// a value, the request would return as many log events as can fit in a response
 	// size of 1MB, up to 10,000 log events.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"10000"`
 
 	// The name of the log group to query.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// The name of the log stream to query.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the nextForwardToken or nextBackwardToken
 	// fields in the response of the previous GetLogEvents request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// If set to true, the earliest log events would be returned first. The default
 	// is false (the latest log events are returned first).
@@ -1315,7 +1603,7 @@ type GetLogEventsInput struct {
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	StartTime *int64 `locationName:"startTime" type:"long"`
+	StartTime *int64 `locationName:"startTime" type:"long" min:"0"`
 
 	metadataGetLogEventsInput `json:"-" xml:"-"`
 }
@@ -1340,12 +1628,12 @@ type GetLogEventsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string"`
+	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string" min:"1"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextForwardToken *string `locationName:"nextForwardToken" type:"string"`
+	NextForwardToken *string `locationName:"nextForwardToken" type:"string" min:"1"`
 
 	metadataGetLogEventsOutput `json:"-" xml:"-"`
 }
@@ -1369,11 +1657,11 @@ func (s GetLogEventsOutput) GoString() string {
 // Logs understands contains two properties: the timestamp of when the event
 // occurred, and the raw event message.
 type InputLogEvent struct {
-	Message *string `locationName:"message" type:"string" required:"true"`
+	Message *string `locationName:"message" type:"string" min:"1" required:"true"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long" required:"true"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0" required:"true"`
 
 	metadataInputLogEvent `json:"-" xml:"-"`
 }
@@ -1397,9 +1685,9 @@ type LogGroup struct {
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512"`
 
 	// The number of metric filters associated with the log group.
 	MetricFilterCount *int64 `locationName:"metricFilterCount" type:"integer"`
@@ -1409,7 +1697,7 @@ type LogGroup struct {
 	// 365, 400, 545, 731, 1827, 3653.
 	RetentionInDays *int64 `locationName:"retentionInDays" type:"integer"`
 
-	StoredBytes *int64 `locationName:"storedBytes" type:"long"`
+	StoredBytes *int64 `locationName:"storedBytes" type:"long" min:"0"`
 
 	metadataLogGroup `json:"-" xml:"-"`
 }
@@ -1434,28 +1722,28 @@ type LogStream struct {
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	FirstEventTimestamp *int64 `locationName:"firstEventTimestamp" type:"long"`
+	FirstEventTimestamp *int64 `locationName:"firstEventTimestamp" type:"long" min:"0"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	LastEventTimestamp *int64 `locationName:"lastEventTimestamp" type:"long"`
+	LastEventTimestamp *int64 `locationName:"lastEventTimestamp" type:"long" min:"0"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	LastIngestionTime *int64 `locationName:"lastIngestionTime" type:"long"`
+	LastIngestionTime *int64 `locationName:"lastIngestionTime" type:"long" min:"0"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512"`
 
-	StoredBytes *int64 `locationName:"storedBytes" type:"long"`
+	StoredBytes *int64 `locationName:"storedBytes" type:"long" min:"0"`
 
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string"`
+	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string" min:"1"`
 
 	metadataLogStream `json:"-" xml:"-"`
 }
@@ -1480,18 +1768,18 @@ func (s LogStream) GoString() string {
 type MetricFilter struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
 	// A name for a metric or subscription filter.
-	FilterName *string `locationName:"filterName" type:"string"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log event. For example, a log event may contain timestamps,
 	// IP addresses, strings, and so on. You use the filter pattern to specify what
 	// to look for in the log event message.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512"`
 
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1"`
 
 	metadataMetricFilter `json:"-" xml:"-"`
 }
@@ -1511,7 +1799,7 @@ func (s MetricFilter) GoString() string {
 }
 
 type MetricFilterMatchRecord struct {
-	EventMessage *string `locationName:"eventMessage" type:"string"`
+	EventMessage *string `locationName:"eventMessage" type:"string" min:"1"`
 
 	EventNumber *int64 `locationName:"eventNumber" type:"long"`
 
@@ -1537,16 +1825,16 @@ func (s MetricFilterMatchRecord) GoString() string {
 type MetricTransformation struct {
 	// The name of the CloudWatch metric to which the monitored log information
 	// should be published. For example, you may publish to a metric called ErrorCount.
-	MetricName *string `locationName:"metricName" type:"string" required:"true"`
+	MetricName *string `locationName:"metricName" type:"string" max:"255" required:"true"`
 
 	// The destination namespace of the new CloudWatch metric.
-	MetricNamespace *string `locationName:"metricNamespace" type:"string" required:"true"`
+	MetricNamespace *string `locationName:"metricNamespace" type:"string" max:"255" required:"true"`
 
 	// What to publish to the metric. For example, if you're counting the occurrences
 	// of a particular term like "Error", the value will be "1" for each occurrence.
 	// If you're counting the bytes transferred the published value will be the
 	// value in the log event.
-	MetricValue *string `locationName:"metricValue" type:"string" required:"true"`
+	MetricValue *string `locationName:"metricValue" type:"string" max:"100" required:"true"`
 
 	metadataMetricTransformation `json:"-" xml:"-"`
 }
@@ -1568,13 +1856,13 @@ func (s MetricTransformation) GoString() string {
 type OutputLogEvent struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	IngestionTime *int64 `locationName:"ingestionTime" type:"long"`
+	IngestionTime *int64 `locationName:"ingestionTime" type:"long" min:"0"`
 
-	Message *string `locationName:"message" type:"string"`
+	Message *string `locationName:"message" type:"string" min:"1"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0"`
 
 	metadataOutputLogEvent `json:"-" xml:"-"`
 }
@@ -1595,17 +1883,17 @@ func (s OutputLogEvent) GoString() string {
 
 type PutLogEventsInput struct {
 	// A list of log events belonging to a log stream.
-	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" required:"true"`
+	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" min:"1" max:"10000" required:"true"`
 
 	// The name of the log group to put log events to.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// The name of the log stream to put log events to.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" required:"true"`
 
 	// A string token that must be obtained from the response of the previous PutLogEvents
 	// request.
-	SequenceToken *string `locationName:"sequenceToken" type:"string"`
+	SequenceToken *string `locationName:"sequenceToken" type:"string" min:"1"`
 
 	metadataPutLogEventsInput `json:"-" xml:"-"`
 }
@@ -1628,7 +1916,7 @@ type PutLogEventsOutput struct {
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string"`
+	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string" min:"1"`
 
 	RejectedLogEventsInfo *RejectedLogEventsInfo `locationName:"rejectedLogEventsInfo" type:"structure"`
 
@@ -1651,17 +1939,17 @@ func (s PutLogEventsOutput) GoString() string {
 
 type PutMetricFilterInput struct {
 	// A name for the metric filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" required:"true"`
 
 	// A valid CloudWatch Logs filter pattern for extracting metric data out of
 	// ingested log events.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
 	// The name of the log group to associate the metric filter with.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// A collection of information needed to define how metric data gets emitted.
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" required:"true"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1" required:"true"`
 
 	metadataPutMetricFilterInput `json:"-" xml:"-"`
 }
@@ -1700,7 +1988,7 @@ func (s PutMetricFilterOutput) GoString() string {
 
 type PutRetentionPolicyInput struct {
 	// The name of the log group to associate the retention policy with.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// Specifies the number of days you want to retain log events in the specified
 	// log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180,
@@ -1744,21 +2032,21 @@ func (s PutRetentionPolicyOutput) GoString() string {
 
 type PutSubscriptionFilterInput struct {
 	// The ARN of an Amazon Kinesis stream to deliver matching log events to.
-	DestinationARN *string `locationName:"destinationArn" type:"string" required:"true"`
+	DestinationARN *string `locationName:"destinationArn" type:"string" min:"1" required:"true"`
 
 	// A name for the subscription filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" required:"true"`
 
 	// A valid CloudWatch Logs filter pattern for subscribing to a filtered stream
 	// of log events.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
 	// The name of the log group to associate the subscription filter with.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" required:"true"`
 
 	// The ARN of an IAM role that grants Amazon CloudWatch Logs permissions to
 	// do Amazon Kinesis PutRecord requests on the desitnation stream.
-	RoleARN *string `locationName:"roleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"roleArn" type:"string" min:"1" required:"true"`
 
 	metadataPutSubscriptionFilterInput `json:"-" xml:"-"`
 }
@@ -1823,7 +2111,7 @@ func (s RejectedLogEventsInfo) GoString() string {
 // request.
 type SearchedLogStream struct {
 	// The name of the log stream.
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512"`
 
 	// Indicates whether all the events in this log stream were searched or more
 	// data exists to search by paginating further.
@@ -1849,22 +2137,22 @@ func (s SearchedLogStream) GoString() string {
 type SubscriptionFilter struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
-	DestinationARN *string `locationName:"destinationArn" type:"string"`
+	DestinationARN *string `locationName:"destinationArn" type:"string" min:"1"`
 
 	// A name for a metric or subscription filter.
-	FilterName *string `locationName:"filterName" type:"string"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log event. For example, a log event may contain timestamps,
 	// IP addresses, strings, and so on. You use the filter pattern to specify what
 	// to look for in the log event message.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512"`
 
-	RoleARN *string `locationName:"roleArn" type:"string"`
+	RoleARN *string `locationName:"roleArn" type:"string" min:"1"`
 
 	metadataSubscriptionFilter `json:"-" xml:"-"`
 }
@@ -1888,10 +2176,10 @@ type TestMetricFilterInput struct {
 	// data in each log event. For example, a log event may contain timestamps,
 	// IP addresses, strings, and so on. You use the filter pattern to specify what
 	// to look for in the log event message.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
 	// A list of log event messages to test.
-	LogEventMessages []*string `locationName:"logEventMessages" type:"list" required:"true"`
+	LogEventMessages []*string `locationName:"logEventMessages" type:"list" min:"1" max:"50" required:"true"`
 
 	metadataTestMetricFilterInput `json:"-" xml:"-"`
 }
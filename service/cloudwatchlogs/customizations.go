@@ -0,0 +1,16 @@
+package cloudwatchlogs
+
+import "github.com/aws/aws-sdk-go/aws"
+
+func init() {
+	initRequest = func(r *aws.Request) {
+		switch r.Operation.Name {
+		case opPutLogEvents:
+			// PutLogEvents accepts gzipped request bodies
+			r.Handlers.Build.PushBackNamed(aws.NamedHandler{
+				Name: aws.CompressRequestBodyHandlerName,
+				Fn:   aws.CompressRequestBody,
+			})
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cloudwatchlogs
+
+const (
+	// ErrCodeDataAlreadyAcceptedException for service response error code
+	// "DataAlreadyAcceptedException".
+	ErrCodeDataAlreadyAcceptedException = "DataAlreadyAcceptedException"
+
+	// ErrCodeInvalidParameterException for service response error code
+	// "InvalidParameterException".
+	//
+	// Returned if a parameter of the request is incorrectly specified.
+	ErrCodeInvalidParameterException = "InvalidParameterException"
+
+	// ErrCodeInvalidSequenceTokenException for service response error code
+	// "InvalidSequenceTokenException".
+	ErrCodeInvalidSequenceTokenException = "InvalidSequenceTokenException"
+
+	// ErrCodeLimitExceededException for service response error code
+	// "LimitExceededException".
+	//
+	// Returned if you have reached the maximum number of resources that can be
+	// created.
+	ErrCodeLimitExceededException = "LimitExceededException"
+
+	// ErrCodeOperationAbortedException for service response error code
+	// "OperationAbortedException".
+	//
+	// Returned if multiple requests to update the same resource were in conflict.
+	ErrCodeOperationAbortedException = "OperationAbortedException"
+
+	// ErrCodeResourceAlreadyExistsException for service response error code
+	// "ResourceAlreadyExistsException".
+	//
+	// Returned if the specified resource already exists.
+	ErrCodeResourceAlreadyExistsException = "ResourceAlreadyExistsException"
+
+	// ErrCodeResourceNotFoundException for service response error code
+	// "ResourceNotFoundException".
+	//
+	// Returned if the specified resource does not exist.
+	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+
+	// ErrCodeServiceUnavailableException for service response error code
+	// "ServiceUnavailableException".
+	//
+	// Returned if the service cannot complete the request.
+	ErrCodeServiceUnavailableException = "ServiceUnavailableException"
+)
@@ -43,6 +43,18 @@ func (c *CodeCommit) BatchGetRepositories(input *BatchGetRepositoriesInput) (*Ba
 	return out, err
 }
 
+// BatchGetRepositoriesWithContext is the same as BatchGetRepositories with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) BatchGetRepositoriesWithContext(ctx aws.Context, input *BatchGetRepositoriesInput, opts ...aws.Option) (*BatchGetRepositoriesOutput, error) {
+	req, out := c.BatchGetRepositoriesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateBranch = "CreateBranch"
 
 // CreateBranchRequest generates a request for the CreateBranch operation.
@@ -73,6 +85,18 @@ func (c *CodeCommit) CreateBranch(input *CreateBranchInput) (*CreateBranchOutput
 	return out, err
 }
 
+// CreateBranchWithContext is the same as CreateBranch with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) CreateBranchWithContext(ctx aws.Context, input *CreateBranchInput, opts ...aws.Option) (*CreateBranchOutput, error) {
+	req, out := c.CreateBranchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateRepository = "CreateRepository"
 
 // CreateRepositoryRequest generates a request for the CreateRepository operation.
@@ -100,6 +124,18 @@ func (c *CodeCommit) CreateRepository(input *CreateRepositoryInput) (*CreateRepo
 	return out, err
 }
 
+// CreateRepositoryWithContext is the same as CreateRepository with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) CreateRepositoryWithContext(ctx aws.Context, input *CreateRepositoryInput, opts ...aws.Option) (*CreateRepositoryOutput, error) {
+	req, out := c.CreateRepositoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRepository = "DeleteRepository"
 
 // DeleteRepositoryRequest generates a request for the DeleteRepository operation.
@@ -132,6 +168,18 @@ func (c *CodeCommit) DeleteRepository(input *DeleteRepositoryInput) (*DeleteRepo
 	return out, err
 }
 
+// DeleteRepositoryWithContext is the same as DeleteRepository with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) DeleteRepositoryWithContext(ctx aws.Context, input *DeleteRepositoryInput, opts ...aws.Option) (*DeleteRepositoryOutput, error) {
+	req, out := c.DeleteRepositoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetBranch = "GetBranch"
 
 // GetBranchRequest generates a request for the GetBranch operation.
@@ -160,6 +208,18 @@ func (c *CodeCommit) GetBranch(input *GetBranchInput) (*GetBranchOutput, error)
 	return out, err
 }
 
+// GetBranchWithContext is the same as GetBranch with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) GetBranchWithContext(ctx aws.Context, input *GetBranchInput, opts ...aws.Option) (*GetBranchOutput, error) {
+	req, out := c.GetBranchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetRepository = "GetRepository"
 
 // GetRepositoryRequest generates a request for the GetRepository operation.
@@ -193,6 +253,18 @@ func (c *CodeCommit) GetRepository(input *GetRepositoryInput) (*GetRepositoryOut
 	return out, err
 }
 
+// GetRepositoryWithContext is the same as GetRepository with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) GetRepositoryWithContext(ctx aws.Context, input *GetRepositoryInput, opts ...aws.Option) (*GetRepositoryOutput, error) {
+	req, out := c.GetRepositoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListBranches = "ListBranches"
 
 // ListBranchesRequest generates a request for the ListBranches operation.
@@ -220,6 +292,18 @@ func (c *CodeCommit) ListBranches(input *ListBranchesInput) (*ListBranchesOutput
 	return out, err
 }
 
+// ListBranchesWithContext is the same as ListBranches with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) ListBranchesWithContext(ctx aws.Context, input *ListBranchesInput, opts ...aws.Option) (*ListBranchesOutput, error) {
+	req, out := c.ListBranchesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListRepositories = "ListRepositories"
 
 // ListRepositoriesRequest generates a request for the ListRepositories operation.
@@ -247,6 +331,18 @@ func (c *CodeCommit) ListRepositories(input *ListRepositoriesInput) (*ListReposi
 	return out, err
 }
 
+// ListRepositoriesWithContext is the same as ListRepositories with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) ListRepositoriesWithContext(ctx aws.Context, input *ListRepositoriesInput, opts ...aws.Option) (*ListRepositoriesOutput, error) {
+	req, out := c.ListRepositoriesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDefaultBranch = "UpdateDefaultBranch"
 
 // UpdateDefaultBranchRequest generates a request for the UpdateDefaultBranch operation.
@@ -278,6 +374,18 @@ func (c *CodeCommit) UpdateDefaultBranch(input *UpdateDefaultBranchInput) (*Upda
 	return out, err
 }
 
+// UpdateDefaultBranchWithContext is the same as UpdateDefaultBranch with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) UpdateDefaultBranchWithContext(ctx aws.Context, input *UpdateDefaultBranchInput, opts ...aws.Option) (*UpdateDefaultBranchOutput, error) {
+	req, out := c.UpdateDefaultBranchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateRepositoryDescription = "UpdateRepositoryDescription"
 
 // UpdateRepositoryDescriptionRequest generates a request for the UpdateRepositoryDescription operation.
@@ -311,6 +419,18 @@ func (c *CodeCommit) UpdateRepositoryDescription(input *UpdateRepositoryDescript
 	return out, err
 }
 
+// UpdateRepositoryDescriptionWithContext is the same as UpdateRepositoryDescription with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) UpdateRepositoryDescriptionWithContext(ctx aws.Context, input *UpdateRepositoryDescriptionInput, opts ...aws.Option) (*UpdateRepositoryDescriptionOutput, error) {
+	req, out := c.UpdateRepositoryDescriptionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateRepositoryName = "UpdateRepositoryName"
 
 // UpdateRepositoryNameRequest generates a request for the UpdateRepositoryName operation.
@@ -338,6 +458,18 @@ func (c *CodeCommit) UpdateRepositoryName(input *UpdateRepositoryNameInput) (*Up
 	return out, err
 }
 
+// UpdateRepositoryNameWithContext is the same as UpdateRepositoryName with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodeCommit) UpdateRepositoryNameWithContext(ctx aws.Context, input *UpdateRepositoryNameInput, opts ...aws.Option) (*UpdateRepositoryNameOutput, error) {
+	req, out := c.UpdateRepositoryNameRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the input of a batch get repositories operation.
 type BatchGetRepositoriesInput struct {
 	// The names of the repositories to get information about.
@@ -388,7 +520,7 @@ func (s BatchGetRepositoriesOutput) GoString() string {
 // Returns information about a branch.
 type BranchInfo struct {
 	// The name of the branch.
-	BranchName *string `locationName:"branchName" type:"string"`
+	BranchName *string `locationName:"branchName" type:"string" min:"1" max:"100"`
 
 	// The ID of the last commit made to the branch.
 	CommitID *string `locationName:"commitId" type:"string"`
@@ -413,7 +545,7 @@ func (s BranchInfo) GoString() string {
 // Represents the input of a create branch operation.
 type CreateBranchInput struct {
 	// The name of the new branch to create.
-	BranchName *string `locationName:"branchName" type:"string" required:"true"`
+	BranchName *string `locationName:"branchName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The ID of the commit to point the new branch to.
 	//
@@ -422,7 +554,7 @@ type CreateBranchInput struct {
 	CommitID *string `locationName:"commitId" type:"string" required:"true"`
 
 	// The name of the repository in which you want to create the new branch.
-	RepositoryName *string `locationName:"repositoryName" type:"string" required:"true"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateBranchInput `json:"-" xml:"-"`
 }
@@ -462,14 +594,14 @@ func (s CreateBranchOutput) GoString() string {
 // Represents the input of a create repository operation.
 type CreateRepositoryInput struct {
 	// A comment or description about the new repository.
-	RepositoryDescription *string `locationName:"repositoryDescription" type:"string"`
+	RepositoryDescription *string `locationName:"repositoryDescription" type:"string" max:"1000"`
 
 	// The name of the new repository to be created.
 	//
 	// The repository name must be unique across the calling AWS account. In addition,
 	// repository names are restricted to alphanumeric characters. The suffix ".git"
 	// is prohibited.
-	RepositoryName *string `locationName:"repositoryName" type:"string" required:"true"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateRepositoryInput `json:"-" xml:"-"`
 }
@@ -513,7 +645,7 @@ func (s CreateRepositoryOutput) GoString() string {
 // Represents the input of a delete repository operation.
 type DeleteRepositoryInput struct {
 	// The name of the repository to delete.
-	RepositoryName *string `locationName:"repositoryName" type:"string" required:"true"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteRepositoryInput `json:"-" xml:"-"`
 }
@@ -557,12 +689,12 @@ func (s DeleteRepositoryOutput) GoString() string {
 // Represents the input of a get branch operation.
 type GetBranchInput struct {
 	// The name of the branch for which you want to retrieve information.
-	BranchName *string `locationName:"branchName" type:"string"`
+	BranchName *string `locationName:"branchName" type:"string" min:"1" max:"100"`
 
 	// Repository name is restricted to alphanumeric characters (a-z, A-Z, 0-9),
 	// ".", "_", and "-". Additionally, the suffix ".git" is prohibited in a repository
 	// name.
-	RepositoryName *string `locationName:"repositoryName" type:"string"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100"`
 
 	metadataGetBranchInput `json:"-" xml:"-"`
 }
@@ -606,7 +738,7 @@ func (s GetBranchOutput) GoString() string {
 // Represents the input of a get repository operation.
 type GetRepositoryInput struct {
 	// The name of the repository to get information about.
-	RepositoryName *string `locationName:"repositoryName" type:"string" required:"true"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetRepositoryInput `json:"-" xml:"-"`
 }
@@ -653,7 +785,7 @@ type ListBranchesInput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 
 	// The name of the repository that contains the branches.
-	RepositoryName *string `locationName:"repositoryName" type:"string" required:"true"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataListBranchesInput `json:"-" xml:"-"`
 }
@@ -774,19 +906,19 @@ type RepositoryMetadata struct {
 	CreationDate *time.Time `locationName:"creationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The repository's default branch name.
-	DefaultBranch *string `locationName:"defaultBranch" type:"string"`
+	DefaultBranch *string `locationName:"defaultBranch" type:"string" min:"1" max:"100"`
 
 	// The date and time the repository was last modified, in timestamp format.
 	LastModifiedDate *time.Time `locationName:"lastModifiedDate" type:"timestamp" timestampFormat:"unix"`
 
 	// A comment or description about the repository.
-	RepositoryDescription *string `locationName:"repositoryDescription" type:"string"`
+	RepositoryDescription *string `locationName:"repositoryDescription" type:"string" max:"1000"`
 
 	// The ID of the repository.
 	RepositoryID *string `locationName:"repositoryId" type:"string"`
 
 	// The repository's name.
-	RepositoryName *string `locationName:"repositoryName" type:"string"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100"`
 
 	metadataRepositoryMetadata `json:"-" xml:"-"`
 }
@@ -813,7 +945,7 @@ type RepositoryNameIDPair struct {
 	// Repository name is restricted to alphanumeric characters (a-z, A-Z, 0-9),
 	// ".", "_", and "-". Additionally, the suffix ".git" is prohibited in a repository
 	// name.
-	RepositoryName *string `locationName:"repositoryName" type:"string"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100"`
 
 	metadataRepositoryNameIDPair `json:"-" xml:"-"`
 }
@@ -835,10 +967,10 @@ func (s RepositoryNameIDPair) GoString() string {
 // Represents the input of an update default branch operation.
 type UpdateDefaultBranchInput struct {
 	// The name of the branch to set as the default.
-	DefaultBranchName *string `locationName:"defaultBranchName" type:"string" required:"true"`
+	DefaultBranchName *string `locationName:"defaultBranchName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the repository to set or change the default branch for.
-	RepositoryName *string `locationName:"repositoryName" type:"string" required:"true"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateDefaultBranchInput `json:"-" xml:"-"`
 }
@@ -878,10 +1010,10 @@ func (s UpdateDefaultBranchOutput) GoString() string {
 // Represents the input of an update repository description operation.
 type UpdateRepositoryDescriptionInput struct {
 	// The new comment or description for the specified repository.
-	RepositoryDescription *string `locationName:"repositoryDescription" type:"string"`
+	RepositoryDescription *string `locationName:"repositoryDescription" type:"string" max:"1000"`
 
 	// The name of the repository to set or change the comment or description for.
-	RepositoryName *string `locationName:"repositoryName" type:"string" required:"true"`
+	RepositoryName *string `locationName:"repositoryName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateRepositoryDescriptionInput `json:"-" xml:"-"`
 }
@@ -923,12 +1055,12 @@ type UpdateRepositoryNameInput struct {
 	// Repository name is restricted to alphanumeric characters (a-z, A-Z, 0-9),
 	// ".", "_", and "-". Additionally, the suffix ".git" is prohibited in a repository
 	// name.
-	NewName *string `locationName:"newName" type:"string" required:"true"`
+	NewName *string `locationName:"newName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Repository name is restricted to alphanumeric characters (a-z, A-Z, 0-9),
 	// ".", "_", and "-". Additionally, the suffix ".git" is prohibited in a repository
 	// name.
-	OldName *string `locationName:"oldName" type:"string" required:"true"`
+	OldName *string `locationName:"oldName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateRepositoryNameInput `json:"-" xml:"-"`
 }
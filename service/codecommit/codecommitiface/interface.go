@@ -4,6 +4,7 @@
 package codecommitiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codecommit"
 )
 
@@ -11,23 +12,45 @@ import (
 type CodeCommitAPI interface {
 	BatchGetRepositories(*codecommit.BatchGetRepositoriesInput) (*codecommit.BatchGetRepositoriesOutput, error)
 
+	BatchGetRepositoriesWithContext(aws.Context, *codecommit.BatchGetRepositoriesInput, ...aws.Option) (*codecommit.BatchGetRepositoriesOutput, error)
+
 	CreateBranch(*codecommit.CreateBranchInput) (*codecommit.CreateBranchOutput, error)
 
+	CreateBranchWithContext(aws.Context, *codecommit.CreateBranchInput, ...aws.Option) (*codecommit.CreateBranchOutput, error)
+
 	CreateRepository(*codecommit.CreateRepositoryInput) (*codecommit.CreateRepositoryOutput, error)
 
+	CreateRepositoryWithContext(aws.Context, *codecommit.CreateRepositoryInput, ...aws.Option) (*codecommit.CreateRepositoryOutput, error)
+
 	DeleteRepository(*codecommit.DeleteRepositoryInput) (*codecommit.DeleteRepositoryOutput, error)
 
+	DeleteRepositoryWithContext(aws.Context, *codecommit.DeleteRepositoryInput, ...aws.Option) (*codecommit.DeleteRepositoryOutput, error)
+
 	GetBranch(*codecommit.GetBranchInput) (*codecommit.GetBranchOutput, error)
 
+	GetBranchWithContext(aws.Context, *codecommit.GetBranchInput, ...aws.Option) (*codecommit.GetBranchOutput, error)
+
 	GetRepository(*codecommit.GetRepositoryInput) (*codecommit.GetRepositoryOutput, error)
 
+	GetRepositoryWithContext(aws.Context, *codecommit.GetRepositoryInput, ...aws.Option) (*codecommit.GetRepositoryOutput, error)
+
 	ListBranches(*codecommit.ListBranchesInput) (*codecommit.ListBranchesOutput, error)
 
+	ListBranchesWithContext(aws.Context, *codecommit.ListBranchesInput, ...aws.Option) (*codecommit.ListBranchesOutput, error)
+
 	ListRepositories(*codecommit.ListRepositoriesInput) (*codecommit.ListRepositoriesOutput, error)
 
+	ListRepositoriesWithContext(aws.Context, *codecommit.ListRepositoriesInput, ...aws.Option) (*codecommit.ListRepositoriesOutput, error)
+
 	UpdateDefaultBranch(*codecommit.UpdateDefaultBranchInput) (*codecommit.UpdateDefaultBranchOutput, error)
 
+	UpdateDefaultBranchWithContext(aws.Context, *codecommit.UpdateDefaultBranchInput, ...aws.Option) (*codecommit.UpdateDefaultBranchOutput, error)
+
 	UpdateRepositoryDescription(*codecommit.UpdateRepositoryDescriptionInput) (*codecommit.UpdateRepositoryDescriptionOutput, error)
 
+	UpdateRepositoryDescriptionWithContext(aws.Context, *codecommit.UpdateRepositoryDescriptionInput, ...aws.Option) (*codecommit.UpdateRepositoryDescriptionOutput, error)
+
 	UpdateRepositoryName(*codecommit.UpdateRepositoryNameInput) (*codecommit.UpdateRepositoryNameOutput, error)
+
+	UpdateRepositoryNameWithContext(aws.Context, *codecommit.UpdateRepositoryNameInput, ...aws.Option) (*codecommit.UpdateRepositoryNameOutput, error)
 }
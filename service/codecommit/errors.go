@@ -0,0 +1,149 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package codecommit
+
+const (
+	// ErrCodeBranchDoesNotExistException for service response error code
+	// "BranchDoesNotExistException".
+	//
+	// The specified branch does not exist.
+	ErrCodeBranchDoesNotExistException = "BranchDoesNotExistException"
+
+	// ErrCodeBranchNameExistsException for service response error code
+	// "BranchNameExistsException".
+	//
+	// The specified branch name already exists.
+	ErrCodeBranchNameExistsException = "BranchNameExistsException"
+
+	// ErrCodeBranchNameRequiredException for service response error code
+	// "BranchNameRequiredException".
+	//
+	// A branch name is required but was not specified.
+	ErrCodeBranchNameRequiredException = "BranchNameRequiredException"
+
+	// ErrCodeCommitDoesNotExistException for service response error code
+	// "CommitDoesNotExistException".
+	//
+	// The specified commit does not exist or no commit was specified, and the specified
+	// repository has no default branch.
+	ErrCodeCommitDoesNotExistException = "CommitDoesNotExistException"
+
+	// ErrCodeCommitIDRequiredException for service response error code
+	// "CommitIdRequiredException".
+	//
+	// A commit ID was not specified.
+	ErrCodeCommitIDRequiredException = "CommitIdRequiredException"
+
+	// ErrCodeEncryptionIntegrityChecksFailedException for service response error code
+	// "EncryptionIntegrityChecksFailedException".
+	//
+	// An encryption integrity check failed.
+	ErrCodeEncryptionIntegrityChecksFailedException = "EncryptionIntegrityChecksFailedException"
+
+	// ErrCodeEncryptionKeyAccessDeniedException for service response error code
+	// "EncryptionKeyAccessDeniedException".
+	//
+	// An encryption key could not be accessed.
+	ErrCodeEncryptionKeyAccessDeniedException = "EncryptionKeyAccessDeniedException"
+
+	// ErrCodeEncryptionKeyDisabledException for service response error code
+	// "EncryptionKeyDisabledException".
+	//
+	// The encryption key is disabled.
+	ErrCodeEncryptionKeyDisabledException = "EncryptionKeyDisabledException"
+
+	// ErrCodeEncryptionKeyNotFoundException for service response error code
+	// "EncryptionKeyNotFoundException".
+	//
+	// No encryption key was found.
+	ErrCodeEncryptionKeyNotFoundException = "EncryptionKeyNotFoundException"
+
+	// ErrCodeEncryptionKeyUnavailableException for service response error code
+	// "EncryptionKeyUnavailableException".
+	//
+	// The encryption key is not available.
+	ErrCodeEncryptionKeyUnavailableException = "EncryptionKeyUnavailableException"
+
+	// ErrCodeInvalidBranchNameException for service response error code
+	// "InvalidBranchNameException".
+	//
+	// The specified branch name is not valid.
+	ErrCodeInvalidBranchNameException = "InvalidBranchNameException"
+
+	// ErrCodeInvalidCommitIDException for service response error code
+	// "InvalidCommitIdException".
+	//
+	// The specified commit ID is not valid.
+	ErrCodeInvalidCommitIDException = "InvalidCommitIdException"
+
+	// ErrCodeInvalidContinuationTokenException for service response error code
+	// "InvalidContinuationTokenException".
+	//
+	// The specified continuation token is not valid.
+	ErrCodeInvalidContinuationTokenException = "InvalidContinuationTokenException"
+
+	// ErrCodeInvalidOrderException for service response error code
+	// "InvalidOrderException".
+	//
+	// The specified sort order is not valid.
+	ErrCodeInvalidOrderException = "InvalidOrderException"
+
+	// ErrCodeInvalidRepositoryDescriptionException for service response error code
+	// "InvalidRepositoryDescriptionException".
+	//
+	// The specified repository description is not valid.
+	ErrCodeInvalidRepositoryDescriptionException = "InvalidRepositoryDescriptionException"
+
+	// ErrCodeInvalidRepositoryNameException for service response error code
+	// "InvalidRepositoryNameException".
+	//
+	// At least one specified repository name is not valid.
+	//
+	// This exception only occurs when a specified repository name is not valid.
+	// Other exceptions occur when a required repository parameter is missing, or
+	// when a specified repository does not exist.
+	ErrCodeInvalidRepositoryNameException = "InvalidRepositoryNameException"
+
+	// ErrCodeInvalidSortByException for service response error code
+	// "InvalidSortByException".
+	//
+	// The specified sort by value is not valid.
+	ErrCodeInvalidSortByException = "InvalidSortByException"
+
+	// ErrCodeMaximumRepositoryNamesExceededException for service response error code
+	// "MaximumRepositoryNamesExceededException".
+	//
+	// The maximum number of allowed repository names was exceeded. Currently, this
+	// number is 25.
+	ErrCodeMaximumRepositoryNamesExceededException = "MaximumRepositoryNamesExceededException"
+
+	// ErrCodeRepositoryDoesNotExistException for service response error code
+	// "RepositoryDoesNotExistException".
+	//
+	// The specified repository does not exist.
+	ErrCodeRepositoryDoesNotExistException = "RepositoryDoesNotExistException"
+
+	// ErrCodeRepositoryLimitExceededException for service response error code
+	// "RepositoryLimitExceededException".
+	//
+	// A repository resource limit was exceeded.
+	ErrCodeRepositoryLimitExceededException = "RepositoryLimitExceededException"
+
+	// ErrCodeRepositoryNameExistsException for service response error code
+	// "RepositoryNameExistsException".
+	//
+	// The specified repository name already exists.
+	ErrCodeRepositoryNameExistsException = "RepositoryNameExistsException"
+
+	// ErrCodeRepositoryNameRequiredException for service response error code
+	// "RepositoryNameRequiredException".
+	//
+	// A repository name is required but was not specified.
+	ErrCodeRepositoryNameRequiredException = "RepositoryNameRequiredException"
+
+	// ErrCodeRepositoryNamesRequiredException for service response error code
+	// "RepositoryNamesRequiredException".
+	//
+	// A repository names object is required but was not specified.
+	ErrCodeRepositoryNamesRequiredException = "RepositoryNamesRequiredException"
+)
@@ -4,6 +4,7 @@
 package codedeployiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 )
 
@@ -11,65 +12,153 @@ import (
 type CodeDeployAPI interface {
 	AddTagsToOnPremisesInstances(*codedeploy.AddTagsToOnPremisesInstancesInput) (*codedeploy.AddTagsToOnPremisesInstancesOutput, error)
 
+	AddTagsToOnPremisesInstancesWithContext(aws.Context, *codedeploy.AddTagsToOnPremisesInstancesInput, ...aws.Option) (*codedeploy.AddTagsToOnPremisesInstancesOutput, error)
+
 	BatchGetApplications(*codedeploy.BatchGetApplicationsInput) (*codedeploy.BatchGetApplicationsOutput, error)
 
+	BatchGetApplicationsWithContext(aws.Context, *codedeploy.BatchGetApplicationsInput, ...aws.Option) (*codedeploy.BatchGetApplicationsOutput, error)
+
 	BatchGetDeployments(*codedeploy.BatchGetDeploymentsInput) (*codedeploy.BatchGetDeploymentsOutput, error)
 
+	BatchGetDeploymentsWithContext(aws.Context, *codedeploy.BatchGetDeploymentsInput, ...aws.Option) (*codedeploy.BatchGetDeploymentsOutput, error)
+
 	BatchGetOnPremisesInstances(*codedeploy.BatchGetOnPremisesInstancesInput) (*codedeploy.BatchGetOnPremisesInstancesOutput, error)
 
+	BatchGetOnPremisesInstancesWithContext(aws.Context, *codedeploy.BatchGetOnPremisesInstancesInput, ...aws.Option) (*codedeploy.BatchGetOnPremisesInstancesOutput, error)
+
 	CreateApplication(*codedeploy.CreateApplicationInput) (*codedeploy.CreateApplicationOutput, error)
 
+	CreateApplicationWithContext(aws.Context, *codedeploy.CreateApplicationInput, ...aws.Option) (*codedeploy.CreateApplicationOutput, error)
+
 	CreateDeployment(*codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error)
 
+	CreateDeploymentWithContext(aws.Context, *codedeploy.CreateDeploymentInput, ...aws.Option) (*codedeploy.CreateDeploymentOutput, error)
+
 	CreateDeploymentConfig(*codedeploy.CreateDeploymentConfigInput) (*codedeploy.CreateDeploymentConfigOutput, error)
 
+	CreateDeploymentConfigWithContext(aws.Context, *codedeploy.CreateDeploymentConfigInput, ...aws.Option) (*codedeploy.CreateDeploymentConfigOutput, error)
+
 	CreateDeploymentGroup(*codedeploy.CreateDeploymentGroupInput) (*codedeploy.CreateDeploymentGroupOutput, error)
 
+	CreateDeploymentGroupWithContext(aws.Context, *codedeploy.CreateDeploymentGroupInput, ...aws.Option) (*codedeploy.CreateDeploymentGroupOutput, error)
+
 	DeleteApplication(*codedeploy.DeleteApplicationInput) (*codedeploy.DeleteApplicationOutput, error)
 
+	DeleteApplicationWithContext(aws.Context, *codedeploy.DeleteApplicationInput, ...aws.Option) (*codedeploy.DeleteApplicationOutput, error)
+
 	DeleteDeploymentConfig(*codedeploy.DeleteDeploymentConfigInput) (*codedeploy.DeleteDeploymentConfigOutput, error)
 
+	DeleteDeploymentConfigWithContext(aws.Context, *codedeploy.DeleteDeploymentConfigInput, ...aws.Option) (*codedeploy.DeleteDeploymentConfigOutput, error)
+
 	DeleteDeploymentGroup(*codedeploy.DeleteDeploymentGroupInput) (*codedeploy.DeleteDeploymentGroupOutput, error)
 
+	DeleteDeploymentGroupWithContext(aws.Context, *codedeploy.DeleteDeploymentGroupInput, ...aws.Option) (*codedeploy.DeleteDeploymentGroupOutput, error)
+
 	DeregisterOnPremisesInstance(*codedeploy.DeregisterOnPremisesInstanceInput) (*codedeploy.DeregisterOnPremisesInstanceOutput, error)
 
+	DeregisterOnPremisesInstanceWithContext(aws.Context, *codedeploy.DeregisterOnPremisesInstanceInput, ...aws.Option) (*codedeploy.DeregisterOnPremisesInstanceOutput, error)
+
 	GetApplication(*codedeploy.GetApplicationInput) (*codedeploy.GetApplicationOutput, error)
 
+	GetApplicationWithContext(aws.Context, *codedeploy.GetApplicationInput, ...aws.Option) (*codedeploy.GetApplicationOutput, error)
+
 	GetApplicationRevision(*codedeploy.GetApplicationRevisionInput) (*codedeploy.GetApplicationRevisionOutput, error)
 
+	GetApplicationRevisionWithContext(aws.Context, *codedeploy.GetApplicationRevisionInput, ...aws.Option) (*codedeploy.GetApplicationRevisionOutput, error)
+
 	GetDeployment(*codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
 
+	GetDeploymentWithContext(aws.Context, *codedeploy.GetDeploymentInput, ...aws.Option) (*codedeploy.GetDeploymentOutput, error)
+
 	GetDeploymentConfig(*codedeploy.GetDeploymentConfigInput) (*codedeploy.GetDeploymentConfigOutput, error)
 
+	GetDeploymentConfigWithContext(aws.Context, *codedeploy.GetDeploymentConfigInput, ...aws.Option) (*codedeploy.GetDeploymentConfigOutput, error)
+
 	GetDeploymentGroup(*codedeploy.GetDeploymentGroupInput) (*codedeploy.GetDeploymentGroupOutput, error)
 
+	GetDeploymentGroupWithContext(aws.Context, *codedeploy.GetDeploymentGroupInput, ...aws.Option) (*codedeploy.GetDeploymentGroupOutput, error)
+
 	GetDeploymentInstance(*codedeploy.GetDeploymentInstanceInput) (*codedeploy.GetDeploymentInstanceOutput, error)
 
+	GetDeploymentInstanceWithContext(aws.Context, *codedeploy.GetDeploymentInstanceInput, ...aws.Option) (*codedeploy.GetDeploymentInstanceOutput, error)
+
 	GetOnPremisesInstance(*codedeploy.GetOnPremisesInstanceInput) (*codedeploy.GetOnPremisesInstanceOutput, error)
 
+	GetOnPremisesInstanceWithContext(aws.Context, *codedeploy.GetOnPremisesInstanceInput, ...aws.Option) (*codedeploy.GetOnPremisesInstanceOutput, error)
+
 	ListApplicationRevisions(*codedeploy.ListApplicationRevisionsInput) (*codedeploy.ListApplicationRevisionsOutput, error)
 
+	ListApplicationRevisionsWithContext(aws.Context, *codedeploy.ListApplicationRevisionsInput, ...aws.Option) (*codedeploy.ListApplicationRevisionsOutput, error)
+
+	ListApplicationRevisionsPages(*codedeploy.ListApplicationRevisionsInput, func(*codedeploy.ListApplicationRevisionsOutput, bool) bool) error
+
+	ListApplicationRevisionsPagesWithContext(aws.Context, *codedeploy.ListApplicationRevisionsInput, func(*codedeploy.ListApplicationRevisionsOutput, bool) bool, ...aws.Option) error
+
 	ListApplications(*codedeploy.ListApplicationsInput) (*codedeploy.ListApplicationsOutput, error)
 
+	ListApplicationsWithContext(aws.Context, *codedeploy.ListApplicationsInput, ...aws.Option) (*codedeploy.ListApplicationsOutput, error)
+
+	ListApplicationsPages(*codedeploy.ListApplicationsInput, func(*codedeploy.ListApplicationsOutput, bool) bool) error
+
+	ListApplicationsPagesWithContext(aws.Context, *codedeploy.ListApplicationsInput, func(*codedeploy.ListApplicationsOutput, bool) bool, ...aws.Option) error
+
 	ListDeploymentConfigs(*codedeploy.ListDeploymentConfigsInput) (*codedeploy.ListDeploymentConfigsOutput, error)
 
+	ListDeploymentConfigsWithContext(aws.Context, *codedeploy.ListDeploymentConfigsInput, ...aws.Option) (*codedeploy.ListDeploymentConfigsOutput, error)
+
+	ListDeploymentConfigsPages(*codedeploy.ListDeploymentConfigsInput, func(*codedeploy.ListDeploymentConfigsOutput, bool) bool) error
+
+	ListDeploymentConfigsPagesWithContext(aws.Context, *codedeploy.ListDeploymentConfigsInput, func(*codedeploy.ListDeploymentConfigsOutput, bool) bool, ...aws.Option) error
+
 	ListDeploymentGroups(*codedeploy.ListDeploymentGroupsInput) (*codedeploy.ListDeploymentGroupsOutput, error)
 
+	ListDeploymentGroupsWithContext(aws.Context, *codedeploy.ListDeploymentGroupsInput, ...aws.Option) (*codedeploy.ListDeploymentGroupsOutput, error)
+
+	ListDeploymentGroupsPages(*codedeploy.ListDeploymentGroupsInput, func(*codedeploy.ListDeploymentGroupsOutput, bool) bool) error
+
+	ListDeploymentGroupsPagesWithContext(aws.Context, *codedeploy.ListDeploymentGroupsInput, func(*codedeploy.ListDeploymentGroupsOutput, bool) bool, ...aws.Option) error
+
 	ListDeploymentInstances(*codedeploy.ListDeploymentInstancesInput) (*codedeploy.ListDeploymentInstancesOutput, error)
 
+	ListDeploymentInstancesWithContext(aws.Context, *codedeploy.ListDeploymentInstancesInput, ...aws.Option) (*codedeploy.ListDeploymentInstancesOutput, error)
+
+	ListDeploymentInstancesPages(*codedeploy.ListDeploymentInstancesInput, func(*codedeploy.ListDeploymentInstancesOutput, bool) bool) error
+
+	ListDeploymentInstancesPagesWithContext(aws.Context, *codedeploy.ListDeploymentInstancesInput, func(*codedeploy.ListDeploymentInstancesOutput, bool) bool, ...aws.Option) error
+
 	ListDeployments(*codedeploy.ListDeploymentsInput) (*codedeploy.ListDeploymentsOutput, error)
 
+	ListDeploymentsWithContext(aws.Context, *codedeploy.ListDeploymentsInput, ...aws.Option) (*codedeploy.ListDeploymentsOutput, error)
+
+	ListDeploymentsPages(*codedeploy.ListDeploymentsInput, func(*codedeploy.ListDeploymentsOutput, bool) bool) error
+
+	ListDeploymentsPagesWithContext(aws.Context, *codedeploy.ListDeploymentsInput, func(*codedeploy.ListDeploymentsOutput, bool) bool, ...aws.Option) error
+
 	ListOnPremisesInstances(*codedeploy.ListOnPremisesInstancesInput) (*codedeploy.ListOnPremisesInstancesOutput, error)
 
+	ListOnPremisesInstancesWithContext(aws.Context, *codedeploy.ListOnPremisesInstancesInput, ...aws.Option) (*codedeploy.ListOnPremisesInstancesOutput, error)
+
 	RegisterApplicationRevision(*codedeploy.RegisterApplicationRevisionInput) (*codedeploy.RegisterApplicationRevisionOutput, error)
 
+	RegisterApplicationRevisionWithContext(aws.Context, *codedeploy.RegisterApplicationRevisionInput, ...aws.Option) (*codedeploy.RegisterApplicationRevisionOutput, error)
+
 	RegisterOnPremisesInstance(*codedeploy.RegisterOnPremisesInstanceInput) (*codedeploy.RegisterOnPremisesInstanceOutput, error)
 
+	RegisterOnPremisesInstanceWithContext(aws.Context, *codedeploy.RegisterOnPremisesInstanceInput, ...aws.Option) (*codedeploy.RegisterOnPremisesInstanceOutput, error)
+
 	RemoveTagsFromOnPremisesInstances(*codedeploy.RemoveTagsFromOnPremisesInstancesInput) (*codedeploy.RemoveTagsFromOnPremisesInstancesOutput, error)
 
+	RemoveTagsFromOnPremisesInstancesWithContext(aws.Context, *codedeploy.RemoveTagsFromOnPremisesInstancesInput, ...aws.Option) (*codedeploy.RemoveTagsFromOnPremisesInstancesOutput, error)
+
 	StopDeployment(*codedeploy.StopDeploymentInput) (*codedeploy.StopDeploymentOutput, error)
 
+	StopDeploymentWithContext(aws.Context, *codedeploy.StopDeploymentInput, ...aws.Option) (*codedeploy.StopDeploymentOutput, error)
+
 	UpdateApplication(*codedeploy.UpdateApplicationInput) (*codedeploy.UpdateApplicationOutput, error)
 
+	UpdateApplicationWithContext(aws.Context, *codedeploy.UpdateApplicationInput, ...aws.Option) (*codedeploy.UpdateApplicationOutput, error)
+
 	UpdateDeploymentGroup(*codedeploy.UpdateDeploymentGroupInput) (*codedeploy.UpdateDeploymentGroupOutput, error)
+
+	UpdateDeploymentGroupWithContext(aws.Context, *codedeploy.UpdateDeploymentGroupInput, ...aws.Option) (*codedeploy.UpdateDeploymentGroupOutput, error)
 }
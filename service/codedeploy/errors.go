@@ -0,0 +1,356 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package codedeploy
+
+const (
+	// ErrCodeApplicationAlreadyExistsException for service response error code
+	// "ApplicationAlreadyExistsException".
+	//
+	// An application with the specified name already exists with the applicable
+	// IAM user or AWS account.
+	ErrCodeApplicationAlreadyExistsException = "ApplicationAlreadyExistsException"
+
+	// ErrCodeApplicationDoesNotExistException for service response error code
+	// "ApplicationDoesNotExistException".
+	//
+	// The application does not exist with the applicable IAM user or AWS account.
+	ErrCodeApplicationDoesNotExistException = "ApplicationDoesNotExistException"
+
+	// ErrCodeApplicationLimitExceededException for service response error code
+	// "ApplicationLimitExceededException".
+	//
+	// More applications were attempted to be created than were allowed.
+	ErrCodeApplicationLimitExceededException = "ApplicationLimitExceededException"
+
+	// ErrCodeApplicationNameRequiredException for service response error code
+	// "ApplicationNameRequiredException".
+	//
+	// The minimum number of required application names was not specified.
+	ErrCodeApplicationNameRequiredException = "ApplicationNameRequiredException"
+
+	// ErrCodeBucketNameFilterRequiredException for service response error code
+	// "BucketNameFilterRequiredException".
+	//
+	// A bucket name is required but was not provided.
+	ErrCodeBucketNameFilterRequiredException = "BucketNameFilterRequiredException"
+
+	// ErrCodeDeploymentAlreadyCompletedException for service response error code
+	// "DeploymentAlreadyCompletedException".
+	//
+	// The deployment is already completed.
+	ErrCodeDeploymentAlreadyCompletedException = "DeploymentAlreadyCompletedException"
+
+	// ErrCodeDeploymentConfigAlreadyExistsException for service response error code
+	// "DeploymentConfigAlreadyExistsException".
+	//
+	// A deployment configuration with the specified name already exists with the
+	// applicable IAM user or AWS account.
+	ErrCodeDeploymentConfigAlreadyExistsException = "DeploymentConfigAlreadyExistsException"
+
+	// ErrCodeDeploymentConfigDoesNotExistException for service response error code
+	// "DeploymentConfigDoesNotExistException".
+	//
+	// The deployment configuration does not exist with the applicable IAM user
+	// or AWS account.
+	ErrCodeDeploymentConfigDoesNotExistException = "DeploymentConfigDoesNotExistException"
+
+	// ErrCodeDeploymentConfigInUseException for service response error code
+	// "DeploymentConfigInUseException".
+	//
+	// The deployment configuration is still in use.
+	ErrCodeDeploymentConfigInUseException = "DeploymentConfigInUseException"
+
+	// ErrCodeDeploymentConfigLimitExceededException for service response error code
+	// "DeploymentConfigLimitExceededException".
+	//
+	// The deployment configurations limit was exceeded.
+	ErrCodeDeploymentConfigLimitExceededException = "DeploymentConfigLimitExceededException"
+
+	// ErrCodeDeploymentConfigNameRequiredException for service response error code
+	// "DeploymentConfigNameRequiredException".
+	//
+	// The deployment configuration name was not specified.
+	ErrCodeDeploymentConfigNameRequiredException = "DeploymentConfigNameRequiredException"
+
+	// ErrCodeDeploymentDoesNotExistException for service response error code
+	// "DeploymentDoesNotExistException".
+	//
+	// The deployment does not exist with the applicable IAM user or AWS account.
+	ErrCodeDeploymentDoesNotExistException = "DeploymentDoesNotExistException"
+
+	// ErrCodeDeploymentGroupAlreadyExistsException for service response error code
+	// "DeploymentGroupAlreadyExistsException".
+	//
+	// A deployment group with the specified name already exists with the applicable
+	// IAM user or AWS account.
+	ErrCodeDeploymentGroupAlreadyExistsException = "DeploymentGroupAlreadyExistsException"
+
+	// ErrCodeDeploymentGroupDoesNotExistException for service response error code
+	// "DeploymentGroupDoesNotExistException".
+	//
+	// The named deployment group does not exist with the applicable IAM user or
+	// AWS account.
+	ErrCodeDeploymentGroupDoesNotExistException = "DeploymentGroupDoesNotExistException"
+
+	// ErrCodeDeploymentGroupLimitExceededException for service response error code
+	// "DeploymentGroupLimitExceededException".
+	//
+	// The deployment groups limit was exceeded.
+	ErrCodeDeploymentGroupLimitExceededException = "DeploymentGroupLimitExceededException"
+
+	// ErrCodeDeploymentGroupNameRequiredException for service response error code
+	// "DeploymentGroupNameRequiredException".
+	//
+	// The deployment group name was not specified.
+	ErrCodeDeploymentGroupNameRequiredException = "DeploymentGroupNameRequiredException"
+
+	// ErrCodeDeploymentIDRequiredException for service response error code
+	// "DeploymentIdRequiredException".
+	//
+	// At least one deployment ID must be specified.
+	ErrCodeDeploymentIDRequiredException = "DeploymentIdRequiredException"
+
+	// ErrCodeDeploymentLimitExceededException for service response error code
+	// "DeploymentLimitExceededException".
+	//
+	// The number of allowed deployments was exceeded.
+	ErrCodeDeploymentLimitExceededException = "DeploymentLimitExceededException"
+
+	// ErrCodeDeploymentNotStartedException for service response error code
+	// "DeploymentNotStartedException".
+	//
+	// The specified deployment has not started.
+	ErrCodeDeploymentNotStartedException = "DeploymentNotStartedException"
+
+	// ErrCodeDescriptionTooLongException for service response error code
+	// "DescriptionTooLongException".
+	//
+	// The description that was provided is too long.
+	ErrCodeDescriptionTooLongException = "DescriptionTooLongException"
+
+	// ErrCodeIAMUserARNAlreadyRegisteredException for service response error code
+	// "IamUserArnAlreadyRegisteredException".
+	//
+	// The specified IAM user ARN is already registered with an on-premises instance.
+	ErrCodeIAMUserARNAlreadyRegisteredException = "IamUserArnAlreadyRegisteredException"
+
+	// ErrCodeIAMUserARNRequiredException for service response error code
+	// "IamUserArnRequiredException".
+	//
+	// An IAM user ARN was not specified.
+	ErrCodeIAMUserARNRequiredException = "IamUserArnRequiredException"
+
+	// ErrCodeInstanceDoesNotExistException for service response error code
+	// "InstanceDoesNotExistException".
+	//
+	// The specified instance does not exist in the deployment group.
+	ErrCodeInstanceDoesNotExistException = "InstanceDoesNotExistException"
+
+	// ErrCodeInstanceIDRequiredException for service response error code
+	// "InstanceIdRequiredException".
+	//
+	// The instance ID was not specified.
+	ErrCodeInstanceIDRequiredException = "InstanceIdRequiredException"
+
+	// ErrCodeInstanceLimitExceededException for service response error code
+	// "InstanceLimitExceededException".
+	//
+	// The maximum number of allowed on-premises instances in a single call was
+	// exceeded.
+	ErrCodeInstanceLimitExceededException = "InstanceLimitExceededException"
+
+	// ErrCodeInstanceNameAlreadyRegisteredException for service response error code
+	// "InstanceNameAlreadyRegisteredException".
+	//
+	// The specified on-premises instance name is already registered.
+	ErrCodeInstanceNameAlreadyRegisteredException = "InstanceNameAlreadyRegisteredException"
+
+	// ErrCodeInstanceNameRequiredException for service response error code
+	// "InstanceNameRequiredException".
+	//
+	// An on-premises instance name was not specified.
+	ErrCodeInstanceNameRequiredException = "InstanceNameRequiredException"
+
+	// ErrCodeInstanceNotRegisteredException for service response error code
+	// "InstanceNotRegisteredException".
+	//
+	// The specified on-premises instance is not registered.
+	ErrCodeInstanceNotRegisteredException = "InstanceNotRegisteredException"
+
+	// ErrCodeInvalidApplicationNameException for service response error code
+	// "InvalidApplicationNameException".
+	//
+	// The application name was specified in an invalid format.
+	ErrCodeInvalidApplicationNameException = "InvalidApplicationNameException"
+
+	// ErrCodeInvalidAutoScalingGroupException for service response error code
+	// "InvalidAutoScalingGroupException".
+	//
+	// The Auto Scaling group was specified in an invalid format or does not exist.
+	ErrCodeInvalidAutoScalingGroupException = "InvalidAutoScalingGroupException"
+
+	// ErrCodeInvalidBucketNameFilterException for service response error code
+	// "InvalidBucketNameFilterException".
+	//
+	// The bucket name either doesn't exist or was specified in an invalid format.
+	ErrCodeInvalidBucketNameFilterException = "InvalidBucketNameFilterException"
+
+	// ErrCodeInvalidDeployedStateFilterException for service response error code
+	// "InvalidDeployedStateFilterException".
+	//
+	// The deployed state filter was specified in an invalid format.
+	ErrCodeInvalidDeployedStateFilterException = "InvalidDeployedStateFilterException"
+
+	// ErrCodeInvalidDeploymentConfigNameException for service response error code
+	// "InvalidDeploymentConfigNameException".
+	//
+	// The deployment configuration name was specified in an invalid format.
+	ErrCodeInvalidDeploymentConfigNameException = "InvalidDeploymentConfigNameException"
+
+	// ErrCodeInvalidDeploymentGroupNameException for service response error code
+	// "InvalidDeploymentGroupNameException".
+	//
+	// The deployment group name was specified in an invalid format.
+	ErrCodeInvalidDeploymentGroupNameException = "InvalidDeploymentGroupNameException"
+
+	// ErrCodeInvalidDeploymentIDException for service response error code
+	// "InvalidDeploymentIdException".
+	//
+	// At least one of the deployment IDs was specified in an invalid format.
+	ErrCodeInvalidDeploymentIDException = "InvalidDeploymentIdException"
+
+	// ErrCodeInvalidDeploymentStatusException for service response error code
+	// "InvalidDeploymentStatusException".
+	//
+	// The specified deployment status doesn't exist or cannot be determined.
+	ErrCodeInvalidDeploymentStatusException = "InvalidDeploymentStatusException"
+
+	// ErrCodeInvalidEC2TagException for service response error code
+	// "InvalidEC2TagException".
+	//
+	// The tag was specified in an invalid format.
+	ErrCodeInvalidEC2TagException = "InvalidEC2TagException"
+
+	// ErrCodeInvalidIAMUserARNException for service response error code
+	// "InvalidIamUserArnException".
+	//
+	// The IAM user ARN was specified in an invalid format.
+	ErrCodeInvalidIAMUserARNException = "InvalidIamUserArnException"
+
+	// ErrCodeInvalidInstanceNameException for service response error code
+	// "InvalidInstanceNameException".
+	//
+	// The specified on-premises instance name was specified in an invalid format.
+	ErrCodeInvalidInstanceNameException = "InvalidInstanceNameException"
+
+	// ErrCodeInvalidInstanceStatusException for service response error code
+	// "InvalidInstanceStatusException".
+	//
+	// The specified instance status does not exist.
+	ErrCodeInvalidInstanceStatusException = "InvalidInstanceStatusException"
+
+	// ErrCodeInvalidKeyPrefixFilterException for service response error code
+	// "InvalidKeyPrefixFilterException".
+	//
+	// The specified key prefix filter was specified in an invalid format.
+	ErrCodeInvalidKeyPrefixFilterException = "InvalidKeyPrefixFilterException"
+
+	// ErrCodeInvalidMinimumHealthyHostValueException for service response error code
+	// "InvalidMinimumHealthyHostValueException".
+	//
+	// The minimum healthy instances value was specified in an invalid format.
+	ErrCodeInvalidMinimumHealthyHostValueException = "InvalidMinimumHealthyHostValueException"
+
+	// ErrCodeInvalidNextTokenException for service response error code
+	// "InvalidNextTokenException".
+	//
+	// The next token was specified in an invalid format.
+	ErrCodeInvalidNextTokenException = "InvalidNextTokenException"
+
+	// ErrCodeInvalidOperationException for service response error code
+	// "InvalidOperationException".
+	//
+	// An invalid operation was detected.
+	ErrCodeInvalidOperationException = "InvalidOperationException"
+
+	// ErrCodeInvalidRegistrationStatusException for service response error code
+	// "InvalidRegistrationStatusException".
+	//
+	// The registration status was specified in an invalid format.
+	ErrCodeInvalidRegistrationStatusException = "InvalidRegistrationStatusException"
+
+	// ErrCodeInvalidRevisionException for service response error code
+	// "InvalidRevisionException".
+	//
+	// The revision was specified in an invalid format.
+	ErrCodeInvalidRevisionException = "InvalidRevisionException"
+
+	// ErrCodeInvalidRoleException for service response error code
+	// "InvalidRoleException".
+	//
+	// The service role ARN was specified in an invalid format. Or, if an Auto Scaling
+	// group was specified, the specified service role does not grant the appropriate
+	// permissions to Auto Scaling.
+	ErrCodeInvalidRoleException = "InvalidRoleException"
+
+	// ErrCodeInvalidSortByException for service response error code
+	// "InvalidSortByException".
+	//
+	// The column name to sort by is either not present or was specified in an invalid
+	// format.
+	ErrCodeInvalidSortByException = "InvalidSortByException"
+
+	// ErrCodeInvalidSortOrderException for service response error code
+	// "InvalidSortOrderException".
+	//
+	// The sort order was specified in an invalid format.
+	ErrCodeInvalidSortOrderException = "InvalidSortOrderException"
+
+	// ErrCodeInvalidTagException for service response error code
+	// "InvalidTagException".
+	//
+	// The specified tag was specified in an invalid format.
+	ErrCodeInvalidTagException = "InvalidTagException"
+
+	// ErrCodeInvalidTagFilterException for service response error code
+	// "InvalidTagFilterException".
+	//
+	// The specified tag filter was specified in an invalid format.
+	ErrCodeInvalidTagFilterException = "InvalidTagFilterException"
+
+	// ErrCodeInvalidTimeRangeException for service response error code
+	// "InvalidTimeRangeException".
+	//
+	// The specified time range was specified in an invalid format.
+	ErrCodeInvalidTimeRangeException = "InvalidTimeRangeException"
+
+	// ErrCodeRevisionDoesNotExistException for service response error code
+	// "RevisionDoesNotExistException".
+	//
+	// The named revision does not exist with the applicable IAM user or AWS account.
+	ErrCodeRevisionDoesNotExistException = "RevisionDoesNotExistException"
+
+	// ErrCodeRevisionRequiredException for service response error code
+	// "RevisionRequiredException".
+	//
+	// The revision ID was not specified.
+	ErrCodeRevisionRequiredException = "RevisionRequiredException"
+
+	// ErrCodeRoleRequiredException for service response error code
+	// "RoleRequiredException".
+	//
+	// The role ID was not specified.
+	ErrCodeRoleRequiredException = "RoleRequiredException"
+
+	// ErrCodeTagLimitExceededException for service response error code
+	// "TagLimitExceededException".
+	//
+	// The maximum allowed number of tags was exceeded.
+	ErrCodeTagLimitExceededException = "TagLimitExceededException"
+
+	// ErrCodeTagRequiredException for service response error code
+	// "TagRequiredException".
+	//
+	// A tag was not specified.
+	ErrCodeTagRequiredException = "TagRequiredException"
+)
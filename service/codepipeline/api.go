@@ -38,6 +38,18 @@ func (c *CodePipeline) AcknowledgeJob(input *AcknowledgeJobInput) (*AcknowledgeJ
 	return out, err
 }
 
+// AcknowledgeJobWithContext is the same as AcknowledgeJob with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) AcknowledgeJobWithContext(ctx aws.Context, input *AcknowledgeJobInput, opts ...aws.Option) (*AcknowledgeJobOutput, error) {
+	req, out := c.AcknowledgeJobRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAcknowledgeThirdPartyJob = "AcknowledgeThirdPartyJob"
 
 // AcknowledgeThirdPartyJobRequest generates a request for the AcknowledgeThirdPartyJob operation.
@@ -66,6 +78,18 @@ func (c *CodePipeline) AcknowledgeThirdPartyJob(input *AcknowledgeThirdPartyJobI
 	return out, err
 }
 
+// AcknowledgeThirdPartyJobWithContext is the same as AcknowledgeThirdPartyJob with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) AcknowledgeThirdPartyJobWithContext(ctx aws.Context, input *AcknowledgeThirdPartyJobInput, opts ...aws.Option) (*AcknowledgeThirdPartyJobOutput, error) {
+	req, out := c.AcknowledgeThirdPartyJobRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCustomActionType = "CreateCustomActionType"
 
 // CreateCustomActionTypeRequest generates a request for the CreateCustomActionType operation.
@@ -94,6 +118,18 @@ func (c *CodePipeline) CreateCustomActionType(input *CreateCustomActionTypeInput
 	return out, err
 }
 
+// CreateCustomActionTypeWithContext is the same as CreateCustomActionType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) CreateCustomActionTypeWithContext(ctx aws.Context, input *CreateCustomActionTypeInput, opts ...aws.Option) (*CreateCustomActionTypeOutput, error) {
+	req, out := c.CreateCustomActionTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePipeline = "CreatePipeline"
 
 // CreatePipelineRequest generates a request for the CreatePipeline operation.
@@ -121,6 +157,18 @@ func (c *CodePipeline) CreatePipeline(input *CreatePipelineInput) (*CreatePipeli
 	return out, err
 }
 
+// CreatePipelineWithContext is the same as CreatePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) CreatePipelineWithContext(ctx aws.Context, input *CreatePipelineInput, opts ...aws.Option) (*CreatePipelineOutput, error) {
+	req, out := c.CreatePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCustomActionType = "DeleteCustomActionType"
 
 // DeleteCustomActionTypeRequest generates a request for the DeleteCustomActionType operation.
@@ -152,6 +200,18 @@ func (c *CodePipeline) DeleteCustomActionType(input *DeleteCustomActionTypeInput
 	return out, err
 }
 
+// DeleteCustomActionTypeWithContext is the same as DeleteCustomActionType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) DeleteCustomActionTypeWithContext(ctx aws.Context, input *DeleteCustomActionTypeInput, opts ...aws.Option) (*DeleteCustomActionTypeOutput, error) {
+	req, out := c.DeleteCustomActionTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePipeline = "DeletePipeline"
 
 // DeletePipelineRequest generates a request for the DeletePipeline operation.
@@ -179,6 +239,18 @@ func (c *CodePipeline) DeletePipeline(input *DeletePipelineInput) (*DeletePipeli
 	return out, err
 }
 
+// DeletePipelineWithContext is the same as DeletePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) DeletePipelineWithContext(ctx aws.Context, input *DeletePipelineInput, opts ...aws.Option) (*DeletePipelineOutput, error) {
+	req, out := c.DeletePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableStageTransition = "DisableStageTransition"
 
 // DisableStageTransitionRequest generates a request for the DisableStageTransition operation.
@@ -207,6 +279,18 @@ func (c *CodePipeline) DisableStageTransition(input *DisableStageTransitionInput
 	return out, err
 }
 
+// DisableStageTransitionWithContext is the same as DisableStageTransition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) DisableStageTransitionWithContext(ctx aws.Context, input *DisableStageTransitionInput, opts ...aws.Option) (*DisableStageTransitionOutput, error) {
+	req, out := c.DisableStageTransitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableStageTransition = "EnableStageTransition"
 
 // EnableStageTransitionRequest generates a request for the EnableStageTransition operation.
@@ -234,6 +318,18 @@ func (c *CodePipeline) EnableStageTransition(input *EnableStageTransitionInput)
 	return out, err
 }
 
+// EnableStageTransitionWithContext is the same as EnableStageTransition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) EnableStageTransitionWithContext(ctx aws.Context, input *EnableStageTransitionInput, opts ...aws.Option) (*EnableStageTransitionOutput, error) {
+	req, out := c.EnableStageTransitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetJobDetails = "GetJobDetails"
 
 // GetJobDetailsRequest generates a request for the GetJobDetails operation.
@@ -266,6 +362,18 @@ func (c *CodePipeline) GetJobDetails(input *GetJobDetailsInput) (*GetJobDetailsO
 	return out, err
 }
 
+// GetJobDetailsWithContext is the same as GetJobDetails with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) GetJobDetailsWithContext(ctx aws.Context, input *GetJobDetailsInput, opts ...aws.Option) (*GetJobDetailsOutput, error) {
+	req, out := c.GetJobDetailsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPipeline = "GetPipeline"
 
 // GetPipelineRequest generates a request for the GetPipeline operation.
@@ -295,6 +403,18 @@ func (c *CodePipeline) GetPipeline(input *GetPipelineInput) (*GetPipelineOutput,
 	return out, err
 }
 
+// GetPipelineWithContext is the same as GetPipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) GetPipelineWithContext(ctx aws.Context, input *GetPipelineInput, opts ...aws.Option) (*GetPipelineOutput, error) {
+	req, out := c.GetPipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPipelineState = "GetPipelineState"
 
 // GetPipelineStateRequest generates a request for the GetPipelineState operation.
@@ -323,6 +443,18 @@ func (c *CodePipeline) GetPipelineState(input *GetPipelineStateInput) (*GetPipel
 	return out, err
 }
 
+// GetPipelineStateWithContext is the same as GetPipelineState with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) GetPipelineStateWithContext(ctx aws.Context, input *GetPipelineStateInput, opts ...aws.Option) (*GetPipelineStateOutput, error) {
+	req, out := c.GetPipelineStateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetThirdPartyJobDetails = "GetThirdPartyJobDetails"
 
 // GetThirdPartyJobDetailsRequest generates a request for the GetThirdPartyJobDetails operation.
@@ -356,6 +488,18 @@ func (c *CodePipeline) GetThirdPartyJobDetails(input *GetThirdPartyJobDetailsInp
 	return out, err
 }
 
+// GetThirdPartyJobDetailsWithContext is the same as GetThirdPartyJobDetails with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) GetThirdPartyJobDetailsWithContext(ctx aws.Context, input *GetThirdPartyJobDetailsInput, opts ...aws.Option) (*GetThirdPartyJobDetailsOutput, error) {
+	req, out := c.GetThirdPartyJobDetailsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListActionTypes = "ListActionTypes"
 
 // ListActionTypesRequest generates a request for the ListActionTypes operation.
@@ -384,6 +528,18 @@ func (c *CodePipeline) ListActionTypes(input *ListActionTypesInput) (*ListAction
 	return out, err
 }
 
+// ListActionTypesWithContext is the same as ListActionTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) ListActionTypesWithContext(ctx aws.Context, input *ListActionTypesInput, opts ...aws.Option) (*ListActionTypesOutput, error) {
+	req, out := c.ListActionTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListPipelines = "ListPipelines"
 
 // ListPipelinesRequest generates a request for the ListPipelines operation.
@@ -411,6 +567,18 @@ func (c *CodePipeline) ListPipelines(input *ListPipelinesInput) (*ListPipelinesO
 	return out, err
 }
 
+// ListPipelinesWithContext is the same as ListPipelines with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) ListPipelinesWithContext(ctx aws.Context, input *ListPipelinesInput, opts ...aws.Option) (*ListPipelinesOutput, error) {
+	req, out := c.ListPipelinesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPollForJobs = "PollForJobs"
 
 // PollForJobsRequest generates a request for the PollForJobs operation.
@@ -443,6 +611,18 @@ func (c *CodePipeline) PollForJobs(input *PollForJobsInput) (*PollForJobsOutput,
 	return out, err
 }
 
+// PollForJobsWithContext is the same as PollForJobs with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) PollForJobsWithContext(ctx aws.Context, input *PollForJobsInput, opts ...aws.Option) (*PollForJobsOutput, error) {
+	req, out := c.PollForJobsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPollForThirdPartyJobs = "PollForThirdPartyJobs"
 
 // PollForThirdPartyJobsRequest generates a request for the PollForThirdPartyJobs operation.
@@ -475,6 +655,18 @@ func (c *CodePipeline) PollForThirdPartyJobs(input *PollForThirdPartyJobsInput)
 	return out, err
 }
 
+// PollForThirdPartyJobsWithContext is the same as PollForThirdPartyJobs with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) PollForThirdPartyJobsWithContext(ctx aws.Context, input *PollForThirdPartyJobsInput, opts ...aws.Option) (*PollForThirdPartyJobsOutput, error) {
+	req, out := c.PollForThirdPartyJobsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutActionRevision = "PutActionRevision"
 
 // PutActionRevisionRequest generates a request for the PutActionRevision operation.
@@ -502,6 +694,18 @@ func (c *CodePipeline) PutActionRevision(input *PutActionRevisionInput) (*PutAct
 	return out, err
 }
 
+// PutActionRevisionWithContext is the same as PutActionRevision with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) PutActionRevisionWithContext(ctx aws.Context, input *PutActionRevisionInput, opts ...aws.Option) (*PutActionRevisionOutput, error) {
+	req, out := c.PutActionRevisionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutJobFailureResult = "PutJobFailureResult"
 
 // PutJobFailureResultRequest generates a request for the PutJobFailureResult operation.
@@ -530,6 +734,18 @@ func (c *CodePipeline) PutJobFailureResult(input *PutJobFailureResultInput) (*Pu
 	return out, err
 }
 
+// PutJobFailureResultWithContext is the same as PutJobFailureResult with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) PutJobFailureResultWithContext(ctx aws.Context, input *PutJobFailureResultInput, opts ...aws.Option) (*PutJobFailureResultOutput, error) {
+	req, out := c.PutJobFailureResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutJobSuccessResult = "PutJobSuccessResult"
 
 // PutJobSuccessResultRequest generates a request for the PutJobSuccessResult operation.
@@ -558,6 +774,18 @@ func (c *CodePipeline) PutJobSuccessResult(input *PutJobSuccessResultInput) (*Pu
 	return out, err
 }
 
+// PutJobSuccessResultWithContext is the same as PutJobSuccessResult with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) PutJobSuccessResultWithContext(ctx aws.Context, input *PutJobSuccessResultInput, opts ...aws.Option) (*PutJobSuccessResultOutput, error) {
+	req, out := c.PutJobSuccessResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutThirdPartyJobFailureResult = "PutThirdPartyJobFailureResult"
 
 // PutThirdPartyJobFailureResultRequest generates a request for the PutThirdPartyJobFailureResult operation.
@@ -586,6 +814,18 @@ func (c *CodePipeline) PutThirdPartyJobFailureResult(input *PutThirdPartyJobFail
 	return out, err
 }
 
+// PutThirdPartyJobFailureResultWithContext is the same as PutThirdPartyJobFailureResult with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) PutThirdPartyJobFailureResultWithContext(ctx aws.Context, input *PutThirdPartyJobFailureResultInput, opts ...aws.Option) (*PutThirdPartyJobFailureResultOutput, error) {
+	req, out := c.PutThirdPartyJobFailureResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutThirdPartyJobSuccessResult = "PutThirdPartyJobSuccessResult"
 
 // PutThirdPartyJobSuccessResultRequest generates a request for the PutThirdPartyJobSuccessResult operation.
@@ -614,6 +854,18 @@ func (c *CodePipeline) PutThirdPartyJobSuccessResult(input *PutThirdPartyJobSucc
 	return out, err
 }
 
+// PutThirdPartyJobSuccessResultWithContext is the same as PutThirdPartyJobSuccessResult with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) PutThirdPartyJobSuccessResultWithContext(ctx aws.Context, input *PutThirdPartyJobSuccessResultInput, opts ...aws.Option) (*PutThirdPartyJobSuccessResultOutput, error) {
+	req, out := c.PutThirdPartyJobSuccessResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opStartPipelineExecution = "StartPipelineExecution"
 
 // StartPipelineExecutionRequest generates a request for the StartPipelineExecution operation.
@@ -642,6 +894,18 @@ func (c *CodePipeline) StartPipelineExecution(input *StartPipelineExecutionInput
 	return out, err
 }
 
+// StartPipelineExecutionWithContext is the same as StartPipelineExecution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) StartPipelineExecutionWithContext(ctx aws.Context, input *StartPipelineExecutionInput, opts ...aws.Option) (*StartPipelineExecutionOutput, error) {
+	req, out := c.StartPipelineExecutionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdatePipeline = "UpdatePipeline"
 
 // UpdatePipelineRequest generates a request for the UpdatePipeline operation.
@@ -672,6 +936,18 @@ func (c *CodePipeline) UpdatePipeline(input *UpdatePipelineInput) (*UpdatePipeli
 	return out, err
 }
 
+// UpdatePipelineWithContext is the same as UpdatePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CodePipeline) UpdatePipelineWithContext(ctx aws.Context, input *UpdatePipelineInput, opts ...aws.Option) (*UpdatePipelineOutput, error) {
+	req, out := c.UpdatePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Represents an AWS session credentials object. These credentials are temporary
 // credentials that are issued by AWS Secure Token Service (STS). They can be
 // used to access input and output artifacts in the Amazon S3 bucket used to
@@ -690,7 +966,7 @@ type AWSSessionCredentials struct {
 }
 
 type metadataAWSSessionCredentials struct {
-	SDKShapeTraits bool `type:"structure"`
+	SDKShapeTraits bool `type:"structure" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -759,7 +1035,7 @@ type AcknowledgeThirdPartyJobInput struct {
 	ClientToken *string `locationName:"clientToken" type:"string" required:"true"`
 
 	// The unique system-generated ID of the job.
-	JobID *string `locationName:"jobId" type:"string" required:"true"`
+	JobID *string `locationName:"jobId" type:"string" min:"1" max:"512" required:"true"`
 
 	// A system-generated random number that AWS CodePipeline uses to ensure that
 	// the job is being worked on by only one job worker. This number must be returned
@@ -831,13 +1107,13 @@ func (s ActionConfiguration) GoString() string {
 type ActionConfigurationProperty struct {
 	// The description of the action configuration property that will be displayed
 	// to users.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" min:"1" max:"2048"`
 
 	// Whether the configuration property is a key.
 	Key *bool `locationName:"key" type:"boolean" required:"true"`
 
 	// The name of the action configuration property.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"50" required:"true"`
 
 	// Indicates that the proprety will be used in conjunction with PollForJobs.
 	// When creating a custom action, an action can have up to one queryable property.
@@ -885,7 +1161,7 @@ func (s ActionConfigurationProperty) GoString() string {
 // worker.
 type ActionContext struct {
 	// The name of the action within the context of a job.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100"`
 
 	metadataActionContext `json:"-" xml:"-"`
 }
@@ -917,7 +1193,7 @@ type ActionDeclaration struct {
 	InputArtifacts []*InputArtifact `locationName:"inputArtifacts" type:"list"`
 
 	// The action declaration's name.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name or ID of the result of the action declaration, such as a test or
 	// build artifact.
@@ -928,7 +1204,7 @@ type ActionDeclaration struct {
 	RoleARN *string `locationName:"roleArn" type:"string"`
 
 	// The order in which actions are run.
-	RunOrder *int64 `locationName:"runOrder" type:"integer"`
+	RunOrder *int64 `locationName:"runOrder" type:"integer" min:"1" max:"999"`
 
 	metadataActionDeclaration `json:"-" xml:"-"`
 }
@@ -957,13 +1233,13 @@ type ActionExecution struct {
 
 	// The URL of a resource external to AWS that will be used when running the
 	// action, for example an external repository URL.
-	ExternalExecutionURL *string `locationName:"externalExecutionUrl" type:"string"`
+	ExternalExecutionURL *string `locationName:"externalExecutionUrl" type:"string" min:"1" max:"2048"`
 
 	// The last status change of the action.
 	LastStatusChange *time.Time `locationName:"lastStatusChange" type:"timestamp" timestampFormat:"unix"`
 
 	// A percentage of completeness of the action as it runs.
-	PercentComplete *int64 `locationName:"percentComplete" type:"integer"`
+	PercentComplete *int64 `locationName:"percentComplete" type:"integer" min:"0" max:"100"`
 
 	// The status of the action, or for a completed action, the last status of the
 	// action.
@@ -1023,21 +1299,21 @@ func (s ActionRevision) GoString() string {
 // Represents information about the state of an action.
 type ActionState struct {
 	// The name of the action.
-	ActionName *string `locationName:"actionName" type:"string"`
+	ActionName *string `locationName:"actionName" type:"string" min:"1" max:"100"`
 
 	// Represents information about the version (or revision) of an action.
 	CurrentRevision *ActionRevision `locationName:"currentRevision" type:"structure"`
 
 	// A URL link for more information about the state of the action, such as a
 	// deployment group details page.
-	EntityURL *string `locationName:"entityUrl" type:"string"`
+	EntityURL *string `locationName:"entityUrl" type:"string" min:"1" max:"2048"`
 
 	// Represents information about how an action runs.
 	LatestExecution *ActionExecution `locationName:"latestExecution" type:"structure"`
 
 	// A URL link for more information about the revision, such as a commit details
 	// page.
-	RevisionURL *string `locationName:"revisionUrl" type:"string"`
+	RevisionURL *string `locationName:"revisionUrl" type:"string" min:"1" max:"2048"`
 
 	metadataActionState `json:"-" xml:"-"`
 }
@@ -1059,7 +1335,7 @@ func (s ActionState) GoString() string {
 // Returns information about the details of an action type.
 type ActionType struct {
 	// The configuration properties for the action type.
-	ActionConfigurationProperties []*ActionConfigurationProperty `locationName:"actionConfigurationProperties" type:"list"`
+	ActionConfigurationProperties []*ActionConfigurationProperty `locationName:"actionConfigurationProperties" type:"list" max:"10"`
 
 	// Represents information about an action type.
 	ID *ActionTypeID `locationName:"id" type:"structure" required:"true"`
@@ -1104,10 +1380,10 @@ type ActionTypeID struct {
 	// determined by the action category. For example, an action in the Deploy category
 	// type might have a provider of AWS CodeDeploy, which would be specified as
 	// CodeDeploy.
-	Provider *string `locationName:"provider" type:"string" required:"true"`
+	Provider *string `locationName:"provider" type:"string" min:"1" max:"25" required:"true"`
 
 	// A string that identifies the action type.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"9" required:"true"`
 
 	metadataActionTypeID `json:"-" xml:"-"`
 }
@@ -1132,23 +1408,23 @@ type ActionTypeSettings struct {
 	// to the resources of the external system, such as the configuration page for
 	// an AWS CodeDeploy deployment group. This link is provided as part of the
 	// action display within the pipeline.
-	EntityURLTemplate *string `locationName:"entityUrlTemplate" type:"string"`
+	EntityURLTemplate *string `locationName:"entityUrlTemplate" type:"string" min:"1" max:"2048"`
 
 	// The URL returned to the AWS CodePipeline console that contains a link to
 	// the top-level landing page for the external system, such as console page
 	// for AWS CodeDeploy. This link is shown on the pipeline view page in the AWS
 	// CodePipeline console and provides a link to the execution entity of the external
 	// action.
-	ExecutionURLTemplate *string `locationName:"executionUrlTemplate" type:"string"`
+	ExecutionURLTemplate *string `locationName:"executionUrlTemplate" type:"string" min:"1" max:"2048"`
 
 	// The URL returned to the AWS CodePipeline console that contains a link to
 	// the page where customers can update or change the configuration of the external
 	// action.
-	RevisionURLTemplate *string `locationName:"revisionUrlTemplate" type:"string"`
+	RevisionURLTemplate *string `locationName:"revisionUrlTemplate" type:"string" min:"1" max:"2048"`
 
 	// The URL of a sign-up page where users can sign up for an external service
 	// and perform initial configuration of the action provided by that service.
-	ThirdPartyConfigurationURL *string `locationName:"thirdPartyConfigurationUrl" type:"string"`
+	ThirdPartyConfigurationURL *string `locationName:"thirdPartyConfigurationUrl" type:"string" min:"1" max:"2048"`
 
 	metadataActionTypeSettings `json:"-" xml:"-"`
 }
@@ -1174,7 +1450,7 @@ type Artifact struct {
 	Location *ArtifactLocation `locationName:"location" type:"structure"`
 
 	// The artifact's name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100"`
 
 	// The artifact's revision ID. Depending on the type of object, this could be
 	// a commit ID (GitHub) or a revision ID (Amazon S3).
@@ -1200,10 +1476,10 @@ func (s Artifact) GoString() string {
 // Returns information about the details of an artifact.
 type ArtifactDetails struct {
 	// The maximum number of artifacts allowed for the action type.
-	MaximumCount *int64 `locationName:"maximumCount" type:"integer" required:"true"`
+	MaximumCount *int64 `locationName:"maximumCount" type:"integer" min:"0" max:"5" required:"true"`
 
 	// The minimum number of artifacts allowed for the action type.
-	MinimumCount *int64 `locationName:"minimumCount" type:"integer" required:"true"`
+	MinimumCount *int64 `locationName:"minimumCount" type:"integer" min:"0" max:"5" required:"true"`
 
 	metadataArtifactDetails `json:"-" xml:"-"`
 }
@@ -1253,7 +1529,7 @@ func (s ArtifactLocation) GoString() string {
 type ArtifactStore struct {
 	// The location for storing the artifacts for a pipeline, such as an S3 bucket
 	// or folder.
-	Location *string `locationName:"location" type:"string" required:"true"`
+	Location *string `locationName:"location" type:"string" min:"3" max:"63" required:"true"`
 
 	// The type of the artifact store, such as S3.
 	Type *string `locationName:"type" type:"string" required:"true"`
@@ -1278,7 +1554,7 @@ func (s ArtifactStore) GoString() string {
 // Represents information about a gate declaration.
 type BlockerDeclaration struct {
 	// The name of the gate declaration.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	// The type of the gate declaration.
 	Type *string `locationName:"type" type:"string" required:"true"`
@@ -1306,7 +1582,7 @@ type CreateCustomActionTypeInput struct {
 	Category *string `locationName:"category" type:"string" required:"true"`
 
 	// The configuration properties for the custom action.
-	ConfigurationProperties []*ActionConfigurationProperty `locationName:"configurationProperties" type:"list"`
+	ConfigurationProperties []*ActionConfigurationProperty `locationName:"configurationProperties" type:"list" max:"10"`
 
 	// Returns information about the details of an artifact.
 	InputArtifactDetails *ArtifactDetails `locationName:"inputArtifactDetails" type:"structure" required:"true"`
@@ -1315,7 +1591,7 @@ type CreateCustomActionTypeInput struct {
 	OutputArtifactDetails *ArtifactDetails `locationName:"outputArtifactDetails" type:"structure" required:"true"`
 
 	// The provider of the service used in the custom action, such as AWS CodeDeploy.
-	Provider *string `locationName:"provider" type:"string" required:"true"`
+	Provider *string `locationName:"provider" type:"string" min:"1" max:"25" required:"true"`
 
 	// Returns information about the settings for an action type.
 	Settings *ActionTypeSettings `locationName:"settings" type:"structure"`
@@ -1324,7 +1600,7 @@ type CreateCustomActionTypeInput struct {
 	//
 	// A newly-created custom action is always assigned a version number of 1.
 	// This is required.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"9" required:"true"`
 
 	metadataCreateCustomActionTypeInput `json:"-" xml:"-"`
 }
@@ -1442,10 +1718,10 @@ type DeleteCustomActionTypeInput struct {
 	Category *string `locationName:"category" type:"string" required:"true"`
 
 	// The provider of the service used in the custom action, such as AWS CodeDeploy.
-	Provider *string `locationName:"provider" type:"string" required:"true"`
+	Provider *string `locationName:"provider" type:"string" min:"1" max:"25" required:"true"`
 
 	// The version of the custom action to delete.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"9" required:"true"`
 
 	metadataDeleteCustomActionTypeInput `json:"-" xml:"-"`
 }
@@ -1485,7 +1761,7 @@ func (s DeleteCustomActionTypeOutput) GoString() string {
 // Represents the input of a delete pipeline action.
 type DeletePipelineInput struct {
 	// The name of the pipeline to be deleted.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeletePipelineInput `json:"-" xml:"-"`
 }
@@ -1526,16 +1802,16 @@ func (s DeletePipelineOutput) GoString() string {
 type DisableStageTransitionInput struct {
 	// The name of the pipeline in which you want to disable the flow of artifacts
 	// from one stage to another.
-	PipelineName *string `locationName:"pipelineName" type:"string" required:"true"`
+	PipelineName *string `locationName:"pipelineName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The reason given to the user why a stage is disabled, such as waiting for
 	// manual approval or manual tests. This message is displayed in the pipeline
 	// console UI.
-	Reason *string `locationName:"reason" type:"string" required:"true"`
+	Reason *string `locationName:"reason" type:"string" min:"1" max:"300" required:"true"`
 
 	// The name of the stage where you want to disable the inbound or outbound transition
 	// of artifacts.
-	StageName *string `locationName:"stageName" type:"string" required:"true"`
+	StageName *string `locationName:"stageName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Specifies whether artifacts will be prevented from transitioning into the
 	// stage and being processed by the actions in that stage (inbound), or prevented
@@ -1582,11 +1858,11 @@ func (s DisableStageTransitionOutput) GoString() string {
 type EnableStageTransitionInput struct {
 	// The name of the pipeline in which you want to enable the flow of artifacts
 	// from one stage to another.
-	PipelineName *string `locationName:"pipelineName" type:"string" required:"true"`
+	PipelineName *string `locationName:"pipelineName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the stage where you want to enable the transition of artifacts,
 	// either into the stage (inbound) or from that stage to the next stage (outbound).
-	StageName *string `locationName:"stageName" type:"string" required:"true"`
+	StageName *string `locationName:"stageName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Specifies whether artifacts will be allowed to enter the stage and be processed
 	// by the actions in that stage (inbound) or whether already-processed artifacts
@@ -1662,7 +1938,7 @@ type ExecutionDetails struct {
 
 	// The percentage of work completed on the action, represented on a scale of
 	// zero to one hundred percent.
-	PercentComplete *int64 `locationName:"percentComplete" type:"integer"`
+	PercentComplete *int64 `locationName:"percentComplete" type:"integer" min:"0" max:"100"`
 
 	// The summary of the current status of the actions.
 	Summary *string `locationName:"summary" type:"string"`
@@ -1763,11 +2039,11 @@ func (s GetJobDetailsOutput) GoString() string {
 type GetPipelineInput struct {
 	// The name of the pipeline for which you want to get information. Pipeline
 	// names must be unique under an Amazon Web Services (AWS) user account.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	// The version number of the pipeline. If you do not specify a version, defaults
 	// to the most current version.
-	Version *int64 `locationName:"version" type:"integer"`
+	Version *int64 `locationName:"version" type:"integer" min:"1"`
 
 	metadataGetPipelineInput `json:"-" xml:"-"`
 }
@@ -1811,7 +2087,7 @@ func (s GetPipelineOutput) GoString() string {
 // Represents the input of a get pipeline state action.
 type GetPipelineStateInput struct {
 	// The name of the pipeline about which you want to get information.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetPipelineStateInput `json:"-" xml:"-"`
 }
@@ -1836,12 +2112,12 @@ type GetPipelineStateOutput struct {
 	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"unix"`
 
 	// The name of the pipeline for which you want to get the state.
-	PipelineName *string `locationName:"pipelineName" type:"string"`
+	PipelineName *string `locationName:"pipelineName" type:"string" min:"1" max:"100"`
 
 	// The version number of the pipeline.
 	//
 	// A newly-created pipeline is always assigned a version number of 1.
-	PipelineVersion *int64 `locationName:"pipelineVersion" type:"integer"`
+	PipelineVersion *int64 `locationName:"pipelineVersion" type:"integer" min:"1"`
 
 	// A list of the pipeline stage output information, including stage name, state,
 	// most recent run details, whether the stage is disabled, and other data.
@@ -1874,7 +2150,7 @@ type GetThirdPartyJobDetailsInput struct {
 	ClientToken *string `locationName:"clientToken" type:"string" required:"true"`
 
 	// The unique system-generated ID used for identifying the job.
-	JobID *string `locationName:"jobId" type:"string" required:"true"`
+	JobID *string `locationName:"jobId" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataGetThirdPartyJobDetailsInput `json:"-" xml:"-"`
 }
@@ -1925,7 +2201,7 @@ type InputArtifact struct {
 	// action in strict sequence from the action that provided the output artifact.
 	// Actions in parallel can declare different output artifacts, which are in
 	// turn consumed by different following actions.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataInputArtifact `json:"-" xml:"-"`
 }
@@ -1990,7 +2266,7 @@ type JobData struct {
 	// credentials that are issued by AWS Secure Token Service (STS). They can be
 	// used to access input and output artifacts in the Amazon S3 bucket used to
 	// store artifact for the pipeline in AWS CodePipeline.
-	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure"`
+	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure" sensitive:"true"`
 
 	// A system-generated token, such as a AWS CodeDeploy deployment ID, that a
 	// job requires in order to continue the job asynchronously.
@@ -2165,7 +2441,7 @@ type OutputArtifact struct {
 	// turn consumed by different following actions.
 	//
 	// Output artifact names must be unique within a pipeline.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataOutputArtifact `json:"-" xml:"-"`
 }
@@ -2192,7 +2468,7 @@ type PipelineContext struct {
 
 	// The name of the pipeline. This is a user-specified value. Pipeline names
 	// must be unique across all pipeline names under an Amazon Web Services account.
-	PipelineName *string `locationName:"pipelineName" type:"string"`
+	PipelineName *string `locationName:"pipelineName" type:"string" min:"1" max:"100"`
 
 	// The stage of the pipeline.
 	Stage *StageContext `locationName:"stage" type:"structure"`
@@ -2222,7 +2498,7 @@ type PipelineDeclaration struct {
 	ArtifactStore *ArtifactStore `locationName:"artifactStore" type:"structure" required:"true"`
 
 	// The name of the action to be performed.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	// The Amazon Resource Name (ARN) for AWS CodePipeline to use to either perform
 	// actions with no actionRoleArn, or to use to assume roles for actions with
@@ -2234,7 +2510,7 @@ type PipelineDeclaration struct {
 
 	// The version number of the pipeline. A new pipeline always has a version number
 	// of 1. This number is automatically incremented when a pipeline is updated.
-	Version *int64 `locationName:"version" type:"integer"`
+	Version *int64 `locationName:"version" type:"integer" min:"1"`
 
 	metadataPipelineDeclaration `json:"-" xml:"-"`
 }
@@ -2259,13 +2535,13 @@ type PipelineSummary struct {
 	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"unix"`
 
 	// The name of the pipeline.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100"`
 
 	// The date and time of the last update to the pipeline, in timestamp format.
 	Updated *time.Time `locationName:"updated" type:"timestamp" timestampFormat:"unix"`
 
 	// The version number of the pipeline.
-	Version *int64 `locationName:"version" type:"integer"`
+	Version *int64 `locationName:"version" type:"integer" min:"1"`
 
 	metadataPipelineSummary `json:"-" xml:"-"`
 }
@@ -2290,13 +2566,13 @@ type PollForJobsInput struct {
 	ActionTypeID *ActionTypeID `locationName:"actionTypeId" type:"structure" required:"true"`
 
 	// The maximum number of jobs to return in a poll for jobs call.
-	MaxBatchSize *int64 `locationName:"maxBatchSize" type:"integer"`
+	MaxBatchSize *int64 `locationName:"maxBatchSize" type:"integer" min:"1"`
 
 	// A map of property names and values. For an action type with no queryable
 	// properties, this value must be null or an empty map. For an action type with
 	// a queryable property, you must supply that property as a key in the map.
 	// Only jobs whose action configuration matches the mapped value will be returned.
-	QueryParam map[string]*string `locationName:"queryParam" type:"map"`
+	QueryParam map[string]*string `locationName:"queryParam" type:"map" min:"0" max:"1"`
 
 	metadataPollForJobsInput `json:"-" xml:"-"`
 }
@@ -2343,7 +2619,7 @@ type PollForThirdPartyJobsInput struct {
 	ActionTypeID *ActionTypeID `locationName:"actionTypeId" type:"structure" required:"true"`
 
 	// The maximum number of jobs to return in a poll for jobs call.
-	MaxBatchSize *int64 `locationName:"maxBatchSize" type:"integer"`
+	MaxBatchSize *int64 `locationName:"maxBatchSize" type:"integer" min:"1"`
 
 	metadataPollForThirdPartyJobsInput `json:"-" xml:"-"`
 }
@@ -2387,16 +2663,16 @@ func (s PollForThirdPartyJobsOutput) GoString() string {
 // Represents the input of a put action revision action.
 type PutActionRevisionInput struct {
 	// The name of the action that will process the revision.
-	ActionName *string `locationName:"actionName" type:"string" required:"true"`
+	ActionName *string `locationName:"actionName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Represents information about the version (or revision) of an action.
 	ActionRevision *ActionRevision `locationName:"actionRevision" type:"structure" required:"true"`
 
 	// The name of the pipeline that will start processing the revision to the source.
-	PipelineName *string `locationName:"pipelineName" type:"string" required:"true"`
+	PipelineName *string `locationName:"pipelineName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the stage that contains the action that will act upon the revision.
-	StageName *string `locationName:"stageName" type:"string" required:"true"`
+	StageName *string `locationName:"stageName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataPutActionRevisionInput `json:"-" xml:"-"`
 }
@@ -2547,7 +2823,7 @@ type PutThirdPartyJobFailureResultInput struct {
 	FailureDetails *FailureDetails `locationName:"failureDetails" type:"structure" required:"true"`
 
 	// The ID of the job that failed. This is the same ID returned from PollForThirdPartyJobs.
-	JobID *string `locationName:"jobId" type:"string" required:"true"`
+	JobID *string `locationName:"jobId" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataPutThirdPartyJobFailureResultInput `json:"-" xml:"-"`
 }
@@ -2603,7 +2879,7 @@ type PutThirdPartyJobSuccessResultInput struct {
 
 	// The ID of the job that successfully completed. This is the same ID returned
 	// from PollForThirdPartyJobs.
-	JobID *string `locationName:"jobId" type:"string" required:"true"`
+	JobID *string `locationName:"jobId" type:"string" min:"1" max:"512" required:"true"`
 
 	metadataPutThirdPartyJobSuccessResultInput `json:"-" xml:"-"`
 }
@@ -2669,7 +2945,7 @@ func (s S3ArtifactLocation) GoString() string {
 // Represents information about a stage to a job worker.
 type StageContext struct {
 	// The name of the stage.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100"`
 
 	metadataStageContext `json:"-" xml:"-"`
 }
@@ -2697,7 +2973,7 @@ type StageDeclaration struct {
 	Blockers []*BlockerDeclaration `locationName:"blockers" type:"list"`
 
 	// The name of the stage.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataStageDeclaration `json:"-" xml:"-"`
 }
@@ -2725,7 +3001,7 @@ type StageState struct {
 	InboundTransitionState *TransitionState `locationName:"inboundTransitionState" type:"structure"`
 
 	// The name of the stage.
-	StageName *string `locationName:"stageName" type:"string"`
+	StageName *string `locationName:"stageName" type:"string" min:"1" max:"100"`
 
 	metadataStageState `json:"-" xml:"-"`
 }
@@ -2747,7 +3023,7 @@ func (s StageState) GoString() string {
 // Represents the input of a start pipeline execution action.
 type StartPipelineExecutionInput struct {
 	// The name of the pipeline to start.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataStartPipelineExecutionInput `json:"-" xml:"-"`
 }
@@ -2827,7 +3103,7 @@ type ThirdPartyJobData struct {
 	// credentials that are issued by AWS Secure Token Service (STS). They can be
 	// used to access input and output artifacts in the Amazon S3 bucket used to
 	// store artifact for the pipeline in AWS CodePipeline.
-	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure"`
+	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure" sensitive:"true"`
 
 	// A system-generated token, such as a AWS CodeDeploy deployment ID, that a
 	// job requires in order to continue the job asynchronously.
@@ -2871,7 +3147,7 @@ type ThirdPartyJobDetails struct {
 	Data *ThirdPartyJobData `locationName:"data" type:"structure"`
 
 	// The identifier used to identify the job details in AWS CodePipeline.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"512"`
 
 	// A system-generated random number that AWS CodePipeline uses to ensure that
 	// the job is being worked on by only one job worker. This number must be returned
@@ -2900,7 +3176,7 @@ func (s ThirdPartyJobDetails) GoString() string {
 type TransitionState struct {
 	// The user-specified reason why the transition between two stages of a pipeline
 	// was disabled.
-	DisabledReason *string `locationName:"disabledReason" type:"string"`
+	DisabledReason *string `locationName:"disabledReason" type:"string" min:"1" max:"300"`
 
 	// Whether the transition between stages is enabled (true) or disabled (false).
 	Enabled *bool `locationName:"enabled" type:"boolean"`
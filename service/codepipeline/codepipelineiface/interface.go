@@ -4,6 +4,7 @@
 package codepipelineiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 )
 
@@ -11,47 +12,93 @@ import (
 type CodePipelineAPI interface {
 	AcknowledgeJob(*codepipeline.AcknowledgeJobInput) (*codepipeline.AcknowledgeJobOutput, error)
 
+	AcknowledgeJobWithContext(aws.Context, *codepipeline.AcknowledgeJobInput, ...aws.Option) (*codepipeline.AcknowledgeJobOutput, error)
+
 	AcknowledgeThirdPartyJob(*codepipeline.AcknowledgeThirdPartyJobInput) (*codepipeline.AcknowledgeThirdPartyJobOutput, error)
 
+	AcknowledgeThirdPartyJobWithContext(aws.Context, *codepipeline.AcknowledgeThirdPartyJobInput, ...aws.Option) (*codepipeline.AcknowledgeThirdPartyJobOutput, error)
+
 	CreateCustomActionType(*codepipeline.CreateCustomActionTypeInput) (*codepipeline.CreateCustomActionTypeOutput, error)
 
+	CreateCustomActionTypeWithContext(aws.Context, *codepipeline.CreateCustomActionTypeInput, ...aws.Option) (*codepipeline.CreateCustomActionTypeOutput, error)
+
 	CreatePipeline(*codepipeline.CreatePipelineInput) (*codepipeline.CreatePipelineOutput, error)
 
+	CreatePipelineWithContext(aws.Context, *codepipeline.CreatePipelineInput, ...aws.Option) (*codepipeline.CreatePipelineOutput, error)
+
 	DeleteCustomActionType(*codepipeline.DeleteCustomActionTypeInput) (*codepipeline.DeleteCustomActionTypeOutput, error)
 
+	DeleteCustomActionTypeWithContext(aws.Context, *codepipeline.DeleteCustomActionTypeInput, ...aws.Option) (*codepipeline.DeleteCustomActionTypeOutput, error)
+
 	DeletePipeline(*codepipeline.DeletePipelineInput) (*codepipeline.DeletePipelineOutput, error)
 
+	DeletePipelineWithContext(aws.Context, *codepipeline.DeletePipelineInput, ...aws.Option) (*codepipeline.DeletePipelineOutput, error)
+
 	DisableStageTransition(*codepipeline.DisableStageTransitionInput) (*codepipeline.DisableStageTransitionOutput, error)
 
+	DisableStageTransitionWithContext(aws.Context, *codepipeline.DisableStageTransitionInput, ...aws.Option) (*codepipeline.DisableStageTransitionOutput, error)
+
 	EnableStageTransition(*codepipeline.EnableStageTransitionInput) (*codepipeline.EnableStageTransitionOutput, error)
 
+	EnableStageTransitionWithContext(aws.Context, *codepipeline.EnableStageTransitionInput, ...aws.Option) (*codepipeline.EnableStageTransitionOutput, error)
+
 	GetJobDetails(*codepipeline.GetJobDetailsInput) (*codepipeline.GetJobDetailsOutput, error)
 
+	GetJobDetailsWithContext(aws.Context, *codepipeline.GetJobDetailsInput, ...aws.Option) (*codepipeline.GetJobDetailsOutput, error)
+
 	GetPipeline(*codepipeline.GetPipelineInput) (*codepipeline.GetPipelineOutput, error)
 
+	GetPipelineWithContext(aws.Context, *codepipeline.GetPipelineInput, ...aws.Option) (*codepipeline.GetPipelineOutput, error)
+
 	GetPipelineState(*codepipeline.GetPipelineStateInput) (*codepipeline.GetPipelineStateOutput, error)
 
+	GetPipelineStateWithContext(aws.Context, *codepipeline.GetPipelineStateInput, ...aws.Option) (*codepipeline.GetPipelineStateOutput, error)
+
 	GetThirdPartyJobDetails(*codepipeline.GetThirdPartyJobDetailsInput) (*codepipeline.GetThirdPartyJobDetailsOutput, error)
 
+	GetThirdPartyJobDetailsWithContext(aws.Context, *codepipeline.GetThirdPartyJobDetailsInput, ...aws.Option) (*codepipeline.GetThirdPartyJobDetailsOutput, error)
+
 	ListActionTypes(*codepipeline.ListActionTypesInput) (*codepipeline.ListActionTypesOutput, error)
 
+	ListActionTypesWithContext(aws.Context, *codepipeline.ListActionTypesInput, ...aws.Option) (*codepipeline.ListActionTypesOutput, error)
+
 	ListPipelines(*codepipeline.ListPipelinesInput) (*codepipeline.ListPipelinesOutput, error)
 
+	ListPipelinesWithContext(aws.Context, *codepipeline.ListPipelinesInput, ...aws.Option) (*codepipeline.ListPipelinesOutput, error)
+
 	PollForJobs(*codepipeline.PollForJobsInput) (*codepipeline.PollForJobsOutput, error)
 
+	PollForJobsWithContext(aws.Context, *codepipeline.PollForJobsInput, ...aws.Option) (*codepipeline.PollForJobsOutput, error)
+
 	PollForThirdPartyJobs(*codepipeline.PollForThirdPartyJobsInput) (*codepipeline.PollForThirdPartyJobsOutput, error)
 
+	PollForThirdPartyJobsWithContext(aws.Context, *codepipeline.PollForThirdPartyJobsInput, ...aws.Option) (*codepipeline.PollForThirdPartyJobsOutput, error)
+
 	PutActionRevision(*codepipeline.PutActionRevisionInput) (*codepipeline.PutActionRevisionOutput, error)
 
+	PutActionRevisionWithContext(aws.Context, *codepipeline.PutActionRevisionInput, ...aws.Option) (*codepipeline.PutActionRevisionOutput, error)
+
 	PutJobFailureResult(*codepipeline.PutJobFailureResultInput) (*codepipeline.PutJobFailureResultOutput, error)
 
+	PutJobFailureResultWithContext(aws.Context, *codepipeline.PutJobFailureResultInput, ...aws.Option) (*codepipeline.PutJobFailureResultOutput, error)
+
 	PutJobSuccessResult(*codepipeline.PutJobSuccessResultInput) (*codepipeline.PutJobSuccessResultOutput, error)
 
+	PutJobSuccessResultWithContext(aws.Context, *codepipeline.PutJobSuccessResultInput, ...aws.Option) (*codepipeline.PutJobSuccessResultOutput, error)
+
 	PutThirdPartyJobFailureResult(*codepipeline.PutThirdPartyJobFailureResultInput) (*codepipeline.PutThirdPartyJobFailureResultOutput, error)
 
+	PutThirdPartyJobFailureResultWithContext(aws.Context, *codepipeline.PutThirdPartyJobFailureResultInput, ...aws.Option) (*codepipeline.PutThirdPartyJobFailureResultOutput, error)
+
 	PutThirdPartyJobSuccessResult(*codepipeline.PutThirdPartyJobSuccessResultInput) (*codepipeline.PutThirdPartyJobSuccessResultOutput, error)
 
+	PutThirdPartyJobSuccessResultWithContext(aws.Context, *codepipeline.PutThirdPartyJobSuccessResultInput, ...aws.Option) (*codepipeline.PutThirdPartyJobSuccessResultOutput, error)
+
 	StartPipelineExecution(*codepipeline.StartPipelineExecutionInput) (*codepipeline.StartPipelineExecutionOutput, error)
 
+	StartPipelineExecutionWithContext(aws.Context, *codepipeline.StartPipelineExecutionInput, ...aws.Option) (*codepipeline.StartPipelineExecutionOutput, error)
+
 	UpdatePipeline(*codepipeline.UpdatePipelineInput) (*codepipeline.UpdatePipelineOutput, error)
+
+	UpdatePipelineWithContext(aws.Context, *codepipeline.UpdatePipelineInput, ...aws.Option) (*codepipeline.UpdatePipelineOutput, error)
 }
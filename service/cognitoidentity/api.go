@@ -39,6 +39,18 @@ func (c *CognitoIdentity) CreateIdentityPool(input *CreateIdentityPoolInput) (*I
 	return out, err
 }
 
+// CreateIdentityPoolWithContext is the same as CreateIdentityPool with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) CreateIdentityPoolWithContext(ctx aws.Context, input *CreateIdentityPoolInput, opts ...aws.Option) (*IdentityPool, error) {
+	req, out := c.CreateIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteIdentities = "DeleteIdentities"
 
 // DeleteIdentitiesRequest generates a request for the DeleteIdentities operation.
@@ -69,6 +81,18 @@ func (c *CognitoIdentity) DeleteIdentities(input *DeleteIdentitiesInput) (*Delet
 	return out, err
 }
 
+// DeleteIdentitiesWithContext is the same as DeleteIdentities with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) DeleteIdentitiesWithContext(ctx aws.Context, input *DeleteIdentitiesInput, opts ...aws.Option) (*DeleteIdentitiesOutput, error) {
+	req, out := c.DeleteIdentitiesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteIdentityPool = "DeleteIdentityPool"
 
 // DeleteIdentityPoolRequest generates a request for the DeleteIdentityPool operation.
@@ -99,6 +123,18 @@ func (c *CognitoIdentity) DeleteIdentityPool(input *DeleteIdentityPoolInput) (*D
 	return out, err
 }
 
+// DeleteIdentityPoolWithContext is the same as DeleteIdentityPool with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) DeleteIdentityPoolWithContext(ctx aws.Context, input *DeleteIdentityPoolInput, opts ...aws.Option) (*DeleteIdentityPoolOutput, error) {
+	req, out := c.DeleteIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIdentity = "DescribeIdentity"
 
 // DescribeIdentityRequest generates a request for the DescribeIdentity operation.
@@ -129,6 +165,18 @@ func (c *CognitoIdentity) DescribeIdentity(input *DescribeIdentityInput) (*Ident
 	return out, err
 }
 
+// DescribeIdentityWithContext is the same as DescribeIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) DescribeIdentityWithContext(ctx aws.Context, input *DescribeIdentityInput, opts ...aws.Option) (*IdentityDescription, error) {
+	req, out := c.DescribeIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIdentityPool = "DescribeIdentityPool"
 
 // DescribeIdentityPoolRequest generates a request for the DescribeIdentityPool operation.
@@ -159,6 +207,18 @@ func (c *CognitoIdentity) DescribeIdentityPool(input *DescribeIdentityPoolInput)
 	return out, err
 }
 
+// DescribeIdentityPoolWithContext is the same as DescribeIdentityPool with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) DescribeIdentityPoolWithContext(ctx aws.Context, input *DescribeIdentityPoolInput, opts ...aws.Option) (*IdentityPool, error) {
+	req, out := c.DescribeIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCredentialsForIdentity = "GetCredentialsForIdentity"
 
 // GetCredentialsForIdentityRequest generates a request for the GetCredentialsForIdentity operation.
@@ -191,6 +251,18 @@ func (c *CognitoIdentity) GetCredentialsForIdentity(input *GetCredentialsForIden
 	return out, err
 }
 
+// GetCredentialsForIdentityWithContext is the same as GetCredentialsForIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) GetCredentialsForIdentityWithContext(ctx aws.Context, input *GetCredentialsForIdentityInput, opts ...aws.Option) (*GetCredentialsForIdentityOutput, error) {
+	req, out := c.GetCredentialsForIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetID = "GetId"
 
 // GetIDRequest generates a request for the GetID operation.
@@ -223,6 +295,18 @@ func (c *CognitoIdentity) GetID(input *GetIDInput) (*GetIDOutput, error) {
 	return out, err
 }
 
+// GetIDWithContext is the same as GetID with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) GetIDWithContext(ctx aws.Context, input *GetIDInput, opts ...aws.Option) (*GetIDOutput, error) {
+	req, out := c.GetIDRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetIdentityPoolRoles = "GetIdentityPoolRoles"
 
 // GetIdentityPoolRolesRequest generates a request for the GetIdentityPoolRoles operation.
@@ -252,6 +336,18 @@ func (c *CognitoIdentity) GetIdentityPoolRoles(input *GetIdentityPoolRolesInput)
 	return out, err
 }
 
+// GetIdentityPoolRolesWithContext is the same as GetIdentityPoolRoles with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) GetIdentityPoolRolesWithContext(ctx aws.Context, input *GetIdentityPoolRolesInput, opts ...aws.Option) (*GetIdentityPoolRolesOutput, error) {
+	req, out := c.GetIdentityPoolRolesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOpenIDToken = "GetOpenIdToken"
 
 // GetOpenIDTokenRequest generates a request for the GetOpenIDToken operation.
@@ -285,6 +381,18 @@ func (c *CognitoIdentity) GetOpenIDToken(input *GetOpenIDTokenInput) (*GetOpenID
 	return out, err
 }
 
+// GetOpenIDTokenWithContext is the same as GetOpenIDToken with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) GetOpenIDTokenWithContext(ctx aws.Context, input *GetOpenIDTokenInput, opts ...aws.Option) (*GetOpenIDTokenOutput, error) {
+	req, out := c.GetOpenIDTokenRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOpenIDTokenForDeveloperIdentity = "GetOpenIdTokenForDeveloperIdentity"
 
 // GetOpenIDTokenForDeveloperIdentityRequest generates a request for the GetOpenIDTokenForDeveloperIdentity operation.
@@ -327,6 +435,18 @@ func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentity(input *GetOpenIDTok
 	return out, err
 }
 
+// GetOpenIDTokenForDeveloperIdentityWithContext is the same as GetOpenIDTokenForDeveloperIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentityWithContext(ctx aws.Context, input *GetOpenIDTokenForDeveloperIdentityInput, opts ...aws.Option) (*GetOpenIDTokenForDeveloperIdentityOutput, error) {
+	req, out := c.GetOpenIDTokenForDeveloperIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListIdentities = "ListIdentities"
 
 // ListIdentitiesRequest generates a request for the ListIdentities operation.
@@ -356,6 +476,18 @@ func (c *CognitoIdentity) ListIdentities(input *ListIdentitiesInput) (*ListIdent
 	return out, err
 }
 
+// ListIdentitiesWithContext is the same as ListIdentities with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) ListIdentitiesWithContext(ctx aws.Context, input *ListIdentitiesInput, opts ...aws.Option) (*ListIdentitiesOutput, error) {
+	req, out := c.ListIdentitiesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListIdentityPools = "ListIdentityPools"
 
 // ListIdentityPoolsRequest generates a request for the ListIdentityPools operation.
@@ -385,6 +517,18 @@ func (c *CognitoIdentity) ListIdentityPools(input *ListIdentityPoolsInput) (*Lis
 	return out, err
 }
 
+// ListIdentityPoolsWithContext is the same as ListIdentityPools with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) ListIdentityPoolsWithContext(ctx aws.Context, input *ListIdentityPoolsInput, opts ...aws.Option) (*ListIdentityPoolsOutput, error) {
+	req, out := c.ListIdentityPoolsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opLookupDeveloperIdentity = "LookupDeveloperIdentity"
 
 // LookupDeveloperIdentityRequest generates a request for the LookupDeveloperIdentity operation.
@@ -421,6 +565,18 @@ func (c *CognitoIdentity) LookupDeveloperIdentity(input *LookupDeveloperIdentity
 	return out, err
 }
 
+// LookupDeveloperIdentityWithContext is the same as LookupDeveloperIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) LookupDeveloperIdentityWithContext(ctx aws.Context, input *LookupDeveloperIdentityInput, opts ...aws.Option) (*LookupDeveloperIdentityOutput, error) {
+	req, out := c.LookupDeveloperIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opMergeDeveloperIdentities = "MergeDeveloperIdentities"
 
 // MergeDeveloperIdentitiesRequest generates a request for the MergeDeveloperIdentities operation.
@@ -456,6 +612,18 @@ func (c *CognitoIdentity) MergeDeveloperIdentities(input *MergeDeveloperIdentiti
 	return out, err
 }
 
+// MergeDeveloperIdentitiesWithContext is the same as MergeDeveloperIdentities with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) MergeDeveloperIdentitiesWithContext(ctx aws.Context, input *MergeDeveloperIdentitiesInput, opts ...aws.Option) (*MergeDeveloperIdentitiesOutput, error) {
+	req, out := c.MergeDeveloperIdentitiesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetIdentityPoolRoles = "SetIdentityPoolRoles"
 
 // SetIdentityPoolRolesRequest generates a request for the SetIdentityPoolRoles operation.
@@ -486,6 +654,18 @@ func (c *CognitoIdentity) SetIdentityPoolRoles(input *SetIdentityPoolRolesInput)
 	return out, err
 }
 
+// SetIdentityPoolRolesWithContext is the same as SetIdentityPoolRoles with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) SetIdentityPoolRolesWithContext(ctx aws.Context, input *SetIdentityPoolRolesInput, opts ...aws.Option) (*SetIdentityPoolRolesOutput, error) {
+	req, out := c.SetIdentityPoolRolesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUnlinkDeveloperIdentity = "UnlinkDeveloperIdentity"
 
 // UnlinkDeveloperIdentityRequest generates a request for the UnlinkDeveloperIdentity operation.
@@ -518,6 +698,18 @@ func (c *CognitoIdentity) UnlinkDeveloperIdentity(input *UnlinkDeveloperIdentity
 	return out, err
 }
 
+// UnlinkDeveloperIdentityWithContext is the same as UnlinkDeveloperIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) UnlinkDeveloperIdentityWithContext(ctx aws.Context, input *UnlinkDeveloperIdentityInput, opts ...aws.Option) (*UnlinkDeveloperIdentityOutput, error) {
+	req, out := c.UnlinkDeveloperIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUnlinkIdentity = "UnlinkIdentity"
 
 // UnlinkIdentityRequest generates a request for the UnlinkIdentity operation.
@@ -549,6 +741,18 @@ func (c *CognitoIdentity) UnlinkIdentity(input *UnlinkIdentityInput) (*UnlinkIde
 	return out, err
 }
 
+// UnlinkIdentityWithContext is the same as UnlinkIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) UnlinkIdentityWithContext(ctx aws.Context, input *UnlinkIdentityInput, opts ...aws.Option) (*UnlinkIdentityOutput, error) {
+	req, out := c.UnlinkIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateIdentityPool = "UpdateIdentityPool"
 
 // UpdateIdentityPoolRequest generates a request for the UpdateIdentityPool operation.
@@ -578,6 +782,18 @@ func (c *CognitoIdentity) UpdateIdentityPool(input *IdentityPool) (*IdentityPool
 	return out, err
 }
 
+// UpdateIdentityPoolWithContext is the same as UpdateIdentityPool with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *CognitoIdentity) UpdateIdentityPoolWithContext(ctx aws.Context, input *IdentityPool, opts ...aws.Option) (*IdentityPool, error) {
+	req, out := c.UpdateIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Input to the CreateIdentityPool action.
 type CreateIdentityPoolInput struct {
 	// TRUE if the identity pool supports unauthenticated logins.
@@ -590,16 +806,16 @@ type CreateIdentityPoolInput struct {
 	//
 	// Once you have set a developer provider name, you cannot change it. Please
 	// take care in setting this parameter.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders map[string]*string `type:"map"`
+	SupportedLoginProviders map[string]*string `type:"map" max:"10"`
 
 	metadataCreateIdentityPoolInput `json:"-" xml:"-"`
 }
@@ -652,7 +868,7 @@ func (s Credentials) GoString() string {
 // Input to the DeleteIdentities action.
 type DeleteIdentitiesInput struct {
 	// A list of 1-60 identities that you want to delete.
-	IdentityIDsToDelete []*string `locationName:"IdentityIdsToDelete" type:"list" required:"true"`
+	IdentityIDsToDelete []*string `locationName:"IdentityIdsToDelete" type:"list" min:"1" max:"60" required:"true"`
 
 	metadataDeleteIdentitiesInput `json:"-" xml:"-"`
 }
@@ -675,7 +891,7 @@ func (s DeleteIdentitiesInput) GoString() string {
 type DeleteIdentitiesOutput struct {
 	// An array of UnprocessedIdentityId objects, each of which contains an ErrorCode
 	// and IdentityId.
-	UnprocessedIdentityIDs []*UnprocessedIdentityID `locationName:"UnprocessedIdentityIds" type:"list"`
+	UnprocessedIdentityIDs []*UnprocessedIdentityID `locationName:"UnprocessedIdentityIds" type:"list" max:"60"`
 
 	metadataDeleteIdentitiesOutput `json:"-" xml:"-"`
 }
@@ -697,7 +913,7 @@ func (s DeleteIdentitiesOutput) GoString() string {
 // Input to the DeleteIdentityPool action.
 type DeleteIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	metadataDeleteIdentityPoolInput `json:"-" xml:"-"`
 }
@@ -737,7 +953,7 @@ func (s DeleteIdentityPoolOutput) GoString() string {
 // Input to the DescribeIdentity action.
 type DescribeIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" required:"true"`
 
 	metadataDescribeIdentityInput `json:"-" xml:"-"`
 }
@@ -759,7 +975,7 @@ func (s DescribeIdentityInput) GoString() string {
 // Input to the DescribeIdentityPool action.
 type DescribeIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	metadataDescribeIdentityPoolInput `json:"-" xml:"-"`
 }
@@ -781,10 +997,10 @@ func (s DescribeIdentityPoolInput) GoString() string {
 // Input to the GetCredentialsForIdentity action.
 type GetCredentialsForIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins map[string]*string `type:"map"`
+	Logins map[string]*string `type:"map" max:"10"`
 
 	metadataGetCredentialsForIdentityInput `json:"-" xml:"-"`
 }
@@ -809,7 +1025,7 @@ type GetCredentialsForIdentityOutput struct {
 	Credentials *Credentials `type:"structure"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	metadataGetCredentialsForIdentityOutput `json:"-" xml:"-"`
 }
@@ -831,17 +1047,17 @@ func (s GetCredentialsForIdentityOutput) GoString() string {
 // Input to the GetId action.
 type GetIDInput struct {
 	// A standard AWS account ID (9+ digits).
-	AccountID *string `locationName:"AccountId" type:"string"`
+	AccountID *string `locationName:"AccountId" type:"string" min:"1" max:"15"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	//
 	// The available provider names for Logins are as follows:  Facebook: graph.facebook.com
 	//  Google: accounts.google.com  Amazon: www.amazon.com  Twitter: www.twitter.com
 	//  Digits: www.digits.com
-	Logins map[string]*string `type:"map"`
+	Logins map[string]*string `type:"map" max:"10"`
 
 	metadataGetIDInput `json:"-" xml:"-"`
 }
@@ -863,7 +1079,7 @@ func (s GetIDInput) GoString() string {
 // Returned in response to a GetId request.
 type GetIDOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	metadataGetIDOutput `json:"-" xml:"-"`
 }
@@ -885,7 +1101,7 @@ func (s GetIDOutput) GoString() string {
 // Input to the GetIdentityPoolRoles action.
 type GetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	metadataGetIdentityPoolRolesInput `json:"-" xml:"-"`
 }
@@ -907,11 +1123,11 @@ func (s GetIdentityPoolRolesInput) GoString() string {
 // Returned in response to a successful GetIdentityPoolRoles operation.
 type GetIdentityPoolRolesOutput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50"`
 
 	// The map of roles associated with this pool. Currently only authenticated
 	// and unauthenticated roles are supported.
-	Roles map[string]*string `type:"map"`
+	Roles map[string]*string `type:"map" max:"2"`
 
 	metadataGetIdentityPoolRolesOutput `json:"-" xml:"-"`
 }
@@ -933,10 +1149,10 @@ func (s GetIdentityPoolRolesOutput) GoString() string {
 // Input to the GetOpenIdTokenForDeveloperIdentity action.
 type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	// Each name-value pair represents a user from a public provider or developer
@@ -947,7 +1163,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// The developer user identifier is an identifier from your backend that uniquely
 	// identifies a user. When you create an identity pool, you can specify the
 	// supported logins.
-	Logins map[string]*string `type:"map" required:"true"`
+	Logins map[string]*string `type:"map" max:"10" required:"true"`
 
 	// The expiration time of the token, in seconds. You can specify a custom expiration
 	// time for the token so that you can cache it. If you don't provide an expiration
@@ -957,7 +1173,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// take care in setting the expiration time for a token, as there are significant
 	// security implications: an attacker could use a leaked token to access your
 	// AWS resources for the token's duration.
-	TokenDuration *int64 `type:"long"`
+	TokenDuration *int64 `type:"long" min:"1" max:"86400"`
 
 	metadataGetOpenIDTokenForDeveloperIdentityInput `json:"-" xml:"-"`
 }
@@ -979,7 +1195,7 @@ func (s GetOpenIDTokenForDeveloperIdentityInput) GoString() string {
 // Returned in response to a successful GetOpenIdTokenForDeveloperIdentity request.
 type GetOpenIDTokenForDeveloperIdentityOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	// An OpenID token.
 	Token *string `type:"string"`
@@ -1004,13 +1220,13 @@ func (s GetOpenIDTokenForDeveloperIdentityOutput) GoString() string {
 // Input to the GetOpenIdToken action.
 type GetOpenIDTokenInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	// When using graph.facebook.com and www.amazon.com, supply the access_token
 	// returned from the provider's authflow. For accounts.google.com or any other
 	// OpenId Connect provider, always include the id_token.
-	Logins map[string]*string `type:"map"`
+	Logins map[string]*string `type:"map" max:"10"`
 
 	metadataGetOpenIDTokenInput `json:"-" xml:"-"`
 }
@@ -1033,7 +1249,7 @@ func (s GetOpenIDTokenInput) GoString() string {
 type GetOpenIDTokenOutput struct {
 	// A unique identifier in the format REGION:GUID. Note that the IdentityId returned
 	// may not match the one passed on input.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	// An OpenID token, valid for 15 minutes.
 	Token *string `type:"string"`
@@ -1061,7 +1277,7 @@ type IdentityDescription struct {
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	// Date on which the identity was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1092,19 +1308,19 @@ type IdentityPool struct {
 	AllowUnauthenticatedIdentities *bool `type:"boolean" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders map[string]*string `type:"map"`
+	SupportedLoginProviders map[string]*string `type:"map" max:"10"`
 
 	metadataIdentityPool `json:"-" xml:"-"`
 }
@@ -1126,10 +1342,10 @@ func (s IdentityPool) GoString() string {
 // A description of the identity pool.
 type IdentityPoolShortDescription struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128"`
 
 	metadataIdentityPoolShortDescription `json:"-" xml:"-"`
 }
@@ -1156,13 +1372,13 @@ type ListIdentitiesInput struct {
 	HideDisabled *bool `type:"boolean"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1"`
 
 	metadataListIdentitiesInput `json:"-" xml:"-"`
 }
@@ -1187,10 +1403,10 @@ type ListIdentitiesOutput struct {
 	Identities []*IdentityDescription `type:"list"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1"`
 
 	metadataListIdentitiesOutput `json:"-" xml:"-"`
 }
@@ -1212,10 +1428,10 @@ func (s ListIdentitiesOutput) GoString() string {
 // Input to the ListIdentityPools action.
 type ListIdentityPoolsInput struct {
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1"`
 
 	metadataListIdentityPoolsInput `json:"-" xml:"-"`
 }
@@ -1240,7 +1456,7 @@ type ListIdentityPoolsOutput struct {
 	IdentityPools []*IdentityPoolShortDescription `type:"list"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1"`
 
 	metadataListIdentityPoolsOutput `json:"-" xml:"-"`
 }
@@ -1264,16 +1480,16 @@ type LookupDeveloperIdentityInput struct {
 	// A unique ID used by your backend authentication process to identify a user.
 	// Typically, a developer identity provider would issue many developer user
 	// identifiers, in keeping with the number of users.
-	DeveloperUserIdentifier *string `type:"string"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -1281,7 +1497,7 @@ type LookupDeveloperIdentityInput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1"`
 
 	metadataLookupDeveloperIdentityInput `json:"-" xml:"-"`
 }
@@ -1308,7 +1524,7 @@ type LookupDeveloperIdentityOutput struct {
 	DeveloperUserIdentifierList []*string `type:"list"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -1316,7 +1532,7 @@ type LookupDeveloperIdentityOutput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1"`
 
 	metadataLookupDeveloperIdentityOutput `json:"-" xml:"-"`
 }
@@ -1338,20 +1554,20 @@ func (s LookupDeveloperIdentityOutput) GoString() string {
 // Input to the MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesInput struct {
 	// User identifier for the destination user. The value should be a DeveloperUserIdentifier.
-	DestinationUserIdentifier *string `type:"string" required:"true"`
+	DestinationUserIdentifier *string `type:"string" min:"1" max:"1024" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users. This is a (pseudo)
 	// domain name that you provide while creating an identity pool. This name acts
 	// as a placeholder that allows your backend and the Cognito service to communicate
 	// about the developer provider. For the DeveloperProviderName, you can use
 	// letters as well as period (.), underscore (_), and dash (-).
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	// User identifier for the source user. The value should be a DeveloperUserIdentifier.
-	SourceUserIdentifier *string `type:"string" required:"true"`
+	SourceUserIdentifier *string `type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataMergeDeveloperIdentitiesInput `json:"-" xml:"-"`
 }
@@ -1373,7 +1589,7 @@ func (s MergeDeveloperIdentitiesInput) GoString() string {
 // Returned in response to a successful MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	metadataMergeDeveloperIdentitiesOutput `json:"-" xml:"-"`
 }
@@ -1395,12 +1611,12 @@ func (s MergeDeveloperIdentitiesOutput) GoString() string {
 // Input to the SetIdentityPoolRoles action.
 type SetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	// The map of roles associated with this pool. For a given role, the key will
 	// be either "authenticated" or "unauthenticated" and the value will be the
 	// Role ARN.
-	Roles map[string]*string `type:"map" required:"true"`
+	Roles map[string]*string `type:"map" max:"2" required:"true"`
 
 	metadataSetIdentityPoolRolesInput `json:"-" xml:"-"`
 }
@@ -1440,16 +1656,16 @@ func (s SetIdentityPoolRolesOutput) GoString() string {
 // Input to the UnlinkDeveloperIdentity action.
 type UnlinkDeveloperIdentityInput struct {
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A unique ID used by your backend authentication process to identify a user.
-	DeveloperUserIdentifier *string `type:"string" required:"true"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024" required:"true"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" required:"true"`
 
 	metadataUnlinkDeveloperIdentityInput `json:"-" xml:"-"`
 }
@@ -1489,10 +1705,10 @@ func (s UnlinkDeveloperIdentityOutput) GoString() string {
 // Input to the UnlinkIdentity action.
 type UnlinkIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins map[string]*string `type:"map" required:"true"`
+	Logins map[string]*string `type:"map" max:"10" required:"true"`
 
 	// Provider names to unlink from this identity.
 	LoginsToRemove []*string `type:"list" required:"true"`
@@ -1539,7 +1755,7 @@ type UnprocessedIdentityID struct {
 	ErrorCode *string `type:"string"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50"`
 
 	metadataUnprocessedIdentityID `json:"-" xml:"-"`
 }
@@ -4,6 +4,7 @@
 package cognitoidentityiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 )
 
@@ -11,37 +12,73 @@ import (
 type CognitoIdentityAPI interface {
 	CreateIdentityPool(*cognitoidentity.CreateIdentityPoolInput) (*cognitoidentity.IdentityPool, error)
 
+	CreateIdentityPoolWithContext(aws.Context, *cognitoidentity.CreateIdentityPoolInput, ...aws.Option) (*cognitoidentity.IdentityPool, error)
+
 	DeleteIdentities(*cognitoidentity.DeleteIdentitiesInput) (*cognitoidentity.DeleteIdentitiesOutput, error)
 
+	DeleteIdentitiesWithContext(aws.Context, *cognitoidentity.DeleteIdentitiesInput, ...aws.Option) (*cognitoidentity.DeleteIdentitiesOutput, error)
+
 	DeleteIdentityPool(*cognitoidentity.DeleteIdentityPoolInput) (*cognitoidentity.DeleteIdentityPoolOutput, error)
 
+	DeleteIdentityPoolWithContext(aws.Context, *cognitoidentity.DeleteIdentityPoolInput, ...aws.Option) (*cognitoidentity.DeleteIdentityPoolOutput, error)
+
 	DescribeIdentity(*cognitoidentity.DescribeIdentityInput) (*cognitoidentity.IdentityDescription, error)
 
+	DescribeIdentityWithContext(aws.Context, *cognitoidentity.DescribeIdentityInput, ...aws.Option) (*cognitoidentity.IdentityDescription, error)
+
 	DescribeIdentityPool(*cognitoidentity.DescribeIdentityPoolInput) (*cognitoidentity.IdentityPool, error)
 
+	DescribeIdentityPoolWithContext(aws.Context, *cognitoidentity.DescribeIdentityPoolInput, ...aws.Option) (*cognitoidentity.IdentityPool, error)
+
 	GetCredentialsForIdentity(*cognitoidentity.GetCredentialsForIdentityInput) (*cognitoidentity.GetCredentialsForIdentityOutput, error)
 
+	GetCredentialsForIdentityWithContext(aws.Context, *cognitoidentity.GetCredentialsForIdentityInput, ...aws.Option) (*cognitoidentity.GetCredentialsForIdentityOutput, error)
+
 	GetID(*cognitoidentity.GetIDInput) (*cognitoidentity.GetIDOutput, error)
 
+	GetIDWithContext(aws.Context, *cognitoidentity.GetIDInput, ...aws.Option) (*cognitoidentity.GetIDOutput, error)
+
 	GetIdentityPoolRoles(*cognitoidentity.GetIdentityPoolRolesInput) (*cognitoidentity.GetIdentityPoolRolesOutput, error)
 
+	GetIdentityPoolRolesWithContext(aws.Context, *cognitoidentity.GetIdentityPoolRolesInput, ...aws.Option) (*cognitoidentity.GetIdentityPoolRolesOutput, error)
+
 	GetOpenIDToken(*cognitoidentity.GetOpenIDTokenInput) (*cognitoidentity.GetOpenIDTokenOutput, error)
 
+	GetOpenIDTokenWithContext(aws.Context, *cognitoidentity.GetOpenIDTokenInput, ...aws.Option) (*cognitoidentity.GetOpenIDTokenOutput, error)
+
 	GetOpenIDTokenForDeveloperIdentity(*cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput) (*cognitoidentity.GetOpenIDTokenForDeveloperIdentityOutput, error)
 
+	GetOpenIDTokenForDeveloperIdentityWithContext(aws.Context, *cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput, ...aws.Option) (*cognitoidentity.GetOpenIDTokenForDeveloperIdentityOutput, error)
+
 	ListIdentities(*cognitoidentity.ListIdentitiesInput) (*cognitoidentity.ListIdentitiesOutput, error)
 
+	ListIdentitiesWithContext(aws.Context, *cognitoidentity.ListIdentitiesInput, ...aws.Option) (*cognitoidentity.ListIdentitiesOutput, error)
+
 	ListIdentityPools(*cognitoidentity.ListIdentityPoolsInput) (*cognitoidentity.ListIdentityPoolsOutput, error)
 
+	ListIdentityPoolsWithContext(aws.Context, *cognitoidentity.ListIdentityPoolsInput, ...aws.Option) (*cognitoidentity.ListIdentityPoolsOutput, error)
+
 	LookupDeveloperIdentity(*cognitoidentity.LookupDeveloperIdentityInput) (*cognitoidentity.LookupDeveloperIdentityOutput, error)
 
+	LookupDeveloperIdentityWithContext(aws.Context, *cognitoidentity.LookupDeveloperIdentityInput, ...aws.Option) (*cognitoidentity.LookupDeveloperIdentityOutput, error)
+
 	MergeDeveloperIdentities(*cognitoidentity.MergeDeveloperIdentitiesInput) (*cognitoidentity.MergeDeveloperIdentitiesOutput, error)
 
+	MergeDeveloperIdentitiesWithContext(aws.Context, *cognitoidentity.MergeDeveloperIdentitiesInput, ...aws.Option) (*cognitoidentity.MergeDeveloperIdentitiesOutput, error)
+
 	SetIdentityPoolRoles(*cognitoidentity.SetIdentityPoolRolesInput) (*cognitoidentity.SetIdentityPoolRolesOutput, error)
 
+	SetIdentityPoolRolesWithContext(aws.Context, *cognitoidentity.SetIdentityPoolRolesInput, ...aws.Option) (*cognitoidentity.SetIdentityPoolRolesOutput, error)
+
 	UnlinkDeveloperIdentity(*cognitoidentity.UnlinkDeveloperIdentityInput) (*cognitoidentity.UnlinkDeveloperIdentityOutput, error)
 
+	UnlinkDeveloperIdentityWithContext(aws.Context, *cognitoidentity.UnlinkDeveloperIdentityInput, ...aws.Option) (*cognitoidentity.UnlinkDeveloperIdentityOutput, error)
+
 	UnlinkIdentity(*cognitoidentity.UnlinkIdentityInput) (*cognitoidentity.UnlinkIdentityOutput, error)
 
+	UnlinkIdentityWithContext(aws.Context, *cognitoidentity.UnlinkIdentityInput, ...aws.Option) (*cognitoidentity.UnlinkIdentityOutput, error)
+
 	UpdateIdentityPool(*cognitoidentity.IdentityPool) (*cognitoidentity.IdentityPool, error)
+
+	UpdateIdentityPoolWithContext(aws.Context, *cognitoidentity.IdentityPool, ...aws.Option) (*cognitoidentity.IdentityPool, error)
 }
@@ -4,6 +4,7 @@
 package cognitosynciface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitosync"
 )
 
@@ -11,35 +12,69 @@ import (
 type CognitoSyncAPI interface {
 	BulkPublish(*cognitosync.BulkPublishInput) (*cognitosync.BulkPublishOutput, error)
 
+	BulkPublishWithContext(aws.Context, *cognitosync.BulkPublishInput, ...aws.Option) (*cognitosync.BulkPublishOutput, error)
+
 	DeleteDataset(*cognitosync.DeleteDatasetInput) (*cognitosync.DeleteDatasetOutput, error)
 
+	DeleteDatasetWithContext(aws.Context, *cognitosync.DeleteDatasetInput, ...aws.Option) (*cognitosync.DeleteDatasetOutput, error)
+
 	DescribeDataset(*cognitosync.DescribeDatasetInput) (*cognitosync.DescribeDatasetOutput, error)
 
+	DescribeDatasetWithContext(aws.Context, *cognitosync.DescribeDatasetInput, ...aws.Option) (*cognitosync.DescribeDatasetOutput, error)
+
 	DescribeIdentityPoolUsage(*cognitosync.DescribeIdentityPoolUsageInput) (*cognitosync.DescribeIdentityPoolUsageOutput, error)
 
+	DescribeIdentityPoolUsageWithContext(aws.Context, *cognitosync.DescribeIdentityPoolUsageInput, ...aws.Option) (*cognitosync.DescribeIdentityPoolUsageOutput, error)
+
 	DescribeIdentityUsage(*cognitosync.DescribeIdentityUsageInput) (*cognitosync.DescribeIdentityUsageOutput, error)
 
+	DescribeIdentityUsageWithContext(aws.Context, *cognitosync.DescribeIdentityUsageInput, ...aws.Option) (*cognitosync.DescribeIdentityUsageOutput, error)
+
 	GetBulkPublishDetails(*cognitosync.GetBulkPublishDetailsInput) (*cognitosync.GetBulkPublishDetailsOutput, error)
 
+	GetBulkPublishDetailsWithContext(aws.Context, *cognitosync.GetBulkPublishDetailsInput, ...aws.Option) (*cognitosync.GetBulkPublishDetailsOutput, error)
+
 	GetCognitoEvents(*cognitosync.GetCognitoEventsInput) (*cognitosync.GetCognitoEventsOutput, error)
 
+	GetCognitoEventsWithContext(aws.Context, *cognitosync.GetCognitoEventsInput, ...aws.Option) (*cognitosync.GetCognitoEventsOutput, error)
+
 	GetIdentityPoolConfiguration(*cognitosync.GetIdentityPoolConfigurationInput) (*cognitosync.GetIdentityPoolConfigurationOutput, error)
 
+	GetIdentityPoolConfigurationWithContext(aws.Context, *cognitosync.GetIdentityPoolConfigurationInput, ...aws.Option) (*cognitosync.GetIdentityPoolConfigurationOutput, error)
+
 	ListDatasets(*cognitosync.ListDatasetsInput) (*cognitosync.ListDatasetsOutput, error)
 
+	ListDatasetsWithContext(aws.Context, *cognitosync.ListDatasetsInput, ...aws.Option) (*cognitosync.ListDatasetsOutput, error)
+
 	ListIdentityPoolUsage(*cognitosync.ListIdentityPoolUsageInput) (*cognitosync.ListIdentityPoolUsageOutput, error)
 
+	ListIdentityPoolUsageWithContext(aws.Context, *cognitosync.ListIdentityPoolUsageInput, ...aws.Option) (*cognitosync.ListIdentityPoolUsageOutput, error)
+
 	ListRecords(*cognitosync.ListRecordsInput) (*cognitosync.ListRecordsOutput, error)
 
+	ListRecordsWithContext(aws.Context, *cognitosync.ListRecordsInput, ...aws.Option) (*cognitosync.ListRecordsOutput, error)
+
 	RegisterDevice(*cognitosync.RegisterDeviceInput) (*cognitosync.RegisterDeviceOutput, error)
 
+	RegisterDeviceWithContext(aws.Context, *cognitosync.RegisterDeviceInput, ...aws.Option) (*cognitosync.RegisterDeviceOutput, error)
+
 	SetCognitoEvents(*cognitosync.SetCognitoEventsInput) (*cognitosync.SetCognitoEventsOutput, error)
 
+	SetCognitoEventsWithContext(aws.Context, *cognitosync.SetCognitoEventsInput, ...aws.Option) (*cognitosync.SetCognitoEventsOutput, error)
+
 	SetIdentityPoolConfiguration(*cognitosync.SetIdentityPoolConfigurationInput) (*cognitosync.SetIdentityPoolConfigurationOutput, error)
 
+	SetIdentityPoolConfigurationWithContext(aws.Context, *cognitosync.SetIdentityPoolConfigurationInput, ...aws.Option) (*cognitosync.SetIdentityPoolConfigurationOutput, error)
+
 	SubscribeToDataset(*cognitosync.SubscribeToDatasetInput) (*cognitosync.SubscribeToDatasetOutput, error)
 
+	SubscribeToDatasetWithContext(aws.Context, *cognitosync.SubscribeToDatasetInput, ...aws.Option) (*cognitosync.SubscribeToDatasetOutput, error)
+
 	UnsubscribeFromDataset(*cognitosync.UnsubscribeFromDatasetInput) (*cognitosync.UnsubscribeFromDatasetOutput, error)
 
+	UnsubscribeFromDatasetWithContext(aws.Context, *cognitosync.UnsubscribeFromDatasetInput, ...aws.Option) (*cognitosync.UnsubscribeFromDatasetOutput, error)
+
 	UpdateRecords(*cognitosync.UpdateRecordsInput) (*cognitosync.UpdateRecordsOutput, error)
+
+	UpdateRecordsWithContext(aws.Context, *cognitosync.UpdateRecordsInput, ...aws.Option) (*cognitosync.UpdateRecordsOutput, error)
 }
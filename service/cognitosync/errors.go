@@ -0,0 +1,84 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package cognitosync
+
+const (
+	// ErrCodeAlreadyStreamedException for service response error code
+	// "AlreadyStreamed".
+	//
+	// An exception thrown when a bulk publish operation is requested less than
+	// 24 hours after a previous bulk publish operation completed successfully.
+	ErrCodeAlreadyStreamedException = "AlreadyStreamed"
+
+	// ErrCodeConcurrentModificationException for service response error code
+	// "ConcurrentModification".
+	//
+	// Thrown if there are parallel requests to modify a resource.
+	ErrCodeConcurrentModificationException = "ConcurrentModification"
+
+	// ErrCodeDuplicateRequestException for service response error code
+	// "DuplicateRequest".
+	//
+	// An exception thrown when there is an IN_PROGRESS bulk publish operation for
+	// the given identity pool.
+	ErrCodeDuplicateRequestException = "DuplicateRequest"
+
+	// ErrCodeInternalErrorException for service response error code
+	// "InternalError".
+	//
+	// Indicates an internal service error.
+	ErrCodeInternalErrorException = "InternalError"
+
+	// ErrCodeInvalidConfigurationException for service response error code
+	// "InvalidConfiguration".
+	ErrCodeInvalidConfigurationException = "InvalidConfiguration"
+
+	// ErrCodeInvalidLambdaFunctionOutputException for service response error code
+	// "InvalidLambdaFunctionOutput".
+	//
+	// The AWS Lambda function returned invalid output or an exception.
+	ErrCodeInvalidLambdaFunctionOutputException = "InvalidLambdaFunctionOutput"
+
+	// ErrCodeInvalidParameterException for service response error code
+	// "InvalidParameter".
+	//
+	// Thrown when a request parameter does not comply with the associated constraints.
+	ErrCodeInvalidParameterException = "InvalidParameter"
+
+	// ErrCodeLambdaThrottledException for service response error code
+	// "LambdaThrottled".
+	//
+	// AWS Lambda throttled your account, please contact AWS Support
+	ErrCodeLambdaThrottledException = "LambdaThrottled"
+
+	// ErrCodeLimitExceededException for service response error code
+	// "LimitExceeded".
+	//
+	// Thrown when the limit on the number of objects or operations has been exceeded.
+	ErrCodeLimitExceededException = "LimitExceeded"
+
+	// ErrCodeNotAuthorizedException for service response error code
+	// "NotAuthorizedError".
+	//
+	// Thrown when a user is not authorized to access the requested resource.
+	ErrCodeNotAuthorizedException = "NotAuthorizedError"
+
+	// ErrCodeResourceConflictException for service response error code
+	// "ResourceConflict".
+	//
+	// Thrown if an update can't be applied because the resource was changed by
+	// another call and this would result in a conflict.
+	ErrCodeResourceConflictException = "ResourceConflict"
+
+	// ErrCodeResourceNotFoundException for service response error code
+	// "ResourceNotFound".
+	//
+	// Thrown if the resource doesn't exist.
+	ErrCodeResourceNotFoundException = "ResourceNotFound"
+
+	// ErrCodeTooManyRequestsException for service response error code
+	// "TooManyRequests".
+	//
+	// Thrown if the request is throttled.
+	ErrCodeTooManyRequestsException = "TooManyRequests"
+)
@@ -42,6 +42,18 @@ func (c *ConfigService) DeleteDeliveryChannel(input *DeleteDeliveryChannelInput)
 	return out, err
 }
 
+// DeleteDeliveryChannelWithContext is the same as DeleteDeliveryChannel with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) DeleteDeliveryChannelWithContext(ctx aws.Context, input *DeleteDeliveryChannelInput, opts ...aws.Option) (*DeleteDeliveryChannelOutput, error) {
+	req, out := c.DeleteDeliveryChannelRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeliverConfigSnapshot = "DeliverConfigSnapshot"
 
 // DeliverConfigSnapshotRequest generates a request for the DeliverConfigSnapshot operation.
@@ -75,6 +87,18 @@ func (c *ConfigService) DeliverConfigSnapshot(input *DeliverConfigSnapshotInput)
 	return out, err
 }
 
+// DeliverConfigSnapshotWithContext is the same as DeliverConfigSnapshot with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) DeliverConfigSnapshotWithContext(ctx aws.Context, input *DeliverConfigSnapshotInput, opts ...aws.Option) (*DeliverConfigSnapshotOutput, error) {
+	req, out := c.DeliverConfigSnapshotRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationRecorderStatus = "DescribeConfigurationRecorderStatus"
 
 // DescribeConfigurationRecorderStatusRequest generates a request for the DescribeConfigurationRecorderStatus operation.
@@ -106,6 +130,18 @@ func (c *ConfigService) DescribeConfigurationRecorderStatus(input *DescribeConfi
 	return out, err
 }
 
+// DescribeConfigurationRecorderStatusWithContext is the same as DescribeConfigurationRecorderStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) DescribeConfigurationRecorderStatusWithContext(ctx aws.Context, input *DescribeConfigurationRecorderStatusInput, opts ...aws.Option) (*DescribeConfigurationRecorderStatusOutput, error) {
+	req, out := c.DescribeConfigurationRecorderStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationRecorders = "DescribeConfigurationRecorders"
 
 // DescribeConfigurationRecordersRequest generates a request for the DescribeConfigurationRecorders operation.
@@ -137,6 +173,18 @@ func (c *ConfigService) DescribeConfigurationRecorders(input *DescribeConfigurat
 	return out, err
 }
 
+// DescribeConfigurationRecordersWithContext is the same as DescribeConfigurationRecorders with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) DescribeConfigurationRecordersWithContext(ctx aws.Context, input *DescribeConfigurationRecordersInput, opts ...aws.Option) (*DescribeConfigurationRecordersOutput, error) {
+	req, out := c.DescribeConfigurationRecordersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDeliveryChannelStatus = "DescribeDeliveryChannelStatus"
 
 // DescribeDeliveryChannelStatusRequest generates a request for the DescribeDeliveryChannelStatus operation.
@@ -168,6 +216,18 @@ func (c *ConfigService) DescribeDeliveryChannelStatus(input *DescribeDeliveryCha
 	return out, err
 }
 
+// DescribeDeliveryChannelStatusWithContext is the same as DescribeDeliveryChannelStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) DescribeDeliveryChannelStatusWithContext(ctx aws.Context, input *DescribeDeliveryChannelStatusInput, opts ...aws.Option) (*DescribeDeliveryChannelStatusOutput, error) {
+	req, out := c.DescribeDeliveryChannelStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDeliveryChannels = "DescribeDeliveryChannels"
 
 // DescribeDeliveryChannelsRequest generates a request for the DescribeDeliveryChannels operation.
@@ -199,6 +259,18 @@ func (c *ConfigService) DescribeDeliveryChannels(input *DescribeDeliveryChannels
 	return out, err
 }
 
+// DescribeDeliveryChannelsWithContext is the same as DescribeDeliveryChannels with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) DescribeDeliveryChannelsWithContext(ctx aws.Context, input *DescribeDeliveryChannelsInput, opts ...aws.Option) (*DescribeDeliveryChannelsOutput, error) {
+	req, out := c.DescribeDeliveryChannelsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetResourceConfigHistory = "GetResourceConfigHistory"
 
 // GetResourceConfigHistoryRequest generates a request for the GetResourceConfigHistory operation.
@@ -212,6 +284,7 @@ func (c *ConfigService) GetResourceConfigHistoryRequest(input *GetResourceConfig
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"configurationItems"},
 		},
 	}
 
@@ -240,6 +313,22 @@ func (c *ConfigService) GetResourceConfigHistory(input *GetResourceConfigHistory
 	return out, err
 }
 
+// GetResourceConfigHistoryWithContext is the same as GetResourceConfigHistory with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) GetResourceConfigHistoryWithContext(ctx aws.Context, input *GetResourceConfigHistoryInput, opts ...aws.Option) (*GetResourceConfigHistoryOutput, error) {
+	req, out := c.GetResourceConfigHistoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// GetResourceConfigHistoryPages iterates over the pages of a GetResourceConfigHistory operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ConfigService) GetResourceConfigHistoryPages(input *GetResourceConfigHistoryInput, fn func(p *GetResourceConfigHistoryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetResourceConfigHistoryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -247,6 +336,19 @@ func (c *ConfigService) GetResourceConfigHistoryPages(input *GetResourceConfigHi
 	})
 }
 
+// GetResourceConfigHistoryPagesWithContext is the same as GetResourceConfigHistoryPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ConfigService) GetResourceConfigHistoryPagesWithContext(ctx aws.Context, input *GetResourceConfigHistoryInput, fn func(p *GetResourceConfigHistoryOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.GetResourceConfigHistoryRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetResourceConfigHistoryOutput), lastPage)
+	})
+}
+
 const opPutConfigurationRecorder = "PutConfigurationRecorder"
 
 // PutConfigurationRecorderRequest generates a request for the PutConfigurationRecorder operation.
@@ -283,6 +385,18 @@ func (c *ConfigService) PutConfigurationRecorder(input *PutConfigurationRecorder
 	return out, err
 }
 
+// PutConfigurationRecorderWithContext is the same as PutConfigurationRecorder with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) PutConfigurationRecorderWithContext(ctx aws.Context, input *PutConfigurationRecorderInput, opts ...aws.Option) (*PutConfigurationRecorderOutput, error) {
+	req, out := c.PutConfigurationRecorderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutDeliveryChannel = "PutDeliveryChannel"
 
 // PutDeliveryChannelRequest generates a request for the PutDeliveryChannel operation.
@@ -320,6 +434,18 @@ func (c *ConfigService) PutDeliveryChannel(input *PutDeliveryChannelInput) (*Put
 	return out, err
 }
 
+// PutDeliveryChannelWithContext is the same as PutDeliveryChannel with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) PutDeliveryChannelWithContext(ctx aws.Context, input *PutDeliveryChannelInput, opts ...aws.Option) (*PutDeliveryChannelOutput, error) {
+	req, out := c.PutDeliveryChannelRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opStartConfigurationRecorder = "StartConfigurationRecorder"
 
 // StartConfigurationRecorderRequest generates a request for the StartConfigurationRecorder operation.
@@ -351,6 +477,18 @@ func (c *ConfigService) StartConfigurationRecorder(input *StartConfigurationReco
 	return out, err
 }
 
+// StartConfigurationRecorderWithContext is the same as StartConfigurationRecorder with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) StartConfigurationRecorderWithContext(ctx aws.Context, input *StartConfigurationRecorderInput, opts ...aws.Option) (*StartConfigurationRecorderOutput, error) {
+	req, out := c.StartConfigurationRecorderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opStopConfigurationRecorder = "StopConfigurationRecorder"
 
 // StopConfigurationRecorderRequest generates a request for the StopConfigurationRecorder operation.
@@ -379,6 +517,18 @@ func (c *ConfigService) StopConfigurationRecorder(input *StopConfigurationRecord
 	return out, err
 }
 
+// StopConfigurationRecorderWithContext is the same as StopConfigurationRecorder with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ConfigService) StopConfigurationRecorderWithContext(ctx aws.Context, input *StopConfigurationRecorderInput, opts ...aws.Option) (*StopConfigurationRecorderOutput, error) {
+	req, out := c.StopConfigurationRecorderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // A list that contains the status of the delivery of either the snapshot or
 // the configuration history to the specified Amazon S3 bucket.
 type ConfigExportDeliveryInfo struct {
@@ -534,7 +684,7 @@ type ConfigurationRecorder struct {
 	// The name of the recorder. By default, AWS Config automatically assigns the
 	// name "default" when creating the configuration recorder. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// The recording group specifies either to record configurations for all supported
 	// resources or to provide a list of resource types to record. The list of resource
@@ -609,7 +759,7 @@ func (s ConfigurationRecorderStatus) GoString() string {
 // data in JSON format.
 type DeleteDeliveryChannelInput struct {
 	// The name of the delivery channel to delete.
-	DeliveryChannelName *string `type:"string" required:"true"`
+	DeliveryChannelName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeleteDeliveryChannelInput `json:"-" xml:"-"`
 }
@@ -649,7 +799,7 @@ func (s DeleteDeliveryChannelOutput) GoString() string {
 // The input for the DeliverConfigSnapshot action.
 type DeliverConfigSnapshotInput struct {
 	// The name of the delivery channel through which the snapshot is delivered.
-	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" required:"true"`
+	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeliverConfigSnapshotInput `json:"-" xml:"-"`
 }
@@ -696,7 +846,7 @@ type DeliveryChannel struct {
 	// The name of the delivery channel. By default, AWS Config automatically assigns
 	// the name "default" when creating the delivery channel. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// The name of the Amazon S3 bucket used to store configuration history for
 	// the delivery channel.
@@ -957,7 +1107,7 @@ type GetResourceConfigHistoryInput struct {
 
 	// The maximum number of configuration items returned in each page. The default
 	// is 10. You cannot specify a limit greater than 100.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"0" max:"100"`
 
 	// An optional parameter used for pagination of the results.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -1159,7 +1309,7 @@ func (s Relationship) GoString() string {
 type StartConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartConfigurationRecorderInput `json:"-" xml:"-"`
 }
@@ -1200,7 +1350,7 @@ func (s StartConfigurationRecorderOutput) GoString() string {
 type StopConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStopConfigurationRecorderInput `json:"-" xml:"-"`
 }
@@ -4,6 +4,7 @@
 package configserviceiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 )
 
@@ -11,23 +12,49 @@ import (
 type ConfigServiceAPI interface {
 	DeleteDeliveryChannel(*configservice.DeleteDeliveryChannelInput) (*configservice.DeleteDeliveryChannelOutput, error)
 
+	DeleteDeliveryChannelWithContext(aws.Context, *configservice.DeleteDeliveryChannelInput, ...aws.Option) (*configservice.DeleteDeliveryChannelOutput, error)
+
 	DeliverConfigSnapshot(*configservice.DeliverConfigSnapshotInput) (*configservice.DeliverConfigSnapshotOutput, error)
 
+	DeliverConfigSnapshotWithContext(aws.Context, *configservice.DeliverConfigSnapshotInput, ...aws.Option) (*configservice.DeliverConfigSnapshotOutput, error)
+
 	DescribeConfigurationRecorderStatus(*configservice.DescribeConfigurationRecorderStatusInput) (*configservice.DescribeConfigurationRecorderStatusOutput, error)
 
+	DescribeConfigurationRecorderStatusWithContext(aws.Context, *configservice.DescribeConfigurationRecorderStatusInput, ...aws.Option) (*configservice.DescribeConfigurationRecorderStatusOutput, error)
+
 	DescribeConfigurationRecorders(*configservice.DescribeConfigurationRecordersInput) (*configservice.DescribeConfigurationRecordersOutput, error)
 
+	DescribeConfigurationRecordersWithContext(aws.Context, *configservice.DescribeConfigurationRecordersInput, ...aws.Option) (*configservice.DescribeConfigurationRecordersOutput, error)
+
 	DescribeDeliveryChannelStatus(*configservice.DescribeDeliveryChannelStatusInput) (*configservice.DescribeDeliveryChannelStatusOutput, error)
 
+	DescribeDeliveryChannelStatusWithContext(aws.Context, *configservice.DescribeDeliveryChannelStatusInput, ...aws.Option) (*configservice.DescribeDeliveryChannelStatusOutput, error)
+
 	DescribeDeliveryChannels(*configservice.DescribeDeliveryChannelsInput) (*configservice.DescribeDeliveryChannelsOutput, error)
 
+	DescribeDeliveryChannelsWithContext(aws.Context, *configservice.DescribeDeliveryChannelsInput, ...aws.Option) (*configservice.DescribeDeliveryChannelsOutput, error)
+
 	GetResourceConfigHistory(*configservice.GetResourceConfigHistoryInput) (*configservice.GetResourceConfigHistoryOutput, error)
 
+	GetResourceConfigHistoryWithContext(aws.Context, *configservice.GetResourceConfigHistoryInput, ...aws.Option) (*configservice.GetResourceConfigHistoryOutput, error)
+
+	GetResourceConfigHistoryPages(*configservice.GetResourceConfigHistoryInput, func(*configservice.GetResourceConfigHistoryOutput, bool) bool) error
+
+	GetResourceConfigHistoryPagesWithContext(aws.Context, *configservice.GetResourceConfigHistoryInput, func(*configservice.GetResourceConfigHistoryOutput, bool) bool, ...aws.Option) error
+
 	PutConfigurationRecorder(*configservice.PutConfigurationRecorderInput) (*configservice.PutConfigurationRecorderOutput, error)
 
+	PutConfigurationRecorderWithContext(aws.Context, *configservice.PutConfigurationRecorderInput, ...aws.Option) (*configservice.PutConfigurationRecorderOutput, error)
+
 	PutDeliveryChannel(*configservice.PutDeliveryChannelInput) (*configservice.PutDeliveryChannelOutput, error)
 
+	PutDeliveryChannelWithContext(aws.Context, *configservice.PutDeliveryChannelInput, ...aws.Option) (*configservice.PutDeliveryChannelOutput, error)
+
 	StartConfigurationRecorder(*configservice.StartConfigurationRecorderInput) (*configservice.StartConfigurationRecorderOutput, error)
 
+	StartConfigurationRecorderWithContext(aws.Context, *configservice.StartConfigurationRecorderInput, ...aws.Option) (*configservice.StartConfigurationRecorderOutput, error)
+
 	StopConfigurationRecorder(*configservice.StopConfigurationRecorderInput) (*configservice.StopConfigurationRecorderOutput, error)
+
+	StopConfigurationRecorderWithContext(aws.Context, *configservice.StopConfigurationRecorderInput, ...aws.Option) (*configservice.StopConfigurationRecorderOutput, error)
 }
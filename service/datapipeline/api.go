@@ -44,6 +44,18 @@ func (c *DataPipeline) ActivatePipeline(input *ActivatePipelineInput) (*Activate
 	return out, err
 }
 
+// ActivatePipelineWithContext is the same as ActivatePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) ActivatePipelineWithContext(ctx aws.Context, input *ActivatePipelineInput, opts ...aws.Option) (*ActivatePipelineOutput, error) {
+	req, out := c.ActivatePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddTags = "AddTags"
 
 // AddTagsRequest generates a request for the AddTags operation.
@@ -71,6 +83,18 @@ func (c *DataPipeline) AddTags(input *AddTagsInput) (*AddTagsOutput, error) {
 	return out, err
 }
 
+// AddTagsWithContext is the same as AddTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) AddTagsWithContext(ctx aws.Context, input *AddTagsInput, opts ...aws.Option) (*AddTagsOutput, error) {
+	req, out := c.AddTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePipeline = "CreatePipeline"
 
 // CreatePipelineRequest generates a request for the CreatePipeline operation.
@@ -99,6 +123,18 @@ func (c *DataPipeline) CreatePipeline(input *CreatePipelineInput) (*CreatePipeli
 	return out, err
 }
 
+// CreatePipelineWithContext is the same as CreatePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) CreatePipelineWithContext(ctx aws.Context, input *CreatePipelineInput, opts ...aws.Option) (*CreatePipelineOutput, error) {
+	req, out := c.CreatePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeactivatePipeline = "DeactivatePipeline"
 
 // DeactivatePipelineRequest generates a request for the DeactivatePipeline operation.
@@ -131,6 +167,18 @@ func (c *DataPipeline) DeactivatePipeline(input *DeactivatePipelineInput) (*Deac
 	return out, err
 }
 
+// DeactivatePipelineWithContext is the same as DeactivatePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) DeactivatePipelineWithContext(ctx aws.Context, input *DeactivatePipelineInput, opts ...aws.Option) (*DeactivatePipelineOutput, error) {
+	req, out := c.DeactivatePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePipeline = "DeletePipeline"
 
 // DeletePipelineRequest generates a request for the DeletePipeline operation.
@@ -165,6 +213,18 @@ func (c *DataPipeline) DeletePipeline(input *DeletePipelineInput) (*DeletePipeli
 	return out, err
 }
 
+// DeletePipelineWithContext is the same as DeletePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) DeletePipelineWithContext(ctx aws.Context, input *DeletePipelineInput, opts ...aws.Option) (*DeletePipelineOutput, error) {
+	req, out := c.DeletePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeObjects = "DescribeObjects"
 
 // DescribeObjectsRequest generates a request for the DescribeObjects operation.
@@ -178,6 +238,7 @@ func (c *DataPipeline) DescribeObjectsRequest(input *DescribeObjectsInput) (req
 			OutputTokens:    []string{"marker"},
 			LimitToken:      "",
 			TruncationToken: "hasMoreResults",
+			ResultKeys:      []string{"pipelineObjects"},
 		},
 	}
 
@@ -200,6 +261,22 @@ func (c *DataPipeline) DescribeObjects(input *DescribeObjectsInput) (*DescribeOb
 	return out, err
 }
 
+// DescribeObjectsWithContext is the same as DescribeObjects with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) DescribeObjectsWithContext(ctx aws.Context, input *DescribeObjectsInput, opts ...aws.Option) (*DescribeObjectsOutput, error) {
+	req, out := c.DescribeObjectsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeObjectsPages iterates over the pages of a DescribeObjects operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *DataPipeline) DescribeObjectsPages(input *DescribeObjectsInput, fn func(p *DescribeObjectsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeObjectsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -207,6 +284,19 @@ func (c *DataPipeline) DescribeObjectsPages(input *DescribeObjectsInput, fn func
 	})
 }
 
+// DescribeObjectsPagesWithContext is the same as DescribeObjectsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *DataPipeline) DescribeObjectsPagesWithContext(ctx aws.Context, input *DescribeObjectsInput, fn func(p *DescribeObjectsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeObjectsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeObjectsOutput), lastPage)
+	})
+}
+
 const opDescribePipelines = "DescribePipelines"
 
 // DescribePipelinesRequest generates a request for the DescribePipelines operation.
@@ -242,6 +332,18 @@ func (c *DataPipeline) DescribePipelines(input *DescribePipelinesInput) (*Descri
 	return out, err
 }
 
+// DescribePipelinesWithContext is the same as DescribePipelines with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) DescribePipelinesWithContext(ctx aws.Context, input *DescribePipelinesInput, opts ...aws.Option) (*DescribePipelinesOutput, error) {
+	req, out := c.DescribePipelinesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEvaluateExpression = "EvaluateExpression"
 
 // EvaluateExpressionRequest generates a request for the EvaluateExpression operation.
@@ -271,6 +373,18 @@ func (c *DataPipeline) EvaluateExpression(input *EvaluateExpressionInput) (*Eval
 	return out, err
 }
 
+// EvaluateExpressionWithContext is the same as EvaluateExpression with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) EvaluateExpressionWithContext(ctx aws.Context, input *EvaluateExpressionInput, opts ...aws.Option) (*EvaluateExpressionOutput, error) {
+	req, out := c.EvaluateExpressionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPipelineDefinition = "GetPipelineDefinition"
 
 // GetPipelineDefinitionRequest generates a request for the GetPipelineDefinition operation.
@@ -299,6 +413,18 @@ func (c *DataPipeline) GetPipelineDefinition(input *GetPipelineDefinitionInput)
 	return out, err
 }
 
+// GetPipelineDefinitionWithContext is the same as GetPipelineDefinition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) GetPipelineDefinitionWithContext(ctx aws.Context, input *GetPipelineDefinitionInput, opts ...aws.Option) (*GetPipelineDefinitionOutput, error) {
+	req, out := c.GetPipelineDefinitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListPipelines = "ListPipelines"
 
 // ListPipelinesRequest generates a request for the ListPipelines operation.
@@ -312,6 +438,7 @@ func (c *DataPipeline) ListPipelinesRequest(input *ListPipelinesInput) (req *aws
 			OutputTokens:    []string{"marker"},
 			LimitToken:      "",
 			TruncationToken: "hasMoreResults",
+			ResultKeys:      []string{"pipelineIdList"},
 		},
 	}
 
@@ -333,6 +460,22 @@ func (c *DataPipeline) ListPipelines(input *ListPipelinesInput) (*ListPipelinesO
 	return out, err
 }
 
+// ListPipelinesWithContext is the same as ListPipelines with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) ListPipelinesWithContext(ctx aws.Context, input *ListPipelinesInput, opts ...aws.Option) (*ListPipelinesOutput, error) {
+	req, out := c.ListPipelinesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListPipelinesPages iterates over the pages of a ListPipelines operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *DataPipeline) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPipelinesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -340,6 +483,19 @@ func (c *DataPipeline) ListPipelinesPages(input *ListPipelinesInput, fn func(p *
 	})
 }
 
+// ListPipelinesPagesWithContext is the same as ListPipelinesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *DataPipeline) ListPipelinesPagesWithContext(ctx aws.Context, input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListPipelinesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPipelinesOutput), lastPage)
+	})
+}
+
 const opPollForTask = "PollForTask"
 
 // PollForTaskRequest generates a request for the PollForTask operation.
@@ -380,6 +536,18 @@ func (c *DataPipeline) PollForTask(input *PollForTaskInput) (*PollForTaskOutput,
 	return out, err
 }
 
+// PollForTaskWithContext is the same as PollForTask with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) PollForTaskWithContext(ctx aws.Context, input *PollForTaskInput, opts ...aws.Option) (*PollForTaskOutput, error) {
+	req, out := c.PollForTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutPipelineDefinition = "PutPipelineDefinition"
 
 // PutPipelineDefinitionRequest generates a request for the PutPipelineDefinition operation.
@@ -418,6 +586,18 @@ func (c *DataPipeline) PutPipelineDefinition(input *PutPipelineDefinitionInput)
 	return out, err
 }
 
+// PutPipelineDefinitionWithContext is the same as PutPipelineDefinition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) PutPipelineDefinitionWithContext(ctx aws.Context, input *PutPipelineDefinitionInput, opts ...aws.Option) (*PutPipelineDefinitionOutput, error) {
+	req, out := c.PutPipelineDefinitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opQueryObjects = "QueryObjects"
 
 // QueryObjectsRequest generates a request for the QueryObjects operation.
@@ -431,6 +611,7 @@ func (c *DataPipeline) QueryObjectsRequest(input *QueryObjectsInput) (req *aws.R
 			OutputTokens:    []string{"marker"},
 			LimitToken:      "limit",
 			TruncationToken: "hasMoreResults",
+			ResultKeys:      []string{"ids"},
 		},
 	}
 
@@ -452,6 +633,22 @@ func (c *DataPipeline) QueryObjects(input *QueryObjectsInput) (*QueryObjectsOutp
 	return out, err
 }
 
+// QueryObjectsWithContext is the same as QueryObjects with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) QueryObjectsWithContext(ctx aws.Context, input *QueryObjectsInput, opts ...aws.Option) (*QueryObjectsOutput, error) {
+	req, out := c.QueryObjectsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// QueryObjectsPages iterates over the pages of a QueryObjects operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *DataPipeline) QueryObjectsPages(input *QueryObjectsInput, fn func(p *QueryObjectsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.QueryObjectsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -459,6 +656,19 @@ func (c *DataPipeline) QueryObjectsPages(input *QueryObjectsInput, fn func(p *Qu
 	})
 }
 
+// QueryObjectsPagesWithContext is the same as QueryObjectsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *DataPipeline) QueryObjectsPagesWithContext(ctx aws.Context, input *QueryObjectsInput, fn func(p *QueryObjectsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.QueryObjectsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*QueryObjectsOutput), lastPage)
+	})
+}
+
 const opRemoveTags = "RemoveTags"
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -486,6 +696,18 @@ func (c *DataPipeline) RemoveTags(input *RemoveTagsInput) (*RemoveTagsOutput, er
 	return out, err
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) RemoveTagsWithContext(ctx aws.Context, input *RemoveTagsInput, opts ...aws.Option) (*RemoveTagsOutput, error) {
+	req, out := c.RemoveTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opReportTaskProgress = "ReportTaskProgress"
 
 // ReportTaskProgressRequest generates a request for the ReportTaskProgress operation.
@@ -524,6 +746,18 @@ func (c *DataPipeline) ReportTaskProgress(input *ReportTaskProgressInput) (*Repo
 	return out, err
 }
 
+// ReportTaskProgressWithContext is the same as ReportTaskProgress with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) ReportTaskProgressWithContext(ctx aws.Context, input *ReportTaskProgressInput, opts ...aws.Option) (*ReportTaskProgressOutput, error) {
+	req, out := c.ReportTaskProgressRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opReportTaskRunnerHeartbeat = "ReportTaskRunnerHeartbeat"
 
 // ReportTaskRunnerHeartbeatRequest generates a request for the ReportTaskRunnerHeartbeat operation.
@@ -555,6 +789,18 @@ func (c *DataPipeline) ReportTaskRunnerHeartbeat(input *ReportTaskRunnerHeartbea
 	return out, err
 }
 
+// ReportTaskRunnerHeartbeatWithContext is the same as ReportTaskRunnerHeartbeat with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) ReportTaskRunnerHeartbeatWithContext(ctx aws.Context, input *ReportTaskRunnerHeartbeatInput, opts ...aws.Option) (*ReportTaskRunnerHeartbeatOutput, error) {
+	req, out := c.ReportTaskRunnerHeartbeatRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetStatus = "SetStatus"
 
 // SetStatusRequest generates a request for the SetStatus operation.
@@ -586,6 +832,18 @@ func (c *DataPipeline) SetStatus(input *SetStatusInput) (*SetStatusOutput, error
 	return out, err
 }
 
+// SetStatusWithContext is the same as SetStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) SetStatusWithContext(ctx aws.Context, input *SetStatusInput, opts ...aws.Option) (*SetStatusOutput, error) {
+	req, out := c.SetStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetTaskStatus = "SetTaskStatus"
 
 // SetTaskStatusRequest generates a request for the SetTaskStatus operation.
@@ -617,6 +875,18 @@ func (c *DataPipeline) SetTaskStatus(input *SetTaskStatusInput) (*SetTaskStatusO
 	return out, err
 }
 
+// SetTaskStatusWithContext is the same as SetTaskStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) SetTaskStatusWithContext(ctx aws.Context, input *SetTaskStatusInput, opts ...aws.Option) (*SetTaskStatusOutput, error) {
+	req, out := c.SetTaskStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opValidatePipelineDefinition = "ValidatePipelineDefinition"
 
 // ValidatePipelineDefinitionRequest generates a request for the ValidatePipelineDefinition operation.
@@ -645,13 +915,25 @@ func (c *DataPipeline) ValidatePipelineDefinition(input *ValidatePipelineDefinit
 	return out, err
 }
 
+// ValidatePipelineDefinitionWithContext is the same as ValidatePipelineDefinition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DataPipeline) ValidatePipelineDefinitionWithContext(ctx aws.Context, input *ValidatePipelineDefinitionInput, opts ...aws.Option) (*ValidatePipelineDefinitionOutput, error) {
+	req, out := c.ValidatePipelineDefinitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Contains the parameters for ActivatePipeline.
 type ActivatePipelineInput struct {
 	// A list of parameter values to pass to the pipeline at activation.
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The date and time to resume the pipeline. By default, the pipeline resumes
 	// from the last completed execution.
@@ -696,10 +978,10 @@ func (s ActivatePipelineOutput) GoString() string {
 // Contains the parameters for AddTags.
 type AddTagsInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The tags to add, as key/value pairs.
-	Tags []*Tag `locationName:"tags" type:"list" required:"true"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10" required:"true"`
 
 	metadataAddTagsInput `json:"-" xml:"-"`
 }
@@ -740,18 +1022,18 @@ func (s AddTagsOutput) GoString() string {
 // Contains the parameters for CreatePipeline.
 type CreatePipelineInput struct {
 	// The description for the pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" min:"0" max:"1024"`
 
 	// The name for the pipeline. You can use the same name for multiple pipelines
 	// associated with your AWS account, because AWS Data Pipeline assigns each
 	// pipeline a unique pipeline identifier.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	// A list of tags to associate with the pipeline at creation. Tags let you control
 	// access to pipelines. For more information, see Controlling User Access to
 	// Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10"`
 
 	// A unique identifier. This identifier is not the same as the pipeline identifier
 	// assigned by AWS Data Pipeline. You are responsible for defining the format
@@ -764,7 +1046,7 @@ type CreatePipelineInput struct {
 	// Instead, you'll receive the pipeline identifier from the previous attempt.
 	// The uniqueness of the name and unique identifier combination is scoped to
 	// the AWS account or IAM user credentials.
-	UniqueID *string `locationName:"uniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"uniqueId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataCreatePipelineInput `json:"-" xml:"-"`
 }
@@ -787,7 +1069,7 @@ func (s CreatePipelineInput) GoString() string {
 type CreatePipelineOutput struct {
 	// The ID that AWS Data Pipeline assigns the newly created pipeline. For example,
 	// df-06372391ZG65EXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataCreatePipelineOutput `json:"-" xml:"-"`
 }
@@ -814,7 +1096,7 @@ type DeactivatePipelineInput struct {
 	CancelActive *bool `locationName:"cancelActive" type:"boolean"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDeactivatePipelineInput `json:"-" xml:"-"`
 }
@@ -855,7 +1137,7 @@ func (s DeactivatePipelineOutput) GoString() string {
 // Contains the parameters for DeletePipeline.
 type DeletePipelineInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDeletePipelineInput `json:"-" xml:"-"`
 }
@@ -902,14 +1184,14 @@ type DescribeObjectsInput struct {
 	// value should be empty. As long as there are more results, continue to call
 	// DescribeObjects with the marker value from the previous call to retrieve
 	// the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// The IDs of the pipeline objects that contain the definitions to be described.
 	// You can pass as many as 25 identifiers in a single call to DescribeObjects.
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// The ID of the pipeline that contains the object definitions.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDescribeObjectsInput `json:"-" xml:"-"`
 }
@@ -936,7 +1218,7 @@ type DescribeObjectsOutput struct {
 	// The starting point for the next page of results. To view the next page of
 	// results, call DescribeObjects again with this marker value. If the value
 	// is null, there are no more results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// An array of object definitions.
 	PipelineObjects []*PipelineObject `locationName:"pipelineObjects" type:"list" required:"true"`
@@ -1006,13 +1288,13 @@ func (s DescribePipelinesOutput) GoString() string {
 // Contains the parameters for EvaluateExpression.
 type EvaluateExpressionInput struct {
 	// The expression to evaluate.
-	Expression *string `locationName:"expression" type:"string" required:"true"`
+	Expression *string `locationName:"expression" type:"string" min:"0" max:"20971520" required:"true"`
 
 	// The ID of the object.
-	ObjectID *string `locationName:"objectId" type:"string" required:"true"`
+	ObjectID *string `locationName:"objectId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataEvaluateExpressionInput `json:"-" xml:"-"`
 }
@@ -1034,7 +1316,7 @@ func (s EvaluateExpressionInput) GoString() string {
 // Contains the output of EvaluateExpression.
 type EvaluateExpressionOutput struct {
 	// The evaluated expression.
-	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" required:"true"`
+	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" min:"0" max:"20971520" required:"true"`
 
 	metadataEvaluateExpressionOutput `json:"-" xml:"-"`
 }
@@ -1058,13 +1340,13 @@ func (s EvaluateExpressionOutput) GoString() string {
 // object (RefValue) but not as both.
 type Field struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as the identifier of another object.
-	RefValue *string `locationName:"refValue" type:"string"`
+	RefValue *string `locationName:"refValue" type:"string" min:"1" max:"256"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240"`
 
 	metadataField `json:"-" xml:"-"`
 }
@@ -1086,12 +1368,12 @@ func (s Field) GoString() string {
 // Contains the parameters for GetPipelineDefinition.
 type GetPipelineDefinitionInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The version of the pipeline definition to retrieve. Set this parameter to
 	// latest (default) to use the last definition saved to the pipeline or active
 	// to use the last definition that was activated.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" min:"0" max:"1024"`
 
 	metadataGetPipelineDefinitionInput `json:"-" xml:"-"`
 }
@@ -1148,11 +1430,11 @@ type InstanceIdentity struct {
 	// A description of an EC2 instance that is generated when the instance is launched
 	// and exposed to the instance via the instance metadata service in the form
 	// of a JSON representation of an object.
-	Document *string `locationName:"document" type:"string"`
+	Document *string `locationName:"document" type:"string" min:"0" max:"1024"`
 
 	// A signature which can be used to verify the accuracy and authenticity of
 	// the information provided in the instance identity document.
-	Signature *string `locationName:"signature" type:"string"`
+	Signature *string `locationName:"signature" type:"string" min:"0" max:"1024"`
 
 	metadataInstanceIdentity `json:"-" xml:"-"`
 }
@@ -1177,7 +1459,7 @@ type ListPipelinesInput struct {
 	// value should be empty. As long as there are more results, continue to call
 	// ListPipelines with the marker value from the previous call to retrieve the
 	// next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	metadataListPipelinesInput `json:"-" xml:"-"`
 }
@@ -1205,7 +1487,7 @@ type ListPipelinesOutput struct {
 	// The starting point for the next page of results. To view the next page of
 	// results, call ListPipelinesOutput again with this marker value. If the value
 	// is null, there are no more results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// The pipeline identifiers. If you require additional information about the
 	// pipelines, you can use these identifiers to call DescribePipelines and GetPipelineDefinition.
@@ -1274,10 +1556,10 @@ func (s Operator) GoString() string {
 // The attributes allowed or specified with a parameter object.
 type ParameterAttribute struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240" required:"true"`
 
 	metadataParameterAttribute `json:"-" xml:"-"`
 }
@@ -1302,7 +1584,7 @@ type ParameterObject struct {
 	Attributes []*ParameterAttribute `locationName:"attributes" type:"list" required:"true"`
 
 	// The ID of the parameter object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataParameterObject `json:"-" xml:"-"`
 }
@@ -1324,10 +1606,10 @@ func (s ParameterObject) GoString() string {
 // A value or list of parameter values.
 type ParameterValue struct {
 	// The ID of the parameter value.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240" required:"true"`
 
 	metadataParameterValue `json:"-" xml:"-"`
 }
@@ -1349,24 +1631,24 @@ func (s ParameterValue) GoString() string {
 // Contains pipeline metadata.
 type PipelineDescription struct {
 	// Description of the pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" min:"0" max:"1024"`
 
 	// A list of read-only fields that contain metadata about the pipeline: @userId,
 	// @accountId, and @pipelineState.
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// The name of the pipeline.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The pipeline identifier that was assigned by AWS Data Pipeline. This is a
 	// string of the form df-297EG78HU43EEXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// A list of tags to associated with a pipeline. Tags let you control access
 	// to pipelines. For more information, see Controlling User Access to Pipelines
 	// (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10"`
 
 	metadataPipelineDescription `json:"-" xml:"-"`
 }
@@ -1389,10 +1671,10 @@ func (s PipelineDescription) GoString() string {
 type PipelineIDName struct {
 	// The ID of the pipeline that was assigned by AWS Data Pipeline. This is a
 	// string of the form df-297EG78HU43EEXAMPLE.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	// The name of the pipeline.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024"`
 
 	metadataPipelineIDName `json:"-" xml:"-"`
 }
@@ -1419,10 +1701,10 @@ type PipelineObject struct {
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// The ID of the object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The name of the object.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataPipelineObject `json:"-" xml:"-"`
 }
@@ -1444,7 +1726,7 @@ func (s PipelineObject) GoString() string {
 // Contains the parameters for PollForTask.
 type PollForTaskInput struct {
 	// The public DNS name of the calling task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024"`
 
 	// Identity information for the EC2 instance that is hosting the task runner.
 	// You can get this value from the instance using http://169.254.169.254/latest/meta-data/instance-id.
@@ -1459,7 +1741,7 @@ type PollForTaskInput struct {
 	// You can only specify a single value for workerGroup in the call to PollForTask.
 	// There are no wildcard values permitted in workerGroup; the string must be
 	// an exact, case-sensitive, match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string" required:"true"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataPollForTaskInput `json:"-" xml:"-"`
 }
@@ -1512,7 +1794,7 @@ type PutPipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The objects that define the pipeline. These objects overwrite the existing
 	// pipeline definition.
@@ -1598,10 +1880,10 @@ type QueryObjectsInput struct {
 	// value should be empty. As long as there are more results, continue to call
 	// QueryObjects with the marker value from the previous call to retrieve the
 	// next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The query that defines the objects to be returned. The Query object can contain
 	// a maximum of ten selectors. The conditions in the query are limited to top-level
@@ -1611,7 +1893,7 @@ type QueryObjectsInput struct {
 
 	// Indicates whether the query applies to components or instances. The possible
 	// values are: COMPONENT, INSTANCE, and ATTEMPT.
-	Sphere *string `locationName:"sphere" type:"string" required:"true"`
+	Sphere *string `locationName:"sphere" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataQueryObjectsInput `json:"-" xml:"-"`
 }
@@ -1642,7 +1924,7 @@ type QueryObjectsOutput struct {
 	// The starting point for the next page of results. To view the next page of
 	// results, call QueryObjects again with this marker value. If the value is
 	// null, there are no more results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	metadataQueryObjectsOutput `json:"-" xml:"-"`
 }
@@ -1664,7 +1946,7 @@ func (s QueryObjectsOutput) GoString() string {
 // Contains the parameters for RemoveTags.
 type RemoveTagsInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The keys of the tags to remove.
 	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
@@ -1713,7 +1995,7 @@ type ReportTaskProgressInput struct {
 
 	// The ID of the task assigned to the task runner. This value is provided in
 	// the response for PollForTask.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" required:"true"`
 
 	metadataReportTaskProgressInput `json:"-" xml:"-"`
 }
@@ -1758,21 +2040,21 @@ func (s ReportTaskProgressOutput) GoString() string {
 // Contains the parameters for ReportTaskRunnerHeartbeat.
 type ReportTaskRunnerHeartbeatInput struct {
 	// The public DNS name of the task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024"`
 
 	// The ID of the task runner. This value should be unique across your AWS account.
 	// In the case of AWS Data Pipeline Task Runner launched on a resource managed
 	// by AWS Data Pipeline, the web service provides a unique identifier when it
 	// launches the application. If you have written a custom task runner, you should
 	// assign a unique identifier for the task runner.
-	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" required:"true"`
+	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The type of task the task runner is configured to accept and process. The
 	// worker group is set as a field on objects in the pipeline when they are created.
 	// You can only specify a single value for workerGroup. There are no wildcard
 	// values permitted in workerGroup; the string must be an exact, case-sensitive,
 	// match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" min:"0" max:"1024"`
 
 	metadataReportTaskRunnerHeartbeatInput `json:"-" xml:"-"`
 }
@@ -1820,7 +2102,7 @@ type Selector struct {
 	// is the "key" portion of the field definition in the pipeline definition syntax
 	// that is used by the AWS Data Pipeline API. If the field is not set on the
 	// object, the condition fails.
-	FieldName *string `locationName:"fieldName" type:"string"`
+	FieldName *string `locationName:"fieldName" type:"string" min:"0" max:"1024"`
 
 	// Contains a logical operation for comparing the value of a field with a specified
 	// value.
@@ -1850,11 +2132,11 @@ type SetStatusInput struct {
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// The ID of the pipeline that contains the objects.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The status to be set on all the objects specified in objectIds. For components,
 	// use PAUSE or RESUME. For instances, use TRY_CANCEL, RERUN, or MARK_FINISHED.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataSetStatusInput `json:"-" xml:"-"`
 }
@@ -1897,7 +2179,7 @@ type SetTaskStatusInput struct {
 	// This value is set on the physical attempt object. It is used to display error
 	// information to the user. It should not start with string "Service_" which
 	// is reserved by the system.
-	ErrorID *string `locationName:"errorId" type:"string"`
+	ErrorID *string `locationName:"errorId" type:"string" min:"0" max:"1024"`
 
 	// If an error occurred during the task, this value specifies a text description
 	// of the error. This value is set on the physical attempt object. It is used
@@ -1909,11 +2191,11 @@ type SetTaskStatusInput struct {
 	// associated with the error. This value is set on the physical attempt object.
 	// It is used to display error information to the user. The web service does
 	// not parse this value.
-	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string"`
+	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string" min:"0" max:"1024"`
 
 	// The ID of the task assigned to the task runner. This value is provided in
 	// the response for PollForTask.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" required:"true"`
 
 	// If FINISHED, the task successfully completed. If FAILED, the task ended unsuccessfully.
 	// Preconditions use false.
@@ -1964,12 +2246,12 @@ type Tag struct {
 	// The key name of a tag defined by a user. For more information, see Controlling
 	// User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"128" required:"true"`
 
 	// The optional value portion of a tag defined by a user. For more information,
 	// see Controlling User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -1992,18 +2274,18 @@ func (s Tag) GoString() string {
 type TaskObject struct {
 	// The ID of the pipeline task attempt object. AWS Data Pipeline uses this value
 	// to track how many times a task is attempted.
-	AttemptID *string `locationName:"attemptId" type:"string"`
+	AttemptID *string `locationName:"attemptId" type:"string" min:"1" max:"1024"`
 
 	// Connection information for the location where the task runner will publish
 	// the output of the task.
 	Objects map[string]*PipelineObject `locationName:"objects" type:"map"`
 
 	// The ID of the pipeline that provided the task.
-	PipelineID *string `locationName:"pipelineId" type:"string"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024"`
 
 	// An internal identifier for the task. This ID is passed to the SetTaskStatus
 	// and ReportTaskProgress actions.
-	TaskID *string `locationName:"taskId" type:"string"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048"`
 
 	metadataTaskObject `json:"-" xml:"-"`
 }
@@ -2031,7 +2313,7 @@ type ValidatePipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The objects that define the pipeline changes to validate against the pipeline.
 	PipelineObjects []*PipelineObject `locationName:"pipelineObjects" type:"list" required:"true"`
@@ -2089,7 +2371,7 @@ type ValidationError struct {
 	Errors []*string `locationName:"errors" type:"list"`
 
 	// The identifier of the object that contains the validation error.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	metadataValidationError `json:"-" xml:"-"`
 }
@@ -2113,7 +2395,7 @@ func (s ValidationError) GoString() string {
 // by AWS Data Pipeline.
 type ValidationWarning struct {
 	// The identifier of the object that contains the validation warning.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	// A description of the validation warning.
 	Warnings []*string `locationName:"warnings" type:"list"`
@@ -4,6 +4,7 @@
 package datapipelineiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 )
 
@@ -11,39 +12,89 @@ import (
 type DataPipelineAPI interface {
 	ActivatePipeline(*datapipeline.ActivatePipelineInput) (*datapipeline.ActivatePipelineOutput, error)
 
+	ActivatePipelineWithContext(aws.Context, *datapipeline.ActivatePipelineInput, ...aws.Option) (*datapipeline.ActivatePipelineOutput, error)
+
 	AddTags(*datapipeline.AddTagsInput) (*datapipeline.AddTagsOutput, error)
 
+	AddTagsWithContext(aws.Context, *datapipeline.AddTagsInput, ...aws.Option) (*datapipeline.AddTagsOutput, error)
+
 	CreatePipeline(*datapipeline.CreatePipelineInput) (*datapipeline.CreatePipelineOutput, error)
 
+	CreatePipelineWithContext(aws.Context, *datapipeline.CreatePipelineInput, ...aws.Option) (*datapipeline.CreatePipelineOutput, error)
+
 	DeactivatePipeline(*datapipeline.DeactivatePipelineInput) (*datapipeline.DeactivatePipelineOutput, error)
 
+	DeactivatePipelineWithContext(aws.Context, *datapipeline.DeactivatePipelineInput, ...aws.Option) (*datapipeline.DeactivatePipelineOutput, error)
+
 	DeletePipeline(*datapipeline.DeletePipelineInput) (*datapipeline.DeletePipelineOutput, error)
 
+	DeletePipelineWithContext(aws.Context, *datapipeline.DeletePipelineInput, ...aws.Option) (*datapipeline.DeletePipelineOutput, error)
+
 	DescribeObjects(*datapipeline.DescribeObjectsInput) (*datapipeline.DescribeObjectsOutput, error)
 
+	DescribeObjectsWithContext(aws.Context, *datapipeline.DescribeObjectsInput, ...aws.Option) (*datapipeline.DescribeObjectsOutput, error)
+
+	DescribeObjectsPages(*datapipeline.DescribeObjectsInput, func(*datapipeline.DescribeObjectsOutput, bool) bool) error
+
+	DescribeObjectsPagesWithContext(aws.Context, *datapipeline.DescribeObjectsInput, func(*datapipeline.DescribeObjectsOutput, bool) bool, ...aws.Option) error
+
 	DescribePipelines(*datapipeline.DescribePipelinesInput) (*datapipeline.DescribePipelinesOutput, error)
 
+	DescribePipelinesWithContext(aws.Context, *datapipeline.DescribePipelinesInput, ...aws.Option) (*datapipeline.DescribePipelinesOutput, error)
+
 	EvaluateExpression(*datapipeline.EvaluateExpressionInput) (*datapipeline.EvaluateExpressionOutput, error)
 
+	EvaluateExpressionWithContext(aws.Context, *datapipeline.EvaluateExpressionInput, ...aws.Option) (*datapipeline.EvaluateExpressionOutput, error)
+
 	GetPipelineDefinition(*datapipeline.GetPipelineDefinitionInput) (*datapipeline.GetPipelineDefinitionOutput, error)
 
+	GetPipelineDefinitionWithContext(aws.Context, *datapipeline.GetPipelineDefinitionInput, ...aws.Option) (*datapipeline.GetPipelineDefinitionOutput, error)
+
 	ListPipelines(*datapipeline.ListPipelinesInput) (*datapipeline.ListPipelinesOutput, error)
 
+	ListPipelinesWithContext(aws.Context, *datapipeline.ListPipelinesInput, ...aws.Option) (*datapipeline.ListPipelinesOutput, error)
+
+	ListPipelinesPages(*datapipeline.ListPipelinesInput, func(*datapipeline.ListPipelinesOutput, bool) bool) error
+
+	ListPipelinesPagesWithContext(aws.Context, *datapipeline.ListPipelinesInput, func(*datapipeline.ListPipelinesOutput, bool) bool, ...aws.Option) error
+
 	PollForTask(*datapipeline.PollForTaskInput) (*datapipeline.PollForTaskOutput, error)
 
+	PollForTaskWithContext(aws.Context, *datapipeline.PollForTaskInput, ...aws.Option) (*datapipeline.PollForTaskOutput, error)
+
 	PutPipelineDefinition(*datapipeline.PutPipelineDefinitionInput) (*datapipeline.PutPipelineDefinitionOutput, error)
 
+	PutPipelineDefinitionWithContext(aws.Context, *datapipeline.PutPipelineDefinitionInput, ...aws.Option) (*datapipeline.PutPipelineDefinitionOutput, error)
+
 	QueryObjects(*datapipeline.QueryObjectsInput) (*datapipeline.QueryObjectsOutput, error)
 
+	QueryObjectsWithContext(aws.Context, *datapipeline.QueryObjectsInput, ...aws.Option) (*datapipeline.QueryObjectsOutput, error)
+
+	QueryObjectsPages(*datapipeline.QueryObjectsInput, func(*datapipeline.QueryObjectsOutput, bool) bool) error
+
+	QueryObjectsPagesWithContext(aws.Context, *datapipeline.QueryObjectsInput, func(*datapipeline.QueryObjectsOutput, bool) bool, ...aws.Option) error
+
 	RemoveTags(*datapipeline.RemoveTagsInput) (*datapipeline.RemoveTagsOutput, error)
 
+	RemoveTagsWithContext(aws.Context, *datapipeline.RemoveTagsInput, ...aws.Option) (*datapipeline.RemoveTagsOutput, error)
+
 	ReportTaskProgress(*datapipeline.ReportTaskProgressInput) (*datapipeline.ReportTaskProgressOutput, error)
 
+	ReportTaskProgressWithContext(aws.Context, *datapipeline.ReportTaskProgressInput, ...aws.Option) (*datapipeline.ReportTaskProgressOutput, error)
+
 	ReportTaskRunnerHeartbeat(*datapipeline.ReportTaskRunnerHeartbeatInput) (*datapipeline.ReportTaskRunnerHeartbeatOutput, error)
 
+	ReportTaskRunnerHeartbeatWithContext(aws.Context, *datapipeline.ReportTaskRunnerHeartbeatInput, ...aws.Option) (*datapipeline.ReportTaskRunnerHeartbeatOutput, error)
+
 	SetStatus(*datapipeline.SetStatusInput) (*datapipeline.SetStatusOutput, error)
 
+	SetStatusWithContext(aws.Context, *datapipeline.SetStatusInput, ...aws.Option) (*datapipeline.SetStatusOutput, error)
+
 	SetTaskStatus(*datapipeline.SetTaskStatusInput) (*datapipeline.SetTaskStatusOutput, error)
 
+	SetTaskStatusWithContext(aws.Context, *datapipeline.SetTaskStatusInput, ...aws.Option) (*datapipeline.SetTaskStatusOutput, error)
+
 	ValidatePipelineDefinition(*datapipeline.ValidatePipelineDefinitionInput) (*datapipeline.ValidatePipelineDefinitionOutput, error)
+
+	ValidatePipelineDefinitionWithContext(aws.Context, *datapipeline.ValidatePipelineDefinitionInput, ...aws.Option) (*datapipeline.ValidatePipelineDefinitionOutput, error)
 }
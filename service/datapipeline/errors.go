@@ -0,0 +1,38 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package datapipeline
+
+const (
+	// ErrCodeInternalServiceError for service response error code
+	// "InternalServiceError".
+	//
+	// An internal service error occurred.
+	ErrCodeInternalServiceError = "InternalServiceError"
+
+	// ErrCodeInvalidRequestException for service response error code
+	// "InvalidRequestException".
+	//
+	// The request was not valid. Verify that your request was properly formatted,
+	// that the signature was generated with the correct credentials, and that you
+	// haven't exceeded any of the service limits for your account.
+	ErrCodeInvalidRequestException = "InvalidRequestException"
+
+	// ErrCodePipelineDeletedException for service response error code
+	// "PipelineDeletedException".
+	//
+	// The specified pipeline has been deleted.
+	ErrCodePipelineDeletedException = "PipelineDeletedException"
+
+	// ErrCodePipelineNotFoundException for service response error code
+	// "PipelineNotFoundException".
+	//
+	// The specified pipeline was not found. Verify that you used the correct user
+	// and account identifiers.
+	ErrCodePipelineNotFoundException = "PipelineNotFoundException"
+
+	// ErrCodeTaskNotFoundException for service response error code
+	// "TaskNotFoundException".
+	//
+	// The specified task was not found.
+	ErrCodeTaskNotFoundException = "TaskNotFoundException"
+)
@@ -0,0 +1,19 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package directconnect
+
+const (
+	// ErrCodeClientException for service response error code
+	// "DirectConnectClientException".
+	//
+	// The API was called with invalid parameters. The error message will contain
+	// additional details about the cause.
+	ErrCodeClientException = "DirectConnectClientException"
+
+	// ErrCodeServerException for service response error code
+	// "DirectConnectServerException".
+	//
+	// A server-side error occurred during the API call. The error message will
+	// contain additional details about the cause.
+	ErrCodeServerException = "DirectConnectServerException"
+)
@@ -37,6 +37,18 @@ func (c *DirectoryService) ConnectDirectory(input *ConnectDirectoryInput) (*Conn
 	return out, err
 }
 
+// ConnectDirectoryWithContext is the same as ConnectDirectory with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) ConnectDirectoryWithContext(ctx aws.Context, input *ConnectDirectoryInput, opts ...aws.Option) (*ConnectDirectoryOutput, error) {
+	req, out := c.ConnectDirectoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAlias = "CreateAlias"
 
 // CreateAliasRequest generates a request for the CreateAlias operation.
@@ -69,6 +81,18 @@ func (c *DirectoryService) CreateAlias(input *CreateAliasInput) (*CreateAliasOut
 	return out, err
 }
 
+// CreateAliasWithContext is the same as CreateAlias with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) CreateAliasWithContext(ctx aws.Context, input *CreateAliasInput, opts ...aws.Option) (*CreateAliasOutput, error) {
+	req, out := c.CreateAliasRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateComputer = "CreateComputer"
 
 // CreateComputerRequest generates a request for the CreateComputer operation.
@@ -97,6 +121,18 @@ func (c *DirectoryService) CreateComputer(input *CreateComputerInput) (*CreateCo
 	return out, err
 }
 
+// CreateComputerWithContext is the same as CreateComputer with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) CreateComputerWithContext(ctx aws.Context, input *CreateComputerInput, opts ...aws.Option) (*CreateComputerOutput, error) {
+	req, out := c.CreateComputerRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDirectory = "CreateDirectory"
 
 // CreateDirectoryRequest generates a request for the CreateDirectory operation.
@@ -124,6 +160,18 @@ func (c *DirectoryService) CreateDirectory(input *CreateDirectoryInput) (*Create
 	return out, err
 }
 
+// CreateDirectoryWithContext is the same as CreateDirectory with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) CreateDirectoryWithContext(ctx aws.Context, input *CreateDirectoryInput, opts ...aws.Option) (*CreateDirectoryOutput, error) {
+	req, out := c.CreateDirectoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshot = "CreateSnapshot"
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -153,6 +201,18 @@ func (c *DirectoryService) CreateSnapshot(input *CreateSnapshotInput) (*CreateSn
 	return out, err
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) CreateSnapshotWithContext(ctx aws.Context, input *CreateSnapshotInput, opts ...aws.Option) (*CreateSnapshotOutput, error) {
+	req, out := c.CreateSnapshotRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDirectory = "DeleteDirectory"
 
 // DeleteDirectoryRequest generates a request for the DeleteDirectory operation.
@@ -180,6 +240,18 @@ func (c *DirectoryService) DeleteDirectory(input *DeleteDirectoryInput) (*Delete
 	return out, err
 }
 
+// DeleteDirectoryWithContext is the same as DeleteDirectory with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) DeleteDirectoryWithContext(ctx aws.Context, input *DeleteDirectoryInput, opts ...aws.Option) (*DeleteDirectoryOutput, error) {
+	req, out := c.DeleteDirectoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSnapshot = "DeleteSnapshot"
 
 // DeleteSnapshotRequest generates a request for the DeleteSnapshot operation.
@@ -207,6 +279,18 @@ func (c *DirectoryService) DeleteSnapshot(input *DeleteSnapshotInput) (*DeleteSn
 	return out, err
 }
 
+// DeleteSnapshotWithContext is the same as DeleteSnapshot with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) DeleteSnapshotWithContext(ctx aws.Context, input *DeleteSnapshotInput, opts ...aws.Option) (*DeleteSnapshotOutput, error) {
+	req, out := c.DeleteSnapshotRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDirectories = "DescribeDirectories"
 
 // DescribeDirectoriesRequest generates a request for the DescribeDirectories operation.
@@ -245,6 +329,18 @@ func (c *DirectoryService) DescribeDirectories(input *DescribeDirectoriesInput)
 	return out, err
 }
 
+// DescribeDirectoriesWithContext is the same as DescribeDirectories with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) DescribeDirectoriesWithContext(ctx aws.Context, input *DescribeDirectoriesInput, opts ...aws.Option) (*DescribeDirectoriesOutput, error) {
+	req, out := c.DescribeDirectoriesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSnapshots = "DescribeSnapshots"
 
 // DescribeSnapshotsRequest generates a request for the DescribeSnapshots operation.
@@ -279,6 +375,18 @@ func (c *DirectoryService) DescribeSnapshots(input *DescribeSnapshotsInput) (*De
 	return out, err
 }
 
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) DescribeSnapshotsWithContext(ctx aws.Context, input *DescribeSnapshotsInput, opts ...aws.Option) (*DescribeSnapshotsOutput, error) {
+	req, out := c.DescribeSnapshotsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableRadius = "DisableRadius"
 
 // DisableRadiusRequest generates a request for the DisableRadius operation.
@@ -307,6 +415,18 @@ func (c *DirectoryService) DisableRadius(input *DisableRadiusInput) (*DisableRad
 	return out, err
 }
 
+// DisableRadiusWithContext is the same as DisableRadius with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) DisableRadiusWithContext(ctx aws.Context, input *DisableRadiusInput, opts ...aws.Option) (*DisableRadiusOutput, error) {
+	req, out := c.DisableRadiusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableSSO = "DisableSso"
 
 // DisableSSORequest generates a request for the DisableSSO operation.
@@ -334,6 +454,18 @@ func (c *DirectoryService) DisableSSO(input *DisableSSOInput) (*DisableSSOOutput
 	return out, err
 }
 
+// DisableSSOWithContext is the same as DisableSSO with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) DisableSSOWithContext(ctx aws.Context, input *DisableSSOInput, opts ...aws.Option) (*DisableSSOOutput, error) {
+	req, out := c.DisableSSORequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableRadius = "EnableRadius"
 
 // EnableRadiusRequest generates a request for the EnableRadius operation.
@@ -362,6 +494,18 @@ func (c *DirectoryService) EnableRadius(input *EnableRadiusInput) (*EnableRadius
 	return out, err
 }
 
+// EnableRadiusWithContext is the same as EnableRadius with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) EnableRadiusWithContext(ctx aws.Context, input *EnableRadiusInput, opts ...aws.Option) (*EnableRadiusOutput, error) {
+	req, out := c.EnableRadiusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableSSO = "EnableSso"
 
 // EnableSSORequest generates a request for the EnableSSO operation.
@@ -389,6 +533,18 @@ func (c *DirectoryService) EnableSSO(input *EnableSSOInput) (*EnableSSOOutput, e
 	return out, err
 }
 
+// EnableSSOWithContext is the same as EnableSSO with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) EnableSSOWithContext(ctx aws.Context, input *EnableSSOInput, opts ...aws.Option) (*EnableSSOOutput, error) {
+	req, out := c.EnableSSORequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDirectoryLimits = "GetDirectoryLimits"
 
 // GetDirectoryLimitsRequest generates a request for the GetDirectoryLimits operation.
@@ -416,6 +572,18 @@ func (c *DirectoryService) GetDirectoryLimits(input *GetDirectoryLimitsInput) (*
 	return out, err
 }
 
+// GetDirectoryLimitsWithContext is the same as GetDirectoryLimits with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) GetDirectoryLimitsWithContext(ctx aws.Context, input *GetDirectoryLimitsInput, opts ...aws.Option) (*GetDirectoryLimitsOutput, error) {
+	req, out := c.GetDirectoryLimitsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSnapshotLimits = "GetSnapshotLimits"
 
 // GetSnapshotLimitsRequest generates a request for the GetSnapshotLimits operation.
@@ -443,6 +611,18 @@ func (c *DirectoryService) GetSnapshotLimits(input *GetSnapshotLimitsInput) (*Ge
 	return out, err
 }
 
+// GetSnapshotLimitsWithContext is the same as GetSnapshotLimits with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) GetSnapshotLimitsWithContext(ctx aws.Context, input *GetSnapshotLimitsInput, opts ...aws.Option) (*GetSnapshotLimitsOutput, error) {
+	req, out := c.GetSnapshotLimitsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRestoreFromSnapshot = "RestoreFromSnapshot"
 
 // RestoreFromSnapshotRequest generates a request for the RestoreFromSnapshot operation.
@@ -478,6 +658,18 @@ func (c *DirectoryService) RestoreFromSnapshot(input *RestoreFromSnapshotInput)
 	return out, err
 }
 
+// RestoreFromSnapshotWithContext is the same as RestoreFromSnapshot with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) RestoreFromSnapshotWithContext(ctx aws.Context, input *RestoreFromSnapshotInput, opts ...aws.Option) (*RestoreFromSnapshotOutput, error) {
+	req, out := c.RestoreFromSnapshotRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateRadius = "UpdateRadius"
 
 // UpdateRadiusRequest generates a request for the UpdateRadius operation.
@@ -506,10 +698,22 @@ func (c *DirectoryService) UpdateRadius(input *UpdateRadiusInput) (*UpdateRadius
 	return out, err
 }
 
+// UpdateRadiusWithContext is the same as UpdateRadius with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DirectoryService) UpdateRadiusWithContext(ctx aws.Context, input *UpdateRadiusInput, opts ...aws.Option) (*UpdateRadiusOutput, error) {
+	req, out := c.UpdateRadiusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Represents a named directory attribute.
 type Attribute struct {
 	// The name of the attribute.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1"`
 
 	// The value of the attribute.
 	Value *string `type:"string"`
@@ -538,10 +742,10 @@ type Computer struct {
 	ComputerAttributes []*Attribute `type:"list"`
 
 	// The identifier of the computer.
-	ComputerID *string `locationName:"ComputerId" type:"string"`
+	ComputerID *string `locationName:"ComputerId" type:"string" min:"1" max:"256"`
 
 	// The computer name.
-	ComputerName *string `type:"string"`
+	ComputerName *string `type:"string" min:"1" max:"15"`
 
 	metadataComputer `json:"-" xml:"-"`
 }
@@ -567,13 +771,13 @@ type ConnectDirectoryInput struct {
 	ConnectSettings *DirectoryConnectSettings `type:"structure" required:"true"`
 
 	// A textual description for the directory.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"128"`
 
 	// The fully-qualified name of the on-premises directory, such as corp.example.com.
 	Name *string `type:"string" required:"true"`
 
 	// The password for the on-premises user account.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" sensitive:"true" min:"1" max:"128" required:"true"`
 
 	// The NetBIOS name of the on-premises directory, such as CORP.
 	ShortName *string `type:"string"`
@@ -626,7 +830,7 @@ type CreateAliasInput struct {
 	//
 	// The alias must be unique amongst all aliases in AWS. This operation will
 	// throw an EntityAlreadyExistsException if this alias already exists.
-	Alias *string `type:"string" required:"true"`
+	Alias *string `type:"string" min:"1" max:"62" required:"true"`
 
 	// The identifier of the directory to create the alias for.
 	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
@@ -651,7 +855,7 @@ func (s CreateAliasInput) GoString() string {
 // Contains the results of the CreateAlias operation.
 type CreateAliasOutput struct {
 	// The alias for the directory.
-	Alias *string `type:"string"`
+	Alias *string `type:"string" min:"1" max:"62"`
 
 	// The identifier of the directory.
 	DirectoryID *string `locationName:"DirectoryId" type:"string"`
@@ -680,18 +884,18 @@ type CreateComputerInput struct {
 	ComputerAttributes []*Attribute `type:"list"`
 
 	// The name of the computer account.
-	ComputerName *string `type:"string" required:"true"`
+	ComputerName *string `type:"string" min:"1" max:"15" required:"true"`
 
 	// The identifier of the directory to create the computer account in.
 	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
 
 	// The fully-qualified distinguished name of the organizational unit to place
 	// the computer account in.
-	OrganizationalUnitDistinguishedName *string `type:"string"`
+	OrganizationalUnitDistinguishedName *string `type:"string" min:"1" max:"2000"`
 
 	// A one-time password that is used to join the computer to the directory. You
 	// should generate a random, strong password to use for this parameter.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" sensitive:"true" min:"8" max:"64" required:"true"`
 
 	metadataCreateComputerInput `json:"-" xml:"-"`
 }
@@ -735,7 +939,7 @@ func (s CreateComputerOutput) GoString() string {
 // Contains the inputs for the CreateDirectory operation.
 type CreateDirectoryInput struct {
 	// A textual description for the directory.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"128"`
 
 	// The fully qualified name for the directory, such as corp.example.com.
 	Name *string `type:"string" required:"true"`
@@ -743,7 +947,7 @@ type CreateDirectoryInput struct {
 	// The password for the directory administrator. The directory creation process
 	// creates a directory administrator account with the username Administrator
 	// and this password.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" sensitive:"true" required:"true"`
 
 	// The short name of the directory, such as CORP.
 	ShortName *string `type:"string"`
@@ -800,7 +1004,7 @@ type CreateSnapshotInput struct {
 	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
 
 	// The descriptive name to apply to the snapshot.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"128"`
 
 	metadataCreateSnapshotInput `json:"-" xml:"-"`
 }
@@ -940,7 +1144,7 @@ type DescribeDirectoriesInput struct {
 
 	// The maximum number of items to return. If this value is zero, the maximum
 	// number of items is specified by the limitations of the operation.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"0"`
 
 	// The DescribeDirectoriesResult.NextToken value from a previous call to DescribeDirectories.
 	// Pass null if this is the first call.
@@ -1001,7 +1205,7 @@ type DescribeSnapshotsInput struct {
 	DirectoryID *string `locationName:"DirectoryId" type:"string"`
 
 	// The maximum number of objects to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"0"`
 
 	// The DescribeSnapshotsResult.NextToken value from a previous call to DescribeSnapshots.
 	// Pass null if this is the first call.
@@ -1071,7 +1275,7 @@ type DirectoryConnectSettings struct {
 	// to the directory. This account must have the following privileges:
 	//
 	//  Read users and groups Create computer objects Join computers to the domain
-	CustomerUserName *string `type:"string" required:"true"`
+	CustomerUserName *string `type:"string" min:"1" required:"true"`
 
 	// A list of subnet identifiers in the VPC that the AD Connector is created
 	// in.
@@ -1106,7 +1310,7 @@ type DirectoryConnectSettingsDescription struct {
 	ConnectIPs []*string `locationName:"ConnectIps" type:"list"`
 
 	// The username of the service account in the on-premises directory.
-	CustomerUserName *string `type:"string"`
+	CustomerUserName *string `type:"string" min:"1"`
 
 	// The security group identifier for the AD Connector directory.
 	SecurityGroupID *string `locationName:"SecurityGroupId" type:"string"`
@@ -1137,10 +1341,10 @@ func (s DirectoryConnectSettingsDescription) GoString() string {
 // Contains information about an AWS Directory Service directory.
 type DirectoryDescription struct {
 	// The access URL for the directory, such as http://<alias>.awsapps.com.
-	AccessURL *string `locationName:"AccessUrl" type:"string"`
+	AccessURL *string `locationName:"AccessUrl" type:"string" min:"1" max:"128"`
 
 	// The alias for the directory.
-	Alias *string `type:"string"`
+	Alias *string `type:"string" min:"1" max:"62"`
 
 	// A DirectoryConnectSettingsDescription object that contains additional information
 	// about an AD Connector directory. This member is only present if the directory
@@ -1155,7 +1359,7 @@ type DirectoryDescription struct {
 	DNSIPAddrs []*string `locationName:"DnsIpAddrs" type:"list"`
 
 	// The textual description for the directory.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"128"`
 
 	// The directory identifier.
 	DirectoryID *string `locationName:"DirectoryId" type:"string"`
@@ -1220,19 +1424,19 @@ func (s DirectoryDescription) GoString() string {
 // Contains directory limit information for a region.
 type DirectoryLimits struct {
 	// The current number of cloud directories in the region.
-	CloudOnlyDirectoriesCurrentCount *int64 `type:"integer"`
+	CloudOnlyDirectoriesCurrentCount *int64 `type:"integer" min:"0"`
 
 	// The maximum number of cloud directories allowed in the region.
-	CloudOnlyDirectoriesLimit *int64 `type:"integer"`
+	CloudOnlyDirectoriesLimit *int64 `type:"integer" min:"0"`
 
 	// Indicates if the cloud directory limit has been reached.
 	CloudOnlyDirectoriesLimitReached *bool `type:"boolean"`
 
 	// The current number of connected directories in the region.
-	ConnectedDirectoriesCurrentCount *int64 `type:"integer"`
+	ConnectedDirectoriesCurrentCount *int64 `type:"integer" min:"0"`
 
 	// The maximum number of connected directories allowed in the region.
-	ConnectedDirectoriesLimit *int64 `type:"integer"`
+	ConnectedDirectoriesLimit *int64 `type:"integer" min:"0"`
 
 	// Indicates if the connected directory limit has been reached.
 	ConnectedDirectoriesLimitReached *bool `type:"boolean"`
@@ -1362,7 +1566,7 @@ type DisableSSOInput struct {
 	// The password of an alternate account to use to disable single-sign on. This
 	// is only used for AD Connector directories. See the UserName parameter for
 	// more information.
-	Password *string `type:"string"`
+	Password *string `type:"string" sensitive:"true" min:"1" max:"128"`
 
 	// The username of an alternate account to use to disable single-sign on. This
 	// is only used for AD Connector directories. This account must have privileges
@@ -1373,7 +1577,7 @@ type DisableSSOInput struct {
 	// and Password parameters. These credentials are only used to disable single
 	// sign-on and are not stored by the service. The AD Connector service account
 	// is not changed.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1"`
 
 	metadataDisableSSOInput `json:"-" xml:"-"`
 }
@@ -1463,7 +1667,7 @@ type EnableSSOInput struct {
 	// The password of an alternate account to use to enable single-sign on. This
 	// is only used for AD Connector directories. See the UserName parameter for
 	// more information.
-	Password *string `type:"string"`
+	Password *string `type:"string" sensitive:"true" min:"1" max:"128"`
 
 	// The username of an alternate account to use to enable single-sign on. This
 	// is only used for AD Connector directories. This account must have privileges
@@ -1474,7 +1678,7 @@ type EnableSSOInput struct {
 	// Password parameters. These credentials are only used to enable single sign-on
 	// and are not stored by the service. The AD Connector service account is not
 	// changed.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1"`
 
 	metadataEnableSSOInput `json:"-" xml:"-"`
 }
@@ -1606,27 +1810,27 @@ type RadiusSettings struct {
 	AuthenticationProtocol *string `type:"string"`
 
 	// Not currently used.
-	DisplayLabel *string `type:"string"`
+	DisplayLabel *string `type:"string" min:"1" max:"64"`
 
 	// The port that your RADIUS server is using for communications. Your on-premises
 	// network must allow inbound traffic over this port from the AWS Directory
 	// Service servers.
-	RadiusPort *int64 `type:"integer"`
+	RadiusPort *int64 `type:"integer" min:"1025" max:"65535"`
 
 	// The maximum number of times that communication with the RADIUS server is
 	// attempted.
-	RadiusRetries *int64 `type:"integer"`
+	RadiusRetries *int64 `type:"integer" min:"0" max:"10"`
 
 	// An array of strings that contains the IP addresses of the RADIUS server endpoints,
 	// or the IP addresses of your RADIUS server load balancer.
 	RadiusServers []*string `type:"list"`
 
 	// The amount of time, in seconds, to wait for the RADIUS server to respond.
-	RadiusTimeout *int64 `type:"integer"`
+	RadiusTimeout *int64 `type:"integer" min:"1" max:"20"`
 
 	// The shared secret code that was specified when your RADIUS endpoints were
 	// created.
-	SharedSecret *string `type:"string"`
+	SharedSecret *string `type:"string" sensitive:"true" min:"8" max:"512"`
 
 	// Not currently used.
 	UseSameUsername *bool `type:"boolean"`
@@ -1695,7 +1899,7 @@ type Snapshot struct {
 	DirectoryID *string `locationName:"DirectoryId" type:"string"`
 
 	// The descriptive name of the snapshot.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"128"`
 
 	// The snapshot identifier.
 	SnapshotID *string `locationName:"SnapshotId" type:"string"`
@@ -1729,10 +1933,10 @@ func (s Snapshot) GoString() string {
 // Contains manual snapshot limit information for a directory.
 type SnapshotLimits struct {
 	// The current number of manual snapshots of the directory.
-	ManualSnapshotsCurrentCount *int64 `type:"integer"`
+	ManualSnapshotsCurrentCount *int64 `type:"integer" min:"0"`
 
 	// The maximum number of manual snapshots allowed.
-	ManualSnapshotsLimit *int64 `type:"integer"`
+	ManualSnapshotsLimit *int64 `type:"integer" min:"0"`
 
 	// Indicates if the manual snapshot limit has been reached.
 	ManualSnapshotsLimitReached *bool `type:"boolean"`
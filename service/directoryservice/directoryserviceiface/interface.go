@@ -4,6 +4,7 @@
 package directoryserviceiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 )
 
@@ -11,35 +12,69 @@ import (
 type DirectoryServiceAPI interface {
 	ConnectDirectory(*directoryservice.ConnectDirectoryInput) (*directoryservice.ConnectDirectoryOutput, error)
 
+	ConnectDirectoryWithContext(aws.Context, *directoryservice.ConnectDirectoryInput, ...aws.Option) (*directoryservice.ConnectDirectoryOutput, error)
+
 	CreateAlias(*directoryservice.CreateAliasInput) (*directoryservice.CreateAliasOutput, error)
 
+	CreateAliasWithContext(aws.Context, *directoryservice.CreateAliasInput, ...aws.Option) (*directoryservice.CreateAliasOutput, error)
+
 	CreateComputer(*directoryservice.CreateComputerInput) (*directoryservice.CreateComputerOutput, error)
 
+	CreateComputerWithContext(aws.Context, *directoryservice.CreateComputerInput, ...aws.Option) (*directoryservice.CreateComputerOutput, error)
+
 	CreateDirectory(*directoryservice.CreateDirectoryInput) (*directoryservice.CreateDirectoryOutput, error)
 
+	CreateDirectoryWithContext(aws.Context, *directoryservice.CreateDirectoryInput, ...aws.Option) (*directoryservice.CreateDirectoryOutput, error)
+
 	CreateSnapshot(*directoryservice.CreateSnapshotInput) (*directoryservice.CreateSnapshotOutput, error)
 
+	CreateSnapshotWithContext(aws.Context, *directoryservice.CreateSnapshotInput, ...aws.Option) (*directoryservice.CreateSnapshotOutput, error)
+
 	DeleteDirectory(*directoryservice.DeleteDirectoryInput) (*directoryservice.DeleteDirectoryOutput, error)
 
+	DeleteDirectoryWithContext(aws.Context, *directoryservice.DeleteDirectoryInput, ...aws.Option) (*directoryservice.DeleteDirectoryOutput, error)
+
 	DeleteSnapshot(*directoryservice.DeleteSnapshotInput) (*directoryservice.DeleteSnapshotOutput, error)
 
+	DeleteSnapshotWithContext(aws.Context, *directoryservice.DeleteSnapshotInput, ...aws.Option) (*directoryservice.DeleteSnapshotOutput, error)
+
 	DescribeDirectories(*directoryservice.DescribeDirectoriesInput) (*directoryservice.DescribeDirectoriesOutput, error)
 
+	DescribeDirectoriesWithContext(aws.Context, *directoryservice.DescribeDirectoriesInput, ...aws.Option) (*directoryservice.DescribeDirectoriesOutput, error)
+
 	DescribeSnapshots(*directoryservice.DescribeSnapshotsInput) (*directoryservice.DescribeSnapshotsOutput, error)
 
+	DescribeSnapshotsWithContext(aws.Context, *directoryservice.DescribeSnapshotsInput, ...aws.Option) (*directoryservice.DescribeSnapshotsOutput, error)
+
 	DisableRadius(*directoryservice.DisableRadiusInput) (*directoryservice.DisableRadiusOutput, error)
 
+	DisableRadiusWithContext(aws.Context, *directoryservice.DisableRadiusInput, ...aws.Option) (*directoryservice.DisableRadiusOutput, error)
+
 	DisableSSO(*directoryservice.DisableSSOInput) (*directoryservice.DisableSSOOutput, error)
 
+	DisableSSOWithContext(aws.Context, *directoryservice.DisableSSOInput, ...aws.Option) (*directoryservice.DisableSSOOutput, error)
+
 	EnableRadius(*directoryservice.EnableRadiusInput) (*directoryservice.EnableRadiusOutput, error)
 
+	EnableRadiusWithContext(aws.Context, *directoryservice.EnableRadiusInput, ...aws.Option) (*directoryservice.EnableRadiusOutput, error)
+
 	EnableSSO(*directoryservice.EnableSSOInput) (*directoryservice.EnableSSOOutput, error)
 
+	EnableSSOWithContext(aws.Context, *directoryservice.EnableSSOInput, ...aws.Option) (*directoryservice.EnableSSOOutput, error)
+
 	GetDirectoryLimits(*directoryservice.GetDirectoryLimitsInput) (*directoryservice.GetDirectoryLimitsOutput, error)
 
+	GetDirectoryLimitsWithContext(aws.Context, *directoryservice.GetDirectoryLimitsInput, ...aws.Option) (*directoryservice.GetDirectoryLimitsOutput, error)
+
 	GetSnapshotLimits(*directoryservice.GetSnapshotLimitsInput) (*directoryservice.GetSnapshotLimitsOutput, error)
 
+	GetSnapshotLimitsWithContext(aws.Context, *directoryservice.GetSnapshotLimitsInput, ...aws.Option) (*directoryservice.GetSnapshotLimitsOutput, error)
+
 	RestoreFromSnapshot(*directoryservice.RestoreFromSnapshotInput) (*directoryservice.RestoreFromSnapshotOutput, error)
 
+	RestoreFromSnapshotWithContext(aws.Context, *directoryservice.RestoreFromSnapshotInput, ...aws.Option) (*directoryservice.RestoreFromSnapshotOutput, error)
+
 	UpdateRadius(*directoryservice.UpdateRadiusInput) (*directoryservice.UpdateRadiusOutput, error)
+
+	UpdateRadiusWithContext(aws.Context, *directoryservice.UpdateRadiusInput, ...aws.Option) (*directoryservice.UpdateRadiusOutput, error)
 }
@@ -24,6 +24,7 @@ func (c *DynamoDB) BatchGetItemRequest(input *BatchGetItemInput) (req *aws.Reque
 			LimitToken:      "",
 			TruncationToken: "",
 		},
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -91,6 +92,22 @@ func (c *DynamoDB) BatchGetItem(input *BatchGetItemInput) (*BatchGetItemOutput,
 	return out, err
 }
 
+// BatchGetItemWithContext is the same as BatchGetItem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) BatchGetItemWithContext(ctx aws.Context, input *BatchGetItemInput, opts ...aws.Option) (*BatchGetItemOutput, error) {
+	req, out := c.BatchGetItemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// BatchGetItemPages iterates over the pages of a BatchGetItem operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *DynamoDB) BatchGetItemPages(input *BatchGetItemInput, fn func(p *BatchGetItemOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.BatchGetItemRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -98,14 +115,28 @@ func (c *DynamoDB) BatchGetItemPages(input *BatchGetItemInput, fn func(p *BatchG
 	})
 }
 
+// BatchGetItemPagesWithContext is the same as BatchGetItemPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *DynamoDB) BatchGetItemPagesWithContext(ctx aws.Context, input *BatchGetItemInput, fn func(p *BatchGetItemOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.BatchGetItemRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*BatchGetItemOutput), lastPage)
+	})
+}
+
 const opBatchWriteItem = "BatchWriteItem"
 
 // BatchWriteItemRequest generates a request for the BatchWriteItem operation.
 func (c *DynamoDB) BatchWriteItemRequest(input *BatchWriteItemInput) (req *aws.Request, output *BatchWriteItemOutput) {
 	op := &aws.Operation{
-		Name:       opBatchWriteItem,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opBatchWriteItem,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -193,14 +224,27 @@ func (c *DynamoDB) BatchWriteItem(input *BatchWriteItemInput) (*BatchWriteItemOu
 	return out, err
 }
 
+// BatchWriteItemWithContext is the same as BatchWriteItem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) BatchWriteItemWithContext(ctx aws.Context, input *BatchWriteItemInput, opts ...aws.Option) (*BatchWriteItemOutput, error) {
+	req, out := c.BatchWriteItemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTable = "CreateTable"
 
 // CreateTableRequest generates a request for the CreateTable operation.
 func (c *DynamoDB) CreateTableRequest(input *CreateTableInput) (req *aws.Request, output *CreateTableOutput) {
 	op := &aws.Operation{
-		Name:       opCreateTable,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opCreateTable,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -234,14 +278,27 @@ func (c *DynamoDB) CreateTable(input *CreateTableInput) (*CreateTableOutput, err
 	return out, err
 }
 
+// CreateTableWithContext is the same as CreateTable with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) CreateTableWithContext(ctx aws.Context, input *CreateTableInput, opts ...aws.Option) (*CreateTableOutput, error) {
+	req, out := c.CreateTableRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteItem = "DeleteItem"
 
 // DeleteItemRequest generates a request for the DeleteItem operation.
 func (c *DynamoDB) DeleteItemRequest(input *DeleteItemInput) (req *aws.Request, output *DeleteItemOutput) {
 	op := &aws.Operation{
-		Name:       opDeleteItem,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opDeleteItem,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -274,14 +331,27 @@ func (c *DynamoDB) DeleteItem(input *DeleteItemInput) (*DeleteItemOutput, error)
 	return out, err
 }
 
+// DeleteItemWithContext is the same as DeleteItem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) DeleteItemWithContext(ctx aws.Context, input *DeleteItemInput, opts ...aws.Option) (*DeleteItemOutput, error) {
+	req, out := c.DeleteItemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTable = "DeleteTable"
 
 // DeleteTableRequest generates a request for the DeleteTable operation.
 func (c *DynamoDB) DeleteTableRequest(input *DeleteTableInput) (req *aws.Request, output *DeleteTableOutput) {
 	op := &aws.Operation{
-		Name:       opDeleteTable,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opDeleteTable,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -318,14 +388,66 @@ func (c *DynamoDB) DeleteTable(input *DeleteTableInput) (*DeleteTableOutput, err
 	return out, err
 }
 
+// DeleteTableWithContext is the same as DeleteTable with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) DeleteTableWithContext(ctx aws.Context, input *DeleteTableInput, opts ...aws.Option) (*DeleteTableOutput, error) {
+	req, out := c.DeleteTableRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+const opDescribeEndpoints = "DescribeEndpoints"
+
+// DescribeEndpointsRequest generates a request for the DescribeEndpoints operation.
+func (c *DynamoDB) DescribeEndpointsRequest(input *DescribeEndpointsInput) (req *aws.Request, output *DescribeEndpointsOutput) {
+	op := &aws.Operation{
+		Name:       opDescribeEndpoints,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DescribeEndpointsInput{}
+	}
+
+	req = c.newRequest(op, input, output)
+	output = &DescribeEndpointsOutput{}
+	req.Data = output
+	return
+}
+
+// Returns the regional endpoint information.
+func (c *DynamoDB) DescribeEndpoints(input *DescribeEndpointsInput) (*DescribeEndpointsOutput, error) {
+	req, out := c.DescribeEndpointsRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeEndpointsWithContext is the same as DescribeEndpoints with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) DescribeEndpointsWithContext(ctx aws.Context, input *DescribeEndpointsInput, opts ...aws.Option) (*DescribeEndpointsOutput, error) {
+	req, out := c.DescribeEndpointsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTable = "DescribeTable"
 
 // DescribeTableRequest generates a request for the DescribeTable operation.
 func (c *DynamoDB) DescribeTableRequest(input *DescribeTableInput) (req *aws.Request, output *DescribeTableOutput) {
 	op := &aws.Operation{
-		Name:       opDescribeTable,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opDescribeTable,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -353,14 +475,27 @@ func (c *DynamoDB) DescribeTable(input *DescribeTableInput) (*DescribeTableOutpu
 	return out, err
 }
 
+// DescribeTableWithContext is the same as DescribeTable with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) DescribeTableWithContext(ctx aws.Context, input *DescribeTableInput, opts ...aws.Option) (*DescribeTableOutput, error) {
+	req, out := c.DescribeTableRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetItem = "GetItem"
 
 // GetItemRequest generates a request for the GetItem operation.
 func (c *DynamoDB) GetItemRequest(input *GetItemInput) (req *aws.Request, output *GetItemOutput) {
 	op := &aws.Operation{
-		Name:       opGetItem,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opGetItem,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -386,6 +521,18 @@ func (c *DynamoDB) GetItem(input *GetItemInput) (*GetItemOutput, error) {
 	return out, err
 }
 
+// GetItemWithContext is the same as GetItem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) GetItemWithContext(ctx aws.Context, input *GetItemInput, opts ...aws.Option) (*GetItemOutput, error) {
+	req, out := c.GetItemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListTables = "ListTables"
 
 // ListTablesRequest generates a request for the ListTables operation.
@@ -399,7 +546,9 @@ func (c *DynamoDB) ListTablesRequest(input *ListTablesInput) (req *aws.Request,
 			OutputTokens:    []string{"LastEvaluatedTableName"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"TableNames"},
 		},
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -421,6 +570,22 @@ func (c *DynamoDB) ListTables(input *ListTablesInput) (*ListTablesOutput, error)
 	return out, err
 }
 
+// ListTablesWithContext is the same as ListTables with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) ListTablesWithContext(ctx aws.Context, input *ListTablesInput, opts ...aws.Option) (*ListTablesOutput, error) {
+	req, out := c.ListTablesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListTablesPages iterates over the pages of a ListTables operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *DynamoDB) ListTablesPages(input *ListTablesInput, fn func(p *ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTablesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -428,14 +593,28 @@ func (c *DynamoDB) ListTablesPages(input *ListTablesInput, fn func(p *ListTables
 	})
 }
 
+// ListTablesPagesWithContext is the same as ListTablesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *DynamoDB) ListTablesPagesWithContext(ctx aws.Context, input *ListTablesInput, fn func(p *ListTablesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListTablesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTablesOutput), lastPage)
+	})
+}
+
 const opPutItem = "PutItem"
 
 // PutItemRequest generates a request for the PutItem operation.
 func (c *DynamoDB) PutItemRequest(input *PutItemInput) (req *aws.Request, output *PutItemOutput) {
 	op := &aws.Operation{
-		Name:       opPutItem,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opPutItem,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -479,6 +658,18 @@ func (c *DynamoDB) PutItem(input *PutItemInput) (*PutItemOutput, error) {
 	return out, err
 }
 
+// PutItemWithContext is the same as PutItem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) PutItemWithContext(ctx aws.Context, input *PutItemInput, opts ...aws.Option) (*PutItemOutput, error) {
+	req, out := c.PutItemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opQuery = "Query"
 
 // QueryRequest generates a request for the Query operation.
@@ -492,7 +683,9 @@ func (c *DynamoDB) QueryRequest(input *QueryInput) (req *aws.Request, output *Qu
 			OutputTokens:    []string{"LastEvaluatedKey"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Items"},
 		},
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -536,6 +729,22 @@ func (c *DynamoDB) Query(input *QueryInput) (*QueryOutput, error) {
 	return out, err
 }
 
+// QueryWithContext is the same as Query with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) QueryWithContext(ctx aws.Context, input *QueryInput, opts ...aws.Option) (*QueryOutput, error) {
+	req, out := c.QueryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// QueryPages iterates over the pages of a Query operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *DynamoDB) QueryPages(input *QueryInput, fn func(p *QueryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.QueryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -543,6 +752,19 @@ func (c *DynamoDB) QueryPages(input *QueryInput, fn func(p *QueryOutput, lastPag
 	})
 }
 
+// QueryPagesWithContext is the same as QueryPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *DynamoDB) QueryPagesWithContext(ctx aws.Context, input *QueryInput, fn func(p *QueryOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.QueryRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*QueryOutput), lastPage)
+	})
+}
+
 const opScan = "Scan"
 
 // ScanRequest generates a request for the Scan operation.
@@ -556,7 +778,9 @@ func (c *DynamoDB) ScanRequest(input *ScanInput) (req *aws.Request, output *Scan
 			OutputTokens:    []string{"LastEvaluatedKey"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Items"},
 		},
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -594,6 +818,22 @@ func (c *DynamoDB) Scan(input *ScanInput) (*ScanOutput, error) {
 	return out, err
 }
 
+// ScanWithContext is the same as Scan with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) ScanWithContext(ctx aws.Context, input *ScanInput, opts ...aws.Option) (*ScanOutput, error) {
+	req, out := c.ScanRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ScanPages iterates over the pages of a Scan operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *DynamoDB) ScanPages(input *ScanInput, fn func(p *ScanOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ScanRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -601,14 +841,28 @@ func (c *DynamoDB) ScanPages(input *ScanInput, fn func(p *ScanOutput, lastPage b
 	})
 }
 
+// ScanPagesWithContext is the same as ScanPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *DynamoDB) ScanPagesWithContext(ctx aws.Context, input *ScanInput, fn func(p *ScanOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ScanRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ScanOutput), lastPage)
+	})
+}
+
 const opUpdateItem = "UpdateItem"
 
 // UpdateItemRequest generates a request for the UpdateItem operation.
 func (c *DynamoDB) UpdateItemRequest(input *UpdateItemInput) (req *aws.Request, output *UpdateItemOutput) {
 	op := &aws.Operation{
-		Name:       opUpdateItem,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opUpdateItem,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -637,14 +891,27 @@ func (c *DynamoDB) UpdateItem(input *UpdateItemInput) (*UpdateItemOutput, error)
 	return out, err
 }
 
+// UpdateItemWithContext is the same as UpdateItem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) UpdateItemWithContext(ctx aws.Context, input *UpdateItemInput, opts ...aws.Option) (*UpdateItemOutput, error) {
+	req, out := c.UpdateItemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateTable = "UpdateTable"
 
 // UpdateTableRequest generates a request for the UpdateTable operation.
 func (c *DynamoDB) UpdateTableRequest(input *UpdateTableInput) (req *aws.Request, output *UpdateTableOutput) {
 	op := &aws.Operation{
-		Name:       opUpdateTable,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:              opUpdateTable,
+		HTTPMethod:        "POST",
+		HTTPPath:          "/",
+		EndpointDiscovery: true,
 	}
 
 	if input == nil {
@@ -681,10 +948,22 @@ func (c *DynamoDB) UpdateTable(input *UpdateTableInput) (*UpdateTableOutput, err
 	return out, err
 }
 
+// UpdateTableWithContext is the same as UpdateTable with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDB) UpdateTableWithContext(ctx aws.Context, input *UpdateTableInput, opts ...aws.Option) (*UpdateTableOutput, error) {
+	req, out := c.UpdateTableRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Represents an attribute for describing the key schema for the table and indexes.
 type AttributeDefinition struct {
 	// A name for the attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The data type for the attribute.
 	AttributeType *string `type:"string" required:"true"`
@@ -944,7 +1223,7 @@ type BatchGetItemInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	RequestItems map[string]*KeysAndAttributes `type:"map" required:"true"`
+	RequestItems map[string]*KeysAndAttributes `type:"map" min:"1" max:"100" required:"true"`
 
 	// Determines the level of detail about provisioned throughput consumption that
 	// is returned in the response:
@@ -1016,7 +1295,7 @@ type BatchGetItemOutput struct {
 	//
 	//   If there are no unprocessed keys remaining, the response contains an empty
 	// UnprocessedKeys map.
-	UnprocessedKeys map[string]*KeysAndAttributes `type:"map"`
+	UnprocessedKeys map[string]*KeysAndAttributes `type:"map" min:"1" max:"100"`
 
 	metadataBatchGetItemOutput `json:"-" xml:"-"`
 }
@@ -1063,7 +1342,7 @@ type BatchWriteItemInput struct {
 	// If you specify any attributes that are part of an index key, then the data
 	// types for those attributes must match those of the schema in the table's
 	// attribute definition.
-	RequestItems map[string][]*WriteRequest `type:"map" required:"true"`
+	RequestItems map[string][]*WriteRequest `type:"map" min:"1" max:"25" required:"true"`
 
 	// Determines the level of detail about provisioned throughput consumption that
 	// is returned in the response:
@@ -1166,7 +1445,7 @@ type BatchWriteItemOutput struct {
 	//
 	//     If there are no unprocessed items remaining, the response contains an
 	// empty UnprocessedItems map.
-	UnprocessedItems map[string][]*WriteRequest `type:"map"`
+	UnprocessedItems map[string][]*WriteRequest `type:"map" min:"1" max:"25"`
 
 	metadataBatchWriteItemOutput `json:"-" xml:"-"`
 }
@@ -1406,7 +1685,7 @@ type ConsumedCapacity struct {
 	Table *Capacity `type:"structure"`
 
 	// The name of the table that was affected by the operation.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255"`
 
 	metadataConsumedCapacity `json:"-" xml:"-"`
 }
@@ -1428,10 +1707,10 @@ func (s ConsumedCapacity) GoString() string {
 // Represents a new global secondary index to be added to an existing table.
 type CreateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be created.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	// The key schema for the global secondary index.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -1520,7 +1799,7 @@ type CreateTableInput struct {
 	//
 	// For more information, see Specifying the Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#WorkingWithTables.primary.key)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// One or more local secondary indexes (the maximum is five) to be created on
 	// the table. Each index is scoped to a given hash key value. There is a 10
@@ -1587,7 +1866,7 @@ type CreateTableInput struct {
 	StreamSpecification *StreamSpecification `type:"structure"`
 
 	// The name of the table to create.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataCreateTableInput `json:"-" xml:"-"`
 }
@@ -1631,7 +1910,7 @@ func (s CreateTableOutput) GoString() string {
 // Represents a global secondary index to be deleted from an existing table.
 type DeleteGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be deleted.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataDeleteGlobalSecondaryIndexAction `json:"-" xml:"-"`
 }
@@ -1989,7 +2268,7 @@ type DeleteItemInput struct {
 	ReturnValues *string `type:"string"`
 
 	// The name of the table from which to delete the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataDeleteItemInput `json:"-" xml:"-"`
 }
@@ -2088,7 +2367,7 @@ func (s DeleteRequest) GoString() string {
 // Represents the input of a DeleteTable operation.
 type DeleteTableInput struct {
 	// The name of the table to delete.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataDeleteTableInput `json:"-" xml:"-"`
 }
@@ -2129,10 +2408,49 @@ func (s DeleteTableOutput) GoString() string {
 	return s.String()
 }
 
+type DescribeEndpointsInput struct {
+	metadataDescribeEndpointsInput `json:"-" xml:"-"`
+}
+
+type metadataDescribeEndpointsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s DescribeEndpointsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEndpointsInput) GoString() string {
+	return s.String()
+}
+
+type DescribeEndpointsOutput struct {
+	// List of endpoints.
+	Endpoints []*Endpoint `type:"list" required:"true"`
+
+	metadataDescribeEndpointsOutput `json:"-" xml:"-"`
+}
+
+type metadataDescribeEndpointsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s DescribeEndpointsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEndpointsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeTable operation.
 type DescribeTableInput struct {
 	// The name of the table to describe.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataDescribeTableInput `json:"-" xml:"-"`
 }
@@ -2173,6 +2491,31 @@ func (s DescribeTableOutput) GoString() string {
 	return s.String()
 }
 
+// An endpoint information details.
+type Endpoint struct {
+	// IP address of the endpoint.
+	Address *string `type:"string" required:"true"`
+
+	// Endpoint cache time to live (TTL) value.
+	CachePeriodInMinutes *int64 `type:"long" required:"true"`
+
+	metadataEndpoint `json:"-" xml:"-"`
+}
+
+type metadataEndpoint struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s Endpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Endpoint) GoString() string {
+	return s.String()
+}
+
 // Represents a condition to be compared with an attribute value. This condition
 // can be used with DeleteItem, PutItem or UpdateItem operations; if the comparison
 // evaluates to true, the operation succeeds; if not, the operation fails. You
@@ -2407,7 +2750,7 @@ type GetItemInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// Determines the read consistency model: If set to true, then the operation
 	// uses strongly consistent reads; otherwise, the operation uses eventually
@@ -2491,7 +2834,7 @@ type GetItemInput struct {
 	ReturnConsumedCapacity *string `type:"string"`
 
 	// The name of the table containing the requested item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataGetItemInput `json:"-" xml:"-"`
 }
@@ -2544,11 +2887,11 @@ func (s GetItemOutput) GoString() string {
 type GlobalSecondaryIndex struct {
 	// The name of the global secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	// The complete key schema for a global secondary index, which consists of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2597,7 +2940,7 @@ type GlobalSecondaryIndexDescription struct {
 	IndexARN *string `locationName:"IndexArn" type:"string"`
 
 	// The name of the global secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2621,7 +2964,7 @@ type GlobalSecondaryIndexDescription struct {
 
 	// The complete key schema for the global secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2741,7 +3084,7 @@ func (s ItemCollectionMetrics) GoString() string {
 // the hash attribute, and another KeySchemaElement for the range attribute.
 type KeySchemaElement struct {
 	// The name of a key attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The attribute data, consisting of the data type and the attribute value itself.
 	KeyType *string `type:"string" required:"true"`
@@ -2774,7 +3117,7 @@ type KeysAndAttributes struct {
 	// One or more attributes to retrieve from the table or index. If no attribute
 	// names are specified then all attributes will be returned. If any of the specified
 	// attributes are not found, they will not appear in the result.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// The consistency of a read operation. If set to true, then a strongly consistent
 	// read is used; otherwise, an eventually consistent read is used.
@@ -2818,7 +3161,7 @@ type KeysAndAttributes struct {
 
 	// The primary key attribute values that define the items and the attributes
 	// associated with the items.
-	Keys []map[string]*AttributeValue `type:"list" required:"true"`
+	Keys []map[string]*AttributeValue `type:"list" min:"1" max:"100" required:"true"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -2856,11 +3199,11 @@ type ListTablesInput struct {
 	// The first table name that this operation will evaluate. Use the value that
 	// was returned for LastEvaluatedTableName in a previous operation, so that
 	// you can obtain the next page of results.
-	ExclusiveStartTableName *string `type:"string"`
+	ExclusiveStartTableName *string `type:"string" min:"3" max:"255"`
 
 	// A maximum number of table names to return. If this parameter is not specified,
 	// the limit is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	metadataListTablesInput `json:"-" xml:"-"`
 }
@@ -2887,7 +3230,7 @@ type ListTablesOutput struct {
 	//
 	// If you do not receive a LastEvaluatedTableName value in the response, this
 	// means that there are no more table names to be retrieved.
-	LastEvaluatedTableName *string `type:"string"`
+	LastEvaluatedTableName *string `type:"string" min:"3" max:"255"`
 
 	// The names of the tables associated with the current account at the current
 	// endpoint. The maximum size of this array is 100.
@@ -2918,11 +3261,11 @@ func (s ListTablesOutput) GoString() string {
 type LocalSecondaryIndex struct {
 	// The name of the local secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	// The complete key schema for the local secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2952,7 +3295,7 @@ type LocalSecondaryIndexDescription struct {
 	IndexARN *string `locationName:"IndexArn" type:"string"`
 
 	// Represents the name of the local secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2965,7 +3308,7 @@ type LocalSecondaryIndexDescription struct {
 
 	// The complete index key schema, which consists of one or more pairs of attribute
 	// names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2999,7 +3342,7 @@ type Projection struct {
 	// across all of the local secondary indexes, must not exceed 20. If you project
 	// the same attribute into two different indexes, this counts as two distinct
 	// attributes when determining the total.
-	NonKeyAttributes []*string `type:"list"`
+	NonKeyAttributes []*string `type:"list" min:"1" max:"20"`
 
 	// The set of attributes that are projected into the index:
 	//
@@ -3039,13 +3382,13 @@ type ProvisionedThroughput struct {
 	// DynamoDB returns a ThrottlingException. For more information, see Specifying
 	// Read and Write Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	ReadCapacityUnits *int64 `type:"long" required:"true"`
+	ReadCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException. For more information, see Specifying Read and Write
 	// Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	WriteCapacityUnits *int64 `type:"long" required:"true"`
+	WriteCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	metadataProvisionedThroughput `json:"-" xml:"-"`
 }
@@ -3077,17 +3420,17 @@ type ProvisionedThroughputDescription struct {
 	// UTC calendar day. For current maximums on provisioned throughput decreases,
 	// see Limits (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
-	NumberOfDecreasesToday *int64 `type:"long"`
+	NumberOfDecreasesToday *int64 `type:"long" min:"1"`
 
 	// The maximum number of strongly consistent reads consumed per second before
 	// DynamoDB returns a ThrottlingException. Eventually consistent reads require
 	// less effort than strongly consistent reads, so a setting of 50 ReadCapacityUnits
 	// per second provides 100 eventually consistent ReadCapacityUnits per second.
-	ReadCapacityUnits *int64 `type:"long"`
+	ReadCapacityUnits *int64 `type:"long" min:"1"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException.
-	WriteCapacityUnits *int64 `type:"long"`
+	WriteCapacityUnits *int64 `type:"long" min:"1"`
 
 	metadataProvisionedThroughputDescription `json:"-" xml:"-"`
 }
@@ -3459,7 +3802,7 @@ type PutItemInput struct {
 	ReturnValues *string `type:"string"`
 
 	// The name of the table to contain the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataPutItemInput `json:"-" xml:"-"`
 }
@@ -3589,7 +3932,7 @@ type QueryInput struct {
 	// If you query a global secondary index, you can only request attributes that
 	// are projected into the index. Global secondary index queries cannot fetch
 	// attributes from the parent table.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use FilterExpression instead. Do not combine legacy parameters and
@@ -3702,7 +4045,7 @@ type QueryInput struct {
 	// The name of an index to query. This index can be any local secondary index
 	// or global secondary index on the table. Note that if you use the IndexName
 	// parameter, you must also provide TableName.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255"`
 
 	// The condition that specifies the key value(s) for items to be retrieved by
 	// the Query action.
@@ -3884,7 +4227,7 @@ type QueryInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -4034,7 +4377,7 @@ type QueryInput struct {
 	Select *string `type:"string"`
 
 	// The name of the table containing the requested items.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataQueryInput `json:"-" xml:"-"`
 }
@@ -4132,7 +4475,7 @@ type ScanInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use FilterExpression instead. Do not combine legacy parameters and
@@ -4259,7 +4602,7 @@ type ScanInput struct {
 	// The name of a secondary index to scan. This index can be any local secondary
 	// index or global secondary index. Note that if you use the IndexName parameter,
 	// you must also provide TableName.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255"`
 
 	// The maximum number of items to evaluate (not necessarily the number of matching
 	// items). If DynamoDB processes the number of items up to the limit while processing
@@ -4270,7 +4613,7 @@ type ScanInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the specified
 	// table or index. These attributes can include scalars, sets, or elements of
@@ -4366,7 +4709,7 @@ type ScanInput struct {
 	// the value provided for TotalSegments.
 	//
 	// If you provide Segment, you must also provide TotalSegments.
-	Segment *int64 `type:"integer"`
+	Segment *int64 `type:"integer" min:"0" max:"999999"`
 
 	// The attributes to be returned in the result. You can retrieve all item attributes,
 	// specific item attributes, or the count of matching items.
@@ -4389,7 +4732,7 @@ type ScanInput struct {
 
 	// The name of the table containing the requested items; or, if you provide
 	// IndexName, the name of the table to which that index belongs.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	// For a parallel Scan request, TotalSegments represents the total number of
 	// segments into which the Scan operation will be divided. The value of TotalSegments
@@ -4402,7 +4745,7 @@ type ScanInput struct {
 	// Scan operation will be sequential rather than parallel.
 	//
 	// If you specify TotalSegments, you must also specify Segment.
-	TotalSegments *int64 `type:"integer"`
+	TotalSegments *int64 `type:"integer" min:"1" max:"1000000"`
 
 	metadataScanInput `json:"-" xml:"-"`
 }
@@ -4616,11 +4959,11 @@ type TableDescription struct {
 	//
 	//   For more information about primary keys, see Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/DataModel.html#DataModelPrimaryKey)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// The Amazon Resource Name (ARN) that uniquely identifies the latest stream
 	// for this table.
-	LatestStreamARN *string `locationName:"LatestStreamArn" type:"string"`
+	LatestStreamARN *string `locationName:"LatestStreamArn" type:"string" min:"37" max:"1024"`
 
 	// A timestamp, in ISO 8601 format, for this stream.
 	//
@@ -4691,7 +5034,7 @@ type TableDescription struct {
 	TableARN *string `locationName:"TableArn" type:"string"`
 
 	// The name of the table.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255"`
 
 	// The total size of the specified table, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -4730,7 +5073,7 @@ func (s TableDescription) GoString() string {
 // secondary index.
 type UpdateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be updated.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	// Represents the provisioned throughput settings for a specified table or index.
 	// The settings can be modified using the UpdateTable operation.
@@ -5193,7 +5536,7 @@ type UpdateItemInput struct {
 	ReturnValues *string `type:"string"`
 
 	// The name of the table containing the item to update.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	// An expression that defines one or more attributes to be updated, the action
 	// to be performed on them, and new value(s) for them.
@@ -5363,7 +5706,7 @@ type UpdateTableInput struct {
 	StreamSpecification *StreamSpecification `type:"structure"`
 
 	// The name of the table to be updated.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" required:"true"`
 
 	metadataUpdateTableInput `json:"-" xml:"-"`
 }
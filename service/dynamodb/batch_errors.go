@@ -0,0 +1,62 @@
+package dynamodb
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeUnprocessedItem is the code of the item errors of the requests a
+// BatchWriteItem operation did not process.
+const ErrCodeUnprocessedItem = "UnprocessedItem"
+
+// BatchWriteItemError returns an awserr.BatchError for the requests of in
+// which were not processed, or nil if all requests were processed. The index
+// of each item error is the index of the request in the list of requests of
+// its table in in.RequestItems, and the message names the table. The errors
+// are ordered by table name.
+//
+// Unprocessed requests can be retried by passing out.UnprocessedItems as the
+// RequestItems of a subsequent BatchWriteItem operation.
+func BatchWriteItemError(in *BatchWriteItemInput, out *BatchWriteItemOutput) error {
+	tables := make([]string, 0, len(out.UnprocessedItems))
+	for table, reqs := range out.UnprocessedItems {
+		if len(reqs) > 0 {
+			tables = append(tables, table)
+		}
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+	sort.Strings(tables)
+
+	n := 0
+	for _, reqs := range in.RequestItems {
+		n += len(reqs)
+	}
+
+	errs := []awserr.BatchItemError{}
+	for _, table := range tables {
+		for _, req := range out.UnprocessedItems[table] {
+			errs = append(errs, awserr.NewBatchItemError(
+				writeRequestIndex(in.RequestItems[table], req), ErrCodeUnprocessedItem,
+				fmt.Sprintf("request for table %s was not processed", table)))
+		}
+	}
+	return awserr.NewBatchError(awserr.ErrCodeBatchPartialFailure,
+		fmt.Sprintf("%d of %d requests of %s were not processed", len(errs), n, opBatchWriteItem), errs)
+}
+
+// writeRequestIndex returns the index of the request in reqs, or -1 if reqs
+// does not contain it. Requests are compared by their string representation,
+// since the unprocessed requests are decoded from the response.
+func writeRequestIndex(reqs []*WriteRequest, req *WriteRequest) int {
+	s := req.String()
+	for i, r := range reqs {
+		if r.String() == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,49 @@
+package dynamodb_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+)
+
+func putRequest(key string) *dynamodb.WriteRequest {
+	return &dynamodb.WriteRequest{
+		PutRequest: &dynamodb.PutRequest{
+			Item: map[string]*dynamodb.AttributeValue{"key": {S: aws.String(key)}},
+		},
+	}
+}
+
+func TestBatchWriteItemError(t *testing.T) {
+	in := &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]*dynamodb.WriteRequest{
+			"users":  {putRequest("a"), putRequest("b")},
+			"orders": {putRequest("c")},
+		},
+	}
+	out := &dynamodb.BatchWriteItemOutput{
+		UnprocessedItems: map[string][]*dynamodb.WriteRequest{
+			"users":  {putRequest("b")},
+			"orders": {putRequest("c")},
+		},
+	}
+
+	err := dynamodb.BatchWriteItemError(in, out)
+	batchErr, ok := err.(awserr.BatchError)
+	assert.True(t, ok, "Expect a BatchError")
+	assert.Equal(t, "2 of 3 requests of BatchWriteItem were not processed", batchErr.Message())
+
+	errs := batchErr.Errors()
+	assert.Len(t, errs, 2)
+	assert.Equal(t, 0, errs[0].Index())
+	assert.Equal(t, dynamodb.ErrCodeUnprocessedItem, errs[0].Code())
+	assert.Equal(t, "request for table orders was not processed", errs[0].Message())
+	assert.Equal(t, 1, errs[1].Index())
+	assert.Equal(t, "request for table users was not processed", errs[1].Message())
+
+	out.UnprocessedItems = map[string][]*dynamodb.WriteRequest{}
+	assert.Nil(t, dynamodb.BatchWriteItemError(in, out))
+}
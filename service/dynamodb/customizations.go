@@ -5,7 +5,6 @@ import (
 	"hash/crc32"
 	"io"
 	"io/ioutil"
-	"math"
 	"strconv"
 	"time"
 
@@ -16,10 +15,7 @@ import (
 func init() {
 	initService = func(s *aws.Service) {
 		s.DefaultMaxRetries = 10
-		s.RetryRules = func(r *aws.Request) time.Duration {
-			delay := time.Duration(math.Pow(2, float64(r.RetryCount))) * 50
-			return delay * time.Millisecond
-		}
+		s.DefaultRetryBaseDelay = 50 * time.Millisecond
 
 		s.Handlers.Build.PushBack(disableCompression)
 		s.Handlers.Unmarshal.PushFront(validateCRC32)
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -45,6 +46,7 @@ func mockCRCResponse(svc *dynamodb.DynamoDB, status int, body, crc string) (req
 func TestCustomRetryRules(t *testing.T) {
 	d := dynamodb.New(&aws.Config{MaxRetries: -1})
 	assert.Equal(t, d.MaxRetries(), uint(10))
+	assert.Equal(t, 50*time.Millisecond, d.RetryBaseDelay())
 }
 
 func TestValidateCRC32NoHeaderSkip(t *testing.T) {
@@ -97,3 +99,28 @@ func TestValidateCRC32DoesNotMatchNoComputeChecksum(t *testing.T) {
 	out := req.Data.(*dynamodb.ListTablesOutput)
 	assert.Equal(t, "A", *out.TableNames[0])
 }
+
+func TestDiscoverEndpoint(t *testing.T) {
+	svc := dynamodb.New(&aws.Config{Region: "us-west-2", EnableEndpointDiscovery: true})
+	svc.Handlers.Send.Clear() // mock sending
+
+	var hosts []string
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		hosts = append(hosts, r.Operation.Name+" "+r.HTTPRequest.URL.Host)
+		body := `{}`
+		if r.Operation.Name == "DescribeEndpoints" {
+			body = `{"Endpoints":[{"Address":"discovered.us-west-2.amazonaws.com","CachePeriodInMinutes":1440}]}`
+		}
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader([]byte(body)))}
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := svc.ListTables(&dynamodb.ListTablesInput{})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{
+		"DescribeEndpoints dynamodb.us-west-2.amazonaws.com",
+		"ListTables discovered.us-west-2.amazonaws.com",
+		"ListTables discovered.us-west-2.amazonaws.com",
+	}, hosts)
+}
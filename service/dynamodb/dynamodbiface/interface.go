@@ -4,6 +4,7 @@
 package dynamodbiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 )
 
@@ -11,27 +12,81 @@ import (
 type DynamoDBAPI interface {
 	BatchGetItem(*dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error)
 
+	BatchGetItemWithContext(aws.Context, *dynamodb.BatchGetItemInput, ...aws.Option) (*dynamodb.BatchGetItemOutput, error)
+
+	BatchGetItemPages(*dynamodb.BatchGetItemInput, func(*dynamodb.BatchGetItemOutput, bool) bool) error
+
+	BatchGetItemPagesWithContext(aws.Context, *dynamodb.BatchGetItemInput, func(*dynamodb.BatchGetItemOutput, bool) bool, ...aws.Option) error
+
 	BatchWriteItem(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
 
+	BatchWriteItemWithContext(aws.Context, *dynamodb.BatchWriteItemInput, ...aws.Option) (*dynamodb.BatchWriteItemOutput, error)
+
 	CreateTable(*dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error)
 
+	CreateTableWithContext(aws.Context, *dynamodb.CreateTableInput, ...aws.Option) (*dynamodb.CreateTableOutput, error)
+
 	DeleteItem(*dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
 
+	DeleteItemWithContext(aws.Context, *dynamodb.DeleteItemInput, ...aws.Option) (*dynamodb.DeleteItemOutput, error)
+
 	DeleteTable(*dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error)
 
+	DeleteTableWithContext(aws.Context, *dynamodb.DeleteTableInput, ...aws.Option) (*dynamodb.DeleteTableOutput, error)
+
+	DescribeEndpoints(*dynamodb.DescribeEndpointsInput) (*dynamodb.DescribeEndpointsOutput, error)
+
+	DescribeEndpointsWithContext(aws.Context, *dynamodb.DescribeEndpointsInput, ...aws.Option) (*dynamodb.DescribeEndpointsOutput, error)
+
 	DescribeTable(*dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
 
+	DescribeTableWithContext(aws.Context, *dynamodb.DescribeTableInput, ...aws.Option) (*dynamodb.DescribeTableOutput, error)
+
 	GetItem(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
 
+	GetItemWithContext(aws.Context, *dynamodb.GetItemInput, ...aws.Option) (*dynamodb.GetItemOutput, error)
+
 	ListTables(*dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error)
 
+	ListTablesWithContext(aws.Context, *dynamodb.ListTablesInput, ...aws.Option) (*dynamodb.ListTablesOutput, error)
+
+	ListTablesPages(*dynamodb.ListTablesInput, func(*dynamodb.ListTablesOutput, bool) bool) error
+
+	ListTablesPagesWithContext(aws.Context, *dynamodb.ListTablesInput, func(*dynamodb.ListTablesOutput, bool) bool, ...aws.Option) error
+
 	PutItem(*dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
 
+	PutItemWithContext(aws.Context, *dynamodb.PutItemInput, ...aws.Option) (*dynamodb.PutItemOutput, error)
+
 	Query(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
 
+	QueryWithContext(aws.Context, *dynamodb.QueryInput, ...aws.Option) (*dynamodb.QueryOutput, error)
+
+	QueryPages(*dynamodb.QueryInput, func(*dynamodb.QueryOutput, bool) bool) error
+
+	QueryPagesWithContext(aws.Context, *dynamodb.QueryInput, func(*dynamodb.QueryOutput, bool) bool, ...aws.Option) error
+
 	Scan(*dynamodb.ScanInput) (*dynamodb.ScanOutput, error)
 
+	ScanWithContext(aws.Context, *dynamodb.ScanInput, ...aws.Option) (*dynamodb.ScanOutput, error)
+
+	ScanPages(*dynamodb.ScanInput, func(*dynamodb.ScanOutput, bool) bool) error
+
+	ScanPagesWithContext(aws.Context, *dynamodb.ScanInput, func(*dynamodb.ScanOutput, bool) bool, ...aws.Option) error
+
 	UpdateItem(*dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error)
 
+	UpdateItemWithContext(aws.Context, *dynamodb.UpdateItemInput, ...aws.Option) (*dynamodb.UpdateItemOutput, error)
+
 	UpdateTable(*dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error)
+
+	UpdateTableWithContext(aws.Context, *dynamodb.UpdateTableInput, ...aws.Option) (*dynamodb.UpdateTableOutput, error)
+
+	WaitUntilTableExists(*dynamodb.DescribeTableInput) error
+
+	WaitUntilTableExistsWithContext(aws.Context, *dynamodb.DescribeTableInput, ...aws.WaiterOption) error
+
+	WaitUntilTableNotExists(*dynamodb.DescribeTableInput) error
+
+	WaitUntilTableNotExistsWithContext(aws.Context, *dynamodb.DescribeTableInput, ...aws.WaiterOption) error
 }
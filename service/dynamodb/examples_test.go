@@ -499,6 +499,31 @@ func ExampleDynamoDB_DeleteTable() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
+func ExampleDynamoDB_DescribeEndpoints() {
+	svc := dynamodb.New(nil)
+
+	var params *dynamodb.DescribeEndpointsInput
+	resp, err := svc.DescribeEndpoints(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, the SDK should always return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
 func ExampleDynamoDB_DescribeTable() {
 	svc := dynamodb.New(nil)
 
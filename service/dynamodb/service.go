@@ -3,6 +3,8 @@
 package dynamodb
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/internal/protocol/jsonrpc"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -123,9 +125,24 @@ var initRequest func(*aws.Request)
 
 // New returns a new DynamoDB client.
 func New(config *aws.Config) *DynamoDB {
+	return newClient(aws.DefaultConfig.Merge(config), nil)
+}
+
+// NewWithSession returns a new DynamoDB client created from the
+// Config and Handlers of p, e.g. a session.Session. If config is not nil it
+// will be merged on top of the Config of p.
+func NewWithSession(p aws.ConfigProvider, config *aws.Config) *DynamoDB {
+	handlers := p.ClientHandlers()
+	return newClient(p.ClientConfig(config), &handlers)
+}
+
+// newClient creates, initializes and returns a new DynamoDB client.
+// If handlers is not nil they will be added after the client's own handlers.
+func newClient(config *aws.Config, handlers *aws.Handlers) *DynamoDB {
 	service := &aws.Service{
-		Config:       aws.DefaultConfig.Merge(config),
+		Config:       config,
 		ServiceName:  "dynamodb",
+		ServiceID:    "DynamoDB",
 		APIVersion:   "2012-08-10",
 		JSONVersion:  "1.0",
 		TargetPrefix: "DynamoDB_20120810",
@@ -133,17 +150,22 @@ func New(config *aws.Config) *DynamoDB {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
+	service.DiscoverEndpoints = discoverEndpoints
 
 	// Run custom service initialization if present
 	if initService != nil {
 		initService(service)
 	}
 
+	if handlers != nil {
+		service.Handlers.Merge(*handlers)
+	}
+
 	return &DynamoDB{service}
 }
 
@@ -159,3 +181,22 @@ func (c *DynamoDB) newRequest(op *aws.Operation, params, data interface{}) *aws.
 
 	return req
 }
+
+// discoverEndpoints discovers the endpoints of requests with the
+// DescribeEndpoints operation.
+func discoverEndpoints(r *aws.Request) ([]aws.DiscoveredEndpoint, error) {
+	req, out := (&DynamoDB{r.Service}).DescribeEndpointsRequest(nil)
+	req.SetContext(r.Context())
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]aws.DiscoveredEndpoint, 0, len(out.Endpoints))
+	for _, ep := range out.Endpoints {
+		endpoints = append(endpoints, aws.DiscoveredEndpoint{
+			Address:     aws.StringValue(ep.Address),
+			CachePeriod: time.Duration(aws.LongValue(ep.CachePeriodInMinutes)) * time.Minute,
+		})
+	}
+	return endpoints, nil
+}
@@ -48,6 +48,18 @@ func (c *DynamoDBStreams) DescribeStream(input *DescribeStreamInput) (*DescribeS
 	return out, err
 }
 
+// DescribeStreamWithContext is the same as DescribeStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDBStreams) DescribeStreamWithContext(ctx aws.Context, input *DescribeStreamInput, opts ...aws.Option) (*DescribeStreamOutput, error) {
+	req, out := c.DescribeStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetRecords = "GetRecords"
 
 // GetRecordsRequest generates a request for the GetRecords operation.
@@ -85,6 +97,18 @@ func (c *DynamoDBStreams) GetRecords(input *GetRecordsInput) (*GetRecordsOutput,
 	return out, err
 }
 
+// GetRecordsWithContext is the same as GetRecords with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDBStreams) GetRecordsWithContext(ctx aws.Context, input *GetRecordsInput, opts ...aws.Option) (*GetRecordsOutput, error) {
+	req, out := c.GetRecordsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetShardIterator = "GetShardIterator"
 
 // GetShardIteratorRequest generates a request for the GetShardIterator operation.
@@ -116,6 +140,18 @@ func (c *DynamoDBStreams) GetShardIterator(input *GetShardIteratorInput) (*GetSh
 	return out, err
 }
 
+// GetShardIteratorWithContext is the same as GetShardIterator with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDBStreams) GetShardIteratorWithContext(ctx aws.Context, input *GetShardIteratorInput, opts ...aws.Option) (*GetShardIteratorOutput, error) {
+	req, out := c.GetShardIteratorRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListStreams = "ListStreams"
 
 // ListStreamsRequest generates a request for the ListStreams operation.
@@ -147,17 +183,29 @@ func (c *DynamoDBStreams) ListStreams(input *ListStreamsInput) (*ListStreamsOutp
 	return out, err
 }
 
+// ListStreamsWithContext is the same as ListStreams with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *DynamoDBStreams) ListStreamsWithContext(ctx aws.Context, input *ListStreamsInput, opts ...aws.Option) (*ListStreamsOutput, error) {
+	req, out := c.ListStreamsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the input of a DescribeStream operation.
 type DescribeStreamInput struct {
 	// The shard ID of the first item that this operation will evaluate. Use the
 	// value that was returned for LastEvaluatedShardId in the previous operation.
-	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string"`
+	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string" min:"28" max:"65"`
 
 	// The maximum number of shard objects to return. The upper limit is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// The Amazon Resource Name (ARN) for the stream.
-	StreamARN *string `locationName:"StreamArn" type:"string" required:"true"`
+	StreamARN *string `locationName:"StreamArn" type:"string" min:"37" max:"1024" required:"true"`
 
 	metadataDescribeStreamInput `json:"-" xml:"-"`
 }
@@ -205,11 +253,11 @@ func (s DescribeStreamOutput) GoString() string {
 type GetRecordsInput struct {
 	// The maximum number of records to return from the shard. The upper limit is
 	// 1000.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A shard iterator that was retrieved from a previous GetShardIterator operation.
 	// This iterator can be used to access the stream records in this shard.
-	ShardIterator *string `type:"string" required:"true"`
+	ShardIterator *string `type:"string" min:"1" max:"2048" required:"true"`
 
 	metadataGetRecordsInput `json:"-" xml:"-"`
 }
@@ -233,7 +281,7 @@ type GetRecordsOutput struct {
 	// The next position in the shard from which to start sequentially reading stream
 	// records. If set to null, the shard has been closed and the requested iterator
 	// will not return any more data.
-	NextShardIterator *string `type:"string"`
+	NextShardIterator *string `type:"string" min:"1" max:"2048"`
 
 	// The stream records from the shard, which were retrieved using the shard iterator.
 	Records []*Record `type:"list"`
@@ -258,11 +306,11 @@ func (s GetRecordsOutput) GoString() string {
 // Represents the input of a GetShardIterator operation.
 type GetShardIteratorInput struct {
 	// The sequence number of a stream record in the shard from which to start reading.
-	SequenceNumber *string `type:"string"`
+	SequenceNumber *string `type:"string" min:"21" max:"40"`
 
 	// The identifier of the shard. The iterator will be returned for this shard
 	// ID.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"28" max:"65" required:"true"`
 
 	// Determines how the shard iterator is used to start reading stream records
 	// from the shard:
@@ -283,7 +331,7 @@ type GetShardIteratorInput struct {
 	ShardIteratorType *string `type:"string" required:"true"`
 
 	// The Amazon Resource Name (ARN) for the stream.
-	StreamARN *string `locationName:"StreamArn" type:"string" required:"true"`
+	StreamARN *string `locationName:"StreamArn" type:"string" min:"37" max:"1024" required:"true"`
 
 	metadataGetShardIteratorInput `json:"-" xml:"-"`
 }
@@ -307,7 +355,7 @@ type GetShardIteratorOutput struct {
 	// The position in the shard from which to start reading stream records sequentially.
 	// A shard iterator specifies this position using the sequence number of a stream
 	// record in a shard.
-	ShardIterator *string `type:"string"`
+	ShardIterator *string `type:"string" min:"1" max:"2048"`
 
 	metadataGetShardIteratorOutput `json:"-" xml:"-"`
 }
@@ -331,14 +379,14 @@ type ListStreamsInput struct {
 	// The ARN (Amazon Resource Name) of the first item that this operation will
 	// evaluate. Use the value that was returned for LastEvaluatedStreamArn in the
 	// previous operation.
-	ExclusiveStartStreamARN *string `locationName:"ExclusiveStartStreamArn" type:"string"`
+	ExclusiveStartStreamARN *string `locationName:"ExclusiveStartStreamArn" type:"string" min:"37" max:"1024"`
 
 	// The maximum number of streams to return. The upper limit is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// If this parameter is provided, then only the streams associated with this
 	// table name are returned.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255"`
 
 	metadataListStreamsInput `json:"-" xml:"-"`
 }
@@ -369,7 +417,7 @@ type ListStreamsOutput struct {
 	// If LastEvaluatedStreamArn is not empty, it does not necessarily mean that
 	// there is more data in the result set. The only way to know when you have
 	// reached the end of the result set is when LastEvaluatedStreamArn is empty.
-	LastEvaluatedStreamARN *string `locationName:"LastEvaluatedStreamArn" type:"string"`
+	LastEvaluatedStreamARN *string `locationName:"LastEvaluatedStreamArn" type:"string" min:"37" max:"1024"`
 
 	// A list of stream descriptors associated with the current account and endpoint.
 	Streams []*Stream `type:"list"`
@@ -441,10 +489,10 @@ func (s Record) GoString() string {
 // within a shard.
 type SequenceNumberRange struct {
 	// The last sequence number.
-	EndingSequenceNumber *string `type:"string"`
+	EndingSequenceNumber *string `type:"string" min:"21" max:"40"`
 
 	// The first sequence number.
-	StartingSequenceNumber *string `type:"string"`
+	StartingSequenceNumber *string `type:"string" min:"21" max:"40"`
 
 	metadataSequenceNumberRange `json:"-" xml:"-"`
 }
@@ -466,13 +514,13 @@ func (s SequenceNumberRange) GoString() string {
 // A uniquely identified group of stream records within a stream.
 type Shard struct {
 	// The shard ID of the current shard's parent.
-	ParentShardID *string `locationName:"ParentShardId" type:"string"`
+	ParentShardID *string `locationName:"ParentShardId" type:"string" min:"28" max:"65"`
 
 	// The range of possible sequence numbers for the shard.
 	SequenceNumberRange *SequenceNumberRange `type:"structure"`
 
 	// The system-generated identifier for this shard.
-	ShardID *string `locationName:"ShardId" type:"string"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"28" max:"65"`
 
 	metadataShard `json:"-" xml:"-"`
 }
@@ -494,7 +542,7 @@ func (s Shard) GoString() string {
 // Represents all of the data describing a particular stream.
 type Stream struct {
 	// The Amazon Resource Name (ARN) for the stream.
-	StreamARN *string `locationName:"StreamArn" type:"string"`
+	StreamARN *string `locationName:"StreamArn" type:"string" min:"37" max:"1024"`
 
 	// A timestamp, in ISO 8601 format, for this stream.
 	//
@@ -511,7 +559,7 @@ type Stream struct {
 	StreamLabel *string `type:"string"`
 
 	// The DynamoDB table with which the stream is associated.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255"`
 
 	metadataStream `json:"-" xml:"-"`
 }
@@ -536,7 +584,7 @@ type StreamDescription struct {
 	CreationRequestDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The key attribute(s) of the stream's DynamoDB table.
-	KeySchema []*dynamodb.KeySchemaElement `type:"list"`
+	KeySchema []*dynamodb.KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// The shard ID of the item where the operation stopped, inclusive of the previous
 	// result set. Use this value to start a new operation, excluding this value
@@ -548,13 +596,13 @@ type StreamDescription struct {
 	// If LastEvaluatedShardId is not empty, it does not necessarily mean that
 	// there is more data in the result set. The only way to know when you have
 	// reached the end of the result set is when LastEvaluatedShardId is empty.
-	LastEvaluatedShardID *string `locationName:"LastEvaluatedShardId" type:"string"`
+	LastEvaluatedShardID *string `locationName:"LastEvaluatedShardId" type:"string" min:"28" max:"65"`
 
 	// The shards that comprise the stream.
 	Shards []*Shard `type:"list"`
 
 	// The Amazon Resource Name (ARN) for the stream.
-	StreamARN *string `locationName:"StreamArn" type:"string"`
+	StreamARN *string `locationName:"StreamArn" type:"string" min:"37" max:"1024"`
 
 	// A timestamp, in ISO 8601 format, for this stream.
 	//
@@ -596,7 +644,7 @@ type StreamDescription struct {
 	StreamViewType *string `type:"string"`
 
 	// The DynamoDB table with which the stream is associated.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255"`
 
 	metadataStreamDescription `json:"-" xml:"-"`
 }
@@ -628,10 +676,10 @@ type StreamRecord struct {
 	OldImage map[string]*dynamodb.AttributeValue `type:"map"`
 
 	// The sequence number of the stream record.
-	SequenceNumber *string `type:"string"`
+	SequenceNumber *string `type:"string" min:"21" max:"40"`
 
 	// The size of the stream record, in bytes.
-	SizeBytes *int64 `type:"long"`
+	SizeBytes *int64 `type:"long" min:"1"`
 
 	// The type of data from the modified DynamoDB item that was captured in this
 	// stream record:
@@ -4,6 +4,7 @@
 package dynamodbstreamsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
 )
 
@@ -11,9 +12,17 @@ import (
 type DynamoDBStreamsAPI interface {
 	DescribeStream(*dynamodbstreams.DescribeStreamInput) (*dynamodbstreams.DescribeStreamOutput, error)
 
+	DescribeStreamWithContext(aws.Context, *dynamodbstreams.DescribeStreamInput, ...aws.Option) (*dynamodbstreams.DescribeStreamOutput, error)
+
 	GetRecords(*dynamodbstreams.GetRecordsInput) (*dynamodbstreams.GetRecordsOutput, error)
 
+	GetRecordsWithContext(aws.Context, *dynamodbstreams.GetRecordsInput, ...aws.Option) (*dynamodbstreams.GetRecordsOutput, error)
+
 	GetShardIterator(*dynamodbstreams.GetShardIteratorInput) (*dynamodbstreams.GetShardIteratorOutput, error)
 
+	GetShardIteratorWithContext(aws.Context, *dynamodbstreams.GetShardIteratorInput, ...aws.Option) (*dynamodbstreams.GetShardIteratorOutput, error)
+
 	ListStreams(*dynamodbstreams.ListStreamsInput) (*dynamodbstreams.ListStreamsOutput, error)
+
+	ListStreamsWithContext(aws.Context, *dynamodbstreams.ListStreamsInput, ...aws.Option) (*dynamodbstreams.ListStreamsOutput, error)
 }
@@ -0,0 +1,119 @@
+package ec2
+
+import (
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+const (
+	// ErrCodeDryRunOperation is the code of the error EC2 responds with to
+	// requests made with DryRun set if the caller has the required
+	// permissions.
+	ErrCodeDryRunOperation = "DryRunOperation"
+
+	// ErrCodeUnauthorizedOperation is the code of the error EC2 responds with
+	// if the caller does not have the required permissions.
+	ErrCodeUnauthorizedOperation = "UnauthorizedOperation"
+
+	// ErrCodeDryRunNotSupported is the code of the error of requests made in
+	// dry run mode for operations which have no DryRun parameter. The request
+	// is not sent.
+	ErrCodeDryRunNotSupported = "DryRunNotSupported"
+)
+
+// DryRunHandlerName is the name of the DryRunHandler.
+const DryRunHandlerName = "awssdk.ec2.DryRun"
+
+// DryRunHandler is a Validate handler which makes requests in dry run mode:
+// the DryRun parameter of the request's input is set, so EC2 checks if the
+// caller has the permissions the operation requires without performing it.
+// The error of the request is then a DryRunResult. Requests for operations
+// without a DryRun parameter fail with ErrCodeDryRunNotSupported without
+// being sent.
+//
+// Added to a client's handlers all of the client's requests are made in dry
+// run mode, e.g. to audit the permissions of a role:
+//
+//     svc := ec2.New(cfg)
+//     svc.Handlers.Validate.PushBackNamed(ec2.DryRunHandler)
+//
+//     _, err := svc.RunInstances(params)
+//     if result, ok := err.(ec2.DryRunResult); ok && result.Permitted() {
+//         // The caller is allowed to run the instances.
+//     }
+var DryRunHandler = aws.NamedHandler{Name: DryRunHandlerName, Fn: dryRun}
+
+// WithDryRun returns an aws.Option which makes a single request in dry run
+// mode, see DryRunHandler.
+//
+// Example:
+//     _, err := svc.TerminateInstancesWithContext(ctx, params, ec2.WithDryRun())
+func WithDryRun() aws.Option {
+	return func(r *aws.Request) {
+		r.Handlers.Validate.RemoveByName(DryRunHandlerName)
+		r.Handlers.Validate.PushBackNamed(DryRunHandler)
+	}
+}
+
+// A DryRunResult is the error of a request made in dry run mode which EC2
+// responded to with ErrCodeDryRunOperation or ErrCodeUnauthorizedOperation.
+type DryRunResult interface {
+	awserr.RequestFailure
+
+	// Returns if the operation would have succeeded, i.e. if the caller has
+	// the permissions the operation requires.
+	Permitted() bool
+}
+
+// So that the RequestFailure interface type can be included as an anonymous
+// field in the dryRunResult struct and not conflict with the error.Error()
+// method.
+type requestFailure awserr.RequestFailure
+
+// A dryRunResult wraps the error EC2 responded to a dry run request with.
+type dryRunResult struct {
+	requestFailure
+}
+
+// Permitted returns if the operation would have succeeded.
+func (r dryRunResult) Permitted() bool {
+	return r.Code() == ErrCodeDryRunOperation
+}
+
+// dryRun sets the DryRun parameter of the request's input and converts the
+// error of the response to a DryRunResult.
+func dryRun(r *aws.Request) {
+	if !r.ParamsFilled() {
+		return
+	}
+
+	field := reflect.Indirect(reflect.ValueOf(r.Params)).FieldByName("DryRun")
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*bool)(nil)) {
+		r.Error = awserr.New(ErrCodeDryRunNotSupported,
+			r.Operation.Name+" does not support dry run mode", nil)
+		return
+	}
+
+	// Set DryRun on a copy, so the caller's input is not modified.
+	params := reflect.New(reflect.TypeOf(r.Params).Elem())
+	params.Elem().Set(reflect.ValueOf(r.Params).Elem())
+	params.Elem().FieldByName("DryRun").Set(reflect.ValueOf(aws.Boolean(true)))
+	r.Params = params.Interface()
+
+	r.Handlers.UnmarshalError.PushBack(convertDryRunError)
+}
+
+// convertDryRunError converts the error of a dry run request's response to
+// a DryRunResult.
+func convertDryRunError(r *aws.Request) {
+	reqErr, ok := r.Error.(awserr.RequestFailure)
+	if !ok {
+		return
+	}
+	switch reqErr.Code() {
+	case ErrCodeDryRunOperation, ErrCodeUnauthorizedOperation:
+		r.Error = dryRunResult{reqErr}
+	}
+}
@@ -0,0 +1,74 @@
+package ec2_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockDryRunResponse(svc *ec2.EC2, status int, code string) {
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		body := `<Response><Errors><Error><Code>` + code + `</Code><Message>message</Message></Error></Errors><RequestId>request-id</RequestId></Response>`
+		r.HTTPResponse = &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+}
+
+func TestDryRunHandler(t *testing.T) {
+	svc := ec2.New(&aws.Config{Region: "us-west-2", MaxRetries: 0})
+	svc.Handlers.Validate.PushBackNamed(ec2.DryRunHandler)
+	mockDryRunResponse(svc, 412, ec2.ErrCodeDryRunOperation)
+
+	input := &ec2.DescribeInstancesInput{}
+	req, _ := svc.DescribeInstancesRequest(input)
+	err := req.Send()
+
+	result, ok := err.(ec2.DryRunResult)
+	assert.True(t, ok, "Expect a DryRunResult")
+	assert.True(t, result.Permitted())
+	assert.Equal(t, "request-id", result.RequestID())
+	assert.Nil(t, input.DryRun, "Expect the caller's input to not be modified")
+
+	b, _ := ioutil.ReadAll(req.HTTPRequest.Body)
+	q, _ := url.ParseQuery(string(b))
+	assert.Equal(t, "true", q.Get("DryRun"))
+}
+
+func TestWithDryRunUnauthorized(t *testing.T) {
+	svc := ec2.New(&aws.Config{Region: "us-west-2", MaxRetries: 0})
+	mockDryRunResponse(svc, 403, ec2.ErrCodeUnauthorizedOperation)
+
+	_, err := svc.TerminateInstancesWithContext(aws.BackgroundContext(), &ec2.TerminateInstancesInput{
+		InstanceIDs: []*string{aws.String("i-12345678")},
+	}, ec2.WithDryRun())
+
+	result, ok := err.(ec2.DryRunResult)
+	assert.True(t, ok, "Expect a DryRunResult")
+	assert.False(t, result.Permitted())
+	assert.Equal(t, ec2.ErrCodeUnauthorizedOperation, result.Code())
+	assert.Equal(t, 403, result.StatusCode())
+}
+
+func TestDryRunNotSupported(t *testing.T) {
+	svc := ec2.New(&aws.Config{Region: "us-west-2", MaxRetries: 0})
+	sent := false
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) { sent = true })
+
+	_, err := svc.AssignPrivateIPAddressesWithContext(aws.BackgroundContext(), &ec2.AssignPrivateIPAddressesInput{
+		NetworkInterfaceID: aws.String("eni-12345678"),
+	}, ec2.WithDryRun())
+	assert.Equal(t, ec2.ErrCodeDryRunNotSupported, err.(awserr.Error).Code())
+	assert.False(t, sent, "Expect the request to not be sent")
+}
@@ -4,6 +4,7 @@
 package ec2iface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
 
@@ -11,365 +12,857 @@ import (
 type EC2API interface {
 	AcceptVPCPeeringConnection(*ec2.AcceptVPCPeeringConnectionInput) (*ec2.AcceptVPCPeeringConnectionOutput, error)
 
+	AcceptVPCPeeringConnectionWithContext(aws.Context, *ec2.AcceptVPCPeeringConnectionInput, ...aws.Option) (*ec2.AcceptVPCPeeringConnectionOutput, error)
+
 	AllocateAddress(*ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error)
 
+	AllocateAddressWithContext(aws.Context, *ec2.AllocateAddressInput, ...aws.Option) (*ec2.AllocateAddressOutput, error)
+
 	AssignPrivateIPAddresses(*ec2.AssignPrivateIPAddressesInput) (*ec2.AssignPrivateIPAddressesOutput, error)
 
+	AssignPrivateIPAddressesWithContext(aws.Context, *ec2.AssignPrivateIPAddressesInput, ...aws.Option) (*ec2.AssignPrivateIPAddressesOutput, error)
+
 	AssociateAddress(*ec2.AssociateAddressInput) (*ec2.AssociateAddressOutput, error)
 
+	AssociateAddressWithContext(aws.Context, *ec2.AssociateAddressInput, ...aws.Option) (*ec2.AssociateAddressOutput, error)
+
 	AssociateDHCPOptions(*ec2.AssociateDHCPOptionsInput) (*ec2.AssociateDHCPOptionsOutput, error)
 
+	AssociateDHCPOptionsWithContext(aws.Context, *ec2.AssociateDHCPOptionsInput, ...aws.Option) (*ec2.AssociateDHCPOptionsOutput, error)
+
 	AssociateRouteTable(*ec2.AssociateRouteTableInput) (*ec2.AssociateRouteTableOutput, error)
 
+	AssociateRouteTableWithContext(aws.Context, *ec2.AssociateRouteTableInput, ...aws.Option) (*ec2.AssociateRouteTableOutput, error)
+
 	AttachClassicLinkVPC(*ec2.AttachClassicLinkVPCInput) (*ec2.AttachClassicLinkVPCOutput, error)
 
+	AttachClassicLinkVPCWithContext(aws.Context, *ec2.AttachClassicLinkVPCInput, ...aws.Option) (*ec2.AttachClassicLinkVPCOutput, error)
+
 	AttachInternetGateway(*ec2.AttachInternetGatewayInput) (*ec2.AttachInternetGatewayOutput, error)
 
+	AttachInternetGatewayWithContext(aws.Context, *ec2.AttachInternetGatewayInput, ...aws.Option) (*ec2.AttachInternetGatewayOutput, error)
+
 	AttachNetworkInterface(*ec2.AttachNetworkInterfaceInput) (*ec2.AttachNetworkInterfaceOutput, error)
 
+	AttachNetworkInterfaceWithContext(aws.Context, *ec2.AttachNetworkInterfaceInput, ...aws.Option) (*ec2.AttachNetworkInterfaceOutput, error)
+
 	AttachVPNGateway(*ec2.AttachVPNGatewayInput) (*ec2.AttachVPNGatewayOutput, error)
 
+	AttachVPNGatewayWithContext(aws.Context, *ec2.AttachVPNGatewayInput, ...aws.Option) (*ec2.AttachVPNGatewayOutput, error)
+
 	AttachVolume(*ec2.AttachVolumeInput) (*ec2.VolumeAttachment, error)
 
+	AttachVolumeWithContext(aws.Context, *ec2.AttachVolumeInput, ...aws.Option) (*ec2.VolumeAttachment, error)
+
 	AuthorizeSecurityGroupEgress(*ec2.AuthorizeSecurityGroupEgressInput) (*ec2.AuthorizeSecurityGroupEgressOutput, error)
 
+	AuthorizeSecurityGroupEgressWithContext(aws.Context, *ec2.AuthorizeSecurityGroupEgressInput, ...aws.Option) (*ec2.AuthorizeSecurityGroupEgressOutput, error)
+
 	AuthorizeSecurityGroupIngress(*ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
 
+	AuthorizeSecurityGroupIngressWithContext(aws.Context, *ec2.AuthorizeSecurityGroupIngressInput, ...aws.Option) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
+
 	BundleInstance(*ec2.BundleInstanceInput) (*ec2.BundleInstanceOutput, error)
 
+	BundleInstanceWithContext(aws.Context, *ec2.BundleInstanceInput, ...aws.Option) (*ec2.BundleInstanceOutput, error)
+
 	CancelBundleTask(*ec2.CancelBundleTaskInput) (*ec2.CancelBundleTaskOutput, error)
 
+	CancelBundleTaskWithContext(aws.Context, *ec2.CancelBundleTaskInput, ...aws.Option) (*ec2.CancelBundleTaskOutput, error)
+
 	CancelConversionTask(*ec2.CancelConversionTaskInput) (*ec2.CancelConversionTaskOutput, error)
 
+	CancelConversionTaskWithContext(aws.Context, *ec2.CancelConversionTaskInput, ...aws.Option) (*ec2.CancelConversionTaskOutput, error)
+
 	CancelExportTask(*ec2.CancelExportTaskInput) (*ec2.CancelExportTaskOutput, error)
 
+	CancelExportTaskWithContext(aws.Context, *ec2.CancelExportTaskInput, ...aws.Option) (*ec2.CancelExportTaskOutput, error)
+
 	CancelImportTask(*ec2.CancelImportTaskInput) (*ec2.CancelImportTaskOutput, error)
 
+	CancelImportTaskWithContext(aws.Context, *ec2.CancelImportTaskInput, ...aws.Option) (*ec2.CancelImportTaskOutput, error)
+
 	CancelReservedInstancesListing(*ec2.CancelReservedInstancesListingInput) (*ec2.CancelReservedInstancesListingOutput, error)
 
+	CancelReservedInstancesListingWithContext(aws.Context, *ec2.CancelReservedInstancesListingInput, ...aws.Option) (*ec2.CancelReservedInstancesListingOutput, error)
+
 	CancelSpotFleetRequests(*ec2.CancelSpotFleetRequestsInput) (*ec2.CancelSpotFleetRequestsOutput, error)
 
+	CancelSpotFleetRequestsWithContext(aws.Context, *ec2.CancelSpotFleetRequestsInput, ...aws.Option) (*ec2.CancelSpotFleetRequestsOutput, error)
+
 	CancelSpotInstanceRequests(*ec2.CancelSpotInstanceRequestsInput) (*ec2.CancelSpotInstanceRequestsOutput, error)
 
+	CancelSpotInstanceRequestsWithContext(aws.Context, *ec2.CancelSpotInstanceRequestsInput, ...aws.Option) (*ec2.CancelSpotInstanceRequestsOutput, error)
+
 	ConfirmProductInstance(*ec2.ConfirmProductInstanceInput) (*ec2.ConfirmProductInstanceOutput, error)
 
+	ConfirmProductInstanceWithContext(aws.Context, *ec2.ConfirmProductInstanceInput, ...aws.Option) (*ec2.ConfirmProductInstanceOutput, error)
+
 	CopyImage(*ec2.CopyImageInput) (*ec2.CopyImageOutput, error)
 
+	CopyImageWithContext(aws.Context, *ec2.CopyImageInput, ...aws.Option) (*ec2.CopyImageOutput, error)
+
 	CopySnapshot(*ec2.CopySnapshotInput) (*ec2.CopySnapshotOutput, error)
 
+	CopySnapshotWithContext(aws.Context, *ec2.CopySnapshotInput, ...aws.Option) (*ec2.CopySnapshotOutput, error)
+
 	CreateCustomerGateway(*ec2.CreateCustomerGatewayInput) (*ec2.CreateCustomerGatewayOutput, error)
 
+	CreateCustomerGatewayWithContext(aws.Context, *ec2.CreateCustomerGatewayInput, ...aws.Option) (*ec2.CreateCustomerGatewayOutput, error)
+
 	CreateDHCPOptions(*ec2.CreateDHCPOptionsInput) (*ec2.CreateDHCPOptionsOutput, error)
 
+	CreateDHCPOptionsWithContext(aws.Context, *ec2.CreateDHCPOptionsInput, ...aws.Option) (*ec2.CreateDHCPOptionsOutput, error)
+
 	CreateFlowLogs(*ec2.CreateFlowLogsInput) (*ec2.CreateFlowLogsOutput, error)
 
+	CreateFlowLogsWithContext(aws.Context, *ec2.CreateFlowLogsInput, ...aws.Option) (*ec2.CreateFlowLogsOutput, error)
+
 	CreateImage(*ec2.CreateImageInput) (*ec2.CreateImageOutput, error)
 
+	CreateImageWithContext(aws.Context, *ec2.CreateImageInput, ...aws.Option) (*ec2.CreateImageOutput, error)
+
 	CreateInstanceExportTask(*ec2.CreateInstanceExportTaskInput) (*ec2.CreateInstanceExportTaskOutput, error)
 
+	CreateInstanceExportTaskWithContext(aws.Context, *ec2.CreateInstanceExportTaskInput, ...aws.Option) (*ec2.CreateInstanceExportTaskOutput, error)
+
 	CreateInternetGateway(*ec2.CreateInternetGatewayInput) (*ec2.CreateInternetGatewayOutput, error)
 
+	CreateInternetGatewayWithContext(aws.Context, *ec2.CreateInternetGatewayInput, ...aws.Option) (*ec2.CreateInternetGatewayOutput, error)
+
 	CreateKeyPair(*ec2.CreateKeyPairInput) (*ec2.CreateKeyPairOutput, error)
 
+	CreateKeyPairWithContext(aws.Context, *ec2.CreateKeyPairInput, ...aws.Option) (*ec2.CreateKeyPairOutput, error)
+
 	CreateNetworkACL(*ec2.CreateNetworkACLInput) (*ec2.CreateNetworkACLOutput, error)
 
+	CreateNetworkACLWithContext(aws.Context, *ec2.CreateNetworkACLInput, ...aws.Option) (*ec2.CreateNetworkACLOutput, error)
+
 	CreateNetworkACLEntry(*ec2.CreateNetworkACLEntryInput) (*ec2.CreateNetworkACLEntryOutput, error)
 
+	CreateNetworkACLEntryWithContext(aws.Context, *ec2.CreateNetworkACLEntryInput, ...aws.Option) (*ec2.CreateNetworkACLEntryOutput, error)
+
 	CreateNetworkInterface(*ec2.CreateNetworkInterfaceInput) (*ec2.CreateNetworkInterfaceOutput, error)
 
+	CreateNetworkInterfaceWithContext(aws.Context, *ec2.CreateNetworkInterfaceInput, ...aws.Option) (*ec2.CreateNetworkInterfaceOutput, error)
+
 	CreatePlacementGroup(*ec2.CreatePlacementGroupInput) (*ec2.CreatePlacementGroupOutput, error)
 
+	CreatePlacementGroupWithContext(aws.Context, *ec2.CreatePlacementGroupInput, ...aws.Option) (*ec2.CreatePlacementGroupOutput, error)
+
 	CreateReservedInstancesListing(*ec2.CreateReservedInstancesListingInput) (*ec2.CreateReservedInstancesListingOutput, error)
 
+	CreateReservedInstancesListingWithContext(aws.Context, *ec2.CreateReservedInstancesListingInput, ...aws.Option) (*ec2.CreateReservedInstancesListingOutput, error)
+
 	CreateRoute(*ec2.CreateRouteInput) (*ec2.CreateRouteOutput, error)
 
+	CreateRouteWithContext(aws.Context, *ec2.CreateRouteInput, ...aws.Option) (*ec2.CreateRouteOutput, error)
+
 	CreateRouteTable(*ec2.CreateRouteTableInput) (*ec2.CreateRouteTableOutput, error)
 
+	CreateRouteTableWithContext(aws.Context, *ec2.CreateRouteTableInput, ...aws.Option) (*ec2.CreateRouteTableOutput, error)
+
 	CreateSecurityGroup(*ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error)
 
+	CreateSecurityGroupWithContext(aws.Context, *ec2.CreateSecurityGroupInput, ...aws.Option) (*ec2.CreateSecurityGroupOutput, error)
+
 	CreateSnapshot(*ec2.CreateSnapshotInput) (*ec2.Snapshot, error)
 
+	CreateSnapshotWithContext(aws.Context, *ec2.CreateSnapshotInput, ...aws.Option) (*ec2.Snapshot, error)
+
 	CreateSpotDatafeedSubscription(*ec2.CreateSpotDatafeedSubscriptionInput) (*ec2.CreateSpotDatafeedSubscriptionOutput, error)
 
+	CreateSpotDatafeedSubscriptionWithContext(aws.Context, *ec2.CreateSpotDatafeedSubscriptionInput, ...aws.Option) (*ec2.CreateSpotDatafeedSubscriptionOutput, error)
+
 	CreateSubnet(*ec2.CreateSubnetInput) (*ec2.CreateSubnetOutput, error)
 
+	CreateSubnetWithContext(aws.Context, *ec2.CreateSubnetInput, ...aws.Option) (*ec2.CreateSubnetOutput, error)
+
 	CreateTags(*ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error)
 
+	CreateTagsWithContext(aws.Context, *ec2.CreateTagsInput, ...aws.Option) (*ec2.CreateTagsOutput, error)
+
 	CreateVPC(*ec2.CreateVPCInput) (*ec2.CreateVPCOutput, error)
 
+	CreateVPCWithContext(aws.Context, *ec2.CreateVPCInput, ...aws.Option) (*ec2.CreateVPCOutput, error)
+
 	CreateVPCEndpoint(*ec2.CreateVPCEndpointInput) (*ec2.CreateVPCEndpointOutput, error)
 
+	CreateVPCEndpointWithContext(aws.Context, *ec2.CreateVPCEndpointInput, ...aws.Option) (*ec2.CreateVPCEndpointOutput, error)
+
 	CreateVPCPeeringConnection(*ec2.CreateVPCPeeringConnectionInput) (*ec2.CreateVPCPeeringConnectionOutput, error)
 
+	CreateVPCPeeringConnectionWithContext(aws.Context, *ec2.CreateVPCPeeringConnectionInput, ...aws.Option) (*ec2.CreateVPCPeeringConnectionOutput, error)
+
 	CreateVPNConnection(*ec2.CreateVPNConnectionInput) (*ec2.CreateVPNConnectionOutput, error)
 
+	CreateVPNConnectionWithContext(aws.Context, *ec2.CreateVPNConnectionInput, ...aws.Option) (*ec2.CreateVPNConnectionOutput, error)
+
 	CreateVPNConnectionRoute(*ec2.CreateVPNConnectionRouteInput) (*ec2.CreateVPNConnectionRouteOutput, error)
 
+	CreateVPNConnectionRouteWithContext(aws.Context, *ec2.CreateVPNConnectionRouteInput, ...aws.Option) (*ec2.CreateVPNConnectionRouteOutput, error)
+
 	CreateVPNGateway(*ec2.CreateVPNGatewayInput) (*ec2.CreateVPNGatewayOutput, error)
 
+	CreateVPNGatewayWithContext(aws.Context, *ec2.CreateVPNGatewayInput, ...aws.Option) (*ec2.CreateVPNGatewayOutput, error)
+
 	CreateVolume(*ec2.CreateVolumeInput) (*ec2.Volume, error)
 
+	CreateVolumeWithContext(aws.Context, *ec2.CreateVolumeInput, ...aws.Option) (*ec2.Volume, error)
+
 	DeleteCustomerGateway(*ec2.DeleteCustomerGatewayInput) (*ec2.DeleteCustomerGatewayOutput, error)
 
+	DeleteCustomerGatewayWithContext(aws.Context, *ec2.DeleteCustomerGatewayInput, ...aws.Option) (*ec2.DeleteCustomerGatewayOutput, error)
+
 	DeleteDHCPOptions(*ec2.DeleteDHCPOptionsInput) (*ec2.DeleteDHCPOptionsOutput, error)
 
+	DeleteDHCPOptionsWithContext(aws.Context, *ec2.DeleteDHCPOptionsInput, ...aws.Option) (*ec2.DeleteDHCPOptionsOutput, error)
+
 	DeleteFlowLogs(*ec2.DeleteFlowLogsInput) (*ec2.DeleteFlowLogsOutput, error)
 
+	DeleteFlowLogsWithContext(aws.Context, *ec2.DeleteFlowLogsInput, ...aws.Option) (*ec2.DeleteFlowLogsOutput, error)
+
 	DeleteInternetGateway(*ec2.DeleteInternetGatewayInput) (*ec2.DeleteInternetGatewayOutput, error)
 
+	DeleteInternetGatewayWithContext(aws.Context, *ec2.DeleteInternetGatewayInput, ...aws.Option) (*ec2.DeleteInternetGatewayOutput, error)
+
 	DeleteKeyPair(*ec2.DeleteKeyPairInput) (*ec2.DeleteKeyPairOutput, error)
 
+	DeleteKeyPairWithContext(aws.Context, *ec2.DeleteKeyPairInput, ...aws.Option) (*ec2.DeleteKeyPairOutput, error)
+
 	DeleteNetworkACL(*ec2.DeleteNetworkACLInput) (*ec2.DeleteNetworkACLOutput, error)
 
+	DeleteNetworkACLWithContext(aws.Context, *ec2.DeleteNetworkACLInput, ...aws.Option) (*ec2.DeleteNetworkACLOutput, error)
+
 	DeleteNetworkACLEntry(*ec2.DeleteNetworkACLEntryInput) (*ec2.DeleteNetworkACLEntryOutput, error)
 
+	DeleteNetworkACLEntryWithContext(aws.Context, *ec2.DeleteNetworkACLEntryInput, ...aws.Option) (*ec2.DeleteNetworkACLEntryOutput, error)
+
 	DeleteNetworkInterface(*ec2.DeleteNetworkInterfaceInput) (*ec2.DeleteNetworkInterfaceOutput, error)
 
+	DeleteNetworkInterfaceWithContext(aws.Context, *ec2.DeleteNetworkInterfaceInput, ...aws.Option) (*ec2.DeleteNetworkInterfaceOutput, error)
+
 	DeletePlacementGroup(*ec2.DeletePlacementGroupInput) (*ec2.DeletePlacementGroupOutput, error)
 
+	DeletePlacementGroupWithContext(aws.Context, *ec2.DeletePlacementGroupInput, ...aws.Option) (*ec2.DeletePlacementGroupOutput, error)
+
 	DeleteRoute(*ec2.DeleteRouteInput) (*ec2.DeleteRouteOutput, error)
 
+	DeleteRouteWithContext(aws.Context, *ec2.DeleteRouteInput, ...aws.Option) (*ec2.DeleteRouteOutput, error)
+
 	DeleteRouteTable(*ec2.DeleteRouteTableInput) (*ec2.DeleteRouteTableOutput, error)
 
+	DeleteRouteTableWithContext(aws.Context, *ec2.DeleteRouteTableInput, ...aws.Option) (*ec2.DeleteRouteTableOutput, error)
+
 	DeleteSecurityGroup(*ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error)
 
+	DeleteSecurityGroupWithContext(aws.Context, *ec2.DeleteSecurityGroupInput, ...aws.Option) (*ec2.DeleteSecurityGroupOutput, error)
+
 	DeleteSnapshot(*ec2.DeleteSnapshotInput) (*ec2.DeleteSnapshotOutput, error)
 
+	DeleteSnapshotWithContext(aws.Context, *ec2.DeleteSnapshotInput, ...aws.Option) (*ec2.DeleteSnapshotOutput, error)
+
 	DeleteSpotDatafeedSubscription(*ec2.DeleteSpotDatafeedSubscriptionInput) (*ec2.DeleteSpotDatafeedSubscriptionOutput, error)
 
+	DeleteSpotDatafeedSubscriptionWithContext(aws.Context, *ec2.DeleteSpotDatafeedSubscriptionInput, ...aws.Option) (*ec2.DeleteSpotDatafeedSubscriptionOutput, error)
+
 	DeleteSubnet(*ec2.DeleteSubnetInput) (*ec2.DeleteSubnetOutput, error)
 
+	DeleteSubnetWithContext(aws.Context, *ec2.DeleteSubnetInput, ...aws.Option) (*ec2.DeleteSubnetOutput, error)
+
 	DeleteTags(*ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error)
 
+	DeleteTagsWithContext(aws.Context, *ec2.DeleteTagsInput, ...aws.Option) (*ec2.DeleteTagsOutput, error)
+
 	DeleteVPC(*ec2.DeleteVPCInput) (*ec2.DeleteVPCOutput, error)
 
+	DeleteVPCWithContext(aws.Context, *ec2.DeleteVPCInput, ...aws.Option) (*ec2.DeleteVPCOutput, error)
+
 	DeleteVPCEndpoints(*ec2.DeleteVPCEndpointsInput) (*ec2.DeleteVPCEndpointsOutput, error)
 
+	DeleteVPCEndpointsWithContext(aws.Context, *ec2.DeleteVPCEndpointsInput, ...aws.Option) (*ec2.DeleteVPCEndpointsOutput, error)
+
 	DeleteVPCPeeringConnection(*ec2.DeleteVPCPeeringConnectionInput) (*ec2.DeleteVPCPeeringConnectionOutput, error)
 
+	DeleteVPCPeeringConnectionWithContext(aws.Context, *ec2.DeleteVPCPeeringConnectionInput, ...aws.Option) (*ec2.DeleteVPCPeeringConnectionOutput, error)
+
 	DeleteVPNConnection(*ec2.DeleteVPNConnectionInput) (*ec2.DeleteVPNConnectionOutput, error)
 
+	DeleteVPNConnectionWithContext(aws.Context, *ec2.DeleteVPNConnectionInput, ...aws.Option) (*ec2.DeleteVPNConnectionOutput, error)
+
 	DeleteVPNConnectionRoute(*ec2.DeleteVPNConnectionRouteInput) (*ec2.DeleteVPNConnectionRouteOutput, error)
 
+	DeleteVPNConnectionRouteWithContext(aws.Context, *ec2.DeleteVPNConnectionRouteInput, ...aws.Option) (*ec2.DeleteVPNConnectionRouteOutput, error)
+
 	DeleteVPNGateway(*ec2.DeleteVPNGatewayInput) (*ec2.DeleteVPNGatewayOutput, error)
 
+	DeleteVPNGatewayWithContext(aws.Context, *ec2.DeleteVPNGatewayInput, ...aws.Option) (*ec2.DeleteVPNGatewayOutput, error)
+
 	DeleteVolume(*ec2.DeleteVolumeInput) (*ec2.DeleteVolumeOutput, error)
 
+	DeleteVolumeWithContext(aws.Context, *ec2.DeleteVolumeInput, ...aws.Option) (*ec2.DeleteVolumeOutput, error)
+
 	DeregisterImage(*ec2.DeregisterImageInput) (*ec2.DeregisterImageOutput, error)
 
+	DeregisterImageWithContext(aws.Context, *ec2.DeregisterImageInput, ...aws.Option) (*ec2.DeregisterImageOutput, error)
+
 	DescribeAccountAttributes(*ec2.DescribeAccountAttributesInput) (*ec2.DescribeAccountAttributesOutput, error)
 
+	DescribeAccountAttributesWithContext(aws.Context, *ec2.DescribeAccountAttributesInput, ...aws.Option) (*ec2.DescribeAccountAttributesOutput, error)
+
 	DescribeAddresses(*ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error)
 
+	DescribeAddressesWithContext(aws.Context, *ec2.DescribeAddressesInput, ...aws.Option) (*ec2.DescribeAddressesOutput, error)
+
 	DescribeAvailabilityZones(*ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 
+	DescribeAvailabilityZonesWithContext(aws.Context, *ec2.DescribeAvailabilityZonesInput, ...aws.Option) (*ec2.DescribeAvailabilityZonesOutput, error)
+
 	DescribeBundleTasks(*ec2.DescribeBundleTasksInput) (*ec2.DescribeBundleTasksOutput, error)
 
+	DescribeBundleTasksWithContext(aws.Context, *ec2.DescribeBundleTasksInput, ...aws.Option) (*ec2.DescribeBundleTasksOutput, error)
+
 	DescribeClassicLinkInstances(*ec2.DescribeClassicLinkInstancesInput) (*ec2.DescribeClassicLinkInstancesOutput, error)
 
+	DescribeClassicLinkInstancesWithContext(aws.Context, *ec2.DescribeClassicLinkInstancesInput, ...aws.Option) (*ec2.DescribeClassicLinkInstancesOutput, error)
+
 	DescribeConversionTasks(*ec2.DescribeConversionTasksInput) (*ec2.DescribeConversionTasksOutput, error)
 
+	DescribeConversionTasksWithContext(aws.Context, *ec2.DescribeConversionTasksInput, ...aws.Option) (*ec2.DescribeConversionTasksOutput, error)
+
 	DescribeCustomerGateways(*ec2.DescribeCustomerGatewaysInput) (*ec2.DescribeCustomerGatewaysOutput, error)
 
+	DescribeCustomerGatewaysWithContext(aws.Context, *ec2.DescribeCustomerGatewaysInput, ...aws.Option) (*ec2.DescribeCustomerGatewaysOutput, error)
+
 	DescribeDHCPOptions(*ec2.DescribeDHCPOptionsInput) (*ec2.DescribeDHCPOptionsOutput, error)
 
+	DescribeDHCPOptionsWithContext(aws.Context, *ec2.DescribeDHCPOptionsInput, ...aws.Option) (*ec2.DescribeDHCPOptionsOutput, error)
+
 	DescribeExportTasks(*ec2.DescribeExportTasksInput) (*ec2.DescribeExportTasksOutput, error)
 
+	DescribeExportTasksWithContext(aws.Context, *ec2.DescribeExportTasksInput, ...aws.Option) (*ec2.DescribeExportTasksOutput, error)
+
 	DescribeFlowLogs(*ec2.DescribeFlowLogsInput) (*ec2.DescribeFlowLogsOutput, error)
 
+	DescribeFlowLogsWithContext(aws.Context, *ec2.DescribeFlowLogsInput, ...aws.Option) (*ec2.DescribeFlowLogsOutput, error)
+
 	DescribeImageAttribute(*ec2.DescribeImageAttributeInput) (*ec2.DescribeImageAttributeOutput, error)
 
+	DescribeImageAttributeWithContext(aws.Context, *ec2.DescribeImageAttributeInput, ...aws.Option) (*ec2.DescribeImageAttributeOutput, error)
+
 	DescribeImages(*ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error)
 
+	DescribeImagesWithContext(aws.Context, *ec2.DescribeImagesInput, ...aws.Option) (*ec2.DescribeImagesOutput, error)
+
 	DescribeImportImageTasks(*ec2.DescribeImportImageTasksInput) (*ec2.DescribeImportImageTasksOutput, error)
 
+	DescribeImportImageTasksWithContext(aws.Context, *ec2.DescribeImportImageTasksInput, ...aws.Option) (*ec2.DescribeImportImageTasksOutput, error)
+
 	DescribeImportSnapshotTasks(*ec2.DescribeImportSnapshotTasksInput) (*ec2.DescribeImportSnapshotTasksOutput, error)
 
+	DescribeImportSnapshotTasksWithContext(aws.Context, *ec2.DescribeImportSnapshotTasksInput, ...aws.Option) (*ec2.DescribeImportSnapshotTasksOutput, error)
+
 	DescribeInstanceAttribute(*ec2.DescribeInstanceAttributeInput) (*ec2.DescribeInstanceAttributeOutput, error)
 
+	DescribeInstanceAttributeWithContext(aws.Context, *ec2.DescribeInstanceAttributeInput, ...aws.Option) (*ec2.DescribeInstanceAttributeOutput, error)
+
 	DescribeInstanceStatus(*ec2.DescribeInstanceStatusInput) (*ec2.DescribeInstanceStatusOutput, error)
 
+	DescribeInstanceStatusWithContext(aws.Context, *ec2.DescribeInstanceStatusInput, ...aws.Option) (*ec2.DescribeInstanceStatusOutput, error)
+
+	DescribeInstanceStatusPages(*ec2.DescribeInstanceStatusInput, func(*ec2.DescribeInstanceStatusOutput, bool) bool) error
+
+	DescribeInstanceStatusPagesWithContext(aws.Context, *ec2.DescribeInstanceStatusInput, func(*ec2.DescribeInstanceStatusOutput, bool) bool, ...aws.Option) error
+
 	DescribeInstances(*ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error)
 
+	DescribeInstancesWithContext(aws.Context, *ec2.DescribeInstancesInput, ...aws.Option) (*ec2.DescribeInstancesOutput, error)
+
+	DescribeInstancesPages(*ec2.DescribeInstancesInput, func(*ec2.DescribeInstancesOutput, bool) bool) error
+
+	DescribeInstancesPagesWithContext(aws.Context, *ec2.DescribeInstancesInput, func(*ec2.DescribeInstancesOutput, bool) bool, ...aws.Option) error
+
 	DescribeInternetGateways(*ec2.DescribeInternetGatewaysInput) (*ec2.DescribeInternetGatewaysOutput, error)
 
+	DescribeInternetGatewaysWithContext(aws.Context, *ec2.DescribeInternetGatewaysInput, ...aws.Option) (*ec2.DescribeInternetGatewaysOutput, error)
+
 	DescribeKeyPairs(*ec2.DescribeKeyPairsInput) (*ec2.DescribeKeyPairsOutput, error)
 
+	DescribeKeyPairsWithContext(aws.Context, *ec2.DescribeKeyPairsInput, ...aws.Option) (*ec2.DescribeKeyPairsOutput, error)
+
 	DescribeMovingAddresses(*ec2.DescribeMovingAddressesInput) (*ec2.DescribeMovingAddressesOutput, error)
 
+	DescribeMovingAddressesWithContext(aws.Context, *ec2.DescribeMovingAddressesInput, ...aws.Option) (*ec2.DescribeMovingAddressesOutput, error)
+
 	DescribeNetworkACLs(*ec2.DescribeNetworkACLsInput) (*ec2.DescribeNetworkACLsOutput, error)
 
+	DescribeNetworkACLsWithContext(aws.Context, *ec2.DescribeNetworkACLsInput, ...aws.Option) (*ec2.DescribeNetworkACLsOutput, error)
+
 	DescribeNetworkInterfaceAttribute(*ec2.DescribeNetworkInterfaceAttributeInput) (*ec2.DescribeNetworkInterfaceAttributeOutput, error)
 
+	DescribeNetworkInterfaceAttributeWithContext(aws.Context, *ec2.DescribeNetworkInterfaceAttributeInput, ...aws.Option) (*ec2.DescribeNetworkInterfaceAttributeOutput, error)
+
 	DescribeNetworkInterfaces(*ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error)
 
+	DescribeNetworkInterfacesWithContext(aws.Context, *ec2.DescribeNetworkInterfacesInput, ...aws.Option) (*ec2.DescribeNetworkInterfacesOutput, error)
+
 	DescribePlacementGroups(*ec2.DescribePlacementGroupsInput) (*ec2.DescribePlacementGroupsOutput, error)
 
+	DescribePlacementGroupsWithContext(aws.Context, *ec2.DescribePlacementGroupsInput, ...aws.Option) (*ec2.DescribePlacementGroupsOutput, error)
+
 	DescribePrefixLists(*ec2.DescribePrefixListsInput) (*ec2.DescribePrefixListsOutput, error)
 
+	DescribePrefixListsWithContext(aws.Context, *ec2.DescribePrefixListsInput, ...aws.Option) (*ec2.DescribePrefixListsOutput, error)
+
 	DescribeRegions(*ec2.DescribeRegionsInput) (*ec2.DescribeRegionsOutput, error)
 
+	DescribeRegionsWithContext(aws.Context, *ec2.DescribeRegionsInput, ...aws.Option) (*ec2.DescribeRegionsOutput, error)
+
 	DescribeReservedInstances(*ec2.DescribeReservedInstancesInput) (*ec2.DescribeReservedInstancesOutput, error)
 
+	DescribeReservedInstancesWithContext(aws.Context, *ec2.DescribeReservedInstancesInput, ...aws.Option) (*ec2.DescribeReservedInstancesOutput, error)
+
 	DescribeReservedInstancesListings(*ec2.DescribeReservedInstancesListingsInput) (*ec2.DescribeReservedInstancesListingsOutput, error)
 
+	DescribeReservedInstancesListingsWithContext(aws.Context, *ec2.DescribeReservedInstancesListingsInput, ...aws.Option) (*ec2.DescribeReservedInstancesListingsOutput, error)
+
 	DescribeReservedInstancesModifications(*ec2.DescribeReservedInstancesModificationsInput) (*ec2.DescribeReservedInstancesModificationsOutput, error)
 
+	DescribeReservedInstancesModificationsWithContext(aws.Context, *ec2.DescribeReservedInstancesModificationsInput, ...aws.Option) (*ec2.DescribeReservedInstancesModificationsOutput, error)
+
+	DescribeReservedInstancesModificationsPages(*ec2.DescribeReservedInstancesModificationsInput, func(*ec2.DescribeReservedInstancesModificationsOutput, bool) bool) error
+
+	DescribeReservedInstancesModificationsPagesWithContext(aws.Context, *ec2.DescribeReservedInstancesModificationsInput, func(*ec2.DescribeReservedInstancesModificationsOutput, bool) bool, ...aws.Option) error
+
 	DescribeReservedInstancesOfferings(*ec2.DescribeReservedInstancesOfferingsInput) (*ec2.DescribeReservedInstancesOfferingsOutput, error)
 
+	DescribeReservedInstancesOfferingsWithContext(aws.Context, *ec2.DescribeReservedInstancesOfferingsInput, ...aws.Option) (*ec2.DescribeReservedInstancesOfferingsOutput, error)
+
+	DescribeReservedInstancesOfferingsPages(*ec2.DescribeReservedInstancesOfferingsInput, func(*ec2.DescribeReservedInstancesOfferingsOutput, bool) bool) error
+
+	DescribeReservedInstancesOfferingsPagesWithContext(aws.Context, *ec2.DescribeReservedInstancesOfferingsInput, func(*ec2.DescribeReservedInstancesOfferingsOutput, bool) bool, ...aws.Option) error
+
 	DescribeRouteTables(*ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error)
 
+	DescribeRouteTablesWithContext(aws.Context, *ec2.DescribeRouteTablesInput, ...aws.Option) (*ec2.DescribeRouteTablesOutput, error)
+
 	DescribeSecurityGroups(*ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error)
 
+	DescribeSecurityGroupsWithContext(aws.Context, *ec2.DescribeSecurityGroupsInput, ...aws.Option) (*ec2.DescribeSecurityGroupsOutput, error)
+
 	DescribeSnapshotAttribute(*ec2.DescribeSnapshotAttributeInput) (*ec2.DescribeSnapshotAttributeOutput, error)
 
+	DescribeSnapshotAttributeWithContext(aws.Context, *ec2.DescribeSnapshotAttributeInput, ...aws.Option) (*ec2.DescribeSnapshotAttributeOutput, error)
+
 	DescribeSnapshots(*ec2.DescribeSnapshotsInput) (*ec2.DescribeSnapshotsOutput, error)
 
+	DescribeSnapshotsWithContext(aws.Context, *ec2.DescribeSnapshotsInput, ...aws.Option) (*ec2.DescribeSnapshotsOutput, error)
+
+	DescribeSnapshotsPages(*ec2.DescribeSnapshotsInput, func(*ec2.DescribeSnapshotsOutput, bool) bool) error
+
+	DescribeSnapshotsPagesWithContext(aws.Context, *ec2.DescribeSnapshotsInput, func(*ec2.DescribeSnapshotsOutput, bool) bool, ...aws.Option) error
+
 	DescribeSpotDatafeedSubscription(*ec2.DescribeSpotDatafeedSubscriptionInput) (*ec2.DescribeSpotDatafeedSubscriptionOutput, error)
 
+	DescribeSpotDatafeedSubscriptionWithContext(aws.Context, *ec2.DescribeSpotDatafeedSubscriptionInput, ...aws.Option) (*ec2.DescribeSpotDatafeedSubscriptionOutput, error)
+
 	DescribeSpotFleetInstances(*ec2.DescribeSpotFleetInstancesInput) (*ec2.DescribeSpotFleetInstancesOutput, error)
 
+	DescribeSpotFleetInstancesWithContext(aws.Context, *ec2.DescribeSpotFleetInstancesInput, ...aws.Option) (*ec2.DescribeSpotFleetInstancesOutput, error)
+
 	DescribeSpotFleetRequestHistory(*ec2.DescribeSpotFleetRequestHistoryInput) (*ec2.DescribeSpotFleetRequestHistoryOutput, error)
 
+	DescribeSpotFleetRequestHistoryWithContext(aws.Context, *ec2.DescribeSpotFleetRequestHistoryInput, ...aws.Option) (*ec2.DescribeSpotFleetRequestHistoryOutput, error)
+
 	DescribeSpotFleetRequests(*ec2.DescribeSpotFleetRequestsInput) (*ec2.DescribeSpotFleetRequestsOutput, error)
 
+	DescribeSpotFleetRequestsWithContext(aws.Context, *ec2.DescribeSpotFleetRequestsInput, ...aws.Option) (*ec2.DescribeSpotFleetRequestsOutput, error)
+
 	DescribeSpotInstanceRequests(*ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error)
 
+	DescribeSpotInstanceRequestsWithContext(aws.Context, *ec2.DescribeSpotInstanceRequestsInput, ...aws.Option) (*ec2.DescribeSpotInstanceRequestsOutput, error)
+
 	DescribeSpotPriceHistory(*ec2.DescribeSpotPriceHistoryInput) (*ec2.DescribeSpotPriceHistoryOutput, error)
 
+	DescribeSpotPriceHistoryWithContext(aws.Context, *ec2.DescribeSpotPriceHistoryInput, ...aws.Option) (*ec2.DescribeSpotPriceHistoryOutput, error)
+
+	DescribeSpotPriceHistoryPages(*ec2.DescribeSpotPriceHistoryInput, func(*ec2.DescribeSpotPriceHistoryOutput, bool) bool) error
+
+	DescribeSpotPriceHistoryPagesWithContext(aws.Context, *ec2.DescribeSpotPriceHistoryInput, func(*ec2.DescribeSpotPriceHistoryOutput, bool) bool, ...aws.Option) error
+
 	DescribeSubnets(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error)
 
+	DescribeSubnetsWithContext(aws.Context, *ec2.DescribeSubnetsInput, ...aws.Option) (*ec2.DescribeSubnetsOutput, error)
+
 	DescribeTags(*ec2.DescribeTagsInput) (*ec2.DescribeTagsOutput, error)
 
+	DescribeTagsWithContext(aws.Context, *ec2.DescribeTagsInput, ...aws.Option) (*ec2.DescribeTagsOutput, error)
+
 	DescribeVPCAttribute(*ec2.DescribeVPCAttributeInput) (*ec2.DescribeVPCAttributeOutput, error)
 
+	DescribeVPCAttributeWithContext(aws.Context, *ec2.DescribeVPCAttributeInput, ...aws.Option) (*ec2.DescribeVPCAttributeOutput, error)
+
 	DescribeVPCClassicLink(*ec2.DescribeVPCClassicLinkInput) (*ec2.DescribeVPCClassicLinkOutput, error)
 
+	DescribeVPCClassicLinkWithContext(aws.Context, *ec2.DescribeVPCClassicLinkInput, ...aws.Option) (*ec2.DescribeVPCClassicLinkOutput, error)
+
 	DescribeVPCEndpointServices(*ec2.DescribeVPCEndpointServicesInput) (*ec2.DescribeVPCEndpointServicesOutput, error)
 
+	DescribeVPCEndpointServicesWithContext(aws.Context, *ec2.DescribeVPCEndpointServicesInput, ...aws.Option) (*ec2.DescribeVPCEndpointServicesOutput, error)
+
 	DescribeVPCEndpoints(*ec2.DescribeVPCEndpointsInput) (*ec2.DescribeVPCEndpointsOutput, error)
 
+	DescribeVPCEndpointsWithContext(aws.Context, *ec2.DescribeVPCEndpointsInput, ...aws.Option) (*ec2.DescribeVPCEndpointsOutput, error)
+
 	DescribeVPCPeeringConnections(*ec2.DescribeVPCPeeringConnectionsInput) (*ec2.DescribeVPCPeeringConnectionsOutput, error)
 
+	DescribeVPCPeeringConnectionsWithContext(aws.Context, *ec2.DescribeVPCPeeringConnectionsInput, ...aws.Option) (*ec2.DescribeVPCPeeringConnectionsOutput, error)
+
 	DescribeVPCs(*ec2.DescribeVPCsInput) (*ec2.DescribeVPCsOutput, error)
 
+	DescribeVPCsWithContext(aws.Context, *ec2.DescribeVPCsInput, ...aws.Option) (*ec2.DescribeVPCsOutput, error)
+
 	DescribeVPNConnections(*ec2.DescribeVPNConnectionsInput) (*ec2.DescribeVPNConnectionsOutput, error)
 
+	DescribeVPNConnectionsWithContext(aws.Context, *ec2.DescribeVPNConnectionsInput, ...aws.Option) (*ec2.DescribeVPNConnectionsOutput, error)
+
 	DescribeVPNGateways(*ec2.DescribeVPNGatewaysInput) (*ec2.DescribeVPNGatewaysOutput, error)
 
+	DescribeVPNGatewaysWithContext(aws.Context, *ec2.DescribeVPNGatewaysInput, ...aws.Option) (*ec2.DescribeVPNGatewaysOutput, error)
+
 	DescribeVolumeAttribute(*ec2.DescribeVolumeAttributeInput) (*ec2.DescribeVolumeAttributeOutput, error)
 
+	DescribeVolumeAttributeWithContext(aws.Context, *ec2.DescribeVolumeAttributeInput, ...aws.Option) (*ec2.DescribeVolumeAttributeOutput, error)
+
 	DescribeVolumeStatus(*ec2.DescribeVolumeStatusInput) (*ec2.DescribeVolumeStatusOutput, error)
 
+	DescribeVolumeStatusWithContext(aws.Context, *ec2.DescribeVolumeStatusInput, ...aws.Option) (*ec2.DescribeVolumeStatusOutput, error)
+
+	DescribeVolumeStatusPages(*ec2.DescribeVolumeStatusInput, func(*ec2.DescribeVolumeStatusOutput, bool) bool) error
+
+	DescribeVolumeStatusPagesWithContext(aws.Context, *ec2.DescribeVolumeStatusInput, func(*ec2.DescribeVolumeStatusOutput, bool) bool, ...aws.Option) error
+
 	DescribeVolumes(*ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error)
 
+	DescribeVolumesWithContext(aws.Context, *ec2.DescribeVolumesInput, ...aws.Option) (*ec2.DescribeVolumesOutput, error)
+
+	DescribeVolumesPages(*ec2.DescribeVolumesInput, func(*ec2.DescribeVolumesOutput, bool) bool) error
+
+	DescribeVolumesPagesWithContext(aws.Context, *ec2.DescribeVolumesInput, func(*ec2.DescribeVolumesOutput, bool) bool, ...aws.Option) error
+
 	DetachClassicLinkVPC(*ec2.DetachClassicLinkVPCInput) (*ec2.DetachClassicLinkVPCOutput, error)
 
+	DetachClassicLinkVPCWithContext(aws.Context, *ec2.DetachClassicLinkVPCInput, ...aws.Option) (*ec2.DetachClassicLinkVPCOutput, error)
+
 	DetachInternetGateway(*ec2.DetachInternetGatewayInput) (*ec2.DetachInternetGatewayOutput, error)
 
+	DetachInternetGatewayWithContext(aws.Context, *ec2.DetachInternetGatewayInput, ...aws.Option) (*ec2.DetachInternetGatewayOutput, error)
+
 	DetachNetworkInterface(*ec2.DetachNetworkInterfaceInput) (*ec2.DetachNetworkInterfaceOutput, error)
 
+	DetachNetworkInterfaceWithContext(aws.Context, *ec2.DetachNetworkInterfaceInput, ...aws.Option) (*ec2.DetachNetworkInterfaceOutput, error)
+
 	DetachVPNGateway(*ec2.DetachVPNGatewayInput) (*ec2.DetachVPNGatewayOutput, error)
 
+	DetachVPNGatewayWithContext(aws.Context, *ec2.DetachVPNGatewayInput, ...aws.Option) (*ec2.DetachVPNGatewayOutput, error)
+
 	DetachVolume(*ec2.DetachVolumeInput) (*ec2.VolumeAttachment, error)
 
+	DetachVolumeWithContext(aws.Context, *ec2.DetachVolumeInput, ...aws.Option) (*ec2.VolumeAttachment, error)
+
 	DisableVGWRoutePropagation(*ec2.DisableVGWRoutePropagationInput) (*ec2.DisableVGWRoutePropagationOutput, error)
 
+	DisableVGWRoutePropagationWithContext(aws.Context, *ec2.DisableVGWRoutePropagationInput, ...aws.Option) (*ec2.DisableVGWRoutePropagationOutput, error)
+
 	DisableVPCClassicLink(*ec2.DisableVPCClassicLinkInput) (*ec2.DisableVPCClassicLinkOutput, error)
 
+	DisableVPCClassicLinkWithContext(aws.Context, *ec2.DisableVPCClassicLinkInput, ...aws.Option) (*ec2.DisableVPCClassicLinkOutput, error)
+
 	DisassociateAddress(*ec2.DisassociateAddressInput) (*ec2.DisassociateAddressOutput, error)
 
+	DisassociateAddressWithContext(aws.Context, *ec2.DisassociateAddressInput, ...aws.Option) (*ec2.DisassociateAddressOutput, error)
+
 	DisassociateRouteTable(*ec2.DisassociateRouteTableInput) (*ec2.DisassociateRouteTableOutput, error)
 
+	DisassociateRouteTableWithContext(aws.Context, *ec2.DisassociateRouteTableInput, ...aws.Option) (*ec2.DisassociateRouteTableOutput, error)
+
 	EnableVGWRoutePropagation(*ec2.EnableVGWRoutePropagationInput) (*ec2.EnableVGWRoutePropagationOutput, error)
 
+	EnableVGWRoutePropagationWithContext(aws.Context, *ec2.EnableVGWRoutePropagationInput, ...aws.Option) (*ec2.EnableVGWRoutePropagationOutput, error)
+
 	EnableVPCClassicLink(*ec2.EnableVPCClassicLinkInput) (*ec2.EnableVPCClassicLinkOutput, error)
 
+	EnableVPCClassicLinkWithContext(aws.Context, *ec2.EnableVPCClassicLinkInput, ...aws.Option) (*ec2.EnableVPCClassicLinkOutput, error)
+
 	EnableVolumeIO(*ec2.EnableVolumeIOInput) (*ec2.EnableVolumeIOOutput, error)
 
+	EnableVolumeIOWithContext(aws.Context, *ec2.EnableVolumeIOInput, ...aws.Option) (*ec2.EnableVolumeIOOutput, error)
+
 	GetConsoleOutput(*ec2.GetConsoleOutputInput) (*ec2.GetConsoleOutputOutput, error)
 
+	GetConsoleOutputWithContext(aws.Context, *ec2.GetConsoleOutputInput, ...aws.Option) (*ec2.GetConsoleOutputOutput, error)
+
 	GetPasswordData(*ec2.GetPasswordDataInput) (*ec2.GetPasswordDataOutput, error)
 
+	GetPasswordDataWithContext(aws.Context, *ec2.GetPasswordDataInput, ...aws.Option) (*ec2.GetPasswordDataOutput, error)
+
 	ImportImage(*ec2.ImportImageInput) (*ec2.ImportImageOutput, error)
 
+	ImportImageWithContext(aws.Context, *ec2.ImportImageInput, ...aws.Option) (*ec2.ImportImageOutput, error)
+
 	ImportInstance(*ec2.ImportInstanceInput) (*ec2.ImportInstanceOutput, error)
 
+	ImportInstanceWithContext(aws.Context, *ec2.ImportInstanceInput, ...aws.Option) (*ec2.ImportInstanceOutput, error)
+
 	ImportKeyPair(*ec2.ImportKeyPairInput) (*ec2.ImportKeyPairOutput, error)
 
+	ImportKeyPairWithContext(aws.Context, *ec2.ImportKeyPairInput, ...aws.Option) (*ec2.ImportKeyPairOutput, error)
+
 	ImportSnapshot(*ec2.ImportSnapshotInput) (*ec2.ImportSnapshotOutput, error)
 
+	ImportSnapshotWithContext(aws.Context, *ec2.ImportSnapshotInput, ...aws.Option) (*ec2.ImportSnapshotOutput, error)
+
 	ImportVolume(*ec2.ImportVolumeInput) (*ec2.ImportVolumeOutput, error)
 
+	ImportVolumeWithContext(aws.Context, *ec2.ImportVolumeInput, ...aws.Option) (*ec2.ImportVolumeOutput, error)
+
 	ModifyImageAttribute(*ec2.ModifyImageAttributeInput) (*ec2.ModifyImageAttributeOutput, error)
 
+	ModifyImageAttributeWithContext(aws.Context, *ec2.ModifyImageAttributeInput, ...aws.Option) (*ec2.ModifyImageAttributeOutput, error)
+
 	ModifyInstanceAttribute(*ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error)
 
+	ModifyInstanceAttributeWithContext(aws.Context, *ec2.ModifyInstanceAttributeInput, ...aws.Option) (*ec2.ModifyInstanceAttributeOutput, error)
+
 	ModifyNetworkInterfaceAttribute(*ec2.ModifyNetworkInterfaceAttributeInput) (*ec2.ModifyNetworkInterfaceAttributeOutput, error)
 
+	ModifyNetworkInterfaceAttributeWithContext(aws.Context, *ec2.ModifyNetworkInterfaceAttributeInput, ...aws.Option) (*ec2.ModifyNetworkInterfaceAttributeOutput, error)
+
 	ModifyReservedInstances(*ec2.ModifyReservedInstancesInput) (*ec2.ModifyReservedInstancesOutput, error)
 
+	ModifyReservedInstancesWithContext(aws.Context, *ec2.ModifyReservedInstancesInput, ...aws.Option) (*ec2.ModifyReservedInstancesOutput, error)
+
 	ModifySnapshotAttribute(*ec2.ModifySnapshotAttributeInput) (*ec2.ModifySnapshotAttributeOutput, error)
 
+	ModifySnapshotAttributeWithContext(aws.Context, *ec2.ModifySnapshotAttributeInput, ...aws.Option) (*ec2.ModifySnapshotAttributeOutput, error)
+
 	ModifySubnetAttribute(*ec2.ModifySubnetAttributeInput) (*ec2.ModifySubnetAttributeOutput, error)
 
+	ModifySubnetAttributeWithContext(aws.Context, *ec2.ModifySubnetAttributeInput, ...aws.Option) (*ec2.ModifySubnetAttributeOutput, error)
+
 	ModifyVPCAttribute(*ec2.ModifyVPCAttributeInput) (*ec2.ModifyVPCAttributeOutput, error)
 
+	ModifyVPCAttributeWithContext(aws.Context, *ec2.ModifyVPCAttributeInput, ...aws.Option) (*ec2.ModifyVPCAttributeOutput, error)
+
 	ModifyVPCEndpoint(*ec2.ModifyVPCEndpointInput) (*ec2.ModifyVPCEndpointOutput, error)
 
+	ModifyVPCEndpointWithContext(aws.Context, *ec2.ModifyVPCEndpointInput, ...aws.Option) (*ec2.ModifyVPCEndpointOutput, error)
+
 	ModifyVolumeAttribute(*ec2.ModifyVolumeAttributeInput) (*ec2.ModifyVolumeAttributeOutput, error)
 
+	ModifyVolumeAttributeWithContext(aws.Context, *ec2.ModifyVolumeAttributeInput, ...aws.Option) (*ec2.ModifyVolumeAttributeOutput, error)
+
 	MonitorInstances(*ec2.MonitorInstancesInput) (*ec2.MonitorInstancesOutput, error)
 
+	MonitorInstancesWithContext(aws.Context, *ec2.MonitorInstancesInput, ...aws.Option) (*ec2.MonitorInstancesOutput, error)
+
 	MoveAddressToVPC(*ec2.MoveAddressToVPCInput) (*ec2.MoveAddressToVPCOutput, error)
 
+	MoveAddressToVPCWithContext(aws.Context, *ec2.MoveAddressToVPCInput, ...aws.Option) (*ec2.MoveAddressToVPCOutput, error)
+
 	PurchaseReservedInstancesOffering(*ec2.PurchaseReservedInstancesOfferingInput) (*ec2.PurchaseReservedInstancesOfferingOutput, error)
 
+	PurchaseReservedInstancesOfferingWithContext(aws.Context, *ec2.PurchaseReservedInstancesOfferingInput, ...aws.Option) (*ec2.PurchaseReservedInstancesOfferingOutput, error)
+
 	RebootInstances(*ec2.RebootInstancesInput) (*ec2.RebootInstancesOutput, error)
 
+	RebootInstancesWithContext(aws.Context, *ec2.RebootInstancesInput, ...aws.Option) (*ec2.RebootInstancesOutput, error)
+
 	RegisterImage(*ec2.RegisterImageInput) (*ec2.RegisterImageOutput, error)
 
+	RegisterImageWithContext(aws.Context, *ec2.RegisterImageInput, ...aws.Option) (*ec2.RegisterImageOutput, error)
+
 	RejectVPCPeeringConnection(*ec2.RejectVPCPeeringConnectionInput) (*ec2.RejectVPCPeeringConnectionOutput, error)
 
+	RejectVPCPeeringConnectionWithContext(aws.Context, *ec2.RejectVPCPeeringConnectionInput, ...aws.Option) (*ec2.RejectVPCPeeringConnectionOutput, error)
+
 	ReleaseAddress(*ec2.ReleaseAddressInput) (*ec2.ReleaseAddressOutput, error)
 
+	ReleaseAddressWithContext(aws.Context, *ec2.ReleaseAddressInput, ...aws.Option) (*ec2.ReleaseAddressOutput, error)
+
 	ReplaceNetworkACLAssociation(*ec2.ReplaceNetworkACLAssociationInput) (*ec2.ReplaceNetworkACLAssociationOutput, error)
 
+	ReplaceNetworkACLAssociationWithContext(aws.Context, *ec2.ReplaceNetworkACLAssociationInput, ...aws.Option) (*ec2.ReplaceNetworkACLAssociationOutput, error)
+
 	ReplaceNetworkACLEntry(*ec2.ReplaceNetworkACLEntryInput) (*ec2.ReplaceNetworkACLEntryOutput, error)
 
+	ReplaceNetworkACLEntryWithContext(aws.Context, *ec2.ReplaceNetworkACLEntryInput, ...aws.Option) (*ec2.ReplaceNetworkACLEntryOutput, error)
+
 	ReplaceRoute(*ec2.ReplaceRouteInput) (*ec2.ReplaceRouteOutput, error)
 
+	ReplaceRouteWithContext(aws.Context, *ec2.ReplaceRouteInput, ...aws.Option) (*ec2.ReplaceRouteOutput, error)
+
 	ReplaceRouteTableAssociation(*ec2.ReplaceRouteTableAssociationInput) (*ec2.ReplaceRouteTableAssociationOutput, error)
 
+	ReplaceRouteTableAssociationWithContext(aws.Context, *ec2.ReplaceRouteTableAssociationInput, ...aws.Option) (*ec2.ReplaceRouteTableAssociationOutput, error)
+
 	ReportInstanceStatus(*ec2.ReportInstanceStatusInput) (*ec2.ReportInstanceStatusOutput, error)
 
+	ReportInstanceStatusWithContext(aws.Context, *ec2.ReportInstanceStatusInput, ...aws.Option) (*ec2.ReportInstanceStatusOutput, error)
+
 	RequestSpotFleet(*ec2.RequestSpotFleetInput) (*ec2.RequestSpotFleetOutput, error)
 
+	RequestSpotFleetWithContext(aws.Context, *ec2.RequestSpotFleetInput, ...aws.Option) (*ec2.RequestSpotFleetOutput, error)
+
 	RequestSpotInstances(*ec2.RequestSpotInstancesInput) (*ec2.RequestSpotInstancesOutput, error)
 
+	RequestSpotInstancesWithContext(aws.Context, *ec2.RequestSpotInstancesInput, ...aws.Option) (*ec2.RequestSpotInstancesOutput, error)
+
 	ResetImageAttribute(*ec2.ResetImageAttributeInput) (*ec2.ResetImageAttributeOutput, error)
 
+	ResetImageAttributeWithContext(aws.Context, *ec2.ResetImageAttributeInput, ...aws.Option) (*ec2.ResetImageAttributeOutput, error)
+
 	ResetInstanceAttribute(*ec2.ResetInstanceAttributeInput) (*ec2.ResetInstanceAttributeOutput, error)
 
+	ResetInstanceAttributeWithContext(aws.Context, *ec2.ResetInstanceAttributeInput, ...aws.Option) (*ec2.ResetInstanceAttributeOutput, error)
+
 	ResetNetworkInterfaceAttribute(*ec2.ResetNetworkInterfaceAttributeInput) (*ec2.ResetNetworkInterfaceAttributeOutput, error)
 
+	ResetNetworkInterfaceAttributeWithContext(aws.Context, *ec2.ResetNetworkInterfaceAttributeInput, ...aws.Option) (*ec2.ResetNetworkInterfaceAttributeOutput, error)
+
 	ResetSnapshotAttribute(*ec2.ResetSnapshotAttributeInput) (*ec2.ResetSnapshotAttributeOutput, error)
 
+	ResetSnapshotAttributeWithContext(aws.Context, *ec2.ResetSnapshotAttributeInput, ...aws.Option) (*ec2.ResetSnapshotAttributeOutput, error)
+
 	RestoreAddressToClassic(*ec2.RestoreAddressToClassicInput) (*ec2.RestoreAddressToClassicOutput, error)
 
+	RestoreAddressToClassicWithContext(aws.Context, *ec2.RestoreAddressToClassicInput, ...aws.Option) (*ec2.RestoreAddressToClassicOutput, error)
+
 	RevokeSecurityGroupEgress(*ec2.RevokeSecurityGroupEgressInput) (*ec2.RevokeSecurityGroupEgressOutput, error)
 
+	RevokeSecurityGroupEgressWithContext(aws.Context, *ec2.RevokeSecurityGroupEgressInput, ...aws.Option) (*ec2.RevokeSecurityGroupEgressOutput, error)
+
 	RevokeSecurityGroupIngress(*ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error)
 
+	RevokeSecurityGroupIngressWithContext(aws.Context, *ec2.RevokeSecurityGroupIngressInput, ...aws.Option) (*ec2.RevokeSecurityGroupIngressOutput, error)
+
 	RunInstances(*ec2.RunInstancesInput) (*ec2.Reservation, error)
 
+	RunInstancesWithContext(aws.Context, *ec2.RunInstancesInput, ...aws.Option) (*ec2.Reservation, error)
+
 	StartInstances(*ec2.StartInstancesInput) (*ec2.StartInstancesOutput, error)
 
+	StartInstancesWithContext(aws.Context, *ec2.StartInstancesInput, ...aws.Option) (*ec2.StartInstancesOutput, error)
+
 	StopInstances(*ec2.StopInstancesInput) (*ec2.StopInstancesOutput, error)
 
+	StopInstancesWithContext(aws.Context, *ec2.StopInstancesInput, ...aws.Option) (*ec2.StopInstancesOutput, error)
+
 	TerminateInstances(*ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error)
 
+	TerminateInstancesWithContext(aws.Context, *ec2.TerminateInstancesInput, ...aws.Option) (*ec2.TerminateInstancesOutput, error)
+
 	UnassignPrivateIPAddresses(*ec2.UnassignPrivateIPAddressesInput) (*ec2.UnassignPrivateIPAddressesOutput, error)
 
+	UnassignPrivateIPAddressesWithContext(aws.Context, *ec2.UnassignPrivateIPAddressesInput, ...aws.Option) (*ec2.UnassignPrivateIPAddressesOutput, error)
+
 	UnmonitorInstances(*ec2.UnmonitorInstancesInput) (*ec2.UnmonitorInstancesOutput, error)
+
+	UnmonitorInstancesWithContext(aws.Context, *ec2.UnmonitorInstancesInput, ...aws.Option) (*ec2.UnmonitorInstancesOutput, error)
+
+	WaitUntilBundleTaskComplete(*ec2.DescribeBundleTasksInput) error
+
+	WaitUntilBundleTaskCompleteWithContext(aws.Context, *ec2.DescribeBundleTasksInput, ...aws.WaiterOption) error
+
+	WaitUntilConversionTaskCancelled(*ec2.DescribeConversionTasksInput) error
+
+	WaitUntilConversionTaskCancelledWithContext(aws.Context, *ec2.DescribeConversionTasksInput, ...aws.WaiterOption) error
+
+	WaitUntilConversionTaskCompleted(*ec2.DescribeConversionTasksInput) error
+
+	WaitUntilConversionTaskCompletedWithContext(aws.Context, *ec2.DescribeConversionTasksInput, ...aws.WaiterOption) error
+
+	WaitUntilConversionTaskDeleted(*ec2.DescribeConversionTasksInput) error
+
+	WaitUntilConversionTaskDeletedWithContext(aws.Context, *ec2.DescribeConversionTasksInput, ...aws.WaiterOption) error
+
+	WaitUntilCustomerGatewayAvailable(*ec2.DescribeCustomerGatewaysInput) error
+
+	WaitUntilCustomerGatewayAvailableWithContext(aws.Context, *ec2.DescribeCustomerGatewaysInput, ...aws.WaiterOption) error
+
+	WaitUntilExportTaskCancelled(*ec2.DescribeExportTasksInput) error
+
+	WaitUntilExportTaskCancelledWithContext(aws.Context, *ec2.DescribeExportTasksInput, ...aws.WaiterOption) error
+
+	WaitUntilExportTaskCompleted(*ec2.DescribeExportTasksInput) error
+
+	WaitUntilExportTaskCompletedWithContext(aws.Context, *ec2.DescribeExportTasksInput, ...aws.WaiterOption) error
+
+	WaitUntilImageAvailable(*ec2.DescribeImagesInput) error
+
+	WaitUntilImageAvailableWithContext(aws.Context, *ec2.DescribeImagesInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceExists(*ec2.DescribeInstancesInput) error
+
+	WaitUntilInstanceExistsWithContext(aws.Context, *ec2.DescribeInstancesInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceRunning(*ec2.DescribeInstancesInput) error
+
+	WaitUntilInstanceRunningWithContext(aws.Context, *ec2.DescribeInstancesInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceStatusOK(*ec2.DescribeInstanceStatusInput) error
+
+	WaitUntilInstanceStatusOKWithContext(aws.Context, *ec2.DescribeInstanceStatusInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceStopped(*ec2.DescribeInstancesInput) error
+
+	WaitUntilInstanceStoppedWithContext(aws.Context, *ec2.DescribeInstancesInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceTerminated(*ec2.DescribeInstancesInput) error
+
+	WaitUntilInstanceTerminatedWithContext(aws.Context, *ec2.DescribeInstancesInput, ...aws.WaiterOption) error
+
+	WaitUntilNetworkInterfaceAvailable(*ec2.DescribeNetworkInterfacesInput) error
+
+	WaitUntilNetworkInterfaceAvailableWithContext(aws.Context, *ec2.DescribeNetworkInterfacesInput, ...aws.WaiterOption) error
+
+	WaitUntilSnapshotCompleted(*ec2.DescribeSnapshotsInput) error
+
+	WaitUntilSnapshotCompletedWithContext(aws.Context, *ec2.DescribeSnapshotsInput, ...aws.WaiterOption) error
+
+	WaitUntilSpotInstanceRequestFulfilled(*ec2.DescribeSpotInstanceRequestsInput) error
+
+	WaitUntilSpotInstanceRequestFulfilledWithContext(aws.Context, *ec2.DescribeSpotInstanceRequestsInput, ...aws.WaiterOption) error
+
+	WaitUntilSubnetAvailable(*ec2.DescribeSubnetsInput) error
+
+	WaitUntilSubnetAvailableWithContext(aws.Context, *ec2.DescribeSubnetsInput, ...aws.WaiterOption) error
+
+	WaitUntilSystemStatusOK(*ec2.DescribeInstanceStatusInput) error
+
+	WaitUntilSystemStatusOKWithContext(aws.Context, *ec2.DescribeInstanceStatusInput, ...aws.WaiterOption) error
+
+	WaitUntilVolumeAvailable(*ec2.DescribeVolumesInput) error
+
+	WaitUntilVolumeAvailableWithContext(aws.Context, *ec2.DescribeVolumesInput, ...aws.WaiterOption) error
+
+	WaitUntilVolumeDeleted(*ec2.DescribeVolumesInput) error
+
+	WaitUntilVolumeDeletedWithContext(aws.Context, *ec2.DescribeVolumesInput, ...aws.WaiterOption) error
+
+	WaitUntilVolumeInUse(*ec2.DescribeVolumesInput) error
+
+	WaitUntilVolumeInUseWithContext(aws.Context, *ec2.DescribeVolumesInput, ...aws.WaiterOption) error
+
+	WaitUntilVPCAvailable(*ec2.DescribeVPCsInput) error
+
+	WaitUntilVPCAvailableWithContext(aws.Context, *ec2.DescribeVPCsInput, ...aws.WaiterOption) error
+
+	WaitUntilVPNConnectionAvailable(*ec2.DescribeVPNConnectionsInput) error
+
+	WaitUntilVPNConnectionAvailableWithContext(aws.Context, *ec2.DescribeVPNConnectionsInput, ...aws.WaiterOption) error
+
+	WaitUntilVPNConnectionDeleted(*ec2.DescribeVPNConnectionsInput) error
+
+	WaitUntilVPNConnectionDeletedWithContext(aws.Context, *ec2.DescribeVPNConnectionsInput, ...aws.WaiterOption) error
 }
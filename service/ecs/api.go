@@ -39,6 +39,18 @@ func (c *ECS) CreateCluster(input *CreateClusterInput) (*CreateClusterOutput, er
 	return out, err
 }
 
+// CreateClusterWithContext is the same as CreateCluster with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) CreateClusterWithContext(ctx aws.Context, input *CreateClusterInput, opts ...aws.Option) (*CreateClusterOutput, error) {
+	req, out := c.CreateClusterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateService = "CreateService"
 
 // CreateServiceRequest generates a request for the CreateService operation.
@@ -68,6 +80,18 @@ func (c *ECS) CreateService(input *CreateServiceInput) (*CreateServiceOutput, er
 	return out, err
 }
 
+// CreateServiceWithContext is the same as CreateService with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) CreateServiceWithContext(ctx aws.Context, input *CreateServiceInput, opts ...aws.Option) (*CreateServiceOutput, error) {
+	req, out := c.CreateServiceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCluster = "DeleteCluster"
 
 // DeleteClusterRequest generates a request for the DeleteCluster operation.
@@ -97,6 +121,18 @@ func (c *ECS) DeleteCluster(input *DeleteClusterInput) (*DeleteClusterOutput, er
 	return out, err
 }
 
+// DeleteClusterWithContext is the same as DeleteCluster with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DeleteClusterWithContext(ctx aws.Context, input *DeleteClusterInput, opts ...aws.Option) (*DeleteClusterOutput, error) {
+	req, out := c.DeleteClusterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteService = "DeleteService"
 
 // DeleteServiceRequest generates a request for the DeleteService operation.
@@ -124,6 +160,18 @@ func (c *ECS) DeleteService(input *DeleteServiceInput) (*DeleteServiceOutput, er
 	return out, err
 }
 
+// DeleteServiceWithContext is the same as DeleteService with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DeleteServiceWithContext(ctx aws.Context, input *DeleteServiceInput, opts ...aws.Option) (*DeleteServiceOutput, error) {
+	req, out := c.DeleteServiceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterContainerInstance = "DeregisterContainerInstance"
 
 // DeregisterContainerInstanceRequest generates a request for the DeregisterContainerInstance operation.
@@ -164,6 +212,18 @@ func (c *ECS) DeregisterContainerInstance(input *DeregisterContainerInstanceInpu
 	return out, err
 }
 
+// DeregisterContainerInstanceWithContext is the same as DeregisterContainerInstance with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DeregisterContainerInstanceWithContext(ctx aws.Context, input *DeregisterContainerInstanceInput, opts ...aws.Option) (*DeregisterContainerInstanceOutput, error) {
+	req, out := c.DeregisterContainerInstanceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterTaskDefinition = "DeregisterTaskDefinition"
 
 // DeregisterTaskDefinitionRequest generates a request for the DeregisterTaskDefinition operation.
@@ -200,6 +260,18 @@ func (c *ECS) DeregisterTaskDefinition(input *DeregisterTaskDefinitionInput) (*D
 	return out, err
 }
 
+// DeregisterTaskDefinitionWithContext is the same as DeregisterTaskDefinition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DeregisterTaskDefinitionWithContext(ctx aws.Context, input *DeregisterTaskDefinitionInput, opts ...aws.Option) (*DeregisterTaskDefinitionOutput, error) {
+	req, out := c.DeregisterTaskDefinitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeClusters = "DescribeClusters"
 
 // DescribeClustersRequest generates a request for the DescribeClusters operation.
@@ -227,6 +299,18 @@ func (c *ECS) DescribeClusters(input *DescribeClustersInput) (*DescribeClustersO
 	return out, err
 }
 
+// DescribeClustersWithContext is the same as DescribeClusters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DescribeClustersWithContext(ctx aws.Context, input *DescribeClustersInput, opts ...aws.Option) (*DescribeClustersOutput, error) {
+	req, out := c.DescribeClustersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeContainerInstances = "DescribeContainerInstances"
 
 // DescribeContainerInstancesRequest generates a request for the DescribeContainerInstances operation.
@@ -255,6 +339,18 @@ func (c *ECS) DescribeContainerInstances(input *DescribeContainerInstancesInput)
 	return out, err
 }
 
+// DescribeContainerInstancesWithContext is the same as DescribeContainerInstances with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DescribeContainerInstancesWithContext(ctx aws.Context, input *DescribeContainerInstancesInput, opts ...aws.Option) (*DescribeContainerInstancesOutput, error) {
+	req, out := c.DescribeContainerInstancesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeServices = "DescribeServices"
 
 // DescribeServicesRequest generates a request for the DescribeServices operation.
@@ -282,6 +378,18 @@ func (c *ECS) DescribeServices(input *DescribeServicesInput) (*DescribeServicesO
 	return out, err
 }
 
+// DescribeServicesWithContext is the same as DescribeServices with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DescribeServicesWithContext(ctx aws.Context, input *DescribeServicesInput, opts ...aws.Option) (*DescribeServicesOutput, error) {
+	req, out := c.DescribeServicesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTaskDefinition = "DescribeTaskDefinition"
 
 // DescribeTaskDefinitionRequest generates a request for the DescribeTaskDefinition operation.
@@ -314,6 +422,18 @@ func (c *ECS) DescribeTaskDefinition(input *DescribeTaskDefinitionInput) (*Descr
 	return out, err
 }
 
+// DescribeTaskDefinitionWithContext is the same as DescribeTaskDefinition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DescribeTaskDefinitionWithContext(ctx aws.Context, input *DescribeTaskDefinitionInput, opts ...aws.Option) (*DescribeTaskDefinitionOutput, error) {
+	req, out := c.DescribeTaskDefinitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTasks = "DescribeTasks"
 
 // DescribeTasksRequest generates a request for the DescribeTasks operation.
@@ -341,6 +461,18 @@ func (c *ECS) DescribeTasks(input *DescribeTasksInput) (*DescribeTasksOutput, er
 	return out, err
 }
 
+// DescribeTasksWithContext is the same as DescribeTasks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DescribeTasksWithContext(ctx aws.Context, input *DescribeTasksInput, opts ...aws.Option) (*DescribeTasksOutput, error) {
+	req, out := c.DescribeTasksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDiscoverPollEndpoint = "DiscoverPollEndpoint"
 
 // DiscoverPollEndpointRequest generates a request for the DiscoverPollEndpoint operation.
@@ -372,6 +504,18 @@ func (c *ECS) DiscoverPollEndpoint(input *DiscoverPollEndpointInput) (*DiscoverP
 	return out, err
 }
 
+// DiscoverPollEndpointWithContext is the same as DiscoverPollEndpoint with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) DiscoverPollEndpointWithContext(ctx aws.Context, input *DiscoverPollEndpointInput, opts ...aws.Option) (*DiscoverPollEndpointOutput, error) {
+	req, out := c.DiscoverPollEndpointRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListClusters = "ListClusters"
 
 // ListClustersRequest generates a request for the ListClusters operation.
@@ -385,6 +529,7 @@ func (c *ECS) ListClustersRequest(input *ListClustersInput) (req *aws.Request, o
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"clusterArns"},
 		},
 	}
 
@@ -405,6 +550,22 @@ func (c *ECS) ListClusters(input *ListClustersInput) (*ListClustersOutput, error
 	return out, err
 }
 
+// ListClustersWithContext is the same as ListClusters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) ListClustersWithContext(ctx aws.Context, input *ListClustersInput, opts ...aws.Option) (*ListClustersOutput, error) {
+	req, out := c.ListClustersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListClustersPages iterates over the pages of a ListClusters operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ECS) ListClustersPages(input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListClustersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -412,6 +573,19 @@ func (c *ECS) ListClustersPages(input *ListClustersInput, fn func(p *ListCluster
 	})
 }
 
+// ListClustersPagesWithContext is the same as ListClustersPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ECS) ListClustersPagesWithContext(ctx aws.Context, input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListClustersRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListClustersOutput), lastPage)
+	})
+}
+
 const opListContainerInstances = "ListContainerInstances"
 
 // ListContainerInstancesRequest generates a request for the ListContainerInstances operation.
@@ -425,6 +599,7 @@ func (c *ECS) ListContainerInstancesRequest(input *ListContainerInstancesInput)
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"containerInstanceArns"},
 		},
 	}
 
@@ -445,6 +620,22 @@ func (c *ECS) ListContainerInstances(input *ListContainerInstancesInput) (*ListC
 	return out, err
 }
 
+// ListContainerInstancesWithContext is the same as ListContainerInstances with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) ListContainerInstancesWithContext(ctx aws.Context, input *ListContainerInstancesInput, opts ...aws.Option) (*ListContainerInstancesOutput, error) {
+	req, out := c.ListContainerInstancesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListContainerInstancesPages iterates over the pages of a ListContainerInstances operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ECS) ListContainerInstancesPages(input *ListContainerInstancesInput, fn func(p *ListContainerInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListContainerInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -452,6 +643,19 @@ func (c *ECS) ListContainerInstancesPages(input *ListContainerInstancesInput, fn
 	})
 }
 
+// ListContainerInstancesPagesWithContext is the same as ListContainerInstancesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ECS) ListContainerInstancesPagesWithContext(ctx aws.Context, input *ListContainerInstancesInput, fn func(p *ListContainerInstancesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListContainerInstancesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListContainerInstancesOutput), lastPage)
+	})
+}
+
 const opListServices = "ListServices"
 
 // ListServicesRequest generates a request for the ListServices operation.
@@ -465,6 +669,7 @@ func (c *ECS) ListServicesRequest(input *ListServicesInput) (req *aws.Request, o
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"serviceArns"},
 		},
 	}
 
@@ -485,6 +690,22 @@ func (c *ECS) ListServices(input *ListServicesInput) (*ListServicesOutput, error
 	return out, err
 }
 
+// ListServicesWithContext is the same as ListServices with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) ListServicesWithContext(ctx aws.Context, input *ListServicesInput, opts ...aws.Option) (*ListServicesOutput, error) {
+	req, out := c.ListServicesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListServicesPages iterates over the pages of a ListServices operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ECS) ListServicesPages(input *ListServicesInput, fn func(p *ListServicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListServicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -492,6 +713,19 @@ func (c *ECS) ListServicesPages(input *ListServicesInput, fn func(p *ListService
 	})
 }
 
+// ListServicesPagesWithContext is the same as ListServicesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ECS) ListServicesPagesWithContext(ctx aws.Context, input *ListServicesInput, fn func(p *ListServicesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListServicesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListServicesOutput), lastPage)
+	})
+}
+
 const opListTaskDefinitionFamilies = "ListTaskDefinitionFamilies"
 
 // ListTaskDefinitionFamiliesRequest generates a request for the ListTaskDefinitionFamilies operation.
@@ -505,6 +739,7 @@ func (c *ECS) ListTaskDefinitionFamiliesRequest(input *ListTaskDefinitionFamilie
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"families"},
 		},
 	}
 
@@ -527,6 +762,22 @@ func (c *ECS) ListTaskDefinitionFamilies(input *ListTaskDefinitionFamiliesInput)
 	return out, err
 }
 
+// ListTaskDefinitionFamiliesWithContext is the same as ListTaskDefinitionFamilies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) ListTaskDefinitionFamiliesWithContext(ctx aws.Context, input *ListTaskDefinitionFamiliesInput, opts ...aws.Option) (*ListTaskDefinitionFamiliesOutput, error) {
+	req, out := c.ListTaskDefinitionFamiliesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListTaskDefinitionFamiliesPages iterates over the pages of a ListTaskDefinitionFamilies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ECS) ListTaskDefinitionFamiliesPages(input *ListTaskDefinitionFamiliesInput, fn func(p *ListTaskDefinitionFamiliesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTaskDefinitionFamiliesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -534,6 +785,19 @@ func (c *ECS) ListTaskDefinitionFamiliesPages(input *ListTaskDefinitionFamiliesI
 	})
 }
 
+// ListTaskDefinitionFamiliesPagesWithContext is the same as ListTaskDefinitionFamiliesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ECS) ListTaskDefinitionFamiliesPagesWithContext(ctx aws.Context, input *ListTaskDefinitionFamiliesInput, fn func(p *ListTaskDefinitionFamiliesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListTaskDefinitionFamiliesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTaskDefinitionFamiliesOutput), lastPage)
+	})
+}
+
 const opListTaskDefinitions = "ListTaskDefinitions"
 
 // ListTaskDefinitionsRequest generates a request for the ListTaskDefinitions operation.
@@ -547,6 +811,7 @@ func (c *ECS) ListTaskDefinitionsRequest(input *ListTaskDefinitionsInput) (req *
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"taskDefinitionArns"},
 		},
 	}
 
@@ -569,6 +834,22 @@ func (c *ECS) ListTaskDefinitions(input *ListTaskDefinitionsInput) (*ListTaskDef
 	return out, err
 }
 
+// ListTaskDefinitionsWithContext is the same as ListTaskDefinitions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) ListTaskDefinitionsWithContext(ctx aws.Context, input *ListTaskDefinitionsInput, opts ...aws.Option) (*ListTaskDefinitionsOutput, error) {
+	req, out := c.ListTaskDefinitionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListTaskDefinitionsPages iterates over the pages of a ListTaskDefinitions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ECS) ListTaskDefinitionsPages(input *ListTaskDefinitionsInput, fn func(p *ListTaskDefinitionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTaskDefinitionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -576,6 +857,19 @@ func (c *ECS) ListTaskDefinitionsPages(input *ListTaskDefinitionsInput, fn func(
 	})
 }
 
+// ListTaskDefinitionsPagesWithContext is the same as ListTaskDefinitionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ECS) ListTaskDefinitionsPagesWithContext(ctx aws.Context, input *ListTaskDefinitionsInput, fn func(p *ListTaskDefinitionsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListTaskDefinitionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTaskDefinitionsOutput), lastPage)
+	})
+}
+
 const opListTasks = "ListTasks"
 
 // ListTasksRequest generates a request for the ListTasks operation.
@@ -589,6 +883,7 @@ func (c *ECS) ListTasksRequest(input *ListTasksInput) (req *aws.Request, output
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"taskArns"},
 		},
 	}
 
@@ -611,6 +906,22 @@ func (c *ECS) ListTasks(input *ListTasksInput) (*ListTasksOutput, error) {
 	return out, err
 }
 
+// ListTasksWithContext is the same as ListTasks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) ListTasksWithContext(ctx aws.Context, input *ListTasksInput, opts ...aws.Option) (*ListTasksOutput, error) {
+	req, out := c.ListTasksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListTasksPages iterates over the pages of a ListTasks operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ECS) ListTasksPages(input *ListTasksInput, fn func(p *ListTasksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTasksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -618,6 +929,19 @@ func (c *ECS) ListTasksPages(input *ListTasksInput, fn func(p *ListTasksOutput,
 	})
 }
 
+// ListTasksPagesWithContext is the same as ListTasksPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ECS) ListTasksPagesWithContext(ctx aws.Context, input *ListTasksInput, fn func(p *ListTasksOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListTasksRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTasksOutput), lastPage)
+	})
+}
+
 const opRegisterContainerInstance = "RegisterContainerInstance"
 
 // RegisterContainerInstanceRequest generates a request for the RegisterContainerInstance operation.
@@ -649,6 +973,18 @@ func (c *ECS) RegisterContainerInstance(input *RegisterContainerInstanceInput) (
 	return out, err
 }
 
+// RegisterContainerInstanceWithContext is the same as RegisterContainerInstance with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) RegisterContainerInstanceWithContext(ctx aws.Context, input *RegisterContainerInstanceInput, opts ...aws.Option) (*RegisterContainerInstanceOutput, error) {
+	req, out := c.RegisterContainerInstanceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterTaskDefinition = "RegisterTaskDefinition"
 
 // RegisterTaskDefinitionRequest generates a request for the RegisterTaskDefinition operation.
@@ -680,6 +1016,18 @@ func (c *ECS) RegisterTaskDefinition(input *RegisterTaskDefinitionInput) (*Regis
 	return out, err
 }
 
+// RegisterTaskDefinitionWithContext is the same as RegisterTaskDefinition with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) RegisterTaskDefinitionWithContext(ctx aws.Context, input *RegisterTaskDefinitionInput, opts ...aws.Option) (*RegisterTaskDefinitionOutput, error) {
+	req, out := c.RegisterTaskDefinitionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRunTask = "RunTask"
 
 // RunTaskRequest generates a request for the RunTask operation.
@@ -711,6 +1059,18 @@ func (c *ECS) RunTask(input *RunTaskInput) (*RunTaskOutput, error) {
 	return out, err
 }
 
+// RunTaskWithContext is the same as RunTask with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) RunTaskWithContext(ctx aws.Context, input *RunTaskInput, opts ...aws.Option) (*RunTaskOutput, error) {
+	req, out := c.RunTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opStartTask = "StartTask"
 
 // StartTaskRequest generates a request for the StartTask operation.
@@ -742,6 +1102,18 @@ func (c *ECS) StartTask(input *StartTaskInput) (*StartTaskOutput, error) {
 	return out, err
 }
 
+// StartTaskWithContext is the same as StartTask with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) StartTaskWithContext(ctx aws.Context, input *StartTaskInput, opts ...aws.Option) (*StartTaskOutput, error) {
+	req, out := c.StartTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opStopTask = "StopTask"
 
 // StopTaskRequest generates a request for the StopTask operation.
@@ -769,6 +1141,18 @@ func (c *ECS) StopTask(input *StopTaskInput) (*StopTaskOutput, error) {
 	return out, err
 }
 
+// StopTaskWithContext is the same as StopTask with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) StopTaskWithContext(ctx aws.Context, input *StopTaskInput, opts ...aws.Option) (*StopTaskOutput, error) {
+	req, out := c.StopTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSubmitContainerStateChange = "SubmitContainerStateChange"
 
 // SubmitContainerStateChangeRequest generates a request for the SubmitContainerStateChange operation.
@@ -799,6 +1183,18 @@ func (c *ECS) SubmitContainerStateChange(input *SubmitContainerStateChangeInput)
 	return out, err
 }
 
+// SubmitContainerStateChangeWithContext is the same as SubmitContainerStateChange with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) SubmitContainerStateChangeWithContext(ctx aws.Context, input *SubmitContainerStateChangeInput, opts ...aws.Option) (*SubmitContainerStateChangeOutput, error) {
+	req, out := c.SubmitContainerStateChangeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSubmitTaskStateChange = "SubmitTaskStateChange"
 
 // SubmitTaskStateChangeRequest generates a request for the SubmitTaskStateChange operation.
@@ -829,6 +1225,18 @@ func (c *ECS) SubmitTaskStateChange(input *SubmitTaskStateChangeInput) (*SubmitT
 	return out, err
 }
 
+// SubmitTaskStateChangeWithContext is the same as SubmitTaskStateChange with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) SubmitTaskStateChangeWithContext(ctx aws.Context, input *SubmitTaskStateChangeInput, opts ...aws.Option) (*SubmitTaskStateChangeOutput, error) {
+	req, out := c.SubmitTaskStateChangeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateContainerAgent = "UpdateContainerAgent"
 
 // UpdateContainerAgentRequest generates a request for the UpdateContainerAgent operation.
@@ -866,6 +1274,18 @@ func (c *ECS) UpdateContainerAgent(input *UpdateContainerAgentInput) (*UpdateCon
 	return out, err
 }
 
+// UpdateContainerAgentWithContext is the same as UpdateContainerAgent with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) UpdateContainerAgentWithContext(ctx aws.Context, input *UpdateContainerAgentInput, opts ...aws.Option) (*UpdateContainerAgentOutput, error) {
+	req, out := c.UpdateContainerAgentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateService = "UpdateService"
 
 // UpdateServiceRequest generates a request for the UpdateService operation.
@@ -907,6 +1327,18 @@ func (c *ECS) UpdateService(input *UpdateServiceInput) (*UpdateServiceOutput, er
 	return out, err
 }
 
+// UpdateServiceWithContext is the same as UpdateService with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ECS) UpdateServiceWithContext(ctx aws.Context, input *UpdateServiceInput, opts ...aws.Option) (*UpdateServiceOutput, error) {
+	req, out := c.UpdateServiceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // A regional grouping of one or more container instances on which you can run
 // task requests. Each account receives a default cluster the first time you
 // use the Amazon ECS service, but you may also create other clusters. Clusters
@@ -4,6 +4,7 @@
 package ecsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
@@ -11,55 +12,145 @@ import (
 type ECSAPI interface {
 	CreateCluster(*ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error)
 
+	CreateClusterWithContext(aws.Context, *ecs.CreateClusterInput, ...aws.Option) (*ecs.CreateClusterOutput, error)
+
 	CreateService(*ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error)
 
+	CreateServiceWithContext(aws.Context, *ecs.CreateServiceInput, ...aws.Option) (*ecs.CreateServiceOutput, error)
+
 	DeleteCluster(*ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error)
 
+	DeleteClusterWithContext(aws.Context, *ecs.DeleteClusterInput, ...aws.Option) (*ecs.DeleteClusterOutput, error)
+
 	DeleteService(*ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error)
 
+	DeleteServiceWithContext(aws.Context, *ecs.DeleteServiceInput, ...aws.Option) (*ecs.DeleteServiceOutput, error)
+
 	DeregisterContainerInstance(*ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error)
 
+	DeregisterContainerInstanceWithContext(aws.Context, *ecs.DeregisterContainerInstanceInput, ...aws.Option) (*ecs.DeregisterContainerInstanceOutput, error)
+
 	DeregisterTaskDefinition(*ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
 
+	DeregisterTaskDefinitionWithContext(aws.Context, *ecs.DeregisterTaskDefinitionInput, ...aws.Option) (*ecs.DeregisterTaskDefinitionOutput, error)
+
 	DescribeClusters(*ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
 
+	DescribeClustersWithContext(aws.Context, *ecs.DescribeClustersInput, ...aws.Option) (*ecs.DescribeClustersOutput, error)
+
 	DescribeContainerInstances(*ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error)
 
+	DescribeContainerInstancesWithContext(aws.Context, *ecs.DescribeContainerInstancesInput, ...aws.Option) (*ecs.DescribeContainerInstancesOutput, error)
+
 	DescribeServices(*ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 
+	DescribeServicesWithContext(aws.Context, *ecs.DescribeServicesInput, ...aws.Option) (*ecs.DescribeServicesOutput, error)
+
 	DescribeTaskDefinition(*ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
 
+	DescribeTaskDefinitionWithContext(aws.Context, *ecs.DescribeTaskDefinitionInput, ...aws.Option) (*ecs.DescribeTaskDefinitionOutput, error)
+
 	DescribeTasks(*ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 
+	DescribeTasksWithContext(aws.Context, *ecs.DescribeTasksInput, ...aws.Option) (*ecs.DescribeTasksOutput, error)
+
 	DiscoverPollEndpoint(*ecs.DiscoverPollEndpointInput) (*ecs.DiscoverPollEndpointOutput, error)
 
+	DiscoverPollEndpointWithContext(aws.Context, *ecs.DiscoverPollEndpointInput, ...aws.Option) (*ecs.DiscoverPollEndpointOutput, error)
+
 	ListClusters(*ecs.ListClustersInput) (*ecs.ListClustersOutput, error)
 
+	ListClustersWithContext(aws.Context, *ecs.ListClustersInput, ...aws.Option) (*ecs.ListClustersOutput, error)
+
+	ListClustersPages(*ecs.ListClustersInput, func(*ecs.ListClustersOutput, bool) bool) error
+
+	ListClustersPagesWithContext(aws.Context, *ecs.ListClustersInput, func(*ecs.ListClustersOutput, bool) bool, ...aws.Option) error
+
 	ListContainerInstances(*ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error)
 
+	ListContainerInstancesWithContext(aws.Context, *ecs.ListContainerInstancesInput, ...aws.Option) (*ecs.ListContainerInstancesOutput, error)
+
+	ListContainerInstancesPages(*ecs.ListContainerInstancesInput, func(*ecs.ListContainerInstancesOutput, bool) bool) error
+
+	ListContainerInstancesPagesWithContext(aws.Context, *ecs.ListContainerInstancesInput, func(*ecs.ListContainerInstancesOutput, bool) bool, ...aws.Option) error
+
 	ListServices(*ecs.ListServicesInput) (*ecs.ListServicesOutput, error)
 
+	ListServicesWithContext(aws.Context, *ecs.ListServicesInput, ...aws.Option) (*ecs.ListServicesOutput, error)
+
+	ListServicesPages(*ecs.ListServicesInput, func(*ecs.ListServicesOutput, bool) bool) error
+
+	ListServicesPagesWithContext(aws.Context, *ecs.ListServicesInput, func(*ecs.ListServicesOutput, bool) bool, ...aws.Option) error
+
 	ListTaskDefinitionFamilies(*ecs.ListTaskDefinitionFamiliesInput) (*ecs.ListTaskDefinitionFamiliesOutput, error)
 
+	ListTaskDefinitionFamiliesWithContext(aws.Context, *ecs.ListTaskDefinitionFamiliesInput, ...aws.Option) (*ecs.ListTaskDefinitionFamiliesOutput, error)
+
+	ListTaskDefinitionFamiliesPages(*ecs.ListTaskDefinitionFamiliesInput, func(*ecs.ListTaskDefinitionFamiliesOutput, bool) bool) error
+
+	ListTaskDefinitionFamiliesPagesWithContext(aws.Context, *ecs.ListTaskDefinitionFamiliesInput, func(*ecs.ListTaskDefinitionFamiliesOutput, bool) bool, ...aws.Option) error
+
 	ListTaskDefinitions(*ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
 
+	ListTaskDefinitionsWithContext(aws.Context, *ecs.ListTaskDefinitionsInput, ...aws.Option) (*ecs.ListTaskDefinitionsOutput, error)
+
+	ListTaskDefinitionsPages(*ecs.ListTaskDefinitionsInput, func(*ecs.ListTaskDefinitionsOutput, bool) bool) error
+
+	ListTaskDefinitionsPagesWithContext(aws.Context, *ecs.ListTaskDefinitionsInput, func(*ecs.ListTaskDefinitionsOutput, bool) bool, ...aws.Option) error
+
 	ListTasks(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 
+	ListTasksWithContext(aws.Context, *ecs.ListTasksInput, ...aws.Option) (*ecs.ListTasksOutput, error)
+
+	ListTasksPages(*ecs.ListTasksInput, func(*ecs.ListTasksOutput, bool) bool) error
+
+	ListTasksPagesWithContext(aws.Context, *ecs.ListTasksInput, func(*ecs.ListTasksOutput, bool) bool, ...aws.Option) error
+
 	RegisterContainerInstance(*ecs.RegisterContainerInstanceInput) (*ecs.RegisterContainerInstanceOutput, error)
 
+	RegisterContainerInstanceWithContext(aws.Context, *ecs.RegisterContainerInstanceInput, ...aws.Option) (*ecs.RegisterContainerInstanceOutput, error)
+
 	RegisterTaskDefinition(*ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
 
+	RegisterTaskDefinitionWithContext(aws.Context, *ecs.RegisterTaskDefinitionInput, ...aws.Option) (*ecs.RegisterTaskDefinitionOutput, error)
+
 	RunTask(*ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 
+	RunTaskWithContext(aws.Context, *ecs.RunTaskInput, ...aws.Option) (*ecs.RunTaskOutput, error)
+
 	StartTask(*ecs.StartTaskInput) (*ecs.StartTaskOutput, error)
 
+	StartTaskWithContext(aws.Context, *ecs.StartTaskInput, ...aws.Option) (*ecs.StartTaskOutput, error)
+
 	StopTask(*ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
 
+	StopTaskWithContext(aws.Context, *ecs.StopTaskInput, ...aws.Option) (*ecs.StopTaskOutput, error)
+
 	SubmitContainerStateChange(*ecs.SubmitContainerStateChangeInput) (*ecs.SubmitContainerStateChangeOutput, error)
 
+	SubmitContainerStateChangeWithContext(aws.Context, *ecs.SubmitContainerStateChangeInput, ...aws.Option) (*ecs.SubmitContainerStateChangeOutput, error)
+
 	SubmitTaskStateChange(*ecs.SubmitTaskStateChangeInput) (*ecs.SubmitTaskStateChangeOutput, error)
 
+	SubmitTaskStateChangeWithContext(aws.Context, *ecs.SubmitTaskStateChangeInput, ...aws.Option) (*ecs.SubmitTaskStateChangeOutput, error)
+
 	UpdateContainerAgent(*ecs.UpdateContainerAgentInput) (*ecs.UpdateContainerAgentOutput, error)
 
+	UpdateContainerAgentWithContext(aws.Context, *ecs.UpdateContainerAgentInput, ...aws.Option) (*ecs.UpdateContainerAgentOutput, error)
+
 	UpdateService(*ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
+
+	UpdateServiceWithContext(aws.Context, *ecs.UpdateServiceInput, ...aws.Option) (*ecs.UpdateServiceOutput, error)
+
+	WaitUntilServicesInactive(*ecs.DescribeServicesInput) error
+
+	WaitUntilServicesInactiveWithContext(aws.Context, *ecs.DescribeServicesInput, ...aws.WaiterOption) error
+
+	WaitUntilTasksRunning(*ecs.DescribeTasksInput) error
+
+	WaitUntilTasksRunningWithContext(aws.Context, *ecs.DescribeTasksInput, ...aws.WaiterOption) error
+
+	WaitUntilTasksStopped(*ecs.DescribeTasksInput) error
+
+	WaitUntilTasksStoppedWithContext(aws.Context, *ecs.DescribeTasksInput, ...aws.WaiterOption) error
 }
@@ -67,6 +67,18 @@ func (c *EFS) CreateFileSystem(input *CreateFileSystemInput) (*FileSystemDescrip
 	return out, err
 }
 
+// CreateFileSystemWithContext is the same as CreateFileSystem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) CreateFileSystemWithContext(ctx aws.Context, input *CreateFileSystemInput, opts ...aws.Option) (*FileSystemDescription, error) {
+	req, out := c.CreateFileSystemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateMountTarget = "CreateMountTarget"
 
 // CreateMountTargetRequest generates a request for the CreateMountTarget operation.
@@ -169,6 +181,18 @@ func (c *EFS) CreateMountTarget(input *CreateMountTargetInput) (*MountTargetDesc
 	return out, err
 }
 
+// CreateMountTargetWithContext is the same as CreateMountTarget with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) CreateMountTargetWithContext(ctx aws.Context, input *CreateMountTargetInput, opts ...aws.Option) (*MountTargetDescription, error) {
+	req, out := c.CreateMountTargetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTags = "CreateTags"
 
 // CreateTagsRequest generates a request for the CreateTags operation.
@@ -203,6 +227,18 @@ func (c *EFS) CreateTags(input *CreateTagsInput) (*CreateTagsOutput, error) {
 	return out, err
 }
 
+// CreateTagsWithContext is the same as CreateTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) CreateTagsWithContext(ctx aws.Context, input *CreateTagsInput, opts ...aws.Option) (*CreateTagsOutput, error) {
+	req, out := c.CreateTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteFileSystem = "DeleteFileSystem"
 
 // DeleteFileSystemRequest generates a request for the DeleteFileSystem operation.
@@ -243,6 +279,18 @@ func (c *EFS) DeleteFileSystem(input *DeleteFileSystemInput) (*DeleteFileSystemO
 	return out, err
 }
 
+// DeleteFileSystemWithContext is the same as DeleteFileSystem with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) DeleteFileSystemWithContext(ctx aws.Context, input *DeleteFileSystemInput, opts ...aws.Option) (*DeleteFileSystemOutput, error) {
+	req, out := c.DeleteFileSystemRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMountTarget = "DeleteMountTarget"
 
 // DeleteMountTargetRequest generates a request for the DeleteMountTarget operation.
@@ -291,6 +339,18 @@ func (c *EFS) DeleteMountTarget(input *DeleteMountTargetInput) (*DeleteMountTarg
 	return out, err
 }
 
+// DeleteMountTargetWithContext is the same as DeleteMountTarget with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) DeleteMountTargetWithContext(ctx aws.Context, input *DeleteMountTargetInput, opts ...aws.Option) (*DeleteMountTargetOutput, error) {
+	req, out := c.DeleteMountTargetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTags = "DeleteTags"
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -325,6 +385,18 @@ func (c *EFS) DeleteTags(input *DeleteTagsInput) (*DeleteTagsOutput, error) {
 	return out, err
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) DeleteTagsWithContext(ctx aws.Context, input *DeleteTagsInput, opts ...aws.Option) (*DeleteTagsOutput, error) {
+	req, out := c.DeleteTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeFileSystems = "DescribeFileSystems"
 
 // DescribeFileSystemsRequest generates a request for the DescribeFileSystems operation.
@@ -377,6 +449,18 @@ func (c *EFS) DescribeFileSystems(input *DescribeFileSystemsInput) (*DescribeFil
 	return out, err
 }
 
+// DescribeFileSystemsWithContext is the same as DescribeFileSystems with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) DescribeFileSystemsWithContext(ctx aws.Context, input *DescribeFileSystemsInput, opts ...aws.Option) (*DescribeFileSystemsOutput, error) {
+	req, out := c.DescribeFileSystemsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMountTargetSecurityGroups = "DescribeMountTargetSecurityGroups"
 
 // DescribeMountTargetSecurityGroupsRequest generates a request for the DescribeMountTargetSecurityGroups operation.
@@ -412,6 +496,18 @@ func (c *EFS) DescribeMountTargetSecurityGroups(input *DescribeMountTargetSecuri
 	return out, err
 }
 
+// DescribeMountTargetSecurityGroupsWithContext is the same as DescribeMountTargetSecurityGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) DescribeMountTargetSecurityGroupsWithContext(ctx aws.Context, input *DescribeMountTargetSecurityGroupsInput, opts ...aws.Option) (*DescribeMountTargetSecurityGroupsOutput, error) {
+	req, out := c.DescribeMountTargetSecurityGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMountTargets = "DescribeMountTargets"
 
 // DescribeMountTargetsRequest generates a request for the DescribeMountTargets operation.
@@ -443,6 +539,18 @@ func (c *EFS) DescribeMountTargets(input *DescribeMountTargetsInput) (*DescribeM
 	return out, err
 }
 
+// DescribeMountTargetsWithContext is the same as DescribeMountTargets with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) DescribeMountTargetsWithContext(ctx aws.Context, input *DescribeMountTargetsInput, opts ...aws.Option) (*DescribeMountTargetsOutput, error) {
+	req, out := c.DescribeMountTargetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTags = "DescribeTags"
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -476,6 +584,18 @@ func (c *EFS) DescribeTags(input *DescribeTagsInput) (*DescribeTagsOutput, error
 	return out, err
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) DescribeTagsWithContext(ctx aws.Context, input *DescribeTagsInput, opts ...aws.Option) (*DescribeTagsOutput, error) {
+	req, out := c.DescribeTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyMountTargetSecurityGroups = "ModifyMountTargetSecurityGroups"
 
 // ModifyMountTargetSecurityGroupsRequest generates a request for the ModifyMountTargetSecurityGroups operation.
@@ -516,10 +636,22 @@ func (c *EFS) ModifyMountTargetSecurityGroups(input *ModifyMountTargetSecurityGr
 	return out, err
 }
 
+// ModifyMountTargetSecurityGroupsWithContext is the same as ModifyMountTargetSecurityGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EFS) ModifyMountTargetSecurityGroupsWithContext(ctx aws.Context, input *ModifyMountTargetSecurityGroupsInput, opts ...aws.Option) (*ModifyMountTargetSecurityGroupsOutput, error) {
+	req, out := c.ModifyMountTargetSecurityGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 type CreateFileSystemInput struct {
 	// String of up to 64 ASCII characters. Amazon EFS uses this to ensure idempotent
 	// creation.
-	CreationToken *string `type:"string" required:"true"`
+	CreationToken *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateFileSystemInput `json:"-" xml:"-"`
 }
@@ -547,7 +679,7 @@ type CreateMountTargetInput struct {
 
 	// Up to 5 VPC security group IDs, of the form "sg-xxxxxxxx". These must be
 	// for the same VPC as subnet specified.
-	SecurityGroups []*string `type:"list"`
+	SecurityGroups []*string `type:"list" max:"5"`
 
 	// The ID of the subnet to add the mount target in.
 	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
@@ -736,7 +868,7 @@ type DescribeFileSystemsInput struct {
 	// Optional string. Restricts the list to the file system with this creation
 	// token (you specify a creation token at the time of creating an Amazon EFS
 	// file system).
-	CreationToken *string `location:"querystring" locationName:"CreationToken" type:"string"`
+	CreationToken *string `location:"querystring" locationName:"CreationToken" type:"string" min:"1" max:"64"`
 
 	// Optional string. File system ID whose description you want to retrieve.
 	FileSystemID *string `location:"querystring" locationName:"FileSystemId" type:"string"`
@@ -751,7 +883,7 @@ type DescribeFileSystemsInput struct {
 	// of items Amazon EFS returns will be the minimum of the MaxItems parameter
 	// specified in the request and the service's internal maximum number of items
 	// per page.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1"`
 
 	metadataDescribeFileSystemsInput `json:"-" xml:"-"`
 }
@@ -821,7 +953,7 @@ func (s DescribeMountTargetSecurityGroupsInput) GoString() string {
 
 type DescribeMountTargetSecurityGroupsOutput struct {
 	// An array of security groups.
-	SecurityGroups []*string `type:"list" required:"true"`
+	SecurityGroups []*string `type:"list" max:"5" required:"true"`
 
 	metadataDescribeMountTargetSecurityGroupsOutput `json:"-" xml:"-"`
 }
@@ -851,7 +983,7 @@ type DescribeMountTargetsInput struct {
 
 	// Optional. Maximum number of mount targets to return in the response. It must
 	// be an integer with a value greater than zero.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1"`
 
 	metadataDescribeMountTargetsInput `json:"-" xml:"-"`
 }
@@ -912,7 +1044,7 @@ type DescribeTagsInput struct {
 
 	// Optional. Maximum number of file system tags to return in the response. It
 	// must be an integer with a value greater than zero.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1"`
 
 	metadataDescribeTagsInput `json:"-" xml:"-"`
 }
@@ -967,7 +1099,7 @@ type FileSystemDescription struct {
 	CreationTime *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// Opaque string specified in the request.
-	CreationToken *string `type:"string" required:"true"`
+	CreationToken *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The file system ID assigned by Amazon EFS.
 	FileSystemID *string `locationName:"FileSystemId" type:"string" required:"true"`
@@ -979,11 +1111,11 @@ type FileSystemDescription struct {
 	// You can add tags to a file system (see CreateTags) including a "Name" tag.
 	// If the file system has a "Name" tag, Amazon EFS returns the value in this
 	// field.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"256"`
 
 	// The current number of mount targets (see CreateMountTarget) the file system
 	// has.
-	NumberOfMountTargets *int64 `type:"integer" required:"true"`
+	NumberOfMountTargets *int64 `type:"integer" min:"0" required:"true"`
 
 	// The AWS account that created the file system. If the file system was created
 	// by an IAM user, the parent account to which the user belongs is the owner.
@@ -1031,7 +1163,7 @@ type FileSystemSize struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The latest known metered size, in bytes, of data stored in the file system.
-	Value *int64 `type:"long" required:"true"`
+	Value *int64 `type:"long" min:"0" required:"true"`
 
 	metadataFileSystemSize `json:"-" xml:"-"`
 }
@@ -1055,7 +1187,7 @@ type ModifyMountTargetSecurityGroupsInput struct {
 	MountTargetID *string `location:"uri" locationName:"MountTargetId" type:"string" required:"true"`
 
 	// An array of up to five VPC security group IDs.
-	SecurityGroups []*string `type:"list"`
+	SecurityGroups []*string `type:"list" max:"5"`
 
 	metadataModifyMountTargetSecurityGroupsInput `json:"-" xml:"-"`
 }
@@ -1138,10 +1270,10 @@ func (s MountTargetDescription) GoString() string {
 // '+', '-', '=', '.', '_', ':', and '/'.
 type Tag struct {
 	// Tag key, a string. The key must not start with "aws:".
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// Value of the tag key.
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"256" required:"true"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -4,6 +4,7 @@
 package efsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 )
 
@@ -11,23 +12,45 @@ import (
 type EFSAPI interface {
 	CreateFileSystem(*efs.CreateFileSystemInput) (*efs.FileSystemDescription, error)
 
+	CreateFileSystemWithContext(aws.Context, *efs.CreateFileSystemInput, ...aws.Option) (*efs.FileSystemDescription, error)
+
 	CreateMountTarget(*efs.CreateMountTargetInput) (*efs.MountTargetDescription, error)
 
+	CreateMountTargetWithContext(aws.Context, *efs.CreateMountTargetInput, ...aws.Option) (*efs.MountTargetDescription, error)
+
 	CreateTags(*efs.CreateTagsInput) (*efs.CreateTagsOutput, error)
 
+	CreateTagsWithContext(aws.Context, *efs.CreateTagsInput, ...aws.Option) (*efs.CreateTagsOutput, error)
+
 	DeleteFileSystem(*efs.DeleteFileSystemInput) (*efs.DeleteFileSystemOutput, error)
 
+	DeleteFileSystemWithContext(aws.Context, *efs.DeleteFileSystemInput, ...aws.Option) (*efs.DeleteFileSystemOutput, error)
+
 	DeleteMountTarget(*efs.DeleteMountTargetInput) (*efs.DeleteMountTargetOutput, error)
 
+	DeleteMountTargetWithContext(aws.Context, *efs.DeleteMountTargetInput, ...aws.Option) (*efs.DeleteMountTargetOutput, error)
+
 	DeleteTags(*efs.DeleteTagsInput) (*efs.DeleteTagsOutput, error)
 
+	DeleteTagsWithContext(aws.Context, *efs.DeleteTagsInput, ...aws.Option) (*efs.DeleteTagsOutput, error)
+
 	DescribeFileSystems(*efs.DescribeFileSystemsInput) (*efs.DescribeFileSystemsOutput, error)
 
+	DescribeFileSystemsWithContext(aws.Context, *efs.DescribeFileSystemsInput, ...aws.Option) (*efs.DescribeFileSystemsOutput, error)
+
 	DescribeMountTargetSecurityGroups(*efs.DescribeMountTargetSecurityGroupsInput) (*efs.DescribeMountTargetSecurityGroupsOutput, error)
 
+	DescribeMountTargetSecurityGroupsWithContext(aws.Context, *efs.DescribeMountTargetSecurityGroupsInput, ...aws.Option) (*efs.DescribeMountTargetSecurityGroupsOutput, error)
+
 	DescribeMountTargets(*efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error)
 
+	DescribeMountTargetsWithContext(aws.Context, *efs.DescribeMountTargetsInput, ...aws.Option) (*efs.DescribeMountTargetsOutput, error)
+
 	DescribeTags(*efs.DescribeTagsInput) (*efs.DescribeTagsOutput, error)
 
+	DescribeTagsWithContext(aws.Context, *efs.DescribeTagsInput, ...aws.Option) (*efs.DescribeTagsOutput, error)
+
 	ModifyMountTargetSecurityGroups(*efs.ModifyMountTargetSecurityGroupsInput) (*efs.ModifyMountTargetSecurityGroupsOutput, error)
+
+	ModifyMountTargetSecurityGroupsWithContext(aws.Context, *efs.ModifyMountTargetSecurityGroupsInput, ...aws.Option) (*efs.ModifyMountTargetSecurityGroupsOutput, error)
 }
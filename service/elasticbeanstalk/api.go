@@ -38,6 +38,18 @@ func (c *ElasticBeanstalk) AbortEnvironmentUpdate(input *AbortEnvironmentUpdateI
 	return out, err
 }
 
+// AbortEnvironmentUpdateWithContext is the same as AbortEnvironmentUpdate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) AbortEnvironmentUpdateWithContext(ctx aws.Context, input *AbortEnvironmentUpdateInput, opts ...aws.Option) (*AbortEnvironmentUpdateOutput, error) {
+	req, out := c.AbortEnvironmentUpdateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCheckDNSAvailability = "CheckDNSAvailability"
 
 // CheckDNSAvailabilityRequest generates a request for the CheckDNSAvailability operation.
@@ -65,6 +77,18 @@ func (c *ElasticBeanstalk) CheckDNSAvailability(input *CheckDNSAvailabilityInput
 	return out, err
 }
 
+// CheckDNSAvailabilityWithContext is the same as CheckDNSAvailability with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) CheckDNSAvailabilityWithContext(ctx aws.Context, input *CheckDNSAvailabilityInput, opts ...aws.Option) (*CheckDNSAvailabilityOutput, error) {
+	req, out := c.CheckDNSAvailabilityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateApplication = "CreateApplication"
 
 // CreateApplicationRequest generates a request for the CreateApplication operation.
@@ -93,6 +117,18 @@ func (c *ElasticBeanstalk) CreateApplication(input *CreateApplicationInput) (*Ap
 	return out, err
 }
 
+// CreateApplicationWithContext is the same as CreateApplication with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) CreateApplicationWithContext(ctx aws.Context, input *CreateApplicationInput, opts ...aws.Option) (*ApplicationDescriptionMessage, error) {
+	req, out := c.CreateApplicationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateApplicationVersion = "CreateApplicationVersion"
 
 // CreateApplicationVersionRequest generates a request for the CreateApplicationVersion operation.
@@ -125,6 +161,18 @@ func (c *ElasticBeanstalk) CreateApplicationVersion(input *CreateApplicationVers
 	return out, err
 }
 
+// CreateApplicationVersionWithContext is the same as CreateApplicationVersion with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) CreateApplicationVersionWithContext(ctx aws.Context, input *CreateApplicationVersionInput, opts ...aws.Option) (*ApplicationVersionDescriptionMessage, error) {
+	req, out := c.CreateApplicationVersionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateConfigurationTemplate = "CreateConfigurationTemplate"
 
 // CreateConfigurationTemplateRequest generates a request for the CreateConfigurationTemplate operation.
@@ -158,6 +206,18 @@ func (c *ElasticBeanstalk) CreateConfigurationTemplate(input *CreateConfiguratio
 	return out, err
 }
 
+// CreateConfigurationTemplateWithContext is the same as CreateConfigurationTemplate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) CreateConfigurationTemplateWithContext(ctx aws.Context, input *CreateConfigurationTemplateInput, opts ...aws.Option) (*ConfigurationSettingsDescription, error) {
+	req, out := c.CreateConfigurationTemplateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateEnvironment = "CreateEnvironment"
 
 // CreateEnvironmentRequest generates a request for the CreateEnvironment operation.
@@ -186,6 +246,18 @@ func (c *ElasticBeanstalk) CreateEnvironment(input *CreateEnvironmentInput) (*En
 	return out, err
 }
 
+// CreateEnvironmentWithContext is the same as CreateEnvironment with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) CreateEnvironmentWithContext(ctx aws.Context, input *CreateEnvironmentInput, opts ...aws.Option) (*EnvironmentDescription, error) {
+	req, out := c.CreateEnvironmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStorageLocation = "CreateStorageLocation"
 
 // CreateStorageLocationRequest generates a request for the CreateStorageLocation operation.
@@ -215,6 +287,18 @@ func (c *ElasticBeanstalk) CreateStorageLocation(input *CreateStorageLocationInp
 	return out, err
 }
 
+// CreateStorageLocationWithContext is the same as CreateStorageLocation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) CreateStorageLocationWithContext(ctx aws.Context, input *CreateStorageLocationInput, opts ...aws.Option) (*CreateStorageLocationOutput, error) {
+	req, out := c.CreateStorageLocationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteApplication = "DeleteApplication"
 
 // DeleteApplicationRequest generates a request for the DeleteApplication operation.
@@ -246,6 +330,18 @@ func (c *ElasticBeanstalk) DeleteApplication(input *DeleteApplicationInput) (*De
 	return out, err
 }
 
+// DeleteApplicationWithContext is the same as DeleteApplication with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DeleteApplicationWithContext(ctx aws.Context, input *DeleteApplicationInput, opts ...aws.Option) (*DeleteApplicationOutput, error) {
+	req, out := c.DeleteApplicationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteApplicationVersion = "DeleteApplicationVersion"
 
 // DeleteApplicationVersionRequest generates a request for the DeleteApplicationVersion operation.
@@ -276,6 +372,18 @@ func (c *ElasticBeanstalk) DeleteApplicationVersion(input *DeleteApplicationVers
 	return out, err
 }
 
+// DeleteApplicationVersionWithContext is the same as DeleteApplicationVersion with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DeleteApplicationVersionWithContext(ctx aws.Context, input *DeleteApplicationVersionInput, opts ...aws.Option) (*DeleteApplicationVersionOutput, error) {
+	req, out := c.DeleteApplicationVersionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteConfigurationTemplate = "DeleteConfigurationTemplate"
 
 // DeleteConfigurationTemplateRequest generates a request for the DeleteConfigurationTemplate operation.
@@ -307,6 +415,18 @@ func (c *ElasticBeanstalk) DeleteConfigurationTemplate(input *DeleteConfiguratio
 	return out, err
 }
 
+// DeleteConfigurationTemplateWithContext is the same as DeleteConfigurationTemplate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DeleteConfigurationTemplateWithContext(ctx aws.Context, input *DeleteConfigurationTemplateInput, opts ...aws.Option) (*DeleteConfigurationTemplateOutput, error) {
+	req, out := c.DeleteConfigurationTemplateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteEnvironmentConfiguration = "DeleteEnvironmentConfiguration"
 
 // DeleteEnvironmentConfigurationRequest generates a request for the DeleteEnvironmentConfiguration operation.
@@ -341,6 +461,18 @@ func (c *ElasticBeanstalk) DeleteEnvironmentConfiguration(input *DeleteEnvironme
 	return out, err
 }
 
+// DeleteEnvironmentConfigurationWithContext is the same as DeleteEnvironmentConfiguration with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DeleteEnvironmentConfigurationWithContext(ctx aws.Context, input *DeleteEnvironmentConfigurationInput, opts ...aws.Option) (*DeleteEnvironmentConfigurationOutput, error) {
+	req, out := c.DeleteEnvironmentConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeApplicationVersions = "DescribeApplicationVersions"
 
 // DescribeApplicationVersionsRequest generates a request for the DescribeApplicationVersions operation.
@@ -368,6 +500,18 @@ func (c *ElasticBeanstalk) DescribeApplicationVersions(input *DescribeApplicatio
 	return out, err
 }
 
+// DescribeApplicationVersionsWithContext is the same as DescribeApplicationVersions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DescribeApplicationVersionsWithContext(ctx aws.Context, input *DescribeApplicationVersionsInput, opts ...aws.Option) (*DescribeApplicationVersionsOutput, error) {
+	req, out := c.DescribeApplicationVersionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeApplications = "DescribeApplications"
 
 // DescribeApplicationsRequest generates a request for the DescribeApplications operation.
@@ -395,6 +539,18 @@ func (c *ElasticBeanstalk) DescribeApplications(input *DescribeApplicationsInput
 	return out, err
 }
 
+// DescribeApplicationsWithContext is the same as DescribeApplications with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DescribeApplicationsWithContext(ctx aws.Context, input *DescribeApplicationsInput, opts ...aws.Option) (*DescribeApplicationsOutput, error) {
+	req, out := c.DescribeApplicationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationOptions = "DescribeConfigurationOptions"
 
 // DescribeConfigurationOptionsRequest generates a request for the DescribeConfigurationOptions operation.
@@ -426,6 +582,18 @@ func (c *ElasticBeanstalk) DescribeConfigurationOptions(input *DescribeConfigura
 	return out, err
 }
 
+// DescribeConfigurationOptionsWithContext is the same as DescribeConfigurationOptions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DescribeConfigurationOptionsWithContext(ctx aws.Context, input *DescribeConfigurationOptionsInput, opts ...aws.Option) (*DescribeConfigurationOptionsOutput, error) {
+	req, out := c.DescribeConfigurationOptionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationSettings = "DescribeConfigurationSettings"
 
 // DescribeConfigurationSettingsRequest generates a request for the DescribeConfigurationSettings operation.
@@ -465,6 +633,18 @@ func (c *ElasticBeanstalk) DescribeConfigurationSettings(input *DescribeConfigur
 	return out, err
 }
 
+// DescribeConfigurationSettingsWithContext is the same as DescribeConfigurationSettings with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DescribeConfigurationSettingsWithContext(ctx aws.Context, input *DescribeConfigurationSettingsInput, opts ...aws.Option) (*DescribeConfigurationSettingsOutput, error) {
+	req, out := c.DescribeConfigurationSettingsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeEnvironmentResources = "DescribeEnvironmentResources"
 
 // DescribeEnvironmentResourcesRequest generates a request for the DescribeEnvironmentResources operation.
@@ -492,6 +672,18 @@ func (c *ElasticBeanstalk) DescribeEnvironmentResources(input *DescribeEnvironme
 	return out, err
 }
 
+// DescribeEnvironmentResourcesWithContext is the same as DescribeEnvironmentResources with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DescribeEnvironmentResourcesWithContext(ctx aws.Context, input *DescribeEnvironmentResourcesInput, opts ...aws.Option) (*DescribeEnvironmentResourcesOutput, error) {
+	req, out := c.DescribeEnvironmentResourcesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeEnvironments = "DescribeEnvironments"
 
 // DescribeEnvironmentsRequest generates a request for the DescribeEnvironments operation.
@@ -519,6 +711,18 @@ func (c *ElasticBeanstalk) DescribeEnvironments(input *DescribeEnvironmentsInput
 	return out, err
 }
 
+// DescribeEnvironmentsWithContext is the same as DescribeEnvironments with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DescribeEnvironmentsWithContext(ctx aws.Context, input *DescribeEnvironmentsInput, opts ...aws.Option) (*DescribeEnvironmentsOutput, error) {
+	req, out := c.DescribeEnvironmentsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeEvents = "DescribeEvents"
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -532,6 +736,7 @@ func (c *ElasticBeanstalk) DescribeEventsRequest(input *DescribeEventsInput) (re
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
+			ResultKeys:      []string{"Events"},
 		},
 	}
 
@@ -554,6 +759,22 @@ func (c *ElasticBeanstalk) DescribeEvents(input *DescribeEventsInput) (*Describe
 	return out, err
 }
 
+// DescribeEventsWithContext is the same as DescribeEvents with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) DescribeEventsWithContext(ctx aws.Context, input *DescribeEventsInput, opts ...aws.Option) (*DescribeEventsOutput, error) {
+	req, out := c.DescribeEventsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ElasticBeanstalk) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeEventsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -561,6 +782,19 @@ func (c *ElasticBeanstalk) DescribeEventsPages(input *DescribeEventsInput, fn fu
 	})
 }
 
+// DescribeEventsPagesWithContext is the same as DescribeEventsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ElasticBeanstalk) DescribeEventsPagesWithContext(ctx aws.Context, input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeEventsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 const opListAvailableSolutionStacks = "ListAvailableSolutionStacks"
 
 // ListAvailableSolutionStacksRequest generates a request for the ListAvailableSolutionStacks operation.
@@ -588,6 +822,18 @@ func (c *ElasticBeanstalk) ListAvailableSolutionStacks(input *ListAvailableSolut
 	return out, err
 }
 
+// ListAvailableSolutionStacksWithContext is the same as ListAvailableSolutionStacks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) ListAvailableSolutionStacksWithContext(ctx aws.Context, input *ListAvailableSolutionStacksInput, opts ...aws.Option) (*ListAvailableSolutionStacksOutput, error) {
+	req, out := c.ListAvailableSolutionStacksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRebuildEnvironment = "RebuildEnvironment"
 
 // RebuildEnvironmentRequest generates a request for the RebuildEnvironment operation.
@@ -616,6 +862,18 @@ func (c *ElasticBeanstalk) RebuildEnvironment(input *RebuildEnvironmentInput) (*
 	return out, err
 }
 
+// RebuildEnvironmentWithContext is the same as RebuildEnvironment with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) RebuildEnvironmentWithContext(ctx aws.Context, input *RebuildEnvironmentInput, opts ...aws.Option) (*RebuildEnvironmentOutput, error) {
+	req, out := c.RebuildEnvironmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRequestEnvironmentInfo = "RequestEnvironmentInfo"
 
 // RequestEnvironmentInfoRequest generates a request for the RequestEnvironmentInfo operation.
@@ -657,6 +915,18 @@ func (c *ElasticBeanstalk) RequestEnvironmentInfo(input *RequestEnvironmentInfoI
 	return out, err
 }
 
+// RequestEnvironmentInfoWithContext is the same as RequestEnvironmentInfo with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) RequestEnvironmentInfoWithContext(ctx aws.Context, input *RequestEnvironmentInfoInput, opts ...aws.Option) (*RequestEnvironmentInfoOutput, error) {
+	req, out := c.RequestEnvironmentInfoRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRestartAppServer = "RestartAppServer"
 
 // RestartAppServerRequest generates a request for the RestartAppServer operation.
@@ -685,6 +955,18 @@ func (c *ElasticBeanstalk) RestartAppServer(input *RestartAppServerInput) (*Rest
 	return out, err
 }
 
+// RestartAppServerWithContext is the same as RestartAppServer with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) RestartAppServerWithContext(ctx aws.Context, input *RestartAppServerInput, opts ...aws.Option) (*RestartAppServerOutput, error) {
+	req, out := c.RestartAppServerRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveEnvironmentInfo = "RetrieveEnvironmentInfo"
 
 // RetrieveEnvironmentInfoRequest generates a request for the RetrieveEnvironmentInfo operation.
@@ -716,6 +998,18 @@ func (c *ElasticBeanstalk) RetrieveEnvironmentInfo(input *RetrieveEnvironmentInf
 	return out, err
 }
 
+// RetrieveEnvironmentInfoWithContext is the same as RetrieveEnvironmentInfo with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) RetrieveEnvironmentInfoWithContext(ctx aws.Context, input *RetrieveEnvironmentInfoInput, opts ...aws.Option) (*RetrieveEnvironmentInfoOutput, error) {
+	req, out := c.RetrieveEnvironmentInfoRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSwapEnvironmentCNAMEs = "SwapEnvironmentCNAMEs"
 
 // SwapEnvironmentCNAMEsRequest generates a request for the SwapEnvironmentCNAMEs operation.
@@ -743,6 +1037,18 @@ func (c *ElasticBeanstalk) SwapEnvironmentCNAMEs(input *SwapEnvironmentCNAMEsInp
 	return out, err
 }
 
+// SwapEnvironmentCNAMEsWithContext is the same as SwapEnvironmentCNAMEs with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) SwapEnvironmentCNAMEsWithContext(ctx aws.Context, input *SwapEnvironmentCNAMEsInput, opts ...aws.Option) (*SwapEnvironmentCNAMEsOutput, error) {
+	req, out := c.SwapEnvironmentCNAMEsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateEnvironment = "TerminateEnvironment"
 
 // TerminateEnvironmentRequest generates a request for the TerminateEnvironment operation.
@@ -770,6 +1076,18 @@ func (c *ElasticBeanstalk) TerminateEnvironment(input *TerminateEnvironmentInput
 	return out, err
 }
 
+// TerminateEnvironmentWithContext is the same as TerminateEnvironment with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) TerminateEnvironmentWithContext(ctx aws.Context, input *TerminateEnvironmentInput, opts ...aws.Option) (*EnvironmentDescription, error) {
+	req, out := c.TerminateEnvironmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateApplication = "UpdateApplication"
 
 // UpdateApplicationRequest generates a request for the UpdateApplication operation.
@@ -800,6 +1118,18 @@ func (c *ElasticBeanstalk) UpdateApplication(input *UpdateApplicationInput) (*Ap
 	return out, err
 }
 
+// UpdateApplicationWithContext is the same as UpdateApplication with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) UpdateApplicationWithContext(ctx aws.Context, input *UpdateApplicationInput, opts ...aws.Option) (*ApplicationDescriptionMessage, error) {
+	req, out := c.UpdateApplicationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateApplicationVersion = "UpdateApplicationVersion"
 
 // UpdateApplicationVersionRequest generates a request for the UpdateApplicationVersion operation.
@@ -830,6 +1160,18 @@ func (c *ElasticBeanstalk) UpdateApplicationVersion(input *UpdateApplicationVers
 	return out, err
 }
 
+// UpdateApplicationVersionWithContext is the same as UpdateApplicationVersion with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) UpdateApplicationVersionWithContext(ctx aws.Context, input *UpdateApplicationVersionInput, opts ...aws.Option) (*ApplicationVersionDescriptionMessage, error) {
+	req, out := c.UpdateApplicationVersionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateConfigurationTemplate = "UpdateConfigurationTemplate"
 
 // UpdateConfigurationTemplateRequest generates a request for the UpdateConfigurationTemplate operation.
@@ -864,6 +1206,18 @@ func (c *ElasticBeanstalk) UpdateConfigurationTemplate(input *UpdateConfiguratio
 	return out, err
 }
 
+// UpdateConfigurationTemplateWithContext is the same as UpdateConfigurationTemplate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) UpdateConfigurationTemplateWithContext(ctx aws.Context, input *UpdateConfigurationTemplateInput, opts ...aws.Option) (*ConfigurationSettingsDescription, error) {
+	req, out := c.UpdateConfigurationTemplateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateEnvironment = "UpdateEnvironment"
 
 // UpdateEnvironmentRequest generates a request for the UpdateEnvironment operation.
@@ -901,6 +1255,18 @@ func (c *ElasticBeanstalk) UpdateEnvironment(input *UpdateEnvironmentInput) (*En
 	return out, err
 }
 
+// UpdateEnvironmentWithContext is the same as UpdateEnvironment with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) UpdateEnvironmentWithContext(ctx aws.Context, input *UpdateEnvironmentInput, opts ...aws.Option) (*EnvironmentDescription, error) {
+	req, out := c.UpdateEnvironmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opValidateConfigurationSettings = "ValidateConfigurationSettings"
 
 // ValidateConfigurationSettingsRequest generates a request for the ValidateConfigurationSettings operation.
@@ -932,6 +1298,18 @@ func (c *ElasticBeanstalk) ValidateConfigurationSettings(input *ValidateConfigur
 	return out, err
 }
 
+// ValidateConfigurationSettingsWithContext is the same as ValidateConfigurationSettings with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticBeanstalk) ValidateConfigurationSettingsWithContext(ctx aws.Context, input *ValidateConfigurationSettingsInput, opts ...aws.Option) (*ValidateConfigurationSettingsOutput, error) {
+	req, out := c.ValidateConfigurationSettingsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 type AbortEnvironmentUpdateInput struct {
 	// This specifies the ID of the environment with the in-progress update that
 	// you want to cancel.
@@ -939,7 +1317,7 @@ type AbortEnvironmentUpdateInput struct {
 
 	// This specifies the name of the environment with the in-progress update that
 	// you want to cancel.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataAbortEnvironmentUpdateInput `json:"-" xml:"-"`
 }
@@ -979,7 +1357,7 @@ func (s AbortEnvironmentUpdateOutput) GoString() string {
 // Describes the properties of an application.
 type ApplicationDescription struct {
 	// The name of the application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The names of the configuration templates associated with this application.
 	ConfigurationTemplates []*string `type:"list"`
@@ -991,7 +1369,7 @@ type ApplicationDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// User-defined description of the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The names of the versions for this application.
 	Versions []*string `type:"list"`
@@ -1038,7 +1416,7 @@ func (s ApplicationDescriptionMessage) GoString() string {
 // Describes the properties of an application version.
 type ApplicationVersionDescription struct {
 	// The name of the application associated with this release.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The creation date of the application version.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1047,13 +1425,13 @@ type ApplicationVersionDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description of this application version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The location where the source bundle is located for this version.
 	SourceBundle *S3Location `type:"structure"`
 
 	// A label uniquely identifying the version for the associated application.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataApplicationVersionDescription `json:"-" xml:"-"`
 }
@@ -1119,7 +1497,7 @@ func (s AutoScalingGroup) GoString() string {
 // Results message indicating whether a CNAME is available.
 type CheckDNSAvailabilityInput struct {
 	// The prefix used when this CNAME is reserved.
-	CNAMEPrefix *string `type:"string" required:"true"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63" required:"true"`
 
 	metadataCheckDNSAvailabilityInput `json:"-" xml:"-"`
 }
@@ -1151,7 +1529,7 @@ type CheckDNSAvailabilityOutput struct {
 
 	// The fully qualified CNAME to reserve when CreateEnvironment is called with
 	// the provided prefix.
-	FullyQualifiedCNAME *string `type:"string"`
+	FullyQualifiedCNAME *string `type:"string" min:"1" max:"255"`
 
 	metadataCheckDNSAvailabilityOutput `json:"-" xml:"-"`
 }
@@ -1289,7 +1667,7 @@ type ConfigurationOptionSetting struct {
 	OptionName *string `type:"string"`
 
 	// A unique resource name for a time-based scaling configuration option.
-	ResourceName *string `type:"string"`
+	ResourceName *string `type:"string" min:"1" max:"256"`
 
 	// The current value for the configuration option.
 	Value *string `type:"string"`
@@ -1314,7 +1692,7 @@ func (s ConfigurationOptionSetting) GoString() string {
 // Describes the settings for a configuration set.
 type ConfigurationSettingsDescription struct {
 	// The name of the application associated with this configuration set.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The date (in UTC time) when this configuration set was created.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1343,21 +1721,21 @@ type ConfigurationSettingsDescription struct {
 	DeploymentStatus *string `type:"string"`
 
 	// Describes this configuration set.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// If not null, the name of the environment for this configuration set.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the configuration options and their values in this configuration
 	// set.
 	OptionSettings []*ConfigurationOptionSetting `type:"list"`
 
 	// The name of the solution stack this configuration set uses.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If not null, the name of the configuration template for this configuration
 	// set.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataConfigurationSettingsDescription `json:"-" xml:"-"`
 }
@@ -1382,10 +1760,10 @@ type CreateApplicationInput struct {
 	//
 	//  Constraint: This name must be unique within your account. If the specified
 	// name already exists, the action returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataCreateApplicationInput `json:"-" xml:"-"`
 }
@@ -1407,7 +1785,7 @@ func (s CreateApplicationInput) GoString() string {
 type CreateApplicationVersionInput struct {
 	// The name of the application. If no application is found with this name, and
 	// AutoCreateApplication is false, returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Determines how the system behaves if the specified application for this version
 	// does not already exist:
@@ -1427,7 +1805,7 @@ type CreateApplicationVersionInput struct {
 	AutoCreateApplication *bool `type:"boolean"`
 
 	// Describes this version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The Amazon S3 bucket and key that identify the location of the source bundle
 	// for this version.
@@ -1447,7 +1825,7 @@ type CreateApplicationVersionInput struct {
 	// Constraint: Must be unique per application. If an application version already
 	// exists with this label for the specified application, AWS Elastic Beanstalk
 	// returns an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateApplicationVersionInput `json:"-" xml:"-"`
 }
@@ -1471,10 +1849,10 @@ type CreateConfigurationTemplateInput struct {
 	// The name of the application to associate with this configuration template.
 	// If no application is found with this name, AWS Elastic Beanstalk returns
 	// an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes this configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment used with this configuration template.
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
@@ -1498,7 +1876,7 @@ type CreateConfigurationTemplateInput struct {
 	//  If a solution stack name is not specified and the source configuration
 	// parameter is specified, AWS Elastic Beanstalk uses the same solution stack
 	// as the source configuration template.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk uses the configuration values from the
 	// specified configuration template to create a new configuration.
@@ -1521,7 +1899,7 @@ type CreateConfigurationTemplateInput struct {
 	//
 	// Default: If a configuration template already exists with this name, AWS
 	// Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateConfigurationTemplateInput `json:"-" xml:"-"`
 }
@@ -1545,15 +1923,15 @@ type CreateEnvironmentInput struct {
 	//
 	//  If no application is found with this name, CreateEnvironment returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// If specified, the environment attempts to use this value as the prefix for
 	// the CNAME. If not specified, the CNAME is generated automatically by appending
 	// a random alphanumeric string to the environment name.
-	CNAMEPrefix *string `type:"string"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A unique name for the deployment environment. Used in the application URL.
 	//
@@ -1564,7 +1942,7 @@ type CreateEnvironmentInput struct {
 	//
 	// Default: If the CNAME parameter is not specified, the environment name becomes
 	// part of the CNAME, and therefore part of the visible URL for your application.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	// If specified, AWS Elastic Beanstalk sets the specified configuration options
 	// to the requested value in the configuration set for the new environment.
@@ -1584,7 +1962,7 @@ type CreateEnvironmentInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// This specifies the tags applied to resources in the environment.
 	Tags []*Tag `type:"list"`
@@ -1597,7 +1975,7 @@ type CreateEnvironmentInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use for creating this environment.
 	Tier *EnvironmentTier `type:"structure"`
@@ -1609,7 +1987,7 @@ type CreateEnvironmentInput struct {
 	//
 	//  Default: If not specified, AWS Elastic Beanstalk attempts to launch the
 	// sample application in the container.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataCreateEnvironmentInput `json:"-" xml:"-"`
 }
@@ -1649,7 +2027,7 @@ func (s CreateStorageLocationInput) GoString() string {
 // Results of a CreateStorageLocationResult call.
 type CreateStorageLocationOutput struct {
 	// The name of the Amazon S3 bucket created.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	metadataCreateStorageLocationOutput `json:"-" xml:"-"`
 }
@@ -1671,7 +2049,7 @@ func (s CreateStorageLocationOutput) GoString() string {
 // This documentation target is not reported in the API reference.
 type DeleteApplicationInput struct {
 	// The name of the application to delete.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// When set to true, running environments will be terminated before deleting
 	// the application.
@@ -1715,7 +2093,7 @@ func (s DeleteApplicationOutput) GoString() string {
 // This documentation target is not reported in the API reference.
 type DeleteApplicationVersionInput struct {
 	// The name of the application to delete releases from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Indicates whether to delete the associated source bundle from Amazon S3:
 	//
@@ -1725,7 +2103,7 @@ type DeleteApplicationVersionInput struct {
 	DeleteSourceBundle *bool `type:"boolean"`
 
 	// The label of the version to delete.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteApplicationVersionInput `json:"-" xml:"-"`
 }
@@ -1765,10 +2143,10 @@ func (s DeleteApplicationVersionOutput) GoString() string {
 // This documentation target is not reported in the API reference.
 type DeleteConfigurationTemplateInput struct {
 	// The name of the application to delete the configuration template from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the configuration template to delete.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteConfigurationTemplateInput `json:"-" xml:"-"`
 }
@@ -1808,10 +2186,10 @@ func (s DeleteConfigurationTemplateOutput) GoString() string {
 // This documentation target is not reported in the API reference.
 type DeleteEnvironmentConfigurationInput struct {
 	// The name of the application the environment is associated with.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to delete the draft configuration from.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	metadataDeleteEnvironmentConfigurationInput `json:"-" xml:"-"`
 }
@@ -1852,7 +2230,7 @@ func (s DeleteEnvironmentConfigurationOutput) GoString() string {
 type DescribeApplicationVersionsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// only include ones that are associated with the specified application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, restricts the returned descriptions to only include ones that
 	// have the specified version labels.
@@ -1947,20 +2325,20 @@ type DescribeConfigurationOptionsInput struct {
 	// The name of the application associated with the configuration template or
 	// environment. Only needed if you want to describe the configuration options
 	// associated with either the configuration template or environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment whose configuration options you want to describe.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, restricts the descriptions to only the specified options.
 	Options []*OptionSpecification `type:"list"`
 
 	// The name of the solution stack whose configuration options you want to describe.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The name of the configuration template whose configuration options you want
 	// to describe.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationOptionsInput `json:"-" xml:"-"`
 }
@@ -1985,7 +2363,7 @@ type DescribeConfigurationOptionsOutput struct {
 	Options []*ConfigurationOptionDescription `type:"list"`
 
 	// The name of the solution stack these configuration options belong to.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataDescribeConfigurationOptionsOutput `json:"-" xml:"-"`
 }
@@ -2008,7 +2386,7 @@ func (s DescribeConfigurationOptionsOutput) GoString() string {
 // solution stack or configuration template.
 type DescribeConfigurationSettingsInput struct {
 	// The application for the environment or configuration template.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to describe.
 	//
@@ -2016,7 +2394,7 @@ type DescribeConfigurationSettingsInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The name of the configuration template to describe.
 	//
@@ -2024,7 +2402,7 @@ type DescribeConfigurationSettingsInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationSettingsInput `json:"-" xml:"-"`
 }
@@ -2079,7 +2457,7 @@ type DescribeEnvironmentResourcesInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataDescribeEnvironmentResourcesInput `json:"-" xml:"-"`
 }
@@ -2124,7 +2502,7 @@ func (s DescribeEnvironmentResourcesOutput) GoString() string {
 type DescribeEnvironmentsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that have the specified IDs.
@@ -2148,7 +2526,7 @@ type DescribeEnvironmentsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEnvironmentsInput `json:"-" xml:"-"`
 }
@@ -2193,7 +2571,7 @@ func (s DescribeEnvironmentsOutput) GoString() string {
 type DescribeEventsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that occur up to, but not including, the EndTime.
@@ -2205,11 +2583,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Specifies the maximum number of events that can be returned, beginning with
 	// the most recent event.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Pagination token. If specified, the events return the next batch of results.
 	NextToken *string `type:"string"`
@@ -2228,11 +2606,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that are associated with this environment configuration.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEventsInput `json:"-" xml:"-"`
 }
@@ -2288,10 +2666,10 @@ type EnvironmentDescription struct {
 	AbortableOperationInProgress *bool `type:"boolean"`
 
 	// The name of the application associated with this environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The URL to the CNAME for this environment.
-	CNAME *string `type:"string"`
+	CNAME *string `type:"string" min:"1" max:"255"`
 
 	// The creation date for this environment.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -2300,7 +2678,7 @@ type EnvironmentDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// For load-balanced, autoscaling environments, the URL to the LoadBalancer.
 	// For single-instance environments, the IP address of the instance.
@@ -2310,7 +2688,7 @@ type EnvironmentDescription struct {
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
 
 	// The name of this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Describes the health status of the environment. AWS Elastic Beanstalk indicates
 	// the failure levels for a running environment:
@@ -2335,7 +2713,7 @@ type EnvironmentDescription struct {
 	Resources *EnvironmentResourcesDescription `type:"structure"`
 
 	// The name of the SolutionStack deployed with this environment.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The current operational status of the environment:
 	//
@@ -2347,13 +2725,13 @@ type EnvironmentDescription struct {
 	Status *string `type:"string"`
 
 	// The name of the configuration template used to originally launch this environment.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// Describes the current tier of this environment.
 	Tier *EnvironmentTier `type:"structure"`
 
 	// The application version deployed in this environment.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEnvironmentDescription `json:"-" xml:"-"`
 }
@@ -2409,7 +2787,7 @@ type EnvironmentResourceDescription struct {
 	AutoScalingGroups []*AutoScalingGroup `type:"list"`
 
 	// The name of the environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The Amazon EC2 instances used by this environment.
 	Instances []*Instance `type:"list"`
@@ -2497,10 +2875,10 @@ func (s EnvironmentTier) GoString() string {
 // Describes an event.
 type EventDescription struct {
 	// The application associated with the event.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment associated with this event.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The date when the event occurred.
 	EventDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -2515,10 +2893,10 @@ type EventDescription struct {
 	Severity *string `type:"string"`
 
 	// The name of the configuration associated with this event.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// The release label for the application version associated with this event.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEventDescription `json:"-" xml:"-"`
 }
@@ -2735,7 +3113,7 @@ type OptionSpecification struct {
 	OptionName *string `type:"string"`
 
 	// A unique resource name for a time-based scaling configuration option.
-	ResourceName *string `type:"string"`
+	ResourceName *string `type:"string" min:"1" max:"256"`
 
 	metadataOptionSpecification `json:"-" xml:"-"`
 }
@@ -2792,7 +3170,7 @@ type RebuildEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRebuildEnvironmentInput `json:"-" xml:"-"`
 }
@@ -2849,7 +3227,7 @@ type RequestEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to request.
 	InfoType *string `type:"string" required:"true"`
@@ -2902,7 +3280,7 @@ type RestartAppServerInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRestartAppServerInput `json:"-" xml:"-"`
 }
@@ -2957,7 +3335,7 @@ type RetrieveEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to retrieve.
 	InfoType *string `type:"string" required:"true"`
@@ -3004,10 +3382,10 @@ func (s RetrieveEnvironmentInfoOutput) GoString() string {
 // A specification of a location in Amazon S3.
 type S3Location struct {
 	// The Amazon S3 bucket where the data is located.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	// The Amazon S3 key where the data is located.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" max:"1024"`
 
 	metadataS3Location `json:"-" xml:"-"`
 }
@@ -3032,7 +3410,7 @@ type SolutionStackDescription struct {
 	PermittedFileTypes []*string `type:"list"`
 
 	// The name of the solution stack.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataSolutionStackDescription `json:"-" xml:"-"`
 }
@@ -3054,10 +3432,10 @@ func (s SolutionStackDescription) GoString() string {
 // A specification for an environment configuration
 type SourceConfiguration struct {
 	// The name of the application associated with the configuration.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the configuration template.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataSourceConfiguration `json:"-" xml:"-"`
 }
@@ -3090,7 +3468,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the DestinationEnvironmentID or the
 	// DestinationEnvironmentName. You may also specify both. You must specify the
 	// SourceEnvironmentName with the DestinationEnvironmentName.
-	DestinationEnvironmentName *string `type:"string"`
+	DestinationEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The ID of the source environment.
 	//
@@ -3104,7 +3482,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the SourceEnvironmentID or the SourceEnvironmentName.
 	// You may also specify both. If you specify the SourceEnvironmentName, you
 	// must specify the DestinationEnvironmentName.
-	SourceEnvironmentName *string `type:"string"`
+	SourceEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataSwapEnvironmentCNAMEsInput `json:"-" xml:"-"`
 }
@@ -3144,10 +3522,10 @@ func (s SwapEnvironmentCNAMEsOutput) GoString() string {
 // Describes a tag applied to a resource in an environment.
 type Tag struct {
 	// The key of the tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128"`
 
 	// The value of the tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -3180,7 +3558,7 @@ type TerminateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Indicates whether the associated AWS resources should shut down when the
 	// environment is terminated:
@@ -3245,12 +3623,12 @@ func (s Trigger) GoString() string {
 type UpdateApplicationInput struct {
 	// The name of the application to update. If no such application is found, UpdateApplication
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the application.
 	//
 	// Default: If not specified, AWS Elastic Beanstalk does not update the description.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataUpdateApplicationInput `json:"-" xml:"-"`
 }
@@ -3274,16 +3652,16 @@ type UpdateApplicationVersionInput struct {
 	//
 	//  If no application is found with this name, UpdateApplication returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for this release.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The name of the version to update.
 	//
 	//  If no application version is found with this label, UpdateApplication returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateApplicationVersionInput `json:"-" xml:"-"`
 }
@@ -3309,10 +3687,10 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no application is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A list of configuration option settings to update with the new specified
 	// option value.
@@ -3327,7 +3705,7 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no configuration template is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateConfigurationTemplateInput `json:"-" xml:"-"`
 }
@@ -3350,7 +3728,7 @@ func (s UpdateConfigurationTemplateInput) GoString() string {
 type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk updates the description
 	// of this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment to update.
 	//
@@ -3368,7 +3746,7 @@ type UpdateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, AWS Elastic Beanstalk updates the configuration set associated
 	// with the running environment and sets the specified configuration options
@@ -3381,12 +3759,12 @@ type UpdateEnvironmentInput struct {
 
 	// This specifies the platform version that the environment will run after the
 	// environment is updated.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If this parameter is specified, AWS Elastic Beanstalk deploys this configuration
 	// template to the environment. If no such configuration template is found,
 	// AWS Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use to update the environment.
 	//
@@ -3397,7 +3775,7 @@ type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk deploys the named application
 	// version to the environment. If no such application version is found, returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataUpdateEnvironmentInput `json:"-" xml:"-"`
 }
@@ -3420,12 +3798,12 @@ func (s UpdateEnvironmentInput) GoString() string {
 type ValidateConfigurationSettingsInput struct {
 	// The name of the application that the configuration template or environment
 	// belongs to.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and a configuration template name.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the options and desired values to evaluate.
 	OptionSettings []*ConfigurationOptionSetting `type:"list" required:"true"`
@@ -3433,7 +3811,7 @@ type ValidateConfigurationSettingsInput struct {
 	// The name of the configuration template to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and an environment name.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataValidateConfigurationSettingsInput `json:"-" xml:"-"`
 }
@@ -0,0 +1,74 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package elasticbeanstalk
+
+const (
+	// ErrCodeInsufficientPrivilegesException for service response error code
+	// "InsufficientPrivilegesException".
+	//
+	// Unable to perform the specified operation because the user does not have
+	// enough privileges for one of more downstream aws services
+	ErrCodeInsufficientPrivilegesException = "InsufficientPrivilegesException"
+
+	// ErrCodeOperationInProgressException for service response error code
+	// "OperationInProgressFailure".
+	//
+	// Unable to perform the specified operation because another operation is already
+	// in progress affecting an an element in this activity.
+	ErrCodeOperationInProgressException = "OperationInProgressFailure"
+
+	// ErrCodeS3LocationNotInServiceRegionException for service response error code
+	// "S3LocationNotInServiceRegionException".
+	//
+	// The specified S3 bucket does not belong to the S3 region in which the service
+	// is running.
+	ErrCodeS3LocationNotInServiceRegionException = "S3LocationNotInServiceRegionException"
+
+	// ErrCodeS3SubscriptionRequiredException for service response error code
+	// "S3SubscriptionRequiredException".
+	//
+	// The caller does not have a subscription to Amazon S3.
+	ErrCodeS3SubscriptionRequiredException = "S3SubscriptionRequiredException"
+
+	// ErrCodeSourceBundleDeletionException for service response error code
+	// "SourceBundleDeletionFailure".
+	//
+	// Unable to delete the Amazon S3 source bundle associated with the application
+	// version, although the application version deleted successfully.
+	ErrCodeSourceBundleDeletionException = "SourceBundleDeletionFailure"
+
+	// ErrCodeTooManyApplicationVersionsException for service response error code
+	// "TooManyApplicationVersionsException".
+	//
+	// The caller has exceeded the limit on the number of application versions associated
+	// with their account.
+	ErrCodeTooManyApplicationVersionsException = "TooManyApplicationVersionsException"
+
+	// ErrCodeTooManyApplicationsException for service response error code
+	// "TooManyApplicationsException".
+	//
+	// The caller has exceeded the limit on the number of applications associated
+	// with their account.
+	ErrCodeTooManyApplicationsException = "TooManyApplicationsException"
+
+	// ErrCodeTooManyBucketsException for service response error code
+	// "TooManyBucketsException".
+	//
+	// The web service attempted to create a bucket in an Amazon S3 account that
+	// already has 100 buckets.
+	ErrCodeTooManyBucketsException = "TooManyBucketsException"
+
+	// ErrCodeTooManyConfigurationTemplatesException for service response error code
+	// "TooManyConfigurationTemplatesException".
+	//
+	// The caller has exceeded the limit on the number of configuration templates
+	// associated with their account.
+	ErrCodeTooManyConfigurationTemplatesException = "TooManyConfigurationTemplatesException"
+
+	// ErrCodeTooManyEnvironmentsException for service response error code
+	// "TooManyEnvironmentsException".
+	//
+	// The caller has exceeded the limit of allowed environments associated with
+	// the account.
+	ErrCodeTooManyEnvironmentsException = "TooManyEnvironmentsException"
+)
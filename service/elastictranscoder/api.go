@@ -39,6 +39,18 @@ func (c *ElasticTranscoder) CancelJob(input *CancelJobInput) (*CancelJobOutput,
 	return out, err
 }
 
+// CancelJobWithContext is the same as CancelJob with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) CancelJobWithContext(ctx aws.Context, input *CancelJobInput, opts ...aws.Option) (*CancelJobOutput, error) {
+	req, out := c.CancelJobRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateJob = "CreateJob"
 
 // CreateJobRequest generates a request for the CreateJob operation.
@@ -72,6 +84,18 @@ func (c *ElasticTranscoder) CreateJob(input *CreateJobInput) (*CreateJobResponse
 	return out, err
 }
 
+// CreateJobWithContext is the same as CreateJob with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) CreateJobWithContext(ctx aws.Context, input *CreateJobInput, opts ...aws.Option) (*CreateJobResponse, error) {
+	req, out := c.CreateJobRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePipeline = "CreatePipeline"
 
 // CreatePipelineRequest generates a request for the CreatePipeline operation.
@@ -99,6 +123,18 @@ func (c *ElasticTranscoder) CreatePipeline(input *CreatePipelineInput) (*CreateP
 	return out, err
 }
 
+// CreatePipelineWithContext is the same as CreatePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) CreatePipelineWithContext(ctx aws.Context, input *CreatePipelineInput, opts ...aws.Option) (*CreatePipelineOutput, error) {
+	req, out := c.CreatePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePreset = "CreatePreset"
 
 // CreatePresetRequest generates a request for the CreatePreset operation.
@@ -140,6 +176,18 @@ func (c *ElasticTranscoder) CreatePreset(input *CreatePresetInput) (*CreatePrese
 	return out, err
 }
 
+// CreatePresetWithContext is the same as CreatePreset with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) CreatePresetWithContext(ctx aws.Context, input *CreatePresetInput, opts ...aws.Option) (*CreatePresetOutput, error) {
+	req, out := c.CreatePresetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePipeline = "DeletePipeline"
 
 // DeletePipelineRequest generates a request for the DeletePipeline operation.
@@ -171,6 +219,18 @@ func (c *ElasticTranscoder) DeletePipeline(input *DeletePipelineInput) (*DeleteP
 	return out, err
 }
 
+// DeletePipelineWithContext is the same as DeletePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) DeletePipelineWithContext(ctx aws.Context, input *DeletePipelineInput, opts ...aws.Option) (*DeletePipelineOutput, error) {
+	req, out := c.DeletePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePreset = "DeletePreset"
 
 // DeletePresetRequest generates a request for the DeletePreset operation.
@@ -200,6 +260,18 @@ func (c *ElasticTranscoder) DeletePreset(input *DeletePresetInput) (*DeletePrese
 	return out, err
 }
 
+// DeletePresetWithContext is the same as DeletePreset with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) DeletePresetWithContext(ctx aws.Context, input *DeletePresetInput, opts ...aws.Option) (*DeletePresetOutput, error) {
+	req, out := c.DeletePresetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListJobsByPipeline = "ListJobsByPipeline"
 
 // ListJobsByPipelineRequest generates a request for the ListJobsByPipeline operation.
@@ -213,6 +285,7 @@ func (c *ElasticTranscoder) ListJobsByPipelineRequest(input *ListJobsByPipelineI
 			OutputTokens:    []string{"NextPageToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Jobs"},
 		},
 	}
 
@@ -237,6 +310,22 @@ func (c *ElasticTranscoder) ListJobsByPipeline(input *ListJobsByPipelineInput) (
 	return out, err
 }
 
+// ListJobsByPipelineWithContext is the same as ListJobsByPipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) ListJobsByPipelineWithContext(ctx aws.Context, input *ListJobsByPipelineInput, opts ...aws.Option) (*ListJobsByPipelineOutput, error) {
+	req, out := c.ListJobsByPipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListJobsByPipelinePages iterates over the pages of a ListJobsByPipeline operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ElasticTranscoder) ListJobsByPipelinePages(input *ListJobsByPipelineInput, fn func(p *ListJobsByPipelineOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListJobsByPipelineRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -244,6 +333,19 @@ func (c *ElasticTranscoder) ListJobsByPipelinePages(input *ListJobsByPipelineInp
 	})
 }
 
+// ListJobsByPipelinePagesWithContext is the same as ListJobsByPipelinePages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ElasticTranscoder) ListJobsByPipelinePagesWithContext(ctx aws.Context, input *ListJobsByPipelineInput, fn func(p *ListJobsByPipelineOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListJobsByPipelineRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListJobsByPipelineOutput), lastPage)
+	})
+}
+
 const opListJobsByStatus = "ListJobsByStatus"
 
 // ListJobsByStatusRequest generates a request for the ListJobsByStatus operation.
@@ -257,6 +359,7 @@ func (c *ElasticTranscoder) ListJobsByStatusRequest(input *ListJobsByStatusInput
 			OutputTokens:    []string{"NextPageToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Jobs"},
 		},
 	}
 
@@ -279,6 +382,22 @@ func (c *ElasticTranscoder) ListJobsByStatus(input *ListJobsByStatusInput) (*Lis
 	return out, err
 }
 
+// ListJobsByStatusWithContext is the same as ListJobsByStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) ListJobsByStatusWithContext(ctx aws.Context, input *ListJobsByStatusInput, opts ...aws.Option) (*ListJobsByStatusOutput, error) {
+	req, out := c.ListJobsByStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListJobsByStatusPages iterates over the pages of a ListJobsByStatus operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ElasticTranscoder) ListJobsByStatusPages(input *ListJobsByStatusInput, fn func(p *ListJobsByStatusOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListJobsByStatusRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -286,6 +405,19 @@ func (c *ElasticTranscoder) ListJobsByStatusPages(input *ListJobsByStatusInput,
 	})
 }
 
+// ListJobsByStatusPagesWithContext is the same as ListJobsByStatusPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ElasticTranscoder) ListJobsByStatusPagesWithContext(ctx aws.Context, input *ListJobsByStatusInput, fn func(p *ListJobsByStatusOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListJobsByStatusRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListJobsByStatusOutput), lastPage)
+	})
+}
+
 const opListPipelines = "ListPipelines"
 
 // ListPipelinesRequest generates a request for the ListPipelines operation.
@@ -299,6 +431,7 @@ func (c *ElasticTranscoder) ListPipelinesRequest(input *ListPipelinesInput) (req
 			OutputTokens:    []string{"NextPageToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Pipelines"},
 		},
 	}
 
@@ -320,6 +453,22 @@ func (c *ElasticTranscoder) ListPipelines(input *ListPipelinesInput) (*ListPipel
 	return out, err
 }
 
+// ListPipelinesWithContext is the same as ListPipelines with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) ListPipelinesWithContext(ctx aws.Context, input *ListPipelinesInput, opts ...aws.Option) (*ListPipelinesOutput, error) {
+	req, out := c.ListPipelinesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListPipelinesPages iterates over the pages of a ListPipelines operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ElasticTranscoder) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPipelinesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -327,6 +476,19 @@ func (c *ElasticTranscoder) ListPipelinesPages(input *ListPipelinesInput, fn fun
 	})
 }
 
+// ListPipelinesPagesWithContext is the same as ListPipelinesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ElasticTranscoder) ListPipelinesPagesWithContext(ctx aws.Context, input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListPipelinesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPipelinesOutput), lastPage)
+	})
+}
+
 const opListPresets = "ListPresets"
 
 // ListPresetsRequest generates a request for the ListPresets operation.
@@ -340,6 +502,7 @@ func (c *ElasticTranscoder) ListPresetsRequest(input *ListPresetsInput) (req *aw
 			OutputTokens:    []string{"NextPageToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Presets"},
 		},
 	}
 
@@ -361,6 +524,22 @@ func (c *ElasticTranscoder) ListPresets(input *ListPresetsInput) (*ListPresetsOu
 	return out, err
 }
 
+// ListPresetsWithContext is the same as ListPresets with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) ListPresetsWithContext(ctx aws.Context, input *ListPresetsInput, opts ...aws.Option) (*ListPresetsOutput, error) {
+	req, out := c.ListPresetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListPresetsPages iterates over the pages of a ListPresets operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *ElasticTranscoder) ListPresetsPages(input *ListPresetsInput, fn func(p *ListPresetsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPresetsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -368,6 +547,19 @@ func (c *ElasticTranscoder) ListPresetsPages(input *ListPresetsInput, fn func(p
 	})
 }
 
+// ListPresetsPagesWithContext is the same as ListPresetsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *ElasticTranscoder) ListPresetsPagesWithContext(ctx aws.Context, input *ListPresetsInput, fn func(p *ListPresetsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListPresetsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPresetsOutput), lastPage)
+	})
+}
+
 const opReadJob = "ReadJob"
 
 // ReadJobRequest generates a request for the ReadJob operation.
@@ -395,6 +587,18 @@ func (c *ElasticTranscoder) ReadJob(input *ReadJobInput) (*ReadJobOutput, error)
 	return out, err
 }
 
+// ReadJobWithContext is the same as ReadJob with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) ReadJobWithContext(ctx aws.Context, input *ReadJobInput, opts ...aws.Option) (*ReadJobOutput, error) {
+	req, out := c.ReadJobRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opReadPipeline = "ReadPipeline"
 
 // ReadPipelineRequest generates a request for the ReadPipeline operation.
@@ -422,6 +626,18 @@ func (c *ElasticTranscoder) ReadPipeline(input *ReadPipelineInput) (*ReadPipelin
 	return out, err
 }
 
+// ReadPipelineWithContext is the same as ReadPipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) ReadPipelineWithContext(ctx aws.Context, input *ReadPipelineInput, opts ...aws.Option) (*ReadPipelineOutput, error) {
+	req, out := c.ReadPipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opReadPreset = "ReadPreset"
 
 // ReadPresetRequest generates a request for the ReadPreset operation.
@@ -449,6 +665,18 @@ func (c *ElasticTranscoder) ReadPreset(input *ReadPresetInput) (*ReadPresetOutpu
 	return out, err
 }
 
+// ReadPresetWithContext is the same as ReadPreset with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) ReadPresetWithContext(ctx aws.Context, input *ReadPresetInput, opts ...aws.Option) (*ReadPresetOutput, error) {
+	req, out := c.ReadPresetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTestRole = "TestRole"
 
 // TestRoleRequest generates a request for the TestRole operation.
@@ -482,6 +710,18 @@ func (c *ElasticTranscoder) TestRole(input *TestRoleInput) (*TestRoleOutput, err
 	return out, err
 }
 
+// TestRoleWithContext is the same as TestRole with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) TestRoleWithContext(ctx aws.Context, input *TestRoleInput, opts ...aws.Option) (*TestRoleOutput, error) {
+	req, out := c.TestRoleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdatePipeline = "UpdatePipeline"
 
 // UpdatePipelineRequest generates a request for the UpdatePipeline operation.
@@ -513,6 +753,18 @@ func (c *ElasticTranscoder) UpdatePipeline(input *UpdatePipelineInput) (*UpdateP
 	return out, err
 }
 
+// UpdatePipelineWithContext is the same as UpdatePipeline with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) UpdatePipelineWithContext(ctx aws.Context, input *UpdatePipelineInput, opts ...aws.Option) (*UpdatePipelineOutput, error) {
+	req, out := c.UpdatePipelineRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdatePipelineNotifications = "UpdatePipelineNotifications"
 
 // UpdatePipelineNotificationsRequest generates a request for the UpdatePipelineNotifications operation.
@@ -544,6 +796,18 @@ func (c *ElasticTranscoder) UpdatePipelineNotifications(input *UpdatePipelineNot
 	return out, err
 }
 
+// UpdatePipelineNotificationsWithContext is the same as UpdatePipelineNotifications with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) UpdatePipelineNotificationsWithContext(ctx aws.Context, input *UpdatePipelineNotificationsInput, opts ...aws.Option) (*UpdatePipelineNotificationsOutput, error) {
+	req, out := c.UpdatePipelineNotificationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdatePipelineStatus = "UpdatePipelineStatus"
 
 // UpdatePipelineStatusRequest generates a request for the UpdatePipelineStatus operation.
@@ -578,6 +842,18 @@ func (c *ElasticTranscoder) UpdatePipelineStatus(input *UpdatePipelineStatusInpu
 	return out, err
 }
 
+// UpdatePipelineStatusWithContext is the same as UpdatePipelineStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *ElasticTranscoder) UpdatePipelineStatusWithContext(ctx aws.Context, input *UpdatePipelineStatusInput, opts ...aws.Option) (*UpdatePipelineStatusOutput, error) {
+	req, out := c.UpdatePipelineStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // The file to be used as album art. There can be multiple artworks associated
 // with an audio file, to a maximum of 20.
 //
@@ -602,7 +878,7 @@ type Artwork struct {
 	// If the file name includes a prefix, for example, cooking/pie.jpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255"`
 
 	// The maximum height of the output album art in pixels. If you specify auto,
 	// Elastic Transcoder uses 600 as the default value. If you specify a numeric
@@ -979,12 +1255,12 @@ type CaptionSource struct {
 
 	// The name of the sidecar caption file that you want Elastic Transcoder to
 	// include in the output file.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The label of the caption shown in the player when choosing a language. We
 	// recommend that you put the caption language name here, in the language of
 	// the captions.
-	Label *string `type:"string"`
+	Label *string `type:"string" min:"1" max:"40"`
 
 	// A string that specifies the language of the caption. Specify this as one
 	// of:
@@ -995,7 +1271,7 @@ type CaptionSource struct {
 	//
 	//  For more information on ISO language codes and language names, see the
 	// List of ISO 639-1 codes.
-	Language *string `type:"string"`
+	Language *string `type:"string" min:"1" max:"255"`
 
 	// For clip generation or captions that do not start at the same time as the
 	// associated video file, the TimeOffset tells Elastic Transcoder how much of
@@ -1025,11 +1301,11 @@ func (s CaptionSource) GoString() string {
 type Captions struct {
 	// The array of file formats for the output captions. If you leave this value
 	// blank, Elastic Transcoder returns an error.
-	CaptionFormats []*CaptionFormat `type:"list"`
+	CaptionFormats []*CaptionFormat `type:"list" max:"4"`
 
 	// Source files for the input sidecar captions used during the transcoding process.
 	// To omit all sidecar captions, leave CaptionSources blank.
-	CaptionSources []*CaptionSource `type:"list"`
+	CaptionSources []*CaptionSource `type:"list" max:"20"`
 
 	// A policy that determines how Elastic Transcoder handles the existence of
 	// multiple captions.
@@ -1103,12 +1379,12 @@ type CreateJobInput struct {
 	// The value, if any, that you want Elastic Transcoder to prepend to the names
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// A section of the request body that provides information about the transcoded
 	// (target) files. We recommend that you use the Outputs syntax instead of the
 	// Output syntax.
-	Outputs []*CreateJobOutput `type:"list"`
+	Outputs []*CreateJobOutput `type:"list" max:"30"`
 
 	// The Id of the pipeline that you want Elastic Transcoder to use for transcoding.
 	// The pipeline determines several settings, including the Amazon S3 bucket
@@ -1121,7 +1397,7 @@ type CreateJobInput struct {
 	// master playlists that you want Elastic Transcoder to create.
 	//
 	// The maximum number of master playlists in a job is 30.
-	Playlists []*CreateJobPlaylist `type:"list"`
+	Playlists []*CreateJobPlaylist `type:"list" max:"30"`
 
 	// User-defined metadata that you want to associate with an Elastic Transcoder
 	// job. You specify metadata in key/value pairs, and you can add up to 10 key/value
@@ -1215,7 +1491,7 @@ type CreateJobOutput struct {
 	// in the Amazon S3 bucket specified by the OutputBucket object in the pipeline
 	// that is specified by the pipeline ID. If a file with the specified name already
 	// exists in the output bucket, the job fails.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The Id of the preset to use for this job. The preset determines the audio,
 	// video, and thumbnail settings that Elastic Transcoder uses for transcoding.
@@ -1323,7 +1599,7 @@ type CreateJobPlaylist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -1362,7 +1638,7 @@ type CreateJobPlaylist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	// The DRM settings, if any, that you want Elastic Transcoder to apply to the
 	// output files associated with this playlist.
@@ -1418,7 +1694,7 @@ type CreatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -1475,7 +1751,7 @@ type CreatePipelineInput struct {
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -1620,11 +1896,11 @@ type CreatePresetInput struct {
 	Container *string `type:"string" required:"true"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"255"`
 
 	// The name of the preset. We recommend that the name be unique within the AWS
 	// account, but uniqueness is not enforced.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// A section of the request body that specifies the thumbnail parameters, if
 	// any.
@@ -1807,7 +2083,7 @@ type Encryption struct {
 	// want Elastic Transcoder to use to encrypt your output files. The initialization
 	// vector must be base64-encoded, and it must be exactly 16 bytes long before
 	// being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// The data encryption key that you want Elastic Transcoder to use to encrypt
 	// your output file, or that was used to encrypt your input file. The key must
@@ -1888,7 +2164,7 @@ type HLSContentProtection struct {
 	// every encryption operation, that you want Elastic Transcoder to use to encrypt
 	// your output files. The initialization vector must be base64-encoded, and
 	// it must be exactly 16 bytes before being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// If you want Elastic Transcoder to generate a key for you, leave this field
 	// blank.
@@ -1918,7 +2194,7 @@ type HLSContentProtection struct {
 	// The location of the license key required to decrypt your HLS playlist. The
 	// URL must be an absolute path, and is referenced in the URI attribute of the
 	// EXT-X-KEY metadata tag in the playlist file.
-	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string"`
+	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string" min:"0" max:"512"`
 
 	// The content protection method for your output. The only valid value is: aes-128.
 	//
@@ -1970,7 +2246,7 @@ type Job struct {
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists. We recommend that you add a / or some other delimiter to the end
 	// of the OutputKeyPrefix.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// Information about the output files. We recommend that you use the Outputs
 	// syntax for all jobs, even when you want Elastic Transcoder to transcode a
@@ -2127,7 +2403,7 @@ type JobInput struct {
 	// If the file name includes a prefix, such as cooking/lasagna.mpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// This value must be auto, which causes Elastic Transcoder to automatically
 	// detect the resolution of the input file.
@@ -2244,7 +2520,7 @@ type JobOutput struct {
 	// The name to assign to the transcoded file. Elastic Transcoder saves the file
 	// in the Amazon S3 bucket specified by the OutputBucket object in the pipeline
 	// that is specified by the pipeline ID.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The value of the Id object for the preset that you want to use for this job.
 	// The preset determines the audio, video, and thumbnail settings that Elastic
@@ -2298,7 +2574,7 @@ type JobOutput struct {
 	Status *string `type:"string"`
 
 	// Information that further explains Status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" min:"0" max:"255"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your thumbnail.
@@ -2384,13 +2660,13 @@ type JobWatermark struct {
 	//  If the file name includes a prefix, for example, logos/128x64.png, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255"`
 
 	// The ID of the watermark settings that Elastic Transcoder uses to add watermarks
 	// to the video during transcoding. The settings are in the preset specified
 	// by Preset for the current output. In that preset, the value of Watermarks
 	// Id tells Elastic Transcoder which settings to use.
-	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string"`
+	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string" min:"1" max:"40"`
 
 	metadataJobWatermark `json:"-" xml:"-"`
 }
@@ -2684,14 +2960,14 @@ type Permission struct {
 	// the thumbnails that Elastic Transcoder adds to the Amazon S3 bucket.  FULL_CONTROL:
 	// The grantee has READ, READ_ACP, and WRITE_ACP permissions for the thumbnails
 	// that Elastic Transcoder adds to the Amazon S3 bucket.
-	Access []*string `type:"list"`
+	Access []*string `type:"list" max:"30"`
 
 	// The AWS user or group that you want to have access to transcoded files and
 	// playlists. To identify the user or group, you can specify the canonical user
 	// ID for an AWS account, an origin access identity for a CloudFront distribution,
 	// the registered email address of an AWS account, or a predefined Amazon S3
 	// group.
-	Grantee *string `type:"string"`
+	Grantee *string `type:"string" min:"1" max:"255"`
 
 	// The type of value that appears in the Grantee object:   Canonical: Either
 	// the canonical user ID for an AWS account or an origin access identity for
@@ -2731,7 +3007,7 @@ type Pipeline struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// Information about the Amazon S3 bucket in which you want Elastic Transcoder
 	// to save transcoded files and playlists. Either you specify both ContentConfig
@@ -2773,7 +3049,7 @@ type Pipeline struct {
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -2877,7 +3153,7 @@ type PipelineOutputConfig struct {
 	//  If you omit Permissions, Elastic Transcoder grants full control over the
 	// transcoded files and playlists to the owner of the role specified by Role,
 	// and grants no other permissions to any other user or group.
-	Permissions []*Permission `type:"list"`
+	Permissions []*Permission `type:"list" max:"30"`
 
 	// The Amazon S3 storage class, Standard or ReducedRedundancy, that you want
 	// Elastic Transcoder to assign to the video files and playlists that it stores
@@ -2917,7 +3193,7 @@ type PlayReadyDRM struct {
 	// your files. The initialization vector must be base64-encoded, and it must
 	// be exactly 8 bytes long before being base64-encoded. If no initialization
 	// vector is provided, Elastic Transcoder generates one for you.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// The DRM key for your file, provided by your DRM license provider. The key
 	// must be base64-encoded, and it must be one of the following bit lengths before
@@ -2948,7 +3224,7 @@ type PlayReadyDRM struct {
 	// header is referenced in the protection header of the client manifest for
 	// Smooth Streaming outputs, and in the EXT-X-DXDRM and EXT-XDXDRMINFO metadata
 	// tags for HLS playlist outputs. An example URL looks like this: https://www.example.com/exampleKey/
-	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string"`
+	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string" min:"1" max:"512"`
 
 	metadataPlayReadyDRM `json:"-" xml:"-"`
 }
@@ -2991,7 +3267,7 @@ type Playlist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -3030,7 +3306,7 @@ type Playlist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	// The DRM settings, if any, that you want Elastic Transcoder to apply to the
 	// output files associated with this playlist.
@@ -3040,7 +3316,7 @@ type Playlist struct {
 	Status *string `type:"string"`
 
 	// Information that further explains the status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" min:"0" max:"255"`
 
 	metadataPlaylist `json:"-" xml:"-"`
 }
@@ -3078,14 +3354,14 @@ type Preset struct {
 	Container *string `type:"string"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"255"`
 
 	// Identifier for the new preset. You use this value to get settings for the
 	// preset or to delete it.
 	ID *string `locationName:"Id" type:"string"`
 
 	// The name of the preset.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// A section of the response body that provides information about the thumbnail
 	// preset values, if any.
@@ -3159,7 +3435,7 @@ type PresetWatermark struct {
 
 	// A unique identifier for the settings for one watermark. The value of Id can
 	// be up to 40 characters long.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" min:"1" max:"40"`
 
 	// The maximum height of the watermark in one of the following formats:  number
 	// of pixels (px): The minimum value is 16 pixels, and the maximum value is
@@ -3415,7 +3691,7 @@ type TestRoleInput struct {
 
 	// The ARNs of one or more Amazon Simple Notification Service (Amazon SNS) topics
 	// that you want the action to send a test notification to.
-	Topics []*string `type:"list" required:"true"`
+	Topics []*string `type:"list" max:"30" required:"true"`
 
 	metadataTestRoleInput `json:"-" xml:"-"`
 }
@@ -3625,7 +3901,7 @@ type UpdatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -3685,7 +3961,7 @@ type UpdatePipelineInput struct {
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic or topics to notify
 	// in order to report job status.
@@ -4044,7 +4320,7 @@ type VideoParameters struct {
 	//
 	// The number of times you want the output gif to loop. Valid values include
 	// Infinite and integers between 0 and 100, inclusive.
-	CodecOptions map[string]*string `type:"map"`
+	CodecOptions map[string]*string `type:"map" max:"30"`
 
 	// The value that Elastic Transcoder adds to the metadata in the output file.
 	DisplayAspectRatio *string `type:"string"`
@@ -4,6 +4,7 @@
 package elastictranscoderiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elastictranscoder"
 )
 
@@ -11,35 +12,89 @@ import (
 type ElasticTranscoderAPI interface {
 	CancelJob(*elastictranscoder.CancelJobInput) (*elastictranscoder.CancelJobOutput, error)
 
+	CancelJobWithContext(aws.Context, *elastictranscoder.CancelJobInput, ...aws.Option) (*elastictranscoder.CancelJobOutput, error)
+
 	CreateJob(*elastictranscoder.CreateJobInput) (*elastictranscoder.CreateJobResponse, error)
 
+	CreateJobWithContext(aws.Context, *elastictranscoder.CreateJobInput, ...aws.Option) (*elastictranscoder.CreateJobResponse, error)
+
 	CreatePipeline(*elastictranscoder.CreatePipelineInput) (*elastictranscoder.CreatePipelineOutput, error)
 
+	CreatePipelineWithContext(aws.Context, *elastictranscoder.CreatePipelineInput, ...aws.Option) (*elastictranscoder.CreatePipelineOutput, error)
+
 	CreatePreset(*elastictranscoder.CreatePresetInput) (*elastictranscoder.CreatePresetOutput, error)
 
+	CreatePresetWithContext(aws.Context, *elastictranscoder.CreatePresetInput, ...aws.Option) (*elastictranscoder.CreatePresetOutput, error)
+
 	DeletePipeline(*elastictranscoder.DeletePipelineInput) (*elastictranscoder.DeletePipelineOutput, error)
 
+	DeletePipelineWithContext(aws.Context, *elastictranscoder.DeletePipelineInput, ...aws.Option) (*elastictranscoder.DeletePipelineOutput, error)
+
 	DeletePreset(*elastictranscoder.DeletePresetInput) (*elastictranscoder.DeletePresetOutput, error)
 
+	DeletePresetWithContext(aws.Context, *elastictranscoder.DeletePresetInput, ...aws.Option) (*elastictranscoder.DeletePresetOutput, error)
+
 	ListJobsByPipeline(*elastictranscoder.ListJobsByPipelineInput) (*elastictranscoder.ListJobsByPipelineOutput, error)
 
+	ListJobsByPipelineWithContext(aws.Context, *elastictranscoder.ListJobsByPipelineInput, ...aws.Option) (*elastictranscoder.ListJobsByPipelineOutput, error)
+
+	ListJobsByPipelinePages(*elastictranscoder.ListJobsByPipelineInput, func(*elastictranscoder.ListJobsByPipelineOutput, bool) bool) error
+
+	ListJobsByPipelinePagesWithContext(aws.Context, *elastictranscoder.ListJobsByPipelineInput, func(*elastictranscoder.ListJobsByPipelineOutput, bool) bool, ...aws.Option) error
+
 	ListJobsByStatus(*elastictranscoder.ListJobsByStatusInput) (*elastictranscoder.ListJobsByStatusOutput, error)
 
+	ListJobsByStatusWithContext(aws.Context, *elastictranscoder.ListJobsByStatusInput, ...aws.Option) (*elastictranscoder.ListJobsByStatusOutput, error)
+
+	ListJobsByStatusPages(*elastictranscoder.ListJobsByStatusInput, func(*elastictranscoder.ListJobsByStatusOutput, bool) bool) error
+
+	ListJobsByStatusPagesWithContext(aws.Context, *elastictranscoder.ListJobsByStatusInput, func(*elastictranscoder.ListJobsByStatusOutput, bool) bool, ...aws.Option) error
+
 	ListPipelines(*elastictranscoder.ListPipelinesInput) (*elastictranscoder.ListPipelinesOutput, error)
 
+	ListPipelinesWithContext(aws.Context, *elastictranscoder.ListPipelinesInput, ...aws.Option) (*elastictranscoder.ListPipelinesOutput, error)
+
+	ListPipelinesPages(*elastictranscoder.ListPipelinesInput, func(*elastictranscoder.ListPipelinesOutput, bool) bool) error
+
+	ListPipelinesPagesWithContext(aws.Context, *elastictranscoder.ListPipelinesInput, func(*elastictranscoder.ListPipelinesOutput, bool) bool, ...aws.Option) error
+
 	ListPresets(*elastictranscoder.ListPresetsInput) (*elastictranscoder.ListPresetsOutput, error)
 
+	ListPresetsWithContext(aws.Context, *elastictranscoder.ListPresetsInput, ...aws.Option) (*elastictranscoder.ListPresetsOutput, error)
+
+	ListPresetsPages(*elastictranscoder.ListPresetsInput, func(*elastictranscoder.ListPresetsOutput, bool) bool) error
+
+	ListPresetsPagesWithContext(aws.Context, *elastictranscoder.ListPresetsInput, func(*elastictranscoder.ListPresetsOutput, bool) bool, ...aws.Option) error
+
 	ReadJob(*elastictranscoder.ReadJobInput) (*elastictranscoder.ReadJobOutput, error)
 
+	ReadJobWithContext(aws.Context, *elastictranscoder.ReadJobInput, ...aws.Option) (*elastictranscoder.ReadJobOutput, error)
+
 	ReadPipeline(*elastictranscoder.ReadPipelineInput) (*elastictranscoder.ReadPipelineOutput, error)
 
+	ReadPipelineWithContext(aws.Context, *elastictranscoder.ReadPipelineInput, ...aws.Option) (*elastictranscoder.ReadPipelineOutput, error)
+
 	ReadPreset(*elastictranscoder.ReadPresetInput) (*elastictranscoder.ReadPresetOutput, error)
 
+	ReadPresetWithContext(aws.Context, *elastictranscoder.ReadPresetInput, ...aws.Option) (*elastictranscoder.ReadPresetOutput, error)
+
 	TestRole(*elastictranscoder.TestRoleInput) (*elastictranscoder.TestRoleOutput, error)
 
+	TestRoleWithContext(aws.Context, *elastictranscoder.TestRoleInput, ...aws.Option) (*elastictranscoder.TestRoleOutput, error)
+
 	UpdatePipeline(*elastictranscoder.UpdatePipelineInput) (*elastictranscoder.UpdatePipelineOutput, error)
 
+	UpdatePipelineWithContext(aws.Context, *elastictranscoder.UpdatePipelineInput, ...aws.Option) (*elastictranscoder.UpdatePipelineOutput, error)
+
 	UpdatePipelineNotifications(*elastictranscoder.UpdatePipelineNotificationsInput) (*elastictranscoder.UpdatePipelineNotificationsOutput, error)
 
+	UpdatePipelineNotificationsWithContext(aws.Context, *elastictranscoder.UpdatePipelineNotificationsInput, ...aws.Option) (*elastictranscoder.UpdatePipelineNotificationsOutput, error)
+
 	UpdatePipelineStatus(*elastictranscoder.UpdatePipelineStatusInput) (*elastictranscoder.UpdatePipelineStatusOutput, error)
+
+	UpdatePipelineStatusWithContext(aws.Context, *elastictranscoder.UpdatePipelineStatusInput, ...aws.Option) (*elastictranscoder.UpdatePipelineStatusOutput, error)
+
+	WaitUntilJobComplete(*elastictranscoder.ReadJobInput) error
+
+	WaitUntilJobCompleteWithContext(aws.Context, *elastictranscoder.ReadJobInput, ...aws.WaiterOption) error
 }
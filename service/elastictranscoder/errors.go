@@ -0,0 +1,50 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package elastictranscoder
+
+const (
+	// ErrCodeAccessDeniedException for service response error code
+	// "AccessDeniedException".
+	//
+	// General authentication failure. The request was not signed correctly.
+	ErrCodeAccessDeniedException = "AccessDeniedException"
+
+	// ErrCodeIncompatibleVersionException for service response error code
+	// "IncompatibleVersionException".
+	ErrCodeIncompatibleVersionException = "IncompatibleVersionException"
+
+	// ErrCodeInternalServiceException for service response error code
+	// "InternalServiceException".
+	//
+	// Elastic Transcoder encountered an unexpected exception while trying to fulfill
+	// the request.
+	ErrCodeInternalServiceException = "InternalServiceException"
+
+	// ErrCodeLimitExceededException for service response error code
+	// "LimitExceededException".
+	//
+	// Too many operations for a given AWS account. For example, the number of pipelines
+	// exceeds the maximum allowed.
+	ErrCodeLimitExceededException = "LimitExceededException"
+
+	// ErrCodeResourceInUseException for service response error code
+	// "ResourceInUseException".
+	//
+	// The resource you are attempting to change is in use. For example, you are
+	// attempting to delete a pipeline that is currently in use.
+	ErrCodeResourceInUseException = "ResourceInUseException"
+
+	// ErrCodeResourceNotFoundException for service response error code
+	// "ResourceNotFoundException".
+	//
+	// The requested resource does not exist or is not available. For example, the
+	// pipeline to which you're trying to add a job doesn't exist or is still being
+	// created.
+	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+
+	// ErrCodeValidationException for service response error code
+	// "ValidationException".
+	//
+	// One or more required parameter values were not provided in the request.
+	ErrCodeValidationException = "ValidationException"
+)
@@ -4,6 +4,7 @@
 package elbiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elb"
 )
 
@@ -11,57 +12,125 @@ import (
 type ELBAPI interface {
 	AddTags(*elb.AddTagsInput) (*elb.AddTagsOutput, error)
 
+	AddTagsWithContext(aws.Context, *elb.AddTagsInput, ...aws.Option) (*elb.AddTagsOutput, error)
+
 	ApplySecurityGroupsToLoadBalancer(*elb.ApplySecurityGroupsToLoadBalancerInput) (*elb.ApplySecurityGroupsToLoadBalancerOutput, error)
 
+	ApplySecurityGroupsToLoadBalancerWithContext(aws.Context, *elb.ApplySecurityGroupsToLoadBalancerInput, ...aws.Option) (*elb.ApplySecurityGroupsToLoadBalancerOutput, error)
+
 	AttachLoadBalancerToSubnets(*elb.AttachLoadBalancerToSubnetsInput) (*elb.AttachLoadBalancerToSubnetsOutput, error)
 
+	AttachLoadBalancerToSubnetsWithContext(aws.Context, *elb.AttachLoadBalancerToSubnetsInput, ...aws.Option) (*elb.AttachLoadBalancerToSubnetsOutput, error)
+
 	ConfigureHealthCheck(*elb.ConfigureHealthCheckInput) (*elb.ConfigureHealthCheckOutput, error)
 
+	ConfigureHealthCheckWithContext(aws.Context, *elb.ConfigureHealthCheckInput, ...aws.Option) (*elb.ConfigureHealthCheckOutput, error)
+
 	CreateAppCookieStickinessPolicy(*elb.CreateAppCookieStickinessPolicyInput) (*elb.CreateAppCookieStickinessPolicyOutput, error)
 
+	CreateAppCookieStickinessPolicyWithContext(aws.Context, *elb.CreateAppCookieStickinessPolicyInput, ...aws.Option) (*elb.CreateAppCookieStickinessPolicyOutput, error)
+
 	CreateLBCookieStickinessPolicy(*elb.CreateLBCookieStickinessPolicyInput) (*elb.CreateLBCookieStickinessPolicyOutput, error)
 
+	CreateLBCookieStickinessPolicyWithContext(aws.Context, *elb.CreateLBCookieStickinessPolicyInput, ...aws.Option) (*elb.CreateLBCookieStickinessPolicyOutput, error)
+
 	CreateLoadBalancer(*elb.CreateLoadBalancerInput) (*elb.CreateLoadBalancerOutput, error)
 
+	CreateLoadBalancerWithContext(aws.Context, *elb.CreateLoadBalancerInput, ...aws.Option) (*elb.CreateLoadBalancerOutput, error)
+
 	CreateLoadBalancerListeners(*elb.CreateLoadBalancerListenersInput) (*elb.CreateLoadBalancerListenersOutput, error)
 
+	CreateLoadBalancerListenersWithContext(aws.Context, *elb.CreateLoadBalancerListenersInput, ...aws.Option) (*elb.CreateLoadBalancerListenersOutput, error)
+
 	CreateLoadBalancerPolicy(*elb.CreateLoadBalancerPolicyInput) (*elb.CreateLoadBalancerPolicyOutput, error)
 
+	CreateLoadBalancerPolicyWithContext(aws.Context, *elb.CreateLoadBalancerPolicyInput, ...aws.Option) (*elb.CreateLoadBalancerPolicyOutput, error)
+
 	DeleteLoadBalancer(*elb.DeleteLoadBalancerInput) (*elb.DeleteLoadBalancerOutput, error)
 
+	DeleteLoadBalancerWithContext(aws.Context, *elb.DeleteLoadBalancerInput, ...aws.Option) (*elb.DeleteLoadBalancerOutput, error)
+
 	DeleteLoadBalancerListeners(*elb.DeleteLoadBalancerListenersInput) (*elb.DeleteLoadBalancerListenersOutput, error)
 
+	DeleteLoadBalancerListenersWithContext(aws.Context, *elb.DeleteLoadBalancerListenersInput, ...aws.Option) (*elb.DeleteLoadBalancerListenersOutput, error)
+
 	DeleteLoadBalancerPolicy(*elb.DeleteLoadBalancerPolicyInput) (*elb.DeleteLoadBalancerPolicyOutput, error)
 
+	DeleteLoadBalancerPolicyWithContext(aws.Context, *elb.DeleteLoadBalancerPolicyInput, ...aws.Option) (*elb.DeleteLoadBalancerPolicyOutput, error)
+
 	DeregisterInstancesFromLoadBalancer(*elb.DeregisterInstancesFromLoadBalancerInput) (*elb.DeregisterInstancesFromLoadBalancerOutput, error)
 
+	DeregisterInstancesFromLoadBalancerWithContext(aws.Context, *elb.DeregisterInstancesFromLoadBalancerInput, ...aws.Option) (*elb.DeregisterInstancesFromLoadBalancerOutput, error)
+
 	DescribeInstanceHealth(*elb.DescribeInstanceHealthInput) (*elb.DescribeInstanceHealthOutput, error)
 
+	DescribeInstanceHealthWithContext(aws.Context, *elb.DescribeInstanceHealthInput, ...aws.Option) (*elb.DescribeInstanceHealthOutput, error)
+
 	DescribeLoadBalancerAttributes(*elb.DescribeLoadBalancerAttributesInput) (*elb.DescribeLoadBalancerAttributesOutput, error)
 
+	DescribeLoadBalancerAttributesWithContext(aws.Context, *elb.DescribeLoadBalancerAttributesInput, ...aws.Option) (*elb.DescribeLoadBalancerAttributesOutput, error)
+
 	DescribeLoadBalancerPolicies(*elb.DescribeLoadBalancerPoliciesInput) (*elb.DescribeLoadBalancerPoliciesOutput, error)
 
+	DescribeLoadBalancerPoliciesWithContext(aws.Context, *elb.DescribeLoadBalancerPoliciesInput, ...aws.Option) (*elb.DescribeLoadBalancerPoliciesOutput, error)
+
 	DescribeLoadBalancerPolicyTypes(*elb.DescribeLoadBalancerPolicyTypesInput) (*elb.DescribeLoadBalancerPolicyTypesOutput, error)
 
+	DescribeLoadBalancerPolicyTypesWithContext(aws.Context, *elb.DescribeLoadBalancerPolicyTypesInput, ...aws.Option) (*elb.DescribeLoadBalancerPolicyTypesOutput, error)
+
 	DescribeLoadBalancers(*elb.DescribeLoadBalancersInput) (*elb.DescribeLoadBalancersOutput, error)
 
+	DescribeLoadBalancersWithContext(aws.Context, *elb.DescribeLoadBalancersInput, ...aws.Option) (*elb.DescribeLoadBalancersOutput, error)
+
+	DescribeLoadBalancersPages(*elb.DescribeLoadBalancersInput, func(*elb.DescribeLoadBalancersOutput, bool) bool) error
+
+	DescribeLoadBalancersPagesWithContext(aws.Context, *elb.DescribeLoadBalancersInput, func(*elb.DescribeLoadBalancersOutput, bool) bool, ...aws.Option) error
+
 	DescribeTags(*elb.DescribeTagsInput) (*elb.DescribeTagsOutput, error)
 
+	DescribeTagsWithContext(aws.Context, *elb.DescribeTagsInput, ...aws.Option) (*elb.DescribeTagsOutput, error)
+
 	DetachLoadBalancerFromSubnets(*elb.DetachLoadBalancerFromSubnetsInput) (*elb.DetachLoadBalancerFromSubnetsOutput, error)
 
+	DetachLoadBalancerFromSubnetsWithContext(aws.Context, *elb.DetachLoadBalancerFromSubnetsInput, ...aws.Option) (*elb.DetachLoadBalancerFromSubnetsOutput, error)
+
 	DisableAvailabilityZonesForLoadBalancer(*elb.DisableAvailabilityZonesForLoadBalancerInput) (*elb.DisableAvailabilityZonesForLoadBalancerOutput, error)
 
+	DisableAvailabilityZonesForLoadBalancerWithContext(aws.Context, *elb.DisableAvailabilityZonesForLoadBalancerInput, ...aws.Option) (*elb.DisableAvailabilityZonesForLoadBalancerOutput, error)
+
 	EnableAvailabilityZonesForLoadBalancer(*elb.EnableAvailabilityZonesForLoadBalancerInput) (*elb.EnableAvailabilityZonesForLoadBalancerOutput, error)
 
+	EnableAvailabilityZonesForLoadBalancerWithContext(aws.Context, *elb.EnableAvailabilityZonesForLoadBalancerInput, ...aws.Option) (*elb.EnableAvailabilityZonesForLoadBalancerOutput, error)
+
 	ModifyLoadBalancerAttributes(*elb.ModifyLoadBalancerAttributesInput) (*elb.ModifyLoadBalancerAttributesOutput, error)
 
+	ModifyLoadBalancerAttributesWithContext(aws.Context, *elb.ModifyLoadBalancerAttributesInput, ...aws.Option) (*elb.ModifyLoadBalancerAttributesOutput, error)
+
 	RegisterInstancesWithLoadBalancer(*elb.RegisterInstancesWithLoadBalancerInput) (*elb.RegisterInstancesWithLoadBalancerOutput, error)
 
+	RegisterInstancesWithLoadBalancerWithContext(aws.Context, *elb.RegisterInstancesWithLoadBalancerInput, ...aws.Option) (*elb.RegisterInstancesWithLoadBalancerOutput, error)
+
 	RemoveTags(*elb.RemoveTagsInput) (*elb.RemoveTagsOutput, error)
 
+	RemoveTagsWithContext(aws.Context, *elb.RemoveTagsInput, ...aws.Option) (*elb.RemoveTagsOutput, error)
+
 	SetLoadBalancerListenerSSLCertificate(*elb.SetLoadBalancerListenerSSLCertificateInput) (*elb.SetLoadBalancerListenerSSLCertificateOutput, error)
 
+	SetLoadBalancerListenerSSLCertificateWithContext(aws.Context, *elb.SetLoadBalancerListenerSSLCertificateInput, ...aws.Option) (*elb.SetLoadBalancerListenerSSLCertificateOutput, error)
+
 	SetLoadBalancerPoliciesForBackendServer(*elb.SetLoadBalancerPoliciesForBackendServerInput) (*elb.SetLoadBalancerPoliciesForBackendServerOutput, error)
 
+	SetLoadBalancerPoliciesForBackendServerWithContext(aws.Context, *elb.SetLoadBalancerPoliciesForBackendServerInput, ...aws.Option) (*elb.SetLoadBalancerPoliciesForBackendServerOutput, error)
+
 	SetLoadBalancerPoliciesOfListener(*elb.SetLoadBalancerPoliciesOfListenerInput) (*elb.SetLoadBalancerPoliciesOfListenerOutput, error)
+
+	SetLoadBalancerPoliciesOfListenerWithContext(aws.Context, *elb.SetLoadBalancerPoliciesOfListenerInput, ...aws.Option) (*elb.SetLoadBalancerPoliciesOfListenerOutput, error)
+
+	WaitUntilAnyInstanceInService(*elb.DescribeInstanceHealthInput) error
+
+	WaitUntilAnyInstanceInServiceWithContext(aws.Context, *elb.DescribeInstanceHealthInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceInService(*elb.DescribeInstanceHealthInput) error
+
+	WaitUntilInstanceInServiceWithContext(aws.Context, *elb.DescribeInstanceHealthInput, ...aws.WaiterOption) error
 }
@@ -37,6 +37,18 @@ func (c *EMR) AddInstanceGroups(input *AddInstanceGroupsInput) (*AddInstanceGrou
 	return out, err
 }
 
+// AddInstanceGroupsWithContext is the same as AddInstanceGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) AddInstanceGroupsWithContext(ctx aws.Context, input *AddInstanceGroupsInput, opts ...aws.Option) (*AddInstanceGroupsOutput, error) {
+	req, out := c.AddInstanceGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddJobFlowSteps = "AddJobFlowSteps"
 
 // AddJobFlowStepsRequest generates a request for the AddJobFlowSteps operation.
@@ -87,6 +99,18 @@ func (c *EMR) AddJobFlowSteps(input *AddJobFlowStepsInput) (*AddJobFlowStepsOutp
 	return out, err
 }
 
+// AddJobFlowStepsWithContext is the same as AddJobFlowSteps with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) AddJobFlowStepsWithContext(ctx aws.Context, input *AddJobFlowStepsInput, opts ...aws.Option) (*AddJobFlowStepsOutput, error) {
+	req, out := c.AddJobFlowStepsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddTags = "AddTags"
 
 // AddTagsRequest generates a request for the AddTags operation.
@@ -117,6 +141,18 @@ func (c *EMR) AddTags(input *AddTagsInput) (*AddTagsOutput, error) {
 	return out, err
 }
 
+// AddTagsWithContext is the same as AddTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) AddTagsWithContext(ctx aws.Context, input *AddTagsInput, opts ...aws.Option) (*AddTagsOutput, error) {
+	req, out := c.AddTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCluster = "DescribeCluster"
 
 // DescribeClusterRequest generates a request for the DescribeCluster operation.
@@ -145,6 +181,18 @@ func (c *EMR) DescribeCluster(input *DescribeClusterInput) (*DescribeClusterOutp
 	return out, err
 }
 
+// DescribeClusterWithContext is the same as DescribeCluster with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) DescribeClusterWithContext(ctx aws.Context, input *DescribeClusterInput, opts ...aws.Option) (*DescribeClusterOutput, error) {
+	req, out := c.DescribeClusterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeJobFlows = "DescribeJobFlows"
 
 // DescribeJobFlowsRequest generates a request for the DescribeJobFlows operation.
@@ -189,6 +237,18 @@ func (c *EMR) DescribeJobFlows(input *DescribeJobFlowsInput) (*DescribeJobFlowsO
 	return out, err
 }
 
+// DescribeJobFlowsWithContext is the same as DescribeJobFlows with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) DescribeJobFlowsWithContext(ctx aws.Context, input *DescribeJobFlowsInput, opts ...aws.Option) (*DescribeJobFlowsOutput, error) {
+	req, out := c.DescribeJobFlowsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStep = "DescribeStep"
 
 // DescribeStepRequest generates a request for the DescribeStep operation.
@@ -216,6 +276,18 @@ func (c *EMR) DescribeStep(input *DescribeStepInput) (*DescribeStepOutput, error
 	return out, err
 }
 
+// DescribeStepWithContext is the same as DescribeStep with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) DescribeStepWithContext(ctx aws.Context, input *DescribeStepInput, opts ...aws.Option) (*DescribeStepOutput, error) {
+	req, out := c.DescribeStepRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListBootstrapActions = "ListBootstrapActions"
 
 // ListBootstrapActionsRequest generates a request for the ListBootstrapActions operation.
@@ -229,6 +301,7 @@ func (c *EMR) ListBootstrapActionsRequest(input *ListBootstrapActionsInput) (req
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"BootstrapActions"},
 		},
 	}
 
@@ -249,6 +322,22 @@ func (c *EMR) ListBootstrapActions(input *ListBootstrapActionsInput) (*ListBoots
 	return out, err
 }
 
+// ListBootstrapActionsWithContext is the same as ListBootstrapActions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) ListBootstrapActionsWithContext(ctx aws.Context, input *ListBootstrapActionsInput, opts ...aws.Option) (*ListBootstrapActionsOutput, error) {
+	req, out := c.ListBootstrapActionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListBootstrapActionsPages iterates over the pages of a ListBootstrapActions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *EMR) ListBootstrapActionsPages(input *ListBootstrapActionsInput, fn func(p *ListBootstrapActionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListBootstrapActionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -256,6 +345,19 @@ func (c *EMR) ListBootstrapActionsPages(input *ListBootstrapActionsInput, fn fun
 	})
 }
 
+// ListBootstrapActionsPagesWithContext is the same as ListBootstrapActionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *EMR) ListBootstrapActionsPagesWithContext(ctx aws.Context, input *ListBootstrapActionsInput, fn func(p *ListBootstrapActionsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListBootstrapActionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListBootstrapActionsOutput), lastPage)
+	})
+}
+
 const opListClusters = "ListClusters"
 
 // ListClustersRequest generates a request for the ListClusters operation.
@@ -269,6 +371,7 @@ func (c *EMR) ListClustersRequest(input *ListClustersInput) (req *aws.Request, o
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Clusters"},
 		},
 	}
 
@@ -293,6 +396,22 @@ func (c *EMR) ListClusters(input *ListClustersInput) (*ListClustersOutput, error
 	return out, err
 }
 
+// ListClustersWithContext is the same as ListClusters with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) ListClustersWithContext(ctx aws.Context, input *ListClustersInput, opts ...aws.Option) (*ListClustersOutput, error) {
+	req, out := c.ListClustersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListClustersPages iterates over the pages of a ListClusters operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *EMR) ListClustersPages(input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListClustersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -300,6 +419,19 @@ func (c *EMR) ListClustersPages(input *ListClustersInput, fn func(p *ListCluster
 	})
 }
 
+// ListClustersPagesWithContext is the same as ListClustersPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *EMR) ListClustersPagesWithContext(ctx aws.Context, input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListClustersRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListClustersOutput), lastPage)
+	})
+}
+
 const opListInstanceGroups = "ListInstanceGroups"
 
 // ListInstanceGroupsRequest generates a request for the ListInstanceGroups operation.
@@ -313,6 +445,7 @@ func (c *EMR) ListInstanceGroupsRequest(input *ListInstanceGroupsInput) (req *aw
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"InstanceGroups"},
 		},
 	}
 
@@ -333,6 +466,22 @@ func (c *EMR) ListInstanceGroups(input *ListInstanceGroupsInput) (*ListInstanceG
 	return out, err
 }
 
+// ListInstanceGroupsWithContext is the same as ListInstanceGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) ListInstanceGroupsWithContext(ctx aws.Context, input *ListInstanceGroupsInput, opts ...aws.Option) (*ListInstanceGroupsOutput, error) {
+	req, out := c.ListInstanceGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListInstanceGroupsPages iterates over the pages of a ListInstanceGroups operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *EMR) ListInstanceGroupsPages(input *ListInstanceGroupsInput, fn func(p *ListInstanceGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstanceGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -340,6 +489,19 @@ func (c *EMR) ListInstanceGroupsPages(input *ListInstanceGroupsInput, fn func(p
 	})
 }
 
+// ListInstanceGroupsPagesWithContext is the same as ListInstanceGroupsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *EMR) ListInstanceGroupsPagesWithContext(ctx aws.Context, input *ListInstanceGroupsInput, fn func(p *ListInstanceGroupsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListInstanceGroupsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceGroupsOutput), lastPage)
+	})
+}
+
 const opListInstances = "ListInstances"
 
 // ListInstancesRequest generates a request for the ListInstances operation.
@@ -353,6 +515,7 @@ func (c *EMR) ListInstancesRequest(input *ListInstancesInput) (req *aws.Request,
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Instances"},
 		},
 	}
 
@@ -377,6 +540,22 @@ func (c *EMR) ListInstances(input *ListInstancesInput) (*ListInstancesOutput, er
 	return out, err
 }
 
+// ListInstancesWithContext is the same as ListInstances with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) ListInstancesWithContext(ctx aws.Context, input *ListInstancesInput, opts ...aws.Option) (*ListInstancesOutput, error) {
+	req, out := c.ListInstancesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListInstancesPages iterates over the pages of a ListInstances operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *EMR) ListInstancesPages(input *ListInstancesInput, fn func(p *ListInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -384,6 +563,19 @@ func (c *EMR) ListInstancesPages(input *ListInstancesInput, fn func(p *ListInsta
 	})
 }
 
+// ListInstancesPagesWithContext is the same as ListInstancesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *EMR) ListInstancesPagesWithContext(ctx aws.Context, input *ListInstancesInput, fn func(p *ListInstancesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListInstancesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstancesOutput), lastPage)
+	})
+}
+
 const opListSteps = "ListSteps"
 
 // ListStepsRequest generates a request for the ListSteps operation.
@@ -397,6 +589,7 @@ func (c *EMR) ListStepsRequest(input *ListStepsInput) (req *aws.Request, output
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Steps"},
 		},
 	}
 
@@ -417,6 +610,22 @@ func (c *EMR) ListSteps(input *ListStepsInput) (*ListStepsOutput, error) {
 	return out, err
 }
 
+// ListStepsWithContext is the same as ListSteps with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) ListStepsWithContext(ctx aws.Context, input *ListStepsInput, opts ...aws.Option) (*ListStepsOutput, error) {
+	req, out := c.ListStepsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListStepsPages iterates over the pages of a ListSteps operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *EMR) ListStepsPages(input *ListStepsInput, fn func(p *ListStepsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStepsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -424,6 +633,19 @@ func (c *EMR) ListStepsPages(input *ListStepsInput, fn func(p *ListStepsOutput,
 	})
 }
 
+// ListStepsPagesWithContext is the same as ListStepsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *EMR) ListStepsPagesWithContext(ctx aws.Context, input *ListStepsInput, fn func(p *ListStepsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListStepsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStepsOutput), lastPage)
+	})
+}
+
 const opModifyInstanceGroups = "ModifyInstanceGroups"
 
 // ModifyInstanceGroupsRequest generates a request for the ModifyInstanceGroups operation.
@@ -454,6 +676,18 @@ func (c *EMR) ModifyInstanceGroups(input *ModifyInstanceGroupsInput) (*ModifyIns
 	return out, err
 }
 
+// ModifyInstanceGroupsWithContext is the same as ModifyInstanceGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) ModifyInstanceGroupsWithContext(ctx aws.Context, input *ModifyInstanceGroupsInput, opts ...aws.Option) (*ModifyInstanceGroupsOutput, error) {
+	req, out := c.ModifyInstanceGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveTags = "RemoveTags"
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -486,6 +720,18 @@ func (c *EMR) RemoveTags(input *RemoveTagsInput) (*RemoveTagsOutput, error) {
 	return out, err
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) RemoveTagsWithContext(ctx aws.Context, input *RemoveTagsInput, opts ...aws.Option) (*RemoveTagsOutput, error) {
+	req, out := c.RemoveTagsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRunJobFlow = "RunJobFlow"
 
 // RunJobFlowRequest generates a request for the RunJobFlow operation.
@@ -535,6 +781,18 @@ func (c *EMR) RunJobFlow(input *RunJobFlowInput) (*RunJobFlowOutput, error) {
 	return out, err
 }
 
+// RunJobFlowWithContext is the same as RunJobFlow with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) RunJobFlowWithContext(ctx aws.Context, input *RunJobFlowInput, opts ...aws.Option) (*RunJobFlowOutput, error) {
+	req, out := c.RunJobFlowRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetTerminationProtection = "SetTerminationProtection"
 
 // SetTerminationProtectionRequest generates a request for the SetTerminationProtection operation.
@@ -578,6 +836,18 @@ func (c *EMR) SetTerminationProtection(input *SetTerminationProtectionInput) (*S
 	return out, err
 }
 
+// SetTerminationProtectionWithContext is the same as SetTerminationProtection with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) SetTerminationProtectionWithContext(ctx aws.Context, input *SetTerminationProtectionInput, opts ...aws.Option) (*SetTerminationProtectionOutput, error) {
+	req, out := c.SetTerminationProtectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetVisibleToAllUsers = "SetVisibleToAllUsers"
 
 // SetVisibleToAllUsersRequest generates a request for the SetVisibleToAllUsers operation.
@@ -610,6 +880,18 @@ func (c *EMR) SetVisibleToAllUsers(input *SetVisibleToAllUsersInput) (*SetVisibl
 	return out, err
 }
 
+// SetVisibleToAllUsersWithContext is the same as SetVisibleToAllUsers with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) SetVisibleToAllUsersWithContext(ctx aws.Context, input *SetVisibleToAllUsersInput, opts ...aws.Option) (*SetVisibleToAllUsersOutput, error) {
+	req, out := c.SetVisibleToAllUsersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateJobFlows = "TerminateJobFlows"
 
 // TerminateJobFlowsRequest generates a request for the TerminateJobFlows operation.
@@ -645,13 +927,25 @@ func (c *EMR) TerminateJobFlows(input *TerminateJobFlowsInput) (*TerminateJobFlo
 	return out, err
 }
 
+// TerminateJobFlowsWithContext is the same as TerminateJobFlows with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *EMR) TerminateJobFlowsWithContext(ctx aws.Context, input *TerminateJobFlowsInput, opts ...aws.Option) (*TerminateJobFlowsOutput, error) {
+	req, out := c.TerminateJobFlowsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Input to an AddInstanceGroups call.
 type AddInstanceGroupsInput struct {
 	// Instance Groups to add.
 	InstanceGroups []*InstanceGroupConfig `type:"list" required:"true"`
 
 	// Job flow in which to add the instance groups.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataAddInstanceGroupsInput `json:"-" xml:"-"`
 }
@@ -676,7 +970,7 @@ type AddInstanceGroupsOutput struct {
 	InstanceGroupIDs []*string `locationName:"InstanceGroupIds" type:"list"`
 
 	// The job flow ID in which the instance groups are added.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256"`
 
 	metadataAddInstanceGroupsOutput `json:"-" xml:"-"`
 }
@@ -699,7 +993,7 @@ func (s AddInstanceGroupsOutput) GoString() string {
 type AddJobFlowStepsInput struct {
 	// A string that uniquely identifies the job flow. This identifier is returned
 	// by RunJobFlow and can also be obtained from ListClusters.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	// A list of StepConfig to be executed by the job flow.
 	Steps []*StepConfig `type:"list" required:"true"`
@@ -837,7 +1131,7 @@ func (s Application) GoString() string {
 // Configuration of a bootstrap action.
 type BootstrapActionConfig struct {
 	// The name of the bootstrap action.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// The script run by the bootstrap action.
 	ScriptBootstrapAction *ScriptBootstrapActionConfig `type:"structure" required:"true"`
@@ -1314,11 +1608,11 @@ type HadoopJARStepConfig struct {
 	Args []*string `type:"list"`
 
 	// A path to a JAR file run during the step.
-	JAR *string `locationName:"Jar" type:"string" required:"true"`
+	JAR *string `locationName:"Jar" type:"string" min:"0" max:"10280" required:"true"`
 
 	// The name of the main class in the specified Java file. If not specified,
 	// the JAR file should specify a Main-Class in its manifest file.
-	MainClass *string `type:"string"`
+	MainClass *string `type:"string" min:"0" max:"10280"`
 
 	// A list of Java properties that are set when the step runs. You can use these
 	// properties to pass key value pairs to your main function.
@@ -1431,7 +1725,7 @@ type InstanceGroup struct {
 	InstanceGroupType *string `type:"string"`
 
 	// The EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"256"`
 
 	// The marketplace to provision instances for this group. Valid values are ON_DEMAND
 	// or SPOT.
@@ -1470,7 +1764,7 @@ func (s InstanceGroup) GoString() string {
 type InstanceGroupConfig struct {
 	// Bid price for each Amazon EC2 instance in the instance group when launching
 	// nodes as Spot Instances, expressed in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" min:"0" max:"256"`
 
 	// Target number of instances for the instance group.
 	InstanceCount *int64 `type:"integer" required:"true"`
@@ -1479,13 +1773,13 @@ type InstanceGroupConfig struct {
 	InstanceRole *string `type:"string" required:"true"`
 
 	// The Amazon EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
 	Market *string `type:"string"`
 
 	// Friendly name given to the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	metadataInstanceGroupConfig `json:"-" xml:"-"`
 }
@@ -1508,7 +1802,7 @@ func (s InstanceGroupConfig) GoString() string {
 type InstanceGroupDetail struct {
 	// Bid price for EC2 Instances when launching nodes as Spot Instances, expressed
 	// in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" min:"0" max:"256"`
 
 	// The date/time the instance group was created.
 	CreationDateTime *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
@@ -1517,7 +1811,7 @@ type InstanceGroupDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Unique identifier for the instance group.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" min:"0" max:"256"`
 
 	// Target number of instances to run in the instance group.
 	InstanceRequestCount *int64 `type:"integer" required:"true"`
@@ -1529,16 +1823,16 @@ type InstanceGroupDetail struct {
 	InstanceRunningCount *int64 `type:"integer" required:"true"`
 
 	// Amazon EC2 Instance type.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Details regarding the state of the instance group.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
 	Market *string `type:"string" required:"true"`
 
 	// Friendly name for the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	// The date/time the instance group was available to the cluster.
 	ReadyDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1578,7 +1872,7 @@ type InstanceGroupModifyConfig struct {
 	InstanceCount *int64 `type:"integer"`
 
 	// Unique ID of the instance group to expand or shrink.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" required:"true"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataInstanceGroupModifyConfig `json:"-" xml:"-"`
 }
@@ -1765,7 +2059,7 @@ type JobFlowDetail struct {
 	// flow. For a list of AMI versions currently supported by Amazon ElasticMapReduce,
 	// go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" min:"0" max:"256"`
 
 	// A list of the bootstrap actions run by the job flow.
 	BootstrapActions []*BootstrapActionDetail `type:"list"`
@@ -1777,21 +2071,21 @@ type JobFlowDetail struct {
 	Instances *JobFlowInstancesDetail `type:"structure" required:"true"`
 
 	// The job flow identifier.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	// The IAM role that was specified when the job flow was launched. The EC2 instances
 	// of the job flow assume this role.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" min:"0" max:"10280"`
 
 	// The location in Amazon S3 where log files for the job are stored.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" min:"0" max:"10280"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"0" max:"10280"`
 
 	// A list of steps run by the job flow.
 	Steps []*StepDetail `type:"list"`
@@ -1835,7 +2129,7 @@ type JobFlowExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Description of the job flow last changed state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// The date and time when the job flow was ready to start running bootstrap
 	// actions.
@@ -1877,7 +2171,7 @@ type JobFlowInstancesConfig struct {
 
 	// The name of the Amazon EC2 key pair that can be used to ssh to the master
 	// node as the user called "hadoop."
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" min:"0" max:"256"`
 
 	// To launch the job flow in Amazon Virtual Private Cloud (Amazon VPC), set
 	// this parameter to the identifier of the Amazon VPC subnet where you want
@@ -1887,21 +2181,21 @@ type JobFlowInstancesConfig struct {
 	//  Amazon VPC currently does not support cluster compute quadruple extra large
 	// (cc1.4xlarge) instances. Thus you cannot specify the cc1.4xlarge instance
 	// type for nodes of a job flow launched in a Amazon VPC.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" min:"0" max:"256"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the master node.
-	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string"`
+	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string" min:"0" max:"256"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the slave nodes.
-	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string"`
+	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string" min:"0" max:"256"`
 
 	// The Hadoop version for the job flow. Valid inputs are "0.18", "0.20", "0.20.205",
 	// "1.0.3", "2.2.0", or "2.4.0". If you do not set this value, the default of
 	// 0.18 is used, unless the AmiVersion parameter is set in the RunJobFlow call,
 	// in which case the default version of Hadoop for that AMI version is used.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" min:"0" max:"256"`
 
 	// The number of Amazon EC2 instances used to execute the job flow.
 	InstanceCount *int64 `type:"integer"`
@@ -1913,13 +2207,13 @@ type JobFlowInstancesConfig struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The EC2 instance type of the master node.
-	MasterInstanceType *string `type:"string"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256"`
 
 	// The Availability Zone the job flow will run in.
 	Placement *PlacementType `type:"structure"`
 
 	// The EC2 instance type of the slave nodes.
-	SlaveInstanceType *string `type:"string"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256"`
 
 	// Specifies whether to lock the job flow to prevent the Amazon EC2 instances
 	// from being terminated by API call, user intervention, or in the event of
@@ -1947,14 +2241,14 @@ func (s JobFlowInstancesConfig) GoString() string {
 type JobFlowInstancesDetail struct {
 	// The name of an Amazon EC2 key pair that can be used to ssh to the master
 	// node of job flow.
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" min:"0" max:"256"`
 
 	// For job flows launched within Amazon Virtual Private Cloud, this value specifies
 	// the identifier of the subnet where the job flow was launched.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" min:"0" max:"256"`
 
 	// The Hadoop version for the job flow.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" min:"0" max:"256"`
 
 	// The number of Amazon EC2 instances in the cluster. If the value is 1, the
 	// same instance serves as both the master and slave node. If the value is greater
@@ -1968,13 +2262,13 @@ type JobFlowInstancesDetail struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The Amazon EC2 instance identifier of the master node.
-	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string"`
+	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string" min:"0" max:"10280"`
 
 	// The Amazon EC2 master node instance type.
-	MasterInstanceType *string `type:"string" required:"true"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// The DNS name of the master node.
-	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string"`
+	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string" min:"0" max:"10280"`
 
 	// An approximation of the cost of the job flow, represented in m1.small/hours.
 	// This value is incremented once for every hour an m1.small runs. Larger instances
@@ -1988,7 +2282,7 @@ type JobFlowInstancesDetail struct {
 	Placement *PlacementType `type:"structure"`
 
 	// The Amazon EC2 slave node instance type.
-	SlaveInstanceType *string `type:"string" required:"true"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Specifies whether the Amazon EC2 instances in the cluster are protected from
 	// termination by API calls, user intervention, or in the event of a job flow
@@ -2015,10 +2309,10 @@ func (s JobFlowInstancesDetail) GoString() string {
 // A key value pair.
 type KeyValue struct {
 	// The unique identifier of a key value pair.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"0" max:"10280"`
 
 	// The value part of the identified key.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"10280"`
 
 	metadataKeyValue `json:"-" xml:"-"`
 }
@@ -2350,7 +2644,7 @@ func (s ModifyInstanceGroupsOutput) GoString() string {
 // The Amazon EC2 location for the job flow.
 type PlacementType struct {
 	// The Amazon EC2 Availability Zone for the job flow.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"0" max:"10280" required:"true"`
 
 	metadataPlacementType `json:"-" xml:"-"`
 }
@@ -2428,10 +2722,10 @@ type RunJobFlowInput struct {
 	// For details about the AMI versions currently supported by Amazon Elastic
 	// MapReduce, go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" min:"0" max:"256"`
 
 	// A JSON string for selecting additional features.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" min:"0" max:"10280"`
 
 	// A list of bootstrap actions that will be run before Hadoop is started on
 	// the cluster nodes.
@@ -2444,14 +2738,14 @@ type RunJobFlowInput struct {
 	// An IAM role for the job flow. The EC2 instances of the job flow assume this
 	// role. The default role is EMRJobflowDefault. In order to use the default
 	// role, you must have already created it using the CLI.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" min:"0" max:"10280"`
 
 	// The location in Amazon S3 to write the log files of the job flow. If a value
 	// is not provided, logs are not created.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" min:"0" max:"10280"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// A list of strings that indicates third-party software to use with the job
 	// flow that accepts a user argument list. EMR accepts and forwards the argument
@@ -2468,7 +2762,7 @@ type RunJobFlowInput struct {
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"0" max:"10280"`
 
 	// A list of steps to be executed by the job flow.
 	Steps []*StepConfig `type:"list"`
@@ -2512,7 +2806,7 @@ func (s RunJobFlowInput) GoString() string {
 // The result of the RunJobFlow operation.
 type RunJobFlowOutput struct {
 	// An unique identifier for the job flow.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256"`
 
 	metadataRunJobFlowOutput `json:"-" xml:"-"`
 }
@@ -2538,7 +2832,7 @@ type ScriptBootstrapActionConfig struct {
 
 	// Location of the script to run during a bootstrap action. Can be either a
 	// location in Amazon S3 or on a local file system.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"0" max:"10280" required:"true"`
 
 	metadataScriptBootstrapActionConfig `json:"-" xml:"-"`
 }
@@ -2695,7 +2989,7 @@ type StepConfig struct {
 	HadoopJARStep *HadoopJARStepConfig `locationName:"HadoopJarStep" type:"structure" required:"true"`
 
 	// The name of the job flow step.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	metadataStepConfig `json:"-" xml:"-"`
 }
@@ -2748,7 +3042,7 @@ type StepExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A description of the step's current state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// The start date and time of the step.
 	StartDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -2897,7 +3191,7 @@ type SupportedProductConfig struct {
 	Args []*string `type:"list"`
 
 	// The name of the product configuration.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	metadataSupportedProductConfig `json:"-" xml:"-"`
 }
@@ -4,6 +4,7 @@
 package emriface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emr"
 )
 
@@ -11,35 +12,97 @@ import (
 type EMRAPI interface {
 	AddInstanceGroups(*emr.AddInstanceGroupsInput) (*emr.AddInstanceGroupsOutput, error)
 
+	AddInstanceGroupsWithContext(aws.Context, *emr.AddInstanceGroupsInput, ...aws.Option) (*emr.AddInstanceGroupsOutput, error)
+
 	AddJobFlowSteps(*emr.AddJobFlowStepsInput) (*emr.AddJobFlowStepsOutput, error)
 
+	AddJobFlowStepsWithContext(aws.Context, *emr.AddJobFlowStepsInput, ...aws.Option) (*emr.AddJobFlowStepsOutput, error)
+
 	AddTags(*emr.AddTagsInput) (*emr.AddTagsOutput, error)
 
+	AddTagsWithContext(aws.Context, *emr.AddTagsInput, ...aws.Option) (*emr.AddTagsOutput, error)
+
 	DescribeCluster(*emr.DescribeClusterInput) (*emr.DescribeClusterOutput, error)
 
+	DescribeClusterWithContext(aws.Context, *emr.DescribeClusterInput, ...aws.Option) (*emr.DescribeClusterOutput, error)
+
 	DescribeJobFlows(*emr.DescribeJobFlowsInput) (*emr.DescribeJobFlowsOutput, error)
 
+	DescribeJobFlowsWithContext(aws.Context, *emr.DescribeJobFlowsInput, ...aws.Option) (*emr.DescribeJobFlowsOutput, error)
+
 	DescribeStep(*emr.DescribeStepInput) (*emr.DescribeStepOutput, error)
 
+	DescribeStepWithContext(aws.Context, *emr.DescribeStepInput, ...aws.Option) (*emr.DescribeStepOutput, error)
+
 	ListBootstrapActions(*emr.ListBootstrapActionsInput) (*emr.ListBootstrapActionsOutput, error)
 
+	ListBootstrapActionsWithContext(aws.Context, *emr.ListBootstrapActionsInput, ...aws.Option) (*emr.ListBootstrapActionsOutput, error)
+
+	ListBootstrapActionsPages(*emr.ListBootstrapActionsInput, func(*emr.ListBootstrapActionsOutput, bool) bool) error
+
+	ListBootstrapActionsPagesWithContext(aws.Context, *emr.ListBootstrapActionsInput, func(*emr.ListBootstrapActionsOutput, bool) bool, ...aws.Option) error
+
 	ListClusters(*emr.ListClustersInput) (*emr.ListClustersOutput, error)
 
+	ListClustersWithContext(aws.Context, *emr.ListClustersInput, ...aws.Option) (*emr.ListClustersOutput, error)
+
+	ListClustersPages(*emr.ListClustersInput, func(*emr.ListClustersOutput, bool) bool) error
+
+	ListClustersPagesWithContext(aws.Context, *emr.ListClustersInput, func(*emr.ListClustersOutput, bool) bool, ...aws.Option) error
+
 	ListInstanceGroups(*emr.ListInstanceGroupsInput) (*emr.ListInstanceGroupsOutput, error)
 
+	ListInstanceGroupsWithContext(aws.Context, *emr.ListInstanceGroupsInput, ...aws.Option) (*emr.ListInstanceGroupsOutput, error)
+
+	ListInstanceGroupsPages(*emr.ListInstanceGroupsInput, func(*emr.ListInstanceGroupsOutput, bool) bool) error
+
+	ListInstanceGroupsPagesWithContext(aws.Context, *emr.ListInstanceGroupsInput, func(*emr.ListInstanceGroupsOutput, bool) bool, ...aws.Option) error
+
 	ListInstances(*emr.ListInstancesInput) (*emr.ListInstancesOutput, error)
 
+	ListInstancesWithContext(aws.Context, *emr.ListInstancesInput, ...aws.Option) (*emr.ListInstancesOutput, error)
+
+	ListInstancesPages(*emr.ListInstancesInput, func(*emr.ListInstancesOutput, bool) bool) error
+
+	ListInstancesPagesWithContext(aws.Context, *emr.ListInstancesInput, func(*emr.ListInstancesOutput, bool) bool, ...aws.Option) error
+
 	ListSteps(*emr.ListStepsInput) (*emr.ListStepsOutput, error)
 
+	ListStepsWithContext(aws.Context, *emr.ListStepsInput, ...aws.Option) (*emr.ListStepsOutput, error)
+
+	ListStepsPages(*emr.ListStepsInput, func(*emr.ListStepsOutput, bool) bool) error
+
+	ListStepsPagesWithContext(aws.Context, *emr.ListStepsInput, func(*emr.ListStepsOutput, bool) bool, ...aws.Option) error
+
 	ModifyInstanceGroups(*emr.ModifyInstanceGroupsInput) (*emr.ModifyInstanceGroupsOutput, error)
 
+	ModifyInstanceGroupsWithContext(aws.Context, *emr.ModifyInstanceGroupsInput, ...aws.Option) (*emr.ModifyInstanceGroupsOutput, error)
+
 	RemoveTags(*emr.RemoveTagsInput) (*emr.RemoveTagsOutput, error)
 
+	RemoveTagsWithContext(aws.Context, *emr.RemoveTagsInput, ...aws.Option) (*emr.RemoveTagsOutput, error)
+
 	RunJobFlow(*emr.RunJobFlowInput) (*emr.RunJobFlowOutput, error)
 
+	RunJobFlowWithContext(aws.Context, *emr.RunJobFlowInput, ...aws.Option) (*emr.RunJobFlowOutput, error)
+
 	SetTerminationProtection(*emr.SetTerminationProtectionInput) (*emr.SetTerminationProtectionOutput, error)
 
+	SetTerminationProtectionWithContext(aws.Context, *emr.SetTerminationProtectionInput, ...aws.Option) (*emr.SetTerminationProtectionOutput, error)
+
 	SetVisibleToAllUsers(*emr.SetVisibleToAllUsersInput) (*emr.SetVisibleToAllUsersOutput, error)
 
+	SetVisibleToAllUsersWithContext(aws.Context, *emr.SetVisibleToAllUsersInput, ...aws.Option) (*emr.SetVisibleToAllUsersOutput, error)
+
 	TerminateJobFlows(*emr.TerminateJobFlowsInput) (*emr.TerminateJobFlowsOutput, error)
+
+	TerminateJobFlowsWithContext(aws.Context, *emr.TerminateJobFlowsInput, ...aws.Option) (*emr.TerminateJobFlowsOutput, error)
+
+	WaitUntilClusterRunning(*emr.DescribeClusterInput) error
+
+	WaitUntilClusterRunningWithContext(aws.Context, *emr.DescribeClusterInput, ...aws.WaiterOption) error
+
+	WaitUntilStepComplete(*emr.DescribeStepInput) error
+
+	WaitUntilStepCompleteWithContext(aws.Context, *emr.DescribeStepInput, ...aws.WaiterOption) error
 }
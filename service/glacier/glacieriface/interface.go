@@ -4,6 +4,7 @@
 package glacieriface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
 )
 
@@ -11,55 +12,133 @@ import (
 type GlacierAPI interface {
 	AbortMultipartUpload(*glacier.AbortMultipartUploadInput) (*glacier.AbortMultipartUploadOutput, error)
 
+	AbortMultipartUploadWithContext(aws.Context, *glacier.AbortMultipartUploadInput, ...aws.Option) (*glacier.AbortMultipartUploadOutput, error)
+
 	AddTagsToVault(*glacier.AddTagsToVaultInput) (*glacier.AddTagsToVaultOutput, error)
 
+	AddTagsToVaultWithContext(aws.Context, *glacier.AddTagsToVaultInput, ...aws.Option) (*glacier.AddTagsToVaultOutput, error)
+
 	CompleteMultipartUpload(*glacier.CompleteMultipartUploadInput) (*glacier.ArchiveCreationOutput, error)
 
+	CompleteMultipartUploadWithContext(aws.Context, *glacier.CompleteMultipartUploadInput, ...aws.Option) (*glacier.ArchiveCreationOutput, error)
+
 	CreateVault(*glacier.CreateVaultInput) (*glacier.CreateVaultOutput, error)
 
+	CreateVaultWithContext(aws.Context, *glacier.CreateVaultInput, ...aws.Option) (*glacier.CreateVaultOutput, error)
+
 	DeleteArchive(*glacier.DeleteArchiveInput) (*glacier.DeleteArchiveOutput, error)
 
+	DeleteArchiveWithContext(aws.Context, *glacier.DeleteArchiveInput, ...aws.Option) (*glacier.DeleteArchiveOutput, error)
+
 	DeleteVault(*glacier.DeleteVaultInput) (*glacier.DeleteVaultOutput, error)
 
+	DeleteVaultWithContext(aws.Context, *glacier.DeleteVaultInput, ...aws.Option) (*glacier.DeleteVaultOutput, error)
+
 	DeleteVaultAccessPolicy(*glacier.DeleteVaultAccessPolicyInput) (*glacier.DeleteVaultAccessPolicyOutput, error)
 
+	DeleteVaultAccessPolicyWithContext(aws.Context, *glacier.DeleteVaultAccessPolicyInput, ...aws.Option) (*glacier.DeleteVaultAccessPolicyOutput, error)
+
 	DeleteVaultNotifications(*glacier.DeleteVaultNotificationsInput) (*glacier.DeleteVaultNotificationsOutput, error)
 
+	DeleteVaultNotificationsWithContext(aws.Context, *glacier.DeleteVaultNotificationsInput, ...aws.Option) (*glacier.DeleteVaultNotificationsOutput, error)
+
 	DescribeJob(*glacier.DescribeJobInput) (*glacier.JobDescription, error)
 
+	DescribeJobWithContext(aws.Context, *glacier.DescribeJobInput, ...aws.Option) (*glacier.JobDescription, error)
+
 	DescribeVault(*glacier.DescribeVaultInput) (*glacier.DescribeVaultOutput, error)
 
+	DescribeVaultWithContext(aws.Context, *glacier.DescribeVaultInput, ...aws.Option) (*glacier.DescribeVaultOutput, error)
+
 	GetDataRetrievalPolicy(*glacier.GetDataRetrievalPolicyInput) (*glacier.GetDataRetrievalPolicyOutput, error)
 
+	GetDataRetrievalPolicyWithContext(aws.Context, *glacier.GetDataRetrievalPolicyInput, ...aws.Option) (*glacier.GetDataRetrievalPolicyOutput, error)
+
 	GetJobOutput(*glacier.GetJobOutputInput) (*glacier.GetJobOutputOutput, error)
 
+	GetJobOutputWithContext(aws.Context, *glacier.GetJobOutputInput, ...aws.Option) (*glacier.GetJobOutputOutput, error)
+
 	GetVaultAccessPolicy(*glacier.GetVaultAccessPolicyInput) (*glacier.GetVaultAccessPolicyOutput, error)
 
+	GetVaultAccessPolicyWithContext(aws.Context, *glacier.GetVaultAccessPolicyInput, ...aws.Option) (*glacier.GetVaultAccessPolicyOutput, error)
+
 	GetVaultNotifications(*glacier.GetVaultNotificationsInput) (*glacier.GetVaultNotificationsOutput, error)
 
+	GetVaultNotificationsWithContext(aws.Context, *glacier.GetVaultNotificationsInput, ...aws.Option) (*glacier.GetVaultNotificationsOutput, error)
+
 	InitiateJob(*glacier.InitiateJobInput) (*glacier.InitiateJobOutput, error)
 
+	InitiateJobWithContext(aws.Context, *glacier.InitiateJobInput, ...aws.Option) (*glacier.InitiateJobOutput, error)
+
 	InitiateMultipartUpload(*glacier.InitiateMultipartUploadInput) (*glacier.InitiateMultipartUploadOutput, error)
 
+	InitiateMultipartUploadWithContext(aws.Context, *glacier.InitiateMultipartUploadInput, ...aws.Option) (*glacier.InitiateMultipartUploadOutput, error)
+
 	ListJobs(*glacier.ListJobsInput) (*glacier.ListJobsOutput, error)
 
+	ListJobsWithContext(aws.Context, *glacier.ListJobsInput, ...aws.Option) (*glacier.ListJobsOutput, error)
+
+	ListJobsPages(*glacier.ListJobsInput, func(*glacier.ListJobsOutput, bool) bool) error
+
+	ListJobsPagesWithContext(aws.Context, *glacier.ListJobsInput, func(*glacier.ListJobsOutput, bool) bool, ...aws.Option) error
+
 	ListMultipartUploads(*glacier.ListMultipartUploadsInput) (*glacier.ListMultipartUploadsOutput, error)
 
+	ListMultipartUploadsWithContext(aws.Context, *glacier.ListMultipartUploadsInput, ...aws.Option) (*glacier.ListMultipartUploadsOutput, error)
+
+	ListMultipartUploadsPages(*glacier.ListMultipartUploadsInput, func(*glacier.ListMultipartUploadsOutput, bool) bool) error
+
+	ListMultipartUploadsPagesWithContext(aws.Context, *glacier.ListMultipartUploadsInput, func(*glacier.ListMultipartUploadsOutput, bool) bool, ...aws.Option) error
+
 	ListParts(*glacier.ListPartsInput) (*glacier.ListPartsOutput, error)
 
+	ListPartsWithContext(aws.Context, *glacier.ListPartsInput, ...aws.Option) (*glacier.ListPartsOutput, error)
+
+	ListPartsPages(*glacier.ListPartsInput, func(*glacier.ListPartsOutput, bool) bool) error
+
+	ListPartsPagesWithContext(aws.Context, *glacier.ListPartsInput, func(*glacier.ListPartsOutput, bool) bool, ...aws.Option) error
+
 	ListTagsForVault(*glacier.ListTagsForVaultInput) (*glacier.ListTagsForVaultOutput, error)
 
+	ListTagsForVaultWithContext(aws.Context, *glacier.ListTagsForVaultInput, ...aws.Option) (*glacier.ListTagsForVaultOutput, error)
+
 	ListVaults(*glacier.ListVaultsInput) (*glacier.ListVaultsOutput, error)
 
+	ListVaultsWithContext(aws.Context, *glacier.ListVaultsInput, ...aws.Option) (*glacier.ListVaultsOutput, error)
+
+	ListVaultsPages(*glacier.ListVaultsInput, func(*glacier.ListVaultsOutput, bool) bool) error
+
+	ListVaultsPagesWithContext(aws.Context, *glacier.ListVaultsInput, func(*glacier.ListVaultsOutput, bool) bool, ...aws.Option) error
+
 	RemoveTagsFromVault(*glacier.RemoveTagsFromVaultInput) (*glacier.RemoveTagsFromVaultOutput, error)
 
+	RemoveTagsFromVaultWithContext(aws.Context, *glacier.RemoveTagsFromVaultInput, ...aws.Option) (*glacier.RemoveTagsFromVaultOutput, error)
+
 	SetDataRetrievalPolicy(*glacier.SetDataRetrievalPolicyInput) (*glacier.SetDataRetrievalPolicyOutput, error)
 
+	SetDataRetrievalPolicyWithContext(aws.Context, *glacier.SetDataRetrievalPolicyInput, ...aws.Option) (*glacier.SetDataRetrievalPolicyOutput, error)
+
 	SetVaultAccessPolicy(*glacier.SetVaultAccessPolicyInput) (*glacier.SetVaultAccessPolicyOutput, error)
 
+	SetVaultAccessPolicyWithContext(aws.Context, *glacier.SetVaultAccessPolicyInput, ...aws.Option) (*glacier.SetVaultAccessPolicyOutput, error)
+
 	SetVaultNotifications(*glacier.SetVaultNotificationsInput) (*glacier.SetVaultNotificationsOutput, error)
 
+	SetVaultNotificationsWithContext(aws.Context, *glacier.SetVaultNotificationsInput, ...aws.Option) (*glacier.SetVaultNotificationsOutput, error)
+
 	UploadArchive(*glacier.UploadArchiveInput) (*glacier.ArchiveCreationOutput, error)
 
+	UploadArchiveWithContext(aws.Context, *glacier.UploadArchiveInput, ...aws.Option) (*glacier.ArchiveCreationOutput, error)
+
 	UploadMultipartPart(*glacier.UploadMultipartPartInput) (*glacier.UploadMultipartPartOutput, error)
+
+	UploadMultipartPartWithContext(aws.Context, *glacier.UploadMultipartPartInput, ...aws.Option) (*glacier.UploadMultipartPartOutput, error)
+
+	WaitUntilVaultExists(*glacier.DescribeVaultInput) error
+
+	WaitUntilVaultExistsWithContext(aws.Context, *glacier.DescribeVaultInput, ...aws.WaiterOption) error
+
+	WaitUntilVaultNotExists(*glacier.DescribeVaultInput) error
+
+	WaitUntilVaultNotExistsWithContext(aws.Context, *glacier.DescribeVaultInput, ...aws.WaiterOption) error
 }
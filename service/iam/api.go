@@ -41,6 +41,18 @@ func (c *IAM) AddClientIDToOpenIDConnectProvider(input *AddClientIDToOpenIDConne
 	return out, err
 }
 
+// AddClientIDToOpenIDConnectProviderWithContext is the same as AddClientIDToOpenIDConnectProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) AddClientIDToOpenIDConnectProviderWithContext(ctx aws.Context, input *AddClientIDToOpenIDConnectProviderInput, opts ...aws.Option) (*AddClientIDToOpenIDConnectProviderOutput, error) {
+	req, out := c.AddClientIDToOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddRoleToInstanceProfile = "AddRoleToInstanceProfile"
 
 // AddRoleToInstanceProfileRequest generates a request for the AddRoleToInstanceProfile operation.
@@ -71,6 +83,18 @@ func (c *IAM) AddRoleToInstanceProfile(input *AddRoleToInstanceProfileInput) (*A
 	return out, err
 }
 
+// AddRoleToInstanceProfileWithContext is the same as AddRoleToInstanceProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) AddRoleToInstanceProfileWithContext(ctx aws.Context, input *AddRoleToInstanceProfileInput, opts ...aws.Option) (*AddRoleToInstanceProfileOutput, error) {
+	req, out := c.AddRoleToInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddUserToGroup = "AddUserToGroup"
 
 // AddUserToGroupRequest generates a request for the AddUserToGroup operation.
@@ -98,6 +122,18 @@ func (c *IAM) AddUserToGroup(input *AddUserToGroupInput) (*AddUserToGroupOutput,
 	return out, err
 }
 
+// AddUserToGroupWithContext is the same as AddUserToGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) AddUserToGroupWithContext(ctx aws.Context, input *AddUserToGroupInput, opts ...aws.Option) (*AddUserToGroupOutput, error) {
+	req, out := c.AddUserToGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachGroupPolicy = "AttachGroupPolicy"
 
 // AttachGroupPolicyRequest generates a request for the AttachGroupPolicy operation.
@@ -132,6 +168,18 @@ func (c *IAM) AttachGroupPolicy(input *AttachGroupPolicyInput) (*AttachGroupPoli
 	return out, err
 }
 
+// AttachGroupPolicyWithContext is the same as AttachGroupPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) AttachGroupPolicyWithContext(ctx aws.Context, input *AttachGroupPolicyInput, opts ...aws.Option) (*AttachGroupPolicyOutput, error) {
+	req, out := c.AttachGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachRolePolicy = "AttachRolePolicy"
 
 // AttachRolePolicyRequest generates a request for the AttachRolePolicy operation.
@@ -170,6 +218,18 @@ func (c *IAM) AttachRolePolicy(input *AttachRolePolicyInput) (*AttachRolePolicyO
 	return out, err
 }
 
+// AttachRolePolicyWithContext is the same as AttachRolePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) AttachRolePolicyWithContext(ctx aws.Context, input *AttachRolePolicyInput, opts ...aws.Option) (*AttachRolePolicyOutput, error) {
+	req, out := c.AttachRolePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachUserPolicy = "AttachUserPolicy"
 
 // AttachUserPolicyRequest generates a request for the AttachUserPolicy operation.
@@ -204,6 +264,18 @@ func (c *IAM) AttachUserPolicy(input *AttachUserPolicyInput) (*AttachUserPolicyO
 	return out, err
 }
 
+// AttachUserPolicyWithContext is the same as AttachUserPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) AttachUserPolicyWithContext(ctx aws.Context, input *AttachUserPolicyInput, opts ...aws.Option) (*AttachUserPolicyOutput, error) {
+	req, out := c.AttachUserPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opChangePassword = "ChangePassword"
 
 // ChangePasswordRequest generates a request for the ChangePassword operation.
@@ -236,6 +308,18 @@ func (c *IAM) ChangePassword(input *ChangePasswordInput) (*ChangePasswordOutput,
 	return out, err
 }
 
+// ChangePasswordWithContext is the same as ChangePassword with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ChangePasswordWithContext(ctx aws.Context, input *ChangePasswordInput, opts ...aws.Option) (*ChangePasswordOutput, error) {
+	req, out := c.ChangePasswordRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAccessKey = "CreateAccessKey"
 
 // CreateAccessKeyRequest generates a request for the CreateAccessKey operation.
@@ -279,6 +363,18 @@ func (c *IAM) CreateAccessKey(input *CreateAccessKeyInput) (*CreateAccessKeyOutp
 	return out, err
 }
 
+// CreateAccessKeyWithContext is the same as CreateAccessKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateAccessKeyWithContext(ctx aws.Context, input *CreateAccessKeyInput, opts ...aws.Option) (*CreateAccessKeyOutput, error) {
+	req, out := c.CreateAccessKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAccountAlias = "CreateAccountAlias"
 
 // CreateAccountAliasRequest generates a request for the CreateAccountAlias operation.
@@ -308,6 +404,18 @@ func (c *IAM) CreateAccountAlias(input *CreateAccountAliasInput) (*CreateAccount
 	return out, err
 }
 
+// CreateAccountAliasWithContext is the same as CreateAccountAlias with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateAccountAliasWithContext(ctx aws.Context, input *CreateAccountAliasInput, opts ...aws.Option) (*CreateAccountAliasOutput, error) {
+	req, out := c.CreateAccountAliasRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateGroup = "CreateGroup"
 
 // CreateGroupRequest generates a request for the CreateGroup operation.
@@ -339,6 +447,18 @@ func (c *IAM) CreateGroup(input *CreateGroupInput) (*CreateGroupOutput, error) {
 	return out, err
 }
 
+// CreateGroupWithContext is the same as CreateGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateGroupWithContext(ctx aws.Context, input *CreateGroupInput, opts ...aws.Option) (*CreateGroupOutput, error) {
+	req, out := c.CreateGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInstanceProfile = "CreateInstanceProfile"
 
 // CreateInstanceProfileRequest generates a request for the CreateInstanceProfile operation.
@@ -371,6 +491,18 @@ func (c *IAM) CreateInstanceProfile(input *CreateInstanceProfileInput) (*CreateI
 	return out, err
 }
 
+// CreateInstanceProfileWithContext is the same as CreateInstanceProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateInstanceProfileWithContext(ctx aws.Context, input *CreateInstanceProfileInput, opts ...aws.Option) (*CreateInstanceProfileOutput, error) {
+	req, out := c.CreateInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLoginProfile = "CreateLoginProfile"
 
 // CreateLoginProfileRequest generates a request for the CreateLoginProfile operation.
@@ -401,6 +533,18 @@ func (c *IAM) CreateLoginProfile(input *CreateLoginProfileInput) (*CreateLoginPr
 	return out, err
 }
 
+// CreateLoginProfileWithContext is the same as CreateLoginProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateLoginProfileWithContext(ctx aws.Context, input *CreateLoginProfileInput, opts ...aws.Option) (*CreateLoginProfileOutput, error) {
+	req, out := c.CreateLoginProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateOpenIDConnectProvider = "CreateOpenIDConnectProvider"
 
 // CreateOpenIDConnectProviderRequest generates a request for the CreateOpenIDConnectProvider operation.
@@ -444,6 +588,18 @@ func (c *IAM) CreateOpenIDConnectProvider(input *CreateOpenIDConnectProviderInpu
 	return out, err
 }
 
+// CreateOpenIDConnectProviderWithContext is the same as CreateOpenIDConnectProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateOpenIDConnectProviderWithContext(ctx aws.Context, input *CreateOpenIDConnectProviderInput, opts ...aws.Option) (*CreateOpenIDConnectProviderOutput, error) {
+	req, out := c.CreateOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePolicy = "CreatePolicy"
 
 // CreatePolicyRequest generates a request for the CreatePolicy operation.
@@ -480,6 +636,18 @@ func (c *IAM) CreatePolicy(input *CreatePolicyInput) (*CreatePolicyOutput, error
 	return out, err
 }
 
+// CreatePolicyWithContext is the same as CreatePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreatePolicyWithContext(ctx aws.Context, input *CreatePolicyInput, opts ...aws.Option) (*CreatePolicyOutput, error) {
+	req, out := c.CreatePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePolicyVersion = "CreatePolicyVersion"
 
 // CreatePolicyVersionRequest generates a request for the CreatePolicyVersion operation.
@@ -519,6 +687,18 @@ func (c *IAM) CreatePolicyVersion(input *CreatePolicyVersionInput) (*CreatePolic
 	return out, err
 }
 
+// CreatePolicyVersionWithContext is the same as CreatePolicyVersion with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreatePolicyVersionWithContext(ctx aws.Context, input *CreatePolicyVersionInput, opts ...aws.Option) (*CreatePolicyVersionOutput, error) {
+	req, out := c.CreatePolicyVersionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateRole = "CreateRole"
 
 // CreateRoleRequest generates a request for the CreateRole operation.
@@ -553,6 +733,18 @@ func (c *IAM) CreateRole(input *CreateRoleInput) (*CreateRoleOutput, error) {
 	return out, err
 }
 
+// CreateRoleWithContext is the same as CreateRole with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateRoleWithContext(ctx aws.Context, input *CreateRoleInput, opts ...aws.Option) (*CreateRoleOutput, error) {
+	req, out := c.CreateRoleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSAMLProvider = "CreateSAMLProvider"
 
 // CreateSAMLProviderRequest generates a request for the CreateSAMLProvider operation.
@@ -599,6 +791,18 @@ func (c *IAM) CreateSAMLProvider(input *CreateSAMLProviderInput) (*CreateSAMLPro
 	return out, err
 }
 
+// CreateSAMLProviderWithContext is the same as CreateSAMLProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateSAMLProviderWithContext(ctx aws.Context, input *CreateSAMLProviderInput, opts ...aws.Option) (*CreateSAMLProviderOutput, error) {
+	req, out := c.CreateSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateUser = "CreateUser"
 
 // CreateUserRequest generates a request for the CreateUser operation.
@@ -630,6 +834,18 @@ func (c *IAM) CreateUser(input *CreateUserInput) (*CreateUserOutput, error) {
 	return out, err
 }
 
+// CreateUserWithContext is the same as CreateUser with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateUserWithContext(ctx aws.Context, input *CreateUserInput, opts ...aws.Option) (*CreateUserOutput, error) {
+	req, out := c.CreateUserRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVirtualMFADevice = "CreateVirtualMFADevice"
 
 // CreateVirtualMFADeviceRequest generates a request for the CreateVirtualMFADevice operation.
@@ -670,6 +886,18 @@ func (c *IAM) CreateVirtualMFADevice(input *CreateVirtualMFADeviceInput) (*Creat
 	return out, err
 }
 
+// CreateVirtualMFADeviceWithContext is the same as CreateVirtualMFADevice with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) CreateVirtualMFADeviceWithContext(ctx aws.Context, input *CreateVirtualMFADeviceInput, opts ...aws.Option) (*CreateVirtualMFADeviceOutput, error) {
+	req, out := c.CreateVirtualMFADeviceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeactivateMFADevice = "DeactivateMFADevice"
 
 // DeactivateMFADeviceRequest generates a request for the DeactivateMFADevice operation.
@@ -702,6 +930,18 @@ func (c *IAM) DeactivateMFADevice(input *DeactivateMFADeviceInput) (*DeactivateM
 	return out, err
 }
 
+// DeactivateMFADeviceWithContext is the same as DeactivateMFADevice with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeactivateMFADeviceWithContext(ctx aws.Context, input *DeactivateMFADeviceInput, opts ...aws.Option) (*DeactivateMFADeviceOutput, error) {
+	req, out := c.DeactivateMFADeviceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAccessKey = "DeleteAccessKey"
 
 // DeleteAccessKeyRequest generates a request for the DeleteAccessKey operation.
@@ -734,6 +974,18 @@ func (c *IAM) DeleteAccessKey(input *DeleteAccessKeyInput) (*DeleteAccessKeyOutp
 	return out, err
 }
 
+// DeleteAccessKeyWithContext is the same as DeleteAccessKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteAccessKeyWithContext(ctx aws.Context, input *DeleteAccessKeyInput, opts ...aws.Option) (*DeleteAccessKeyOutput, error) {
+	req, out := c.DeleteAccessKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAccountAlias = "DeleteAccountAlias"
 
 // DeleteAccountAliasRequest generates a request for the DeleteAccountAlias operation.
@@ -763,6 +1015,18 @@ func (c *IAM) DeleteAccountAlias(input *DeleteAccountAliasInput) (*DeleteAccount
 	return out, err
 }
 
+// DeleteAccountAliasWithContext is the same as DeleteAccountAlias with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteAccountAliasWithContext(ctx aws.Context, input *DeleteAccountAliasInput, opts ...aws.Option) (*DeleteAccountAliasOutput, error) {
+	req, out := c.DeleteAccountAliasRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAccountPasswordPolicy = "DeleteAccountPasswordPolicy"
 
 // DeleteAccountPasswordPolicyRequest generates a request for the DeleteAccountPasswordPolicy operation.
@@ -790,6 +1054,18 @@ func (c *IAM) DeleteAccountPasswordPolicy(input *DeleteAccountPasswordPolicyInpu
 	return out, err
 }
 
+// DeleteAccountPasswordPolicyWithContext is the same as DeleteAccountPasswordPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteAccountPasswordPolicyWithContext(ctx aws.Context, input *DeleteAccountPasswordPolicyInput, opts ...aws.Option) (*DeleteAccountPasswordPolicyOutput, error) {
+	req, out := c.DeleteAccountPasswordPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteGroup = "DeleteGroup"
 
 // DeleteGroupRequest generates a request for the DeleteGroup operation.
@@ -818,6 +1094,18 @@ func (c *IAM) DeleteGroup(input *DeleteGroupInput) (*DeleteGroupOutput, error) {
 	return out, err
 }
 
+// DeleteGroupWithContext is the same as DeleteGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteGroupWithContext(ctx aws.Context, input *DeleteGroupInput, opts ...aws.Option) (*DeleteGroupOutput, error) {
+	req, out := c.DeleteGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteGroupPolicy = "DeleteGroupPolicy"
 
 // DeleteGroupPolicyRequest generates a request for the DeleteGroupPolicy operation.
@@ -850,6 +1138,18 @@ func (c *IAM) DeleteGroupPolicy(input *DeleteGroupPolicyInput) (*DeleteGroupPoli
 	return out, err
 }
 
+// DeleteGroupPolicyWithContext is the same as DeleteGroupPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteGroupPolicyWithContext(ctx aws.Context, input *DeleteGroupPolicyInput, opts ...aws.Option) (*DeleteGroupPolicyOutput, error) {
+	req, out := c.DeleteGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteInstanceProfile = "DeleteInstanceProfile"
 
 // DeleteInstanceProfileRequest generates a request for the DeleteInstanceProfile operation.
@@ -884,6 +1184,18 @@ func (c *IAM) DeleteInstanceProfile(input *DeleteInstanceProfileInput) (*DeleteI
 	return out, err
 }
 
+// DeleteInstanceProfileWithContext is the same as DeleteInstanceProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteInstanceProfileWithContext(ctx aws.Context, input *DeleteInstanceProfileInput, opts ...aws.Option) (*DeleteInstanceProfileOutput, error) {
+	req, out := c.DeleteInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLoginProfile = "DeleteLoginProfile"
 
 // DeleteLoginProfileRequest generates a request for the DeleteLoginProfile operation.
@@ -917,6 +1229,18 @@ func (c *IAM) DeleteLoginProfile(input *DeleteLoginProfileInput) (*DeleteLoginPr
 	return out, err
 }
 
+// DeleteLoginProfileWithContext is the same as DeleteLoginProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteLoginProfileWithContext(ctx aws.Context, input *DeleteLoginProfileInput, opts ...aws.Option) (*DeleteLoginProfileOutput, error) {
+	req, out := c.DeleteLoginProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteOpenIDConnectProvider = "DeleteOpenIDConnectProvider"
 
 // DeleteOpenIDConnectProviderRequest generates a request for the DeleteOpenIDConnectProvider operation.
@@ -951,6 +1275,18 @@ func (c *IAM) DeleteOpenIDConnectProvider(input *DeleteOpenIDConnectProviderInpu
 	return out, err
 }
 
+// DeleteOpenIDConnectProviderWithContext is the same as DeleteOpenIDConnectProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteOpenIDConnectProviderWithContext(ctx aws.Context, input *DeleteOpenIDConnectProviderInput, opts ...aws.Option) (*DeleteOpenIDConnectProviderOutput, error) {
+	req, out := c.DeleteOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePolicy = "DeletePolicy"
 
 // DeletePolicyRequest generates a request for the DeletePolicy operation.
@@ -995,6 +1331,18 @@ func (c *IAM) DeletePolicy(input *DeletePolicyInput) (*DeletePolicyOutput, error
 	return out, err
 }
 
+// DeletePolicyWithContext is the same as DeletePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeletePolicyWithContext(ctx aws.Context, input *DeletePolicyInput, opts ...aws.Option) (*DeletePolicyOutput, error) {
+	req, out := c.DeletePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePolicyVersion = "DeletePolicyVersion"
 
 // DeletePolicyVersionRequest generates a request for the DeletePolicyVersion operation.
@@ -1030,6 +1378,18 @@ func (c *IAM) DeletePolicyVersion(input *DeletePolicyVersionInput) (*DeletePolic
 	return out, err
 }
 
+// DeletePolicyVersionWithContext is the same as DeletePolicyVersion with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeletePolicyVersionWithContext(ctx aws.Context, input *DeletePolicyVersionInput, opts ...aws.Option) (*DeletePolicyVersionOutput, error) {
+	req, out := c.DeletePolicyVersionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRole = "DeleteRole"
 
 // DeleteRoleRequest generates a request for the DeleteRole operation.
@@ -1062,6 +1422,18 @@ func (c *IAM) DeleteRole(input *DeleteRoleInput) (*DeleteRoleOutput, error) {
 	return out, err
 }
 
+// DeleteRoleWithContext is the same as DeleteRole with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteRoleWithContext(ctx aws.Context, input *DeleteRoleInput, opts ...aws.Option) (*DeleteRoleOutput, error) {
+	req, out := c.DeleteRoleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRolePolicy = "DeleteRolePolicy"
 
 // DeleteRolePolicyRequest generates a request for the DeleteRolePolicy operation.
@@ -1094,6 +1466,18 @@ func (c *IAM) DeleteRolePolicy(input *DeleteRolePolicyInput) (*DeleteRolePolicyO
 	return out, err
 }
 
+// DeleteRolePolicyWithContext is the same as DeleteRolePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteRolePolicyWithContext(ctx aws.Context, input *DeleteRolePolicyInput, opts ...aws.Option) (*DeleteRolePolicyOutput, error) {
+	req, out := c.DeleteRolePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSAMLProvider = "DeleteSAMLProvider"
 
 // DeleteSAMLProviderRequest generates a request for the DeleteSAMLProvider operation.
@@ -1127,6 +1511,18 @@ func (c *IAM) DeleteSAMLProvider(input *DeleteSAMLProviderInput) (*DeleteSAMLPro
 	return out, err
 }
 
+// DeleteSAMLProviderWithContext is the same as DeleteSAMLProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteSAMLProviderWithContext(ctx aws.Context, input *DeleteSAMLProviderInput, opts ...aws.Option) (*DeleteSAMLProviderOutput, error) {
+	req, out := c.DeleteSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSSHPublicKey = "DeleteSSHPublicKey"
 
 // DeleteSSHPublicKeyRequest generates a request for the DeleteSSHPublicKey operation.
@@ -1160,6 +1556,18 @@ func (c *IAM) DeleteSSHPublicKey(input *DeleteSSHPublicKeyInput) (*DeleteSSHPubl
 	return out, err
 }
 
+// DeleteSSHPublicKeyWithContext is the same as DeleteSSHPublicKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteSSHPublicKeyWithContext(ctx aws.Context, input *DeleteSSHPublicKeyInput, opts ...aws.Option) (*DeleteSSHPublicKeyOutput, error) {
+	req, out := c.DeleteSSHPublicKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteServerCertificate = "DeleteServerCertificate"
 
 // DeleteServerCertificateRequest generates a request for the DeleteServerCertificate operation.
@@ -1197,6 +1605,18 @@ func (c *IAM) DeleteServerCertificate(input *DeleteServerCertificateInput) (*Del
 	return out, err
 }
 
+// DeleteServerCertificateWithContext is the same as DeleteServerCertificate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteServerCertificateWithContext(ctx aws.Context, input *DeleteServerCertificateInput, opts ...aws.Option) (*DeleteServerCertificateOutput, error) {
+	req, out := c.DeleteServerCertificateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSigningCertificate = "DeleteSigningCertificate"
 
 // DeleteSigningCertificateRequest generates a request for the DeleteSigningCertificate operation.
@@ -1229,6 +1649,18 @@ func (c *IAM) DeleteSigningCertificate(input *DeleteSigningCertificateInput) (*D
 	return out, err
 }
 
+// DeleteSigningCertificateWithContext is the same as DeleteSigningCertificate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteSigningCertificateWithContext(ctx aws.Context, input *DeleteSigningCertificateInput, opts ...aws.Option) (*DeleteSigningCertificateOutput, error) {
+	req, out := c.DeleteSigningCertificateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteUser = "DeleteUser"
 
 // DeleteUserRequest generates a request for the DeleteUser operation.
@@ -1257,6 +1689,18 @@ func (c *IAM) DeleteUser(input *DeleteUserInput) (*DeleteUserOutput, error) {
 	return out, err
 }
 
+// DeleteUserWithContext is the same as DeleteUser with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteUserWithContext(ctx aws.Context, input *DeleteUserInput, opts ...aws.Option) (*DeleteUserOutput, error) {
+	req, out := c.DeleteUserRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteUserPolicy = "DeleteUserPolicy"
 
 // DeleteUserPolicyRequest generates a request for the DeleteUserPolicy operation.
@@ -1289,6 +1733,18 @@ func (c *IAM) DeleteUserPolicy(input *DeleteUserPolicyInput) (*DeleteUserPolicyO
 	return out, err
 }
 
+// DeleteUserPolicyWithContext is the same as DeleteUserPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteUserPolicyWithContext(ctx aws.Context, input *DeleteUserPolicyInput, opts ...aws.Option) (*DeleteUserPolicyOutput, error) {
+	req, out := c.DeleteUserPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVirtualMFADevice = "DeleteVirtualMFADevice"
 
 // DeleteVirtualMFADeviceRequest generates a request for the DeleteVirtualMFADevice operation.
@@ -1319,6 +1775,18 @@ func (c *IAM) DeleteVirtualMFADevice(input *DeleteVirtualMFADeviceInput) (*Delet
 	return out, err
 }
 
+// DeleteVirtualMFADeviceWithContext is the same as DeleteVirtualMFADevice with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DeleteVirtualMFADeviceWithContext(ctx aws.Context, input *DeleteVirtualMFADeviceInput, opts ...aws.Option) (*DeleteVirtualMFADeviceOutput, error) {
+	req, out := c.DeleteVirtualMFADeviceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachGroupPolicy = "DetachGroupPolicy"
 
 // DetachGroupPolicyRequest generates a request for the DetachGroupPolicy operation.
@@ -1351,6 +1819,18 @@ func (c *IAM) DetachGroupPolicy(input *DetachGroupPolicyInput) (*DetachGroupPoli
 	return out, err
 }
 
+// DetachGroupPolicyWithContext is the same as DetachGroupPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DetachGroupPolicyWithContext(ctx aws.Context, input *DetachGroupPolicyInput, opts ...aws.Option) (*DetachGroupPolicyOutput, error) {
+	req, out := c.DetachGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachRolePolicy = "DetachRolePolicy"
 
 // DetachRolePolicyRequest generates a request for the DetachRolePolicy operation.
@@ -1383,6 +1863,18 @@ func (c *IAM) DetachRolePolicy(input *DetachRolePolicyInput) (*DetachRolePolicyO
 	return out, err
 }
 
+// DetachRolePolicyWithContext is the same as DetachRolePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DetachRolePolicyWithContext(ctx aws.Context, input *DetachRolePolicyInput, opts ...aws.Option) (*DetachRolePolicyOutput, error) {
+	req, out := c.DetachRolePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachUserPolicy = "DetachUserPolicy"
 
 // DetachUserPolicyRequest generates a request for the DetachUserPolicy operation.
@@ -1415,6 +1907,18 @@ func (c *IAM) DetachUserPolicy(input *DetachUserPolicyInput) (*DetachUserPolicyO
 	return out, err
 }
 
+// DetachUserPolicyWithContext is the same as DetachUserPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) DetachUserPolicyWithContext(ctx aws.Context, input *DetachUserPolicyInput, opts ...aws.Option) (*DetachUserPolicyOutput, error) {
+	req, out := c.DetachUserPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableMFADevice = "EnableMFADevice"
 
 // EnableMFADeviceRequest generates a request for the EnableMFADevice operation.
@@ -1444,6 +1948,18 @@ func (c *IAM) EnableMFADevice(input *EnableMFADeviceInput) (*EnableMFADeviceOutp
 	return out, err
 }
 
+// EnableMFADeviceWithContext is the same as EnableMFADevice with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) EnableMFADeviceWithContext(ctx aws.Context, input *EnableMFADeviceInput, opts ...aws.Option) (*EnableMFADeviceOutput, error) {
+	req, out := c.EnableMFADeviceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateCredentialReport = "GenerateCredentialReport"
 
 // GenerateCredentialReportRequest generates a request for the GenerateCredentialReport operation.
@@ -1473,6 +1989,18 @@ func (c *IAM) GenerateCredentialReport(input *GenerateCredentialReportInput) (*G
 	return out, err
 }
 
+// GenerateCredentialReportWithContext is the same as GenerateCredentialReport with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GenerateCredentialReportWithContext(ctx aws.Context, input *GenerateCredentialReportInput, opts ...aws.Option) (*GenerateCredentialReportOutput, error) {
+	req, out := c.GenerateCredentialReportRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetAccessKeyLastUsed = "GetAccessKeyLastUsed"
 
 // GetAccessKeyLastUsedRequest generates a request for the GetAccessKeyLastUsed operation.
@@ -1503,6 +2031,18 @@ func (c *IAM) GetAccessKeyLastUsed(input *GetAccessKeyLastUsedInput) (*GetAccess
 	return out, err
 }
 
+// GetAccessKeyLastUsedWithContext is the same as GetAccessKeyLastUsed with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetAccessKeyLastUsedWithContext(ctx aws.Context, input *GetAccessKeyLastUsedInput, opts ...aws.Option) (*GetAccessKeyLastUsedOutput, error) {
+	req, out := c.GetAccessKeyLastUsedRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetAccountAuthorizationDetails = "GetAccountAuthorizationDetails"
 
 // GetAccountAuthorizationDetailsRequest generates a request for the GetAccountAuthorizationDetails operation.
@@ -1542,6 +2082,22 @@ func (c *IAM) GetAccountAuthorizationDetails(input *GetAccountAuthorizationDetai
 	return out, err
 }
 
+// GetAccountAuthorizationDetailsWithContext is the same as GetAccountAuthorizationDetails with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetAccountAuthorizationDetailsWithContext(ctx aws.Context, input *GetAccountAuthorizationDetailsInput, opts ...aws.Option) (*GetAccountAuthorizationDetailsOutput, error) {
+	req, out := c.GetAccountAuthorizationDetailsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// GetAccountAuthorizationDetailsPages iterates over the pages of a GetAccountAuthorizationDetails operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) GetAccountAuthorizationDetailsPages(input *GetAccountAuthorizationDetailsInput, fn func(p *GetAccountAuthorizationDetailsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetAccountAuthorizationDetailsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1549,6 +2105,19 @@ func (c *IAM) GetAccountAuthorizationDetailsPages(input *GetAccountAuthorization
 	})
 }
 
+// GetAccountAuthorizationDetailsPagesWithContext is the same as GetAccountAuthorizationDetailsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) GetAccountAuthorizationDetailsPagesWithContext(ctx aws.Context, input *GetAccountAuthorizationDetailsInput, fn func(p *GetAccountAuthorizationDetailsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.GetAccountAuthorizationDetailsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetAccountAuthorizationDetailsOutput), lastPage)
+	})
+}
+
 const opGetAccountPasswordPolicy = "GetAccountPasswordPolicy"
 
 // GetAccountPasswordPolicyRequest generates a request for the GetAccountPasswordPolicy operation.
@@ -1577,6 +2146,18 @@ func (c *IAM) GetAccountPasswordPolicy(input *GetAccountPasswordPolicyInput) (*G
 	return out, err
 }
 
+// GetAccountPasswordPolicyWithContext is the same as GetAccountPasswordPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetAccountPasswordPolicyWithContext(ctx aws.Context, input *GetAccountPasswordPolicyInput, opts ...aws.Option) (*GetAccountPasswordPolicyOutput, error) {
+	req, out := c.GetAccountPasswordPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetAccountSummary = "GetAccountSummary"
 
 // GetAccountSummaryRequest generates a request for the GetAccountSummary operation.
@@ -1608,6 +2189,18 @@ func (c *IAM) GetAccountSummary(input *GetAccountSummaryInput) (*GetAccountSumma
 	return out, err
 }
 
+// GetAccountSummaryWithContext is the same as GetAccountSummary with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetAccountSummaryWithContext(ctx aws.Context, input *GetAccountSummaryInput, opts ...aws.Option) (*GetAccountSummaryOutput, error) {
+	req, out := c.GetAccountSummaryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCredentialReport = "GetCredentialReport"
 
 // GetCredentialReportRequest generates a request for the GetCredentialReport operation.
@@ -1637,6 +2230,18 @@ func (c *IAM) GetCredentialReport(input *GetCredentialReportInput) (*GetCredenti
 	return out, err
 }
 
+// GetCredentialReportWithContext is the same as GetCredentialReport with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetCredentialReportWithContext(ctx aws.Context, input *GetCredentialReportInput, opts ...aws.Option) (*GetCredentialReportOutput, error) {
+	req, out := c.GetCredentialReportRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetGroup = "GetGroup"
 
 // GetGroupRequest generates a request for the GetGroup operation.
@@ -1650,6 +2255,7 @@ func (c *IAM) GetGroupRequest(input *GetGroupInput) (req *aws.Request, output *G
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"Users"},
 		},
 	}
 
@@ -1671,6 +2277,22 @@ func (c *IAM) GetGroup(input *GetGroupInput) (*GetGroupOutput, error) {
 	return out, err
 }
 
+// GetGroupWithContext is the same as GetGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetGroupWithContext(ctx aws.Context, input *GetGroupInput, opts ...aws.Option) (*GetGroupOutput, error) {
+	req, out := c.GetGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// GetGroupPages iterates over the pages of a GetGroup operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) GetGroupPages(input *GetGroupInput, fn func(p *GetGroupOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetGroupRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1678,6 +2300,19 @@ func (c *IAM) GetGroupPages(input *GetGroupInput, fn func(p *GetGroupOutput, las
 	})
 }
 
+// GetGroupPagesWithContext is the same as GetGroupPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) GetGroupPagesWithContext(ctx aws.Context, input *GetGroupInput, fn func(p *GetGroupOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.GetGroupRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetGroupOutput), lastPage)
+	})
+}
+
 const opGetGroupPolicy = "GetGroupPolicy"
 
 // GetGroupPolicyRequest generates a request for the GetGroupPolicy operation.
@@ -1715,6 +2350,18 @@ func (c *IAM) GetGroupPolicy(input *GetGroupPolicyInput) (*GetGroupPolicyOutput,
 	return out, err
 }
 
+// GetGroupPolicyWithContext is the same as GetGroupPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetGroupPolicyWithContext(ctx aws.Context, input *GetGroupPolicyInput, opts ...aws.Option) (*GetGroupPolicyOutput, error) {
+	req, out := c.GetGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetInstanceProfile = "GetInstanceProfile"
 
 // GetInstanceProfileRequest generates a request for the GetInstanceProfile operation.
@@ -1745,6 +2392,18 @@ func (c *IAM) GetInstanceProfile(input *GetInstanceProfileInput) (*GetInstancePr
 	return out, err
 }
 
+// GetInstanceProfileWithContext is the same as GetInstanceProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetInstanceProfileWithContext(ctx aws.Context, input *GetInstanceProfileInput, opts ...aws.Option) (*GetInstanceProfileOutput, error) {
+	req, out := c.GetInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetLoginProfile = "GetLoginProfile"
 
 // GetLoginProfileRequest generates a request for the GetLoginProfile operation.
@@ -1774,6 +2433,18 @@ func (c *IAM) GetLoginProfile(input *GetLoginProfileInput) (*GetLoginProfileOutp
 	return out, err
 }
 
+// GetLoginProfileWithContext is the same as GetLoginProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetLoginProfileWithContext(ctx aws.Context, input *GetLoginProfileInput, opts ...aws.Option) (*GetLoginProfileOutput, error) {
+	req, out := c.GetLoginProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOpenIDConnectProvider = "GetOpenIDConnectProvider"
 
 // GetOpenIDConnectProviderRequest generates a request for the GetOpenIDConnectProvider operation.
@@ -1801,6 +2472,18 @@ func (c *IAM) GetOpenIDConnectProvider(input *GetOpenIDConnectProviderInput) (*G
 	return out, err
 }
 
+// GetOpenIDConnectProviderWithContext is the same as GetOpenIDConnectProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetOpenIDConnectProviderWithContext(ctx aws.Context, input *GetOpenIDConnectProviderInput, opts ...aws.Option) (*GetOpenIDConnectProviderOutput, error) {
+	req, out := c.GetOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPolicy = "GetPolicy"
 
 // GetPolicyRequest generates a request for the GetPolicy operation.
@@ -1841,6 +2524,18 @@ func (c *IAM) GetPolicy(input *GetPolicyInput) (*GetPolicyOutput, error) {
 	return out, err
 }
 
+// GetPolicyWithContext is the same as GetPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetPolicyWithContext(ctx aws.Context, input *GetPolicyInput, opts ...aws.Option) (*GetPolicyOutput, error) {
+	req, out := c.GetPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPolicyVersion = "GetPolicyVersion"
 
 // GetPolicyVersionRequest generates a request for the GetPolicyVersion operation.
@@ -1879,6 +2574,18 @@ func (c *IAM) GetPolicyVersion(input *GetPolicyVersionInput) (*GetPolicyVersionO
 	return out, err
 }
 
+// GetPolicyVersionWithContext is the same as GetPolicyVersion with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetPolicyVersionWithContext(ctx aws.Context, input *GetPolicyVersionInput, opts ...aws.Option) (*GetPolicyVersionOutput, error) {
+	req, out := c.GetPolicyVersionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetRole = "GetRole"
 
 // GetRoleRequest generates a request for the GetRole operation.
@@ -1909,6 +2616,18 @@ func (c *IAM) GetRole(input *GetRoleInput) (*GetRoleOutput, error) {
 	return out, err
 }
 
+// GetRoleWithContext is the same as GetRole with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetRoleWithContext(ctx aws.Context, input *GetRoleInput, opts ...aws.Option) (*GetRoleOutput, error) {
+	req, out := c.GetRoleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetRolePolicy = "GetRolePolicy"
 
 // GetRolePolicyRequest generates a request for the GetRolePolicy operation.
@@ -1949,6 +2668,18 @@ func (c *IAM) GetRolePolicy(input *GetRolePolicyInput) (*GetRolePolicyOutput, er
 	return out, err
 }
 
+// GetRolePolicyWithContext is the same as GetRolePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetRolePolicyWithContext(ctx aws.Context, input *GetRolePolicyInput, opts ...aws.Option) (*GetRolePolicyOutput, error) {
+	req, out := c.GetRolePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSAMLProvider = "GetSAMLProvider"
 
 // GetSAMLProviderRequest generates a request for the GetSAMLProvider operation.
@@ -1979,6 +2710,18 @@ func (c *IAM) GetSAMLProvider(input *GetSAMLProviderInput) (*GetSAMLProviderOutp
 	return out, err
 }
 
+// GetSAMLProviderWithContext is the same as GetSAMLProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetSAMLProviderWithContext(ctx aws.Context, input *GetSAMLProviderInput, opts ...aws.Option) (*GetSAMLProviderOutput, error) {
+	req, out := c.GetSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSSHPublicKey = "GetSSHPublicKey"
 
 // GetSSHPublicKeyRequest generates a request for the GetSSHPublicKey operation.
@@ -2012,6 +2755,18 @@ func (c *IAM) GetSSHPublicKey(input *GetSSHPublicKeyInput) (*GetSSHPublicKeyOutp
 	return out, err
 }
 
+// GetSSHPublicKeyWithContext is the same as GetSSHPublicKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetSSHPublicKeyWithContext(ctx aws.Context, input *GetSSHPublicKeyInput, opts ...aws.Option) (*GetSSHPublicKeyOutput, error) {
+	req, out := c.GetSSHPublicKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetServerCertificate = "GetServerCertificate"
 
 // GetServerCertificateRequest generates a request for the GetServerCertificate operation.
@@ -2039,6 +2794,18 @@ func (c *IAM) GetServerCertificate(input *GetServerCertificateInput) (*GetServer
 	return out, err
 }
 
+// GetServerCertificateWithContext is the same as GetServerCertificate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetServerCertificateWithContext(ctx aws.Context, input *GetServerCertificateInput, opts ...aws.Option) (*GetServerCertificateOutput, error) {
+	req, out := c.GetServerCertificateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetUser = "GetUser"
 
 // GetUserRequest generates a request for the GetUser operation.
@@ -2070,6 +2837,18 @@ func (c *IAM) GetUser(input *GetUserInput) (*GetUserOutput, error) {
 	return out, err
 }
 
+// GetUserWithContext is the same as GetUser with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetUserWithContext(ctx aws.Context, input *GetUserInput, opts ...aws.Option) (*GetUserOutput, error) {
+	req, out := c.GetUserRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetUserPolicy = "GetUserPolicy"
 
 // GetUserPolicyRequest generates a request for the GetUserPolicy operation.
@@ -2107,6 +2886,18 @@ func (c *IAM) GetUserPolicy(input *GetUserPolicyInput) (*GetUserPolicyOutput, er
 	return out, err
 }
 
+// GetUserPolicyWithContext is the same as GetUserPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) GetUserPolicyWithContext(ctx aws.Context, input *GetUserPolicyInput, opts ...aws.Option) (*GetUserPolicyOutput, error) {
+	req, out := c.GetUserPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListAccessKeys = "ListAccessKeys"
 
 // ListAccessKeysRequest generates a request for the ListAccessKeys operation.
@@ -2120,6 +2911,7 @@ func (c *IAM) ListAccessKeysRequest(input *ListAccessKeysInput) (req *aws.Reques
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"AccessKeyMetadata"},
 		},
 	}
 
@@ -2152,6 +2944,22 @@ func (c *IAM) ListAccessKeys(input *ListAccessKeysInput) (*ListAccessKeysOutput,
 	return out, err
 }
 
+// ListAccessKeysWithContext is the same as ListAccessKeys with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListAccessKeysWithContext(ctx aws.Context, input *ListAccessKeysInput, opts ...aws.Option) (*ListAccessKeysOutput, error) {
+	req, out := c.ListAccessKeysRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListAccessKeysPages iterates over the pages of a ListAccessKeys operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListAccessKeysPages(input *ListAccessKeysInput, fn func(p *ListAccessKeysOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAccessKeysRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2159,6 +2967,19 @@ func (c *IAM) ListAccessKeysPages(input *ListAccessKeysInput, fn func(p *ListAcc
 	})
 }
 
+// ListAccessKeysPagesWithContext is the same as ListAccessKeysPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListAccessKeysPagesWithContext(ctx aws.Context, input *ListAccessKeysInput, fn func(p *ListAccessKeysOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListAccessKeysRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAccessKeysOutput), lastPage)
+	})
+}
+
 const opListAccountAliases = "ListAccountAliases"
 
 // ListAccountAliasesRequest generates a request for the ListAccountAliases operation.
@@ -2172,6 +2993,7 @@ func (c *IAM) ListAccountAliasesRequest(input *ListAccountAliasesInput) (req *aw
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"AccountAliases"},
 		},
 	}
 
@@ -2196,6 +3018,22 @@ func (c *IAM) ListAccountAliases(input *ListAccountAliasesInput) (*ListAccountAl
 	return out, err
 }
 
+// ListAccountAliasesWithContext is the same as ListAccountAliases with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListAccountAliasesWithContext(ctx aws.Context, input *ListAccountAliasesInput, opts ...aws.Option) (*ListAccountAliasesOutput, error) {
+	req, out := c.ListAccountAliasesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListAccountAliasesPages iterates over the pages of a ListAccountAliases operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListAccountAliasesPages(input *ListAccountAliasesInput, fn func(p *ListAccountAliasesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAccountAliasesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2203,6 +3041,19 @@ func (c *IAM) ListAccountAliasesPages(input *ListAccountAliasesInput, fn func(p
 	})
 }
 
+// ListAccountAliasesPagesWithContext is the same as ListAccountAliasesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListAccountAliasesPagesWithContext(ctx aws.Context, input *ListAccountAliasesInput, fn func(p *ListAccountAliasesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListAccountAliasesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAccountAliasesOutput), lastPage)
+	})
+}
+
 const opListAttachedGroupPolicies = "ListAttachedGroupPolicies"
 
 // ListAttachedGroupPoliciesRequest generates a request for the ListAttachedGroupPolicies operation.
@@ -2216,6 +3067,7 @@ func (c *IAM) ListAttachedGroupPoliciesRequest(input *ListAttachedGroupPoliciesI
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"AttachedPolicies"},
 		},
 	}
 
@@ -2247,6 +3099,22 @@ func (c *IAM) ListAttachedGroupPolicies(input *ListAttachedGroupPoliciesInput) (
 	return out, err
 }
 
+// ListAttachedGroupPoliciesWithContext is the same as ListAttachedGroupPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListAttachedGroupPoliciesWithContext(ctx aws.Context, input *ListAttachedGroupPoliciesInput, opts ...aws.Option) (*ListAttachedGroupPoliciesOutput, error) {
+	req, out := c.ListAttachedGroupPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListAttachedGroupPoliciesPages iterates over the pages of a ListAttachedGroupPolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListAttachedGroupPoliciesPages(input *ListAttachedGroupPoliciesInput, fn func(p *ListAttachedGroupPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAttachedGroupPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2254,6 +3122,19 @@ func (c *IAM) ListAttachedGroupPoliciesPages(input *ListAttachedGroupPoliciesInp
 	})
 }
 
+// ListAttachedGroupPoliciesPagesWithContext is the same as ListAttachedGroupPoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListAttachedGroupPoliciesPagesWithContext(ctx aws.Context, input *ListAttachedGroupPoliciesInput, fn func(p *ListAttachedGroupPoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListAttachedGroupPoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAttachedGroupPoliciesOutput), lastPage)
+	})
+}
+
 const opListAttachedRolePolicies = "ListAttachedRolePolicies"
 
 // ListAttachedRolePoliciesRequest generates a request for the ListAttachedRolePolicies operation.
@@ -2267,6 +3148,7 @@ func (c *IAM) ListAttachedRolePoliciesRequest(input *ListAttachedRolePoliciesInp
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"AttachedPolicies"},
 		},
 	}
 
@@ -2298,6 +3180,22 @@ func (c *IAM) ListAttachedRolePolicies(input *ListAttachedRolePoliciesInput) (*L
 	return out, err
 }
 
+// ListAttachedRolePoliciesWithContext is the same as ListAttachedRolePolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListAttachedRolePoliciesWithContext(ctx aws.Context, input *ListAttachedRolePoliciesInput, opts ...aws.Option) (*ListAttachedRolePoliciesOutput, error) {
+	req, out := c.ListAttachedRolePoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListAttachedRolePoliciesPages iterates over the pages of a ListAttachedRolePolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListAttachedRolePoliciesPages(input *ListAttachedRolePoliciesInput, fn func(p *ListAttachedRolePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAttachedRolePoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2305,6 +3203,19 @@ func (c *IAM) ListAttachedRolePoliciesPages(input *ListAttachedRolePoliciesInput
 	})
 }
 
+// ListAttachedRolePoliciesPagesWithContext is the same as ListAttachedRolePoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListAttachedRolePoliciesPagesWithContext(ctx aws.Context, input *ListAttachedRolePoliciesInput, fn func(p *ListAttachedRolePoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListAttachedRolePoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAttachedRolePoliciesOutput), lastPage)
+	})
+}
+
 const opListAttachedUserPolicies = "ListAttachedUserPolicies"
 
 // ListAttachedUserPoliciesRequest generates a request for the ListAttachedUserPolicies operation.
@@ -2318,6 +3229,7 @@ func (c *IAM) ListAttachedUserPoliciesRequest(input *ListAttachedUserPoliciesInp
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"AttachedPolicies"},
 		},
 	}
 
@@ -2349,6 +3261,22 @@ func (c *IAM) ListAttachedUserPolicies(input *ListAttachedUserPoliciesInput) (*L
 	return out, err
 }
 
+// ListAttachedUserPoliciesWithContext is the same as ListAttachedUserPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListAttachedUserPoliciesWithContext(ctx aws.Context, input *ListAttachedUserPoliciesInput, opts ...aws.Option) (*ListAttachedUserPoliciesOutput, error) {
+	req, out := c.ListAttachedUserPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListAttachedUserPoliciesPages iterates over the pages of a ListAttachedUserPolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListAttachedUserPoliciesPages(input *ListAttachedUserPoliciesInput, fn func(p *ListAttachedUserPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAttachedUserPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2356,6 +3284,19 @@ func (c *IAM) ListAttachedUserPoliciesPages(input *ListAttachedUserPoliciesInput
 	})
 }
 
+// ListAttachedUserPoliciesPagesWithContext is the same as ListAttachedUserPoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListAttachedUserPoliciesPagesWithContext(ctx aws.Context, input *ListAttachedUserPoliciesInput, fn func(p *ListAttachedUserPoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListAttachedUserPoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAttachedUserPoliciesOutput), lastPage)
+	})
+}
+
 const opListEntitiesForPolicy = "ListEntitiesForPolicy"
 
 // ListEntitiesForPolicyRequest generates a request for the ListEntitiesForPolicy operation.
@@ -2369,6 +3310,7 @@ func (c *IAM) ListEntitiesForPolicyRequest(input *ListEntitiesForPolicyInput) (r
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"PolicyGroups", "PolicyUsers", "PolicyRoles"},
 		},
 	}
 
@@ -2397,6 +3339,22 @@ func (c *IAM) ListEntitiesForPolicy(input *ListEntitiesForPolicyInput) (*ListEnt
 	return out, err
 }
 
+// ListEntitiesForPolicyWithContext is the same as ListEntitiesForPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListEntitiesForPolicyWithContext(ctx aws.Context, input *ListEntitiesForPolicyInput, opts ...aws.Option) (*ListEntitiesForPolicyOutput, error) {
+	req, out := c.ListEntitiesForPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListEntitiesForPolicyPages iterates over the pages of a ListEntitiesForPolicy operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListEntitiesForPolicyPages(input *ListEntitiesForPolicyInput, fn func(p *ListEntitiesForPolicyOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListEntitiesForPolicyRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2404,6 +3362,19 @@ func (c *IAM) ListEntitiesForPolicyPages(input *ListEntitiesForPolicyInput, fn f
 	})
 }
 
+// ListEntitiesForPolicyPagesWithContext is the same as ListEntitiesForPolicyPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListEntitiesForPolicyPagesWithContext(ctx aws.Context, input *ListEntitiesForPolicyInput, fn func(p *ListEntitiesForPolicyOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListEntitiesForPolicyRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListEntitiesForPolicyOutput), lastPage)
+	})
+}
+
 const opListGroupPolicies = "ListGroupPolicies"
 
 // ListGroupPoliciesRequest generates a request for the ListGroupPolicies operation.
@@ -2417,6 +3388,7 @@ func (c *IAM) ListGroupPoliciesRequest(input *ListGroupPoliciesInput) (req *aws.
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"PolicyNames"},
 		},
 	}
 
@@ -2448,6 +3420,22 @@ func (c *IAM) ListGroupPolicies(input *ListGroupPoliciesInput) (*ListGroupPolici
 	return out, err
 }
 
+// ListGroupPoliciesWithContext is the same as ListGroupPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListGroupPoliciesWithContext(ctx aws.Context, input *ListGroupPoliciesInput, opts ...aws.Option) (*ListGroupPoliciesOutput, error) {
+	req, out := c.ListGroupPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListGroupPoliciesPages iterates over the pages of a ListGroupPolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListGroupPoliciesPages(input *ListGroupPoliciesInput, fn func(p *ListGroupPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGroupPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2455,6 +3443,19 @@ func (c *IAM) ListGroupPoliciesPages(input *ListGroupPoliciesInput, fn func(p *L
 	})
 }
 
+// ListGroupPoliciesPagesWithContext is the same as ListGroupPoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListGroupPoliciesPagesWithContext(ctx aws.Context, input *ListGroupPoliciesInput, fn func(p *ListGroupPoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListGroupPoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupPoliciesOutput), lastPage)
+	})
+}
+
 const opListGroups = "ListGroups"
 
 // ListGroupsRequest generates a request for the ListGroups operation.
@@ -2468,6 +3469,7 @@ func (c *IAM) ListGroupsRequest(input *ListGroupsInput) (req *aws.Request, outpu
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"Groups"},
 		},
 	}
 
@@ -2490,6 +3492,22 @@ func (c *IAM) ListGroups(input *ListGroupsInput) (*ListGroupsOutput, error) {
 	return out, err
 }
 
+// ListGroupsWithContext is the same as ListGroups with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListGroupsWithContext(ctx aws.Context, input *ListGroupsInput, opts ...aws.Option) (*ListGroupsOutput, error) {
+	req, out := c.ListGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListGroupsPages iterates over the pages of a ListGroups operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListGroupsPages(input *ListGroupsInput, fn func(p *ListGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2497,6 +3515,19 @@ func (c *IAM) ListGroupsPages(input *ListGroupsInput, fn func(p *ListGroupsOutpu
 	})
 }
 
+// ListGroupsPagesWithContext is the same as ListGroupsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListGroupsPagesWithContext(ctx aws.Context, input *ListGroupsInput, fn func(p *ListGroupsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListGroupsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupsOutput), lastPage)
+	})
+}
+
 const opListGroupsForUser = "ListGroupsForUser"
 
 // ListGroupsForUserRequest generates a request for the ListGroupsForUser operation.
@@ -2510,6 +3541,7 @@ func (c *IAM) ListGroupsForUserRequest(input *ListGroupsForUserInput) (req *aws.
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"Groups"},
 		},
 	}
 
@@ -2532,6 +3564,22 @@ func (c *IAM) ListGroupsForUser(input *ListGroupsForUserInput) (*ListGroupsForUs
 	return out, err
 }
 
+// ListGroupsForUserWithContext is the same as ListGroupsForUser with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListGroupsForUserWithContext(ctx aws.Context, input *ListGroupsForUserInput, opts ...aws.Option) (*ListGroupsForUserOutput, error) {
+	req, out := c.ListGroupsForUserRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListGroupsForUserPages iterates over the pages of a ListGroupsForUser operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListGroupsForUserPages(input *ListGroupsForUserInput, fn func(p *ListGroupsForUserOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGroupsForUserRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2539,6 +3587,19 @@ func (c *IAM) ListGroupsForUserPages(input *ListGroupsForUserInput, fn func(p *L
 	})
 }
 
+// ListGroupsForUserPagesWithContext is the same as ListGroupsForUserPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListGroupsForUserPagesWithContext(ctx aws.Context, input *ListGroupsForUserInput, fn func(p *ListGroupsForUserOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListGroupsForUserRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupsForUserOutput), lastPage)
+	})
+}
+
 const opListInstanceProfiles = "ListInstanceProfiles"
 
 // ListInstanceProfilesRequest generates a request for the ListInstanceProfiles operation.
@@ -2552,6 +3613,7 @@ func (c *IAM) ListInstanceProfilesRequest(input *ListInstanceProfilesInput) (req
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"InstanceProfiles"},
 		},
 	}
 
@@ -2576,6 +3638,22 @@ func (c *IAM) ListInstanceProfiles(input *ListInstanceProfilesInput) (*ListInsta
 	return out, err
 }
 
+// ListInstanceProfilesWithContext is the same as ListInstanceProfiles with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListInstanceProfilesWithContext(ctx aws.Context, input *ListInstanceProfilesInput, opts ...aws.Option) (*ListInstanceProfilesOutput, error) {
+	req, out := c.ListInstanceProfilesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListInstanceProfilesPages iterates over the pages of a ListInstanceProfiles operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListInstanceProfilesPages(input *ListInstanceProfilesInput, fn func(p *ListInstanceProfilesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstanceProfilesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2583,6 +3661,19 @@ func (c *IAM) ListInstanceProfilesPages(input *ListInstanceProfilesInput, fn fun
 	})
 }
 
+// ListInstanceProfilesPagesWithContext is the same as ListInstanceProfilesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListInstanceProfilesPagesWithContext(ctx aws.Context, input *ListInstanceProfilesInput, fn func(p *ListInstanceProfilesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListInstanceProfilesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceProfilesOutput), lastPage)
+	})
+}
+
 const opListInstanceProfilesForRole = "ListInstanceProfilesForRole"
 
 // ListInstanceProfilesForRoleRequest generates a request for the ListInstanceProfilesForRole operation.
@@ -2596,6 +3687,7 @@ func (c *IAM) ListInstanceProfilesForRoleRequest(input *ListInstanceProfilesForR
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"InstanceProfiles"},
 		},
 	}
 
@@ -2620,6 +3712,22 @@ func (c *IAM) ListInstanceProfilesForRole(input *ListInstanceProfilesForRoleInpu
 	return out, err
 }
 
+// ListInstanceProfilesForRoleWithContext is the same as ListInstanceProfilesForRole with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListInstanceProfilesForRoleWithContext(ctx aws.Context, input *ListInstanceProfilesForRoleInput, opts ...aws.Option) (*ListInstanceProfilesForRoleOutput, error) {
+	req, out := c.ListInstanceProfilesForRoleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListInstanceProfilesForRolePages iterates over the pages of a ListInstanceProfilesForRole operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListInstanceProfilesForRolePages(input *ListInstanceProfilesForRoleInput, fn func(p *ListInstanceProfilesForRoleOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstanceProfilesForRoleRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2627,6 +3735,19 @@ func (c *IAM) ListInstanceProfilesForRolePages(input *ListInstanceProfilesForRol
 	})
 }
 
+// ListInstanceProfilesForRolePagesWithContext is the same as ListInstanceProfilesForRolePages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListInstanceProfilesForRolePagesWithContext(ctx aws.Context, input *ListInstanceProfilesForRoleInput, fn func(p *ListInstanceProfilesForRoleOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListInstanceProfilesForRoleRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceProfilesForRoleOutput), lastPage)
+	})
+}
+
 const opListMFADevices = "ListMFADevices"
 
 // ListMFADevicesRequest generates a request for the ListMFADevices operation.
@@ -2640,6 +3761,7 @@ func (c *IAM) ListMFADevicesRequest(input *ListMFADevicesInput) (req *aws.Reques
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"MFADevices"},
 		},
 	}
 
@@ -2665,6 +3787,22 @@ func (c *IAM) ListMFADevices(input *ListMFADevicesInput) (*ListMFADevicesOutput,
 	return out, err
 }
 
+// ListMFADevicesWithContext is the same as ListMFADevices with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListMFADevicesWithContext(ctx aws.Context, input *ListMFADevicesInput, opts ...aws.Option) (*ListMFADevicesOutput, error) {
+	req, out := c.ListMFADevicesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListMFADevicesPages iterates over the pages of a ListMFADevices operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListMFADevicesPages(input *ListMFADevicesInput, fn func(p *ListMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListMFADevicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2672,6 +3810,19 @@ func (c *IAM) ListMFADevicesPages(input *ListMFADevicesInput, fn func(p *ListMFA
 	})
 }
 
+// ListMFADevicesPagesWithContext is the same as ListMFADevicesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListMFADevicesPagesWithContext(ctx aws.Context, input *ListMFADevicesInput, fn func(p *ListMFADevicesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListMFADevicesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMFADevicesOutput), lastPage)
+	})
+}
+
 const opListOpenIDConnectProviders = "ListOpenIDConnectProviders"
 
 // ListOpenIDConnectProvidersRequest generates a request for the ListOpenIDConnectProviders operation.
@@ -2699,6 +3850,18 @@ func (c *IAM) ListOpenIDConnectProviders(input *ListOpenIDConnectProvidersInput)
 	return out, err
 }
 
+// ListOpenIDConnectProvidersWithContext is the same as ListOpenIDConnectProviders with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListOpenIDConnectProvidersWithContext(ctx aws.Context, input *ListOpenIDConnectProvidersInput, opts ...aws.Option) (*ListOpenIDConnectProvidersOutput, error) {
+	req, out := c.ListOpenIDConnectProvidersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListPolicies = "ListPolicies"
 
 // ListPoliciesRequest generates a request for the ListPolicies operation.
@@ -2712,6 +3875,7 @@ func (c *IAM) ListPoliciesRequest(input *ListPoliciesInput) (req *aws.Request, o
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"Policies"},
 		},
 	}
 
@@ -2744,6 +3908,22 @@ func (c *IAM) ListPolicies(input *ListPoliciesInput) (*ListPoliciesOutput, error
 	return out, err
 }
 
+// ListPoliciesWithContext is the same as ListPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListPoliciesWithContext(ctx aws.Context, input *ListPoliciesInput, opts ...aws.Option) (*ListPoliciesOutput, error) {
+	req, out := c.ListPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListPoliciesPages iterates over the pages of a ListPolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListPoliciesPages(input *ListPoliciesInput, fn func(p *ListPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2751,6 +3931,19 @@ func (c *IAM) ListPoliciesPages(input *ListPoliciesInput, fn func(p *ListPolicie
 	})
 }
 
+// ListPoliciesPagesWithContext is the same as ListPoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListPoliciesPagesWithContext(ctx aws.Context, input *ListPoliciesInput, fn func(p *ListPoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListPoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPoliciesOutput), lastPage)
+	})
+}
+
 const opListPolicyVersions = "ListPolicyVersions"
 
 // ListPolicyVersionsRequest generates a request for the ListPolicyVersions operation.
@@ -2783,6 +3976,18 @@ func (c *IAM) ListPolicyVersions(input *ListPolicyVersionsInput) (*ListPolicyVer
 	return out, err
 }
 
+// ListPolicyVersionsWithContext is the same as ListPolicyVersions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListPolicyVersionsWithContext(ctx aws.Context, input *ListPolicyVersionsInput, opts ...aws.Option) (*ListPolicyVersionsOutput, error) {
+	req, out := c.ListPolicyVersionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListRolePolicies = "ListRolePolicies"
 
 // ListRolePoliciesRequest generates a request for the ListRolePolicies operation.
@@ -2796,6 +4001,7 @@ func (c *IAM) ListRolePoliciesRequest(input *ListRolePoliciesInput) (req *aws.Re
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"PolicyNames"},
 		},
 	}
 
@@ -2827,6 +4033,22 @@ func (c *IAM) ListRolePolicies(input *ListRolePoliciesInput) (*ListRolePoliciesO
 	return out, err
 }
 
+// ListRolePoliciesWithContext is the same as ListRolePolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListRolePoliciesWithContext(ctx aws.Context, input *ListRolePoliciesInput, opts ...aws.Option) (*ListRolePoliciesOutput, error) {
+	req, out := c.ListRolePoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListRolePoliciesPages iterates over the pages of a ListRolePolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListRolePoliciesPages(input *ListRolePoliciesInput, fn func(p *ListRolePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListRolePoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2834,6 +4056,19 @@ func (c *IAM) ListRolePoliciesPages(input *ListRolePoliciesInput, fn func(p *Lis
 	})
 }
 
+// ListRolePoliciesPagesWithContext is the same as ListRolePoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListRolePoliciesPagesWithContext(ctx aws.Context, input *ListRolePoliciesInput, fn func(p *ListRolePoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListRolePoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListRolePoliciesOutput), lastPage)
+	})
+}
+
 const opListRoles = "ListRoles"
 
 // ListRolesRequest generates a request for the ListRoles operation.
@@ -2847,6 +4082,7 @@ func (c *IAM) ListRolesRequest(input *ListRolesInput) (req *aws.Request, output
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"Roles"},
 		},
 	}
 
@@ -2871,6 +4107,22 @@ func (c *IAM) ListRoles(input *ListRolesInput) (*ListRolesOutput, error) {
 	return out, err
 }
 
+// ListRolesWithContext is the same as ListRoles with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListRolesWithContext(ctx aws.Context, input *ListRolesInput, opts ...aws.Option) (*ListRolesOutput, error) {
+	req, out := c.ListRolesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListRolesPages iterates over the pages of a ListRoles operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListRolesPages(input *ListRolesInput, fn func(p *ListRolesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListRolesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2878,6 +4130,19 @@ func (c *IAM) ListRolesPages(input *ListRolesInput, fn func(p *ListRolesOutput,
 	})
 }
 
+// ListRolesPagesWithContext is the same as ListRolesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListRolesPagesWithContext(ctx aws.Context, input *ListRolesInput, fn func(p *ListRolesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListRolesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListRolesOutput), lastPage)
+	})
+}
+
 const opListSAMLProviders = "ListSAMLProviders"
 
 // ListSAMLProvidersRequest generates a request for the ListSAMLProviders operation.
@@ -2907,6 +4172,18 @@ func (c *IAM) ListSAMLProviders(input *ListSAMLProvidersInput) (*ListSAMLProvide
 	return out, err
 }
 
+// ListSAMLProvidersWithContext is the same as ListSAMLProviders with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListSAMLProvidersWithContext(ctx aws.Context, input *ListSAMLProvidersInput, opts ...aws.Option) (*ListSAMLProvidersOutput, error) {
+	req, out := c.ListSAMLProvidersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListSSHPublicKeys = "ListSSHPublicKeys"
 
 // ListSSHPublicKeysRequest generates a request for the ListSSHPublicKeys operation.
@@ -2944,6 +4221,18 @@ func (c *IAM) ListSSHPublicKeys(input *ListSSHPublicKeysInput) (*ListSSHPublicKe
 	return out, err
 }
 
+// ListSSHPublicKeysWithContext is the same as ListSSHPublicKeys with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListSSHPublicKeysWithContext(ctx aws.Context, input *ListSSHPublicKeysInput, opts ...aws.Option) (*ListSSHPublicKeysOutput, error) {
+	req, out := c.ListSSHPublicKeysRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListServerCertificates = "ListServerCertificates"
 
 // ListServerCertificatesRequest generates a request for the ListServerCertificates operation.
@@ -2957,6 +4246,7 @@ func (c *IAM) ListServerCertificatesRequest(input *ListServerCertificatesInput)
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"ServerCertificateMetadataList"},
 		},
 	}
 
@@ -2980,6 +4270,22 @@ func (c *IAM) ListServerCertificates(input *ListServerCertificatesInput) (*ListS
 	return out, err
 }
 
+// ListServerCertificatesWithContext is the same as ListServerCertificates with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListServerCertificatesWithContext(ctx aws.Context, input *ListServerCertificatesInput, opts ...aws.Option) (*ListServerCertificatesOutput, error) {
+	req, out := c.ListServerCertificatesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListServerCertificatesPages iterates over the pages of a ListServerCertificates operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListServerCertificatesPages(input *ListServerCertificatesInput, fn func(p *ListServerCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListServerCertificatesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2987,6 +4293,19 @@ func (c *IAM) ListServerCertificatesPages(input *ListServerCertificatesInput, fn
 	})
 }
 
+// ListServerCertificatesPagesWithContext is the same as ListServerCertificatesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListServerCertificatesPagesWithContext(ctx aws.Context, input *ListServerCertificatesInput, fn func(p *ListServerCertificatesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListServerCertificatesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListServerCertificatesOutput), lastPage)
+	})
+}
+
 const opListSigningCertificates = "ListSigningCertificates"
 
 // ListSigningCertificatesRequest generates a request for the ListSigningCertificates operation.
@@ -3000,6 +4319,7 @@ func (c *IAM) ListSigningCertificatesRequest(input *ListSigningCertificatesInput
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"Certificates"},
 		},
 	}
 
@@ -3029,6 +4349,22 @@ func (c *IAM) ListSigningCertificates(input *ListSigningCertificatesInput) (*Lis
 	return out, err
 }
 
+// ListSigningCertificatesWithContext is the same as ListSigningCertificates with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListSigningCertificatesWithContext(ctx aws.Context, input *ListSigningCertificatesInput, opts ...aws.Option) (*ListSigningCertificatesOutput, error) {
+	req, out := c.ListSigningCertificatesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListSigningCertificatesPages iterates over the pages of a ListSigningCertificates operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListSigningCertificatesPages(input *ListSigningCertificatesInput, fn func(p *ListSigningCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListSigningCertificatesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3036,6 +4372,19 @@ func (c *IAM) ListSigningCertificatesPages(input *ListSigningCertificatesInput,
 	})
 }
 
+// ListSigningCertificatesPagesWithContext is the same as ListSigningCertificatesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListSigningCertificatesPagesWithContext(ctx aws.Context, input *ListSigningCertificatesInput, fn func(p *ListSigningCertificatesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListSigningCertificatesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListSigningCertificatesOutput), lastPage)
+	})
+}
+
 const opListUserPolicies = "ListUserPolicies"
 
 // ListUserPoliciesRequest generates a request for the ListUserPolicies operation.
@@ -3049,6 +4398,7 @@ func (c *IAM) ListUserPoliciesRequest(input *ListUserPoliciesInput) (req *aws.Re
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"PolicyNames"},
 		},
 	}
 
@@ -3079,6 +4429,22 @@ func (c *IAM) ListUserPolicies(input *ListUserPoliciesInput) (*ListUserPoliciesO
 	return out, err
 }
 
+// ListUserPoliciesWithContext is the same as ListUserPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListUserPoliciesWithContext(ctx aws.Context, input *ListUserPoliciesInput, opts ...aws.Option) (*ListUserPoliciesOutput, error) {
+	req, out := c.ListUserPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListUserPoliciesPages iterates over the pages of a ListUserPolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListUserPoliciesPages(input *ListUserPoliciesInput, fn func(p *ListUserPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListUserPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3086,6 +4452,19 @@ func (c *IAM) ListUserPoliciesPages(input *ListUserPoliciesInput, fn func(p *Lis
 	})
 }
 
+// ListUserPoliciesPagesWithContext is the same as ListUserPoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListUserPoliciesPagesWithContext(ctx aws.Context, input *ListUserPoliciesInput, fn func(p *ListUserPoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListUserPoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListUserPoliciesOutput), lastPage)
+	})
+}
+
 const opListUsers = "ListUsers"
 
 // ListUsersRequest generates a request for the ListUsers operation.
@@ -3099,6 +4478,7 @@ func (c *IAM) ListUsersRequest(input *ListUsersInput) (req *aws.Request, output
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"Users"},
 		},
 	}
 
@@ -3123,6 +4503,22 @@ func (c *IAM) ListUsers(input *ListUsersInput) (*ListUsersOutput, error) {
 	return out, err
 }
 
+// ListUsersWithContext is the same as ListUsers with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListUsersWithContext(ctx aws.Context, input *ListUsersInput, opts ...aws.Option) (*ListUsersOutput, error) {
+	req, out := c.ListUsersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListUsersPages iterates over the pages of a ListUsers operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListUsersPages(input *ListUsersInput, fn func(p *ListUsersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListUsersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3130,6 +4526,19 @@ func (c *IAM) ListUsersPages(input *ListUsersInput, fn func(p *ListUsersOutput,
 	})
 }
 
+// ListUsersPagesWithContext is the same as ListUsersPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListUsersPagesWithContext(ctx aws.Context, input *ListUsersInput, fn func(p *ListUsersOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListUsersRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListUsersOutput), lastPage)
+	})
+}
+
 const opListVirtualMFADevices = "ListVirtualMFADevices"
 
 // ListVirtualMFADevicesRequest generates a request for the ListVirtualMFADevices operation.
@@ -3143,6 +4552,7 @@ func (c *IAM) ListVirtualMFADevicesRequest(input *ListVirtualMFADevicesInput) (r
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"VirtualMFADevices"},
 		},
 	}
 
@@ -3168,6 +4578,22 @@ func (c *IAM) ListVirtualMFADevices(input *ListVirtualMFADevicesInput) (*ListVir
 	return out, err
 }
 
+// ListVirtualMFADevicesWithContext is the same as ListVirtualMFADevices with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ListVirtualMFADevicesWithContext(ctx aws.Context, input *ListVirtualMFADevicesInput, opts ...aws.Option) (*ListVirtualMFADevicesOutput, error) {
+	req, out := c.ListVirtualMFADevicesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListVirtualMFADevicesPages iterates over the pages of a ListVirtualMFADevices operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *IAM) ListVirtualMFADevicesPages(input *ListVirtualMFADevicesInput, fn func(p *ListVirtualMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListVirtualMFADevicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3175,6 +4601,19 @@ func (c *IAM) ListVirtualMFADevicesPages(input *ListVirtualMFADevicesInput, fn f
 	})
 }
 
+// ListVirtualMFADevicesPagesWithContext is the same as ListVirtualMFADevicesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *IAM) ListVirtualMFADevicesPagesWithContext(ctx aws.Context, input *ListVirtualMFADevicesInput, fn func(p *ListVirtualMFADevicesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListVirtualMFADevicesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListVirtualMFADevicesOutput), lastPage)
+	})
+}
+
 const opPutGroupPolicy = "PutGroupPolicy"
 
 // PutGroupPolicyRequest generates a request for the PutGroupPolicy operation.
@@ -3218,6 +4657,18 @@ func (c *IAM) PutGroupPolicy(input *PutGroupPolicyInput) (*PutGroupPolicyOutput,
 	return out, err
 }
 
+// PutGroupPolicyWithContext is the same as PutGroupPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) PutGroupPolicyWithContext(ctx aws.Context, input *PutGroupPolicyInput, opts ...aws.Option) (*PutGroupPolicyOutput, error) {
+	req, out := c.PutGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRolePolicy = "PutRolePolicy"
 
 // PutRolePolicyRequest generates a request for the PutRolePolicy operation.
@@ -3267,6 +4718,18 @@ func (c *IAM) PutRolePolicy(input *PutRolePolicyInput) (*PutRolePolicyOutput, er
 	return out, err
 }
 
+// PutRolePolicyWithContext is the same as PutRolePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) PutRolePolicyWithContext(ctx aws.Context, input *PutRolePolicyInput, opts ...aws.Option) (*PutRolePolicyOutput, error) {
+	req, out := c.PutRolePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutUserPolicy = "PutUserPolicy"
 
 // PutUserPolicyRequest generates a request for the PutUserPolicy operation.
@@ -3310,6 +4773,18 @@ func (c *IAM) PutUserPolicy(input *PutUserPolicyInput) (*PutUserPolicyOutput, er
 	return out, err
 }
 
+// PutUserPolicyWithContext is the same as PutUserPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) PutUserPolicyWithContext(ctx aws.Context, input *PutUserPolicyInput, opts ...aws.Option) (*PutUserPolicyOutput, error) {
+	req, out := c.PutUserPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveClientIDFromOpenIDConnectProvider = "RemoveClientIDFromOpenIDConnectProvider"
 
 // RemoveClientIDFromOpenIDConnectProviderRequest generates a request for the RemoveClientIDFromOpenIDConnectProvider operation.
@@ -3341,6 +4816,18 @@ func (c *IAM) RemoveClientIDFromOpenIDConnectProvider(input *RemoveClientIDFromO
 	return out, err
 }
 
+// RemoveClientIDFromOpenIDConnectProviderWithContext is the same as RemoveClientIDFromOpenIDConnectProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) RemoveClientIDFromOpenIDConnectProviderWithContext(ctx aws.Context, input *RemoveClientIDFromOpenIDConnectProviderInput, opts ...aws.Option) (*RemoveClientIDFromOpenIDConnectProviderOutput, error) {
+	req, out := c.RemoveClientIDFromOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveRoleFromInstanceProfile = "RemoveRoleFromInstanceProfile"
 
 // RemoveRoleFromInstanceProfileRequest generates a request for the RemoveRoleFromInstanceProfile operation.
@@ -3376,6 +4863,18 @@ func (c *IAM) RemoveRoleFromInstanceProfile(input *RemoveRoleFromInstanceProfile
 	return out, err
 }
 
+// RemoveRoleFromInstanceProfileWithContext is the same as RemoveRoleFromInstanceProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) RemoveRoleFromInstanceProfileWithContext(ctx aws.Context, input *RemoveRoleFromInstanceProfileInput, opts ...aws.Option) (*RemoveRoleFromInstanceProfileOutput, error) {
+	req, out := c.RemoveRoleFromInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveUserFromGroup = "RemoveUserFromGroup"
 
 // RemoveUserFromGroupRequest generates a request for the RemoveUserFromGroup operation.
@@ -3403,6 +4902,18 @@ func (c *IAM) RemoveUserFromGroup(input *RemoveUserFromGroupInput) (*RemoveUserF
 	return out, err
 }
 
+// RemoveUserFromGroupWithContext is the same as RemoveUserFromGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) RemoveUserFromGroupWithContext(ctx aws.Context, input *RemoveUserFromGroupInput, opts ...aws.Option) (*RemoveUserFromGroupOutput, error) {
+	req, out := c.RemoveUserFromGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opResyncMFADevice = "ResyncMFADevice"
 
 // ResyncMFADeviceRequest generates a request for the ResyncMFADevice operation.
@@ -3434,6 +4945,18 @@ func (c *IAM) ResyncMFADevice(input *ResyncMFADeviceInput) (*ResyncMFADeviceOutp
 	return out, err
 }
 
+// ResyncMFADeviceWithContext is the same as ResyncMFADevice with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) ResyncMFADeviceWithContext(ctx aws.Context, input *ResyncMFADeviceInput, opts ...aws.Option) (*ResyncMFADeviceOutput, error) {
+	req, out := c.ResyncMFADeviceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSetDefaultPolicyVersion = "SetDefaultPolicyVersion"
 
 // SetDefaultPolicyVersionRequest generates a request for the SetDefaultPolicyVersion operation.
@@ -3470,6 +4993,18 @@ func (c *IAM) SetDefaultPolicyVersion(input *SetDefaultPolicyVersionInput) (*Set
 	return out, err
 }
 
+// SetDefaultPolicyVersionWithContext is the same as SetDefaultPolicyVersion with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) SetDefaultPolicyVersionWithContext(ctx aws.Context, input *SetDefaultPolicyVersionInput, opts ...aws.Option) (*SetDefaultPolicyVersionOutput, error) {
+	req, out := c.SetDefaultPolicyVersionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAccessKey = "UpdateAccessKey"
 
 // UpdateAccessKeyRequest generates a request for the UpdateAccessKey operation.
@@ -3508,6 +5043,18 @@ func (c *IAM) UpdateAccessKey(input *UpdateAccessKeyInput) (*UpdateAccessKeyOutp
 	return out, err
 }
 
+// UpdateAccessKeyWithContext is the same as UpdateAccessKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateAccessKeyWithContext(ctx aws.Context, input *UpdateAccessKeyInput, opts ...aws.Option) (*UpdateAccessKeyOutput, error) {
+	req, out := c.UpdateAccessKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAccountPasswordPolicy = "UpdateAccountPasswordPolicy"
 
 // UpdateAccountPasswordPolicyRequest generates a request for the UpdateAccountPasswordPolicy operation.
@@ -3544,6 +5091,18 @@ func (c *IAM) UpdateAccountPasswordPolicy(input *UpdateAccountPasswordPolicyInpu
 	return out, err
 }
 
+// UpdateAccountPasswordPolicyWithContext is the same as UpdateAccountPasswordPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateAccountPasswordPolicyWithContext(ctx aws.Context, input *UpdateAccountPasswordPolicyInput, opts ...aws.Option) (*UpdateAccountPasswordPolicyOutput, error) {
+	req, out := c.UpdateAccountPasswordPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAssumeRolePolicy = "UpdateAssumeRolePolicy"
 
 // UpdateAssumeRolePolicyRequest generates a request for the UpdateAssumeRolePolicy operation.
@@ -3573,6 +5132,18 @@ func (c *IAM) UpdateAssumeRolePolicy(input *UpdateAssumeRolePolicyInput) (*Updat
 	return out, err
 }
 
+// UpdateAssumeRolePolicyWithContext is the same as UpdateAssumeRolePolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateAssumeRolePolicyWithContext(ctx aws.Context, input *UpdateAssumeRolePolicyInput, opts ...aws.Option) (*UpdateAssumeRolePolicyOutput, error) {
+	req, out := c.UpdateAssumeRolePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateGroup = "UpdateGroup"
 
 // UpdateGroupRequest generates a request for the UpdateGroup operation.
@@ -3609,6 +5180,18 @@ func (c *IAM) UpdateGroup(input *UpdateGroupInput) (*UpdateGroupOutput, error) {
 	return out, err
 }
 
+// UpdateGroupWithContext is the same as UpdateGroup with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateGroupWithContext(ctx aws.Context, input *UpdateGroupInput, opts ...aws.Option) (*UpdateGroupOutput, error) {
+	req, out := c.UpdateGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateLoginProfile = "UpdateLoginProfile"
 
 // UpdateLoginProfileRequest generates a request for the UpdateLoginProfile operation.
@@ -3640,6 +5223,18 @@ func (c *IAM) UpdateLoginProfile(input *UpdateLoginProfileInput) (*UpdateLoginPr
 	return out, err
 }
 
+// UpdateLoginProfileWithContext is the same as UpdateLoginProfile with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateLoginProfileWithContext(ctx aws.Context, input *UpdateLoginProfileInput, opts ...aws.Option) (*UpdateLoginProfileOutput, error) {
+	req, out := c.UpdateLoginProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateOpenIDConnectProviderThumbprint = "UpdateOpenIDConnectProviderThumbprint"
 
 // UpdateOpenIDConnectProviderThumbprintRequest generates a request for the UpdateOpenIDConnectProviderThumbprint operation.
@@ -3680,6 +5275,18 @@ func (c *IAM) UpdateOpenIDConnectProviderThumbprint(input *UpdateOpenIDConnectPr
 	return out, err
 }
 
+// UpdateOpenIDConnectProviderThumbprintWithContext is the same as UpdateOpenIDConnectProviderThumbprint with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateOpenIDConnectProviderThumbprintWithContext(ctx aws.Context, input *UpdateOpenIDConnectProviderThumbprintInput, opts ...aws.Option) (*UpdateOpenIDConnectProviderThumbprintOutput, error) {
+	req, out := c.UpdateOpenIDConnectProviderThumbprintRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateSAMLProvider = "UpdateSAMLProvider"
 
 // UpdateSAMLProviderRequest generates a request for the UpdateSAMLProvider operation.
@@ -3709,6 +5316,18 @@ func (c *IAM) UpdateSAMLProvider(input *UpdateSAMLProviderInput) (*UpdateSAMLPro
 	return out, err
 }
 
+// UpdateSAMLProviderWithContext is the same as UpdateSAMLProvider with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateSAMLProviderWithContext(ctx aws.Context, input *UpdateSAMLProviderInput, opts ...aws.Option) (*UpdateSAMLProviderOutput, error) {
+	req, out := c.UpdateSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateSSHPublicKey = "UpdateSSHPublicKey"
 
 // UpdateSSHPublicKeyRequest generates a request for the UpdateSSHPublicKey operation.
@@ -3745,6 +5364,18 @@ func (c *IAM) UpdateSSHPublicKey(input *UpdateSSHPublicKeyInput) (*UpdateSSHPubl
 	return out, err
 }
 
+// UpdateSSHPublicKeyWithContext is the same as UpdateSSHPublicKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateSSHPublicKeyWithContext(ctx aws.Context, input *UpdateSSHPublicKeyInput, opts ...aws.Option) (*UpdateSSHPublicKeyOutput, error) {
+	req, out := c.UpdateSSHPublicKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateServerCertificate = "UpdateServerCertificate"
 
 // UpdateServerCertificateRequest generates a request for the UpdateServerCertificate operation.
@@ -3782,6 +5413,18 @@ func (c *IAM) UpdateServerCertificate(input *UpdateServerCertificateInput) (*Upd
 	return out, err
 }
 
+// UpdateServerCertificateWithContext is the same as UpdateServerCertificate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateServerCertificateWithContext(ctx aws.Context, input *UpdateServerCertificateInput, opts ...aws.Option) (*UpdateServerCertificateOutput, error) {
+	req, out := c.UpdateServerCertificateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateSigningCertificate = "UpdateSigningCertificate"
 
 // UpdateSigningCertificateRequest generates a request for the UpdateSigningCertificate operation.
@@ -3816,6 +5459,18 @@ func (c *IAM) UpdateSigningCertificate(input *UpdateSigningCertificateInput) (*U
 	return out, err
 }
 
+// UpdateSigningCertificateWithContext is the same as UpdateSigningCertificate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateSigningCertificateWithContext(ctx aws.Context, input *UpdateSigningCertificateInput, opts ...aws.Option) (*UpdateSigningCertificateOutput, error) {
+	req, out := c.UpdateSigningCertificateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateUser = "UpdateUser"
 
 // UpdateUserRequest generates a request for the UpdateUser operation.
@@ -3852,6 +5507,18 @@ func (c *IAM) UpdateUser(input *UpdateUserInput) (*UpdateUserOutput, error) {
 	return out, err
 }
 
+// UpdateUserWithContext is the same as UpdateUser with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UpdateUserWithContext(ctx aws.Context, input *UpdateUserInput, opts ...aws.Option) (*UpdateUserOutput, error) {
+	req, out := c.UpdateUserRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadSSHPublicKey = "UploadSSHPublicKey"
 
 // UploadSSHPublicKeyRequest generates a request for the UploadSSHPublicKey operation.
@@ -3885,6 +5552,18 @@ func (c *IAM) UploadSSHPublicKey(input *UploadSSHPublicKeyInput) (*UploadSSHPubl
 	return out, err
 }
 
+// UploadSSHPublicKeyWithContext is the same as UploadSSHPublicKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UploadSSHPublicKeyWithContext(ctx aws.Context, input *UploadSSHPublicKeyInput, opts ...aws.Option) (*UploadSSHPublicKeyOutput, error) {
+	req, out := c.UploadSSHPublicKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadServerCertificate = "UploadServerCertificate"
 
 // UploadServerCertificateRequest generates a request for the UploadServerCertificate operation.
@@ -3926,6 +5605,18 @@ func (c *IAM) UploadServerCertificate(input *UploadServerCertificateInput) (*Upl
 	return out, err
 }
 
+// UploadServerCertificateWithContext is the same as UploadServerCertificate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UploadServerCertificateWithContext(ctx aws.Context, input *UploadServerCertificateInput, opts ...aws.Option) (*UploadServerCertificateOutput, error) {
+	req, out := c.UploadServerCertificateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadSigningCertificate = "UploadSigningCertificate"
 
 // UploadSigningCertificateRequest generates a request for the UploadSigningCertificate operation.
@@ -3969,6 +5660,18 @@ func (c *IAM) UploadSigningCertificate(input *UploadSigningCertificateInput) (*U
 	return out, err
 }
 
+// UploadSigningCertificateWithContext is the same as UploadSigningCertificate with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *IAM) UploadSigningCertificateWithContext(ctx aws.Context, input *UploadSigningCertificateInput, opts ...aws.Option) (*UploadSigningCertificateOutput, error) {
+	req, out := c.UploadSigningCertificateRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Contains information about an AWS access key.
 //
 //  This data type is used as a response element in the CreateAccessKey and
@@ -3980,20 +5683,20 @@ func (c *IAM) UploadSigningCertificate(input *UploadSigningCertificateInput) (*U
 // key, you must create a new access key.
 type AccessKey struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The secret key used to sign requests.
-	SecretAccessKey *string `type:"string" required:"true"`
+	SecretAccessKey *string `type:"string" sensitive:"true" required:"true"`
 
 	// The status of the access key. Active means the key is valid for API calls,
 	// while Inactive means it is not.
 	Status *string `type:"string" required:"true"`
 
 	// The name of the IAM user that the access key is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataAccessKey `json:"-" xml:"-"`
 }
@@ -4075,7 +5778,7 @@ func (s AccessKeyLastUsed) GoString() string {
 // This data type is used as a response element in the ListAccessKeys action.
 type AccessKeyMetadata struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -4085,7 +5788,7 @@ type AccessKeyMetadata struct {
 	Status *string `type:"string"`
 
 	// The name of the IAM user that the key is associated with.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64"`
 
 	metadataAccessKeyMetadata `json:"-" xml:"-"`
 }
@@ -4106,12 +5809,12 @@ func (s AccessKeyMetadata) GoString() string {
 
 type AddClientIDToOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to add to the IAM OpenID Connect provider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to add the client ID to. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAddClientIDToOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -4150,10 +5853,10 @@ func (s AddClientIDToOpenIDConnectProviderOutput) GoString() string {
 
 type AddRoleToInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the role to add.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataAddRoleToInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -4192,10 +5895,10 @@ func (s AddRoleToInstanceProfileOutput) GoString() string {
 
 type AddUserToGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the user to add.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataAddUserToGroupInput `json:"-" xml:"-"`
 }
@@ -4234,14 +5937,14 @@ func (s AddUserToGroupOutput) GoString() string {
 
 type AttachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to attach the policy to.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAttachGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -4284,10 +5987,10 @@ type AttachRolePolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to attach the policy to.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataAttachRolePolicyInput `json:"-" xml:"-"`
 }
@@ -4330,10 +6033,10 @@ type AttachUserPolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to attach the policy to.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataAttachUserPolicyInput `json:"-" xml:"-"`
 }
@@ -4386,10 +6089,10 @@ type AttachedPolicy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048"`
 
 	// The friendly name of the attached policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128"`
 
 	metadataAttachedPolicy `json:"-" xml:"-"`
 }
@@ -4411,10 +6114,10 @@ func (s AttachedPolicy) GoString() string {
 type ChangePasswordInput struct {
 	// The new password. The new password must conform to the AWS account's password
 	// policy, if one exists.
-	NewPassword *string `type:"string" required:"true"`
+	NewPassword *string `type:"string" sensitive:"true" min:"1" max:"128" required:"true"`
 
 	// The IAM user's current password.
-	OldPassword *string `type:"string" required:"true"`
+	OldPassword *string `type:"string" sensitive:"true" min:"1" max:"128" required:"true"`
 
 	metadataChangePasswordInput `json:"-" xml:"-"`
 }
@@ -4453,7 +6156,7 @@ func (s ChangePasswordOutput) GoString() string {
 
 type CreateAccessKeyInput struct {
 	// The user name that the new key will belong to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataCreateAccessKeyInput `json:"-" xml:"-"`
 }
@@ -4496,7 +6199,7 @@ func (s CreateAccessKeyOutput) GoString() string {
 
 type CreateAccountAliasInput struct {
 	// The account alias to create.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" required:"true"`
 
 	metadataCreateAccountAliasInput `json:"-" xml:"-"`
 }
@@ -4535,7 +6238,7 @@ func (s CreateAccountAliasOutput) GoString() string {
 
 type CreateGroupInput struct {
 	// The name of the group to create. Do not include the path in this value.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4543,7 +6246,7 @@ type CreateGroupInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	metadataCreateGroupInput `json:"-" xml:"-"`
 }
@@ -4586,7 +6289,7 @@ func (s CreateGroupOutput) GoString() string {
 
 type CreateInstanceProfileInput struct {
 	// The name of the instance profile to create.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4594,7 +6297,7 @@ type CreateInstanceProfileInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	metadataCreateInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -4637,13 +6340,13 @@ func (s CreateInstanceProfileOutput) GoString() string {
 
 type CreateLoginProfileInput struct {
 	// The new password for the user.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" sensitive:"true" min:"1" max:"128" required:"true"`
 
 	// Specifies whether the user is required to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user to create a password for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateLoginProfileInput `json:"-" xml:"-"`
 }
@@ -4728,7 +6431,7 @@ type CreateOpenIDConnectProviderInput struct {
 	// You cannot register the same provider multiple times in a single AWS account.
 	// If you try to submit a URL that has already been used for an OpenID Connect
 	// provider in the AWS account, you will get an error.
-	URL *string `locationName:"Url" type:"string" required:"true"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255" required:"true"`
 
 	metadataCreateOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -4751,7 +6454,7 @@ func (s CreateOpenIDConnectProviderInput) GoString() string {
 type CreateOpenIDConnectProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider that was
 	// created. For more information, see OpenIDConnectProviderListEntry.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateOpenIDConnectProviderOutput `json:"-" xml:"-"`
 }
@@ -4778,7 +6481,7 @@ type CreatePolicyInput struct {
 	//
 	// The policy description is immutable. After a value is assigned, it cannot
 	// be changed.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// The path for the policy.
 	//
@@ -4790,10 +6493,10 @@ type CreatePolicyInput struct {
 	Path *string `type:"string"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataCreatePolicyInput `json:"-" xml:"-"`
 }
@@ -4840,10 +6543,10 @@ type CreatePolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// Specifies whether to set this version as the policy's default version.
 	//
@@ -4897,7 +6600,7 @@ func (s CreatePolicyVersionOutput) GoString() string {
 
 type CreateRoleInput struct {
 	// The policy that grants an entity permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string" required:"true"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4905,10 +6608,10 @@ type CreateRoleInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	// The name of the role to create.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateRoleInput `json:"-" xml:"-"`
 }
@@ -4951,7 +6654,7 @@ func (s CreateRoleOutput) GoString() string {
 
 type CreateSAMLProviderInput struct {
 	// The name of the provider to create.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// An XML document generated by an identity provider (IdP) that supports SAML
 	// 2.0. The document includes the issuer's name, expiration information, and
@@ -4962,7 +6665,7 @@ type CreateSAMLProviderInput struct {
 	// For more information, see Creating Temporary Security Credentials for SAML
 	// Federation (http://docs.aws.amazon.com/STS/latest/UsingSTS/CreatingSAML.html)
 	// in the Using Temporary Security Credentials guide.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	metadataCreateSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -4984,7 +6687,7 @@ func (s CreateSAMLProviderInput) GoString() string {
 // Contains the response to a successful CreateSAMLProvider request.
 type CreateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateSAMLProviderOutput `json:"-" xml:"-"`
 }
@@ -5010,10 +6713,10 @@ type CreateUserInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	// The name of the user to create.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateUserInput `json:"-" xml:"-"`
 }
@@ -5061,11 +6764,11 @@ type CreateVirtualMFADeviceInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	// The name of the virtual MFA device. Use with path to uniquely identify a
 	// virtual MFA device.
-	VirtualMFADeviceName *string `type:"string" required:"true"`
+	VirtualMFADeviceName *string `type:"string" min:"1" required:"true"`
 
 	metadataCreateVirtualMFADeviceInput `json:"-" xml:"-"`
 }
@@ -5109,10 +6812,10 @@ func (s CreateVirtualMFADeviceOutput) GoString() string {
 type DeactivateMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" required:"true"`
 
 	// The name of the user whose MFA device you want to deactivate.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeactivateMFADeviceInput `json:"-" xml:"-"`
 }
@@ -5152,10 +6855,10 @@ func (s DeactivateMFADeviceOutput) GoString() string {
 type DeleteAccessKeyInput struct {
 	// The access key ID for the access key ID and secret access key you want to
 	// delete.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The name of the user whose key you want to delete.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataDeleteAccessKeyInput `json:"-" xml:"-"`
 }
@@ -5194,7 +6897,7 @@ func (s DeleteAccessKeyOutput) GoString() string {
 
 type DeleteAccountAliasInput struct {
 	// The name of the account alias to delete.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" required:"true"`
 
 	metadataDeleteAccountAliasInput `json:"-" xml:"-"`
 }
@@ -5269,7 +6972,7 @@ func (s DeleteAccountPasswordPolicyOutput) GoString() string {
 
 type DeleteGroupInput struct {
 	// The name of the group to delete.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeleteGroupInput `json:"-" xml:"-"`
 }
@@ -5309,10 +7012,10 @@ func (s DeleteGroupOutput) GoString() string {
 type DeleteGroupPolicyInput struct {
 	// The name (friendly name, not ARN) identifying the group that the policy is
 	// embedded in.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeleteGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -5351,7 +7054,7 @@ func (s DeleteGroupPolicyOutput) GoString() string {
 
 type DeleteInstanceProfileInput struct {
 	// The name of the instance profile to delete.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeleteInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -5390,7 +7093,7 @@ func (s DeleteInstanceProfileOutput) GoString() string {
 
 type DeleteLoginProfileInput struct {
 	// The name of the user whose password you want to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteLoginProfileInput `json:"-" xml:"-"`
 }
@@ -5431,7 +7134,7 @@ type DeleteOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider to delete.
 	// You can get a list of OpenID Connect provider ARNs by using the ListOpenIDConnectProviders
 	// action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -5474,7 +7177,7 @@ type DeletePolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeletePolicyInput `json:"-" xml:"-"`
 }
@@ -5517,7 +7220,7 @@ type DeletePolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy version to delete.
 	//
@@ -5563,7 +7266,7 @@ func (s DeletePolicyVersionOutput) GoString() string {
 
 type DeleteRoleInput struct {
 	// The name of the role to delete.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteRoleInput `json:"-" xml:"-"`
 }
@@ -5602,11 +7305,11 @@ func (s DeleteRoleOutput) GoString() string {
 
 type DeleteRolePolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the role that the policy is
 	// embedded in.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteRolePolicyInput `json:"-" xml:"-"`
 }
@@ -5645,7 +7348,7 @@ func (s DeleteRolePolicyOutput) GoString() string {
 
 type DeleteSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to delete.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -5684,10 +7387,10 @@ func (s DeleteSAMLProviderOutput) GoString() string {
 
 type DeleteSSHPublicKeyInput struct {
 	// The unique identifier for the SSH public key.
-	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" required:"true"`
+	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" min:"20" max:"128" required:"true"`
 
 	// The name of the IAM user associated with the SSH public key.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteSSHPublicKeyInput `json:"-" xml:"-"`
 }
@@ -5726,7 +7429,7 @@ func (s DeleteSSHPublicKeyOutput) GoString() string {
 
 type DeleteServerCertificateInput struct {
 	// The name of the server certificate you want to delete.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeleteServerCertificateInput `json:"-" xml:"-"`
 }
@@ -5765,10 +7468,10 @@ func (s DeleteServerCertificateOutput) GoString() string {
 
 type DeleteSigningCertificateInput struct {
 	// The ID of the signing certificate to delete.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataDeleteSigningCertificateInput `json:"-" xml:"-"`
 }
@@ -5807,7 +7510,7 @@ func (s DeleteSigningCertificateOutput) GoString() string {
 
 type DeleteUserInput struct {
 	// The name of the user to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeleteUserInput `json:"-" xml:"-"`
 }
@@ -5846,11 +7549,11 @@ func (s DeleteUserOutput) GoString() string {
 
 type DeleteUserPolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the user that the policy is
 	// embedded in.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeleteUserPolicyInput `json:"-" xml:"-"`
 }
@@ -5890,7 +7593,7 @@ func (s DeleteUserPolicyOutput) GoString() string {
 type DeleteVirtualMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the same as the ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" required:"true"`
 
 	metadataDeleteVirtualMFADeviceInput `json:"-" xml:"-"`
 }
@@ -5929,14 +7632,14 @@ func (s DeleteVirtualMFADeviceOutput) GoString() string {
 
 type DetachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to detach the policy from.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDetachGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -5979,10 +7682,10 @@ type DetachRolePolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to detach the policy from.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDetachRolePolicyInput `json:"-" xml:"-"`
 }
@@ -6025,10 +7728,10 @@ type DetachUserPolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to detach the policy from.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDetachUserPolicyInput `json:"-" xml:"-"`
 }
@@ -6067,17 +7770,17 @@ func (s DetachUserPolicyOutput) GoString() string {
 
 type EnableMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" required:"true"`
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" required:"true"`
 
 	// The name of the user for whom you want to enable the MFA device.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataEnableMFADeviceInput `json:"-" xml:"-"`
 }
@@ -6159,7 +7862,7 @@ func (s GenerateCredentialReportOutput) GoString() string {
 
 type GetAccessKeyLastUsedInput struct {
 	// The identifier of an access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" required:"true"`
 
 	metadataGetAccessKeyLastUsedInput `json:"-" xml:"-"`
 }
@@ -6186,7 +7889,7 @@ type GetAccessKeyLastUsedOutput struct {
 	AccessKeyLastUsed *AccessKeyLastUsed `type:"structure"`
 
 	// The name of the AWS IAM user that owns this access key.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataGetAccessKeyLastUsedOutput `json:"-" xml:"-"`
 }
@@ -6213,14 +7916,14 @@ type GetAccountAuthorizationDetailsInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetAccountAuthorizationDetailsInput `json:"-" xml:"-"`
 }
@@ -6251,7 +7954,7 @@ type GetAccountAuthorizationDetailsOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list containing information about managed policies.
 	Policies []*ManagedPolicyDetail `type:"list"`
@@ -6553,19 +8256,19 @@ func (s GetCredentialReportOutput) GoString() string {
 
 type GetGroupInput struct {
 	// The name of the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetGroupInput `json:"-" xml:"-"`
 }
@@ -6596,7 +8299,7 @@ type GetGroupOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of users in the group.
 	Users []*User `type:"list" required:"true"`
@@ -6620,10 +8323,10 @@ func (s GetGroupOutput) GoString() string {
 
 type GetGroupPolicyInput struct {
 	// The name of the group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -6645,13 +8348,13 @@ func (s GetGroupPolicyInput) GoString() string {
 // Contains the response to a successful GetGroupPolicy request.
 type GetGroupPolicyOutput struct {
 	// The group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetGroupPolicyOutput `json:"-" xml:"-"`
 }
@@ -6672,7 +8375,7 @@ func (s GetGroupPolicyOutput) GoString() string {
 
 type GetInstanceProfileInput struct {
 	// The name of the instance profile to get information about.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -6715,7 +8418,7 @@ func (s GetInstanceProfileOutput) GoString() string {
 
 type GetLoginProfileInput struct {
 	// The name of the user whose login profile you want to retrieve.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataGetLoginProfileInput `json:"-" xml:"-"`
 }
@@ -6760,7 +8463,7 @@ type GetOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to get information for. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -6795,7 +8498,7 @@ type GetOpenIDConnectProviderOutput struct {
 
 	// The URL that the IAM OpenID Connect provider is associated with. For more
 	// information, see CreateOpenIDConnectProvider.
-	URL *string `locationName:"Url" type:"string"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255"`
 
 	metadataGetOpenIDConnectProviderOutput `json:"-" xml:"-"`
 }
@@ -6820,7 +8523,7 @@ type GetPolicyInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetPolicyInput `json:"-" xml:"-"`
 }
@@ -6867,7 +8570,7 @@ type GetPolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// Identifies the policy version to retrieve.
 	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
@@ -6917,7 +8620,7 @@ func (s GetPolicyVersionOutput) GoString() string {
 
 type GetRoleInput struct {
 	// The name of the role to get information about.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataGetRoleInput `json:"-" xml:"-"`
 }
@@ -6960,10 +8663,10 @@ func (s GetRoleOutput) GoString() string {
 
 type GetRolePolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the role associated with the policy.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataGetRolePolicyInput `json:"-" xml:"-"`
 }
@@ -6985,13 +8688,13 @@ func (s GetRolePolicyInput) GoString() string {
 // Contains the response to a successful GetRolePolicy request.
 type GetRolePolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The role the policy is associated with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataGetRolePolicyOutput `json:"-" xml:"-"`
 }
@@ -7012,7 +8715,7 @@ func (s GetRolePolicyOutput) GoString() string {
 
 type GetSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to get information about.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -7037,7 +8740,7 @@ type GetSAMLProviderOutput struct {
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The XML metadata document that includes information about an identity provider.
-	SAMLMetadataDocument *string `type:"string"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000"`
 
 	// The expiration date and time for the SAML provider.
 	ValidUntil *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7066,10 +8769,10 @@ type GetSSHPublicKeyInput struct {
 	Encoding *string `type:"string" required:"true"`
 
 	// The unique identifier for the SSH public key.
-	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" required:"true"`
+	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" min:"20" max:"128" required:"true"`
 
 	// The name of the IAM user associated with the SSH public key.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataGetSSHPublicKeyInput `json:"-" xml:"-"`
 }
@@ -7112,7 +8815,7 @@ func (s GetSSHPublicKeyOutput) GoString() string {
 
 type GetServerCertificateInput struct {
 	// The name of the server certificate you want to retrieve information about.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetServerCertificateInput `json:"-" xml:"-"`
 }
@@ -7158,7 +8861,7 @@ type GetUserInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to the user
 	// making the request.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataGetUserInput `json:"-" xml:"-"`
 }
@@ -7201,10 +8904,10 @@ func (s GetUserOutput) GoString() string {
 
 type GetUserPolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the user who the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetUserPolicyInput `json:"-" xml:"-"`
 }
@@ -7226,13 +8929,13 @@ func (s GetUserPolicyInput) GoString() string {
 // Contains the response to a successful GetUserPolicy request.
 type GetUserPolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The user the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetUserPolicyOutput `json:"-" xml:"-"`
 }
@@ -7260,7 +8963,7 @@ type Group struct {
 	// The Amazon Resource Name (ARN) specifying the group. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the group was created.
@@ -7269,15 +8972,15 @@ type Group struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string" required:"true"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" required:"true"`
 
 	metadataGroup `json:"-" xml:"-"`
 }
@@ -7306,7 +9009,7 @@ type GroupDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// A list of the managed policies attached to the group.
 	AttachedManagedPolicies []*AttachedPolicy `type:"list"`
@@ -7318,10 +9021,10 @@ type GroupDetail struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128"`
 
 	// A list of the inline policies embedded in the group.
 	GroupPolicyList []*PolicyDetail `type:"list"`
@@ -7329,7 +9032,7 @@ type GroupDetail struct {
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	metadataGroupDetail `json:"-" xml:"-"`
 }
@@ -7364,7 +9067,7 @@ type InstanceProfile struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date when the instance profile was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -7372,15 +9075,15 @@ type InstanceProfile struct {
 	// The stable and unique string identifying the instance profile. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" required:"true"`
+	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The name identifying the instance profile.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" required:"true"`
 
 	// The role associated with the instance profile.
 	Roles []*Role `type:"list" required:"true"`
@@ -7406,17 +9109,17 @@ type ListAccessKeysInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataListAccessKeysInput `json:"-" xml:"-"`
 }
@@ -7447,7 +9150,7 @@ type ListAccessKeysOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListAccessKeysOutput `json:"-" xml:"-"`
 }
@@ -7470,14 +9173,14 @@ type ListAccountAliasesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListAccountAliasesInput `json:"-" xml:"-"`
 }
@@ -7508,7 +9211,7 @@ type ListAccountAliasesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListAccountAliasesOutput `json:"-" xml:"-"`
 }
@@ -7530,19 +9233,19 @@ func (s ListAccountAliasesOutput) GoString() string {
 type ListAttachedGroupPoliciesInput struct {
 	// The name (friendly name, not ARN) of the group to list attached policies
 	// for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
@@ -7577,7 +9280,7 @@ type ListAttachedGroupPoliciesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListAttachedGroupPoliciesOutput `json:"-" xml:"-"`
 }
@@ -7600,21 +9303,21 @@ type ListAttachedRolePoliciesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
 	PathPrefix *string `type:"string"`
 
 	// The name (friendly name, not ARN) of the role to list attached policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataListAttachedRolePoliciesInput `json:"-" xml:"-"`
 }
@@ -7645,7 +9348,7 @@ type ListAttachedRolePoliciesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListAttachedRolePoliciesOutput `json:"-" xml:"-"`
 }
@@ -7668,21 +9371,21 @@ type ListAttachedUserPoliciesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
 	PathPrefix *string `type:"string"`
 
 	// The name (friendly name, not ARN) of the user to list attached policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataListAttachedUserPoliciesInput `json:"-" xml:"-"`
 }
@@ -7713,7 +9416,7 @@ type ListAttachedUserPoliciesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListAttachedUserPoliciesOutput `json:"-" xml:"-"`
 }
@@ -7743,25 +9446,25 @@ type ListEntitiesForPolicyInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all entities.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListEntitiesForPolicyInput `json:"-" xml:"-"`
 }
@@ -7789,7 +9492,7 @@ type ListEntitiesForPolicyOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of groups that the policy is attached to.
 	PolicyGroups []*PolicyGroup `type:"list"`
@@ -7819,19 +9522,19 @@ func (s ListEntitiesForPolicyOutput) GoString() string {
 
 type ListGroupPoliciesInput struct {
 	// The name of the group to list policies for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListGroupPoliciesInput `json:"-" xml:"-"`
 }
@@ -7859,7 +9562,7 @@ type ListGroupPoliciesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -7885,17 +9588,17 @@ type ListGroupsForUserInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list groups for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataListGroupsForUserInput `json:"-" xml:"-"`
 }
@@ -7926,7 +9629,7 @@ type ListGroupsForUserOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListGroupsForUserOutput `json:"-" xml:"-"`
 }
@@ -7949,21 +9652,21 @@ type ListGroupsInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /division_abc/subdivision_xyz/
 	// gets all groups whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all groups.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512"`
 
 	metadataListGroupsInput `json:"-" xml:"-"`
 }
@@ -7994,7 +9697,7 @@ type ListGroupsOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListGroupsOutput `json:"-" xml:"-"`
 }
@@ -8017,17 +9720,17 @@ type ListInstanceProfilesForRoleInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list instance profiles for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataListInstanceProfilesForRoleInput `json:"-" xml:"-"`
 }
@@ -8058,7 +9761,7 @@ type ListInstanceProfilesForRoleOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListInstanceProfilesForRoleOutput `json:"-" xml:"-"`
 }
@@ -8081,21 +9784,21 @@ type ListInstanceProfilesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all instance profiles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all instance profiles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512"`
 
 	metadataListInstanceProfilesInput `json:"-" xml:"-"`
 }
@@ -8126,7 +9829,7 @@ type ListInstanceProfilesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListInstanceProfilesOutput `json:"-" xml:"-"`
 }
@@ -8149,17 +9852,17 @@ type ListMFADevicesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user whose MFA devices you want to list.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataListMFADevicesInput `json:"-" xml:"-"`
 }
@@ -8190,7 +9893,7 @@ type ListMFADevicesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListMFADevicesOutput `json:"-" xml:"-"`
 }
@@ -8253,14 +9956,14 @@ type ListPoliciesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// A flag to filter the results to only the attached policies.
 	//
@@ -8308,7 +10011,7 @@ type ListPoliciesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of policies.
 	Policies []*Policy `type:"list"`
@@ -8334,21 +10037,21 @@ type ListPolicyVersionsInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListPolicyVersionsInput `json:"-" xml:"-"`
 }
@@ -8376,7 +10079,7 @@ type ListPolicyVersionsOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of policy versions.
 	//
@@ -8406,17 +10109,17 @@ type ListRolePoliciesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataListRolePoliciesInput `json:"-" xml:"-"`
 }
@@ -8444,7 +10147,7 @@ type ListRolePoliciesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -8470,21 +10173,21 @@ type ListRolesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all roles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all roles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512"`
 
 	metadataListRolesInput `json:"-" xml:"-"`
 }
@@ -8512,7 +10215,7 @@ type ListRolesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of roles.
 	Roles []*Role `type:"list" required:"true"`
@@ -8578,19 +10281,19 @@ type ListSSHPublicKeysInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the IAM user to list SSH public keys for. If none is specified,
 	// the UserName field is determined implicitly based on the AWS access key used
 	// to sign the request.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64"`
 
 	metadataListSSHPublicKeysInput `json:"-" xml:"-"`
 }
@@ -8618,7 +10321,7 @@ type ListSSHPublicKeysOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of SSH public keys.
 	SSHPublicKeys []*SSHPublicKeyMetadata `type:"list"`
@@ -8644,21 +10347,21 @@ type ListServerCertificatesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /company/servercerts
 	// would get all server certificates for which the path starts with /company/servercerts.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all server certificates.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512"`
 
 	metadataListServerCertificatesInput `json:"-" xml:"-"`
 }
@@ -8686,7 +10389,7 @@ type ListServerCertificatesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of server certificates.
 	ServerCertificateMetadataList []*ServerCertificateMetadata `type:"list" required:"true"`
@@ -8712,17 +10415,17 @@ type ListSigningCertificatesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataListSigningCertificatesInput `json:"-" xml:"-"`
 }
@@ -8753,7 +10456,7 @@ type ListSigningCertificatesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListSigningCertificatesOutput `json:"-" xml:"-"`
 }
@@ -8776,17 +10479,17 @@ type ListUserPoliciesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataListUserPoliciesInput `json:"-" xml:"-"`
 }
@@ -8814,7 +10517,7 @@ type ListUserPoliciesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -8840,21 +10543,21 @@ type ListUsersInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /division_abc/subdivision_xyz/,
 	// which would get all user names whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all user names.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512"`
 
 	metadataListUsersInput `json:"-" xml:"-"`
 }
@@ -8882,7 +10585,7 @@ type ListUsersOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// A list of users.
 	Users []*User `type:"list" required:"true"`
@@ -8913,14 +10616,14 @@ type ListVirtualMFADevicesInput struct {
 	// Use this parameter only when paginating results and only after you have received
 	// a response where the results are truncated. Set it to the value of the Marker
 	// element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true.
 	//
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListVirtualMFADevicesInput `json:"-" xml:"-"`
 }
@@ -8948,7 +10651,7 @@ type ListVirtualMFADevicesOutput struct {
 
 	// When IsTruncated is true, this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	// The list of virtual MFA devices in the current account that match the AssignmentStatus
 	// value that was passed in the request.
@@ -8984,7 +10687,7 @@ type LoginProfile struct {
 
 	// The name of the user, which can be used for signing in to the AWS Management
 	// Console.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataLoginProfile `json:"-" xml:"-"`
 }
@@ -9012,10 +10715,10 @@ type MFADevice struct {
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" required:"true"`
 
 	// The user with whom the MFA device is associated.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataMFADevice `json:"-" xml:"-"`
 }
@@ -9050,7 +10753,7 @@ type ManagedPolicyDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The number of principal entities (users, groups, and roles) that the policy
 	// is attached to.
@@ -9069,7 +10772,7 @@ type ManagedPolicyDetail struct {
 	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string"`
 
 	// A friendly description of the policy.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// Specifies whether the policy can be attached to an IAM user, group, or role.
 	IsAttachable *bool `type:"boolean"`
@@ -9084,10 +10787,10 @@ type ManagedPolicyDetail struct {
 	//
 	// For more information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	PolicyID *string `locationName:"PolicyId" type:"string"`
+	PolicyID *string `locationName:"PolicyId" type:"string" min:"16" max:"32"`
 
 	// The friendly name (not ARN) identifying the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128"`
 
 	// A list containing information about the versions of the policy.
 	PolicyVersionList []*PolicyVersion `type:"list"`
@@ -9125,7 +10828,7 @@ type OpenIDConnectProviderListEntry struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	metadataOpenIDConnectProviderListEntry `json:"-" xml:"-"`
 }
@@ -9161,14 +10864,14 @@ type PasswordPolicy struct {
 	HardExpiry *bool `type:"boolean"`
 
 	// The number of days that an IAM user password is valid.
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// Minimum length to require for IAM user passwords.
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing.
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether to require lowercase characters for IAM user passwords.
 	RequireLowercaseCharacters *bool `type:"boolean"`
@@ -9213,7 +10916,7 @@ type Policy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The number of entities (users, groups, and roles) that the policy is attached
 	// to.
@@ -9230,7 +10933,7 @@ type Policy struct {
 	//
 	// This element is included in the response to the GetPolicy operation. It
 	// is not included in the response to the ListPolicies operation.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// Specifies whether the policy can be attached to an IAM user, group, or role.
 	IsAttachable *bool `type:"boolean"`
@@ -9245,10 +10948,10 @@ type Policy struct {
 	//
 	// For more information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	PolicyID *string `locationName:"PolicyId" type:"string"`
+	PolicyID *string `locationName:"PolicyId" type:"string" min:"16" max:"32"`
 
 	// The friendly name (not ARN) identifying the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the policy was last updated.
@@ -9282,10 +10985,10 @@ func (s Policy) GoString() string {
 // action.
 type PolicyDetail struct {
 	// The policy document.
-	PolicyDocument *string `type:"string"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128"`
 
 	metadataPolicyDetail `json:"-" xml:"-"`
 }
@@ -9314,7 +11017,7 @@ func (s PolicyDetail) GoString() string {
 // in the Using IAM guide.
 type PolicyGroup struct {
 	// The name (friendly name, not ARN) identifying the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128"`
 
 	metadataPolicyGroup `json:"-" xml:"-"`
 }
@@ -9343,7 +11046,7 @@ func (s PolicyGroup) GoString() string {
 // in the Using IAM guide.
 type PolicyRole struct {
 	// The name (friendly name, not ARN) identifying the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64"`
 
 	metadataPolicyRole `json:"-" xml:"-"`
 }
@@ -9372,7 +11075,7 @@ func (s PolicyRole) GoString() string {
 // in the Using IAM guide.
 type PolicyUser struct {
 	// The name (friendly name, not ARN) identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64"`
 
 	metadataPolicyUser `json:"-" xml:"-"`
 }
@@ -9410,7 +11113,7 @@ type PolicyVersion struct {
 	// The policy document is returned in the response to the GetPolicyVersion
 	// and GetAccountAuthorizationDetails operations. It is not returned in the
 	// response to the CreatePolicyVersion or ListPolicyVersions operations.
-	Document *string `type:"string"`
+	Document *string `type:"string" min:"1" max:"131072"`
 
 	// Specifies whether the policy version is set as the policy's default version.
 	IsDefaultVersion *bool `type:"boolean"`
@@ -9440,13 +11143,13 @@ func (s PolicyVersion) GoString() string {
 
 type PutGroupPolicyInput struct {
 	// The name of the group to associate the policy with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataPutGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -9485,13 +11188,13 @@ func (s PutGroupPolicyOutput) GoString() string {
 
 type PutRolePolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the role to associate the policy with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataPutRolePolicyInput `json:"-" xml:"-"`
 }
@@ -9530,13 +11233,13 @@ func (s PutRolePolicyOutput) GoString() string {
 
 type PutUserPolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the user to associate the policy with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataPutUserPolicyInput `json:"-" xml:"-"`
 }
@@ -9576,12 +11279,12 @@ func (s PutUserPolicyOutput) GoString() string {
 type RemoveClientIDFromOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to remove from the IAM OpenID Connect
 	// provider. For more information about client IDs, see CreateOpenIDConnectProvider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to remove the client ID from. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataRemoveClientIDFromOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -9620,10 +11323,10 @@ func (s RemoveClientIDFromOpenIDConnectProviderOutput) GoString() string {
 
 type RemoveRoleFromInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the role to remove.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRemoveRoleFromInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -9662,10 +11365,10 @@ func (s RemoveRoleFromInstanceProfileOutput) GoString() string {
 
 type RemoveUserFromGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the user to remove.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataRemoveUserFromGroupInput `json:"-" xml:"-"`
 }
@@ -9704,16 +11407,16 @@ func (s RemoveUserFromGroupOutput) GoString() string {
 
 type ResyncMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" required:"true"`
 
 	// Serial number that uniquely identifies the MFA device.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" required:"true"`
 
 	// The name of the user whose MFA device you want to resynchronize.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataResyncMFADeviceInput `json:"-" xml:"-"`
 }
@@ -9763,10 +11466,10 @@ type Role struct {
 	// The Amazon Resource Name (ARN) specifying the role. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy that grants an entity permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the role was created.
@@ -9775,15 +11478,15 @@ type Role struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" required:"true"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string" required:"true"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRole `json:"-" xml:"-"`
 }
@@ -9812,10 +11515,10 @@ type RoleDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The trust policy that grants permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072"`
 
 	// A list of managed policies attached to the role. These policies are the role's
 	// access (permissions) policies.
@@ -9831,15 +11534,15 @@ type RoleDetail struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64"`
 
 	// A list of inline policies embedded in the role. These policies are the role's
 	// access (permissions) policies.
@@ -9865,7 +11568,7 @@ func (s RoleDetail) GoString() string {
 // Contains the list of SAML providers for this account.
 type SAMLProviderListEntry struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time when the SAML provider was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -9896,13 +11599,13 @@ func (s SAMLProviderListEntry) GoString() string {
 // UploadSSHPublicKey actions.
 type SSHPublicKey struct {
 	// The MD5 message digest of the SSH public key.
-	Fingerprint *string `type:"string" required:"true"`
+	Fingerprint *string `type:"string" min:"48" max:"48" required:"true"`
 
 	// The SSH public key.
-	SSHPublicKeyBody *string `type:"string" required:"true"`
+	SSHPublicKeyBody *string `type:"string" min:"1" max:"16384" required:"true"`
 
 	// The unique identifier for the SSH public key.
-	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" required:"true"`
+	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" min:"20" max:"128" required:"true"`
 
 	// The status of the SSH public key. Active means the key can be used for authentication
 	// with an AWS CodeCommit repository. Inactive means the key cannot be used.
@@ -9913,7 +11616,7 @@ type SSHPublicKey struct {
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The name of the IAM user associated with the SSH public key.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSSHPublicKey `json:"-" xml:"-"`
 }
@@ -9937,7 +11640,7 @@ func (s SSHPublicKey) GoString() string {
 // This data type is used as a response element in the ListSSHPublicKeys action.
 type SSHPublicKeyMetadata struct {
 	// The unique identifier for the SSH public key.
-	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" required:"true"`
+	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" min:"20" max:"128" required:"true"`
 
 	// The status of the SSH public key. Active means the key can be used for authentication
 	// with an AWS CodeCommit repository. Inactive means the key cannot be used.
@@ -9948,7 +11651,7 @@ type SSHPublicKeyMetadata struct {
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The name of the IAM user associated with the SSH public key.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSSHPublicKeyMetadata `json:"-" xml:"-"`
 }
@@ -9973,10 +11676,10 @@ func (s SSHPublicKeyMetadata) GoString() string {
 // action.
 type ServerCertificate struct {
 	// The contents of the public key certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" required:"true"`
 
 	// The contents of the public key certificate chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152"`
 
 	// The meta information of the server certificate, such as its name, path, ID,
 	// and ARN.
@@ -10009,7 +11712,7 @@ type ServerCertificateMetadata struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date on which the certificate is set to expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -10017,15 +11720,15 @@ type ServerCertificateMetadata struct {
 	// The path to the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" required:"true"`
 
 	// The stable and unique string identifying the server certificate. For more
 	// information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" required:"true"`
+	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The name that identifies the server certificate.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The date when the server certificate was uploaded.
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -10053,7 +11756,7 @@ type SetDefaultPolicyVersionInput struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The version of the policy to set as the default (operative) version.
 	//
@@ -10103,10 +11806,10 @@ func (s SetDefaultPolicyVersionOutput) GoString() string {
 // and ListSigningCertificates actions.
 type SigningCertificate struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" required:"true"`
 
 	// The ID for the signing certificate.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" required:"true"`
 
 	// The status of the signing certificate. Active means the key is valid for
 	// API calls, while Inactive means it is not.
@@ -10116,7 +11819,7 @@ type SigningCertificate struct {
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The name of the user the signing certificate is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSigningCertificate `json:"-" xml:"-"`
 }
@@ -10137,7 +11840,7 @@ func (s SigningCertificate) GoString() string {
 
 type UpdateAccessKeyInput struct {
 	// The access key ID of the secret access key you want to update.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The status you want to assign to the secret access key. Active means the
 	// key can be used for API calls to AWS, while Inactive means the key cannot
@@ -10145,7 +11848,7 @@ type UpdateAccessKeyInput struct {
 	Status *string `type:"string" required:"true"`
 
 	// The name of the user whose key you want to update.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataUpdateAccessKeyInput `json:"-" xml:"-"`
 }
@@ -10200,19 +11903,19 @@ type UpdateAccountPasswordPolicyInput struct {
 	// of 0 means IAM user passwords never expire.
 	//
 	// Default value: 0
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// The minimum number of characters allowed in an IAM user password.
 	//
 	// Default value: 6
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing. The default value of 0 means IAM users are not prevented from reusing
 	// previous passwords.
 	//
 	// Default value: 0
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether IAM user passwords must contain at least one lowercase
 	// character from the ISO basic Latin alphabet (a to z).
@@ -10277,10 +11980,10 @@ func (s UpdateAccountPasswordPolicyOutput) GoString() string {
 
 type UpdateAssumeRolePolicyInput struct {
 	// The policy that grants an entity permission to assume the role.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// The name of the role to update.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataUpdateAssumeRolePolicyInput `json:"-" xml:"-"`
 }
@@ -10320,13 +12023,13 @@ func (s UpdateAssumeRolePolicyOutput) GoString() string {
 type UpdateGroupInput struct {
 	// Name of the group to update. If you're changing the name of the group, this
 	// is the original name.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// New name for the group. Only include this if changing the group's name.
-	NewGroupName *string `type:"string"`
+	NewGroupName *string `type:"string" min:"1" max:"128"`
 
 	// New path for the group. Only include this if changing the group's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512"`
 
 	metadataUpdateGroupInput `json:"-" xml:"-"`
 }
@@ -10365,13 +12068,13 @@ func (s UpdateGroupOutput) GoString() string {
 
 type UpdateLoginProfileInput struct {
 	// The new password for the specified user.
-	Password *string `type:"string"`
+	Password *string `type:"string" sensitive:"true" min:"1" max:"128"`
 
 	// Require the specified user to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user whose password you want to update.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataUpdateLoginProfileInput `json:"-" xml:"-"`
 }
@@ -10412,7 +12115,7 @@ type UpdateOpenIDConnectProviderThumbprintInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to update the thumbprint for. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A list of certificate thumbprints that are associated with the specified
 	// IAM OpenID Connect provider. For more information, see CreateOpenIDConnectProvider.
@@ -10459,10 +12162,10 @@ type UpdateSAMLProviderInput struct {
 	// keys that can be used to validate the SAML authentication response (assertions)
 	// that are received from the IdP. You must generate the metadata document using
 	// the identity management software that is used as your organization's IdP.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider to update.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataUpdateSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -10484,7 +12187,7 @@ func (s UpdateSAMLProviderInput) GoString() string {
 // Contains the response to a successful UpdateSAMLProvider request.
 type UpdateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider that was updated.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataUpdateSAMLProviderOutput `json:"-" xml:"-"`
 }
@@ -10505,7 +12208,7 @@ func (s UpdateSAMLProviderOutput) GoString() string {
 
 type UpdateSSHPublicKeyInput struct {
 	// The unique identifier for the SSH public key.
-	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" required:"true"`
+	SSHPublicKeyID *string `locationName:"SSHPublicKeyId" type:"string" min:"20" max:"128" required:"true"`
 
 	// The status to assign to the SSH public key. Active means the key can be used
 	// for authentication with an AWS CodeCommit repository. Inactive means the
@@ -10513,7 +12216,7 @@ type UpdateSSHPublicKeyInput struct {
 	Status *string `type:"string" required:"true"`
 
 	// The name of the IAM user associated with the SSH public key.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataUpdateSSHPublicKeyInput `json:"-" xml:"-"`
 }
@@ -10553,15 +12256,15 @@ func (s UpdateSSHPublicKeyOutput) GoString() string {
 type UpdateServerCertificateInput struct {
 	// The new path for the server certificate. Include this only if you are updating
 	// the server certificate's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512"`
 
 	// The new name for the server certificate. Include this only if you are updating
 	// the server certificate's name. The name of the certificate cannot contain
 	// any spaces.
-	NewServerCertificateName *string `type:"string"`
+	NewServerCertificateName *string `type:"string" min:"1" max:"128"`
 
 	// The name of the server certificate that you want to update.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataUpdateServerCertificateInput `json:"-" xml:"-"`
 }
@@ -10600,7 +12303,7 @@ func (s UpdateServerCertificateOutput) GoString() string {
 
 type UpdateSigningCertificateInput struct {
 	// The ID of the signing certificate you want to update.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" required:"true"`
 
 	// The status you want to assign to the certificate. Active means the certificate
 	// can be used for API calls to AWS, while Inactive means the certificate cannot
@@ -10608,7 +12311,7 @@ type UpdateSigningCertificateInput struct {
 	Status *string `type:"string" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataUpdateSigningCertificateInput `json:"-" xml:"-"`
 }
@@ -10648,15 +12351,15 @@ func (s UpdateSigningCertificateOutput) GoString() string {
 type UpdateUserInput struct {
 	// New path for the user. Include this parameter only if you're changing the
 	// user's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512"`
 
 	// New name for the user. Include this parameter only if you're changing the
 	// user's name.
-	NewUserName *string `type:"string"`
+	NewUserName *string `type:"string" min:"1" max:"64"`
 
 	// Name of the user to update. If you're changing the name of the user, this
 	// is the original user name.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataUpdateUserInput `json:"-" xml:"-"`
 }
@@ -10696,10 +12399,10 @@ func (s UpdateUserOutput) GoString() string {
 type UploadSSHPublicKeyInput struct {
 	// The SSH public key. The public key must be encoded in ssh-rsa format or PEM
 	// format.
-	SSHPublicKeyBody *string `type:"string" required:"true"`
+	SSHPublicKeyBody *string `type:"string" min:"1" max:"16384" required:"true"`
 
 	// The name of the IAM user to associate the SSH public key with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataUploadSSHPublicKeyInput `json:"-" xml:"-"`
 }
@@ -10742,11 +12445,11 @@ func (s UploadSSHPublicKeyOutput) GoString() string {
 
 type UploadServerCertificateInput struct {
 	// The contents of the public key certificate in PEM-encoded format.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" required:"true"`
 
 	// The contents of the certificate chain. This is typically a concatenation
 	// of the PEM-encoded public key certificates of the chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152"`
 
 	// The path for the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -10759,14 +12462,14 @@ type UploadServerCertificateInput struct {
 	// CloudFront distributions, you must specify a path using the --path option.
 	// The path must begin with /cloudfront and must include a trailing slash (for
 	// example, /cloudfront/test/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	// The contents of the private key in PEM-encoded format.
-	PrivateKey *string `type:"string" required:"true"`
+	PrivateKey *string `type:"string" sensitive:"true" min:"1" max:"16384" required:"true"`
 
 	// The name for the server certificate. Do not include the path in this value.
 	// The name of the certificate cannot contain any spaces.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataUploadServerCertificateInput `json:"-" xml:"-"`
 }
@@ -10810,10 +12513,10 @@ func (s UploadServerCertificateOutput) GoString() string {
 
 type UploadSigningCertificateInput struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" required:"true"`
 
 	// The name of the user the signing certificate is for.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128"`
 
 	metadataUploadSigningCertificateInput `json:"-" xml:"-"`
 }
@@ -10867,7 +12570,7 @@ type User struct {
 	// The Amazon Resource Name (ARN) that identifies the user. For more information
 	// about ARNs and how to use ARNs in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the user was created.
@@ -10894,15 +12597,15 @@ type User struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" required:"true"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string" required:"true"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataUser `json:"-" xml:"-"`
 }
@@ -10932,7 +12635,7 @@ type UserDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// A list of the managed policies attached to the user.
 	AttachedManagedPolicies []*AttachedPolicy `type:"list"`
@@ -10947,15 +12650,15 @@ type UserDetail struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64"`
 
 	// A list of the inline policies embedded in the user.
 	UserPolicyList []*PolicyDetail `type:"list"`
@@ -10981,7 +12684,7 @@ func (s UserDetail) GoString() string {
 type VirtualMFADevice struct {
 	// The Base32 seed defined as specified in RFC3548 (http://www.ietf.org/rfc/rfc3548.txt).
 	// The Base32StringSeed is Base64-encoded.
-	Base32StringSeed []byte `type:"blob"`
+	Base32StringSeed []byte `type:"blob" sensitive:"true"`
 
 	// The date and time on which the virtual MFA device was enabled.
 	EnableDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -10990,10 +12693,10 @@ type VirtualMFADevice struct {
 	// where $virtualMFADeviceName is one of the create call arguments, AccountName
 	// is the user name if set (otherwise, the account ID otherwise), and Base32String
 	// is the seed in Base32 format. The Base32String value is Base64-encoded.
-	QRCodePNG []byte `type:"blob"`
+	QRCodePNG []byte `type:"blob" sensitive:"true"`
 
 	// The serial number associated with VirtualMFADevice.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" required:"true"`
 
 	// Contains information about an IAM user entity.
 	//
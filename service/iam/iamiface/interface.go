@@ -4,6 +4,7 @@
 package iamiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 )
 
@@ -11,219 +12,529 @@ import (
 type IAMAPI interface {
 	AddClientIDToOpenIDConnectProvider(*iam.AddClientIDToOpenIDConnectProviderInput) (*iam.AddClientIDToOpenIDConnectProviderOutput, error)
 
+	AddClientIDToOpenIDConnectProviderWithContext(aws.Context, *iam.AddClientIDToOpenIDConnectProviderInput, ...aws.Option) (*iam.AddClientIDToOpenIDConnectProviderOutput, error)
+
 	AddRoleToInstanceProfile(*iam.AddRoleToInstanceProfileInput) (*iam.AddRoleToInstanceProfileOutput, error)
 
+	AddRoleToInstanceProfileWithContext(aws.Context, *iam.AddRoleToInstanceProfileInput, ...aws.Option) (*iam.AddRoleToInstanceProfileOutput, error)
+
 	AddUserToGroup(*iam.AddUserToGroupInput) (*iam.AddUserToGroupOutput, error)
 
+	AddUserToGroupWithContext(aws.Context, *iam.AddUserToGroupInput, ...aws.Option) (*iam.AddUserToGroupOutput, error)
+
 	AttachGroupPolicy(*iam.AttachGroupPolicyInput) (*iam.AttachGroupPolicyOutput, error)
 
+	AttachGroupPolicyWithContext(aws.Context, *iam.AttachGroupPolicyInput, ...aws.Option) (*iam.AttachGroupPolicyOutput, error)
+
 	AttachRolePolicy(*iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error)
 
+	AttachRolePolicyWithContext(aws.Context, *iam.AttachRolePolicyInput, ...aws.Option) (*iam.AttachRolePolicyOutput, error)
+
 	AttachUserPolicy(*iam.AttachUserPolicyInput) (*iam.AttachUserPolicyOutput, error)
 
+	AttachUserPolicyWithContext(aws.Context, *iam.AttachUserPolicyInput, ...aws.Option) (*iam.AttachUserPolicyOutput, error)
+
 	ChangePassword(*iam.ChangePasswordInput) (*iam.ChangePasswordOutput, error)
 
+	ChangePasswordWithContext(aws.Context, *iam.ChangePasswordInput, ...aws.Option) (*iam.ChangePasswordOutput, error)
+
 	CreateAccessKey(*iam.CreateAccessKeyInput) (*iam.CreateAccessKeyOutput, error)
 
+	CreateAccessKeyWithContext(aws.Context, *iam.CreateAccessKeyInput, ...aws.Option) (*iam.CreateAccessKeyOutput, error)
+
 	CreateAccountAlias(*iam.CreateAccountAliasInput) (*iam.CreateAccountAliasOutput, error)
 
+	CreateAccountAliasWithContext(aws.Context, *iam.CreateAccountAliasInput, ...aws.Option) (*iam.CreateAccountAliasOutput, error)
+
 	CreateGroup(*iam.CreateGroupInput) (*iam.CreateGroupOutput, error)
 
+	CreateGroupWithContext(aws.Context, *iam.CreateGroupInput, ...aws.Option) (*iam.CreateGroupOutput, error)
+
 	CreateInstanceProfile(*iam.CreateInstanceProfileInput) (*iam.CreateInstanceProfileOutput, error)
 
+	CreateInstanceProfileWithContext(aws.Context, *iam.CreateInstanceProfileInput, ...aws.Option) (*iam.CreateInstanceProfileOutput, error)
+
 	CreateLoginProfile(*iam.CreateLoginProfileInput) (*iam.CreateLoginProfileOutput, error)
 
+	CreateLoginProfileWithContext(aws.Context, *iam.CreateLoginProfileInput, ...aws.Option) (*iam.CreateLoginProfileOutput, error)
+
 	CreateOpenIDConnectProvider(*iam.CreateOpenIDConnectProviderInput) (*iam.CreateOpenIDConnectProviderOutput, error)
 
+	CreateOpenIDConnectProviderWithContext(aws.Context, *iam.CreateOpenIDConnectProviderInput, ...aws.Option) (*iam.CreateOpenIDConnectProviderOutput, error)
+
 	CreatePolicy(*iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error)
 
+	CreatePolicyWithContext(aws.Context, *iam.CreatePolicyInput, ...aws.Option) (*iam.CreatePolicyOutput, error)
+
 	CreatePolicyVersion(*iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error)
 
+	CreatePolicyVersionWithContext(aws.Context, *iam.CreatePolicyVersionInput, ...aws.Option) (*iam.CreatePolicyVersionOutput, error)
+
 	CreateRole(*iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
 
+	CreateRoleWithContext(aws.Context, *iam.CreateRoleInput, ...aws.Option) (*iam.CreateRoleOutput, error)
+
 	CreateSAMLProvider(*iam.CreateSAMLProviderInput) (*iam.CreateSAMLProviderOutput, error)
 
+	CreateSAMLProviderWithContext(aws.Context, *iam.CreateSAMLProviderInput, ...aws.Option) (*iam.CreateSAMLProviderOutput, error)
+
 	CreateUser(*iam.CreateUserInput) (*iam.CreateUserOutput, error)
 
+	CreateUserWithContext(aws.Context, *iam.CreateUserInput, ...aws.Option) (*iam.CreateUserOutput, error)
+
 	CreateVirtualMFADevice(*iam.CreateVirtualMFADeviceInput) (*iam.CreateVirtualMFADeviceOutput, error)
 
+	CreateVirtualMFADeviceWithContext(aws.Context, *iam.CreateVirtualMFADeviceInput, ...aws.Option) (*iam.CreateVirtualMFADeviceOutput, error)
+
 	DeactivateMFADevice(*iam.DeactivateMFADeviceInput) (*iam.DeactivateMFADeviceOutput, error)
 
+	DeactivateMFADeviceWithContext(aws.Context, *iam.DeactivateMFADeviceInput, ...aws.Option) (*iam.DeactivateMFADeviceOutput, error)
+
 	DeleteAccessKey(*iam.DeleteAccessKeyInput) (*iam.DeleteAccessKeyOutput, error)
 
+	DeleteAccessKeyWithContext(aws.Context, *iam.DeleteAccessKeyInput, ...aws.Option) (*iam.DeleteAccessKeyOutput, error)
+
 	DeleteAccountAlias(*iam.DeleteAccountAliasInput) (*iam.DeleteAccountAliasOutput, error)
 
+	DeleteAccountAliasWithContext(aws.Context, *iam.DeleteAccountAliasInput, ...aws.Option) (*iam.DeleteAccountAliasOutput, error)
+
 	DeleteAccountPasswordPolicy(*iam.DeleteAccountPasswordPolicyInput) (*iam.DeleteAccountPasswordPolicyOutput, error)
 
+	DeleteAccountPasswordPolicyWithContext(aws.Context, *iam.DeleteAccountPasswordPolicyInput, ...aws.Option) (*iam.DeleteAccountPasswordPolicyOutput, error)
+
 	DeleteGroup(*iam.DeleteGroupInput) (*iam.DeleteGroupOutput, error)
 
+	DeleteGroupWithContext(aws.Context, *iam.DeleteGroupInput, ...aws.Option) (*iam.DeleteGroupOutput, error)
+
 	DeleteGroupPolicy(*iam.DeleteGroupPolicyInput) (*iam.DeleteGroupPolicyOutput, error)
 
+	DeleteGroupPolicyWithContext(aws.Context, *iam.DeleteGroupPolicyInput, ...aws.Option) (*iam.DeleteGroupPolicyOutput, error)
+
 	DeleteInstanceProfile(*iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error)
 
+	DeleteInstanceProfileWithContext(aws.Context, *iam.DeleteInstanceProfileInput, ...aws.Option) (*iam.DeleteInstanceProfileOutput, error)
+
 	DeleteLoginProfile(*iam.DeleteLoginProfileInput) (*iam.DeleteLoginProfileOutput, error)
 
+	DeleteLoginProfileWithContext(aws.Context, *iam.DeleteLoginProfileInput, ...aws.Option) (*iam.DeleteLoginProfileOutput, error)
+
 	DeleteOpenIDConnectProvider(*iam.DeleteOpenIDConnectProviderInput) (*iam.DeleteOpenIDConnectProviderOutput, error)
 
+	DeleteOpenIDConnectProviderWithContext(aws.Context, *iam.DeleteOpenIDConnectProviderInput, ...aws.Option) (*iam.DeleteOpenIDConnectProviderOutput, error)
+
 	DeletePolicy(*iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error)
 
+	DeletePolicyWithContext(aws.Context, *iam.DeletePolicyInput, ...aws.Option) (*iam.DeletePolicyOutput, error)
+
 	DeletePolicyVersion(*iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error)
 
+	DeletePolicyVersionWithContext(aws.Context, *iam.DeletePolicyVersionInput, ...aws.Option) (*iam.DeletePolicyVersionOutput, error)
+
 	DeleteRole(*iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 
+	DeleteRoleWithContext(aws.Context, *iam.DeleteRoleInput, ...aws.Option) (*iam.DeleteRoleOutput, error)
+
 	DeleteRolePolicy(*iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error)
 
+	DeleteRolePolicyWithContext(aws.Context, *iam.DeleteRolePolicyInput, ...aws.Option) (*iam.DeleteRolePolicyOutput, error)
+
 	DeleteSAMLProvider(*iam.DeleteSAMLProviderInput) (*iam.DeleteSAMLProviderOutput, error)
 
+	DeleteSAMLProviderWithContext(aws.Context, *iam.DeleteSAMLProviderInput, ...aws.Option) (*iam.DeleteSAMLProviderOutput, error)
+
 	DeleteSSHPublicKey(*iam.DeleteSSHPublicKeyInput) (*iam.DeleteSSHPublicKeyOutput, error)
 
+	DeleteSSHPublicKeyWithContext(aws.Context, *iam.DeleteSSHPublicKeyInput, ...aws.Option) (*iam.DeleteSSHPublicKeyOutput, error)
+
 	DeleteServerCertificate(*iam.DeleteServerCertificateInput) (*iam.DeleteServerCertificateOutput, error)
 
+	DeleteServerCertificateWithContext(aws.Context, *iam.DeleteServerCertificateInput, ...aws.Option) (*iam.DeleteServerCertificateOutput, error)
+
 	DeleteSigningCertificate(*iam.DeleteSigningCertificateInput) (*iam.DeleteSigningCertificateOutput, error)
 
+	DeleteSigningCertificateWithContext(aws.Context, *iam.DeleteSigningCertificateInput, ...aws.Option) (*iam.DeleteSigningCertificateOutput, error)
+
 	DeleteUser(*iam.DeleteUserInput) (*iam.DeleteUserOutput, error)
 
+	DeleteUserWithContext(aws.Context, *iam.DeleteUserInput, ...aws.Option) (*iam.DeleteUserOutput, error)
+
 	DeleteUserPolicy(*iam.DeleteUserPolicyInput) (*iam.DeleteUserPolicyOutput, error)
 
+	DeleteUserPolicyWithContext(aws.Context, *iam.DeleteUserPolicyInput, ...aws.Option) (*iam.DeleteUserPolicyOutput, error)
+
 	DeleteVirtualMFADevice(*iam.DeleteVirtualMFADeviceInput) (*iam.DeleteVirtualMFADeviceOutput, error)
 
+	DeleteVirtualMFADeviceWithContext(aws.Context, *iam.DeleteVirtualMFADeviceInput, ...aws.Option) (*iam.DeleteVirtualMFADeviceOutput, error)
+
 	DetachGroupPolicy(*iam.DetachGroupPolicyInput) (*iam.DetachGroupPolicyOutput, error)
 
+	DetachGroupPolicyWithContext(aws.Context, *iam.DetachGroupPolicyInput, ...aws.Option) (*iam.DetachGroupPolicyOutput, error)
+
 	DetachRolePolicy(*iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error)
 
+	DetachRolePolicyWithContext(aws.Context, *iam.DetachRolePolicyInput, ...aws.Option) (*iam.DetachRolePolicyOutput, error)
+
 	DetachUserPolicy(*iam.DetachUserPolicyInput) (*iam.DetachUserPolicyOutput, error)
 
+	DetachUserPolicyWithContext(aws.Context, *iam.DetachUserPolicyInput, ...aws.Option) (*iam.DetachUserPolicyOutput, error)
+
 	EnableMFADevice(*iam.EnableMFADeviceInput) (*iam.EnableMFADeviceOutput, error)
 
+	EnableMFADeviceWithContext(aws.Context, *iam.EnableMFADeviceInput, ...aws.Option) (*iam.EnableMFADeviceOutput, error)
+
 	GenerateCredentialReport(*iam.GenerateCredentialReportInput) (*iam.GenerateCredentialReportOutput, error)
 
+	GenerateCredentialReportWithContext(aws.Context, *iam.GenerateCredentialReportInput, ...aws.Option) (*iam.GenerateCredentialReportOutput, error)
+
 	GetAccessKeyLastUsed(*iam.GetAccessKeyLastUsedInput) (*iam.GetAccessKeyLastUsedOutput, error)
 
+	GetAccessKeyLastUsedWithContext(aws.Context, *iam.GetAccessKeyLastUsedInput, ...aws.Option) (*iam.GetAccessKeyLastUsedOutput, error)
+
 	GetAccountAuthorizationDetails(*iam.GetAccountAuthorizationDetailsInput) (*iam.GetAccountAuthorizationDetailsOutput, error)
 
+	GetAccountAuthorizationDetailsWithContext(aws.Context, *iam.GetAccountAuthorizationDetailsInput, ...aws.Option) (*iam.GetAccountAuthorizationDetailsOutput, error)
+
+	GetAccountAuthorizationDetailsPages(*iam.GetAccountAuthorizationDetailsInput, func(*iam.GetAccountAuthorizationDetailsOutput, bool) bool) error
+
+	GetAccountAuthorizationDetailsPagesWithContext(aws.Context, *iam.GetAccountAuthorizationDetailsInput, func(*iam.GetAccountAuthorizationDetailsOutput, bool) bool, ...aws.Option) error
+
 	GetAccountPasswordPolicy(*iam.GetAccountPasswordPolicyInput) (*iam.GetAccountPasswordPolicyOutput, error)
 
+	GetAccountPasswordPolicyWithContext(aws.Context, *iam.GetAccountPasswordPolicyInput, ...aws.Option) (*iam.GetAccountPasswordPolicyOutput, error)
+
 	GetAccountSummary(*iam.GetAccountSummaryInput) (*iam.GetAccountSummaryOutput, error)
 
+	GetAccountSummaryWithContext(aws.Context, *iam.GetAccountSummaryInput, ...aws.Option) (*iam.GetAccountSummaryOutput, error)
+
 	GetCredentialReport(*iam.GetCredentialReportInput) (*iam.GetCredentialReportOutput, error)
 
+	GetCredentialReportWithContext(aws.Context, *iam.GetCredentialReportInput, ...aws.Option) (*iam.GetCredentialReportOutput, error)
+
 	GetGroup(*iam.GetGroupInput) (*iam.GetGroupOutput, error)
 
+	GetGroupWithContext(aws.Context, *iam.GetGroupInput, ...aws.Option) (*iam.GetGroupOutput, error)
+
+	GetGroupPages(*iam.GetGroupInput, func(*iam.GetGroupOutput, bool) bool) error
+
+	GetGroupPagesWithContext(aws.Context, *iam.GetGroupInput, func(*iam.GetGroupOutput, bool) bool, ...aws.Option) error
+
 	GetGroupPolicy(*iam.GetGroupPolicyInput) (*iam.GetGroupPolicyOutput, error)
 
+	GetGroupPolicyWithContext(aws.Context, *iam.GetGroupPolicyInput, ...aws.Option) (*iam.GetGroupPolicyOutput, error)
+
 	GetInstanceProfile(*iam.GetInstanceProfileInput) (*iam.GetInstanceProfileOutput, error)
 
+	GetInstanceProfileWithContext(aws.Context, *iam.GetInstanceProfileInput, ...aws.Option) (*iam.GetInstanceProfileOutput, error)
+
 	GetLoginProfile(*iam.GetLoginProfileInput) (*iam.GetLoginProfileOutput, error)
 
+	GetLoginProfileWithContext(aws.Context, *iam.GetLoginProfileInput, ...aws.Option) (*iam.GetLoginProfileOutput, error)
+
 	GetOpenIDConnectProvider(*iam.GetOpenIDConnectProviderInput) (*iam.GetOpenIDConnectProviderOutput, error)
 
+	GetOpenIDConnectProviderWithContext(aws.Context, *iam.GetOpenIDConnectProviderInput, ...aws.Option) (*iam.GetOpenIDConnectProviderOutput, error)
+
 	GetPolicy(*iam.GetPolicyInput) (*iam.GetPolicyOutput, error)
 
+	GetPolicyWithContext(aws.Context, *iam.GetPolicyInput, ...aws.Option) (*iam.GetPolicyOutput, error)
+
 	GetPolicyVersion(*iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error)
 
+	GetPolicyVersionWithContext(aws.Context, *iam.GetPolicyVersionInput, ...aws.Option) (*iam.GetPolicyVersionOutput, error)
+
 	GetRole(*iam.GetRoleInput) (*iam.GetRoleOutput, error)
 
+	GetRoleWithContext(aws.Context, *iam.GetRoleInput, ...aws.Option) (*iam.GetRoleOutput, error)
+
 	GetRolePolicy(*iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error)
 
+	GetRolePolicyWithContext(aws.Context, *iam.GetRolePolicyInput, ...aws.Option) (*iam.GetRolePolicyOutput, error)
+
 	GetSAMLProvider(*iam.GetSAMLProviderInput) (*iam.GetSAMLProviderOutput, error)
 
+	GetSAMLProviderWithContext(aws.Context, *iam.GetSAMLProviderInput, ...aws.Option) (*iam.GetSAMLProviderOutput, error)
+
 	GetSSHPublicKey(*iam.GetSSHPublicKeyInput) (*iam.GetSSHPublicKeyOutput, error)
 
+	GetSSHPublicKeyWithContext(aws.Context, *iam.GetSSHPublicKeyInput, ...aws.Option) (*iam.GetSSHPublicKeyOutput, error)
+
 	GetServerCertificate(*iam.GetServerCertificateInput) (*iam.GetServerCertificateOutput, error)
 
+	GetServerCertificateWithContext(aws.Context, *iam.GetServerCertificateInput, ...aws.Option) (*iam.GetServerCertificateOutput, error)
+
 	GetUser(*iam.GetUserInput) (*iam.GetUserOutput, error)
 
+	GetUserWithContext(aws.Context, *iam.GetUserInput, ...aws.Option) (*iam.GetUserOutput, error)
+
 	GetUserPolicy(*iam.GetUserPolicyInput) (*iam.GetUserPolicyOutput, error)
 
+	GetUserPolicyWithContext(aws.Context, *iam.GetUserPolicyInput, ...aws.Option) (*iam.GetUserPolicyOutput, error)
+
 	ListAccessKeys(*iam.ListAccessKeysInput) (*iam.ListAccessKeysOutput, error)
 
+	ListAccessKeysWithContext(aws.Context, *iam.ListAccessKeysInput, ...aws.Option) (*iam.ListAccessKeysOutput, error)
+
+	ListAccessKeysPages(*iam.ListAccessKeysInput, func(*iam.ListAccessKeysOutput, bool) bool) error
+
+	ListAccessKeysPagesWithContext(aws.Context, *iam.ListAccessKeysInput, func(*iam.ListAccessKeysOutput, bool) bool, ...aws.Option) error
+
 	ListAccountAliases(*iam.ListAccountAliasesInput) (*iam.ListAccountAliasesOutput, error)
 
+	ListAccountAliasesWithContext(aws.Context, *iam.ListAccountAliasesInput, ...aws.Option) (*iam.ListAccountAliasesOutput, error)
+
+	ListAccountAliasesPages(*iam.ListAccountAliasesInput, func(*iam.ListAccountAliasesOutput, bool) bool) error
+
+	ListAccountAliasesPagesWithContext(aws.Context, *iam.ListAccountAliasesInput, func(*iam.ListAccountAliasesOutput, bool) bool, ...aws.Option) error
+
 	ListAttachedGroupPolicies(*iam.ListAttachedGroupPoliciesInput) (*iam.ListAttachedGroupPoliciesOutput, error)
 
+	ListAttachedGroupPoliciesWithContext(aws.Context, *iam.ListAttachedGroupPoliciesInput, ...aws.Option) (*iam.ListAttachedGroupPoliciesOutput, error)
+
+	ListAttachedGroupPoliciesPages(*iam.ListAttachedGroupPoliciesInput, func(*iam.ListAttachedGroupPoliciesOutput, bool) bool) error
+
+	ListAttachedGroupPoliciesPagesWithContext(aws.Context, *iam.ListAttachedGroupPoliciesInput, func(*iam.ListAttachedGroupPoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListAttachedRolePolicies(*iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error)
 
+	ListAttachedRolePoliciesWithContext(aws.Context, *iam.ListAttachedRolePoliciesInput, ...aws.Option) (*iam.ListAttachedRolePoliciesOutput, error)
+
+	ListAttachedRolePoliciesPages(*iam.ListAttachedRolePoliciesInput, func(*iam.ListAttachedRolePoliciesOutput, bool) bool) error
+
+	ListAttachedRolePoliciesPagesWithContext(aws.Context, *iam.ListAttachedRolePoliciesInput, func(*iam.ListAttachedRolePoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListAttachedUserPolicies(*iam.ListAttachedUserPoliciesInput) (*iam.ListAttachedUserPoliciesOutput, error)
 
+	ListAttachedUserPoliciesWithContext(aws.Context, *iam.ListAttachedUserPoliciesInput, ...aws.Option) (*iam.ListAttachedUserPoliciesOutput, error)
+
+	ListAttachedUserPoliciesPages(*iam.ListAttachedUserPoliciesInput, func(*iam.ListAttachedUserPoliciesOutput, bool) bool) error
+
+	ListAttachedUserPoliciesPagesWithContext(aws.Context, *iam.ListAttachedUserPoliciesInput, func(*iam.ListAttachedUserPoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListEntitiesForPolicy(*iam.ListEntitiesForPolicyInput) (*iam.ListEntitiesForPolicyOutput, error)
 
+	ListEntitiesForPolicyWithContext(aws.Context, *iam.ListEntitiesForPolicyInput, ...aws.Option) (*iam.ListEntitiesForPolicyOutput, error)
+
+	ListEntitiesForPolicyPages(*iam.ListEntitiesForPolicyInput, func(*iam.ListEntitiesForPolicyOutput, bool) bool) error
+
+	ListEntitiesForPolicyPagesWithContext(aws.Context, *iam.ListEntitiesForPolicyInput, func(*iam.ListEntitiesForPolicyOutput, bool) bool, ...aws.Option) error
+
 	ListGroupPolicies(*iam.ListGroupPoliciesInput) (*iam.ListGroupPoliciesOutput, error)
 
+	ListGroupPoliciesWithContext(aws.Context, *iam.ListGroupPoliciesInput, ...aws.Option) (*iam.ListGroupPoliciesOutput, error)
+
+	ListGroupPoliciesPages(*iam.ListGroupPoliciesInput, func(*iam.ListGroupPoliciesOutput, bool) bool) error
+
+	ListGroupPoliciesPagesWithContext(aws.Context, *iam.ListGroupPoliciesInput, func(*iam.ListGroupPoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListGroups(*iam.ListGroupsInput) (*iam.ListGroupsOutput, error)
 
+	ListGroupsWithContext(aws.Context, *iam.ListGroupsInput, ...aws.Option) (*iam.ListGroupsOutput, error)
+
+	ListGroupsPages(*iam.ListGroupsInput, func(*iam.ListGroupsOutput, bool) bool) error
+
+	ListGroupsPagesWithContext(aws.Context, *iam.ListGroupsInput, func(*iam.ListGroupsOutput, bool) bool, ...aws.Option) error
+
 	ListGroupsForUser(*iam.ListGroupsForUserInput) (*iam.ListGroupsForUserOutput, error)
 
+	ListGroupsForUserWithContext(aws.Context, *iam.ListGroupsForUserInput, ...aws.Option) (*iam.ListGroupsForUserOutput, error)
+
+	ListGroupsForUserPages(*iam.ListGroupsForUserInput, func(*iam.ListGroupsForUserOutput, bool) bool) error
+
+	ListGroupsForUserPagesWithContext(aws.Context, *iam.ListGroupsForUserInput, func(*iam.ListGroupsForUserOutput, bool) bool, ...aws.Option) error
+
 	ListInstanceProfiles(*iam.ListInstanceProfilesInput) (*iam.ListInstanceProfilesOutput, error)
 
+	ListInstanceProfilesWithContext(aws.Context, *iam.ListInstanceProfilesInput, ...aws.Option) (*iam.ListInstanceProfilesOutput, error)
+
+	ListInstanceProfilesPages(*iam.ListInstanceProfilesInput, func(*iam.ListInstanceProfilesOutput, bool) bool) error
+
+	ListInstanceProfilesPagesWithContext(aws.Context, *iam.ListInstanceProfilesInput, func(*iam.ListInstanceProfilesOutput, bool) bool, ...aws.Option) error
+
 	ListInstanceProfilesForRole(*iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error)
 
+	ListInstanceProfilesForRoleWithContext(aws.Context, *iam.ListInstanceProfilesForRoleInput, ...aws.Option) (*iam.ListInstanceProfilesForRoleOutput, error)
+
+	ListInstanceProfilesForRolePages(*iam.ListInstanceProfilesForRoleInput, func(*iam.ListInstanceProfilesForRoleOutput, bool) bool) error
+
+	ListInstanceProfilesForRolePagesWithContext(aws.Context, *iam.ListInstanceProfilesForRoleInput, func(*iam.ListInstanceProfilesForRoleOutput, bool) bool, ...aws.Option) error
+
 	ListMFADevices(*iam.ListMFADevicesInput) (*iam.ListMFADevicesOutput, error)
 
+	ListMFADevicesWithContext(aws.Context, *iam.ListMFADevicesInput, ...aws.Option) (*iam.ListMFADevicesOutput, error)
+
+	ListMFADevicesPages(*iam.ListMFADevicesInput, func(*iam.ListMFADevicesOutput, bool) bool) error
+
+	ListMFADevicesPagesWithContext(aws.Context, *iam.ListMFADevicesInput, func(*iam.ListMFADevicesOutput, bool) bool, ...aws.Option) error
+
 	ListOpenIDConnectProviders(*iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
 
+	ListOpenIDConnectProvidersWithContext(aws.Context, *iam.ListOpenIDConnectProvidersInput, ...aws.Option) (*iam.ListOpenIDConnectProvidersOutput, error)
+
 	ListPolicies(*iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
 
+	ListPoliciesWithContext(aws.Context, *iam.ListPoliciesInput, ...aws.Option) (*iam.ListPoliciesOutput, error)
+
+	ListPoliciesPages(*iam.ListPoliciesInput, func(*iam.ListPoliciesOutput, bool) bool) error
+
+	ListPoliciesPagesWithContext(aws.Context, *iam.ListPoliciesInput, func(*iam.ListPoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListPolicyVersions(*iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error)
 
+	ListPolicyVersionsWithContext(aws.Context, *iam.ListPolicyVersionsInput, ...aws.Option) (*iam.ListPolicyVersionsOutput, error)
+
 	ListRolePolicies(*iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error)
 
+	ListRolePoliciesWithContext(aws.Context, *iam.ListRolePoliciesInput, ...aws.Option) (*iam.ListRolePoliciesOutput, error)
+
+	ListRolePoliciesPages(*iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool) error
+
+	ListRolePoliciesPagesWithContext(aws.Context, *iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListRoles(*iam.ListRolesInput) (*iam.ListRolesOutput, error)
 
+	ListRolesWithContext(aws.Context, *iam.ListRolesInput, ...aws.Option) (*iam.ListRolesOutput, error)
+
+	ListRolesPages(*iam.ListRolesInput, func(*iam.ListRolesOutput, bool) bool) error
+
+	ListRolesPagesWithContext(aws.Context, *iam.ListRolesInput, func(*iam.ListRolesOutput, bool) bool, ...aws.Option) error
+
 	ListSAMLProviders(*iam.ListSAMLProvidersInput) (*iam.ListSAMLProvidersOutput, error)
 
+	ListSAMLProvidersWithContext(aws.Context, *iam.ListSAMLProvidersInput, ...aws.Option) (*iam.ListSAMLProvidersOutput, error)
+
 	ListSSHPublicKeys(*iam.ListSSHPublicKeysInput) (*iam.ListSSHPublicKeysOutput, error)
 
+	ListSSHPublicKeysWithContext(aws.Context, *iam.ListSSHPublicKeysInput, ...aws.Option) (*iam.ListSSHPublicKeysOutput, error)
+
 	ListServerCertificates(*iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error)
 
+	ListServerCertificatesWithContext(aws.Context, *iam.ListServerCertificatesInput, ...aws.Option) (*iam.ListServerCertificatesOutput, error)
+
+	ListServerCertificatesPages(*iam.ListServerCertificatesInput, func(*iam.ListServerCertificatesOutput, bool) bool) error
+
+	ListServerCertificatesPagesWithContext(aws.Context, *iam.ListServerCertificatesInput, func(*iam.ListServerCertificatesOutput, bool) bool, ...aws.Option) error
+
 	ListSigningCertificates(*iam.ListSigningCertificatesInput) (*iam.ListSigningCertificatesOutput, error)
 
+	ListSigningCertificatesWithContext(aws.Context, *iam.ListSigningCertificatesInput, ...aws.Option) (*iam.ListSigningCertificatesOutput, error)
+
+	ListSigningCertificatesPages(*iam.ListSigningCertificatesInput, func(*iam.ListSigningCertificatesOutput, bool) bool) error
+
+	ListSigningCertificatesPagesWithContext(aws.Context, *iam.ListSigningCertificatesInput, func(*iam.ListSigningCertificatesOutput, bool) bool, ...aws.Option) error
+
 	ListUserPolicies(*iam.ListUserPoliciesInput) (*iam.ListUserPoliciesOutput, error)
 
+	ListUserPoliciesWithContext(aws.Context, *iam.ListUserPoliciesInput, ...aws.Option) (*iam.ListUserPoliciesOutput, error)
+
+	ListUserPoliciesPages(*iam.ListUserPoliciesInput, func(*iam.ListUserPoliciesOutput, bool) bool) error
+
+	ListUserPoliciesPagesWithContext(aws.Context, *iam.ListUserPoliciesInput, func(*iam.ListUserPoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListUsers(*iam.ListUsersInput) (*iam.ListUsersOutput, error)
 
+	ListUsersWithContext(aws.Context, *iam.ListUsersInput, ...aws.Option) (*iam.ListUsersOutput, error)
+
+	ListUsersPages(*iam.ListUsersInput, func(*iam.ListUsersOutput, bool) bool) error
+
+	ListUsersPagesWithContext(aws.Context, *iam.ListUsersInput, func(*iam.ListUsersOutput, bool) bool, ...aws.Option) error
+
 	ListVirtualMFADevices(*iam.ListVirtualMFADevicesInput) (*iam.ListVirtualMFADevicesOutput, error)
 
+	ListVirtualMFADevicesWithContext(aws.Context, *iam.ListVirtualMFADevicesInput, ...aws.Option) (*iam.ListVirtualMFADevicesOutput, error)
+
+	ListVirtualMFADevicesPages(*iam.ListVirtualMFADevicesInput, func(*iam.ListVirtualMFADevicesOutput, bool) bool) error
+
+	ListVirtualMFADevicesPagesWithContext(aws.Context, *iam.ListVirtualMFADevicesInput, func(*iam.ListVirtualMFADevicesOutput, bool) bool, ...aws.Option) error
+
 	PutGroupPolicy(*iam.PutGroupPolicyInput) (*iam.PutGroupPolicyOutput, error)
 
+	PutGroupPolicyWithContext(aws.Context, *iam.PutGroupPolicyInput, ...aws.Option) (*iam.PutGroupPolicyOutput, error)
+
 	PutRolePolicy(*iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
 
+	PutRolePolicyWithContext(aws.Context, *iam.PutRolePolicyInput, ...aws.Option) (*iam.PutRolePolicyOutput, error)
+
 	PutUserPolicy(*iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error)
 
+	PutUserPolicyWithContext(aws.Context, *iam.PutUserPolicyInput, ...aws.Option) (*iam.PutUserPolicyOutput, error)
+
 	RemoveClientIDFromOpenIDConnectProvider(*iam.RemoveClientIDFromOpenIDConnectProviderInput) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
 
+	RemoveClientIDFromOpenIDConnectProviderWithContext(aws.Context, *iam.RemoveClientIDFromOpenIDConnectProviderInput, ...aws.Option) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
+
 	RemoveRoleFromInstanceProfile(*iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error)
 
+	RemoveRoleFromInstanceProfileWithContext(aws.Context, *iam.RemoveRoleFromInstanceProfileInput, ...aws.Option) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+
 	RemoveUserFromGroup(*iam.RemoveUserFromGroupInput) (*iam.RemoveUserFromGroupOutput, error)
 
+	RemoveUserFromGroupWithContext(aws.Context, *iam.RemoveUserFromGroupInput, ...aws.Option) (*iam.RemoveUserFromGroupOutput, error)
+
 	ResyncMFADevice(*iam.ResyncMFADeviceInput) (*iam.ResyncMFADeviceOutput, error)
 
+	ResyncMFADeviceWithContext(aws.Context, *iam.ResyncMFADeviceInput, ...aws.Option) (*iam.ResyncMFADeviceOutput, error)
+
 	SetDefaultPolicyVersion(*iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error)
 
+	SetDefaultPolicyVersionWithContext(aws.Context, *iam.SetDefaultPolicyVersionInput, ...aws.Option) (*iam.SetDefaultPolicyVersionOutput, error)
+
 	UpdateAccessKey(*iam.UpdateAccessKeyInput) (*iam.UpdateAccessKeyOutput, error)
 
+	UpdateAccessKeyWithContext(aws.Context, *iam.UpdateAccessKeyInput, ...aws.Option) (*iam.UpdateAccessKeyOutput, error)
+
 	UpdateAccountPasswordPolicy(*iam.UpdateAccountPasswordPolicyInput) (*iam.UpdateAccountPasswordPolicyOutput, error)
 
+	UpdateAccountPasswordPolicyWithContext(aws.Context, *iam.UpdateAccountPasswordPolicyInput, ...aws.Option) (*iam.UpdateAccountPasswordPolicyOutput, error)
+
 	UpdateAssumeRolePolicy(*iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error)
 
+	UpdateAssumeRolePolicyWithContext(aws.Context, *iam.UpdateAssumeRolePolicyInput, ...aws.Option) (*iam.UpdateAssumeRolePolicyOutput, error)
+
 	UpdateGroup(*iam.UpdateGroupInput) (*iam.UpdateGroupOutput, error)
 
+	UpdateGroupWithContext(aws.Context, *iam.UpdateGroupInput, ...aws.Option) (*iam.UpdateGroupOutput, error)
+
 	UpdateLoginProfile(*iam.UpdateLoginProfileInput) (*iam.UpdateLoginProfileOutput, error)
 
+	UpdateLoginProfileWithContext(aws.Context, *iam.UpdateLoginProfileInput, ...aws.Option) (*iam.UpdateLoginProfileOutput, error)
+
 	UpdateOpenIDConnectProviderThumbprint(*iam.UpdateOpenIDConnectProviderThumbprintInput) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
 
+	UpdateOpenIDConnectProviderThumbprintWithContext(aws.Context, *iam.UpdateOpenIDConnectProviderThumbprintInput, ...aws.Option) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
+
 	UpdateSAMLProvider(*iam.UpdateSAMLProviderInput) (*iam.UpdateSAMLProviderOutput, error)
 
+	UpdateSAMLProviderWithContext(aws.Context, *iam.UpdateSAMLProviderInput, ...aws.Option) (*iam.UpdateSAMLProviderOutput, error)
+
 	UpdateSSHPublicKey(*iam.UpdateSSHPublicKeyInput) (*iam.UpdateSSHPublicKeyOutput, error)
 
+	UpdateSSHPublicKeyWithContext(aws.Context, *iam.UpdateSSHPublicKeyInput, ...aws.Option) (*iam.UpdateSSHPublicKeyOutput, error)
+
 	UpdateServerCertificate(*iam.UpdateServerCertificateInput) (*iam.UpdateServerCertificateOutput, error)
 
+	UpdateServerCertificateWithContext(aws.Context, *iam.UpdateServerCertificateInput, ...aws.Option) (*iam.UpdateServerCertificateOutput, error)
+
 	UpdateSigningCertificate(*iam.UpdateSigningCertificateInput) (*iam.UpdateSigningCertificateOutput, error)
 
+	UpdateSigningCertificateWithContext(aws.Context, *iam.UpdateSigningCertificateInput, ...aws.Option) (*iam.UpdateSigningCertificateOutput, error)
+
 	UpdateUser(*iam.UpdateUserInput) (*iam.UpdateUserOutput, error)
 
+	UpdateUserWithContext(aws.Context, *iam.UpdateUserInput, ...aws.Option) (*iam.UpdateUserOutput, error)
+
 	UploadSSHPublicKey(*iam.UploadSSHPublicKeyInput) (*iam.UploadSSHPublicKeyOutput, error)
 
+	UploadSSHPublicKeyWithContext(aws.Context, *iam.UploadSSHPublicKeyInput, ...aws.Option) (*iam.UploadSSHPublicKeyOutput, error)
+
 	UploadServerCertificate(*iam.UploadServerCertificateInput) (*iam.UploadServerCertificateOutput, error)
 
+	UploadServerCertificateWithContext(aws.Context, *iam.UploadServerCertificateInput, ...aws.Option) (*iam.UploadServerCertificateOutput, error)
+
 	UploadSigningCertificate(*iam.UploadSigningCertificateInput) (*iam.UploadSigningCertificateOutput, error)
+
+	UploadSigningCertificateWithContext(aws.Context, *iam.UploadSigningCertificateInput, ...aws.Option) (*iam.UploadSigningCertificateOutput, error)
+
+	WaitUntilUserExists(*iam.GetUserInput) error
+
+	WaitUntilUserExistsWithContext(aws.Context, *iam.GetUserInput, ...aws.WaiterOption) error
 }
@@ -0,0 +1,205 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package importexport_test
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/importexport"
+)
+
+var _ time.Duration
+var _ bytes.Buffer
+
+func ExampleImportExport_CancelJob() {
+	svc := importexport.New(nil)
+
+	params := &importexport.CancelJobInput{
+		JobID:      aws.String("JobId"), // Required
+		APIVersion: aws.String("APIVersion"),
+	}
+	resp, err := svc.CancelJob(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, the SDK should always return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleImportExport_CreateJob() {
+	svc := importexport.New(nil)
+
+	params := &importexport.CreateJobInput{
+		JobType:          aws.String("JobType"),  // Required
+		Manifest:         aws.String("Manifest"), // Required
+		ValidateOnly:     aws.Boolean(true),      // Required
+		APIVersion:       aws.String("APIVersion"),
+		ManifestAddendum: aws.String("ManifestAddendum"),
+	}
+	resp, err := svc.CreateJob(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, the SDK should always return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleImportExport_GetShippingLabel() {
+	svc := importexport.New(nil)
+
+	params := &importexport.GetShippingLabelInput{
+		JobIDs: []*string{ // Required
+			aws.String("GenericString"), // Required
+			// More values...
+		},
+		APIVersion:      aws.String("GenericString"),
+		City:            aws.String("GenericString"),
+		Company:         aws.String("GenericString"),
+		Country:         aws.String("GenericString"),
+		Name:            aws.String("GenericString"),
+		PhoneNumber:     aws.String("GenericString"),
+		PostalCode:      aws.String("GenericString"),
+		StateOrProvince: aws.String("GenericString"),
+		Street1:         aws.String("GenericString"),
+		Street2:         aws.String("GenericString"),
+		Street3:         aws.String("GenericString"),
+	}
+	resp, err := svc.GetShippingLabel(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, the SDK should always return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleImportExport_GetStatus() {
+	svc := importexport.New(nil)
+
+	params := &importexport.GetStatusInput{
+		JobID:      aws.String("JobId"), // Required
+		APIVersion: aws.String("APIVersion"),
+	}
+	resp, err := svc.GetStatus(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, the SDK should always return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleImportExport_ListJobs() {
+	svc := importexport.New(nil)
+
+	params := &importexport.ListJobsInput{
+		APIVersion: aws.String("APIVersion"),
+		Marker:     aws.String("Marker"),
+		MaxJobs:    aws.Long(1),
+	}
+	resp, err := svc.ListJobs(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, the SDK should always return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleImportExport_UpdateJob() {
+	svc := importexport.New(nil)
+
+	params := &importexport.UpdateJobInput{
+		JobID:        aws.String("JobId"),    // Required
+		JobType:      aws.String("JobType"),  // Required
+		Manifest:     aws.String("Manifest"), // Required
+		ValidateOnly: aws.Boolean(true),      // Required
+		APIVersion:   aws.String("APIVersion"),
+	}
+	resp, err := svc.UpdateJob(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, the SDK should always return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
@@ -0,0 +1,40 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+// Package importexportiface provides an interface for the AWS Import/Export.
+package importexportiface
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/importexport"
+)
+
+// ImportExportAPI is the interface type for importexport.ImportExport.
+type ImportExportAPI interface {
+	CancelJob(*importexport.CancelJobInput) (*importexport.CancelJobOutput, error)
+
+	CancelJobWithContext(aws.Context, *importexport.CancelJobInput, ...aws.Option) (*importexport.CancelJobOutput, error)
+
+	CreateJob(*importexport.CreateJobInput) (*importexport.CreateJobOutput, error)
+
+	CreateJobWithContext(aws.Context, *importexport.CreateJobInput, ...aws.Option) (*importexport.CreateJobOutput, error)
+
+	GetShippingLabel(*importexport.GetShippingLabelInput) (*importexport.GetShippingLabelOutput, error)
+
+	GetShippingLabelWithContext(aws.Context, *importexport.GetShippingLabelInput, ...aws.Option) (*importexport.GetShippingLabelOutput, error)
+
+	GetStatus(*importexport.GetStatusInput) (*importexport.GetStatusOutput, error)
+
+	GetStatusWithContext(aws.Context, *importexport.GetStatusInput, ...aws.Option) (*importexport.GetStatusOutput, error)
+
+	ListJobs(*importexport.ListJobsInput) (*importexport.ListJobsOutput, error)
+
+	ListJobsWithContext(aws.Context, *importexport.ListJobsInput, ...aws.Option) (*importexport.ListJobsOutput, error)
+
+	ListJobsPages(*importexport.ListJobsInput, func(*importexport.ListJobsOutput, bool) bool) error
+
+	ListJobsPagesWithContext(aws.Context, *importexport.ListJobsInput, func(*importexport.ListJobsOutput, bool) bool, ...aws.Option) error
+
+	UpdateJob(*importexport.UpdateJobInput) (*importexport.UpdateJobOutput, error)
+
+	UpdateJobWithContext(aws.Context, *importexport.UpdateJobInput, ...aws.Option) (*importexport.UpdateJobOutput, error)
+}
@@ -39,6 +39,18 @@ func (c *Kinesis) AddTagsToStream(input *AddTagsToStreamInput) (*AddTagsToStream
 	return out, err
 }
 
+// AddTagsToStreamWithContext is the same as AddTagsToStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) AddTagsToStreamWithContext(ctx aws.Context, input *AddTagsToStreamInput, opts ...aws.Option) (*AddTagsToStreamOutput, error) {
+	req, out := c.AddTagsToStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStream = "CreateStream"
 
 // CreateStreamRequest generates a request for the CreateStream operation.
@@ -101,6 +113,18 @@ func (c *Kinesis) CreateStream(input *CreateStreamInput) (*CreateStreamOutput, e
 	return out, err
 }
 
+// CreateStreamWithContext is the same as CreateStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) CreateStreamWithContext(ctx aws.Context, input *CreateStreamInput, opts ...aws.Option) (*CreateStreamOutput, error) {
+	req, out := c.CreateStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteStream = "DeleteStream"
 
 // DeleteStreamRequest generates a request for the DeleteStream operation.
@@ -146,6 +170,18 @@ func (c *Kinesis) DeleteStream(input *DeleteStreamInput) (*DeleteStreamOutput, e
 	return out, err
 }
 
+// DeleteStreamWithContext is the same as DeleteStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) DeleteStreamWithContext(ctx aws.Context, input *DeleteStreamInput, opts ...aws.Option) (*DeleteStreamOutput, error) {
+	req, out := c.DeleteStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStream = "DescribeStream"
 
 // DescribeStreamRequest generates a request for the DescribeStream operation.
@@ -159,6 +195,7 @@ func (c *Kinesis) DescribeStreamRequest(input *DescribeStreamInput) (req *aws.Re
 			OutputTokens:    []string{"StreamDescription.Shards[-1].ShardId"},
 			LimitToken:      "Limit",
 			TruncationToken: "StreamDescription.HasMoreShards",
+			ResultKeys:      []string{"StreamDescription.Shards"},
 		},
 	}
 
@@ -200,6 +237,22 @@ func (c *Kinesis) DescribeStream(input *DescribeStreamInput) (*DescribeStreamOut
 	return out, err
 }
 
+// DescribeStreamWithContext is the same as DescribeStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) DescribeStreamWithContext(ctx aws.Context, input *DescribeStreamInput, opts ...aws.Option) (*DescribeStreamOutput, error) {
+	req, out := c.DescribeStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeStreamPages iterates over the pages of a DescribeStream operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Kinesis) DescribeStreamPages(input *DescribeStreamInput, fn func(p *DescribeStreamOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeStreamRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -207,6 +260,19 @@ func (c *Kinesis) DescribeStreamPages(input *DescribeStreamInput, fn func(p *Des
 	})
 }
 
+// DescribeStreamPagesWithContext is the same as DescribeStreamPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Kinesis) DescribeStreamPagesWithContext(ctx aws.Context, input *DescribeStreamInput, fn func(p *DescribeStreamOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeStreamRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStreamOutput), lastPage)
+	})
+}
+
 const opGetRecords = "GetRecords"
 
 // GetRecordsRequest generates a request for the GetRecords operation.
@@ -277,6 +343,18 @@ func (c *Kinesis) GetRecords(input *GetRecordsInput) (*GetRecordsOutput, error)
 	return out, err
 }
 
+// GetRecordsWithContext is the same as GetRecords with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) GetRecordsWithContext(ctx aws.Context, input *GetRecordsInput, opts ...aws.Option) (*GetRecordsOutput, error) {
+	req, out := c.GetRecordsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetShardIterator = "GetShardIterator"
 
 // GetShardIteratorRequest generates a request for the GetShardIterator operation.
@@ -340,6 +418,18 @@ func (c *Kinesis) GetShardIterator(input *GetShardIteratorInput) (*GetShardItera
 	return out, err
 }
 
+// GetShardIteratorWithContext is the same as GetShardIterator with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) GetShardIteratorWithContext(ctx aws.Context, input *GetShardIteratorInput, opts ...aws.Option) (*GetShardIteratorOutput, error) {
+	req, out := c.GetShardIteratorRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListStreams = "ListStreams"
 
 // ListStreamsRequest generates a request for the ListStreams operation.
@@ -353,6 +443,7 @@ func (c *Kinesis) ListStreamsRequest(input *ListStreamsInput) (req *aws.Request,
 			OutputTokens:    []string{"StreamNames[-1]"},
 			LimitToken:      "Limit",
 			TruncationToken: "HasMoreStreams",
+			ResultKeys:      []string{"StreamNames"},
 		},
 	}
 
@@ -388,6 +479,22 @@ func (c *Kinesis) ListStreams(input *ListStreamsInput) (*ListStreamsOutput, erro
 	return out, err
 }
 
+// ListStreamsWithContext is the same as ListStreams with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) ListStreamsWithContext(ctx aws.Context, input *ListStreamsInput, opts ...aws.Option) (*ListStreamsOutput, error) {
+	req, out := c.ListStreamsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListStreamsPages iterates over the pages of a ListStreams operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Kinesis) ListStreamsPages(input *ListStreamsInput, fn func(p *ListStreamsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStreamsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -395,6 +502,19 @@ func (c *Kinesis) ListStreamsPages(input *ListStreamsInput, fn func(p *ListStrea
 	})
 }
 
+// ListStreamsPagesWithContext is the same as ListStreamsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Kinesis) ListStreamsPagesWithContext(ctx aws.Context, input *ListStreamsInput, fn func(p *ListStreamsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListStreamsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStreamsOutput), lastPage)
+	})
+}
+
 const opListTagsForStream = "ListTagsForStream"
 
 // ListTagsForStreamRequest generates a request for the ListTagsForStream operation.
@@ -422,6 +542,18 @@ func (c *Kinesis) ListTagsForStream(input *ListTagsForStreamInput) (*ListTagsFor
 	return out, err
 }
 
+// ListTagsForStreamWithContext is the same as ListTagsForStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) ListTagsForStreamWithContext(ctx aws.Context, input *ListTagsForStreamInput, opts ...aws.Option) (*ListTagsForStreamOutput, error) {
+	req, out := c.ListTagsForStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opMergeShards = "MergeShards"
 
 // MergeShardsRequest generates a request for the MergeShards operation.
@@ -483,6 +615,18 @@ func (c *Kinesis) MergeShards(input *MergeShardsInput) (*MergeShardsOutput, erro
 	return out, err
 }
 
+// MergeShardsWithContext is the same as MergeShards with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) MergeShardsWithContext(ctx aws.Context, input *MergeShardsInput, opts ...aws.Option) (*MergeShardsOutput, error) {
+	req, out := c.MergeShardsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRecord = "PutRecord"
 
 // PutRecordRequest generates a request for the PutRecord operation.
@@ -547,6 +691,18 @@ func (c *Kinesis) PutRecord(input *PutRecordInput) (*PutRecordOutput, error) {
 	return out, err
 }
 
+// PutRecordWithContext is the same as PutRecord with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) PutRecordWithContext(ctx aws.Context, input *PutRecordInput, opts ...aws.Option) (*PutRecordOutput, error) {
+	req, out := c.PutRecordRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRecords = "PutRecords"
 
 // PutRecordsRequest generates a request for the PutRecords operation.
@@ -628,6 +784,18 @@ func (c *Kinesis) PutRecords(input *PutRecordsInput) (*PutRecordsOutput, error)
 	return out, err
 }
 
+// PutRecordsWithContext is the same as PutRecords with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) PutRecordsWithContext(ctx aws.Context, input *PutRecordsInput, opts ...aws.Option) (*PutRecordsOutput, error) {
+	req, out := c.PutRecordsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveTagsFromStream = "RemoveTagsFromStream"
 
 // RemoveTagsFromStreamRequest generates a request for the RemoveTagsFromStream operation.
@@ -657,6 +825,18 @@ func (c *Kinesis) RemoveTagsFromStream(input *RemoveTagsFromStreamInput) (*Remov
 	return out, err
 }
 
+// RemoveTagsFromStreamWithContext is the same as RemoveTagsFromStream with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) RemoveTagsFromStreamWithContext(ctx aws.Context, input *RemoveTagsFromStreamInput, opts ...aws.Option) (*RemoveTagsFromStreamOutput, error) {
+	req, out := c.RemoveTagsFromStreamRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSplitShard = "SplitShard"
 
 // SplitShardRequest generates a request for the SplitShard operation.
@@ -729,13 +909,25 @@ func (c *Kinesis) SplitShard(input *SplitShardInput) (*SplitShardOutput, error)
 	return out, err
 }
 
+// SplitShardWithContext is the same as SplitShard with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Kinesis) SplitShardWithContext(ctx aws.Context, input *SplitShardInput, opts ...aws.Option) (*SplitShardOutput, error) {
+	req, out := c.SplitShardRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the input for AddTagsToStream.
 type AddTagsToStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The set of key-value pairs to use to create the tags.
-	Tags map[string]*string `type:"map" required:"true"`
+	Tags map[string]*string `type:"map" min:"1" max:"10" required:"true"`
 
 	metadataAddTagsToStreamInput `json:"-" xml:"-"`
 }
@@ -779,14 +971,14 @@ type CreateStreamInput struct {
 	// provisioned throughput.
 	//
 	// DefaultShardLimit;
-	ShardCount *int64 `type:"integer" required:"true"`
+	ShardCount *int64 `type:"integer" min:"1" required:"true"`
 
 	// A name to identify the stream. The stream name is scoped to the AWS account
 	// used by the application that creates the stream. It is also scoped by region.
 	// That is, two streams in two different AWS accounts can have the same name,
 	// and two streams in the same AWS account, but in two different regions, can
 	// have the same name.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataCreateStreamInput `json:"-" xml:"-"`
 }
@@ -826,7 +1018,7 @@ func (s CreateStreamOutput) GoString() string {
 // Represents the input for DeleteStream.
 type DeleteStreamInput struct {
 	// The name of the stream to delete.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDeleteStreamInput `json:"-" xml:"-"`
 }
@@ -866,13 +1058,13 @@ func (s DeleteStreamOutput) GoString() string {
 // Represents the input for DescribeStream.
 type DescribeStreamInput struct {
 	// The shard ID of the shard to start with.
-	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string"`
+	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string" min:"1" max:"128"`
 
 	// The maximum number of shards to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The name of the stream to describe.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataDescribeStreamInput `json:"-" xml:"-"`
 }
@@ -918,12 +1110,12 @@ func (s DescribeStreamOutput) GoString() string {
 type GetRecordsInput struct {
 	// The maximum number of records to return. Specify a value of up to 10,000.
 	// If you specify a value that is greater than 10,000, GetRecords throws InvalidArgumentException.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The position in the shard from which you want to start sequentially reading
 	// data records. A shard iterator specifies this position using the sequence
 	// number of a data record in the shard.
-	ShardIterator *string `type:"string" required:"true"`
+	ShardIterator *string `type:"string" min:"1" max:"512" required:"true"`
 
 	metadataGetRecordsInput `json:"-" xml:"-"`
 }
@@ -948,12 +1140,12 @@ type GetRecordsOutput struct {
 	// stream, indicating how far behind current time the consumer is. A value of
 	// zero indicates record processing is caught up, and there are no new records
 	// to process at this moment.
-	MillisBehindLatest *int64 `type:"long"`
+	MillisBehindLatest *int64 `type:"long" min:"0"`
 
 	// The next position in the shard from which to start sequentially reading data
 	// records. If set to null, the shard has been closed and the requested iterator
 	// will not return any more data.
-	NextShardIterator *string `type:"string"`
+	NextShardIterator *string `type:"string" min:"1" max:"512"`
 
 	// The data records retrieved from the shard.
 	Records []*Record `type:"list" required:"true"`
@@ -978,7 +1170,7 @@ func (s GetRecordsOutput) GoString() string {
 // Represents the input for GetShardIterator.
 type GetShardIteratorInput struct {
 	// The shard ID of the shard to get the iterator for.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" required:"true"`
 
 	// Determines how the shard iterator is used to start reading data records from
 	// the shard.
@@ -999,7 +1191,7 @@ type GetShardIteratorInput struct {
 	StartingSequenceNumber *string `type:"string"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetShardIteratorInput `json:"-" xml:"-"`
 }
@@ -1023,7 +1215,7 @@ type GetShardIteratorOutput struct {
 	// The position in the shard from which to start reading data records sequentially.
 	// A shard iterator specifies this position using the sequence number of a data
 	// record in a shard.
-	ShardIterator *string `type:"string"`
+	ShardIterator *string `type:"string" min:"1" max:"512"`
 
 	metadataGetShardIteratorOutput `json:"-" xml:"-"`
 }
@@ -1071,10 +1263,10 @@ func (s HashKeyRange) GoString() string {
 // Represents the input for ListStreams.
 type ListStreamsInput struct {
 	// The name of the stream to start the list with.
-	ExclusiveStartStreamName *string `type:"string"`
+	ExclusiveStartStreamName *string `type:"string" min:"1" max:"128"`
 
 	// The maximum number of streams to list.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	metadataListStreamsInput `json:"-" xml:"-"`
 }
@@ -1123,15 +1315,15 @@ func (s ListStreamsOutput) GoString() string {
 type ListTagsForStreamInput struct {
 	// The key to use as the starting point for the list of tags. If this parameter
 	// is set, ListTagsForStream gets all tags that occur after ExclusiveStartTagKey.
-	ExclusiveStartTagKey *string `type:"string"`
+	ExclusiveStartTagKey *string `type:"string" min:"1" max:"128"`
 
 	// The number of tags to return. If this number is less than the total number
 	// of tags associated with the stream, HasMoreTags is set to true. To list additional
 	// tags, set ExclusiveStartTagKey to the last key in the response.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataListTagsForStreamInput `json:"-" xml:"-"`
 }
@@ -1158,7 +1350,7 @@ type ListTagsForStreamOutput struct {
 
 	// A list of tags associated with StreamName, starting with the first tag after
 	// ExclusiveStartTagKey and up to the specified Limit.
-	Tags []*Tag `type:"list" required:"true"`
+	Tags []*Tag `type:"list" min:"0" required:"true"`
 
 	metadataListTagsForStreamOutput `json:"-" xml:"-"`
 }
@@ -1180,13 +1372,13 @@ func (s ListTagsForStreamOutput) GoString() string {
 // Represents the input for MergeShards.
 type MergeShardsInput struct {
 	// The shard ID of the adjacent shard for the merge.
-	AdjacentShardToMerge *string `type:"string" required:"true"`
+	AdjacentShardToMerge *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The shard ID of the shard to combine with the adjacent shard for the merge.
-	ShardToMerge *string `type:"string" required:"true"`
+	ShardToMerge *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the stream for the merge.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataMergeShardsInput `json:"-" xml:"-"`
 }
@@ -1228,7 +1420,7 @@ type PutRecordInput struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// The hash value used to explicitly determine the shard the data record is
 	// assigned to by overriding the partition key hash.
@@ -1242,7 +1434,7 @@ type PutRecordInput struct {
 	// and to map associated data records to shards. As a result of this hashing
 	// mechanism, all data records with the same partition key will map to the same
 	// shard within the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Guarantees strictly increasing sequence numbers, for puts from the same client
 	// and to the same partition key. Usage: set the SequenceNumberForOrdering of
@@ -1252,7 +1444,7 @@ type PutRecordInput struct {
 	SequenceNumberForOrdering *string `type:"string"`
 
 	// The name of the stream to put the data record into.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataPutRecordInput `json:"-" xml:"-"`
 }
@@ -1280,7 +1472,7 @@ type PutRecordOutput struct {
 	SequenceNumber *string `type:"string" required:"true"`
 
 	// The shard ID of the shard where the data record was placed.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" required:"true"`
 
 	metadataPutRecordOutput `json:"-" xml:"-"`
 }
@@ -1302,10 +1494,10 @@ func (s PutRecordOutput) GoString() string {
 // A PutRecords request.
 type PutRecordsInput struct {
 	// The records associated with the request.
-	Records []*PutRecordsRequestEntry `type:"list" required:"true"`
+	Records []*PutRecordsRequestEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	// The stream name associated with the request.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataPutRecordsInput `json:"-" xml:"-"`
 }
@@ -1327,14 +1519,14 @@ func (s PutRecordsInput) GoString() string {
 // PutRecords results.
 type PutRecordsOutput struct {
 	// The number of unsuccessfully processed records in a PutRecords request.
-	FailedRecordCount *int64 `type:"integer"`
+	FailedRecordCount *int64 `type:"integer" min:"1"`
 
 	// An array of successfully and unsuccessfully processed record results, correlated
 	// with the request by natural ordering. A record that is successfully added
 	// to your Amazon Kinesis stream includes SequenceNumber and ShardId in the
 	// result. A record that fails to be added to your Amazon Kinesis stream includes
 	// ErrorCode and ErrorMessage in the result.
-	Records []*PutRecordsResultEntry `type:"list" required:"true"`
+	Records []*PutRecordsResultEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	metadataPutRecordsOutput `json:"-" xml:"-"`
 }
@@ -1358,7 +1550,7 @@ type PutRecordsRequestEntry struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// The hash value used to determine explicitly the shard that the data record
 	// is assigned to by overriding the partition key hash.
@@ -1372,7 +1564,7 @@ type PutRecordsRequestEntry struct {
 	// and to map associated data records to shards. As a result of this hashing
 	// mechanism, all data records with the same partition key map to the same shard
 	// within the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataPutRecordsRequestEntry `json:"-" xml:"-"`
 }
@@ -1411,7 +1603,7 @@ type PutRecordsResultEntry struct {
 	SequenceNumber *string `type:"string"`
 
 	// The shard ID for an individual record result.
-	ShardID *string `locationName:"ShardId" type:"string"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128"`
 
 	metadataPutRecordsResultEntry `json:"-" xml:"-"`
 }
@@ -1437,10 +1629,10 @@ type Record struct {
 	// Kinesis service, which does not inspect, interpret, or change the data in
 	// the blob in any way. The maximum size of the data blob (the payload before
 	// base64-encoding) is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// Identifies which shard in the stream the data record is assigned to.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// The unique identifier for the record in the Amazon Kinesis stream.
 	SequenceNumber *string `type:"string" required:"true"`
@@ -1465,10 +1657,10 @@ func (s Record) GoString() string {
 // Represents the input for RemoveTagsFromStream.
 type RemoveTagsFromStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A list of tag keys. Each corresponding tag is removed from the stream.
-	TagKeys []*string `type:"list" required:"true"`
+	TagKeys []*string `type:"list" min:"1" max:"10" required:"true"`
 
 	metadataRemoveTagsFromStreamInput `json:"-" xml:"-"`
 }
@@ -1534,20 +1726,20 @@ func (s SequenceNumberRange) GoString() string {
 // A uniquely identified group of data records in an Amazon Kinesis stream.
 type Shard struct {
 	// The shard Id of the shard adjacent to the shard's parent.
-	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string"`
+	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string" min:"1" max:"128"`
 
 	// The range of possible hash key values for the shard, which is a set of ordered
 	// contiguous positive integers.
 	HashKeyRange *HashKeyRange `type:"structure" required:"true"`
 
 	// The shard Id of the shard's parent.
-	ParentShardID *string `locationName:"ParentShardId" type:"string"`
+	ParentShardID *string `locationName:"ParentShardId" type:"string" min:"1" max:"128"`
 
 	// The range of possible sequence numbers for the shard.
 	SequenceNumberRange *SequenceNumberRange `type:"structure" required:"true"`
 
 	// The unique identifier of the shard within the Amazon Kinesis stream.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" required:"true"`
 
 	metadataShard `json:"-" xml:"-"`
 }
@@ -1578,10 +1770,10 @@ type SplitShardInput struct {
 	NewStartingHashKey *string `type:"string" required:"true"`
 
 	// The shard ID of the shard to split.
-	ShardToSplit *string `type:"string" required:"true"`
+	ShardToSplit *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The name of the stream for the shard split.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataSplitShardInput `json:"-" xml:"-"`
 }
@@ -1630,7 +1822,7 @@ type StreamDescription struct {
 	StreamARN *string `type:"string" required:"true"`
 
 	// The name of the stream being described.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The current status of the stream being described.
 	//
@@ -1667,12 +1859,12 @@ func (s StreamDescription) GoString() string {
 type Tag struct {
 	// A unique identifier for the tag. Maximum length: 128 characters. Valid characters:
 	// Unicode letters, digits, white space, _ . / = + - % @
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// An optional string, typically used to describe or define the tag. Maximum
 	// length: 256 characters. Valid characters: Unicode letters, digits, white
 	// space, _ . / = + - % @
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
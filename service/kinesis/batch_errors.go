@@ -0,0 +1,28 @@
+package kinesis
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// PutRecordsError returns an awserr.BatchError for the records which failed
+// to be put, or nil if all records were put. The index of each item error is
+// the index of the record in the request, which is also its index in
+// out.Records.
+func PutRecordsError(out *PutRecordsOutput) error {
+	errs := []awserr.BatchItemError{}
+	for i, record := range out.Records {
+		if record.ErrorCode == nil {
+			continue
+		}
+		errs = append(errs, awserr.NewBatchItemError(i,
+			aws.StringValue(record.ErrorCode), aws.StringValue(record.ErrorMessage)))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return awserr.NewBatchError(awserr.ErrCodeBatchPartialFailure,
+		fmt.Sprintf("%d of %d records of %s failed", len(errs), len(out.Records), opPutRecords), errs)
+}
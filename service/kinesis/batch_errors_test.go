@@ -0,0 +1,32 @@
+package kinesis_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPutRecordsError(t *testing.T) {
+	out := &kinesis.PutRecordsOutput{
+		FailedRecordCount: aws.Long(1),
+		Records: []*kinesis.PutRecordsResultEntry{
+			{SequenceNumber: aws.String("1"), ShardID: aws.String("shard")},
+			{ErrorCode: aws.String("ProvisionedThroughputExceededException"), ErrorMessage: aws.String("Rate exceeded")},
+		},
+	}
+
+	err := kinesis.PutRecordsError(out)
+	batchErr, ok := err.(awserr.BatchError)
+	assert.True(t, ok, "Expect a BatchError")
+	assert.Equal(t, "1 of 2 records of PutRecords failed", batchErr.Message())
+	assert.Len(t, batchErr.Errors(), 1)
+	assert.Equal(t, 1, batchErr.Errors()[0].Index())
+	assert.Equal(t, "ProvisionedThroughputExceededException", batchErr.Errors()[0].Code())
+	assert.Equal(t, "Rate exceeded", batchErr.Errors()[0].Message())
+
+	out.Records = out.Records[:1]
+	assert.Nil(t, kinesis.PutRecordsError(out))
+}
@@ -0,0 +1,48 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package kinesis
+
+const (
+	// ErrCodeExpiredIteratorException for service response error code
+	// "ExpiredIteratorException".
+	//
+	// The provided iterator exceeds the maximum age allowed.
+	ErrCodeExpiredIteratorException = "ExpiredIteratorException"
+
+	// ErrCodeInvalidArgumentException for service response error code
+	// "InvalidArgumentException".
+	//
+	// A specified parameter exceeds its restrictions, is not supported, or can't
+	// be used. For more information, see the returned message.
+	ErrCodeInvalidArgumentException = "InvalidArgumentException"
+
+	// ErrCodeLimitExceededException for service response error code
+	// "LimitExceededException".
+	//
+	// The requested resource exceeds the maximum number allowed, or the number
+	// of concurrent stream requests exceeds the maximum number allowed (5).
+	ErrCodeLimitExceededException = "LimitExceededException"
+
+	// ErrCodeProvisionedThroughputExceededException for service response error code
+	// "ProvisionedThroughputExceededException".
+	//
+	// The request rate is too high, or the requested data is too large for the
+	// available throughput. Reduce the frequency or size of your requests. For
+	// more information, see Error Retries and Exponential Backoff in AWS (http://docs.aws.amazon.com/general/latest/gr/api-retries.html"
+	// target="_blank) in the AWS General Reference.
+	ErrCodeProvisionedThroughputExceededException = "ProvisionedThroughputExceededException"
+
+	// ErrCodeResourceInUseException for service response error code
+	// "ResourceInUseException".
+	//
+	// The resource is not available for this operation. For example, you attempted
+	// to split a shard but the stream is not in the ACTIVE state.
+	ErrCodeResourceInUseException = "ResourceInUseException"
+
+	// ErrCodeResourceNotFoundException for service response error code
+	// "ResourceNotFoundException".
+	//
+	// The requested resource could not be found. It might not be specified correctly,
+	// or it might not be in the ACTIVE state.
+	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+)
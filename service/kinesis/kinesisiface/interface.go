@@ -4,6 +4,7 @@
 package kinesisiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 )
 
@@ -11,27 +12,65 @@ import (
 type KinesisAPI interface {
 	AddTagsToStream(*kinesis.AddTagsToStreamInput) (*kinesis.AddTagsToStreamOutput, error)
 
+	AddTagsToStreamWithContext(aws.Context, *kinesis.AddTagsToStreamInput, ...aws.Option) (*kinesis.AddTagsToStreamOutput, error)
+
 	CreateStream(*kinesis.CreateStreamInput) (*kinesis.CreateStreamOutput, error)
 
+	CreateStreamWithContext(aws.Context, *kinesis.CreateStreamInput, ...aws.Option) (*kinesis.CreateStreamOutput, error)
+
 	DeleteStream(*kinesis.DeleteStreamInput) (*kinesis.DeleteStreamOutput, error)
 
+	DeleteStreamWithContext(aws.Context, *kinesis.DeleteStreamInput, ...aws.Option) (*kinesis.DeleteStreamOutput, error)
+
 	DescribeStream(*kinesis.DescribeStreamInput) (*kinesis.DescribeStreamOutput, error)
 
+	DescribeStreamWithContext(aws.Context, *kinesis.DescribeStreamInput, ...aws.Option) (*kinesis.DescribeStreamOutput, error)
+
+	DescribeStreamPages(*kinesis.DescribeStreamInput, func(*kinesis.DescribeStreamOutput, bool) bool) error
+
+	DescribeStreamPagesWithContext(aws.Context, *kinesis.DescribeStreamInput, func(*kinesis.DescribeStreamOutput, bool) bool, ...aws.Option) error
+
 	GetRecords(*kinesis.GetRecordsInput) (*kinesis.GetRecordsOutput, error)
 
+	GetRecordsWithContext(aws.Context, *kinesis.GetRecordsInput, ...aws.Option) (*kinesis.GetRecordsOutput, error)
+
 	GetShardIterator(*kinesis.GetShardIteratorInput) (*kinesis.GetShardIteratorOutput, error)
 
+	GetShardIteratorWithContext(aws.Context, *kinesis.GetShardIteratorInput, ...aws.Option) (*kinesis.GetShardIteratorOutput, error)
+
 	ListStreams(*kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error)
 
+	ListStreamsWithContext(aws.Context, *kinesis.ListStreamsInput, ...aws.Option) (*kinesis.ListStreamsOutput, error)
+
+	ListStreamsPages(*kinesis.ListStreamsInput, func(*kinesis.ListStreamsOutput, bool) bool) error
+
+	ListStreamsPagesWithContext(aws.Context, *kinesis.ListStreamsInput, func(*kinesis.ListStreamsOutput, bool) bool, ...aws.Option) error
+
 	ListTagsForStream(*kinesis.ListTagsForStreamInput) (*kinesis.ListTagsForStreamOutput, error)
 
+	ListTagsForStreamWithContext(aws.Context, *kinesis.ListTagsForStreamInput, ...aws.Option) (*kinesis.ListTagsForStreamOutput, error)
+
 	MergeShards(*kinesis.MergeShardsInput) (*kinesis.MergeShardsOutput, error)
 
+	MergeShardsWithContext(aws.Context, *kinesis.MergeShardsInput, ...aws.Option) (*kinesis.MergeShardsOutput, error)
+
 	PutRecord(*kinesis.PutRecordInput) (*kinesis.PutRecordOutput, error)
 
+	PutRecordWithContext(aws.Context, *kinesis.PutRecordInput, ...aws.Option) (*kinesis.PutRecordOutput, error)
+
 	PutRecords(*kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error)
 
+	PutRecordsWithContext(aws.Context, *kinesis.PutRecordsInput, ...aws.Option) (*kinesis.PutRecordsOutput, error)
+
 	RemoveTagsFromStream(*kinesis.RemoveTagsFromStreamInput) (*kinesis.RemoveTagsFromStreamOutput, error)
 
+	RemoveTagsFromStreamWithContext(aws.Context, *kinesis.RemoveTagsFromStreamInput, ...aws.Option) (*kinesis.RemoveTagsFromStreamOutput, error)
+
 	SplitShard(*kinesis.SplitShardInput) (*kinesis.SplitShardOutput, error)
+
+	SplitShardWithContext(aws.Context, *kinesis.SplitShardInput, ...aws.Option) (*kinesis.SplitShardOutput, error)
+
+	WaitUntilStreamExists(*kinesis.DescribeStreamInput) error
+
+	WaitUntilStreamExistsWithContext(aws.Context, *kinesis.DescribeStreamInput, ...aws.WaiterOption) error
 }
@@ -48,6 +48,18 @@ func (c *KMS) CreateAlias(input *CreateAliasInput) (*CreateAliasOutput, error) {
 	return out, err
 }
 
+// CreateAliasWithContext is the same as CreateAlias with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) CreateAliasWithContext(ctx aws.Context, input *CreateAliasInput, opts ...aws.Option) (*CreateAliasOutput, error) {
+	req, out := c.CreateAliasRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateGrant = "CreateGrant"
 
 // CreateGrantRequest generates a request for the CreateGrant operation.
@@ -79,6 +91,18 @@ func (c *KMS) CreateGrant(input *CreateGrantInput) (*CreateGrantOutput, error) {
 	return out, err
 }
 
+// CreateGrantWithContext is the same as CreateGrant with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) CreateGrantWithContext(ctx aws.Context, input *CreateGrantInput, opts ...aws.Option) (*CreateGrantOutput, error) {
+	req, out := c.CreateGrantRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateKey = "CreateKey"
 
 // CreateKeyRequest generates a request for the CreateKey operation.
@@ -109,6 +133,18 @@ func (c *KMS) CreateKey(input *CreateKeyInput) (*CreateKeyOutput, error) {
 	return out, err
 }
 
+// CreateKeyWithContext is the same as CreateKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) CreateKeyWithContext(ctx aws.Context, input *CreateKeyInput, opts ...aws.Option) (*CreateKeyOutput, error) {
+	req, out := c.CreateKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDecrypt = "Decrypt"
 
 // DecryptRequest generates a request for the Decrypt operation.
@@ -147,6 +183,18 @@ func (c *KMS) Decrypt(input *DecryptInput) (*DecryptOutput, error) {
 	return out, err
 }
 
+// DecryptWithContext is the same as Decrypt with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) DecryptWithContext(ctx aws.Context, input *DecryptInput, opts ...aws.Option) (*DecryptOutput, error) {
+	req, out := c.DecryptRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAlias = "DeleteAlias"
 
 // DeleteAliasRequest generates a request for the DeleteAlias operation.
@@ -175,6 +223,18 @@ func (c *KMS) DeleteAlias(input *DeleteAliasInput) (*DeleteAliasOutput, error) {
 	return out, err
 }
 
+// DeleteAliasWithContext is the same as DeleteAlias with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) DeleteAliasWithContext(ctx aws.Context, input *DeleteAliasInput, opts ...aws.Option) (*DeleteAliasOutput, error) {
+	req, out := c.DeleteAliasRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeKey = "DescribeKey"
 
 // DescribeKeyRequest generates a request for the DescribeKey operation.
@@ -202,6 +262,18 @@ func (c *KMS) DescribeKey(input *DescribeKeyInput) (*DescribeKeyOutput, error) {
 	return out, err
 }
 
+// DescribeKeyWithContext is the same as DescribeKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) DescribeKeyWithContext(ctx aws.Context, input *DescribeKeyInput, opts ...aws.Option) (*DescribeKeyOutput, error) {
+	req, out := c.DescribeKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableKey = "DisableKey"
 
 // DisableKeyRequest generates a request for the DisableKey operation.
@@ -229,6 +301,18 @@ func (c *KMS) DisableKey(input *DisableKeyInput) (*DisableKeyOutput, error) {
 	return out, err
 }
 
+// DisableKeyWithContext is the same as DisableKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) DisableKeyWithContext(ctx aws.Context, input *DisableKeyInput, opts ...aws.Option) (*DisableKeyOutput, error) {
+	req, out := c.DisableKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableKeyRotation = "DisableKeyRotation"
 
 // DisableKeyRotationRequest generates a request for the DisableKeyRotation operation.
@@ -256,6 +340,18 @@ func (c *KMS) DisableKeyRotation(input *DisableKeyRotationInput) (*DisableKeyRot
 	return out, err
 }
 
+// DisableKeyRotationWithContext is the same as DisableKeyRotation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) DisableKeyRotationWithContext(ctx aws.Context, input *DisableKeyRotationInput, opts ...aws.Option) (*DisableKeyRotationOutput, error) {
+	req, out := c.DisableKeyRotationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableKey = "EnableKey"
 
 // EnableKeyRequest generates a request for the EnableKey operation.
@@ -284,6 +380,18 @@ func (c *KMS) EnableKey(input *EnableKeyInput) (*EnableKeyOutput, error) {
 	return out, err
 }
 
+// EnableKeyWithContext is the same as EnableKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) EnableKeyWithContext(ctx aws.Context, input *EnableKeyInput, opts ...aws.Option) (*EnableKeyOutput, error) {
+	req, out := c.EnableKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableKeyRotation = "EnableKeyRotation"
 
 // EnableKeyRotationRequest generates a request for the EnableKeyRotation operation.
@@ -311,6 +419,18 @@ func (c *KMS) EnableKeyRotation(input *EnableKeyRotationInput) (*EnableKeyRotati
 	return out, err
 }
 
+// EnableKeyRotationWithContext is the same as EnableKeyRotation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) EnableKeyRotationWithContext(ctx aws.Context, input *EnableKeyRotationInput, opts ...aws.Option) (*EnableKeyRotationOutput, error) {
+	req, out := c.EnableKeyRotationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEncrypt = "Encrypt"
 
 // EncryptRequest generates a request for the Encrypt operation.
@@ -354,6 +474,18 @@ func (c *KMS) Encrypt(input *EncryptInput) (*EncryptOutput, error) {
 	return out, err
 }
 
+// EncryptWithContext is the same as Encrypt with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) EncryptWithContext(ctx aws.Context, input *EncryptInput, opts ...aws.Option) (*EncryptOutput, error) {
+	req, out := c.EncryptRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateDataKey = "GenerateDataKey"
 
 // GenerateDataKeyRequest generates a request for the GenerateDataKey operation.
@@ -410,6 +542,18 @@ func (c *KMS) GenerateDataKey(input *GenerateDataKeyInput) (*GenerateDataKeyOutp
 	return out, err
 }
 
+// GenerateDataKeyWithContext is the same as GenerateDataKey with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) GenerateDataKeyWithContext(ctx aws.Context, input *GenerateDataKeyInput, opts ...aws.Option) (*GenerateDataKeyOutput, error) {
+	req, out := c.GenerateDataKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateDataKeyWithoutPlaintext = "GenerateDataKeyWithoutPlaintext"
 
 // GenerateDataKeyWithoutPlaintextRequest generates a request for the GenerateDataKeyWithoutPlaintext operation.
@@ -441,6 +585,18 @@ func (c *KMS) GenerateDataKeyWithoutPlaintext(input *GenerateDataKeyWithoutPlain
 	return out, err
 }
 
+// GenerateDataKeyWithoutPlaintextWithContext is the same as GenerateDataKeyWithoutPlaintext with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) GenerateDataKeyWithoutPlaintextWithContext(ctx aws.Context, input *GenerateDataKeyWithoutPlaintextInput, opts ...aws.Option) (*GenerateDataKeyWithoutPlaintextOutput, error) {
+	req, out := c.GenerateDataKeyWithoutPlaintextRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateRandom = "GenerateRandom"
 
 // GenerateRandomRequest generates a request for the GenerateRandom operation.
@@ -468,6 +624,18 @@ func (c *KMS) GenerateRandom(input *GenerateRandomInput) (*GenerateRandomOutput,
 	return out, err
 }
 
+// GenerateRandomWithContext is the same as GenerateRandom with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) GenerateRandomWithContext(ctx aws.Context, input *GenerateRandomInput, opts ...aws.Option) (*GenerateRandomOutput, error) {
+	req, out := c.GenerateRandomRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetKeyPolicy = "GetKeyPolicy"
 
 // GetKeyPolicyRequest generates a request for the GetKeyPolicy operation.
@@ -495,6 +663,18 @@ func (c *KMS) GetKeyPolicy(input *GetKeyPolicyInput) (*GetKeyPolicyOutput, error
 	return out, err
 }
 
+// GetKeyPolicyWithContext is the same as GetKeyPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) GetKeyPolicyWithContext(ctx aws.Context, input *GetKeyPolicyInput, opts ...aws.Option) (*GetKeyPolicyOutput, error) {
+	req, out := c.GetKeyPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetKeyRotationStatus = "GetKeyRotationStatus"
 
 // GetKeyRotationStatusRequest generates a request for the GetKeyRotationStatus operation.
@@ -523,6 +703,18 @@ func (c *KMS) GetKeyRotationStatus(input *GetKeyRotationStatusInput) (*GetKeyRot
 	return out, err
 }
 
+// GetKeyRotationStatusWithContext is the same as GetKeyRotationStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) GetKeyRotationStatusWithContext(ctx aws.Context, input *GetKeyRotationStatusInput, opts ...aws.Option) (*GetKeyRotationStatusOutput, error) {
+	req, out := c.GetKeyRotationStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListAliases = "ListAliases"
 
 // ListAliasesRequest generates a request for the ListAliases operation.
@@ -536,6 +728,7 @@ func (c *KMS) ListAliasesRequest(input *ListAliasesInput) (req *aws.Request, out
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "Limit",
 			TruncationToken: "Truncated",
+			ResultKeys:      []string{"Aliases"},
 		},
 	}
 
@@ -556,6 +749,22 @@ func (c *KMS) ListAliases(input *ListAliasesInput) (*ListAliasesOutput, error) {
 	return out, err
 }
 
+// ListAliasesWithContext is the same as ListAliases with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) ListAliasesWithContext(ctx aws.Context, input *ListAliasesInput, opts ...aws.Option) (*ListAliasesOutput, error) {
+	req, out := c.ListAliasesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListAliasesPages iterates over the pages of a ListAliases operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *KMS) ListAliasesPages(input *ListAliasesInput, fn func(p *ListAliasesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAliasesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -563,6 +772,19 @@ func (c *KMS) ListAliasesPages(input *ListAliasesInput, fn func(p *ListAliasesOu
 	})
 }
 
+// ListAliasesPagesWithContext is the same as ListAliasesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *KMS) ListAliasesPagesWithContext(ctx aws.Context, input *ListAliasesInput, fn func(p *ListAliasesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListAliasesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAliasesOutput), lastPage)
+	})
+}
+
 const opListGrants = "ListGrants"
 
 // ListGrantsRequest generates a request for the ListGrants operation.
@@ -576,6 +798,7 @@ func (c *KMS) ListGrantsRequest(input *ListGrantsInput) (req *aws.Request, outpu
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "Limit",
 			TruncationToken: "Truncated",
+			ResultKeys:      []string{"Grants"},
 		},
 	}
 
@@ -596,6 +819,22 @@ func (c *KMS) ListGrants(input *ListGrantsInput) (*ListGrantsOutput, error) {
 	return out, err
 }
 
+// ListGrantsWithContext is the same as ListGrants with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) ListGrantsWithContext(ctx aws.Context, input *ListGrantsInput, opts ...aws.Option) (*ListGrantsOutput, error) {
+	req, out := c.ListGrantsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListGrantsPages iterates over the pages of a ListGrants operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *KMS) ListGrantsPages(input *ListGrantsInput, fn func(p *ListGrantsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGrantsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -603,6 +842,19 @@ func (c *KMS) ListGrantsPages(input *ListGrantsInput, fn func(p *ListGrantsOutpu
 	})
 }
 
+// ListGrantsPagesWithContext is the same as ListGrantsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *KMS) ListGrantsPagesWithContext(ctx aws.Context, input *ListGrantsInput, fn func(p *ListGrantsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListGrantsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGrantsOutput), lastPage)
+	})
+}
+
 const opListKeyPolicies = "ListKeyPolicies"
 
 // ListKeyPoliciesRequest generates a request for the ListKeyPolicies operation.
@@ -616,6 +868,7 @@ func (c *KMS) ListKeyPoliciesRequest(input *ListKeyPoliciesInput) (req *aws.Requ
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "Limit",
 			TruncationToken: "Truncated",
+			ResultKeys:      []string{"PolicyNames"},
 		},
 	}
 
@@ -636,6 +889,22 @@ func (c *KMS) ListKeyPolicies(input *ListKeyPoliciesInput) (*ListKeyPoliciesOutp
 	return out, err
 }
 
+// ListKeyPoliciesWithContext is the same as ListKeyPolicies with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) ListKeyPoliciesWithContext(ctx aws.Context, input *ListKeyPoliciesInput, opts ...aws.Option) (*ListKeyPoliciesOutput, error) {
+	req, out := c.ListKeyPoliciesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListKeyPoliciesPages iterates over the pages of a ListKeyPolicies operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *KMS) ListKeyPoliciesPages(input *ListKeyPoliciesInput, fn func(p *ListKeyPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListKeyPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -643,6 +912,19 @@ func (c *KMS) ListKeyPoliciesPages(input *ListKeyPoliciesInput, fn func(p *ListK
 	})
 }
 
+// ListKeyPoliciesPagesWithContext is the same as ListKeyPoliciesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *KMS) ListKeyPoliciesPagesWithContext(ctx aws.Context, input *ListKeyPoliciesInput, fn func(p *ListKeyPoliciesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListKeyPoliciesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListKeyPoliciesOutput), lastPage)
+	})
+}
+
 const opListKeys = "ListKeys"
 
 // ListKeysRequest generates a request for the ListKeys operation.
@@ -656,6 +938,7 @@ func (c *KMS) ListKeysRequest(input *ListKeysInput) (req *aws.Request, output *L
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "Limit",
 			TruncationToken: "Truncated",
+			ResultKeys:      []string{"Keys"},
 		},
 	}
 
@@ -676,6 +959,22 @@ func (c *KMS) ListKeys(input *ListKeysInput) (*ListKeysOutput, error) {
 	return out, err
 }
 
+// ListKeysWithContext is the same as ListKeys with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) ListKeysWithContext(ctx aws.Context, input *ListKeysInput, opts ...aws.Option) (*ListKeysOutput, error) {
+	req, out := c.ListKeysRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListKeysPages iterates over the pages of a ListKeys operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *KMS) ListKeysPages(input *ListKeysInput, fn func(p *ListKeysOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListKeysRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -683,6 +982,19 @@ func (c *KMS) ListKeysPages(input *ListKeysInput, fn func(p *ListKeysOutput, las
 	})
 }
 
+// ListKeysPagesWithContext is the same as ListKeysPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *KMS) ListKeysPagesWithContext(ctx aws.Context, input *ListKeysInput, fn func(p *ListKeysOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListKeysRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListKeysOutput), lastPage)
+	})
+}
+
 const opPutKeyPolicy = "PutKeyPolicy"
 
 // PutKeyPolicyRequest generates a request for the PutKeyPolicy operation.
@@ -710,6 +1022,18 @@ func (c *KMS) PutKeyPolicy(input *PutKeyPolicyInput) (*PutKeyPolicyOutput, error
 	return out, err
 }
 
+// PutKeyPolicyWithContext is the same as PutKeyPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) PutKeyPolicyWithContext(ctx aws.Context, input *PutKeyPolicyInput, opts ...aws.Option) (*PutKeyPolicyOutput, error) {
+	req, out := c.PutKeyPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opReEncrypt = "ReEncrypt"
 
 // ReEncryptRequest generates a request for the ReEncrypt operation.
@@ -748,6 +1072,18 @@ func (c *KMS) ReEncrypt(input *ReEncryptInput) (*ReEncryptOutput, error) {
 	return out, err
 }
 
+// ReEncryptWithContext is the same as ReEncrypt with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) ReEncryptWithContext(ctx aws.Context, input *ReEncryptInput, opts ...aws.Option) (*ReEncryptOutput, error) {
+	req, out := c.ReEncryptRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRetireGrant = "RetireGrant"
 
 // RetireGrantRequest generates a request for the RetireGrant operation.
@@ -783,6 +1119,18 @@ func (c *KMS) RetireGrant(input *RetireGrantInput) (*RetireGrantOutput, error) {
 	return out, err
 }
 
+// RetireGrantWithContext is the same as RetireGrant with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) RetireGrantWithContext(ctx aws.Context, input *RetireGrantInput, opts ...aws.Option) (*RetireGrantOutput, error) {
+	req, out := c.RetireGrantRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRevokeGrant = "RevokeGrant"
 
 // RevokeGrantRequest generates a request for the RevokeGrant operation.
@@ -811,6 +1159,18 @@ func (c *KMS) RevokeGrant(input *RevokeGrantInput) (*RevokeGrantOutput, error) {
 	return out, err
 }
 
+// RevokeGrantWithContext is the same as RevokeGrant with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) RevokeGrantWithContext(ctx aws.Context, input *RevokeGrantInput, opts ...aws.Option) (*RevokeGrantOutput, error) {
+	req, out := c.RevokeGrantRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAlias = "UpdateAlias"
 
 // UpdateAliasRequest generates a request for the UpdateAlias operation.
@@ -850,6 +1210,18 @@ func (c *KMS) UpdateAlias(input *UpdateAliasInput) (*UpdateAliasOutput, error) {
 	return out, err
 }
 
+// UpdateAliasWithContext is the same as UpdateAlias with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) UpdateAliasWithContext(ctx aws.Context, input *UpdateAliasInput, opts ...aws.Option) (*UpdateAliasOutput, error) {
+	req, out := c.UpdateAliasRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateKeyDescription = "UpdateKeyDescription"
 
 // UpdateKeyDescriptionRequest generates a request for the UpdateKeyDescription operation.
@@ -877,16 +1249,28 @@ func (c *KMS) UpdateKeyDescription(input *UpdateKeyDescriptionInput) (*UpdateKey
 	return out, err
 }
 
+// UpdateKeyDescriptionWithContext is the same as UpdateKeyDescription with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *KMS) UpdateKeyDescriptionWithContext(ctx aws.Context, input *UpdateKeyDescriptionInput, opts ...aws.Option) (*UpdateKeyDescriptionOutput, error) {
+	req, out := c.UpdateKeyDescriptionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Contains information about an alias.
 type AliasListEntry struct {
 	// String that contains the key ARN.
-	AliasARN *string `locationName:"AliasArn" type:"string"`
+	AliasARN *string `locationName:"AliasArn" type:"string" min:"20" max:"2048"`
 
 	// String that contains the alias.
-	AliasName *string `type:"string"`
+	AliasName *string `type:"string" min:"1" max:"256"`
 
 	// String that contains the key identifier pointed to by the alias.
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256"`
 
 	metadataAliasListEntry `json:"-" xml:"-"`
 }
@@ -909,13 +1293,13 @@ type CreateAliasInput struct {
 	// String that contains the display name. The name must start with the word
 	// "alias" followed by a forward slash (alias/). Aliases that begin with "alias/AWS"
 	// are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// An identifier of the key for which you are creating the alias. This value
 	// cannot be another alias but can be a globally unique identifier or a fully
 	// specified ARN to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string" required:"true"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCreateAliasInput `json:"-" xml:"-"`
 }
@@ -958,17 +1342,17 @@ type CreateGrantInput struct {
 	Constraints *GrantConstraints `type:"structure"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// Principal given permission by the grant to use the key identified by the
 	// keyId parameter.
-	GranteePrincipal *string `type:"string" required:"true"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -977,7 +1361,7 @@ type CreateGrantInput struct {
 
 	// Principal given permission to retire the grant. For more information, see
 	// RetireGrant.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataCreateGrantInput `json:"-" xml:"-"`
 }
@@ -998,10 +1382,10 @@ func (s CreateGrantInput) GoString() string {
 
 type CreateGrantOutput struct {
 	// Unique grant identifier. You can use the GrantId value to revoke a grant.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantToken *string `type:"string"`
+	GrantToken *string `type:"string" min:"1" max:"8192"`
 
 	metadataCreateGrantOutput `json:"-" xml:"-"`
 }
@@ -1023,7 +1407,7 @@ func (s CreateGrantOutput) GoString() string {
 type CreateKeyInput struct {
 	// Description of the key. We recommend that you choose a description that helps
 	// your customer decide whether the key is appropriate for a task.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"8192"`
 
 	// Specifies the intended use of the key. Currently this defaults to ENCRYPT/DECRYPT,
 	// and only symmetric encryption and decryption are supported.
@@ -1031,7 +1415,7 @@ type CreateKeyInput struct {
 
 	// Policy to be attached to the key. This is required and delegates back to
 	// the account. The key is the root of trust.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072"`
 
 	metadataCreateKeyInput `json:"-" xml:"-"`
 }
@@ -1073,7 +1457,7 @@ func (s CreateKeyOutput) GoString() string {
 
 type DecryptInput struct {
 	// Ciphertext to be decrypted. The blob includes metadata.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// The encryption context. If this was specified in the Encrypt function, it
 	// must be specified here or the decryption operation will fail. For more information,
@@ -1081,7 +1465,7 @@ type DecryptInput struct {
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	metadataDecryptInput `json:"-" xml:"-"`
 }
@@ -1103,11 +1487,11 @@ func (s DecryptInput) GoString() string {
 type DecryptOutput struct {
 	// ARN of the key used to perform the decryption. This value is returned if
 	// no errors are encountered during the operation.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Decrypted plaintext data. This value may not be returned if the customer
 	// master key is not available or if you didn't have permission to use it.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" sensitive:"true" min:"1" max:"4096"`
 
 	metadataDecryptOutput `json:"-" xml:"-"`
 }
@@ -1129,7 +1513,7 @@ func (s DecryptOutput) GoString() string {
 type DeleteAliasInput struct {
 	// The alias to be deleted. The name must start with the word "alias" followed
 	// by a forward slash (alias/). Aliases that begin with "alias/AWS" are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeleteAliasInput `json:"-" xml:"-"`
 }
@@ -1173,7 +1557,7 @@ type DescribeKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeKeyInput `json:"-" xml:"-"`
 }
@@ -1218,7 +1602,7 @@ type DisableKeyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyInput `json:"-" xml:"-"`
 }
@@ -1260,7 +1644,7 @@ type DisableKeyRotationInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyRotationInput `json:"-" xml:"-"`
 }
@@ -1302,7 +1686,7 @@ type EnableKeyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyInput `json:"-" xml:"-"`
 }
@@ -1344,7 +1728,7 @@ type EnableKeyRotationInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyRotationInput `json:"-" xml:"-"`
 }
@@ -1389,7 +1773,7 @@ type EncryptInput struct {
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1397,10 +1781,10 @@ type EncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to be encrypted.
-	Plaintext []byte `type:"blob" required:"true"`
+	Plaintext []byte `type:"blob" sensitive:"true" min:"1" max:"4096" required:"true"`
 
 	metadataEncryptInput `json:"-" xml:"-"`
 }
@@ -1422,10 +1806,10 @@ func (s EncryptInput) GoString() string {
 type EncryptOutput struct {
 	// The encrypted plaintext. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// The ID of the key used during encryption.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataEncryptOutput `json:"-" xml:"-"`
 }
@@ -1451,7 +1835,7 @@ type GenerateDataKeyInput struct {
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1459,7 +1843,7 @@ type GenerateDataKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size to generate a
 	// data key for. Currently this can be AES_128 or AES_256.
@@ -1468,7 +1852,7 @@ type GenerateDataKeyInput struct {
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, and 1024. 1024 is the current limit. We recommend that you
 	// use the KeySpec parameter instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyInput `json:"-" xml:"-"`
 }
@@ -1496,15 +1880,15 @@ type GenerateDataKeyOutput struct {
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Plaintext that contains the data key. Use this for encryption and decryption
 	// and then remove it from memory as soon as possible.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" sensitive:"true" min:"1" max:"4096"`
 
 	metadataGenerateDataKeyOutput `json:"-" xml:"-"`
 }
@@ -1529,7 +1913,7 @@ type GenerateDataKeyWithoutPlaintextInput struct {
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1537,7 +1921,7 @@ type GenerateDataKeyWithoutPlaintextInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size. Currently this
 	// can be AES_128 or AES_256.
@@ -1546,7 +1930,7 @@ type GenerateDataKeyWithoutPlaintextInput struct {
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. We recommend that you use the KeySpec parameter
 	// instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyWithoutPlaintextInput `json:"-" xml:"-"`
 }
@@ -1571,11 +1955,11 @@ type GenerateDataKeyWithoutPlaintextOutput struct {
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataGenerateDataKeyWithoutPlaintextOutput `json:"-" xml:"-"`
 }
@@ -1597,7 +1981,7 @@ func (s GenerateDataKeyWithoutPlaintextOutput) GoString() string {
 type GenerateRandomInput struct {
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. The current limit is 1024 bytes.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateRandomInput `json:"-" xml:"-"`
 }
@@ -1618,7 +2002,7 @@ func (s GenerateRandomInput) GoString() string {
 
 type GenerateRandomOutput struct {
 	// Plaintext that contains the unpredictable byte string.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" sensitive:"true" min:"1" max:"4096"`
 
 	metadataGenerateRandomOutput `json:"-" xml:"-"`
 }
@@ -1642,11 +2026,11 @@ type GetKeyPolicyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// String that contains the name of the policy. Currently, this must be "default".
 	// Policy names can be discovered by calling ListKeyPolicies.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataGetKeyPolicyInput `json:"-" xml:"-"`
 }
@@ -1667,7 +2051,7 @@ func (s GetKeyPolicyInput) GoString() string {
 
 type GetKeyPolicyOutput struct {
 	// A policy document in JSON format.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072"`
 
 	metadataGetKeyPolicyOutput `json:"-" xml:"-"`
 }
@@ -1691,7 +2075,7 @@ type GetKeyRotationStatusInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataGetKeyRotationStatusInput `json:"-" xml:"-"`
 }
@@ -1764,13 +2148,13 @@ type GrantListEntry struct {
 	Constraints *GrantConstraints `type:"structure"`
 
 	// Unique grant identifier.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// The principal that receives the grant permission.
-	GranteePrincipal *string `type:"string"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256"`
 
 	// The account under which the grant was issued.
-	IssuingAccount *string `type:"string"`
+	IssuingAccount *string `type:"string" min:"1" max:"256"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -1778,7 +2162,7 @@ type GrantListEntry struct {
 	Operations []*string `type:"list"`
 
 	// The principal that can retire the account.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataGrantListEntry `json:"-" xml:"-"`
 }
@@ -1800,10 +2184,10 @@ func (s GrantListEntry) GoString() string {
 // Contains information about each entry in the key list.
 type KeyListEntry struct {
 	// ARN of the key.
-	KeyARN *string `locationName:"KeyArn" type:"string"`
+	KeyARN *string `locationName:"KeyArn" type:"string" min:"20" max:"2048"`
 
 	// Unique identifier of the key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataKeyListEntry `json:"-" xml:"-"`
 }
@@ -1825,7 +2209,7 @@ func (s KeyListEntry) GoString() string {
 // Contains metadata associated with a specific key.
 type KeyMetadata struct {
 	// Key ARN (Amazon Resource Name).
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// Account ID number.
 	AWSAccountID *string `locationName:"AWSAccountId" type:"string"`
@@ -1834,13 +2218,13 @@ type KeyMetadata struct {
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the key.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"8192"`
 
 	// Value that specifies whether the key is enabled.
 	Enabled *bool `type:"boolean"`
 
 	// Unique identifier for the key.
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// A value that specifies what operation(s) the key can perform.
 	KeyUsage *string `type:"string"`
@@ -1866,12 +2250,12 @@ type ListAliasesInput struct {
 	// Specify this parameter when paginating results to indicate the maximum number
 	// of aliases you want in each response. If there are additional aliases beyond
 	// the maximum you specify, the Truncated response element will be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter when paginating results, and only in a subsequent request
 	// after you've received a response where the results are truncated. Set it
 	// to the value of the NextMarker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListAliasesInput `json:"-" xml:"-"`
 }
@@ -1896,7 +2280,7 @@ type ListAliasesOutput struct {
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1925,18 +2309,18 @@ type ListGrantsInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of grants you want listed in the response. If there are additional
 	// grants beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListGrantsInput `json:"-" xml:"-"`
 }
@@ -1961,7 +2345,7 @@ type ListGrantsOutput struct {
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1992,18 +2376,18 @@ type ListKeyPoliciesInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of policies you want listed in the response. If there are additional
 	// policies beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListKeyPoliciesInput `json:"-" xml:"-"`
 }
@@ -2025,7 +2409,7 @@ func (s ListKeyPoliciesInput) GoString() string {
 type ListKeyPoliciesOutput struct {
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320"`
 
 	// A list of policy names. Currently, there is only one policy and it is named
 	// "Default".
@@ -2058,12 +2442,12 @@ type ListKeysInput struct {
 	// number of keys you want listed in the response. If there are additional keys
 	// beyond the maximum you specify, the Truncated response element will be set
 	// to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320"`
 
 	metadataListKeysInput `json:"-" xml:"-"`
 }
@@ -2088,7 +2472,7 @@ type ListKeysOutput struct {
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -2117,14 +2501,14 @@ type PutKeyPolicyInput struct {
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The policy, in JSON format, to be attached to the key.
-	Policy *string `type:"string" required:"true"`
+	Policy *string `type:"string" min:"1" max:"131072" required:"true"`
 
 	// Name of the policy to be attached. Currently, the only supported name is
 	// "default".
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataPutKeyPolicyInput `json:"-" xml:"-"`
 }
@@ -2163,7 +2547,7 @@ func (s PutKeyPolicyOutput) GoString() string {
 
 type ReEncryptInput struct {
 	// Ciphertext of the data to re-encrypt.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// Encryption context to be used when the data is re-encrypted.
 	DestinationEncryptionContext map[string]*string `type:"map"`
@@ -2175,10 +2559,10 @@ type ReEncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" required:"true"`
+	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// Encryption context used to encrypt and decrypt the data specified in the
 	// CiphertextBlob parameter.
@@ -2204,13 +2588,13 @@ func (s ReEncryptInput) GoString() string {
 type ReEncryptOutput struct {
 	// The re-encrypted data. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// Unique identifier of the key used to re-encrypt the data.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Unique identifier of the key used to originally encrypt the data.
-	SourceKeyID *string `locationName:"SourceKeyId" type:"string"`
+	SourceKeyID *string `locationName:"SourceKeyId" type:"string" min:"1" max:"256"`
 
 	metadataReEncryptOutput `json:"-" xml:"-"`
 }
@@ -2232,16 +2616,16 @@ func (s ReEncryptOutput) GoString() string {
 type RetireGrantInput struct {
 	// Unique identifier of the grant to be retired. The grant ID is returned by
 	// the CreateGrant function.  Grant ID Example - 0123456789012345678901234567890123456789012345678901234567890123
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// Token that identifies the grant to be retired.
-	GrantToken *string `type:"string"`
+	GrantToken *string `type:"string" min:"1" max:"8192"`
 
 	// A unique identifier for the customer master key associated with the grant.
 	// This value can be a globally unique identifier or a fully specified ARN of
 	// the key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataRetireGrantInput `json:"-" xml:"-"`
 }
@@ -2280,13 +2664,13 @@ func (s RetireGrantOutput) GoString() string {
 
 type RevokeGrantInput struct {
 	// Identifier of the grant to be revoked.
-	GrantID *string `locationName:"GrantId" type:"string" required:"true"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128" required:"true"`
 
 	// A unique identifier for the customer master key associated with the grant.
 	// This value can be a globally unique identifier or the fully specified ARN
 	// to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRevokeGrantInput `json:"-" xml:"-"`
 }
@@ -2327,13 +2711,13 @@ type UpdateAliasInput struct {
 	// String that contains the name of the alias to be modifed. The name must start
 	// with the word "alias" followed by a forward slash (alias/). Aliases that
 	// begin with "alias/AWS" are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Unique identifier of the customer master key to be associated with the alias.
 	// This value can be a globally unique identifier or the fully specified ARN
 	// of a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string" required:"true"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataUpdateAliasInput `json:"-" xml:"-"`
 }
@@ -2372,13 +2756,13 @@ func (s UpdateAliasOutput) GoString() string {
 
 type UpdateKeyDescriptionInput struct {
 	// New description for the key.
-	Description *string `type:"string" required:"true"`
+	Description *string `type:"string" min:"0" max:"8192" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataUpdateKeyDescriptionInput `json:"-" xml:"-"`
 }
@@ -4,6 +4,7 @@
 package kmsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 )
 
@@ -11,53 +12,121 @@ import (
 type KMSAPI interface {
 	CreateAlias(*kms.CreateAliasInput) (*kms.CreateAliasOutput, error)
 
+	CreateAliasWithContext(aws.Context, *kms.CreateAliasInput, ...aws.Option) (*kms.CreateAliasOutput, error)
+
 	CreateGrant(*kms.CreateGrantInput) (*kms.CreateGrantOutput, error)
 
+	CreateGrantWithContext(aws.Context, *kms.CreateGrantInput, ...aws.Option) (*kms.CreateGrantOutput, error)
+
 	CreateKey(*kms.CreateKeyInput) (*kms.CreateKeyOutput, error)
 
+	CreateKeyWithContext(aws.Context, *kms.CreateKeyInput, ...aws.Option) (*kms.CreateKeyOutput, error)
+
 	Decrypt(*kms.DecryptInput) (*kms.DecryptOutput, error)
 
+	DecryptWithContext(aws.Context, *kms.DecryptInput, ...aws.Option) (*kms.DecryptOutput, error)
+
 	DeleteAlias(*kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error)
 
+	DeleteAliasWithContext(aws.Context, *kms.DeleteAliasInput, ...aws.Option) (*kms.DeleteAliasOutput, error)
+
 	DescribeKey(*kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error)
 
+	DescribeKeyWithContext(aws.Context, *kms.DescribeKeyInput, ...aws.Option) (*kms.DescribeKeyOutput, error)
+
 	DisableKey(*kms.DisableKeyInput) (*kms.DisableKeyOutput, error)
 
+	DisableKeyWithContext(aws.Context, *kms.DisableKeyInput, ...aws.Option) (*kms.DisableKeyOutput, error)
+
 	DisableKeyRotation(*kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error)
 
+	DisableKeyRotationWithContext(aws.Context, *kms.DisableKeyRotationInput, ...aws.Option) (*kms.DisableKeyRotationOutput, error)
+
 	EnableKey(*kms.EnableKeyInput) (*kms.EnableKeyOutput, error)
 
+	EnableKeyWithContext(aws.Context, *kms.EnableKeyInput, ...aws.Option) (*kms.EnableKeyOutput, error)
+
 	EnableKeyRotation(*kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error)
 
+	EnableKeyRotationWithContext(aws.Context, *kms.EnableKeyRotationInput, ...aws.Option) (*kms.EnableKeyRotationOutput, error)
+
 	Encrypt(*kms.EncryptInput) (*kms.EncryptOutput, error)
 
+	EncryptWithContext(aws.Context, *kms.EncryptInput, ...aws.Option) (*kms.EncryptOutput, error)
+
 	GenerateDataKey(*kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error)
 
+	GenerateDataKeyWithContext(aws.Context, *kms.GenerateDataKeyInput, ...aws.Option) (*kms.GenerateDataKeyOutput, error)
+
 	GenerateDataKeyWithoutPlaintext(*kms.GenerateDataKeyWithoutPlaintextInput) (*kms.GenerateDataKeyWithoutPlaintextOutput, error)
 
+	GenerateDataKeyWithoutPlaintextWithContext(aws.Context, *kms.GenerateDataKeyWithoutPlaintextInput, ...aws.Option) (*kms.GenerateDataKeyWithoutPlaintextOutput, error)
+
 	GenerateRandom(*kms.GenerateRandomInput) (*kms.GenerateRandomOutput, error)
 
+	GenerateRandomWithContext(aws.Context, *kms.GenerateRandomInput, ...aws.Option) (*kms.GenerateRandomOutput, error)
+
 	GetKeyPolicy(*kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error)
 
+	GetKeyPolicyWithContext(aws.Context, *kms.GetKeyPolicyInput, ...aws.Option) (*kms.GetKeyPolicyOutput, error)
+
 	GetKeyRotationStatus(*kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error)
 
+	GetKeyRotationStatusWithContext(aws.Context, *kms.GetKeyRotationStatusInput, ...aws.Option) (*kms.GetKeyRotationStatusOutput, error)
+
 	ListAliases(*kms.ListAliasesInput) (*kms.ListAliasesOutput, error)
 
+	ListAliasesWithContext(aws.Context, *kms.ListAliasesInput, ...aws.Option) (*kms.ListAliasesOutput, error)
+
+	ListAliasesPages(*kms.ListAliasesInput, func(*kms.ListAliasesOutput, bool) bool) error
+
+	ListAliasesPagesWithContext(aws.Context, *kms.ListAliasesInput, func(*kms.ListAliasesOutput, bool) bool, ...aws.Option) error
+
 	ListGrants(*kms.ListGrantsInput) (*kms.ListGrantsOutput, error)
 
+	ListGrantsWithContext(aws.Context, *kms.ListGrantsInput, ...aws.Option) (*kms.ListGrantsOutput, error)
+
+	ListGrantsPages(*kms.ListGrantsInput, func(*kms.ListGrantsOutput, bool) bool) error
+
+	ListGrantsPagesWithContext(aws.Context, *kms.ListGrantsInput, func(*kms.ListGrantsOutput, bool) bool, ...aws.Option) error
+
 	ListKeyPolicies(*kms.ListKeyPoliciesInput) (*kms.ListKeyPoliciesOutput, error)
 
+	ListKeyPoliciesWithContext(aws.Context, *kms.ListKeyPoliciesInput, ...aws.Option) (*kms.ListKeyPoliciesOutput, error)
+
+	ListKeyPoliciesPages(*kms.ListKeyPoliciesInput, func(*kms.ListKeyPoliciesOutput, bool) bool) error
+
+	ListKeyPoliciesPagesWithContext(aws.Context, *kms.ListKeyPoliciesInput, func(*kms.ListKeyPoliciesOutput, bool) bool, ...aws.Option) error
+
 	ListKeys(*kms.ListKeysInput) (*kms.ListKeysOutput, error)
 
+	ListKeysWithContext(aws.Context, *kms.ListKeysInput, ...aws.Option) (*kms.ListKeysOutput, error)
+
+	ListKeysPages(*kms.ListKeysInput, func(*kms.ListKeysOutput, bool) bool) error
+
+	ListKeysPagesWithContext(aws.Context, *kms.ListKeysInput, func(*kms.ListKeysOutput, bool) bool, ...aws.Option) error
+
 	PutKeyPolicy(*kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error)
 
+	PutKeyPolicyWithContext(aws.Context, *kms.PutKeyPolicyInput, ...aws.Option) (*kms.PutKeyPolicyOutput, error)
+
 	ReEncrypt(*kms.ReEncryptInput) (*kms.ReEncryptOutput, error)
 
+	ReEncryptWithContext(aws.Context, *kms.ReEncryptInput, ...aws.Option) (*kms.ReEncryptOutput, error)
+
 	RetireGrant(*kms.RetireGrantInput) (*kms.RetireGrantOutput, error)
 
+	RetireGrantWithContext(aws.Context, *kms.RetireGrantInput, ...aws.Option) (*kms.RetireGrantOutput, error)
+
 	RevokeGrant(*kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error)
 
+	RevokeGrantWithContext(aws.Context, *kms.RevokeGrantInput, ...aws.Option) (*kms.RevokeGrantOutput, error)
+
 	UpdateAlias(*kms.UpdateAliasInput) (*kms.UpdateAliasOutput, error)
 
+	UpdateAliasWithContext(aws.Context, *kms.UpdateAliasInput, ...aws.Option) (*kms.UpdateAliasOutput, error)
+
 	UpdateKeyDescription(*kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error)
+
+	UpdateKeyDescriptionWithContext(aws.Context, *kms.UpdateKeyDescriptionInput, ...aws.Option) (*kms.UpdateKeyDescriptionOutput, error)
 }
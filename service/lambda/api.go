@@ -47,6 +47,18 @@ func (c *Lambda) AddPermission(input *AddPermissionInput) (*AddPermissionOutput,
 	return out, err
 }
 
+// AddPermissionWithContext is the same as AddPermission with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) AddPermissionWithContext(ctx aws.Context, input *AddPermissionInput, opts ...aws.Option) (*AddPermissionOutput, error) {
+	req, out := c.AddPermissionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateEventSourceMapping = "CreateEventSourceMapping"
 
 // CreateEventSourceMappingRequest generates a request for the CreateEventSourceMapping operation.
@@ -92,6 +104,18 @@ func (c *Lambda) CreateEventSourceMapping(input *CreateEventSourceMappingInput)
 	return out, err
 }
 
+// CreateEventSourceMappingWithContext is the same as CreateEventSourceMapping with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) CreateEventSourceMappingWithContext(ctx aws.Context, input *CreateEventSourceMappingInput, opts ...aws.Option) (*EventSourceMappingConfiguration, error) {
+	req, out := c.CreateEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateFunction = "CreateFunction"
 
 // CreateFunctionRequest generates a request for the CreateFunction operation.
@@ -124,6 +148,18 @@ func (c *Lambda) CreateFunction(input *CreateFunctionInput) (*FunctionConfigurat
 	return out, err
 }
 
+// CreateFunctionWithContext is the same as CreateFunction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) CreateFunctionWithContext(ctx aws.Context, input *CreateFunctionInput, opts ...aws.Option) (*FunctionConfiguration, error) {
+	req, out := c.CreateFunctionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteEventSourceMapping = "DeleteEventSourceMapping"
 
 // DeleteEventSourceMappingRequest generates a request for the DeleteEventSourceMapping operation.
@@ -155,6 +191,18 @@ func (c *Lambda) DeleteEventSourceMapping(input *DeleteEventSourceMappingInput)
 	return out, err
 }
 
+// DeleteEventSourceMappingWithContext is the same as DeleteEventSourceMapping with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) DeleteEventSourceMappingWithContext(ctx aws.Context, input *DeleteEventSourceMappingInput, opts ...aws.Option) (*EventSourceMappingConfiguration, error) {
+	req, out := c.DeleteEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteFunction = "DeleteFunction"
 
 // DeleteFunctionRequest generates a request for the DeleteFunction operation.
@@ -187,6 +235,18 @@ func (c *Lambda) DeleteFunction(input *DeleteFunctionInput) (*DeleteFunctionOutp
 	return out, err
 }
 
+// DeleteFunctionWithContext is the same as DeleteFunction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) DeleteFunctionWithContext(ctx aws.Context, input *DeleteFunctionInput, opts ...aws.Option) (*DeleteFunctionOutput, error) {
+	req, out := c.DeleteFunctionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetEventSourceMapping = "GetEventSourceMapping"
 
 // GetEventSourceMappingRequest generates a request for the GetEventSourceMapping operation.
@@ -218,6 +278,18 @@ func (c *Lambda) GetEventSourceMapping(input *GetEventSourceMappingInput) (*Even
 	return out, err
 }
 
+// GetEventSourceMappingWithContext is the same as GetEventSourceMapping with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) GetEventSourceMappingWithContext(ctx aws.Context, input *GetEventSourceMappingInput, opts ...aws.Option) (*EventSourceMappingConfiguration, error) {
+	req, out := c.GetEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetFunction = "GetFunction"
 
 // GetFunctionRequest generates a request for the GetFunction operation.
@@ -251,6 +323,18 @@ func (c *Lambda) GetFunction(input *GetFunctionInput) (*GetFunctionOutput, error
 	return out, err
 }
 
+// GetFunctionWithContext is the same as GetFunction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) GetFunctionWithContext(ctx aws.Context, input *GetFunctionInput, opts ...aws.Option) (*GetFunctionOutput, error) {
+	req, out := c.GetFunctionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetFunctionConfiguration = "GetFunctionConfiguration"
 
 // GetFunctionConfigurationRequest generates a request for the GetFunctionConfiguration operation.
@@ -283,6 +367,18 @@ func (c *Lambda) GetFunctionConfiguration(input *GetFunctionConfigurationInput)
 	return out, err
 }
 
+// GetFunctionConfigurationWithContext is the same as GetFunctionConfiguration with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) GetFunctionConfigurationWithContext(ctx aws.Context, input *GetFunctionConfigurationInput, opts ...aws.Option) (*FunctionConfiguration, error) {
+	req, out := c.GetFunctionConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPolicy = "GetPolicy"
 
 // GetPolicyRequest generates a request for the GetPolicy operation.
@@ -313,6 +409,18 @@ func (c *Lambda) GetPolicy(input *GetPolicyInput) (*GetPolicyOutput, error) {
 	return out, err
 }
 
+// GetPolicyWithContext is the same as GetPolicy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) GetPolicyWithContext(ctx aws.Context, input *GetPolicyInput, opts ...aws.Option) (*GetPolicyOutput, error) {
+	req, out := c.GetPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opInvoke = "Invoke"
 
 // InvokeRequest generates a request for the Invoke operation.
@@ -342,6 +450,18 @@ func (c *Lambda) Invoke(input *InvokeInput) (*InvokeOutput, error) {
 	return out, err
 }
 
+// InvokeWithContext is the same as Invoke with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) InvokeWithContext(ctx aws.Context, input *InvokeInput, opts ...aws.Option) (*InvokeOutput, error) {
+	req, out := c.InvokeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opInvokeAsync = "InvokeAsync"
 
 // InvokeAsyncRequest generates a request for the InvokeAsync operation.
@@ -374,6 +494,18 @@ func (c *Lambda) InvokeAsync(input *InvokeAsyncInput) (*InvokeAsyncOutput, error
 	return out, err
 }
 
+// InvokeAsyncWithContext is the same as InvokeAsync with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) InvokeAsyncWithContext(ctx aws.Context, input *InvokeAsyncInput, opts ...aws.Option) (*InvokeAsyncOutput, error) {
+	req, out := c.InvokeAsyncRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListEventSourceMappings = "ListEventSourceMappings"
 
 // ListEventSourceMappingsRequest generates a request for the ListEventSourceMappings operation.
@@ -387,6 +519,7 @@ func (c *Lambda) ListEventSourceMappingsRequest(input *ListEventSourceMappingsIn
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "",
+			ResultKeys:      []string{"EventSourceMappings"},
 		},
 	}
 
@@ -415,6 +548,22 @@ func (c *Lambda) ListEventSourceMappings(input *ListEventSourceMappingsInput) (*
 	return out, err
 }
 
+// ListEventSourceMappingsWithContext is the same as ListEventSourceMappings with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) ListEventSourceMappingsWithContext(ctx aws.Context, input *ListEventSourceMappingsInput, opts ...aws.Option) (*ListEventSourceMappingsOutput, error) {
+	req, out := c.ListEventSourceMappingsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListEventSourceMappingsPages iterates over the pages of a ListEventSourceMappings operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Lambda) ListEventSourceMappingsPages(input *ListEventSourceMappingsInput, fn func(p *ListEventSourceMappingsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListEventSourceMappingsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -422,6 +571,19 @@ func (c *Lambda) ListEventSourceMappingsPages(input *ListEventSourceMappingsInpu
 	})
 }
 
+// ListEventSourceMappingsPagesWithContext is the same as ListEventSourceMappingsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Lambda) ListEventSourceMappingsPagesWithContext(ctx aws.Context, input *ListEventSourceMappingsInput, fn func(p *ListEventSourceMappingsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListEventSourceMappingsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListEventSourceMappingsOutput), lastPage)
+	})
+}
+
 const opListFunctions = "ListFunctions"
 
 // ListFunctionsRequest generates a request for the ListFunctions operation.
@@ -435,6 +597,7 @@ func (c *Lambda) ListFunctionsRequest(input *ListFunctionsInput) (req *aws.Reque
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "",
+			ResultKeys:      []string{"Functions"},
 		},
 	}
 
@@ -459,6 +622,22 @@ func (c *Lambda) ListFunctions(input *ListFunctionsInput) (*ListFunctionsOutput,
 	return out, err
 }
 
+// ListFunctionsWithContext is the same as ListFunctions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) ListFunctionsWithContext(ctx aws.Context, input *ListFunctionsInput, opts ...aws.Option) (*ListFunctionsOutput, error) {
+	req, out := c.ListFunctionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListFunctionsPages iterates over the pages of a ListFunctions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Lambda) ListFunctionsPages(input *ListFunctionsInput, fn func(p *ListFunctionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListFunctionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -466,6 +645,19 @@ func (c *Lambda) ListFunctionsPages(input *ListFunctionsInput, fn func(p *ListFu
 	})
 }
 
+// ListFunctionsPagesWithContext is the same as ListFunctionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Lambda) ListFunctionsPagesWithContext(ctx aws.Context, input *ListFunctionsInput, fn func(p *ListFunctionsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListFunctionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListFunctionsOutput), lastPage)
+	})
+}
+
 const opRemovePermission = "RemovePermission"
 
 // RemovePermissionRequest generates a request for the RemovePermission operation.
@@ -499,6 +691,18 @@ func (c *Lambda) RemovePermission(input *RemovePermissionInput) (*RemovePermissi
 	return out, err
 }
 
+// RemovePermissionWithContext is the same as RemovePermission with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput, opts ...aws.Option) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateEventSourceMapping = "UpdateEventSourceMapping"
 
 // UpdateEventSourceMappingRequest generates a request for the UpdateEventSourceMapping operation.
@@ -532,6 +736,18 @@ func (c *Lambda) UpdateEventSourceMapping(input *UpdateEventSourceMappingInput)
 	return out, err
 }
 
+// UpdateEventSourceMappingWithContext is the same as UpdateEventSourceMapping with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) UpdateEventSourceMappingWithContext(ctx aws.Context, input *UpdateEventSourceMappingInput, opts ...aws.Option) (*EventSourceMappingConfiguration, error) {
+	req, out := c.UpdateEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateFunctionCode = "UpdateFunctionCode"
 
 // UpdateFunctionCodeRequest generates a request for the UpdateFunctionCode operation.
@@ -563,6 +779,18 @@ func (c *Lambda) UpdateFunctionCode(input *UpdateFunctionCodeInput) (*FunctionCo
 	return out, err
 }
 
+// UpdateFunctionCodeWithContext is the same as UpdateFunctionCode with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) UpdateFunctionCodeWithContext(ctx aws.Context, input *UpdateFunctionCodeInput, opts ...aws.Option) (*FunctionConfiguration, error) {
+	req, out := c.UpdateFunctionCodeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateFunctionConfiguration = "UpdateFunctionConfiguration"
 
 // UpdateFunctionConfigurationRequest generates a request for the UpdateFunctionConfiguration operation.
@@ -596,6 +824,18 @@ func (c *Lambda) UpdateFunctionConfiguration(input *UpdateFunctionConfigurationI
 	return out, err
 }
 
+// UpdateFunctionConfigurationWithContext is the same as UpdateFunctionConfiguration with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Lambda) UpdateFunctionConfigurationWithContext(ctx aws.Context, input *UpdateFunctionConfigurationInput, opts ...aws.Option) (*FunctionConfiguration, error) {
+	req, out := c.UpdateFunctionConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 type AddPermissionInput struct {
 	// The AWS Lambda action you want to allow in this statement. Each Lambda action
 	// is a string starting with "lambda:" followed by the API name (see Operations).
@@ -612,7 +852,7 @@ type AddPermissionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	// The principal who is getting this permission. It can be Amazon S3 service
 	// Principal ("s3.amazonaws.com") if you want Amazon S3 to invoke the function,
@@ -642,7 +882,7 @@ type AddPermissionInput struct {
 	SourceAccount *string `type:"string"`
 
 	// A unique statement identifier.
-	StatementID *string `locationName:"StatementId" type:"string" required:"true"`
+	StatementID *string `locationName:"StatementId" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataAddPermissionInput `json:"-" xml:"-"`
 }
@@ -687,7 +927,7 @@ type CreateEventSourceMappingInput struct {
 	// The largest number of records that AWS Lambda will retrieve from your event
 	// source at the time of invoking your function. Your function receives an event
 	// with all the retrieved records. The default is 100 records.
-	BatchSize *int64 `type:"integer"`
+	BatchSize *int64 `type:"integer" min:"1" max:"10000"`
 
 	// Indicates whether AWS Lambda should begin polling the event source, the default
 	// is not enabled.
@@ -708,7 +948,7 @@ type CreateEventSourceMappingInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `type:"string" required:"true"`
+	FunctionName *string `type:"string" min:"1" max:"111" required:"true"`
 
 	// The position in the stream where AWS Lambda should start reading. For more
 	// information, go to ShardIteratorType (http://docs.aws.amazon.com/kinesis/latest/APIReference/API_GetShardIterator.html#Kinesis-GetShardIterator-request-ShardIteratorType)
@@ -738,7 +978,7 @@ type CreateFunctionInput struct {
 
 	// A short, user-defined function description. Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"256"`
 
 	// The name you want to assign to the function you are uploading. You can specify
 	// an unqualified function name (for example, "Thumbnail") or you can specify
@@ -749,13 +989,13 @@ type CreateFunctionInput struct {
 	// 64 character in length. The function names appear in the console and are
 	// returned in the ListFunctions API. Function names are used to specify functions
 	// to other AWS Lambda APIs, such as Invoke.
-	FunctionName *string `type:"string" required:"true"`
+	FunctionName *string `type:"string" min:"1" max:"111" required:"true"`
 
 	// The function within your code that Lambda calls to begin execution. For Node.js,
 	// it is the module-name.export value in your function. For Java, it can be
 	// package.class-name::handler or package.class-name. For more information,
 	// see Lambda Function Handler (Java) (http://docs.aws.amazon.com/lambda/latest/dg/java-programming-model-handler-types.html).
-	Handler *string `type:"string" required:"true"`
+	Handler *string `type:"string" max:"128" required:"true"`
 
 	// The amount of memory, in MB, your Lambda function is given. Lambda uses this
 	// memory size to infer the amount of CPU and memory allocated to your function.
@@ -763,7 +1003,7 @@ type CreateFunctionInput struct {
 	// a database operation might need less memory compared to an image processing
 	// function. The default value is 128 MB. The value must be a multiple of 64
 	// MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1536"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
@@ -777,7 +1017,7 @@ type CreateFunctionInput struct {
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataCreateFunctionInput `json:"-" xml:"-"`
 }
@@ -826,7 +1066,7 @@ type DeleteFunctionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	metadataDeleteFunctionInput `json:"-" xml:"-"`
 }
@@ -868,7 +1108,7 @@ type EventSourceMappingConfiguration struct {
 	// The largest number of records that AWS Lambda will retrieve from your event
 	// source at the time of invoking your function. Your function receives an event
 	// with all the retrieved records.
-	BatchSize *int64 `type:"integer"`
+	BatchSize *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Kinesis stream that is the source
 	// of events.
@@ -916,13 +1156,13 @@ type FunctionCode struct {
 	// Amazon S3 bucket name where the .zip file containing your deployment package
 	// is stored. This bucket must reside in the same AWS region where you are creating
 	// the Lambda function.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" min:"3" max:"63"`
 
 	// The Amazon S3 object (the deployment package) key name you want to upload.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" min:"1" max:"1024"`
 
 	// The Amazon S3 object (the deployment package) version you want to upload.
-	S3ObjectVersion *string `type:"string"`
+	S3ObjectVersion *string `type:"string" min:"1" max:"1024"`
 
 	// A base64-encoded .zip file containing your deployment package. For more information
 	// about creating a .zip file, go to Execution Permissions (http://docs.aws.amazon.com/lambda/latest/dg/intro-permission-model.html#lambda-intro-execution-role.html)
@@ -978,23 +1218,23 @@ type FunctionConfiguration struct {
 	CodeSize *int64 `type:"long"`
 
 	// The user-provided description.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"256"`
 
 	// The Amazon Resource Name (ARN) assigned to the function.
 	FunctionARN *string `locationName:"FunctionArn" type:"string"`
 
 	// The name of the function.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"111"`
 
 	// The function Lambda calls to begin executing your function.
-	Handler *string `type:"string"`
+	Handler *string `type:"string" max:"128"`
 
 	// The timestamp of the last time you updated the function.
 	LastModified *string `type:"string"`
 
 	// The memory size, in MB, you configured for the function. Must be a multiple
 	// of 64 MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1536"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
@@ -1006,7 +1246,7 @@ type FunctionConfiguration struct {
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataFunctionConfiguration `json:"-" xml:"-"`
 }
@@ -1056,7 +1296,7 @@ type GetFunctionConfigurationInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	metadataGetFunctionConfigurationInput `json:"-" xml:"-"`
 }
@@ -1084,7 +1324,7 @@ type GetFunctionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	metadataGetFunctionInput `json:"-" xml:"-"`
 }
@@ -1137,7 +1377,7 @@ type GetPolicyInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	metadataGetPolicyInput `json:"-" xml:"-"`
 }
@@ -1180,7 +1420,7 @@ func (s GetPolicyOutput) GoString() string {
 
 type InvokeAsyncInput struct {
 	// The Lambda function name.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	// JSON that you want to provide to your Lambda function as input.
 	InvokeArgs io.ReadSeeker `type:"blob" required:"true"`
@@ -1242,7 +1482,7 @@ type InvokeInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	// By default, the Invoke API assumes "RequestResponse" invocation type. You
 	// can optionally request asynchronous execution by specifying "Event" as the
@@ -1337,7 +1577,7 @@ type ListEventSourceMappingsInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string"`
+	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string" min:"1" max:"111"`
 
 	// Optional string. An opaque pagination token returned from a previous ListEventSourceMappings
 	// operation. If present, specifies to continue the list from where the returning
@@ -1346,7 +1586,7 @@ type ListEventSourceMappingsInput struct {
 
 	// Optional integer. Specifies the maximum number of event sources to return
 	// in response. This value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListEventSourceMappingsInput `json:"-" xml:"-"`
 }
@@ -1397,7 +1637,7 @@ type ListFunctionsInput struct {
 
 	// Optional integer. Specifies the maximum number of AWS Lambda functions to
 	// return in response. This parameter value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListFunctionsInput `json:"-" xml:"-"`
 }
@@ -1450,10 +1690,10 @@ type RemovePermissionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	// Statement ID of the permission to remove.
-	StatementID *string `location:"uri" locationName:"StatementId" type:"string" required:"true"`
+	StatementID *string `location:"uri" locationName:"StatementId" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataRemovePermissionInput `json:"-" xml:"-"`
 }
@@ -1493,7 +1733,7 @@ func (s RemovePermissionOutput) GoString() string {
 type UpdateEventSourceMappingInput struct {
 	// The maximum number of stream records that can be sent to your Lambda function
 	// for a single invocation.
-	BatchSize *int64 `type:"integer"`
+	BatchSize *int64 `type:"integer" min:"1" max:"10000"`
 
 	// Specifies whether AWS Lambda should actively poll the stream or not. If disabled,
 	// AWS Lambda will not poll the stream.
@@ -1507,7 +1747,7 @@ type UpdateEventSourceMappingInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"111"`
 
 	// The event source mapping identifier.
 	UUID *string `location:"uri" locationName:"UUID" type:"string" required:"true"`
@@ -1538,18 +1778,18 @@ type UpdateFunctionCodeInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	// Amazon S3 bucket name where the .zip file containing your deployment package
 	// is stored. This bucket must reside in the same AWS region where you are creating
 	// the Lambda function.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" min:"3" max:"63"`
 
 	// The Amazon S3 object (the deployment package) key name you want to upload.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" min:"1" max:"1024"`
 
 	// The Amazon S3 object (the deployment package) version you want to upload.
-	S3ObjectVersion *string `type:"string"`
+	S3ObjectVersion *string `type:"string" min:"1" max:"1024"`
 
 	// Based64-encoded .zip file containing your packaged source code.
 	ZipFile []byte `type:"blob"`
@@ -1574,7 +1814,7 @@ func (s UpdateFunctionCodeInput) GoString() string {
 type UpdateFunctionConfigurationInput struct {
 	// A short user-defined function description. AWS Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"256"`
 
 	// The name of the Lambda function.
 	//
@@ -1584,11 +1824,11 @@ type UpdateFunctionConfigurationInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" required:"true"`
 
 	// The function that Lambda calls to begin executing your function. For Node.js,
 	// it is the module-name.export value in your function.
-	Handler *string `type:"string"`
+	Handler *string `type:"string" max:"128"`
 
 	// The amount of memory, in MB, your Lambda function is given. AWS Lambda uses
 	// this memory size to infer the amount of CPU allocated to your function. Your
@@ -1596,7 +1836,7 @@ type UpdateFunctionConfigurationInput struct {
 	// a database operation might need less memory compared to an image processing
 	// function. The default value is 128 MB. The value must be a multiple of 64
 	// MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1536"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda will assume when
 	// it executes your function.
@@ -1605,7 +1845,7 @@ type UpdateFunctionConfigurationInput struct {
 	// The function execution time at which AWS Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataUpdateFunctionConfigurationInput `json:"-" xml:"-"`
 }
@@ -0,0 +1,60 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package lambda
+
+const (
+	// ErrCodeCodeStorageExceededException for service response error code
+	// "CodeStorageExceededException".
+	ErrCodeCodeStorageExceededException = "CodeStorageExceededException"
+
+	// ErrCodeInvalidParameterValueException for service response error code
+	// "InvalidParameterValueException".
+	//
+	// One of the parameters in the request is invalid. For example, if you provided
+	// an IAM role for AWS Lambda to assume in the CreateFunction or the UpdateFunctionConfiguration
+	// API, that AWS Lambda is unable to assume you will get this exception.
+	ErrCodeInvalidParameterValueException = "InvalidParameterValueException"
+
+	// ErrCodeInvalidRequestContentException for service response error code
+	// "InvalidRequestContentException".
+	//
+	// The request body could not be parsed as JSON.
+	ErrCodeInvalidRequestContentException = "InvalidRequestContentException"
+
+	// ErrCodePolicyLengthExceededException for service response error code
+	// "PolicyLengthExceededException".
+	//
+	// Lambda function access policy is limited to 20 KB.
+	ErrCodePolicyLengthExceededException = "PolicyLengthExceededException"
+
+	// ErrCodeRequestTooLargeException for service response error code
+	// "RequestTooLargeException".
+	ErrCodeRequestTooLargeException = "RequestTooLargeException"
+
+	// ErrCodeResourceConflictException for service response error code
+	// "ResourceConflictException".
+	//
+	// The resource already exists.
+	ErrCodeResourceConflictException = "ResourceConflictException"
+
+	// ErrCodeResourceNotFoundException for service response error code
+	// "ResourceNotFoundException".
+	//
+	// The resource (for example, a Lambda function or access policy statement)
+	// specified in the request does not exist.
+	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+
+	// ErrCodeServiceException for service response error code
+	// "ServiceException".
+	//
+	// The AWS Lambda service encountered an internal error.
+	ErrCodeServiceException = "ServiceException"
+
+	// ErrCodeTooManyRequestsException for service response error code
+	// "TooManyRequestsException".
+	ErrCodeTooManyRequestsException = "TooManyRequestsException"
+
+	// ErrCodeUnsupportedMediaTypeException for service response error code
+	// "UnsupportedMediaTypeException".
+	ErrCodeUnsupportedMediaTypeException = "UnsupportedMediaTypeException"
+)
@@ -4,6 +4,7 @@
 package lambdaiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 )
 
@@ -11,35 +12,77 @@ import (
 type LambdaAPI interface {
 	AddPermission(*lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error)
 
+	AddPermissionWithContext(aws.Context, *lambda.AddPermissionInput, ...aws.Option) (*lambda.AddPermissionOutput, error)
+
 	CreateEventSourceMapping(*lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	CreateEventSourceMappingWithContext(aws.Context, *lambda.CreateEventSourceMappingInput, ...aws.Option) (*lambda.EventSourceMappingConfiguration, error)
+
 	CreateFunction(*lambda.CreateFunctionInput) (*lambda.FunctionConfiguration, error)
 
+	CreateFunctionWithContext(aws.Context, *lambda.CreateFunctionInput, ...aws.Option) (*lambda.FunctionConfiguration, error)
+
 	DeleteEventSourceMapping(*lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	DeleteEventSourceMappingWithContext(aws.Context, *lambda.DeleteEventSourceMappingInput, ...aws.Option) (*lambda.EventSourceMappingConfiguration, error)
+
 	DeleteFunction(*lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error)
 
+	DeleteFunctionWithContext(aws.Context, *lambda.DeleteFunctionInput, ...aws.Option) (*lambda.DeleteFunctionOutput, error)
+
 	GetEventSourceMapping(*lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	GetEventSourceMappingWithContext(aws.Context, *lambda.GetEventSourceMappingInput, ...aws.Option) (*lambda.EventSourceMappingConfiguration, error)
+
 	GetFunction(*lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error)
 
+	GetFunctionWithContext(aws.Context, *lambda.GetFunctionInput, ...aws.Option) (*lambda.GetFunctionOutput, error)
+
 	GetFunctionConfiguration(*lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
 
+	GetFunctionConfigurationWithContext(aws.Context, *lambda.GetFunctionConfigurationInput, ...aws.Option) (*lambda.FunctionConfiguration, error)
+
 	GetPolicy(*lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error)
 
+	GetPolicyWithContext(aws.Context, *lambda.GetPolicyInput, ...aws.Option) (*lambda.GetPolicyOutput, error)
+
 	Invoke(*lambda.InvokeInput) (*lambda.InvokeOutput, error)
 
+	InvokeWithContext(aws.Context, *lambda.InvokeInput, ...aws.Option) (*lambda.InvokeOutput, error)
+
 	InvokeAsync(*lambda.InvokeAsyncInput) (*lambda.InvokeAsyncOutput, error)
 
+	InvokeAsyncWithContext(aws.Context, *lambda.InvokeAsyncInput, ...aws.Option) (*lambda.InvokeAsyncOutput, error)
+
 	ListEventSourceMappings(*lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error)
 
+	ListEventSourceMappingsWithContext(aws.Context, *lambda.ListEventSourceMappingsInput, ...aws.Option) (*lambda.ListEventSourceMappingsOutput, error)
+
+	ListEventSourceMappingsPages(*lambda.ListEventSourceMappingsInput, func(*lambda.ListEventSourceMappingsOutput, bool) bool) error
+
+	ListEventSourceMappingsPagesWithContext(aws.Context, *lambda.ListEventSourceMappingsInput, func(*lambda.ListEventSourceMappingsOutput, bool) bool, ...aws.Option) error
+
 	ListFunctions(*lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error)
 
+	ListFunctionsWithContext(aws.Context, *lambda.ListFunctionsInput, ...aws.Option) (*lambda.ListFunctionsOutput, error)
+
+	ListFunctionsPages(*lambda.ListFunctionsInput, func(*lambda.ListFunctionsOutput, bool) bool) error
+
+	ListFunctionsPagesWithContext(aws.Context, *lambda.ListFunctionsInput, func(*lambda.ListFunctionsOutput, bool) bool, ...aws.Option) error
+
 	RemovePermission(*lambda.RemovePermissionInput) (*lambda.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(aws.Context, *lambda.RemovePermissionInput, ...aws.Option) (*lambda.RemovePermissionOutput, error)
+
 	UpdateEventSourceMapping(*lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	UpdateEventSourceMappingWithContext(aws.Context, *lambda.UpdateEventSourceMappingInput, ...aws.Option) (*lambda.EventSourceMappingConfiguration, error)
+
 	UpdateFunctionCode(*lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error)
 
+	UpdateFunctionCodeWithContext(aws.Context, *lambda.UpdateFunctionCodeInput, ...aws.Option) (*lambda.FunctionConfiguration, error)
+
 	UpdateFunctionConfiguration(*lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
+
+	UpdateFunctionConfigurationWithContext(aws.Context, *lambda.UpdateFunctionConfigurationInput, ...aws.Option) (*lambda.FunctionConfiguration, error)
 }
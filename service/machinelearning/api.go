@@ -50,6 +50,18 @@ func (c *MachineLearning) CreateBatchPrediction(input *CreateBatchPredictionInpu
 	return out, err
 }
 
+// CreateBatchPredictionWithContext is the same as CreateBatchPrediction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) CreateBatchPredictionWithContext(ctx aws.Context, input *CreateBatchPredictionInput, opts ...aws.Option) (*CreateBatchPredictionOutput, error) {
+	req, out := c.CreateBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDataSourceFromRDS = "CreateDataSourceFromRDS"
 
 // CreateDataSourceFromRDSRequest generates a request for the CreateDataSourceFromRDS operation.
@@ -90,6 +102,18 @@ func (c *MachineLearning) CreateDataSourceFromRDS(input *CreateDataSourceFromRDS
 	return out, err
 }
 
+// CreateDataSourceFromRDSWithContext is the same as CreateDataSourceFromRDS with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) CreateDataSourceFromRDSWithContext(ctx aws.Context, input *CreateDataSourceFromRDSInput, opts ...aws.Option) (*CreateDataSourceFromRDSOutput, error) {
+	req, out := c.CreateDataSourceFromRDSRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDataSourceFromRedshift = "CreateDataSourceFromRedshift"
 
 // CreateDataSourceFromRedshiftRequest generates a request for the CreateDataSourceFromRedshift operation.
@@ -146,6 +170,18 @@ func (c *MachineLearning) CreateDataSourceFromRedshift(input *CreateDataSourceFr
 	return out, err
 }
 
+// CreateDataSourceFromRedshiftWithContext is the same as CreateDataSourceFromRedshift with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) CreateDataSourceFromRedshiftWithContext(ctx aws.Context, input *CreateDataSourceFromRedshiftInput, opts ...aws.Option) (*CreateDataSourceFromRedshiftOutput, error) {
+	req, out := c.CreateDataSourceFromRedshiftRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDataSourceFromS3 = "CreateDataSourceFromS3"
 
 // CreateDataSourceFromS3Request generates a request for the CreateDataSourceFromS3 operation.
@@ -202,6 +238,18 @@ func (c *MachineLearning) CreateDataSourceFromS3(input *CreateDataSourceFromS3In
 	return out, err
 }
 
+// CreateDataSourceFromS3WithContext is the same as CreateDataSourceFromS3 with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) CreateDataSourceFromS3WithContext(ctx aws.Context, input *CreateDataSourceFromS3Input, opts ...aws.Option) (*CreateDataSourceFromS3Output, error) {
+	req, out := c.CreateDataSourceFromS3Request(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateEvaluation = "CreateEvaluation"
 
 // CreateEvaluationRequest generates a request for the CreateEvaluation operation.
@@ -244,6 +292,18 @@ func (c *MachineLearning) CreateEvaluation(input *CreateEvaluationInput) (*Creat
 	return out, err
 }
 
+// CreateEvaluationWithContext is the same as CreateEvaluation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) CreateEvaluationWithContext(ctx aws.Context, input *CreateEvaluationInput, opts ...aws.Option) (*CreateEvaluationOutput, error) {
+	req, out := c.CreateEvaluationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateMLModel = "CreateMLModel"
 
 // CreateMLModelRequest generates a request for the CreateMLModel operation.
@@ -287,6 +347,18 @@ func (c *MachineLearning) CreateMLModel(input *CreateMLModelInput) (*CreateMLMod
 	return out, err
 }
 
+// CreateMLModelWithContext is the same as CreateMLModel with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) CreateMLModelWithContext(ctx aws.Context, input *CreateMLModelInput, opts ...aws.Option) (*CreateMLModelOutput, error) {
+	req, out := c.CreateMLModelRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateRealtimeEndpoint = "CreateRealtimeEndpoint"
 
 // CreateRealtimeEndpointRequest generates a request for the CreateRealtimeEndpoint operation.
@@ -316,6 +388,18 @@ func (c *MachineLearning) CreateRealtimeEndpoint(input *CreateRealtimeEndpointIn
 	return out, err
 }
 
+// CreateRealtimeEndpointWithContext is the same as CreateRealtimeEndpoint with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) CreateRealtimeEndpointWithContext(ctx aws.Context, input *CreateRealtimeEndpointInput, opts ...aws.Option) (*CreateRealtimeEndpointOutput, error) {
+	req, out := c.CreateRealtimeEndpointRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteBatchPrediction = "DeleteBatchPrediction"
 
 // DeleteBatchPredictionRequest generates a request for the DeleteBatchPrediction operation.
@@ -348,6 +432,18 @@ func (c *MachineLearning) DeleteBatchPrediction(input *DeleteBatchPredictionInpu
 	return out, err
 }
 
+// DeleteBatchPredictionWithContext is the same as DeleteBatchPrediction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DeleteBatchPredictionWithContext(ctx aws.Context, input *DeleteBatchPredictionInput, opts ...aws.Option) (*DeleteBatchPredictionOutput, error) {
+	req, out := c.DeleteBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDataSource = "DeleteDataSource"
 
 // DeleteDataSourceRequest generates a request for the DeleteDataSource operation.
@@ -380,6 +476,18 @@ func (c *MachineLearning) DeleteDataSource(input *DeleteDataSourceInput) (*Delet
 	return out, err
 }
 
+// DeleteDataSourceWithContext is the same as DeleteDataSource with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DeleteDataSourceWithContext(ctx aws.Context, input *DeleteDataSourceInput, opts ...aws.Option) (*DeleteDataSourceOutput, error) {
+	req, out := c.DeleteDataSourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteEvaluation = "DeleteEvaluation"
 
 // DeleteEvaluationRequest generates a request for the DeleteEvaluation operation.
@@ -412,6 +520,18 @@ func (c *MachineLearning) DeleteEvaluation(input *DeleteEvaluationInput) (*Delet
 	return out, err
 }
 
+// DeleteEvaluationWithContext is the same as DeleteEvaluation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DeleteEvaluationWithContext(ctx aws.Context, input *DeleteEvaluationInput, opts ...aws.Option) (*DeleteEvaluationOutput, error) {
+	req, out := c.DeleteEvaluationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMLModel = "DeleteMLModel"
 
 // DeleteMLModelRequest generates a request for the DeleteMLModel operation.
@@ -444,6 +564,18 @@ func (c *MachineLearning) DeleteMLModel(input *DeleteMLModelInput) (*DeleteMLMod
 	return out, err
 }
 
+// DeleteMLModelWithContext is the same as DeleteMLModel with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DeleteMLModelWithContext(ctx aws.Context, input *DeleteMLModelInput, opts ...aws.Option) (*DeleteMLModelOutput, error) {
+	req, out := c.DeleteMLModelRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRealtimeEndpoint = "DeleteRealtimeEndpoint"
 
 // DeleteRealtimeEndpointRequest generates a request for the DeleteRealtimeEndpoint operation.
@@ -471,6 +603,18 @@ func (c *MachineLearning) DeleteRealtimeEndpoint(input *DeleteRealtimeEndpointIn
 	return out, err
 }
 
+// DeleteRealtimeEndpointWithContext is the same as DeleteRealtimeEndpoint with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DeleteRealtimeEndpointWithContext(ctx aws.Context, input *DeleteRealtimeEndpointInput, opts ...aws.Option) (*DeleteRealtimeEndpointOutput, error) {
+	req, out := c.DeleteRealtimeEndpointRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeBatchPredictions = "DescribeBatchPredictions"
 
 // DescribeBatchPredictionsRequest generates a request for the DescribeBatchPredictions operation.
@@ -484,6 +628,7 @@ func (c *MachineLearning) DescribeBatchPredictionsRequest(input *DescribeBatchPr
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Results"},
 		},
 	}
 
@@ -505,6 +650,22 @@ func (c *MachineLearning) DescribeBatchPredictions(input *DescribeBatchPredictio
 	return out, err
 }
 
+// DescribeBatchPredictionsWithContext is the same as DescribeBatchPredictions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DescribeBatchPredictionsWithContext(ctx aws.Context, input *DescribeBatchPredictionsInput, opts ...aws.Option) (*DescribeBatchPredictionsOutput, error) {
+	req, out := c.DescribeBatchPredictionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeBatchPredictionsPages iterates over the pages of a DescribeBatchPredictions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *MachineLearning) DescribeBatchPredictionsPages(input *DescribeBatchPredictionsInput, fn func(p *DescribeBatchPredictionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeBatchPredictionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -512,6 +673,19 @@ func (c *MachineLearning) DescribeBatchPredictionsPages(input *DescribeBatchPred
 	})
 }
 
+// DescribeBatchPredictionsPagesWithContext is the same as DescribeBatchPredictionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *MachineLearning) DescribeBatchPredictionsPagesWithContext(ctx aws.Context, input *DescribeBatchPredictionsInput, fn func(p *DescribeBatchPredictionsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeBatchPredictionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeBatchPredictionsOutput), lastPage)
+	})
+}
+
 const opDescribeDataSources = "DescribeDataSources"
 
 // DescribeDataSourcesRequest generates a request for the DescribeDataSources operation.
@@ -525,6 +699,7 @@ func (c *MachineLearning) DescribeDataSourcesRequest(input *DescribeDataSourcesI
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Results"},
 		},
 	}
 
@@ -545,6 +720,22 @@ func (c *MachineLearning) DescribeDataSources(input *DescribeDataSourcesInput) (
 	return out, err
 }
 
+// DescribeDataSourcesWithContext is the same as DescribeDataSources with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DescribeDataSourcesWithContext(ctx aws.Context, input *DescribeDataSourcesInput, opts ...aws.Option) (*DescribeDataSourcesOutput, error) {
+	req, out := c.DescribeDataSourcesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeDataSourcesPages iterates over the pages of a DescribeDataSources operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *MachineLearning) DescribeDataSourcesPages(input *DescribeDataSourcesInput, fn func(p *DescribeDataSourcesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeDataSourcesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -552,6 +743,19 @@ func (c *MachineLearning) DescribeDataSourcesPages(input *DescribeDataSourcesInp
 	})
 }
 
+// DescribeDataSourcesPagesWithContext is the same as DescribeDataSourcesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *MachineLearning) DescribeDataSourcesPagesWithContext(ctx aws.Context, input *DescribeDataSourcesInput, fn func(p *DescribeDataSourcesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeDataSourcesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDataSourcesOutput), lastPage)
+	})
+}
+
 const opDescribeEvaluations = "DescribeEvaluations"
 
 // DescribeEvaluationsRequest generates a request for the DescribeEvaluations operation.
@@ -565,6 +769,7 @@ func (c *MachineLearning) DescribeEvaluationsRequest(input *DescribeEvaluationsI
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Results"},
 		},
 	}
 
@@ -586,6 +791,22 @@ func (c *MachineLearning) DescribeEvaluations(input *DescribeEvaluationsInput) (
 	return out, err
 }
 
+// DescribeEvaluationsWithContext is the same as DescribeEvaluations with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DescribeEvaluationsWithContext(ctx aws.Context, input *DescribeEvaluationsInput, opts ...aws.Option) (*DescribeEvaluationsOutput, error) {
+	req, out := c.DescribeEvaluationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeEvaluationsPages iterates over the pages of a DescribeEvaluations operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *MachineLearning) DescribeEvaluationsPages(input *DescribeEvaluationsInput, fn func(p *DescribeEvaluationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeEvaluationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -593,6 +814,19 @@ func (c *MachineLearning) DescribeEvaluationsPages(input *DescribeEvaluationsInp
 	})
 }
 
+// DescribeEvaluationsPagesWithContext is the same as DescribeEvaluationsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *MachineLearning) DescribeEvaluationsPagesWithContext(ctx aws.Context, input *DescribeEvaluationsInput, fn func(p *DescribeEvaluationsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeEvaluationsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEvaluationsOutput), lastPage)
+	})
+}
+
 const opDescribeMLModels = "DescribeMLModels"
 
 // DescribeMLModelsRequest generates a request for the DescribeMLModels operation.
@@ -606,6 +840,7 @@ func (c *MachineLearning) DescribeMLModelsRequest(input *DescribeMLModelsInput)
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Results"},
 		},
 	}
 
@@ -626,6 +861,22 @@ func (c *MachineLearning) DescribeMLModels(input *DescribeMLModelsInput) (*Descr
 	return out, err
 }
 
+// DescribeMLModelsWithContext is the same as DescribeMLModels with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) DescribeMLModelsWithContext(ctx aws.Context, input *DescribeMLModelsInput, opts ...aws.Option) (*DescribeMLModelsOutput, error) {
+	req, out := c.DescribeMLModelsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeMLModelsPages iterates over the pages of a DescribeMLModels operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *MachineLearning) DescribeMLModelsPages(input *DescribeMLModelsInput, fn func(p *DescribeMLModelsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeMLModelsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -633,6 +884,19 @@ func (c *MachineLearning) DescribeMLModelsPages(input *DescribeMLModelsInput, fn
 	})
 }
 
+// DescribeMLModelsPagesWithContext is the same as DescribeMLModelsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *MachineLearning) DescribeMLModelsPagesWithContext(ctx aws.Context, input *DescribeMLModelsInput, fn func(p *DescribeMLModelsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeMLModelsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeMLModelsOutput), lastPage)
+	})
+}
+
 const opGetBatchPrediction = "GetBatchPrediction"
 
 // GetBatchPredictionRequest generates a request for the GetBatchPrediction operation.
@@ -661,6 +925,18 @@ func (c *MachineLearning) GetBatchPrediction(input *GetBatchPredictionInput) (*G
 	return out, err
 }
 
+// GetBatchPredictionWithContext is the same as GetBatchPrediction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) GetBatchPredictionWithContext(ctx aws.Context, input *GetBatchPredictionInput, opts ...aws.Option) (*GetBatchPredictionOutput, error) {
+	req, out := c.GetBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDataSource = "GetDataSource"
 
 // GetDataSourceRequest generates a request for the GetDataSource operation.
@@ -693,6 +969,18 @@ func (c *MachineLearning) GetDataSource(input *GetDataSourceInput) (*GetDataSour
 	return out, err
 }
 
+// GetDataSourceWithContext is the same as GetDataSource with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) GetDataSourceWithContext(ctx aws.Context, input *GetDataSourceInput, opts ...aws.Option) (*GetDataSourceOutput, error) {
+	req, out := c.GetDataSourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetEvaluation = "GetEvaluation"
 
 // GetEvaluationRequest generates a request for the GetEvaluation operation.
@@ -721,6 +1009,18 @@ func (c *MachineLearning) GetEvaluation(input *GetEvaluationInput) (*GetEvaluati
 	return out, err
 }
 
+// GetEvaluationWithContext is the same as GetEvaluation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) GetEvaluationWithContext(ctx aws.Context, input *GetEvaluationInput, opts ...aws.Option) (*GetEvaluationOutput, error) {
+	req, out := c.GetEvaluationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetMLModel = "GetMLModel"
 
 // GetMLModelRequest generates a request for the GetMLModel operation.
@@ -751,6 +1051,18 @@ func (c *MachineLearning) GetMLModel(input *GetMLModelInput) (*GetMLModelOutput,
 	return out, err
 }
 
+// GetMLModelWithContext is the same as GetMLModel with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) GetMLModelWithContext(ctx aws.Context, input *GetMLModelInput, opts ...aws.Option) (*GetMLModelOutput, error) {
+	req, out := c.GetMLModelRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPredict = "Predict"
 
 // PredictRequest generates a request for the Predict operation.
@@ -781,6 +1093,18 @@ func (c *MachineLearning) Predict(input *PredictInput) (*PredictOutput, error) {
 	return out, err
 }
 
+// PredictWithContext is the same as Predict with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) PredictWithContext(ctx aws.Context, input *PredictInput, opts ...aws.Option) (*PredictOutput, error) {
+	req, out := c.PredictRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateBatchPrediction = "UpdateBatchPrediction"
 
 // UpdateBatchPredictionRequest generates a request for the UpdateBatchPrediction operation.
@@ -811,6 +1135,18 @@ func (c *MachineLearning) UpdateBatchPrediction(input *UpdateBatchPredictionInpu
 	return out, err
 }
 
+// UpdateBatchPredictionWithContext is the same as UpdateBatchPrediction with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) UpdateBatchPredictionWithContext(ctx aws.Context, input *UpdateBatchPredictionInput, opts ...aws.Option) (*UpdateBatchPredictionOutput, error) {
+	req, out := c.UpdateBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDataSource = "UpdateDataSource"
 
 // UpdateDataSourceRequest generates a request for the UpdateDataSource operation.
@@ -841,6 +1177,18 @@ func (c *MachineLearning) UpdateDataSource(input *UpdateDataSourceInput) (*Updat
 	return out, err
 }
 
+// UpdateDataSourceWithContext is the same as UpdateDataSource with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) UpdateDataSourceWithContext(ctx aws.Context, input *UpdateDataSourceInput, opts ...aws.Option) (*UpdateDataSourceOutput, error) {
+	req, out := c.UpdateDataSourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateEvaluation = "UpdateEvaluation"
 
 // UpdateEvaluationRequest generates a request for the UpdateEvaluation operation.
@@ -871,6 +1219,18 @@ func (c *MachineLearning) UpdateEvaluation(input *UpdateEvaluationInput) (*Updat
 	return out, err
 }
 
+// UpdateEvaluationWithContext is the same as UpdateEvaluation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) UpdateEvaluationWithContext(ctx aws.Context, input *UpdateEvaluationInput, opts ...aws.Option) (*UpdateEvaluationOutput, error) {
+	req, out := c.UpdateEvaluationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateMLModel = "UpdateMLModel"
 
 // UpdateMLModelRequest generates a request for the UpdateMLModel operation.
@@ -901,17 +1261,29 @@ func (c *MachineLearning) UpdateMLModel(input *UpdateMLModelInput) (*UpdateMLMod
 	return out, err
 }
 
+// UpdateMLModelWithContext is the same as UpdateMLModel with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *MachineLearning) UpdateMLModelWithContext(ctx aws.Context, input *UpdateMLModelInput, opts ...aws.Option) (*UpdateMLModelOutput, error) {
+	req, out := c.UpdateMLModelRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the output of GetBatchPrediction operation.
 //
 //  The content consists of the detailed metadata, the status, and the data
 // file information of a Batch Prediction.
 type BatchPrediction struct {
 	// The ID of the DataSource that points to the group of observations to predict.
-	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string"`
+	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" min:"1" max:"64"`
 
 	// The ID assigned to the BatchPrediction at creation. This value should be
 	// identical to the value of the BatchPredictionID in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64"`
 
 	// The time that the BatchPrediction was created. The time is expressed in epoch
 	// time.
@@ -924,7 +1296,7 @@ type BatchPrediction struct {
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048"`
 
 	// The time of the most recent edit to the BatchPrediction. The time is expressed
 	// in epoch time.
@@ -932,19 +1304,19 @@ type BatchPrediction struct {
 
 	// The ID of the MLModel that generated predictions for the BatchPrediction
 	// request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// A description of the most recent details about processing the batch prediction
 	// request.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the BatchPrediction.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// The location of an Amazon S3 bucket or directory to receive the operation
 	// results. The following substrings are not allowed in the s3 key portion of
 	// the "outputURI" field: ':', '//', '/./', '/../'.
-	OutputURI *string `locationName:"OutputUri" type:"string"`
+	OutputURI *string `locationName:"OutputUri" type:"string" max:"2048"`
 
 	// The status of the BatchPrediction. This element can have one of the following
 	// values:
@@ -975,17 +1347,17 @@ func (s BatchPrediction) GoString() string {
 
 type CreateBatchPredictionInput struct {
 	// The ID of the DataSource that points to the group of observations to predict.
-	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" required:"true"`
+	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied ID that uniquely identifies the BatchPrediction.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied name or description of the BatchPrediction. BatchPredictionName
 	// can only use the UTF-8 character set.
-	BatchPredictionName *string `type:"string"`
+	BatchPredictionName *string `type:"string" max:"1024"`
 
 	// The ID of the MLModel that will generate predictions for the group of observations.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The location of an Amazon Simple Storage Service (Amazon S3) bucket or directory
 	// to store the batch prediction results. The following substrings are not allowed
@@ -994,7 +1366,7 @@ type CreateBatchPredictionInput struct {
 	// Amazon ML needs permissions to store and retrieve the logs on your behalf.
 	// For information about how to set permissions, see the Amazon Machine Learning
 	// Developer Guide (http://docs.aws.amazon.com/machine-learning/latest/dg).
-	OutputURI *string `locationName:"OutputUri" type:"string" required:"true"`
+	OutputURI *string `locationName:"OutputUri" type:"string" max:"2048" required:"true"`
 
 	metadataCreateBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -1022,7 +1394,7 @@ func (s CreateBatchPredictionInput) GoString() string {
 type CreateBatchPredictionOutput struct {
 	// A user-supplied ID that uniquely identifies the BatchPrediction. This value
 	// is identical to the value of the BatchPredictionId in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64"`
 
 	metadataCreateBatchPredictionOutput `json:"-" xml:"-"`
 }
@@ -1050,10 +1422,10 @@ type CreateDataSourceFromRDSInput struct {
 
 	// A user-supplied ID that uniquely identifies the DataSource. Typically, an
 	// Amazon Resource Number (ARN) becomes the ID for a DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied name or description of the DataSource.
-	DataSourceName *string `type:"string"`
+	DataSourceName *string `type:"string" max:"1024"`
 
 	// The data specification of an Amazon RDS DataSource:
 	//
@@ -1099,7 +1471,7 @@ type CreateDataSourceFromRDSInput struct {
 	// The role that Amazon ML assumes on behalf of the user to create and activate
 	// a data pipeline in the user’s account and copy data (using the SelectSqlQuery)
 	// query from Amazon RDS to Amazon S3.
-	RoleARN *string `type:"string" required:"true"`
+	RoleARN *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateDataSourceFromRDSInput `json:"-" xml:"-"`
 }
@@ -1130,7 +1502,7 @@ func (s CreateDataSourceFromRDSInput) GoString() string {
 type CreateDataSourceFromRDSOutput struct {
 	// A user-supplied ID that uniquely identifies the datasource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64"`
 
 	metadataCreateDataSourceFromRDSOutput `json:"-" xml:"-"`
 }
@@ -1157,10 +1529,10 @@ type CreateDataSourceFromRedshiftInput struct {
 	ComputeStatistics *bool `type:"boolean"`
 
 	// A user-supplied ID that uniquely identifies the DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied name or description of the DataSource.
-	DataSourceName *string `type:"string"`
+	DataSourceName *string `type:"string" max:"1024"`
 
 	// The data specification of an Amazon Redshift DataSource:
 	//
@@ -1196,7 +1568,7 @@ type CreateDataSourceFromRedshiftInput struct {
 	//
 	// An Amazon S3 bucket policy to grant Amazon ML read/write permissions on
 	// the S3StagingLocation
-	RoleARN *string `type:"string" required:"true"`
+	RoleARN *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateDataSourceFromRedshiftInput `json:"-" xml:"-"`
 }
@@ -1224,7 +1596,7 @@ func (s CreateDataSourceFromRedshiftInput) GoString() string {
 type CreateDataSourceFromRedshiftOutput struct {
 	// A user-supplied ID that uniquely identifies the datasource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64"`
 
 	metadataCreateDataSourceFromRedshiftOutput `json:"-" xml:"-"`
 }
@@ -1251,10 +1623,10 @@ type CreateDataSourceFromS3Input struct {
 	ComputeStatistics *bool `type:"boolean"`
 
 	// A user-supplied identifier that uniquely identifies the DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied name or description of the DataSource.
-	DataSourceName *string `type:"string"`
+	DataSourceName *string `type:"string" max:"1024"`
 
 	// The data specification of a DataSource:
 	//
@@ -1297,7 +1669,7 @@ func (s CreateDataSourceFromS3Input) GoString() string {
 type CreateDataSourceFromS3Output struct {
 	// A user-supplied ID that uniquely identifies the datasource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64"`
 
 	metadataCreateDataSourceFromS3Output `json:"-" xml:"-"`
 }
@@ -1319,19 +1691,19 @@ func (s CreateDataSourceFromS3Output) GoString() string {
 type CreateEvaluationInput struct {
 	// The ID of the DataSource for the evaluation. The schema of the DataSource
 	// must match the schema used to create the MLModel.
-	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" required:"true"`
+	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied ID that uniquely identifies the Evaluation.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied name or description of the Evaluation.
-	EvaluationName *string `type:"string"`
+	EvaluationName *string `type:"string" max:"1024"`
 
 	// The ID of the MLModel to evaluate.
 	//
 	// The schema used in creating the MLModel must match the schema of the DataSource
 	// used in the Evaluation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateEvaluationInput `json:"-" xml:"-"`
 }
@@ -1358,7 +1730,7 @@ func (s CreateEvaluationInput) GoString() string {
 type CreateEvaluationOutput struct {
 	// The user-supplied ID that uniquely identifies the Evaluation. This value
 	// should be identical to the value of the EvaluationId in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64"`
 
 	metadataCreateEvaluationOutput `json:"-" xml:"-"`
 }
@@ -1379,10 +1751,10 @@ func (s CreateEvaluationOutput) GoString() string {
 
 type CreateMLModelInput struct {
 	// A user-supplied ID that uniquely identifies the MLModel.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied name or description of the MLModel.
-	MLModelName *string `type:"string"`
+	MLModelName *string `type:"string" max:"1024"`
 
 	// The category of supervised learning that this MLModel will address. Choose
 	// from the following types:
@@ -1430,15 +1802,15 @@ type CreateMLModelInput struct {
 	// The data recipe for creating MLModel. You must specify either the recipe
 	// or its URI. If you don’t specify a recipe or its URI, Amazon ML creates a
 	// default.
-	Recipe *string `type:"string"`
+	Recipe *string `type:"string" max:"131071"`
 
 	// The Amazon Simple Storage Service (Amazon S3) location and file name that
 	// contains the MLModel recipe. You must specify either the recipe or its URI.
 	// If you don’t specify a recipe or its URI, Amazon ML creates a default.
-	RecipeURI *string `locationName:"RecipeUri" type:"string"`
+	RecipeURI *string `locationName:"RecipeUri" type:"string" max:"2048"`
 
 	// The DataSource that points to the training data.
-	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" required:"true"`
+	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateMLModelInput `json:"-" xml:"-"`
 }
@@ -1465,7 +1837,7 @@ func (s CreateMLModelInput) GoString() string {
 type CreateMLModelOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	metadataCreateMLModelOutput `json:"-" xml:"-"`
 }
@@ -1486,7 +1858,7 @@ func (s CreateMLModelOutput) GoString() string {
 
 type CreateRealtimeEndpointInput struct {
 	// The ID assigned to the MLModel during creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateRealtimeEndpointInput `json:"-" xml:"-"`
 }
@@ -1514,7 +1886,7 @@ func (s CreateRealtimeEndpointInput) GoString() string {
 type CreateRealtimeEndpointOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// The endpoint information of the MLModel
 	RealtimeEndpointInfo *RealtimeEndpointInfo `type:"structure"`
@@ -1556,7 +1928,7 @@ type DataSource struct {
 
 	// The location and name of the data in Amazon Simple Storage Service (Amazon
 	// S3) that is used by a DataSource.
-	DataLocationS3 *string `type:"string"`
+	DataLocationS3 *string `type:"string" max:"2048"`
 
 	// A JSON string that represents the splitting requirement of a Datasource.
 	DataRearrangement *string `type:"string"`
@@ -1566,17 +1938,17 @@ type DataSource struct {
 	DataSizeInBytes *int64 `type:"long"`
 
 	// The ID that is assigned to the DataSource during creation.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64"`
 
 	// The time of the most recent edit to the BatchPrediction. The time is expressed
 	// in epoch time.
 	LastUpdatedAt *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A description of the most recent details about creating the DataSource.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the DataSource.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// The number of data files referenced by the DataSource.
 	NumberOfFiles *int64 `type:"long"`
@@ -1589,7 +1961,7 @@ type DataSource struct {
 
 	// The Amazon Resource Name (ARN) of an AWS IAM Role (http://docs.aws.amazon.com/IAM/latest/UserGuide/roles-toplevel.html#roles-about-termsandconcepts)
 	// such as the following: arn:aws:iam::account:role/rolename.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"100"`
 
 	// The current status of the DataSource. This element can have one of the following
 	// values:
@@ -1620,7 +1992,7 @@ func (s DataSource) GoString() string {
 
 type DeleteBatchPredictionInput struct {
 	// A user-supplied ID that uniquely identifies the BatchPrediction.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -1646,7 +2018,7 @@ func (s DeleteBatchPredictionInput) GoString() string {
 type DeleteBatchPredictionOutput struct {
 	// A user-supplied ID that uniquely identifies the BatchPrediction. This value
 	// should be identical to the value of the BatchPredictionID in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64"`
 
 	metadataDeleteBatchPredictionOutput `json:"-" xml:"-"`
 }
@@ -1667,7 +2039,7 @@ func (s DeleteBatchPredictionOutput) GoString() string {
 
 type DeleteDataSourceInput struct {
 	// A user-supplied ID that uniquely identifies the DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteDataSourceInput `json:"-" xml:"-"`
 }
@@ -1690,7 +2062,7 @@ func (s DeleteDataSourceInput) GoString() string {
 type DeleteDataSourceOutput struct {
 	// A user-supplied ID that uniquely identifies the DataSource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64"`
 
 	metadataDeleteDataSourceOutput `json:"-" xml:"-"`
 }
@@ -1711,7 +2083,7 @@ func (s DeleteDataSourceOutput) GoString() string {
 
 type DeleteEvaluationInput struct {
 	// A user-supplied ID that uniquely identifies the Evaluation to delete.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteEvaluationInput `json:"-" xml:"-"`
 }
@@ -1738,7 +2110,7 @@ func (s DeleteEvaluationInput) GoString() string {
 type DeleteEvaluationOutput struct {
 	// A user-supplied ID that uniquely identifies the Evaluation. This value should
 	// be identical to the value of the EvaluationId in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64"`
 
 	metadataDeleteEvaluationOutput `json:"-" xml:"-"`
 }
@@ -1759,7 +2131,7 @@ func (s DeleteEvaluationOutput) GoString() string {
 
 type DeleteMLModelInput struct {
 	// A user-supplied ID that uniquely identifies the MLModel.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteMLModelInput `json:"-" xml:"-"`
 }
@@ -1785,7 +2157,7 @@ func (s DeleteMLModelInput) GoString() string {
 type DeleteMLModelOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelID in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	metadataDeleteMLModelOutput `json:"-" xml:"-"`
 }
@@ -1806,7 +2178,7 @@ func (s DeleteMLModelOutput) GoString() string {
 
 type DeleteRealtimeEndpointInput struct {
 	// The ID assigned to the MLModel during creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataDeleteRealtimeEndpointInput `json:"-" xml:"-"`
 }
@@ -1831,7 +2203,7 @@ func (s DeleteRealtimeEndpointInput) GoString() string {
 type DeleteRealtimeEndpointOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// The endpoint information of the MLModel
 	RealtimeEndpointInfo *RealtimeEndpointInfo `type:"structure"`
@@ -1856,7 +2228,7 @@ func (s DeleteRealtimeEndpointOutput) GoString() string {
 type DescribeBatchPredictionsInput struct {
 	// The equal to operator. The BatchPrediction results will have FilterVariable
 	// values that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024"`
 
 	// Use one of the following variables to filter a list of BatchPrediction:
 	//
@@ -1874,28 +2246,28 @@ type DescribeBatchPredictionsInput struct {
 	// The greater than or equal to operator. The BatchPrediction results will have
 	// FilterVariable values that are greater than or equal to the value specified
 	// with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024"`
 
 	// The greater than operator. The BatchPrediction results will have FilterVariable
 	// values that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024"`
 
 	// The less than or equal to operator. The BatchPrediction results will have
 	// FilterVariable values that are less than or equal to the value specified
 	// with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024"`
 
 	// The less than operator. The BatchPrediction results will have FilterVariable
 	// values that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024"`
 
 	// The number of pages of information to include in the result. The range of
 	// acceptable values is 1 through 100. The default value is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The BatchPrediction results will have FilterVariable
 	// values not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024"`
 
 	// An ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -1911,7 +2283,7 @@ type DescribeBatchPredictionsInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of MLModels.
@@ -1967,7 +2339,7 @@ func (s DescribeBatchPredictionsOutput) GoString() string {
 type DescribeDataSourcesInput struct {
 	// The equal to operator. The DataSource results will have FilterVariable values
 	// that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024"`
 
 	// Use one of the following variables to filter a list of DataSource:
 	//
@@ -1982,26 +2354,26 @@ type DescribeDataSourcesInput struct {
 
 	// The greater than or equal to operator. The DataSource results will have FilterVariable
 	// values that are greater than or equal to the value specified with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024"`
 
 	// The greater than operator. The DataSource results will have FilterVariable
 	// values that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024"`
 
 	// The less than or equal to operator. The DataSource results will have FilterVariable
 	// values that are less than or equal to the value specified with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024"`
 
 	// The less than operator. The DataSource results will have FilterVariable values
 	// that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024"`
 
 	// The maximum number of DataSource to include in the result.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The DataSource results will have FilterVariable
 	// values not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024"`
 
 	// The ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -2017,7 +2389,7 @@ type DescribeDataSourcesInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of DataSource.
@@ -2073,7 +2445,7 @@ func (s DescribeDataSourcesOutput) GoString() string {
 type DescribeEvaluationsInput struct {
 	// The equal to operator. The Evaluation results will have FilterVariable values
 	// that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024"`
 
 	// Use one of the following variable to filter a list of Evaluation objects:
 	//
@@ -2090,26 +2462,26 @@ type DescribeEvaluationsInput struct {
 
 	// The greater than or equal to operator. The Evaluation results will have FilterVariable
 	// values that are greater than or equal to the value specified with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024"`
 
 	// The greater than operator. The Evaluation results will have FilterVariable
 	// values that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024"`
 
 	// The less than or equal to operator. The Evaluation results will have FilterVariable
 	// values that are less than or equal to the value specified with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024"`
 
 	// The less than operator. The Evaluation results will have FilterVariable values
 	// that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024"`
 
 	// The maximum number of Evaluation to include in the result.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The Evaluation results will have FilterVariable
 	// values not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024"`
 
 	// The ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -2125,7 +2497,7 @@ type DescribeEvaluationsInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of Evaluation.
@@ -2181,7 +2553,7 @@ func (s DescribeEvaluationsOutput) GoString() string {
 type DescribeMLModelsInput struct {
 	// The equal to operator. The MLModel results will have FilterVariable values
 	// that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024"`
 
 	// Use one of the following variables to filter a list of MLModel:
 	//
@@ -2200,27 +2572,27 @@ type DescribeMLModelsInput struct {
 
 	// The greater than or equal to operator. The MLModel results will have FilterVariable
 	// values that are greater than or equal to the value specified with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024"`
 
 	// The greater than operator. The MLModel results will have FilterVariable values
 	// that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024"`
 
 	// The less than or equal to operator. The MLModel results will have FilterVariable
 	// values that are less than or equal to the value specified with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024"`
 
 	// The less than operator. The MLModel results will have FilterVariable values
 	// that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024"`
 
 	// The number of pages of information to include in the result. The range of
 	// acceptable values is 1 through 100. The default value is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The MLModel results will have FilterVariable values
 	// not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024"`
 
 	// The ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -2236,7 +2608,7 @@ type DescribeMLModelsInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of MLModel.
@@ -2304,27 +2676,27 @@ type Evaluation struct {
 	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
 
 	// The ID of the DataSource that is used to evaluate the MLModel.
-	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string"`
+	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" min:"1" max:"64"`
 
 	// The ID that is assigned to the Evaluation at creation.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64"`
 
 	// The location and name of the data in Amazon Simple Storage Server (Amazon
 	// S3) that is used in the evaluation.
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048"`
 
 	// The time of the most recent edit to the Evaluation. The time is expressed
 	// in epoch time.
 	LastUpdatedAt *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID of the MLModel that is the focus of the evaluation.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// A description of the most recent details about evaluating the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the Evaluation.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// Measurements of how well the MLModel performed, using observations referenced
 	// by the DataSource. One of the following metrics is returned, based on the
@@ -2373,7 +2745,7 @@ func (s Evaluation) GoString() string {
 
 type GetBatchPredictionInput struct {
 	// An ID assigned to the BatchPrediction at creation.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataGetBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -2395,11 +2767,11 @@ func (s GetBatchPredictionInput) GoString() string {
 // Represents the output of a GetBatchPrediction operation and describes a BatchPrediction.
 type GetBatchPredictionOutput struct {
 	// The ID of the DataSource that was used to create the BatchPrediction.
-	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string"`
+	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" min:"1" max:"64"`
 
 	// An ID assigned to the BatchPrediction at creation. This value should be identical
 	// to the value of the BatchPredictionID in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64"`
 
 	// The time when the BatchPrediction was created. The time is expressed in epoch
 	// time.
@@ -2412,7 +2784,7 @@ type GetBatchPredictionOutput struct {
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048"`
 
 	// The time of the most recent edit to BatchPrediction. The time is expressed
 	// in epoch time.
@@ -2423,18 +2795,18 @@ type GetBatchPredictionOutput struct {
 
 	// The ID of the MLModel that generated predictions for the BatchPrediction
 	// request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// A description of the most recent details about processing the batch prediction
 	// request.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the BatchPrediction.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// The location of an Amazon S3 bucket or directory to receive the operation
 	// results.
-	OutputURI *string `locationName:"OutputUri" type:"string"`
+	OutputURI *string `locationName:"OutputUri" type:"string" max:"2048"`
 
 	// The status of the BatchPrediction, which can be one of the following values:
 	//
@@ -2464,7 +2836,7 @@ func (s GetBatchPredictionOutput) GoString() string {
 
 type GetDataSourceInput struct {
 	// The ID assigned to the DataSource at creation.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	// Specifies whether the GetDataSource operation should return DataSourceSchema.
 	//
@@ -2507,7 +2879,7 @@ type GetDataSourceOutput struct {
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	DataLocationS3 *string `type:"string"`
+	DataLocationS3 *string `type:"string" max:"2048"`
 
 	// A JSON string that captures the splitting rearrangement requirement of the
 	// DataSource.
@@ -2518,12 +2890,12 @@ type GetDataSourceOutput struct {
 
 	// The ID assigned to the DataSource at creation. This value should be identical
 	// to the value of the DataSourceId in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64"`
 
 	// The schema used by all of the data files of this DataSource.
 	//
 	// Note This parameter is provided as part of the verbose format.
-	DataSourceSchema *string `type:"string"`
+	DataSourceSchema *string `type:"string" max:"131071"`
 
 	// The time of the most recent edit to the DataSource. The time is expressed
 	// in epoch time.
@@ -2533,10 +2905,10 @@ type GetDataSourceOutput struct {
 	LogURI *string `locationName:"LogUri" type:"string"`
 
 	// The description of the most recent details about creating the DataSource.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the DataSource.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// The number of data files referenced by the DataSource.
 	NumberOfFiles *int64 `type:"long"`
@@ -2549,7 +2921,7 @@ type GetDataSourceOutput struct {
 
 	// The Amazon Resource Name (ARN) of an AWS IAM Role (http://docs.aws.amazon.com/IAM/latest/UserGuide/roles-toplevel.html#roles-about-termsandconcepts)
 	// such as the following: arn:aws:iam::account:role/rolename.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"100"`
 
 	// The current status of the DataSource. This element can have one of the following
 	// values:
@@ -2581,7 +2953,7 @@ func (s GetDataSourceOutput) GoString() string {
 type GetEvaluationInput struct {
 	// The ID of the Evaluation to retrieve. The evaluation of each MLModel is recorded
 	// and cataloged. The ID provides the means to access the information.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataGetEvaluationInput `json:"-" xml:"-"`
 }
@@ -2612,14 +2984,14 @@ type GetEvaluationOutput struct {
 	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
 
 	// The DataSource used for this evaluation.
-	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string"`
+	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" min:"1" max:"64"`
 
 	// The evaluation ID which is same as the EvaluationId in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64"`
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048"`
 
 	// The time of the most recent edit to the BatchPrediction. The time is expressed
 	// in epoch time.
@@ -2629,13 +3001,13 @@ type GetEvaluationOutput struct {
 	LogURI *string `locationName:"LogUri" type:"string"`
 
 	// The ID of the MLModel that was the focus of the evaluation.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// A description of the most recent details about evaluating the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the Evaluation.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// Measurements of how well the MLModel performed using observations referenced
 	// by the DataSource. One of the following metric is returned based on the type
@@ -2684,7 +3056,7 @@ func (s GetEvaluationOutput) GoString() string {
 
 type GetMLModelInput struct {
 	// The ID assigned to the MLModel at creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	// Specifies whether the GetMLModel operation should return Recipe.
 	//
@@ -2726,7 +3098,7 @@ type GetMLModelOutput struct {
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048"`
 
 	// The time of the most recent edit to the MLModel. The time is expressed in
 	// epoch time.
@@ -2736,7 +3108,7 @@ type GetMLModelOutput struct {
 	LogURI *string `locationName:"LogUri" type:"string"`
 
 	// The MLModel ID which is same as the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// Identifies the MLModel category. The following are the available types:
 	//
@@ -2747,22 +3119,22 @@ type GetMLModelOutput struct {
 	MLModelType *string `type:"string"`
 
 	// Description of the most recent details about accessing the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the MLModel.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// The recipe to use when training the MLModel. The Recipe provides detailed
 	// information about the observation data to use during training, as well as
 	// manipulations to perform on the observation data during training.
 	//
 	// Note This parameter is provided as part of the verbose format.
-	Recipe *string `type:"string"`
+	Recipe *string `type:"string" max:"131071"`
 
 	// The schema used by all of the data files referenced by the DataSource.
 	//
 	// Note This parameter is provided as part of the verbose format.
-	Schema *string `type:"string"`
+	Schema *string `type:"string" max:"131071"`
 
 	// The scoring threshold is used in binary classification MLModels, and marks
 	// the boundary between a positive prediction and a negative prediction.
@@ -2789,7 +3161,7 @@ type GetMLModelOutput struct {
 	Status *string `type:"string"`
 
 	// The ID of the training DataSource.
-	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string"`
+	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" min:"1" max:"64"`
 
 	// A list of the training parameters in the MLModel. The list is implemented
 	// as a map of key/value pairs.
@@ -2866,14 +3238,14 @@ type MLModel struct {
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048"`
 
 	// The time of the most recent edit to the MLModel. The time is expressed in
 	// epoch time.
 	LastUpdatedAt *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID assigned to the MLModel at creation.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	// Identifies the MLModel category. The following are the available types:
 	//
@@ -2885,10 +3257,10 @@ type MLModel struct {
 	MLModelType *string `type:"string"`
 
 	// A description of the most recent details about accessing the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the MLModel.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	ScoreThreshold *float64 `type:"float"`
 
@@ -2910,7 +3282,7 @@ type MLModel struct {
 	Status *string `type:"string"`
 
 	// The ID of the training DataSource. The CreateMLModel operation uses the TrainingDataSourceId.
-	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string"`
+	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" min:"1" max:"64"`
 
 	// A list of the training parameters in the MLModel. The list is implemented
 	// as a map of key/value pairs.
@@ -3000,9 +3372,9 @@ func (s PerformanceMetrics) GoString() string {
 
 type PredictInput struct {
 	// A unique identifier of the MLModel.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
-	PredictEndpoint *string `type:"string" required:"true"`
+	PredictEndpoint *string `type:"string" max:"2048" required:"true"`
 
 	// A map of variable name-value pairs that represent an observation.
 	Record map[string]*string `type:"map" required:"true"`
@@ -3071,7 +3443,7 @@ type Prediction struct {
 	Details map[string]*string `locationName:"details" type:"map"`
 
 	// The prediction label for either a BINARY or MULTICLASS MLModel.
-	PredictedLabel *string `locationName:"predictedLabel" type:"string"`
+	PredictedLabel *string `locationName:"predictedLabel" type:"string" min:"1"`
 
 	// Provides the raw classification score corresponding to each label.
 	PredictedScores map[string]*float64 `locationName:"predictedScores" type:"map"`
@@ -3107,10 +3479,10 @@ type RDSDataSpec struct {
 
 	// A JSON string that represents the schema. This is not required if DataSchemaUri
 	// is specified.
-	DataSchema *string `type:"string"`
+	DataSchema *string `type:"string" max:"131071"`
 
 	// The Amazon S3 location of the DataSchema.
-	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string"`
+	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string" max:"2048"`
 
 	// The AWS Identity and Access Management (IAM) credentials that are used connect
 	// to the Amazon RDS database.
@@ -3123,11 +3495,11 @@ type RDSDataSpec struct {
 	// Cloud (Amazon EC2) instance to carry out the copy operation from Amazon RDS
 	// to an Amazon S3 task. For more information, see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ResourceRole *string `type:"string" required:"true"`
+	ResourceRole *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The Amazon S3 location for staging Amazon RDS data. The data retrieved from
 	// Amazon RDS using SelectSqlQuery is stored in this location.
-	S3StagingLocation *string `type:"string" required:"true"`
+	S3StagingLocation *string `type:"string" max:"2048" required:"true"`
 
 	// The security group IDs to be used to access a VPC-based RDS DB instance.
 	// Ensure that there are appropriate ingress rules set up to allow access to
@@ -3136,18 +3508,18 @@ type RDSDataSpec struct {
 	SecurityGroupIDs []*string `locationName:"SecurityGroupIds" type:"list" required:"true"`
 
 	// The query that is used to retrieve the observation data for the DataSource.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" required:"true"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216" required:"true"`
 
 	// The role (DataPipelineDefaultRole) assumed by AWS Data Pipeline service to
 	// monitor the progress of the copy task from Amazon RDS to Amazon S3. For more
 	// information, see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ServiceRole *string `type:"string" required:"true"`
+	ServiceRole *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The subnet ID to be used to access a VPC-based RDS DB instance. This attribute
 	// is used by Data Pipeline to carry out the copy task from Amazon RDS to Amazon
 	// S3.
-	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
+	SubnetID *string `locationName:"SubnetId" type:"string" min:"1" max:"255" required:"true"`
 
 	metadataRDSDataSpec `json:"-" xml:"-"`
 }
@@ -3169,10 +3541,10 @@ func (s RDSDataSpec) GoString() string {
 // The database details of an Amazon RDS database.
 type RDSDatabase struct {
 	// The name of a database hosted on an RDS DB instance.
-	DatabaseName *string `type:"string" required:"true"`
+	DatabaseName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The ID of an RDS DB instance.
-	InstanceIdentifier *string `type:"string" required:"true"`
+	InstanceIdentifier *string `type:"string" min:"1" max:"63" required:"true"`
 
 	metadataRDSDatabase `json:"-" xml:"-"`
 }
@@ -3196,12 +3568,12 @@ type RDSDatabaseCredentials struct {
 	// The password to be used by Amazon ML to connect to a database on an RDS DB
 	// instance. The password should have sufficient permissions to execute the
 	// RDSSelectQuery query.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"8" max:"128" required:"true"`
 
 	// The username to be used by Amazon ML to connect to database on an Amazon
 	// RDS instance. The username should have sufficient permissions to execute
 	// an RDSSelectSqlQuery query.
-	Username *string `type:"string" required:"true"`
+	Username *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataRDSDatabaseCredentials `json:"-" xml:"-"`
 }
@@ -3225,7 +3597,7 @@ type RDSMetadata struct {
 	// The ID of the Data Pipeline instance that is used to carry to copy data from
 	// Amazon RDS to Amazon S3. You can use the ID to find details about the instance
 	// in the Data Pipeline console.
-	DataPipelineID *string `locationName:"DataPipelineId" type:"string"`
+	DataPipelineID *string `locationName:"DataPipelineId" type:"string" min:"1" max:"1024"`
 
 	// The database details required to connect to an Amazon RDS.
 	Database *RDSDatabase `type:"structure"`
@@ -3233,23 +3605,23 @@ type RDSMetadata struct {
 	// The username to be used by Amazon ML to connect to database on an Amazon
 	// RDS instance. The username should have sufficient permissions to execute
 	// an RDSSelectSqlQuery query.
-	DatabaseUserName *string `type:"string"`
+	DatabaseUserName *string `type:"string" min:"1" max:"128"`
 
 	// The role (DataPipelineDefaultResourceRole) assumed by an Amazon EC2 instance
 	// to carry out the copy task from Amazon RDS to Amazon S3. For more information,
 	// see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ResourceRole *string `type:"string"`
+	ResourceRole *string `type:"string" min:"1" max:"64"`
 
 	// The SQL query that is supplied during CreateDataSourceFromRDS. Returns only
 	// if Verbose is true in GetDataSourceInput.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216"`
 
 	// The role (DataPipelineDefaultRole) assumed by the Data Pipeline service to
 	// monitor the progress of the copy task from Amazon RDS to Amazon S3. For more
 	// information, see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"1" max:"64"`
 
 	metadataRDSMetadata `json:"-" xml:"-"`
 }
@@ -3287,7 +3659,7 @@ type RealtimeEndpointInfo struct {
 	//
 	// Note The application must wait until the real-time endpoint is ready before
 	// using this URI.
-	EndpointURL *string `locationName:"EndpointUrl" type:"string"`
+	EndpointURL *string `locationName:"EndpointUrl" type:"string" max:"2048"`
 
 	// The maximum processing rate for the real-time endpoint for MLModel, measured
 	// in incoming requests per second.
@@ -3316,10 +3688,10 @@ type RedshiftDataSpec struct {
 	DataRearrangement *string `type:"string"`
 
 	// Describes the schema for an Amazon Redshift DataSource.
-	DataSchema *string `type:"string"`
+	DataSchema *string `type:"string" max:"131071"`
 
 	// Describes the schema location for an Amazon Redshift DataSource.
-	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string"`
+	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string" max:"2048"`
 
 	// Describes AWS Identity and Access Management (IAM) credentials that are used
 	// connect to the Amazon Redshift database.
@@ -3330,11 +3702,11 @@ type RedshiftDataSpec struct {
 
 	// Describes an Amazon S3 location to store the result set of the SelectSqlQuery
 	// query.
-	S3StagingLocation *string `type:"string" required:"true"`
+	S3StagingLocation *string `type:"string" max:"2048" required:"true"`
 
 	// Describes the SQL Query to execute on an Amazon Redshift database for an
 	// Amazon Redshift DataSource.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" required:"true"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216" required:"true"`
 
 	metadataRedshiftDataSpec `json:"-" xml:"-"`
 }
@@ -3357,10 +3729,10 @@ func (s RedshiftDataSpec) GoString() string {
 // database.
 type RedshiftDatabase struct {
 	// The ID of an Amazon Redshift cluster.
-	ClusterIdentifier *string `type:"string" required:"true"`
+	ClusterIdentifier *string `type:"string" min:"1" max:"63" required:"true"`
 
 	// The name of a database hosted on an Amazon Redshift cluster.
-	DatabaseName *string `type:"string" required:"true"`
+	DatabaseName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRedshiftDatabase `json:"-" xml:"-"`
 }
@@ -3386,13 +3758,13 @@ type RedshiftDatabaseCredentials struct {
 	// Redshift cluster. The password should have sufficient permissions to execute
 	// a RedshiftSelectSqlQuery query. The password should be valid for an Amazon
 	// Redshift USER (http://docs.aws.amazon.com/redshift/latest/dg/r_CREATE_USER.html).
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"8" max:"64" required:"true"`
 
 	// A username to be used by Amazon Machine Learning (Amazon ML)to connect to
 	// a database on an Amazon Redshift cluster. The username should have sufficient
 	// permissions to execute the RedshiftSelectSqlQuery query. The username should
 	// be valid for an Amazon Redshift USER (http://docs.aws.amazon.com/redshift/latest/dg/r_CREATE_USER.html).
-	Username *string `type:"string" required:"true"`
+	Username *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataRedshiftDatabaseCredentials `json:"-" xml:"-"`
 }
@@ -3417,7 +3789,7 @@ type RedshiftMetadata struct {
 	// a database on an Amazon Redshift cluster. The username should have sufficient
 	// permissions to execute the RedshiftSelectSqlQuery query. The username should
 	// be valid for an Amazon Redshift USER (http://docs.aws.amazon.com/redshift/latest/dg/r_CREATE_USER.html).
-	DatabaseUserName *string `type:"string"`
+	DatabaseUserName *string `type:"string" min:"1" max:"128"`
 
 	// Describes the database details required to connect to an Amazon Redshift
 	// database.
@@ -3425,7 +3797,7 @@ type RedshiftMetadata struct {
 
 	// The SQL query that is specified during CreateDataSourceFromRedshift. Returns
 	// only if Verbose is true in GetDataSourceInput.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216"`
 
 	metadataRedshiftMetadata `json:"-" xml:"-"`
 }
@@ -3449,16 +3821,16 @@ type S3DataSpec struct {
 	// The location of the data file(s) used by a DataSource. The URI specifies
 	// a data file or an Amazon Simple Storage Service (Amazon S3) directory or
 	// bucket containing data files.
-	DataLocationS3 *string `type:"string" required:"true"`
+	DataLocationS3 *string `type:"string" max:"2048" required:"true"`
 
 	// Describes the splitting requirement of a Datasource.
 	DataRearrangement *string `type:"string"`
 
 	// Describes the schema for an Amazon S3 DataSource.
-	DataSchema *string `type:"string"`
+	DataSchema *string `type:"string" max:"131071"`
 
 	// Describes the schema Location in Amazon S3.
-	DataSchemaLocationS3 *string `type:"string"`
+	DataSchemaLocationS3 *string `type:"string" max:"2048"`
 
 	metadataS3DataSpec `json:"-" xml:"-"`
 }
@@ -3479,10 +3851,10 @@ func (s S3DataSpec) GoString() string {
 
 type UpdateBatchPredictionInput struct {
 	// The ID assigned to the BatchPrediction during creation.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A new user-supplied name or description of the BatchPrediction.
-	BatchPredictionName *string `type:"string" required:"true"`
+	BatchPredictionName *string `type:"string" max:"1024" required:"true"`
 
 	metadataUpdateBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -3507,7 +3879,7 @@ func (s UpdateBatchPredictionInput) GoString() string {
 type UpdateBatchPredictionOutput struct {
 	// The ID assigned to the BatchPrediction during creation. This value should
 	// be identical to the value of the BatchPredictionId in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64"`
 
 	metadataUpdateBatchPredictionOutput `json:"-" xml:"-"`
 }
@@ -3528,11 +3900,11 @@ func (s UpdateBatchPredictionOutput) GoString() string {
 
 type UpdateDataSourceInput struct {
 	// The ID assigned to the DataSource during creation.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A new user-supplied name or description of the DataSource that will replace
 	// the current description.
-	DataSourceName *string `type:"string" required:"true"`
+	DataSourceName *string `type:"string" max:"1024" required:"true"`
 
 	metadataUpdateDataSourceInput `json:"-" xml:"-"`
 }
@@ -3557,7 +3929,7 @@ func (s UpdateDataSourceInput) GoString() string {
 type UpdateDataSourceOutput struct {
 	// The ID assigned to the DataSource during creation. This value should be identical
 	// to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64"`
 
 	metadataUpdateDataSourceOutput `json:"-" xml:"-"`
 }
@@ -3578,11 +3950,11 @@ func (s UpdateDataSourceOutput) GoString() string {
 
 type UpdateEvaluationInput struct {
 	// The ID assigned to the Evaluation during creation.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A new user-supplied name or description of the Evaluation that will replace
 	// the current content.
-	EvaluationName *string `type:"string" required:"true"`
+	EvaluationName *string `type:"string" max:"1024" required:"true"`
 
 	metadataUpdateEvaluationInput `json:"-" xml:"-"`
 }
@@ -3607,7 +3979,7 @@ func (s UpdateEvaluationInput) GoString() string {
 type UpdateEvaluationOutput struct {
 	// The ID assigned to the Evaluation during creation. This value should be identical
 	// to the value of the Evaluation in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64"`
 
 	metadataUpdateEvaluationOutput `json:"-" xml:"-"`
 }
@@ -3628,10 +4000,10 @@ func (s UpdateEvaluationOutput) GoString() string {
 
 type UpdateMLModelInput struct {
 	// The ID assigned to the MLModel during creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A user-supplied name or description of the MLModel.
-	MLModelName *string `type:"string"`
+	MLModelName *string `type:"string" max:"1024"`
 
 	// The ScoreThreshold used in binary classification MLModel that marks the boundary
 	// between a positive prediction and a negative prediction.
@@ -3664,7 +4036,7 @@ func (s UpdateMLModelInput) GoString() string {
 type UpdateMLModelOutput struct {
 	// The ID assigned to the MLModel during creation. This value should be identical
 	// to the value of the MLModelID in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64"`
 
 	metadataUpdateMLModelOutput `json:"-" xml:"-"`
 }
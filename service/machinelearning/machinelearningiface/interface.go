@@ -4,6 +4,7 @@
 package machinelearningiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
 )
 
@@ -11,51 +12,117 @@ import (
 type MachineLearningAPI interface {
 	CreateBatchPrediction(*machinelearning.CreateBatchPredictionInput) (*machinelearning.CreateBatchPredictionOutput, error)
 
+	CreateBatchPredictionWithContext(aws.Context, *machinelearning.CreateBatchPredictionInput, ...aws.Option) (*machinelearning.CreateBatchPredictionOutput, error)
+
 	CreateDataSourceFromRDS(*machinelearning.CreateDataSourceFromRDSInput) (*machinelearning.CreateDataSourceFromRDSOutput, error)
 
+	CreateDataSourceFromRDSWithContext(aws.Context, *machinelearning.CreateDataSourceFromRDSInput, ...aws.Option) (*machinelearning.CreateDataSourceFromRDSOutput, error)
+
 	CreateDataSourceFromRedshift(*machinelearning.CreateDataSourceFromRedshiftInput) (*machinelearning.CreateDataSourceFromRedshiftOutput, error)
 
+	CreateDataSourceFromRedshiftWithContext(aws.Context, *machinelearning.CreateDataSourceFromRedshiftInput, ...aws.Option) (*machinelearning.CreateDataSourceFromRedshiftOutput, error)
+
 	CreateDataSourceFromS3(*machinelearning.CreateDataSourceFromS3Input) (*machinelearning.CreateDataSourceFromS3Output, error)
 
+	CreateDataSourceFromS3WithContext(aws.Context, *machinelearning.CreateDataSourceFromS3Input, ...aws.Option) (*machinelearning.CreateDataSourceFromS3Output, error)
+
 	CreateEvaluation(*machinelearning.CreateEvaluationInput) (*machinelearning.CreateEvaluationOutput, error)
 
+	CreateEvaluationWithContext(aws.Context, *machinelearning.CreateEvaluationInput, ...aws.Option) (*machinelearning.CreateEvaluationOutput, error)
+
 	CreateMLModel(*machinelearning.CreateMLModelInput) (*machinelearning.CreateMLModelOutput, error)
 
+	CreateMLModelWithContext(aws.Context, *machinelearning.CreateMLModelInput, ...aws.Option) (*machinelearning.CreateMLModelOutput, error)
+
 	CreateRealtimeEndpoint(*machinelearning.CreateRealtimeEndpointInput) (*machinelearning.CreateRealtimeEndpointOutput, error)
 
+	CreateRealtimeEndpointWithContext(aws.Context, *machinelearning.CreateRealtimeEndpointInput, ...aws.Option) (*machinelearning.CreateRealtimeEndpointOutput, error)
+
 	DeleteBatchPrediction(*machinelearning.DeleteBatchPredictionInput) (*machinelearning.DeleteBatchPredictionOutput, error)
 
+	DeleteBatchPredictionWithContext(aws.Context, *machinelearning.DeleteBatchPredictionInput, ...aws.Option) (*machinelearning.DeleteBatchPredictionOutput, error)
+
 	DeleteDataSource(*machinelearning.DeleteDataSourceInput) (*machinelearning.DeleteDataSourceOutput, error)
 
+	DeleteDataSourceWithContext(aws.Context, *machinelearning.DeleteDataSourceInput, ...aws.Option) (*machinelearning.DeleteDataSourceOutput, error)
+
 	DeleteEvaluation(*machinelearning.DeleteEvaluationInput) (*machinelearning.DeleteEvaluationOutput, error)
 
+	DeleteEvaluationWithContext(aws.Context, *machinelearning.DeleteEvaluationInput, ...aws.Option) (*machinelearning.DeleteEvaluationOutput, error)
+
 	DeleteMLModel(*machinelearning.DeleteMLModelInput) (*machinelearning.DeleteMLModelOutput, error)
 
+	DeleteMLModelWithContext(aws.Context, *machinelearning.DeleteMLModelInput, ...aws.Option) (*machinelearning.DeleteMLModelOutput, error)
+
 	DeleteRealtimeEndpoint(*machinelearning.DeleteRealtimeEndpointInput) (*machinelearning.DeleteRealtimeEndpointOutput, error)
 
+	DeleteRealtimeEndpointWithContext(aws.Context, *machinelearning.DeleteRealtimeEndpointInput, ...aws.Option) (*machinelearning.DeleteRealtimeEndpointOutput, error)
+
 	DescribeBatchPredictions(*machinelearning.DescribeBatchPredictionsInput) (*machinelearning.DescribeBatchPredictionsOutput, error)
 
+	DescribeBatchPredictionsWithContext(aws.Context, *machinelearning.DescribeBatchPredictionsInput, ...aws.Option) (*machinelearning.DescribeBatchPredictionsOutput, error)
+
+	DescribeBatchPredictionsPages(*machinelearning.DescribeBatchPredictionsInput, func(*machinelearning.DescribeBatchPredictionsOutput, bool) bool) error
+
+	DescribeBatchPredictionsPagesWithContext(aws.Context, *machinelearning.DescribeBatchPredictionsInput, func(*machinelearning.DescribeBatchPredictionsOutput, bool) bool, ...aws.Option) error
+
 	DescribeDataSources(*machinelearning.DescribeDataSourcesInput) (*machinelearning.DescribeDataSourcesOutput, error)
 
+	DescribeDataSourcesWithContext(aws.Context, *machinelearning.DescribeDataSourcesInput, ...aws.Option) (*machinelearning.DescribeDataSourcesOutput, error)
+
+	DescribeDataSourcesPages(*machinelearning.DescribeDataSourcesInput, func(*machinelearning.DescribeDataSourcesOutput, bool) bool) error
+
+	DescribeDataSourcesPagesWithContext(aws.Context, *machinelearning.DescribeDataSourcesInput, func(*machinelearning.DescribeDataSourcesOutput, bool) bool, ...aws.Option) error
+
 	DescribeEvaluations(*machinelearning.DescribeEvaluationsInput) (*machinelearning.DescribeEvaluationsOutput, error)
 
+	DescribeEvaluationsWithContext(aws.Context, *machinelearning.DescribeEvaluationsInput, ...aws.Option) (*machinelearning.DescribeEvaluationsOutput, error)
+
+	DescribeEvaluationsPages(*machinelearning.DescribeEvaluationsInput, func(*machinelearning.DescribeEvaluationsOutput, bool) bool) error
+
+	DescribeEvaluationsPagesWithContext(aws.Context, *machinelearning.DescribeEvaluationsInput, func(*machinelearning.DescribeEvaluationsOutput, bool) bool, ...aws.Option) error
+
 	DescribeMLModels(*machinelearning.DescribeMLModelsInput) (*machinelearning.DescribeMLModelsOutput, error)
 
+	DescribeMLModelsWithContext(aws.Context, *machinelearning.DescribeMLModelsInput, ...aws.Option) (*machinelearning.DescribeMLModelsOutput, error)
+
+	DescribeMLModelsPages(*machinelearning.DescribeMLModelsInput, func(*machinelearning.DescribeMLModelsOutput, bool) bool) error
+
+	DescribeMLModelsPagesWithContext(aws.Context, *machinelearning.DescribeMLModelsInput, func(*machinelearning.DescribeMLModelsOutput, bool) bool, ...aws.Option) error
+
 	GetBatchPrediction(*machinelearning.GetBatchPredictionInput) (*machinelearning.GetBatchPredictionOutput, error)
 
+	GetBatchPredictionWithContext(aws.Context, *machinelearning.GetBatchPredictionInput, ...aws.Option) (*machinelearning.GetBatchPredictionOutput, error)
+
 	GetDataSource(*machinelearning.GetDataSourceInput) (*machinelearning.GetDataSourceOutput, error)
 
+	GetDataSourceWithContext(aws.Context, *machinelearning.GetDataSourceInput, ...aws.Option) (*machinelearning.GetDataSourceOutput, error)
+
 	GetEvaluation(*machinelearning.GetEvaluationInput) (*machinelearning.GetEvaluationOutput, error)
 
+	GetEvaluationWithContext(aws.Context, *machinelearning.GetEvaluationInput, ...aws.Option) (*machinelearning.GetEvaluationOutput, error)
+
 	GetMLModel(*machinelearning.GetMLModelInput) (*machinelearning.GetMLModelOutput, error)
 
+	GetMLModelWithContext(aws.Context, *machinelearning.GetMLModelInput, ...aws.Option) (*machinelearning.GetMLModelOutput, error)
+
 	Predict(*machinelearning.PredictInput) (*machinelearning.PredictOutput, error)
 
+	PredictWithContext(aws.Context, *machinelearning.PredictInput, ...aws.Option) (*machinelearning.PredictOutput, error)
+
 	UpdateBatchPrediction(*machinelearning.UpdateBatchPredictionInput) (*machinelearning.UpdateBatchPredictionOutput, error)
 
+	UpdateBatchPredictionWithContext(aws.Context, *machinelearning.UpdateBatchPredictionInput, ...aws.Option) (*machinelearning.UpdateBatchPredictionOutput, error)
+
 	UpdateDataSource(*machinelearning.UpdateDataSourceInput) (*machinelearning.UpdateDataSourceOutput, error)
 
+	UpdateDataSourceWithContext(aws.Context, *machinelearning.UpdateDataSourceInput, ...aws.Option) (*machinelearning.UpdateDataSourceOutput, error)
+
 	UpdateEvaluation(*machinelearning.UpdateEvaluationInput) (*machinelearning.UpdateEvaluationOutput, error)
 
+	UpdateEvaluationWithContext(aws.Context, *machinelearning.UpdateEvaluationInput, ...aws.Option) (*machinelearning.UpdateEvaluationOutput, error)
+
 	UpdateMLModel(*machinelearning.UpdateMLModelInput) (*machinelearning.UpdateMLModelOutput, error)
+
+	UpdateMLModelWithContext(aws.Context, *machinelearning.UpdateMLModelInput, ...aws.Option) (*machinelearning.UpdateMLModelOutput, error)
 }
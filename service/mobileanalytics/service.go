@@ -24,6 +24,7 @@ func New(config *aws.Config) *MobileAnalytics {
 	service := &aws.Service{
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "mobileanalytics",
+		ServiceID:   "Mobile Analytics",
 		APIVersion:  "2014-06-05",
 	}
 	service.Initialize()
@@ -4,6 +4,7 @@
 package opsworksiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 )
 
@@ -11,135 +12,281 @@ import (
 type OpsWorksAPI interface {
 	AssignInstance(*opsworks.AssignInstanceInput) (*opsworks.AssignInstanceOutput, error)
 
+	AssignInstanceWithContext(aws.Context, *opsworks.AssignInstanceInput, ...aws.Option) (*opsworks.AssignInstanceOutput, error)
+
 	AssignVolume(*opsworks.AssignVolumeInput) (*opsworks.AssignVolumeOutput, error)
 
+	AssignVolumeWithContext(aws.Context, *opsworks.AssignVolumeInput, ...aws.Option) (*opsworks.AssignVolumeOutput, error)
+
 	AssociateElasticIP(*opsworks.AssociateElasticIPInput) (*opsworks.AssociateElasticIPOutput, error)
 
+	AssociateElasticIPWithContext(aws.Context, *opsworks.AssociateElasticIPInput, ...aws.Option) (*opsworks.AssociateElasticIPOutput, error)
+
 	AttachElasticLoadBalancer(*opsworks.AttachElasticLoadBalancerInput) (*opsworks.AttachElasticLoadBalancerOutput, error)
 
+	AttachElasticLoadBalancerWithContext(aws.Context, *opsworks.AttachElasticLoadBalancerInput, ...aws.Option) (*opsworks.AttachElasticLoadBalancerOutput, error)
+
 	CloneStack(*opsworks.CloneStackInput) (*opsworks.CloneStackOutput, error)
 
+	CloneStackWithContext(aws.Context, *opsworks.CloneStackInput, ...aws.Option) (*opsworks.CloneStackOutput, error)
+
 	CreateApp(*opsworks.CreateAppInput) (*opsworks.CreateAppOutput, error)
 
+	CreateAppWithContext(aws.Context, *opsworks.CreateAppInput, ...aws.Option) (*opsworks.CreateAppOutput, error)
+
 	CreateDeployment(*opsworks.CreateDeploymentInput) (*opsworks.CreateDeploymentOutput, error)
 
+	CreateDeploymentWithContext(aws.Context, *opsworks.CreateDeploymentInput, ...aws.Option) (*opsworks.CreateDeploymentOutput, error)
+
 	CreateInstance(*opsworks.CreateInstanceInput) (*opsworks.CreateInstanceOutput, error)
 
+	CreateInstanceWithContext(aws.Context, *opsworks.CreateInstanceInput, ...aws.Option) (*opsworks.CreateInstanceOutput, error)
+
 	CreateLayer(*opsworks.CreateLayerInput) (*opsworks.CreateLayerOutput, error)
 
+	CreateLayerWithContext(aws.Context, *opsworks.CreateLayerInput, ...aws.Option) (*opsworks.CreateLayerOutput, error)
+
 	CreateStack(*opsworks.CreateStackInput) (*opsworks.CreateStackOutput, error)
 
+	CreateStackWithContext(aws.Context, *opsworks.CreateStackInput, ...aws.Option) (*opsworks.CreateStackOutput, error)
+
 	CreateUserProfile(*opsworks.CreateUserProfileInput) (*opsworks.CreateUserProfileOutput, error)
 
+	CreateUserProfileWithContext(aws.Context, *opsworks.CreateUserProfileInput, ...aws.Option) (*opsworks.CreateUserProfileOutput, error)
+
 	DeleteApp(*opsworks.DeleteAppInput) (*opsworks.DeleteAppOutput, error)
 
+	DeleteAppWithContext(aws.Context, *opsworks.DeleteAppInput, ...aws.Option) (*opsworks.DeleteAppOutput, error)
+
 	DeleteInstance(*opsworks.DeleteInstanceInput) (*opsworks.DeleteInstanceOutput, error)
 
+	DeleteInstanceWithContext(aws.Context, *opsworks.DeleteInstanceInput, ...aws.Option) (*opsworks.DeleteInstanceOutput, error)
+
 	DeleteLayer(*opsworks.DeleteLayerInput) (*opsworks.DeleteLayerOutput, error)
 
+	DeleteLayerWithContext(aws.Context, *opsworks.DeleteLayerInput, ...aws.Option) (*opsworks.DeleteLayerOutput, error)
+
 	DeleteStack(*opsworks.DeleteStackInput) (*opsworks.DeleteStackOutput, error)
 
+	DeleteStackWithContext(aws.Context, *opsworks.DeleteStackInput, ...aws.Option) (*opsworks.DeleteStackOutput, error)
+
 	DeleteUserProfile(*opsworks.DeleteUserProfileInput) (*opsworks.DeleteUserProfileOutput, error)
 
+	DeleteUserProfileWithContext(aws.Context, *opsworks.DeleteUserProfileInput, ...aws.Option) (*opsworks.DeleteUserProfileOutput, error)
+
 	DeregisterElasticIP(*opsworks.DeregisterElasticIPInput) (*opsworks.DeregisterElasticIPOutput, error)
 
+	DeregisterElasticIPWithContext(aws.Context, *opsworks.DeregisterElasticIPInput, ...aws.Option) (*opsworks.DeregisterElasticIPOutput, error)
+
 	DeregisterInstance(*opsworks.DeregisterInstanceInput) (*opsworks.DeregisterInstanceOutput, error)
 
+	DeregisterInstanceWithContext(aws.Context, *opsworks.DeregisterInstanceInput, ...aws.Option) (*opsworks.DeregisterInstanceOutput, error)
+
 	DeregisterRDSDBInstance(*opsworks.DeregisterRDSDBInstanceInput) (*opsworks.DeregisterRDSDBInstanceOutput, error)
 
+	DeregisterRDSDBInstanceWithContext(aws.Context, *opsworks.DeregisterRDSDBInstanceInput, ...aws.Option) (*opsworks.DeregisterRDSDBInstanceOutput, error)
+
 	DeregisterVolume(*opsworks.DeregisterVolumeInput) (*opsworks.DeregisterVolumeOutput, error)
 
+	DeregisterVolumeWithContext(aws.Context, *opsworks.DeregisterVolumeInput, ...aws.Option) (*opsworks.DeregisterVolumeOutput, error)
+
 	DescribeAgentVersions(*opsworks.DescribeAgentVersionsInput) (*opsworks.DescribeAgentVersionsOutput, error)
 
+	DescribeAgentVersionsWithContext(aws.Context, *opsworks.DescribeAgentVersionsInput, ...aws.Option) (*opsworks.DescribeAgentVersionsOutput, error)
+
 	DescribeApps(*opsworks.DescribeAppsInput) (*opsworks.DescribeAppsOutput, error)
 
+	DescribeAppsWithContext(aws.Context, *opsworks.DescribeAppsInput, ...aws.Option) (*opsworks.DescribeAppsOutput, error)
+
 	DescribeCommands(*opsworks.DescribeCommandsInput) (*opsworks.DescribeCommandsOutput, error)
 
+	DescribeCommandsWithContext(aws.Context, *opsworks.DescribeCommandsInput, ...aws.Option) (*opsworks.DescribeCommandsOutput, error)
+
 	DescribeDeployments(*opsworks.DescribeDeploymentsInput) (*opsworks.DescribeDeploymentsOutput, error)
 
+	DescribeDeploymentsWithContext(aws.Context, *opsworks.DescribeDeploymentsInput, ...aws.Option) (*opsworks.DescribeDeploymentsOutput, error)
+
 	DescribeElasticIPs(*opsworks.DescribeElasticIPsInput) (*opsworks.DescribeElasticIPsOutput, error)
 
+	DescribeElasticIPsWithContext(aws.Context, *opsworks.DescribeElasticIPsInput, ...aws.Option) (*opsworks.DescribeElasticIPsOutput, error)
+
 	DescribeElasticLoadBalancers(*opsworks.DescribeElasticLoadBalancersInput) (*opsworks.DescribeElasticLoadBalancersOutput, error)
 
+	DescribeElasticLoadBalancersWithContext(aws.Context, *opsworks.DescribeElasticLoadBalancersInput, ...aws.Option) (*opsworks.DescribeElasticLoadBalancersOutput, error)
+
 	DescribeInstances(*opsworks.DescribeInstancesInput) (*opsworks.DescribeInstancesOutput, error)
 
+	DescribeInstancesWithContext(aws.Context, *opsworks.DescribeInstancesInput, ...aws.Option) (*opsworks.DescribeInstancesOutput, error)
+
 	DescribeLayers(*opsworks.DescribeLayersInput) (*opsworks.DescribeLayersOutput, error)
 
+	DescribeLayersWithContext(aws.Context, *opsworks.DescribeLayersInput, ...aws.Option) (*opsworks.DescribeLayersOutput, error)
+
 	DescribeLoadBasedAutoScaling(*opsworks.DescribeLoadBasedAutoScalingInput) (*opsworks.DescribeLoadBasedAutoScalingOutput, error)
 
+	DescribeLoadBasedAutoScalingWithContext(aws.Context, *opsworks.DescribeLoadBasedAutoScalingInput, ...aws.Option) (*opsworks.DescribeLoadBasedAutoScalingOutput, error)
+
 	DescribeMyUserProfile(*opsworks.DescribeMyUserProfileInput) (*opsworks.DescribeMyUserProfileOutput, error)
 
+	DescribeMyUserProfileWithContext(aws.Context, *opsworks.DescribeMyUserProfileInput, ...aws.Option) (*opsworks.DescribeMyUserProfileOutput, error)
+
 	DescribePermissions(*opsworks.DescribePermissionsInput) (*opsworks.DescribePermissionsOutput, error)
 
+	DescribePermissionsWithContext(aws.Context, *opsworks.DescribePermissionsInput, ...aws.Option) (*opsworks.DescribePermissionsOutput, error)
+
 	DescribeRAIDArrays(*opsworks.DescribeRAIDArraysInput) (*opsworks.DescribeRAIDArraysOutput, error)
 
+	DescribeRAIDArraysWithContext(aws.Context, *opsworks.DescribeRAIDArraysInput, ...aws.Option) (*opsworks.DescribeRAIDArraysOutput, error)
+
 	DescribeRDSDBInstances(*opsworks.DescribeRDSDBInstancesInput) (*opsworks.DescribeRDSDBInstancesOutput, error)
 
+	DescribeRDSDBInstancesWithContext(aws.Context, *opsworks.DescribeRDSDBInstancesInput, ...aws.Option) (*opsworks.DescribeRDSDBInstancesOutput, error)
+
 	DescribeServiceErrors(*opsworks.DescribeServiceErrorsInput) (*opsworks.DescribeServiceErrorsOutput, error)
 
+	DescribeServiceErrorsWithContext(aws.Context, *opsworks.DescribeServiceErrorsInput, ...aws.Option) (*opsworks.DescribeServiceErrorsOutput, error)
+
 	DescribeStackProvisioningParameters(*opsworks.DescribeStackProvisioningParametersInput) (*opsworks.DescribeStackProvisioningParametersOutput, error)
 
+	DescribeStackProvisioningParametersWithContext(aws.Context, *opsworks.DescribeStackProvisioningParametersInput, ...aws.Option) (*opsworks.DescribeStackProvisioningParametersOutput, error)
+
 	DescribeStackSummary(*opsworks.DescribeStackSummaryInput) (*opsworks.DescribeStackSummaryOutput, error)
 
+	DescribeStackSummaryWithContext(aws.Context, *opsworks.DescribeStackSummaryInput, ...aws.Option) (*opsworks.DescribeStackSummaryOutput, error)
+
 	DescribeStacks(*opsworks.DescribeStacksInput) (*opsworks.DescribeStacksOutput, error)
 
+	DescribeStacksWithContext(aws.Context, *opsworks.DescribeStacksInput, ...aws.Option) (*opsworks.DescribeStacksOutput, error)
+
 	DescribeTimeBasedAutoScaling(*opsworks.DescribeTimeBasedAutoScalingInput) (*opsworks.DescribeTimeBasedAutoScalingOutput, error)
 
+	DescribeTimeBasedAutoScalingWithContext(aws.Context, *opsworks.DescribeTimeBasedAutoScalingInput, ...aws.Option) (*opsworks.DescribeTimeBasedAutoScalingOutput, error)
+
 	DescribeUserProfiles(*opsworks.DescribeUserProfilesInput) (*opsworks.DescribeUserProfilesOutput, error)
 
+	DescribeUserProfilesWithContext(aws.Context, *opsworks.DescribeUserProfilesInput, ...aws.Option) (*opsworks.DescribeUserProfilesOutput, error)
+
 	DescribeVolumes(*opsworks.DescribeVolumesInput) (*opsworks.DescribeVolumesOutput, error)
 
+	DescribeVolumesWithContext(aws.Context, *opsworks.DescribeVolumesInput, ...aws.Option) (*opsworks.DescribeVolumesOutput, error)
+
 	DetachElasticLoadBalancer(*opsworks.DetachElasticLoadBalancerInput) (*opsworks.DetachElasticLoadBalancerOutput, error)
 
+	DetachElasticLoadBalancerWithContext(aws.Context, *opsworks.DetachElasticLoadBalancerInput, ...aws.Option) (*opsworks.DetachElasticLoadBalancerOutput, error)
+
 	DisassociateElasticIP(*opsworks.DisassociateElasticIPInput) (*opsworks.DisassociateElasticIPOutput, error)
 
+	DisassociateElasticIPWithContext(aws.Context, *opsworks.DisassociateElasticIPInput, ...aws.Option) (*opsworks.DisassociateElasticIPOutput, error)
+
 	GetHostnameSuggestion(*opsworks.GetHostnameSuggestionInput) (*opsworks.GetHostnameSuggestionOutput, error)
 
+	GetHostnameSuggestionWithContext(aws.Context, *opsworks.GetHostnameSuggestionInput, ...aws.Option) (*opsworks.GetHostnameSuggestionOutput, error)
+
 	GrantAccess(*opsworks.GrantAccessInput) (*opsworks.GrantAccessOutput, error)
 
+	GrantAccessWithContext(aws.Context, *opsworks.GrantAccessInput, ...aws.Option) (*opsworks.GrantAccessOutput, error)
+
 	RebootInstance(*opsworks.RebootInstanceInput) (*opsworks.RebootInstanceOutput, error)
 
+	RebootInstanceWithContext(aws.Context, *opsworks.RebootInstanceInput, ...aws.Option) (*opsworks.RebootInstanceOutput, error)
+
 	RegisterElasticIP(*opsworks.RegisterElasticIPInput) (*opsworks.RegisterElasticIPOutput, error)
 
+	RegisterElasticIPWithContext(aws.Context, *opsworks.RegisterElasticIPInput, ...aws.Option) (*opsworks.RegisterElasticIPOutput, error)
+
 	RegisterInstance(*opsworks.RegisterInstanceInput) (*opsworks.RegisterInstanceOutput, error)
 
+	RegisterInstanceWithContext(aws.Context, *opsworks.RegisterInstanceInput, ...aws.Option) (*opsworks.RegisterInstanceOutput, error)
+
 	RegisterRDSDBInstance(*opsworks.RegisterRDSDBInstanceInput) (*opsworks.RegisterRDSDBInstanceOutput, error)
 
+	RegisterRDSDBInstanceWithContext(aws.Context, *opsworks.RegisterRDSDBInstanceInput, ...aws.Option) (*opsworks.RegisterRDSDBInstanceOutput, error)
+
 	RegisterVolume(*opsworks.RegisterVolumeInput) (*opsworks.RegisterVolumeOutput, error)
 
+	RegisterVolumeWithContext(aws.Context, *opsworks.RegisterVolumeInput, ...aws.Option) (*opsworks.RegisterVolumeOutput, error)
+
 	SetLoadBasedAutoScaling(*opsworks.SetLoadBasedAutoScalingInput) (*opsworks.SetLoadBasedAutoScalingOutput, error)
 
+	SetLoadBasedAutoScalingWithContext(aws.Context, *opsworks.SetLoadBasedAutoScalingInput, ...aws.Option) (*opsworks.SetLoadBasedAutoScalingOutput, error)
+
 	SetPermission(*opsworks.SetPermissionInput) (*opsworks.SetPermissionOutput, error)
 
+	SetPermissionWithContext(aws.Context, *opsworks.SetPermissionInput, ...aws.Option) (*opsworks.SetPermissionOutput, error)
+
 	SetTimeBasedAutoScaling(*opsworks.SetTimeBasedAutoScalingInput) (*opsworks.SetTimeBasedAutoScalingOutput, error)
 
+	SetTimeBasedAutoScalingWithContext(aws.Context, *opsworks.SetTimeBasedAutoScalingInput, ...aws.Option) (*opsworks.SetTimeBasedAutoScalingOutput, error)
+
 	StartInstance(*opsworks.StartInstanceInput) (*opsworks.StartInstanceOutput, error)
 
+	StartInstanceWithContext(aws.Context, *opsworks.StartInstanceInput, ...aws.Option) (*opsworks.StartInstanceOutput, error)
+
 	StartStack(*opsworks.StartStackInput) (*opsworks.StartStackOutput, error)
 
+	StartStackWithContext(aws.Context, *opsworks.StartStackInput, ...aws.Option) (*opsworks.StartStackOutput, error)
+
 	StopInstance(*opsworks.StopInstanceInput) (*opsworks.StopInstanceOutput, error)
 
+	StopInstanceWithContext(aws.Context, *opsworks.StopInstanceInput, ...aws.Option) (*opsworks.StopInstanceOutput, error)
+
 	StopStack(*opsworks.StopStackInput) (*opsworks.StopStackOutput, error)
 
+	StopStackWithContext(aws.Context, *opsworks.StopStackInput, ...aws.Option) (*opsworks.StopStackOutput, error)
+
 	UnassignInstance(*opsworks.UnassignInstanceInput) (*opsworks.UnassignInstanceOutput, error)
 
+	UnassignInstanceWithContext(aws.Context, *opsworks.UnassignInstanceInput, ...aws.Option) (*opsworks.UnassignInstanceOutput, error)
+
 	UnassignVolume(*opsworks.UnassignVolumeInput) (*opsworks.UnassignVolumeOutput, error)
 
+	UnassignVolumeWithContext(aws.Context, *opsworks.UnassignVolumeInput, ...aws.Option) (*opsworks.UnassignVolumeOutput, error)
+
 	UpdateApp(*opsworks.UpdateAppInput) (*opsworks.UpdateAppOutput, error)
 
+	UpdateAppWithContext(aws.Context, *opsworks.UpdateAppInput, ...aws.Option) (*opsworks.UpdateAppOutput, error)
+
 	UpdateElasticIP(*opsworks.UpdateElasticIPInput) (*opsworks.UpdateElasticIPOutput, error)
 
+	UpdateElasticIPWithContext(aws.Context, *opsworks.UpdateElasticIPInput, ...aws.Option) (*opsworks.UpdateElasticIPOutput, error)
+
 	UpdateInstance(*opsworks.UpdateInstanceInput) (*opsworks.UpdateInstanceOutput, error)
 
+	UpdateInstanceWithContext(aws.Context, *opsworks.UpdateInstanceInput, ...aws.Option) (*opsworks.UpdateInstanceOutput, error)
+
 	UpdateLayer(*opsworks.UpdateLayerInput) (*opsworks.UpdateLayerOutput, error)
 
+	UpdateLayerWithContext(aws.Context, *opsworks.UpdateLayerInput, ...aws.Option) (*opsworks.UpdateLayerOutput, error)
+
 	UpdateMyUserProfile(*opsworks.UpdateMyUserProfileInput) (*opsworks.UpdateMyUserProfileOutput, error)
 
+	UpdateMyUserProfileWithContext(aws.Context, *opsworks.UpdateMyUserProfileInput, ...aws.Option) (*opsworks.UpdateMyUserProfileOutput, error)
+
 	UpdateRDSDBInstance(*opsworks.UpdateRDSDBInstanceInput) (*opsworks.UpdateRDSDBInstanceOutput, error)
 
+	UpdateRDSDBInstanceWithContext(aws.Context, *opsworks.UpdateRDSDBInstanceInput, ...aws.Option) (*opsworks.UpdateRDSDBInstanceOutput, error)
+
 	UpdateStack(*opsworks.UpdateStackInput) (*opsworks.UpdateStackOutput, error)
 
+	UpdateStackWithContext(aws.Context, *opsworks.UpdateStackInput, ...aws.Option) (*opsworks.UpdateStackOutput, error)
+
 	UpdateUserProfile(*opsworks.UpdateUserProfileInput) (*opsworks.UpdateUserProfileOutput, error)
 
+	UpdateUserProfileWithContext(aws.Context, *opsworks.UpdateUserProfileInput, ...aws.Option) (*opsworks.UpdateUserProfileOutput, error)
+
 	UpdateVolume(*opsworks.UpdateVolumeInput) (*opsworks.UpdateVolumeOutput, error)
+
+	UpdateVolumeWithContext(aws.Context, *opsworks.UpdateVolumeInput, ...aws.Option) (*opsworks.UpdateVolumeOutput, error)
+
+	WaitUntilInstanceOnline(*opsworks.DescribeInstancesInput) error
+
+	WaitUntilInstanceOnlineWithContext(aws.Context, *opsworks.DescribeInstancesInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceStopped(*opsworks.DescribeInstancesInput) error
+
+	WaitUntilInstanceStoppedWithContext(aws.Context, *opsworks.DescribeInstancesInput, ...aws.WaiterOption) error
+
+	WaitUntilInstanceTerminated(*opsworks.DescribeInstancesInput) error
+
+	WaitUntilInstanceTerminatedWithContext(aws.Context, *opsworks.DescribeInstancesInput, ...aws.WaiterOption) error
 }
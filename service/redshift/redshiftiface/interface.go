@@ -4,6 +4,7 @@
 package redshiftiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 )
 
@@ -11,119 +12,309 @@ import (
 type RedshiftAPI interface {
 	AuthorizeClusterSecurityGroupIngress(*redshift.AuthorizeClusterSecurityGroupIngressInput) (*redshift.AuthorizeClusterSecurityGroupIngressOutput, error)
 
+	AuthorizeClusterSecurityGroupIngressWithContext(aws.Context, *redshift.AuthorizeClusterSecurityGroupIngressInput, ...aws.Option) (*redshift.AuthorizeClusterSecurityGroupIngressOutput, error)
+
 	AuthorizeSnapshotAccess(*redshift.AuthorizeSnapshotAccessInput) (*redshift.AuthorizeSnapshotAccessOutput, error)
 
+	AuthorizeSnapshotAccessWithContext(aws.Context, *redshift.AuthorizeSnapshotAccessInput, ...aws.Option) (*redshift.AuthorizeSnapshotAccessOutput, error)
+
 	CopyClusterSnapshot(*redshift.CopyClusterSnapshotInput) (*redshift.CopyClusterSnapshotOutput, error)
 
+	CopyClusterSnapshotWithContext(aws.Context, *redshift.CopyClusterSnapshotInput, ...aws.Option) (*redshift.CopyClusterSnapshotOutput, error)
+
 	CreateCluster(*redshift.CreateClusterInput) (*redshift.CreateClusterOutput, error)
 
+	CreateClusterWithContext(aws.Context, *redshift.CreateClusterInput, ...aws.Option) (*redshift.CreateClusterOutput, error)
+
 	CreateClusterParameterGroup(*redshift.CreateClusterParameterGroupInput) (*redshift.CreateClusterParameterGroupOutput, error)
 
+	CreateClusterParameterGroupWithContext(aws.Context, *redshift.CreateClusterParameterGroupInput, ...aws.Option) (*redshift.CreateClusterParameterGroupOutput, error)
+
 	CreateClusterSecurityGroup(*redshift.CreateClusterSecurityGroupInput) (*redshift.CreateClusterSecurityGroupOutput, error)
 
+	CreateClusterSecurityGroupWithContext(aws.Context, *redshift.CreateClusterSecurityGroupInput, ...aws.Option) (*redshift.CreateClusterSecurityGroupOutput, error)
+
 	CreateClusterSnapshot(*redshift.CreateClusterSnapshotInput) (*redshift.CreateClusterSnapshotOutput, error)
 
+	CreateClusterSnapshotWithContext(aws.Context, *redshift.CreateClusterSnapshotInput, ...aws.Option) (*redshift.CreateClusterSnapshotOutput, error)
+
 	CreateClusterSubnetGroup(*redshift.CreateClusterSubnetGroupInput) (*redshift.CreateClusterSubnetGroupOutput, error)
 
+	CreateClusterSubnetGroupWithContext(aws.Context, *redshift.CreateClusterSubnetGroupInput, ...aws.Option) (*redshift.CreateClusterSubnetGroupOutput, error)
+
 	CreateEventSubscription(*redshift.CreateEventSubscriptionInput) (*redshift.CreateEventSubscriptionOutput, error)
 
+	CreateEventSubscriptionWithContext(aws.Context, *redshift.CreateEventSubscriptionInput, ...aws.Option) (*redshift.CreateEventSubscriptionOutput, error)
+
 	CreateHSMClientCertificate(*redshift.CreateHSMClientCertificateInput) (*redshift.CreateHSMClientCertificateOutput, error)
 
+	CreateHSMClientCertificateWithContext(aws.Context, *redshift.CreateHSMClientCertificateInput, ...aws.Option) (*redshift.CreateHSMClientCertificateOutput, error)
+
 	CreateHSMConfiguration(*redshift.CreateHSMConfigurationInput) (*redshift.CreateHSMConfigurationOutput, error)
 
+	CreateHSMConfigurationWithContext(aws.Context, *redshift.CreateHSMConfigurationInput, ...aws.Option) (*redshift.CreateHSMConfigurationOutput, error)
+
 	CreateSnapshotCopyGrant(*redshift.CreateSnapshotCopyGrantInput) (*redshift.CreateSnapshotCopyGrantOutput, error)
 
+	CreateSnapshotCopyGrantWithContext(aws.Context, *redshift.CreateSnapshotCopyGrantInput, ...aws.Option) (*redshift.CreateSnapshotCopyGrantOutput, error)
+
 	CreateTags(*redshift.CreateTagsInput) (*redshift.CreateTagsOutput, error)
 
+	CreateTagsWithContext(aws.Context, *redshift.CreateTagsInput, ...aws.Option) (*redshift.CreateTagsOutput, error)
+
 	DeleteCluster(*redshift.DeleteClusterInput) (*redshift.DeleteClusterOutput, error)
 
+	DeleteClusterWithContext(aws.Context, *redshift.DeleteClusterInput, ...aws.Option) (*redshift.DeleteClusterOutput, error)
+
 	DeleteClusterParameterGroup(*redshift.DeleteClusterParameterGroupInput) (*redshift.DeleteClusterParameterGroupOutput, error)
 
+	DeleteClusterParameterGroupWithContext(aws.Context, *redshift.DeleteClusterParameterGroupInput, ...aws.Option) (*redshift.DeleteClusterParameterGroupOutput, error)
+
 	DeleteClusterSecurityGroup(*redshift.DeleteClusterSecurityGroupInput) (*redshift.DeleteClusterSecurityGroupOutput, error)
 
+	DeleteClusterSecurityGroupWithContext(aws.Context, *redshift.DeleteClusterSecurityGroupInput, ...aws.Option) (*redshift.DeleteClusterSecurityGroupOutput, error)
+
 	DeleteClusterSnapshot(*redshift.DeleteClusterSnapshotInput) (*redshift.DeleteClusterSnapshotOutput, error)
 
+	DeleteClusterSnapshotWithContext(aws.Context, *redshift.DeleteClusterSnapshotInput, ...aws.Option) (*redshift.DeleteClusterSnapshotOutput, error)
+
 	DeleteClusterSubnetGroup(*redshift.DeleteClusterSubnetGroupInput) (*redshift.DeleteClusterSubnetGroupOutput, error)
 
+	DeleteClusterSubnetGroupWithContext(aws.Context, *redshift.DeleteClusterSubnetGroupInput, ...aws.Option) (*redshift.DeleteClusterSubnetGroupOutput, error)
+
 	DeleteEventSubscription(*redshift.DeleteEventSubscriptionInput) (*redshift.DeleteEventSubscriptionOutput, error)
 
+	DeleteEventSubscriptionWithContext(aws.Context, *redshift.DeleteEventSubscriptionInput, ...aws.Option) (*redshift.DeleteEventSubscriptionOutput, error)
+
 	DeleteHSMClientCertificate(*redshift.DeleteHSMClientCertificateInput) (*redshift.DeleteHSMClientCertificateOutput, error)
 
+	DeleteHSMClientCertificateWithContext(aws.Context, *redshift.DeleteHSMClientCertificateInput, ...aws.Option) (*redshift.DeleteHSMClientCertificateOutput, error)
+
 	DeleteHSMConfiguration(*redshift.DeleteHSMConfigurationInput) (*redshift.DeleteHSMConfigurationOutput, error)
 
+	DeleteHSMConfigurationWithContext(aws.Context, *redshift.DeleteHSMConfigurationInput, ...aws.Option) (*redshift.DeleteHSMConfigurationOutput, error)
+
 	DeleteSnapshotCopyGrant(*redshift.DeleteSnapshotCopyGrantInput) (*redshift.DeleteSnapshotCopyGrantOutput, error)
 
+	DeleteSnapshotCopyGrantWithContext(aws.Context, *redshift.DeleteSnapshotCopyGrantInput, ...aws.Option) (*redshift.DeleteSnapshotCopyGrantOutput, error)
+
 	DeleteTags(*redshift.DeleteTagsInput) (*redshift.DeleteTagsOutput, error)
 
+	DeleteTagsWithContext(aws.Context, *redshift.DeleteTagsInput, ...aws.Option) (*redshift.DeleteTagsOutput, error)
+
 	DescribeClusterParameterGroups(*redshift.DescribeClusterParameterGroupsInput) (*redshift.DescribeClusterParameterGroupsOutput, error)
 
+	DescribeClusterParameterGroupsWithContext(aws.Context, *redshift.DescribeClusterParameterGroupsInput, ...aws.Option) (*redshift.DescribeClusterParameterGroupsOutput, error)
+
+	DescribeClusterParameterGroupsPages(*redshift.DescribeClusterParameterGroupsInput, func(*redshift.DescribeClusterParameterGroupsOutput, bool) bool) error
+
+	DescribeClusterParameterGroupsPagesWithContext(aws.Context, *redshift.DescribeClusterParameterGroupsInput, func(*redshift.DescribeClusterParameterGroupsOutput, bool) bool, ...aws.Option) error
+
 	DescribeClusterParameters(*redshift.DescribeClusterParametersInput) (*redshift.DescribeClusterParametersOutput, error)
 
+	DescribeClusterParametersWithContext(aws.Context, *redshift.DescribeClusterParametersInput, ...aws.Option) (*redshift.DescribeClusterParametersOutput, error)
+
+	DescribeClusterParametersPages(*redshift.DescribeClusterParametersInput, func(*redshift.DescribeClusterParametersOutput, bool) bool) error
+
+	DescribeClusterParametersPagesWithContext(aws.Context, *redshift.DescribeClusterParametersInput, func(*redshift.DescribeClusterParametersOutput, bool) bool, ...aws.Option) error
+
 	DescribeClusterSecurityGroups(*redshift.DescribeClusterSecurityGroupsInput) (*redshift.DescribeClusterSecurityGroupsOutput, error)
 
+	DescribeClusterSecurityGroupsWithContext(aws.Context, *redshift.DescribeClusterSecurityGroupsInput, ...aws.Option) (*redshift.DescribeClusterSecurityGroupsOutput, error)
+
+	DescribeClusterSecurityGroupsPages(*redshift.DescribeClusterSecurityGroupsInput, func(*redshift.DescribeClusterSecurityGroupsOutput, bool) bool) error
+
+	DescribeClusterSecurityGroupsPagesWithContext(aws.Context, *redshift.DescribeClusterSecurityGroupsInput, func(*redshift.DescribeClusterSecurityGroupsOutput, bool) bool, ...aws.Option) error
+
 	DescribeClusterSnapshots(*redshift.DescribeClusterSnapshotsInput) (*redshift.DescribeClusterSnapshotsOutput, error)
 
+	DescribeClusterSnapshotsWithContext(aws.Context, *redshift.DescribeClusterSnapshotsInput, ...aws.Option) (*redshift.DescribeClusterSnapshotsOutput, error)
+
+	DescribeClusterSnapshotsPages(*redshift.DescribeClusterSnapshotsInput, func(*redshift.DescribeClusterSnapshotsOutput, bool) bool) error
+
+	DescribeClusterSnapshotsPagesWithContext(aws.Context, *redshift.DescribeClusterSnapshotsInput, func(*redshift.DescribeClusterSnapshotsOutput, bool) bool, ...aws.Option) error
+
 	DescribeClusterSubnetGroups(*redshift.DescribeClusterSubnetGroupsInput) (*redshift.DescribeClusterSubnetGroupsOutput, error)
 
+	DescribeClusterSubnetGroupsWithContext(aws.Context, *redshift.DescribeClusterSubnetGroupsInput, ...aws.Option) (*redshift.DescribeClusterSubnetGroupsOutput, error)
+
+	DescribeClusterSubnetGroupsPages(*redshift.DescribeClusterSubnetGroupsInput, func(*redshift.DescribeClusterSubnetGroupsOutput, bool) bool) error
+
+	DescribeClusterSubnetGroupsPagesWithContext(aws.Context, *redshift.DescribeClusterSubnetGroupsInput, func(*redshift.DescribeClusterSubnetGroupsOutput, bool) bool, ...aws.Option) error
+
 	DescribeClusterVersions(*redshift.DescribeClusterVersionsInput) (*redshift.DescribeClusterVersionsOutput, error)
 
+	DescribeClusterVersionsWithContext(aws.Context, *redshift.DescribeClusterVersionsInput, ...aws.Option) (*redshift.DescribeClusterVersionsOutput, error)
+
+	DescribeClusterVersionsPages(*redshift.DescribeClusterVersionsInput, func(*redshift.DescribeClusterVersionsOutput, bool) bool) error
+
+	DescribeClusterVersionsPagesWithContext(aws.Context, *redshift.DescribeClusterVersionsInput, func(*redshift.DescribeClusterVersionsOutput, bool) bool, ...aws.Option) error
+
 	DescribeClusters(*redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error)
 
+	DescribeClustersWithContext(aws.Context, *redshift.DescribeClustersInput, ...aws.Option) (*redshift.DescribeClustersOutput, error)
+
+	DescribeClustersPages(*redshift.DescribeClustersInput, func(*redshift.DescribeClustersOutput, bool) bool) error
+
+	DescribeClustersPagesWithContext(aws.Context, *redshift.DescribeClustersInput, func(*redshift.DescribeClustersOutput, bool) bool, ...aws.Option) error
+
 	DescribeDefaultClusterParameters(*redshift.DescribeDefaultClusterParametersInput) (*redshift.DescribeDefaultClusterParametersOutput, error)
 
+	DescribeDefaultClusterParametersWithContext(aws.Context, *redshift.DescribeDefaultClusterParametersInput, ...aws.Option) (*redshift.DescribeDefaultClusterParametersOutput, error)
+
+	DescribeDefaultClusterParametersPages(*redshift.DescribeDefaultClusterParametersInput, func(*redshift.DescribeDefaultClusterParametersOutput, bool) bool) error
+
+	DescribeDefaultClusterParametersPagesWithContext(aws.Context, *redshift.DescribeDefaultClusterParametersInput, func(*redshift.DescribeDefaultClusterParametersOutput, bool) bool, ...aws.Option) error
+
 	DescribeEventCategories(*redshift.DescribeEventCategoriesInput) (*redshift.DescribeEventCategoriesOutput, error)
 
+	DescribeEventCategoriesWithContext(aws.Context, *redshift.DescribeEventCategoriesInput, ...aws.Option) (*redshift.DescribeEventCategoriesOutput, error)
+
 	DescribeEventSubscriptions(*redshift.DescribeEventSubscriptionsInput) (*redshift.DescribeEventSubscriptionsOutput, error)
 
+	DescribeEventSubscriptionsWithContext(aws.Context, *redshift.DescribeEventSubscriptionsInput, ...aws.Option) (*redshift.DescribeEventSubscriptionsOutput, error)
+
+	DescribeEventSubscriptionsPages(*redshift.DescribeEventSubscriptionsInput, func(*redshift.DescribeEventSubscriptionsOutput, bool) bool) error
+
+	DescribeEventSubscriptionsPagesWithContext(aws.Context, *redshift.DescribeEventSubscriptionsInput, func(*redshift.DescribeEventSubscriptionsOutput, bool) bool, ...aws.Option) error
+
 	DescribeEvents(*redshift.DescribeEventsInput) (*redshift.DescribeEventsOutput, error)
 
+	DescribeEventsWithContext(aws.Context, *redshift.DescribeEventsInput, ...aws.Option) (*redshift.DescribeEventsOutput, error)
+
+	DescribeEventsPages(*redshift.DescribeEventsInput, func(*redshift.DescribeEventsOutput, bool) bool) error
+
+	DescribeEventsPagesWithContext(aws.Context, *redshift.DescribeEventsInput, func(*redshift.DescribeEventsOutput, bool) bool, ...aws.Option) error
+
 	DescribeHSMClientCertificates(*redshift.DescribeHSMClientCertificatesInput) (*redshift.DescribeHSMClientCertificatesOutput, error)
 
+	DescribeHSMClientCertificatesWithContext(aws.Context, *redshift.DescribeHSMClientCertificatesInput, ...aws.Option) (*redshift.DescribeHSMClientCertificatesOutput, error)
+
+	DescribeHSMClientCertificatesPages(*redshift.DescribeHSMClientCertificatesInput, func(*redshift.DescribeHSMClientCertificatesOutput, bool) bool) error
+
+	DescribeHSMClientCertificatesPagesWithContext(aws.Context, *redshift.DescribeHSMClientCertificatesInput, func(*redshift.DescribeHSMClientCertificatesOutput, bool) bool, ...aws.Option) error
+
 	DescribeHSMConfigurations(*redshift.DescribeHSMConfigurationsInput) (*redshift.DescribeHSMConfigurationsOutput, error)
 
+	DescribeHSMConfigurationsWithContext(aws.Context, *redshift.DescribeHSMConfigurationsInput, ...aws.Option) (*redshift.DescribeHSMConfigurationsOutput, error)
+
+	DescribeHSMConfigurationsPages(*redshift.DescribeHSMConfigurationsInput, func(*redshift.DescribeHSMConfigurationsOutput, bool) bool) error
+
+	DescribeHSMConfigurationsPagesWithContext(aws.Context, *redshift.DescribeHSMConfigurationsInput, func(*redshift.DescribeHSMConfigurationsOutput, bool) bool, ...aws.Option) error
+
 	DescribeLoggingStatus(*redshift.DescribeLoggingStatusInput) (*redshift.LoggingStatus, error)
 
+	DescribeLoggingStatusWithContext(aws.Context, *redshift.DescribeLoggingStatusInput, ...aws.Option) (*redshift.LoggingStatus, error)
+
 	DescribeOrderableClusterOptions(*redshift.DescribeOrderableClusterOptionsInput) (*redshift.DescribeOrderableClusterOptionsOutput, error)
 
+	DescribeOrderableClusterOptionsWithContext(aws.Context, *redshift.DescribeOrderableClusterOptionsInput, ...aws.Option) (*redshift.DescribeOrderableClusterOptionsOutput, error)
+
+	DescribeOrderableClusterOptionsPages(*redshift.DescribeOrderableClusterOptionsInput, func(*redshift.DescribeOrderableClusterOptionsOutput, bool) bool) error
+
+	DescribeOrderableClusterOptionsPagesWithContext(aws.Context, *redshift.DescribeOrderableClusterOptionsInput, func(*redshift.DescribeOrderableClusterOptionsOutput, bool) bool, ...aws.Option) error
+
 	DescribeReservedNodeOfferings(*redshift.DescribeReservedNodeOfferingsInput) (*redshift.DescribeReservedNodeOfferingsOutput, error)
 
+	DescribeReservedNodeOfferingsWithContext(aws.Context, *redshift.DescribeReservedNodeOfferingsInput, ...aws.Option) (*redshift.DescribeReservedNodeOfferingsOutput, error)
+
+	DescribeReservedNodeOfferingsPages(*redshift.DescribeReservedNodeOfferingsInput, func(*redshift.DescribeReservedNodeOfferingsOutput, bool) bool) error
+
+	DescribeReservedNodeOfferingsPagesWithContext(aws.Context, *redshift.DescribeReservedNodeOfferingsInput, func(*redshift.DescribeReservedNodeOfferingsOutput, bool) bool, ...aws.Option) error
+
 	DescribeReservedNodes(*redshift.DescribeReservedNodesInput) (*redshift.DescribeReservedNodesOutput, error)
 
+	DescribeReservedNodesWithContext(aws.Context, *redshift.DescribeReservedNodesInput, ...aws.Option) (*redshift.DescribeReservedNodesOutput, error)
+
+	DescribeReservedNodesPages(*redshift.DescribeReservedNodesInput, func(*redshift.DescribeReservedNodesOutput, bool) bool) error
+
+	DescribeReservedNodesPagesWithContext(aws.Context, *redshift.DescribeReservedNodesInput, func(*redshift.DescribeReservedNodesOutput, bool) bool, ...aws.Option) error
+
 	DescribeResize(*redshift.DescribeResizeInput) (*redshift.DescribeResizeOutput, error)
 
+	DescribeResizeWithContext(aws.Context, *redshift.DescribeResizeInput, ...aws.Option) (*redshift.DescribeResizeOutput, error)
+
 	DescribeSnapshotCopyGrants(*redshift.DescribeSnapshotCopyGrantsInput) (*redshift.DescribeSnapshotCopyGrantsOutput, error)
 
+	DescribeSnapshotCopyGrantsWithContext(aws.Context, *redshift.DescribeSnapshotCopyGrantsInput, ...aws.Option) (*redshift.DescribeSnapshotCopyGrantsOutput, error)
+
 	DescribeTags(*redshift.DescribeTagsInput) (*redshift.DescribeTagsOutput, error)
 
+	DescribeTagsWithContext(aws.Context, *redshift.DescribeTagsInput, ...aws.Option) (*redshift.DescribeTagsOutput, error)
+
 	DisableLogging(*redshift.DisableLoggingInput) (*redshift.LoggingStatus, error)
 
+	DisableLoggingWithContext(aws.Context, *redshift.DisableLoggingInput, ...aws.Option) (*redshift.LoggingStatus, error)
+
 	DisableSnapshotCopy(*redshift.DisableSnapshotCopyInput) (*redshift.DisableSnapshotCopyOutput, error)
 
+	DisableSnapshotCopyWithContext(aws.Context, *redshift.DisableSnapshotCopyInput, ...aws.Option) (*redshift.DisableSnapshotCopyOutput, error)
+
 	EnableLogging(*redshift.EnableLoggingInput) (*redshift.LoggingStatus, error)
 
+	EnableLoggingWithContext(aws.Context, *redshift.EnableLoggingInput, ...aws.Option) (*redshift.LoggingStatus, error)
+
 	EnableSnapshotCopy(*redshift.EnableSnapshotCopyInput) (*redshift.EnableSnapshotCopyOutput, error)
 
+	EnableSnapshotCopyWithContext(aws.Context, *redshift.EnableSnapshotCopyInput, ...aws.Option) (*redshift.EnableSnapshotCopyOutput, error)
+
 	ModifyCluster(*redshift.ModifyClusterInput) (*redshift.ModifyClusterOutput, error)
 
+	ModifyClusterWithContext(aws.Context, *redshift.ModifyClusterInput, ...aws.Option) (*redshift.ModifyClusterOutput, error)
+
 	ModifyClusterParameterGroup(*redshift.ModifyClusterParameterGroupInput) (*redshift.ClusterParameterGroupNameMessage, error)
 
+	ModifyClusterParameterGroupWithContext(aws.Context, *redshift.ModifyClusterParameterGroupInput, ...aws.Option) (*redshift.ClusterParameterGroupNameMessage, error)
+
 	ModifyClusterSubnetGroup(*redshift.ModifyClusterSubnetGroupInput) (*redshift.ModifyClusterSubnetGroupOutput, error)
 
+	ModifyClusterSubnetGroupWithContext(aws.Context, *redshift.ModifyClusterSubnetGroupInput, ...aws.Option) (*redshift.ModifyClusterSubnetGroupOutput, error)
+
 	ModifyEventSubscription(*redshift.ModifyEventSubscriptionInput) (*redshift.ModifyEventSubscriptionOutput, error)
 
+	ModifyEventSubscriptionWithContext(aws.Context, *redshift.ModifyEventSubscriptionInput, ...aws.Option) (*redshift.ModifyEventSubscriptionOutput, error)
+
 	ModifySnapshotCopyRetentionPeriod(*redshift.ModifySnapshotCopyRetentionPeriodInput) (*redshift.ModifySnapshotCopyRetentionPeriodOutput, error)
 
+	ModifySnapshotCopyRetentionPeriodWithContext(aws.Context, *redshift.ModifySnapshotCopyRetentionPeriodInput, ...aws.Option) (*redshift.ModifySnapshotCopyRetentionPeriodOutput, error)
+
 	PurchaseReservedNodeOffering(*redshift.PurchaseReservedNodeOfferingInput) (*redshift.PurchaseReservedNodeOfferingOutput, error)
 
+	PurchaseReservedNodeOfferingWithContext(aws.Context, *redshift.PurchaseReservedNodeOfferingInput, ...aws.Option) (*redshift.PurchaseReservedNodeOfferingOutput, error)
+
 	RebootCluster(*redshift.RebootClusterInput) (*redshift.RebootClusterOutput, error)
 
+	RebootClusterWithContext(aws.Context, *redshift.RebootClusterInput, ...aws.Option) (*redshift.RebootClusterOutput, error)
+
 	ResetClusterParameterGroup(*redshift.ResetClusterParameterGroupInput) (*redshift.ClusterParameterGroupNameMessage, error)
 
+	ResetClusterParameterGroupWithContext(aws.Context, *redshift.ResetClusterParameterGroupInput, ...aws.Option) (*redshift.ClusterParameterGroupNameMessage, error)
+
 	RestoreFromClusterSnapshot(*redshift.RestoreFromClusterSnapshotInput) (*redshift.RestoreFromClusterSnapshotOutput, error)
 
+	RestoreFromClusterSnapshotWithContext(aws.Context, *redshift.RestoreFromClusterSnapshotInput, ...aws.Option) (*redshift.RestoreFromClusterSnapshotOutput, error)
+
 	RevokeClusterSecurityGroupIngress(*redshift.RevokeClusterSecurityGroupIngressInput) (*redshift.RevokeClusterSecurityGroupIngressOutput, error)
 
+	RevokeClusterSecurityGroupIngressWithContext(aws.Context, *redshift.RevokeClusterSecurityGroupIngressInput, ...aws.Option) (*redshift.RevokeClusterSecurityGroupIngressOutput, error)
+
 	RevokeSnapshotAccess(*redshift.RevokeSnapshotAccessInput) (*redshift.RevokeSnapshotAccessOutput, error)
 
+	RevokeSnapshotAccessWithContext(aws.Context, *redshift.RevokeSnapshotAccessInput, ...aws.Option) (*redshift.RevokeSnapshotAccessOutput, error)
+
 	RotateEncryptionKey(*redshift.RotateEncryptionKeyInput) (*redshift.RotateEncryptionKeyOutput, error)
+
+	RotateEncryptionKeyWithContext(aws.Context, *redshift.RotateEncryptionKeyInput, ...aws.Option) (*redshift.RotateEncryptionKeyOutput, error)
+
+	WaitUntilClusterAvailable(*redshift.DescribeClustersInput) error
+
+	WaitUntilClusterAvailableWithContext(aws.Context, *redshift.DescribeClustersInput, ...aws.WaiterOption) error
+
+	WaitUntilClusterRestored(*redshift.DescribeClustersInput) error
+
+	WaitUntilClusterRestoredWithContext(aws.Context, *redshift.DescribeClustersInput, ...aws.WaiterOption) error
+
+	WaitUntilSnapshotAvailable(*redshift.DescribeClusterSnapshotsInput) error
+
+	WaitUntilSnapshotAvailableWithContext(aws.Context, *redshift.DescribeClusterSnapshotsInput, ...aws.WaiterOption) error
 }
@@ -44,6 +44,18 @@ func (c *Route53) AssociateVPCWithHostedZone(input *AssociateVPCWithHostedZoneIn
 	return out, err
 }
 
+// AssociateVPCWithHostedZoneWithContext is the same as AssociateVPCWithHostedZone with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) AssociateVPCWithHostedZoneWithContext(ctx aws.Context, input *AssociateVPCWithHostedZoneInput, opts ...aws.Option) (*AssociateVPCWithHostedZoneOutput, error) {
+	req, out := c.AssociateVPCWithHostedZoneRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opChangeResourceRecordSets = "ChangeResourceRecordSets"
 
 // ChangeResourceRecordSetsRequest generates a request for the ChangeResourceRecordSets operation.
@@ -98,6 +110,18 @@ func (c *Route53) ChangeResourceRecordSets(input *ChangeResourceRecordSetsInput)
 	return out, err
 }
 
+// ChangeResourceRecordSetsWithContext is the same as ChangeResourceRecordSets with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ChangeResourceRecordSetsWithContext(ctx aws.Context, input *ChangeResourceRecordSetsInput, opts ...aws.Option) (*ChangeResourceRecordSetsOutput, error) {
+	req, out := c.ChangeResourceRecordSetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opChangeTagsForResource = "ChangeTagsForResource"
 
 // ChangeTagsForResourceRequest generates a request for the ChangeTagsForResource operation.
@@ -124,6 +148,18 @@ func (c *Route53) ChangeTagsForResource(input *ChangeTagsForResourceInput) (*Cha
 	return out, err
 }
 
+// ChangeTagsForResourceWithContext is the same as ChangeTagsForResource with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ChangeTagsForResourceWithContext(ctx aws.Context, input *ChangeTagsForResourceInput, opts ...aws.Option) (*ChangeTagsForResourceOutput, error) {
+	req, out := c.ChangeTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateHealthCheck = "CreateHealthCheck"
 
 // CreateHealthCheckRequest generates a request for the CreateHealthCheck operation.
@@ -156,6 +192,18 @@ func (c *Route53) CreateHealthCheck(input *CreateHealthCheckInput) (*CreateHealt
 	return out, err
 }
 
+// CreateHealthCheckWithContext is the same as CreateHealthCheck with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) CreateHealthCheckWithContext(ctx aws.Context, input *CreateHealthCheckInput, opts ...aws.Option) (*CreateHealthCheckOutput, error) {
+	req, out := c.CreateHealthCheckRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateHostedZone = "CreateHostedZone"
 
 // CreateHostedZoneRequest generates a request for the CreateHostedZone operation.
@@ -203,6 +251,18 @@ func (c *Route53) CreateHostedZone(input *CreateHostedZoneInput) (*CreateHostedZ
 	return out, err
 }
 
+// CreateHostedZoneWithContext is the same as CreateHostedZone with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) CreateHostedZoneWithContext(ctx aws.Context, input *CreateHostedZoneInput, opts ...aws.Option) (*CreateHostedZoneOutput, error) {
+	req, out := c.CreateHostedZoneRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateReusableDelegationSet = "CreateReusableDelegationSet"
 
 // CreateReusableDelegationSetRequest generates a request for the CreateReusableDelegationSet operation.
@@ -238,6 +298,18 @@ func (c *Route53) CreateReusableDelegationSet(input *CreateReusableDelegationSet
 	return out, err
 }
 
+// CreateReusableDelegationSetWithContext is the same as CreateReusableDelegationSet with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) CreateReusableDelegationSetWithContext(ctx aws.Context, input *CreateReusableDelegationSetInput, opts ...aws.Option) (*CreateReusableDelegationSetOutput, error) {
+	req, out := c.CreateReusableDelegationSetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHealthCheck = "DeleteHealthCheck"
 
 // DeleteHealthCheckRequest generates a request for the DeleteHealthCheck operation.
@@ -274,6 +346,18 @@ func (c *Route53) DeleteHealthCheck(input *DeleteHealthCheckInput) (*DeleteHealt
 	return out, err
 }
 
+// DeleteHealthCheckWithContext is the same as DeleteHealthCheck with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) DeleteHealthCheckWithContext(ctx aws.Context, input *DeleteHealthCheckInput, opts ...aws.Option) (*DeleteHealthCheckOutput, error) {
+	req, out := c.DeleteHealthCheckRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHostedZone = "DeleteHostedZone"
 
 // DeleteHostedZoneRequest generates a request for the DeleteHostedZone operation.
@@ -314,6 +398,18 @@ func (c *Route53) DeleteHostedZone(input *DeleteHostedZoneInput) (*DeleteHostedZ
 	return out, err
 }
 
+// DeleteHostedZoneWithContext is the same as DeleteHostedZone with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) DeleteHostedZoneWithContext(ctx aws.Context, input *DeleteHostedZoneInput, opts ...aws.Option) (*DeleteHostedZoneOutput, error) {
+	req, out := c.DeleteHostedZoneRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteReusableDelegationSet = "DeleteReusableDelegationSet"
 
 // DeleteReusableDelegationSetRequest generates a request for the DeleteReusableDelegationSet operation.
@@ -349,6 +445,18 @@ func (c *Route53) DeleteReusableDelegationSet(input *DeleteReusableDelegationSet
 	return out, err
 }
 
+// DeleteReusableDelegationSetWithContext is the same as DeleteReusableDelegationSet with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) DeleteReusableDelegationSetWithContext(ctx aws.Context, input *DeleteReusableDelegationSetInput, opts ...aws.Option) (*DeleteReusableDelegationSetOutput, error) {
+	req, out := c.DeleteReusableDelegationSetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisassociateVPCFromHostedZone = "DisassociateVPCFromHostedZone"
 
 // DisassociateVPCFromHostedZoneRequest generates a request for the DisassociateVPCFromHostedZone operation.
@@ -383,6 +491,18 @@ func (c *Route53) DisassociateVPCFromHostedZone(input *DisassociateVPCFromHosted
 	return out, err
 }
 
+// DisassociateVPCFromHostedZoneWithContext is the same as DisassociateVPCFromHostedZone with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) DisassociateVPCFromHostedZoneWithContext(ctx aws.Context, input *DisassociateVPCFromHostedZoneInput, opts ...aws.Option) (*DisassociateVPCFromHostedZoneOutput, error) {
+	req, out := c.DisassociateVPCFromHostedZoneRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetChange = "GetChange"
 
 // GetChangeRequest generates a request for the GetChange operation.
@@ -418,6 +538,18 @@ func (c *Route53) GetChange(input *GetChangeInput) (*GetChangeOutput, error) {
 	return out, err
 }
 
+// GetChangeWithContext is the same as GetChange with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetChangeWithContext(ctx aws.Context, input *GetChangeInput, opts ...aws.Option) (*GetChangeOutput, error) {
+	req, out := c.GetChangeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCheckerIPRanges = "GetCheckerIpRanges"
 
 // GetCheckerIPRangesRequest generates a request for the GetCheckerIPRanges operation.
@@ -448,6 +580,18 @@ func (c *Route53) GetCheckerIPRanges(input *GetCheckerIPRangesInput) (*GetChecke
 	return out, err
 }
 
+// GetCheckerIPRangesWithContext is the same as GetCheckerIPRanges with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetCheckerIPRangesWithContext(ctx aws.Context, input *GetCheckerIPRangesInput, opts ...aws.Option) (*GetCheckerIPRangesOutput, error) {
+	req, out := c.GetCheckerIPRangesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetGeoLocation = "GetGeoLocation"
 
 // GetGeoLocationRequest generates a request for the GetGeoLocation operation.
@@ -477,6 +621,18 @@ func (c *Route53) GetGeoLocation(input *GetGeoLocationInput) (*GetGeoLocationOut
 	return out, err
 }
 
+// GetGeoLocationWithContext is the same as GetGeoLocation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetGeoLocationWithContext(ctx aws.Context, input *GetGeoLocationInput, opts ...aws.Option) (*GetGeoLocationOutput, error) {
+	req, out := c.GetGeoLocationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheck = "GetHealthCheck"
 
 // GetHealthCheckRequest generates a request for the GetHealthCheck operation.
@@ -505,6 +661,18 @@ func (c *Route53) GetHealthCheck(input *GetHealthCheckInput) (*GetHealthCheckOut
 	return out, err
 }
 
+// GetHealthCheckWithContext is the same as GetHealthCheck with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetHealthCheckWithContext(ctx aws.Context, input *GetHealthCheckInput, opts ...aws.Option) (*GetHealthCheckOutput, error) {
+	req, out := c.GetHealthCheckRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheckCount = "GetHealthCheckCount"
 
 // GetHealthCheckCountRequest generates a request for the GetHealthCheckCount operation.
@@ -533,6 +701,18 @@ func (c *Route53) GetHealthCheckCount(input *GetHealthCheckCountInput) (*GetHeal
 	return out, err
 }
 
+// GetHealthCheckCountWithContext is the same as GetHealthCheckCount with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetHealthCheckCountWithContext(ctx aws.Context, input *GetHealthCheckCountInput, opts ...aws.Option) (*GetHealthCheckCountOutput, error) {
+	req, out := c.GetHealthCheckCountRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheckLastFailureReason = "GetHealthCheckLastFailureReason"
 
 // GetHealthCheckLastFailureReasonRequest generates a request for the GetHealthCheckLastFailureReason operation.
@@ -563,6 +743,18 @@ func (c *Route53) GetHealthCheckLastFailureReason(input *GetHealthCheckLastFailu
 	return out, err
 }
 
+// GetHealthCheckLastFailureReasonWithContext is the same as GetHealthCheckLastFailureReason with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetHealthCheckLastFailureReasonWithContext(ctx aws.Context, input *GetHealthCheckLastFailureReasonInput, opts ...aws.Option) (*GetHealthCheckLastFailureReasonOutput, error) {
+	req, out := c.GetHealthCheckLastFailureReasonRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheckStatus = "GetHealthCheckStatus"
 
 // GetHealthCheckStatusRequest generates a request for the GetHealthCheckStatus operation.
@@ -592,6 +784,18 @@ func (c *Route53) GetHealthCheckStatus(input *GetHealthCheckStatusInput) (*GetHe
 	return out, err
 }
 
+// GetHealthCheckStatusWithContext is the same as GetHealthCheckStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetHealthCheckStatusWithContext(ctx aws.Context, input *GetHealthCheckStatusInput, opts ...aws.Option) (*GetHealthCheckStatusOutput, error) {
+	req, out := c.GetHealthCheckStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHostedZone = "GetHostedZone"
 
 // GetHostedZoneRequest generates a request for the GetHostedZone operation.
@@ -622,6 +826,18 @@ func (c *Route53) GetHostedZone(input *GetHostedZoneInput) (*GetHostedZoneOutput
 	return out, err
 }
 
+// GetHostedZoneWithContext is the same as GetHostedZone with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetHostedZoneWithContext(ctx aws.Context, input *GetHostedZoneInput, opts ...aws.Option) (*GetHostedZoneOutput, error) {
+	req, out := c.GetHostedZoneRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHostedZoneCount = "GetHostedZoneCount"
 
 // GetHostedZoneCountRequest generates a request for the GetHostedZoneCount operation.
@@ -650,6 +866,18 @@ func (c *Route53) GetHostedZoneCount(input *GetHostedZoneCountInput) (*GetHosted
 	return out, err
 }
 
+// GetHostedZoneCountWithContext is the same as GetHostedZoneCount with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetHostedZoneCountWithContext(ctx aws.Context, input *GetHostedZoneCountInput, opts ...aws.Option) (*GetHostedZoneCountOutput, error) {
+	req, out := c.GetHostedZoneCountRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetReusableDelegationSet = "GetReusableDelegationSet"
 
 // GetReusableDelegationSetRequest generates a request for the GetReusableDelegationSet operation.
@@ -678,6 +906,18 @@ func (c *Route53) GetReusableDelegationSet(input *GetReusableDelegationSetInput)
 	return out, err
 }
 
+// GetReusableDelegationSetWithContext is the same as GetReusableDelegationSet with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) GetReusableDelegationSetWithContext(ctx aws.Context, input *GetReusableDelegationSetInput, opts ...aws.Option) (*GetReusableDelegationSetOutput, error) {
+	req, out := c.GetReusableDelegationSetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListGeoLocations = "ListGeoLocations"
 
 // ListGeoLocationsRequest generates a request for the ListGeoLocations operation.
@@ -717,6 +957,18 @@ func (c *Route53) ListGeoLocations(input *ListGeoLocationsInput) (*ListGeoLocati
 	return out, err
 }
 
+// ListGeoLocationsWithContext is the same as ListGeoLocations with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListGeoLocationsWithContext(ctx aws.Context, input *ListGeoLocationsInput, opts ...aws.Option) (*ListGeoLocationsOutput, error) {
+	req, out := c.ListGeoLocationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListHealthChecks = "ListHealthChecks"
 
 // ListHealthChecksRequest generates a request for the ListHealthChecks operation.
@@ -730,6 +982,7 @@ func (c *Route53) ListHealthChecksRequest(input *ListHealthChecksInput) (req *aw
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"HealthChecks"},
 		},
 	}
 
@@ -758,6 +1011,22 @@ func (c *Route53) ListHealthChecks(input *ListHealthChecksInput) (*ListHealthChe
 	return out, err
 }
 
+// ListHealthChecksWithContext is the same as ListHealthChecks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListHealthChecksWithContext(ctx aws.Context, input *ListHealthChecksInput, opts ...aws.Option) (*ListHealthChecksOutput, error) {
+	req, out := c.ListHealthChecksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListHealthChecksPages iterates over the pages of a ListHealthChecks operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Route53) ListHealthChecksPages(input *ListHealthChecksInput, fn func(p *ListHealthChecksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListHealthChecksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -765,6 +1034,19 @@ func (c *Route53) ListHealthChecksPages(input *ListHealthChecksInput, fn func(p
 	})
 }
 
+// ListHealthChecksPagesWithContext is the same as ListHealthChecksPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Route53) ListHealthChecksPagesWithContext(ctx aws.Context, input *ListHealthChecksInput, fn func(p *ListHealthChecksOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListHealthChecksRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListHealthChecksOutput), lastPage)
+	})
+}
+
 const opListHostedZones = "ListHostedZones"
 
 // ListHostedZonesRequest generates a request for the ListHostedZones operation.
@@ -778,6 +1060,7 @@ func (c *Route53) ListHostedZonesRequest(input *ListHostedZonesInput) (req *aws.
 			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"HostedZones"},
 		},
 	}
 
@@ -806,6 +1089,22 @@ func (c *Route53) ListHostedZones(input *ListHostedZonesInput) (*ListHostedZones
 	return out, err
 }
 
+// ListHostedZonesWithContext is the same as ListHostedZones with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListHostedZonesWithContext(ctx aws.Context, input *ListHostedZonesInput, opts ...aws.Option) (*ListHostedZonesOutput, error) {
+	req, out := c.ListHostedZonesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListHostedZonesPages iterates over the pages of a ListHostedZones operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Route53) ListHostedZonesPages(input *ListHostedZonesInput, fn func(p *ListHostedZonesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListHostedZonesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -813,6 +1112,19 @@ func (c *Route53) ListHostedZonesPages(input *ListHostedZonesInput, fn func(p *L
 	})
 }
 
+// ListHostedZonesPagesWithContext is the same as ListHostedZonesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Route53) ListHostedZonesPagesWithContext(ctx aws.Context, input *ListHostedZonesInput, fn func(p *ListHostedZonesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListHostedZonesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListHostedZonesOutput), lastPage)
+	})
+}
+
 const opListHostedZonesByName = "ListHostedZonesByName"
 
 // ListHostedZonesByNameRequest generates a request for the ListHostedZonesByName operation.
@@ -850,6 +1162,18 @@ func (c *Route53) ListHostedZonesByName(input *ListHostedZonesByNameInput) (*Lis
 	return out, err
 }
 
+// ListHostedZonesByNameWithContext is the same as ListHostedZonesByName with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListHostedZonesByNameWithContext(ctx aws.Context, input *ListHostedZonesByNameInput, opts ...aws.Option) (*ListHostedZonesByNameOutput, error) {
+	req, out := c.ListHostedZonesByNameRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListResourceRecordSets = "ListResourceRecordSets"
 
 // ListResourceRecordSetsRequest generates a request for the ListResourceRecordSets operation.
@@ -863,6 +1187,7 @@ func (c *Route53) ListResourceRecordSetsRequest(input *ListResourceRecordSetsInp
 			OutputTokens:    []string{"NextRecordName", "NextRecordType", "NextRecordIdentifier"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
+			ResultKeys:      []string{"ResourceRecordSets"},
 		},
 	}
 
@@ -920,6 +1245,22 @@ func (c *Route53) ListResourceRecordSets(input *ListResourceRecordSetsInput) (*L
 	return out, err
 }
 
+// ListResourceRecordSetsWithContext is the same as ListResourceRecordSets with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListResourceRecordSetsWithContext(ctx aws.Context, input *ListResourceRecordSetsInput, opts ...aws.Option) (*ListResourceRecordSetsOutput, error) {
+	req, out := c.ListResourceRecordSetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListResourceRecordSetsPages iterates over the pages of a ListResourceRecordSets operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Route53) ListResourceRecordSetsPages(input *ListResourceRecordSetsInput, fn func(p *ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListResourceRecordSetsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -927,6 +1268,19 @@ func (c *Route53) ListResourceRecordSetsPages(input *ListResourceRecordSetsInput
 	})
 }
 
+// ListResourceRecordSetsPagesWithContext is the same as ListResourceRecordSetsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Route53) ListResourceRecordSetsPagesWithContext(ctx aws.Context, input *ListResourceRecordSetsInput, fn func(p *ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListResourceRecordSetsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListResourceRecordSetsOutput), lastPage)
+	})
+}
+
 const opListReusableDelegationSets = "ListReusableDelegationSets"
 
 // ListReusableDelegationSetsRequest generates a request for the ListReusableDelegationSets operation.
@@ -963,6 +1317,18 @@ func (c *Route53) ListReusableDelegationSets(input *ListReusableDelegationSetsIn
 	return out, err
 }
 
+// ListReusableDelegationSetsWithContext is the same as ListReusableDelegationSets with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListReusableDelegationSetsWithContext(ctx aws.Context, input *ListReusableDelegationSetsInput, opts ...aws.Option) (*ListReusableDelegationSetsOutput, error) {
+	req, out := c.ListReusableDelegationSetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListTagsForResource = "ListTagsForResource"
 
 // ListTagsForResourceRequest generates a request for the ListTagsForResource operation.
@@ -989,6 +1355,18 @@ func (c *Route53) ListTagsForResource(input *ListTagsForResourceInput) (*ListTag
 	return out, err
 }
 
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...aws.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListTagsForResources = "ListTagsForResources"
 
 // ListTagsForResourcesRequest generates a request for the ListTagsForResources operation.
@@ -1015,6 +1393,18 @@ func (c *Route53) ListTagsForResources(input *ListTagsForResourcesInput) (*ListT
 	return out, err
 }
 
+// ListTagsForResourcesWithContext is the same as ListTagsForResources with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) ListTagsForResourcesWithContext(ctx aws.Context, input *ListTagsForResourcesInput, opts ...aws.Option) (*ListTagsForResourcesOutput, error) {
+	req, out := c.ListTagsForResourcesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateHealthCheck = "UpdateHealthCheck"
 
 // UpdateHealthCheckRequest generates a request for the UpdateHealthCheck operation.
@@ -1047,6 +1437,18 @@ func (c *Route53) UpdateHealthCheck(input *UpdateHealthCheckInput) (*UpdateHealt
 	return out, err
 }
 
+// UpdateHealthCheckWithContext is the same as UpdateHealthCheck with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) UpdateHealthCheckWithContext(ctx aws.Context, input *UpdateHealthCheckInput, opts ...aws.Option) (*UpdateHealthCheckOutput, error) {
+	req, out := c.UpdateHealthCheckRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateHostedZoneComment = "UpdateHostedZoneComment"
 
 // UpdateHostedZoneCommentRequest generates a request for the UpdateHostedZoneComment operation.
@@ -1078,6 +1480,18 @@ func (c *Route53) UpdateHostedZoneComment(input *UpdateHostedZoneCommentInput) (
 	return out, err
 }
 
+// UpdateHostedZoneCommentWithContext is the same as UpdateHostedZoneComment with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53) UpdateHostedZoneCommentWithContext(ctx aws.Context, input *UpdateHostedZoneCommentInput, opts ...aws.Option) (*UpdateHostedZoneCommentOutput, error) {
+	req, out := c.UpdateHostedZoneCommentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Alias resource record sets only: Information about the domain to which you
 // are redirecting traffic.
 //
@@ -1095,7 +1509,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	DNSName *string `type:"string" required:"true"`
+	DNSName *string `type:"string" max:"1024" required:"true"`
 
 	// Alias resource record sets only: A boolean value that indicates whether this
 	// Resource Record Set should respect the health status of any health checks
@@ -1116,7 +1530,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string" required:"true"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32" required:"true"`
 
 	metadataAliasTarget `json:"-" xml:"-"`
 }
@@ -1145,7 +1559,7 @@ type AssociateVPCWithHostedZoneInput struct {
 	//
 	// Note that you cannot associate a VPC with a hosted zone that doesn't have
 	// an existing VPC association.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1223,10 +1637,10 @@ func (s Change) GoString() string {
 type ChangeBatch struct {
 	// A complex type that contains one Change element for each resource record
 	// set that you want to create or delete.
-	Changes []*Change `locationNameList:"Change" type:"list" required:"true"`
+	Changes []*Change `locationNameList:"Change" type:"list" min:"1" required:"true"`
 
 	// Optional: Any comments you want to include about a change batch request.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	metadataChangeBatch `json:"-" xml:"-"`
 }
@@ -1256,11 +1670,11 @@ type ChangeInfo struct {
 	//
 	// This element contains an ID that you use when performing a GetChange action
 	// to get detailed information about the change.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the request. Use this ID to track when the change has completed
 	// across all Amazon Route 53 DNS servers.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The current state of the request. PENDING indicates that this request has
 	// not yet been applied to all Amazon Route 53 DNS servers.
@@ -1298,7 +1712,7 @@ type ChangeResourceRecordSetsInput struct {
 
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to change.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataChangeResourceRecordSetsInput `json:"-" xml:"-"`
 }
@@ -1348,13 +1762,13 @@ func (s ChangeResourceRecordSetsOutput) GoString() string {
 type ChangeTagsForResourceInput struct {
 	// A complex type that contains a list of Tag elements. Each Tag element identifies
 	// a tag that you want to add or update for the specified resource.
-	AddTags []*Tag `locationNameList:"Tag" type:"list"`
+	AddTags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	// A list of Tag keys that you want to remove from the specified resource.
-	RemoveTagKeys []*string `locationNameList:"Key" type:"list"`
+	RemoveTagKeys []*string `locationNameList:"Key" type:"list" min:"1" max:"10"`
 
 	// The ID of the resource for which you want to add, change, or delete tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
@@ -1410,7 +1824,7 @@ type CreateHealthCheckInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -1438,7 +1852,7 @@ type CreateHealthCheckOutput struct {
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
 
 	// The unique URL representing the new health check.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateHealthCheckOutput `json:"-" xml:"-"`
 }
@@ -1468,11 +1882,11 @@ type CreateHostedZoneInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The delegation set id of the reusable delgation set whose NS records you
 	// want to assign to the new hosted zone.
-	DelegationSetID *string `locationName:"DelegationSetId" type:"string"`
+	DelegationSetID *string `locationName:"DelegationSetId" type:"string" max:"32"`
 
 	// A complex type that contains an optional comment about your hosted zone.
 	HostedZoneConfig *HostedZoneConfig `type:"structure"`
@@ -1485,7 +1899,7 @@ type CreateHostedZoneInput struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with. By providing
 	// this parameter, your newly created hosted cannot be resolved anywhere other
@@ -1523,7 +1937,7 @@ type CreateHostedZoneOutput struct {
 	HostedZone *HostedZone `type:"structure" required:"true"`
 
 	// The unique URL representing the new hosted zone.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	VPC *VPC `type:"structure"`
 
@@ -1553,11 +1967,11 @@ type CreateReusableDelegationSetInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The ID of the hosted zone whose delegation set you want to mark as reusable.
 	// It is an optional parameter.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	metadataCreateReusableDelegationSetInput `json:"-" xml:"-"`
 }
@@ -1581,7 +1995,7 @@ type CreateReusableDelegationSetOutput struct {
 	DelegationSet *DelegationSet `type:"structure" required:"true"`
 
 	// The unique URL representing the new reusbale delegation set.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateReusableDelegationSetOutput `json:"-" xml:"-"`
 }
@@ -1602,14 +2016,14 @@ func (s CreateReusableDelegationSetOutput) GoString() string {
 
 // A complex type that contains name server information.
 type DelegationSet struct {
-	CallerReference *string `type:"string"`
+	CallerReference *string `type:"string" min:"1" max:"128"`
 
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" max:"32"`
 
 	// A complex type that contains the authoritative name servers for the hosted
 	// zone. Use the method provided by your domain registrar to add an NS record
 	// to your domain for each NameServer that is assigned to your hosted zone.
-	NameServers []*string `locationNameList:"NameServer" type:"list" required:"true"`
+	NameServers []*string `locationNameList:"NameServer" type:"list" min:"1" required:"true"`
 
 	metadataDelegationSet `json:"-" xml:"-"`
 }
@@ -1631,7 +2045,7 @@ func (s DelegationSet) GoString() string {
 // A complex type containing the request information for delete health check.
 type DeleteHealthCheckInput struct {
 	// The ID of the health check to delete.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataDeleteHealthCheckInput `json:"-" xml:"-"`
 }
@@ -1673,7 +2087,7 @@ func (s DeleteHealthCheckOutput) GoString() string {
 // to delete.
 type DeleteHostedZoneInput struct {
 	// The ID of the hosted zone you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteHostedZoneInput `json:"-" xml:"-"`
 }
@@ -1718,7 +2132,7 @@ func (s DeleteHostedZoneOutput) GoString() string {
 // A complex type containing the information for the delete request.
 type DeleteReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteReusableDelegationSetInput `json:"-" xml:"-"`
 }
@@ -1765,7 +2179,7 @@ type DisassociateVPCFromHostedZoneInput struct {
 	// The ID of the hosted zone you want to disassociate your VPC from.
 	//
 	// Note that you cannot disassociate the last VPC from a hosted zone.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be disassociated from.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1819,21 +2233,21 @@ type GeoLocation struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataGeoLocation `json:"-" xml:"-"`
 }
@@ -1856,30 +2270,30 @@ func (s GeoLocation) GoString() string {
 type GeoLocationDetails struct {
 	// The code for a continent geo location. Note: only continent locations have
 	// a continent code.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The name of the continent. This element is only present if ContinentCode
 	// is also present.
-	ContinentName *string `type:"string"`
+	ContinentName *string `type:"string" min:"1" max:"32"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The name of the country. This element is only present if CountryCode is also
 	// present.
-	CountryName *string `type:"string"`
+	CountryName *string `type:"string" min:"1" max:"64"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	// The name of the subdivision. This element is only present if SubdivisionCode
 	// is also present.
-	SubdivisionName *string `type:"string"`
+	SubdivisionName *string `type:"string" min:"1" max:"64"`
 
 	metadataGeoLocationDetails `json:"-" xml:"-"`
 }
@@ -1903,7 +2317,7 @@ type GetChangeInput struct {
 	// The ID of the change batch request. The value that you specify here is the
 	// value that ChangeResourceRecordSets returned in the Id element when you submitted
 	// the request.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetChangeInput `json:"-" xml:"-"`
 }
@@ -1997,21 +2411,21 @@ type GetGeoLocationInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string"`
+	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string"`
+	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string"`
+	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataGetGeoLocationInput `json:"-" xml:"-"`
 }
@@ -2099,7 +2513,7 @@ func (s GetHealthCheckCountOutput) GoString() string {
 // check.
 type GetHealthCheckInput struct {
 	// The ID of the health check to retrieve.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckInput `json:"-" xml:"-"`
 }
@@ -2123,7 +2537,7 @@ func (s GetHealthCheckInput) GoString() string {
 type GetHealthCheckLastFailureReasonInput struct {
 	// The ID of the health check for which you want to retrieve the reason for
 	// the most recent failure.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckLastFailureReasonInput `json:"-" xml:"-"`
 }
@@ -2193,7 +2607,7 @@ func (s GetHealthCheckOutput) GoString() string {
 type GetHealthCheckStatusInput struct {
 	// The ID of the health check for which you want to retrieve the most recent
 	// status.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckStatusInput `json:"-" xml:"-"`
 }
@@ -2283,7 +2697,7 @@ func (s GetHostedZoneCountOutput) GoString() string {
 type GetHostedZoneInput struct {
 	// The ID of the hosted zone for which you want to get a list of the name servers
 	// in the delegation set.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetHostedZoneInput `json:"-" xml:"-"`
 }
@@ -2313,7 +2727,7 @@ type GetHostedZoneOutput struct {
 
 	// A complex type that contains information about VPCs associated with the specified
 	// hosted zone.
-	VPCs []*VPC `locationNameList:"VPC" type:"list"`
+	VPCs []*VPC `locationNameList:"VPC" type:"list" min:"1"`
 
 	metadataGetHostedZoneOutput `json:"-" xml:"-"`
 }
@@ -2336,7 +2750,7 @@ func (s GetHostedZoneOutput) GoString() string {
 type GetReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set for which you want to get a list of
 	// the name server.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetReusableDelegationSetInput `json:"-" xml:"-"`
 }
@@ -2382,7 +2796,7 @@ func (s GetReusableDelegationSetOutput) GoString() string {
 // A complex type that contains identifying information about the health check.
 type HealthCheck struct {
 	// A unique string that identifies the request to create the health check.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains the health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -2390,10 +2804,10 @@ type HealthCheck struct {
 	// The version of the health check. You can optionally pass this value in a
 	// call to UpdateHealthCheck to prevent overwriting another change to the health
 	// check.
-	HealthCheckVersion *int64 `type:"long" required:"true"`
+	HealthCheckVersion *int64 `type:"long" min:"1" required:"true"`
 
 	// The ID of the specified health check.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"64" required:"true"`
 
 	metadataHealthCheck `json:"-" xml:"-"`
 }
@@ -2421,34 +2835,34 @@ type HealthCheckConfig struct {
 	// Valid values are integers between 1 and 10. For more information, see "How
 	// Amazon Route 53 Determines Whether an Endpoint Is Healthy" in the Amazon
 	// Route 53 Developer Guide.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// IP Address of the instance being checked.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15"`
 
 	// Port on which connection will be opened to the instance to health check.
 	// For HTTP and HTTP_STR_MATCH this defaults to 80 if the port is not specified.
 	// For HTTPS and HTTPS_STR_MATCH this defaults to 443 if the port is not specified.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The number of seconds between the time that Route 53 gets a response from
 	// your endpoint and the time that it sends the next health-check request.
 	//
 	// Each Route 53 health checker makes requests at this interval. Valid values
 	// are 10 and 30. The default value is 30.
-	RequestInterval *int64 `type:"integer"`
+	RequestInterval *int64 `type:"integer" min:"10" max:"30"`
 
 	// Path to ping on the instance to check the health. Required for HTTP, HTTPS,
 	// HTTP_STR_MATCH, and HTTPS_STR_MATCH health checks, HTTP request is issued
 	// to the instance on the given port and path.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// A string to search for in the body of a health check response. Required for
 	// HTTP_STR_MATCH and HTTPS_STR_MATCH health checks.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	// The type of health check to be performed. Currently supported types are TCP,
 	// HTTP, HTTPS, HTTP_STR_MATCH, and HTTPS_STR_MATCH.
@@ -2475,7 +2889,7 @@ func (s HealthCheckConfig) GoString() string {
 // and the reason for the health check status.
 type HealthCheckObservation struct {
 	// The IP address of the Route 53 health checker that performed the health check.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15"`
 
 	// A complex type that contains information about the health check status for
 	// the current observation.
@@ -2501,13 +2915,13 @@ func (s HealthCheckObservation) GoString() string {
 // A complex type that contain information about the specified hosted zone.
 type HostedZone struct {
 	// A unique string that identifies the request to create the hosted zone.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A complex type that contains the Comment element.
 	Config *HostedZoneConfig `type:"structure"`
 
 	// The ID of the specified hosted zone.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The name of the domain. This must be a fully-specified domain, for example,
 	// www.example.com. The trailing dot is optional; Route 53 assumes that the
@@ -2517,7 +2931,7 @@ type HostedZone struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Total number of resource record sets in the hosted zone.
 	ResourceRecordSetCount *int64 `type:"long"`
@@ -2546,7 +2960,7 @@ type HostedZoneConfig struct {
 	// An optional comment about your hosted zone. If you don't want to specify
 	// a comment, you can omit the HostedZoneConfig and Comment elements from the
 	// XML document.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// A value that indicates whether this is a private hosted zone. The value is
 	// returned in the response; do not specify it in the request.
@@ -2582,21 +2996,21 @@ type ListGeoLocationsInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string"`
+	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string" min:"2" max:"2"`
 
 	// The first country code in the lexicographic ordering of geo locations that
 	// you want the ListGeoLocations request to list.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string"`
+	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string" min:"1" max:"2"`
 
 	// The first subdivision code in the lexicographic ordering of geo locations
 	// that you want the ListGeoLocations request to list.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string"`
+	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsInput `json:"-" xml:"-"`
 }
@@ -2638,17 +3052,17 @@ type ListGeoLocationsOutput struct {
 	// If the results were truncated, the continent code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is a continent location.
-	NextContinentCode *string `type:"string"`
+	NextContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// If the results were truncated, the country code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is not a continent location.
-	NextCountryCode *string `type:"string"`
+	NextCountryCode *string `type:"string" min:"1" max:"2"`
 
 	// If the results were truncated, the subdivision code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location has a subdivision.
-	NextSubdivisionCode *string `type:"string"`
+	NextSubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsOutput `json:"-" xml:"-"`
 }
@@ -2680,7 +3094,7 @@ type ListHealthChecksInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of health checks to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2718,7 +3132,7 @@ type ListHealthChecksOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of health checks to be included in the response body.
 	// If the number of health checks associated with this AWS account exceeds MaxItems,
@@ -2730,7 +3144,7 @@ type ListHealthChecksOutput struct {
 	// Indicates where to continue listing health checks. If ListHealthChecksResponse$IsTruncated
 	// is true, make another request to ListHealthChecks and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHealthChecksOutput `json:"-" xml:"-"`
 }
@@ -2768,12 +3182,12 @@ type ListHostedZonesByNameInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	DNSName *string `location:"querystring" locationName:"dnsname" type:"string"`
+	DNSName *string `location:"querystring" locationName:"dnsname" type:"string" max:"1024"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string"`
+	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string" max:"32"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2798,10 +3212,10 @@ func (s ListHostedZonesByNameInput) GoString() string {
 // A complex type that contains the response for the request.
 type ListHostedZonesByNameOutput struct {
 	// The DNSName value sent in the request.
-	DNSName *string `type:"string"`
+	DNSName *string `type:"string" max:"1024"`
 
 	// The HostedZoneId value sent in the request.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	// A complex type that contains information about the hosted zones associated
 	// with the current AWS account.
@@ -2827,14 +3241,14 @@ type ListHostedZonesByNameOutput struct {
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextDNSName *string `type:"string"`
+	NextDNSName *string `type:"string" max:"1024"`
 
 	// If ListHostedZonesByNameResponse$IsTruncated is true, there are more hosted
 	// zones associated with the current AWS account. To get the next page of results,
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string"`
+	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string" max:"32"`
 
 	metadataListHostedZonesByNameOutput `json:"-" xml:"-"`
 }
@@ -2866,12 +3280,12 @@ func (s ListHostedZonesByNameOutput) GoString() string {
 //  Route 53 returns a maximum of 100 items. If you set MaxItems to a value
 // greater than 100, Route 53 returns only the first 100.
 type ListHostedZonesInput struct {
-	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string"`
+	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string" max:"32"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2909,7 +3323,7 @@ type ListHostedZonesOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of hosted zones to be included in the response body. If
 	// the number of hosted zones associated with this AWS account exceeds MaxItems,
@@ -2921,7 +3335,7 @@ type ListHostedZonesOutput struct {
 	// Indicates where to continue listing hosted zones. If ListHostedZonesResponse$IsTruncated
 	// is true, make another request to ListHostedZones and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHostedZonesOutput `json:"-" xml:"-"`
 }
@@ -2944,7 +3358,7 @@ func (s ListHostedZonesOutput) GoString() string {
 type ListResourceRecordSetsInput struct {
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to get.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The maximum number of records you want in the response body.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2953,11 +3367,11 @@ type ListResourceRecordSetsInput struct {
 	// DNS name and type, specify the value of ListResourceRecordSetsResponse$NextRecordIdentifier
 	// from the previous response to get the next resource record set that has the
 	// current DNS name and type.
-	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string"`
+	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string" min:"1" max:"128"`
 
 	// The first name in the lexicographic ordering of domain names that you want
 	// the ListResourceRecordSets request to list.
-	StartRecordName *string `location:"querystring" locationName:"name" type:"string"`
+	StartRecordName *string `location:"querystring" locationName:"name" type:"string" max:"1024"`
 
 	// The DNS type at which to begin the listing of resource record sets.
 	//
@@ -3008,12 +3422,12 @@ type ListResourceRecordSetsOutput struct {
 	// Weighted resource record sets only: If results were truncated for a given
 	// DNS name and type, the value of SetIdentifier for the next resource record
 	// set that has the current DNS name and type.
-	NextRecordIdentifier *string `type:"string"`
+	NextRecordIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// If the results were truncated, the name of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
 	// true.
-	NextRecordName *string `type:"string"`
+	NextRecordName *string `type:"string" max:"1024"`
 
 	// If the results were truncated, the type of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
@@ -3055,7 +3469,7 @@ type ListReusableDelegationSetsInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of reusable delegation sets to return per page
 	// of results.
@@ -3094,7 +3508,7 @@ type ListReusableDelegationSetsOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of reusable delegation sets to be included in the response
 	// body. If the number of reusable delegation sets associated with this AWS
@@ -3108,7 +3522,7 @@ type ListReusableDelegationSetsOutput struct {
 	// is true, make another request to ListReusableDelegationSets and include the
 	// value of the NextMarker element in the Marker element to get the next page
 	// of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListReusableDelegationSetsOutput `json:"-" xml:"-"`
 }
@@ -3131,7 +3545,7 @@ func (s ListReusableDelegationSetsOutput) GoString() string {
 // that are associated with an individual resource.
 type ListTagsForResourceInput struct {
 	// The ID of the resource for which you want to retrieve tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
@@ -3184,7 +3598,7 @@ func (s ListTagsForResourceOutput) GoString() string {
 type ListTagsForResourcesInput struct {
 	// A complex type that contains the ResourceId element for each resource for
 	// which you want to get a list of tags.
-	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" required:"true"`
+	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" min:"1" max:"10" required:"true"`
 
 	// The type of the resources.
 	//
@@ -3236,7 +3650,7 @@ func (s ListTagsForResourcesOutput) GoString() string {
 // resource record set.
 type ResourceRecord struct {
 	// The value of the Value element for the current resource record set.
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"4000" required:"true"`
 
 	metadataResourceRecord `json:"-" xml:"-"`
 }
@@ -3288,27 +3702,27 @@ type ResourceRecordSet struct {
 	// Health Check resource record sets only, not required for alias resource record
 	// sets: An identifier that is used to identify health check associated with
 	// the resource record set.
-	HealthCheckID *string `locationName:"HealthCheckId" type:"string"`
+	HealthCheckID *string `locationName:"HealthCheckId" type:"string" max:"64"`
 
 	// The domain name of the current resource record set.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Latency-based resource record sets only: Among resource record sets that
 	// have the same combination of DNS name and type, a value that specifies the
 	// AWS region for the current resource record set.
-	Region *string `type:"string"`
+	Region *string `type:"string" min:"1" max:"64"`
 
 	// A complex type that contains the resource records for the current resource
 	// record set.
-	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list"`
+	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list" min:"1"`
 
 	// Weighted, Latency, Geo, and Failover resource record sets only: An identifier
 	// that differentiates among multiple resource record sets that have the same
 	// combination of DNS name and type.
-	SetIdentifier *string `type:"string"`
+	SetIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// The cache time to live for the current resource record set.
-	TTL *int64 `type:"long"`
+	TTL *int64 `type:"long" min:"0" max:"2147483647"`
 
 	// The type of the current resource record set.
 	Type *string `type:"string" required:"true"`
@@ -3317,7 +3731,7 @@ type ResourceRecordSet struct {
 	// the same combination of DNS name and type, a value that determines what portion
 	// of traffic for the current resource record set is routed to the associated
 	// location.
-	Weight *int64 `type:"long"`
+	Weight *int64 `type:"long" min:"0" max:"255"`
 
 	metadataResourceRecordSet `json:"-" xml:"-"`
 }
@@ -3339,7 +3753,7 @@ func (s ResourceRecordSet) GoString() string {
 // A complex type containing a resource and its associated tags.
 type ResourceTagSet struct {
 	// The ID for the specified resource.
-	ResourceID *string `locationName:"ResourceId" type:"string"`
+	ResourceID *string `locationName:"ResourceId" type:"string" max:"64"`
 
 	// The type of the resource.
 	//
@@ -3349,7 +3763,7 @@ type ResourceTagSet struct {
 	ResourceType *string `type:"string"`
 
 	// The tags associated with the specified resource.
-	Tags []*Tag `locationNameList:"Tag" type:"list"`
+	Tags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	metadataResourceTagSet `json:"-" xml:"-"`
 }
@@ -3400,10 +3814,10 @@ func (s StatusReport) GoString() string {
 // A single tag containing a key and value.
 type Tag struct {
 	// The key for a Tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" max:"128"`
 
 	// The value for a Tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -3434,32 +3848,32 @@ type UpdateHealthCheckInput struct {
 	// Route 53 Developer Guide.
 	//
 	// Specify this value only if you want to change it.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
 	//
 	// Specify this value only if you want to change it.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// The ID of the health check to update.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	// Optional. When you specify a health check version, Route 53 compares this
 	// value with the current value in the health check, which prevents you from
 	// updating the health check when the versions don't match. Using HealthCheckVersion
 	// lets you prevent overwriting another change to the health check.
-	HealthCheckVersion *int64 `type:"long"`
+	HealthCheckVersion *int64 `type:"long" min:"1"`
 
 	// The IP address of the resource that you want to check.
 	//
 	// Specify this value only if you want to change it.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15"`
 
 	// The port on which you want Route 53 to open a connection to perform health
 	// checks.
 	//
 	// Specify this value only if you want to change it.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The path that you want Amazon Route 53 to request when performing health
 	// checks. The path can be any value for which your endpoint will return an
@@ -3467,7 +3881,7 @@ type UpdateHealthCheckInput struct {
 	// the file /docs/route53-health-check.html.
 	//
 	// Specify this value only if you want to change it.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// If the value of Type is HTTP_STR_MATCH or HTTP_STR_MATCH, the string that
 	// you want Route 53 to search for in the response body from the specified resource.
@@ -3475,7 +3889,7 @@ type UpdateHealthCheckInput struct {
 	// healthy.
 	//
 	// Specify this value only if you want to change it.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	metadataUpdateHealthCheckInput `json:"-" xml:"-"`
 }
@@ -3519,10 +3933,10 @@ func (s UpdateHealthCheckOutput) GoString() string {
 // zone comment.
 type UpdateHostedZoneCommentInput struct {
 	// A comment about your hosted zone.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the hosted zone you want to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataUpdateHostedZoneCommentInput `json:"-" xml:"-"`
 }
@@ -3566,9 +3980,9 @@ func (s UpdateHostedZoneCommentOutput) GoString() string {
 
 type VPC struct {
 	// A VPC ID
-	VPCID *string `locationName:"VPCId" type:"string"`
+	VPCID *string `locationName:"VPCId" type:"string" max:"1024"`
 
-	VPCRegion *string `type:"string"`
+	VPCRegion *string `type:"string" min:"1" max:"64"`
 
 	metadataVPC `json:"-" xml:"-"`
 }
@@ -0,0 +1,204 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package route53
+
+const (
+	// ErrCodeConflictingDomainExists for service response error code
+	// "ConflictingDomainExists".
+	ErrCodeConflictingDomainExists = "ConflictingDomainExists"
+
+	// ErrCodeDelegationSetAlreadyCreated for service response error code
+	// "DelegationSetAlreadyCreated".
+	//
+	// A delegation set with the same owner and caller reference combination has
+	// already been created.
+	ErrCodeDelegationSetAlreadyCreated = "DelegationSetAlreadyCreated"
+
+	// ErrCodeDelegationSetAlreadyReusable for service response error code
+	// "DelegationSetAlreadyReusable".
+	//
+	// The specified delegation set has already been marked as reusable.
+	ErrCodeDelegationSetAlreadyReusable = "DelegationSetAlreadyReusable"
+
+	// ErrCodeDelegationSetInUse for service response error code
+	// "DelegationSetInUse".
+	//
+	// The specified delegation contains associated hosted zones which must be deleted
+	// before the reusable delegation set can be deleted.
+	ErrCodeDelegationSetInUse = "DelegationSetInUse"
+
+	// ErrCodeDelegationSetNotAvailable for service response error code
+	// "DelegationSetNotAvailable".
+	//
+	// Route 53 allows some duplicate domain names, but there is a maximum number
+	// of duplicate names. This error indicates that you have reached that maximum.
+	// If you want to create another hosted zone with the same name and Route 53
+	// generates this error, you can request an increase to the limit on the Contact
+	// Us (http://aws.amazon.com/route53-request/) page.
+	ErrCodeDelegationSetNotAvailable = "DelegationSetNotAvailable"
+
+	// ErrCodeDelegationSetNotReusable for service response error code
+	// "DelegationSetNotReusable".
+	//
+	// The specified delegation set has not been marked as reusable.
+	ErrCodeDelegationSetNotReusable = "DelegationSetNotReusable"
+
+	// ErrCodeHealthCheckAlreadyExists for service response error code
+	// "HealthCheckAlreadyExists".
+	//
+	// The health check you are trying to create already exists. Route 53 returns
+	// this error when a health check has already been created with the specified
+	// CallerReference.
+	ErrCodeHealthCheckAlreadyExists = "HealthCheckAlreadyExists"
+
+	// ErrCodeHealthCheckInUse for service response error code
+	// "HealthCheckInUse".
+	//
+	// There are resource records associated with this health check. Before you
+	// can delete the health check, you must disassociate it from the resource record
+	// sets.
+	ErrCodeHealthCheckInUse = "HealthCheckInUse"
+
+	// ErrCodeHealthCheckVersionMismatch for service response error code
+	// "HealthCheckVersionMismatch".
+	ErrCodeHealthCheckVersionMismatch = "HealthCheckVersionMismatch"
+
+	// ErrCodeHostedZoneAlreadyExists for service response error code
+	// "HostedZoneAlreadyExists".
+	//
+	// The hosted zone you are trying to create already exists. Route 53 returns
+	// this error when a hosted zone has already been created with the specified
+	// CallerReference.
+	ErrCodeHostedZoneAlreadyExists = "HostedZoneAlreadyExists"
+
+	// ErrCodeHostedZoneNotEmpty for service response error code
+	// "HostedZoneNotEmpty".
+	//
+	// The hosted zone contains resource record sets in addition to the default
+	// NS and SOA resource record sets. Before you can delete the hosted zone, you
+	// must delete the additional resource record sets.
+	ErrCodeHostedZoneNotEmpty = "HostedZoneNotEmpty"
+
+	// ErrCodeHostedZoneNotFound for service response error code
+	// "HostedZoneNotFound".
+	//
+	// The specified HostedZone cannot be found.
+	ErrCodeHostedZoneNotFound = "HostedZoneNotFound"
+
+	// ErrCodeIncompatibleVersion for service response error code
+	// "IncompatibleVersion".
+	//
+	// The resource you are trying to access is unsupported on this Route 53 endpoint.
+	// Please consider using a newer endpoint or a tool that does so.
+	ErrCodeIncompatibleVersion = "IncompatibleVersion"
+
+	// ErrCodeInvalidArgument for service response error code
+	// "InvalidArgument".
+	//
+	// At least one of the specified arguments is invalid.
+	ErrCodeInvalidArgument = "InvalidArgument"
+
+	// ErrCodeInvalidChangeBatch for service response error code
+	// "InvalidChangeBatch".
+	//
+	// This error contains a list of one or more error messages. Each error message
+	// indicates one error in the change batch. For more information, see Example
+	// InvalidChangeBatch Errors (http://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html#example_Errors).
+	ErrCodeInvalidChangeBatch = "InvalidChangeBatch"
+
+	// ErrCodeInvalidDomainName for service response error code
+	// "InvalidDomainName".
+	//
+	// This error indicates that the specified domain name is not valid.
+	ErrCodeInvalidDomainName = "InvalidDomainName"
+
+	// ErrCodeInvalidInput for service response error code
+	// "InvalidInput".
+	//
+	// Some value specified in the request is invalid or the XML document is malformed.
+	ErrCodeInvalidInput = "InvalidInput"
+
+	// ErrCodeInvalidVPCID for service response error code
+	// "InvalidVPCId".
+	//
+	// The hosted zone you are trying to create for your VPC_ID does not belong
+	// to you. Route 53 returns this error when the VPC specified by VPCId does
+	// not belong to you.
+	ErrCodeInvalidVPCID = "InvalidVPCId"
+
+	// ErrCodeLastVPCAssociation for service response error code
+	// "LastVPCAssociation".
+	//
+	// The VPC you are trying to disassociate from the hosted zone is the last the
+	// VPC that is associated with the hosted zone. Route 53 currently doesn't support
+	// disassociate the last VPC from the hosted zone.
+	ErrCodeLastVPCAssociation = "LastVPCAssociation"
+
+	// ErrCodeLimitsExceeded for service response error code
+	// "LimitsExceeded".
+	//
+	// The limits specified for a resource have been exceeded.
+	ErrCodeLimitsExceeded = "LimitsExceeded"
+
+	// ErrCodeNoSuchChange for service response error code
+	// "NoSuchChange".
+	ErrCodeNoSuchChange = "NoSuchChange"
+
+	// ErrCodeNoSuchDelegationSet for service response error code
+	// "NoSuchDelegationSet".
+	//
+	// The specified delegation set does not exist.
+	ErrCodeNoSuchDelegationSet = "NoSuchDelegationSet"
+
+	// ErrCodeNoSuchGeoLocation for service response error code
+	// "NoSuchGeoLocation".
+	//
+	// The geo location you are trying to get does not exist.
+	ErrCodeNoSuchGeoLocation = "NoSuchGeoLocation"
+
+	// ErrCodeNoSuchHealthCheck for service response error code
+	// "NoSuchHealthCheck".
+	//
+	// The health check you are trying to get or delete does not exist.
+	ErrCodeNoSuchHealthCheck = "NoSuchHealthCheck"
+
+	// ErrCodeNoSuchHostedZone for service response error code
+	// "NoSuchHostedZone".
+	ErrCodeNoSuchHostedZone = "NoSuchHostedZone"
+
+	// ErrCodePriorRequestNotComplete for service response error code
+	// "PriorRequestNotComplete".
+	//
+	// The request was rejected because Route 53 was still processing a prior request.
+	ErrCodePriorRequestNotComplete = "PriorRequestNotComplete"
+
+	// ErrCodePublicZoneVPCAssociation for service response error code
+	// "PublicZoneVPCAssociation".
+	//
+	// The hosted zone you are trying to associate VPC with doesn't have any VPC
+	// association. Route 53 currently doesn't support associate a VPC with a public
+	// hosted zone.
+	ErrCodePublicZoneVPCAssociation = "PublicZoneVPCAssociation"
+
+	// ErrCodeThrottlingException for service response error code
+	// "ThrottlingException".
+	ErrCodeThrottlingException = "ThrottlingException"
+
+	// ErrCodeTooManyHealthChecks for service response error code
+	// "TooManyHealthChecks".
+	ErrCodeTooManyHealthChecks = "TooManyHealthChecks"
+
+	// ErrCodeTooManyHostedZones for service response error code
+	// "TooManyHostedZones".
+	//
+	// This error indicates that you've reached the maximum number of hosted zones
+	// that can be created for the current AWS account. You can request an increase
+	// to the limit on the Contact Us (http://aws.amazon.com/route53-request/) page.
+	ErrCodeTooManyHostedZones = "TooManyHostedZones"
+
+	// ErrCodeVPCAssociationNotFound for service response error code
+	// "VPCAssociationNotFound".
+	//
+	// The VPC you specified is not currently associated with the hosted zone.
+	ErrCodeVPCAssociationNotFound = "VPCAssociationNotFound"
+)
@@ -4,6 +4,7 @@
 package route53iface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 )
 
@@ -11,61 +12,133 @@ import (
 type Route53API interface {
 	AssociateVPCWithHostedZone(*route53.AssociateVPCWithHostedZoneInput) (*route53.AssociateVPCWithHostedZoneOutput, error)
 
+	AssociateVPCWithHostedZoneWithContext(aws.Context, *route53.AssociateVPCWithHostedZoneInput, ...aws.Option) (*route53.AssociateVPCWithHostedZoneOutput, error)
+
 	ChangeResourceRecordSets(*route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
 
+	ChangeResourceRecordSetsWithContext(aws.Context, *route53.ChangeResourceRecordSetsInput, ...aws.Option) (*route53.ChangeResourceRecordSetsOutput, error)
+
 	ChangeTagsForResource(*route53.ChangeTagsForResourceInput) (*route53.ChangeTagsForResourceOutput, error)
 
+	ChangeTagsForResourceWithContext(aws.Context, *route53.ChangeTagsForResourceInput, ...aws.Option) (*route53.ChangeTagsForResourceOutput, error)
+
 	CreateHealthCheck(*route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error)
 
+	CreateHealthCheckWithContext(aws.Context, *route53.CreateHealthCheckInput, ...aws.Option) (*route53.CreateHealthCheckOutput, error)
+
 	CreateHostedZone(*route53.CreateHostedZoneInput) (*route53.CreateHostedZoneOutput, error)
 
+	CreateHostedZoneWithContext(aws.Context, *route53.CreateHostedZoneInput, ...aws.Option) (*route53.CreateHostedZoneOutput, error)
+
 	CreateReusableDelegationSet(*route53.CreateReusableDelegationSetInput) (*route53.CreateReusableDelegationSetOutput, error)
 
+	CreateReusableDelegationSetWithContext(aws.Context, *route53.CreateReusableDelegationSetInput, ...aws.Option) (*route53.CreateReusableDelegationSetOutput, error)
+
 	DeleteHealthCheck(*route53.DeleteHealthCheckInput) (*route53.DeleteHealthCheckOutput, error)
 
+	DeleteHealthCheckWithContext(aws.Context, *route53.DeleteHealthCheckInput, ...aws.Option) (*route53.DeleteHealthCheckOutput, error)
+
 	DeleteHostedZone(*route53.DeleteHostedZoneInput) (*route53.DeleteHostedZoneOutput, error)
 
+	DeleteHostedZoneWithContext(aws.Context, *route53.DeleteHostedZoneInput, ...aws.Option) (*route53.DeleteHostedZoneOutput, error)
+
 	DeleteReusableDelegationSet(*route53.DeleteReusableDelegationSetInput) (*route53.DeleteReusableDelegationSetOutput, error)
 
+	DeleteReusableDelegationSetWithContext(aws.Context, *route53.DeleteReusableDelegationSetInput, ...aws.Option) (*route53.DeleteReusableDelegationSetOutput, error)
+
 	DisassociateVPCFromHostedZone(*route53.DisassociateVPCFromHostedZoneInput) (*route53.DisassociateVPCFromHostedZoneOutput, error)
 
+	DisassociateVPCFromHostedZoneWithContext(aws.Context, *route53.DisassociateVPCFromHostedZoneInput, ...aws.Option) (*route53.DisassociateVPCFromHostedZoneOutput, error)
+
 	GetChange(*route53.GetChangeInput) (*route53.GetChangeOutput, error)
 
+	GetChangeWithContext(aws.Context, *route53.GetChangeInput, ...aws.Option) (*route53.GetChangeOutput, error)
+
 	GetCheckerIPRanges(*route53.GetCheckerIPRangesInput) (*route53.GetCheckerIPRangesOutput, error)
 
+	GetCheckerIPRangesWithContext(aws.Context, *route53.GetCheckerIPRangesInput, ...aws.Option) (*route53.GetCheckerIPRangesOutput, error)
+
 	GetGeoLocation(*route53.GetGeoLocationInput) (*route53.GetGeoLocationOutput, error)
 
+	GetGeoLocationWithContext(aws.Context, *route53.GetGeoLocationInput, ...aws.Option) (*route53.GetGeoLocationOutput, error)
+
 	GetHealthCheck(*route53.GetHealthCheckInput) (*route53.GetHealthCheckOutput, error)
 
+	GetHealthCheckWithContext(aws.Context, *route53.GetHealthCheckInput, ...aws.Option) (*route53.GetHealthCheckOutput, error)
+
 	GetHealthCheckCount(*route53.GetHealthCheckCountInput) (*route53.GetHealthCheckCountOutput, error)
 
+	GetHealthCheckCountWithContext(aws.Context, *route53.GetHealthCheckCountInput, ...aws.Option) (*route53.GetHealthCheckCountOutput, error)
+
 	GetHealthCheckLastFailureReason(*route53.GetHealthCheckLastFailureReasonInput) (*route53.GetHealthCheckLastFailureReasonOutput, error)
 
+	GetHealthCheckLastFailureReasonWithContext(aws.Context, *route53.GetHealthCheckLastFailureReasonInput, ...aws.Option) (*route53.GetHealthCheckLastFailureReasonOutput, error)
+
 	GetHealthCheckStatus(*route53.GetHealthCheckStatusInput) (*route53.GetHealthCheckStatusOutput, error)
 
+	GetHealthCheckStatusWithContext(aws.Context, *route53.GetHealthCheckStatusInput, ...aws.Option) (*route53.GetHealthCheckStatusOutput, error)
+
 	GetHostedZone(*route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
 
+	GetHostedZoneWithContext(aws.Context, *route53.GetHostedZoneInput, ...aws.Option) (*route53.GetHostedZoneOutput, error)
+
 	GetHostedZoneCount(*route53.GetHostedZoneCountInput) (*route53.GetHostedZoneCountOutput, error)
 
+	GetHostedZoneCountWithContext(aws.Context, *route53.GetHostedZoneCountInput, ...aws.Option) (*route53.GetHostedZoneCountOutput, error)
+
 	GetReusableDelegationSet(*route53.GetReusableDelegationSetInput) (*route53.GetReusableDelegationSetOutput, error)
 
+	GetReusableDelegationSetWithContext(aws.Context, *route53.GetReusableDelegationSetInput, ...aws.Option) (*route53.GetReusableDelegationSetOutput, error)
+
 	ListGeoLocations(*route53.ListGeoLocationsInput) (*route53.ListGeoLocationsOutput, error)
 
+	ListGeoLocationsWithContext(aws.Context, *route53.ListGeoLocationsInput, ...aws.Option) (*route53.ListGeoLocationsOutput, error)
+
 	ListHealthChecks(*route53.ListHealthChecksInput) (*route53.ListHealthChecksOutput, error)
 
+	ListHealthChecksWithContext(aws.Context, *route53.ListHealthChecksInput, ...aws.Option) (*route53.ListHealthChecksOutput, error)
+
+	ListHealthChecksPages(*route53.ListHealthChecksInput, func(*route53.ListHealthChecksOutput, bool) bool) error
+
+	ListHealthChecksPagesWithContext(aws.Context, *route53.ListHealthChecksInput, func(*route53.ListHealthChecksOutput, bool) bool, ...aws.Option) error
+
 	ListHostedZones(*route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error)
 
+	ListHostedZonesWithContext(aws.Context, *route53.ListHostedZonesInput, ...aws.Option) (*route53.ListHostedZonesOutput, error)
+
+	ListHostedZonesPages(*route53.ListHostedZonesInput, func(*route53.ListHostedZonesOutput, bool) bool) error
+
+	ListHostedZonesPagesWithContext(aws.Context, *route53.ListHostedZonesInput, func(*route53.ListHostedZonesOutput, bool) bool, ...aws.Option) error
+
 	ListHostedZonesByName(*route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
 
+	ListHostedZonesByNameWithContext(aws.Context, *route53.ListHostedZonesByNameInput, ...aws.Option) (*route53.ListHostedZonesByNameOutput, error)
+
 	ListResourceRecordSets(*route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error)
 
+	ListResourceRecordSetsWithContext(aws.Context, *route53.ListResourceRecordSetsInput, ...aws.Option) (*route53.ListResourceRecordSetsOutput, error)
+
+	ListResourceRecordSetsPages(*route53.ListResourceRecordSetsInput, func(*route53.ListResourceRecordSetsOutput, bool) bool) error
+
+	ListResourceRecordSetsPagesWithContext(aws.Context, *route53.ListResourceRecordSetsInput, func(*route53.ListResourceRecordSetsOutput, bool) bool, ...aws.Option) error
+
 	ListReusableDelegationSets(*route53.ListReusableDelegationSetsInput) (*route53.ListReusableDelegationSetsOutput, error)
 
+	ListReusableDelegationSetsWithContext(aws.Context, *route53.ListReusableDelegationSetsInput, ...aws.Option) (*route53.ListReusableDelegationSetsOutput, error)
+
 	ListTagsForResource(*route53.ListTagsForResourceInput) (*route53.ListTagsForResourceOutput, error)
 
+	ListTagsForResourceWithContext(aws.Context, *route53.ListTagsForResourceInput, ...aws.Option) (*route53.ListTagsForResourceOutput, error)
+
 	ListTagsForResources(*route53.ListTagsForResourcesInput) (*route53.ListTagsForResourcesOutput, error)
 
+	ListTagsForResourcesWithContext(aws.Context, *route53.ListTagsForResourcesInput, ...aws.Option) (*route53.ListTagsForResourcesOutput, error)
+
 	UpdateHealthCheck(*route53.UpdateHealthCheckInput) (*route53.UpdateHealthCheckOutput, error)
 
+	UpdateHealthCheckWithContext(aws.Context, *route53.UpdateHealthCheckInput, ...aws.Option) (*route53.UpdateHealthCheckOutput, error)
+
 	UpdateHostedZoneComment(*route53.UpdateHostedZoneCommentInput) (*route53.UpdateHostedZoneCommentOutput, error)
+
+	UpdateHostedZoneCommentWithContext(aws.Context, *route53.UpdateHostedZoneCommentInput, ...aws.Option) (*route53.UpdateHostedZoneCommentOutput, error)
 }
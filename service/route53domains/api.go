@@ -40,6 +40,18 @@ func (c *Route53Domains) CheckDomainAvailability(input *CheckDomainAvailabilityI
 	return out, err
 }
 
+// CheckDomainAvailabilityWithContext is the same as CheckDomainAvailability with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) CheckDomainAvailabilityWithContext(ctx aws.Context, input *CheckDomainAvailabilityInput, opts ...aws.Option) (*CheckDomainAvailabilityOutput, error) {
+	req, out := c.CheckDomainAvailabilityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTagsForDomain = "DeleteTagsForDomain"
 
 // DeleteTagsForDomainRequest generates a request for the DeleteTagsForDomain operation.
@@ -70,6 +82,18 @@ func (c *Route53Domains) DeleteTagsForDomain(input *DeleteTagsForDomainInput) (*
 	return out, err
 }
 
+// DeleteTagsForDomainWithContext is the same as DeleteTagsForDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) DeleteTagsForDomainWithContext(ctx aws.Context, input *DeleteTagsForDomainInput, opts ...aws.Option) (*DeleteTagsForDomainOutput, error) {
+	req, out := c.DeleteTagsForDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableDomainAutoRenew = "DisableDomainAutoRenew"
 
 // DisableDomainAutoRenewRequest generates a request for the DisableDomainAutoRenew operation.
@@ -103,6 +127,18 @@ func (c *Route53Domains) DisableDomainAutoRenew(input *DisableDomainAutoRenewInp
 	return out, err
 }
 
+// DisableDomainAutoRenewWithContext is the same as DisableDomainAutoRenew with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) DisableDomainAutoRenewWithContext(ctx aws.Context, input *DisableDomainAutoRenewInput, opts ...aws.Option) (*DisableDomainAutoRenewOutput, error) {
+	req, out := c.DisableDomainAutoRenewRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableDomainTransferLock = "DisableDomainTransferLock"
 
 // DisableDomainTransferLockRequest generates a request for the DisableDomainTransferLock operation.
@@ -136,6 +172,18 @@ func (c *Route53Domains) DisableDomainTransferLock(input *DisableDomainTransferL
 	return out, err
 }
 
+// DisableDomainTransferLockWithContext is the same as DisableDomainTransferLock with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) DisableDomainTransferLockWithContext(ctx aws.Context, input *DisableDomainTransferLockInput, opts ...aws.Option) (*DisableDomainTransferLockOutput, error) {
+	req, out := c.DisableDomainTransferLockRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableDomainAutoRenew = "EnableDomainAutoRenew"
 
 // EnableDomainAutoRenewRequest generates a request for the EnableDomainAutoRenew operation.
@@ -172,6 +220,18 @@ func (c *Route53Domains) EnableDomainAutoRenew(input *EnableDomainAutoRenewInput
 	return out, err
 }
 
+// EnableDomainAutoRenewWithContext is the same as EnableDomainAutoRenew with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) EnableDomainAutoRenewWithContext(ctx aws.Context, input *EnableDomainAutoRenewInput, opts ...aws.Option) (*EnableDomainAutoRenewOutput, error) {
+	req, out := c.EnableDomainAutoRenewRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableDomainTransferLock = "EnableDomainTransferLock"
 
 // EnableDomainTransferLockRequest generates a request for the EnableDomainTransferLock operation.
@@ -203,6 +263,18 @@ func (c *Route53Domains) EnableDomainTransferLock(input *EnableDomainTransferLoc
 	return out, err
 }
 
+// EnableDomainTransferLockWithContext is the same as EnableDomainTransferLock with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) EnableDomainTransferLockWithContext(ctx aws.Context, input *EnableDomainTransferLockInput, opts ...aws.Option) (*EnableDomainTransferLockOutput, error) {
+	req, out := c.EnableDomainTransferLockRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDomainDetail = "GetDomainDetail"
 
 // GetDomainDetailRequest generates a request for the GetDomainDetail operation.
@@ -231,6 +303,18 @@ func (c *Route53Domains) GetDomainDetail(input *GetDomainDetailInput) (*GetDomai
 	return out, err
 }
 
+// GetDomainDetailWithContext is the same as GetDomainDetail with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) GetDomainDetailWithContext(ctx aws.Context, input *GetDomainDetailInput, opts ...aws.Option) (*GetDomainDetailOutput, error) {
+	req, out := c.GetDomainDetailRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOperationDetail = "GetOperationDetail"
 
 // GetOperationDetailRequest generates a request for the GetOperationDetail operation.
@@ -258,6 +342,18 @@ func (c *Route53Domains) GetOperationDetail(input *GetOperationDetailInput) (*Ge
 	return out, err
 }
 
+// GetOperationDetailWithContext is the same as GetOperationDetail with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) GetOperationDetailWithContext(ctx aws.Context, input *GetOperationDetailInput, opts ...aws.Option) (*GetOperationDetailOutput, error) {
+	req, out := c.GetOperationDetailRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListDomains = "ListDomains"
 
 // ListDomainsRequest generates a request for the ListDomains operation.
@@ -271,6 +367,7 @@ func (c *Route53Domains) ListDomainsRequest(input *ListDomainsInput) (req *aws.R
 			OutputTokens:    []string{"NextPageMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "",
+			ResultKeys:      []string{"Domains"},
 		},
 	}
 
@@ -292,6 +389,22 @@ func (c *Route53Domains) ListDomains(input *ListDomainsInput) (*ListDomainsOutpu
 	return out, err
 }
 
+// ListDomainsWithContext is the same as ListDomains with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) ListDomainsWithContext(ctx aws.Context, input *ListDomainsInput, opts ...aws.Option) (*ListDomainsOutput, error) {
+	req, out := c.ListDomainsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListDomainsPages iterates over the pages of a ListDomains operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Route53Domains) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDomainsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -299,6 +412,19 @@ func (c *Route53Domains) ListDomainsPages(input *ListDomainsInput, fn func(p *Li
 	})
 }
 
+// ListDomainsPagesWithContext is the same as ListDomainsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Route53Domains) ListDomainsPagesWithContext(ctx aws.Context, input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListDomainsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDomainsOutput), lastPage)
+	})
+}
+
 const opListOperations = "ListOperations"
 
 // ListOperationsRequest generates a request for the ListOperations operation.
@@ -312,6 +438,7 @@ func (c *Route53Domains) ListOperationsRequest(input *ListOperationsInput) (req
 			OutputTokens:    []string{"NextPageMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "",
+			ResultKeys:      []string{"Operations"},
 		},
 	}
 
@@ -332,6 +459,22 @@ func (c *Route53Domains) ListOperations(input *ListOperationsInput) (*ListOperat
 	return out, err
 }
 
+// ListOperationsWithContext is the same as ListOperations with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) ListOperationsWithContext(ctx aws.Context, input *ListOperationsInput, opts ...aws.Option) (*ListOperationsOutput, error) {
+	req, out := c.ListOperationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListOperationsPages iterates over the pages of a ListOperations operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Route53Domains) ListOperationsPages(input *ListOperationsInput, fn func(p *ListOperationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListOperationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -339,6 +482,19 @@ func (c *Route53Domains) ListOperationsPages(input *ListOperationsInput, fn func
 	})
 }
 
+// ListOperationsPagesWithContext is the same as ListOperationsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Route53Domains) ListOperationsPagesWithContext(ctx aws.Context, input *ListOperationsInput, fn func(p *ListOperationsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListOperationsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListOperationsOutput), lastPage)
+	})
+}
+
 const opListTagsForDomain = "ListTagsForDomain"
 
 // ListTagsForDomainRequest generates a request for the ListTagsForDomain operation.
@@ -370,6 +526,18 @@ func (c *Route53Domains) ListTagsForDomain(input *ListTagsForDomainInput) (*List
 	return out, err
 }
 
+// ListTagsForDomainWithContext is the same as ListTagsForDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) ListTagsForDomainWithContext(ctx aws.Context, input *ListTagsForDomainInput, opts ...aws.Option) (*ListTagsForDomainOutput, error) {
+	req, out := c.ListTagsForDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterDomain = "RegisterDomain"
 
 // RegisterDomainRequest generates a request for the RegisterDomain operation.
@@ -415,6 +583,18 @@ func (c *Route53Domains) RegisterDomain(input *RegisterDomainInput) (*RegisterDo
 	return out, err
 }
 
+// RegisterDomainWithContext is the same as RegisterDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) RegisterDomainWithContext(ctx aws.Context, input *RegisterDomainInput, opts ...aws.Option) (*RegisterDomainOutput, error) {
+	req, out := c.RegisterDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveDomainAuthCode = "RetrieveDomainAuthCode"
 
 // RetrieveDomainAuthCodeRequest generates a request for the RetrieveDomainAuthCode operation.
@@ -443,6 +623,18 @@ func (c *Route53Domains) RetrieveDomainAuthCode(input *RetrieveDomainAuthCodeInp
 	return out, err
 }
 
+// RetrieveDomainAuthCodeWithContext is the same as RetrieveDomainAuthCode with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) RetrieveDomainAuthCodeWithContext(ctx aws.Context, input *RetrieveDomainAuthCodeInput, opts ...aws.Option) (*RetrieveDomainAuthCodeOutput, error) {
+	req, out := c.RetrieveDomainAuthCodeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTransferDomain = "TransferDomain"
 
 // TransferDomainRequest generates a request for the TransferDomain operation.
@@ -493,6 +685,18 @@ func (c *Route53Domains) TransferDomain(input *TransferDomainInput) (*TransferDo
 	return out, err
 }
 
+// TransferDomainWithContext is the same as TransferDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) TransferDomainWithContext(ctx aws.Context, input *TransferDomainInput, opts ...aws.Option) (*TransferDomainOutput, error) {
+	req, out := c.TransferDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDomainContact = "UpdateDomainContact"
 
 // UpdateDomainContactRequest generates a request for the UpdateDomainContact operation.
@@ -527,6 +731,18 @@ func (c *Route53Domains) UpdateDomainContact(input *UpdateDomainContactInput) (*
 	return out, err
 }
 
+// UpdateDomainContactWithContext is the same as UpdateDomainContact with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) UpdateDomainContactWithContext(ctx aws.Context, input *UpdateDomainContactInput, opts ...aws.Option) (*UpdateDomainContactOutput, error) {
+	req, out := c.UpdateDomainContactRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDomainContactPrivacy = "UpdateDomainContactPrivacy"
 
 // UpdateDomainContactPrivacyRequest generates a request for the UpdateDomainContactPrivacy operation.
@@ -564,6 +780,18 @@ func (c *Route53Domains) UpdateDomainContactPrivacy(input *UpdateDomainContactPr
 	return out, err
 }
 
+// UpdateDomainContactPrivacyWithContext is the same as UpdateDomainContactPrivacy with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) UpdateDomainContactPrivacyWithContext(ctx aws.Context, input *UpdateDomainContactPrivacyInput, opts ...aws.Option) (*UpdateDomainContactPrivacyOutput, error) {
+	req, out := c.UpdateDomainContactPrivacyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDomainNameservers = "UpdateDomainNameservers"
 
 // UpdateDomainNameserversRequest generates a request for the UpdateDomainNameservers operation.
@@ -598,6 +826,18 @@ func (c *Route53Domains) UpdateDomainNameservers(input *UpdateDomainNameserversI
 	return out, err
 }
 
+// UpdateDomainNameserversWithContext is the same as UpdateDomainNameservers with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) UpdateDomainNameserversWithContext(ctx aws.Context, input *UpdateDomainNameserversInput, opts ...aws.Option) (*UpdateDomainNameserversOutput, error) {
+	req, out := c.UpdateDomainNameserversRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateTagsForDomain = "UpdateTagsForDomain"
 
 // UpdateTagsForDomainRequest generates a request for the UpdateTagsForDomain operation.
@@ -628,6 +868,18 @@ func (c *Route53Domains) UpdateTagsForDomain(input *UpdateTagsForDomainInput) (*
 	return out, err
 }
 
+// UpdateTagsForDomainWithContext is the same as UpdateTagsForDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Route53Domains) UpdateTagsForDomainWithContext(ctx aws.Context, input *UpdateTagsForDomainInput, opts ...aws.Option) (*UpdateTagsForDomainOutput, error) {
+	req, out := c.UpdateTagsForDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // The CheckDomainAvailability request contains the following elements.
 type CheckDomainAvailabilityInput struct {
 	// The name of a domain.
@@ -641,10 +893,10 @@ type CheckDomainAvailabilityInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	metadataCheckDomainAvailabilityInput `json:"-" xml:"-"`
 }
@@ -715,7 +967,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	AddressLine1 *string `type:"string"`
+	AddressLine1 *string `type:"string" max:"255"`
 
 	// Second line of contact's address, if any.
 	//
@@ -728,7 +980,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	AddressLine2 *string `type:"string"`
+	AddressLine2 *string `type:"string" max:"255"`
 
 	// The city of the contact's address.
 	//
@@ -741,7 +993,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	City *string `type:"string"`
+	City *string `type:"string" max:"255"`
 
 	// Indicates whether the contact is a person, company, association, or public
 	// organization. If you choose an option other than PERSON, you must enter an
@@ -784,7 +1036,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	Email *string `type:"string"`
+	Email *string `type:"string" max:"254"`
 
 	// A list of name-value pairs for parameters required by certain top-level domains.
 	//
@@ -812,7 +1064,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	Fax *string `type:"string"`
+	Fax *string `type:"string" max:"30"`
 
 	// First name of contact.
 	//
@@ -825,7 +1077,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	FirstName *string `type:"string"`
+	FirstName *string `type:"string" max:"255"`
 
 	// Last name of contact.
 	//
@@ -838,7 +1090,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	LastName *string `type:"string"`
+	LastName *string `type:"string" max:"255"`
 
 	// Name of the organization for contact types other than PERSON.
 	//
@@ -851,7 +1103,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	OrganizationName *string `type:"string"`
+	OrganizationName *string `type:"string" max:"255"`
 
 	// The phone number of the contact.
 	//
@@ -866,7 +1118,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	PhoneNumber *string `type:"string"`
+	PhoneNumber *string `type:"string" max:"30"`
 
 	// The state or province of the contact's city.
 	//
@@ -879,7 +1131,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	State *string `type:"string"`
+	State *string `type:"string" max:"255"`
 
 	// The zip or postal code of the contact's address.
 	//
@@ -892,13 +1144,13 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	ZipCode *string `type:"string"`
+	ZipCode *string `type:"string" max:"255"`
 
 	metadataContactDetail `json:"-" xml:"-"`
 }
 
 type metadataContactDetail struct {
-	SDKShapeTraits bool `type:"structure"`
+	SDKShapeTraits bool `type:"structure" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -928,7 +1180,7 @@ type DeleteTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// A list of tag keys to delete.
 	//
@@ -977,7 +1229,7 @@ func (s DeleteTagsForDomainOutput) GoString() string {
 }
 
 type DisableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	metadataDisableDomainAutoRenewInput `json:"-" xml:"-"`
 }
@@ -1027,7 +1279,7 @@ type DisableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	metadataDisableDomainTransferLockInput `json:"-" xml:"-"`
 }
@@ -1056,7 +1308,7 @@ type DisableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataDisableDomainTransferLockOutput `json:"-" xml:"-"`
 }
@@ -1086,7 +1338,7 @@ type DomainSummary struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// Expiration date of the domain in Coordinated Universal Time (UTC).
 	//
@@ -1119,7 +1371,7 @@ func (s DomainSummary) GoString() string {
 }
 
 type EnableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	metadataEnableDomainAutoRenewInput `json:"-" xml:"-"`
 }
@@ -1169,7 +1421,7 @@ type EnableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	metadataEnableDomainTransferLockInput `json:"-" xml:"-"`
 }
@@ -1198,7 +1450,7 @@ type EnableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataEnableDomainTransferLockOutput `json:"-" xml:"-"`
 }
@@ -1248,7 +1500,7 @@ type ExtraParam struct {
 	// Parent: ExtraParams
 	//
 	// Required: Yes
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"2048" required:"true"`
 
 	metadataExtraParam `json:"-" xml:"-"`
 }
@@ -1280,7 +1532,7 @@ type GetDomainDetailInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	metadataGetDomainDetailInput `json:"-" xml:"-"`
 }
@@ -1306,12 +1558,12 @@ type GetDomainDetailOutput struct {
 	// is cybersquatting on a domain name, or report some other type of abuse.
 	//
 	// Type: String
-	AbuseContactEmail *string `type:"string"`
+	AbuseContactEmail *string `type:"string" max:"254"`
 
 	// Phone number for reporting abuse.
 	//
 	// Type: String
-	AbuseContactPhone *string `type:"string"`
+	AbuseContactPhone *string `type:"string" max:"30"`
 
 	// Provides details about the domain administrative contact.
 	//
@@ -1320,7 +1572,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the admin contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1345,7 +1597,7 @@ type GetDomainDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// The date when the registration for the domain is set to expire. The date
 	// format is Unix time.
@@ -1363,7 +1615,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the registrant contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1418,7 +1670,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the tech contact is concealed from
 	// WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1465,7 +1717,7 @@ type GetOperationDetailInput struct {
 	// Default: None
 	//
 	// Required: Yes
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataGetOperationDetailInput `json:"-" xml:"-"`
 }
@@ -1489,7 +1741,7 @@ type GetOperationDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string"`
+	DomainName *string `type:"string" max:"255"`
 
 	// Detailed information on the status including possible errors.
 	//
@@ -1499,7 +1751,7 @@ type GetOperationDetailOutput struct {
 	// The identifier for the operation.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255"`
 
 	// The current status of the requested operation in the system.
 	//
@@ -1547,7 +1799,7 @@ type ListDomainsInput struct {
 	// Constraints: The marker must match the value specified in the previous request.
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1558,7 +1810,7 @@ type ListDomainsInput struct {
 	// Constraints: A numeral between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListDomainsInput `json:"-" xml:"-"`
 }
@@ -1593,7 +1845,7 @@ type ListDomainsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	metadataListDomainsOutput `json:"-" xml:"-"`
 }
@@ -1626,7 +1878,7 @@ type ListOperationsInput struct {
 	// Default: None
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1637,7 +1889,7 @@ type ListOperationsInput struct {
 	// Constraints: A value between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListOperationsInput `json:"-" xml:"-"`
 }
@@ -1665,7 +1917,7 @@ type ListOperationsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	// Lists summaries of the operations.
 	//
@@ -1694,7 +1946,7 @@ func (s ListOperationsOutput) GoString() string {
 // The ListTagsForDomainRequest includes the following elements.
 type ListTagsForDomainInput struct {
 	// The domain for which you want to get a list of tags.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	metadataListTagsForDomainInput `json:"-" xml:"-"`
 }
@@ -1772,7 +2024,7 @@ type Nameserver struct {
 	// Constraint: Maximum 255 characterss
 	//
 	// Parent: Nameservers
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"255" required:"true"`
 
 	metadataNameserver `json:"-" xml:"-"`
 }
@@ -1796,7 +2048,7 @@ type OperationSummary struct {
 	// Identifier returned to track the requested action.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	// The current status of the requested operation in the system.
 	//
@@ -1842,7 +2094,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -1867,7 +2119,7 @@ type RegisterDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -1879,10 +2131,10 @@ type RegisterDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -1935,7 +2187,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1946,7 +2198,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	metadataRegisterDomainInput `json:"-" xml:"-"`
 }
@@ -1975,7 +2227,7 @@ type RegisterDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataRegisterDomainOutput `json:"-" xml:"-"`
 }
@@ -2007,7 +2259,7 @@ type RetrieveDomainAuthCodeInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	metadataRetrieveDomainAuthCodeInput `json:"-" xml:"-"`
 }
@@ -2031,7 +2283,7 @@ type RetrieveDomainAuthCodeOutput struct {
 	// The authorization code for the domain.
 	//
 	// Type: String
-	AuthCode *string `type:"string" required:"true"`
+	AuthCode *string `type:"string" sensitive:"true" max:"1024" required:"true"`
 
 	metadataRetrieveDomainAuthCodeOutput `json:"-" xml:"-"`
 }
@@ -2106,7 +2358,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// The authorization code for the domain. You get this value from the current
 	// registrar.
@@ -2114,7 +2366,7 @@ type TransferDomainInput struct {
 	// Type: String
 	//
 	// Required: Yes
-	AuthCode *string `type:"string"`
+	AuthCode *string `type:"string" sensitive:"true" max:"1024"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -2139,7 +2391,7 @@ type TransferDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -2151,10 +2403,10 @@ type TransferDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Contains details for the host and glue IP addresses.
 	//
@@ -2216,7 +2468,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -2227,7 +2479,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	metadataTransferDomainInput `json:"-" xml:"-"`
 }
@@ -2256,7 +2508,7 @@ type TransferDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataTransferDomainOutput `json:"-" xml:"-"`
 }
@@ -2286,7 +2538,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// The name of a domain.
 	//
@@ -2299,7 +2551,7 @@ type UpdateDomainContactInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -2310,7 +2562,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -2321,7 +2573,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	metadataUpdateDomainContactInput `json:"-" xml:"-"`
 }
@@ -2350,7 +2602,7 @@ type UpdateDomainContactOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactOutput `json:"-" xml:"-"`
 }
@@ -2396,7 +2648,7 @@ type UpdateDomainContactPrivacyInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -2453,7 +2705,7 @@ type UpdateDomainContactPrivacyOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactPrivacyOutput `json:"-" xml:"-"`
 }
@@ -2485,7 +2737,7 @@ type UpdateDomainNameserversInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// The authorization key for .fi domains
 	FIAuthKey *string `type:"string"`
@@ -2526,7 +2778,7 @@ type UpdateDomainNameserversOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainNameserversOutput `json:"-" xml:"-"`
 }
@@ -2562,7 +2814,7 @@ type UpdateTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" required:"true"`
 
 	// A list of the tag keys and values that you want to add or update. If you
 	// specify a key that already exists, the corresponding value will be replaced.
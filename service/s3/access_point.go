@@ -0,0 +1,119 @@
+package s3
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// An accessPointARN is the ARN of an S3 access point, e.g.
+// "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point".
+type accessPointARN struct {
+	Partition string
+	Region    string
+	AccountID string
+	Name      string
+}
+
+// isARN returns true if the bucket is an ARN instead of a bucket name.
+func isARN(bucket string) bool {
+	return strings.HasPrefix(bucket, "arn:")
+}
+
+// parseAccessPointARN parses the access point ARN. The access point name can
+// be separated from the resource type by either "/" or ":".
+func parseAccessPointARN(arn string) (accessPointARN, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "s3" {
+		return accessPointARN{}, invalidAccessPointARN(arn, "not an S3 ARN")
+	}
+
+	resource := strings.SplitN(parts[5], "/", 2)
+	if len(resource) != 2 {
+		resource = strings.SplitN(parts[5], ":", 2)
+	}
+	if len(resource) != 2 || resource[0] != "accesspoint" {
+		return accessPointARN{}, invalidAccessPointARN(arn, "not an access point ARN")
+	}
+
+	ap := accessPointARN{
+		Partition: parts[1],
+		Region:    parts[3],
+		AccountID: parts[4],
+		Name:      resource[1],
+	}
+	switch {
+	case ap.Partition == "":
+		return accessPointARN{}, invalidAccessPointARN(arn, "missing partition")
+	case ap.Region == "":
+		return accessPointARN{}, invalidAccessPointARN(arn, "missing region")
+	case ap.AccountID == "":
+		return accessPointARN{}, invalidAccessPointARN(arn, "missing account ID")
+	case !dnsCompatibleBucketName(ap.Name) || strings.Contains(ap.Name, "."):
+		return accessPointARN{}, invalidAccessPointARN(arn, "invalid access point name")
+	}
+	return ap, nil
+}
+
+// updateEndpointForAccessPoint sends the request to the endpoint of the
+// access point, e.g. "my-access-point-123456789012.s3-accesspoint.us-west-2.amazonaws.com",
+// signed for the access point's region.
+func updateEndpointForAccessPoint(r *aws.Request, arn string) {
+	ap, err := parseAccessPointARN(arn)
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	if r.Service.HasCustomEndpoint() {
+		r.Error = invalidAccessPointARN(arn, "access points cannot be used with a custom endpoint")
+		return
+	}
+	if r.Config.S3ForcePathStyle {
+		r.Error = invalidAccessPointARN(arn, "access points cannot be used with S3ForcePathStyle")
+		return
+	}
+
+	region := r.Config.Region
+	if ap.Region != region && !r.Config.S3UseARNRegion {
+		r.Error = invalidAccessPointARN(arn, "the access point's region "+ap.Region+
+			" differs from the client's region "+region+", set S3UseARNRegion to use the access point's region")
+		return
+	}
+
+	clientEP, _ := endpoints.Resolve("s3", region)
+	if clientEP.PartitionID != ap.Partition {
+		r.Error = invalidAccessPointARN(arn, "the access point's partition "+ap.Partition+
+			" differs from the client's partition "+clientEP.PartitionID)
+		return
+	}
+	var dnsSuffix string
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == ap.Partition {
+			dnsSuffix = p.DNSSuffix()
+		}
+	}
+
+	host := ap.Name + "-" + ap.AccountID + ".s3-accesspoint"
+	if r.Config.UseFIPSEndpoint {
+		host += "-fips"
+	}
+	if r.Config.UseDualStack {
+		host += ".dualstack"
+	}
+	r.HTTPRequest.URL.Host = host + "." + ap.Region + "." + dnsSuffix
+	r.HTTPRequest.URL.Path = strings.Replace(r.HTTPRequest.URL.Path, "/{Bucket}", "", -1)
+	if r.HTTPRequest.URL.Path == "" {
+		r.HTTPRequest.URL.Path = "/"
+	}
+
+	svc := *r.Service
+	svc.SigningRegion = ap.Region
+	r.Service = &svc
+}
+
+func invalidAccessPointARN(arn, reason string) error {
+	return awserr.New("InvalidAccessPointARN", "invalid access point ARN "+arn+", "+reason, nil)
+}
@@ -0,0 +1,86 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessPointARN(t *testing.T) {
+	cases := []struct {
+		config        *aws.Config
+		bucket        string
+		url           string
+		signingRegion string
+	}{
+		{
+			&aws.Config{Region: "us-west-2"},
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			"https://myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/",
+			"us-west-2",
+		},
+		{
+			&aws.Config{Region: "us-west-2"},
+			"arn:aws:s3:us-west-2:123456789012:accesspoint:myendpoint",
+			"https://myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/",
+			"us-west-2",
+		},
+		{
+			&aws.Config{Region: "us-east-1", S3UseARNRegion: true},
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			"https://myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/",
+			"us-west-2",
+		},
+		{
+			&aws.Config{Region: "us-west-2", UseDualStack: true},
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			"https://myendpoint-123456789012.s3-accesspoint.dualstack.us-west-2.amazonaws.com/",
+			"us-west-2",
+		},
+		{
+			&aws.Config{Region: "cn-north-1"},
+			"arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/myendpoint",
+			"https://myendpoint-123456789012.s3-accesspoint.cn-north-1.amazonaws.com.cn/",
+			"cn-north-1",
+		},
+	}
+
+	for _, c := range cases {
+		c.config.Credentials = credentials.NewStaticCredentials("AKID", "SECRET", "")
+		svc := s3.New(c.config)
+		req, _ := svc.ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String(c.bucket)})
+		req.Sign()
+		assert.NoError(t, req.Error, c.bucket)
+		assert.Equal(t, c.url, req.HTTPRequest.URL.String(), c.bucket)
+		assert.Contains(t, req.HTTPRequest.Header.Get("Authorization"), "/"+c.signingRegion+"/s3/", c.bucket)
+	}
+}
+
+func TestAccessPointARNInvalid(t *testing.T) {
+	cases := []struct {
+		config *aws.Config
+		bucket string
+	}{
+		{&aws.Config{Region: "us-west-2"}, "arn:aws:s3:us-west-2:123456789012:bucket/mybucket"},
+		{&aws.Config{Region: "us-west-2"}, "arn:aws:sqs:us-west-2:123456789012:accesspoint/myendpoint"},
+		{&aws.Config{Region: "us-west-2"}, "arn:aws:s3:us-west-2::accesspoint/myendpoint"},
+		{&aws.Config{Region: "us-west-2"}, "arn:aws:s3:us-west-2:123456789012:accesspoint/my.endpoint"},
+		{&aws.Config{Region: "us-east-1"}, "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint"},
+		{&aws.Config{Region: "us-west-2", S3UseARNRegion: true}, "arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/myendpoint"},
+		{&aws.Config{Region: "us-west-2", S3ForcePathStyle: true}, "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint"},
+		{&aws.Config{Region: "us-west-2", Endpoint: "https://localhost"}, "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint"},
+	}
+
+	for _, c := range cases {
+		svc := s3.New(c.config)
+		req, _ := svc.ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String(c.bucket)})
+		err := req.Build()
+		if assert.Error(t, err, c.bucket) {
+			assert.Equal(t, "InvalidAccessPointARN", err.(awserr.Error).Code(), c.bucket)
+		}
+	}
+}
@@ -11,6 +11,11 @@ func init() {
 		s.Handlers.Validate.PushBack(validateSSERequiresSSL)
 		s.Handlers.Build.PushBack(computeSSEKeys)
 
+		// Optionally sign requests sent over HTTPS without the body's hash.
+		// Pushed before the request's handlers, so that it takes precedence
+		// over streaming signatures.
+		s.Handlers.Build.PushBack(useUnsignedPayload)
+
 		// S3 uses custom error unmarshaling logic
 		s.Handlers.UnmarshalError.Clear()
 		s.Handlers.UnmarshalError.PushBack(unmarshalError)
@@ -27,6 +32,9 @@ func init() {
 		case opCreateBucket:
 			// Auto-populate LocationConstraint with current region
 			r.Handlers.Validate.PushFront(populateLocationConstraint)
+		case opPutObject, opUploadPart:
+			// Optionally sign the uploaded body chunk by chunk
+			r.Handlers.Build.PushBack(useStreamingSignature)
 		}
 	}
 }
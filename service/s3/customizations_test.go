@@ -1,9 +1,12 @@
 package s3_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"crypto/md5"
 	"encoding/base64"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -88,3 +91,35 @@ func TestMD5InDeleteObjects(t *testing.T) {
 	})
 	assertMD5(t, req)
 }
+
+func TestAcceptGzipEncoding(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, req.Build())
+	assert.Equal(t, "gzip", req.HTTPRequest.Header.Get("Accept-Encoding"))
+
+	req, _ = svc.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, req.Build())
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Accept-Encoding"), "Expect object bodies to be returned as stored")
+}
+
+func TestDecompressListObjects(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte(`<ListBucketResult><Name>bucket</Name><Contents><Key>key</Key></Contents></ListBucketResult>`))
+	w.Close()
+
+	svc := s3.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Encoding": []string{"gzip"}},
+			Body:       ioutil.NopCloser(&buf),
+		}
+	})
+	out, err := svc.ListObjects(&s3.ListObjectsInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, "bucket", *out.Name)
+	assert.Equal(t, "key", *out.Contents[0].Key)
+}
@@ -43,7 +43,9 @@ func updateHostWithBucket(r *aws.Request) {
 		return
 	}
 
-	if bucket := b[0].(string); bucket != "" && hostStyleBucketName(r, bucket) {
+	if bucket := b[0].(string); isARN(bucket) {
+		updateEndpointForAccessPoint(r, bucket)
+	} else if bucket != "" && hostStyleBucketName(r, bucket) {
 		r.HTTPRequest.URL.Host = bucket + "." + r.HTTPRequest.URL.Host
 		r.HTTPRequest.URL.Path = strings.Replace(r.HTTPRequest.URL.Path, "/{Bucket}", "", -1)
 		if r.HTTPRequest.URL.Path == "" {
@@ -29,6 +29,11 @@ var (
 		{"a..bc", "http://s3.mock-region.amazonaws.com/a..bc"},
 	}
 
+	dualStackTests = []s3BucketTest{
+		{"abc", "https://abc.s3.dualstack.mock-region.amazonaws.com/"},
+		{"a.b.c", "https://s3.dualstack.mock-region.amazonaws.com/a.b.c"},
+	}
+
 	forcepathTests = []s3BucketTest{
 		{"abc", "https://s3.mock-region.amazonaws.com/abc"},
 		{"a$b$c", "https://s3.mock-region.amazonaws.com/a%24b%24c"},
@@ -55,7 +60,26 @@ func TestHostStyleBucketBuildNoSSL(t *testing.T) {
 	runTests(t, s, nosslTests)
 }
 
+func TestHostStyleBucketBuildDualStack(t *testing.T) {
+	s := s3.New(&aws.Config{UseDualStack: true})
+	runTests(t, s, dualStackTests)
+}
+
 func TestPathStyleBucketBuild(t *testing.T) {
 	s := s3.New(&aws.Config{S3ForcePathStyle: true})
 	runTests(t, s, forcepathTests)
 }
+
+func TestDisableRestProtocolURICleaning(t *testing.T) {
+	key := "a//b/./c/../d/"
+
+	s := s3.New(nil)
+	req, _ := s.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("abc"), Key: &key})
+	req.Build()
+	assert.Equal(t, "https://abc.s3.mock-region.amazonaws.com/a/b/d", req.HTTPRequest.URL.String())
+
+	s = s3.New(&aws.Config{DisableRestProtocolURICleaning: true})
+	req, _ = s.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("abc"), Key: &key})
+	req.Build()
+	assert.Equal(t, "https://abc.s3.mock-region.amazonaws.com/a//b/./c/../d/", req.HTTPRequest.URL.String())
+}
@@ -0,0 +1,169 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/signer/v4"
+)
+
+// A PostPolicy describes the uploads of a presigned POST, a
+// multipart/form-data form browsers can upload objects to a bucket with
+// directly. Each field adds a condition to the policy; uploads which do not
+// meet all conditions are rejected by S3.
+type PostPolicy struct {
+	// The bucket objects are uploaded to. Required.
+	Bucket string
+
+	// The key of the uploaded object. The key may contain ${filename}, which
+	// S3 replaces with the name of the file the user uploads.
+	Key string
+
+	// If set, instead of Key the key of the uploaded object must start with
+	// the prefix. The form's key field is set to the prefix followed by
+	// ${filename}, it can be changed by the form.
+	KeyPrefix string
+
+	// The canned ACL of the uploaded object, e.g. "public-read".
+	ACL string
+
+	// The Content-Type of the uploaded object.
+	ContentType string
+
+	// The minimum and maximum size in bytes of the uploaded file. The size is
+	// not limited if MaxContentLength is 0.
+	MinContentLength int64
+	MaxContentLength int64
+
+	// The status code S3 responds to successful uploads with, if the form does
+	// not redirect, "200", "201", or "204".
+	SuccessActionStatus string
+
+	// How long the policy is valid for. Defaults to 15 minutes, and must be
+	// at most aws.MaxPresignExpireTime.
+	ExpireTime time.Duration
+}
+
+// A PresignedPost is the action URL and the fields of the form of a
+// presigned POST. The fields must be included in the form before the file.
+type PresignedPost struct {
+	URL    string
+	Fields map[string]string
+}
+
+// PresignPost returns the form of a presigned POST upload, whose policy is
+// signed with the client's credentials and region.
+//
+// Example:
+//     post, err := svc.PresignPost(&s3.PostPolicy{
+//         Bucket:           "bucket",
+//         KeyPrefix:        "uploads/",
+//         ACL:              "public-read",
+//         MaxContentLength: 10 << 20,
+//     })
+//
+//     // <form action="{{.URL}}" method="post" enctype="multipart/form-data">
+//     //   {{range $name, $value := .Fields}}
+//     //   <input type="hidden" name="{{$name}}" value="{{$value}}">
+//     //   {{end}}
+//     //   <input type="file" name="file">
+//     // </form>
+func (c *S3) PresignPost(p *PostPolicy) (*PresignedPost, error) {
+	if p.Bucket == "" {
+		return nil, awserr.New("InvalidParameter", "missing required parameter: Bucket", nil)
+	}
+	if p.Key == "" && p.KeyPrefix == "" {
+		return nil, awserr.New("InvalidParameter", "missing required parameter: Key or KeyPrefix", nil)
+	}
+	expireTime := p.ExpireTime
+	if expireTime == 0 {
+		expireTime = 15 * time.Minute
+	}
+	if expireTime < 0 || expireTime > aws.MaxPresignExpireTime {
+		return nil, awserr.New("InvalidPresignExpireTime",
+			fmt.Sprintf("presign expire time must be greater than 0 and at most %s, got %s",
+				aws.MaxPresignExpireTime, expireTime), nil)
+	}
+	if c.Config.Credentials == credentials.AnonymousCredentials {
+		return nil, awserr.New("AnonymousCredentials", "cannot sign a POST policy with anonymous credentials", nil)
+	}
+
+	// Build the URL of the bucket the same as for requests.
+	req, _ := c.HeadBucketRequest(&HeadBucketInput{Bucket: aws.String(p.Bucket)})
+	if err := req.Build(); err != nil {
+		return nil, err
+	}
+
+	creds, err := c.Config.Credentials.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	region := c.SigningRegion
+	if region == "" {
+		region = c.Config.Region
+	}
+	now := time.Now().UTC()
+	credential := creds.AccessKeyID + "/" + now.Format("20060102") + "/" + region + "/s3/aws4_request"
+
+	fields := map[string]string{
+		"x-amz-algorithm":  "AWS4-HMAC-SHA256",
+		"x-amz-credential": credential,
+		"x-amz-date":       now.Format("20060102T150405Z"),
+	}
+	conditions := []interface{}{map[string]string{"bucket": p.Bucket}}
+
+	if p.KeyPrefix != "" {
+		fields["key"] = p.KeyPrefix + "${filename}"
+		conditions = append(conditions, []string{"starts-with", "$key", p.KeyPrefix})
+	} else {
+		fields["key"] = p.Key
+		conditions = append(conditions, map[string]string{"key": p.Key})
+	}
+	if p.MaxContentLength > 0 {
+		conditions = append(conditions, []interface{}{"content-length-range", p.MinContentLength, p.MaxContentLength})
+	}
+	if p.ACL != "" {
+		fields["acl"] = p.ACL
+	}
+	if p.ContentType != "" {
+		fields["Content-Type"] = p.ContentType
+	}
+	if p.SuccessActionStatus != "" {
+		fields["success_action_status"] = p.SuccessActionStatus
+	}
+	if creds.SessionToken != "" {
+		fields["x-amz-security-token"] = creds.SessionToken
+	}
+	for _, name := range []string{"acl", "Content-Type", "success_action_status",
+		"x-amz-algorithm", "x-amz-credential", "x-amz-date", "x-amz-security-token"} {
+		if v, ok := fields[name]; ok {
+			conditions = append(conditions, map[string]string{name: v})
+		}
+	}
+
+	doc, err := json.Marshal(struct {
+		Expiration string        `json:"expiration"`
+		Conditions []interface{} `json:"conditions"`
+	}{now.Add(expireTime).Format("2006-01-02T15:04:05.000Z"), conditions})
+	if err != nil {
+		return nil, awserr.New("SerializationError", "failed encoding POST policy", err)
+	}
+	policy := base64.StdEncoding.EncodeToString(doc)
+
+	hash := hmac.New(sha256.New, v4.SigningKey(creds.SecretAccessKey, now, region, "s3"))
+	hash.Write([]byte(policy))
+
+	fields["policy"] = policy
+	fields["x-amz-signature"] = hex.EncodeToString(hash.Sum(nil))
+
+	return &PresignedPost{URL: req.HTTPRequest.URL.String(), Fields: fields}, nil
+}
@@ -0,0 +1,66 @@
+package s3_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/signer/v4"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresignPost(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-west-2"})
+	post, err := svc.PresignPost(&s3.PostPolicy{
+		Bucket:           "bucket",
+		KeyPrefix:        "uploads/",
+		ACL:              "public-read",
+		MinContentLength: 1,
+		MaxContentLength: 1024,
+		ExpireTime:       time.Hour,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "https://bucket.s3-us-west-2.amazonaws.com/", post.URL)
+	assert.Equal(t, "uploads/${filename}", post.Fields["key"])
+	assert.Equal(t, "public-read", post.Fields["acl"])
+	assert.Equal(t, "SESSION", post.Fields["x-amz-security-token"])
+	assert.Equal(t, "AWS4-HMAC-SHA256", post.Fields["x-amz-algorithm"])
+	assert.Regexp(t, `^AKID/\d{8}/us-west-2/s3/aws4_request$`, post.Fields["x-amz-credential"])
+
+	b, err := base64.StdEncoding.DecodeString(post.Fields["policy"])
+	assert.NoError(t, err)
+	var policy struct {
+		Expiration string
+		Conditions []interface{}
+	}
+	assert.NoError(t, json.Unmarshal(b, &policy))
+	assert.Contains(t, policy.Conditions, map[string]interface{}{"bucket": "bucket"})
+	assert.Contains(t, policy.Conditions, []interface{}{"starts-with", "$key", "uploads/"})
+	assert.Contains(t, policy.Conditions, []interface{}{"content-length-range", float64(1), float64(1024)})
+	assert.Contains(t, policy.Conditions, map[string]interface{}{"acl": "public-read"})
+	assert.Contains(t, policy.Conditions, map[string]interface{}{"x-amz-date": post.Fields["x-amz-date"]})
+	expiration, err := time.Parse("2006-01-02T15:04:05.000Z", policy.Expiration)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiration, time.Minute)
+
+	date, _ := time.Parse("20060102T150405Z", post.Fields["x-amz-date"])
+	hash := hmac.New(sha256.New, v4.SigningKey("SECRET", date, "us-west-2", "s3"))
+	hash.Write([]byte(post.Fields["policy"]))
+	assert.Equal(t, hex.EncodeToString(hash.Sum(nil)), post.Fields["x-amz-signature"])
+}
+
+func TestPresignPostInvalid(t *testing.T) {
+	svc := s3.New(nil)
+	_, err := svc.PresignPost(&s3.PostPolicy{Bucket: "bucket"})
+	assert.Error(t, err, "Expect an error without a key")
+
+	_, err = svc.PresignPost(&s3.PostPolicy{Bucket: "bucket", Key: "key", ExpireTime: 8 * 24 * time.Hour})
+	assert.Error(t, err, "Expect an error for an expire time over 7 days")
+}
@@ -4,6 +4,7 @@
 package s3iface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
@@ -11,109 +12,249 @@ import (
 type S3API interface {
 	AbortMultipartUpload(*s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
 
+	AbortMultipartUploadWithContext(aws.Context, *s3.AbortMultipartUploadInput, ...aws.Option) (*s3.AbortMultipartUploadOutput, error)
+
 	CompleteMultipartUpload(*s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
 
+	CompleteMultipartUploadWithContext(aws.Context, *s3.CompleteMultipartUploadInput, ...aws.Option) (*s3.CompleteMultipartUploadOutput, error)
+
 	CopyObject(*s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
 
+	CopyObjectWithContext(aws.Context, *s3.CopyObjectInput, ...aws.Option) (*s3.CopyObjectOutput, error)
+
 	CreateBucket(*s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
 
+	CreateBucketWithContext(aws.Context, *s3.CreateBucketInput, ...aws.Option) (*s3.CreateBucketOutput, error)
+
 	CreateMultipartUpload(*s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
 
+	CreateMultipartUploadWithContext(aws.Context, *s3.CreateMultipartUploadInput, ...aws.Option) (*s3.CreateMultipartUploadOutput, error)
+
 	DeleteBucket(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
 
+	DeleteBucketWithContext(aws.Context, *s3.DeleteBucketInput, ...aws.Option) (*s3.DeleteBucketOutput, error)
+
 	DeleteBucketCORS(*s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
 
+	DeleteBucketCORSWithContext(aws.Context, *s3.DeleteBucketCORSInput, ...aws.Option) (*s3.DeleteBucketCORSOutput, error)
+
 	DeleteBucketLifecycle(*s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
 
+	DeleteBucketLifecycleWithContext(aws.Context, *s3.DeleteBucketLifecycleInput, ...aws.Option) (*s3.DeleteBucketLifecycleOutput, error)
+
 	DeleteBucketPolicy(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
 
+	DeleteBucketPolicyWithContext(aws.Context, *s3.DeleteBucketPolicyInput, ...aws.Option) (*s3.DeleteBucketPolicyOutput, error)
+
 	DeleteBucketReplication(*s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
 
+	DeleteBucketReplicationWithContext(aws.Context, *s3.DeleteBucketReplicationInput, ...aws.Option) (*s3.DeleteBucketReplicationOutput, error)
+
 	DeleteBucketTagging(*s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
 
+	DeleteBucketTaggingWithContext(aws.Context, *s3.DeleteBucketTaggingInput, ...aws.Option) (*s3.DeleteBucketTaggingOutput, error)
+
 	DeleteBucketWebsite(*s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
 
+	DeleteBucketWebsiteWithContext(aws.Context, *s3.DeleteBucketWebsiteInput, ...aws.Option) (*s3.DeleteBucketWebsiteOutput, error)
+
 	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 
+	DeleteObjectWithContext(aws.Context, *s3.DeleteObjectInput, ...aws.Option) (*s3.DeleteObjectOutput, error)
+
 	DeleteObjects(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 
+	DeleteObjectsWithContext(aws.Context, *s3.DeleteObjectsInput, ...aws.Option) (*s3.DeleteObjectsOutput, error)
+
 	GetBucketACL(*s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
 
+	GetBucketACLWithContext(aws.Context, *s3.GetBucketACLInput, ...aws.Option) (*s3.GetBucketACLOutput, error)
+
 	GetBucketCORS(*s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
 
+	GetBucketCORSWithContext(aws.Context, *s3.GetBucketCORSInput, ...aws.Option) (*s3.GetBucketCORSOutput, error)
+
 	GetBucketLifecycle(*s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
 
+	GetBucketLifecycleWithContext(aws.Context, *s3.GetBucketLifecycleInput, ...aws.Option) (*s3.GetBucketLifecycleOutput, error)
+
 	GetBucketLocation(*s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
 
+	GetBucketLocationWithContext(aws.Context, *s3.GetBucketLocationInput, ...aws.Option) (*s3.GetBucketLocationOutput, error)
+
 	GetBucketLogging(*s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
 
+	GetBucketLoggingWithContext(aws.Context, *s3.GetBucketLoggingInput, ...aws.Option) (*s3.GetBucketLoggingOutput, error)
+
 	GetBucketNotification(*s3.GetBucketNotificationConfigurationRequest) (*s3.NotificationConfigurationDeprecated, error)
 
+	GetBucketNotificationWithContext(aws.Context, *s3.GetBucketNotificationConfigurationRequest, ...aws.Option) (*s3.NotificationConfigurationDeprecated, error)
+
 	GetBucketNotificationConfiguration(*s3.GetBucketNotificationConfigurationRequest) (*s3.NotificationConfiguration, error)
 
+	GetBucketNotificationConfigurationWithContext(aws.Context, *s3.GetBucketNotificationConfigurationRequest, ...aws.Option) (*s3.NotificationConfiguration, error)
+
 	GetBucketPolicy(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
 
+	GetBucketPolicyWithContext(aws.Context, *s3.GetBucketPolicyInput, ...aws.Option) (*s3.GetBucketPolicyOutput, error)
+
 	GetBucketReplication(*s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
 
+	GetBucketReplicationWithContext(aws.Context, *s3.GetBucketReplicationInput, ...aws.Option) (*s3.GetBucketReplicationOutput, error)
+
 	GetBucketRequestPayment(*s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
 
+	GetBucketRequestPaymentWithContext(aws.Context, *s3.GetBucketRequestPaymentInput, ...aws.Option) (*s3.GetBucketRequestPaymentOutput, error)
+
 	GetBucketTagging(*s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
 
+	GetBucketTaggingWithContext(aws.Context, *s3.GetBucketTaggingInput, ...aws.Option) (*s3.GetBucketTaggingOutput, error)
+
 	GetBucketVersioning(*s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
 
+	GetBucketVersioningWithContext(aws.Context, *s3.GetBucketVersioningInput, ...aws.Option) (*s3.GetBucketVersioningOutput, error)
+
 	GetBucketWebsite(*s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
 
+	GetBucketWebsiteWithContext(aws.Context, *s3.GetBucketWebsiteInput, ...aws.Option) (*s3.GetBucketWebsiteOutput, error)
+
 	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
 
+	GetObjectWithContext(aws.Context, *s3.GetObjectInput, ...aws.Option) (*s3.GetObjectOutput, error)
+
 	GetObjectACL(*s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
 
+	GetObjectACLWithContext(aws.Context, *s3.GetObjectACLInput, ...aws.Option) (*s3.GetObjectACLOutput, error)
+
 	GetObjectTorrent(*s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
 
+	GetObjectTorrentWithContext(aws.Context, *s3.GetObjectTorrentInput, ...aws.Option) (*s3.GetObjectTorrentOutput, error)
+
 	HeadBucket(*s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
 
+	HeadBucketWithContext(aws.Context, *s3.HeadBucketInput, ...aws.Option) (*s3.HeadBucketOutput, error)
+
 	HeadObject(*s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 
+	HeadObjectWithContext(aws.Context, *s3.HeadObjectInput, ...aws.Option) (*s3.HeadObjectOutput, error)
+
 	ListBuckets(*s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
 
+	ListBucketsWithContext(aws.Context, *s3.ListBucketsInput, ...aws.Option) (*s3.ListBucketsOutput, error)
+
 	ListMultipartUploads(*s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
 
+	ListMultipartUploadsWithContext(aws.Context, *s3.ListMultipartUploadsInput, ...aws.Option) (*s3.ListMultipartUploadsOutput, error)
+
+	ListMultipartUploadsPages(*s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool) error
+
+	ListMultipartUploadsPagesWithContext(aws.Context, *s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool, ...aws.Option) error
+
 	ListObjectVersions(*s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 
+	ListObjectVersionsWithContext(aws.Context, *s3.ListObjectVersionsInput, ...aws.Option) (*s3.ListObjectVersionsOutput, error)
+
+	ListObjectVersionsPages(*s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool) error
+
+	ListObjectVersionsPagesWithContext(aws.Context, *s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool, ...aws.Option) error
+
 	ListObjects(*s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
 
+	ListObjectsWithContext(aws.Context, *s3.ListObjectsInput, ...aws.Option) (*s3.ListObjectsOutput, error)
+
+	ListObjectsPages(*s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool) error
+
+	ListObjectsPagesWithContext(aws.Context, *s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool, ...aws.Option) error
+
 	ListParts(*s3.ListPartsInput) (*s3.ListPartsOutput, error)
 
+	ListPartsWithContext(aws.Context, *s3.ListPartsInput, ...aws.Option) (*s3.ListPartsOutput, error)
+
+	ListPartsPages(*s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool) error
+
+	ListPartsPagesWithContext(aws.Context, *s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool, ...aws.Option) error
+
 	PutBucketACL(*s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
 
+	PutBucketACLWithContext(aws.Context, *s3.PutBucketACLInput, ...aws.Option) (*s3.PutBucketACLOutput, error)
+
 	PutBucketCORS(*s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
 
+	PutBucketCORSWithContext(aws.Context, *s3.PutBucketCORSInput, ...aws.Option) (*s3.PutBucketCORSOutput, error)
+
 	PutBucketLifecycle(*s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
 
+	PutBucketLifecycleWithContext(aws.Context, *s3.PutBucketLifecycleInput, ...aws.Option) (*s3.PutBucketLifecycleOutput, error)
+
 	PutBucketLogging(*s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
 
+	PutBucketLoggingWithContext(aws.Context, *s3.PutBucketLoggingInput, ...aws.Option) (*s3.PutBucketLoggingOutput, error)
+
 	PutBucketNotification(*s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
 
+	PutBucketNotificationWithContext(aws.Context, *s3.PutBucketNotificationInput, ...aws.Option) (*s3.PutBucketNotificationOutput, error)
+
 	PutBucketNotificationConfiguration(*s3.PutBucketNotificationConfigurationInput) (*s3.PutBucketNotificationConfigurationOutput, error)
 
+	PutBucketNotificationConfigurationWithContext(aws.Context, *s3.PutBucketNotificationConfigurationInput, ...aws.Option) (*s3.PutBucketNotificationConfigurationOutput, error)
+
 	PutBucketPolicy(*s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
 
+	PutBucketPolicyWithContext(aws.Context, *s3.PutBucketPolicyInput, ...aws.Option) (*s3.PutBucketPolicyOutput, error)
+
 	PutBucketReplication(*s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
 
+	PutBucketReplicationWithContext(aws.Context, *s3.PutBucketReplicationInput, ...aws.Option) (*s3.PutBucketReplicationOutput, error)
+
 	PutBucketRequestPayment(*s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
 
+	PutBucketRequestPaymentWithContext(aws.Context, *s3.PutBucketRequestPaymentInput, ...aws.Option) (*s3.PutBucketRequestPaymentOutput, error)
+
 	PutBucketTagging(*s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
 
+	PutBucketTaggingWithContext(aws.Context, *s3.PutBucketTaggingInput, ...aws.Option) (*s3.PutBucketTaggingOutput, error)
+
 	PutBucketVersioning(*s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
 
+	PutBucketVersioningWithContext(aws.Context, *s3.PutBucketVersioningInput, ...aws.Option) (*s3.PutBucketVersioningOutput, error)
+
 	PutBucketWebsite(*s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
 
+	PutBucketWebsiteWithContext(aws.Context, *s3.PutBucketWebsiteInput, ...aws.Option) (*s3.PutBucketWebsiteOutput, error)
+
 	PutObject(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
 
+	PutObjectWithContext(aws.Context, *s3.PutObjectInput, ...aws.Option) (*s3.PutObjectOutput, error)
+
 	PutObjectACL(*s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
 
+	PutObjectACLWithContext(aws.Context, *s3.PutObjectACLInput, ...aws.Option) (*s3.PutObjectACLOutput, error)
+
 	RestoreObject(*s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
 
+	RestoreObjectWithContext(aws.Context, *s3.RestoreObjectInput, ...aws.Option) (*s3.RestoreObjectOutput, error)
+
 	UploadPart(*s3.UploadPartInput) (*s3.UploadPartOutput, error)
 
+	UploadPartWithContext(aws.Context, *s3.UploadPartInput, ...aws.Option) (*s3.UploadPartOutput, error)
+
 	UploadPartCopy(*s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
+
+	UploadPartCopyWithContext(aws.Context, *s3.UploadPartCopyInput, ...aws.Option) (*s3.UploadPartCopyOutput, error)
+
+	WaitUntilBucketExists(*s3.HeadBucketInput) error
+
+	WaitUntilBucketExistsWithContext(aws.Context, *s3.HeadBucketInput, ...aws.WaiterOption) error
+
+	WaitUntilBucketNotExists(*s3.HeadBucketInput) error
+
+	WaitUntilBucketNotExistsWithContext(aws.Context, *s3.HeadBucketInput, ...aws.WaiterOption) error
+
+	WaitUntilObjectExists(*s3.HeadObjectInput) error
+
+	WaitUntilObjectExistsWithContext(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
+
+	WaitUntilObjectNotExists(*s3.HeadObjectInput) error
+
+	WaitUntilObjectNotExistsWithContext(aws.Context, *s3.HeadObjectInput, ...aws.WaiterOption) error
 }
@@ -0,0 +1,296 @@
+package s3manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// The default number of objects to delete with each DeleteObjects request,
+// the most objects a DeleteObjects request can delete.
+var DefaultBatchDeleteSize = 1000
+
+// The default set of options used when opts is nil in NewBatchDelete().
+var DefaultBatchDeleteOptions = &BatchDeleteOptions{
+	BatchSize: DefaultBatchDeleteSize,
+}
+
+// BatchDeleteOptions keeps tracks of extra options to pass to a Delete() call.
+type BatchDeleteOptions struct {
+	// The number of objects to delete with each DeleteObjects request. If this
+	// value is set to zero, or is greater than DefaultBatchDeleteSize, the
+	// DefaultBatchDeleteSize value will be used.
+	BatchSize int
+
+	// An S3 client to use when deleting objects. Leave this as nil to use a
+	// default client.
+	S3 *s3.S3
+}
+
+// A BatchDeleteItemError is the error of an object which failed to be deleted
+// by a BatchDelete. The index of the error is the index of the object in the
+// order the objects were received.
+//
+// Example:
+//
+//     err := s3manager.NewBatchDelete(nil).Delete(bucket, it.C)
+//     if batchErr, ok := err.(awserr.BatchError); ok {
+//         for _, itemErr := range batchErr.Errors() {
+//             objErr := itemErr.(s3manager.BatchDeleteItemError)
+//             fmt.Println("Failed to delete", objErr.Key(), objErr.Code(), objErr.Message())
+//         }
+//     }
+//
+type BatchDeleteItemError interface {
+	awserr.BatchItemError
+
+	// Returns the key of the object.
+	Key() string
+
+	// Returns the version ID of the object, empty if no version was deleted.
+	VersionID() string
+}
+
+// A batchDeleteItemError wraps the key and version ID of an object which
+// failed to be deleted.
+type batchDeleteItemError struct {
+	awserr.BatchItemError
+	key       string
+	versionID string
+}
+
+// Key returns the key of the object.
+func (b batchDeleteItemError) Key() string {
+	return b.key
+}
+
+// VersionID returns the version ID of the object.
+func (b batchDeleteItemError) VersionID() string {
+	return b.versionID
+}
+
+// NewBatchDelete creates a new BatchDelete structure that deletes objects
+// with DeleteObjects requests of up to DefaultBatchDeleteSize objects. Pass in
+// an optional BatchDeleteOptions struct to customize the batching behavior.
+func NewBatchDelete(opts *BatchDeleteOptions) *BatchDelete {
+	if opts == nil {
+		opts = DefaultBatchDeleteOptions
+	}
+	return &BatchDelete{opts: opts}
+}
+
+// The BatchDelete structure that calls Delete(). It is safe to call Delete()
+// on this structure for multiple buckets and across concurrent goroutines.
+type BatchDelete struct {
+	opts *BatchDeleteOptions
+}
+
+// Delete deletes the objects of the bucket received from the channel, in
+// batches of BatchSize objects, until the channel is closed. The channel can
+// be the channel of an aws.Iterator, e.g. of a ListObjects or a
+// ListObjectVersions request, or a channel the caller sends keys to. The
+// objects received can be:
+//
+//     string, *string          the key of the object to delete
+//     *s3.ObjectIdentifier     the key, and version to delete, of the object
+//     *s3.Object               an object listed by ListObjects
+//     *s3.ObjectVersion        a version listed by ListObjectVersions
+//     *s3.DeleteMarkerEntry    a delete marker listed by ListObjectVersions
+//
+// *s3.CommonPrefix values, which ListObjectVersions iterators yield with the
+// versions, are ignored. Other values fail to be deleted, with an
+// ErrCodeInvalidParameter error.
+//
+// Returns nil if all the objects were deleted. Otherwise an awserr.BatchError
+// is returned, whose errors are the BatchDeleteItemErrors of the objects
+// which failed to be deleted. If a DeleteObjects request fails, deleting stops
+// with the request's objects as the failed objects, and the BatchError has the
+// code and message of the request's error. Delete stops receiving from the
+// channel then, so the caller must close the iterator the channel is
+// received from.
+//
+// Example:
+//
+//     req, _ := svc.ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String(bucket)})
+//     it := aws.NewIterator(req)
+//     defer it.Close()
+//     err := s3manager.NewBatchDelete(nil).Delete(bucket, it.C)
+//     if err == nil {
+//         err = it.Err()
+//     }
+//
+func (b *BatchDelete) Delete(bucket string, objects <-chan interface{}) error {
+	return b.DeleteWithContext(aws.BackgroundContext(), bucket, objects)
+}
+
+// DeleteWithContext is the same as Delete with the addition of the ability to
+// pass a context and additional request options, which are used for each of
+// the DeleteObjects requests. The context must not be nil. If the context is
+// canceled deleting stops, with the objects not yet deleted as the failed
+// objects of an ErrCodeRequestCanceled BatchError.
+func (b *BatchDelete) DeleteWithContext(ctx aws.Context, bucket string, objects <-chan interface{}, opts ...aws.Option) error {
+	impl := batchDeleter{opts: *b.opts, bucket: bucket, ctx: ctx, reqOpts: opts}
+	return impl.delete(objects)
+}
+
+// batchDeleter is the implementation structure used internally by
+// BatchDelete.
+type batchDeleter struct {
+	opts   BatchDeleteOptions
+	bucket string
+
+	ctx     aws.Context
+	reqOpts []aws.Option
+
+	count int // the number of objects received
+	errs  []awserr.BatchItemError
+}
+
+// init initializes the deleter with default options.
+func (d *batchDeleter) init() {
+	if d.opts.BatchSize <= 0 || d.opts.BatchSize > DefaultBatchDeleteSize {
+		d.opts.BatchSize = DefaultBatchDeleteSize
+	}
+
+	if d.opts.S3 == nil {
+		d.opts.S3 = s3.New(nil)
+	}
+}
+
+// delete deletes the objects received from the channel, a batch at a time.
+func (d *batchDeleter) delete(objects <-chan interface{}) error {
+	d.init()
+
+	batch := []*s3.ObjectIdentifier{}
+	first := 0 // the index of the first object of the batch
+	for {
+		var v interface{}
+		ok := true
+		select {
+		case v, ok = <-objects:
+		case <-d.ctx.Done():
+			err := awserr.New(aws.ErrCodeRequestCanceled, "request context canceled", d.ctx.Err())
+			d.failBatch(first, batch, err)
+			return d.err(err)
+		}
+
+		if ok {
+			if _, isPrefix := v.(*s3.CommonPrefix); isPrefix {
+				continue
+			}
+			obj, err := objectIdentifier(v)
+			if err != nil {
+				d.errs = append(d.errs, batchDeleteItemError{
+					BatchItemError: awserr.NewBatchItemError(d.count, err.Code(), err.Message()),
+				})
+				d.count++
+				continue
+			}
+			if len(batch) == 0 {
+				first = d.count
+			}
+			batch = append(batch, obj)
+			d.count++
+		}
+
+		if len(batch) > 0 && (len(batch) == d.opts.BatchSize || !ok) {
+			if err := d.deleteBatch(first, batch); err != nil {
+				return d.err(err)
+			}
+			batch = []*s3.ObjectIdentifier{}
+		}
+		if !ok {
+			return d.err(nil)
+		}
+	}
+}
+
+// deleteBatch deletes the objects of the batch with a DeleteObjects request,
+// where first is the index of the batch's first object. The objects the
+// request failed to delete are added to the errors of the deleter. Returns
+// the error of the request, if it failed.
+func (d *batchDeleter) deleteBatch(first int, batch []*s3.ObjectIdentifier) awserr.Error {
+	index := map[string]int{}
+	for i, obj := range batch {
+		index[objectID(obj.Key, obj.VersionID)] = first + i
+	}
+
+	resp, err := d.opts.S3.DeleteObjectsWithContext(d.ctx, &s3.DeleteObjectsInput{
+		Bucket: &d.bucket,
+		Delete: &s3.Delete{Objects: batch, Quiet: aws.Boolean(true)},
+	}, d.reqOpts...)
+	if err != nil {
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			aerr = awserr.New("DeleteObjectsError", "failed to delete objects", err)
+		}
+		d.failBatch(first, batch, aerr)
+		return aerr
+	}
+
+	for _, e := range resp.Errors {
+		i, ok := index[objectID(e.Key, e.VersionID)]
+		if !ok {
+			i = -1
+		}
+		d.errs = append(d.errs, batchDeleteItemError{
+			BatchItemError: awserr.NewBatchItemError(i, aws.StringValue(e.Code), aws.StringValue(e.Message)),
+			key:            aws.StringValue(e.Key),
+			versionID:      aws.StringValue(e.VersionID),
+		})
+	}
+	return nil
+}
+
+// failBatch adds the objects of the batch, where first is the index of the
+// batch's first object, to the errors of the deleter with the error.
+func (d *batchDeleter) failBatch(first int, batch []*s3.ObjectIdentifier, err awserr.Error) {
+	for i, obj := range batch {
+		d.errs = append(d.errs, batchDeleteItemError{
+			BatchItemError: awserr.NewBatchItemError(first+i, err.Code(), err.Message()),
+			key:            aws.StringValue(obj.Key),
+			versionID:      aws.StringValue(obj.VersionID),
+		})
+	}
+}
+
+// err returns the BatchError of the objects which failed to be deleted, with
+// the code and message of the error deleting stopped with, if any, or nil if
+// all the objects were deleted.
+func (d *batchDeleter) err(stopErr awserr.Error) error {
+	if stopErr != nil {
+		return awserr.NewBatchError(stopErr.Code(), stopErr.Message(), d.errs)
+	}
+	if len(d.errs) == 0 {
+		return nil
+	}
+	return awserr.NewBatchError(awserr.ErrCodeBatchPartialFailure,
+		fmt.Sprintf("%d of %d objects of bucket %s failed to be deleted", len(d.errs), d.count, d.bucket), d.errs)
+}
+
+// objectIdentifier returns the identifier of the object to delete for a value
+// received by a BatchDelete.
+func objectIdentifier(v interface{}) (*s3.ObjectIdentifier, awserr.Error) {
+	switch o := v.(type) {
+	case string:
+		return &s3.ObjectIdentifier{Key: aws.String(o)}, nil
+	case *string:
+		return &s3.ObjectIdentifier{Key: o}, nil
+	case *s3.ObjectIdentifier:
+		return o, nil
+	case *s3.Object:
+		return &s3.ObjectIdentifier{Key: o.Key}, nil
+	case *s3.ObjectVersion:
+		return &s3.ObjectIdentifier{Key: o.Key, VersionID: o.VersionID}, nil
+	case *s3.DeleteMarkerEntry:
+		return &s3.ObjectIdentifier{Key: o.Key, VersionID: o.VersionID}, nil
+	}
+	return nil, awserr.New(aws.ErrCodeInvalidParameter, fmt.Sprintf("cannot delete an object of type %T", v), nil)
+}
+
+// objectID returns the key of the batch index of the object's version.
+func objectID(key, versionID *string) string {
+	return aws.StringValue(key) + "\x00" + aws.StringValue(versionID)
+}
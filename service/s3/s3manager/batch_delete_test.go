@@ -0,0 +1,170 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+// delLoggingSvc returns a client whose DeleteObjects requests receive the
+// responses, in order, the keys of each of its DeleteObjects requests, and
+// the names of its operations.
+func delLoggingSvc(resps ...func() *http.Response) (*s3.S3, *[][]string, *[]string) {
+	var m sync.Mutex
+	batches := [][]string{}
+	names := []string{}
+
+	svc := s3.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		names = append(names, r.Operation.Name)
+		if r.Operation.Name == "ListObjects" {
+			r.HTTPResponse = &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(bytes.NewBufferString(`<ListBucketResult>` +
+					`<Contents><Key>a</Key></Contents><Contents><Key>b</Key></Contents>` +
+					`<IsTruncated>false</IsTruncated></ListBucketResult>`)),
+			}
+			return
+		}
+
+		keys := []string{}
+		for _, obj := range r.Params.(*s3.DeleteObjectsInput).Delete.Objects {
+			keys = append(keys, *obj.Key)
+		}
+		i := len(batches)
+		batches = append(batches, keys)
+
+		if i < len(resps) {
+			r.HTTPResponse = resps[i]()
+		} else {
+			r.HTTPResponse = delResponse(200, `<DeleteResult></DeleteResult>`)()
+		}
+	})
+
+	return svc, &batches, &names
+}
+
+func delResponse(status int, body string) func() *http.Response {
+	return func() *http.Response {
+		return &http.Response{
+			StatusCode:    status,
+			Body:          ioutil.NopCloser(bytes.NewBufferString(body)),
+			ContentLength: int64(len(body)),
+			Header:        http.Header{},
+		}
+	}
+}
+
+func delKeys(keys ...interface{}) <-chan interface{} {
+	c := make(chan interface{})
+	go func() {
+		for _, k := range keys {
+			c <- k
+		}
+		close(c)
+	}()
+	return c
+}
+
+func TestBatchDelete(t *testing.T) {
+	s, batches, _ := delLoggingSvc()
+	keys := []interface{}{}
+	for i := 0; i < 2500; i++ {
+		keys = append(keys, fmt.Sprintf("key%d", i))
+	}
+
+	err := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: s}).Delete("bucket", delKeys(keys...))
+	assert.NoError(t, err)
+	if assert.Len(t, *batches, 3) {
+		assert.Len(t, (*batches)[0], 1000)
+		assert.Len(t, (*batches)[1], 1000)
+		assert.Len(t, (*batches)[2], 500)
+		assert.Equal(t, "key2000", (*batches)[2][0])
+	}
+}
+
+func TestBatchDeleteItemFailures(t *testing.T) {
+	s, batches, _ := delLoggingSvc(
+		delResponse(200, `<DeleteResult></DeleteResult>`),
+		delResponse(200, `<DeleteResult><Error><Key>c</Key><VersionId>v1</VersionId>`+
+			`<Code>AccessDenied</Code><Message>Access Denied</Message></Error></DeleteResult>`),
+	)
+
+	err := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: s, BatchSize: 2}).Delete("bucket", delKeys(
+		"a", aws.String("b"), 42,
+		&s3.ObjectVersion{Key: aws.String("c"), VersionID: aws.String("v1")},
+		&s3.CommonPrefix{Prefix: aws.String("d/")},
+		&s3.DeleteMarkerEntry{Key: aws.String("c"), VersionID: aws.String("v2")},
+	))
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "c"}}, *batches)
+
+	batchErr, ok := err.(awserr.BatchError)
+	if !assert.True(t, ok, "expect a BatchError") {
+		return
+	}
+	assert.Equal(t, awserr.ErrCodeBatchPartialFailure, batchErr.Code())
+	assert.Contains(t, batchErr.Message(), "2 of 5 objects")
+
+	errs := batchErr.Errors()
+	if assert.Len(t, errs, 2) {
+		assert.Equal(t, 2, errs[0].Index())
+		assert.Equal(t, aws.ErrCodeInvalidParameter, errs[0].Code())
+
+		objErr := errs[1].(s3manager.BatchDeleteItemError)
+		assert.Equal(t, 3, objErr.Index())
+		assert.Equal(t, "AccessDenied", objErr.Code())
+		assert.Equal(t, "c", objErr.Key())
+		assert.Equal(t, "v1", objErr.VersionID())
+	}
+}
+
+func TestBatchDeleteRequestFailure(t *testing.T) {
+	s, batches, _ := delLoggingSvc(
+		delResponse(200, `<DeleteResult></DeleteResult>`),
+		delResponse(403, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`),
+	)
+
+	err := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: s, BatchSize: 2}).Delete("bucket",
+		delKeys("a", "b", "c", "d", "e"))
+	assert.Len(t, *batches, 2, "expect deleting to stop once a request failed")
+
+	batchErr, ok := err.(awserr.BatchError)
+	if !assert.True(t, ok, "expect a BatchError") {
+		return
+	}
+	assert.Equal(t, "AccessDenied", batchErr.Code())
+	errs := batchErr.Errors()
+	if assert.Len(t, errs, 2) {
+		assert.Equal(t, "c", errs[0].(s3manager.BatchDeleteItemError).Key())
+		assert.Equal(t, 2, errs[0].Index())
+		assert.Equal(t, "d", errs[1].(s3manager.BatchDeleteItemError).Key())
+		assert.Equal(t, 3, errs[1].Index())
+	}
+}
+
+func TestBatchDeleteListObjectsIterator(t *testing.T) {
+	s, batches, names := delLoggingSvc()
+
+	req, _ := s.ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String("bucket")})
+	it := aws.NewIterator(req)
+	defer it.Close()
+
+	err := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: s}).Delete("bucket", it.C)
+	assert.NoError(t, err)
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"ListObjects", "DeleteObjects"}, *names)
+	assert.Equal(t, [][]string{{"a", "b"}}, *batches)
+}
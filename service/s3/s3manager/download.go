@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
@@ -26,13 +28,14 @@ var DefaultDownloadOptions = &DownloadOptions{
 
 // DownloadOptions keeps tracks of extra options to pass to an Download() call.
 type DownloadOptions struct {
-	// The buffer size (in bytes) to use when buffering data into chunks and
-	// sending them as parts to S3. The minimum allowed part size is 5MB, and
-	// if this value is set to zero, the DefaultPartSize value will be used.
+	// The size (in bytes) of the byte ranges of the object to get with each
+	// GET request. If this value is set to zero, the DefaultDownloadPartSize
+	// value will be used.
 	PartSize int64
 
-	// The number of goroutines to spin up in parallel when sending parts.
-	// If this is set to zero, the DefaultConcurrency value will be used.
+	// The number of goroutines to spin up in parallel when getting parts.
+	// If this is set to zero, the DefaultDownloadConcurrency value will be
+	// used.
 	Concurrency int
 
 	// An S3 client to use when performing downloads. Leave this as nil to use
@@ -62,7 +65,15 @@ type Downloader struct {
 // It is safe to call this method for multiple objects and across concurrent
 // goroutines.
 func (d *Downloader) Download(w io.WriterAt, input *s3.GetObjectInput) (n int64, err error) {
-	impl := downloader{w: w, in: input, opts: *d.opts}
+	return d.DownloadWithContext(aws.BackgroundContext(), w, input)
+}
+
+// DownloadWithContext is the same as Download with the addition of the
+// ability to pass a context and additional request options, which are used
+// for each of the GET requests. The context must not be nil. If the context is
+// canceled the download stops, and the GET requests in flight are canceled.
+func (d *Downloader) DownloadWithContext(ctx aws.Context, w io.WriterAt, input *s3.GetObjectInput, opts ...aws.Option) (n int64, err error) {
+	impl := downloader{w: w, in: input, opts: *d.opts, ctx: ctx, reqOpts: opts}
 	return impl.download()
 }
 
@@ -72,6 +83,9 @@ type downloader struct {
 	in   *s3.GetObjectInput
 	w    io.WriterAt
 
+	ctx     aws.Context
+	reqOpts []aws.Option
+
 	wg sync.WaitGroup
 	m  sync.Mutex
 
@@ -156,6 +170,10 @@ func (d *downloader) downloadPart(ch chan dlchunk) {
 			break
 		}
 
+		if err := d.ctx.Err(); err != nil && d.geterr() == nil {
+			d.seterr(awserr.New(aws.ErrCodeRequestCanceled, "download canceled", err))
+		}
+
 		if d.geterr() == nil {
 			// Get the next byte range of data
 			in := &s3.GetObjectInput{}
@@ -164,7 +182,7 @@ func (d *downloader) downloadPart(ch chan dlchunk) {
 				chunk.start, chunk.start+chunk.size-1)
 			in.Range = &rng
 
-			resp, err := d.opts.S3.GetObject(in)
+			resp, err := d.opts.S3.GetObjectWithContext(d.ctx, in, d.reqOpts...)
 			if err != nil {
 				d.seterr(err)
 			} else {
@@ -199,6 +217,17 @@ func (d *downloader) setTotalBytes(resp *s3.GetObjectOutput) {
 		return
 	}
 
+	if resp.ContentRange == nil {
+		// The whole object was returned instead of the range, e.g. for an
+		// empty object.
+		if resp.ContentLength != nil {
+			d.totalBytes = *resp.ContentLength
+			return
+		}
+		d.err = awserr.New("SerializationError", "failed to get the size of the object, response has no Content-Range", nil)
+		return
+	}
+
 	parts := strings.Split(*resp.ContentRange, "/")
 	total, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
 	if err != nil {
@@ -2,6 +2,7 @@ package s3manager_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/internal/test/unit"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -163,3 +165,56 @@ func TestDownloadError(t *testing.T) {
 	assert.Equal(t, []string{"GetObject", "GetObject"}, *names)
 	assert.Equal(t, []byte{1, 0, 0}, w.buf)
 }
+
+func TestDownloadWithoutContentRange(t *testing.T) {
+	s, names, _ := dlLoggingSvc([]byte{1, 2, 3})
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse.Header.Del("Content-Range")
+		r.HTTPResponse.Header.Set("Content-Length", "3")
+	})
+
+	d := s3manager.NewDownloader(&s3manager.DownloadOptions{S3: s})
+	w := newDLWriter(3)
+	n, err := d.Download(w, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), n)
+	assert.Equal(t, []string{"GetObject"}, *names)
+	assert.Equal(t, []byte{1, 2, 3}, w.buf)
+}
+
+func TestDownloadWithContext(t *testing.T) {
+	s, names, _ := dlLoggingSvc([]byte{1, 2, 3})
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		cancel() // cancel once the first part was received
+	})
+
+	headers := []string{}
+	opt := func(r *aws.Request) {
+		r.Handlers.Build.PushBack(func(r *aws.Request) {
+			headers = append(headers, r.HTTPRequest.Header.Get("X-Test"))
+		})
+		r.Handlers.Build.PushBack(func(r *aws.Request) {
+			r.HTTPRequest.Header.Set("X-Test", "value")
+		})
+	}
+
+	d := s3manager.NewDownloader(&s3manager.DownloadOptions{S3: s, PartSize: 1, Concurrency: 1})
+	w := newDLWriter(3)
+	n, err := d.DownloadWithContext(ctx, w, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	}, opt)
+
+	if assert.Error(t, err) {
+		assert.Equal(t, aws.ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	}
+	assert.Equal(t, int64(1), n)
+	assert.Equal(t, []string{"GetObject"}, *names, "expect no parts to be requested once canceled")
+	assert.Equal(t, []byte{1, 0, 0}, w.buf)
+	assert.NotEmpty(t, headers, "expect the options to be applied to the requests")
+}
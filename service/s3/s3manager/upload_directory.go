@@ -0,0 +1,342 @@
+package s3manager
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// The default number of files to upload at a time when using
+// UploadDirectory().
+var DefaultUploadDirectoryConcurrency = 5
+
+// UploadDirectoryInput contains all input for directory uploads to Amazon S3.
+type UploadDirectoryInput struct {
+	// The bucket to upload the files to.
+	Bucket *string
+
+	// The local directory whose files, and the files of its subdirectories,
+	// are uploaded.
+	Dir string
+
+	// The prefix of the keys of the files' objects. The key of a file is the
+	// prefix followed by the path of the file relative to Dir, with slashes as
+	// separators, e.g. "backups/photos/cat.jpg" for the file photos/cat.jpg
+	// with the prefix "backups/".
+	KeyPrefix string
+
+	// The number of files to upload in parallel. Each file is uploaded with
+	// the Uploader's options. If this is set to zero, the
+	// DefaultUploadDirectoryConcurrency value will be used.
+	Concurrency int
+
+	// An optional function called with the path and the upload input of each
+	// file before it is uploaded, e.g. to set the ContentType or ACL of the
+	// file's object. The input's Bucket, Key and Body are set.
+	Before func(path string, input *UploadInput)
+}
+
+// UploadDirectoryOutput represents a response from the UploadDirectory() and
+// SyncDirectory() calls.
+type UploadDirectoryOutput struct {
+	// The keys of the files which were uploaded.
+	Uploaded []string
+
+	// The keys of the files which SyncDirectory skipped, because they were
+	// unchanged.
+	Skipped []string
+}
+
+// An UploadFileFailure is the error of a file which failed to be uploaded by
+// UploadDirectory or SyncDirectory. The index of the error is the index of the
+// file in the lexical order of the files' paths.
+//
+// Example:
+//
+//     _, err := u.UploadDirectory(input)
+//     if batchErr, ok := err.(awserr.BatchError); ok {
+//         for _, itemErr := range batchErr.Errors() {
+//             fileErr := itemErr.(s3manager.UploadFileFailure)
+//             fmt.Println("Failed to upload", fileErr.Path(), fileErr.Code(), fileErr.Message())
+//         }
+//     }
+//
+type UploadFileFailure interface {
+	awserr.BatchItemError
+
+	// Returns the path of the file.
+	Path() string
+
+	// Returns the key of the file's object.
+	Key() string
+}
+
+// An uploadFileFailure wraps the path and the key of a file which failed to
+// be uploaded.
+type uploadFileFailure struct {
+	awserr.BatchItemError
+	path string
+	key  string
+}
+
+// Path returns the path of the file.
+func (u uploadFileFailure) Path() string {
+	return u.path
+}
+
+// Key returns the key of the file's object.
+func (u uploadFileFailure) Key() string {
+	return u.key
+}
+
+// UploadDirectory uploads the files of a local directory, and of its
+// subdirectories, to S3, uploading Concurrency files in parallel. Files which
+// are not regular files, e.g. symbolic links, are not uploaded.
+//
+// A file which fails to be uploaded does not stop the other files from being
+// uploaded. The output lists the files which were uploaded, and the error
+// returned is an awserr.BatchError whose errors are the UploadFileFailures of
+// the files which failed, if any.
+//
+// It is safe to call this method for multiple directories and across
+// concurrent goroutines.
+func (u *Uploader) UploadDirectory(input *UploadDirectoryInput) (*UploadDirectoryOutput, error) {
+	d := directoryUploader{in: input, uploader: u}
+	return d.upload(false)
+}
+
+// SyncDirectory is the same as UploadDirectory, except that the files whose
+// objects are unchanged are skipped, like the "aws s3 sync" command. The
+// objects of the keys with KeyPrefix are listed before uploading, and a file
+// is unchanged if its object has the same size and ETag as the file would
+// have once uploaded with the Uploader's part size.
+//
+// S3 ETags are not the MD5 digests of the objects of some uploads, e.g. of
+// objects encrypted with SSE-KMS, or of multipart uploads with another part
+// size. The files of these objects are always uploaded.
+func (u *Uploader) SyncDirectory(input *UploadDirectoryInput) (*UploadDirectoryOutput, error) {
+	d := directoryUploader{in: input, uploader: u}
+	return d.upload(true)
+}
+
+// internal structure to manage a directory upload to S3.
+type directoryUploader struct {
+	in       *UploadDirectoryInput
+	uploader *Uploader
+
+	files   []dirFile
+	objects map[string]*s3.Object // the listed objects of the keys, when syncing
+}
+
+// A dirFile is a file of an uploaded directory.
+type dirFile struct {
+	path string
+	key  string
+	size int64
+}
+
+// upload uploads the directory's files, skipping the files which are
+// unchanged if skipUnchanged is true.
+func (d *directoryUploader) upload(skipUnchanged bool) (*UploadDirectoryOutput, error) {
+	if err := d.walk(); err != nil {
+		return nil, err
+	}
+	if skipUnchanged {
+		if err := d.listObjects(); err != nil {
+			return nil, err
+		}
+	}
+
+	concurrency := d.in.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultUploadDirectoryConcurrency
+	}
+
+	skipped := make([]bool, len(d.files))
+	errs := make([]awserr.BatchItemError, len(d.files))
+
+	var wg sync.WaitGroup
+	ch := make(chan int, concurrency)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range ch {
+				f := d.files[i]
+				if skipUnchanged && d.unchanged(f) {
+					skipped[i] = true
+					continue
+				}
+				if err := d.uploadFile(f); err != nil {
+					errs[i] = uploadFileFailure{
+						BatchItemError: awserr.NewBatchItemError(i, err.Code(), err.Message()),
+						path:           f.path,
+						key:            f.key,
+					}
+				}
+			}
+		}()
+	}
+	for i := range d.files {
+		ch <- i
+	}
+	close(ch)
+	wg.Wait()
+
+	out := &UploadDirectoryOutput{Uploaded: []string{}, Skipped: []string{}}
+	failed := []awserr.BatchItemError{}
+	for i, f := range d.files {
+		switch {
+		case errs[i] != nil:
+			failed = append(failed, errs[i])
+		case skipped[i]:
+			out.Skipped = append(out.Skipped, f.key)
+		default:
+			out.Uploaded = append(out.Uploaded, f.key)
+		}
+	}
+
+	if len(failed) > 0 {
+		return out, awserr.NewBatchError(awserr.ErrCodeBatchPartialFailure,
+			fmt.Sprintf("%d of %d files of %s failed to upload", len(failed), len(d.files), d.in.Dir), failed)
+	}
+	return out, nil
+}
+
+// walk lists the regular files of the directory, in lexical order.
+func (d *directoryUploader) walk() error {
+	err := filepath.Walk(d.in.Dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(d.in.Dir, path)
+		if err != nil {
+			return err
+		}
+		d.files = append(d.files, dirFile{
+			path: path,
+			key:  d.in.KeyPrefix + filepath.ToSlash(rel),
+			size: info.Size(),
+		})
+		return nil
+	})
+	if err != nil {
+		return awserr.New("ReadDirectory", "failed to read directory "+d.in.Dir, err)
+	}
+	return nil
+}
+
+// listObjects lists the objects of the keys with the key prefix.
+func (d *directoryUploader) listObjects() error {
+	d.objects = map[string]*s3.Object{}
+
+	svc := d.uploader.opts.S3
+	if svc == nil {
+		svc = s3.New(nil)
+	}
+	return svc.ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: d.in.Bucket,
+		Prefix: aws.String(d.in.KeyPrefix),
+	}, func(p *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, obj := range p.Contents {
+			d.objects[aws.StringValue(obj.Key)] = obj
+		}
+		return true
+	})
+}
+
+// uploadFile uploads the file to its key.
+func (d *directoryUploader) uploadFile(f dirFile) awserr.Error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return awserr.New("ReadFile", "failed to open file "+f.path, err)
+	}
+	defer file.Close()
+
+	input := &UploadInput{
+		Bucket: d.in.Bucket,
+		Key:    aws.String(f.key),
+		Body:   file,
+	}
+	if d.in.Before != nil {
+		d.in.Before(f.path, input)
+	}
+
+	if _, err := d.uploader.Upload(input); err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			return aerr
+		}
+		return awserr.New("UploadError", "failed to upload file "+f.path, err)
+	}
+	return nil
+}
+
+// unchanged returns true if the file's object has the size and ETag the
+// file would have once uploaded.
+func (d *directoryUploader) unchanged(f dirFile) bool {
+	obj, ok := d.objects[f.key]
+	if !ok || aws.LongValue(obj.Size) != f.size {
+		return false
+	}
+
+	etag, err := fileETag(f.path, f.size, d.partSize(f.size))
+	return err == nil && etag == strings.Trim(aws.StringValue(obj.ETag), `"`)
+}
+
+// partSize returns the part size the uploader uploads a file of the size
+// with.
+func (d *directoryUploader) partSize(size int64) int64 {
+	partSize := d.uploader.opts.PartSize
+	if partSize == 0 {
+		partSize = DefaultUploadPartSize
+	}
+	if size/partSize >= int64(MaxUploadParts) {
+		partSize = size / int64(MaxUploadParts)
+	}
+	return partSize
+}
+
+// fileETag returns the ETag of the object of a file of the size uploaded
+// with the part size: the MD5 digest of the file if it is uploaded in a
+// single part, or else the MD5 digest of the parts' MD5 digests followed by
+// the number of parts.
+func fileETag(path string, size, partSize int64) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if size <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, f); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	sums := md5.New()
+	parts := 0
+	for pos := int64(0); pos < size; pos += partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, io.NewSectionReader(f, pos, partSize)); err != nil {
+			return "", err
+		}
+		sums.Write(h.Sum(nil))
+		parts++
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sums.Sum(nil)), parts), nil
+}
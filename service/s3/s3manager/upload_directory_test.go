@@ -0,0 +1,189 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+// dirLoggingSvc returns a client listing the objects, and the keys and
+// inputs of its PutObject requests. The PutObject requests of the failed
+// keys fail.
+func dirLoggingSvc(objects []*s3.Object, failed ...string) (*s3.S3, *[]string, map[string]*s3.PutObjectInput) {
+	var m sync.Mutex
+	keys := []string{}
+	inputs := map[string]*s3.PutObjectInput{}
+
+	svc := s3.New(nil)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		switch in := r.Params.(type) {
+		case *s3.ListObjectsInput:
+			r.Data.(*s3.ListObjectsOutput).Contents = objects
+		case *s3.PutObjectInput:
+			key := aws.StringValue(in.Key)
+			if contains(failed, key) {
+				r.Error = awserr.New("AccessDenied", "Access Denied", nil)
+				return
+			}
+			keys = append(keys, key)
+			inputs[key] = in
+		}
+	})
+
+	return svc, &keys, inputs
+}
+
+// tempDir returns a temporary directory with the files, by relative path.
+func tempDir(t *testing.T, files map[string][]byte) string {
+	dir, err := ioutil.TempDir("", "s3manager")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, data := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func md5Hex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUploadDirectory(t *testing.T) {
+	dir := tempDir(t, map[string][]byte{
+		"a.txt":       []byte("a"),
+		"sub/b.txt":   []byte("b"),
+		"sub/c/d.txt": []byte("d"),
+	})
+	defer os.RemoveAll(dir)
+
+	s, keys, inputs := dirLoggingSvc(nil)
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s})
+	out, err := mgr.UploadDirectory(&s3manager.UploadDirectoryInput{
+		Bucket:    aws.String("Bucket"),
+		Dir:       dir,
+		KeyPrefix: "backup/",
+		Before: func(path string, input *s3manager.UploadInput) {
+			input.ContentType = aws.String("text/plain")
+		},
+	})
+
+	assert.NoError(t, err)
+	expected := []string{"backup/a.txt", "backup/sub/b.txt", "backup/sub/c/d.txt"}
+	assert.Equal(t, expected, out.Uploaded)
+	assert.Empty(t, out.Skipped)
+	sort.Strings(*keys)
+	assert.Equal(t, expected, *keys)
+	assert.Equal(t, "Bucket", aws.StringValue(inputs["backup/sub/b.txt"].Bucket))
+	assert.Equal(t, "text/plain", aws.StringValue(inputs["backup/sub/b.txt"].ContentType))
+}
+
+func TestSyncDirectory(t *testing.T) {
+	data := make([]byte, 1024*1024*6)
+	dir := tempDir(t, map[string][]byte{
+		"a.txt":     []byte("unchanged"),
+		"b.txt":     []byte("changed"),
+		"new.txt":   []byte("new"),
+		"large.bin": data,
+	})
+	defer os.RemoveAll(dir)
+
+	// the ETag of the 6MB file uploaded in 5MB parts
+	part1, part2 := md5.Sum(data[:1024*1024*5]), md5.Sum(data[1024*1024*5:])
+	largeETag := md5Hex(append(part1[:], part2[:]...)) + "-2"
+
+	s, keys, _ := dirLoggingSvc([]*s3.Object{
+		{Key: aws.String("a.txt"), Size: aws.Long(9), ETag: aws.String(`"` + md5Hex([]byte("unchanged")) + `"`)},
+		{Key: aws.String("b.txt"), Size: aws.Long(7), ETag: aws.String(`"` + md5Hex([]byte("original")) + `"`)},
+		{Key: aws.String("large.bin"), Size: aws.Long(int64(len(data))), ETag: aws.String(`"` + largeETag + `"`)},
+	})
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s})
+	out, err := mgr.SyncDirectory(&s3manager.UploadDirectoryInput{
+		Bucket: aws.String("Bucket"),
+		Dir:    dir,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b.txt", "new.txt"}, out.Uploaded)
+	assert.Equal(t, []string{"a.txt", "large.bin"}, out.Skipped)
+	sort.Strings(*keys)
+	assert.Equal(t, []string{"b.txt", "new.txt"}, *keys)
+}
+
+func TestUploadDirectoryFailure(t *testing.T) {
+	dir := tempDir(t, map[string][]byte{
+		"a.txt": []byte("a"),
+		"b.txt": []byte("b"),
+		"c.txt": []byte("c"),
+	})
+	defer os.RemoveAll(dir)
+
+	s, keys, _ := dirLoggingSvc(nil, "b.txt")
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s})
+	out, err := mgr.UploadDirectory(&s3manager.UploadDirectoryInput{
+		Bucket:      aws.String("Bucket"),
+		Dir:         dir,
+		Concurrency: 1,
+	})
+
+	assert.Equal(t, []string{"a.txt", "c.txt"}, *keys, "expect the other files to be uploaded")
+	assert.Equal(t, []string{"a.txt", "c.txt"}, out.Uploaded)
+
+	batchErr, ok := err.(awserr.BatchError)
+	if !assert.True(t, ok, "expect a BatchError") {
+		return
+	}
+	assert.Equal(t, awserr.ErrCodeBatchPartialFailure, batchErr.Code())
+	if assert.Len(t, batchErr.Errors(), 1) {
+		fileErr := batchErr.Errors()[0].(s3manager.UploadFileFailure)
+		assert.Equal(t, 1, fileErr.Index())
+		assert.Equal(t, "AccessDenied", fileErr.Code())
+		assert.Equal(t, "b.txt", fileErr.Key())
+		assert.Equal(t, filepath.Join(dir, "b.txt"), fileErr.Path())
+	}
+}
+
+func TestUploadDirectoryMissing(t *testing.T) {
+	s, _, _ := dirLoggingSvc(nil)
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s})
+	_, err := mgr.UploadDirectory(&s3manager.UploadDirectoryInput{
+		Bucket: aws.String("Bucket"),
+		Dir:    filepath.Join(os.TempDir(), "s3manager-missing-dir"),
+	})
+
+	if assert.Error(t, err) {
+		assert.Equal(t, "ReadDirectory", err.(awserr.Error).Code())
+	}
+}
@@ -0,0 +1,20 @@
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/signer/v4"
+)
+
+// useStreamingSignature marks the body of upload requests to be signed chunk
+// by chunk as it is sent, if the Config's S3UseStreamingSignature is set.
+// Requests whose payload hash is already set, e.g. to UNSIGNED-PAYLOAD, are
+// not changed.
+func useStreamingSignature(r *aws.Request) {
+	if !r.Config.S3UseStreamingSignature || r.Body == nil {
+		return
+	}
+	if r.HTTPRequest.Header.Get("X-Amz-Content-Sha256") != "" {
+		return
+	}
+	r.HTTPRequest.Header.Set("X-Amz-Content-Sha256", v4.StreamingPayload)
+}
@@ -0,0 +1,87 @@
+package s3_test
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+// decodeChunked returns the data of an aws-chunked body.
+func decodeChunked(t *testing.T, b []byte) []byte {
+	var data []byte
+	r := bufio.NewReader(bytes.NewReader(b))
+	for {
+		line, err := r.ReadString('\n')
+		assert.NoError(t, err)
+		assert.Contains(t, line, ";chunk-signature=")
+		size, err := strconv.ParseInt(line[:strings.Index(line, ";")], 16, 64)
+		assert.NoError(t, err)
+
+		chunk := make([]byte, size+2)
+		_, err = io.ReadFull(r, chunk)
+		assert.NoError(t, err)
+		data = append(data, chunk[:size]...)
+		if size == 0 {
+			return data
+		}
+	}
+}
+
+func TestPutObjectStreamingSignature(t *testing.T) {
+	body := bytes.Repeat([]byte("0123456789"), 10000)
+
+	svc := s3.New(&aws.Config{
+		S3UseStreamingSignature: true,
+		MaxRetries:              1,
+		RetryBaseDelay:          time.Millisecond,
+	})
+	svc.Handlers.Send.Clear()
+	attempts := 0
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		attempts++
+		assert.Equal(t, "STREAMING-AWS4-HMAC-SHA256-PAYLOAD", r.HTTPRequest.Header.Get("X-Amz-Content-Sha256"))
+		assert.Equal(t, "aws-chunked", r.HTTPRequest.Header.Get("Content-Encoding"))
+		assert.Equal(t, "100000", r.HTTPRequest.Header.Get("X-Amz-Decoded-Content-Length"))
+
+		b, err := ioutil.ReadAll(r.HTTPRequest.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, r.HTTPRequest.ContentLength, int64(len(b)))
+		assert.Equal(t, body, decodeChunked(t, b))
+
+		status := 200
+		if attempts == 1 {
+			status = 500 // retried
+		}
+		r.HTTPResponse = &http.Response{StatusCode: status, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(nil))}
+	})
+
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(body),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestPutObjectStreamingSignatureDisabled(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   strings.NewReader("hello world"),
+	})
+	assert.NoError(t, req.Sign())
+	assert.NotEqual(t, "STREAMING-AWS4-HMAC-SHA256-PAYLOAD", req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"))
+	assert.Empty(t, req.HTTPRequest.Header.Get("Content-Encoding"))
+}
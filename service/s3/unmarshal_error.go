@@ -2,6 +2,7 @@ package s3
 
 import (
 	"encoding/xml"
+	"fmt"
 	"io"
 	"strings"
 
@@ -9,10 +10,61 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
+// A RequestFailure is an awserr.RequestFailure of an S3 request, which also
+// provides the host ID of the failed request. Include the request ID and host
+// ID when contacting AWS support about a failed request.
+//
+//     if reqErr, ok := err.(s3.RequestFailure); ok {
+//         log.Println(reqErr.Code(), reqErr.RequestID(), reqErr.HostID())
+//     }
+type RequestFailure interface {
+	awserr.RequestFailure
+
+	// The host ID returned by S3 in the "X-Amz-Id-2" header.
+	HostID() string
+}
+
+type requestFailure struct {
+	awserr.RequestFailure
+	hostID string
+}
+
+// newRequestFailure returns a RequestFailure wrapping err with the status
+// code, request ID and host ID of the request's response.
+func newRequestFailure(r *aws.Request, err awserr.Error) RequestFailure {
+	return requestFailure{
+		RequestFailure: awserr.NewRequestFailure(err, r.HTTPResponse.StatusCode, r.RequestID),
+		hostID:         r.HTTPResponse.Header.Get("X-Amz-Id-2"),
+	}
+}
+
+// Error returns the string representation of the error, including the host
+// ID.
+func (e requestFailure) Error() string {
+	extra := fmt.Sprintf("status code: %d, request id: [%s], host id: [%s]",
+		e.StatusCode(), e.RequestID(), e.hostID)
+	return awserr.SprintError(e.Code(), e.Message(), extra, e.OrigErr())
+}
+
+// String returns the string representation of the error.
+func (e requestFailure) String() string {
+	return e.Error()
+}
+
+// HostID returns the host ID of the failed request.
+func (e requestFailure) HostID() string {
+	return e.hostID
+}
+
 type xmlErrorResponse struct {
 	XMLName xml.Name `xml:"Error"`
 	Code    string   `xml:"Code"`
 	Message string   `xml:"Message"`
+
+	// The canonical request and string to sign S3 computed, returned with
+	// SignatureDoesNotMatch errors.
+	CanonicalRequest string `xml:"CanonicalRequest"`
+	StringToSign     string `xml:"StringToSign"`
 }
 
 func unmarshalError(r *aws.Request) {
@@ -20,23 +72,30 @@ func unmarshalError(r *aws.Request) {
 
 	if r.HTTPResponse.ContentLength == int64(0) {
 		// No body, use status code to generate an awserr.Error
-		r.Error = awserr.NewRequestFailure(
-			awserr.New(strings.Replace(r.HTTPResponse.Status, " ", "", -1), r.HTTPResponse.Status, nil),
-			r.HTTPResponse.StatusCode,
-			"",
-		)
+		r.Error = newRequestFailure(r,
+			awserr.New(strings.Replace(r.HTTPResponse.Status, " ", "", -1), r.HTTPResponse.Status, nil))
 		return
 	}
 
 	resp := &xmlErrorResponse{}
 	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
 	if err != nil && err != io.EOF {
-		r.Error = awserr.New("SerializationError", "failed to decode S3 XML error response", nil)
+		r.Error = newRequestFailure(r,
+			awserr.New("SerializationError", "failed to decode S3 XML error response", err))
 	} else {
-		r.Error = awserr.NewRequestFailure(
-			awserr.New(resp.Code, resp.Message, nil),
-			r.HTTPResponse.StatusCode,
-			"",
-		)
+		r.Error = newRequestFailure(r, awserr.New(resp.Code, resp.Message, nil))
+		if resp.Code == "SignatureDoesNotMatch" && r.Config.LogLevel.Matches(aws.LogDebugWithSigning) {
+			r.Config.Logger.Log(fmt.Sprintf(logSignatureMismatchMsg, resp.CanonicalRequest, resp.StringToSign))
+		}
 	}
 }
+
+// logSignatureMismatchMsg logs the signing details S3 computed for a request
+// whose signature did not match, which can be compared with the details the
+// request was signed with, logged by the signer.
+const logSignatureMismatchMsg = `DEBUG: Response Signature Mismatch:
+---[ SERVER CANONICAL STRING ]-----------------------
+%s
+---[ SERVER STRING TO SIGN ]-------------------------
+%s
+-----------------------------------------------------`
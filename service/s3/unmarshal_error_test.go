@@ -2,8 +2,10 @@ package s3_test
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -51,3 +53,89 @@ func TestStatusCodeError(t *testing.T) {
 		assert.Equal(t, test.message, err.(awserr.Error).Message())
 	}
 }
+
+func TestInvalidErrorBody(t *testing.T) {
+	s := s3.New(nil)
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		body := "<html><body>Service Unavailable"
+		r.HTTPResponse = &http.Response{
+			ContentLength: int64(len(body)),
+			StatusCode:    503,
+			Status:        "Service Unavailable",
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	s.Config.MaxRetries = 0
+	_, err := s.PutBucketACL(&s3.PutBucketACLInput{
+		Bucket: aws.String("bucket"), ACL: aws.String("public-read"),
+	})
+
+	if assert.Error(t, err) {
+		assert.Equal(t, "SerializationError", err.(awserr.Error).Code())
+		assert.NotNil(t, err.(awserr.Error).OrigErr(), "Expect the decoding error")
+		assert.Equal(t, 503, err.(awserr.RequestFailure).StatusCode())
+	}
+}
+
+func TestRequestFailureHostID(t *testing.T) {
+	s := s3.New(nil)
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		body := `<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`
+		r.HTTPResponse = &http.Response{
+			ContentLength: int64(len(body)),
+			StatusCode:    404,
+			Status:        "Not Found",
+			Header: http.Header{
+				"X-Amz-Request-Id": []string{"request-id"},
+				"X-Amz-Id-2":       []string{"host-id"},
+			},
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	_, err := s.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+
+	reqErr, ok := err.(s3.RequestFailure)
+	if assert.True(t, ok, "Expect an s3.RequestFailure") {
+		assert.Equal(t, "NoSuchBucket", reqErr.Code())
+		assert.Equal(t, 404, reqErr.StatusCode())
+		assert.Equal(t, "request-id", reqErr.RequestID())
+		assert.Equal(t, "host-id", reqErr.HostID())
+		assert.Contains(t, reqErr.Error(), "host id: [host-id]")
+	}
+}
+
+func TestSignatureDoesNotMatchLogging(t *testing.T) {
+	var logged []string
+	s := s3.New(&aws.Config{
+		LogLevel: aws.LogDebugWithSigning,
+		Logger: aws.LoggerFunc(func(args ...interface{}) {
+			logged = append(logged, fmt.Sprint(args...))
+		}),
+	})
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		body := `<Error><Code>SignatureDoesNotMatch</Code><Message>The request signature we calculated does not match the signature you provided.</Message>` +
+			`<StringToSign>AWS4-HMAC-SHA256&#10;20150830T123600Z</StringToSign><CanonicalRequest>GET&#10;/bucket</CanonicalRequest></Error>`
+		r.HTTPResponse = &http.Response{
+			ContentLength: int64(len(body)),
+			StatusCode:    403,
+			Status:        "Forbidden",
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	_, err := s.ListObjects(&s3.ListObjectsInput{Bucket: aws.String("bucket")})
+	assert.Error(t, err)
+	assert.Equal(t, "SignatureDoesNotMatch", err.(awserr.Error).Code())
+
+	var mismatch string
+	for _, msg := range logged {
+		if strings.Contains(msg, "Response Signature Mismatch") {
+			mismatch = msg
+		}
+	}
+	assert.Contains(t, mismatch, "---[ SERVER CANONICAL STRING ]-----------------------\nGET\n/bucket\n")
+	assert.Contains(t, mismatch, "---[ SERVER STRING TO SIGN ]-------------------------\nAWS4-HMAC-SHA256\n20150830T123600Z\n")
+	assert.Contains(t, strings.Join(logged, "\n"), "---[ CANONICAL STRING  ]", "Expect the request's signing details to be logged")
+}
@@ -0,0 +1,18 @@
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/signer/v4"
+)
+
+// useUnsignedPayload marks requests sent over HTTPS to be signed without the
+// hash of their body, if the Config's S3UseUnsignedPayload is set.
+func useUnsignedPayload(r *aws.Request) {
+	if !r.Config.S3UseUnsignedPayload || r.HTTPRequest.URL.Scheme != "https" {
+		return
+	}
+	if r.HTTPRequest.Header.Get("X-Amz-Content-Sha256") != "" {
+		return
+	}
+	r.HTTPRequest.Header.Set("X-Amz-Content-Sha256", v4.UnsignedPayload)
+}
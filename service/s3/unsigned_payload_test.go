@@ -0,0 +1,54 @@
+package s3_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+// unreadableBody panics if it is read, e.g. to compute its hash.
+type unreadableBody struct{ *strings.Reader }
+
+func (unreadableBody) Read([]byte) (int, error) { panic("body should not be read") }
+
+func TestUnsignedPayload(t *testing.T) {
+	svc := s3.New(&aws.Config{S3UseUnsignedPayload: true})
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   unreadableBody{strings.NewReader("hello world")},
+	})
+	assert.NoError(t, req.Sign())
+	assert.Equal(t, "UNSIGNED-PAYLOAD", req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"))
+	assert.Contains(t, req.HTTPRequest.Header.Get("Authorization"), "x-amz-content-sha256",
+		"Expect the payload hash to be signed")
+}
+
+func TestUnsignedPayloadHTTP(t *testing.T) {
+	svc := s3.New(&aws.Config{S3UseUnsignedPayload: true, DisableSSL: true})
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   strings.NewReader("hello world"),
+	})
+	assert.NoError(t, req.Sign())
+	assert.Equal(t, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"), "Expect the body to be signed over HTTP")
+}
+
+func TestUnsignedPayloadOverStreaming(t *testing.T) {
+	svc := s3.New(&aws.Config{S3UseUnsignedPayload: true, S3UseStreamingSignature: true})
+	req, _ := svc.UploadPartRequest(&s3.UploadPartInput{
+		Bucket:     aws.String("bucket"),
+		Key:        aws.String("key"),
+		PartNumber: aws.Long(1),
+		UploadID:   aws.String("upload"),
+		Body:       strings.NewReader("hello world"),
+	})
+	assert.NoError(t, req.Sign())
+	assert.Equal(t, "UNSIGNED-PAYLOAD", req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"))
+	assert.Empty(t, req.HTTPRequest.Header.Get("Content-Encoding"))
+}
@@ -4,6 +4,7 @@
 package sesiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
 )
 
@@ -11,45 +12,97 @@ import (
 type SESAPI interface {
 	DeleteIdentity(*ses.DeleteIdentityInput) (*ses.DeleteIdentityOutput, error)
 
+	DeleteIdentityWithContext(aws.Context, *ses.DeleteIdentityInput, ...aws.Option) (*ses.DeleteIdentityOutput, error)
+
 	DeleteIdentityPolicy(*ses.DeleteIdentityPolicyInput) (*ses.DeleteIdentityPolicyOutput, error)
 
+	DeleteIdentityPolicyWithContext(aws.Context, *ses.DeleteIdentityPolicyInput, ...aws.Option) (*ses.DeleteIdentityPolicyOutput, error)
+
 	DeleteVerifiedEmailAddress(*ses.DeleteVerifiedEmailAddressInput) (*ses.DeleteVerifiedEmailAddressOutput, error)
 
+	DeleteVerifiedEmailAddressWithContext(aws.Context, *ses.DeleteVerifiedEmailAddressInput, ...aws.Option) (*ses.DeleteVerifiedEmailAddressOutput, error)
+
 	GetIdentityDKIMAttributes(*ses.GetIdentityDKIMAttributesInput) (*ses.GetIdentityDKIMAttributesOutput, error)
 
+	GetIdentityDKIMAttributesWithContext(aws.Context, *ses.GetIdentityDKIMAttributesInput, ...aws.Option) (*ses.GetIdentityDKIMAttributesOutput, error)
+
 	GetIdentityNotificationAttributes(*ses.GetIdentityNotificationAttributesInput) (*ses.GetIdentityNotificationAttributesOutput, error)
 
+	GetIdentityNotificationAttributesWithContext(aws.Context, *ses.GetIdentityNotificationAttributesInput, ...aws.Option) (*ses.GetIdentityNotificationAttributesOutput, error)
+
 	GetIdentityPolicies(*ses.GetIdentityPoliciesInput) (*ses.GetIdentityPoliciesOutput, error)
 
+	GetIdentityPoliciesWithContext(aws.Context, *ses.GetIdentityPoliciesInput, ...aws.Option) (*ses.GetIdentityPoliciesOutput, error)
+
 	GetIdentityVerificationAttributes(*ses.GetIdentityVerificationAttributesInput) (*ses.GetIdentityVerificationAttributesOutput, error)
 
+	GetIdentityVerificationAttributesWithContext(aws.Context, *ses.GetIdentityVerificationAttributesInput, ...aws.Option) (*ses.GetIdentityVerificationAttributesOutput, error)
+
 	GetSendQuota(*ses.GetSendQuotaInput) (*ses.GetSendQuotaOutput, error)
 
+	GetSendQuotaWithContext(aws.Context, *ses.GetSendQuotaInput, ...aws.Option) (*ses.GetSendQuotaOutput, error)
+
 	GetSendStatistics(*ses.GetSendStatisticsInput) (*ses.GetSendStatisticsOutput, error)
 
+	GetSendStatisticsWithContext(aws.Context, *ses.GetSendStatisticsInput, ...aws.Option) (*ses.GetSendStatisticsOutput, error)
+
 	ListIdentities(*ses.ListIdentitiesInput) (*ses.ListIdentitiesOutput, error)
 
+	ListIdentitiesWithContext(aws.Context, *ses.ListIdentitiesInput, ...aws.Option) (*ses.ListIdentitiesOutput, error)
+
+	ListIdentitiesPages(*ses.ListIdentitiesInput, func(*ses.ListIdentitiesOutput, bool) bool) error
+
+	ListIdentitiesPagesWithContext(aws.Context, *ses.ListIdentitiesInput, func(*ses.ListIdentitiesOutput, bool) bool, ...aws.Option) error
+
 	ListIdentityPolicies(*ses.ListIdentityPoliciesInput) (*ses.ListIdentityPoliciesOutput, error)
 
+	ListIdentityPoliciesWithContext(aws.Context, *ses.ListIdentityPoliciesInput, ...aws.Option) (*ses.ListIdentityPoliciesOutput, error)
+
 	ListVerifiedEmailAddresses(*ses.ListVerifiedEmailAddressesInput) (*ses.ListVerifiedEmailAddressesOutput, error)
 
+	ListVerifiedEmailAddressesWithContext(aws.Context, *ses.ListVerifiedEmailAddressesInput, ...aws.Option) (*ses.ListVerifiedEmailAddressesOutput, error)
+
 	PutIdentityPolicy(*ses.PutIdentityPolicyInput) (*ses.PutIdentityPolicyOutput, error)
 
+	PutIdentityPolicyWithContext(aws.Context, *ses.PutIdentityPolicyInput, ...aws.Option) (*ses.PutIdentityPolicyOutput, error)
+
 	SendEmail(*ses.SendEmailInput) (*ses.SendEmailOutput, error)
 
+	SendEmailWithContext(aws.Context, *ses.SendEmailInput, ...aws.Option) (*ses.SendEmailOutput, error)
+
 	SendRawEmail(*ses.SendRawEmailInput) (*ses.SendRawEmailOutput, error)
 
+	SendRawEmailWithContext(aws.Context, *ses.SendRawEmailInput, ...aws.Option) (*ses.SendRawEmailOutput, error)
+
 	SetIdentityDKIMEnabled(*ses.SetIdentityDKIMEnabledInput) (*ses.SetIdentityDKIMEnabledOutput, error)
 
+	SetIdentityDKIMEnabledWithContext(aws.Context, *ses.SetIdentityDKIMEnabledInput, ...aws.Option) (*ses.SetIdentityDKIMEnabledOutput, error)
+
 	SetIdentityFeedbackForwardingEnabled(*ses.SetIdentityFeedbackForwardingEnabledInput) (*ses.SetIdentityFeedbackForwardingEnabledOutput, error)
 
+	SetIdentityFeedbackForwardingEnabledWithContext(aws.Context, *ses.SetIdentityFeedbackForwardingEnabledInput, ...aws.Option) (*ses.SetIdentityFeedbackForwardingEnabledOutput, error)
+
 	SetIdentityNotificationTopic(*ses.SetIdentityNotificationTopicInput) (*ses.SetIdentityNotificationTopicOutput, error)
 
+	SetIdentityNotificationTopicWithContext(aws.Context, *ses.SetIdentityNotificationTopicInput, ...aws.Option) (*ses.SetIdentityNotificationTopicOutput, error)
+
 	VerifyDomainDKIM(*ses.VerifyDomainDKIMInput) (*ses.VerifyDomainDKIMOutput, error)
 
+	VerifyDomainDKIMWithContext(aws.Context, *ses.VerifyDomainDKIMInput, ...aws.Option) (*ses.VerifyDomainDKIMOutput, error)
+
 	VerifyDomainIdentity(*ses.VerifyDomainIdentityInput) (*ses.VerifyDomainIdentityOutput, error)
 
+	VerifyDomainIdentityWithContext(aws.Context, *ses.VerifyDomainIdentityInput, ...aws.Option) (*ses.VerifyDomainIdentityOutput, error)
+
 	VerifyEmailAddress(*ses.VerifyEmailAddressInput) (*ses.VerifyEmailAddressOutput, error)
 
+	VerifyEmailAddressWithContext(aws.Context, *ses.VerifyEmailAddressInput, ...aws.Option) (*ses.VerifyEmailAddressOutput, error)
+
 	VerifyEmailIdentity(*ses.VerifyEmailIdentityInput) (*ses.VerifyEmailIdentityOutput, error)
+
+	VerifyEmailIdentityWithContext(aws.Context, *ses.VerifyEmailIdentityInput, ...aws.Option) (*ses.VerifyEmailIdentityOutput, error)
+
+	WaitUntilIdentityExists(*ses.GetIdentityVerificationAttributesInput) error
+
+	WaitUntilIdentityExistsWithContext(aws.Context, *ses.GetIdentityVerificationAttributesInput, ...aws.WaiterOption) error
 }
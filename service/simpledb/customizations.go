@@ -0,0 +1,15 @@
+package simpledb
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/protocol/query"
+)
+
+func init() {
+	initService = func(s *aws.Service) {
+		// SimpleDB's error responses are not in the format of other query
+		// protocol services.
+		s.Handlers.UnmarshalError.Remove(query.UnmarshalErrorHandler)
+		s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	}
+}
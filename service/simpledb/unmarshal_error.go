@@ -0,0 +1,56 @@
+package simpledb
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+type xmlErrorDetail struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type xmlErrorResponse struct {
+	XMLName   xml.Name         `xml:"Response"`
+	Errors    []xmlErrorDetail `xml:"Errors>Error"`
+	RequestID string           `xml:"RequestID"`
+}
+
+// unmarshalError unmarshals a SimpleDB error response. Only the first of the
+// response's errors is returned.
+func unmarshalError(r *aws.Request) {
+	defer r.HTTPResponse.Body.Close()
+
+	resp := &xmlErrorResponse{}
+	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
+	if err != nil && err != io.EOF {
+		r.Error = awserr.NewRequestFailure(
+			awserr.New("SerializationError", "failed to decode SimpleDB XML error response", err),
+			r.HTTPResponse.StatusCode,
+			r.RequestID,
+		)
+		return
+	}
+
+	if resp.RequestID != "" {
+		r.RequestID = resp.RequestID
+	}
+
+	// Responses without a body, e.g. to HEAD requests, only have a status.
+	detail := xmlErrorDetail{
+		Code:    http.StatusText(r.HTTPResponse.StatusCode),
+		Message: http.StatusText(r.HTTPResponse.StatusCode),
+	}
+	if len(resp.Errors) > 0 {
+		detail = resp.Errors[0]
+	}
+	r.Error = awserr.NewRequestFailure(
+		awserr.New(detail.Code, detail.Message, nil),
+		r.HTTPResponse.StatusCode,
+		r.RequestID,
+	)
+}
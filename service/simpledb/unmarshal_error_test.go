@@ -0,0 +1,76 @@
+package simpledb_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/test/unit"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+func TestSignedWithV2(t *testing.T) {
+	svc := simpledb.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		q, _ := url.ParseQuery(string(body))
+		assert.Equal(t, "ListDomains", q.Get("Action"))
+		assert.Equal(t, "AKID", q.Get("AWSAccessKeyId"))
+		assert.Equal(t, "2", q.Get("SignatureVersion"))
+		assert.NotEmpty(t, q.Get("Signature"))
+		assert.Empty(t, r.HTTPRequest.Header.Get("Authorization"))
+		assert.Equal(t, int64(len(body)), r.HTTPRequest.ContentLength)
+
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(nil))}
+	})
+
+	_, err := svc.ListDomains(&simpledb.ListDomainsInput{})
+	assert.NoError(t, err)
+}
+
+func TestUnmarshalError(t *testing.T) {
+	svc := simpledb.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		body := `<?xml version="1.0"?>
+<Response><Errors><Error><Code>NoSuchDomain</Code><Message>The specified domain does not exist.</Message><BoxUsage>0.0000071759</BoxUsage></Error></Errors><RequestID>request-id</RequestID></Response>`
+		r.HTTPResponse = &http.Response{
+			StatusCode: 400,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+
+	_, err := svc.DomainMetadata(&simpledb.DomainMetadataInput{DomainName: aws.String("domain")})
+	assert.Error(t, err)
+
+	reqErr := err.(awserr.RequestFailure)
+	assert.Equal(t, simpledb.ErrCodeNoSuchDomain, reqErr.Code())
+	assert.Equal(t, "The specified domain does not exist.", reqErr.Message())
+	assert.Equal(t, 400, reqErr.StatusCode())
+	assert.Equal(t, "request-id", reqErr.RequestID())
+}
+
+func TestUnmarshalErrorNoBody(t *testing.T) {
+	svc := simpledb.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: 403,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}
+	})
+
+	_, err := svc.ListDomains(&simpledb.ListDomainsInput{})
+	assert.Error(t, err)
+	assert.Equal(t, "Forbidden", err.(awserr.Error).Code())
+}
@@ -0,0 +1,59 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package sns
+
+const (
+	// ErrCodeAuthorizationErrorException for service response error code
+	// "AuthorizationError".
+	//
+	// Indicates that the user has been denied access to the requested resource.
+	ErrCodeAuthorizationErrorException = "AuthorizationError"
+
+	// ErrCodeEndpointDisabledException for service response error code
+	// "EndpointDisabled".
+	//
+	// Exception error indicating endpoint disabled.
+	ErrCodeEndpointDisabledException = "EndpointDisabled"
+
+	// ErrCodeInternalErrorException for service response error code
+	// "InternalError".
+	//
+	// Indicates an internal service error.
+	ErrCodeInternalErrorException = "InternalError"
+
+	// ErrCodeInvalidParameterException for service response error code
+	// "InvalidParameter".
+	//
+	// Indicates that a request parameter does not comply with the associated constraints.
+	ErrCodeInvalidParameterException = "InvalidParameter"
+
+	// ErrCodeInvalidParameterValueException for service response error code
+	// "ParameterValueInvalid".
+	//
+	// Indicates that a request parameter does not comply with the associated constraints.
+	ErrCodeInvalidParameterValueException = "ParameterValueInvalid"
+
+	// ErrCodeNotFoundException for service response error code
+	// "NotFound".
+	//
+	// Indicates that the requested resource does not exist.
+	ErrCodeNotFoundException = "NotFound"
+
+	// ErrCodePlatformApplicationDisabledException for service response error code
+	// "PlatformApplicationDisabled".
+	//
+	// Exception error indicating platform application disabled.
+	ErrCodePlatformApplicationDisabledException = "PlatformApplicationDisabled"
+
+	// ErrCodeSubscriptionLimitExceededException for service response error code
+	// "SubscriptionLimitExceeded".
+	//
+	// Indicates that the customer already owns the maximum allowed number of subscriptions.
+	ErrCodeSubscriptionLimitExceededException = "SubscriptionLimitExceeded"
+
+	// ErrCodeTopicLimitExceededException for service response error code
+	// "TopicLimitExceeded".
+	//
+	// Indicates that the customer already owns the maximum allowed number of topics.
+	ErrCodeTopicLimitExceededException = "TopicLimitExceeded"
+)
@@ -4,6 +4,7 @@
 package snsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
 )
 
@@ -11,51 +12,121 @@ import (
 type SNSAPI interface {
 	AddPermission(*sns.AddPermissionInput) (*sns.AddPermissionOutput, error)
 
+	AddPermissionWithContext(aws.Context, *sns.AddPermissionInput, ...aws.Option) (*sns.AddPermissionOutput, error)
+
 	ConfirmSubscription(*sns.ConfirmSubscriptionInput) (*sns.ConfirmSubscriptionOutput, error)
 
+	ConfirmSubscriptionWithContext(aws.Context, *sns.ConfirmSubscriptionInput, ...aws.Option) (*sns.ConfirmSubscriptionOutput, error)
+
 	CreatePlatformApplication(*sns.CreatePlatformApplicationInput) (*sns.CreatePlatformApplicationOutput, error)
 
+	CreatePlatformApplicationWithContext(aws.Context, *sns.CreatePlatformApplicationInput, ...aws.Option) (*sns.CreatePlatformApplicationOutput, error)
+
 	CreatePlatformEndpoint(*sns.CreatePlatformEndpointInput) (*sns.CreatePlatformEndpointOutput, error)
 
+	CreatePlatformEndpointWithContext(aws.Context, *sns.CreatePlatformEndpointInput, ...aws.Option) (*sns.CreatePlatformEndpointOutput, error)
+
 	CreateTopic(*sns.CreateTopicInput) (*sns.CreateTopicOutput, error)
 
+	CreateTopicWithContext(aws.Context, *sns.CreateTopicInput, ...aws.Option) (*sns.CreateTopicOutput, error)
+
 	DeleteEndpoint(*sns.DeleteEndpointInput) (*sns.DeleteEndpointOutput, error)
 
+	DeleteEndpointWithContext(aws.Context, *sns.DeleteEndpointInput, ...aws.Option) (*sns.DeleteEndpointOutput, error)
+
 	DeletePlatformApplication(*sns.DeletePlatformApplicationInput) (*sns.DeletePlatformApplicationOutput, error)
 
+	DeletePlatformApplicationWithContext(aws.Context, *sns.DeletePlatformApplicationInput, ...aws.Option) (*sns.DeletePlatformApplicationOutput, error)
+
 	DeleteTopic(*sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error)
 
+	DeleteTopicWithContext(aws.Context, *sns.DeleteTopicInput, ...aws.Option) (*sns.DeleteTopicOutput, error)
+
 	GetEndpointAttributes(*sns.GetEndpointAttributesInput) (*sns.GetEndpointAttributesOutput, error)
 
+	GetEndpointAttributesWithContext(aws.Context, *sns.GetEndpointAttributesInput, ...aws.Option) (*sns.GetEndpointAttributesOutput, error)
+
 	GetPlatformApplicationAttributes(*sns.GetPlatformApplicationAttributesInput) (*sns.GetPlatformApplicationAttributesOutput, error)
 
+	GetPlatformApplicationAttributesWithContext(aws.Context, *sns.GetPlatformApplicationAttributesInput, ...aws.Option) (*sns.GetPlatformApplicationAttributesOutput, error)
+
 	GetSubscriptionAttributes(*sns.GetSubscriptionAttributesInput) (*sns.GetSubscriptionAttributesOutput, error)
 
+	GetSubscriptionAttributesWithContext(aws.Context, *sns.GetSubscriptionAttributesInput, ...aws.Option) (*sns.GetSubscriptionAttributesOutput, error)
+
 	GetTopicAttributes(*sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error)
 
+	GetTopicAttributesWithContext(aws.Context, *sns.GetTopicAttributesInput, ...aws.Option) (*sns.GetTopicAttributesOutput, error)
+
 	ListEndpointsByPlatformApplication(*sns.ListEndpointsByPlatformApplicationInput) (*sns.ListEndpointsByPlatformApplicationOutput, error)
 
+	ListEndpointsByPlatformApplicationWithContext(aws.Context, *sns.ListEndpointsByPlatformApplicationInput, ...aws.Option) (*sns.ListEndpointsByPlatformApplicationOutput, error)
+
+	ListEndpointsByPlatformApplicationPages(*sns.ListEndpointsByPlatformApplicationInput, func(*sns.ListEndpointsByPlatformApplicationOutput, bool) bool) error
+
+	ListEndpointsByPlatformApplicationPagesWithContext(aws.Context, *sns.ListEndpointsByPlatformApplicationInput, func(*sns.ListEndpointsByPlatformApplicationOutput, bool) bool, ...aws.Option) error
+
 	ListPlatformApplications(*sns.ListPlatformApplicationsInput) (*sns.ListPlatformApplicationsOutput, error)
 
+	ListPlatformApplicationsWithContext(aws.Context, *sns.ListPlatformApplicationsInput, ...aws.Option) (*sns.ListPlatformApplicationsOutput, error)
+
+	ListPlatformApplicationsPages(*sns.ListPlatformApplicationsInput, func(*sns.ListPlatformApplicationsOutput, bool) bool) error
+
+	ListPlatformApplicationsPagesWithContext(aws.Context, *sns.ListPlatformApplicationsInput, func(*sns.ListPlatformApplicationsOutput, bool) bool, ...aws.Option) error
+
 	ListSubscriptions(*sns.ListSubscriptionsInput) (*sns.ListSubscriptionsOutput, error)
 
+	ListSubscriptionsWithContext(aws.Context, *sns.ListSubscriptionsInput, ...aws.Option) (*sns.ListSubscriptionsOutput, error)
+
+	ListSubscriptionsPages(*sns.ListSubscriptionsInput, func(*sns.ListSubscriptionsOutput, bool) bool) error
+
+	ListSubscriptionsPagesWithContext(aws.Context, *sns.ListSubscriptionsInput, func(*sns.ListSubscriptionsOutput, bool) bool, ...aws.Option) error
+
 	ListSubscriptionsByTopic(*sns.ListSubscriptionsByTopicInput) (*sns.ListSubscriptionsByTopicOutput, error)
 
+	ListSubscriptionsByTopicWithContext(aws.Context, *sns.ListSubscriptionsByTopicInput, ...aws.Option) (*sns.ListSubscriptionsByTopicOutput, error)
+
+	ListSubscriptionsByTopicPages(*sns.ListSubscriptionsByTopicInput, func(*sns.ListSubscriptionsByTopicOutput, bool) bool) error
+
+	ListSubscriptionsByTopicPagesWithContext(aws.Context, *sns.ListSubscriptionsByTopicInput, func(*sns.ListSubscriptionsByTopicOutput, bool) bool, ...aws.Option) error
+
 	ListTopics(*sns.ListTopicsInput) (*sns.ListTopicsOutput, error)
 
+	ListTopicsWithContext(aws.Context, *sns.ListTopicsInput, ...aws.Option) (*sns.ListTopicsOutput, error)
+
+	ListTopicsPages(*sns.ListTopicsInput, func(*sns.ListTopicsOutput, bool) bool) error
+
+	ListTopicsPagesWithContext(aws.Context, *sns.ListTopicsInput, func(*sns.ListTopicsOutput, bool) bool, ...aws.Option) error
+
 	Publish(*sns.PublishInput) (*sns.PublishOutput, error)
 
+	PublishWithContext(aws.Context, *sns.PublishInput, ...aws.Option) (*sns.PublishOutput, error)
+
 	RemovePermission(*sns.RemovePermissionInput) (*sns.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(aws.Context, *sns.RemovePermissionInput, ...aws.Option) (*sns.RemovePermissionOutput, error)
+
 	SetEndpointAttributes(*sns.SetEndpointAttributesInput) (*sns.SetEndpointAttributesOutput, error)
 
+	SetEndpointAttributesWithContext(aws.Context, *sns.SetEndpointAttributesInput, ...aws.Option) (*sns.SetEndpointAttributesOutput, error)
+
 	SetPlatformApplicationAttributes(*sns.SetPlatformApplicationAttributesInput) (*sns.SetPlatformApplicationAttributesOutput, error)
 
+	SetPlatformApplicationAttributesWithContext(aws.Context, *sns.SetPlatformApplicationAttributesInput, ...aws.Option) (*sns.SetPlatformApplicationAttributesOutput, error)
+
 	SetSubscriptionAttributes(*sns.SetSubscriptionAttributesInput) (*sns.SetSubscriptionAttributesOutput, error)
 
+	SetSubscriptionAttributesWithContext(aws.Context, *sns.SetSubscriptionAttributesInput, ...aws.Option) (*sns.SetSubscriptionAttributesOutput, error)
+
 	SetTopicAttributes(*sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error)
 
+	SetTopicAttributesWithContext(aws.Context, *sns.SetTopicAttributesInput, ...aws.Option) (*sns.SetTopicAttributesOutput, error)
+
 	Subscribe(*sns.SubscribeInput) (*sns.SubscribeOutput, error)
 
+	SubscribeWithContext(aws.Context, *sns.SubscribeInput, ...aws.Option) (*sns.SubscribeOutput, error)
+
 	Unsubscribe(*sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error)
+
+	UnsubscribeWithContext(aws.Context, *sns.UnsubscribeInput, ...aws.Option) (*sns.UnsubscribeOutput, error)
 }
@@ -0,0 +1,67 @@
+package sqs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// SendMessageBatchError returns an awserr.BatchError for the entries of in
+// which failed to be sent, or nil if all entries were sent. The index of
+// each item error is the index of the entry in in.Entries.
+func SendMessageBatchError(in *SendMessageBatchInput, out *SendMessageBatchOutput) error {
+	ids := make([]*string, len(in.Entries))
+	for i, entry := range in.Entries {
+		ids[i] = entry.ID
+	}
+	return batchError(opSendMessageBatch, ids, out.Failed)
+}
+
+// DeleteMessageBatchError returns an awserr.BatchError for the entries of in
+// which failed to be deleted, or nil if all entries were deleted. The index of
+// each item error is the index of the entry in in.Entries.
+func DeleteMessageBatchError(in *DeleteMessageBatchInput, out *DeleteMessageBatchOutput) error {
+	ids := make([]*string, len(in.Entries))
+	for i, entry := range in.Entries {
+		ids[i] = entry.ID
+	}
+	return batchError(opDeleteMessageBatch, ids, out.Failed)
+}
+
+// ChangeMessageVisibilityBatchError returns an awserr.BatchError for the
+// entries of in whose visibility failed to be changed, or nil if the
+// visibility of all entries was changed. The index of each item error is the
+// index of the entry in in.Entries.
+func ChangeMessageVisibilityBatchError(in *ChangeMessageVisibilityBatchInput, out *ChangeMessageVisibilityBatchOutput) error {
+	ids := make([]*string, len(in.Entries))
+	for i, entry := range in.Entries {
+		ids[i] = entry.ID
+	}
+	return batchError(opChangeMessageVisibilityBatch, ids, out.Failed)
+}
+
+// batchError returns the error of the failed entries of the batch operation,
+// where ids are the IDs of the entries of the request.
+func batchError(op string, ids []*string, failed []*BatchResultErrorEntry) error {
+	if len(failed) == 0 {
+		return nil
+	}
+
+	index := map[string]int{}
+	for i, id := range ids {
+		index[aws.StringValue(id)] = i
+	}
+
+	errs := make([]awserr.BatchItemError, 0, len(failed))
+	for _, entry := range failed {
+		i, ok := index[aws.StringValue(entry.ID)]
+		if !ok {
+			i = -1
+		}
+		errs = append(errs, awserr.NewBatchItemError(i,
+			aws.StringValue(entry.Code), aws.StringValue(entry.Message)))
+	}
+	return awserr.NewBatchError(awserr.ErrCodeBatchPartialFailure,
+		fmt.Sprintf("%d of %d entries of %s failed", len(failed), len(ids), op), errs)
+}
@@ -0,0 +1,54 @@
+package sqs_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendMessageBatchError(t *testing.T) {
+	in := &sqs.SendMessageBatchInput{
+		Entries: []*sqs.SendMessageBatchRequestEntry{
+			{ID: aws.String("a"), MessageBody: aws.String("body a")},
+			{ID: aws.String("b"), MessageBody: aws.String("body b")},
+			{ID: aws.String("c"), MessageBody: aws.String("body c")},
+		},
+	}
+	out := &sqs.SendMessageBatchOutput{
+		Successful: []*sqs.SendMessageBatchResultEntry{{ID: aws.String("a")}},
+		Failed: []*sqs.BatchResultErrorEntry{
+			{ID: aws.String("c"), Code: aws.String("InvalidParameterValue"), Message: aws.String("bad c")},
+			{ID: aws.String("b"), Code: aws.String("InternalError"), Message: aws.String("bad b")},
+		},
+	}
+
+	err := sqs.SendMessageBatchError(in, out)
+	batchErr, ok := err.(awserr.BatchError)
+	assert.True(t, ok, "Expect a BatchError")
+	assert.Equal(t, awserr.ErrCodeBatchPartialFailure, batchErr.Code())
+	assert.Equal(t, "2 of 3 entries of SendMessageBatch failed", batchErr.Message())
+
+	errs := batchErr.Errors()
+	assert.Len(t, errs, 2)
+	assert.Equal(t, 2, errs[0].Index())
+	assert.Equal(t, "InvalidParameterValue", errs[0].Code())
+	assert.Equal(t, "bad c", errs[0].Message())
+	assert.Equal(t, 1, errs[1].Index())
+	assert.Equal(t, "InternalError", errs[1].Code())
+	assert.Equal(t, "BatchPartialFailure: 2 of 3 entries of SendMessageBatch failed\n"+
+		"\t[2] InvalidParameterValue: bad c\n"+
+		"\t[1] InternalError: bad b", err.Error())
+}
+
+func TestSendMessageBatchErrorNoFailures(t *testing.T) {
+	in := &sqs.SendMessageBatchInput{
+		Entries: []*sqs.SendMessageBatchRequestEntry{{ID: aws.String("a")}},
+	}
+	out := &sqs.SendMessageBatchOutput{
+		Successful: []*sqs.SendMessageBatchResultEntry{{ID: aws.String("a")}},
+	}
+	assert.Nil(t, sqs.SendMessageBatchError(in, out))
+}
@@ -0,0 +1,109 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package sqs
+
+const (
+	// ErrCodeBatchEntryIDsNotDistinct for service response error code
+	// "AWS.SimpleQueueService.BatchEntryIdsNotDistinct".
+	//
+	// Two or more batch entries have the same Id in the request.
+	ErrCodeBatchEntryIDsNotDistinct = "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
+
+	// ErrCodeBatchRequestTooLong for service response error code
+	// "AWS.SimpleQueueService.BatchRequestTooLong".
+	//
+	// The length of all the messages put together is more than the limit.
+	ErrCodeBatchRequestTooLong = "AWS.SimpleQueueService.BatchRequestTooLong"
+
+	// ErrCodeEmptyBatchRequest for service response error code
+	// "AWS.SimpleQueueService.EmptyBatchRequest".
+	//
+	// Batch request does not contain an entry.
+	ErrCodeEmptyBatchRequest = "AWS.SimpleQueueService.EmptyBatchRequest"
+
+	// ErrCodeInvalidAttributeName for service response error code
+	// "InvalidAttributeName".
+	//
+	// The attribute referred to does not exist.
+	ErrCodeInvalidAttributeName = "InvalidAttributeName"
+
+	// ErrCodeInvalidBatchEntryID for service response error code
+	// "AWS.SimpleQueueService.InvalidBatchEntryId".
+	//
+	// The Id of a batch entry in a batch request does not abide by the specification.
+	ErrCodeInvalidBatchEntryID = "AWS.SimpleQueueService.InvalidBatchEntryId"
+
+	// ErrCodeInvalidIDFormat for service response error code
+	// "InvalidIdFormat".
+	//
+	// The receipt handle is not valid for the current version.
+	ErrCodeInvalidIDFormat = "InvalidIdFormat"
+
+	// ErrCodeInvalidMessageContents for service response error code
+	// "InvalidMessageContents".
+	//
+	// The message contains characters outside the allowed set.
+	ErrCodeInvalidMessageContents = "InvalidMessageContents"
+
+	// ErrCodeMessageNotInflight for service response error code
+	// "AWS.SimpleQueueService.MessageNotInflight".
+	//
+	// The message referred to is not in flight.
+	ErrCodeMessageNotInflight = "AWS.SimpleQueueService.MessageNotInflight"
+
+	// ErrCodeOverLimit for service response error code
+	// "OverLimit".
+	//
+	// The action that you requested would violate a limit. For example, ReceiveMessage
+	// returns this error if the maximum number of messages inflight has already
+	// been reached. AddPermission returns this error if the maximum number of permissions
+	// for the queue has already been reached.
+	ErrCodeOverLimit = "OverLimit"
+
+	// ErrCodePurgeQueueInProgress for service response error code
+	// "AWS.SimpleQueueService.PurgeQueueInProgress".
+	//
+	// Indicates that the specified queue previously received a PurgeQueue request
+	// within the last 60 seconds, the time it can take to delete the messages in
+	// the queue.
+	ErrCodePurgeQueueInProgress = "AWS.SimpleQueueService.PurgeQueueInProgress"
+
+	// ErrCodeQueueDeletedRecently for service response error code
+	// "AWS.SimpleQueueService.QueueDeletedRecently".
+	//
+	// You must wait 60 seconds after deleting a queue before you can create another
+	// with the same name.
+	ErrCodeQueueDeletedRecently = "AWS.SimpleQueueService.QueueDeletedRecently"
+
+	// ErrCodeQueueDoesNotExist for service response error code
+	// "AWS.SimpleQueueService.NonExistentQueue".
+	//
+	// The queue referred to does not exist.
+	ErrCodeQueueDoesNotExist = "AWS.SimpleQueueService.NonExistentQueue"
+
+	// ErrCodeQueueNameExists for service response error code
+	// "QueueAlreadyExists".
+	//
+	// A queue already exists with this name. Amazon SQS returns this error only
+	// if the request includes attributes whose values differ from those of the
+	// existing queue.
+	ErrCodeQueueNameExists = "QueueAlreadyExists"
+
+	// ErrCodeReceiptHandleIsInvalid for service response error code
+	// "ReceiptHandleIsInvalid".
+	//
+	// The receipt handle provided is not valid.
+	ErrCodeReceiptHandleIsInvalid = "ReceiptHandleIsInvalid"
+
+	// ErrCodeTooManyEntriesInBatchRequest for service response error code
+	// "AWS.SimpleQueueService.TooManyEntriesInBatchRequest".
+	//
+	// Batch request contains more number of entries than permissible.
+	ErrCodeTooManyEntriesInBatchRequest = "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
+
+	// ErrCodeUnsupportedOperation for service response error code
+	// "AWS.SimpleQueueService.UnsupportedOperation".
+	//
+	// Error code 400. Unsupported operation.
+	ErrCodeUnsupportedOperation = "AWS.SimpleQueueService.UnsupportedOperation"
+)
@@ -4,6 +4,7 @@
 package sqsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 )
 
@@ -11,35 +12,69 @@ import (
 type SQSAPI interface {
 	AddPermission(*sqs.AddPermissionInput) (*sqs.AddPermissionOutput, error)
 
+	AddPermissionWithContext(aws.Context, *sqs.AddPermissionInput, ...aws.Option) (*sqs.AddPermissionOutput, error)
+
 	ChangeMessageVisibility(*sqs.ChangeMessageVisibilityInput) (*sqs.ChangeMessageVisibilityOutput, error)
 
+	ChangeMessageVisibilityWithContext(aws.Context, *sqs.ChangeMessageVisibilityInput, ...aws.Option) (*sqs.ChangeMessageVisibilityOutput, error)
+
 	ChangeMessageVisibilityBatch(*sqs.ChangeMessageVisibilityBatchInput) (*sqs.ChangeMessageVisibilityBatchOutput, error)
 
+	ChangeMessageVisibilityBatchWithContext(aws.Context, *sqs.ChangeMessageVisibilityBatchInput, ...aws.Option) (*sqs.ChangeMessageVisibilityBatchOutput, error)
+
 	CreateQueue(*sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error)
 
+	CreateQueueWithContext(aws.Context, *sqs.CreateQueueInput, ...aws.Option) (*sqs.CreateQueueOutput, error)
+
 	DeleteMessage(*sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error)
 
+	DeleteMessageWithContext(aws.Context, *sqs.DeleteMessageInput, ...aws.Option) (*sqs.DeleteMessageOutput, error)
+
 	DeleteMessageBatch(*sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error)
 
+	DeleteMessageBatchWithContext(aws.Context, *sqs.DeleteMessageBatchInput, ...aws.Option) (*sqs.DeleteMessageBatchOutput, error)
+
 	DeleteQueue(*sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error)
 
+	DeleteQueueWithContext(aws.Context, *sqs.DeleteQueueInput, ...aws.Option) (*sqs.DeleteQueueOutput, error)
+
 	GetQueueAttributes(*sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
 
+	GetQueueAttributesWithContext(aws.Context, *sqs.GetQueueAttributesInput, ...aws.Option) (*sqs.GetQueueAttributesOutput, error)
+
 	GetQueueURL(*sqs.GetQueueURLInput) (*sqs.GetQueueURLOutput, error)
 
+	GetQueueURLWithContext(aws.Context, *sqs.GetQueueURLInput, ...aws.Option) (*sqs.GetQueueURLOutput, error)
+
 	ListDeadLetterSourceQueues(*sqs.ListDeadLetterSourceQueuesInput) (*sqs.ListDeadLetterSourceQueuesOutput, error)
 
+	ListDeadLetterSourceQueuesWithContext(aws.Context, *sqs.ListDeadLetterSourceQueuesInput, ...aws.Option) (*sqs.ListDeadLetterSourceQueuesOutput, error)
+
 	ListQueues(*sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error)
 
+	ListQueuesWithContext(aws.Context, *sqs.ListQueuesInput, ...aws.Option) (*sqs.ListQueuesOutput, error)
+
 	PurgeQueue(*sqs.PurgeQueueInput) (*sqs.PurgeQueueOutput, error)
 
+	PurgeQueueWithContext(aws.Context, *sqs.PurgeQueueInput, ...aws.Option) (*sqs.PurgeQueueOutput, error)
+
 	ReceiveMessage(*sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
 
+	ReceiveMessageWithContext(aws.Context, *sqs.ReceiveMessageInput, ...aws.Option) (*sqs.ReceiveMessageOutput, error)
+
 	RemovePermission(*sqs.RemovePermissionInput) (*sqs.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(aws.Context, *sqs.RemovePermissionInput, ...aws.Option) (*sqs.RemovePermissionOutput, error)
+
 	SendMessage(*sqs.SendMessageInput) (*sqs.SendMessageOutput, error)
 
+	SendMessageWithContext(aws.Context, *sqs.SendMessageInput, ...aws.Option) (*sqs.SendMessageOutput, error)
+
 	SendMessageBatch(*sqs.SendMessageBatchInput) (*sqs.SendMessageBatchOutput, error)
 
+	SendMessageBatchWithContext(aws.Context, *sqs.SendMessageBatchInput, ...aws.Option) (*sqs.SendMessageBatchOutput, error)
+
 	SetQueueAttributes(*sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error)
+
+	SetQueueAttributesWithContext(aws.Context, *sqs.SetQueueAttributesInput, ...aws.Option) (*sqs.SetQueueAttributesOutput, error)
 }
@@ -45,6 +45,18 @@ func (c *SSM) CreateAssociation(input *CreateAssociationInput) (*CreateAssociati
 	return out, err
 }
 
+// CreateAssociationWithContext is the same as CreateAssociation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) CreateAssociationWithContext(ctx aws.Context, input *CreateAssociationInput, opts ...aws.Option) (*CreateAssociationOutput, error) {
+	req, out := c.CreateAssociationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAssociationBatch = "CreateAssociationBatch"
 
 // CreateAssociationBatchRequest generates a request for the CreateAssociationBatch operation.
@@ -80,6 +92,18 @@ func (c *SSM) CreateAssociationBatch(input *CreateAssociationBatchInput) (*Creat
 	return out, err
 }
 
+// CreateAssociationBatchWithContext is the same as CreateAssociationBatch with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) CreateAssociationBatchWithContext(ctx aws.Context, input *CreateAssociationBatchInput, opts ...aws.Option) (*CreateAssociationBatchOutput, error) {
+	req, out := c.CreateAssociationBatchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDocument = "CreateDocument"
 
 // CreateDocumentRequest generates a request for the CreateDocument operation.
@@ -110,6 +134,18 @@ func (c *SSM) CreateDocument(input *CreateDocumentInput) (*CreateDocumentOutput,
 	return out, err
 }
 
+// CreateDocumentWithContext is the same as CreateDocument with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) CreateDocumentWithContext(ctx aws.Context, input *CreateDocumentInput, opts ...aws.Option) (*CreateDocumentOutput, error) {
+	req, out := c.CreateDocumentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAssociation = "DeleteAssociation"
 
 // DeleteAssociationRequest generates a request for the DeleteAssociation operation.
@@ -143,6 +179,18 @@ func (c *SSM) DeleteAssociation(input *DeleteAssociationInput) (*DeleteAssociati
 	return out, err
 }
 
+// DeleteAssociationWithContext is the same as DeleteAssociation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) DeleteAssociationWithContext(ctx aws.Context, input *DeleteAssociationInput, opts ...aws.Option) (*DeleteAssociationOutput, error) {
+	req, out := c.DeleteAssociationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDocument = "DeleteDocument"
 
 // DeleteDocumentRequest generates a request for the DeleteDocument operation.
@@ -173,6 +221,18 @@ func (c *SSM) DeleteDocument(input *DeleteDocumentInput) (*DeleteDocumentOutput,
 	return out, err
 }
 
+// DeleteDocumentWithContext is the same as DeleteDocument with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) DeleteDocumentWithContext(ctx aws.Context, input *DeleteDocumentInput, opts ...aws.Option) (*DeleteDocumentOutput, error) {
+	req, out := c.DeleteDocumentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAssociation = "DescribeAssociation"
 
 // DescribeAssociationRequest generates a request for the DescribeAssociation operation.
@@ -200,6 +260,18 @@ func (c *SSM) DescribeAssociation(input *DescribeAssociationInput) (*DescribeAss
 	return out, err
 }
 
+// DescribeAssociationWithContext is the same as DescribeAssociation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) DescribeAssociationWithContext(ctx aws.Context, input *DescribeAssociationInput, opts ...aws.Option) (*DescribeAssociationOutput, error) {
+	req, out := c.DescribeAssociationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDocument = "DescribeDocument"
 
 // DescribeDocumentRequest generates a request for the DescribeDocument operation.
@@ -227,6 +299,18 @@ func (c *SSM) DescribeDocument(input *DescribeDocumentInput) (*DescribeDocumentO
 	return out, err
 }
 
+// DescribeDocumentWithContext is the same as DescribeDocument with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) DescribeDocumentWithContext(ctx aws.Context, input *DescribeDocumentInput, opts ...aws.Option) (*DescribeDocumentOutput, error) {
+	req, out := c.DescribeDocumentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDocument = "GetDocument"
 
 // GetDocumentRequest generates a request for the GetDocument operation.
@@ -254,6 +338,18 @@ func (c *SSM) GetDocument(input *GetDocumentInput) (*GetDocumentOutput, error) {
 	return out, err
 }
 
+// GetDocumentWithContext is the same as GetDocument with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) GetDocumentWithContext(ctx aws.Context, input *GetDocumentInput, opts ...aws.Option) (*GetDocumentOutput, error) {
+	req, out := c.GetDocumentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListAssociations = "ListAssociations"
 
 // ListAssociationsRequest generates a request for the ListAssociations operation.
@@ -281,6 +377,18 @@ func (c *SSM) ListAssociations(input *ListAssociationsInput) (*ListAssociationsO
 	return out, err
 }
 
+// ListAssociationsWithContext is the same as ListAssociations with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) ListAssociationsWithContext(ctx aws.Context, input *ListAssociationsInput, opts ...aws.Option) (*ListAssociationsOutput, error) {
+	req, out := c.ListAssociationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListDocuments = "ListDocuments"
 
 // ListDocumentsRequest generates a request for the ListDocuments operation.
@@ -308,6 +416,18 @@ func (c *SSM) ListDocuments(input *ListDocumentsInput) (*ListDocumentsOutput, er
 	return out, err
 }
 
+// ListDocumentsWithContext is the same as ListDocuments with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) ListDocumentsWithContext(ctx aws.Context, input *ListDocumentsInput, opts ...aws.Option) (*ListDocumentsOutput, error) {
+	req, out := c.ListDocumentsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAssociationStatus = "UpdateAssociationStatus"
 
 // UpdateAssociationStatusRequest generates a request for the UpdateAssociationStatus operation.
@@ -336,13 +456,25 @@ func (c *SSM) UpdateAssociationStatus(input *UpdateAssociationStatusInput) (*Upd
 	return out, err
 }
 
+// UpdateAssociationStatusWithContext is the same as UpdateAssociationStatus with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SSM) UpdateAssociationStatusWithContext(ctx aws.Context, input *UpdateAssociationStatusInput, opts ...aws.Option) (*UpdateAssociationStatusOutput, error) {
+	req, out := c.UpdateAssociationStatusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Describes an association of a configuration document and an instance.
 type Association struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64"`
 
 	metadataAssociation `json:"-" xml:"-"`
 }
@@ -367,10 +499,10 @@ type AssociationDescription struct {
 	Date *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64"`
 
 	// The association status.
 	Status *AssociationStatus `type:"structure"`
@@ -398,7 +530,7 @@ type AssociationFilter struct {
 	Key *string `locationName:"key" type:"string" required:"true"`
 
 	// The filter value.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataAssociationFilter `json:"-" xml:"-"`
 }
@@ -420,13 +552,13 @@ func (s AssociationFilter) GoString() string {
 // Describes an association status.
 type AssociationStatus struct {
 	// A user-defined string.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" max:"1024"`
 
 	// The date when the status changed.
 	Date *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// The reason for the status.
-	Message *string `type:"string" required:"true"`
+	Message *string `type:"string" max:"1024" required:"true"`
 
 	// The status.
 	Name *string `type:"string" required:"true"`
@@ -496,10 +628,10 @@ func (s CreateAssociationBatchOutput) GoString() string {
 // Describes the association of a configuration document and an instance.
 type CreateAssociationBatchRequestEntry struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64"`
 
 	metadataCreateAssociationBatchRequestEntry `json:"-" xml:"-"`
 }
@@ -520,10 +652,10 @@ func (s CreateAssociationBatchRequestEntry) GoString() string {
 
 type CreateAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataCreateAssociationInput `json:"-" xml:"-"`
 }
@@ -566,10 +698,10 @@ func (s CreateAssociationOutput) GoString() string {
 type CreateDocumentInput struct {
 	// A valid JSON file. For more information about the contents of this file,
 	// see Configuration Document (http://docs.aws.amazon.com/ssm/latest/APIReference/aws-ssm-document.html).
-	Content *string `type:"string" required:"true"`
+	Content *string `type:"string" min:"1" required:"true"`
 
 	// A name for the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataCreateDocumentInput `json:"-" xml:"-"`
 }
@@ -611,10 +743,10 @@ func (s CreateDocumentOutput) GoString() string {
 
 type DeleteAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataDeleteAssociationInput `json:"-" xml:"-"`
 }
@@ -653,7 +785,7 @@ func (s DeleteAssociationOutput) GoString() string {
 
 type DeleteDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataDeleteDocumentInput `json:"-" xml:"-"`
 }
@@ -692,10 +824,10 @@ func (s DeleteDocumentOutput) GoString() string {
 
 type DescribeAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataDescribeAssociationInput `json:"-" xml:"-"`
 }
@@ -737,7 +869,7 @@ func (s DescribeAssociationOutput) GoString() string {
 
 type DescribeDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataDescribeDocumentInput `json:"-" xml:"-"`
 }
@@ -783,7 +915,7 @@ type DocumentDescription struct {
 	CreatedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64"`
 
 	// The SHA1 hash of the document, which you can use for verification purposes.
 	SHA1 *string `locationName:"Sha1" type:"string"`
@@ -814,7 +946,7 @@ type DocumentFilter struct {
 	Key *string `locationName:"key" type:"string" required:"true"`
 
 	// The value of the filter.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataDocumentFilter `json:"-" xml:"-"`
 }
@@ -836,7 +968,7 @@ func (s DocumentFilter) GoString() string {
 // Describes the name of a configuration document.
 type DocumentIdentifier struct {
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64"`
 
 	metadataDocumentIdentifier `json:"-" xml:"-"`
 }
@@ -885,7 +1017,7 @@ func (s FailedCreateAssociation) GoString() string {
 
 type GetDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataGetDocumentInput `json:"-" xml:"-"`
 }
@@ -906,10 +1038,10 @@ func (s GetDocumentInput) GoString() string {
 
 type GetDocumentOutput struct {
 	// The contents of the configuration document.
-	Content *string `type:"string"`
+	Content *string `type:"string" min:"1"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64"`
 
 	metadataGetDocumentOutput `json:"-" xml:"-"`
 }
@@ -930,12 +1062,12 @@ func (s GetDocumentOutput) GoString() string {
 
 type ListAssociationsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" required:"true"`
+	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" min:"1" required:"true"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -985,12 +1117,12 @@ func (s ListAssociationsOutput) GoString() string {
 
 type ListDocumentsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list"`
+	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list" min:"1"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -1043,10 +1175,10 @@ type UpdateAssociationStatusInput struct {
 	AssociationStatus *AssociationStatus `type:"structure" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" required:"true"`
 
 	metadataUpdateAssociationStatusInput `json:"-" xml:"-"`
 }
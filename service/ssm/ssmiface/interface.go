@@ -4,6 +4,7 @@
 package ssmiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
@@ -11,23 +12,45 @@ import (
 type SSMAPI interface {
 	CreateAssociation(*ssm.CreateAssociationInput) (*ssm.CreateAssociationOutput, error)
 
+	CreateAssociationWithContext(aws.Context, *ssm.CreateAssociationInput, ...aws.Option) (*ssm.CreateAssociationOutput, error)
+
 	CreateAssociationBatch(*ssm.CreateAssociationBatchInput) (*ssm.CreateAssociationBatchOutput, error)
 
+	CreateAssociationBatchWithContext(aws.Context, *ssm.CreateAssociationBatchInput, ...aws.Option) (*ssm.CreateAssociationBatchOutput, error)
+
 	CreateDocument(*ssm.CreateDocumentInput) (*ssm.CreateDocumentOutput, error)
 
+	CreateDocumentWithContext(aws.Context, *ssm.CreateDocumentInput, ...aws.Option) (*ssm.CreateDocumentOutput, error)
+
 	DeleteAssociation(*ssm.DeleteAssociationInput) (*ssm.DeleteAssociationOutput, error)
 
+	DeleteAssociationWithContext(aws.Context, *ssm.DeleteAssociationInput, ...aws.Option) (*ssm.DeleteAssociationOutput, error)
+
 	DeleteDocument(*ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error)
 
+	DeleteDocumentWithContext(aws.Context, *ssm.DeleteDocumentInput, ...aws.Option) (*ssm.DeleteDocumentOutput, error)
+
 	DescribeAssociation(*ssm.DescribeAssociationInput) (*ssm.DescribeAssociationOutput, error)
 
+	DescribeAssociationWithContext(aws.Context, *ssm.DescribeAssociationInput, ...aws.Option) (*ssm.DescribeAssociationOutput, error)
+
 	DescribeDocument(*ssm.DescribeDocumentInput) (*ssm.DescribeDocumentOutput, error)
 
+	DescribeDocumentWithContext(aws.Context, *ssm.DescribeDocumentInput, ...aws.Option) (*ssm.DescribeDocumentOutput, error)
+
 	GetDocument(*ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error)
 
+	GetDocumentWithContext(aws.Context, *ssm.GetDocumentInput, ...aws.Option) (*ssm.GetDocumentOutput, error)
+
 	ListAssociations(*ssm.ListAssociationsInput) (*ssm.ListAssociationsOutput, error)
 
+	ListAssociationsWithContext(aws.Context, *ssm.ListAssociationsInput, ...aws.Option) (*ssm.ListAssociationsOutput, error)
+
 	ListDocuments(*ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error)
 
+	ListDocumentsWithContext(aws.Context, *ssm.ListDocumentsInput, ...aws.Option) (*ssm.ListDocumentsOutput, error)
+
 	UpdateAssociationStatus(*ssm.UpdateAssociationStatusInput) (*ssm.UpdateAssociationStatusOutput, error)
+
+	UpdateAssociationStatusWithContext(aws.Context, *ssm.UpdateAssociationStatusInput, ...aws.Option) (*ssm.UpdateAssociationStatusOutput, error)
 }
@@ -0,0 +1,12 @@
+package sso
+
+import "github.com/aws/aws-sdk-go/aws"
+
+func init() {
+	initRequest = func(r *aws.Request) {
+		switch r.Operation.Name {
+		case opGetRoleCredentials:
+			r.Handlers.Sign.Clear() // authorized by the access token instead
+		}
+	}
+}
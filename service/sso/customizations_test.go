@@ -0,0 +1,28 @@
+package sso_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/stretchr/testify/assert"
+)
+
+var svc = sso.New(&aws.Config{
+	Region: "us-west-2",
+})
+
+func TestUnsignedRequest_GetRoleCredentials(t *testing.T) {
+	req, _ := svc.GetRoleCredentialsRequest(&sso.GetRoleCredentialsInput{
+		AccessToken: aws.String("TOKEN"),
+		AccountID:   aws.String("123456789012"),
+		RoleName:    aws.String("ROLE"),
+	})
+
+	err := req.Sign()
+	assert.NoError(t, err)
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Authorization"))
+	assert.Equal(t, "TOKEN", req.HTTPRequest.Header.Get("x-amz-sso_bearer_token"))
+	assert.Equal(t, "https://portal.sso.us-west-2.amazonaws.com/federation/credentials?account_id=123456789012&role_name=ROLE",
+		req.HTTPRequest.URL.String())
+}
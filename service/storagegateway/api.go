@@ -45,6 +45,18 @@ func (c *StorageGateway) ActivateGateway(input *ActivateGatewayInput) (*Activate
 	return out, err
 }
 
+// ActivateGatewayWithContext is the same as ActivateGateway with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ActivateGatewayWithContext(ctx aws.Context, input *ActivateGatewayInput, opts ...aws.Option) (*ActivateGatewayOutput, error) {
+	req, out := c.ActivateGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddCache = "AddCache"
 
 // AddCacheRequest generates a request for the AddCache operation.
@@ -78,6 +90,18 @@ func (c *StorageGateway) AddCache(input *AddCacheInput) (*AddCacheOutput, error)
 	return out, err
 }
 
+// AddCacheWithContext is the same as AddCache with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) AddCacheWithContext(ctx aws.Context, input *AddCacheInput, opts ...aws.Option) (*AddCacheOutput, error) {
+	req, out := c.AddCacheRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddUploadBuffer = "AddUploadBuffer"
 
 // AddUploadBufferRequest generates a request for the AddUploadBuffer operation.
@@ -111,6 +135,18 @@ func (c *StorageGateway) AddUploadBuffer(input *AddUploadBufferInput) (*AddUploa
 	return out, err
 }
 
+// AddUploadBufferWithContext is the same as AddUploadBuffer with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) AddUploadBufferWithContext(ctx aws.Context, input *AddUploadBufferInput, opts ...aws.Option) (*AddUploadBufferOutput, error) {
+	req, out := c.AddUploadBufferRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddWorkingStorage = "AddWorkingStorage"
 
 // AddWorkingStorageRequest generates a request for the AddWorkingStorage operation.
@@ -148,6 +184,18 @@ func (c *StorageGateway) AddWorkingStorage(input *AddWorkingStorageInput) (*AddW
 	return out, err
 }
 
+// AddWorkingStorageWithContext is the same as AddWorkingStorage with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) AddWorkingStorageWithContext(ctx aws.Context, input *AddWorkingStorageInput, opts ...aws.Option) (*AddWorkingStorageOutput, error) {
+	req, out := c.AddWorkingStorageRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelArchival = "CancelArchival"
 
 // CancelArchivalRequest generates a request for the CancelArchival operation.
@@ -176,6 +224,18 @@ func (c *StorageGateway) CancelArchival(input *CancelArchivalInput) (*CancelArch
 	return out, err
 }
 
+// CancelArchivalWithContext is the same as CancelArchival with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) CancelArchivalWithContext(ctx aws.Context, input *CancelArchivalInput, opts ...aws.Option) (*CancelArchivalOutput, error) {
+	req, out := c.CancelArchivalRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelRetrieval = "CancelRetrieval"
 
 // CancelRetrievalRequest generates a request for the CancelRetrieval operation.
@@ -205,6 +265,18 @@ func (c *StorageGateway) CancelRetrieval(input *CancelRetrievalInput) (*CancelRe
 	return out, err
 }
 
+// CancelRetrievalWithContext is the same as CancelRetrieval with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) CancelRetrievalWithContext(ctx aws.Context, input *CancelRetrievalInput, opts ...aws.Option) (*CancelRetrievalOutput, error) {
+	req, out := c.CancelRetrievalRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCachediSCSIVolume = "CreateCachediSCSIVolume"
 
 // CreateCachediSCSIVolumeRequest generates a request for the CreateCachediSCSIVolume operation.
@@ -242,6 +314,18 @@ func (c *StorageGateway) CreateCachediSCSIVolume(input *CreateCachediSCSIVolumeI
 	return out, err
 }
 
+// CreateCachediSCSIVolumeWithContext is the same as CreateCachediSCSIVolume with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) CreateCachediSCSIVolumeWithContext(ctx aws.Context, input *CreateCachediSCSIVolumeInput, opts ...aws.Option) (*CreateCachediSCSIVolumeOutput, error) {
+	req, out := c.CreateCachediSCSIVolumeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshot = "CreateSnapshot"
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -289,6 +373,18 @@ func (c *StorageGateway) CreateSnapshot(input *CreateSnapshotInput) (*CreateSnap
 	return out, err
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) CreateSnapshotWithContext(ctx aws.Context, input *CreateSnapshotInput, opts ...aws.Option) (*CreateSnapshotOutput, error) {
+	req, out := c.CreateSnapshotRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshotFromVolumeRecoveryPoint = "CreateSnapshotFromVolumeRecoveryPoint"
 
 // CreateSnapshotFromVolumeRecoveryPointRequest generates a request for the CreateSnapshotFromVolumeRecoveryPoint operation.
@@ -333,6 +429,18 @@ func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPoint(input *CreateSnap
 	return out, err
 }
 
+// CreateSnapshotFromVolumeRecoveryPointWithContext is the same as CreateSnapshotFromVolumeRecoveryPoint with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPointWithContext(ctx aws.Context, input *CreateSnapshotFromVolumeRecoveryPointInput, opts ...aws.Option) (*CreateSnapshotFromVolumeRecoveryPointOutput, error) {
+	req, out := c.CreateSnapshotFromVolumeRecoveryPointRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStorediSCSIVolume = "CreateStorediSCSIVolume"
 
 // CreateStorediSCSIVolumeRequest generates a request for the CreateStorediSCSIVolume operation.
@@ -372,6 +480,18 @@ func (c *StorageGateway) CreateStorediSCSIVolume(input *CreateStorediSCSIVolumeI
 	return out, err
 }
 
+// CreateStorediSCSIVolumeWithContext is the same as CreateStorediSCSIVolume with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) CreateStorediSCSIVolumeWithContext(ctx aws.Context, input *CreateStorediSCSIVolumeInput, opts ...aws.Option) (*CreateStorediSCSIVolumeOutput, error) {
+	req, out := c.CreateStorediSCSIVolumeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTapes = "CreateTapes"
 
 // CreateTapesRequest generates a request for the CreateTapes operation.
@@ -403,6 +523,18 @@ func (c *StorageGateway) CreateTapes(input *CreateTapesInput) (*CreateTapesOutpu
 	return out, err
 }
 
+// CreateTapesWithContext is the same as CreateTapes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) CreateTapesWithContext(ctx aws.Context, input *CreateTapesInput, opts ...aws.Option) (*CreateTapesOutput, error) {
+	req, out := c.CreateTapesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteBandwidthRateLimit = "DeleteBandwidthRateLimit"
 
 // DeleteBandwidthRateLimitRequest generates a request for the DeleteBandwidthRateLimit operation.
@@ -434,6 +566,18 @@ func (c *StorageGateway) DeleteBandwidthRateLimit(input *DeleteBandwidthRateLimi
 	return out, err
 }
 
+// DeleteBandwidthRateLimitWithContext is the same as DeleteBandwidthRateLimit with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DeleteBandwidthRateLimitWithContext(ctx aws.Context, input *DeleteBandwidthRateLimitInput, opts ...aws.Option) (*DeleteBandwidthRateLimitOutput, error) {
+	req, out := c.DeleteBandwidthRateLimitRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteChapCredentials = "DeleteChapCredentials"
 
 // DeleteChapCredentialsRequest generates a request for the DeleteChapCredentials operation.
@@ -462,6 +606,18 @@ func (c *StorageGateway) DeleteChapCredentials(input *DeleteChapCredentialsInput
 	return out, err
 }
 
+// DeleteChapCredentialsWithContext is the same as DeleteChapCredentials with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DeleteChapCredentialsWithContext(ctx aws.Context, input *DeleteChapCredentialsInput, opts ...aws.Option) (*DeleteChapCredentialsOutput, error) {
+	req, out := c.DeleteChapCredentialsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteGateway = "DeleteGateway"
 
 // DeleteGatewayRequest generates a request for the DeleteGateway operation.
@@ -504,6 +660,18 @@ func (c *StorageGateway) DeleteGateway(input *DeleteGatewayInput) (*DeleteGatewa
 	return out, err
 }
 
+// DeleteGatewayWithContext is the same as DeleteGateway with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DeleteGatewayWithContext(ctx aws.Context, input *DeleteGatewayInput, opts ...aws.Option) (*DeleteGatewayOutput, error) {
+	req, out := c.DeleteGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSnapshotSchedule = "DeleteSnapshotSchedule"
 
 // DeleteSnapshotScheduleRequest generates a request for the DeleteSnapshotSchedule operation.
@@ -540,6 +708,18 @@ func (c *StorageGateway) DeleteSnapshotSchedule(input *DeleteSnapshotScheduleInp
 	return out, err
 }
 
+// DeleteSnapshotScheduleWithContext is the same as DeleteSnapshotSchedule with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DeleteSnapshotScheduleWithContext(ctx aws.Context, input *DeleteSnapshotScheduleInput, opts ...aws.Option) (*DeleteSnapshotScheduleOutput, error) {
+	req, out := c.DeleteSnapshotScheduleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTape = "DeleteTape"
 
 // DeleteTapeRequest generates a request for the DeleteTape operation.
@@ -567,6 +747,18 @@ func (c *StorageGateway) DeleteTape(input *DeleteTapeInput) (*DeleteTapeOutput,
 	return out, err
 }
 
+// DeleteTapeWithContext is the same as DeleteTape with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DeleteTapeWithContext(ctx aws.Context, input *DeleteTapeInput, opts ...aws.Option) (*DeleteTapeOutput, error) {
+	req, out := c.DeleteTapeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTapeArchive = "DeleteTapeArchive"
 
 // DeleteTapeArchiveRequest generates a request for the DeleteTapeArchive operation.
@@ -594,6 +786,18 @@ func (c *StorageGateway) DeleteTapeArchive(input *DeleteTapeArchiveInput) (*Dele
 	return out, err
 }
 
+// DeleteTapeArchiveWithContext is the same as DeleteTapeArchive with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DeleteTapeArchiveWithContext(ctx aws.Context, input *DeleteTapeArchiveInput, opts ...aws.Option) (*DeleteTapeArchiveOutput, error) {
+	req, out := c.DeleteTapeArchiveRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVolume = "DeleteVolume"
 
 // DeleteVolumeRequest generates a request for the DeleteVolume operation.
@@ -634,6 +838,18 @@ func (c *StorageGateway) DeleteVolume(input *DeleteVolumeInput) (*DeleteVolumeOu
 	return out, err
 }
 
+// DeleteVolumeWithContext is the same as DeleteVolume with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DeleteVolumeWithContext(ctx aws.Context, input *DeleteVolumeInput, opts ...aws.Option) (*DeleteVolumeOutput, error) {
+	req, out := c.DeleteVolumeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeBandwidthRateLimit = "DescribeBandwidthRateLimit"
 
 // DescribeBandwidthRateLimitRequest generates a request for the DescribeBandwidthRateLimit operation.
@@ -667,6 +883,18 @@ func (c *StorageGateway) DescribeBandwidthRateLimit(input *DescribeBandwidthRate
 	return out, err
 }
 
+// DescribeBandwidthRateLimitWithContext is the same as DescribeBandwidthRateLimit with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeBandwidthRateLimitWithContext(ctx aws.Context, input *DescribeBandwidthRateLimitInput, opts ...aws.Option) (*DescribeBandwidthRateLimitOutput, error) {
+	req, out := c.DescribeBandwidthRateLimitRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCache = "DescribeCache"
 
 // DescribeCacheRequest generates a request for the DescribeCache operation.
@@ -698,6 +926,18 @@ func (c *StorageGateway) DescribeCache(input *DescribeCacheInput) (*DescribeCach
 	return out, err
 }
 
+// DescribeCacheWithContext is the same as DescribeCache with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeCacheWithContext(ctx aws.Context, input *DescribeCacheInput, opts ...aws.Option) (*DescribeCacheOutput, error) {
+	req, out := c.DescribeCacheRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCachediSCSIVolumes = "DescribeCachediSCSIVolumes"
 
 // DescribeCachediSCSIVolumesRequest generates a request for the DescribeCachediSCSIVolumes operation.
@@ -731,6 +971,18 @@ func (c *StorageGateway) DescribeCachediSCSIVolumes(input *DescribeCachediSCSIVo
 	return out, err
 }
 
+// DescribeCachediSCSIVolumesWithContext is the same as DescribeCachediSCSIVolumes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeCachediSCSIVolumesWithContext(ctx aws.Context, input *DescribeCachediSCSIVolumesInput, opts ...aws.Option) (*DescribeCachediSCSIVolumesOutput, error) {
+	req, out := c.DescribeCachediSCSIVolumesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeChapCredentials = "DescribeChapCredentials"
 
 // DescribeChapCredentialsRequest generates a request for the DescribeChapCredentials operation.
@@ -760,6 +1012,18 @@ func (c *StorageGateway) DescribeChapCredentials(input *DescribeChapCredentialsI
 	return out, err
 }
 
+// DescribeChapCredentialsWithContext is the same as DescribeChapCredentials with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeChapCredentialsWithContext(ctx aws.Context, input *DescribeChapCredentialsInput, opts ...aws.Option) (*DescribeChapCredentialsOutput, error) {
+	req, out := c.DescribeChapCredentialsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeGatewayInformation = "DescribeGatewayInformation"
 
 // DescribeGatewayInformationRequest generates a request for the DescribeGatewayInformation operation.
@@ -790,6 +1054,18 @@ func (c *StorageGateway) DescribeGatewayInformation(input *DescribeGatewayInform
 	return out, err
 }
 
+// DescribeGatewayInformationWithContext is the same as DescribeGatewayInformation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeGatewayInformationWithContext(ctx aws.Context, input *DescribeGatewayInformationInput, opts ...aws.Option) (*DescribeGatewayInformationOutput, error) {
+	req, out := c.DescribeGatewayInformationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMaintenanceStartTime = "DescribeMaintenanceStartTime"
 
 // DescribeMaintenanceStartTimeRequest generates a request for the DescribeMaintenanceStartTime operation.
@@ -819,6 +1095,18 @@ func (c *StorageGateway) DescribeMaintenanceStartTime(input *DescribeMaintenance
 	return out, err
 }
 
+// DescribeMaintenanceStartTimeWithContext is the same as DescribeMaintenanceStartTime with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeMaintenanceStartTimeWithContext(ctx aws.Context, input *DescribeMaintenanceStartTimeInput, opts ...aws.Option) (*DescribeMaintenanceStartTimeOutput, error) {
+	req, out := c.DescribeMaintenanceStartTimeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSnapshotSchedule = "DescribeSnapshotSchedule"
 
 // DescribeSnapshotScheduleRequest generates a request for the DescribeSnapshotSchedule operation.
@@ -848,6 +1136,18 @@ func (c *StorageGateway) DescribeSnapshotSchedule(input *DescribeSnapshotSchedul
 	return out, err
 }
 
+// DescribeSnapshotScheduleWithContext is the same as DescribeSnapshotSchedule with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeSnapshotScheduleWithContext(ctx aws.Context, input *DescribeSnapshotScheduleInput, opts ...aws.Option) (*DescribeSnapshotScheduleOutput, error) {
+	req, out := c.DescribeSnapshotScheduleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStorediSCSIVolumes = "DescribeStorediSCSIVolumes"
 
 // DescribeStorediSCSIVolumesRequest generates a request for the DescribeStorediSCSIVolumes operation.
@@ -878,6 +1178,18 @@ func (c *StorageGateway) DescribeStorediSCSIVolumes(input *DescribeStorediSCSIVo
 	return out, err
 }
 
+// DescribeStorediSCSIVolumesWithContext is the same as DescribeStorediSCSIVolumes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeStorediSCSIVolumesWithContext(ctx aws.Context, input *DescribeStorediSCSIVolumesInput, opts ...aws.Option) (*DescribeStorediSCSIVolumesOutput, error) {
+	req, out := c.DescribeStorediSCSIVolumesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTapeArchives = "DescribeTapeArchives"
 
 // DescribeTapeArchivesRequest generates a request for the DescribeTapeArchives operation.
@@ -891,6 +1203,7 @@ func (c *StorageGateway) DescribeTapeArchivesRequest(input *DescribeTapeArchives
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"TapeArchives"},
 		},
 	}
 
@@ -915,6 +1228,22 @@ func (c *StorageGateway) DescribeTapeArchives(input *DescribeTapeArchivesInput)
 	return out, err
 }
 
+// DescribeTapeArchivesWithContext is the same as DescribeTapeArchives with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeTapeArchivesWithContext(ctx aws.Context, input *DescribeTapeArchivesInput, opts ...aws.Option) (*DescribeTapeArchivesOutput, error) {
+	req, out := c.DescribeTapeArchivesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeTapeArchivesPages iterates over the pages of a DescribeTapeArchives operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *StorageGateway) DescribeTapeArchivesPages(input *DescribeTapeArchivesInput, fn func(p *DescribeTapeArchivesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTapeArchivesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -922,6 +1251,19 @@ func (c *StorageGateway) DescribeTapeArchivesPages(input *DescribeTapeArchivesIn
 	})
 }
 
+// DescribeTapeArchivesPagesWithContext is the same as DescribeTapeArchivesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *StorageGateway) DescribeTapeArchivesPagesWithContext(ctx aws.Context, input *DescribeTapeArchivesInput, fn func(p *DescribeTapeArchivesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeTapeArchivesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapeArchivesOutput), lastPage)
+	})
+}
+
 const opDescribeTapeRecoveryPoints = "DescribeTapeRecoveryPoints"
 
 // DescribeTapeRecoveryPointsRequest generates a request for the DescribeTapeRecoveryPoints operation.
@@ -935,6 +1277,7 @@ func (c *StorageGateway) DescribeTapeRecoveryPointsRequest(input *DescribeTapeRe
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"TapeRecoveryPointInfos"},
 		},
 	}
 
@@ -960,6 +1303,22 @@ func (c *StorageGateway) DescribeTapeRecoveryPoints(input *DescribeTapeRecoveryP
 	return out, err
 }
 
+// DescribeTapeRecoveryPointsWithContext is the same as DescribeTapeRecoveryPoints with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeTapeRecoveryPointsWithContext(ctx aws.Context, input *DescribeTapeRecoveryPointsInput, opts ...aws.Option) (*DescribeTapeRecoveryPointsOutput, error) {
+	req, out := c.DescribeTapeRecoveryPointsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeTapeRecoveryPointsPages iterates over the pages of a DescribeTapeRecoveryPoints operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *StorageGateway) DescribeTapeRecoveryPointsPages(input *DescribeTapeRecoveryPointsInput, fn func(p *DescribeTapeRecoveryPointsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTapeRecoveryPointsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -967,6 +1326,19 @@ func (c *StorageGateway) DescribeTapeRecoveryPointsPages(input *DescribeTapeReco
 	})
 }
 
+// DescribeTapeRecoveryPointsPagesWithContext is the same as DescribeTapeRecoveryPointsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *StorageGateway) DescribeTapeRecoveryPointsPagesWithContext(ctx aws.Context, input *DescribeTapeRecoveryPointsInput, fn func(p *DescribeTapeRecoveryPointsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeTapeRecoveryPointsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapeRecoveryPointsOutput), lastPage)
+	})
+}
+
 const opDescribeTapes = "DescribeTapes"
 
 // DescribeTapesRequest generates a request for the DescribeTapes operation.
@@ -980,6 +1352,7 @@ func (c *StorageGateway) DescribeTapesRequest(input *DescribeTapesInput) (req *a
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Tapes"},
 		},
 	}
 
@@ -1002,6 +1375,22 @@ func (c *StorageGateway) DescribeTapes(input *DescribeTapesInput) (*DescribeTape
 	return out, err
 }
 
+// DescribeTapesWithContext is the same as DescribeTapes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeTapesWithContext(ctx aws.Context, input *DescribeTapesInput, opts ...aws.Option) (*DescribeTapesOutput, error) {
+	req, out := c.DescribeTapesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeTapesPages iterates over the pages of a DescribeTapes operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *StorageGateway) DescribeTapesPages(input *DescribeTapesInput, fn func(p *DescribeTapesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTapesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1009,6 +1398,19 @@ func (c *StorageGateway) DescribeTapesPages(input *DescribeTapesInput, fn func(p
 	})
 }
 
+// DescribeTapesPagesWithContext is the same as DescribeTapesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *StorageGateway) DescribeTapesPagesWithContext(ctx aws.Context, input *DescribeTapesInput, fn func(p *DescribeTapesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeTapesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapesOutput), lastPage)
+	})
+}
+
 const opDescribeUploadBuffer = "DescribeUploadBuffer"
 
 // DescribeUploadBufferRequest generates a request for the DescribeUploadBuffer operation.
@@ -1041,6 +1443,18 @@ func (c *StorageGateway) DescribeUploadBuffer(input *DescribeUploadBufferInput)
 	return out, err
 }
 
+// DescribeUploadBufferWithContext is the same as DescribeUploadBuffer with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeUploadBufferWithContext(ctx aws.Context, input *DescribeUploadBufferInput, opts ...aws.Option) (*DescribeUploadBufferOutput, error) {
+	req, out := c.DescribeUploadBufferRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVTLDevices = "DescribeVTLDevices"
 
 // DescribeVTLDevicesRequest generates a request for the DescribeVTLDevices operation.
@@ -1054,6 +1468,7 @@ func (c *StorageGateway) DescribeVTLDevicesRequest(input *DescribeVTLDevicesInpu
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"VTLDevices"},
 		},
 	}
 
@@ -1077,6 +1492,22 @@ func (c *StorageGateway) DescribeVTLDevices(input *DescribeVTLDevicesInput) (*De
 	return out, err
 }
 
+// DescribeVTLDevicesWithContext is the same as DescribeVTLDevices with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeVTLDevicesWithContext(ctx aws.Context, input *DescribeVTLDevicesInput, opts ...aws.Option) (*DescribeVTLDevicesOutput, error) {
+	req, out := c.DescribeVTLDevicesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeVTLDevicesPages iterates over the pages of a DescribeVTLDevices operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *StorageGateway) DescribeVTLDevicesPages(input *DescribeVTLDevicesInput, fn func(p *DescribeVTLDevicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeVTLDevicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1084,6 +1515,19 @@ func (c *StorageGateway) DescribeVTLDevicesPages(input *DescribeVTLDevicesInput,
 	})
 }
 
+// DescribeVTLDevicesPagesWithContext is the same as DescribeVTLDevicesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *StorageGateway) DescribeVTLDevicesPagesWithContext(ctx aws.Context, input *DescribeVTLDevicesInput, fn func(p *DescribeVTLDevicesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeVTLDevicesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeVTLDevicesOutput), lastPage)
+	})
+}
+
 const opDescribeWorkingStorage = "DescribeWorkingStorage"
 
 // DescribeWorkingStorageRequest generates a request for the DescribeWorkingStorage operation.
@@ -1120,6 +1564,18 @@ func (c *StorageGateway) DescribeWorkingStorage(input *DescribeWorkingStorageInp
 	return out, err
 }
 
+// DescribeWorkingStorageWithContext is the same as DescribeWorkingStorage with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DescribeWorkingStorageWithContext(ctx aws.Context, input *DescribeWorkingStorageInput, opts ...aws.Option) (*DescribeWorkingStorageOutput, error) {
+	req, out := c.DescribeWorkingStorageRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableGateway = "DisableGateway"
 
 // DisableGatewayRequest generates a request for the DisableGateway operation.
@@ -1153,6 +1609,18 @@ func (c *StorageGateway) DisableGateway(input *DisableGatewayInput) (*DisableGat
 	return out, err
 }
 
+// DisableGatewayWithContext is the same as DisableGateway with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) DisableGatewayWithContext(ctx aws.Context, input *DisableGatewayInput, opts ...aws.Option) (*DisableGatewayOutput, error) {
+	req, out := c.DisableGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListGateways = "ListGateways"
 
 // ListGatewaysRequest generates a request for the ListGateways operation.
@@ -1166,6 +1634,7 @@ func (c *StorageGateway) ListGatewaysRequest(input *ListGatewaysInput) (req *aws
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Gateways"},
 		},
 	}
 
@@ -1196,6 +1665,22 @@ func (c *StorageGateway) ListGateways(input *ListGatewaysInput) (*ListGatewaysOu
 	return out, err
 }
 
+// ListGatewaysWithContext is the same as ListGateways with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ListGatewaysWithContext(ctx aws.Context, input *ListGatewaysInput, opts ...aws.Option) (*ListGatewaysOutput, error) {
+	req, out := c.ListGatewaysRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListGatewaysPages iterates over the pages of a ListGateways operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *StorageGateway) ListGatewaysPages(input *ListGatewaysInput, fn func(p *ListGatewaysOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGatewaysRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1203,6 +1688,19 @@ func (c *StorageGateway) ListGatewaysPages(input *ListGatewaysInput, fn func(p *
 	})
 }
 
+// ListGatewaysPagesWithContext is the same as ListGatewaysPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *StorageGateway) ListGatewaysPagesWithContext(ctx aws.Context, input *ListGatewaysInput, fn func(p *ListGatewaysOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListGatewaysRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGatewaysOutput), lastPage)
+	})
+}
+
 const opListLocalDisks = "ListLocalDisks"
 
 // ListLocalDisksRequest generates a request for the ListLocalDisks operation.
@@ -1239,6 +1737,18 @@ func (c *StorageGateway) ListLocalDisks(input *ListLocalDisksInput) (*ListLocalD
 	return out, err
 }
 
+// ListLocalDisksWithContext is the same as ListLocalDisks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ListLocalDisksWithContext(ctx aws.Context, input *ListLocalDisksInput, opts ...aws.Option) (*ListLocalDisksOutput, error) {
+	req, out := c.ListLocalDisksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListVolumeInitiators = "ListVolumeInitiators"
 
 // ListVolumeInitiatorsRequest generates a request for the ListVolumeInitiators operation.
@@ -1267,6 +1777,18 @@ func (c *StorageGateway) ListVolumeInitiators(input *ListVolumeInitiatorsInput)
 	return out, err
 }
 
+// ListVolumeInitiatorsWithContext is the same as ListVolumeInitiators with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ListVolumeInitiatorsWithContext(ctx aws.Context, input *ListVolumeInitiatorsInput, opts ...aws.Option) (*ListVolumeInitiatorsOutput, error) {
+	req, out := c.ListVolumeInitiatorsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListVolumeRecoveryPoints = "ListVolumeRecoveryPoints"
 
 // ListVolumeRecoveryPointsRequest generates a request for the ListVolumeRecoveryPoints operation.
@@ -1300,6 +1822,18 @@ func (c *StorageGateway) ListVolumeRecoveryPoints(input *ListVolumeRecoveryPoint
 	return out, err
 }
 
+// ListVolumeRecoveryPointsWithContext is the same as ListVolumeRecoveryPoints with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ListVolumeRecoveryPointsWithContext(ctx aws.Context, input *ListVolumeRecoveryPointsInput, opts ...aws.Option) (*ListVolumeRecoveryPointsOutput, error) {
+	req, out := c.ListVolumeRecoveryPointsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opListVolumes = "ListVolumes"
 
 // ListVolumesRequest generates a request for the ListVolumes operation.
@@ -1313,6 +1847,7 @@ func (c *StorageGateway) ListVolumesRequest(input *ListVolumesInput) (req *aws.R
 			OutputTokens:    []string{"Marker"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"VolumeInfos"},
 		},
 	}
 
@@ -1342,6 +1877,22 @@ func (c *StorageGateway) ListVolumes(input *ListVolumesInput) (*ListVolumesOutpu
 	return out, err
 }
 
+// ListVolumesWithContext is the same as ListVolumes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ListVolumesWithContext(ctx aws.Context, input *ListVolumesInput, opts ...aws.Option) (*ListVolumesOutput, error) {
+	req, out := c.ListVolumesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListVolumesPages iterates over the pages of a ListVolumes operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *StorageGateway) ListVolumesPages(input *ListVolumesInput, fn func(p *ListVolumesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListVolumesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1349,6 +1900,19 @@ func (c *StorageGateway) ListVolumesPages(input *ListVolumesInput, fn func(p *Li
 	})
 }
 
+// ListVolumesPagesWithContext is the same as ListVolumesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *StorageGateway) ListVolumesPagesWithContext(ctx aws.Context, input *ListVolumesInput, fn func(p *ListVolumesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListVolumesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListVolumesOutput), lastPage)
+	})
+}
+
 const opResetCache = "ResetCache"
 
 // ResetCacheRequest generates a request for the ResetCache operation.
@@ -1386,6 +1950,18 @@ func (c *StorageGateway) ResetCache(input *ResetCacheInput) (*ResetCacheOutput,
 	return out, err
 }
 
+// ResetCacheWithContext is the same as ResetCache with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ResetCacheWithContext(ctx aws.Context, input *ResetCacheInput, opts ...aws.Option) (*ResetCacheOutput, error) {
+	req, out := c.ResetCacheRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveTapeArchive = "RetrieveTapeArchive"
 
 // RetrieveTapeArchiveRequest generates a request for the RetrieveTapeArchive operation.
@@ -1420,6 +1996,18 @@ func (c *StorageGateway) RetrieveTapeArchive(input *RetrieveTapeArchiveInput) (*
 	return out, err
 }
 
+// RetrieveTapeArchiveWithContext is the same as RetrieveTapeArchive with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) RetrieveTapeArchiveWithContext(ctx aws.Context, input *RetrieveTapeArchiveInput, opts ...aws.Option) (*RetrieveTapeArchiveOutput, error) {
+	req, out := c.RetrieveTapeArchiveRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveTapeRecoveryPoint = "RetrieveTapeRecoveryPoint"
 
 // RetrieveTapeRecoveryPointRequest generates a request for the RetrieveTapeRecoveryPoint operation.
@@ -1455,6 +2043,18 @@ func (c *StorageGateway) RetrieveTapeRecoveryPoint(input *RetrieveTapeRecoveryPo
 	return out, err
 }
 
+// RetrieveTapeRecoveryPointWithContext is the same as RetrieveTapeRecoveryPoint with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) RetrieveTapeRecoveryPointWithContext(ctx aws.Context, input *RetrieveTapeRecoveryPointInput, opts ...aws.Option) (*RetrieveTapeRecoveryPointOutput, error) {
+	req, out := c.RetrieveTapeRecoveryPointRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opShutdownGateway = "ShutdownGateway"
 
 // ShutdownGatewayRequest generates a request for the ShutdownGateway operation.
@@ -1500,6 +2100,18 @@ func (c *StorageGateway) ShutdownGateway(input *ShutdownGatewayInput) (*Shutdown
 	return out, err
 }
 
+// ShutdownGatewayWithContext is the same as ShutdownGateway with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) ShutdownGatewayWithContext(ctx aws.Context, input *ShutdownGatewayInput, opts ...aws.Option) (*ShutdownGatewayOutput, error) {
+	req, out := c.ShutdownGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opStartGateway = "StartGateway"
 
 // StartGatewayRequest generates a request for the StartGateway operation.
@@ -1536,6 +2148,18 @@ func (c *StorageGateway) StartGateway(input *StartGatewayInput) (*StartGatewayOu
 	return out, err
 }
 
+// StartGatewayWithContext is the same as StartGateway with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) StartGatewayWithContext(ctx aws.Context, input *StartGatewayInput, opts ...aws.Option) (*StartGatewayOutput, error) {
+	req, out := c.StartGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateBandwidthRateLimit = "UpdateBandwidthRateLimit"
 
 // UpdateBandwidthRateLimitRequest generates a request for the UpdateBandwidthRateLimit operation.
@@ -1573,6 +2197,18 @@ func (c *StorageGateway) UpdateBandwidthRateLimit(input *UpdateBandwidthRateLimi
 	return out, err
 }
 
+// UpdateBandwidthRateLimitWithContext is the same as UpdateBandwidthRateLimit with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) UpdateBandwidthRateLimitWithContext(ctx aws.Context, input *UpdateBandwidthRateLimitInput, opts ...aws.Option) (*UpdateBandwidthRateLimitOutput, error) {
+	req, out := c.UpdateBandwidthRateLimitRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateChapCredentials = "UpdateChapCredentials"
 
 // UpdateChapCredentialsRequest generates a request for the UpdateChapCredentials operation.
@@ -1605,6 +2241,18 @@ func (c *StorageGateway) UpdateChapCredentials(input *UpdateChapCredentialsInput
 	return out, err
 }
 
+// UpdateChapCredentialsWithContext is the same as UpdateChapCredentials with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) UpdateChapCredentialsWithContext(ctx aws.Context, input *UpdateChapCredentialsInput, opts ...aws.Option) (*UpdateChapCredentialsOutput, error) {
+	req, out := c.UpdateChapCredentialsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateGatewayInformation = "UpdateGatewayInformation"
 
 // UpdateGatewayInformationRequest generates a request for the UpdateGatewayInformation operation.
@@ -1634,6 +2282,18 @@ func (c *StorageGateway) UpdateGatewayInformation(input *UpdateGatewayInformatio
 	return out, err
 }
 
+// UpdateGatewayInformationWithContext is the same as UpdateGatewayInformation with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) UpdateGatewayInformationWithContext(ctx aws.Context, input *UpdateGatewayInformationInput, opts ...aws.Option) (*UpdateGatewayInformationOutput, error) {
+	req, out := c.UpdateGatewayInformationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateGatewaySoftwareNow = "UpdateGatewaySoftwareNow"
 
 // UpdateGatewaySoftwareNowRequest generates a request for the UpdateGatewaySoftwareNow operation.
@@ -1673,6 +2333,18 @@ func (c *StorageGateway) UpdateGatewaySoftwareNow(input *UpdateGatewaySoftwareNo
 	return out, err
 }
 
+// UpdateGatewaySoftwareNowWithContext is the same as UpdateGatewaySoftwareNow with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) UpdateGatewaySoftwareNowWithContext(ctx aws.Context, input *UpdateGatewaySoftwareNowInput, opts ...aws.Option) (*UpdateGatewaySoftwareNowOutput, error) {
+	req, out := c.UpdateGatewaySoftwareNowRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateMaintenanceStartTime = "UpdateMaintenanceStartTime"
 
 // UpdateMaintenanceStartTimeRequest generates a request for the UpdateMaintenanceStartTime operation.
@@ -1702,6 +2374,18 @@ func (c *StorageGateway) UpdateMaintenanceStartTime(input *UpdateMaintenanceStar
 	return out, err
 }
 
+// UpdateMaintenanceStartTimeWithContext is the same as UpdateMaintenanceStartTime with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) UpdateMaintenanceStartTimeWithContext(ctx aws.Context, input *UpdateMaintenanceStartTimeInput, opts ...aws.Option) (*UpdateMaintenanceStartTimeOutput, error) {
+	req, out := c.UpdateMaintenanceStartTimeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateSnapshotSchedule = "UpdateSnapshotSchedule"
 
 // UpdateSnapshotScheduleRequest generates a request for the UpdateSnapshotSchedule operation.
@@ -1737,6 +2421,18 @@ func (c *StorageGateway) UpdateSnapshotSchedule(input *UpdateSnapshotScheduleInp
 	return out, err
 }
 
+// UpdateSnapshotScheduleWithContext is the same as UpdateSnapshotSchedule with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) UpdateSnapshotScheduleWithContext(ctx aws.Context, input *UpdateSnapshotScheduleInput, opts ...aws.Option) (*UpdateSnapshotScheduleOutput, error) {
+	req, out := c.UpdateSnapshotScheduleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateVTLDeviceType = "UpdateVTLDeviceType"
 
 // UpdateVTLDeviceTypeRequest generates a request for the UpdateVTLDeviceType operation.
@@ -1767,6 +2463,18 @@ func (c *StorageGateway) UpdateVTLDeviceType(input *UpdateVTLDeviceTypeInput) (*
 	return out, err
 }
 
+// UpdateVTLDeviceTypeWithContext is the same as UpdateVTLDeviceType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *StorageGateway) UpdateVTLDeviceTypeWithContext(ctx aws.Context, input *UpdateVTLDeviceTypeInput, opts ...aws.Option) (*UpdateVTLDeviceTypeOutput, error) {
+	req, out := c.UpdateVTLDeviceTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   ActivateGatewayInput$ActivationKey   GatewayName   ActivateGatewayInput$GatewayRegion
@@ -1780,11 +2488,11 @@ type ActivateGatewayInput struct {
 	// also include other activation-related parameters, however, these are merely
 	// defaults -- the arguments you pass to the ActivateGateway API call determine
 	// the actual configuration of your gateway.
-	ActivationKey *string `type:"string" required:"true"`
+	ActivationKey *string `type:"string" min:"1" max:"50" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string" required:"true"`
+	GatewayName *string `type:"string" min:"2" max:"255" required:"true"`
 
 	// One of the values that indicates the region where you want to store the snapshot
 	// backups. The gateway region specified must be the same region as the region
@@ -1795,29 +2503,29 @@ type ActivateGatewayInput struct {
 	//
 	// Valid Values: "us-east-1", "us-west-1", "us-west-2", "eu-west-1", "eu-central-1",
 	// "ap-northeast-1", "ap-southeast-1", "ap-southeast-2", "sa-east-1"
-	GatewayRegion *string `type:"string" required:"true"`
+	GatewayRegion *string `type:"string" min:"1" max:"25" required:"true"`
 
 	// One of the values that indicates the time zone you want to set for the gateway.
 	// The time zone is used, for example, for scheduling snapshots and your gateway's
 	// maintenance schedule.
-	GatewayTimezone *string `type:"string" required:"true"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10" required:"true"`
 
 	// One of the values that defines the type of gateway to activate. The type
 	// specified is critical to all later functions of the gateway and cannot be
 	// changed after activation. The default value is STORED.
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The value that indicates the type of medium changer to use for gateway-VTL.
 	// This field is optional.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	MediumChangerType *string `type:"string"`
+	MediumChangerType *string `type:"string" min:"2" max:"50"`
 
 	// The value that indicates the type of tape drive to use for gateway-VTL. This
 	// field is optional.
 	//
 	// Valid Values: "IBM-ULT3580-TD5"
-	TapeDriveType *string `type:"string"`
+	TapeDriveType *string `type:"string" min:"2" max:"50"`
 
 	metadataActivateGatewayInput `json:"-" xml:"-"`
 }
@@ -1843,7 +2551,7 @@ func (s ActivateGatewayInput) GoString() string {
 type ActivateGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataActivateGatewayOutput `json:"-" xml:"-"`
 }
@@ -1867,7 +2575,7 @@ type AddCacheInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddCacheInput `json:"-" xml:"-"`
 }
@@ -1889,7 +2597,7 @@ func (s AddCacheInput) GoString() string {
 type AddCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddCacheOutput `json:"-" xml:"-"`
 }
@@ -1913,7 +2621,7 @@ type AddUploadBufferInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddUploadBufferInput `json:"-" xml:"-"`
 }
@@ -1935,7 +2643,7 @@ func (s AddUploadBufferInput) GoString() string {
 type AddUploadBufferOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddUploadBufferOutput `json:"-" xml:"-"`
 }
@@ -1965,7 +2673,7 @@ type AddWorkingStorageInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddWorkingStorageInput `json:"-" xml:"-"`
 }
@@ -1989,7 +2697,7 @@ func (s AddWorkingStorageInput) GoString() string {
 type AddWorkingStorageOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddWorkingStorageOutput `json:"-" xml:"-"`
 }
@@ -2011,17 +2719,17 @@ func (s AddWorkingStorageOutput) GoString() string {
 type CachediSCSIVolume struct {
 	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -2047,11 +2755,11 @@ func (s CachediSCSIVolume) GoString() string {
 type CancelArchivalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel archiving
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelArchivalInput `json:"-" xml:"-"`
 }
@@ -2074,7 +2782,7 @@ func (s CancelArchivalInput) GoString() string {
 type CancelArchivalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which archiving was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelArchivalOutput `json:"-" xml:"-"`
 }
@@ -2097,11 +2805,11 @@ func (s CancelArchivalOutput) GoString() string {
 type CancelRetrievalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel retrieval
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelRetrievalInput `json:"-" xml:"-"`
 }
@@ -2124,7 +2832,7 @@ func (s CancelRetrievalInput) GoString() string {
 type CancelRetrievalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which retrieval was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelRetrievalOutput `json:"-" xml:"-"`
 }
@@ -2147,21 +2855,21 @@ func (s CancelRetrievalOutput) GoString() string {
 // that supports authentication between your gateway and iSCSI initiators.
 type ChapInfo struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255"`
 
 	// The secret key that the initiator (for example, the Windows client) must
 	// provide to participate in mutual CHAP with the target.
-	SecretToAuthenticateInitiator *string `type:"string"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"1" max:"100"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"1" max:"100"`
 
 	// The Amazon Resource Name (ARN) of the volume.
 	//
 	// Valid Values: 50 to 500 lowercase letters, numbers, periods (.), and hyphens
 	// (-).
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataChapInfo `json:"-" xml:"-"`
 }
@@ -2181,17 +2889,17 @@ func (s ChapInfo) GoString() string {
 }
 
 type CreateCachediSCSIVolumeInput struct {
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" idempotencyToken:"true" min:"5" max:"100" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" required:"true"`
 
 	SnapshotID *string `locationName:"SnapshotId" type:"string"`
 
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" required:"true"`
 
 	VolumeSizeInBytes *int64 `type:"long" required:"true"`
 
@@ -2213,9 +2921,9 @@ func (s CreateCachediSCSIVolumeInput) GoString() string {
 }
 
 type CreateCachediSCSIVolumeOutput struct {
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateCachediSCSIVolumeOutput `json:"-" xml:"-"`
 }
@@ -2235,9 +2943,9 @@ func (s CreateCachediSCSIVolumeOutput) GoString() string {
 }
 
 type CreateSnapshotFromVolumeRecoveryPointInput struct {
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotFromVolumeRecoveryPointInput `json:"-" xml:"-"`
 }
@@ -2259,7 +2967,7 @@ func (s CreateSnapshotFromVolumeRecoveryPointInput) GoString() string {
 type CreateSnapshotFromVolumeRecoveryPointOutput struct {
 	SnapshotID *string `locationName:"SnapshotId" type:"string"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -2287,11 +2995,11 @@ type CreateSnapshotInput struct {
 	// Textual description of the snapshot that appears in the Amazon EC2 console,
 	// Elastic Block Store snapshots panel in the Description field, and in the
 	// AWS Storage Gateway snapshot Details pane, Description field
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotInput `json:"-" xml:"-"`
 }
@@ -2318,7 +3026,7 @@ type CreateSnapshotOutput struct {
 	SnapshotID *string `locationName:"SnapshotId" type:"string"`
 
 	// The Amazon Resource Name (ARN) of the volume of which the snapshot was taken.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateSnapshotOutput `json:"-" xml:"-"`
 }
@@ -2346,11 +3054,11 @@ type CreateStorediSCSIVolumeInput struct {
 	// The unique identifier for the gateway local disk that is configured as a
 	// stored volume. Use ListLocalDisks (http://docs.aws.amazon.com/storagegateway/latest/userguide/API_ListLocalDisks.html)
 	// to list disk IDs for a gateway.
-	DiskID *string `locationName:"DiskId" type:"string" required:"true"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The network interface of the gateway on which to expose the iSCSI target.
 	// Only IPv4 addresses are accepted. Use DescribeGatewayInformation to get a
@@ -2376,7 +3084,7 @@ type CreateStorediSCSIVolumeInput struct {
 	// and as a suffix for the target ARN. For example, specifying TargetName as
 	// myvolume results in the target ARN of arn:aws:storagegateway:us-east-1:111122223333:gateway/mygateway/target/iqn.1997-05.com.amazon:myvolume.
 	// The target name must be unique across all volumes of a gateway.
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" required:"true"`
 
 	metadataCreateStorediSCSIVolumeInput `json:"-" xml:"-"`
 }
@@ -2399,10 +3107,10 @@ func (s CreateStorediSCSIVolumeInput) GoString() string {
 type CreateStorediSCSIVolumeOutput struct {
 	// he Amazon Resource Name (ARN) of the volume target that includes the iSCSI
 	// name that initiators can use to connect to the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	// The Amazon Resource Name (ARN) of the configured volume.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	// The size of the volume in bytes.
 	VolumeSizeInBytes *int64 `type:"long"`
@@ -2430,22 +3138,22 @@ type CreateTapesInput struct {
 	// use the same ClientToken you specified in the initial request.
 	//
 	// Using the same ClientToken prevents creating the tape multiple times.
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" idempotencyToken:"true" min:"5" max:"100" required:"true"`
 
 	// The unique Amazon Resource Name(ARN) that represents the gateway to associate
 	// the virtual tapes with. Use the ListGateways operation to return a list of
 	// gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The number of virtual tapes you want to create.
-	NumTapesToCreate *int64 `type:"integer" required:"true"`
+	NumTapesToCreate *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// A prefix you append to the barcode of the virtual tape you are creating.
 	// This makes a barcode unique.
 	//
 	// The prefix must be 1 to 4 characters in length and must be upper-case letters
 	// A-Z.
-	TapeBarcodePrefix *string `type:"string" required:"true"`
+	TapeBarcodePrefix *string `type:"string" min:"1" max:"4" required:"true"`
 
 	// The size, in bytes, of the virtual tapes you want to create.
 	//
@@ -2493,11 +3201,11 @@ func (s CreateTapesOutput) GoString() string {
 }
 
 type DeleteBandwidthRateLimitInput struct {
-	BandwidthType *string `type:"string" required:"true"`
+	BandwidthType *string `type:"string" min:"3" max:"25" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteBandwidthRateLimitInput `json:"-" xml:"-"`
 }
@@ -2521,7 +3229,7 @@ func (s DeleteBandwidthRateLimitInput) GoString() string {
 type DeleteBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteBandwidthRateLimitOutput `json:"-" xml:"-"`
 }
@@ -2545,11 +3253,11 @@ func (s DeleteBandwidthRateLimitOutput) GoString() string {
 //   DeleteChapCredentialsInput$InitiatorName   DeleteChapCredentialsInput$TargetARN
 type DeleteChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDeleteChapCredentialsInput `json:"-" xml:"-"`
 }
@@ -2571,10 +3279,10 @@ func (s DeleteChapCredentialsInput) GoString() string {
 // A JSON object containing the following fields:
 type DeleteChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeleteChapCredentialsOutput `json:"-" xml:"-"`
 }
@@ -2597,7 +3305,7 @@ func (s DeleteChapCredentialsOutput) GoString() string {
 type DeleteGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteGatewayInput `json:"-" xml:"-"`
 }
@@ -2620,7 +3328,7 @@ func (s DeleteGatewayInput) GoString() string {
 type DeleteGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteGatewayOutput `json:"-" xml:"-"`
 }
@@ -2640,7 +3348,7 @@ func (s DeleteGatewayOutput) GoString() string {
 }
 
 type DeleteSnapshotScheduleInput struct {
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteSnapshotScheduleInput `json:"-" xml:"-"`
 }
@@ -2660,7 +3368,7 @@ func (s DeleteSnapshotScheduleInput) GoString() string {
 }
 
 type DeleteSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteSnapshotScheduleOutput `json:"-" xml:"-"`
 }
@@ -2683,7 +3391,7 @@ func (s DeleteSnapshotScheduleOutput) GoString() string {
 type DeleteTapeArchiveInput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape to delete from the virtual
 	// tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeArchiveInput `json:"-" xml:"-"`
 }
@@ -2706,7 +3414,7 @@ func (s DeleteTapeArchiveInput) GoString() string {
 type DeleteTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape that was deleted from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeArchiveOutput `json:"-" xml:"-"`
 }
@@ -2730,10 +3438,10 @@ type DeleteTapeInput struct {
 	// The unique Amazon Resource Name (ARN) of the gateway that the virtual tape
 	// to delete is associated with. Use the ListGateways operation to return a
 	// list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape to delete.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeInput `json:"-" xml:"-"`
 }
@@ -2755,7 +3463,7 @@ func (s DeleteTapeInput) GoString() string {
 // DeleteTapeOutput
 type DeleteTapeOutput struct {
 	// The Amazon Resource Name (ARN) of the deleted virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeOutput `json:"-" xml:"-"`
 }
@@ -2778,7 +3486,7 @@ func (s DeleteTapeOutput) GoString() string {
 type DeleteVolumeInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteVolumeInput `json:"-" xml:"-"`
 }
@@ -2801,7 +3509,7 @@ func (s DeleteVolumeInput) GoString() string {
 type DeleteVolumeOutput struct {
 	// The Amazon Resource Name (ARN) of the storage volume that was deleted. It
 	// is the same ARN you provided in the request.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteVolumeOutput `json:"-" xml:"-"`
 }
@@ -2824,7 +3532,7 @@ func (s DeleteVolumeOutput) GoString() string {
 type DescribeBandwidthRateLimitInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeBandwidthRateLimitInput `json:"-" xml:"-"`
 }
@@ -2847,15 +3555,15 @@ func (s DescribeBandwidthRateLimitInput) GoString() string {
 type DescribeBandwidthRateLimitOutput struct {
 	// The average download bandwidth rate limit in bits per second. This field
 	// does not appear in the response if the download rate limit is not set.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second. This field does
 	// not appear in the response if the upload rate limit is not set.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeBandwidthRateLimitOutput `json:"-" xml:"-"`
 }
@@ -2877,7 +3585,7 @@ func (s DescribeBandwidthRateLimitOutput) GoString() string {
 type DescribeCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeCacheInput `json:"-" xml:"-"`
 }
@@ -2911,7 +3619,7 @@ type DescribeCacheOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeCacheOutput `json:"-" xml:"-"`
 }
@@ -2978,7 +3686,7 @@ func (s DescribeCachediSCSIVolumesOutput) GoString() string {
 type DescribeChapCredentialsInput struct {
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDescribeChapCredentialsInput `json:"-" xml:"-"`
 }
@@ -3037,7 +3745,7 @@ func (s DescribeChapCredentialsOutput) GoString() string {
 type DescribeGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeGatewayInformationInput `json:"-" xml:"-"`
 }
@@ -3060,33 +3768,33 @@ func (s DescribeGatewayInformationInput) GoString() string {
 type DescribeGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The gateway ID.
-	GatewayID *string `locationName:"GatewayId" type:"string"`
+	GatewayID *string `locationName:"GatewayId" type:"string" min:"12" max:"30"`
 
 	// A NetworkInterface array that contains descriptions of the gateway network
 	// interfaces.
 	GatewayNetworkInterfaces []*NetworkInterface `type:"list"`
 
 	// One of the values that indicates the operating state of the gateway.
-	GatewayState *string `type:"string"`
+	GatewayState *string `type:"string" min:"2" max:"25"`
 
 	// One of the values that indicates the time zone configured for the gateway.
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	// The type of the gateway.
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The date on which the last software update was applied to the gateway. If
 	// the gateway has never been updated, this field does not return a value in
 	// the response.
-	LastSoftwareUpdate *string `type:"string"`
+	LastSoftwareUpdate *string `type:"string" min:"1" max:"25"`
 
 	// The date on which an update to the gateway is available. This date is in
 	// the time zone of the gateway. If the gateway is not available for an update
 	// this field is not returned in the response.
-	NextUpdateAvailabilityDate *string `type:"string"`
+	NextUpdateAvailabilityDate *string `type:"string" min:"1" max:"25"`
 
 	metadataDescribeGatewayInformationOutput `json:"-" xml:"-"`
 }
@@ -3109,7 +3817,7 @@ func (s DescribeGatewayInformationOutput) GoString() string {
 type DescribeMaintenanceStartTimeInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeMaintenanceStartTimeInput `json:"-" xml:"-"`
 }
@@ -3129,17 +3837,17 @@ func (s DescribeMaintenanceStartTimeInput) GoString() string {
 }
 
 type DescribeMaintenanceStartTimeOutput struct {
-	DayOfWeek *int64 `type:"integer"`
+	DayOfWeek *int64 `type:"integer" min:"0" max:"6"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	HourOfDay *int64 `type:"integer"`
+	HourOfDay *int64 `type:"integer" min:"0" max:"23"`
 
-	MinuteOfHour *int64 `type:"integer"`
+	MinuteOfHour *int64 `type:"integer" min:"0" max:"59"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
 	metadataDescribeMaintenanceStartTimeOutput `json:"-" xml:"-"`
 }
@@ -3163,7 +3871,7 @@ func (s DescribeMaintenanceStartTimeOutput) GoString() string {
 type DescribeSnapshotScheduleInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeSnapshotScheduleInput `json:"-" xml:"-"`
 }
@@ -3183,15 +3891,15 @@ func (s DescribeSnapshotScheduleInput) GoString() string {
 }
 
 type DescribeSnapshotScheduleOutput struct {
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
-	RecurrenceInHours *int64 `type:"integer"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24"`
 
-	StartAt *int64 `type:"integer"`
+	StartAt *int64 `type:"integer" min:"0" max:"23"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeSnapshotScheduleOutput `json:"-" xml:"-"`
 }
@@ -3258,11 +3966,11 @@ func (s DescribeStorediSCSIVolumesOutput) GoString() string {
 type DescribeTapeArchivesInput struct {
 	// Specifies that the number of virtual tapes descried be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// virtual tapes.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe.
@@ -3292,7 +4000,7 @@ type DescribeTapeArchivesOutput struct {
 	// to fetch the next set of virtual tapes in the virtual tape shelf (VTS). If
 	// there are no more virtual tapes to describe, this field does not appear in
 	// the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape objects in the virtual tape shelf (VTS). The description
 	// includes of the Amazon Resource Name(ARN) of the virtual tapes. The information
@@ -3321,15 +4029,15 @@ func (s DescribeTapeArchivesOutput) GoString() string {
 type DescribeTapeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tape recovery points that are described
 	// be limited to the specified number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the virtual tape recovery points.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataDescribeTapeRecoveryPointsInput `json:"-" xml:"-"`
 }
@@ -3352,7 +4060,7 @@ func (s DescribeTapeRecoveryPointsInput) GoString() string {
 type DescribeTapeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the virtual tape recovery
 	// points that were listed for description ended.
@@ -3360,7 +4068,7 @@ type DescribeTapeRecoveryPointsOutput struct {
 	// Use this marker in your next request to list the next set of virtual tape
 	// recovery points in the list. If there are no more recovery points to describe,
 	// this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of TapeRecoveryPointInfos that are available for the specified gateway.
 	TapeRecoveryPointInfos []*TapeRecoveryPointInfo `type:"list"`
@@ -3386,19 +4094,19 @@ func (s DescribeTapeRecoveryPointsOutput) GoString() string {
 type DescribeTapesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tapes described be limited to the specified
 	// number.
 	//
 	// Amazon Web Services may impose its own limit, if this field is not set.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A marker value, obtained in a previous call to DescribeTapes. This marker
 	// indicates which page of results to retrieve.
 	//
 	// If not specified, the first page of results is retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe. If this parameter is not specified,
@@ -3430,7 +4138,7 @@ type DescribeTapesOutput struct {
 	//
 	// If a response does not contain a marker, then there are no more results
 	// to be retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape descriptions.
 	Tapes []*Tape `type:"list"`
@@ -3455,7 +4163,7 @@ func (s DescribeTapesOutput) GoString() string {
 type DescribeUploadBufferInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeUploadBufferInput `json:"-" xml:"-"`
 }
@@ -3479,7 +4187,7 @@ type DescribeUploadBufferOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	UploadBufferAllocatedInBytes *int64 `type:"long"`
 
@@ -3506,15 +4214,15 @@ func (s DescribeUploadBufferOutput) GoString() string {
 type DescribeVTLDevicesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of VTL devices described be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the VTL devices.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of strings, where each string represents the Amazon Resource Name
 	// (ARN) of a VTL device.
@@ -3545,13 +4253,13 @@ func (s DescribeVTLDevicesInput) GoString() string {
 type DescribeVTLDevicesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the VTL devices that
 	// were fetched for description ended. Use the marker in your next request to
 	// fetch the next set of VTL devices in the list. If there are no more VTL devices
 	// to describe, this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of VTL device objects composed of the Amazon Resource Name(ARN)
 	// of the VTL devices.
@@ -3578,7 +4286,7 @@ func (s DescribeVTLDevicesOutput) GoString() string {
 type DescribeWorkingStorageInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeWorkingStorageInput `json:"-" xml:"-"`
 }
@@ -3607,7 +4315,7 @@ type DescribeWorkingStorageOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The total working storage in bytes allocated for the gateway. If no working
 	// storage is configured for the gateway, this field returns 0.
@@ -3647,7 +4355,7 @@ type DeviceiSCSIAttributes struct {
 
 	// Specifies the unique Amazon Resource Name(ARN) that encodes the iSCSI qualified
 	// name(iqn) of a tape drive or media changer target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeviceiSCSIAttributes `json:"-" xml:"-"`
 }
@@ -3670,7 +4378,7 @@ func (s DeviceiSCSIAttributes) GoString() string {
 type DisableGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDisableGatewayInput `json:"-" xml:"-"`
 }
@@ -3692,7 +4400,7 @@ func (s DisableGatewayInput) GoString() string {
 // DisableGatewayOutput
 type DisableGatewayOutput struct {
 	// The unique Amazon Resource Name of the disabled gateway.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDisableGatewayOutput `json:"-" xml:"-"`
 }
@@ -3714,9 +4422,9 @@ func (s DisableGatewayOutput) GoString() string {
 type Disk struct {
 	DiskAllocationResource *string `type:"string"`
 
-	DiskAllocationType *string `type:"string"`
+	DiskAllocationType *string `type:"string" min:"3" max:"100"`
 
-	DiskID *string `locationName:"DiskId" type:"string"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300"`
 
 	DiskNode *string `type:"string"`
 
@@ -3773,11 +4481,11 @@ func (s Error) GoString() string {
 type GatewayInfo struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	GatewayOperationalState *string `type:"string"`
+	GatewayOperationalState *string `type:"string" min:"2" max:"25"`
 
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	metadataGatewayInfo `json:"-" xml:"-"`
 }
@@ -3802,11 +4510,11 @@ func (s GatewayInfo) GoString() string {
 type ListGatewaysInput struct {
 	// Specifies that the list of gateways returned be limited to the specified
 	// number of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin the returned
 	// list of gateways.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysInput `json:"-" xml:"-"`
 }
@@ -3828,7 +4536,7 @@ func (s ListGatewaysInput) GoString() string {
 type ListGatewaysOutput struct {
 	Gateways []*GatewayInfo `type:"list"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysOutput `json:"-" xml:"-"`
 }
@@ -3851,7 +4559,7 @@ func (s ListGatewaysOutput) GoString() string {
 type ListLocalDisksInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListLocalDisksInput `json:"-" xml:"-"`
 }
@@ -3875,7 +4583,7 @@ type ListLocalDisksOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataListLocalDisksOutput `json:"-" xml:"-"`
 }
@@ -3898,7 +4606,7 @@ func (s ListLocalDisksOutput) GoString() string {
 type ListVolumeInitiatorsInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes for the gateway.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListVolumeInitiatorsInput `json:"-" xml:"-"`
 }
@@ -3943,7 +4651,7 @@ func (s ListVolumeInitiatorsOutput) GoString() string {
 type ListVolumeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListVolumeRecoveryPointsInput `json:"-" xml:"-"`
 }
@@ -3965,7 +4673,7 @@ func (s ListVolumeRecoveryPointsInput) GoString() string {
 type ListVolumeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointInfos []*VolumeRecoveryPointInfo `type:"list"`
 
@@ -3992,16 +4700,16 @@ func (s ListVolumeRecoveryPointsOutput) GoString() string {
 type ListVolumesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the list of volumes returned be limited to the specified number
 	// of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that indicates the position at which to begin the returned list
 	// of volumes. Obtain the marker from the response of a previous List iSCSI
 	// Volumes request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListVolumesInput `json:"-" xml:"-"`
 }
@@ -4023,9 +4731,9 @@ func (s ListVolumesInput) GoString() string {
 type ListVolumesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	VolumeInfos []*VolumeInfo `type:"list"`
 
@@ -4080,7 +4788,7 @@ func (s NetworkInterface) GoString() string {
 type ResetCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataResetCacheInput `json:"-" xml:"-"`
 }
@@ -4102,7 +4810,7 @@ func (s ResetCacheInput) GoString() string {
 type ResetCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataResetCacheOutput `json:"-" xml:"-"`
 }
@@ -4129,11 +4837,11 @@ type RetrieveTapeArchiveInput struct {
 	//
 	// You retrieve archived virtual tapes to only one gateway and the gateway
 	// must be a gateway-VTL.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to retrieve from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeArchiveInput `json:"-" xml:"-"`
 }
@@ -4155,7 +4863,7 @@ func (s RetrieveTapeArchiveInput) GoString() string {
 // RetrieveTapeArchiveOutput
 type RetrieveTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the retrieved virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeArchiveOutput `json:"-" xml:"-"`
 }
@@ -4178,11 +4886,11 @@ func (s RetrieveTapeArchiveOutput) GoString() string {
 type RetrieveTapeRecoveryPointInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape for which you want to
 	// retrieve the recovery point.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeRecoveryPointInput `json:"-" xml:"-"`
 }
@@ -4205,7 +4913,7 @@ func (s RetrieveTapeRecoveryPointInput) GoString() string {
 type RetrieveTapeRecoveryPointOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which the recovery
 	// point was retrieved.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeRecoveryPointOutput `json:"-" xml:"-"`
 }
@@ -4228,7 +4936,7 @@ func (s RetrieveTapeRecoveryPointOutput) GoString() string {
 type ShutdownGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataShutdownGatewayInput `json:"-" xml:"-"`
 }
@@ -4251,7 +4959,7 @@ func (s ShutdownGatewayInput) GoString() string {
 type ShutdownGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataShutdownGatewayOutput `json:"-" xml:"-"`
 }
@@ -4274,7 +4982,7 @@ func (s ShutdownGatewayOutput) GoString() string {
 type StartGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataStartGatewayInput `json:"-" xml:"-"`
 }
@@ -4297,7 +5005,7 @@ func (s StartGatewayInput) GoString() string {
 type StartGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataStartGatewayOutput `json:"-" xml:"-"`
 }
@@ -4321,19 +5029,19 @@ type StorediSCSIVolume struct {
 
 	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string"`
+	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string" min:"1" max:"300"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -4364,10 +5072,10 @@ type Tape struct {
 	Progress *float64 `type:"double"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies a specific virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"7" max:"16"`
 
 	// The size, in bytes, of the virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -4377,7 +5085,7 @@ type Tape struct {
 
 	// The virtual tape library (VTL) device that the virtual tape is associated
 	// with.
-	VTLDevice *string `type:"string"`
+	VTLDevice *string `type:"string" min:"50" max:"500"`
 
 	metadataTape `json:"-" xml:"-"`
 }
@@ -4408,13 +5116,13 @@ type TapeArchive struct {
 	// being retrieved to.
 	//
 	// The virtual tape is retrieved from the virtual tape shelf (VTS).
-	RetrievedTo *string `type:"string"`
+	RetrievedTo *string `type:"string" min:"50" max:"500"`
 
 	// The Amazon Resource Name (ARN) of an archived virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies the archived virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"7" max:"16"`
 
 	// The size, in bytes, of the archived virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -4442,7 +5150,7 @@ func (s TapeArchive) GoString() string {
 // Describes a recovery point.
 type TapeRecoveryPointInfo struct {
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The time when the point-in-time view of the virtual tape was replicated for
 	// later recovery.
@@ -4478,14 +5186,14 @@ func (s TapeRecoveryPointInfo) GoString() string {
 //   UpdateBandwidthRateLimitInput$AverageDownloadRateLimitInBitsPerSec   UpdateBandwidthRateLimitInput$AverageUploadRateLimitInBitsPerSec
 type UpdateBandwidthRateLimitInput struct {
 	// The average download bandwidth rate limit in bits per second.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateBandwidthRateLimitInput `json:"-" xml:"-"`
 }
@@ -4509,7 +5217,7 @@ func (s UpdateBandwidthRateLimitInput) GoString() string {
 type UpdateBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateBandwidthRateLimitOutput `json:"-" xml:"-"`
 }
@@ -4534,13 +5242,13 @@ func (s UpdateBandwidthRateLimitOutput) GoString() string {
 //   UpdateChapCredentialsInput$SecretToAuthenticateTarget   UpdateChapCredentialsInput$TargetARN
 type UpdateChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The secret key that the initiator (for example, the Windows client) must
 	// provide to participate in mutual CHAP with the target.
 	//
 	// The secret key must be between 12 and 16 bytes when encoded in UTF-8.
-	SecretToAuthenticateInitiator *string `type:"string" required:"true"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
@@ -4548,11 +5256,11 @@ type UpdateChapCredentialsInput struct {
 	// Byte constraints: Minimum bytes of 12. Maximum bytes of 16.
 	//
 	// The secret key must be between 12 and 16 bytes when encoded in UTF-8.
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"1" max:"100"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataUpdateChapCredentialsInput `json:"-" xml:"-"`
 }
@@ -4575,11 +5283,11 @@ func (s UpdateChapCredentialsInput) GoString() string {
 type UpdateChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target. This is the same initiator
 	// name specified in the request.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the target. This is the same target specified
 	// in the request.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataUpdateChapCredentialsOutput `json:"-" xml:"-"`
 }
@@ -4601,13 +5309,13 @@ func (s UpdateChapCredentialsOutput) GoString() string {
 type UpdateGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string"`
+	GatewayName *string `type:"string" min:"2" max:"255"`
 
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	metadataUpdateGatewayInformationInput `json:"-" xml:"-"`
 }
@@ -4630,7 +5338,7 @@ func (s UpdateGatewayInformationInput) GoString() string {
 type UpdateGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewayInformationOutput `json:"-" xml:"-"`
 }
@@ -4653,7 +5361,7 @@ func (s UpdateGatewayInformationOutput) GoString() string {
 type UpdateGatewaySoftwareNowInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateGatewaySoftwareNowInput `json:"-" xml:"-"`
 }
@@ -4676,7 +5384,7 @@ func (s UpdateGatewaySoftwareNowInput) GoString() string {
 type UpdateGatewaySoftwareNowOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewaySoftwareNowOutput `json:"-" xml:"-"`
 }
@@ -4701,21 +5409,21 @@ func (s UpdateGatewaySoftwareNowOutput) GoString() string {
 //   UpdateMaintenanceStartTimeInput$MinuteOfHour
 type UpdateMaintenanceStartTimeInput struct {
 	// The maintenance start time day of the week.
-	DayOfWeek *int64 `type:"integer" required:"true"`
+	DayOfWeek *int64 `type:"integer" min:"0" max:"6" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The hour component of the maintenance start time represented as hh, where
 	// hh is the hour (00 to 23). The hour of the day is in the time zone of the
 	// gateway.
-	HourOfDay *int64 `type:"integer" required:"true"`
+	HourOfDay *int64 `type:"integer" min:"0" max:"23" required:"true"`
 
 	// The minute component of the maintenance start time represented as mm, where
 	// mm is the minute (00 to 59). The minute of the hour is in the time zone of
 	// the gateway.
-	MinuteOfHour *int64 `type:"integer" required:"true"`
+	MinuteOfHour *int64 `type:"integer" min:"0" max:"59" required:"true"`
 
 	metadataUpdateMaintenanceStartTimeInput `json:"-" xml:"-"`
 }
@@ -4739,7 +5447,7 @@ func (s UpdateMaintenanceStartTimeInput) GoString() string {
 type UpdateMaintenanceStartTimeOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateMaintenanceStartTimeOutput `json:"-" xml:"-"`
 }
@@ -4764,19 +5472,19 @@ func (s UpdateMaintenanceStartTimeOutput) GoString() string {
 //   UpdateSnapshotScheduleInput$StartAt   UpdateSnapshotScheduleInput$VolumeARN
 type UpdateSnapshotScheduleInput struct {
 	// Optional description of the snapshot that overwrites the existing description.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
 	// Frequency of snapshots. Specify the number of hours between snapshots.
-	RecurrenceInHours *int64 `type:"integer" required:"true"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24" required:"true"`
 
 	// The hour of the day at which the snapshot schedule begins represented as
 	// hh, where hh is the hour (0 to 23). The hour of the day is in the time zone
 	// of the gateway.
-	StartAt *int64 `type:"integer" required:"true"`
+	StartAt *int64 `type:"integer" min:"0" max:"23" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateSnapshotScheduleInput `json:"-" xml:"-"`
 }
@@ -4797,7 +5505,7 @@ func (s UpdateSnapshotScheduleInput) GoString() string {
 
 // A JSON object containing the of the updated storage volume.
 type UpdateSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateSnapshotScheduleOutput `json:"-" xml:"-"`
 }
@@ -4821,10 +5529,10 @@ type UpdateVTLDeviceTypeInput struct {
 	// The type of medium changer you want to select.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	DeviceType *string `type:"string" required:"true"`
+	DeviceType *string `type:"string" min:"2" max:"50" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the medium changer you want to select.
-	VTLDeviceARN *string `type:"string" required:"true"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateVTLDeviceTypeInput `json:"-" xml:"-"`
 }
@@ -4846,7 +5554,7 @@ func (s UpdateVTLDeviceTypeInput) GoString() string {
 // UpdateVTLDeviceTypeOutput
 type UpdateVTLDeviceTypeOutput struct {
 	// The Amazon Resource Name (ARN) of the medium changer you have selected.
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateVTLDeviceTypeOutput `json:"-" xml:"-"`
 }
@@ -4872,7 +5580,7 @@ type VTLDevice struct {
 
 	// Specifies the unique Amazon Resource Name (ARN) of the device (tape drive
 	// or media changer).
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	VTLDeviceProductIdentifier *string `type:"string"`
 
@@ -4898,9 +5606,9 @@ func (s VTLDevice) GoString() string {
 }
 
 type VolumeInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	metadataVolumeInfo `json:"-" xml:"-"`
 }
@@ -4920,7 +5628,7 @@ func (s VolumeInfo) GoString() string {
 }
 
 type VolumeRecoveryPointInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -4951,7 +5659,7 @@ type VolumeiSCSIAttributes struct {
 	ChapEnabled *bool `type:"boolean"`
 
 	// The logical disk number.
-	LunNumber *int64 `type:"integer"`
+	LunNumber *int64 `type:"integer" min:"1"`
 
 	// The network interface identifier.
 	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
@@ -4960,7 +5668,7 @@ type VolumeiSCSIAttributes struct {
 	NetworkInterfacePort *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the volume target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataVolumeiSCSIAttributes `json:"-" xml:"-"`
 }
@@ -0,0 +1,19 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package storagegateway
+
+const (
+	// ErrCodeInternalServerError for service response error code
+	// "InternalServerError".
+	//
+	// An internal server error has occurred during the request. See the error and
+	// message fields for more information.
+	ErrCodeInternalServerError = "InternalServerError"
+
+	// ErrCodeInvalidGatewayRequestException for service response error code
+	// "InvalidGatewayRequestException".
+	//
+	// An exception occurred because an invalid gateway request was issued to the
+	// service. See the error and message fields for more information.
+	ErrCodeInvalidGatewayRequestException = "InvalidGatewayRequestException"
+)
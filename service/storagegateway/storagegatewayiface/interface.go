@@ -4,6 +4,7 @@
 package storagegatewayiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 )
 
@@ -11,101 +12,225 @@ import (
 type StorageGatewayAPI interface {
 	ActivateGateway(*storagegateway.ActivateGatewayInput) (*storagegateway.ActivateGatewayOutput, error)
 
+	ActivateGatewayWithContext(aws.Context, *storagegateway.ActivateGatewayInput, ...aws.Option) (*storagegateway.ActivateGatewayOutput, error)
+
 	AddCache(*storagegateway.AddCacheInput) (*storagegateway.AddCacheOutput, error)
 
+	AddCacheWithContext(aws.Context, *storagegateway.AddCacheInput, ...aws.Option) (*storagegateway.AddCacheOutput, error)
+
 	AddUploadBuffer(*storagegateway.AddUploadBufferInput) (*storagegateway.AddUploadBufferOutput, error)
 
+	AddUploadBufferWithContext(aws.Context, *storagegateway.AddUploadBufferInput, ...aws.Option) (*storagegateway.AddUploadBufferOutput, error)
+
 	AddWorkingStorage(*storagegateway.AddWorkingStorageInput) (*storagegateway.AddWorkingStorageOutput, error)
 
+	AddWorkingStorageWithContext(aws.Context, *storagegateway.AddWorkingStorageInput, ...aws.Option) (*storagegateway.AddWorkingStorageOutput, error)
+
 	CancelArchival(*storagegateway.CancelArchivalInput) (*storagegateway.CancelArchivalOutput, error)
 
+	CancelArchivalWithContext(aws.Context, *storagegateway.CancelArchivalInput, ...aws.Option) (*storagegateway.CancelArchivalOutput, error)
+
 	CancelRetrieval(*storagegateway.CancelRetrievalInput) (*storagegateway.CancelRetrievalOutput, error)
 
+	CancelRetrievalWithContext(aws.Context, *storagegateway.CancelRetrievalInput, ...aws.Option) (*storagegateway.CancelRetrievalOutput, error)
+
 	CreateCachediSCSIVolume(*storagegateway.CreateCachediSCSIVolumeInput) (*storagegateway.CreateCachediSCSIVolumeOutput, error)
 
+	CreateCachediSCSIVolumeWithContext(aws.Context, *storagegateway.CreateCachediSCSIVolumeInput, ...aws.Option) (*storagegateway.CreateCachediSCSIVolumeOutput, error)
+
 	CreateSnapshot(*storagegateway.CreateSnapshotInput) (*storagegateway.CreateSnapshotOutput, error)
 
+	CreateSnapshotWithContext(aws.Context, *storagegateway.CreateSnapshotInput, ...aws.Option) (*storagegateway.CreateSnapshotOutput, error)
+
 	CreateSnapshotFromVolumeRecoveryPoint(*storagegateway.CreateSnapshotFromVolumeRecoveryPointInput) (*storagegateway.CreateSnapshotFromVolumeRecoveryPointOutput, error)
 
+	CreateSnapshotFromVolumeRecoveryPointWithContext(aws.Context, *storagegateway.CreateSnapshotFromVolumeRecoveryPointInput, ...aws.Option) (*storagegateway.CreateSnapshotFromVolumeRecoveryPointOutput, error)
+
 	CreateStorediSCSIVolume(*storagegateway.CreateStorediSCSIVolumeInput) (*storagegateway.CreateStorediSCSIVolumeOutput, error)
 
+	CreateStorediSCSIVolumeWithContext(aws.Context, *storagegateway.CreateStorediSCSIVolumeInput, ...aws.Option) (*storagegateway.CreateStorediSCSIVolumeOutput, error)
+
 	CreateTapes(*storagegateway.CreateTapesInput) (*storagegateway.CreateTapesOutput, error)
 
+	CreateTapesWithContext(aws.Context, *storagegateway.CreateTapesInput, ...aws.Option) (*storagegateway.CreateTapesOutput, error)
+
 	DeleteBandwidthRateLimit(*storagegateway.DeleteBandwidthRateLimitInput) (*storagegateway.DeleteBandwidthRateLimitOutput, error)
 
+	DeleteBandwidthRateLimitWithContext(aws.Context, *storagegateway.DeleteBandwidthRateLimitInput, ...aws.Option) (*storagegateway.DeleteBandwidthRateLimitOutput, error)
+
 	DeleteChapCredentials(*storagegateway.DeleteChapCredentialsInput) (*storagegateway.DeleteChapCredentialsOutput, error)
 
+	DeleteChapCredentialsWithContext(aws.Context, *storagegateway.DeleteChapCredentialsInput, ...aws.Option) (*storagegateway.DeleteChapCredentialsOutput, error)
+
 	DeleteGateway(*storagegateway.DeleteGatewayInput) (*storagegateway.DeleteGatewayOutput, error)
 
+	DeleteGatewayWithContext(aws.Context, *storagegateway.DeleteGatewayInput, ...aws.Option) (*storagegateway.DeleteGatewayOutput, error)
+
 	DeleteSnapshotSchedule(*storagegateway.DeleteSnapshotScheduleInput) (*storagegateway.DeleteSnapshotScheduleOutput, error)
 
+	DeleteSnapshotScheduleWithContext(aws.Context, *storagegateway.DeleteSnapshotScheduleInput, ...aws.Option) (*storagegateway.DeleteSnapshotScheduleOutput, error)
+
 	DeleteTape(*storagegateway.DeleteTapeInput) (*storagegateway.DeleteTapeOutput, error)
 
+	DeleteTapeWithContext(aws.Context, *storagegateway.DeleteTapeInput, ...aws.Option) (*storagegateway.DeleteTapeOutput, error)
+
 	DeleteTapeArchive(*storagegateway.DeleteTapeArchiveInput) (*storagegateway.DeleteTapeArchiveOutput, error)
 
+	DeleteTapeArchiveWithContext(aws.Context, *storagegateway.DeleteTapeArchiveInput, ...aws.Option) (*storagegateway.DeleteTapeArchiveOutput, error)
+
 	DeleteVolume(*storagegateway.DeleteVolumeInput) (*storagegateway.DeleteVolumeOutput, error)
 
+	DeleteVolumeWithContext(aws.Context, *storagegateway.DeleteVolumeInput, ...aws.Option) (*storagegateway.DeleteVolumeOutput, error)
+
 	DescribeBandwidthRateLimit(*storagegateway.DescribeBandwidthRateLimitInput) (*storagegateway.DescribeBandwidthRateLimitOutput, error)
 
+	DescribeBandwidthRateLimitWithContext(aws.Context, *storagegateway.DescribeBandwidthRateLimitInput, ...aws.Option) (*storagegateway.DescribeBandwidthRateLimitOutput, error)
+
 	DescribeCache(*storagegateway.DescribeCacheInput) (*storagegateway.DescribeCacheOutput, error)
 
+	DescribeCacheWithContext(aws.Context, *storagegateway.DescribeCacheInput, ...aws.Option) (*storagegateway.DescribeCacheOutput, error)
+
 	DescribeCachediSCSIVolumes(*storagegateway.DescribeCachediSCSIVolumesInput) (*storagegateway.DescribeCachediSCSIVolumesOutput, error)
 
+	DescribeCachediSCSIVolumesWithContext(aws.Context, *storagegateway.DescribeCachediSCSIVolumesInput, ...aws.Option) (*storagegateway.DescribeCachediSCSIVolumesOutput, error)
+
 	DescribeChapCredentials(*storagegateway.DescribeChapCredentialsInput) (*storagegateway.DescribeChapCredentialsOutput, error)
 
+	DescribeChapCredentialsWithContext(aws.Context, *storagegateway.DescribeChapCredentialsInput, ...aws.Option) (*storagegateway.DescribeChapCredentialsOutput, error)
+
 	DescribeGatewayInformation(*storagegateway.DescribeGatewayInformationInput) (*storagegateway.DescribeGatewayInformationOutput, error)
 
+	DescribeGatewayInformationWithContext(aws.Context, *storagegateway.DescribeGatewayInformationInput, ...aws.Option) (*storagegateway.DescribeGatewayInformationOutput, error)
+
 	DescribeMaintenanceStartTime(*storagegateway.DescribeMaintenanceStartTimeInput) (*storagegateway.DescribeMaintenanceStartTimeOutput, error)
 
+	DescribeMaintenanceStartTimeWithContext(aws.Context, *storagegateway.DescribeMaintenanceStartTimeInput, ...aws.Option) (*storagegateway.DescribeMaintenanceStartTimeOutput, error)
+
 	DescribeSnapshotSchedule(*storagegateway.DescribeSnapshotScheduleInput) (*storagegateway.DescribeSnapshotScheduleOutput, error)
 
+	DescribeSnapshotScheduleWithContext(aws.Context, *storagegateway.DescribeSnapshotScheduleInput, ...aws.Option) (*storagegateway.DescribeSnapshotScheduleOutput, error)
+
 	DescribeStorediSCSIVolumes(*storagegateway.DescribeStorediSCSIVolumesInput) (*storagegateway.DescribeStorediSCSIVolumesOutput, error)
 
+	DescribeStorediSCSIVolumesWithContext(aws.Context, *storagegateway.DescribeStorediSCSIVolumesInput, ...aws.Option) (*storagegateway.DescribeStorediSCSIVolumesOutput, error)
+
 	DescribeTapeArchives(*storagegateway.DescribeTapeArchivesInput) (*storagegateway.DescribeTapeArchivesOutput, error)
 
+	DescribeTapeArchivesWithContext(aws.Context, *storagegateway.DescribeTapeArchivesInput, ...aws.Option) (*storagegateway.DescribeTapeArchivesOutput, error)
+
+	DescribeTapeArchivesPages(*storagegateway.DescribeTapeArchivesInput, func(*storagegateway.DescribeTapeArchivesOutput, bool) bool) error
+
+	DescribeTapeArchivesPagesWithContext(aws.Context, *storagegateway.DescribeTapeArchivesInput, func(*storagegateway.DescribeTapeArchivesOutput, bool) bool, ...aws.Option) error
+
 	DescribeTapeRecoveryPoints(*storagegateway.DescribeTapeRecoveryPointsInput) (*storagegateway.DescribeTapeRecoveryPointsOutput, error)
 
+	DescribeTapeRecoveryPointsWithContext(aws.Context, *storagegateway.DescribeTapeRecoveryPointsInput, ...aws.Option) (*storagegateway.DescribeTapeRecoveryPointsOutput, error)
+
+	DescribeTapeRecoveryPointsPages(*storagegateway.DescribeTapeRecoveryPointsInput, func(*storagegateway.DescribeTapeRecoveryPointsOutput, bool) bool) error
+
+	DescribeTapeRecoveryPointsPagesWithContext(aws.Context, *storagegateway.DescribeTapeRecoveryPointsInput, func(*storagegateway.DescribeTapeRecoveryPointsOutput, bool) bool, ...aws.Option) error
+
 	DescribeTapes(*storagegateway.DescribeTapesInput) (*storagegateway.DescribeTapesOutput, error)
 
+	DescribeTapesWithContext(aws.Context, *storagegateway.DescribeTapesInput, ...aws.Option) (*storagegateway.DescribeTapesOutput, error)
+
+	DescribeTapesPages(*storagegateway.DescribeTapesInput, func(*storagegateway.DescribeTapesOutput, bool) bool) error
+
+	DescribeTapesPagesWithContext(aws.Context, *storagegateway.DescribeTapesInput, func(*storagegateway.DescribeTapesOutput, bool) bool, ...aws.Option) error
+
 	DescribeUploadBuffer(*storagegateway.DescribeUploadBufferInput) (*storagegateway.DescribeUploadBufferOutput, error)
 
+	DescribeUploadBufferWithContext(aws.Context, *storagegateway.DescribeUploadBufferInput, ...aws.Option) (*storagegateway.DescribeUploadBufferOutput, error)
+
 	DescribeVTLDevices(*storagegateway.DescribeVTLDevicesInput) (*storagegateway.DescribeVTLDevicesOutput, error)
 
+	DescribeVTLDevicesWithContext(aws.Context, *storagegateway.DescribeVTLDevicesInput, ...aws.Option) (*storagegateway.DescribeVTLDevicesOutput, error)
+
+	DescribeVTLDevicesPages(*storagegateway.DescribeVTLDevicesInput, func(*storagegateway.DescribeVTLDevicesOutput, bool) bool) error
+
+	DescribeVTLDevicesPagesWithContext(aws.Context, *storagegateway.DescribeVTLDevicesInput, func(*storagegateway.DescribeVTLDevicesOutput, bool) bool, ...aws.Option) error
+
 	DescribeWorkingStorage(*storagegateway.DescribeWorkingStorageInput) (*storagegateway.DescribeWorkingStorageOutput, error)
 
+	DescribeWorkingStorageWithContext(aws.Context, *storagegateway.DescribeWorkingStorageInput, ...aws.Option) (*storagegateway.DescribeWorkingStorageOutput, error)
+
 	DisableGateway(*storagegateway.DisableGatewayInput) (*storagegateway.DisableGatewayOutput, error)
 
+	DisableGatewayWithContext(aws.Context, *storagegateway.DisableGatewayInput, ...aws.Option) (*storagegateway.DisableGatewayOutput, error)
+
 	ListGateways(*storagegateway.ListGatewaysInput) (*storagegateway.ListGatewaysOutput, error)
 
+	ListGatewaysWithContext(aws.Context, *storagegateway.ListGatewaysInput, ...aws.Option) (*storagegateway.ListGatewaysOutput, error)
+
+	ListGatewaysPages(*storagegateway.ListGatewaysInput, func(*storagegateway.ListGatewaysOutput, bool) bool) error
+
+	ListGatewaysPagesWithContext(aws.Context, *storagegateway.ListGatewaysInput, func(*storagegateway.ListGatewaysOutput, bool) bool, ...aws.Option) error
+
 	ListLocalDisks(*storagegateway.ListLocalDisksInput) (*storagegateway.ListLocalDisksOutput, error)
 
+	ListLocalDisksWithContext(aws.Context, *storagegateway.ListLocalDisksInput, ...aws.Option) (*storagegateway.ListLocalDisksOutput, error)
+
 	ListVolumeInitiators(*storagegateway.ListVolumeInitiatorsInput) (*storagegateway.ListVolumeInitiatorsOutput, error)
 
+	ListVolumeInitiatorsWithContext(aws.Context, *storagegateway.ListVolumeInitiatorsInput, ...aws.Option) (*storagegateway.ListVolumeInitiatorsOutput, error)
+
 	ListVolumeRecoveryPoints(*storagegateway.ListVolumeRecoveryPointsInput) (*storagegateway.ListVolumeRecoveryPointsOutput, error)
 
+	ListVolumeRecoveryPointsWithContext(aws.Context, *storagegateway.ListVolumeRecoveryPointsInput, ...aws.Option) (*storagegateway.ListVolumeRecoveryPointsOutput, error)
+
 	ListVolumes(*storagegateway.ListVolumesInput) (*storagegateway.ListVolumesOutput, error)
 
+	ListVolumesWithContext(aws.Context, *storagegateway.ListVolumesInput, ...aws.Option) (*storagegateway.ListVolumesOutput, error)
+
+	ListVolumesPages(*storagegateway.ListVolumesInput, func(*storagegateway.ListVolumesOutput, bool) bool) error
+
+	ListVolumesPagesWithContext(aws.Context, *storagegateway.ListVolumesInput, func(*storagegateway.ListVolumesOutput, bool) bool, ...aws.Option) error
+
 	ResetCache(*storagegateway.ResetCacheInput) (*storagegateway.ResetCacheOutput, error)
 
+	ResetCacheWithContext(aws.Context, *storagegateway.ResetCacheInput, ...aws.Option) (*storagegateway.ResetCacheOutput, error)
+
 	RetrieveTapeArchive(*storagegateway.RetrieveTapeArchiveInput) (*storagegateway.RetrieveTapeArchiveOutput, error)
 
+	RetrieveTapeArchiveWithContext(aws.Context, *storagegateway.RetrieveTapeArchiveInput, ...aws.Option) (*storagegateway.RetrieveTapeArchiveOutput, error)
+
 	RetrieveTapeRecoveryPoint(*storagegateway.RetrieveTapeRecoveryPointInput) (*storagegateway.RetrieveTapeRecoveryPointOutput, error)
 
+	RetrieveTapeRecoveryPointWithContext(aws.Context, *storagegateway.RetrieveTapeRecoveryPointInput, ...aws.Option) (*storagegateway.RetrieveTapeRecoveryPointOutput, error)
+
 	ShutdownGateway(*storagegateway.ShutdownGatewayInput) (*storagegateway.ShutdownGatewayOutput, error)
 
+	ShutdownGatewayWithContext(aws.Context, *storagegateway.ShutdownGatewayInput, ...aws.Option) (*storagegateway.ShutdownGatewayOutput, error)
+
 	StartGateway(*storagegateway.StartGatewayInput) (*storagegateway.StartGatewayOutput, error)
 
+	StartGatewayWithContext(aws.Context, *storagegateway.StartGatewayInput, ...aws.Option) (*storagegateway.StartGatewayOutput, error)
+
 	UpdateBandwidthRateLimit(*storagegateway.UpdateBandwidthRateLimitInput) (*storagegateway.UpdateBandwidthRateLimitOutput, error)
 
+	UpdateBandwidthRateLimitWithContext(aws.Context, *storagegateway.UpdateBandwidthRateLimitInput, ...aws.Option) (*storagegateway.UpdateBandwidthRateLimitOutput, error)
+
 	UpdateChapCredentials(*storagegateway.UpdateChapCredentialsInput) (*storagegateway.UpdateChapCredentialsOutput, error)
 
+	UpdateChapCredentialsWithContext(aws.Context, *storagegateway.UpdateChapCredentialsInput, ...aws.Option) (*storagegateway.UpdateChapCredentialsOutput, error)
+
 	UpdateGatewayInformation(*storagegateway.UpdateGatewayInformationInput) (*storagegateway.UpdateGatewayInformationOutput, error)
 
+	UpdateGatewayInformationWithContext(aws.Context, *storagegateway.UpdateGatewayInformationInput, ...aws.Option) (*storagegateway.UpdateGatewayInformationOutput, error)
+
 	UpdateGatewaySoftwareNow(*storagegateway.UpdateGatewaySoftwareNowInput) (*storagegateway.UpdateGatewaySoftwareNowOutput, error)
 
+	UpdateGatewaySoftwareNowWithContext(aws.Context, *storagegateway.UpdateGatewaySoftwareNowInput, ...aws.Option) (*storagegateway.UpdateGatewaySoftwareNowOutput, error)
+
 	UpdateMaintenanceStartTime(*storagegateway.UpdateMaintenanceStartTimeInput) (*storagegateway.UpdateMaintenanceStartTimeOutput, error)
 
+	UpdateMaintenanceStartTimeWithContext(aws.Context, *storagegateway.UpdateMaintenanceStartTimeInput, ...aws.Option) (*storagegateway.UpdateMaintenanceStartTimeOutput, error)
+
 	UpdateSnapshotSchedule(*storagegateway.UpdateSnapshotScheduleInput) (*storagegateway.UpdateSnapshotScheduleOutput, error)
 
+	UpdateSnapshotScheduleWithContext(aws.Context, *storagegateway.UpdateSnapshotScheduleInput, ...aws.Option) (*storagegateway.UpdateSnapshotScheduleOutput, error)
+
 	UpdateVTLDeviceType(*storagegateway.UpdateVTLDeviceTypeInput) (*storagegateway.UpdateVTLDeviceTypeOutput, error)
+
+	UpdateVTLDeviceTypeWithContext(aws.Context, *storagegateway.UpdateVTLDeviceTypeInput, ...aws.Option) (*storagegateway.UpdateVTLDeviceTypeOutput, error)
 }
@@ -108,6 +108,18 @@ func (c *STS) AssumeRole(input *AssumeRoleInput) (*AssumeRoleOutput, error) {
 	return out, err
 }
 
+// AssumeRoleWithContext is the same as AssumeRole with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *STS) AssumeRoleWithContext(ctx aws.Context, input *AssumeRoleInput, opts ...aws.Option) (*AssumeRoleOutput, error) {
+	req, out := c.AssumeRoleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAssumeRoleWithSAML = "AssumeRoleWithSAML"
 
 // AssumeRoleWithSAMLRequest generates a request for the AssumeRoleWithSAML operation.
@@ -179,6 +191,18 @@ func (c *STS) AssumeRoleWithSAML(input *AssumeRoleWithSAMLInput) (*AssumeRoleWit
 	return out, err
 }
 
+// AssumeRoleWithSAMLWithContext is the same as AssumeRoleWithSAML with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *STS) AssumeRoleWithSAMLWithContext(ctx aws.Context, input *AssumeRoleWithSAMLInput, opts ...aws.Option) (*AssumeRoleWithSAMLOutput, error) {
+	req, out := c.AssumeRoleWithSAMLRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAssumeRoleWithWebIdentity = "AssumeRoleWithWebIdentity"
 
 // AssumeRoleWithWebIdentityRequest generates a request for the AssumeRoleWithWebIdentity operation.
@@ -270,6 +294,18 @@ func (c *STS) AssumeRoleWithWebIdentity(input *AssumeRoleWithWebIdentityInput) (
 	return out, err
 }
 
+// AssumeRoleWithWebIdentityWithContext is the same as AssumeRoleWithWebIdentity with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *STS) AssumeRoleWithWebIdentityWithContext(ctx aws.Context, input *AssumeRoleWithWebIdentityInput, opts ...aws.Option) (*AssumeRoleWithWebIdentityOutput, error) {
+	req, out := c.AssumeRoleWithWebIdentityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDecodeAuthorizationMessage = "DecodeAuthorizationMessage"
 
 // DecodeAuthorizationMessageRequest generates a request for the DecodeAuthorizationMessage operation.
@@ -320,6 +356,18 @@ func (c *STS) DecodeAuthorizationMessage(input *DecodeAuthorizationMessageInput)
 	return out, err
 }
 
+// DecodeAuthorizationMessageWithContext is the same as DecodeAuthorizationMessage with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *STS) DecodeAuthorizationMessageWithContext(ctx aws.Context, input *DecodeAuthorizationMessageInput, opts ...aws.Option) (*DecodeAuthorizationMessageOutput, error) {
+	req, out := c.DecodeAuthorizationMessageRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetFederationToken = "GetFederationToken"
 
 // GetFederationTokenRequest generates a request for the GetFederationToken operation.
@@ -410,6 +458,18 @@ func (c *STS) GetFederationToken(input *GetFederationTokenInput) (*GetFederation
 	return out, err
 }
 
+// GetFederationTokenWithContext is the same as GetFederationToken with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *STS) GetFederationTokenWithContext(ctx aws.Context, input *GetFederationTokenInput, opts ...aws.Option) (*GetFederationTokenOutput, error) {
+	req, out := c.GetFederationTokenRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSessionToken = "GetSessionToken"
 
 // GetSessionTokenRequest generates a request for the GetSessionToken operation.
@@ -467,11 +527,23 @@ func (c *STS) GetSessionToken(input *GetSessionTokenInput) (*GetSessionTokenOutp
 	return out, err
 }
 
+// GetSessionTokenWithContext is the same as GetSessionToken with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *STS) GetSessionTokenWithContext(ctx aws.Context, input *GetSessionTokenInput, opts ...aws.Option) (*GetSessionTokenOutput, error) {
+	req, out := c.GetSessionTokenRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 type AssumeRoleInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"3600"`
 
 	// A unique identifier that is used by third parties when assuming roles in
 	// their customers' accounts. For each role that the third party can assume,
@@ -482,7 +554,7 @@ type AssumeRoleInput struct {
 	// created it. For more information about the external ID, see How to Use External
 	// ID When Granting Access to Your AWS Resources (http://docs.aws.amazon.com/STS/latest/UsingSTS/sts-delegating-externalid.html)
 	// in Using Temporary Security Credentials.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" min:"2" max:"1224"`
 
 	// An IAM policy in JSON format.
 	//
@@ -502,10 +574,10 @@ type AssumeRoleInput struct {
 	// The PackedPolicySize response element indicates by percentage how close to
 	// the upper size limit the policy is, with 100% equaling the maximum allowed
 	// size.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048"`
 
 	// The Amazon Resource Name (ARN) of the role to assume.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session.
 	//
@@ -516,20 +588,20 @@ type AssumeRoleInput struct {
 	// of the assumed role principal. This means that subsequent cross-account API
 	// requests using the temporary security credentials will expose the role session
 	// name to the external account in their CloudTrail logs.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" required:"true"`
 
 	// The identification number of the MFA device that is associated with the user
 	// who is making the AssumeRole call. Specify this value if the trust policy
 	// of the role being assumed includes a condition that requires MFA authentication.
 	// The value is either the serial number for a hardware device (such as GAHT12345678)
 	// or an Amazon Resource Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256"`
 
 	// The value provided by the MFA device, if the trust policy of the role being
 	// assumed requires MFA (that is, if the policy includes a condition that tests
 	// for MFA). If the role being assumed requires MFA and if the TokenCode value
 	// is missing or expired, the AssumeRole call returns an "access denied" error.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6"`
 
 	metadataAssumeRoleInput `json:"-" xml:"-"`
 }
@@ -565,7 +637,7 @@ type AssumeRoleOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	metadataAssumeRoleOutput `json:"-" xml:"-"`
 }
@@ -593,7 +665,7 @@ type AssumeRoleWithSAMLInput struct {
 	//
 	// The maximum duration for a session is 1 hour, and the minimum duration is
 	// 15 minutes, even if values outside this range are specified.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"3600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -612,21 +684,21 @@ type AssumeRoleWithSAMLInput struct {
 	// The PackedPolicySize response element indicates by percentage how close to
 	// the upper size limit the policy is, with 100% equaling the maximum allowed
 	// size.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider in IAM that describes
 	// the IdP.
-	PrincipalARN *string `locationName:"PrincipalArn" type:"string" required:"true"`
+	PrincipalARN *string `locationName:"PrincipalArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The base-64 encoded SAML authentication response provided by the IdP.
 	//
 	// For more information, see Configuring a Relying Party and Adding Claims
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/create-role-saml-IdP-tasks.html)
 	// in the Using IAM guide.
-	SAMLAssertion *string `type:"string" required:"true"`
+	SAMLAssertion *string `type:"string" min:"4" max:"50000" required:"true"`
 
 	metadataAssumeRoleWithSAMLInput `json:"-" xml:"-"`
 }
@@ -676,7 +748,7 @@ type AssumeRoleWithSAMLOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	// The value of the NameID element in the Subject element of the SAML assertion.
 	Subject *string `type:"string"`
@@ -712,7 +784,7 @@ type AssumeRoleWithWebIdentityInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"3600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -730,7 +802,7 @@ type AssumeRoleWithWebIdentityInput struct {
 	// The PackedPolicySize response element indicates by percentage how close to
 	// the upper size limit the policy is, with 100% equaling the maximum allowed
 	// size.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048"`
 
 	// The fully qualified host component of the domain name of the identity provider.
 	//
@@ -739,23 +811,23 @@ type AssumeRoleWithWebIdentityInput struct {
 	// 2.0 access tokens. Do not include URL schemes and port numbers.
 	//
 	// Do not specify this value for OpenID Connect ID tokens.
-	ProviderID *string `locationName:"ProviderId" type:"string"`
+	ProviderID *string `locationName:"ProviderId" type:"string" min:"4" max:"2048"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session. Typically, you pass the name
 	// or identifier that is associated with the user who is using your application.
 	// That way, the temporary security credentials that your application will use
 	// are associated with that user. This session name is included as part of the
 	// ARN and assumed role ID in the AssumedRoleUser response element.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" required:"true"`
 
 	// The OAuth 2.0 access token or OpenID Connect ID token that is provided by
 	// the identity provider. Your application must get this token by authenticating
 	// the user who is using your application with a web identity provider before
 	// the application makes an AssumeRoleWithWebIdentity call.
-	WebIdentityToken *string `type:"string" required:"true"`
+	WebIdentityToken *string `type:"string" min:"4" max:"2048" required:"true"`
 
 	metadataAssumeRoleWithWebIdentityInput `json:"-" xml:"-"`
 }
@@ -796,7 +868,7 @@ type AssumeRoleWithWebIdentityOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	// The issuing authority of the web identity token presented. For OpenID Connect
 	// ID Tokens this contains the value of the iss field. For OAuth 2.0 access
@@ -810,7 +882,7 @@ type AssumeRoleWithWebIdentityOutput struct {
 	// the user and the application that acquired the WebIdentityToken (pairwise
 	// identifier). For OpenID Connect ID tokens, this field contains the value
 	// returned by the identity provider as the token's sub (Subject) claim.
-	SubjectFromWebIdentityToken *string `type:"string"`
+	SubjectFromWebIdentityToken *string `type:"string" min:"6" max:"255"`
 
 	metadataAssumeRoleWithWebIdentityOutput `json:"-" xml:"-"`
 }
@@ -836,12 +908,12 @@ type AssumedRoleUser struct {
 	// AssumeRole action. For more information about ARNs and how to use them in
 	// policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/reference_identifiers.html)
 	// in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A unique identifier that contains the role ID and the role session name of
 	// the role that is being assumed. The role ID is generated by AWS when the
 	// role is created.
-	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" required:"true"`
+	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" min:"2" max:"96" required:"true"`
 
 	metadataAssumedRoleUser `json:"-" xml:"-"`
 }
@@ -863,7 +935,7 @@ func (s AssumedRoleUser) GoString() string {
 // AWS credentials for API authentication.
 type Credentials struct {
 	// The access key ID that identifies the temporary security credentials.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" required:"true"`
 
 	// The date on which the current credentials expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -893,7 +965,7 @@ func (s Credentials) GoString() string {
 
 type DecodeAuthorizationMessageInput struct {
 	// The encoded message that was returned with the response.
-	EncodedMessage *string `type:"string" required:"true"`
+	EncodedMessage *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataDecodeAuthorizationMessageInput `json:"-" xml:"-"`
 }
@@ -943,11 +1015,11 @@ type FederatedUser struct {
 	// For more information about ARNs and how to use them in policies, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/reference_identifiers.html)
 	// in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The string that identifies the federated user associated with the credentials,
 	// similar to the unique ID of an IAM user.
-	FederatedUserID *string `locationName:"FederatedUserId" type:"string" required:"true"`
+	FederatedUserID *string `locationName:"FederatedUserId" type:"string" min:"2" max:"96" required:"true"`
 
 	metadataFederatedUser `json:"-" xml:"-"`
 }
@@ -974,13 +1046,13 @@ type GetFederationTokenInput struct {
 	// seconds (one hour). If the specified duration is longer than one hour, the
 	// session obtained by using AWS account (root) credentials defaults to one
 	// hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The name of the federated user. The name is used as an identifier for the
 	// temporary security credentials (such as Bob). For example, you can reference
 	// the federated user name in a resource-based policy, such as in an Amazon
 	// S3 bucket policy.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"2" max:"32" required:"true"`
 
 	// An IAM policy in JSON format that is passed with the GetFederationToken call
 	// and evaluated along with the policy or policies that are attached to the
@@ -1003,7 +1075,7 @@ type GetFederationTokenInput struct {
 	// the upper size limit the policy is, with 100% equaling the maximum allowed
 	// size.  For more information about how permissions work, see Permissions for
 	// GetFederationToken (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-get-federation-token.html).
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048"`
 
 	metadataGetFederationTokenInput `json:"-" xml:"-"`
 }
@@ -1037,7 +1109,7 @@ type GetFederationTokenOutput struct {
 	// A percentage value indicating the size of the policy in packed form. The
 	// service rejects policies for which the packed size is greater than 100 percent
 	// of the allowed value.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	metadataGetFederationTokenOutput `json:"-" xml:"-"`
 }
@@ -1063,7 +1135,7 @@ type GetSessionTokenInput struct {
 	// for AWS account owners are restricted to a maximum of 3600 seconds (one hour).
 	// If the duration is longer than one hour, the session for AWS account owners
 	// defaults to one hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The identification number of the MFA device that is associated with the IAM
 	// user who is making the GetSessionToken call. Specify this value if the IAM
@@ -1072,14 +1144,14 @@ type GetSessionTokenInput struct {
 	// Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
 	// You can find the device for an IAM user by going to the AWS Management Console
 	// and viewing the user's security credentials.
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256"`
 
 	// The value provided by the MFA device, if MFA is required. If any policy requires
 	// the IAM user to submit an MFA code, specify this value. If MFA authentication
 	// is required, and the user does not provide a code when requesting a set of
 	// temporary security credentials, the user will receive an "access denied"
 	// response when requesting resources that require MFA authentication.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6"`
 
 	metadataGetSessionTokenInput `json:"-" xml:"-"`
 }
@@ -4,6 +4,7 @@
 package stsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
@@ -11,13 +12,25 @@ import (
 type STSAPI interface {
 	AssumeRole(*sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
 
+	AssumeRoleWithContext(aws.Context, *sts.AssumeRoleInput, ...aws.Option) (*sts.AssumeRoleOutput, error)
+
 	AssumeRoleWithSAML(*sts.AssumeRoleWithSAMLInput) (*sts.AssumeRoleWithSAMLOutput, error)
 
+	AssumeRoleWithSAMLWithContext(aws.Context, *sts.AssumeRoleWithSAMLInput, ...aws.Option) (*sts.AssumeRoleWithSAMLOutput, error)
+
 	AssumeRoleWithWebIdentity(*sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error)
 
+	AssumeRoleWithWebIdentityWithContext(aws.Context, *sts.AssumeRoleWithWebIdentityInput, ...aws.Option) (*sts.AssumeRoleWithWebIdentityOutput, error)
+
 	DecodeAuthorizationMessage(*sts.DecodeAuthorizationMessageInput) (*sts.DecodeAuthorizationMessageOutput, error)
 
+	DecodeAuthorizationMessageWithContext(aws.Context, *sts.DecodeAuthorizationMessageInput, ...aws.Option) (*sts.DecodeAuthorizationMessageOutput, error)
+
 	GetFederationToken(*sts.GetFederationTokenInput) (*sts.GetFederationTokenOutput, error)
 
+	GetFederationTokenWithContext(aws.Context, *sts.GetFederationTokenInput, ...aws.Option) (*sts.GetFederationTokenOutput, error)
+
 	GetSessionToken(*sts.GetSessionTokenInput) (*sts.GetSessionTokenOutput, error)
+
+	GetSessionTokenWithContext(aws.Context, *sts.GetSessionTokenInput, ...aws.Option) (*sts.GetSessionTokenOutput, error)
 }
@@ -44,6 +44,18 @@ func (c *Support) AddAttachmentsToSet(input *AddAttachmentsToSetInput) (*AddAtta
 	return out, err
 }
 
+// AddAttachmentsToSetWithContext is the same as AddAttachmentsToSet with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) AddAttachmentsToSetWithContext(ctx aws.Context, input *AddAttachmentsToSetInput, opts ...aws.Option) (*AddAttachmentsToSetOutput, error) {
+	req, out := c.AddAttachmentsToSetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opAddCommunicationToCase = "AddCommunicationToCase"
 
 // AddCommunicationToCaseRequest generates a request for the AddCommunicationToCase operation.
@@ -78,6 +90,18 @@ func (c *Support) AddCommunicationToCase(input *AddCommunicationToCaseInput) (*A
 	return out, err
 }
 
+// AddCommunicationToCaseWithContext is the same as AddCommunicationToCase with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) AddCommunicationToCaseWithContext(ctx aws.Context, input *AddCommunicationToCaseInput, opts ...aws.Option) (*AddCommunicationToCaseOutput, error) {
+	req, out := c.AddCommunicationToCaseRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCase = "CreateCase"
 
 // CreateCaseRequest generates a request for the CreateCase operation.
@@ -134,6 +158,18 @@ func (c *Support) CreateCase(input *CreateCaseInput) (*CreateCaseOutput, error)
 	return out, err
 }
 
+// CreateCaseWithContext is the same as CreateCase with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) CreateCaseWithContext(ctx aws.Context, input *CreateCaseInput, opts ...aws.Option) (*CreateCaseOutput, error) {
+	req, out := c.CreateCaseRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAttachment = "DescribeAttachment"
 
 // DescribeAttachmentRequest generates a request for the DescribeAttachment operation.
@@ -164,6 +200,18 @@ func (c *Support) DescribeAttachment(input *DescribeAttachmentInput) (*DescribeA
 	return out, err
 }
 
+// DescribeAttachmentWithContext is the same as DescribeAttachment with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeAttachmentWithContext(ctx aws.Context, input *DescribeAttachmentInput, opts ...aws.Option) (*DescribeAttachmentOutput, error) {
+	req, out := c.DescribeAttachmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCases = "DescribeCases"
 
 // DescribeCasesRequest generates a request for the DescribeCases operation.
@@ -177,6 +225,7 @@ func (c *Support) DescribeCasesRequest(input *DescribeCasesInput) (req *aws.Requ
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"cases"},
 		},
 	}
 
@@ -210,6 +259,22 @@ func (c *Support) DescribeCases(input *DescribeCasesInput) (*DescribeCasesOutput
 	return out, err
 }
 
+// DescribeCasesWithContext is the same as DescribeCases with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeCasesWithContext(ctx aws.Context, input *DescribeCasesInput, opts ...aws.Option) (*DescribeCasesOutput, error) {
+	req, out := c.DescribeCasesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeCasesPages iterates over the pages of a DescribeCases operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Support) DescribeCasesPages(input *DescribeCasesInput, fn func(p *DescribeCasesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCasesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -217,6 +282,19 @@ func (c *Support) DescribeCasesPages(input *DescribeCasesInput, fn func(p *Descr
 	})
 }
 
+// DescribeCasesPagesWithContext is the same as DescribeCasesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Support) DescribeCasesPagesWithContext(ctx aws.Context, input *DescribeCasesInput, fn func(p *DescribeCasesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeCasesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCasesOutput), lastPage)
+	})
+}
+
 const opDescribeCommunications = "DescribeCommunications"
 
 // DescribeCommunicationsRequest generates a request for the DescribeCommunications operation.
@@ -230,6 +308,7 @@ func (c *Support) DescribeCommunicationsRequest(input *DescribeCommunicationsInp
 			OutputTokens:    []string{"nextToken"},
 			LimitToken:      "maxResults",
 			TruncationToken: "",
+			ResultKeys:      []string{"communications"},
 		},
 	}
 
@@ -259,6 +338,22 @@ func (c *Support) DescribeCommunications(input *DescribeCommunicationsInput) (*D
 	return out, err
 }
 
+// DescribeCommunicationsWithContext is the same as DescribeCommunications with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeCommunicationsWithContext(ctx aws.Context, input *DescribeCommunicationsInput, opts ...aws.Option) (*DescribeCommunicationsOutput, error) {
+	req, out := c.DescribeCommunicationsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeCommunicationsPages iterates over the pages of a DescribeCommunications operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *Support) DescribeCommunicationsPages(input *DescribeCommunicationsInput, fn func(p *DescribeCommunicationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCommunicationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -266,6 +361,19 @@ func (c *Support) DescribeCommunicationsPages(input *DescribeCommunicationsInput
 	})
 }
 
+// DescribeCommunicationsPagesWithContext is the same as DescribeCommunicationsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *Support) DescribeCommunicationsPagesWithContext(ctx aws.Context, input *DescribeCommunicationsInput, fn func(p *DescribeCommunicationsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeCommunicationsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCommunicationsOutput), lastPage)
+	})
+}
+
 const opDescribeServices = "DescribeServices"
 
 // DescribeServicesRequest generates a request for the DescribeServices operation.
@@ -303,6 +411,18 @@ func (c *Support) DescribeServices(input *DescribeServicesInput) (*DescribeServi
 	return out, err
 }
 
+// DescribeServicesWithContext is the same as DescribeServices with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeServicesWithContext(ctx aws.Context, input *DescribeServicesInput, opts ...aws.Option) (*DescribeServicesOutput, error) {
+	req, out := c.DescribeServicesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSeverityLevels = "DescribeSeverityLevels"
 
 // DescribeSeverityLevelsRequest generates a request for the DescribeSeverityLevels operation.
@@ -332,6 +452,18 @@ func (c *Support) DescribeSeverityLevels(input *DescribeSeverityLevelsInput) (*D
 	return out, err
 }
 
+// DescribeSeverityLevelsWithContext is the same as DescribeSeverityLevels with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeSeverityLevelsWithContext(ctx aws.Context, input *DescribeSeverityLevelsInput, opts ...aws.Option) (*DescribeSeverityLevelsOutput, error) {
+	req, out := c.DescribeSeverityLevelsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorCheckRefreshStatuses = "DescribeTrustedAdvisorCheckRefreshStatuses"
 
 // DescribeTrustedAdvisorCheckRefreshStatusesRequest generates a request for the DescribeTrustedAdvisorCheckRefreshStatuses operation.
@@ -360,6 +492,18 @@ func (c *Support) DescribeTrustedAdvisorCheckRefreshStatuses(input *DescribeTrus
 	return out, err
 }
 
+// DescribeTrustedAdvisorCheckRefreshStatusesWithContext is the same as DescribeTrustedAdvisorCheckRefreshStatuses with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeTrustedAdvisorCheckRefreshStatusesWithContext(ctx aws.Context, input *DescribeTrustedAdvisorCheckRefreshStatusesInput, opts ...aws.Option) (*DescribeTrustedAdvisorCheckRefreshStatusesOutput, error) {
+	req, out := c.DescribeTrustedAdvisorCheckRefreshStatusesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorCheckResult = "DescribeTrustedAdvisorCheckResult"
 
 // DescribeTrustedAdvisorCheckResultRequest generates a request for the DescribeTrustedAdvisorCheckResult operation.
@@ -398,6 +542,18 @@ func (c *Support) DescribeTrustedAdvisorCheckResult(input *DescribeTrustedAdviso
 	return out, err
 }
 
+// DescribeTrustedAdvisorCheckResultWithContext is the same as DescribeTrustedAdvisorCheckResult with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeTrustedAdvisorCheckResultWithContext(ctx aws.Context, input *DescribeTrustedAdvisorCheckResultInput, opts ...aws.Option) (*DescribeTrustedAdvisorCheckResultOutput, error) {
+	req, out := c.DescribeTrustedAdvisorCheckResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorCheckSummaries = "DescribeTrustedAdvisorCheckSummaries"
 
 // DescribeTrustedAdvisorCheckSummariesRequest generates a request for the DescribeTrustedAdvisorCheckSummaries operation.
@@ -428,6 +584,18 @@ func (c *Support) DescribeTrustedAdvisorCheckSummaries(input *DescribeTrustedAdv
 	return out, err
 }
 
+// DescribeTrustedAdvisorCheckSummariesWithContext is the same as DescribeTrustedAdvisorCheckSummaries with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeTrustedAdvisorCheckSummariesWithContext(ctx aws.Context, input *DescribeTrustedAdvisorCheckSummariesInput, opts ...aws.Option) (*DescribeTrustedAdvisorCheckSummariesOutput, error) {
+	req, out := c.DescribeTrustedAdvisorCheckSummariesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorChecks = "DescribeTrustedAdvisorChecks"
 
 // DescribeTrustedAdvisorChecksRequest generates a request for the DescribeTrustedAdvisorChecks operation.
@@ -458,6 +626,18 @@ func (c *Support) DescribeTrustedAdvisorChecks(input *DescribeTrustedAdvisorChec
 	return out, err
 }
 
+// DescribeTrustedAdvisorChecksWithContext is the same as DescribeTrustedAdvisorChecks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) DescribeTrustedAdvisorChecksWithContext(ctx aws.Context, input *DescribeTrustedAdvisorChecksInput, opts ...aws.Option) (*DescribeTrustedAdvisorChecksOutput, error) {
+	req, out := c.DescribeTrustedAdvisorChecksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRefreshTrustedAdvisorCheck = "RefreshTrustedAdvisorCheck"
 
 // RefreshTrustedAdvisorCheckRequest generates a request for the RefreshTrustedAdvisorCheck operation.
@@ -494,6 +674,18 @@ func (c *Support) RefreshTrustedAdvisorCheck(input *RefreshTrustedAdvisorCheckIn
 	return out, err
 }
 
+// RefreshTrustedAdvisorCheckWithContext is the same as RefreshTrustedAdvisorCheck with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) RefreshTrustedAdvisorCheckWithContext(ctx aws.Context, input *RefreshTrustedAdvisorCheckInput, opts ...aws.Option) (*RefreshTrustedAdvisorCheckOutput, error) {
+	req, out := c.RefreshTrustedAdvisorCheckRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opResolveCase = "ResolveCase"
 
 // ResolveCaseRequest generates a request for the ResolveCase operation.
@@ -522,6 +714,18 @@ func (c *Support) ResolveCase(input *ResolveCaseInput) (*ResolveCaseOutput, erro
 	return out, err
 }
 
+// ResolveCaseWithContext is the same as ResolveCase with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *Support) ResolveCaseWithContext(ctx aws.Context, input *ResolveCaseInput, opts ...aws.Option) (*ResolveCaseOutput, error) {
+	req, out := c.ResolveCaseRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 type AddAttachmentsToSetInput struct {
 	// The ID of the attachment set. If an AttachmentSetId is not specified, a new
 	// attachment set is created, and the ID of the set is returned in the response.
@@ -966,7 +1170,7 @@ type DescribeCasesInput struct {
 
 	// A list of ID numbers of the support cases you want returned. The maximum
 	// number of cases is 100.
-	CaseIDList []*string `locationName:"caseIdList" type:"list"`
+	CaseIDList []*string `locationName:"caseIdList" type:"list" min:"0" max:"100"`
 
 	// The ID displayed for a case in the AWS Support Center user interface.
 	DisplayID *string `locationName:"displayId" type:"string"`
@@ -985,7 +1189,7 @@ type DescribeCasesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -1047,7 +1251,7 @@ type DescribeCommunicationsInput struct {
 	CaseID *string `locationName:"caseId" type:"string" required:"true"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -1101,7 +1305,7 @@ type DescribeServicesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// A JSON-formatted list of service codes available for AWS services.
-	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list"`
+	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list" min:"0" max:"100"`
 
 	metadataDescribeServicesInput `json:"-" xml:"-"`
 }
@@ -4,6 +4,7 @@
 package supportiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/support"
 )
 
@@ -11,29 +12,65 @@ import (
 type SupportAPI interface {
 	AddAttachmentsToSet(*support.AddAttachmentsToSetInput) (*support.AddAttachmentsToSetOutput, error)
 
+	AddAttachmentsToSetWithContext(aws.Context, *support.AddAttachmentsToSetInput, ...aws.Option) (*support.AddAttachmentsToSetOutput, error)
+
 	AddCommunicationToCase(*support.AddCommunicationToCaseInput) (*support.AddCommunicationToCaseOutput, error)
 
+	AddCommunicationToCaseWithContext(aws.Context, *support.AddCommunicationToCaseInput, ...aws.Option) (*support.AddCommunicationToCaseOutput, error)
+
 	CreateCase(*support.CreateCaseInput) (*support.CreateCaseOutput, error)
 
+	CreateCaseWithContext(aws.Context, *support.CreateCaseInput, ...aws.Option) (*support.CreateCaseOutput, error)
+
 	DescribeAttachment(*support.DescribeAttachmentInput) (*support.DescribeAttachmentOutput, error)
 
+	DescribeAttachmentWithContext(aws.Context, *support.DescribeAttachmentInput, ...aws.Option) (*support.DescribeAttachmentOutput, error)
+
 	DescribeCases(*support.DescribeCasesInput) (*support.DescribeCasesOutput, error)
 
+	DescribeCasesWithContext(aws.Context, *support.DescribeCasesInput, ...aws.Option) (*support.DescribeCasesOutput, error)
+
+	DescribeCasesPages(*support.DescribeCasesInput, func(*support.DescribeCasesOutput, bool) bool) error
+
+	DescribeCasesPagesWithContext(aws.Context, *support.DescribeCasesInput, func(*support.DescribeCasesOutput, bool) bool, ...aws.Option) error
+
 	DescribeCommunications(*support.DescribeCommunicationsInput) (*support.DescribeCommunicationsOutput, error)
 
+	DescribeCommunicationsWithContext(aws.Context, *support.DescribeCommunicationsInput, ...aws.Option) (*support.DescribeCommunicationsOutput, error)
+
+	DescribeCommunicationsPages(*support.DescribeCommunicationsInput, func(*support.DescribeCommunicationsOutput, bool) bool) error
+
+	DescribeCommunicationsPagesWithContext(aws.Context, *support.DescribeCommunicationsInput, func(*support.DescribeCommunicationsOutput, bool) bool, ...aws.Option) error
+
 	DescribeServices(*support.DescribeServicesInput) (*support.DescribeServicesOutput, error)
 
+	DescribeServicesWithContext(aws.Context, *support.DescribeServicesInput, ...aws.Option) (*support.DescribeServicesOutput, error)
+
 	DescribeSeverityLevels(*support.DescribeSeverityLevelsInput) (*support.DescribeSeverityLevelsOutput, error)
 
+	DescribeSeverityLevelsWithContext(aws.Context, *support.DescribeSeverityLevelsInput, ...aws.Option) (*support.DescribeSeverityLevelsOutput, error)
+
 	DescribeTrustedAdvisorCheckRefreshStatuses(*support.DescribeTrustedAdvisorCheckRefreshStatusesInput) (*support.DescribeTrustedAdvisorCheckRefreshStatusesOutput, error)
 
+	DescribeTrustedAdvisorCheckRefreshStatusesWithContext(aws.Context, *support.DescribeTrustedAdvisorCheckRefreshStatusesInput, ...aws.Option) (*support.DescribeTrustedAdvisorCheckRefreshStatusesOutput, error)
+
 	DescribeTrustedAdvisorCheckResult(*support.DescribeTrustedAdvisorCheckResultInput) (*support.DescribeTrustedAdvisorCheckResultOutput, error)
 
+	DescribeTrustedAdvisorCheckResultWithContext(aws.Context, *support.DescribeTrustedAdvisorCheckResultInput, ...aws.Option) (*support.DescribeTrustedAdvisorCheckResultOutput, error)
+
 	DescribeTrustedAdvisorCheckSummaries(*support.DescribeTrustedAdvisorCheckSummariesInput) (*support.DescribeTrustedAdvisorCheckSummariesOutput, error)
 
+	DescribeTrustedAdvisorCheckSummariesWithContext(aws.Context, *support.DescribeTrustedAdvisorCheckSummariesInput, ...aws.Option) (*support.DescribeTrustedAdvisorCheckSummariesOutput, error)
+
 	DescribeTrustedAdvisorChecks(*support.DescribeTrustedAdvisorChecksInput) (*support.DescribeTrustedAdvisorChecksOutput, error)
 
+	DescribeTrustedAdvisorChecksWithContext(aws.Context, *support.DescribeTrustedAdvisorChecksInput, ...aws.Option) (*support.DescribeTrustedAdvisorChecksOutput, error)
+
 	RefreshTrustedAdvisorCheck(*support.RefreshTrustedAdvisorCheckInput) (*support.RefreshTrustedAdvisorCheckOutput, error)
 
+	RefreshTrustedAdvisorCheckWithContext(aws.Context, *support.RefreshTrustedAdvisorCheckInput, ...aws.Option) (*support.RefreshTrustedAdvisorCheckOutput, error)
+
 	ResolveCase(*support.ResolveCaseInput) (*support.ResolveCaseOutput, error)
+
+	ResolveCaseWithContext(aws.Context, *support.ResolveCaseInput, ...aws.Option) (*support.ResolveCaseOutput, error)
 }
@@ -56,6 +56,18 @@ func (c *SWF) CountClosedWorkflowExecutions(input *CountClosedWorkflowExecutions
 	return out, err
 }
 
+// CountClosedWorkflowExecutionsWithContext is the same as CountClosedWorkflowExecutions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) CountClosedWorkflowExecutionsWithContext(ctx aws.Context, input *CountClosedWorkflowExecutionsInput, opts ...aws.Option) (*WorkflowExecutionCount, error) {
+	req, out := c.CountClosedWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCountOpenWorkflowExecutions = "CountOpenWorkflowExecutions"
 
 // CountOpenWorkflowExecutionsRequest generates a request for the CountOpenWorkflowExecutions operation.
@@ -102,6 +114,18 @@ func (c *SWF) CountOpenWorkflowExecutions(input *CountOpenWorkflowExecutionsInpu
 	return out, err
 }
 
+// CountOpenWorkflowExecutionsWithContext is the same as CountOpenWorkflowExecutions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) CountOpenWorkflowExecutionsWithContext(ctx aws.Context, input *CountOpenWorkflowExecutionsInput, opts ...aws.Option) (*WorkflowExecutionCount, error) {
+	req, out := c.CountOpenWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCountPendingActivityTasks = "CountPendingActivityTasks"
 
 // CountPendingActivityTasksRequest generates a request for the CountPendingActivityTasks operation.
@@ -147,6 +171,18 @@ func (c *SWF) CountPendingActivityTasks(input *CountPendingActivityTasksInput) (
 	return out, err
 }
 
+// CountPendingActivityTasksWithContext is the same as CountPendingActivityTasks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) CountPendingActivityTasksWithContext(ctx aws.Context, input *CountPendingActivityTasksInput, opts ...aws.Option) (*PendingTaskCount, error) {
+	req, out := c.CountPendingActivityTasksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opCountPendingDecisionTasks = "CountPendingDecisionTasks"
 
 // CountPendingDecisionTasksRequest generates a request for the CountPendingDecisionTasks operation.
@@ -192,6 +228,18 @@ func (c *SWF) CountPendingDecisionTasks(input *CountPendingDecisionTasksInput) (
 	return out, err
 }
 
+// CountPendingDecisionTasksWithContext is the same as CountPendingDecisionTasks with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) CountPendingDecisionTasksWithContext(ctx aws.Context, input *CountPendingDecisionTasksInput, opts ...aws.Option) (*PendingTaskCount, error) {
+	req, out := c.CountPendingDecisionTasksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeprecateActivityType = "DeprecateActivityType"
 
 // DeprecateActivityTypeRequest generates a request for the DeprecateActivityType operation.
@@ -238,6 +286,18 @@ func (c *SWF) DeprecateActivityType(input *DeprecateActivityTypeInput) (*Depreca
 	return out, err
 }
 
+// DeprecateActivityTypeWithContext is the same as DeprecateActivityType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) DeprecateActivityTypeWithContext(ctx aws.Context, input *DeprecateActivityTypeInput, opts ...aws.Option) (*DeprecateActivityTypeOutput, error) {
+	req, out := c.DeprecateActivityTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeprecateDomain = "DeprecateDomain"
 
 // DeprecateDomainRequest generates a request for the DeprecateDomain operation.
@@ -285,6 +345,18 @@ func (c *SWF) DeprecateDomain(input *DeprecateDomainInput) (*DeprecateDomainOutp
 	return out, err
 }
 
+// DeprecateDomainWithContext is the same as DeprecateDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) DeprecateDomainWithContext(ctx aws.Context, input *DeprecateDomainInput, opts ...aws.Option) (*DeprecateDomainOutput, error) {
+	req, out := c.DeprecateDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDeprecateWorkflowType = "DeprecateWorkflowType"
 
 // DeprecateWorkflowTypeRequest generates a request for the DeprecateWorkflowType operation.
@@ -332,6 +404,18 @@ func (c *SWF) DeprecateWorkflowType(input *DeprecateWorkflowTypeInput) (*Depreca
 	return out, err
 }
 
+// DeprecateWorkflowTypeWithContext is the same as DeprecateWorkflowType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) DeprecateWorkflowTypeWithContext(ctx aws.Context, input *DeprecateWorkflowTypeInput, opts ...aws.Option) (*DeprecateWorkflowTypeOutput, error) {
+	req, out := c.DeprecateWorkflowTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeActivityType = "DescribeActivityType"
 
 // DescribeActivityTypeRequest generates a request for the DescribeActivityType operation.
@@ -377,6 +461,18 @@ func (c *SWF) DescribeActivityType(input *DescribeActivityTypeInput) (*DescribeA
 	return out, err
 }
 
+// DescribeActivityTypeWithContext is the same as DescribeActivityType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) DescribeActivityTypeWithContext(ctx aws.Context, input *DescribeActivityTypeInput, opts ...aws.Option) (*DescribeActivityTypeOutput, error) {
+	req, out := c.DescribeActivityTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDomain = "DescribeDomain"
 
 // DescribeDomainRequest generates a request for the DescribeDomain operation.
@@ -419,6 +515,18 @@ func (c *SWF) DescribeDomain(input *DescribeDomainInput) (*DescribeDomainOutput,
 	return out, err
 }
 
+// DescribeDomainWithContext is the same as DescribeDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) DescribeDomainWithContext(ctx aws.Context, input *DescribeDomainInput, opts ...aws.Option) (*DescribeDomainOutput, error) {
+	req, out := c.DescribeDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeWorkflowExecution = "DescribeWorkflowExecution"
 
 // DescribeWorkflowExecutionRequest generates a request for the DescribeWorkflowExecution operation.
@@ -462,6 +570,18 @@ func (c *SWF) DescribeWorkflowExecution(input *DescribeWorkflowExecutionInput) (
 	return out, err
 }
 
+// DescribeWorkflowExecutionWithContext is the same as DescribeWorkflowExecution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) DescribeWorkflowExecutionWithContext(ctx aws.Context, input *DescribeWorkflowExecutionInput, opts ...aws.Option) (*DescribeWorkflowExecutionOutput, error) {
+	req, out := c.DescribeWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeWorkflowType = "DescribeWorkflowType"
 
 // DescribeWorkflowTypeRequest generates a request for the DescribeWorkflowType operation.
@@ -507,6 +627,18 @@ func (c *SWF) DescribeWorkflowType(input *DescribeWorkflowTypeInput) (*DescribeW
 	return out, err
 }
 
+// DescribeWorkflowTypeWithContext is the same as DescribeWorkflowType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) DescribeWorkflowTypeWithContext(ctx aws.Context, input *DescribeWorkflowTypeInput, opts ...aws.Option) (*DescribeWorkflowTypeOutput, error) {
+	req, out := c.DescribeWorkflowTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opGetWorkflowExecutionHistory = "GetWorkflowExecutionHistory"
 
 // GetWorkflowExecutionHistoryRequest generates a request for the GetWorkflowExecutionHistory operation.
@@ -520,6 +652,7 @@ func (c *SWF) GetWorkflowExecutionHistoryRequest(input *GetWorkflowExecutionHist
 			OutputTokens:    []string{"nextPageToken"},
 			LimitToken:      "maximumPageSize",
 			TruncationToken: "",
+			ResultKeys:      []string{"events"},
 		},
 	}
 
@@ -557,6 +690,22 @@ func (c *SWF) GetWorkflowExecutionHistory(input *GetWorkflowExecutionHistoryInpu
 	return out, err
 }
 
+// GetWorkflowExecutionHistoryWithContext is the same as GetWorkflowExecutionHistory with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) GetWorkflowExecutionHistoryWithContext(ctx aws.Context, input *GetWorkflowExecutionHistoryInput, opts ...aws.Option) (*GetWorkflowExecutionHistoryOutput, error) {
+	req, out := c.GetWorkflowExecutionHistoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// GetWorkflowExecutionHistoryPages iterates over the pages of a GetWorkflowExecutionHistory operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *SWF) GetWorkflowExecutionHistoryPages(input *GetWorkflowExecutionHistoryInput, fn func(p *GetWorkflowExecutionHistoryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetWorkflowExecutionHistoryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -564,6 +713,19 @@ func (c *SWF) GetWorkflowExecutionHistoryPages(input *GetWorkflowExecutionHistor
 	})
 }
 
+// GetWorkflowExecutionHistoryPagesWithContext is the same as GetWorkflowExecutionHistoryPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *SWF) GetWorkflowExecutionHistoryPagesWithContext(ctx aws.Context, input *GetWorkflowExecutionHistoryInput, fn func(p *GetWorkflowExecutionHistoryOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.GetWorkflowExecutionHistoryRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetWorkflowExecutionHistoryOutput), lastPage)
+	})
+}
+
 const opListActivityTypes = "ListActivityTypes"
 
 // ListActivityTypesRequest generates a request for the ListActivityTypes operation.
@@ -577,6 +739,7 @@ func (c *SWF) ListActivityTypesRequest(input *ListActivityTypesInput) (req *aws.
 			OutputTokens:    []string{"nextPageToken"},
 			LimitToken:      "maximumPageSize",
 			TruncationToken: "",
+			ResultKeys:      []string{"typeInfos"},
 		},
 	}
 
@@ -615,6 +778,22 @@ func (c *SWF) ListActivityTypes(input *ListActivityTypesInput) (*ListActivityTyp
 	return out, err
 }
 
+// ListActivityTypesWithContext is the same as ListActivityTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) ListActivityTypesWithContext(ctx aws.Context, input *ListActivityTypesInput, opts ...aws.Option) (*ListActivityTypesOutput, error) {
+	req, out := c.ListActivityTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListActivityTypesPages iterates over the pages of a ListActivityTypes operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *SWF) ListActivityTypesPages(input *ListActivityTypesInput, fn func(p *ListActivityTypesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListActivityTypesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -622,6 +801,19 @@ func (c *SWF) ListActivityTypesPages(input *ListActivityTypesInput, fn func(p *L
 	})
 }
 
+// ListActivityTypesPagesWithContext is the same as ListActivityTypesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *SWF) ListActivityTypesPagesWithContext(ctx aws.Context, input *ListActivityTypesInput, fn func(p *ListActivityTypesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListActivityTypesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListActivityTypesOutput), lastPage)
+	})
+}
+
 const opListClosedWorkflowExecutions = "ListClosedWorkflowExecutions"
 
 // ListClosedWorkflowExecutionsRequest generates a request for the ListClosedWorkflowExecutions operation.
@@ -635,6 +827,7 @@ func (c *SWF) ListClosedWorkflowExecutionsRequest(input *ListClosedWorkflowExecu
 			OutputTokens:    []string{"nextPageToken"},
 			LimitToken:      "maximumPageSize",
 			TruncationToken: "",
+			ResultKeys:      []string{"executionInfos"},
 		},
 	}
 
@@ -676,6 +869,22 @@ func (c *SWF) ListClosedWorkflowExecutions(input *ListClosedWorkflowExecutionsIn
 	return out, err
 }
 
+// ListClosedWorkflowExecutionsWithContext is the same as ListClosedWorkflowExecutions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) ListClosedWorkflowExecutionsWithContext(ctx aws.Context, input *ListClosedWorkflowExecutionsInput, opts ...aws.Option) (*WorkflowExecutionInfos, error) {
+	req, out := c.ListClosedWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListClosedWorkflowExecutionsPages iterates over the pages of a ListClosedWorkflowExecutions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *SWF) ListClosedWorkflowExecutionsPages(input *ListClosedWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListClosedWorkflowExecutionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -683,6 +892,19 @@ func (c *SWF) ListClosedWorkflowExecutionsPages(input *ListClosedWorkflowExecuti
 	})
 }
 
+// ListClosedWorkflowExecutionsPagesWithContext is the same as ListClosedWorkflowExecutionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *SWF) ListClosedWorkflowExecutionsPagesWithContext(ctx aws.Context, input *ListClosedWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListClosedWorkflowExecutionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*WorkflowExecutionInfos), lastPage)
+	})
+}
+
 const opListDomains = "ListDomains"
 
 // ListDomainsRequest generates a request for the ListDomains operation.
@@ -696,6 +918,7 @@ func (c *SWF) ListDomainsRequest(input *ListDomainsInput) (req *aws.Request, out
 			OutputTokens:    []string{"nextPageToken"},
 			LimitToken:      "maximumPageSize",
 			TruncationToken: "",
+			ResultKeys:      []string{"domainInfos"},
 		},
 	}
 
@@ -734,6 +957,22 @@ func (c *SWF) ListDomains(input *ListDomainsInput) (*ListDomainsOutput, error) {
 	return out, err
 }
 
+// ListDomainsWithContext is the same as ListDomains with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) ListDomainsWithContext(ctx aws.Context, input *ListDomainsInput, opts ...aws.Option) (*ListDomainsOutput, error) {
+	req, out := c.ListDomainsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListDomainsPages iterates over the pages of a ListDomains operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *SWF) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDomainsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -741,6 +980,19 @@ func (c *SWF) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOu
 	})
 }
 
+// ListDomainsPagesWithContext is the same as ListDomainsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *SWF) ListDomainsPagesWithContext(ctx aws.Context, input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListDomainsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDomainsOutput), lastPage)
+	})
+}
+
 const opListOpenWorkflowExecutions = "ListOpenWorkflowExecutions"
 
 // ListOpenWorkflowExecutionsRequest generates a request for the ListOpenWorkflowExecutions operation.
@@ -754,6 +1006,7 @@ func (c *SWF) ListOpenWorkflowExecutionsRequest(input *ListOpenWorkflowExecution
 			OutputTokens:    []string{"nextPageToken"},
 			LimitToken:      "maximumPageSize",
 			TruncationToken: "",
+			ResultKeys:      []string{"executionInfos"},
 		},
 	}
 
@@ -795,6 +1048,22 @@ func (c *SWF) ListOpenWorkflowExecutions(input *ListOpenWorkflowExecutionsInput)
 	return out, err
 }
 
+// ListOpenWorkflowExecutionsWithContext is the same as ListOpenWorkflowExecutions with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) ListOpenWorkflowExecutionsWithContext(ctx aws.Context, input *ListOpenWorkflowExecutionsInput, opts ...aws.Option) (*WorkflowExecutionInfos, error) {
+	req, out := c.ListOpenWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListOpenWorkflowExecutionsPages iterates over the pages of a ListOpenWorkflowExecutions operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *SWF) ListOpenWorkflowExecutionsPages(input *ListOpenWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListOpenWorkflowExecutionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -802,6 +1071,19 @@ func (c *SWF) ListOpenWorkflowExecutionsPages(input *ListOpenWorkflowExecutionsI
 	})
 }
 
+// ListOpenWorkflowExecutionsPagesWithContext is the same as ListOpenWorkflowExecutionsPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *SWF) ListOpenWorkflowExecutionsPagesWithContext(ctx aws.Context, input *ListOpenWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListOpenWorkflowExecutionsRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*WorkflowExecutionInfos), lastPage)
+	})
+}
+
 const opListWorkflowTypes = "ListWorkflowTypes"
 
 // ListWorkflowTypesRequest generates a request for the ListWorkflowTypes operation.
@@ -815,6 +1097,7 @@ func (c *SWF) ListWorkflowTypesRequest(input *ListWorkflowTypesInput) (req *aws.
 			OutputTokens:    []string{"nextPageToken"},
 			LimitToken:      "maximumPageSize",
 			TruncationToken: "",
+			ResultKeys:      []string{"typeInfos"},
 		},
 	}
 
@@ -851,6 +1134,22 @@ func (c *SWF) ListWorkflowTypes(input *ListWorkflowTypesInput) (*ListWorkflowTyp
 	return out, err
 }
 
+// ListWorkflowTypesWithContext is the same as ListWorkflowTypes with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) ListWorkflowTypesWithContext(ctx aws.Context, input *ListWorkflowTypesInput, opts ...aws.Option) (*ListWorkflowTypesOutput, error) {
+	req, out := c.ListWorkflowTypesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// ListWorkflowTypesPages iterates over the pages of a ListWorkflowTypes operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *SWF) ListWorkflowTypesPages(input *ListWorkflowTypesInput, fn func(p *ListWorkflowTypesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListWorkflowTypesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -858,6 +1157,19 @@ func (c *SWF) ListWorkflowTypesPages(input *ListWorkflowTypesInput, fn func(p *L
 	})
 }
 
+// ListWorkflowTypesPagesWithContext is the same as ListWorkflowTypesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *SWF) ListWorkflowTypesPagesWithContext(ctx aws.Context, input *ListWorkflowTypesInput, fn func(p *ListWorkflowTypesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.ListWorkflowTypesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListWorkflowTypesOutput), lastPage)
+	})
+}
+
 const opPollForActivityTask = "PollForActivityTask"
 
 // PollForActivityTaskRequest generates a request for the PollForActivityTask operation.
@@ -909,6 +1221,18 @@ func (c *SWF) PollForActivityTask(input *PollForActivityTaskInput) (*PollForActi
 	return out, err
 }
 
+// PollForActivityTaskWithContext is the same as PollForActivityTask with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) PollForActivityTaskWithContext(ctx aws.Context, input *PollForActivityTaskInput, opts ...aws.Option) (*PollForActivityTaskOutput, error) {
+	req, out := c.PollForActivityTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opPollForDecisionTask = "PollForDecisionTask"
 
 // PollForDecisionTaskRequest generates a request for the PollForDecisionTask operation.
@@ -922,6 +1246,7 @@ func (c *SWF) PollForDecisionTaskRequest(input *PollForDecisionTaskInput) (req *
 			OutputTokens:    []string{"nextPageToken"},
 			LimitToken:      "maximumPageSize",
 			TruncationToken: "",
+			ResultKeys:      []string{"events"},
 		},
 	}
 
@@ -974,6 +1299,22 @@ func (c *SWF) PollForDecisionTask(input *PollForDecisionTaskInput) (*PollForDeci
 	return out, err
 }
 
+// PollForDecisionTaskWithContext is the same as PollForDecisionTask with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) PollForDecisionTaskWithContext(ctx aws.Context, input *PollForDecisionTaskInput, opts ...aws.Option) (*PollForDecisionTaskOutput, error) {
+	req, out := c.PollForDecisionTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// PollForDecisionTaskPages iterates over the pages of a PollForDecisionTask operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *SWF) PollForDecisionTaskPages(input *PollForDecisionTaskInput, fn func(p *PollForDecisionTaskOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.PollForDecisionTaskRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -981,6 +1322,19 @@ func (c *SWF) PollForDecisionTaskPages(input *PollForDecisionTaskInput, fn func(
 	})
 }
 
+// PollForDecisionTaskPagesWithContext is the same as PollForDecisionTaskPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *SWF) PollForDecisionTaskPagesWithContext(ctx aws.Context, input *PollForDecisionTaskInput, fn func(p *PollForDecisionTaskOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.PollForDecisionTaskRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*PollForDecisionTaskOutput), lastPage)
+	})
+}
+
 const opRecordActivityTaskHeartbeat = "RecordActivityTaskHeartbeat"
 
 // RecordActivityTaskHeartbeatRequest generates a request for the RecordActivityTaskHeartbeat operation.
@@ -1043,6 +1397,18 @@ func (c *SWF) RecordActivityTaskHeartbeat(input *RecordActivityTaskHeartbeatInpu
 	return out, err
 }
 
+// RecordActivityTaskHeartbeatWithContext is the same as RecordActivityTaskHeartbeat with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RecordActivityTaskHeartbeatWithContext(ctx aws.Context, input *RecordActivityTaskHeartbeatInput, opts ...aws.Option) (*RecordActivityTaskHeartbeatOutput, error) {
+	req, out := c.RecordActivityTaskHeartbeatRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterActivityType = "RegisterActivityType"
 
 // RegisterActivityTypeRequest generates a request for the RegisterActivityType operation.
@@ -1090,6 +1456,18 @@ func (c *SWF) RegisterActivityType(input *RegisterActivityTypeInput) (*RegisterA
 	return out, err
 }
 
+// RegisterActivityTypeWithContext is the same as RegisterActivityType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RegisterActivityTypeWithContext(ctx aws.Context, input *RegisterActivityTypeInput, opts ...aws.Option) (*RegisterActivityTypeOutput, error) {
+	req, out := c.RegisterActivityTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterDomain = "RegisterDomain"
 
 // RegisterDomainRequest generates a request for the RegisterDomain operation.
@@ -1132,6 +1510,18 @@ func (c *SWF) RegisterDomain(input *RegisterDomainInput) (*RegisterDomainOutput,
 	return out, err
 }
 
+// RegisterDomainWithContext is the same as RegisterDomain with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RegisterDomainWithContext(ctx aws.Context, input *RegisterDomainInput, opts ...aws.Option) (*RegisterDomainOutput, error) {
+	req, out := c.RegisterDomainRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterWorkflowType = "RegisterWorkflowType"
 
 // RegisterWorkflowTypeRequest generates a request for the RegisterWorkflowType operation.
@@ -1182,6 +1572,18 @@ func (c *SWF) RegisterWorkflowType(input *RegisterWorkflowTypeInput) (*RegisterW
 	return out, err
 }
 
+// RegisterWorkflowTypeWithContext is the same as RegisterWorkflowType with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RegisterWorkflowTypeWithContext(ctx aws.Context, input *RegisterWorkflowTypeInput, opts ...aws.Option) (*RegisterWorkflowTypeOutput, error) {
+	req, out := c.RegisterWorkflowTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRequestCancelWorkflowExecution = "RequestCancelWorkflowExecution"
 
 // RequestCancelWorkflowExecutionRequest generates a request for the RequestCancelWorkflowExecution operation.
@@ -1231,6 +1633,18 @@ func (c *SWF) RequestCancelWorkflowExecution(input *RequestCancelWorkflowExecuti
 	return out, err
 }
 
+// RequestCancelWorkflowExecutionWithContext is the same as RequestCancelWorkflowExecution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RequestCancelWorkflowExecutionWithContext(ctx aws.Context, input *RequestCancelWorkflowExecutionInput, opts ...aws.Option) (*RequestCancelWorkflowExecutionOutput, error) {
+	req, out := c.RequestCancelWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondActivityTaskCanceled = "RespondActivityTaskCanceled"
 
 // RespondActivityTaskCanceledRequest generates a request for the RespondActivityTaskCanceled operation.
@@ -1285,6 +1699,18 @@ func (c *SWF) RespondActivityTaskCanceled(input *RespondActivityTaskCanceledInpu
 	return out, err
 }
 
+// RespondActivityTaskCanceledWithContext is the same as RespondActivityTaskCanceled with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RespondActivityTaskCanceledWithContext(ctx aws.Context, input *RespondActivityTaskCanceledInput, opts ...aws.Option) (*RespondActivityTaskCanceledOutput, error) {
+	req, out := c.RespondActivityTaskCanceledRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondActivityTaskCompleted = "RespondActivityTaskCompleted"
 
 // RespondActivityTaskCompletedRequest generates a request for the RespondActivityTaskCompleted operation.
@@ -1337,6 +1763,18 @@ func (c *SWF) RespondActivityTaskCompleted(input *RespondActivityTaskCompletedIn
 	return out, err
 }
 
+// RespondActivityTaskCompletedWithContext is the same as RespondActivityTaskCompleted with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RespondActivityTaskCompletedWithContext(ctx aws.Context, input *RespondActivityTaskCompletedInput, opts ...aws.Option) (*RespondActivityTaskCompletedOutput, error) {
+	req, out := c.RespondActivityTaskCompletedRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondActivityTaskFailed = "RespondActivityTaskFailed"
 
 // RespondActivityTaskFailedRequest generates a request for the RespondActivityTaskFailed operation.
@@ -1386,6 +1824,18 @@ func (c *SWF) RespondActivityTaskFailed(input *RespondActivityTaskFailedInput) (
 	return out, err
 }
 
+// RespondActivityTaskFailedWithContext is the same as RespondActivityTaskFailed with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RespondActivityTaskFailedWithContext(ctx aws.Context, input *RespondActivityTaskFailedInput, opts ...aws.Option) (*RespondActivityTaskFailedOutput, error) {
+	req, out := c.RespondActivityTaskFailedRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondDecisionTaskCompleted = "RespondDecisionTaskCompleted"
 
 // RespondDecisionTaskCompletedRequest generates a request for the RespondDecisionTaskCompleted operation.
@@ -1428,6 +1878,18 @@ func (c *SWF) RespondDecisionTaskCompleted(input *RespondDecisionTaskCompletedIn
 	return out, err
 }
 
+// RespondDecisionTaskCompletedWithContext is the same as RespondDecisionTaskCompleted with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) RespondDecisionTaskCompletedWithContext(ctx aws.Context, input *RespondDecisionTaskCompletedInput, opts ...aws.Option) (*RespondDecisionTaskCompletedOutput, error) {
+	req, out := c.RespondDecisionTaskCompletedRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opSignalWorkflowExecution = "SignalWorkflowExecution"
 
 // SignalWorkflowExecutionRequest generates a request for the SignalWorkflowExecution operation.
@@ -1475,6 +1937,18 @@ func (c *SWF) SignalWorkflowExecution(input *SignalWorkflowExecutionInput) (*Sig
 	return out, err
 }
 
+// SignalWorkflowExecutionWithContext is the same as SignalWorkflowExecution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) SignalWorkflowExecutionWithContext(ctx aws.Context, input *SignalWorkflowExecutionInput, opts ...aws.Option) (*SignalWorkflowExecutionOutput, error) {
+	req, out := c.SignalWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opStartWorkflowExecution = "StartWorkflowExecution"
 
 // StartWorkflowExecutionRequest generates a request for the StartWorkflowExecution operation.
@@ -1525,6 +1999,18 @@ func (c *SWF) StartWorkflowExecution(input *StartWorkflowExecutionInput) (*Start
 	return out, err
 }
 
+// StartWorkflowExecutionWithContext is the same as StartWorkflowExecution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) StartWorkflowExecutionWithContext(ctx aws.Context, input *StartWorkflowExecutionInput, opts ...aws.Option) (*StartWorkflowExecutionOutput, error) {
+	req, out := c.StartWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateWorkflowExecution = "TerminateWorkflowExecution"
 
 // TerminateWorkflowExecutionRequest generates a request for the TerminateWorkflowExecution operation.
@@ -1575,10 +2061,22 @@ func (c *SWF) TerminateWorkflowExecution(input *TerminateWorkflowExecutionInput)
 	return out, err
 }
 
+// TerminateWorkflowExecutionWithContext is the same as TerminateWorkflowExecution with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *SWF) TerminateWorkflowExecutionWithContext(ctx aws.Context, input *TerminateWorkflowExecutionInput, opts ...aws.Option) (*TerminateWorkflowExecutionOutput, error) {
+	req, out := c.TerminateWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Provides details of the ActivityTaskCancelRequested event.
 type ActivityTaskCancelRequestedEventAttributes struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelActivityTask decision for this cancellation
@@ -1606,7 +2104,7 @@ func (s ActivityTaskCancelRequestedEventAttributes) GoString() string {
 // Provides details of the ActivityTaskCanceled event.
 type ActivityTaskCanceledEventAttributes struct {
 	// Details of the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// If set, contains the Id of the last ActivityTaskCancelRequested event recorded
 	// for this activity task. This information can be useful for diagnosing problems
@@ -1643,7 +2141,7 @@ func (s ActivityTaskCanceledEventAttributes) GoString() string {
 // Provides details of the ActivityTaskCompleted event.
 type ActivityTaskCompletedEventAttributes struct {
 	// The results of the activity task (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1675,10 +2173,10 @@ func (s ActivityTaskCompletedEventAttributes) GoString() string {
 // Provides details of the ActivityTaskFailed event.
 type ActivityTaskFailedEventAttributes struct {
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1710,14 +2208,14 @@ func (s ActivityTaskFailedEventAttributes) GoString() string {
 // Provides details of the ActivityTaskScheduled event.
 type ActivityTaskScheduledEventAttributes struct {
 	// The unique id of the activity task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision that
 	// resulted in the scheduling of this activity task. This information can be
@@ -1729,20 +2227,20 @@ type ActivityTaskScheduledEventAttributes struct {
 	// progress by calling RecordActivityTaskHeartbeat. If the timeout is exceeded,
 	// the activity task is automatically timed out. If the worker subsequently
 	// attempts to record a heartbeat or return a result, it will be ignored.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum amount of time for this activity task.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time the activity task can wait to be assigned to a
 	// worker.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time a worker may take to process the activity task.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The task list in which the activity task has been scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -1758,7 +2256,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataActivityTaskScheduledEventAttributes `json:"-" xml:"-"`
 }
@@ -1781,7 +2279,7 @@ func (s ActivityTaskScheduledEventAttributes) GoString() string {
 type ActivityTaskStartedEventAttributes struct {
 	// Identity of the worker that was assigned this task. This aids diagnostics
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1809,7 +2307,7 @@ func (s ActivityTaskStartedEventAttributes) GoString() string {
 type ActivityTaskTimedOutEventAttributes struct {
 	// Contains the content of the details parameter for the last call made by the
 	// activity to RecordActivityTaskHeartbeat.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1847,13 +2345,13 @@ type ActivityType struct {
 	//
 	// The combination of activity type name and version must be unique within
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of this activity.
 	//
 	// The combination of activity type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataActivityType `json:"-" xml:"-"`
 }
@@ -1886,7 +2384,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list specified for this activity type at registration.
 	// This default is used if a task list is not provided when a task is scheduled
@@ -1904,7 +2402,7 @@ type ActivityTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, for tasks of this activity type. You can override this default when
@@ -1912,7 +2410,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, that a task of an activity type can wait before being assigned to a
@@ -1921,7 +2419,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration for tasks of an activity type specified
 	// when registering the activity type. You can override this default when scheduling
@@ -1929,7 +2427,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataActivityTypeConfiguration `json:"-" xml:"-"`
 }
@@ -1960,7 +2458,7 @@ type ActivityTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the activity type provided in RegisterActivityType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the activity type.
 	Status *string `locationName:"status" type:"string" required:"true"`
@@ -1999,7 +2497,7 @@ func (s ActivityTypeInfo) GoString() string {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelTimerDecisionAttributes struct {
 	// Required. The unique Id of the timer to cancel.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerDecisionAttributes `json:"-" xml:"-"`
 }
@@ -2035,7 +2533,7 @@ type CancelTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the CancelTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -2071,7 +2569,7 @@ func (s CancelTimerFailedEventAttributes) GoString() string {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelWorkflowExecutionDecisionAttributes struct {
 	// Optional. details of the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataCancelWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -2126,7 +2624,7 @@ func (s CancelWorkflowExecutionFailedEventAttributes) GoString() string {
 // Provide details of the ChildWorkflowExecutionCanceled event.
 type ChildWorkflowExecutionCanceledEventAttributes struct {
 	// Details of the cancellation (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -2171,7 +2669,7 @@ type ChildWorkflowExecutionCompletedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The result of the child workflow execution (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -2204,7 +2702,7 @@ func (s ChildWorkflowExecutionCompletedEventAttributes) GoString() string {
 // Provides details of the ChildWorkflowExecutionFailed event.
 type ChildWorkflowExecutionFailedEventAttributes struct {
 	// The details of the failure (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -2213,7 +2711,7 @@ type ChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The reason for the failure (if provided).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -2392,7 +2890,7 @@ func (s CloseStatusFilter) GoString() string {
 type CompleteWorkflowExecutionDecisionAttributes struct {
 	// The result of the workflow execution. The form of the result is implementation
 	// defined.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataCompleteWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -2492,16 +2990,16 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this field.
 	// If neither this field is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the new workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure"`
@@ -2515,7 +3013,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for the new workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -2528,9 +3026,9 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
-	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string"`
+	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string" min:"1" max:"64"`
 
 	metadataContinueAsNewWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -2598,7 +3096,7 @@ type CountClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2646,7 +3144,7 @@ func (s CountClosedWorkflowExecutionsInput) GoString() string {
 
 type CountOpenWorkflowExecutionsInput struct {
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2691,7 +3189,7 @@ func (s CountOpenWorkflowExecutionsInput) GoString() string {
 
 type CountPendingActivityTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2715,7 +3213,7 @@ func (s CountPendingActivityTasksInput) GoString() string {
 
 type CountPendingDecisionTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2912,7 +3410,7 @@ func (s Decision) GoString() string {
 // Provides details of the DecisionTaskCompleted event.
 type DecisionTaskCompletedEventAttributes struct {
 	// User defined context for the workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -2948,7 +3446,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The name of the task list in which the decision task was scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2961,7 +3459,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataDecisionTaskScheduledEventAttributes `json:"-" xml:"-"`
 }
@@ -2984,7 +3482,7 @@ func (s DecisionTaskScheduledEventAttributes) GoString() string {
 type DecisionTaskStartedEventAttributes struct {
 	// Identity of the decider making the request. This enables diagnostic tracing
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -3045,7 +3543,7 @@ type DeprecateActivityTypeInput struct {
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateActivityTypeInput `json:"-" xml:"-"`
 }
@@ -3084,7 +3582,7 @@ func (s DeprecateActivityTypeOutput) GoString() string {
 
 type DeprecateDomainInput struct {
 	// The name of the domain to deprecate.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateDomainInput `json:"-" xml:"-"`
 }
@@ -3123,7 +3621,7 @@ func (s DeprecateDomainOutput) GoString() string {
 
 type DeprecateWorkflowTypeInput struct {
 	// The name of the domain in which the workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to deprecate.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -3169,7 +3667,7 @@ type DescribeActivityTypeInput struct {
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeActivityTypeInput `json:"-" xml:"-"`
 }
@@ -3223,7 +3721,7 @@ func (s DescribeActivityTypeOutput) GoString() string {
 
 type DescribeDomainInput struct {
 	// The name of the domain to describe.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeDomainInput `json:"-" xml:"-"`
 }
@@ -3269,7 +3767,7 @@ func (s DescribeDomainOutput) GoString() string {
 
 type DescribeWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow execution to describe.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -3308,7 +3806,7 @@ type DescribeWorkflowExecutionOutput struct {
 	// The latest executionContext provided by the decider for this workflow execution.
 	// A decider can provide an executionContext (a free-form string) when closing
 	// a decision task using RespondDecisionTaskCompleted.
-	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string"`
+	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string" max:"32768"`
 
 	// The number of tasks for this workflow execution. This includes open and closed
 	// tasks of all types.
@@ -3333,7 +3831,7 @@ func (s DescribeWorkflowExecutionOutput) GoString() string {
 
 type DescribeWorkflowTypeInput struct {
 	// The name of the domain in which this workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to describe.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -3391,7 +3889,7 @@ func (s DescribeWorkflowTypeOutput) GoString() string {
 // Contains the configuration settings of a domain.
 type DomainConfiguration struct {
 	// The retention period for workflow executions in this domain.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataDomainConfiguration `json:"-" xml:"-"`
 }
@@ -3413,10 +3911,10 @@ func (s DomainConfiguration) GoString() string {
 // Contains general information about a domain.
 type DomainInfo struct {
 	// The description of the domain provided through RegisterDomain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain. This name is unique within the account.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The status of the domain:
 	//
@@ -3545,10 +4043,10 @@ func (s ExternalWorkflowExecutionSignaledEventAttributes) GoString() string {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type FailWorkflowExecutionDecisionAttributes struct {
 	// Optional. Details of the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// A descriptive reason for the failure that may help in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataFailWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -3602,7 +4100,7 @@ func (s FailWorkflowExecutionFailedEventAttributes) GoString() string {
 
 type GetWorkflowExecutionHistoryInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specifies the workflow execution for which to return the history.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -3614,7 +4112,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3622,7 +4120,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimeStamp of the events.
@@ -3658,7 +4156,7 @@ type GetWorkflowExecutionHistoryOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataGetWorkflowExecutionHistoryOutput `json:"-" xml:"-"`
 }
@@ -3991,7 +4489,7 @@ func (s HistoryEvent) GoString() string {
 
 type ListActivityTypesInput struct {
 	// The name of the domain in which the activity types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -4000,10 +4498,10 @@ type ListActivityTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If specified, only lists the activity types that have this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -4011,7 +4509,7 @@ type ListActivityTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the activity types to list.
 	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
@@ -4045,7 +4543,7 @@ type ListActivityTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// List of activity type information.
 	TypeInfos []*ActivityTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -4086,7 +4584,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -4102,7 +4600,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -4110,7 +4608,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start or the close time of the executions.
@@ -4162,7 +4660,7 @@ type ListDomainsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -4170,7 +4668,7 @@ type ListDomainsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the domains to list.
 	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
@@ -4207,7 +4705,7 @@ type ListDomainsOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataListDomainsOutput `json:"-" xml:"-"`
 }
@@ -4228,7 +4726,7 @@ func (s ListDomainsOutput) GoString() string {
 
 type ListOpenWorkflowExecutionsInput struct {
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -4244,7 +4742,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -4252,7 +4750,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start time of the executions.
@@ -4293,7 +4791,7 @@ func (s ListOpenWorkflowExecutionsInput) GoString() string {
 
 type ListWorkflowTypesInput struct {
 	// The name of the domain in which the workflow types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -4302,10 +4800,10 @@ type ListWorkflowTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If specified, lists the workflow type with this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -4313,7 +4811,7 @@ type ListWorkflowTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the workflow types to list.
 	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
@@ -4348,7 +4846,7 @@ type ListWorkflowTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The list of workflow type information.
 	TypeInfos []*WorkflowTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -4379,10 +4877,10 @@ type MarkerRecordedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details of the marker (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataMarkerRecordedEventAttributes `json:"-" xml:"-"`
 }
@@ -4404,7 +4902,7 @@ func (s MarkerRecordedEventAttributes) GoString() string {
 // Contains the count of tasks in a task list.
 type PendingTaskCount struct {
 	// The number of tasks in the task list.
-	Count *int64 `locationName:"count" type:"integer" required:"true"`
+	Count *int64 `locationName:"count" type:"integer" min:"0" required:"true"`
 
 	// If set to true, indicates that the actual count was more than the maximum
 	// supported by this API and the count returned is the truncated value.
@@ -4429,12 +4927,12 @@ func (s PendingTaskCount) GoString() string {
 
 type PollForActivityTaskInput struct {
 	// The name of the domain that contains the task lists being polled.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the worker making the request, recorded in the ActivityTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// Specifies the task list to poll for activity tasks.
 	//
@@ -4464,14 +4962,14 @@ func (s PollForActivityTaskInput) GoString() string {
 // Unit of work sent to an activity worker.
 type PollForActivityTaskOutput struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of this activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The inputs provided when the activity task was scheduled. The form of the
 	// input is user defined and should be meaningful to the activity implementation.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskStarted event recorded in the history.
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
@@ -4479,7 +4977,7 @@ type PollForActivityTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution that started this activity task.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -4503,12 +5001,12 @@ func (s PollForActivityTaskOutput) GoString() string {
 
 type PollForDecisionTaskInput struct {
 	// The name of the domain containing the task lists to poll.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the decider making the request, which is recorded in the DecisionTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -4517,7 +5015,7 @@ type PollForDecisionTaskInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -4530,7 +5028,7 @@ type PollForDecisionTaskInput struct {
 	// to get the next page. You must call PollForDecisionTask again (with the nextPageToken)
 	// to retrieve the next page of history records. Calling PollForDecisionTask
 	// with a nextPageToken will not return a new decision task..
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimestamp of the events.
@@ -4574,7 +5072,7 @@ type PollForDecisionTaskOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The id of the DecisionTaskStarted event of the previous decision task of
 	// this workflow execution that was processed by the decider. This can be used
@@ -4588,7 +5086,7 @@ type PollForDecisionTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution for which this decision task was created.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -4615,14 +5113,14 @@ func (s PollForDecisionTaskOutput) GoString() string {
 
 type RecordActivityTaskHeartbeatInput struct {
 	// If specified, contains details about the progress of the task.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRecordActivityTaskHeartbeatInput `json:"-" xml:"-"`
 }
@@ -4680,10 +5178,10 @@ func (s RecordActivityTaskHeartbeatOutput) GoString() string {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RecordMarkerDecisionAttributes struct {
 	// Optional. details of the marker.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Required. The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerDecisionAttributes `json:"-" xml:"-"`
 }
@@ -4719,7 +5217,7 @@ type RecordMarkerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The marker's name.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -4750,7 +5248,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling tasks of this
 	// activity type. This default task list is used if a task list is not provided
@@ -4765,7 +5263,7 @@ type RegisterActivityTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration for a task of this activity
 	// type. This default can be overridden when scheduling an activity task using
@@ -4773,7 +5271,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a task of this activity
 	// type can wait before being assigned to a worker. This default can be overridden
@@ -4781,7 +5279,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a worker can take to
 	// process tasks of this activity type. This default can be overridden when
@@ -4789,13 +5287,13 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// A textual description of the activity type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which this activity is to be registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the activity type within the domain.
 	//
@@ -4803,7 +5301,7 @@ type RegisterActivityTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the activity type.
 	//
@@ -4812,7 +5310,7 @@ type RegisterActivityTypeInput struct {
 	// end with whitespace. It must not contain a : (colon), / (slash), | (vertical
 	// bar), or any control characters (\u0000-\u001f | \u007f - \u009f). Also,
 	// it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterActivityTypeInput `json:"-" xml:"-"`
 }
@@ -4851,7 +5349,7 @@ func (s RegisterActivityTypeOutput) GoString() string {
 
 type RegisterDomainInput struct {
 	// A text description of the domain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// Name of the domain to register. The name must be unique in the region that
 	// the domain is registered in.
@@ -4860,7 +5358,7 @@ type RegisterDomainInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The duration (in days) that records and histories of workflow executions
 	// on the domain should be kept by the service. After the retention period,
@@ -4873,7 +5371,7 @@ type RegisterDomainInput struct {
 	// The maximum workflow execution retention period is 90 days. For more information
 	// about Amazon SWF service limits, see: Amazon SWF Service Limits (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dg-limits.html)
 	// in the Amazon SWF Developer Guide.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataRegisterDomainInput `json:"-" xml:"-"`
 }
@@ -4935,7 +5433,7 @@ type RegisterWorkflowTypeInput struct {
 	// specify a value of "NONE" for defaultExecutionStartToCloseTimeout; there
 	// is a one-year max limit on the time that a workflow execution can run. Exceeding
 	// this limit will always cause the workflow execution to time out.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling decision tasks
 	// for executions of this workflow type. This default is used only if a task
@@ -4951,7 +5449,7 @@ type RegisterWorkflowTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration of decision tasks for this
 	// workflow type. This default can be overridden when starting a workflow execution
@@ -4960,13 +5458,13 @@ type RegisterWorkflowTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Textual description of the workflow type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which to register the workflow type.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the workflow type.
 	//
@@ -4974,7 +5472,7 @@ type RegisterWorkflowTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the workflow type.
 	//
@@ -4984,7 +5482,7 @@ type RegisterWorkflowTypeInput struct {
 	// not start or end with whitespace. It must not contain a : (colon), / (slash),
 	// | (vertical bar), or any control characters (\u0000-\u001f | \u007f - \u009f).
 	// Also, it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterWorkflowTypeInput `json:"-" xml:"-"`
 }
@@ -5038,7 +5536,7 @@ func (s RegisterWorkflowTypeOutput) GoString() string {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RequestCancelActivityTaskDecisionAttributes struct {
 	// The activityId of the activity task to be canceled.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelActivityTaskDecisionAttributes `json:"-" xml:"-"`
 }
@@ -5060,7 +5558,7 @@ func (s RequestCancelActivityTaskDecisionAttributes) GoString() string {
 // Provides details of the RequestCancelActivityTaskFailed event.
 type RequestCancelActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the RequestCancelActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The cause of the failure. This information is generated by the system and
 	// can be useful for diagnostic purposes.
@@ -5111,13 +5609,13 @@ func (s RequestCancelActivityTaskFailedEventAttributes) GoString() string {
 type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The workflowId of the external workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -5146,7 +5644,7 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 	Cause *string `locationName:"cause" type:"string" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -5161,11 +5659,11 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow to which the cancel request was to
 	// be delivered.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -5188,7 +5686,7 @@ func (s RequestCancelExternalWorkflowExecutionFailedEventAttributes) GoString()
 type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -5197,10 +5695,10 @@ type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution to be canceled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution to be canceled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"-" xml:"-"`
 }
@@ -5221,13 +5719,13 @@ func (s RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GoString
 
 type RequestCancelWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to cancel.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The runId of the workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelWorkflowExecutionInput `json:"-" xml:"-"`
 }
@@ -5266,14 +5764,14 @@ func (s RequestCancelWorkflowExecutionOutput) GoString() string {
 
 type RespondActivityTaskCanceledInput struct {
 	// Optional. Information about the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCanceledInput `json:"-" xml:"-"`
 }
@@ -5313,14 +5811,14 @@ func (s RespondActivityTaskCanceledOutput) GoString() string {
 type RespondActivityTaskCompletedInput struct {
 	// The result of the activity task. It is a free form string that is implementation
 	// specific.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCompletedInput `json:"-" xml:"-"`
 }
@@ -5359,17 +5857,17 @@ func (s RespondActivityTaskCompletedOutput) GoString() string {
 
 type RespondActivityTaskFailedInput struct {
 	// Optional. Detailed information about the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Description of the error that may assist in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskFailedInput `json:"-" xml:"-"`
 }
@@ -5412,14 +5910,14 @@ type RespondDecisionTaskCompletedInput struct {
 	Decisions []*Decision `locationName:"decisions" type:"list"`
 
 	// User defined context to add to workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The taskToken from the DecisionTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondDecisionTaskCompletedInput `json:"-" xml:"-"`
 }
@@ -5481,14 +5979,14 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the activity task to schedule.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// If set, specifies the maximum time before which a worker processing a task
 	// of this type must report progress by calling RecordActivityTaskHeartbeat.
@@ -5499,10 +5997,10 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum duration for this activity task.
 	//
@@ -5513,7 +6011,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. If set, specifies the maximum duration the activity task can wait
 	// to be assigned to a worker. This overrides the default schedule-to-start
@@ -5526,7 +6024,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-start timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the maximum duration a worker may take to process this
 	// activity task. This overrides the default start-to-close timeout specified
@@ -5539,7 +6037,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default start-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the name of the task list in which to schedule the activity
 	// task. If not specified, the defaultTaskList registered with the activity
@@ -5563,7 +6061,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataScheduleActivityTaskDecisionAttributes `json:"-" xml:"-"`
 }
@@ -5585,7 +6083,7 @@ func (s ScheduleActivityTaskDecisionAttributes) GoString() string {
 // Provides details of the ScheduleActivityTaskFailed event.
 type ScheduleActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the ScheduleActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The activity type provided in the ScheduleActivityTask decision that failed.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
@@ -5639,21 +6137,21 @@ func (s ScheduleActivityTaskFailedEventAttributes) GoString() string {
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Optional. Input data to be provided with the signal. The target workflow
 	// execution will use the signal name and input data to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to be signaled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The name of the signal.The target workflow execution will use the
 	// signal name and input to process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The workflowId of the workflow execution to be signaled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -5682,7 +6180,7 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 	Cause *string `locationName:"cause" type:"string" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -5698,11 +6196,11 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 
 	// The runId of the external workflow execution that the signal was being delivered
 	// to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution that the signal was being
 	// delivered to.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -5725,7 +6223,7 @@ func (s SignalExternalWorkflowExecutionFailedEventAttributes) GoString() string
 type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -5734,16 +6232,16 @@ type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Input provided to the signal (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to send the signal to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the external workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionInitiatedEventAttributes `json:"-" xml:"-"`
 }
@@ -5764,20 +6262,20 @@ func (s SignalExternalWorkflowExecutionInitiatedEventAttributes) GoString() stri
 
 type SignalWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to signal.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to attach to the WorkflowExecutionSignaled event in the target workflow
 	// execution's history.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to signal.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal. This name must be meaningful to the target workflow.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the workflow execution to signal.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalWorkflowExecutionInput `json:"-" xml:"-"`
 }
@@ -5854,7 +6352,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the child workflow execution.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -5866,16 +6364,16 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this parameter.
 	// If neither this parameter is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input to be provided to the workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the child workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list to be used for decision tasks of the child workflow
 	// execution.
@@ -5898,7 +6396,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -5911,7 +6409,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Required. The workflowId of the workflow execution.
 	//
@@ -5919,7 +6417,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the workflow execution to be started.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5951,7 +6449,7 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 	Cause *string `locationName:"cause" type:"string" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -5966,7 +6464,7 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type provided in the StartChildWorkflowExecution Decision that
 	// failed.
@@ -6006,7 +6504,7 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -6019,13 +6517,13 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The inputs provided to the child workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associated with the child workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list used for the decision tasks of the child workflow
 	// execution.
@@ -6039,16 +6537,16 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for the decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the child workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -6088,13 +6586,13 @@ func (s StartChildWorkflowExecutionInitiatedEventAttributes) GoString() string {
 type StartTimerDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Required. The duration to wait before firing the timer.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// Required. The unique Id of the timer.
 	//
@@ -6102,7 +6600,7 @@ type StartTimerDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerDecisionAttributes `json:"-" xml:"-"`
 }
@@ -6138,7 +6636,7 @@ type StartTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the StartTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -6177,7 +6675,7 @@ type StartWorkflowExecutionInput struct {
 	ChildPolicy *string `locationName:"childPolicy" type:"string"`
 
 	// The name of the domain in which the workflow execution is created.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -6192,18 +6690,18 @@ type StartWorkflowExecutionInput struct {
 	// parameter or as a default when the workflow type is registered. If neither
 	// this parameter nor a default execution start-to-close timeout is specified,
 	// a fault is returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input for the workflow execution. This is a free form string which should
 	// be meaningful to the workflow you are starting. This input is made available
 	// to the new workflow execution in the WorkflowExecutionStarted history event.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the workflow execution. You can specify
 	// a maximum of 5 tags. You can list workflow executions with a specific tag
 	// by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions and
 	// specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The task list to use for the decision tasks generated for this workflow execution.
 	// This overrides the defaultTaskList specified when registering the workflow
@@ -6228,7 +6726,7 @@ type StartWorkflowExecutionInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -6241,7 +6739,7 @@ type StartWorkflowExecutionInput struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The user defined identifier associated with the workflow execution. You can
 	// use this to associate a custom identifier with the workflow execution. You
@@ -6253,7 +6751,7 @@ type StartWorkflowExecutionInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the workflow to start.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -6279,7 +6777,7 @@ func (s StartWorkflowExecutionInput) GoString() string {
 type StartWorkflowExecutionOutput struct {
 	// The runId of a workflow execution. This Id is generated by the service and
 	// can be used to uniquely identify the workflow execution within a domain.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64"`
 
 	metadataStartWorkflowExecutionOutput `json:"-" xml:"-"`
 }
@@ -6302,7 +6800,7 @@ func (s StartWorkflowExecutionOutput) GoString() string {
 type TagFilter struct {
 	// Required. Specifies the tag that must be associated with the execution for
 	// it to meet the filter criteria.
-	Tag *string `locationName:"tag" type:"string" required:"true"`
+	Tag *string `locationName:"tag" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTagFilter `json:"-" xml:"-"`
 }
@@ -6324,7 +6822,7 @@ func (s TagFilter) GoString() string {
 // Represents a task list.
 type TaskList struct {
 	// The name of the task list.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTaskList `json:"-" xml:"-"`
 }
@@ -6363,19 +6861,19 @@ type TerminateWorkflowExecutionInput struct {
 	ChildPolicy *string `locationName:"childPolicy" type:"string"`
 
 	// Optional. Details for terminating the workflow execution.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The domain of the workflow execution to terminate.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Optional. A descriptive reason for terminating the workflow execution.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The runId of the workflow execution to terminate.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to terminate.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTerminateWorkflowExecutionInput `json:"-" xml:"-"`
 }
@@ -6426,7 +6924,7 @@ type TimerCanceledEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that was canceled.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerCanceledEventAttributes `json:"-" xml:"-"`
 }
@@ -6453,7 +6951,7 @@ type TimerFiredEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that fired.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerFiredEventAttributes `json:"-" xml:"-"`
 }
@@ -6476,7 +6974,7 @@ func (s TimerFiredEventAttributes) GoString() string {
 type TimerStartedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartTimer decision for this activity task. This information
@@ -6488,10 +6986,10 @@ type TimerStartedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The unique Id of the timer that was started.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerStartedEventAttributes `json:"-" xml:"-"`
 }
@@ -6513,10 +7011,10 @@ func (s TimerStartedEventAttributes) GoString() string {
 // Represents a workflow execution.
 type WorkflowExecution struct {
 	// A system-generated unique identifier for the workflow execution.
-	RunID *string `locationName:"runId" type:"string" required:"true"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The user defined identifier associated with the workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecution `json:"-" xml:"-"`
 }
@@ -6578,7 +7076,7 @@ type WorkflowExecutionCanceledEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details for the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCanceledEventAttributes `json:"-" xml:"-"`
 }
@@ -6606,7 +7104,7 @@ type WorkflowExecutionCompletedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The result produced by the workflow execution upon successful completion.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCompletedEventAttributes `json:"-" xml:"-"`
 }
@@ -6647,7 +7145,7 @@ type WorkflowExecutionConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" required:"true"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The task list used for the decision tasks generated for this workflow execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -6659,13 +7157,13 @@ type WorkflowExecutionConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" required:"true"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataWorkflowExecutionConfiguration `json:"-" xml:"-"`
 }
@@ -6709,27 +7207,27 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the new workflow execution.
-	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" required:"true"`
+	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The list of tags associated with the new workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for the new workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Represents a workflow type.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -6755,7 +7253,7 @@ func (s WorkflowExecutionContinuedAsNewEventAttributes) GoString() string {
 // or CountClosedWorkflowExecutions
 type WorkflowExecutionCount struct {
 	// The number of workflow executions.
-	Count *int64 `locationName:"count" type:"integer" required:"true"`
+	Count *int64 `locationName:"count" type:"integer" min:"0" required:"true"`
 
 	// If set to true, indicates that the actual count was more than the maximum
 	// supported by this API and the count returned is the truncated value.
@@ -6787,10 +7285,10 @@ type WorkflowExecutionFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The descriptive reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -6812,7 +7310,7 @@ func (s WorkflowExecutionFailedEventAttributes) GoString() string {
 // Used to filter the workflow executions in visibility APIs by their workflowId.
 type WorkflowExecutionFilter struct {
 	// The workflowId to pass of match the criteria of this filter.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionFilter `json:"-" xml:"-"`
 }
@@ -6868,7 +7366,7 @@ type WorkflowExecutionInfo struct {
 	// The list of tags associated with the workflow execution. Tags can be used
 	// to identify and list workflow executions of interest through the visibility
 	// APIs. A workflow execution can have a maximum of 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The type of the workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -6901,7 +7399,7 @@ type WorkflowExecutionInfos struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataWorkflowExecutionInfos `json:"-" xml:"-"`
 }
@@ -6924,18 +7422,18 @@ func (s WorkflowExecutionInfos) GoString() string {
 // a workflow execution.
 type WorkflowExecutionOpenCounts struct {
 	// The count of activity tasks whose status is OPEN.
-	OpenActivityTasks *int64 `locationName:"openActivityTasks" type:"integer" required:"true"`
+	OpenActivityTasks *int64 `locationName:"openActivityTasks" type:"integer" min:"0" required:"true"`
 
 	// The count of child workflow executions whose status is OPEN.
-	OpenChildWorkflowExecutions *int64 `locationName:"openChildWorkflowExecutions" type:"integer" required:"true"`
+	OpenChildWorkflowExecutions *int64 `locationName:"openChildWorkflowExecutions" type:"integer" min:"0" required:"true"`
 
 	// The count of decision tasks whose status is OPEN. A workflow execution can
 	// have at most one open decision task.
-	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" required:"true"`
+	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" min:"0" max:"1" required:"true"`
 
 	// The count of timers started by this workflow execution that have not fired
 	// yet.
-	OpenTimers *int64 `locationName:"openTimers" type:"integer" required:"true"`
+	OpenTimers *int64 `locationName:"openTimers" type:"integer" min:"0" required:"true"`
 
 	metadataWorkflowExecutionOpenCounts `json:"-" xml:"-"`
 }
@@ -6970,11 +7468,11 @@ type WorkflowExecutionSignaledEventAttributes struct {
 
 	// Inputs provided with the signal (if any). The decider can use the signal
 	// name and inputs to determine how to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The name of the signal received. The decider can use the signal name and
 	// inputs to determine how to the process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionSignaledEventAttributes `json:"-" xml:"-"`
 }
@@ -7011,16 +7509,16 @@ type WorkflowExecutionStartedEventAttributes struct {
 	// If this workflow execution was started due to a ContinueAsNewWorkflowExecution
 	// decision, then it contains the runId of the previous workflow execution that
 	// was closed and continued as this execution.
-	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string"`
+	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string" max:"64"`
 
 	// The maximum duration for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this workflow execution.
@@ -7035,19 +7533,19 @@ type WorkflowExecutionStartedEventAttributes struct {
 
 	// The list of tags associated with this workflow execution. An execution can
 	// have up to 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list for scheduling the decision tasks for this workflow
 	// execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for this workflow type.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflow type of this execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -7088,10 +7586,10 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
 
 	// The details provided for the termination (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the termination (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionTerminatedEventAttributes `json:"-" xml:"-"`
 }
@@ -7149,13 +7647,13 @@ type WorkflowType struct {
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The version of the workflow type.
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataWorkflowType `json:"-" xml:"-"`
 }
@@ -7198,7 +7696,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list, specified when registering the workflow
 	// type, for decisions tasks scheduled for workflow executions of this type.
@@ -7217,7 +7715,7 @@ type WorkflowTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the workflow
 	// type, that a decision task for executions of this workflow type might take
@@ -7229,7 +7727,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataWorkflowTypeConfiguration `json:"-" xml:"-"`
 }
@@ -7252,10 +7750,10 @@ func (s WorkflowTypeConfiguration) GoString() string {
 // if specified, defines a rule that must be satisfied by each returned result.
 type WorkflowTypeFilter struct {
 	// Required. Name of the workflow type.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Version of the workflow type.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" max:"64"`
 
 	metadataWorkflowTypeFilter `json:"-" xml:"-"`
 }
@@ -7284,7 +7782,7 @@ type WorkflowTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the type registered through RegisterWorkflowType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the workflow type.
 	Status *string `locationName:"status" type:"string" required:"true"`
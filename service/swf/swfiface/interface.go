@@ -4,6 +4,7 @@
 package swfiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/swf"
 )
 
@@ -11,63 +12,153 @@ import (
 type SWFAPI interface {
 	CountClosedWorkflowExecutions(*swf.CountClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
 
+	CountClosedWorkflowExecutionsWithContext(aws.Context, *swf.CountClosedWorkflowExecutionsInput, ...aws.Option) (*swf.WorkflowExecutionCount, error)
+
 	CountOpenWorkflowExecutions(*swf.CountOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
 
+	CountOpenWorkflowExecutionsWithContext(aws.Context, *swf.CountOpenWorkflowExecutionsInput, ...aws.Option) (*swf.WorkflowExecutionCount, error)
+
 	CountPendingActivityTasks(*swf.CountPendingActivityTasksInput) (*swf.PendingTaskCount, error)
 
+	CountPendingActivityTasksWithContext(aws.Context, *swf.CountPendingActivityTasksInput, ...aws.Option) (*swf.PendingTaskCount, error)
+
 	CountPendingDecisionTasks(*swf.CountPendingDecisionTasksInput) (*swf.PendingTaskCount, error)
 
+	CountPendingDecisionTasksWithContext(aws.Context, *swf.CountPendingDecisionTasksInput, ...aws.Option) (*swf.PendingTaskCount, error)
+
 	DeprecateActivityType(*swf.DeprecateActivityTypeInput) (*swf.DeprecateActivityTypeOutput, error)
 
+	DeprecateActivityTypeWithContext(aws.Context, *swf.DeprecateActivityTypeInput, ...aws.Option) (*swf.DeprecateActivityTypeOutput, error)
+
 	DeprecateDomain(*swf.DeprecateDomainInput) (*swf.DeprecateDomainOutput, error)
 
+	DeprecateDomainWithContext(aws.Context, *swf.DeprecateDomainInput, ...aws.Option) (*swf.DeprecateDomainOutput, error)
+
 	DeprecateWorkflowType(*swf.DeprecateWorkflowTypeInput) (*swf.DeprecateWorkflowTypeOutput, error)
 
+	DeprecateWorkflowTypeWithContext(aws.Context, *swf.DeprecateWorkflowTypeInput, ...aws.Option) (*swf.DeprecateWorkflowTypeOutput, error)
+
 	DescribeActivityType(*swf.DescribeActivityTypeInput) (*swf.DescribeActivityTypeOutput, error)
 
+	DescribeActivityTypeWithContext(aws.Context, *swf.DescribeActivityTypeInput, ...aws.Option) (*swf.DescribeActivityTypeOutput, error)
+
 	DescribeDomain(*swf.DescribeDomainInput) (*swf.DescribeDomainOutput, error)
 
+	DescribeDomainWithContext(aws.Context, *swf.DescribeDomainInput, ...aws.Option) (*swf.DescribeDomainOutput, error)
+
 	DescribeWorkflowExecution(*swf.DescribeWorkflowExecutionInput) (*swf.DescribeWorkflowExecutionOutput, error)
 
+	DescribeWorkflowExecutionWithContext(aws.Context, *swf.DescribeWorkflowExecutionInput, ...aws.Option) (*swf.DescribeWorkflowExecutionOutput, error)
+
 	DescribeWorkflowType(*swf.DescribeWorkflowTypeInput) (*swf.DescribeWorkflowTypeOutput, error)
 
+	DescribeWorkflowTypeWithContext(aws.Context, *swf.DescribeWorkflowTypeInput, ...aws.Option) (*swf.DescribeWorkflowTypeOutput, error)
+
 	GetWorkflowExecutionHistory(*swf.GetWorkflowExecutionHistoryInput) (*swf.GetWorkflowExecutionHistoryOutput, error)
 
+	GetWorkflowExecutionHistoryWithContext(aws.Context, *swf.GetWorkflowExecutionHistoryInput, ...aws.Option) (*swf.GetWorkflowExecutionHistoryOutput, error)
+
+	GetWorkflowExecutionHistoryPages(*swf.GetWorkflowExecutionHistoryInput, func(*swf.GetWorkflowExecutionHistoryOutput, bool) bool) error
+
+	GetWorkflowExecutionHistoryPagesWithContext(aws.Context, *swf.GetWorkflowExecutionHistoryInput, func(*swf.GetWorkflowExecutionHistoryOutput, bool) bool, ...aws.Option) error
+
 	ListActivityTypes(*swf.ListActivityTypesInput) (*swf.ListActivityTypesOutput, error)
 
+	ListActivityTypesWithContext(aws.Context, *swf.ListActivityTypesInput, ...aws.Option) (*swf.ListActivityTypesOutput, error)
+
+	ListActivityTypesPages(*swf.ListActivityTypesInput, func(*swf.ListActivityTypesOutput, bool) bool) error
+
+	ListActivityTypesPagesWithContext(aws.Context, *swf.ListActivityTypesInput, func(*swf.ListActivityTypesOutput, bool) bool, ...aws.Option) error
+
 	ListClosedWorkflowExecutions(*swf.ListClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
 
+	ListClosedWorkflowExecutionsWithContext(aws.Context, *swf.ListClosedWorkflowExecutionsInput, ...aws.Option) (*swf.WorkflowExecutionInfos, error)
+
+	ListClosedWorkflowExecutionsPages(*swf.ListClosedWorkflowExecutionsInput, func(*swf.WorkflowExecutionInfos, bool) bool) error
+
+	ListClosedWorkflowExecutionsPagesWithContext(aws.Context, *swf.ListClosedWorkflowExecutionsInput, func(*swf.WorkflowExecutionInfos, bool) bool, ...aws.Option) error
+
 	ListDomains(*swf.ListDomainsInput) (*swf.ListDomainsOutput, error)
 
+	ListDomainsWithContext(aws.Context, *swf.ListDomainsInput, ...aws.Option) (*swf.ListDomainsOutput, error)
+
+	ListDomainsPages(*swf.ListDomainsInput, func(*swf.ListDomainsOutput, bool) bool) error
+
+	ListDomainsPagesWithContext(aws.Context, *swf.ListDomainsInput, func(*swf.ListDomainsOutput, bool) bool, ...aws.Option) error
+
 	ListOpenWorkflowExecutions(*swf.ListOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
 
+	ListOpenWorkflowExecutionsWithContext(aws.Context, *swf.ListOpenWorkflowExecutionsInput, ...aws.Option) (*swf.WorkflowExecutionInfos, error)
+
+	ListOpenWorkflowExecutionsPages(*swf.ListOpenWorkflowExecutionsInput, func(*swf.WorkflowExecutionInfos, bool) bool) error
+
+	ListOpenWorkflowExecutionsPagesWithContext(aws.Context, *swf.ListOpenWorkflowExecutionsInput, func(*swf.WorkflowExecutionInfos, bool) bool, ...aws.Option) error
+
 	ListWorkflowTypes(*swf.ListWorkflowTypesInput) (*swf.ListWorkflowTypesOutput, error)
 
+	ListWorkflowTypesWithContext(aws.Context, *swf.ListWorkflowTypesInput, ...aws.Option) (*swf.ListWorkflowTypesOutput, error)
+
+	ListWorkflowTypesPages(*swf.ListWorkflowTypesInput, func(*swf.ListWorkflowTypesOutput, bool) bool) error
+
+	ListWorkflowTypesPagesWithContext(aws.Context, *swf.ListWorkflowTypesInput, func(*swf.ListWorkflowTypesOutput, bool) bool, ...aws.Option) error
+
 	PollForActivityTask(*swf.PollForActivityTaskInput) (*swf.PollForActivityTaskOutput, error)
 
+	PollForActivityTaskWithContext(aws.Context, *swf.PollForActivityTaskInput, ...aws.Option) (*swf.PollForActivityTaskOutput, error)
+
 	PollForDecisionTask(*swf.PollForDecisionTaskInput) (*swf.PollForDecisionTaskOutput, error)
 
+	PollForDecisionTaskWithContext(aws.Context, *swf.PollForDecisionTaskInput, ...aws.Option) (*swf.PollForDecisionTaskOutput, error)
+
+	PollForDecisionTaskPages(*swf.PollForDecisionTaskInput, func(*swf.PollForDecisionTaskOutput, bool) bool) error
+
+	PollForDecisionTaskPagesWithContext(aws.Context, *swf.PollForDecisionTaskInput, func(*swf.PollForDecisionTaskOutput, bool) bool, ...aws.Option) error
+
 	RecordActivityTaskHeartbeat(*swf.RecordActivityTaskHeartbeatInput) (*swf.RecordActivityTaskHeartbeatOutput, error)
 
+	RecordActivityTaskHeartbeatWithContext(aws.Context, *swf.RecordActivityTaskHeartbeatInput, ...aws.Option) (*swf.RecordActivityTaskHeartbeatOutput, error)
+
 	RegisterActivityType(*swf.RegisterActivityTypeInput) (*swf.RegisterActivityTypeOutput, error)
 
+	RegisterActivityTypeWithContext(aws.Context, *swf.RegisterActivityTypeInput, ...aws.Option) (*swf.RegisterActivityTypeOutput, error)
+
 	RegisterDomain(*swf.RegisterDomainInput) (*swf.RegisterDomainOutput, error)
 
+	RegisterDomainWithContext(aws.Context, *swf.RegisterDomainInput, ...aws.Option) (*swf.RegisterDomainOutput, error)
+
 	RegisterWorkflowType(*swf.RegisterWorkflowTypeInput) (*swf.RegisterWorkflowTypeOutput, error)
 
+	RegisterWorkflowTypeWithContext(aws.Context, *swf.RegisterWorkflowTypeInput, ...aws.Option) (*swf.RegisterWorkflowTypeOutput, error)
+
 	RequestCancelWorkflowExecution(*swf.RequestCancelWorkflowExecutionInput) (*swf.RequestCancelWorkflowExecutionOutput, error)
 
+	RequestCancelWorkflowExecutionWithContext(aws.Context, *swf.RequestCancelWorkflowExecutionInput, ...aws.Option) (*swf.RequestCancelWorkflowExecutionOutput, error)
+
 	RespondActivityTaskCanceled(*swf.RespondActivityTaskCanceledInput) (*swf.RespondActivityTaskCanceledOutput, error)
 
+	RespondActivityTaskCanceledWithContext(aws.Context, *swf.RespondActivityTaskCanceledInput, ...aws.Option) (*swf.RespondActivityTaskCanceledOutput, error)
+
 	RespondActivityTaskCompleted(*swf.RespondActivityTaskCompletedInput) (*swf.RespondActivityTaskCompletedOutput, error)
 
+	RespondActivityTaskCompletedWithContext(aws.Context, *swf.RespondActivityTaskCompletedInput, ...aws.Option) (*swf.RespondActivityTaskCompletedOutput, error)
+
 	RespondActivityTaskFailed(*swf.RespondActivityTaskFailedInput) (*swf.RespondActivityTaskFailedOutput, error)
 
+	RespondActivityTaskFailedWithContext(aws.Context, *swf.RespondActivityTaskFailedInput, ...aws.Option) (*swf.RespondActivityTaskFailedOutput, error)
+
 	RespondDecisionTaskCompleted(*swf.RespondDecisionTaskCompletedInput) (*swf.RespondDecisionTaskCompletedOutput, error)
 
+	RespondDecisionTaskCompletedWithContext(aws.Context, *swf.RespondDecisionTaskCompletedInput, ...aws.Option) (*swf.RespondDecisionTaskCompletedOutput, error)
+
 	SignalWorkflowExecution(*swf.SignalWorkflowExecutionInput) (*swf.SignalWorkflowExecutionOutput, error)
 
+	SignalWorkflowExecutionWithContext(aws.Context, *swf.SignalWorkflowExecutionInput, ...aws.Option) (*swf.SignalWorkflowExecutionOutput, error)
+
 	StartWorkflowExecution(*swf.StartWorkflowExecutionInput) (*swf.StartWorkflowExecutionOutput, error)
 
+	StartWorkflowExecutionWithContext(aws.Context, *swf.StartWorkflowExecutionInput, ...aws.Option) (*swf.StartWorkflowExecutionOutput, error)
+
 	TerminateWorkflowExecution(*swf.TerminateWorkflowExecutionInput) (*swf.TerminateWorkflowExecutionOutput, error)
+
+	TerminateWorkflowExecutionWithContext(aws.Context, *swf.TerminateWorkflowExecutionInput, ...aws.Option) (*swf.TerminateWorkflowExecutionOutput, error)
 }
@@ -37,6 +37,18 @@ func (c *WorkSpaces) CreateWorkspaces(input *CreateWorkspacesInput) (*CreateWork
 	return out, err
 }
 
+// CreateWorkspacesWithContext is the same as CreateWorkspaces with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *WorkSpaces) CreateWorkspacesWithContext(ctx aws.Context, input *CreateWorkspacesInput, opts ...aws.Option) (*CreateWorkspacesOutput, error) {
+	req, out := c.CreateWorkspacesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeWorkspaceBundles = "DescribeWorkspaceBundles"
 
 // DescribeWorkspaceBundlesRequest generates a request for the DescribeWorkspaceBundles operation.
@@ -50,6 +62,7 @@ func (c *WorkSpaces) DescribeWorkspaceBundlesRequest(input *DescribeWorkspaceBun
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Bundles"},
 		},
 	}
 
@@ -79,6 +92,22 @@ func (c *WorkSpaces) DescribeWorkspaceBundles(input *DescribeWorkspaceBundlesInp
 	return out, err
 }
 
+// DescribeWorkspaceBundlesWithContext is the same as DescribeWorkspaceBundles with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *WorkSpaces) DescribeWorkspaceBundlesWithContext(ctx aws.Context, input *DescribeWorkspaceBundlesInput, opts ...aws.Option) (*DescribeWorkspaceBundlesOutput, error) {
+	req, out := c.DescribeWorkspaceBundlesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeWorkspaceBundlesPages iterates over the pages of a DescribeWorkspaceBundles operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *WorkSpaces) DescribeWorkspaceBundlesPages(input *DescribeWorkspaceBundlesInput, fn func(p *DescribeWorkspaceBundlesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeWorkspaceBundlesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -86,6 +115,19 @@ func (c *WorkSpaces) DescribeWorkspaceBundlesPages(input *DescribeWorkspaceBundl
 	})
 }
 
+// DescribeWorkspaceBundlesPagesWithContext is the same as DescribeWorkspaceBundlesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *WorkSpaces) DescribeWorkspaceBundlesPagesWithContext(ctx aws.Context, input *DescribeWorkspaceBundlesInput, fn func(p *DescribeWorkspaceBundlesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeWorkspaceBundlesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeWorkspaceBundlesOutput), lastPage)
+	})
+}
+
 const opDescribeWorkspaceDirectories = "DescribeWorkspaceDirectories"
 
 // DescribeWorkspaceDirectoriesRequest generates a request for the DescribeWorkspaceDirectories operation.
@@ -99,6 +141,7 @@ func (c *WorkSpaces) DescribeWorkspaceDirectoriesRequest(input *DescribeWorkspac
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "",
 			TruncationToken: "",
+			ResultKeys:      []string{"Directories"},
 		},
 	}
 
@@ -126,6 +169,22 @@ func (c *WorkSpaces) DescribeWorkspaceDirectories(input *DescribeWorkspaceDirect
 	return out, err
 }
 
+// DescribeWorkspaceDirectoriesWithContext is the same as DescribeWorkspaceDirectories with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *WorkSpaces) DescribeWorkspaceDirectoriesWithContext(ctx aws.Context, input *DescribeWorkspaceDirectoriesInput, opts ...aws.Option) (*DescribeWorkspaceDirectoriesOutput, error) {
+	req, out := c.DescribeWorkspaceDirectoriesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeWorkspaceDirectoriesPages iterates over the pages of a DescribeWorkspaceDirectories operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *WorkSpaces) DescribeWorkspaceDirectoriesPages(input *DescribeWorkspaceDirectoriesInput, fn func(p *DescribeWorkspaceDirectoriesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeWorkspaceDirectoriesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -133,6 +192,19 @@ func (c *WorkSpaces) DescribeWorkspaceDirectoriesPages(input *DescribeWorkspaceD
 	})
 }
 
+// DescribeWorkspaceDirectoriesPagesWithContext is the same as DescribeWorkspaceDirectoriesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *WorkSpaces) DescribeWorkspaceDirectoriesPagesWithContext(ctx aws.Context, input *DescribeWorkspaceDirectoriesInput, fn func(p *DescribeWorkspaceDirectoriesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeWorkspaceDirectoriesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeWorkspaceDirectoriesOutput), lastPage)
+	})
+}
+
 const opDescribeWorkspaces = "DescribeWorkspaces"
 
 // DescribeWorkspacesRequest generates a request for the DescribeWorkspaces operation.
@@ -146,6 +218,7 @@ func (c *WorkSpaces) DescribeWorkspacesRequest(input *DescribeWorkspacesInput) (
 			OutputTokens:    []string{"NextToken"},
 			LimitToken:      "Limit",
 			TruncationToken: "",
+			ResultKeys:      []string{"Workspaces"},
 		},
 	}
 
@@ -174,6 +247,22 @@ func (c *WorkSpaces) DescribeWorkspaces(input *DescribeWorkspacesInput) (*Descri
 	return out, err
 }
 
+// DescribeWorkspacesWithContext is the same as DescribeWorkspaces with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *WorkSpaces) DescribeWorkspacesWithContext(ctx aws.Context, input *DescribeWorkspacesInput, opts ...aws.Option) (*DescribeWorkspacesOutput, error) {
+	req, out := c.DescribeWorkspacesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
+// DescribeWorkspacesPages iterates over the pages of a DescribeWorkspaces operation,
+// calling fn with each page and whether it is the last page. Iterating stops
+// when fn returns false, or with an aws.PaginationError if a page's request
+// fails.
 func (c *WorkSpaces) DescribeWorkspacesPages(input *DescribeWorkspacesInput, fn func(p *DescribeWorkspacesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeWorkspacesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -181,6 +270,19 @@ func (c *WorkSpaces) DescribeWorkspacesPages(input *DescribeWorkspacesInput, fn
 	})
 }
 
+// DescribeWorkspacesPagesWithContext is the same as DescribeWorkspacesPages with the
+// addition of the ability to pass a context and additional request options,
+// which are used for the requests of every page. The context must not be nil.
+// If the context is done iterating stops before the next page is requested.
+func (c *WorkSpaces) DescribeWorkspacesPagesWithContext(ctx aws.Context, input *DescribeWorkspacesInput, fn func(p *DescribeWorkspacesOutput, lastPage bool) (shouldContinue bool), opts ...aws.Option) error {
+	page, _ := c.DescribeWorkspacesRequest(input)
+	page.SetContext(ctx)
+	page.ApplyOptions(opts...)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeWorkspacesOutput), lastPage)
+	})
+}
+
 const opRebootWorkspaces = "RebootWorkspaces"
 
 // RebootWorkspacesRequest generates a request for the RebootWorkspaces operation.
@@ -214,6 +316,18 @@ func (c *WorkSpaces) RebootWorkspaces(input *RebootWorkspacesInput) (*RebootWork
 	return out, err
 }
 
+// RebootWorkspacesWithContext is the same as RebootWorkspaces with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *WorkSpaces) RebootWorkspacesWithContext(ctx aws.Context, input *RebootWorkspacesInput, opts ...aws.Option) (*RebootWorkspacesOutput, error) {
+	req, out := c.RebootWorkspacesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opRebuildWorkspaces = "RebuildWorkspaces"
 
 // RebuildWorkspacesRequest generates a request for the RebuildWorkspaces operation.
@@ -256,6 +370,18 @@ func (c *WorkSpaces) RebuildWorkspaces(input *RebuildWorkspacesInput) (*RebuildW
 	return out, err
 }
 
+// RebuildWorkspacesWithContext is the same as RebuildWorkspaces with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *WorkSpaces) RebuildWorkspacesWithContext(ctx aws.Context, input *RebuildWorkspacesInput, opts ...aws.Option) (*RebuildWorkspacesOutput, error) {
+	req, out := c.RebuildWorkspacesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateWorkspaces = "TerminateWorkspaces"
 
 // TerminateWorkspacesRequest generates a request for the TerminateWorkspaces operation.
@@ -292,6 +418,18 @@ func (c *WorkSpaces) TerminateWorkspaces(input *TerminateWorkspacesInput) (*Term
 	return out, err
 }
 
+// TerminateWorkspacesWithContext is the same as TerminateWorkspaces with the addition
+// of the ability to pass a context and additional request options. The context
+// must not be nil. If the context is canceled the in-flight request, its retries,
+// and the retrieval of credentials used to sign it will be canceled.
+func (c *WorkSpaces) TerminateWorkspacesWithContext(ctx aws.Context, input *TerminateWorkspacesInput, opts ...aws.Option) (*TerminateWorkspacesOutput, error) {
+	req, out := c.TerminateWorkspacesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	err := req.Send()
+	return out, err
+}
+
 // Contains information about the compute type of a WorkSpace bundle.
 type ComputeType struct {
 	// The name of the compute type for the bundle.
@@ -317,7 +455,7 @@ func (s ComputeType) GoString() string {
 // Contains the inputs for the CreateWorkspaces operation.
 type CreateWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to create.
-	Workspaces []*WorkspaceRequest `type:"list" required:"true"`
+	Workspaces []*WorkspaceRequest `type:"list" min:"1" max:"25" required:"true"`
 
 	metadataCreateWorkspacesInput `json:"-" xml:"-"`
 }
@@ -406,11 +544,11 @@ func (s DefaultWorkspaceCreationProperties) GoString() string {
 type DescribeWorkspaceBundlesInput struct {
 	// An array of strings that contains the identifiers of the bundles to retrieve.
 	// This parameter cannot be combined with any other filter parameter.
-	BundleIDs []*string `locationName:"BundleIds" type:"list"`
+	BundleIDs []*string `locationName:"BundleIds" type:"list" min:"1" max:"25"`
 
 	// The NextToken value from a previous call to this operation. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	// The owner of the bundles to retrieve. This parameter cannot be combined with
 	// any other filter parameter.
@@ -446,7 +584,7 @@ type DescribeWorkspaceBundlesOutput struct {
 	// If not null, more results are available. Pass this value for the NextToken
 	// parameter in a subsequent call to this operation to retrieve the next set
 	// of items. This token is valid for one day and must be used within that timeframe.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	metadataDescribeWorkspaceBundlesOutput `json:"-" xml:"-"`
 }
@@ -469,11 +607,11 @@ func (s DescribeWorkspaceBundlesOutput) GoString() string {
 type DescribeWorkspaceDirectoriesInput struct {
 	// An array of strings that contains the directory identifiers to retrieve information
 	// for. If this member is null, all directories are retrieved.
-	DirectoryIDs []*string `locationName:"DirectoryIds" type:"list"`
+	DirectoryIDs []*string `locationName:"DirectoryIds" type:"list" min:"1" max:"25"`
 
 	// The NextToken value from a previous call to this operation. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	metadataDescribeWorkspaceDirectoriesInput `json:"-" xml:"-"`
 }
@@ -500,7 +638,7 @@ type DescribeWorkspaceDirectoriesOutput struct {
 	// If not null, more results are available. Pass this value for the NextToken
 	// parameter in a subsequent call to this operation to retrieve the next set
 	// of items. This token is valid for one day and must be used within that timeframe.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	metadataDescribeWorkspaceDirectoriesOutput `json:"-" xml:"-"`
 }
@@ -532,15 +670,15 @@ type DescribeWorkspacesInput struct {
 	DirectoryID *string `locationName:"DirectoryId" type:"string"`
 
 	// The maximum number of items to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"25"`
 
 	// The NextToken value from a previous call to this operation. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	// Used with the DirectoryId parameter to specify the directory user for which
 	// to obtain the WorkSpace.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"63"`
 
 	// An array of strings that contain the identifiers of the WorkSpaces for which
 	// to retrieve information. This parameter cannot be combined with any other
@@ -549,7 +687,7 @@ type DescribeWorkspacesInput struct {
 	// Because the CreateWorkspaces operation is asynchronous, the identifier returned
 	// by CreateWorkspaces is not immediately available. If you immediately call
 	// DescribeWorkspaces with this identifier, no information will be returned.
-	WorkspaceIDs []*string `locationName:"WorkspaceIds" type:"list"`
+	WorkspaceIDs []*string `locationName:"WorkspaceIds" type:"list" min:"1" max:"25"`
 
 	metadataDescribeWorkspacesInput `json:"-" xml:"-"`
 }
@@ -573,7 +711,7 @@ type DescribeWorkspacesOutput struct {
 	// If not null, more results are available. Pass this value for the NextToken
 	// parameter in a subsequent call to this operation to retrieve the next set
 	// of items. This token is valid for one day and must be used within that timeframe.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	// An array of structures that contain the information about the WorkSpaces.
 	//
@@ -682,7 +820,7 @@ func (s RebootRequest) GoString() string {
 // Contains the inputs for the RebootWorkspaces operation.
 type RebootWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to reboot.
-	RebootWorkspaceRequests []*RebootRequest `type:"list" required:"true"`
+	RebootWorkspaceRequests []*RebootRequest `type:"list" min:"1" max:"25" required:"true"`
 
 	metadataRebootWorkspacesInput `json:"-" xml:"-"`
 }
@@ -749,7 +887,7 @@ func (s RebuildRequest) GoString() string {
 // Contains the inputs for the RebuildWorkspaces operation.
 type RebuildWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to rebuild.
-	RebuildWorkspaceRequests []*RebuildRequest `type:"list" required:"true"`
+	RebuildWorkspaceRequests []*RebuildRequest `type:"list" min:"1" max:"1" required:"true"`
 
 	metadataRebuildWorkspacesInput `json:"-" xml:"-"`
 }
@@ -816,7 +954,7 @@ func (s TerminateRequest) GoString() string {
 // Contains the inputs for the TerminateWorkspaces operation.
 type TerminateWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to terminate.
-	TerminateWorkspaceRequests []*TerminateRequest `type:"list" required:"true"`
+	TerminateWorkspaceRequests []*TerminateRequest `type:"list" min:"1" max:"25" required:"true"`
 
 	metadataTerminateWorkspacesInput `json:"-" xml:"-"`
 }
@@ -860,7 +998,7 @@ func (s TerminateWorkspacesOutput) GoString() string {
 // Contains information about the user storage for a WorkSpace bundle.
 type UserStorage struct {
 	// The amount of user storage for the bundle.
-	Capacity *string `type:"string"`
+	Capacity *string `type:"string" min:"1"`
 
 	metadataUserStorage `json:"-" xml:"-"`
 }
@@ -905,7 +1043,7 @@ type Workspace struct {
 	SubnetID *string `locationName:"SubnetId" type:"string"`
 
 	// The user that the WorkSpace is assigned to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"63"`
 
 	// The identifier of the WorkSpace.
 	WorkspaceID *string `locationName:"WorkspaceId" type:"string"`
@@ -939,7 +1077,7 @@ type WorkspaceBundle struct {
 	Description *string `type:"string"`
 
 	// The name of the bundle.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1"`
 
 	// The owner of the bundle. This contains the owner's account identifier, or
 	// AMAZON if the bundle is provided by AWS.
@@ -973,7 +1111,7 @@ type WorkspaceDirectory struct {
 	Alias *string `type:"string"`
 
 	// The user name for the service account.
-	CustomerUserName *string `type:"string"`
+	CustomerUserName *string `type:"string" min:"1" max:"63"`
 
 	// An array of strings that contains the IP addresses of the DNS servers for
 	// the directory.
@@ -994,7 +1132,7 @@ type WorkspaceDirectory struct {
 
 	// The registration code for the directory. This is the code that users enter
 	// in their Amazon WorkSpaces client application to connect to the directory.
-	RegistrationCode *string `type:"string"`
+	RegistrationCode *string `type:"string" min:"1" max:"20"`
 
 	// The state of the directory's registration with Amazon WorkSpaces
 	State *string `type:"string"`
@@ -1041,7 +1179,7 @@ type WorkspaceRequest struct {
 
 	// The username that the WorkSpace is assigned to. This username must exist
 	// in the AWS Directory Service directory specified by the DirectoryId member.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"63" required:"true"`
 
 	metadataWorkspaceRequest `json:"-" xml:"-"`
 }
@@ -0,0 +1,23 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package workspaces
+
+const (
+	// ErrCodeInvalidParameterValuesException for service response error code
+	// "InvalidParameterValuesException".
+	//
+	// One or more parameter values are not valid.
+	ErrCodeInvalidParameterValuesException = "InvalidParameterValuesException"
+
+	// ErrCodeResourceLimitExceededException for service response error code
+	// "ResourceLimitExceededException".
+	//
+	// Your resource limits have been exceeded.
+	ErrCodeResourceLimitExceededException = "ResourceLimitExceededException"
+
+	// ErrCodeResourceUnavailableException for service response error code
+	// "ResourceUnavailableException".
+	//
+	// The specified resource is not available.
+	ErrCodeResourceUnavailableException = "ResourceUnavailableException"
+)
@@ -4,6 +4,7 @@
 package workspacesiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
 )
 
@@ -11,15 +12,41 @@ import (
 type WorkSpacesAPI interface {
 	CreateWorkspaces(*workspaces.CreateWorkspacesInput) (*workspaces.CreateWorkspacesOutput, error)
 
+	CreateWorkspacesWithContext(aws.Context, *workspaces.CreateWorkspacesInput, ...aws.Option) (*workspaces.CreateWorkspacesOutput, error)
+
 	DescribeWorkspaceBundles(*workspaces.DescribeWorkspaceBundlesInput) (*workspaces.DescribeWorkspaceBundlesOutput, error)
 
+	DescribeWorkspaceBundlesWithContext(aws.Context, *workspaces.DescribeWorkspaceBundlesInput, ...aws.Option) (*workspaces.DescribeWorkspaceBundlesOutput, error)
+
+	DescribeWorkspaceBundlesPages(*workspaces.DescribeWorkspaceBundlesInput, func(*workspaces.DescribeWorkspaceBundlesOutput, bool) bool) error
+
+	DescribeWorkspaceBundlesPagesWithContext(aws.Context, *workspaces.DescribeWorkspaceBundlesInput, func(*workspaces.DescribeWorkspaceBundlesOutput, bool) bool, ...aws.Option) error
+
 	DescribeWorkspaceDirectories(*workspaces.DescribeWorkspaceDirectoriesInput) (*workspaces.DescribeWorkspaceDirectoriesOutput, error)
 
+	DescribeWorkspaceDirectoriesWithContext(aws.Context, *workspaces.DescribeWorkspaceDirectoriesInput, ...aws.Option) (*workspaces.DescribeWorkspaceDirectoriesOutput, error)
+
+	DescribeWorkspaceDirectoriesPages(*workspaces.DescribeWorkspaceDirectoriesInput, func(*workspaces.DescribeWorkspaceDirectoriesOutput, bool) bool) error
+
+	DescribeWorkspaceDirectoriesPagesWithContext(aws.Context, *workspaces.DescribeWorkspaceDirectoriesInput, func(*workspaces.DescribeWorkspaceDirectoriesOutput, bool) bool, ...aws.Option) error
+
 	DescribeWorkspaces(*workspaces.DescribeWorkspacesInput) (*workspaces.DescribeWorkspacesOutput, error)
 
+	DescribeWorkspacesWithContext(aws.Context, *workspaces.DescribeWorkspacesInput, ...aws.Option) (*workspaces.DescribeWorkspacesOutput, error)
+
+	DescribeWorkspacesPages(*workspaces.DescribeWorkspacesInput, func(*workspaces.DescribeWorkspacesOutput, bool) bool) error
+
+	DescribeWorkspacesPagesWithContext(aws.Context, *workspaces.DescribeWorkspacesInput, func(*workspaces.DescribeWorkspacesOutput, bool) bool, ...aws.Option) error
+
 	RebootWorkspaces(*workspaces.RebootWorkspacesInput) (*workspaces.RebootWorkspacesOutput, error)
 
+	RebootWorkspacesWithContext(aws.Context, *workspaces.RebootWorkspacesInput, ...aws.Option) (*workspaces.RebootWorkspacesOutput, error)
+
 	RebuildWorkspaces(*workspaces.RebuildWorkspacesInput) (*workspaces.RebuildWorkspacesOutput, error)
 
+	RebuildWorkspacesWithContext(aws.Context, *workspaces.RebuildWorkspacesInput, ...aws.Option) (*workspaces.RebuildWorkspacesOutput, error)
+
 	TerminateWorkspaces(*workspaces.TerminateWorkspacesInput) (*workspaces.TerminateWorkspacesOutput, error)
+
+	TerminateWorkspacesWithContext(aws.Context, *workspaces.TerminateWorkspacesInput, ...aws.Option) (*workspaces.TerminateWorkspacesOutput, error)
 }